@@ -21,8 +21,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ApiAuditLogService_List_FullMethodName = "/admin.service.v1.ApiAuditLogService/List"
-	ApiAuditLogService_Get_FullMethodName  = "/admin.service.v1.ApiAuditLogService/Get"
+	ApiAuditLogService_List_FullMethodName   = "/admin.service.v1.ApiAuditLogService/List"
+	ApiAuditLogService_Get_FullMethodName    = "/admin.service.v1.ApiAuditLogService/Get"
+	ApiAuditLogService_Export_FullMethodName = "/admin.service.v1.ApiAuditLogService/Export"
 )
 
 // ApiAuditLogServiceClient is the client API for ApiAuditLogService service.
@@ -35,6 +36,8 @@ type ApiAuditLogServiceClient interface {
 	List(ctx context.Context, in *v1.PagingRequest, opts ...grpc.CallOption) (*v11.ListApiAuditLogResponse, error)
 	// 查询API审计日志详情
 	Get(ctx context.Context, in *v11.GetApiAuditLogRequest, opts ...grpc.CallOption) (*v11.ApiAuditLog, error)
+	// 导出API审计日志（后台任务，完成后站内信通知）
+	Export(ctx context.Context, in *v11.ExportAuditLogRequest, opts ...grpc.CallOption) (*v11.ExportAuditLogResponse, error)
 }
 
 type apiAuditLogServiceClient struct {
@@ -65,6 +68,16 @@ func (c *apiAuditLogServiceClient) Get(ctx context.Context, in *v11.GetApiAuditL
 	return out, nil
 }
 
+func (c *apiAuditLogServiceClient) Export(ctx context.Context, in *v11.ExportAuditLogRequest, opts ...grpc.CallOption) (*v11.ExportAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v11.ExportAuditLogResponse)
+	err := c.cc.Invoke(ctx, ApiAuditLogService_Export_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ApiAuditLogServiceServer is the server API for ApiAuditLogService service.
 // All implementations must embed UnimplementedApiAuditLogServiceServer
 // for forward compatibility.
@@ -75,6 +88,8 @@ type ApiAuditLogServiceServer interface {
 	List(context.Context, *v1.PagingRequest) (*v11.ListApiAuditLogResponse, error)
 	// 查询API审计日志详情
 	Get(context.Context, *v11.GetApiAuditLogRequest) (*v11.ApiAuditLog, error)
+	// 导出API审计日志（后台任务，完成后站内信通知）
+	Export(context.Context, *v11.ExportAuditLogRequest) (*v11.ExportAuditLogResponse, error)
 	mustEmbedUnimplementedApiAuditLogServiceServer()
 }
 
@@ -91,6 +106,9 @@ func (UnimplementedApiAuditLogServiceServer) List(context.Context, *v1.PagingReq
 func (UnimplementedApiAuditLogServiceServer) Get(context.Context, *v11.GetApiAuditLogRequest) (*v11.ApiAuditLog, error) {
 	return nil, status.Error(codes.Unimplemented, "method Get not implemented")
 }
+func (UnimplementedApiAuditLogServiceServer) Export(context.Context, *v11.ExportAuditLogRequest) (*v11.ExportAuditLogResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Export not implemented")
+}
 func (UnimplementedApiAuditLogServiceServer) mustEmbedUnimplementedApiAuditLogServiceServer() {}
 func (UnimplementedApiAuditLogServiceServer) testEmbeddedByValue()                            {}
 
@@ -148,6 +166,24 @@ func _ApiAuditLogService_Get_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiAuditLogService_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.ExportAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiAuditLogServiceServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiAuditLogService_Export_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiAuditLogServiceServer).Export(ctx, req.(*v11.ExportAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ApiAuditLogService_ServiceDesc is the grpc.ServiceDesc for ApiAuditLogService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -163,6 +199,10 @@ var ApiAuditLogService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Get",
 			Handler:    _ApiAuditLogService_Get_Handler,
 		},
+		{
+			MethodName: "Export",
+			Handler:    _ApiAuditLogService_Export_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin/service/v1/i_api_audit_log.proto",
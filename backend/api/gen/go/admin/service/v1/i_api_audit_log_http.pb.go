@@ -21,10 +21,13 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationApiAuditLogServiceExport = "/admin.service.v1.ApiAuditLogService/Export"
 const OperationApiAuditLogServiceGet = "/admin.service.v1.ApiAuditLogService/Get"
 const OperationApiAuditLogServiceList = "/admin.service.v1.ApiAuditLogService/List"
 
 type ApiAuditLogServiceHTTPServer interface {
+	// Export 导出API审计日志（后台任务，完成后站内信通知）
+	Export(context.Context, *v11.ExportAuditLogRequest) (*v11.ExportAuditLogResponse, error)
 	// Get 查询API审计日志详情
 	Get(context.Context, *v11.GetApiAuditLogRequest) (*v11.ApiAuditLog, error)
 	// List 查询API审计日志列表
@@ -35,6 +38,7 @@ func RegisterApiAuditLogServiceHTTPServer(s *http.Server, srv ApiAuditLogService
 	r := s.Route("/")
 	r.GET("/admin/v1/api-audit-logs", _ApiAuditLogService_List1_HTTP_Handler(srv))
 	r.GET("/admin/v1/api-audit-logs/{id}", _ApiAuditLogService_Get1_HTTP_Handler(srv))
+	r.POST("/admin/v1/api-audit-logs:export", _ApiAuditLogService_Export0_HTTP_Handler(srv))
 }
 
 func _ApiAuditLogService_List1_HTTP_Handler(srv ApiAuditLogServiceHTTPServer) func(ctx http.Context) error {
@@ -78,7 +82,31 @@ func _ApiAuditLogService_Get1_HTTP_Handler(srv ApiAuditLogServiceHTTPServer) fun
 	}
 }
 
+func _ApiAuditLogService_Export0_HTTP_Handler(srv ApiAuditLogServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v11.ExportAuditLogRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationApiAuditLogServiceExport)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Export(ctx, req.(*v11.ExportAuditLogRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v11.ExportAuditLogResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type ApiAuditLogServiceHTTPClient interface {
+	// Export 导出API审计日志（后台任务，完成后站内信通知）
+	Export(ctx context.Context, req *v11.ExportAuditLogRequest, opts ...http.CallOption) (rsp *v11.ExportAuditLogResponse, err error)
 	// Get 查询API审计日志详情
 	Get(ctx context.Context, req *v11.GetApiAuditLogRequest, opts ...http.CallOption) (rsp *v11.ApiAuditLog, err error)
 	// List 查询API审计日志列表
@@ -93,6 +121,20 @@ func NewApiAuditLogServiceHTTPClient(client *http.Client) ApiAuditLogServiceHTTP
 	return &ApiAuditLogServiceHTTPClientImpl{client}
 }
 
+// Export 导出API审计日志（后台任务，完成后站内信通知）
+func (c *ApiAuditLogServiceHTTPClientImpl) Export(ctx context.Context, in *v11.ExportAuditLogRequest, opts ...http.CallOption) (*v11.ExportAuditLogResponse, error) {
+	var out v11.ExportAuditLogResponse
+	pattern := "/admin/v1/api-audit-logs:export"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationApiAuditLogServiceExport))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // Get 查询API审计日志详情
 func (c *ApiAuditLogServiceHTTPClientImpl) Get(ctx context.Context, in *v11.GetApiAuditLogRequest, opts ...http.CallOption) (*v11.ApiAuditLog, error) {
 	var out v11.ApiAuditLog
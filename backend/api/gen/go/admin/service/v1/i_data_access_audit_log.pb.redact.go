@@ -21,6 +21,7 @@ var (
 	_ codes.Code
 	_ status.Status
 	_ pagination.Sorting
+	_ auditpb.ExportAuditLogRequest
 	_ auditpb.DataAccessAuditLog
 )
 
@@ -63,3 +64,14 @@ func (s *redactedDataAccessAuditLogServiceServer) Get(ctx context.Context, in *a
 	}
 	return res, err
 }
+
+// Export is the redacted wrapper for the actual DataAccessAuditLogServiceServer.Export method
+// Unary RPC
+func (s *redactedDataAccessAuditLogServiceServer) Export(ctx context.Context, in *auditpb.ExportAuditLogRequest) (*auditpb.ExportAuditLogResponse, error) {
+	res, err := s.srv.Export(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
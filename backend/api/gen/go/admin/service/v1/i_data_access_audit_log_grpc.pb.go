@@ -21,8 +21,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	DataAccessAuditLogService_List_FullMethodName = "/admin.service.v1.DataAccessAuditLogService/List"
-	DataAccessAuditLogService_Get_FullMethodName  = "/admin.service.v1.DataAccessAuditLogService/Get"
+	DataAccessAuditLogService_List_FullMethodName   = "/admin.service.v1.DataAccessAuditLogService/List"
+	DataAccessAuditLogService_Get_FullMethodName    = "/admin.service.v1.DataAccessAuditLogService/Get"
+	DataAccessAuditLogService_Export_FullMethodName = "/admin.service.v1.DataAccessAuditLogService/Export"
 )
 
 // DataAccessAuditLogServiceClient is the client API for DataAccessAuditLogService service.
@@ -35,6 +36,8 @@ type DataAccessAuditLogServiceClient interface {
 	List(ctx context.Context, in *v1.PagingRequest, opts ...grpc.CallOption) (*v11.ListDataAccessAuditLogResponse, error)
 	// 查询数据访问审计日志详情
 	Get(ctx context.Context, in *v11.GetDataAccessAuditLogRequest, opts ...grpc.CallOption) (*v11.DataAccessAuditLog, error)
+	// 导出数据访问审计日志（后台任务，完成后站内信通知）
+	Export(ctx context.Context, in *v11.ExportAuditLogRequest, opts ...grpc.CallOption) (*v11.ExportAuditLogResponse, error)
 }
 
 type dataAccessAuditLogServiceClient struct {
@@ -65,6 +68,16 @@ func (c *dataAccessAuditLogServiceClient) Get(ctx context.Context, in *v11.GetDa
 	return out, nil
 }
 
+func (c *dataAccessAuditLogServiceClient) Export(ctx context.Context, in *v11.ExportAuditLogRequest, opts ...grpc.CallOption) (*v11.ExportAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v11.ExportAuditLogResponse)
+	err := c.cc.Invoke(ctx, DataAccessAuditLogService_Export_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DataAccessAuditLogServiceServer is the server API for DataAccessAuditLogService service.
 // All implementations must embed UnimplementedDataAccessAuditLogServiceServer
 // for forward compatibility.
@@ -75,6 +88,8 @@ type DataAccessAuditLogServiceServer interface {
 	List(context.Context, *v1.PagingRequest) (*v11.ListDataAccessAuditLogResponse, error)
 	// 查询数据访问审计日志详情
 	Get(context.Context, *v11.GetDataAccessAuditLogRequest) (*v11.DataAccessAuditLog, error)
+	// 导出数据访问审计日志（后台任务，完成后站内信通知）
+	Export(context.Context, *v11.ExportAuditLogRequest) (*v11.ExportAuditLogResponse, error)
 	mustEmbedUnimplementedDataAccessAuditLogServiceServer()
 }
 
@@ -91,6 +106,9 @@ func (UnimplementedDataAccessAuditLogServiceServer) List(context.Context, *v1.Pa
 func (UnimplementedDataAccessAuditLogServiceServer) Get(context.Context, *v11.GetDataAccessAuditLogRequest) (*v11.DataAccessAuditLog, error) {
 	return nil, status.Error(codes.Unimplemented, "method Get not implemented")
 }
+func (UnimplementedDataAccessAuditLogServiceServer) Export(context.Context, *v11.ExportAuditLogRequest) (*v11.ExportAuditLogResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Export not implemented")
+}
 func (UnimplementedDataAccessAuditLogServiceServer) mustEmbedUnimplementedDataAccessAuditLogServiceServer() {
 }
 func (UnimplementedDataAccessAuditLogServiceServer) testEmbeddedByValue() {}
@@ -149,6 +167,24 @@ func _DataAccessAuditLogService_Get_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataAccessAuditLogService_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.ExportAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataAccessAuditLogServiceServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataAccessAuditLogService_Export_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataAccessAuditLogServiceServer).Export(ctx, req.(*v11.ExportAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DataAccessAuditLogService_ServiceDesc is the grpc.ServiceDesc for DataAccessAuditLogService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -164,6 +200,10 @@ var DataAccessAuditLogService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Get",
 			Handler:    _DataAccessAuditLogService_Get_Handler,
 		},
+		{
+			MethodName: "Export",
+			Handler:    _DataAccessAuditLogService_Export_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin/service/v1/i_data_access_audit_log.proto",
@@ -21,10 +21,13 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationDataAccessAuditLogServiceExport = "/admin.service.v1.DataAccessAuditLogService/Export"
 const OperationDataAccessAuditLogServiceGet = "/admin.service.v1.DataAccessAuditLogService/Get"
 const OperationDataAccessAuditLogServiceList = "/admin.service.v1.DataAccessAuditLogService/List"
 
 type DataAccessAuditLogServiceHTTPServer interface {
+	// Export 导出数据访问审计日志（后台任务，完成后站内信通知）
+	Export(context.Context, *v11.ExportAuditLogRequest) (*v11.ExportAuditLogResponse, error)
 	// Get 查询数据访问审计日志详情
 	Get(context.Context, *v11.GetDataAccessAuditLogRequest) (*v11.DataAccessAuditLog, error)
 	// List 查询数据访问审计日志列表
@@ -35,6 +38,7 @@ func RegisterDataAccessAuditLogServiceHTTPServer(s *http.Server, srv DataAccessA
 	r := s.Route("/")
 	r.GET("/admin/v1/data-access-audit-logs", _DataAccessAuditLogService_List2_HTTP_Handler(srv))
 	r.GET("/admin/v1/data-access-audit-logs/{id}", _DataAccessAuditLogService_Get2_HTTP_Handler(srv))
+	r.POST("/admin/v1/data-access-audit-logs:export", _DataAccessAuditLogService_Export1_HTTP_Handler(srv))
 }
 
 func _DataAccessAuditLogService_List2_HTTP_Handler(srv DataAccessAuditLogServiceHTTPServer) func(ctx http.Context) error {
@@ -78,7 +82,31 @@ func _DataAccessAuditLogService_Get2_HTTP_Handler(srv DataAccessAuditLogServiceH
 	}
 }
 
+func _DataAccessAuditLogService_Export1_HTTP_Handler(srv DataAccessAuditLogServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v11.ExportAuditLogRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDataAccessAuditLogServiceExport)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Export(ctx, req.(*v11.ExportAuditLogRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v11.ExportAuditLogResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type DataAccessAuditLogServiceHTTPClient interface {
+	// Export 导出数据访问审计日志（后台任务，完成后站内信通知）
+	Export(ctx context.Context, req *v11.ExportAuditLogRequest, opts ...http.CallOption) (rsp *v11.ExportAuditLogResponse, err error)
 	// Get 查询数据访问审计日志详情
 	Get(ctx context.Context, req *v11.GetDataAccessAuditLogRequest, opts ...http.CallOption) (rsp *v11.DataAccessAuditLog, err error)
 	// List 查询数据访问审计日志列表
@@ -93,6 +121,20 @@ func NewDataAccessAuditLogServiceHTTPClient(client *http.Client) DataAccessAudit
 	return &DataAccessAuditLogServiceHTTPClientImpl{client}
 }
 
+// Export 导出数据访问审计日志（后台任务，完成后站内信通知）
+func (c *DataAccessAuditLogServiceHTTPClientImpl) Export(ctx context.Context, in *v11.ExportAuditLogRequest, opts ...http.CallOption) (*v11.ExportAuditLogResponse, error) {
+	var out v11.ExportAuditLogResponse
+	pattern := "/admin/v1/data-access-audit-logs:export"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationDataAccessAuditLogServiceExport))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // Get 查询数据访问审计日志详情
 func (c *DataAccessAuditLogServiceHTTPClientImpl) Get(ctx context.Context, in *v11.GetDataAccessAuditLogRequest, opts ...http.CallOption) (*v11.DataAccessAuditLog, error) {
 	var out v11.DataAccessAuditLog
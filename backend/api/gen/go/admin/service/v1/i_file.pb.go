@@ -28,42 +28,64 @@ var File_admin_service_v1_i_file_proto protoreflect.FileDescriptor
 
 const file_admin_service_v1_i_file_proto_rawDesc = "" +
 	"\n" +
-	"\x1dadmin/service/v1/i_file.proto\x12\x10admin.service.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1epagination/v1/pagination.proto\x1a\x1dstorage/service/v1/file.proto2\x88\x04\n" +
+	"\x1dadmin/service/v1/i_file.proto\x12\x10admin.service.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1epagination/v1/pagination.proto\x1a\x1dstorage/service/v1/file.proto2\xb2\n" +
+	"\n" +
 	"\vFileService\x12`\n" +
 	"\x04List\x12\x19.pagination.PagingRequest\x1a$.storage.service.v1.ListFileResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/admin/v1/files\x12a\n" +
 	"\x03Get\x12\".storage.service.v1.GetFileRequest\x1a\x18.storage.service.v1.File\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/admin/v1/files/{id}\x12c\n" +
 	"\x06Create\x12%.storage.service.v1.CreateFileRequest\x1a\x16.google.protobuf.Empty\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/admin/v1/files\x12h\n" +
 	"\x06Update\x12%.storage.service.v1.UpdateFileRequest\x1a\x16.google.protobuf.Empty\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\x1a\x14/admin/v1/files/{id}\x12e\n" +
-	"\x06Delete\x12%.storage.service.v1.DeleteFileRequest\x1a\x16.google.protobuf.Empty\"\x1c\x82\xd3\xe4\x93\x02\x16*\x14/admin/v1/files/{id}B\xb7\x01\n" +
+	"\x06Delete\x12%.storage.service.v1.DeleteFileRequest\x1a\x16.google.protobuf.Empty\"\x1c\x82\xd3\xe4\x93\x02\x16*\x14/admin/v1/files/{id}\x12\x88\x01\n" +
+	"\fListVersions\x12+.storage.service.v1.ListFileVersionsRequest\x1a$.storage.service.v1.ListFileResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/admin/v1/files/{id}/versions\x12\x8e\x01\n" +
+	"\x0eRestoreVersion\x12&.storage.service.v1.FileVersionRequest\x1a\x18.storage.service.v1.File\":\x82\xd3\xe4\x93\x024:\x01*\"//admin/v1/files/{id}/versions/{version}/restore\x12\x7f\n" +
+	"\fPurgeVersion\x12&.storage.service.v1.FileVersionRequest\x1a\x16.google.protobuf.Empty\"/\x82\xd3\xe4\x93\x02)*'/admin/v1/files/{id}/versions/{version}\x12v\n" +
+	"\x0eListRecycleBin\x12\x19.pagination.PagingRequest\x1a$.storage.service.v1.ListFileResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/admin/v1/recycle-bin/files\x12\x8f\x01\n" +
+	"\x15RestoreFromRecycleBin\x12'.storage.service.v1.RecycledFileRequest\x1a\x18.storage.service.v1.File\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/admin/v1/recycle-bin/files/{id}/restore\x12\x80\x01\n" +
+	"\x13PurgeFromRecycleBin\x12'.storage.service.v1.RecycledFileRequest\x1a\x16.google.protobuf.Empty\"(\x82\xd3\xe4\x93\x02\"* /admin/v1/recycle-bin/files/{id}B\xb7\x01\n" +
 	"\x14com.admin.service.v1B\n" +
 	"IFileProtoP\x01Z1go-wind-admin/api/gen/go/admin/service/v1;adminpb\xa2\x02\x03ASX\xaa\x02\x10Admin.Service.V1\xca\x02\x10Admin\\Service\\V1\xe2\x02\x1cAdmin\\Service\\V1\\GPBMetadata\xea\x02\x12Admin::Service::V1b\x06proto3"
 
 var file_admin_service_v1_i_file_proto_goTypes = []any{
-	(*v1.PagingRequest)(nil),      // 0: pagination.PagingRequest
-	(*v11.GetFileRequest)(nil),    // 1: storage.service.v1.GetFileRequest
-	(*v11.CreateFileRequest)(nil), // 2: storage.service.v1.CreateFileRequest
-	(*v11.UpdateFileRequest)(nil), // 3: storage.service.v1.UpdateFileRequest
-	(*v11.DeleteFileRequest)(nil), // 4: storage.service.v1.DeleteFileRequest
-	(*v11.ListFileResponse)(nil),  // 5: storage.service.v1.ListFileResponse
-	(*v11.File)(nil),              // 6: storage.service.v1.File
-	(*emptypb.Empty)(nil),         // 7: google.protobuf.Empty
+	(*v1.PagingRequest)(nil),            // 0: pagination.PagingRequest
+	(*v11.GetFileRequest)(nil),          // 1: storage.service.v1.GetFileRequest
+	(*v11.CreateFileRequest)(nil),       // 2: storage.service.v1.CreateFileRequest
+	(*v11.UpdateFileRequest)(nil),       // 3: storage.service.v1.UpdateFileRequest
+	(*v11.DeleteFileRequest)(nil),       // 4: storage.service.v1.DeleteFileRequest
+	(*v11.ListFileVersionsRequest)(nil), // 5: storage.service.v1.ListFileVersionsRequest
+	(*v11.FileVersionRequest)(nil),      // 6: storage.service.v1.FileVersionRequest
+	(*v11.RecycledFileRequest)(nil),     // 7: storage.service.v1.RecycledFileRequest
+	(*v11.ListFileResponse)(nil),        // 8: storage.service.v1.ListFileResponse
+	(*v11.File)(nil),                    // 9: storage.service.v1.File
+	(*emptypb.Empty)(nil),               // 10: google.protobuf.Empty
 }
 var file_admin_service_v1_i_file_proto_depIdxs = []int32{
-	0, // 0: admin.service.v1.FileService.List:input_type -> pagination.PagingRequest
-	1, // 1: admin.service.v1.FileService.Get:input_type -> storage.service.v1.GetFileRequest
-	2, // 2: admin.service.v1.FileService.Create:input_type -> storage.service.v1.CreateFileRequest
-	3, // 3: admin.service.v1.FileService.Update:input_type -> storage.service.v1.UpdateFileRequest
-	4, // 4: admin.service.v1.FileService.Delete:input_type -> storage.service.v1.DeleteFileRequest
-	5, // 5: admin.service.v1.FileService.List:output_type -> storage.service.v1.ListFileResponse
-	6, // 6: admin.service.v1.FileService.Get:output_type -> storage.service.v1.File
-	7, // 7: admin.service.v1.FileService.Create:output_type -> google.protobuf.Empty
-	7, // 8: admin.service.v1.FileService.Update:output_type -> google.protobuf.Empty
-	7, // 9: admin.service.v1.FileService.Delete:output_type -> google.protobuf.Empty
-	5, // [5:10] is the sub-list for method output_type
-	0, // [0:5] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: admin.service.v1.FileService.List:input_type -> pagination.PagingRequest
+	1,  // 1: admin.service.v1.FileService.Get:input_type -> storage.service.v1.GetFileRequest
+	2,  // 2: admin.service.v1.FileService.Create:input_type -> storage.service.v1.CreateFileRequest
+	3,  // 3: admin.service.v1.FileService.Update:input_type -> storage.service.v1.UpdateFileRequest
+	4,  // 4: admin.service.v1.FileService.Delete:input_type -> storage.service.v1.DeleteFileRequest
+	5,  // 5: admin.service.v1.FileService.ListVersions:input_type -> storage.service.v1.ListFileVersionsRequest
+	6,  // 6: admin.service.v1.FileService.RestoreVersion:input_type -> storage.service.v1.FileVersionRequest
+	6,  // 7: admin.service.v1.FileService.PurgeVersion:input_type -> storage.service.v1.FileVersionRequest
+	0,  // 8: admin.service.v1.FileService.ListRecycleBin:input_type -> pagination.PagingRequest
+	7,  // 9: admin.service.v1.FileService.RestoreFromRecycleBin:input_type -> storage.service.v1.RecycledFileRequest
+	7,  // 10: admin.service.v1.FileService.PurgeFromRecycleBin:input_type -> storage.service.v1.RecycledFileRequest
+	8,  // 11: admin.service.v1.FileService.List:output_type -> storage.service.v1.ListFileResponse
+	9,  // 12: admin.service.v1.FileService.Get:output_type -> storage.service.v1.File
+	10, // 13: admin.service.v1.FileService.Create:output_type -> google.protobuf.Empty
+	10, // 14: admin.service.v1.FileService.Update:output_type -> google.protobuf.Empty
+	10, // 15: admin.service.v1.FileService.Delete:output_type -> google.protobuf.Empty
+	8,  // 16: admin.service.v1.FileService.ListVersions:output_type -> storage.service.v1.ListFileResponse
+	9,  // 17: admin.service.v1.FileService.RestoreVersion:output_type -> storage.service.v1.File
+	10, // 18: admin.service.v1.FileService.PurgeVersion:output_type -> google.protobuf.Empty
+	8,  // 19: admin.service.v1.FileService.ListRecycleBin:output_type -> storage.service.v1.ListFileResponse
+	9,  // 20: admin.service.v1.FileService.RestoreFromRecycleBin:output_type -> storage.service.v1.File
+	10, // 21: admin.service.v1.FileService.PurgeFromRecycleBin:output_type -> google.protobuf.Empty
+	11, // [11:22] is the sub-list for method output_type
+	0,  // [0:11] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_admin_service_v1_i_file_proto_init() }
@@ -98,3 +98,69 @@ func (s *redactedFileServiceServer) Delete(ctx context.Context, in *storagepb.De
 	}
 	return res, err
 }
+
+// ListVersions is the redacted wrapper for the actual FileServiceServer.ListVersions method
+// Unary RPC
+func (s *redactedFileServiceServer) ListVersions(ctx context.Context, in *storagepb.ListFileVersionsRequest) (*storagepb.ListFileResponse, error) {
+	res, err := s.srv.ListVersions(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// RestoreVersion is the redacted wrapper for the actual FileServiceServer.RestoreVersion method
+// Unary RPC
+func (s *redactedFileServiceServer) RestoreVersion(ctx context.Context, in *storagepb.FileVersionRequest) (*storagepb.File, error) {
+	res, err := s.srv.RestoreVersion(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// PurgeVersion is the redacted wrapper for the actual FileServiceServer.PurgeVersion method
+// Unary RPC
+func (s *redactedFileServiceServer) PurgeVersion(ctx context.Context, in *storagepb.FileVersionRequest) (*emptypb.Empty, error) {
+	res, err := s.srv.PurgeVersion(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ListRecycleBin is the redacted wrapper for the actual FileServiceServer.ListRecycleBin method
+// Unary RPC
+func (s *redactedFileServiceServer) ListRecycleBin(ctx context.Context, in *pagination.PagingRequest) (*storagepb.ListFileResponse, error) {
+	res, err := s.srv.ListRecycleBin(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// RestoreFromRecycleBin is the redacted wrapper for the actual FileServiceServer.RestoreFromRecycleBin method
+// Unary RPC
+func (s *redactedFileServiceServer) RestoreFromRecycleBin(ctx context.Context, in *storagepb.RecycledFileRequest) (*storagepb.File, error) {
+	res, err := s.srv.RestoreFromRecycleBin(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// PurgeFromRecycleBin is the redacted wrapper for the actual FileServiceServer.PurgeFromRecycleBin method
+// Unary RPC
+func (s *redactedFileServiceServer) PurgeFromRecycleBin(ctx context.Context, in *storagepb.RecycledFileRequest) (*emptypb.Empty, error) {
+	res, err := s.srv.PurgeFromRecycleBin(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
@@ -22,11 +22,17 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	FileService_List_FullMethodName   = "/admin.service.v1.FileService/List"
-	FileService_Get_FullMethodName    = "/admin.service.v1.FileService/Get"
-	FileService_Create_FullMethodName = "/admin.service.v1.FileService/Create"
-	FileService_Update_FullMethodName = "/admin.service.v1.FileService/Update"
-	FileService_Delete_FullMethodName = "/admin.service.v1.FileService/Delete"
+	FileService_List_FullMethodName                  = "/admin.service.v1.FileService/List"
+	FileService_Get_FullMethodName                   = "/admin.service.v1.FileService/Get"
+	FileService_Create_FullMethodName                = "/admin.service.v1.FileService/Create"
+	FileService_Update_FullMethodName                = "/admin.service.v1.FileService/Update"
+	FileService_Delete_FullMethodName                = "/admin.service.v1.FileService/Delete"
+	FileService_ListVersions_FullMethodName          = "/admin.service.v1.FileService/ListVersions"
+	FileService_RestoreVersion_FullMethodName        = "/admin.service.v1.FileService/RestoreVersion"
+	FileService_PurgeVersion_FullMethodName          = "/admin.service.v1.FileService/PurgeVersion"
+	FileService_ListRecycleBin_FullMethodName        = "/admin.service.v1.FileService/ListRecycleBin"
+	FileService_RestoreFromRecycleBin_FullMethodName = "/admin.service.v1.FileService/RestoreFromRecycleBin"
+	FileService_PurgeFromRecycleBin_FullMethodName   = "/admin.service.v1.FileService/PurgeFromRecycleBin"
 )
 
 // FileServiceClient is the client API for FileService service.
@@ -45,6 +51,18 @@ type FileServiceClient interface {
 	Update(ctx context.Context, in *v11.UpdateFileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// 删除文件
 	Delete(ctx context.Context, in *v11.DeleteFileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 查询文件的版本历史
+	ListVersions(ctx context.Context, in *v11.ListFileVersionsRequest, opts ...grpc.CallOption) (*v11.ListFileResponse, error)
+	// 恢复文件的指定版本为当前版本
+	RestoreVersion(ctx context.Context, in *v11.FileVersionRequest, opts ...grpc.CallOption) (*v11.File, error)
+	// 永久删除文件的指定历史版本
+	PurgeVersion(ctx context.Context, in *v11.FileVersionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 查询回收站中的文件
+	ListRecycleBin(ctx context.Context, in *v1.PagingRequest, opts ...grpc.CallOption) (*v11.ListFileResponse, error)
+	// 从回收站恢复文件
+	RestoreFromRecycleBin(ctx context.Context, in *v11.RecycledFileRequest, opts ...grpc.CallOption) (*v11.File, error)
+	// 从回收站永久删除文件
+	PurgeFromRecycleBin(ctx context.Context, in *v11.RecycledFileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type fileServiceClient struct {
@@ -105,6 +123,66 @@ func (c *fileServiceClient) Delete(ctx context.Context, in *v11.DeleteFileReques
 	return out, nil
 }
 
+func (c *fileServiceClient) ListVersions(ctx context.Context, in *v11.ListFileVersionsRequest, opts ...grpc.CallOption) (*v11.ListFileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v11.ListFileResponse)
+	err := c.cc.Invoke(ctx, FileService_ListVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) RestoreVersion(ctx context.Context, in *v11.FileVersionRequest, opts ...grpc.CallOption) (*v11.File, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v11.File)
+	err := c.cc.Invoke(ctx, FileService_RestoreVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) PurgeVersion(ctx context.Context, in *v11.FileVersionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, FileService_PurgeVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) ListRecycleBin(ctx context.Context, in *v1.PagingRequest, opts ...grpc.CallOption) (*v11.ListFileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v11.ListFileResponse)
+	err := c.cc.Invoke(ctx, FileService_ListRecycleBin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) RestoreFromRecycleBin(ctx context.Context, in *v11.RecycledFileRequest, opts ...grpc.CallOption) (*v11.File, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v11.File)
+	err := c.cc.Invoke(ctx, FileService_RestoreFromRecycleBin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) PurgeFromRecycleBin(ctx context.Context, in *v11.RecycledFileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, FileService_PurgeFromRecycleBin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FileServiceServer is the server API for FileService service.
 // All implementations must embed UnimplementedFileServiceServer
 // for forward compatibility.
@@ -121,6 +199,18 @@ type FileServiceServer interface {
 	Update(context.Context, *v11.UpdateFileRequest) (*emptypb.Empty, error)
 	// 删除文件
 	Delete(context.Context, *v11.DeleteFileRequest) (*emptypb.Empty, error)
+	// 查询文件的版本历史
+	ListVersions(context.Context, *v11.ListFileVersionsRequest) (*v11.ListFileResponse, error)
+	// 恢复文件的指定版本为当前版本
+	RestoreVersion(context.Context, *v11.FileVersionRequest) (*v11.File, error)
+	// 永久删除文件的指定历史版本
+	PurgeVersion(context.Context, *v11.FileVersionRequest) (*emptypb.Empty, error)
+	// 查询回收站中的文件
+	ListRecycleBin(context.Context, *v1.PagingRequest) (*v11.ListFileResponse, error)
+	// 从回收站恢复文件
+	RestoreFromRecycleBin(context.Context, *v11.RecycledFileRequest) (*v11.File, error)
+	// 从回收站永久删除文件
+	PurgeFromRecycleBin(context.Context, *v11.RecycledFileRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedFileServiceServer()
 }
 
@@ -146,6 +236,24 @@ func (UnimplementedFileServiceServer) Update(context.Context, *v11.UpdateFileReq
 func (UnimplementedFileServiceServer) Delete(context.Context, *v11.DeleteFileRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedFileServiceServer) ListVersions(context.Context, *v11.ListFileVersionsRequest) (*v11.ListFileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListVersions not implemented")
+}
+func (UnimplementedFileServiceServer) RestoreVersion(context.Context, *v11.FileVersionRequest) (*v11.File, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreVersion not implemented")
+}
+func (UnimplementedFileServiceServer) PurgeVersion(context.Context, *v11.FileVersionRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method PurgeVersion not implemented")
+}
+func (UnimplementedFileServiceServer) ListRecycleBin(context.Context, *v1.PagingRequest) (*v11.ListFileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRecycleBin not implemented")
+}
+func (UnimplementedFileServiceServer) RestoreFromRecycleBin(context.Context, *v11.RecycledFileRequest) (*v11.File, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreFromRecycleBin not implemented")
+}
+func (UnimplementedFileServiceServer) PurgeFromRecycleBin(context.Context, *v11.RecycledFileRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method PurgeFromRecycleBin not implemented")
+}
 func (UnimplementedFileServiceServer) mustEmbedUnimplementedFileServiceServer() {}
 func (UnimplementedFileServiceServer) testEmbeddedByValue()                     {}
 
@@ -257,6 +365,114 @@ func _FileService_Delete_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FileService_ListVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.ListFileVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).ListVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_ListVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).ListVersions(ctx, req.(*v11.ListFileVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_RestoreVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.FileVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).RestoreVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_RestoreVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).RestoreVersion(ctx, req.(*v11.FileVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_PurgeVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.FileVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).PurgeVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_PurgeVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).PurgeVersion(ctx, req.(*v11.FileVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_ListRecycleBin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.PagingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).ListRecycleBin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_ListRecycleBin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).ListRecycleBin(ctx, req.(*v1.PagingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_RestoreFromRecycleBin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.RecycledFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).RestoreFromRecycleBin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_RestoreFromRecycleBin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).RestoreFromRecycleBin(ctx, req.(*v11.RecycledFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_PurgeFromRecycleBin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.RecycledFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).PurgeFromRecycleBin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_PurgeFromRecycleBin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).PurgeFromRecycleBin(ctx, req.(*v11.RecycledFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FileService_ServiceDesc is the grpc.ServiceDesc for FileService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -284,6 +500,30 @@ var FileService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _FileService_Delete_Handler,
 		},
+		{
+			MethodName: "ListVersions",
+			Handler:    _FileService_ListVersions_Handler,
+		},
+		{
+			MethodName: "RestoreVersion",
+			Handler:    _FileService_RestoreVersion_Handler,
+		},
+		{
+			MethodName: "PurgeVersion",
+			Handler:    _FileService_PurgeVersion_Handler,
+		},
+		{
+			MethodName: "ListRecycleBin",
+			Handler:    _FileService_ListRecycleBin_Handler,
+		},
+		{
+			MethodName: "RestoreFromRecycleBin",
+			Handler:    _FileService_RestoreFromRecycleBin_Handler,
+		},
+		{
+			MethodName: "PurgeFromRecycleBin",
+			Handler:    _FileService_PurgeFromRecycleBin_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin/service/v1/i_file.proto",
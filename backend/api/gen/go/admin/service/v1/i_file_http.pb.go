@@ -26,6 +26,12 @@ const OperationFileServiceCreate = "/admin.service.v1.FileService/Create"
 const OperationFileServiceDelete = "/admin.service.v1.FileService/Delete"
 const OperationFileServiceGet = "/admin.service.v1.FileService/Get"
 const OperationFileServiceList = "/admin.service.v1.FileService/List"
+const OperationFileServiceListRecycleBin = "/admin.service.v1.FileService/ListRecycleBin"
+const OperationFileServiceListVersions = "/admin.service.v1.FileService/ListVersions"
+const OperationFileServicePurgeFromRecycleBin = "/admin.service.v1.FileService/PurgeFromRecycleBin"
+const OperationFileServicePurgeVersion = "/admin.service.v1.FileService/PurgeVersion"
+const OperationFileServiceRestoreFromRecycleBin = "/admin.service.v1.FileService/RestoreFromRecycleBin"
+const OperationFileServiceRestoreVersion = "/admin.service.v1.FileService/RestoreVersion"
 const OperationFileServiceUpdate = "/admin.service.v1.FileService/Update"
 
 type FileServiceHTTPServer interface {
@@ -37,6 +43,18 @@ type FileServiceHTTPServer interface {
 	Get(context.Context, *v11.GetFileRequest) (*v11.File, error)
 	// List 查询文件列表
 	List(context.Context, *v1.PagingRequest) (*v11.ListFileResponse, error)
+	// ListRecycleBin 查询回收站中的文件
+	ListRecycleBin(context.Context, *v1.PagingRequest) (*v11.ListFileResponse, error)
+	// ListVersions 查询文件的版本历史
+	ListVersions(context.Context, *v11.ListFileVersionsRequest) (*v11.ListFileResponse, error)
+	// PurgeFromRecycleBin 从回收站永久删除文件
+	PurgeFromRecycleBin(context.Context, *v11.RecycledFileRequest) (*emptypb.Empty, error)
+	// PurgeVersion 永久删除文件的指定历史版本
+	PurgeVersion(context.Context, *v11.FileVersionRequest) (*emptypb.Empty, error)
+	// RestoreFromRecycleBin 从回收站恢复文件
+	RestoreFromRecycleBin(context.Context, *v11.RecycledFileRequest) (*v11.File, error)
+	// RestoreVersion 恢复文件的指定版本为当前版本
+	RestoreVersion(context.Context, *v11.FileVersionRequest) (*v11.File, error)
 	// Update 更新文件
 	Update(context.Context, *v11.UpdateFileRequest) (*emptypb.Empty, error)
 }
@@ -48,6 +66,12 @@ func RegisterFileServiceHTTPServer(s *http.Server, srv FileServiceHTTPServer) {
 	r.POST("/admin/v1/files", _FileService_Create3_HTTP_Handler(srv))
 	r.PUT("/admin/v1/files/{id}", _FileService_Update3_HTTP_Handler(srv))
 	r.DELETE("/admin/v1/files/{id}", _FileService_Delete3_HTTP_Handler(srv))
+	r.GET("/admin/v1/files/{id}/versions", _FileService_ListVersions0_HTTP_Handler(srv))
+	r.POST("/admin/v1/files/{id}/versions/{version}/restore", _FileService_RestoreVersion0_HTTP_Handler(srv))
+	r.DELETE("/admin/v1/files/{id}/versions/{version}", _FileService_PurgeVersion0_HTTP_Handler(srv))
+	r.GET("/admin/v1/recycle-bin/files", _FileService_ListRecycleBin0_HTTP_Handler(srv))
+	r.POST("/admin/v1/recycle-bin/files/{id}/restore", _FileService_RestoreFromRecycleBin0_HTTP_Handler(srv))
+	r.DELETE("/admin/v1/recycle-bin/files/{id}", _FileService_PurgeFromRecycleBin0_HTTP_Handler(srv))
 }
 
 func _FileService_List5_HTTP_Handler(srv FileServiceHTTPServer) func(ctx http.Context) error {
@@ -160,6 +184,141 @@ func _FileService_Delete3_HTTP_Handler(srv FileServiceHTTPServer) func(ctx http.
 	}
 }
 
+func _FileService_ListVersions0_HTTP_Handler(srv FileServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v11.ListFileVersionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationFileServiceListVersions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListVersions(ctx, req.(*v11.ListFileVersionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v11.ListFileResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _FileService_RestoreVersion0_HTTP_Handler(srv FileServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v11.FileVersionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationFileServiceRestoreVersion)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RestoreVersion(ctx, req.(*v11.FileVersionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v11.File)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _FileService_PurgeVersion0_HTTP_Handler(srv FileServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v11.FileVersionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationFileServicePurgeVersion)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PurgeVersion(ctx, req.(*v11.FileVersionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _FileService_ListRecycleBin0_HTTP_Handler(srv FileServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.PagingRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationFileServiceListRecycleBin)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListRecycleBin(ctx, req.(*v1.PagingRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v11.ListFileResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _FileService_RestoreFromRecycleBin0_HTTP_Handler(srv FileServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v11.RecycledFileRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationFileServiceRestoreFromRecycleBin)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RestoreFromRecycleBin(ctx, req.(*v11.RecycledFileRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v11.File)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _FileService_PurgeFromRecycleBin0_HTTP_Handler(srv FileServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v11.RecycledFileRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationFileServicePurgeFromRecycleBin)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PurgeFromRecycleBin(ctx, req.(*v11.RecycledFileRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
 type FileServiceHTTPClient interface {
 	// Create 创建文件
 	Create(ctx context.Context, req *v11.CreateFileRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
@@ -169,6 +328,18 @@ type FileServiceHTTPClient interface {
 	Get(ctx context.Context, req *v11.GetFileRequest, opts ...http.CallOption) (rsp *v11.File, err error)
 	// List 查询文件列表
 	List(ctx context.Context, req *v1.PagingRequest, opts ...http.CallOption) (rsp *v11.ListFileResponse, err error)
+	// ListRecycleBin 查询回收站中的文件
+	ListRecycleBin(ctx context.Context, req *v1.PagingRequest, opts ...http.CallOption) (rsp *v11.ListFileResponse, err error)
+	// ListVersions 查询文件的版本历史
+	ListVersions(ctx context.Context, req *v11.ListFileVersionsRequest, opts ...http.CallOption) (rsp *v11.ListFileResponse, err error)
+	// PurgeFromRecycleBin 从回收站永久删除文件
+	PurgeFromRecycleBin(ctx context.Context, req *v11.RecycledFileRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// PurgeVersion 永久删除文件的指定历史版本
+	PurgeVersion(ctx context.Context, req *v11.FileVersionRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// RestoreFromRecycleBin 从回收站恢复文件
+	RestoreFromRecycleBin(ctx context.Context, req *v11.RecycledFileRequest, opts ...http.CallOption) (rsp *v11.File, err error)
+	// RestoreVersion 恢复文件的指定版本为当前版本
+	RestoreVersion(ctx context.Context, req *v11.FileVersionRequest, opts ...http.CallOption) (rsp *v11.File, err error)
 	// Update 更新文件
 	Update(ctx context.Context, req *v11.UpdateFileRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 }
@@ -237,6 +408,90 @@ func (c *FileServiceHTTPClientImpl) List(ctx context.Context, in *v1.PagingReque
 	return &out, nil
 }
 
+// ListRecycleBin 查询回收站中的文件
+func (c *FileServiceHTTPClientImpl) ListRecycleBin(ctx context.Context, in *v1.PagingRequest, opts ...http.CallOption) (*v11.ListFileResponse, error) {
+	var out v11.ListFileResponse
+	pattern := "/admin/v1/recycle-bin/files"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationFileServiceListRecycleBin))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListVersions 查询文件的版本历史
+func (c *FileServiceHTTPClientImpl) ListVersions(ctx context.Context, in *v11.ListFileVersionsRequest, opts ...http.CallOption) (*v11.ListFileResponse, error) {
+	var out v11.ListFileResponse
+	pattern := "/admin/v1/files/{id}/versions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationFileServiceListVersions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PurgeFromRecycleBin 从回收站永久删除文件
+func (c *FileServiceHTTPClientImpl) PurgeFromRecycleBin(ctx context.Context, in *v11.RecycledFileRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/admin/v1/recycle-bin/files/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationFileServicePurgeFromRecycleBin))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PurgeVersion 永久删除文件的指定历史版本
+func (c *FileServiceHTTPClientImpl) PurgeVersion(ctx context.Context, in *v11.FileVersionRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/admin/v1/files/{id}/versions/{version}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationFileServicePurgeVersion))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RestoreFromRecycleBin 从回收站恢复文件
+func (c *FileServiceHTTPClientImpl) RestoreFromRecycleBin(ctx context.Context, in *v11.RecycledFileRequest, opts ...http.CallOption) (*v11.File, error) {
+	var out v11.File
+	pattern := "/admin/v1/recycle-bin/files/{id}/restore"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationFileServiceRestoreFromRecycleBin))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RestoreVersion 恢复文件的指定版本为当前版本
+func (c *FileServiceHTTPClientImpl) RestoreVersion(ctx context.Context, in *v11.FileVersionRequest, opts ...http.CallOption) (*v11.File, error) {
+	var out v11.File
+	pattern := "/admin/v1/files/{id}/versions/{version}/restore"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationFileServiceRestoreVersion))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // Update 更新文件
 func (c *FileServiceHTTPClientImpl) Update(ctx context.Context, in *v11.UpdateFileRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
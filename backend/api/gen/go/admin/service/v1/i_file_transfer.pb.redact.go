@@ -19,6 +19,7 @@ var (
 	_ redact.Redactor
 	_ codes.Code
 	_ status.Status
+	_ storagepb.File
 	_ storagepb.DownloadFileRequest
 )
 
@@ -72,3 +73,14 @@ func (s *redactedFileTransferServiceServer) PostUploadFile(ctx context.Context,
 	}
 	return res, err
 }
+
+// CompletePresignedUpload is the redacted wrapper for the actual FileTransferServiceServer.CompletePresignedUpload method
+// Unary RPC
+func (s *redactedFileTransferServiceServer) CompletePresignedUpload(ctx context.Context, in *storagepb.CompletePresignedUploadRequest) (*storagepb.File, error) {
+	res, err := s.srv.CompletePresignedUpload(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
@@ -20,9 +20,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	FileTransferService_DownloadFile_FullMethodName   = "/admin.service.v1.FileTransferService/DownloadFile"
-	FileTransferService_PutUploadFile_FullMethodName  = "/admin.service.v1.FileTransferService/PutUploadFile"
-	FileTransferService_PostUploadFile_FullMethodName = "/admin.service.v1.FileTransferService/PostUploadFile"
+	FileTransferService_DownloadFile_FullMethodName            = "/admin.service.v1.FileTransferService/DownloadFile"
+	FileTransferService_PutUploadFile_FullMethodName           = "/admin.service.v1.FileTransferService/PutUploadFile"
+	FileTransferService_PostUploadFile_FullMethodName          = "/admin.service.v1.FileTransferService/PostUploadFile"
+	FileTransferService_CompletePresignedUpload_FullMethodName = "/admin.service.v1.FileTransferService/CompletePresignedUpload"
 )
 
 // FileTransferServiceClient is the client API for FileTransferService service.
@@ -37,6 +38,8 @@ type FileTransferServiceClient interface {
 	PutUploadFile(ctx context.Context, in *v1.UploadFileRequest, opts ...grpc.CallOption) (*v1.UploadFileResponse, error)
 	// 上传文件 POST 方式
 	PostUploadFile(ctx context.Context, in *v1.UploadFileRequest, opts ...grpc.CallOption) (*v1.UploadFileResponse, error)
+	// 预签名上传完成后登记文件，文件通过恶意文件扫描后才能下载
+	CompletePresignedUpload(ctx context.Context, in *v1.CompletePresignedUploadRequest, opts ...grpc.CallOption) (*v1.File, error)
 }
 
 type fileTransferServiceClient struct {
@@ -77,6 +80,16 @@ func (c *fileTransferServiceClient) PostUploadFile(ctx context.Context, in *v1.U
 	return out, nil
 }
 
+func (c *fileTransferServiceClient) CompletePresignedUpload(ctx context.Context, in *v1.CompletePresignedUploadRequest, opts ...grpc.CallOption) (*v1.File, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.File)
+	err := c.cc.Invoke(ctx, FileTransferService_CompletePresignedUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FileTransferServiceServer is the server API for FileTransferService service.
 // All implementations must embed UnimplementedFileTransferServiceServer
 // for forward compatibility.
@@ -89,6 +102,8 @@ type FileTransferServiceServer interface {
 	PutUploadFile(context.Context, *v1.UploadFileRequest) (*v1.UploadFileResponse, error)
 	// 上传文件 POST 方式
 	PostUploadFile(context.Context, *v1.UploadFileRequest) (*v1.UploadFileResponse, error)
+	// 预签名上传完成后登记文件，文件通过恶意文件扫描后才能下载
+	CompletePresignedUpload(context.Context, *v1.CompletePresignedUploadRequest) (*v1.File, error)
 	mustEmbedUnimplementedFileTransferServiceServer()
 }
 
@@ -108,6 +123,9 @@ func (UnimplementedFileTransferServiceServer) PutUploadFile(context.Context, *v1
 func (UnimplementedFileTransferServiceServer) PostUploadFile(context.Context, *v1.UploadFileRequest) (*v1.UploadFileResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method PostUploadFile not implemented")
 }
+func (UnimplementedFileTransferServiceServer) CompletePresignedUpload(context.Context, *v1.CompletePresignedUploadRequest) (*v1.File, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompletePresignedUpload not implemented")
+}
 func (UnimplementedFileTransferServiceServer) mustEmbedUnimplementedFileTransferServiceServer() {}
 func (UnimplementedFileTransferServiceServer) testEmbeddedByValue()                             {}
 
@@ -183,6 +201,24 @@ func _FileTransferService_PostUploadFile_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FileTransferService_CompletePresignedUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.CompletePresignedUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileTransferServiceServer).CompletePresignedUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileTransferService_CompletePresignedUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileTransferServiceServer).CompletePresignedUpload(ctx, req.(*v1.CompletePresignedUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FileTransferService_ServiceDesc is the grpc.ServiceDesc for FileTransferService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -202,6 +238,10 @@ var FileTransferService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PostUploadFile",
 			Handler:    _FileTransferService_PostUploadFile_Handler,
 		},
+		{
+			MethodName: "CompletePresignedUpload",
+			Handler:    _FileTransferService_CompletePresignedUpload_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin/service/v1/i_file_transfer.proto",
@@ -20,11 +20,14 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationFileTransferServiceCompletePresignedUpload = "/admin.service.v1.FileTransferService/CompletePresignedUpload"
 const OperationFileTransferServiceDownloadFile = "/admin.service.v1.FileTransferService/DownloadFile"
 const OperationFileTransferServicePostUploadFile = "/admin.service.v1.FileTransferService/PostUploadFile"
 const OperationFileTransferServicePutUploadFile = "/admin.service.v1.FileTransferService/PutUploadFile"
 
 type FileTransferServiceHTTPServer interface {
+	// CompletePresignedUpload 预签名上传完成后登记文件，文件通过恶意文件扫描后才能下载
+	CompletePresignedUpload(context.Context, *v1.CompletePresignedUploadRequest) (*v1.File, error)
 	// DownloadFile 下载文件
 	DownloadFile(context.Context, *v1.DownloadFileRequest) (*v1.DownloadFileResponse, error)
 	// PostUploadFile 上传文件 POST 方式
@@ -38,6 +41,7 @@ func RegisterFileTransferServiceHTTPServer(s *http.Server, srv FileTransferServi
 	r.GET("/admin/v1/file/download", _FileTransferService_DownloadFile0_HTTP_Handler(srv))
 	r.PUT("/admin/v1/file/upload", _FileTransferService_PutUploadFile0_HTTP_Handler(srv))
 	r.POST("/admin/v1/file/upload", _FileTransferService_PostUploadFile0_HTTP_Handler(srv))
+	r.POST("/admin/v1/file/upload:complete", _FileTransferService_CompletePresignedUpload0_HTTP_Handler(srv))
 }
 
 func _FileTransferService_DownloadFile0_HTTP_Handler(srv FileTransferServiceHTTPServer) func(ctx http.Context) error {
@@ -103,7 +107,31 @@ func _FileTransferService_PostUploadFile0_HTTP_Handler(srv FileTransferServiceHT
 	}
 }
 
+func _FileTransferService_CompletePresignedUpload0_HTTP_Handler(srv FileTransferServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.CompletePresignedUploadRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationFileTransferServiceCompletePresignedUpload)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CompletePresignedUpload(ctx, req.(*v1.CompletePresignedUploadRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.File)
+		return ctx.Result(200, reply)
+	}
+}
+
 type FileTransferServiceHTTPClient interface {
+	// CompletePresignedUpload 预签名上传完成后登记文件，文件通过恶意文件扫描后才能下载
+	CompletePresignedUpload(ctx context.Context, req *v1.CompletePresignedUploadRequest, opts ...http.CallOption) (rsp *v1.File, err error)
 	// DownloadFile 下载文件
 	DownloadFile(ctx context.Context, req *v1.DownloadFileRequest, opts ...http.CallOption) (rsp *v1.DownloadFileResponse, err error)
 	// PostUploadFile 上传文件 POST 方式
@@ -120,6 +148,20 @@ func NewFileTransferServiceHTTPClient(client *http.Client) FileTransferServiceHT
 	return &FileTransferServiceHTTPClientImpl{client}
 }
 
+// CompletePresignedUpload 预签名上传完成后登记文件，文件通过恶意文件扫描后才能下载
+func (c *FileTransferServiceHTTPClientImpl) CompletePresignedUpload(ctx context.Context, in *v1.CompletePresignedUploadRequest, opts ...http.CallOption) (*v1.File, error) {
+	var out v1.File
+	pattern := "/admin/v1/file/upload:complete"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationFileTransferServiceCompletePresignedUpload))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // DownloadFile 下载文件
 func (c *FileTransferServiceHTTPClientImpl) DownloadFile(ctx context.Context, in *v1.DownloadFileRequest, opts ...http.CallOption) (*v1.DownloadFileResponse, error) {
 	var out v1.DownloadFileResponse
@@ -27,25 +27,30 @@ var File_admin_service_v1_i_login_audit_log_proto protoreflect.FileDescriptor
 
 const file_admin_service_v1_i_login_audit_log_proto_rawDesc = "" +
 	"\n" +
-	"(admin/service/v1/i_login_audit_log.proto\x12\x10admin.service.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1epagination/v1/pagination.proto\x1a&audit/service/v1/login_audit_log.proto2\x86\x02\n" +
+	"(admin/service/v1/i_login_audit_log.proto\x12\x10admin.service.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1epagination/v1/pagination.proto\x1a'audit/service/v1/audit_log_export.proto\x1a&audit/service/v1/login_audit_log.proto2\x92\x03\n" +
 	"\x14LoginAuditLogService\x12r\n" +
 	"\x04List\x12\x19.pagination.PagingRequest\x1a+.audit.service.v1.ListLoginAuditLogResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/admin/v1/login-audit-logs\x12z\n" +
-	"\x03Get\x12).audit.service.v1.GetLoginAuditLogRequest\x1a\x1f.audit.service.v1.LoginAuditLog\"'\x82\xd3\xe4\x93\x02!\x12\x1f/admin/v1/login-audit-logs/{id}B\xc0\x01\n" +
+	"\x03Get\x12).audit.service.v1.GetLoginAuditLogRequest\x1a\x1f.audit.service.v1.LoginAuditLog\"'\x82\xd3\xe4\x93\x02!\x12\x1f/admin/v1/login-audit-logs/{id}\x12\x89\x01\n" +
+	"\x06Export\x12'.audit.service.v1.ExportAuditLogRequest\x1a(.audit.service.v1.ExportAuditLogResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/admin/v1/login-audit-logs:exportB\xc0\x01\n" +
 	"\x14com.admin.service.v1B\x13ILoginAuditLogProtoP\x01Z1go-wind-admin/api/gen/go/admin/service/v1;adminpb\xa2\x02\x03ASX\xaa\x02\x10Admin.Service.V1\xca\x02\x10Admin\\Service\\V1\xe2\x02\x1cAdmin\\Service\\V1\\GPBMetadata\xea\x02\x12Admin::Service::V1b\x06proto3"
 
 var file_admin_service_v1_i_login_audit_log_proto_goTypes = []any{
 	(*v1.PagingRequest)(nil),              // 0: pagination.PagingRequest
 	(*v11.GetLoginAuditLogRequest)(nil),   // 1: audit.service.v1.GetLoginAuditLogRequest
-	(*v11.ListLoginAuditLogResponse)(nil), // 2: audit.service.v1.ListLoginAuditLogResponse
-	(*v11.LoginAuditLog)(nil),             // 3: audit.service.v1.LoginAuditLog
+	(*v11.ExportAuditLogRequest)(nil),     // 2: audit.service.v1.ExportAuditLogRequest
+	(*v11.ListLoginAuditLogResponse)(nil), // 3: audit.service.v1.ListLoginAuditLogResponse
+	(*v11.LoginAuditLog)(nil),             // 4: audit.service.v1.LoginAuditLog
+	(*v11.ExportAuditLogResponse)(nil),    // 5: audit.service.v1.ExportAuditLogResponse
 }
 var file_admin_service_v1_i_login_audit_log_proto_depIdxs = []int32{
 	0, // 0: admin.service.v1.LoginAuditLogService.List:input_type -> pagination.PagingRequest
 	1, // 1: admin.service.v1.LoginAuditLogService.Get:input_type -> audit.service.v1.GetLoginAuditLogRequest
-	2, // 2: admin.service.v1.LoginAuditLogService.List:output_type -> audit.service.v1.ListLoginAuditLogResponse
-	3, // 3: admin.service.v1.LoginAuditLogService.Get:output_type -> audit.service.v1.LoginAuditLog
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
+	2, // 2: admin.service.v1.LoginAuditLogService.Export:input_type -> audit.service.v1.ExportAuditLogRequest
+	3, // 3: admin.service.v1.LoginAuditLogService.List:output_type -> audit.service.v1.ListLoginAuditLogResponse
+	4, // 4: admin.service.v1.LoginAuditLogService.Get:output_type -> audit.service.v1.LoginAuditLog
+	5, // 5: admin.service.v1.LoginAuditLogService.Export:output_type -> audit.service.v1.ExportAuditLogResponse
+	3, // [3:6] is the sub-list for method output_type
+	0, // [0:3] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
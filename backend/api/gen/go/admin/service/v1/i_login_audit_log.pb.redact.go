@@ -21,6 +21,7 @@ var (
 	_ codes.Code
 	_ status.Status
 	_ pagination.Sorting
+	_ auditpb.ExportAuditLogRequest
 	_ auditpb.LoginAuditLog
 )
 
@@ -63,3 +64,14 @@ func (s *redactedLoginAuditLogServiceServer) Get(ctx context.Context, in *auditp
 	}
 	return res, err
 }
+
+// Export is the redacted wrapper for the actual LoginAuditLogServiceServer.Export method
+// Unary RPC
+func (s *redactedLoginAuditLogServiceServer) Export(ctx context.Context, in *auditpb.ExportAuditLogRequest) (*auditpb.ExportAuditLogResponse, error) {
+	res, err := s.srv.Export(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
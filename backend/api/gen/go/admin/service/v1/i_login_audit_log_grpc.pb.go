@@ -21,8 +21,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	LoginAuditLogService_List_FullMethodName = "/admin.service.v1.LoginAuditLogService/List"
-	LoginAuditLogService_Get_FullMethodName  = "/admin.service.v1.LoginAuditLogService/Get"
+	LoginAuditLogService_List_FullMethodName   = "/admin.service.v1.LoginAuditLogService/List"
+	LoginAuditLogService_Get_FullMethodName    = "/admin.service.v1.LoginAuditLogService/Get"
+	LoginAuditLogService_Export_FullMethodName = "/admin.service.v1.LoginAuditLogService/Export"
 )
 
 // LoginAuditLogServiceClient is the client API for LoginAuditLogService service.
@@ -35,6 +36,8 @@ type LoginAuditLogServiceClient interface {
 	List(ctx context.Context, in *v1.PagingRequest, opts ...grpc.CallOption) (*v11.ListLoginAuditLogResponse, error)
 	// 查询登录审计日志详情
 	Get(ctx context.Context, in *v11.GetLoginAuditLogRequest, opts ...grpc.CallOption) (*v11.LoginAuditLog, error)
+	// 导出登录审计日志（后台任务，完成后站内信通知）
+	Export(ctx context.Context, in *v11.ExportAuditLogRequest, opts ...grpc.CallOption) (*v11.ExportAuditLogResponse, error)
 }
 
 type loginAuditLogServiceClient struct {
@@ -65,6 +68,16 @@ func (c *loginAuditLogServiceClient) Get(ctx context.Context, in *v11.GetLoginAu
 	return out, nil
 }
 
+func (c *loginAuditLogServiceClient) Export(ctx context.Context, in *v11.ExportAuditLogRequest, opts ...grpc.CallOption) (*v11.ExportAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v11.ExportAuditLogResponse)
+	err := c.cc.Invoke(ctx, LoginAuditLogService_Export_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LoginAuditLogServiceServer is the server API for LoginAuditLogService service.
 // All implementations must embed UnimplementedLoginAuditLogServiceServer
 // for forward compatibility.
@@ -75,6 +88,8 @@ type LoginAuditLogServiceServer interface {
 	List(context.Context, *v1.PagingRequest) (*v11.ListLoginAuditLogResponse, error)
 	// 查询登录审计日志详情
 	Get(context.Context, *v11.GetLoginAuditLogRequest) (*v11.LoginAuditLog, error)
+	// 导出登录审计日志（后台任务，完成后站内信通知）
+	Export(context.Context, *v11.ExportAuditLogRequest) (*v11.ExportAuditLogResponse, error)
 	mustEmbedUnimplementedLoginAuditLogServiceServer()
 }
 
@@ -91,6 +106,9 @@ func (UnimplementedLoginAuditLogServiceServer) List(context.Context, *v1.PagingR
 func (UnimplementedLoginAuditLogServiceServer) Get(context.Context, *v11.GetLoginAuditLogRequest) (*v11.LoginAuditLog, error) {
 	return nil, status.Error(codes.Unimplemented, "method Get not implemented")
 }
+func (UnimplementedLoginAuditLogServiceServer) Export(context.Context, *v11.ExportAuditLogRequest) (*v11.ExportAuditLogResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Export not implemented")
+}
 func (UnimplementedLoginAuditLogServiceServer) mustEmbedUnimplementedLoginAuditLogServiceServer() {}
 func (UnimplementedLoginAuditLogServiceServer) testEmbeddedByValue()                              {}
 
@@ -148,6 +166,24 @@ func _LoginAuditLogService_Get_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LoginAuditLogService_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.ExportAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoginAuditLogServiceServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoginAuditLogService_Export_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoginAuditLogServiceServer).Export(ctx, req.(*v11.ExportAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LoginAuditLogService_ServiceDesc is the grpc.ServiceDesc for LoginAuditLogService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -163,6 +199,10 @@ var LoginAuditLogService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Get",
 			Handler:    _LoginAuditLogService_Get_Handler,
 		},
+		{
+			MethodName: "Export",
+			Handler:    _LoginAuditLogService_Export_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin/service/v1/i_login_audit_log.proto",
@@ -21,10 +21,13 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationLoginAuditLogServiceExport = "/admin.service.v1.LoginAuditLogService/Export"
 const OperationLoginAuditLogServiceGet = "/admin.service.v1.LoginAuditLogService/Get"
 const OperationLoginAuditLogServiceList = "/admin.service.v1.LoginAuditLogService/List"
 
 type LoginAuditLogServiceHTTPServer interface {
+	// Export 导出登录审计日志（后台任务，完成后站内信通知）
+	Export(context.Context, *v11.ExportAuditLogRequest) (*v11.ExportAuditLogResponse, error)
 	// Get 查询登录审计日志详情
 	Get(context.Context, *v11.GetLoginAuditLogRequest) (*v11.LoginAuditLog, error)
 	// List 查询登录审计日志列表
@@ -35,6 +38,7 @@ func RegisterLoginAuditLogServiceHTTPServer(s *http.Server, srv LoginAuditLogSer
 	r := s.Route("/")
 	r.GET("/admin/v1/login-audit-logs", _LoginAuditLogService_List8_HTTP_Handler(srv))
 	r.GET("/admin/v1/login-audit-logs/{id}", _LoginAuditLogService_Get8_HTTP_Handler(srv))
+	r.POST("/admin/v1/login-audit-logs:export", _LoginAuditLogService_Export2_HTTP_Handler(srv))
 }
 
 func _LoginAuditLogService_List8_HTTP_Handler(srv LoginAuditLogServiceHTTPServer) func(ctx http.Context) error {
@@ -78,7 +82,31 @@ func _LoginAuditLogService_Get8_HTTP_Handler(srv LoginAuditLogServiceHTTPServer)
 	}
 }
 
+func _LoginAuditLogService_Export2_HTTP_Handler(srv LoginAuditLogServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v11.ExportAuditLogRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLoginAuditLogServiceExport)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Export(ctx, req.(*v11.ExportAuditLogRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v11.ExportAuditLogResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type LoginAuditLogServiceHTTPClient interface {
+	// Export 导出登录审计日志（后台任务，完成后站内信通知）
+	Export(ctx context.Context, req *v11.ExportAuditLogRequest, opts ...http.CallOption) (rsp *v11.ExportAuditLogResponse, err error)
 	// Get 查询登录审计日志详情
 	Get(ctx context.Context, req *v11.GetLoginAuditLogRequest, opts ...http.CallOption) (rsp *v11.LoginAuditLog, err error)
 	// List 查询登录审计日志列表
@@ -93,6 +121,20 @@ func NewLoginAuditLogServiceHTTPClient(client *http.Client) LoginAuditLogService
 	return &LoginAuditLogServiceHTTPClientImpl{client}
 }
 
+// Export 导出登录审计日志（后台任务，完成后站内信通知）
+func (c *LoginAuditLogServiceHTTPClientImpl) Export(ctx context.Context, in *v11.ExportAuditLogRequest, opts ...http.CallOption) (*v11.ExportAuditLogResponse, error) {
+	var out v11.ExportAuditLogResponse
+	pattern := "/admin/v1/login-audit-logs:export"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationLoginAuditLogServiceExport))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // Get 查询登录审计日志详情
 func (c *LoginAuditLogServiceHTTPClientImpl) Get(ctx context.Context, in *v11.GetLoginAuditLogRequest, opts ...http.CallOption) (*v11.LoginAuditLog, error) {
 	var out v11.LoginAuditLog
@@ -21,8 +21,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	OperationAuditLogService_List_FullMethodName = "/admin.service.v1.OperationAuditLogService/List"
-	OperationAuditLogService_Get_FullMethodName  = "/admin.service.v1.OperationAuditLogService/Get"
+	OperationAuditLogService_List_FullMethodName   = "/admin.service.v1.OperationAuditLogService/List"
+	OperationAuditLogService_Get_FullMethodName    = "/admin.service.v1.OperationAuditLogService/Get"
+	OperationAuditLogService_Export_FullMethodName = "/admin.service.v1.OperationAuditLogService/Export"
 )
 
 // OperationAuditLogServiceClient is the client API for OperationAuditLogService service.
@@ -35,6 +36,8 @@ type OperationAuditLogServiceClient interface {
 	List(ctx context.Context, in *v1.PagingRequest, opts ...grpc.CallOption) (*v11.ListOperationAuditLogResponse, error)
 	// 查询操作审计日志详情
 	Get(ctx context.Context, in *v11.GetOperationAuditLogRequest, opts ...grpc.CallOption) (*v11.OperationAuditLog, error)
+	// 导出操作审计日志（后台任务，完成后站内信通知）
+	Export(ctx context.Context, in *v11.ExportAuditLogRequest, opts ...grpc.CallOption) (*v11.ExportAuditLogResponse, error)
 }
 
 type operationAuditLogServiceClient struct {
@@ -65,6 +68,16 @@ func (c *operationAuditLogServiceClient) Get(ctx context.Context, in *v11.GetOpe
 	return out, nil
 }
 
+func (c *operationAuditLogServiceClient) Export(ctx context.Context, in *v11.ExportAuditLogRequest, opts ...grpc.CallOption) (*v11.ExportAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v11.ExportAuditLogResponse)
+	err := c.cc.Invoke(ctx, OperationAuditLogService_Export_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OperationAuditLogServiceServer is the server API for OperationAuditLogService service.
 // All implementations must embed UnimplementedOperationAuditLogServiceServer
 // for forward compatibility.
@@ -75,6 +88,8 @@ type OperationAuditLogServiceServer interface {
 	List(context.Context, *v1.PagingRequest) (*v11.ListOperationAuditLogResponse, error)
 	// 查询操作审计日志详情
 	Get(context.Context, *v11.GetOperationAuditLogRequest) (*v11.OperationAuditLog, error)
+	// 导出操作审计日志（后台任务，完成后站内信通知）
+	Export(context.Context, *v11.ExportAuditLogRequest) (*v11.ExportAuditLogResponse, error)
 	mustEmbedUnimplementedOperationAuditLogServiceServer()
 }
 
@@ -91,6 +106,9 @@ func (UnimplementedOperationAuditLogServiceServer) List(context.Context, *v1.Pag
 func (UnimplementedOperationAuditLogServiceServer) Get(context.Context, *v11.GetOperationAuditLogRequest) (*v11.OperationAuditLog, error) {
 	return nil, status.Error(codes.Unimplemented, "method Get not implemented")
 }
+func (UnimplementedOperationAuditLogServiceServer) Export(context.Context, *v11.ExportAuditLogRequest) (*v11.ExportAuditLogResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Export not implemented")
+}
 func (UnimplementedOperationAuditLogServiceServer) mustEmbedUnimplementedOperationAuditLogServiceServer() {
 }
 func (UnimplementedOperationAuditLogServiceServer) testEmbeddedByValue() {}
@@ -149,6 +167,24 @@ func _OperationAuditLogService_Get_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OperationAuditLogService_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.ExportAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperationAuditLogServiceServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OperationAuditLogService_Export_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperationAuditLogServiceServer).Export(ctx, req.(*v11.ExportAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OperationAuditLogService_ServiceDesc is the grpc.ServiceDesc for OperationAuditLogService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -164,6 +200,10 @@ var OperationAuditLogService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Get",
 			Handler:    _OperationAuditLogService_Get_Handler,
 		},
+		{
+			MethodName: "Export",
+			Handler:    _OperationAuditLogService_Export_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin/service/v1/i_operation_audit_log.proto",
@@ -21,10 +21,13 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationOperationAuditLogServiceExport = "/admin.service.v1.OperationAuditLogService/Export"
 const OperationOperationAuditLogServiceGet = "/admin.service.v1.OperationAuditLogService/Get"
 const OperationOperationAuditLogServiceList = "/admin.service.v1.OperationAuditLogService/List"
 
 type OperationAuditLogServiceHTTPServer interface {
+	// Export 导出操作审计日志（后台任务，完成后站内信通知）
+	Export(context.Context, *v11.ExportAuditLogRequest) (*v11.ExportAuditLogResponse, error)
 	// Get 查询操作审计日志详情
 	Get(context.Context, *v11.GetOperationAuditLogRequest) (*v11.OperationAuditLog, error)
 	// List 查询操作审计日志列表
@@ -35,6 +38,7 @@ func RegisterOperationAuditLogServiceHTTPServer(s *http.Server, srv OperationAud
 	r := s.Route("/")
 	r.GET("/admin/v1/operation-audit-logs", _OperationAuditLogService_List11_HTTP_Handler(srv))
 	r.GET("/admin/v1/operation-audit-logs/{id}", _OperationAuditLogService_Get11_HTTP_Handler(srv))
+	r.POST("/admin/v1/operation-audit-logs:export", _OperationAuditLogService_Export3_HTTP_Handler(srv))
 }
 
 func _OperationAuditLogService_List11_HTTP_Handler(srv OperationAuditLogServiceHTTPServer) func(ctx http.Context) error {
@@ -78,7 +82,31 @@ func _OperationAuditLogService_Get11_HTTP_Handler(srv OperationAuditLogServiceHT
 	}
 }
 
+func _OperationAuditLogService_Export3_HTTP_Handler(srv OperationAuditLogServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v11.ExportAuditLogRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOperationAuditLogServiceExport)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Export(ctx, req.(*v11.ExportAuditLogRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v11.ExportAuditLogResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type OperationAuditLogServiceHTTPClient interface {
+	// Export 导出操作审计日志（后台任务，完成后站内信通知）
+	Export(ctx context.Context, req *v11.ExportAuditLogRequest, opts ...http.CallOption) (rsp *v11.ExportAuditLogResponse, err error)
 	// Get 查询操作审计日志详情
 	Get(ctx context.Context, req *v11.GetOperationAuditLogRequest, opts ...http.CallOption) (rsp *v11.OperationAuditLog, err error)
 	// List 查询操作审计日志列表
@@ -93,6 +121,20 @@ func NewOperationAuditLogServiceHTTPClient(client *http.Client) OperationAuditLo
 	return &OperationAuditLogServiceHTTPClientImpl{client}
 }
 
+// Export 导出操作审计日志（后台任务，完成后站内信通知）
+func (c *OperationAuditLogServiceHTTPClientImpl) Export(ctx context.Context, in *v11.ExportAuditLogRequest, opts ...http.CallOption) (*v11.ExportAuditLogResponse, error) {
+	var out v11.ExportAuditLogResponse
+	pattern := "/admin/v1/operation-audit-logs:export"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationOperationAuditLogServiceExport))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // Get 查询操作审计日志详情
 func (c *OperationAuditLogServiceHTTPClientImpl) Get(ctx context.Context, in *v11.GetOperationAuditLogRequest, opts ...http.CallOption) (*v11.OperationAuditLog, error) {
 	var out v11.OperationAuditLog
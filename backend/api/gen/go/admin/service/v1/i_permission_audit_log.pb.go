@@ -27,25 +27,30 @@ var File_admin_service_v1_i_permission_audit_log_proto protoreflect.FileDescript
 
 const file_admin_service_v1_i_permission_audit_log_proto_rawDesc = "" +
 	"\n" +
-	"-admin/service/v1/i_permission_audit_log.proto\x12\x10admin.service.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1epagination/v1/pagination.proto\x1a+audit/service/v1/permission_audit_log.proto2\xa5\x02\n" +
+	"-admin/service/v1/i_permission_audit_log.proto\x12\x10admin.service.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1epagination/v1/pagination.proto\x1a'audit/service/v1/audit_log_export.proto\x1a+audit/service/v1/permission_audit_log.proto2\xb6\x03\n" +
 	"\x19PermissionAuditLogService\x12|\n" +
 	"\x04List\x12\x19.pagination.PagingRequest\x1a0.audit.service.v1.ListPermissionAuditLogResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/admin/v1/permission-audit-logs\x12\x89\x01\n" +
-	"\x03Get\x12..audit.service.v1.GetPermissionAuditLogRequest\x1a$.audit.service.v1.PermissionAuditLog\",\x82\xd3\xe4\x93\x02&\x12$/admin/v1/permission-audit-logs/{id}B\xc5\x01\n" +
+	"\x03Get\x12..audit.service.v1.GetPermissionAuditLogRequest\x1a$.audit.service.v1.PermissionAuditLog\",\x82\xd3\xe4\x93\x02&\x12$/admin/v1/permission-audit-logs/{id}\x12\x8e\x01\n" +
+	"\x06Export\x12'.audit.service.v1.ExportAuditLogRequest\x1a(.audit.service.v1.ExportAuditLogResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/admin/v1/permission-audit-logs:exportB\xc5\x01\n" +
 	"\x14com.admin.service.v1B\x18IPermissionAuditLogProtoP\x01Z1go-wind-admin/api/gen/go/admin/service/v1;adminpb\xa2\x02\x03ASX\xaa\x02\x10Admin.Service.V1\xca\x02\x10Admin\\Service\\V1\xe2\x02\x1cAdmin\\Service\\V1\\GPBMetadata\xea\x02\x12Admin::Service::V1b\x06proto3"
 
 var file_admin_service_v1_i_permission_audit_log_proto_goTypes = []any{
 	(*v1.PagingRequest)(nil),                   // 0: pagination.PagingRequest
 	(*v11.GetPermissionAuditLogRequest)(nil),   // 1: audit.service.v1.GetPermissionAuditLogRequest
-	(*v11.ListPermissionAuditLogResponse)(nil), // 2: audit.service.v1.ListPermissionAuditLogResponse
-	(*v11.PermissionAuditLog)(nil),             // 3: audit.service.v1.PermissionAuditLog
+	(*v11.ExportAuditLogRequest)(nil),          // 2: audit.service.v1.ExportAuditLogRequest
+	(*v11.ListPermissionAuditLogResponse)(nil), // 3: audit.service.v1.ListPermissionAuditLogResponse
+	(*v11.PermissionAuditLog)(nil),             // 4: audit.service.v1.PermissionAuditLog
+	(*v11.ExportAuditLogResponse)(nil),         // 5: audit.service.v1.ExportAuditLogResponse
 }
 var file_admin_service_v1_i_permission_audit_log_proto_depIdxs = []int32{
 	0, // 0: admin.service.v1.PermissionAuditLogService.List:input_type -> pagination.PagingRequest
 	1, // 1: admin.service.v1.PermissionAuditLogService.Get:input_type -> audit.service.v1.GetPermissionAuditLogRequest
-	2, // 2: admin.service.v1.PermissionAuditLogService.List:output_type -> audit.service.v1.ListPermissionAuditLogResponse
-	3, // 3: admin.service.v1.PermissionAuditLogService.Get:output_type -> audit.service.v1.PermissionAuditLog
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
+	2, // 2: admin.service.v1.PermissionAuditLogService.Export:input_type -> audit.service.v1.ExportAuditLogRequest
+	3, // 3: admin.service.v1.PermissionAuditLogService.List:output_type -> audit.service.v1.ListPermissionAuditLogResponse
+	4, // 4: admin.service.v1.PermissionAuditLogService.Get:output_type -> audit.service.v1.PermissionAuditLog
+	5, // 5: admin.service.v1.PermissionAuditLogService.Export:output_type -> audit.service.v1.ExportAuditLogResponse
+	3, // [3:6] is the sub-list for method output_type
+	0, // [0:3] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
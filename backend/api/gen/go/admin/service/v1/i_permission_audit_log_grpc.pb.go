@@ -21,8 +21,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PermissionAuditLogService_List_FullMethodName = "/admin.service.v1.PermissionAuditLogService/List"
-	PermissionAuditLogService_Get_FullMethodName  = "/admin.service.v1.PermissionAuditLogService/Get"
+	PermissionAuditLogService_List_FullMethodName   = "/admin.service.v1.PermissionAuditLogService/List"
+	PermissionAuditLogService_Get_FullMethodName    = "/admin.service.v1.PermissionAuditLogService/Get"
+	PermissionAuditLogService_Export_FullMethodName = "/admin.service.v1.PermissionAuditLogService/Export"
 )
 
 // PermissionAuditLogServiceClient is the client API for PermissionAuditLogService service.
@@ -35,6 +36,8 @@ type PermissionAuditLogServiceClient interface {
 	List(ctx context.Context, in *v1.PagingRequest, opts ...grpc.CallOption) (*v11.ListPermissionAuditLogResponse, error)
 	// 查询权限变更审计日志详情
 	Get(ctx context.Context, in *v11.GetPermissionAuditLogRequest, opts ...grpc.CallOption) (*v11.PermissionAuditLog, error)
+	// 导出权限变更审计日志（后台任务，完成后站内信通知）
+	Export(ctx context.Context, in *v11.ExportAuditLogRequest, opts ...grpc.CallOption) (*v11.ExportAuditLogResponse, error)
 }
 
 type permissionAuditLogServiceClient struct {
@@ -65,6 +68,16 @@ func (c *permissionAuditLogServiceClient) Get(ctx context.Context, in *v11.GetPe
 	return out, nil
 }
 
+func (c *permissionAuditLogServiceClient) Export(ctx context.Context, in *v11.ExportAuditLogRequest, opts ...grpc.CallOption) (*v11.ExportAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v11.ExportAuditLogResponse)
+	err := c.cc.Invoke(ctx, PermissionAuditLogService_Export_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PermissionAuditLogServiceServer is the server API for PermissionAuditLogService service.
 // All implementations must embed UnimplementedPermissionAuditLogServiceServer
 // for forward compatibility.
@@ -75,6 +88,8 @@ type PermissionAuditLogServiceServer interface {
 	List(context.Context, *v1.PagingRequest) (*v11.ListPermissionAuditLogResponse, error)
 	// 查询权限变更审计日志详情
 	Get(context.Context, *v11.GetPermissionAuditLogRequest) (*v11.PermissionAuditLog, error)
+	// 导出权限变更审计日志（后台任务，完成后站内信通知）
+	Export(context.Context, *v11.ExportAuditLogRequest) (*v11.ExportAuditLogResponse, error)
 	mustEmbedUnimplementedPermissionAuditLogServiceServer()
 }
 
@@ -91,6 +106,9 @@ func (UnimplementedPermissionAuditLogServiceServer) List(context.Context, *v1.Pa
 func (UnimplementedPermissionAuditLogServiceServer) Get(context.Context, *v11.GetPermissionAuditLogRequest) (*v11.PermissionAuditLog, error) {
 	return nil, status.Error(codes.Unimplemented, "method Get not implemented")
 }
+func (UnimplementedPermissionAuditLogServiceServer) Export(context.Context, *v11.ExportAuditLogRequest) (*v11.ExportAuditLogResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Export not implemented")
+}
 func (UnimplementedPermissionAuditLogServiceServer) mustEmbedUnimplementedPermissionAuditLogServiceServer() {
 }
 func (UnimplementedPermissionAuditLogServiceServer) testEmbeddedByValue() {}
@@ -149,6 +167,24 @@ func _PermissionAuditLogService_Get_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PermissionAuditLogService_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.ExportAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PermissionAuditLogServiceServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PermissionAuditLogService_Export_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PermissionAuditLogServiceServer).Export(ctx, req.(*v11.ExportAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PermissionAuditLogService_ServiceDesc is the grpc.ServiceDesc for PermissionAuditLogService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -164,6 +200,10 @@ var PermissionAuditLogService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Get",
 			Handler:    _PermissionAuditLogService_Get_Handler,
 		},
+		{
+			MethodName: "Export",
+			Handler:    _PermissionAuditLogService_Export_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin/service/v1/i_permission_audit_log.proto",
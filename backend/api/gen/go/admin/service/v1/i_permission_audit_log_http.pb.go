@@ -21,10 +21,13 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationPermissionAuditLogServiceExport = "/admin.service.v1.PermissionAuditLogService/Export"
 const OperationPermissionAuditLogServiceGet = "/admin.service.v1.PermissionAuditLogService/Get"
 const OperationPermissionAuditLogServiceList = "/admin.service.v1.PermissionAuditLogService/List"
 
 type PermissionAuditLogServiceHTTPServer interface {
+	// Export 导出权限变更审计日志（后台任务，完成后站内信通知）
+	Export(context.Context, *v11.ExportAuditLogRequest) (*v11.ExportAuditLogResponse, error)
 	// Get 查询权限变更审计日志详情
 	Get(context.Context, *v11.GetPermissionAuditLogRequest) (*v11.PermissionAuditLog, error)
 	// List 查询权限变更审计日志列表
@@ -35,6 +38,7 @@ func RegisterPermissionAuditLogServiceHTTPServer(s *http.Server, srv PermissionA
 	r := s.Route("/")
 	r.GET("/admin/v1/permission-audit-logs", _PermissionAuditLogService_List14_HTTP_Handler(srv))
 	r.GET("/admin/v1/permission-audit-logs/{id}", _PermissionAuditLogService_Get14_HTTP_Handler(srv))
+	r.POST("/admin/v1/permission-audit-logs:export", _PermissionAuditLogService_Export4_HTTP_Handler(srv))
 }
 
 func _PermissionAuditLogService_List14_HTTP_Handler(srv PermissionAuditLogServiceHTTPServer) func(ctx http.Context) error {
@@ -78,7 +82,31 @@ func _PermissionAuditLogService_Get14_HTTP_Handler(srv PermissionAuditLogService
 	}
 }
 
+func _PermissionAuditLogService_Export4_HTTP_Handler(srv PermissionAuditLogServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v11.ExportAuditLogRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPermissionAuditLogServiceExport)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Export(ctx, req.(*v11.ExportAuditLogRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v11.ExportAuditLogResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type PermissionAuditLogServiceHTTPClient interface {
+	// Export 导出权限变更审计日志（后台任务，完成后站内信通知）
+	Export(ctx context.Context, req *v11.ExportAuditLogRequest, opts ...http.CallOption) (rsp *v11.ExportAuditLogResponse, err error)
 	// Get 查询权限变更审计日志详情
 	Get(ctx context.Context, req *v11.GetPermissionAuditLogRequest, opts ...http.CallOption) (rsp *v11.PermissionAuditLog, err error)
 	// List 查询权限变更审计日志列表
@@ -93,6 +121,20 @@ func NewPermissionAuditLogServiceHTTPClient(client *http.Client) PermissionAudit
 	return &PermissionAuditLogServiceHTTPClientImpl{client}
 }
 
+// Export 导出权限变更审计日志（后台任务，完成后站内信通知）
+func (c *PermissionAuditLogServiceHTTPClientImpl) Export(ctx context.Context, in *v11.ExportAuditLogRequest, opts ...http.CallOption) (*v11.ExportAuditLogResponse, error) {
+	var out v11.ExportAuditLogResponse
+	pattern := "/admin/v1/permission-audit-logs:export"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPermissionAuditLogServiceExport))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // Get 查询权限变更审计日志详情
 func (c *PermissionAuditLogServiceHTTPClientImpl) Get(ctx context.Context, in *v11.GetPermissionAuditLogRequest, opts ...http.CallOption) (*v11.PermissionAuditLog, error) {
 	var out v11.PermissionAuditLog
@@ -8,6 +8,7 @@ package adminpb
 
 import (
 	v1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	v12 "go-wind-admin/api/gen/go/audit/service/v1"
 	v11 "go-wind-admin/api/gen/go/permission/service/v1"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -27,25 +28,30 @@ var File_admin_service_v1_i_policy_evaluation_log_proto protoreflect.FileDescrip
 
 const file_admin_service_v1_i_policy_evaluation_log_proto_rawDesc = "" +
 	"\n" +
-	".admin/service/v1/i_policy_evaluation_log.proto\x12\x10admin.service.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1epagination/v1/pagination.proto\x1a1permission/service/v1/policy_evaluation_log.proto2\xbb\x02\n" +
+	".admin/service/v1/i_policy_evaluation_log.proto\x12\x10admin.service.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1epagination/v1/pagination.proto\x1a'audit/service/v1/audit_log_export.proto\x1a1permission/service/v1/policy_evaluation_log.proto2\xcd\x03\n" +
 	"\x1aPolicyEvaluationLogService\x12\x83\x01\n" +
 	"\x04List\x12\x19.pagination.PagingRequest\x1a6.permission.service.v1.ListPolicyEvaluationLogResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /admin/v1/policy-evaluation-logs\x12\x96\x01\n" +
-	"\x03Get\x124.permission.service.v1.GetPolicyEvaluationLogRequest\x1a*.permission.service.v1.PolicyEvaluationLog\"-\x82\xd3\xe4\x93\x02'\x12%/admin/v1/policy-evaluation-logs/{id}B\xc6\x01\n" +
+	"\x03Get\x124.permission.service.v1.GetPolicyEvaluationLogRequest\x1a*.permission.service.v1.PolicyEvaluationLog\"-\x82\xd3\xe4\x93\x02'\x12%/admin/v1/policy-evaluation-logs/{id}\x12\x8f\x01\n" +
+	"\x06Export\x12'.audit.service.v1.ExportAuditLogRequest\x1a(.audit.service.v1.ExportAuditLogResponse\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/admin/v1/policy-evaluation-logs:exportB\xc6\x01\n" +
 	"\x14com.admin.service.v1B\x19IPolicyEvaluationLogProtoP\x01Z1go-wind-admin/api/gen/go/admin/service/v1;adminpb\xa2\x02\x03ASX\xaa\x02\x10Admin.Service.V1\xca\x02\x10Admin\\Service\\V1\xe2\x02\x1cAdmin\\Service\\V1\\GPBMetadata\xea\x02\x12Admin::Service::V1b\x06proto3"
 
 var file_admin_service_v1_i_policy_evaluation_log_proto_goTypes = []any{
 	(*v1.PagingRequest)(nil),                    // 0: pagination.PagingRequest
 	(*v11.GetPolicyEvaluationLogRequest)(nil),   // 1: permission.service.v1.GetPolicyEvaluationLogRequest
-	(*v11.ListPolicyEvaluationLogResponse)(nil), // 2: permission.service.v1.ListPolicyEvaluationLogResponse
-	(*v11.PolicyEvaluationLog)(nil),             // 3: permission.service.v1.PolicyEvaluationLog
+	(*v12.ExportAuditLogRequest)(nil),           // 2: audit.service.v1.ExportAuditLogRequest
+	(*v11.ListPolicyEvaluationLogResponse)(nil), // 3: permission.service.v1.ListPolicyEvaluationLogResponse
+	(*v11.PolicyEvaluationLog)(nil),             // 4: permission.service.v1.PolicyEvaluationLog
+	(*v12.ExportAuditLogResponse)(nil),          // 5: audit.service.v1.ExportAuditLogResponse
 }
 var file_admin_service_v1_i_policy_evaluation_log_proto_depIdxs = []int32{
 	0, // 0: admin.service.v1.PolicyEvaluationLogService.List:input_type -> pagination.PagingRequest
 	1, // 1: admin.service.v1.PolicyEvaluationLogService.Get:input_type -> permission.service.v1.GetPolicyEvaluationLogRequest
-	2, // 2: admin.service.v1.PolicyEvaluationLogService.List:output_type -> permission.service.v1.ListPolicyEvaluationLogResponse
-	3, // 3: admin.service.v1.PolicyEvaluationLogService.Get:output_type -> permission.service.v1.PolicyEvaluationLog
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
+	2, // 2: admin.service.v1.PolicyEvaluationLogService.Export:input_type -> audit.service.v1.ExportAuditLogRequest
+	3, // 3: admin.service.v1.PolicyEvaluationLogService.List:output_type -> permission.service.v1.ListPolicyEvaluationLogResponse
+	4, // 4: admin.service.v1.PolicyEvaluationLogService.Get:output_type -> permission.service.v1.PolicyEvaluationLog
+	5, // 5: admin.service.v1.PolicyEvaluationLogService.Export:output_type -> audit.service.v1.ExportAuditLogResponse
+	3, // [3:6] is the sub-list for method output_type
+	0, // [0:3] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -9,6 +9,7 @@ package adminpb
 import (
 	context "context"
 	v1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	v12 "go-wind-admin/api/gen/go/audit/service/v1"
 	v11 "go-wind-admin/api/gen/go/permission/service/v1"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -21,8 +22,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PolicyEvaluationLogService_List_FullMethodName = "/admin.service.v1.PolicyEvaluationLogService/List"
-	PolicyEvaluationLogService_Get_FullMethodName  = "/admin.service.v1.PolicyEvaluationLogService/Get"
+	PolicyEvaluationLogService_List_FullMethodName   = "/admin.service.v1.PolicyEvaluationLogService/List"
+	PolicyEvaluationLogService_Get_FullMethodName    = "/admin.service.v1.PolicyEvaluationLogService/Get"
+	PolicyEvaluationLogService_Export_FullMethodName = "/admin.service.v1.PolicyEvaluationLogService/Export"
 )
 
 // PolicyEvaluationLogServiceClient is the client API for PolicyEvaluationLogService service.
@@ -35,6 +37,8 @@ type PolicyEvaluationLogServiceClient interface {
 	List(ctx context.Context, in *v1.PagingRequest, opts ...grpc.CallOption) (*v11.ListPolicyEvaluationLogResponse, error)
 	// 查询策略评估日志详情
 	Get(ctx context.Context, in *v11.GetPolicyEvaluationLogRequest, opts ...grpc.CallOption) (*v11.PolicyEvaluationLog, error)
+	// 导出策略评估日志（后台任务，完成后站内信通知）
+	Export(ctx context.Context, in *v12.ExportAuditLogRequest, opts ...grpc.CallOption) (*v12.ExportAuditLogResponse, error)
 }
 
 type policyEvaluationLogServiceClient struct {
@@ -65,6 +69,16 @@ func (c *policyEvaluationLogServiceClient) Get(ctx context.Context, in *v11.GetP
 	return out, nil
 }
 
+func (c *policyEvaluationLogServiceClient) Export(ctx context.Context, in *v12.ExportAuditLogRequest, opts ...grpc.CallOption) (*v12.ExportAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v12.ExportAuditLogResponse)
+	err := c.cc.Invoke(ctx, PolicyEvaluationLogService_Export_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PolicyEvaluationLogServiceServer is the server API for PolicyEvaluationLogService service.
 // All implementations must embed UnimplementedPolicyEvaluationLogServiceServer
 // for forward compatibility.
@@ -75,6 +89,8 @@ type PolicyEvaluationLogServiceServer interface {
 	List(context.Context, *v1.PagingRequest) (*v11.ListPolicyEvaluationLogResponse, error)
 	// 查询策略评估日志详情
 	Get(context.Context, *v11.GetPolicyEvaluationLogRequest) (*v11.PolicyEvaluationLog, error)
+	// 导出策略评估日志（后台任务，完成后站内信通知）
+	Export(context.Context, *v12.ExportAuditLogRequest) (*v12.ExportAuditLogResponse, error)
 	mustEmbedUnimplementedPolicyEvaluationLogServiceServer()
 }
 
@@ -91,6 +107,9 @@ func (UnimplementedPolicyEvaluationLogServiceServer) List(context.Context, *v1.P
 func (UnimplementedPolicyEvaluationLogServiceServer) Get(context.Context, *v11.GetPolicyEvaluationLogRequest) (*v11.PolicyEvaluationLog, error) {
 	return nil, status.Error(codes.Unimplemented, "method Get not implemented")
 }
+func (UnimplementedPolicyEvaluationLogServiceServer) Export(context.Context, *v12.ExportAuditLogRequest) (*v12.ExportAuditLogResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Export not implemented")
+}
 func (UnimplementedPolicyEvaluationLogServiceServer) mustEmbedUnimplementedPolicyEvaluationLogServiceServer() {
 }
 func (UnimplementedPolicyEvaluationLogServiceServer) testEmbeddedByValue() {}
@@ -149,6 +168,24 @@ func _PolicyEvaluationLogService_Get_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PolicyEvaluationLogService_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v12.ExportAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PolicyEvaluationLogServiceServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PolicyEvaluationLogService_Export_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PolicyEvaluationLogServiceServer).Export(ctx, req.(*v12.ExportAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PolicyEvaluationLogService_ServiceDesc is the grpc.ServiceDesc for PolicyEvaluationLogService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -164,6 +201,10 @@ var PolicyEvaluationLogService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Get",
 			Handler:    _PolicyEvaluationLogService_Get_Handler,
 		},
+		{
+			MethodName: "Export",
+			Handler:    _PolicyEvaluationLogService_Export_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin/service/v1/i_policy_evaluation_log.proto",
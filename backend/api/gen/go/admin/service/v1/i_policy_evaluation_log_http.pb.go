@@ -11,6 +11,7 @@ import (
 	http "github.com/go-kratos/kratos/v2/transport/http"
 	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
 	v1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	v12 "go-wind-admin/api/gen/go/audit/service/v1"
 	v11 "go-wind-admin/api/gen/go/permission/service/v1"
 )
 
@@ -21,10 +22,13 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationPolicyEvaluationLogServiceExport = "/admin.service.v1.PolicyEvaluationLogService/Export"
 const OperationPolicyEvaluationLogServiceGet = "/admin.service.v1.PolicyEvaluationLogService/Get"
 const OperationPolicyEvaluationLogServiceList = "/admin.service.v1.PolicyEvaluationLogService/List"
 
 type PolicyEvaluationLogServiceHTTPServer interface {
+	// Export 导出策略评估日志（后台任务，完成后站内信通知）
+	Export(context.Context, *v12.ExportAuditLogRequest) (*v12.ExportAuditLogResponse, error)
 	// Get 查询策略评估日志详情
 	Get(context.Context, *v11.GetPolicyEvaluationLogRequest) (*v11.PolicyEvaluationLog, error)
 	// List 查询策略评估日志列表
@@ -35,6 +39,7 @@ func RegisterPolicyEvaluationLogServiceHTTPServer(s *http.Server, srv PolicyEval
 	r := s.Route("/")
 	r.GET("/admin/v1/policy-evaluation-logs", _PolicyEvaluationLogService_List16_HTTP_Handler(srv))
 	r.GET("/admin/v1/policy-evaluation-logs/{id}", _PolicyEvaluationLogService_Get16_HTTP_Handler(srv))
+	r.POST("/admin/v1/policy-evaluation-logs:export", _PolicyEvaluationLogService_Export5_HTTP_Handler(srv))
 }
 
 func _PolicyEvaluationLogService_List16_HTTP_Handler(srv PolicyEvaluationLogServiceHTTPServer) func(ctx http.Context) error {
@@ -78,7 +83,31 @@ func _PolicyEvaluationLogService_Get16_HTTP_Handler(srv PolicyEvaluationLogServi
 	}
 }
 
+func _PolicyEvaluationLogService_Export5_HTTP_Handler(srv PolicyEvaluationLogServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v12.ExportAuditLogRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPolicyEvaluationLogServiceExport)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Export(ctx, req.(*v12.ExportAuditLogRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v12.ExportAuditLogResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type PolicyEvaluationLogServiceHTTPClient interface {
+	// Export 导出策略评估日志（后台任务，完成后站内信通知）
+	Export(ctx context.Context, req *v12.ExportAuditLogRequest, opts ...http.CallOption) (rsp *v12.ExportAuditLogResponse, err error)
 	// Get 查询策略评估日志详情
 	Get(ctx context.Context, req *v11.GetPolicyEvaluationLogRequest, opts ...http.CallOption) (rsp *v11.PolicyEvaluationLog, err error)
 	// List 查询策略评估日志列表
@@ -93,6 +122,20 @@ func NewPolicyEvaluationLogServiceHTTPClient(client *http.Client) PolicyEvaluati
 	return &PolicyEvaluationLogServiceHTTPClientImpl{client}
 }
 
+// Export 导出策略评估日志（后台任务，完成后站内信通知）
+func (c *PolicyEvaluationLogServiceHTTPClientImpl) Export(ctx context.Context, in *v12.ExportAuditLogRequest, opts ...http.CallOption) (*v12.ExportAuditLogResponse, error) {
+	var out v12.ExportAuditLogResponse
+	pattern := "/admin/v1/policy-evaluation-logs:export"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPolicyEvaluationLogServiceExport))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // Get 查询策略评估日志详情
 func (c *PolicyEvaluationLogServiceHTTPClientImpl) Get(ctx context.Context, in *v11.GetPolicyEvaluationLogRequest, opts ...http.CallOption) (*v11.PolicyEvaluationLog, error) {
 	var out v11.PolicyEvaluationLog
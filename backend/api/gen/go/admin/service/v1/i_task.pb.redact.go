@@ -24,6 +24,7 @@ var (
 	_ emptypb.Empty
 	_ pagination.Sorting
 	_ taskpb.TaskOption
+	_ taskpb.TaskRun
 )
 
 // RegisterRedactedTaskServiceServer wraps the TaskServiceServer with the redacted server and registers the service in GRPC
@@ -153,3 +154,47 @@ func (s *redactedTaskServiceServer) ControlTask(ctx context.Context, in *taskpb.
 	}
 	return res, err
 }
+
+// ListNextRunTimes is the redacted wrapper for the actual TaskServiceServer.ListNextRunTimes method
+// Unary RPC
+func (s *redactedTaskServiceServer) ListNextRunTimes(ctx context.Context, in *taskpb.ListNextRunTimesRequest) (*taskpb.ListNextRunTimesResponse, error) {
+	res, err := s.srv.ListNextRunTimes(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ListTaskRuns is the redacted wrapper for the actual TaskServiceServer.ListTaskRuns method
+// Unary RPC
+func (s *redactedTaskServiceServer) ListTaskRuns(ctx context.Context, in *pagination.PagingRequest) (*taskpb.ListTaskRunResponse, error) {
+	res, err := s.srv.ListTaskRuns(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// GetTaskRun is the redacted wrapper for the actual TaskServiceServer.GetTaskRun method
+// Unary RPC
+func (s *redactedTaskServiceServer) GetTaskRun(ctx context.Context, in *taskpb.GetTaskRunRequest) (*taskpb.TaskRun, error) {
+	res, err := s.srv.GetTaskRun(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// PurgeTaskRuns is the redacted wrapper for the actual TaskServiceServer.PurgeTaskRuns method
+// Unary RPC
+func (s *redactedTaskServiceServer) PurgeTaskRuns(ctx context.Context, in *taskpb.PurgeTaskRunsRequest) (*taskpb.PurgeTaskRunsResponse, error) {
+	res, err := s.srv.PurgeTaskRuns(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
@@ -32,6 +32,10 @@ const (
 	TaskService_StartAllTask_FullMethodName     = "/admin.service.v1.TaskService/StartAllTask"
 	TaskService_StopAllTask_FullMethodName      = "/admin.service.v1.TaskService/StopAllTask"
 	TaskService_ControlTask_FullMethodName      = "/admin.service.v1.TaskService/ControlTask"
+	TaskService_ListNextRunTimes_FullMethodName = "/admin.service.v1.TaskService/ListNextRunTimes"
+	TaskService_ListTaskRuns_FullMethodName     = "/admin.service.v1.TaskService/ListTaskRuns"
+	TaskService_GetTaskRun_FullMethodName       = "/admin.service.v1.TaskService/GetTaskRun"
+	TaskService_PurgeTaskRuns_FullMethodName    = "/admin.service.v1.TaskService/PurgeTaskRuns"
 )
 
 // TaskServiceClient is the client API for TaskService service.
@@ -60,6 +64,14 @@ type TaskServiceClient interface {
 	StopAllTask(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// 控制调度任务
 	ControlTask(ctx context.Context, in *v11.ControlTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 预览周期任务的下次执行时间
+	ListNextRunTimes(ctx context.Context, in *v11.ListNextRunTimesRequest, opts ...grpc.CallOption) (*v11.ListNextRunTimesResponse, error)
+	// 查询任务执行记录列表
+	ListTaskRuns(ctx context.Context, in *v1.PagingRequest, opts ...grpc.CallOption) (*v11.ListTaskRunResponse, error)
+	// 查询任务执行记录详情
+	GetTaskRun(ctx context.Context, in *v11.GetTaskRunRequest, opts ...grpc.CallOption) (*v11.TaskRun, error)
+	// 清理过期的任务执行记录
+	PurgeTaskRuns(ctx context.Context, in *v11.PurgeTaskRunsRequest, opts ...grpc.CallOption) (*v11.PurgeTaskRunsResponse, error)
 }
 
 type taskServiceClient struct {
@@ -170,6 +182,46 @@ func (c *taskServiceClient) ControlTask(ctx context.Context, in *v11.ControlTask
 	return out, nil
 }
 
+func (c *taskServiceClient) ListNextRunTimes(ctx context.Context, in *v11.ListNextRunTimesRequest, opts ...grpc.CallOption) (*v11.ListNextRunTimesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v11.ListNextRunTimesResponse)
+	err := c.cc.Invoke(ctx, TaskService_ListNextRunTimes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListTaskRuns(ctx context.Context, in *v1.PagingRequest, opts ...grpc.CallOption) (*v11.ListTaskRunResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v11.ListTaskRunResponse)
+	err := c.cc.Invoke(ctx, TaskService_ListTaskRuns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GetTaskRun(ctx context.Context, in *v11.GetTaskRunRequest, opts ...grpc.CallOption) (*v11.TaskRun, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v11.TaskRun)
+	err := c.cc.Invoke(ctx, TaskService_GetTaskRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) PurgeTaskRuns(ctx context.Context, in *v11.PurgeTaskRunsRequest, opts ...grpc.CallOption) (*v11.PurgeTaskRunsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v11.PurgeTaskRunsResponse)
+	err := c.cc.Invoke(ctx, TaskService_PurgeTaskRuns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TaskServiceServer is the server API for TaskService service.
 // All implementations must embed UnimplementedTaskServiceServer
 // for forward compatibility.
@@ -196,6 +248,14 @@ type TaskServiceServer interface {
 	StopAllTask(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	// 控制调度任务
 	ControlTask(context.Context, *v11.ControlTaskRequest) (*emptypb.Empty, error)
+	// 预览周期任务的下次执行时间
+	ListNextRunTimes(context.Context, *v11.ListNextRunTimesRequest) (*v11.ListNextRunTimesResponse, error)
+	// 查询任务执行记录列表
+	ListTaskRuns(context.Context, *v1.PagingRequest) (*v11.ListTaskRunResponse, error)
+	// 查询任务执行记录详情
+	GetTaskRun(context.Context, *v11.GetTaskRunRequest) (*v11.TaskRun, error)
+	// 清理过期的任务执行记录
+	PurgeTaskRuns(context.Context, *v11.PurgeTaskRunsRequest) (*v11.PurgeTaskRunsResponse, error)
 	mustEmbedUnimplementedTaskServiceServer()
 }
 
@@ -236,6 +296,18 @@ func (UnimplementedTaskServiceServer) StopAllTask(context.Context, *emptypb.Empt
 func (UnimplementedTaskServiceServer) ControlTask(context.Context, *v11.ControlTaskRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method ControlTask not implemented")
 }
+func (UnimplementedTaskServiceServer) ListNextRunTimes(context.Context, *v11.ListNextRunTimesRequest) (*v11.ListNextRunTimesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListNextRunTimes not implemented")
+}
+func (UnimplementedTaskServiceServer) ListTaskRuns(context.Context, *v1.PagingRequest) (*v11.ListTaskRunResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTaskRuns not implemented")
+}
+func (UnimplementedTaskServiceServer) GetTaskRun(context.Context, *v11.GetTaskRunRequest) (*v11.TaskRun, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTaskRun not implemented")
+}
+func (UnimplementedTaskServiceServer) PurgeTaskRuns(context.Context, *v11.PurgeTaskRunsRequest) (*v11.PurgeTaskRunsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PurgeTaskRuns not implemented")
+}
 func (UnimplementedTaskServiceServer) mustEmbedUnimplementedTaskServiceServer() {}
 func (UnimplementedTaskServiceServer) testEmbeddedByValue()                     {}
 
@@ -437,6 +509,78 @@ func _TaskService_ControlTask_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaskService_ListNextRunTimes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.ListNextRunTimesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListNextRunTimes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListNextRunTimes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListNextRunTimes(ctx, req.(*v11.ListNextRunTimesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ListTaskRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.PagingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListTaskRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListTaskRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListTaskRuns(ctx, req.(*v1.PagingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetTaskRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.GetTaskRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetTaskRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_GetTaskRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetTaskRun(ctx, req.(*v11.GetTaskRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_PurgeTaskRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.PurgeTaskRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).PurgeTaskRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_PurgeTaskRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).PurgeTaskRuns(ctx, req.(*v11.PurgeTaskRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TaskService_ServiceDesc is the grpc.ServiceDesc for TaskService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -484,6 +628,22 @@ var TaskService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ControlTask",
 			Handler:    _TaskService_ControlTask_Handler,
 		},
+		{
+			MethodName: "ListNextRunTimes",
+			Handler:    _TaskService_ListNextRunTimes_Handler,
+		},
+		{
+			MethodName: "ListTaskRuns",
+			Handler:    _TaskService_ListTaskRuns_Handler,
+		},
+		{
+			MethodName: "GetTaskRun",
+			Handler:    _TaskService_GetTaskRun_Handler,
+		},
+		{
+			MethodName: "PurgeTaskRuns",
+			Handler:    _TaskService_PurgeTaskRuns_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin/service/v1/i_task.proto",
@@ -26,8 +26,12 @@ const OperationTaskServiceControlTask = "/admin.service.v1.TaskService/ControlTa
 const OperationTaskServiceCreate = "/admin.service.v1.TaskService/Create"
 const OperationTaskServiceDelete = "/admin.service.v1.TaskService/Delete"
 const OperationTaskServiceGet = "/admin.service.v1.TaskService/Get"
+const OperationTaskServiceGetTaskRun = "/admin.service.v1.TaskService/GetTaskRun"
 const OperationTaskServiceList = "/admin.service.v1.TaskService/List"
+const OperationTaskServiceListNextRunTimes = "/admin.service.v1.TaskService/ListNextRunTimes"
+const OperationTaskServiceListTaskRuns = "/admin.service.v1.TaskService/ListTaskRuns"
 const OperationTaskServiceListTaskTypeName = "/admin.service.v1.TaskService/ListTaskTypeName"
+const OperationTaskServicePurgeTaskRuns = "/admin.service.v1.TaskService/PurgeTaskRuns"
 const OperationTaskServiceRestartAllTask = "/admin.service.v1.TaskService/RestartAllTask"
 const OperationTaskServiceStartAllTask = "/admin.service.v1.TaskService/StartAllTask"
 const OperationTaskServiceStopAllTask = "/admin.service.v1.TaskService/StopAllTask"
@@ -42,10 +46,18 @@ type TaskServiceHTTPServer interface {
 	Delete(context.Context, *v11.DeleteTaskRequest) (*emptypb.Empty, error)
 	// Get 查询调度任务详情
 	Get(context.Context, *v11.GetTaskRequest) (*v11.Task, error)
+	// GetTaskRun 查询任务执行记录详情
+	GetTaskRun(context.Context, *v11.GetTaskRunRequest) (*v11.TaskRun, error)
 	// List 查询调度任务列表
 	List(context.Context, *v1.PagingRequest) (*v11.ListTaskResponse, error)
+	// ListNextRunTimes 预览周期任务的下次执行时间
+	ListNextRunTimes(context.Context, *v11.ListNextRunTimesRequest) (*v11.ListNextRunTimesResponse, error)
+	// ListTaskRuns 查询任务执行记录列表
+	ListTaskRuns(context.Context, *v1.PagingRequest) (*v11.ListTaskRunResponse, error)
 	// ListTaskTypeName 任务类型名称列表
 	ListTaskTypeName(context.Context, *emptypb.Empty) (*v11.ListTaskTypeNameResponse, error)
+	// PurgeTaskRuns 清理过期的任务执行记录
+	PurgeTaskRuns(context.Context, *v11.PurgeTaskRunsRequest) (*v11.PurgeTaskRunsResponse, error)
 	// RestartAllTask 重启所有的调度任务
 	RestartAllTask(context.Context, *emptypb.Empty) (*v11.RestartAllTaskResponse, error)
 	// StartAllTask 启动所有的调度任务
@@ -69,6 +81,10 @@ func RegisterTaskServiceHTTPServer(s *http.Server, srv TaskServiceHTTPServer) {
 	r.POST("/admin/v1/tasks:start", _TaskService_StartAllTask0_HTTP_Handler(srv))
 	r.POST("/admin/v1/tasks:stop", _TaskService_StopAllTask0_HTTP_Handler(srv))
 	r.POST("/admin/v1/tasks:control", _TaskService_ControlTask0_HTTP_Handler(srv))
+	r.GET("/admin/v1/tasks:next-run-times", _TaskService_ListNextRunTimes0_HTTP_Handler(srv))
+	r.GET("/admin/v1/task-runs", _TaskService_ListTaskRuns0_HTTP_Handler(srv))
+	r.GET("/admin/v1/task-runs/{id}", _TaskService_GetTaskRun0_HTTP_Handler(srv))
+	r.POST("/admin/v1/task-runs:purge", _TaskService_PurgeTaskRuns0_HTTP_Handler(srv))
 }
 
 func _TaskService_List19_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Context) error {
@@ -310,6 +326,88 @@ func _TaskService_ControlTask0_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx
 	}
 }
 
+func _TaskService_ListNextRunTimes0_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v11.ListNextRunTimesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTaskServiceListNextRunTimes)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListNextRunTimes(ctx, req.(*v11.ListNextRunTimesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v11.ListNextRunTimesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TaskService_ListTaskRuns0_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.PagingRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTaskServiceListTaskRuns)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTaskRuns(ctx, req.(*v1.PagingRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v11.ListTaskRunResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TaskService_GetTaskRun0_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v11.GetTaskRunRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTaskServiceGetTaskRun)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTaskRun(ctx, req.(*v11.GetTaskRunRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v11.TaskRun)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TaskService_PurgeTaskRuns0_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v11.PurgeTaskRunsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTaskServicePurgeTaskRuns)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PurgeTaskRuns(ctx, req.(*v11.PurgeTaskRunsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v11.PurgeTaskRunsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type TaskServiceHTTPClient interface {
 	// ControlTask 控制调度任务
 	ControlTask(ctx context.Context, req *v11.ControlTaskRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
@@ -319,10 +417,18 @@ type TaskServiceHTTPClient interface {
 	Delete(ctx context.Context, req *v11.DeleteTaskRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	// Get 查询调度任务详情
 	Get(ctx context.Context, req *v11.GetTaskRequest, opts ...http.CallOption) (rsp *v11.Task, err error)
+	// GetTaskRun 查询任务执行记录详情
+	GetTaskRun(ctx context.Context, req *v11.GetTaskRunRequest, opts ...http.CallOption) (rsp *v11.TaskRun, err error)
 	// List 查询调度任务列表
 	List(ctx context.Context, req *v1.PagingRequest, opts ...http.CallOption) (rsp *v11.ListTaskResponse, err error)
+	// ListNextRunTimes 预览周期任务的下次执行时间
+	ListNextRunTimes(ctx context.Context, req *v11.ListNextRunTimesRequest, opts ...http.CallOption) (rsp *v11.ListNextRunTimesResponse, err error)
+	// ListTaskRuns 查询任务执行记录列表
+	ListTaskRuns(ctx context.Context, req *v1.PagingRequest, opts ...http.CallOption) (rsp *v11.ListTaskRunResponse, err error)
 	// ListTaskTypeName 任务类型名称列表
 	ListTaskTypeName(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *v11.ListTaskTypeNameResponse, err error)
+	// PurgeTaskRuns 清理过期的任务执行记录
+	PurgeTaskRuns(ctx context.Context, req *v11.PurgeTaskRunsRequest, opts ...http.CallOption) (rsp *v11.PurgeTaskRunsResponse, err error)
 	// RestartAllTask 重启所有的调度任务
 	RestartAllTask(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *v11.RestartAllTaskResponse, err error)
 	// StartAllTask 启动所有的调度任务
@@ -397,6 +503,20 @@ func (c *TaskServiceHTTPClientImpl) Get(ctx context.Context, in *v11.GetTaskRequ
 	return &out, nil
 }
 
+// GetTaskRun 查询任务执行记录详情
+func (c *TaskServiceHTTPClientImpl) GetTaskRun(ctx context.Context, in *v11.GetTaskRunRequest, opts ...http.CallOption) (*v11.TaskRun, error) {
+	var out v11.TaskRun
+	pattern := "/admin/v1/task-runs/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTaskServiceGetTaskRun))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // List 查询调度任务列表
 func (c *TaskServiceHTTPClientImpl) List(ctx context.Context, in *v1.PagingRequest, opts ...http.CallOption) (*v11.ListTaskResponse, error) {
 	var out v11.ListTaskResponse
@@ -411,6 +531,34 @@ func (c *TaskServiceHTTPClientImpl) List(ctx context.Context, in *v1.PagingReque
 	return &out, nil
 }
 
+// ListNextRunTimes 预览周期任务的下次执行时间
+func (c *TaskServiceHTTPClientImpl) ListNextRunTimes(ctx context.Context, in *v11.ListNextRunTimesRequest, opts ...http.CallOption) (*v11.ListNextRunTimesResponse, error) {
+	var out v11.ListNextRunTimesResponse
+	pattern := "/admin/v1/tasks:next-run-times"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTaskServiceListNextRunTimes))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListTaskRuns 查询任务执行记录列表
+func (c *TaskServiceHTTPClientImpl) ListTaskRuns(ctx context.Context, in *v1.PagingRequest, opts ...http.CallOption) (*v11.ListTaskRunResponse, error) {
+	var out v11.ListTaskRunResponse
+	pattern := "/admin/v1/task-runs"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTaskServiceListTaskRuns))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // ListTaskTypeName 任务类型名称列表
 func (c *TaskServiceHTTPClientImpl) ListTaskTypeName(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*v11.ListTaskTypeNameResponse, error) {
 	var out v11.ListTaskTypeNameResponse
@@ -425,6 +573,20 @@ func (c *TaskServiceHTTPClientImpl) ListTaskTypeName(ctx context.Context, in *em
 	return &out, nil
 }
 
+// PurgeTaskRuns 清理过期的任务执行记录
+func (c *TaskServiceHTTPClientImpl) PurgeTaskRuns(ctx context.Context, in *v11.PurgeTaskRunsRequest, opts ...http.CallOption) (*v11.PurgeTaskRunsResponse, error) {
+	var out v11.PurgeTaskRunsResponse
+	pattern := "/admin/v1/task-runs:purge"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTaskServicePurgeTaskRuns))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // RestartAllTask 重启所有的调度任务
 func (c *TaskServiceHTTPClientImpl) RestartAllTask(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*v11.RestartAllTaskResponse, error) {
 	var out v11.RestartAllTaskResponse
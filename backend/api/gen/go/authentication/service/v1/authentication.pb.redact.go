@@ -212,6 +212,10 @@ func (x *LoginRequest) Redact() string {
 	// Safe field: DeviceId
 
 	// Safe field: Jti
+
+	// Redacting field: MfaCode
+	MfaCodeTmp := ``
+	x.MfaCode = &MfaCodeTmp
 	return x.String()
 }
 
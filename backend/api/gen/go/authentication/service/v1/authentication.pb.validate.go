@@ -144,6 +144,10 @@ func (m *LoginRequest) validate(all bool) error {
 		// no validation rules for Jti
 	}
 
+	if m.MfaCode != nil {
+		// no validation rules for MfaCode
+	}
+
 	if len(errors) > 0 {
 		return LoginRequestMultiError(errors)
 	}
@@ -40,10 +40,12 @@ const (
 	AuthenticationErrorReason_INCORRECT_REFRESH_TOKEN AuthenticationErrorReason = 105 // 刷新令牌错误
 	AuthenticationErrorReason_TOKEN_EXPIRED           AuthenticationErrorReason = 106 // token过期
 	AuthenticationErrorReason_TOKEN_NOT_EXIST         AuthenticationErrorReason = 107 // token不存在
+	AuthenticationErrorReason_LOGIN_MFA_REQUIRED      AuthenticationErrorReason = 108 // 登录需要多因素认证
 	// 402
 	AuthenticationErrorReason_PAYMENT_REQUIRED AuthenticationErrorReason = 200 // 需要支付
 	// 403
-	AuthenticationErrorReason_FORBIDDEN AuthenticationErrorReason = 300 // 禁止访问
+	AuthenticationErrorReason_FORBIDDEN          AuthenticationErrorReason = 300 // 禁止访问
+	AuthenticationErrorReason_LOGIN_RISK_BLOCKED AuthenticationErrorReason = 301 // 高风险登录被拦截
 	// 404
 	AuthenticationErrorReason_NOT_FOUND               AuthenticationErrorReason = 400 // 找不到资源
 	AuthenticationErrorReason_USER_NOT_FOUND          AuthenticationErrorReason = 401 // 用户不存在
@@ -140,8 +142,10 @@ var (
 		105:  "INCORRECT_REFRESH_TOKEN",
 		106:  "TOKEN_EXPIRED",
 		107:  "TOKEN_NOT_EXIST",
+		108:  "LOGIN_MFA_REQUIRED",
 		200:  "PAYMENT_REQUIRED",
 		300:  "FORBIDDEN",
+		301:  "LOGIN_RISK_BLOCKED",
 		400:  "NOT_FOUND",
 		401:  "USER_NOT_FOUND",
 		402:  "ACCESS_TOKEN_NOT_FOUND",
@@ -197,8 +201,10 @@ var (
 		"INCORRECT_REFRESH_TOKEN":         105,
 		"TOKEN_EXPIRED":                   106,
 		"TOKEN_NOT_EXIST":                 107,
+		"LOGIN_MFA_REQUIRED":              108,
 		"PAYMENT_REQUIRED":                200,
 		"FORBIDDEN":                       300,
+		"LOGIN_RISK_BLOCKED":              301,
 		"NOT_FOUND":                       400,
 		"USER_NOT_FOUND":                  401,
 		"ACCESS_TOKEN_NOT_FOUND":          402,
@@ -274,7 +280,7 @@ var File_authentication_service_v1_authentication_error_proto protoreflect.FileD
 
 const file_authentication_service_v1_authentication_error_proto_rawDesc = "" +
 	"\n" +
-	"4authentication/service/v1/authentication_error.proto\x12\x19authentication.service.v1\x1a\x13errors/errors.proto*\x96\r\n" +
+	"4authentication/service/v1/authentication_error.proto\x12\x19authentication.service.v1\x1a\x13errors/errors.proto*\xd3\r\n" +
 	"\x19AuthenticationErrorReason\x12\x15\n" +
 	"\vBAD_REQUEST\x10\x00\x1a\x04\xa8E\x90\x03\x12\x1c\n" +
 	"\x12INVALID_GRANT_TYPE\x10\x01\x1a\x04\xa8E\x90\x03\x12\x18\n" +
@@ -287,9 +293,11 @@ const file_authentication_service_v1_authentication_error_proto_rawDesc = "" +
 	"\x16INCORRECT_ACCESS_TOKEN\x10h\x1a\x04\xa8E\x91\x03\x12!\n" +
 	"\x17INCORRECT_REFRESH_TOKEN\x10i\x1a\x04\xa8E\x91\x03\x12\x17\n" +
 	"\rTOKEN_EXPIRED\x10j\x1a\x04\xa8E\x91\x03\x12\x19\n" +
-	"\x0fTOKEN_NOT_EXIST\x10k\x1a\x04\xa8E\x91\x03\x12\x1b\n" +
+	"\x0fTOKEN_NOT_EXIST\x10k\x1a\x04\xa8E\x91\x03\x12\x1c\n" +
+	"\x12LOGIN_MFA_REQUIRED\x10l\x1a\x04\xa8E\x91\x03\x12\x1b\n" +
 	"\x10PAYMENT_REQUIRED\x10\xc8\x01\x1a\x04\xa8E\x92\x03\x12\x14\n" +
-	"\tFORBIDDEN\x10\xac\x02\x1a\x04\xa8E\x93\x03\x12\x14\n" +
+	"\tFORBIDDEN\x10\xac\x02\x1a\x04\xa8E\x93\x03\x12\x1d\n" +
+	"\x12LOGIN_RISK_BLOCKED\x10\xad\x02\x1a\x04\xa8E\x93\x03\x12\x14\n" +
 	"\tNOT_FOUND\x10\x90\x03\x1a\x04\xa8E\x94\x03\x12\x19\n" +
 	"\x0eUSER_NOT_FOUND\x10\x91\x03\x1a\x04\xa8E\x94\x03\x12!\n" +
 	"\x16ACCESS_TOKEN_NOT_FOUND\x10\x92\x03\x1a\x04\xa8E\x94\x03\x12\"\n" +
@@ -179,6 +179,20 @@ func ErrorTokenNotExist(format string, args ...interface{}) *errors.Error {
 	return errors.New(401, AuthenticationErrorReason_TOKEN_NOT_EXIST.String(), fmt.Sprintf(format, args...))
 }
 
+// 登录需要多因素认证
+func IsLoginMfaRequired(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == AuthenticationErrorReason_LOGIN_MFA_REQUIRED.String() && e.Code == 401
+}
+
+// 登录需要多因素认证
+func ErrorLoginMfaRequired(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, AuthenticationErrorReason_LOGIN_MFA_REQUIRED.String(), fmt.Sprintf(format, args...))
+}
+
 // 402
 func IsPaymentRequired(err error) bool {
 	if err == nil {
@@ -207,6 +221,20 @@ func ErrorForbidden(format string, args ...interface{}) *errors.Error {
 	return errors.New(403, AuthenticationErrorReason_FORBIDDEN.String(), fmt.Sprintf(format, args...))
 }
 
+// 高风险登录被拦截
+func IsLoginRiskBlocked(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == AuthenticationErrorReason_LOGIN_RISK_BLOCKED.String() && e.Code == 403
+}
+
+// 高风险登录被拦截
+func ErrorLoginRiskBlocked(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, AuthenticationErrorReason_LOGIN_RISK_BLOCKED.String(), fmt.Sprintf(format, args...))
+}
+
 // 404
 func IsNotFound(err error) bool {
 	if err == nil {
@@ -8,7 +8,8 @@ package identitypb
 
 import (
 	_ "github.com/google/gnostic/openapiv3"
-	v1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	v11 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	v1 "go-wind-admin/api/gen/go/storage/service/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
@@ -191,34 +192,144 @@ func (Tenant_AuditStatus) EnumDescriptor() ([]byte, []int) {
 	return file_identity_service_v1_tenant_proto_rawDescGZIP(), []int{0, 2}
 }
 
+// 高风险登录处置方式
+type Tenant_HighRiskLoginAction int32
+
+const (
+	Tenant_HIGH_RISK_LOGIN_ACTION_UNSPECIFIED Tenant_HighRiskLoginAction = 0 // 未指定
+	Tenant_ALLOW                              Tenant_HighRiskLoginAction = 1 // 放行（仅记录审计日志）
+	Tenant_BLOCK                              Tenant_HighRiskLoginAction = 2 // 拒绝登录
+	Tenant_REQUIRE_MFA                        Tenant_HighRiskLoginAction = 3 // 要求通过多因素认证（TOTP）后放行
+)
+
+// Enum value maps for Tenant_HighRiskLoginAction.
+var (
+	Tenant_HighRiskLoginAction_name = map[int32]string{
+		0: "HIGH_RISK_LOGIN_ACTION_UNSPECIFIED",
+		1: "ALLOW",
+		2: "BLOCK",
+		3: "REQUIRE_MFA",
+	}
+	Tenant_HighRiskLoginAction_value = map[string]int32{
+		"HIGH_RISK_LOGIN_ACTION_UNSPECIFIED": 0,
+		"ALLOW":                              1,
+		"BLOCK":                              2,
+		"REQUIRE_MFA":                        3,
+	}
+)
+
+func (x Tenant_HighRiskLoginAction) Enum() *Tenant_HighRiskLoginAction {
+	p := new(Tenant_HighRiskLoginAction)
+	*p = x
+	return p
+}
+
+func (x Tenant_HighRiskLoginAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Tenant_HighRiskLoginAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_identity_service_v1_tenant_proto_enumTypes[3].Descriptor()
+}
+
+func (Tenant_HighRiskLoginAction) Type() protoreflect.EnumType {
+	return &file_identity_service_v1_tenant_proto_enumTypes[3]
+}
+
+func (x Tenant_HighRiskLoginAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Tenant_HighRiskLoginAction.Descriptor instead.
+func (Tenant_HighRiskLoginAction) EnumDescriptor() ([]byte, []int) {
+	return file_identity_service_v1_tenant_proto_rawDescGZIP(), []int{0, 3}
+}
+
+// 文件去重策略
+type Tenant_FileDedupPolicy int32
+
+const (
+	Tenant_FILE_DEDUP_POLICY_UNSPECIFIED Tenant_FileDedupPolicy = 0 // 未指定（按租户内去重处理）
+	Tenant_DEDUP_DISABLED                Tenant_FileDedupPolicy = 1 // 不去重
+	Tenant_DEDUP_TENANT                  Tenant_FileDedupPolicy = 2 // 仅在本租户内去重
+	Tenant_DEDUP_SHARED                  Tenant_FileDedupPolicy = 3 // 与同样允许共享的租户共用物理对象
+)
+
+// Enum value maps for Tenant_FileDedupPolicy.
+var (
+	Tenant_FileDedupPolicy_name = map[int32]string{
+		0: "FILE_DEDUP_POLICY_UNSPECIFIED",
+		1: "DEDUP_DISABLED",
+		2: "DEDUP_TENANT",
+		3: "DEDUP_SHARED",
+	}
+	Tenant_FileDedupPolicy_value = map[string]int32{
+		"FILE_DEDUP_POLICY_UNSPECIFIED": 0,
+		"DEDUP_DISABLED":                1,
+		"DEDUP_TENANT":                  2,
+		"DEDUP_SHARED":                  3,
+	}
+)
+
+func (x Tenant_FileDedupPolicy) Enum() *Tenant_FileDedupPolicy {
+	p := new(Tenant_FileDedupPolicy)
+	*p = x
+	return p
+}
+
+func (x Tenant_FileDedupPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Tenant_FileDedupPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_identity_service_v1_tenant_proto_enumTypes[4].Descriptor()
+}
+
+func (Tenant_FileDedupPolicy) Type() protoreflect.EnumType {
+	return &file_identity_service_v1_tenant_proto_enumTypes[4]
+}
+
+func (x Tenant_FileDedupPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Tenant_FileDedupPolicy.Descriptor instead.
+func (Tenant_FileDedupPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_identity_service_v1_tenant_proto_rawDescGZIP(), []int{0, 4}
+}
+
 // 租户
 type Tenant struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`                                                                                   // 租户ID
-	Name             *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`                                                                                // 租户名称
-	Code             *string                `protobuf:"bytes,3,opt,name=code,proto3,oneof" json:"code,omitempty"`                                                                                // 租户编码
-	Domain           *string                `protobuf:"bytes,4,opt,name=domain,proto3,oneof" json:"domain,omitempty"`                                                                            // 租户专属域名
-	LogoUrl          *string                `protobuf:"bytes,5,opt,name=logo_url,json=logoUrl,proto3,oneof" json:"logo_url,omitempty"`                                                           // 租户logo地址
-	Industry         *string                `protobuf:"bytes,6,opt,name=industry,proto3,oneof" json:"industry,omitempty"`                                                                        // 所属行业
-	Type             *Tenant_Type           `protobuf:"varint,7,opt,name=type,proto3,enum=identity.service.v1.Tenant_Type,oneof" json:"type,omitempty"`                                          // 租户类型
-	Remark           *string                `protobuf:"bytes,8,opt,name=remark,proto3,oneof" json:"remark,omitempty"`                                                                            // 备注
-	AdminUserId      *uint32                `protobuf:"varint,10,opt,name=admin_user_id,json=adminUserId,proto3,oneof" json:"admin_user_id,omitempty"`                                           // 管理员用户ID
-	AdminUserName    *string                `protobuf:"bytes,11,opt,name=admin_user_name,json=adminUserName,proto3,oneof" json:"admin_user_name,omitempty"`                                      // 管理员用户名
-	SubscriptionAt   *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=subscription_at,json=subscriptionAt,proto3,oneof" json:"subscription_at,omitempty"`                                     // 订阅时间（首次订阅/续费时间，NULL表示未订阅）
-	UnsubscribeAt    *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=unsubscribe_at,json=unsubscribeAt,proto3,oneof" json:"unsubscribe_at,omitempty"`                                        // 取消订阅时间（NULL表示未取消）
-	ExpiredAt        *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=expired_at,json=expiredAt,proto3,oneof" json:"expired_at,omitempty"`                                                    // 租户有效期（NULL表示永久，过期后状态自动改为“过期”）
-	SubscriptionPlan *string                `protobuf:"bytes,23,opt,name=subscription_plan,json=subscriptionPlan,proto3,oneof" json:"subscription_plan,omitempty"`                               // 订阅套餐（如“企业版1年”“基础版3个月”）
-	MemberCount      *int32                 `protobuf:"varint,30,opt,name=member_count,json=memberCount,proto3,oneof" json:"member_count,omitempty"`                                             // 成员数量
-	Status           *Tenant_Status         `protobuf:"varint,31,opt,name=status,proto3,enum=identity.service.v1.Tenant_Status,oneof" json:"status,omitempty"`                                   // 租户状态
-	AuditStatus      *Tenant_AuditStatus    `protobuf:"varint,32,opt,name=audit_status,json=auditStatus,proto3,enum=identity.service.v1.Tenant_AuditStatus,oneof" json:"audit_status,omitempty"` // 审核状态
-	CreatedBy        *uint32                `protobuf:"varint,100,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`                                                  // 创建者ID
-	UpdatedBy        *uint32                `protobuf:"varint,101,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`                                                  // 更新者ID
-	DeletedBy        *uint32                `protobuf:"varint,102,opt,name=deleted_by,json=deletedBy,proto3,oneof" json:"deleted_by,omitempty"`                                                  // 删除者用户ID
-	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,200,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`                                                   // 创建时间
-	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,201,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`                                                   // 更新时间
-	DeletedAt        *timestamppb.Timestamp `protobuf:"bytes,202,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`                                                   // 删除时间
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	state                   protoimpl.MessageState      `protogen:"open.v1"`
+	Id                      *uint32                     `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`                                                                                                                       // 租户ID
+	Name                    *string                     `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`                                                                                                                    // 租户名称
+	Code                    *string                     `protobuf:"bytes,3,opt,name=code,proto3,oneof" json:"code,omitempty"`                                                                                                                    // 租户编码
+	Domain                  *string                     `protobuf:"bytes,4,opt,name=domain,proto3,oneof" json:"domain,omitempty"`                                                                                                                // 租户专属域名
+	LogoUrl                 *string                     `protobuf:"bytes,5,opt,name=logo_url,json=logoUrl,proto3,oneof" json:"logo_url,omitempty"`                                                                                               // 租户logo地址
+	Industry                *string                     `protobuf:"bytes,6,opt,name=industry,proto3,oneof" json:"industry,omitempty"`                                                                                                            // 所属行业
+	Type                    *Tenant_Type                `protobuf:"varint,7,opt,name=type,proto3,enum=identity.service.v1.Tenant_Type,oneof" json:"type,omitempty"`                                                                              // 租户类型
+	Remark                  *string                     `protobuf:"bytes,8,opt,name=remark,proto3,oneof" json:"remark,omitempty"`                                                                                                                // 备注
+	AdminUserId             *uint32                     `protobuf:"varint,10,opt,name=admin_user_id,json=adminUserId,proto3,oneof" json:"admin_user_id,omitempty"`                                                                               // 管理员用户ID
+	AdminUserName           *string                     `protobuf:"bytes,11,opt,name=admin_user_name,json=adminUserName,proto3,oneof" json:"admin_user_name,omitempty"`                                                                          // 管理员用户名
+	SubscriptionAt          *timestamppb.Timestamp      `protobuf:"bytes,20,opt,name=subscription_at,json=subscriptionAt,proto3,oneof" json:"subscription_at,omitempty"`                                                                         // 订阅时间（首次订阅/续费时间，NULL表示未订阅）
+	UnsubscribeAt           *timestamppb.Timestamp      `protobuf:"bytes,21,opt,name=unsubscribe_at,json=unsubscribeAt,proto3,oneof" json:"unsubscribe_at,omitempty"`                                                                            // 取消订阅时间（NULL表示未取消）
+	ExpiredAt               *timestamppb.Timestamp      `protobuf:"bytes,22,opt,name=expired_at,json=expiredAt,proto3,oneof" json:"expired_at,omitempty"`                                                                                        // 租户有效期（NULL表示永久，过期后状态自动改为“过期”）
+	SubscriptionPlan        *string                     `protobuf:"bytes,23,opt,name=subscription_plan,json=subscriptionPlan,proto3,oneof" json:"subscription_plan,omitempty"`                                                                   // 订阅套餐（如“企业版1年”“基础版3个月”）
+	MemberCount             *int32                      `protobuf:"varint,30,opt,name=member_count,json=memberCount,proto3,oneof" json:"member_count,omitempty"`                                                                                 // 成员数量
+	Status                  *Tenant_Status              `protobuf:"varint,31,opt,name=status,proto3,enum=identity.service.v1.Tenant_Status,oneof" json:"status,omitempty"`                                                                       // 租户状态
+	AuditStatus             *Tenant_AuditStatus         `protobuf:"varint,32,opt,name=audit_status,json=auditStatus,proto3,enum=identity.service.v1.Tenant_AuditStatus,oneof" json:"audit_status,omitempty"`                                     // 审核状态
+	HighRiskLoginAction     *Tenant_HighRiskLoginAction `protobuf:"varint,33,opt,name=high_risk_login_action,json=highRiskLoginAction,proto3,enum=identity.service.v1.Tenant_HighRiskLoginAction,oneof" json:"high_risk_login_action,omitempty"` // 高风险登录处置方式
+	StorageProvider         *v1.OSSProvider             `protobuf:"varint,34,opt,name=storage_provider,json=storageProvider,proto3,enum=storage.service.v1.OSSProvider,oneof" json:"storage_provider,omitempty"`                                 // 文件存储后端（为空时使用平台默认存储）
+	FileDedupPolicy         *Tenant_FileDedupPolicy     `protobuf:"varint,35,opt,name=file_dedup_policy,json=fileDedupPolicy,proto3,enum=identity.service.v1.Tenant_FileDedupPolicy,oneof" json:"file_dedup_policy,omitempty"`                   // 文件去重策略（为空时仅在本租户内去重）
+	RecycleBinRetentionDays *uint32                     `protobuf:"varint,36,opt,name=recycle_bin_retention_days,json=recycleBinRetentionDays,proto3,oneof" json:"recycle_bin_retention_days,omitempty"`                                         // 回收站文件保留天数（为空或0时使用平台默认值）
+	CreatedBy               *uint32                     `protobuf:"varint,100,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`                                                                                      // 创建者ID
+	UpdatedBy               *uint32                     `protobuf:"varint,101,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`                                                                                      // 更新者ID
+	DeletedBy               *uint32                     `protobuf:"varint,102,opt,name=deleted_by,json=deletedBy,proto3,oneof" json:"deleted_by,omitempty"`                                                                                      // 删除者用户ID
+	CreatedAt               *timestamppb.Timestamp      `protobuf:"bytes,200,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`                                                                                       // 创建时间
+	UpdatedAt               *timestamppb.Timestamp      `protobuf:"bytes,201,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`                                                                                       // 更新时间
+	DeletedAt               *timestamppb.Timestamp      `protobuf:"bytes,202,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`                                                                                       // 删除时间
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
 func (x *Tenant) Reset() {
@@ -370,6 +481,34 @@ func (x *Tenant) GetAuditStatus() Tenant_AuditStatus {
 	return Tenant_TENANT_AUDIT_STATUS_UNSPECIFIED
 }
 
+func (x *Tenant) GetHighRiskLoginAction() Tenant_HighRiskLoginAction {
+	if x != nil && x.HighRiskLoginAction != nil {
+		return *x.HighRiskLoginAction
+	}
+	return Tenant_HIGH_RISK_LOGIN_ACTION_UNSPECIFIED
+}
+
+func (x *Tenant) GetStorageProvider() v1.OSSProvider {
+	if x != nil && x.StorageProvider != nil {
+		return *x.StorageProvider
+	}
+	return v1.OSSProvider(0)
+}
+
+func (x *Tenant) GetFileDedupPolicy() Tenant_FileDedupPolicy {
+	if x != nil && x.FileDedupPolicy != nil {
+		return *x.FileDedupPolicy
+	}
+	return Tenant_FILE_DEDUP_POLICY_UNSPECIFIED
+}
+
+func (x *Tenant) GetRecycleBinRetentionDays() uint32 {
+	if x != nil && x.RecycleBinRetentionDays != nil {
+		return *x.RecycleBinRetentionDays
+	}
+	return 0
+}
+
 func (x *Tenant) GetCreatedBy() uint32 {
 	if x != nil && x.CreatedBy != nil {
 		return *x.CreatedBy
@@ -1100,7 +1239,7 @@ var File_identity_service_v1_tenant_proto protoreflect.FileDescriptor
 
 const file_identity_service_v1_tenant_proto_rawDesc = "" +
 	"\n" +
-	" identity/service/v1/tenant.proto\x12\x13identity.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1epagination/v1/pagination.proto\x1a\x1eidentity/service/v1/user.proto\"\xc1\x12\n" +
+	" identity/service/v1/tenant.proto\x12\x13identity.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1epagination/v1/pagination.proto\x1a\x1eidentity/service/v1/user.proto\x1a\x1dstorage/service/v1/file.proto\"\xca\x19\n" +
 	"\x06Tenant\x12#\n" +
 	"\x02id\x18\x01 \x01(\rB\x0e\xbaG\v\x92\x02\b租户IDH\x00R\x02id\x88\x01\x01\x12+\n" +
 	"\x04name\x18\x02 \x01(\tB\x12\xbaG\x0f\x92\x02\f租户名称H\x01R\x04name\x88\x01\x01\x12+\n" +
@@ -1121,19 +1260,23 @@ const file_identity_service_v1_tenant_proto_rawDesc = "" +
 	"\x11subscription_plan\x18\x17 \x01(\tBD\xbaGA\x92\x02>订阅套餐（如“企业版1年”“基础版3个月”）H\rR\x10subscriptionPlan\x88\x01\x01\x12:\n" +
 	"\fmember_count\x18\x1e \x01(\x05B\x12\xbaG\x0f\x92\x02\f成员数量H\x0eR\vmemberCount\x88\x01\x01\x12S\n" +
 	"\x06status\x18\x1f \x01(\x0e2\".identity.service.v1.Tenant.StatusB\x12\xbaG\x0f\x92\x02\f租户状态H\x0fR\x06status\x88\x01\x01\x12c\n" +
-	"\faudit_status\x18  \x01(\x0e2'.identity.service.v1.Tenant.AuditStatusB\x12\xbaG\x0f\x92\x02\f审核状态H\x10R\vauditStatus\x88\x01\x01\x125\n" +
+	"\faudit_status\x18  \x01(\x0e2'.identity.service.v1.Tenant.AuditStatusB\x12\xbaG\x0f\x92\x02\f审核状态H\x10R\vauditStatus\x88\x01\x01\x12\x8c\x01\n" +
+	"\x16high_risk_login_action\x18! \x01(\x0e2/.identity.service.v1.Tenant.HighRiskLoginActionB!\xbaG\x1e\x92\x02\x1b高风险登录处置方式H\x11R\x13highRiskLoginAction\x88\x01\x01\x12\x90\x01\n" +
+	"\x10storage_provider\x18\" \x01(\x0e2\x1f.storage.service.v1.OSSProviderB?\xbaG<\x92\x029文件存储后端（为空时使用平台默认存储）H\x12R\x0fstorageProvider\x88\x01\x01\x12\x9d\x01\n" +
+	"\x11file_dedup_policy\x18# \x01(\x0e2+.identity.service.v1.Tenant.FileDedupPolicyB?\xbaG<\x92\x029文件去重策略（为空时仅在本租户内去重）H\x13R\x0ffileDedupPolicy\x88\x01\x01\x12\x8b\x01\n" +
+	"\x1arecycle_bin_retention_days\x18$ \x01(\rBI\xbaGF\x92\x02C回收站文件保留天数（为空或0时使用平台默认值）H\x14R\x17recycleBinRetentionDays\x88\x01\x01\x125\n" +
 	"\n" +
-	"created_by\x18d \x01(\rB\x11\xbaG\x0e\x92\x02\v创建者IDH\x11R\tcreatedBy\x88\x01\x01\x125\n" +
+	"created_by\x18d \x01(\rB\x11\xbaG\x0e\x92\x02\v创建者IDH\x15R\tcreatedBy\x88\x01\x01\x125\n" +
 	"\n" +
-	"updated_by\x18e \x01(\rB\x11\xbaG\x0e\x92\x02\v更新者IDH\x12R\tupdatedBy\x88\x01\x01\x12;\n" +
+	"updated_by\x18e \x01(\rB\x11\xbaG\x0e\x92\x02\v更新者IDH\x16R\tupdatedBy\x88\x01\x01\x12;\n" +
 	"\n" +
-	"deleted_by\x18f \x01(\rB\x17\xbaG\x14\x92\x02\x11删除者用户IDH\x13R\tdeletedBy\x88\x01\x01\x12S\n" +
+	"deleted_by\x18f \x01(\rB\x17\xbaG\x14\x92\x02\x11删除者用户IDH\x17R\tdeletedBy\x88\x01\x01\x12S\n" +
 	"\n" +
-	"created_at\x18\xc8\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f创建时间H\x14R\tcreatedAt\x88\x01\x01\x12S\n" +
+	"created_at\x18\xc8\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f创建时间H\x18R\tcreatedAt\x88\x01\x01\x12S\n" +
 	"\n" +
-	"updated_at\x18\xc9\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f更新时间H\x15R\tupdatedAt\x88\x01\x01\x12S\n" +
+	"updated_at\x18\xc9\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f更新时间H\x19R\tupdatedAt\x88\x01\x01\x12S\n" +
 	"\n" +
-	"deleted_at\x18\xca\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f删除时间H\x16R\tdeletedAt\x88\x01\x01\"2\n" +
+	"deleted_at\x18\xca\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f删除时间H\x1aR\tdeletedAt\x88\x01\x01\"2\n" +
 	"\x06Status\x12\a\n" +
 	"\x03OFF\x10\x00\x12\x06\n" +
 	"\x02ON\x10\x01\x12\v\n" +
@@ -1152,7 +1295,17 @@ const file_identity_service_v1_tenant_proto_rawDesc = "" +
 	"\x1fTENANT_AUDIT_STATUS_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aPENDING\x10\x01\x12\f\n" +
 	"\bAPPROVED\x10\x02\x12\f\n" +
-	"\bREJECTED\x10\x03B\x05\n" +
+	"\bREJECTED\x10\x03\"d\n" +
+	"\x13HighRiskLoginAction\x12&\n" +
+	"\"HIGH_RISK_LOGIN_ACTION_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05ALLOW\x10\x01\x12\t\n" +
+	"\x05BLOCK\x10\x02\x12\x0f\n" +
+	"\vREQUIRE_MFA\x10\x03\"l\n" +
+	"\x0fFileDedupPolicy\x12!\n" +
+	"\x1dFILE_DEDUP_POLICY_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eDEDUP_DISABLED\x10\x01\x12\x10\n" +
+	"\fDEDUP_TENANT\x10\x02\x12\x10\n" +
+	"\fDEDUP_SHARED\x10\x03B\x05\n" +
 	"\x03_idB\a\n" +
 	"\x05_nameB\a\n" +
 	"\x05_codeB\t\n" +
@@ -1169,7 +1322,11 @@ const file_identity_service_v1_tenant_proto_rawDesc = "" +
 	"\x12_subscription_planB\x0f\n" +
 	"\r_member_countB\t\n" +
 	"\a_statusB\x0f\n" +
-	"\r_audit_statusB\r\n" +
+	"\r_audit_statusB\x19\n" +
+	"\x17_high_risk_login_actionB\x13\n" +
+	"\x11_storage_providerB\x14\n" +
+	"\x12_file_dedup_policyB\x1d\n" +
+	"\x1b_recycle_bin_retention_daysB\r\n" +
 	"\v_created_byB\r\n" +
 	"\v_updated_byB\r\n" +
 	"\v_deleted_byB\r\n" +
@@ -1246,72 +1403,78 @@ func file_identity_service_v1_tenant_proto_rawDescGZIP() []byte {
 	return file_identity_service_v1_tenant_proto_rawDescData
 }
 
-var file_identity_service_v1_tenant_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_identity_service_v1_tenant_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
 var file_identity_service_v1_tenant_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_identity_service_v1_tenant_proto_goTypes = []any{
 	(Tenant_Status)(0),                       // 0: identity.service.v1.Tenant.Status
 	(Tenant_Type)(0),                         // 1: identity.service.v1.Tenant.Type
 	(Tenant_AuditStatus)(0),                  // 2: identity.service.v1.Tenant.AuditStatus
-	(*Tenant)(nil),                           // 3: identity.service.v1.Tenant
-	(*ListTenantResponse)(nil),               // 4: identity.service.v1.ListTenantResponse
-	(*GetTenantRequest)(nil),                 // 5: identity.service.v1.GetTenantRequest
-	(*CreateTenantRequest)(nil),              // 6: identity.service.v1.CreateTenantRequest
-	(*UpdateTenantRequest)(nil),              // 7: identity.service.v1.UpdateTenantRequest
-	(*DeleteTenantRequest)(nil),              // 8: identity.service.v1.DeleteTenantRequest
-	(*BatchCreateTenantsRequest)(nil),        // 9: identity.service.v1.BatchCreateTenantsRequest
-	(*BatchCreateTenantsResponse)(nil),       // 10: identity.service.v1.BatchCreateTenantsResponse
-	(*TenantExistsRequest)(nil),              // 11: identity.service.v1.TenantExistsRequest
-	(*TenantExistsResponse)(nil),             // 12: identity.service.v1.TenantExistsResponse
-	(*CreateTenantWithAdminUserRequest)(nil), // 13: identity.service.v1.CreateTenantWithAdminUserRequest
-	(*CountTenantResponse)(nil),              // 14: identity.service.v1.CountTenantResponse
-	(*AssignTenantAdminRequest)(nil),         // 15: identity.service.v1.AssignTenantAdminRequest
-	(*timestamppb.Timestamp)(nil),            // 16: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),            // 17: google.protobuf.FieldMask
-	(*User)(nil),                             // 18: identity.service.v1.User
-	(*v1.PagingRequest)(nil),                 // 19: pagination.PagingRequest
-	(*emptypb.Empty)(nil),                    // 20: google.protobuf.Empty
+	(Tenant_HighRiskLoginAction)(0),          // 3: identity.service.v1.Tenant.HighRiskLoginAction
+	(Tenant_FileDedupPolicy)(0),              // 4: identity.service.v1.Tenant.FileDedupPolicy
+	(*Tenant)(nil),                           // 5: identity.service.v1.Tenant
+	(*ListTenantResponse)(nil),               // 6: identity.service.v1.ListTenantResponse
+	(*GetTenantRequest)(nil),                 // 7: identity.service.v1.GetTenantRequest
+	(*CreateTenantRequest)(nil),              // 8: identity.service.v1.CreateTenantRequest
+	(*UpdateTenantRequest)(nil),              // 9: identity.service.v1.UpdateTenantRequest
+	(*DeleteTenantRequest)(nil),              // 10: identity.service.v1.DeleteTenantRequest
+	(*BatchCreateTenantsRequest)(nil),        // 11: identity.service.v1.BatchCreateTenantsRequest
+	(*BatchCreateTenantsResponse)(nil),       // 12: identity.service.v1.BatchCreateTenantsResponse
+	(*TenantExistsRequest)(nil),              // 13: identity.service.v1.TenantExistsRequest
+	(*TenantExistsResponse)(nil),             // 14: identity.service.v1.TenantExistsResponse
+	(*CreateTenantWithAdminUserRequest)(nil), // 15: identity.service.v1.CreateTenantWithAdminUserRequest
+	(*CountTenantResponse)(nil),              // 16: identity.service.v1.CountTenantResponse
+	(*AssignTenantAdminRequest)(nil),         // 17: identity.service.v1.AssignTenantAdminRequest
+	(*timestamppb.Timestamp)(nil),            // 18: google.protobuf.Timestamp
+	(v1.OSSProvider)(0),                      // 19: storage.service.v1.OSSProvider
+	(*fieldmaskpb.FieldMask)(nil),            // 20: google.protobuf.FieldMask
+	(*User)(nil),                             // 21: identity.service.v1.User
+	(*v11.PagingRequest)(nil),                // 22: pagination.PagingRequest
+	(*emptypb.Empty)(nil),                    // 23: google.protobuf.Empty
 }
 var file_identity_service_v1_tenant_proto_depIdxs = []int32{
 	1,  // 0: identity.service.v1.Tenant.type:type_name -> identity.service.v1.Tenant.Type
-	16, // 1: identity.service.v1.Tenant.subscription_at:type_name -> google.protobuf.Timestamp
-	16, // 2: identity.service.v1.Tenant.unsubscribe_at:type_name -> google.protobuf.Timestamp
-	16, // 3: identity.service.v1.Tenant.expired_at:type_name -> google.protobuf.Timestamp
+	18, // 1: identity.service.v1.Tenant.subscription_at:type_name -> google.protobuf.Timestamp
+	18, // 2: identity.service.v1.Tenant.unsubscribe_at:type_name -> google.protobuf.Timestamp
+	18, // 3: identity.service.v1.Tenant.expired_at:type_name -> google.protobuf.Timestamp
 	0,  // 4: identity.service.v1.Tenant.status:type_name -> identity.service.v1.Tenant.Status
 	2,  // 5: identity.service.v1.Tenant.audit_status:type_name -> identity.service.v1.Tenant.AuditStatus
-	16, // 6: identity.service.v1.Tenant.created_at:type_name -> google.protobuf.Timestamp
-	16, // 7: identity.service.v1.Tenant.updated_at:type_name -> google.protobuf.Timestamp
-	16, // 8: identity.service.v1.Tenant.deleted_at:type_name -> google.protobuf.Timestamp
-	3,  // 9: identity.service.v1.ListTenantResponse.items:type_name -> identity.service.v1.Tenant
-	17, // 10: identity.service.v1.GetTenantRequest.view_mask:type_name -> google.protobuf.FieldMask
-	3,  // 11: identity.service.v1.CreateTenantRequest.data:type_name -> identity.service.v1.Tenant
-	3,  // 12: identity.service.v1.UpdateTenantRequest.data:type_name -> identity.service.v1.Tenant
-	17, // 13: identity.service.v1.UpdateTenantRequest.update_mask:type_name -> google.protobuf.FieldMask
-	3,  // 14: identity.service.v1.BatchCreateTenantsRequest.items:type_name -> identity.service.v1.Tenant
-	3,  // 15: identity.service.v1.CreateTenantWithAdminUserRequest.tenant:type_name -> identity.service.v1.Tenant
-	18, // 16: identity.service.v1.CreateTenantWithAdminUserRequest.user:type_name -> identity.service.v1.User
-	19, // 17: identity.service.v1.TenantService.List:input_type -> pagination.PagingRequest
-	19, // 18: identity.service.v1.TenantService.Count:input_type -> pagination.PagingRequest
-	5,  // 19: identity.service.v1.TenantService.Get:input_type -> identity.service.v1.GetTenantRequest
-	9,  // 20: identity.service.v1.TenantService.BatchCreate:input_type -> identity.service.v1.BatchCreateTenantsRequest
-	6,  // 21: identity.service.v1.TenantService.Create:input_type -> identity.service.v1.CreateTenantRequest
-	7,  // 22: identity.service.v1.TenantService.Update:input_type -> identity.service.v1.UpdateTenantRequest
-	8,  // 23: identity.service.v1.TenantService.Delete:input_type -> identity.service.v1.DeleteTenantRequest
-	11, // 24: identity.service.v1.TenantService.TenantExists:input_type -> identity.service.v1.TenantExistsRequest
-	15, // 25: identity.service.v1.TenantService.AssignTenantAdmin:input_type -> identity.service.v1.AssignTenantAdminRequest
-	4,  // 26: identity.service.v1.TenantService.List:output_type -> identity.service.v1.ListTenantResponse
-	14, // 27: identity.service.v1.TenantService.Count:output_type -> identity.service.v1.CountTenantResponse
-	3,  // 28: identity.service.v1.TenantService.Get:output_type -> identity.service.v1.Tenant
-	10, // 29: identity.service.v1.TenantService.BatchCreate:output_type -> identity.service.v1.BatchCreateTenantsResponse
-	20, // 30: identity.service.v1.TenantService.Create:output_type -> google.protobuf.Empty
-	20, // 31: identity.service.v1.TenantService.Update:output_type -> google.protobuf.Empty
-	20, // 32: identity.service.v1.TenantService.Delete:output_type -> google.protobuf.Empty
-	12, // 33: identity.service.v1.TenantService.TenantExists:output_type -> identity.service.v1.TenantExistsResponse
-	20, // 34: identity.service.v1.TenantService.AssignTenantAdmin:output_type -> google.protobuf.Empty
-	26, // [26:35] is the sub-list for method output_type
-	17, // [17:26] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	3,  // 6: identity.service.v1.Tenant.high_risk_login_action:type_name -> identity.service.v1.Tenant.HighRiskLoginAction
+	19, // 7: identity.service.v1.Tenant.storage_provider:type_name -> storage.service.v1.OSSProvider
+	4,  // 8: identity.service.v1.Tenant.file_dedup_policy:type_name -> identity.service.v1.Tenant.FileDedupPolicy
+	18, // 9: identity.service.v1.Tenant.created_at:type_name -> google.protobuf.Timestamp
+	18, // 10: identity.service.v1.Tenant.updated_at:type_name -> google.protobuf.Timestamp
+	18, // 11: identity.service.v1.Tenant.deleted_at:type_name -> google.protobuf.Timestamp
+	5,  // 12: identity.service.v1.ListTenantResponse.items:type_name -> identity.service.v1.Tenant
+	20, // 13: identity.service.v1.GetTenantRequest.view_mask:type_name -> google.protobuf.FieldMask
+	5,  // 14: identity.service.v1.CreateTenantRequest.data:type_name -> identity.service.v1.Tenant
+	5,  // 15: identity.service.v1.UpdateTenantRequest.data:type_name -> identity.service.v1.Tenant
+	20, // 16: identity.service.v1.UpdateTenantRequest.update_mask:type_name -> google.protobuf.FieldMask
+	5,  // 17: identity.service.v1.BatchCreateTenantsRequest.items:type_name -> identity.service.v1.Tenant
+	5,  // 18: identity.service.v1.CreateTenantWithAdminUserRequest.tenant:type_name -> identity.service.v1.Tenant
+	21, // 19: identity.service.v1.CreateTenantWithAdminUserRequest.user:type_name -> identity.service.v1.User
+	22, // 20: identity.service.v1.TenantService.List:input_type -> pagination.PagingRequest
+	22, // 21: identity.service.v1.TenantService.Count:input_type -> pagination.PagingRequest
+	7,  // 22: identity.service.v1.TenantService.Get:input_type -> identity.service.v1.GetTenantRequest
+	11, // 23: identity.service.v1.TenantService.BatchCreate:input_type -> identity.service.v1.BatchCreateTenantsRequest
+	8,  // 24: identity.service.v1.TenantService.Create:input_type -> identity.service.v1.CreateTenantRequest
+	9,  // 25: identity.service.v1.TenantService.Update:input_type -> identity.service.v1.UpdateTenantRequest
+	10, // 26: identity.service.v1.TenantService.Delete:input_type -> identity.service.v1.DeleteTenantRequest
+	13, // 27: identity.service.v1.TenantService.TenantExists:input_type -> identity.service.v1.TenantExistsRequest
+	17, // 28: identity.service.v1.TenantService.AssignTenantAdmin:input_type -> identity.service.v1.AssignTenantAdminRequest
+	6,  // 29: identity.service.v1.TenantService.List:output_type -> identity.service.v1.ListTenantResponse
+	16, // 30: identity.service.v1.TenantService.Count:output_type -> identity.service.v1.CountTenantResponse
+	5,  // 31: identity.service.v1.TenantService.Get:output_type -> identity.service.v1.Tenant
+	12, // 32: identity.service.v1.TenantService.BatchCreate:output_type -> identity.service.v1.BatchCreateTenantsResponse
+	23, // 33: identity.service.v1.TenantService.Create:output_type -> google.protobuf.Empty
+	23, // 34: identity.service.v1.TenantService.Update:output_type -> google.protobuf.Empty
+	23, // 35: identity.service.v1.TenantService.Delete:output_type -> google.protobuf.Empty
+	14, // 36: identity.service.v1.TenantService.TenantExists:output_type -> identity.service.v1.TenantExistsResponse
+	23, // 37: identity.service.v1.TenantService.AssignTenantAdmin:output_type -> google.protobuf.Empty
+	29, // [29:38] is the sub-list for method output_type
+	20, // [20:29] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_identity_service_v1_tenant_proto_init() }
@@ -1335,7 +1498,7 @@ func file_identity_service_v1_tenant_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_identity_service_v1_tenant_proto_rawDesc), len(file_identity_service_v1_tenant_proto_rawDesc)),
-			NumEnums:      3,
+			NumEnums:      5,
 			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
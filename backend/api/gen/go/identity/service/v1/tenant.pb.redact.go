@@ -7,6 +7,7 @@ import (
 	context "context"
 	redact "github.com/menta2k/protoc-gen-redact/v3/redact/v3"
 	pagination "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	storagepb "go-wind-admin/api/gen/go/storage/service/v1"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -26,6 +27,7 @@ var (
 	_ fieldmaskpb.FieldMask
 	_ timestamppb.Timestamp
 	_ pagination.Sorting
+	_ storagepb.File
 )
 
 // RegisterRedactedTenantServiceServer wraps the TenantServiceServer with the redacted server and registers the service in GRPC
@@ -185,6 +187,14 @@ func (x *Tenant) Redact() string {
 
 	// Safe field: AuditStatus
 
+	// Safe field: HighRiskLoginAction
+
+	// Safe field: StorageProvider
+
+	// Safe field: FileDedupPolicy
+
+	// Safe field: RecycleBinRetentionDays
+
 	// Safe field: CreatedBy
 
 	// Safe field: UpdatedBy
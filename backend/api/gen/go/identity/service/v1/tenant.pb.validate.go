@@ -17,6 +17,8 @@ import (
 	"unicode/utf8"
 
 	"google.golang.org/protobuf/types/known/anypb"
+
+	storagepb "go-wind-admin/api/gen/go/storage/service/v1"
 )
 
 // ensure the imports are used
@@ -33,6 +35,8 @@ var (
 	_ = (*mail.Address)(nil)
 	_ = anypb.Any{}
 	_ = sort.Sort
+
+	_ = storagepb.OSSProvider(0)
 )
 
 // Validate checks the field values on Tenant with the rules defined in the
@@ -211,6 +215,22 @@ func (m *Tenant) validate(all bool) error {
 		// no validation rules for AuditStatus
 	}
 
+	if m.HighRiskLoginAction != nil {
+		// no validation rules for HighRiskLoginAction
+	}
+
+	if m.StorageProvider != nil {
+		// no validation rules for StorageProvider
+	}
+
+	if m.FileDedupPolicy != nil {
+		// no validation rules for FileDedupPolicy
+	}
+
+	if m.RecycleBinRetentionDays != nil {
+		// no validation rules for RecycleBinRetentionDays
+	}
+
 	if m.CreatedBy != nil {
 		// no validation rules for CreatedBy
 	}
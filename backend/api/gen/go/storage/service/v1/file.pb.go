@@ -98,31 +98,98 @@ func (OSSProvider) EnumDescriptor() ([]byte, []int) {
 	return file_storage_service_v1_file_proto_rawDescGZIP(), []int{0}
 }
 
+// 恶意文件扫描状态
+type File_ScanStatus int32
+
+const (
+	File_SCAN_STATUS_UNSPECIFIED File_ScanStatus = 0 // 未指定（启用扫描前上传的文件）
+	File_SCAN_PENDING            File_ScanStatus = 1 // 等待扫描
+	File_SCAN_CLEAN              File_ScanStatus = 2 // 未检出恶意内容
+	File_SCAN_INFECTED           File_ScanStatus = 3 // 检出恶意内容，已隔离
+	File_SCAN_FAILED             File_ScanStatus = 4 // 扫描失败
+	File_SCAN_SKIPPED            File_ScanStatus = 5 // 未启用扫描
+)
+
+// Enum value maps for File_ScanStatus.
+var (
+	File_ScanStatus_name = map[int32]string{
+		0: "SCAN_STATUS_UNSPECIFIED",
+		1: "SCAN_PENDING",
+		2: "SCAN_CLEAN",
+		3: "SCAN_INFECTED",
+		4: "SCAN_FAILED",
+		5: "SCAN_SKIPPED",
+	}
+	File_ScanStatus_value = map[string]int32{
+		"SCAN_STATUS_UNSPECIFIED": 0,
+		"SCAN_PENDING":            1,
+		"SCAN_CLEAN":              2,
+		"SCAN_INFECTED":           3,
+		"SCAN_FAILED":             4,
+		"SCAN_SKIPPED":            5,
+	}
+)
+
+func (x File_ScanStatus) Enum() *File_ScanStatus {
+	p := new(File_ScanStatus)
+	*p = x
+	return p
+}
+
+func (x File_ScanStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (File_ScanStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_storage_service_v1_file_proto_enumTypes[1].Descriptor()
+}
+
+func (File_ScanStatus) Type() protoreflect.EnumType {
+	return &file_storage_service_v1_file_proto_enumTypes[1]
+}
+
+func (x File_ScanStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use File_ScanStatus.Descriptor instead.
+func (File_ScanStatus) EnumDescriptor() ([]byte, []int) {
+	return file_storage_service_v1_file_proto_rawDescGZIP(), []int{0, 0}
+}
+
 // 文件
 type File struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`                                                 // 文件ID
-	Provider      *OSSProvider           `protobuf:"varint,2,opt,name=provider,proto3,enum=storage.service.v1.OSSProvider,oneof" json:"provider,omitempty"` // OSS供应商
-	BucketName    *string                `protobuf:"bytes,3,opt,name=bucket_name,json=bucketName,proto3,oneof" json:"bucket_name,omitempty"`                // 存储桶名称
-	FileDirectory *string                `protobuf:"bytes,4,opt,name=file_directory,json=fileDirectory,proto3,oneof" json:"file_directory,omitempty"`       // 文件目录
-	FileGuid      *string                `protobuf:"bytes,5,opt,name=file_guid,json=fileGuid,proto3,oneof" json:"file_guid,omitempty"`                      // 文件Guid
-	SaveFileName  *string                `protobuf:"bytes,6,opt,name=save_file_name,json=saveFileName,proto3,oneof" json:"save_file_name,omitempty"`        // 实际存储文件名（防止在服务器文件系统发生文件冲突）
-	FileName      *string                `protobuf:"bytes,7,opt,name=file_name,json=fileName,proto3,oneof" json:"file_name,omitempty"`                      // 原始文件名
-	Extension     *string                `protobuf:"bytes,8,opt,name=extension,proto3,oneof" json:"extension,omitempty"`                                    // 文件扩展名
-	Size          *uint64                `protobuf:"varint,9,opt,name=size,proto3,oneof" json:"size,omitempty"`                                             // 文件字节长度
-	SizeFormat    *string                `protobuf:"bytes,10,opt,name=size_format,json=sizeFormat,proto3,oneof" json:"size_format,omitempty"`               // 格式化后的文件长度字符串
-	LinkUrl       *string                `protobuf:"bytes,11,opt,name=link_url,json=linkUrl,proto3,oneof" json:"link_url,omitempty"`                        // 链接地址
-	ContentHash   *string                `protobuf:"bytes,12,opt,name=content_hash,json=contentHash,proto3,oneof" json:"content_hash,omitempty"`            // 文件内容hash值
-	TenantId      *uint32                `protobuf:"varint,40,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`                    // 租户ID，0代表系统全局角色
-	TenantName    *string                `protobuf:"bytes,41,opt,name=tenant_name,json=tenantName,proto3,oneof" json:"tenant_name,omitempty"`               // 租户名称
-	CreatedBy     *uint32                `protobuf:"varint,100,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`                // 创建者用户ID
-	UpdatedBy     *uint32                `protobuf:"varint,101,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`                // 更新者用户ID
-	DeletedBy     *uint32                `protobuf:"varint,102,opt,name=deleted_by,json=deletedBy,proto3,oneof" json:"deleted_by,omitempty"`                // 删除者用户ID
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,200,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`                 // 创建时间
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,201,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`                 // 更新时间
-	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,202,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`                 // 删除时间
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`                                                                            // 文件ID
+	Provider        *OSSProvider           `protobuf:"varint,2,opt,name=provider,proto3,enum=storage.service.v1.OSSProvider,oneof" json:"provider,omitempty"`                            // OSS供应商
+	BucketName      *string                `protobuf:"bytes,3,opt,name=bucket_name,json=bucketName,proto3,oneof" json:"bucket_name,omitempty"`                                           // 存储桶名称
+	FileDirectory   *string                `protobuf:"bytes,4,opt,name=file_directory,json=fileDirectory,proto3,oneof" json:"file_directory,omitempty"`                                  // 文件目录
+	FileGuid        *string                `protobuf:"bytes,5,opt,name=file_guid,json=fileGuid,proto3,oneof" json:"file_guid,omitempty"`                                                 // 文件Guid
+	SaveFileName    *string                `protobuf:"bytes,6,opt,name=save_file_name,json=saveFileName,proto3,oneof" json:"save_file_name,omitempty"`                                   // 实际存储文件名（防止在服务器文件系统发生文件冲突）
+	FileName        *string                `protobuf:"bytes,7,opt,name=file_name,json=fileName,proto3,oneof" json:"file_name,omitempty"`                                                 // 原始文件名
+	Extension       *string                `protobuf:"bytes,8,opt,name=extension,proto3,oneof" json:"extension,omitempty"`                                                               // 文件扩展名
+	Size            *uint64                `protobuf:"varint,9,opt,name=size,proto3,oneof" json:"size,omitempty"`                                                                        // 文件字节长度
+	SizeFormat      *string                `protobuf:"bytes,10,opt,name=size_format,json=sizeFormat,proto3,oneof" json:"size_format,omitempty"`                                          // 格式化后的文件长度字符串
+	LinkUrl         *string                `protobuf:"bytes,11,opt,name=link_url,json=linkUrl,proto3,oneof" json:"link_url,omitempty"`                                                   // 链接地址
+	ContentHash     *string                `protobuf:"bytes,12,opt,name=content_hash,json=contentHash,proto3,oneof" json:"content_hash,omitempty"`                                       // 文件内容hash值
+	StorageObjectId *uint32                `protobuf:"varint,13,opt,name=storage_object_id,json=storageObjectId,proto3,oneof" json:"storage_object_id,omitempty"`                        // 物理对象ID（去重后多个文件可引用同一物理对象）
+	ScanStatus      *File_ScanStatus       `protobuf:"varint,14,opt,name=scan_status,json=scanStatus,proto3,enum=storage.service.v1.File_ScanStatus,oneof" json:"scan_status,omitempty"` // 恶意文件扫描状态
+	ScanSignature   *string                `protobuf:"bytes,15,opt,name=scan_signature,json=scanSignature,proto3,oneof" json:"scan_signature,omitempty"`                                 // 检出的恶意特征名称或扫描失败原因
+	ScannedAt       *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=scanned_at,json=scannedAt,proto3,oneof" json:"scanned_at,omitempty"`                                             // 扫描时间
+	Version         *uint32                `protobuf:"varint,17,opt,name=version,proto3,oneof" json:"version,omitempty"`                                                                 // 版本号
+	IsLatest        *bool                  `protobuf:"varint,18,opt,name=is_latest,json=isLatest,proto3,oneof" json:"is_latest,omitempty"`                                               // 是否为当前版本
+	VersionGroup    *string                `protobuf:"bytes,19,opt,name=version_group,json=versionGroup,proto3,oneof" json:"version_group,omitempty"`                                    // 版本组标识
+	ObjectName      *string                `protobuf:"bytes,20,opt,name=object_name,json=objectName,proto3,oneof" json:"object_name,omitempty"`                                          // 物理对象名
+	TenantId        *uint32                `protobuf:"varint,40,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`                                               // 租户ID，0代表系统全局角色
+	TenantName      *string                `protobuf:"bytes,41,opt,name=tenant_name,json=tenantName,proto3,oneof" json:"tenant_name,omitempty"`                                          // 租户名称
+	CreatedBy       *uint32                `protobuf:"varint,100,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`                                           // 创建者用户ID
+	UpdatedBy       *uint32                `protobuf:"varint,101,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`                                           // 更新者用户ID
+	DeletedBy       *uint32                `protobuf:"varint,102,opt,name=deleted_by,json=deletedBy,proto3,oneof" json:"deleted_by,omitempty"`                                           // 删除者用户ID
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,200,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`                                            // 创建时间
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,201,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`                                            // 更新时间
+	DeletedAt       *timestamppb.Timestamp `protobuf:"bytes,202,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`                                            // 删除时间
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *File) Reset() {
@@ -239,6 +306,62 @@ func (x *File) GetContentHash() string {
 	return ""
 }
 
+func (x *File) GetStorageObjectId() uint32 {
+	if x != nil && x.StorageObjectId != nil {
+		return *x.StorageObjectId
+	}
+	return 0
+}
+
+func (x *File) GetScanStatus() File_ScanStatus {
+	if x != nil && x.ScanStatus != nil {
+		return *x.ScanStatus
+	}
+	return File_SCAN_STATUS_UNSPECIFIED
+}
+
+func (x *File) GetScanSignature() string {
+	if x != nil && x.ScanSignature != nil {
+		return *x.ScanSignature
+	}
+	return ""
+}
+
+func (x *File) GetScannedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScannedAt
+	}
+	return nil
+}
+
+func (x *File) GetVersion() uint32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *File) GetIsLatest() bool {
+	if x != nil && x.IsLatest != nil {
+		return *x.IsLatest
+	}
+	return false
+}
+
+func (x *File) GetVersionGroup() string {
+	if x != nil && x.VersionGroup != nil {
+		return *x.VersionGroup
+	}
+	return ""
+}
+
+func (x *File) GetObjectName() string {
+	if x != nil && x.ObjectName != nil {
+		return *x.ObjectName
+	}
+	return ""
+}
+
 func (x *File) GetTenantId() uint32 {
 	if x != nil && x.TenantId != nil {
 		return *x.TenantId
@@ -648,11 +771,154 @@ func (x *CountFileResponse) GetCount() uint64 {
 	return 0
 }
 
+// 查询文件版本历史 - 请求
+type ListFileVersionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 文件任一版本的ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFileVersionsRequest) Reset() {
+	*x = ListFileVersionsRequest{}
+	mi := &file_storage_service_v1_file_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFileVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFileVersionsRequest) ProtoMessage() {}
+
+func (x *ListFileVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_service_v1_file_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFileVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListFileVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_storage_service_v1_file_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListFileVersionsRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 文件版本 - 请求
+type FileVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`           // 文件任一版本的ID
+	Version       uint32                 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // 版本号
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileVersionRequest) Reset() {
+	*x = FileVersionRequest{}
+	mi := &file_storage_service_v1_file_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileVersionRequest) ProtoMessage() {}
+
+func (x *FileVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_service_v1_file_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileVersionRequest.ProtoReflect.Descriptor instead.
+func (*FileVersionRequest) Descriptor() ([]byte, []int) {
+	return file_storage_service_v1_file_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *FileVersionRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FileVersionRequest) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// 回收站文件 - 请求
+type RecycledFileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 回收站中文件的ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecycledFileRequest) Reset() {
+	*x = RecycledFileRequest{}
+	mi := &file_storage_service_v1_file_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecycledFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecycledFileRequest) ProtoMessage() {}
+
+func (x *RecycledFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_service_v1_file_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecycledFileRequest.ProtoReflect.Descriptor instead.
+func (*RecycledFileRequest) Descriptor() ([]byte, []int) {
+	return file_storage_service_v1_file_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RecycledFileRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
 var File_storage_service_v1_file_proto protoreflect.FileDescriptor
 
 const file_storage_service_v1_file_proto_rawDesc = "" +
 	"\n" +
-	"\x1dstorage/service/v1/file.proto\x12\x12storage.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a google/protobuf/field_mask.proto\x1a\x1epagination/v1/pagination.proto\"\x90\x0e\n" +
+	"\x1dstorage/service/v1/file.proto\x12\x12storage.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a google/protobuf/field_mask.proto\x1a\x1epagination/v1/pagination.proto\"\xf0\x16\n" +
 	"\x04File\x12&\n" +
 	"\x02id\x18\x01 \x01(\rB\x11\xe0A\x01\xbaG\v\x92\x02\b文件IDH\x00R\x02id\x88\x01\x01\x12T\n" +
 	"\bprovider\x18\x02 \x01(\x0e2\x1f.storage.service.v1.OSSProviderB\x12\xbaG\x0f\x92\x02\fOSS供应商H\x01R\bprovider\x88\x01\x01\x12;\n" +
@@ -670,22 +936,42 @@ const file_storage_service_v1_file_proto_rawDesc = "" +
 	"sizeFormat\x88\x01\x01\x122\n" +
 	"\blink_url\x18\v \x01(\tB\x12\xbaG\x0f\x92\x02\f链接地址H\n" +
 	"R\alinkUrl\x88\x01\x01\x12A\n" +
-	"\fcontent_hash\x18\f \x01(\tB\x19\xbaG\x16\x92\x02\x13文件内容hash值H\vR\vcontentHash\x88\x01\x01\x12L\n" +
-	"\ttenant_id\x18( \x01(\rB*\xbaG'\x92\x02$租户ID，0代表系统全局角色H\fR\btenantId\x88\x01\x01\x128\n" +
-	"\vtenant_name\x18) \x01(\tB\x12\xbaG\x0f\x92\x02\f租户名称H\rR\n" +
+	"\fcontent_hash\x18\f \x01(\tB\x19\xbaG\x16\x92\x02\x13文件内容hash值H\vR\vcontentHash\x88\x01\x01\x12}\n" +
+	"\x11storage_object_id\x18\r \x01(\rBL\xbaGI\x18\x01\x92\x02D物理对象ID（去重后多个文件可引用同一物理对象）H\fR\x0fstorageObjectId\x88\x01\x01\x12i\n" +
+	"\vscan_status\x18\x0e \x01(\x0e2#.storage.service.v1.File.ScanStatusB\x1e\xbaG\x1b\x92\x02\x18恶意文件扫描状态H\rR\n" +
+	"scanStatus\x88\x01\x01\x12b\n" +
+	"\x0escan_signature\x18\x0f \x01(\tB6\xbaG3\x92\x020检出的恶意特征名称或扫描失败原因H\x0eR\rscanSignature\x88\x01\x01\x12R\n" +
+	"\n" +
+	"scanned_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f扫描时间H\x0fR\tscannedAt\x88\x01\x01\x12l\n" +
+	"\aversion\x18\x11 \x01(\rBM\xbaGJ\x18\x01\x92\x02E版本号，同一目录下同名文件的每次上传为一个版本H\x10R\aversion\x88\x01\x01\x12?\n" +
+	"\tis_latest\x18\x12 \x01(\bB\x1d\xbaG\x1a\x18\x01\x92\x02\x15是否为当前版本H\x11R\bisLatest\x88\x01\x01\x12e\n" +
+	"\rversion_group\x18\x13 \x01(\tB;\xbaG8\x18\x01\x92\x023版本组标识，同一文件的全部版本相同H\x12R\fversionGroup\x88\x01\x01\x12\x9d\x01\n" +
+	"\vobject_name\x18\x14 \x01(\tBw\xbaGt\x18\x01\x92\x02o物理对象名，去重或隔离后可与文件目录不同，为空时由文件目录和保存文件名组成H\x13R\n" +
+	"objectName\x88\x01\x01\x12L\n" +
+	"\ttenant_id\x18( \x01(\rB*\xbaG'\x92\x02$租户ID，0代表系统全局角色H\x14R\btenantId\x88\x01\x01\x128\n" +
+	"\vtenant_name\x18) \x01(\tB\x12\xbaG\x0f\x92\x02\f租户名称H\x15R\n" +
 	"tenantName\x88\x01\x01\x12;\n" +
 	"\n" +
-	"created_by\x18d \x01(\rB\x17\xbaG\x14\x92\x02\x11创建者用户IDH\x0eR\tcreatedBy\x88\x01\x01\x12;\n" +
+	"created_by\x18d \x01(\rB\x17\xbaG\x14\x92\x02\x11创建者用户IDH\x16R\tcreatedBy\x88\x01\x01\x12;\n" +
+	"\n" +
+	"updated_by\x18e \x01(\rB\x17\xbaG\x14\x92\x02\x11更新者用户IDH\x17R\tupdatedBy\x88\x01\x01\x12;\n" +
+	"\n" +
+	"deleted_by\x18f \x01(\rB\x17\xbaG\x14\x92\x02\x11删除者用户IDH\x18R\tdeletedBy\x88\x01\x01\x12S\n" +
 	"\n" +
-	"updated_by\x18e \x01(\rB\x17\xbaG\x14\x92\x02\x11更新者用户IDH\x0fR\tupdatedBy\x88\x01\x01\x12;\n" +
+	"created_at\x18\xc8\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f创建时间H\x19R\tcreatedAt\x88\x01\x01\x12S\n" +
 	"\n" +
-	"deleted_by\x18f \x01(\rB\x17\xbaG\x14\x92\x02\x11删除者用户IDH\x10R\tdeletedBy\x88\x01\x01\x12S\n" +
+	"updated_at\x18\xc9\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f更新时间H\x1aR\tupdatedAt\x88\x01\x01\x12S\n" +
 	"\n" +
-	"created_at\x18\xc8\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f创建时间H\x11R\tcreatedAt\x88\x01\x01\x12S\n" +
+	"deleted_at\x18\xca\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f删除时间H\x1bR\tdeletedAt\x88\x01\x01\"\x81\x01\n" +
 	"\n" +
-	"updated_at\x18\xc9\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f更新时间H\x12R\tupdatedAt\x88\x01\x01\x12S\n" +
+	"ScanStatus\x12\x1b\n" +
+	"\x17SCAN_STATUS_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fSCAN_PENDING\x10\x01\x12\x0e\n" +
 	"\n" +
-	"deleted_at\x18\xca\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f删除时间H\x13R\tdeletedAt\x88\x01\x01B\x05\n" +
+	"SCAN_CLEAN\x10\x02\x12\x11\n" +
+	"\rSCAN_INFECTED\x10\x03\x12\x0f\n" +
+	"\vSCAN_FAILED\x10\x04\x12\x10\n" +
+	"\fSCAN_SKIPPED\x10\x05B\x05\n" +
 	"\x03_idB\v\n" +
 	"\t_providerB\x0e\n" +
 	"\f_bucket_nameB\x11\n" +
@@ -700,7 +986,17 @@ const file_storage_service_v1_file_proto_rawDesc = "" +
 	"\x05_sizeB\x0e\n" +
 	"\f_size_formatB\v\n" +
 	"\t_link_urlB\x0f\n" +
-	"\r_content_hashB\f\n" +
+	"\r_content_hashB\x14\n" +
+	"\x12_storage_object_idB\x0e\n" +
+	"\f_scan_statusB\x11\n" +
+	"\x0f_scan_signatureB\r\n" +
+	"\v_scanned_atB\n" +
+	"\n" +
+	"\b_versionB\f\n" +
+	"\n" +
+	"_is_latestB\x10\n" +
+	"\x0e_version_groupB\x0e\n" +
+	"\f_object_nameB\f\n" +
 	"\n" +
 	"_tenant_idB\x0e\n" +
 	"\f_tenant_nameB\r\n" +
@@ -736,7 +1032,14 @@ const file_storage_service_v1_file_proto_rawDesc = "" +
 	"\n" +
 	"\bquery_by\")\n" +
 	"\x11CountFileResponse\x12\x14\n" +
-	"\x05count\x18\x01 \x01(\x04R\x05count*~\n" +
+	"\x05count\x18\x01 \x01(\x04R\x05count\"H\n" +
+	"\x17ListFileVersionsRequest\x12-\n" +
+	"\x02id\x18\x01 \x01(\rB\x1d\xbaG\x1a\x92\x02\x17文件任一版本的IDR\x02id\"n\n" +
+	"\x12FileVersionRequest\x12-\n" +
+	"\x02id\x18\x01 \x01(\rB\x1d\xbaG\x1a\x92\x02\x17文件任一版本的IDR\x02id\x12)\n" +
+	"\aversion\x18\x02 \x01(\rB\x0f\xbaG\f\x92\x02\t版本号R\aversion\"D\n" +
+	"\x13RecycledFileRequest\x12-\n" +
+	"\x02id\x18\x01 \x01(\rB\x1d\xbaG\x1a\x92\x02\x17回收站中文件的IDR\x02id*~\n" +
 	"\vOSSProvider\x12\t\n" +
 	"\x05MINIO\x10\x00\x12\n" +
 	"\n" +
@@ -751,14 +1054,20 @@ const file_storage_service_v1_file_proto_rawDesc = "" +
 	"\n" +
 	"\x06HUAWEI\x10\b\x12\t\n" +
 	"\x05LOCAL\x10\n" +
-	"2\xcd\x03\n" +
+	"2\xe7\a\n" +
 	"\vFileService\x12I\n" +
 	"\x04List\x12\x19.pagination.PagingRequest\x1a$.storage.service.v1.ListFileResponse\"\x00\x12K\n" +
 	"\x05Count\x12\x19.pagination.PagingRequest\x1a%.storage.service.v1.CountFileResponse\"\x00\x12E\n" +
 	"\x03Get\x12\".storage.service.v1.GetFileRequest\x1a\x18.storage.service.v1.File\"\x00\x12I\n" +
 	"\x06Create\x12%.storage.service.v1.CreateFileRequest\x1a\x16.google.protobuf.Empty\"\x00\x12I\n" +
 	"\x06Update\x12%.storage.service.v1.UpdateFileRequest\x1a\x16.google.protobuf.Empty\"\x00\x12I\n" +
-	"\x06Delete\x12%.storage.service.v1.DeleteFileRequest\x1a\x16.google.protobuf.Empty\"\x00B\xc4\x01\n" +
+	"\x06Delete\x12%.storage.service.v1.DeleteFileRequest\x1a\x16.google.protobuf.Empty\"\x00\x12c\n" +
+	"\fListVersions\x12+.storage.service.v1.ListFileVersionsRequest\x1a$.storage.service.v1.ListFileResponse\"\x00\x12T\n" +
+	"\x0eRestoreVersion\x12&.storage.service.v1.FileVersionRequest\x1a\x18.storage.service.v1.File\"\x00\x12P\n" +
+	"\fPurgeVersion\x12&.storage.service.v1.FileVersionRequest\x1a\x16.google.protobuf.Empty\"\x00\x12S\n" +
+	"\x0eListRecycleBin\x12\x19.pagination.PagingRequest\x1a$.storage.service.v1.ListFileResponse\"\x00\x12\\\n" +
+	"\x15RestoreFromRecycleBin\x12'.storage.service.v1.RecycledFileRequest\x1a\x18.storage.service.v1.File\"\x00\x12X\n" +
+	"\x13PurgeFromRecycleBin\x12'.storage.service.v1.RecycledFileRequest\x1a\x16.google.protobuf.Empty\"\x00B\xc4\x01\n" +
 	"\x16com.storage.service.v1B\tFileProtoP\x01Z5go-wind-admin/api/gen/go/storage/service/v1;storagepb\xa2\x02\x03SSX\xaa\x02\x12Storage.Service.V1\xca\x02\x12Storage\\Service\\V1\xe2\x02\x1eStorage\\Service\\V1\\GPBMetadata\xea\x02\x14Storage::Service::V1b\x06proto3"
 
 var (
@@ -773,49 +1082,67 @@ func file_storage_service_v1_file_proto_rawDescGZIP() []byte {
 	return file_storage_service_v1_file_proto_rawDescData
 }
 
-var file_storage_service_v1_file_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_storage_service_v1_file_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_storage_service_v1_file_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_storage_service_v1_file_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_storage_service_v1_file_proto_goTypes = []any{
-	(OSSProvider)(0),              // 0: storage.service.v1.OSSProvider
-	(*File)(nil),                  // 1: storage.service.v1.File
-	(*ListFileResponse)(nil),      // 2: storage.service.v1.ListFileResponse
-	(*GetFileRequest)(nil),        // 3: storage.service.v1.GetFileRequest
-	(*CreateFileRequest)(nil),     // 4: storage.service.v1.CreateFileRequest
-	(*UpdateFileRequest)(nil),     // 5: storage.service.v1.UpdateFileRequest
-	(*DeleteFileRequest)(nil),     // 6: storage.service.v1.DeleteFileRequest
-	(*CountFileResponse)(nil),     // 7: storage.service.v1.CountFileResponse
-	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil), // 9: google.protobuf.FieldMask
-	(*v1.PagingRequest)(nil),      // 10: pagination.PagingRequest
-	(*emptypb.Empty)(nil),         // 11: google.protobuf.Empty
+	(OSSProvider)(0),                // 0: storage.service.v1.OSSProvider
+	(File_ScanStatus)(0),            // 1: storage.service.v1.File.ScanStatus
+	(*File)(nil),                    // 2: storage.service.v1.File
+	(*ListFileResponse)(nil),        // 3: storage.service.v1.ListFileResponse
+	(*GetFileRequest)(nil),          // 4: storage.service.v1.GetFileRequest
+	(*CreateFileRequest)(nil),       // 5: storage.service.v1.CreateFileRequest
+	(*UpdateFileRequest)(nil),       // 6: storage.service.v1.UpdateFileRequest
+	(*DeleteFileRequest)(nil),       // 7: storage.service.v1.DeleteFileRequest
+	(*CountFileResponse)(nil),       // 8: storage.service.v1.CountFileResponse
+	(*ListFileVersionsRequest)(nil), // 9: storage.service.v1.ListFileVersionsRequest
+	(*FileVersionRequest)(nil),      // 10: storage.service.v1.FileVersionRequest
+	(*RecycledFileRequest)(nil),     // 11: storage.service.v1.RecycledFileRequest
+	(*timestamppb.Timestamp)(nil),   // 12: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),   // 13: google.protobuf.FieldMask
+	(*v1.PagingRequest)(nil),        // 14: pagination.PagingRequest
+	(*emptypb.Empty)(nil),           // 15: google.protobuf.Empty
 }
 var file_storage_service_v1_file_proto_depIdxs = []int32{
 	0,  // 0: storage.service.v1.File.provider:type_name -> storage.service.v1.OSSProvider
-	8,  // 1: storage.service.v1.File.created_at:type_name -> google.protobuf.Timestamp
-	8,  // 2: storage.service.v1.File.updated_at:type_name -> google.protobuf.Timestamp
-	8,  // 3: storage.service.v1.File.deleted_at:type_name -> google.protobuf.Timestamp
-	1,  // 4: storage.service.v1.ListFileResponse.items:type_name -> storage.service.v1.File
-	9,  // 5: storage.service.v1.GetFileRequest.view_mask:type_name -> google.protobuf.FieldMask
-	1,  // 6: storage.service.v1.CreateFileRequest.data:type_name -> storage.service.v1.File
-	1,  // 7: storage.service.v1.UpdateFileRequest.data:type_name -> storage.service.v1.File
-	9,  // 8: storage.service.v1.UpdateFileRequest.update_mask:type_name -> google.protobuf.FieldMask
-	10, // 9: storage.service.v1.FileService.List:input_type -> pagination.PagingRequest
-	10, // 10: storage.service.v1.FileService.Count:input_type -> pagination.PagingRequest
-	3,  // 11: storage.service.v1.FileService.Get:input_type -> storage.service.v1.GetFileRequest
-	4,  // 12: storage.service.v1.FileService.Create:input_type -> storage.service.v1.CreateFileRequest
-	5,  // 13: storage.service.v1.FileService.Update:input_type -> storage.service.v1.UpdateFileRequest
-	6,  // 14: storage.service.v1.FileService.Delete:input_type -> storage.service.v1.DeleteFileRequest
-	2,  // 15: storage.service.v1.FileService.List:output_type -> storage.service.v1.ListFileResponse
-	7,  // 16: storage.service.v1.FileService.Count:output_type -> storage.service.v1.CountFileResponse
-	1,  // 17: storage.service.v1.FileService.Get:output_type -> storage.service.v1.File
-	11, // 18: storage.service.v1.FileService.Create:output_type -> google.protobuf.Empty
-	11, // 19: storage.service.v1.FileService.Update:output_type -> google.protobuf.Empty
-	11, // 20: storage.service.v1.FileService.Delete:output_type -> google.protobuf.Empty
-	15, // [15:21] is the sub-list for method output_type
-	9,  // [9:15] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	1,  // 1: storage.service.v1.File.scan_status:type_name -> storage.service.v1.File.ScanStatus
+	12, // 2: storage.service.v1.File.scanned_at:type_name -> google.protobuf.Timestamp
+	12, // 3: storage.service.v1.File.created_at:type_name -> google.protobuf.Timestamp
+	12, // 4: storage.service.v1.File.updated_at:type_name -> google.protobuf.Timestamp
+	12, // 5: storage.service.v1.File.deleted_at:type_name -> google.protobuf.Timestamp
+	2,  // 6: storage.service.v1.ListFileResponse.items:type_name -> storage.service.v1.File
+	13, // 7: storage.service.v1.GetFileRequest.view_mask:type_name -> google.protobuf.FieldMask
+	2,  // 8: storage.service.v1.CreateFileRequest.data:type_name -> storage.service.v1.File
+	2,  // 9: storage.service.v1.UpdateFileRequest.data:type_name -> storage.service.v1.File
+	13, // 10: storage.service.v1.UpdateFileRequest.update_mask:type_name -> google.protobuf.FieldMask
+	14, // 11: storage.service.v1.FileService.List:input_type -> pagination.PagingRequest
+	14, // 12: storage.service.v1.FileService.Count:input_type -> pagination.PagingRequest
+	4,  // 13: storage.service.v1.FileService.Get:input_type -> storage.service.v1.GetFileRequest
+	5,  // 14: storage.service.v1.FileService.Create:input_type -> storage.service.v1.CreateFileRequest
+	6,  // 15: storage.service.v1.FileService.Update:input_type -> storage.service.v1.UpdateFileRequest
+	7,  // 16: storage.service.v1.FileService.Delete:input_type -> storage.service.v1.DeleteFileRequest
+	9,  // 17: storage.service.v1.FileService.ListVersions:input_type -> storage.service.v1.ListFileVersionsRequest
+	10, // 18: storage.service.v1.FileService.RestoreVersion:input_type -> storage.service.v1.FileVersionRequest
+	10, // 19: storage.service.v1.FileService.PurgeVersion:input_type -> storage.service.v1.FileVersionRequest
+	14, // 20: storage.service.v1.FileService.ListRecycleBin:input_type -> pagination.PagingRequest
+	11, // 21: storage.service.v1.FileService.RestoreFromRecycleBin:input_type -> storage.service.v1.RecycledFileRequest
+	11, // 22: storage.service.v1.FileService.PurgeFromRecycleBin:input_type -> storage.service.v1.RecycledFileRequest
+	3,  // 23: storage.service.v1.FileService.List:output_type -> storage.service.v1.ListFileResponse
+	8,  // 24: storage.service.v1.FileService.Count:output_type -> storage.service.v1.CountFileResponse
+	2,  // 25: storage.service.v1.FileService.Get:output_type -> storage.service.v1.File
+	15, // 26: storage.service.v1.FileService.Create:output_type -> google.protobuf.Empty
+	15, // 27: storage.service.v1.FileService.Update:output_type -> google.protobuf.Empty
+	15, // 28: storage.service.v1.FileService.Delete:output_type -> google.protobuf.Empty
+	3,  // 29: storage.service.v1.FileService.ListVersions:output_type -> storage.service.v1.ListFileResponse
+	2,  // 30: storage.service.v1.FileService.RestoreVersion:output_type -> storage.service.v1.File
+	15, // 31: storage.service.v1.FileService.PurgeVersion:output_type -> google.protobuf.Empty
+	3,  // 32: storage.service.v1.FileService.ListRecycleBin:output_type -> storage.service.v1.ListFileResponse
+	2,  // 33: storage.service.v1.FileService.RestoreFromRecycleBin:output_type -> storage.service.v1.File
+	15, // 34: storage.service.v1.FileService.PurgeFromRecycleBin:output_type -> google.protobuf.Empty
+	23, // [23:35] is the sub-list for method output_type
+	11, // [11:23] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_storage_service_v1_file_proto_init() }
@@ -836,8 +1163,8 @@ func file_storage_service_v1_file_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_storage_service_v1_file_proto_rawDesc), len(file_storage_service_v1_file_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   7,
+			NumEnums:      2,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
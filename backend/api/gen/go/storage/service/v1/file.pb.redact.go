@@ -114,6 +114,72 @@ func (s *redactedFileServiceServer) Delete(ctx context.Context, in *DeleteFileRe
 	return res, err
 }
 
+// ListVersions is the redacted wrapper for the actual FileServiceServer.ListVersions method
+// Unary RPC
+func (s *redactedFileServiceServer) ListVersions(ctx context.Context, in *ListFileVersionsRequest) (*ListFileResponse, error) {
+	res, err := s.srv.ListVersions(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// RestoreVersion is the redacted wrapper for the actual FileServiceServer.RestoreVersion method
+// Unary RPC
+func (s *redactedFileServiceServer) RestoreVersion(ctx context.Context, in *FileVersionRequest) (*File, error) {
+	res, err := s.srv.RestoreVersion(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// PurgeVersion is the redacted wrapper for the actual FileServiceServer.PurgeVersion method
+// Unary RPC
+func (s *redactedFileServiceServer) PurgeVersion(ctx context.Context, in *FileVersionRequest) (*emptypb.Empty, error) {
+	res, err := s.srv.PurgeVersion(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ListRecycleBin is the redacted wrapper for the actual FileServiceServer.ListRecycleBin method
+// Unary RPC
+func (s *redactedFileServiceServer) ListRecycleBin(ctx context.Context, in *pagination.PagingRequest) (*ListFileResponse, error) {
+	res, err := s.srv.ListRecycleBin(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// RestoreFromRecycleBin is the redacted wrapper for the actual FileServiceServer.RestoreFromRecycleBin method
+// Unary RPC
+func (s *redactedFileServiceServer) RestoreFromRecycleBin(ctx context.Context, in *RecycledFileRequest) (*File, error) {
+	res, err := s.srv.RestoreFromRecycleBin(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// PurgeFromRecycleBin is the redacted wrapper for the actual FileServiceServer.PurgeFromRecycleBin method
+// Unary RPC
+func (s *redactedFileServiceServer) PurgeFromRecycleBin(ctx context.Context, in *RecycledFileRequest) (*emptypb.Empty, error) {
+	res, err := s.srv.PurgeFromRecycleBin(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
 // Redact method implementation for File
 func (x *File) Redact() string {
 	if x == nil {
@@ -144,6 +210,22 @@ func (x *File) Redact() string {
 
 	// Safe field: ContentHash
 
+	// Safe field: StorageObjectId
+
+	// Safe field: ScanStatus
+
+	// Safe field: ScanSignature
+
+	// Safe field: ScannedAt
+
+	// Safe field: Version
+
+	// Safe field: IsLatest
+
+	// Safe field: VersionGroup
+
+	// Safe field: ObjectName
+
 	// Safe field: TenantId
 
 	// Safe field: TenantName
@@ -227,3 +309,35 @@ func (x *CountFileResponse) Redact() string {
 	// Safe field: Count
 	return x.String()
 }
+
+// Redact method implementation for ListFileVersionsRequest
+func (x *ListFileVersionsRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+	return x.String()
+}
+
+// Redact method implementation for FileVersionRequest
+func (x *FileVersionRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: Version
+	return x.String()
+}
+
+// Redact method implementation for RecycledFileRequest
+func (x *RecycledFileRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+	return x.String()
+}
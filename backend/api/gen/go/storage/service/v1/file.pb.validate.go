@@ -104,6 +104,67 @@ func (m *File) validate(all bool) error {
 		// no validation rules for ContentHash
 	}
 
+	if m.StorageObjectId != nil {
+		// no validation rules for StorageObjectId
+	}
+
+	if m.ScanStatus != nil {
+		// no validation rules for ScanStatus
+	}
+
+	if m.ScanSignature != nil {
+		// no validation rules for ScanSignature
+	}
+
+	if m.ScannedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetScannedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FileValidationError{
+						field:  "ScannedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FileValidationError{
+						field:  "ScannedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetScannedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FileValidationError{
+					field:  "ScannedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Version != nil {
+		// no validation rules for Version
+	}
+
+	if m.IsLatest != nil {
+		// no validation rules for IsLatest
+	}
+
+	if m.VersionGroup != nil {
+		// no validation rules for VersionGroup
+	}
+
+	if m.ObjectName != nil {
+		// no validation rules for ObjectName
+	}
+
 	if m.TenantId != nil {
 		// no validation rules for TenantId
 	}
@@ -1105,3 +1166,317 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = CountFileResponseValidationError{}
+
+// Validate checks the field values on ListFileVersionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFileVersionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFileVersionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFileVersionsRequestMultiError, or nil if none found.
+func (m *ListFileVersionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFileVersionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return ListFileVersionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFileVersionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListFileVersionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListFileVersionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFileVersionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFileVersionsRequestMultiError) AllErrors() []error { return m }
+
+// ListFileVersionsRequestValidationError is the validation error returned by
+// ListFileVersionsRequest.Validate if the designated constraints aren't met.
+type ListFileVersionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFileVersionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFileVersionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFileVersionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFileVersionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFileVersionsRequestValidationError) ErrorName() string {
+	return "ListFileVersionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFileVersionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFileVersionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFileVersionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFileVersionsRequestValidationError{}
+
+// Validate checks the field values on FileVersionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FileVersionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FileVersionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FileVersionRequestMultiError, or nil if none found.
+func (m *FileVersionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FileVersionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return FileVersionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FileVersionRequestMultiError is an error wrapping multiple validation errors
+// returned by FileVersionRequest.ValidateAll() if the designated constraints
+// aren't met.
+type FileVersionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FileVersionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FileVersionRequestMultiError) AllErrors() []error { return m }
+
+// FileVersionRequestValidationError is the validation error returned by
+// FileVersionRequest.Validate if the designated constraints aren't met.
+type FileVersionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FileVersionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FileVersionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FileVersionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FileVersionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FileVersionRequestValidationError) ErrorName() string {
+	return "FileVersionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FileVersionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFileVersionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FileVersionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FileVersionRequestValidationError{}
+
+// Validate checks the field values on RecycledFileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecycledFileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecycledFileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecycledFileRequestMultiError, or nil if none found.
+func (m *RecycledFileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecycledFileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return RecycledFileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecycledFileRequestMultiError is an error wrapping multiple validation
+// errors returned by RecycledFileRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RecycledFileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecycledFileRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecycledFileRequestMultiError) AllErrors() []error { return m }
+
+// RecycledFileRequestValidationError is the validation error returned by
+// RecycledFileRequest.Validate if the designated constraints aren't met.
+type RecycledFileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecycledFileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecycledFileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecycledFileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecycledFileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecycledFileRequestValidationError) ErrorName() string {
+	return "RecycledFileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecycledFileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecycledFileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecycledFileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecycledFileRequestValidationError{}
@@ -29,11 +29,13 @@ const (
 	// 400
 	StorageErrorReason_BAD_REQUEST StorageErrorReason = 0 // 错误请求
 	// 401
-	StorageErrorReason_UNAUTHORIZED StorageErrorReason = 100 // 未授权
+	StorageErrorReason_UNAUTHORIZED                 StorageErrorReason = 100 // 未授权
+	StorageErrorReason_SHARE_LINK_PASSWORD_REQUIRED StorageErrorReason = 101 // 分享链接需要密码或密码错误
 	// 402
 	StorageErrorReason_PAYMENT_REQUIRED StorageErrorReason = 200 // 需要支付
 	// 403
-	StorageErrorReason_FORBIDDEN StorageErrorReason = 300 // 禁止访问
+	StorageErrorReason_FORBIDDEN                  StorageErrorReason = 300 // 禁止访问
+	StorageErrorReason_SHARE_LINK_AUDIENCE_DENIED StorageErrorReason = 301 // 不在分享链接允许访问的范围内
 	// 404
 	StorageErrorReason_NOT_FOUND      StorageErrorReason = 400 // 找不到资源
 	StorageErrorReason_FILE_NOT_FOUND StorageErrorReason = 401 // 文件不存在
@@ -46,9 +48,11 @@ const (
 	// 408
 	StorageErrorReason_REQUEST_TIMEOUT StorageErrorReason = 800 // 请求超时
 	// 409
-	StorageErrorReason_CONFLICT StorageErrorReason = 900 // 冲突
+	StorageErrorReason_CONFLICT           StorageErrorReason = 900 // 冲突
+	StorageErrorReason_FILE_NAME_CONFLICT StorageErrorReason = 901 // 同一目录下已存在同名文件
 	// 410
-	StorageErrorReason_GONE StorageErrorReason = 1000 // 已删除
+	StorageErrorReason_GONE               StorageErrorReason = 1000 // 已删除
+	StorageErrorReason_SHARE_LINK_EXPIRED StorageErrorReason = 1001 // 分享链接已过期、已撤销或下载次数已用完
 	// 411
 	StorageErrorReason_LENGTH_REQUIRED StorageErrorReason = 1010 // 需要Content-Length
 	// 412
@@ -86,6 +90,8 @@ const (
 	StorageErrorReason_REQUEST_HEADER_FIELDS_TOO_LARGE StorageErrorReason = 1170 // 请求头字段过大
 	// 451
 	StorageErrorReason_UNAVAILABLE_FOR_LEGAL_REASONS StorageErrorReason = 1180 // 因法律原因不可用
+	StorageErrorReason_FILE_NOT_SCANNED              StorageErrorReason = 1181 // 文件尚未通过恶意文件扫描
+	StorageErrorReason_FILE_QUARANTINED              StorageErrorReason = 1182 // 文件检出恶意内容，已隔离
 	// 500
 	StorageErrorReason_INTERNAL_SERVER_ERROR StorageErrorReason = 2000 // 内部服务器错误
 	StorageErrorReason_UPLOAD_FAILED         StorageErrorReason = 2001
@@ -105,6 +111,7 @@ const (
 	StorageErrorReason_VARIANT_ALSO_NEGOTIATES StorageErrorReason = 2600 // 变体也协商
 	// 507
 	StorageErrorReason_INSUFFICIENT_STORAGE StorageErrorReason = 2700 // 存储空间不足
+	StorageErrorReason_QUOTA_EXCEEDED       StorageErrorReason = 2701 // 超出存储配额
 	// 508
 	StorageErrorReason_LOOP_DETECTED StorageErrorReason = 2800 // 检测到循环
 	// 510
@@ -122,8 +129,10 @@ var (
 	StorageErrorReason_name = map[int32]string{
 		0:    "BAD_REQUEST",
 		100:  "UNAUTHORIZED",
+		101:  "SHARE_LINK_PASSWORD_REQUIRED",
 		200:  "PAYMENT_REQUIRED",
 		300:  "FORBIDDEN",
+		301:  "SHARE_LINK_AUDIENCE_DENIED",
 		400:  "NOT_FOUND",
 		401:  "FILE_NOT_FOUND",
 		500:  "METHOD_NOT_ALLOWED",
@@ -131,7 +140,9 @@ var (
 		700:  "PROXY_AUTHENTICATION_REQUIRED",
 		800:  "REQUEST_TIMEOUT",
 		900:  "CONFLICT",
+		901:  "FILE_NAME_CONFLICT",
 		1000: "GONE",
+		1001: "SHARE_LINK_EXPIRED",
 		1010: "LENGTH_REQUIRED",
 		1020: "PRECONDITION_FAILED",
 		1030: "PAYLOAD_TOO_LARGE",
@@ -151,6 +162,8 @@ var (
 		1160: "TOO_MANY_REQUESTS",
 		1170: "REQUEST_HEADER_FIELDS_TOO_LARGE",
 		1180: "UNAVAILABLE_FOR_LEGAL_REASONS",
+		1181: "FILE_NOT_SCANNED",
+		1182: "FILE_QUARANTINED",
 		2000: "INTERNAL_SERVER_ERROR",
 		2001: "UPLOAD_FAILED",
 		2002: "DOWNLOAD_FAILED",
@@ -162,6 +175,7 @@ var (
 		2500: "HTTP_VERSION_NOT_SUPPORTED",
 		2600: "VARIANT_ALSO_NEGOTIATES",
 		2700: "INSUFFICIENT_STORAGE",
+		2701: "QUOTA_EXCEEDED",
 		2800: "LOOP_DETECTED",
 		2900: "NOT_EXTENDED",
 		3000: "NETWORK_AUTHENTICATION_REQUIRED",
@@ -171,8 +185,10 @@ var (
 	StorageErrorReason_value = map[string]int32{
 		"BAD_REQUEST":                     0,
 		"UNAUTHORIZED":                    100,
+		"SHARE_LINK_PASSWORD_REQUIRED":    101,
 		"PAYMENT_REQUIRED":                200,
 		"FORBIDDEN":                       300,
+		"SHARE_LINK_AUDIENCE_DENIED":      301,
 		"NOT_FOUND":                       400,
 		"FILE_NOT_FOUND":                  401,
 		"METHOD_NOT_ALLOWED":              500,
@@ -180,7 +196,9 @@ var (
 		"PROXY_AUTHENTICATION_REQUIRED":   700,
 		"REQUEST_TIMEOUT":                 800,
 		"CONFLICT":                        900,
+		"FILE_NAME_CONFLICT":              901,
 		"GONE":                            1000,
+		"SHARE_LINK_EXPIRED":              1001,
 		"LENGTH_REQUIRED":                 1010,
 		"PRECONDITION_FAILED":             1020,
 		"PAYLOAD_TOO_LARGE":               1030,
@@ -200,6 +218,8 @@ var (
 		"TOO_MANY_REQUESTS":               1160,
 		"REQUEST_HEADER_FIELDS_TOO_LARGE": 1170,
 		"UNAVAILABLE_FOR_LEGAL_REASONS":   1180,
+		"FILE_NOT_SCANNED":                1181,
+		"FILE_QUARANTINED":                1182,
 		"INTERNAL_SERVER_ERROR":           2000,
 		"UPLOAD_FAILED":                   2001,
 		"DOWNLOAD_FAILED":                 2002,
@@ -211,6 +231,7 @@ var (
 		"HTTP_VERSION_NOT_SUPPORTED":      2500,
 		"VARIANT_ALSO_NEGOTIATES":         2600,
 		"INSUFFICIENT_STORAGE":            2700,
+		"QUOTA_EXCEEDED":                  2701,
 		"LOOP_DETECTED":                   2800,
 		"NOT_EXTENDED":                    2900,
 		"NETWORK_AUTHENTICATION_REQUIRED": 3000,
@@ -250,20 +271,24 @@ var File_storage_service_v1_file_error_proto protoreflect.FileDescriptor
 
 const file_storage_service_v1_file_error_proto_rawDesc = "" +
 	"\n" +
-	"#storage/service/v1/file_error.proto\x12\x12storage.service.v1\x1a\x13errors/errors.proto*\x96\v\n" +
+	"#storage/service/v1/file_error.proto\x12\x12storage.service.v1\x1a\x13errors/errors.proto*\xf8\f\n" +
 	"\x12StorageErrorReason\x12\x15\n" +
 	"\vBAD_REQUEST\x10\x00\x1a\x04\xa8E\x90\x03\x12\x16\n" +
-	"\fUNAUTHORIZED\x10d\x1a\x04\xa8E\x91\x03\x12\x1b\n" +
+	"\fUNAUTHORIZED\x10d\x1a\x04\xa8E\x91\x03\x12&\n" +
+	"\x1cSHARE_LINK_PASSWORD_REQUIRED\x10e\x1a\x04\xa8E\x91\x03\x12\x1b\n" +
 	"\x10PAYMENT_REQUIRED\x10\xc8\x01\x1a\x04\xa8E\x92\x03\x12\x14\n" +
-	"\tFORBIDDEN\x10\xac\x02\x1a\x04\xa8E\x93\x03\x12\x14\n" +
+	"\tFORBIDDEN\x10\xac\x02\x1a\x04\xa8E\x93\x03\x12%\n" +
+	"\x1aSHARE_LINK_AUDIENCE_DENIED\x10\xad\x02\x1a\x04\xa8E\x93\x03\x12\x14\n" +
 	"\tNOT_FOUND\x10\x90\x03\x1a\x04\xa8E\x94\x03\x12\x19\n" +
 	"\x0eFILE_NOT_FOUND\x10\x91\x03\x1a\x04\xa8E\x94\x03\x12\x1d\n" +
 	"\x12METHOD_NOT_ALLOWED\x10\xf4\x03\x1a\x04\xa8E\x95\x03\x12\x19\n" +
 	"\x0eNOT_ACCEPTABLE\x10\xd8\x04\x1a\x04\xa8E\x96\x03\x12(\n" +
 	"\x1dPROXY_AUTHENTICATION_REQUIRED\x10\xbc\x05\x1a\x04\xa8E\x97\x03\x12\x1a\n" +
 	"\x0fREQUEST_TIMEOUT\x10\xa0\x06\x1a\x04\xa8E\x98\x03\x12\x13\n" +
-	"\bCONFLICT\x10\x84\a\x1a\x04\xa8E\x99\x03\x12\x0f\n" +
-	"\x04GONE\x10\xe8\a\x1a\x04\xa8E\x9a\x03\x12\x1a\n" +
+	"\bCONFLICT\x10\x84\a\x1a\x04\xa8E\x99\x03\x12\x1d\n" +
+	"\x12FILE_NAME_CONFLICT\x10\x85\a\x1a\x04\xa8E\x99\x03\x12\x0f\n" +
+	"\x04GONE\x10\xe8\a\x1a\x04\xa8E\x9a\x03\x12\x1d\n" +
+	"\x12SHARE_LINK_EXPIRED\x10\xe9\a\x1a\x04\xa8E\x9a\x03\x12\x1a\n" +
 	"\x0fLENGTH_REQUIRED\x10\xf2\a\x1a\x04\xa8E\x9b\x03\x12\x1e\n" +
 	"\x13PRECONDITION_FAILED\x10\xfc\a\x1a\x04\xa8E\x9c\x03\x12\x1c\n" +
 	"\x11PAYLOAD_TOO_LARGE\x10\x86\b\x1a\x04\xa8E\x9d\x03\x12\x19\n" +
@@ -282,7 +307,9 @@ const file_storage_service_v1_file_error_proto_rawDesc = "" +
 	"\x15PRECONDITION_REQUIRED\x10\xfe\b\x1a\x04\xa8E\xac\x03\x12\x1c\n" +
 	"\x11TOO_MANY_REQUESTS\x10\x88\t\x1a\x04\xa8E\xad\x03\x12*\n" +
 	"\x1fREQUEST_HEADER_FIELDS_TOO_LARGE\x10\x92\t\x1a\x04\xa8E\xaf\x03\x12(\n" +
-	"\x1dUNAVAILABLE_FOR_LEGAL_REASONS\x10\x9c\t\x1a\x04\xa8E\xc3\x03\x12 \n" +
+	"\x1dUNAVAILABLE_FOR_LEGAL_REASONS\x10\x9c\t\x1a\x04\xa8E\xc3\x03\x12\x1b\n" +
+	"\x10FILE_NOT_SCANNED\x10\x9d\t\x1a\x04\xa8E\xc3\x03\x12\x1b\n" +
+	"\x10FILE_QUARANTINED\x10\x9e\t\x1a\x04\xa8E\xc3\x03\x12 \n" +
 	"\x15INTERNAL_SERVER_ERROR\x10\xd0\x0f\x1a\x04\xa8E\xf4\x03\x12\x18\n" +
 	"\rUPLOAD_FAILED\x10\xd1\x0f\x1a\x04\xa8E\xf4\x03\x12\x1a\n" +
 	"\x0fDOWNLOAD_FAILED\x10\xd2\x0f\x1a\x04\xa8E\xf4\x03\x12\x18\n" +
@@ -293,7 +320,8 @@ const file_storage_service_v1_file_error_proto_rawDesc = "" +
 	"\x0fGATEWAY_TIMEOUT\x10\xe0\x12\x1a\x04\xa8E\xf8\x03\x12%\n" +
 	"\x1aHTTP_VERSION_NOT_SUPPORTED\x10\xc4\x13\x1a\x04\xa8E\xf9\x03\x12\"\n" +
 	"\x17VARIANT_ALSO_NEGOTIATES\x10\xa8\x14\x1a\x04\xa8E\xfa\x03\x12\x1f\n" +
-	"\x14INSUFFICIENT_STORAGE\x10\x8c\x15\x1a\x04\xa8E\xfb\x03\x12\x18\n" +
+	"\x14INSUFFICIENT_STORAGE\x10\x8c\x15\x1a\x04\xa8E\xfb\x03\x12\x19\n" +
+	"\x0eQUOTA_EXCEEDED\x10\x8d\x15\x1a\x04\xa8E\xfb\x03\x12\x18\n" +
 	"\rLOOP_DETECTED\x10\xf0\x15\x1a\x04\xa8E\xfc\x03\x12\x17\n" +
 	"\fNOT_EXTENDED\x10\xd4\x16\x1a\x04\xa8E\xfe\x03\x12*\n" +
 	"\x1fNETWORK_AUTHENTICATION_REQUIRED\x10\xb8\x17\x1a\x04\xa8E\xff\x03\x12%\n" +
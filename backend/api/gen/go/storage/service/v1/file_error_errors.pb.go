@@ -39,6 +39,20 @@ func ErrorUnauthorized(format string, args ...interface{}) *errors.Error {
 	return errors.New(401, StorageErrorReason_UNAUTHORIZED.String(), fmt.Sprintf(format, args...))
 }
 
+// 分享链接需要密码或密码错误
+func IsShareLinkPasswordRequired(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == StorageErrorReason_SHARE_LINK_PASSWORD_REQUIRED.String() && e.Code == 401
+}
+
+// 分享链接需要密码或密码错误
+func ErrorShareLinkPasswordRequired(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, StorageErrorReason_SHARE_LINK_PASSWORD_REQUIRED.String(), fmt.Sprintf(format, args...))
+}
+
 // 402
 func IsPaymentRequired(err error) bool {
 	if err == nil {
@@ -67,6 +81,20 @@ func ErrorForbidden(format string, args ...interface{}) *errors.Error {
 	return errors.New(403, StorageErrorReason_FORBIDDEN.String(), fmt.Sprintf(format, args...))
 }
 
+// 不在分享链接允许访问的范围内
+func IsShareLinkAudienceDenied(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == StorageErrorReason_SHARE_LINK_AUDIENCE_DENIED.String() && e.Code == 403
+}
+
+// 不在分享链接允许访问的范围内
+func ErrorShareLinkAudienceDenied(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, StorageErrorReason_SHARE_LINK_AUDIENCE_DENIED.String(), fmt.Sprintf(format, args...))
+}
+
 // 404
 func IsNotFound(err error) bool {
 	if err == nil {
@@ -165,6 +193,20 @@ func ErrorConflict(format string, args ...interface{}) *errors.Error {
 	return errors.New(409, StorageErrorReason_CONFLICT.String(), fmt.Sprintf(format, args...))
 }
 
+// 同一目录下已存在同名文件
+func IsFileNameConflict(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == StorageErrorReason_FILE_NAME_CONFLICT.String() && e.Code == 409
+}
+
+// 同一目录下已存在同名文件
+func ErrorFileNameConflict(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, StorageErrorReason_FILE_NAME_CONFLICT.String(), fmt.Sprintf(format, args...))
+}
+
 // 410
 func IsGone(err error) bool {
 	if err == nil {
@@ -179,6 +221,20 @@ func ErrorGone(format string, args ...interface{}) *errors.Error {
 	return errors.New(410, StorageErrorReason_GONE.String(), fmt.Sprintf(format, args...))
 }
 
+// 分享链接已过期、已撤销或下载次数已用完
+func IsShareLinkExpired(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == StorageErrorReason_SHARE_LINK_EXPIRED.String() && e.Code == 410
+}
+
+// 分享链接已过期、已撤销或下载次数已用完
+func ErrorShareLinkExpired(format string, args ...interface{}) *errors.Error {
+	return errors.New(410, StorageErrorReason_SHARE_LINK_EXPIRED.String(), fmt.Sprintf(format, args...))
+}
+
 // 411
 func IsLengthRequired(err error) bool {
 	if err == nil {
@@ -445,6 +501,34 @@ func ErrorUnavailableForLegalReasons(format string, args ...interface{}) *errors
 	return errors.New(451, StorageErrorReason_UNAVAILABLE_FOR_LEGAL_REASONS.String(), fmt.Sprintf(format, args...))
 }
 
+// 文件尚未通过恶意文件扫描
+func IsFileNotScanned(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == StorageErrorReason_FILE_NOT_SCANNED.String() && e.Code == 451
+}
+
+// 文件尚未通过恶意文件扫描
+func ErrorFileNotScanned(format string, args ...interface{}) *errors.Error {
+	return errors.New(451, StorageErrorReason_FILE_NOT_SCANNED.String(), fmt.Sprintf(format, args...))
+}
+
+// 文件检出恶意内容，已隔离
+func IsFileQuarantined(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == StorageErrorReason_FILE_QUARANTINED.String() && e.Code == 451
+}
+
+// 文件检出恶意内容，已隔离
+func ErrorFileQuarantined(format string, args ...interface{}) *errors.Error {
+	return errors.New(451, StorageErrorReason_FILE_QUARANTINED.String(), fmt.Sprintf(format, args...))
+}
+
 // 500
 func IsInternalServerError(err error) bool {
 	if err == nil {
@@ -593,6 +677,20 @@ func ErrorInsufficientStorage(format string, args ...interface{}) *errors.Error
 	return errors.New(507, StorageErrorReason_INSUFFICIENT_STORAGE.String(), fmt.Sprintf(format, args...))
 }
 
+// 超出存储配额
+func IsQuotaExceeded(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == StorageErrorReason_QUOTA_EXCEEDED.String() && e.Code == 507
+}
+
+// 超出存储配额
+func ErrorQuotaExceeded(format string, args ...interface{}) *errors.Error {
+	return errors.New(507, StorageErrorReason_QUOTA_EXCEEDED.String(), fmt.Sprintf(format, args...))
+}
+
 // 508
 func IsLoopDetected(err error) bool {
 	if err == nil {
@@ -40,8 +40,12 @@ type DownloadFileRequest struct {
 	PresignExpireSeconds *int32  `protobuf:"varint,7,opt,name=presign_expire_seconds,json=presignExpireSeconds,proto3,oneof" json:"presign_expire_seconds,omitempty"` // 生成预签名 URL 的有效期（秒），仅在 prefer_presigned_url 为 true 时有意义
 	Disposition          *string `protobuf:"bytes,8,opt,name=disposition,proto3,oneof" json:"disposition,omitempty"`                                                  // 客户端期望的 Content-Disposition 值，常见 "attachment" 或 "inline"（默认 attachment）
 	AcceptMime           *string `protobuf:"bytes,9,opt,name=accept_mime,json=acceptMime,proto3,oneof" json:"accept_mime,omitempty"`                                  // 客户端期望的 MIME 类型（可用于后端选择或转换）
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// 图片缩放（仅对图片有效，缩放结果会被缓存）
+	W             *uint32 `protobuf:"varint,10,opt,name=w,proto3,oneof" json:"w,omitempty"`    // 缩放后的宽度（像素），为 0 时按高度等比缩放
+	H             *uint32 `protobuf:"varint,11,opt,name=h,proto3,oneof" json:"h,omitempty"`    // 缩放后的高度（像素），为 0 时按宽度等比缩放
+	Fit           *string `protobuf:"bytes,12,opt,name=fit,proto3,oneof" json:"fit,omitempty"` // 缩放模式：cover（默认，居中裁剪）、contain（完整显示）、fill（拉伸）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DownloadFileRequest) Reset() {
@@ -150,6 +154,27 @@ func (x *DownloadFileRequest) GetAcceptMime() string {
 	return ""
 }
 
+func (x *DownloadFileRequest) GetW() uint32 {
+	if x != nil && x.W != nil {
+		return *x.W
+	}
+	return 0
+}
+
+func (x *DownloadFileRequest) GetH() uint32 {
+	if x != nil && x.H != nil {
+		return *x.H
+	}
+	return 0
+}
+
+func (x *DownloadFileRequest) GetFit() string {
+	if x != nil && x.Fit != nil {
+		return *x.Fit
+	}
+	return ""
+}
+
 type isDownloadFileRequest_Selector interface {
 	isDownloadFileRequest_Selector()
 }
@@ -421,8 +446,12 @@ func (*UploadFileRequest_Presign) isUploadFileRequest_Source() {}
 
 type UploadFileResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ObjectName    *string                `protobuf:"bytes,1,opt,name=object_name,json=objectName,proto3,oneof" json:"object_name,omitempty"`       // OSS 对象键
-	PresignedUrl  *string                `protobuf:"bytes,2,opt,name=presigned_url,json=presignedUrl,proto3,oneof" json:"presigned_url,omitempty"` // 预签名上传链接
+	ObjectName    *string                `protobuf:"bytes,1,opt,name=object_name,json=objectName,proto3,oneof" json:"object_name,omitempty"`                                                               // OSS 对象键
+	PresignedUrl  *string                `protobuf:"bytes,2,opt,name=presigned_url,json=presignedUrl,proto3,oneof" json:"presigned_url,omitempty"`                                                         // 预签名上传链接
+	BucketName    *string                `protobuf:"bytes,3,opt,name=bucket_name,json=bucketName,proto3,oneof" json:"bucket_name,omitempty"`                                                               // 预签名上传的存储桶名称
+	FormData      map[string]string      `protobuf:"bytes,4,rep,name=form_data,json=formData,proto3" json:"form_data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 预签名 POST 上传需要附带的表单字段
+	FileId        *uint32                `protobuf:"varint,5,opt,name=file_id,json=fileId,proto3,oneof" json:"file_id,omitempty"`                                                                          // 直接上传登记的文件 ID
+	DownloadUrl   *string                `protobuf:"bytes,6,opt,name=download_url,json=downloadUrl,proto3,oneof" json:"download_url,omitempty"`                                                            // 经过扫描状态检查的下载路由
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -471,11 +500,92 @@ func (x *UploadFileResponse) GetPresignedUrl() string {
 	return ""
 }
 
+func (x *UploadFileResponse) GetBucketName() string {
+	if x != nil && x.BucketName != nil {
+		return *x.BucketName
+	}
+	return ""
+}
+
+func (x *UploadFileResponse) GetFormData() map[string]string {
+	if x != nil {
+		return x.FormData
+	}
+	return nil
+}
+
+func (x *UploadFileResponse) GetFileId() uint32 {
+	if x != nil && x.FileId != nil {
+		return *x.FileId
+	}
+	return 0
+}
+
+func (x *UploadFileResponse) GetDownloadUrl() string {
+	if x != nil && x.DownloadUrl != nil {
+		return *x.DownloadUrl
+	}
+	return ""
+}
+
+// 预签名上传完成请求
+type CompletePresignedUploadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BucketName    string                 `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"` // 存储桶名称
+	ObjectName    string                 `protobuf:"bytes,2,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"` // 对象名称
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompletePresignedUploadRequest) Reset() {
+	*x = CompletePresignedUploadRequest{}
+	mi := &file_storage_service_v1_file_transfer_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompletePresignedUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompletePresignedUploadRequest) ProtoMessage() {}
+
+func (x *CompletePresignedUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_service_v1_file_transfer_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompletePresignedUploadRequest.ProtoReflect.Descriptor instead.
+func (*CompletePresignedUploadRequest) Descriptor() ([]byte, []int) {
+	return file_storage_service_v1_file_transfer_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CompletePresignedUploadRequest) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+func (x *CompletePresignedUploadRequest) GetObjectName() string {
+	if x != nil {
+		return x.ObjectName
+	}
+	return ""
+}
+
 var File_storage_service_v1_file_transfer_proto protoreflect.FileDescriptor
 
 const file_storage_service_v1_file_transfer_proto_rawDesc = "" +
 	"\n" +
-	"&storage/service/v1/file_transfer.proto\x12\x12storage.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x19google/api/httpbody.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cstorage/service/v1/oss.proto\"\xd7\b\n" +
+	"&storage/service/v1/file_transfer.proto\x12\x12storage.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x19google/api/httpbody.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cstorage/service/v1/oss.proto\x1a\x1dstorage/service/v1/file.proto\"\x9c\v\n" +
 	"\x13DownloadFileRequest\x129\n" +
 	"\afile_id\x18\x01 \x01(\rB\x1e\xbaG\x1b\x92\x02\x18服务端内部文件 IDH\x00R\x06fileId\x12d\n" +
 	"\x0estorage_object\x18\x02 \x01(\v2!.storage.service.v1.StorageObjectB\x18\xbaG\x15\x92\x02\x12对象存储对象H\x00R\rstorageObject\x12\\\n" +
@@ -487,7 +597,11 @@ const file_storage_service_v1_file_transfer_proto_rawDesc = "" +
 	"\x16presign_expire_seconds\x18\a \x01(\x05Bc\xbaG`\x92\x02]生成预签名 URL 的有效期（秒），仅在 prefer_presigned_url 为 true 时有意义H\x04R\x14presignExpireSeconds\x88\x01\x01\x12\x8d\x01\n" +
 	"\vdisposition\x18\b \x01(\tBf\xbaGc\x92\x02`客户端期望的 Content-Disposition 值，常见 attachment 或 inline（默认 attachment）H\x05R\vdisposition\x88\x01\x01\x12n\n" +
 	"\vaccept_mime\x18\t \x01(\tBH\xbaGE\x92\x02B客户端期望的 MIME 类型（可用于后端选择或转换）H\x06R\n" +
-	"acceptMime\x88\x01\x01B\n" +
+	"acceptMime\x88\x01\x01\x12X\n" +
+	"\x01w\x18\n" +
+	" \x01(\rBE\xbaGB\x92\x02?缩放后的宽度（像素），为 0 时按高度等比缩放H\aR\x01w\x88\x01\x01\x12X\n" +
+	"\x01h\x18\v \x01(\rBE\xbaGB\x92\x02?缩放后的高度（像素），为 0 时按宽度等比缩放H\bR\x01h\x88\x01\x01\x12{\n" +
+	"\x03fit\x18\f \x01(\tBd\xbaGa\x92\x02^缩放模式：cover（默认，居中裁剪）、contain（完整显示）、fill（拉伸）H\tR\x03fit\x88\x01\x01B\n" +
 	"\n" +
 	"\bselectorB\x0e\n" +
 	"\f_range_startB\f\n" +
@@ -496,7 +610,10 @@ const file_storage_service_v1_file_transfer_proto_rawDesc = "" +
 	"\x15_prefer_presigned_urlB\x19\n" +
 	"\x17_presign_expire_secondsB\x0e\n" +
 	"\f_dispositionB\x0e\n" +
-	"\f_accept_mime\"\xd7\x05\n" +
+	"\f_accept_mimeB\x04\n" +
+	"\x02_wB\x04\n" +
+	"\x02_hB\x06\n" +
+	"\x04_fit\"\xd7\x05\n" +
 	"\x14DownloadFileResponse\x12R\n" +
 	"\x04file\x18\x01 \x01(\fB<\xbaG9\x92\x026直接返回文件字节（小文件或同步场景）H\x00R\x04file\x12V\n" +
 	"\fdownload_url\x18\x02 \x01(\tB1\xbaG.\x92\x02+预签名 URL（大文件或异步下载）H\x00R\vdownloadUrl\x12`\n" +
@@ -519,17 +636,35 @@ const file_storage_service_v1_file_transfer_proto_rawDesc = "" +
 	"\x06sourceB\x13\n" +
 	"\x11_source_file_nameB\a\n" +
 	"\x05_mimeB\a\n" +
-	"\x05_size\"\xb8\x01\n" +
+	"\x05_size\"\x98\x05\n" +
 	"\x12UploadFileResponse\x129\n" +
 	"\vobject_name\x18\x01 \x01(\tB\x13\xbaG\x10\x92\x02\rOSS 对象键H\x00R\n" +
 	"objectName\x88\x01\x01\x12E\n" +
-	"\rpresigned_url\x18\x02 \x01(\tB\x1b\xbaG\x18\x92\x02\x15预签名上传链接H\x01R\fpresignedUrl\x88\x01\x01B\x0e\n" +
+	"\rpresigned_url\x18\x02 \x01(\tB\x1b\xbaG\x18\x92\x02\x15预签名上传链接H\x01R\fpresignedUrl\x88\x01\x01\x12M\n" +
+	"\vbucket_name\x18\x03 \x01(\tB'\xbaG$\x92\x02!预签名上传的存储桶名称H\x02R\n" +
+	"bucketName\x88\x01\x01\x12\x89\x01\n" +
+	"\tform_data\x18\x04 \x03(\v24.storage.service.v1.UploadFileResponse.FormDataEntryB6\xbaG3\x92\x020预签名 POST 上传需要附带的表单字段R\bformData\x12B\n" +
+	"\afile_id\x18\x05 \x01(\rB$\xbaG!\x92\x02\x1e直接上传登记的文件 IDH\x03R\x06fileId\x88\x01\x01\x12U\n" +
+	"\fdownload_url\x18\x06 \x01(\tB-\xbaG*\x92\x02'经过扫描状态检查的下载路由H\x04R\vdownloadUrl\x88\x01\x01\x1a;\n" +
+	"\rFormDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x0e\n" +
 	"\f_object_nameB\x10\n" +
-	"\x0e_presigned_url2\x8f\x02\n" +
+	"\x0e_presigned_urlB\x0e\n" +
+	"\f_bucket_nameB\n" +
+	"\n" +
+	"\b_file_idB\x0f\n" +
+	"\r_download_url\"\x8d\x01\n" +
+	"\x1eCompletePresignedUploadRequest\x126\n" +
+	"\vbucket_name\x18\x01 \x01(\tB\x15\xbaG\x12\x92\x02\x0f存储桶名称R\n" +
+	"bucketName\x123\n" +
+	"\vobject_name\x18\x02 \x01(\tB\x12\xbaG\x0f\x92\x02\f对象名称R\n" +
+	"objectName2\xfa\x02\n" +
 	"\x13FileTransferService\x12Q\n" +
 	"\fDownloadFile\x12'.storage.service.v1.DownloadFileRequest\x1a\x14.google.api.HttpBody\"\x000\x01\x12Q\n" +
 	"\rPutUploadFile\x12\x14.google.api.HttpBody\x1a&.storage.service.v1.UploadFileResponse\"\x00(\x01\x12R\n" +
-	"\x0ePostUploadFile\x12\x14.google.api.HttpBody\x1a&.storage.service.v1.UploadFileResponse\"\x00(\x01B\xcc\x01\n" +
+	"\x0ePostUploadFile\x12\x14.google.api.HttpBody\x1a&.storage.service.v1.UploadFileResponse\"\x00(\x01\x12i\n" +
+	"\x17CompletePresignedUpload\x122.storage.service.v1.CompletePresignedUploadRequest\x1a\x18.storage.service.v1.File\"\x00B\xcc\x01\n" +
 	"\x16com.storage.service.v1B\x11FileTransferProtoP\x01Z5go-wind-admin/api/gen/go/storage/service/v1;storagepb\xa2\x02\x03SSX\xaa\x02\x12Storage.Service.V1\xca\x02\x12Storage\\Service\\V1\xe2\x02\x1eStorage\\Service\\V1\\GPBMetadata\xea\x02\x14Storage::Service::V1b\x06proto3"
 
 var (
@@ -544,33 +679,39 @@ func file_storage_service_v1_file_transfer_proto_rawDescGZIP() []byte {
 	return file_storage_service_v1_file_transfer_proto_rawDescData
 }
 
-var file_storage_service_v1_file_transfer_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_storage_service_v1_file_transfer_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_storage_service_v1_file_transfer_proto_goTypes = []any{
-	(*DownloadFileRequest)(nil),   // 0: storage.service.v1.DownloadFileRequest
-	(*DownloadFileResponse)(nil),  // 1: storage.service.v1.DownloadFileResponse
-	(*UploadFileRequest)(nil),     // 2: storage.service.v1.UploadFileRequest
-	(*UploadFileResponse)(nil),    // 3: storage.service.v1.UploadFileResponse
-	(*StorageObject)(nil),         // 4: storage.service.v1.StorageObject
-	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
-	(*PresignOption)(nil),         // 6: storage.service.v1.PresignOption
-	(*httpbody.HttpBody)(nil),     // 7: google.api.HttpBody
+	(*DownloadFileRequest)(nil),            // 0: storage.service.v1.DownloadFileRequest
+	(*DownloadFileResponse)(nil),           // 1: storage.service.v1.DownloadFileResponse
+	(*UploadFileRequest)(nil),              // 2: storage.service.v1.UploadFileRequest
+	(*UploadFileResponse)(nil),             // 3: storage.service.v1.UploadFileResponse
+	(*CompletePresignedUploadRequest)(nil), // 4: storage.service.v1.CompletePresignedUploadRequest
+	nil,                                    // 5: storage.service.v1.UploadFileResponse.FormDataEntry
+	(*StorageObject)(nil),                  // 6: storage.service.v1.StorageObject
+	(*timestamppb.Timestamp)(nil),          // 7: google.protobuf.Timestamp
+	(*PresignOption)(nil),                  // 8: storage.service.v1.PresignOption
+	(*httpbody.HttpBody)(nil),              // 9: google.api.HttpBody
+	(*File)(nil),                           // 10: storage.service.v1.File
 }
 var file_storage_service_v1_file_transfer_proto_depIdxs = []int32{
-	4, // 0: storage.service.v1.DownloadFileRequest.storage_object:type_name -> storage.service.v1.StorageObject
-	5, // 1: storage.service.v1.DownloadFileResponse.updated_at:type_name -> google.protobuf.Timestamp
-	4, // 2: storage.service.v1.UploadFileRequest.storage_object:type_name -> storage.service.v1.StorageObject
-	6, // 3: storage.service.v1.UploadFileRequest.presign:type_name -> storage.service.v1.PresignOption
-	0, // 4: storage.service.v1.FileTransferService.DownloadFile:input_type -> storage.service.v1.DownloadFileRequest
-	7, // 5: storage.service.v1.FileTransferService.PutUploadFile:input_type -> google.api.HttpBody
-	7, // 6: storage.service.v1.FileTransferService.PostUploadFile:input_type -> google.api.HttpBody
-	7, // 7: storage.service.v1.FileTransferService.DownloadFile:output_type -> google.api.HttpBody
-	3, // 8: storage.service.v1.FileTransferService.PutUploadFile:output_type -> storage.service.v1.UploadFileResponse
-	3, // 9: storage.service.v1.FileTransferService.PostUploadFile:output_type -> storage.service.v1.UploadFileResponse
-	7, // [7:10] is the sub-list for method output_type
-	4, // [4:7] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	6,  // 0: storage.service.v1.DownloadFileRequest.storage_object:type_name -> storage.service.v1.StorageObject
+	7,  // 1: storage.service.v1.DownloadFileResponse.updated_at:type_name -> google.protobuf.Timestamp
+	6,  // 2: storage.service.v1.UploadFileRequest.storage_object:type_name -> storage.service.v1.StorageObject
+	8,  // 3: storage.service.v1.UploadFileRequest.presign:type_name -> storage.service.v1.PresignOption
+	5,  // 4: storage.service.v1.UploadFileResponse.form_data:type_name -> storage.service.v1.UploadFileResponse.FormDataEntry
+	0,  // 5: storage.service.v1.FileTransferService.DownloadFile:input_type -> storage.service.v1.DownloadFileRequest
+	9,  // 6: storage.service.v1.FileTransferService.PutUploadFile:input_type -> google.api.HttpBody
+	9,  // 7: storage.service.v1.FileTransferService.PostUploadFile:input_type -> google.api.HttpBody
+	4,  // 8: storage.service.v1.FileTransferService.CompletePresignedUpload:input_type -> storage.service.v1.CompletePresignedUploadRequest
+	9,  // 9: storage.service.v1.FileTransferService.DownloadFile:output_type -> google.api.HttpBody
+	3,  // 10: storage.service.v1.FileTransferService.PutUploadFile:output_type -> storage.service.v1.UploadFileResponse
+	3,  // 11: storage.service.v1.FileTransferService.PostUploadFile:output_type -> storage.service.v1.UploadFileResponse
+	10, // 12: storage.service.v1.FileTransferService.CompletePresignedUpload:output_type -> storage.service.v1.File
+	9,  // [9:13] is the sub-list for method output_type
+	5,  // [5:9] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_storage_service_v1_file_transfer_proto_init() }
@@ -579,6 +720,7 @@ func file_storage_service_v1_file_transfer_proto_init() {
 		return
 	}
 	file_storage_service_v1_oss_proto_init()
+	file_storage_service_v1_file_proto_init()
 	file_storage_service_v1_file_transfer_proto_msgTypes[0].OneofWrappers = []any{
 		(*DownloadFileRequest_FileId)(nil),
 		(*DownloadFileRequest_StorageObject)(nil),
@@ -599,7 +741,7 @@ func file_storage_service_v1_file_transfer_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_storage_service_v1_file_transfer_proto_rawDesc), len(file_storage_service_v1_file_transfer_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
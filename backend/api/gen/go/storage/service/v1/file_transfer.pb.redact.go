@@ -66,6 +66,17 @@ func (s *redactedFileTransferServiceServer) PostUploadFile(stream grpc.ClientStr
 	return s.srv.PostUploadFile(stream)
 }
 
+// CompletePresignedUpload is the redacted wrapper for the actual FileTransferServiceServer.CompletePresignedUpload method
+// Unary RPC
+func (s *redactedFileTransferServiceServer) CompletePresignedUpload(ctx context.Context, in *CompletePresignedUploadRequest) (*File, error) {
+	res, err := s.srv.CompletePresignedUpload(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
 // Redact method implementation for DownloadFileRequest
 func (x *DownloadFileRequest) Redact() string {
 	if x == nil {
@@ -83,6 +94,12 @@ func (x *DownloadFileRequest) Redact() string {
 	// Safe field: Disposition
 
 	// Safe field: AcceptMime
+
+	// Safe field: W
+
+	// Safe field: H
+
+	// Safe field: Fit
 	return x.String()
 }
 
@@ -131,5 +148,25 @@ func (x *UploadFileResponse) Redact() string {
 	// Safe field: ObjectName
 
 	// Safe field: PresignedUrl
+
+	// Safe field: BucketName
+
+	// Safe field: FormData
+
+	// Safe field: FileId
+
+	// Safe field: DownloadUrl
+	return x.String()
+}
+
+// Redact method implementation for CompletePresignedUploadRequest
+func (x *CompletePresignedUploadRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: BucketName
+
+	// Safe field: ObjectName
 	return x.String()
 }
@@ -151,6 +151,18 @@ func (m *DownloadFileRequest) validate(all bool) error {
 		// no validation rules for AcceptMime
 	}
 
+	if m.W != nil {
+		// no validation rules for W
+	}
+
+	if m.H != nil {
+		// no validation rules for H
+	}
+
+	if m.Fit != nil {
+		// no validation rules for Fit
+	}
+
 	if len(errors) > 0 {
 		return DownloadFileRequestMultiError(errors)
 	}
@@ -628,6 +640,8 @@ func (m *UploadFileResponse) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for FormData
+
 	if m.ObjectName != nil {
 		// no validation rules for ObjectName
 	}
@@ -636,6 +650,18 @@ func (m *UploadFileResponse) validate(all bool) error {
 		// no validation rules for PresignedUrl
 	}
 
+	if m.BucketName != nil {
+		// no validation rules for BucketName
+	}
+
+	if m.FileId != nil {
+		// no validation rules for FileId
+	}
+
+	if m.DownloadUrl != nil {
+		// no validation rules for DownloadUrl
+	}
+
 	if len(errors) > 0 {
 		return UploadFileResponseMultiError(errors)
 	}
@@ -715,3 +741,110 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = UploadFileResponseValidationError{}
+
+// Validate checks the field values on CompletePresignedUploadRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CompletePresignedUploadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CompletePresignedUploadRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CompletePresignedUploadRequestMultiError, or nil if none found.
+func (m *CompletePresignedUploadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CompletePresignedUploadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BucketName
+
+	// no validation rules for ObjectName
+
+	if len(errors) > 0 {
+		return CompletePresignedUploadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CompletePresignedUploadRequestMultiError is an error wrapping multiple
+// validation errors returned by CompletePresignedUploadRequest.ValidateAll()
+// if the designated constraints aren't met.
+type CompletePresignedUploadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CompletePresignedUploadRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CompletePresignedUploadRequestMultiError) AllErrors() []error { return m }
+
+// CompletePresignedUploadRequestValidationError is the validation error
+// returned by CompletePresignedUploadRequest.Validate if the designated
+// constraints aren't met.
+type CompletePresignedUploadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CompletePresignedUploadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CompletePresignedUploadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CompletePresignedUploadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CompletePresignedUploadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CompletePresignedUploadRequestValidationError) ErrorName() string {
+	return "CompletePresignedUploadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CompletePresignedUploadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCompletePresignedUploadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CompletePresignedUploadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CompletePresignedUploadRequestValidationError{}
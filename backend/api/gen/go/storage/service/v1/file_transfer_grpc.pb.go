@@ -20,9 +20,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	FileTransferService_DownloadFile_FullMethodName   = "/storage.service.v1.FileTransferService/DownloadFile"
-	FileTransferService_PutUploadFile_FullMethodName  = "/storage.service.v1.FileTransferService/PutUploadFile"
-	FileTransferService_PostUploadFile_FullMethodName = "/storage.service.v1.FileTransferService/PostUploadFile"
+	FileTransferService_DownloadFile_FullMethodName            = "/storage.service.v1.FileTransferService/DownloadFile"
+	FileTransferService_PutUploadFile_FullMethodName           = "/storage.service.v1.FileTransferService/PutUploadFile"
+	FileTransferService_PostUploadFile_FullMethodName          = "/storage.service.v1.FileTransferService/PostUploadFile"
+	FileTransferService_CompletePresignedUpload_FullMethodName = "/storage.service.v1.FileTransferService/CompletePresignedUpload"
 )
 
 // FileTransferServiceClient is the client API for FileTransferService service.
@@ -37,6 +38,8 @@ type FileTransferServiceClient interface {
 	PutUploadFile(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[httpbody.HttpBody, UploadFileResponse], error)
 	// 上传文件 POST 方式
 	PostUploadFile(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[httpbody.HttpBody, UploadFileResponse], error)
+	// 预签名上传完成后登记文件
+	CompletePresignedUpload(ctx context.Context, in *CompletePresignedUploadRequest, opts ...grpc.CallOption) (*File, error)
 }
 
 type fileTransferServiceClient struct {
@@ -92,6 +95,16 @@ func (c *fileTransferServiceClient) PostUploadFile(ctx context.Context, opts ...
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type FileTransferService_PostUploadFileClient = grpc.ClientStreamingClient[httpbody.HttpBody, UploadFileResponse]
 
+func (c *fileTransferServiceClient) CompletePresignedUpload(ctx context.Context, in *CompletePresignedUploadRequest, opts ...grpc.CallOption) (*File, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(File)
+	err := c.cc.Invoke(ctx, FileTransferService_CompletePresignedUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FileTransferServiceServer is the server API for FileTransferService service.
 // All implementations must embed UnimplementedFileTransferServiceServer
 // for forward compatibility.
@@ -104,6 +117,8 @@ type FileTransferServiceServer interface {
 	PutUploadFile(grpc.ClientStreamingServer[httpbody.HttpBody, UploadFileResponse]) error
 	// 上传文件 POST 方式
 	PostUploadFile(grpc.ClientStreamingServer[httpbody.HttpBody, UploadFileResponse]) error
+	// 预签名上传完成后登记文件
+	CompletePresignedUpload(context.Context, *CompletePresignedUploadRequest) (*File, error)
 	mustEmbedUnimplementedFileTransferServiceServer()
 }
 
@@ -123,6 +138,9 @@ func (UnimplementedFileTransferServiceServer) PutUploadFile(grpc.ClientStreaming
 func (UnimplementedFileTransferServiceServer) PostUploadFile(grpc.ClientStreamingServer[httpbody.HttpBody, UploadFileResponse]) error {
 	return status.Error(codes.Unimplemented, "method PostUploadFile not implemented")
 }
+func (UnimplementedFileTransferServiceServer) CompletePresignedUpload(context.Context, *CompletePresignedUploadRequest) (*File, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompletePresignedUpload not implemented")
+}
 func (UnimplementedFileTransferServiceServer) mustEmbedUnimplementedFileTransferServiceServer() {}
 func (UnimplementedFileTransferServiceServer) testEmbeddedByValue()                             {}
 
@@ -169,13 +187,36 @@ func _FileTransferService_PostUploadFile_Handler(srv interface{}, stream grpc.Se
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type FileTransferService_PostUploadFileServer = grpc.ClientStreamingServer[httpbody.HttpBody, UploadFileResponse]
 
+func _FileTransferService_CompletePresignedUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompletePresignedUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileTransferServiceServer).CompletePresignedUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileTransferService_CompletePresignedUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileTransferServiceServer).CompletePresignedUpload(ctx, req.(*CompletePresignedUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FileTransferService_ServiceDesc is the grpc.ServiceDesc for FileTransferService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var FileTransferService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "storage.service.v1.FileTransferService",
 	HandlerType: (*FileTransferServiceServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CompletePresignedUpload",
+			Handler:    _FileTransferService_CompletePresignedUpload_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "DownloadFile",
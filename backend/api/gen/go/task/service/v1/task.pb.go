@@ -78,6 +78,56 @@ func (Task_Type) EnumDescriptor() ([]byte, []int) {
 	return file_task_service_v1_task_proto_rawDescGZIP(), []int{1, 0}
 }
 
+// 错过触发时间后的补偿策略
+type Task_MisfirePolicy int32
+
+const (
+	Task_FIRE_ONCE Task_MisfirePolicy = 0 // 错过的多次触发只补执行一次
+	Task_SKIP      Task_MisfirePolicy = 1 // 丢弃错过的触发，等待下一次触发时间
+	Task_CATCH_UP  Task_MisfirePolicy = 2 // 逐次补执行错过的触发
+)
+
+// Enum value maps for Task_MisfirePolicy.
+var (
+	Task_MisfirePolicy_name = map[int32]string{
+		0: "FIRE_ONCE",
+		1: "SKIP",
+		2: "CATCH_UP",
+	}
+	Task_MisfirePolicy_value = map[string]int32{
+		"FIRE_ONCE": 0,
+		"SKIP":      1,
+		"CATCH_UP":  2,
+	}
+)
+
+func (x Task_MisfirePolicy) Enum() *Task_MisfirePolicy {
+	p := new(Task_MisfirePolicy)
+	*p = x
+	return p
+}
+
+func (x Task_MisfirePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Task_MisfirePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_service_v1_task_proto_enumTypes[1].Descriptor()
+}
+
+func (Task_MisfirePolicy) Type() protoreflect.EnumType {
+	return &file_task_service_v1_task_proto_enumTypes[1]
+}
+
+func (x Task_MisfirePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Task_MisfirePolicy.Descriptor instead.
+func (Task_MisfirePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_task_service_v1_task_proto_rawDescGZIP(), []int{1, 1}
+}
+
 // 调度任务控制类型
 type ControlTaskRequest_ControlType int32
 
@@ -85,6 +135,9 @@ const (
 	ControlTaskRequest_Start   ControlTaskRequest_ControlType = 0 // 启动
 	ControlTaskRequest_Stop    ControlTaskRequest_ControlType = 1 // 停止
 	ControlTaskRequest_Restart ControlTaskRequest_ControlType = 2 // 重启
+	ControlTaskRequest_Pause   ControlTaskRequest_ControlType = 3 // 暂停工作流运行
+	ControlTaskRequest_Resume  ControlTaskRequest_ControlType = 4 // 恢复工作流运行，失败或已取消的运行从未完成的步骤继续
+	ControlTaskRequest_Cancel  ControlTaskRequest_ControlType = 5 // 取消工作流运行
 )
 
 // Enum value maps for ControlTaskRequest_ControlType.
@@ -93,11 +146,17 @@ var (
 		0: "Start",
 		1: "Stop",
 		2: "Restart",
+		3: "Pause",
+		4: "Resume",
+		5: "Cancel",
 	}
 	ControlTaskRequest_ControlType_value = map[string]int32{
 		"Start":   0,
 		"Stop":    1,
 		"Restart": 2,
+		"Pause":   3,
+		"Resume":  4,
+		"Cancel":  5,
 	}
 )
 
@@ -112,11 +171,11 @@ func (x ControlTaskRequest_ControlType) String() string {
 }
 
 func (ControlTaskRequest_ControlType) Descriptor() protoreflect.EnumDescriptor {
-	return file_task_service_v1_task_proto_enumTypes[1].Descriptor()
+	return file_task_service_v1_task_proto_enumTypes[2].Descriptor()
 }
 
 func (ControlTaskRequest_ControlType) Type() protoreflect.EnumType {
-	return &file_task_service_v1_task_proto_enumTypes[1]
+	return &file_task_service_v1_task_proto_enumTypes[2]
 }
 
 func (x ControlTaskRequest_ControlType) Number() protoreflect.EnumNumber {
@@ -240,21 +299,23 @@ func (x *TaskOption) GetTaskId() string {
 // 调度任务
 type Task struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`                                     // 任务ID
-	Type          *Task_Type             `protobuf:"varint,2,opt,name=type,proto3,enum=task.service.v1.Task_Type,oneof" json:"type,omitempty"`  // 任务类型
-	TypeName      *string                `protobuf:"bytes,3,opt,name=type_name,json=typeName,proto3,oneof" json:"type_name,omitempty"`          // 任务执行类型名
-	TaskPayload   *string                `protobuf:"bytes,4,opt,name=task_payload,json=taskPayload,proto3,oneof" json:"task_payload,omitempty"` // 任务数据，以 JSON 格式存储，方便存储不同类型和数量的参数
-	CronSpec      *string                `protobuf:"bytes,5,opt,name=cron_spec,json=cronSpec,proto3,oneof" json:"cron_spec,omitempty"`          // cron表达式
-	TaskOptions   *TaskOption            `protobuf:"bytes,6,opt,name=task_options,json=taskOptions,proto3,oneof" json:"task_options,omitempty"` // 任务选项
-	Enable        *bool                  `protobuf:"varint,10,opt,name=enable,proto3,oneof" json:"enable,omitempty"`                            // 启用/禁用任务
-	Remark        *string                `protobuf:"bytes,11,opt,name=remark,proto3,oneof" json:"remark,omitempty"`                             // 备注
-	TenantId      *uint32                `protobuf:"varint,20,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`        // 租户ID，0代表系统全局角色
-	CreatedBy     *uint32                `protobuf:"varint,100,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`    // 创建者ID
-	UpdatedBy     *uint32                `protobuf:"varint,101,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`    // 更新者ID
-	DeletedBy     *uint32                `protobuf:"varint,102,opt,name=deleted_by,json=deletedBy,proto3,oneof" json:"deleted_by,omitempty"`    // 删除者用户ID
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,200,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`     // 创建时间
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,201,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`     // 更新时间
-	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,202,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`     // 删除时间
+	Id            *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`                                                                                    // 任务ID
+	Type          *Task_Type             `protobuf:"varint,2,opt,name=type,proto3,enum=task.service.v1.Task_Type,oneof" json:"type,omitempty"`                                                 // 任务类型
+	TypeName      *string                `protobuf:"bytes,3,opt,name=type_name,json=typeName,proto3,oneof" json:"type_name,omitempty"`                                                         // 任务执行类型名
+	TaskPayload   *string                `protobuf:"bytes,4,opt,name=task_payload,json=taskPayload,proto3,oneof" json:"task_payload,omitempty"`                                                // 任务数据，以 JSON 格式存储，方便存储不同类型和数量的参数
+	CronSpec      *string                `protobuf:"bytes,5,opt,name=cron_spec,json=cronSpec,proto3,oneof" json:"cron_spec,omitempty"`                                                         // cron表达式
+	TaskOptions   *TaskOption            `protobuf:"bytes,6,opt,name=task_options,json=taskOptions,proto3,oneof" json:"task_options,omitempty"`                                                // 任务选项
+	Timezone      *string                `protobuf:"bytes,7,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`                                                                         // cron表达式使用的时区
+	MisfirePolicy *Task_MisfirePolicy    `protobuf:"varint,8,opt,name=misfire_policy,json=misfirePolicy,proto3,enum=task.service.v1.Task_MisfirePolicy,oneof" json:"misfire_policy,omitempty"` // 错过触发时间后的补偿策略
+	Enable        *bool                  `protobuf:"varint,10,opt,name=enable,proto3,oneof" json:"enable,omitempty"`                                                                           // 启用/禁用任务
+	Remark        *string                `protobuf:"bytes,11,opt,name=remark,proto3,oneof" json:"remark,omitempty"`                                                                            // 备注
+	TenantId      *uint32                `protobuf:"varint,20,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`                                                       // 租户ID，0代表系统全局角色
+	CreatedBy     *uint32                `protobuf:"varint,100,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`                                                   // 创建者ID
+	UpdatedBy     *uint32                `protobuf:"varint,101,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`                                                   // 更新者ID
+	DeletedBy     *uint32                `protobuf:"varint,102,opt,name=deleted_by,json=deletedBy,proto3,oneof" json:"deleted_by,omitempty"`                                                   // 删除者用户ID
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,200,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`                                                    // 创建时间
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,201,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`                                                    // 更新时间
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,202,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`                                                    // 删除时间
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -331,6 +392,20 @@ func (x *Task) GetTaskOptions() *TaskOption {
 	return nil
 }
 
+func (x *Task) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
+func (x *Task) GetMisfirePolicy() Task_MisfirePolicy {
+	if x != nil && x.MisfirePolicy != nil {
+		return *x.MisfirePolicy
+	}
+	return Task_FIRE_ONCE
+}
+
 func (x *Task) GetEnable() bool {
 	if x != nil && x.Enable != nil {
 		return *x.Enable
@@ -769,6 +844,7 @@ type ControlTaskRequest struct {
 	state         protoimpl.MessageState         `protogen:"open.v1"`
 	ControlType   ControlTaskRequest_ControlType `protobuf:"varint,1,opt,name=control_type,json=controlType,proto3,enum=task.service.v1.ControlTaskRequest_ControlType" json:"control_type,omitempty"` // 控制类型
 	TypeName      string                         `protobuf:"bytes,2,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`                                                               // 任务执行类型名
+	WorkflowRunId *uint32                        `protobuf:"varint,3,opt,name=workflow_run_id,json=workflowRunId,proto3,oneof" json:"workflow_run_id,omitempty"`                                       // 工作流运行ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -817,6 +893,13 @@ func (x *ControlTaskRequest) GetTypeName() string {
 	return ""
 }
 
+func (x *ControlTaskRequest) GetWorkflowRunId() uint32 {
+	if x != nil && x.WorkflowRunId != nil {
+		return *x.WorkflowRunId
+	}
+	return 0
+}
+
 // 任务类型名称列表 - 回应
 type ListTaskTypeNameResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -906,6 +989,136 @@ func (x *CountTaskResponse) GetCount() uint64 {
 	return 0
 }
 
+// 预览下次执行时间 - 请求
+type ListNextRunTimesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`                            // 任务ID
+	CronSpec      *string                `protobuf:"bytes,2,opt,name=cron_spec,json=cronSpec,proto3,oneof" json:"cron_spec,omitempty"` // cron表达式
+	Timezone      *string                `protobuf:"bytes,3,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`                 // 时区
+	Count         *uint32                `protobuf:"varint,4,opt,name=count,proto3,oneof" json:"count,omitempty"`                      // 返回的执行时间数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNextRunTimesRequest) Reset() {
+	*x = ListNextRunTimesRequest{}
+	mi := &file_task_service_v1_task_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNextRunTimesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNextRunTimesRequest) ProtoMessage() {}
+
+func (x *ListNextRunTimesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_service_v1_task_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNextRunTimesRequest.ProtoReflect.Descriptor instead.
+func (*ListNextRunTimesRequest) Descriptor() ([]byte, []int) {
+	return file_task_service_v1_task_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListNextRunTimesRequest) GetId() uint32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *ListNextRunTimesRequest) GetCronSpec() string {
+	if x != nil && x.CronSpec != nil {
+		return *x.CronSpec
+	}
+	return ""
+}
+
+func (x *ListNextRunTimesRequest) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
+func (x *ListNextRunTimesRequest) GetCount() uint32 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+// 预览下次执行时间 - 回应
+type ListNextRunTimesResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Times         []*timestamppb.Timestamp `protobuf:"bytes,1,rep,name=times,proto3" json:"times,omitempty"`                             // 下次执行时间列表
+	LocalTimes    []string                 `protobuf:"bytes,2,rep,name=local_times,json=localTimes,proto3" json:"local_times,omitempty"` // 按任务时区格式化的执行时间
+	Timezone      string                   `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`                       // 计算所使用的时区
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNextRunTimesResponse) Reset() {
+	*x = ListNextRunTimesResponse{}
+	mi := &file_task_service_v1_task_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNextRunTimesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNextRunTimesResponse) ProtoMessage() {}
+
+func (x *ListNextRunTimesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_service_v1_task_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNextRunTimesResponse.ProtoReflect.Descriptor instead.
+func (*ListNextRunTimesResponse) Descriptor() ([]byte, []int) {
+	return file_task_service_v1_task_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListNextRunTimesResponse) GetTimes() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.Times
+	}
+	return nil
+}
+
+func (x *ListNextRunTimesResponse) GetLocalTimes() []string {
+	if x != nil {
+		return x.LocalTimes
+	}
+	return nil
+}
+
+func (x *ListNextRunTimesResponse) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
 var File_task_service_v1_task_proto protoreflect.FileDescriptor
 
 const file_task_service_v1_task_proto_rawDesc = "" +
@@ -937,35 +1150,41 @@ const file_task_service_v1_task_proto_rawDesc = "" +
 	"_retentionB\b\n" +
 	"\x06_groupB\n" +
 	"\n" +
-	"\b_task_id\"\xed\v\n" +
+	"\b_task_id\"\xf1\x0e\n" +
 	"\x04Task\x12&\n" +
 	"\x02id\x18\x01 \x01(\rB\x11\xe0A\x01\xbaG\v\x92\x02\b任务IDH\x00R\x02id\x88\x01\x01\x12J\n" +
 	"\x04type\x18\x02 \x01(\x0e2\x1a.task.service.v1.Task.TypeB\x15\xe0A\x01\xbaG\x0f\x92\x02\f任务类型H\x01R\x04type\x88\x01\x01\x12\x92\x01\n" +
 	"\ttype_name\x18\x03 \x01(\tBp\xe0A\x01\xbaGj\x92\x02g任务执行类型名，例如 \"send_email\"、\"generate_report\" 等，用于区分不同类型的任务H\x02R\btypeName\x88\x01\x01\x12\x82\x01\n" +
 	"\ftask_payload\x18\x04 \x01(\tBZ\xe0A\x01\xbaGT\x92\x02Q任务数据，以 JSON 格式存储，方便存储不同类型和数量的参数H\x03R\vtaskPayload\x88\x01\x01\x12\\\n" +
 	"\tcron_spec\x18\x05 \x01(\tB:\xe0A\x01\xbaG4\x92\x021cron表达式，用于定义任务的调度时间H\x04R\bcronSpec\x88\x01\x01\x12\x9f\x01\n" +
-	"\ftask_options\x18\x06 \x01(\v2\x1b.task.service.v1.TaskOptionBZ\xe0A\x01\xbaGT\x92\x02Q任务选项，以 JSON 格式存储，方便存储不同类型和数量的选项H\x05R\vtaskOptions\x88\x01\x01\x126\n" +
+	"\ftask_options\x18\x06 \x01(\v2\x1b.task.service.v1.TaskOptionBZ\xe0A\x01\xbaGT\x92\x02Q任务选项，以 JSON 格式存储，方便存储不同类型和数量的选项H\x05R\vtaskOptions\x88\x01\x01\x12\x8a\x01\n" +
+	"\btimezone\x18\a \x01(\tBi\xe0A\x01\xbaGc\x92\x02`cron表达式使用的IANA时区，例如 \"Asia/Shanghai\"，为空时使用服务器本地时区H\x06R\btimezone\x88\x01\x01\x12\x9c\x01\n" +
+	"\x0emisfire_policy\x18\b \x01(\x0e2#.task.service.v1.Task.MisfirePolicyBK\xe0A\x01\xbaGE\x92\x02B停机或主节点切换导致错过触发时间后的补偿策略H\aR\rmisfirePolicy\x88\x01\x01\x126\n" +
 	"\x06enable\x18\n" +
-	" \x01(\bB\x19\xbaG\x16\x92\x02\x13启用/禁用任务H\x06R\x06enable\x88\x01\x01\x12)\n" +
-	"\x06remark\x18\v \x01(\tB\f\xbaG\t\x92\x02\x06备注H\aR\x06remark\x88\x01\x01\x12L\n" +
-	"\ttenant_id\x18\x14 \x01(\rB*\xbaG'\x92\x02$租户ID，0代表系统全局角色H\bR\btenantId\x88\x01\x01\x125\n" +
+	" \x01(\bB\x19\xbaG\x16\x92\x02\x13启用/禁用任务H\bR\x06enable\x88\x01\x01\x12)\n" +
+	"\x06remark\x18\v \x01(\tB\f\xbaG\t\x92\x02\x06备注H\tR\x06remark\x88\x01\x01\x12L\n" +
+	"\ttenant_id\x18\x14 \x01(\rB*\xbaG'\x92\x02$租户ID，0代表系统全局角色H\n" +
+	"R\btenantId\x88\x01\x01\x125\n" +
 	"\n" +
-	"created_by\x18d \x01(\rB\x11\xbaG\x0e\x92\x02\v创建者IDH\tR\tcreatedBy\x88\x01\x01\x125\n" +
+	"created_by\x18d \x01(\rB\x11\xbaG\x0e\x92\x02\v创建者IDH\vR\tcreatedBy\x88\x01\x01\x125\n" +
 	"\n" +
-	"updated_by\x18e \x01(\rB\x11\xbaG\x0e\x92\x02\v更新者IDH\n" +
-	"R\tupdatedBy\x88\x01\x01\x12;\n" +
+	"updated_by\x18e \x01(\rB\x11\xbaG\x0e\x92\x02\v更新者IDH\fR\tupdatedBy\x88\x01\x01\x12;\n" +
 	"\n" +
-	"deleted_by\x18f \x01(\rB\x17\xbaG\x14\x92\x02\x11删除者用户IDH\vR\tdeletedBy\x88\x01\x01\x12S\n" +
+	"deleted_by\x18f \x01(\rB\x17\xbaG\x14\x92\x02\x11删除者用户IDH\rR\tdeletedBy\x88\x01\x01\x12S\n" +
 	"\n" +
-	"created_at\x18\xc8\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f创建时间H\fR\tcreatedAt\x88\x01\x01\x12S\n" +
+	"created_at\x18\xc8\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f创建时间H\x0eR\tcreatedAt\x88\x01\x01\x12S\n" +
 	"\n" +
-	"updated_at\x18\xc9\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f更新时间H\rR\tupdatedAt\x88\x01\x01\x12S\n" +
+	"updated_at\x18\xc9\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f更新时间H\x0fR\tupdatedAt\x88\x01\x01\x12S\n" +
 	"\n" +
-	"deleted_at\x18\xca\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f删除时间H\x0eR\tdeletedAt\x88\x01\x01\"0\n" +
+	"deleted_at\x18\xca\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f删除时间H\x10R\tdeletedAt\x88\x01\x01\"0\n" +
 	"\x04Type\x12\f\n" +
 	"\bPERIODIC\x10\x00\x12\t\n" +
 	"\x05DELAY\x10\x01\x12\x0f\n" +
-	"\vWAIT_RESULT\x10\x02B\x05\n" +
+	"\vWAIT_RESULT\x10\x02\"6\n" +
+	"\rMisfirePolicy\x12\r\n" +
+	"\tFIRE_ONCE\x10\x00\x12\b\n" +
+	"\x04SKIP\x10\x01\x12\f\n" +
+	"\bCATCH_UP\x10\x02B\x05\n" +
 	"\x03_idB\a\n" +
 	"\x05_typeB\f\n" +
 	"\n" +
@@ -973,7 +1192,9 @@ const file_task_service_v1_task_proto_rawDesc = "" +
 	"\r_task_payloadB\f\n" +
 	"\n" +
 	"_cron_specB\x0f\n" +
-	"\r_task_optionsB\t\n" +
+	"\r_task_optionsB\v\n" +
+	"\t_timezoneB\x11\n" +
+	"\x0f_misfire_policyB\t\n" +
 	"\a_enableB\t\n" +
 	"\a_remarkB\f\n" +
 	"\n" +
@@ -1011,19 +1232,41 @@ const file_task_service_v1_task_proto_rawDesc = "" +
 	"\n" +
 	"\bquery_by\".\n" +
 	"\x16RestartAllTaskResponse\x12\x14\n" +
-	"\x05count\x18\x01 \x01(\x05R\x05count\"\xbd\x02\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\"\x9e\x04\n" +
 	"\x12ControlTaskRequest\x12f\n" +
 	"\fcontrol_type\x18\x01 \x01(\x0e2/.task.service.v1.ControlTaskRequest.ControlTypeB\x12\xbaG\x0f\x92\x02\f控制类型R\vcontrolType\x12\x8d\x01\n" +
-	"\ttype_name\x18\x02 \x01(\tBp\xe0A\x01\xbaGj\x92\x02g任务执行类型名，例如 \"send_email\"、\"generate_report\" 等，用于区分不同类型的任务R\btypeName\"/\n" +
+	"\ttype_name\x18\x02 \x01(\tBp\xe0A\x01\xbaGj\x92\x02g任务执行类型名，例如 \"send_email\"、\"generate_report\" 等，用于区分不同类型的任务R\btypeName\x12\xa7\x01\n" +
+	"\x0fworkflow_run_id\x18\x03 \x01(\rBz\xe0A\x01\xbaGt\x92\x02q工作流运行ID，设置时控制该工作流运行（支持 Pause、Resume、Cancel，Stop 等同于 Cancel）H\x00R\rworkflowRunId\x88\x01\x01\"R\n" +
 	"\vControlType\x12\t\n" +
 	"\x05Start\x10\x00\x12\b\n" +
 	"\x04Stop\x10\x01\x12\v\n" +
-	"\aRestart\x10\x02\"S\n" +
+	"\aRestart\x10\x02\x12\t\n" +
+	"\x05Pause\x10\x03\x12\n" +
+	"\n" +
+	"\x06Resume\x10\x04\x12\n" +
+	"\n" +
+	"\x06Cancel\x10\x05B\x12\n" +
+	"\x10_workflow_run_id\"S\n" +
 	"\x18ListTaskTypeNameResponse\x127\n" +
 	"\n" +
 	"type_names\x18\x01 \x03(\tB\x18\xbaG\x15\x92\x02\x12类型名称列表R\ttypeNames\")\n" +
 	"\x11CountTaskResponse\x12\x14\n" +
-	"\x05count\x18\x01 \x01(\x04R\x05count2\xb7\x06\n" +
+	"\x05count\x18\x01 \x01(\x04R\x05count\"\x8f\x03\n" +
+	"\x17ListNextRunTimesRequest\x12Z\n" +
+	"\x02id\x18\x01 \x01(\rBE\xe0A\x01\xbaG?\x92\x02<任务ID，设置时使用该任务的cron表达式与时区H\x00R\x02id\x88\x01\x01\x128\n" +
+	"\tcron_spec\x18\x02 \x01(\tB\x16\xe0A\x01\xbaG\x10\x92\x02\rcron表达式H\x01R\bcronSpec\x88\x01\x01\x12[\n" +
+	"\btimezone\x18\x03 \x01(\tB:\xe0A\x01\xbaG4\x92\x021IANA时区，为空时使用服务器本地时区H\x02R\btimezone\x88\x01\x01\x12U\n" +
+	"\x05count\x18\x04 \x01(\rB:\xe0A\x01\xbaG4\x92\x021返回的执行时间数量，默认5，最多100H\x03R\x05count\x88\x01\x01B\x05\n" +
+	"\x03_idB\f\n" +
+	"\n" +
+	"_cron_specB\v\n" +
+	"\t_timezoneB\b\n" +
+	"\x06_count\"\x85\x02\n" +
+	"\x18ListNextRunTimesResponse\x12P\n" +
+	"\x05times\x18\x01 \x03(\v2\x1a.google.protobuf.TimestampB\x1e\xbaG\x1b\x92\x02\x18下次执行时间列表R\x05times\x12[\n" +
+	"\vlocal_times\x18\x02 \x03(\tB:\xbaG7\x92\x024按任务时区格式化的执行时间（RFC3339）R\n" +
+	"localTimes\x12:\n" +
+	"\btimezone\x18\x03 \x01(\tB\x1e\xbaG\x1b\x92\x02\x18计算所使用的时区R\btimezone2\xa2\a\n" +
 	"\vTaskService\x12F\n" +
 	"\x04List\x12\x19.pagination.PagingRequest\x1a!.task.service.v1.ListTaskResponse\"\x00\x12H\n" +
 	"\x05Count\x12\x19.pagination.PagingRequest\x1a\".task.service.v1.CountTaskResponse\"\x00\x12?\n" +
@@ -1035,7 +1278,8 @@ const file_task_service_v1_task_proto_rawDesc = "" +
 	"\x0eRestartAllTask\x12\x16.google.protobuf.Empty\x1a'.task.service.v1.RestartAllTaskResponse\"\x00\x12@\n" +
 	"\fStartAllTask\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\"\x00\x12?\n" +
 	"\vStopAllTask\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\"\x00\x12L\n" +
-	"\vControlTask\x12#.task.service.v1.ControlTaskRequest\x1a\x16.google.protobuf.Empty\"\x00B\xaf\x01\n" +
+	"\vControlTask\x12#.task.service.v1.ControlTaskRequest\x1a\x16.google.protobuf.Empty\"\x00\x12i\n" +
+	"\x10ListNextRunTimes\x12(.task.service.v1.ListNextRunTimesRequest\x1a).task.service.v1.ListNextRunTimesResponse\"\x00B\xaf\x01\n" +
 	"\x13com.task.service.v1B\tTaskProtoP\x01Z/go-wind-admin/api/gen/go/task/service/v1;taskpb\xa2\x02\x03TSX\xaa\x02\x0fTask.Service.V1\xca\x02\x0fTask\\Service\\V1\xe2\x02\x1bTask\\Service\\V1\\GPBMetadata\xea\x02\x11Task::Service::V1b\x06proto3"
 
 var (
@@ -1050,73 +1294,80 @@ func file_task_service_v1_task_proto_rawDescGZIP() []byte {
 	return file_task_service_v1_task_proto_rawDescData
 }
 
-var file_task_service_v1_task_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_task_service_v1_task_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_task_service_v1_task_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_task_service_v1_task_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_task_service_v1_task_proto_goTypes = []any{
 	(Task_Type)(0),                      // 0: task.service.v1.Task.Type
-	(ControlTaskRequest_ControlType)(0), // 1: task.service.v1.ControlTaskRequest.ControlType
-	(*TaskOption)(nil),                  // 2: task.service.v1.TaskOption
-	(*Task)(nil),                        // 3: task.service.v1.Task
-	(*ListTaskResponse)(nil),            // 4: task.service.v1.ListTaskResponse
-	(*GetTaskRequest)(nil),              // 5: task.service.v1.GetTaskRequest
-	(*CreateTaskRequest)(nil),           // 6: task.service.v1.CreateTaskRequest
-	(*UpdateTaskRequest)(nil),           // 7: task.service.v1.UpdateTaskRequest
-	(*DeleteTaskRequest)(nil),           // 8: task.service.v1.DeleteTaskRequest
-	(*RestartAllTaskResponse)(nil),      // 9: task.service.v1.RestartAllTaskResponse
-	(*ControlTaskRequest)(nil),          // 10: task.service.v1.ControlTaskRequest
-	(*ListTaskTypeNameResponse)(nil),    // 11: task.service.v1.ListTaskTypeNameResponse
-	(*CountTaskResponse)(nil),           // 12: task.service.v1.CountTaskResponse
-	(*durationpb.Duration)(nil),         // 13: google.protobuf.Duration
-	(*timestamppb.Timestamp)(nil),       // 14: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),       // 15: google.protobuf.FieldMask
-	(*v1.PagingRequest)(nil),            // 16: pagination.PagingRequest
-	(*emptypb.Empty)(nil),               // 17: google.protobuf.Empty
+	(Task_MisfirePolicy)(0),             // 1: task.service.v1.Task.MisfirePolicy
+	(ControlTaskRequest_ControlType)(0), // 2: task.service.v1.ControlTaskRequest.ControlType
+	(*TaskOption)(nil),                  // 3: task.service.v1.TaskOption
+	(*Task)(nil),                        // 4: task.service.v1.Task
+	(*ListTaskResponse)(nil),            // 5: task.service.v1.ListTaskResponse
+	(*GetTaskRequest)(nil),              // 6: task.service.v1.GetTaskRequest
+	(*CreateTaskRequest)(nil),           // 7: task.service.v1.CreateTaskRequest
+	(*UpdateTaskRequest)(nil),           // 8: task.service.v1.UpdateTaskRequest
+	(*DeleteTaskRequest)(nil),           // 9: task.service.v1.DeleteTaskRequest
+	(*RestartAllTaskResponse)(nil),      // 10: task.service.v1.RestartAllTaskResponse
+	(*ControlTaskRequest)(nil),          // 11: task.service.v1.ControlTaskRequest
+	(*ListTaskTypeNameResponse)(nil),    // 12: task.service.v1.ListTaskTypeNameResponse
+	(*CountTaskResponse)(nil),           // 13: task.service.v1.CountTaskResponse
+	(*ListNextRunTimesRequest)(nil),     // 14: task.service.v1.ListNextRunTimesRequest
+	(*ListNextRunTimesResponse)(nil),    // 15: task.service.v1.ListNextRunTimesResponse
+	(*durationpb.Duration)(nil),         // 16: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),       // 17: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),       // 18: google.protobuf.FieldMask
+	(*v1.PagingRequest)(nil),            // 19: pagination.PagingRequest
+	(*emptypb.Empty)(nil),               // 20: google.protobuf.Empty
 }
 var file_task_service_v1_task_proto_depIdxs = []int32{
-	13, // 0: task.service.v1.TaskOption.timeout:type_name -> google.protobuf.Duration
-	14, // 1: task.service.v1.TaskOption.deadline:type_name -> google.protobuf.Timestamp
-	13, // 2: task.service.v1.TaskOption.process_in:type_name -> google.protobuf.Duration
-	14, // 3: task.service.v1.TaskOption.process_at:type_name -> google.protobuf.Timestamp
-	13, // 4: task.service.v1.TaskOption.unique_ttl:type_name -> google.protobuf.Duration
-	13, // 5: task.service.v1.TaskOption.retention:type_name -> google.protobuf.Duration
+	16, // 0: task.service.v1.TaskOption.timeout:type_name -> google.protobuf.Duration
+	17, // 1: task.service.v1.TaskOption.deadline:type_name -> google.protobuf.Timestamp
+	16, // 2: task.service.v1.TaskOption.process_in:type_name -> google.protobuf.Duration
+	17, // 3: task.service.v1.TaskOption.process_at:type_name -> google.protobuf.Timestamp
+	16, // 4: task.service.v1.TaskOption.unique_ttl:type_name -> google.protobuf.Duration
+	16, // 5: task.service.v1.TaskOption.retention:type_name -> google.protobuf.Duration
 	0,  // 6: task.service.v1.Task.type:type_name -> task.service.v1.Task.Type
-	2,  // 7: task.service.v1.Task.task_options:type_name -> task.service.v1.TaskOption
-	14, // 8: task.service.v1.Task.created_at:type_name -> google.protobuf.Timestamp
-	14, // 9: task.service.v1.Task.updated_at:type_name -> google.protobuf.Timestamp
-	14, // 10: task.service.v1.Task.deleted_at:type_name -> google.protobuf.Timestamp
-	3,  // 11: task.service.v1.ListTaskResponse.items:type_name -> task.service.v1.Task
-	15, // 12: task.service.v1.GetTaskRequest.view_mask:type_name -> google.protobuf.FieldMask
-	3,  // 13: task.service.v1.CreateTaskRequest.data:type_name -> task.service.v1.Task
-	3,  // 14: task.service.v1.UpdateTaskRequest.data:type_name -> task.service.v1.Task
-	15, // 15: task.service.v1.UpdateTaskRequest.update_mask:type_name -> google.protobuf.FieldMask
-	1,  // 16: task.service.v1.ControlTaskRequest.control_type:type_name -> task.service.v1.ControlTaskRequest.ControlType
-	16, // 17: task.service.v1.TaskService.List:input_type -> pagination.PagingRequest
-	16, // 18: task.service.v1.TaskService.Count:input_type -> pagination.PagingRequest
-	5,  // 19: task.service.v1.TaskService.Get:input_type -> task.service.v1.GetTaskRequest
-	6,  // 20: task.service.v1.TaskService.Create:input_type -> task.service.v1.CreateTaskRequest
-	7,  // 21: task.service.v1.TaskService.Update:input_type -> task.service.v1.UpdateTaskRequest
-	8,  // 22: task.service.v1.TaskService.Delete:input_type -> task.service.v1.DeleteTaskRequest
-	17, // 23: task.service.v1.TaskService.ListTaskTypeName:input_type -> google.protobuf.Empty
-	17, // 24: task.service.v1.TaskService.RestartAllTask:input_type -> google.protobuf.Empty
-	17, // 25: task.service.v1.TaskService.StartAllTask:input_type -> google.protobuf.Empty
-	17, // 26: task.service.v1.TaskService.StopAllTask:input_type -> google.protobuf.Empty
-	10, // 27: task.service.v1.TaskService.ControlTask:input_type -> task.service.v1.ControlTaskRequest
-	4,  // 28: task.service.v1.TaskService.List:output_type -> task.service.v1.ListTaskResponse
-	12, // 29: task.service.v1.TaskService.Count:output_type -> task.service.v1.CountTaskResponse
-	3,  // 30: task.service.v1.TaskService.Get:output_type -> task.service.v1.Task
-	17, // 31: task.service.v1.TaskService.Create:output_type -> google.protobuf.Empty
-	17, // 32: task.service.v1.TaskService.Update:output_type -> google.protobuf.Empty
-	17, // 33: task.service.v1.TaskService.Delete:output_type -> google.protobuf.Empty
-	11, // 34: task.service.v1.TaskService.ListTaskTypeName:output_type -> task.service.v1.ListTaskTypeNameResponse
-	9,  // 35: task.service.v1.TaskService.RestartAllTask:output_type -> task.service.v1.RestartAllTaskResponse
-	17, // 36: task.service.v1.TaskService.StartAllTask:output_type -> google.protobuf.Empty
-	17, // 37: task.service.v1.TaskService.StopAllTask:output_type -> google.protobuf.Empty
-	17, // 38: task.service.v1.TaskService.ControlTask:output_type -> google.protobuf.Empty
-	28, // [28:39] is the sub-list for method output_type
-	17, // [17:28] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	3,  // 7: task.service.v1.Task.task_options:type_name -> task.service.v1.TaskOption
+	1,  // 8: task.service.v1.Task.misfire_policy:type_name -> task.service.v1.Task.MisfirePolicy
+	17, // 9: task.service.v1.Task.created_at:type_name -> google.protobuf.Timestamp
+	17, // 10: task.service.v1.Task.updated_at:type_name -> google.protobuf.Timestamp
+	17, // 11: task.service.v1.Task.deleted_at:type_name -> google.protobuf.Timestamp
+	4,  // 12: task.service.v1.ListTaskResponse.items:type_name -> task.service.v1.Task
+	18, // 13: task.service.v1.GetTaskRequest.view_mask:type_name -> google.protobuf.FieldMask
+	4,  // 14: task.service.v1.CreateTaskRequest.data:type_name -> task.service.v1.Task
+	4,  // 15: task.service.v1.UpdateTaskRequest.data:type_name -> task.service.v1.Task
+	18, // 16: task.service.v1.UpdateTaskRequest.update_mask:type_name -> google.protobuf.FieldMask
+	2,  // 17: task.service.v1.ControlTaskRequest.control_type:type_name -> task.service.v1.ControlTaskRequest.ControlType
+	17, // 18: task.service.v1.ListNextRunTimesResponse.times:type_name -> google.protobuf.Timestamp
+	19, // 19: task.service.v1.TaskService.List:input_type -> pagination.PagingRequest
+	19, // 20: task.service.v1.TaskService.Count:input_type -> pagination.PagingRequest
+	6,  // 21: task.service.v1.TaskService.Get:input_type -> task.service.v1.GetTaskRequest
+	7,  // 22: task.service.v1.TaskService.Create:input_type -> task.service.v1.CreateTaskRequest
+	8,  // 23: task.service.v1.TaskService.Update:input_type -> task.service.v1.UpdateTaskRequest
+	9,  // 24: task.service.v1.TaskService.Delete:input_type -> task.service.v1.DeleteTaskRequest
+	20, // 25: task.service.v1.TaskService.ListTaskTypeName:input_type -> google.protobuf.Empty
+	20, // 26: task.service.v1.TaskService.RestartAllTask:input_type -> google.protobuf.Empty
+	20, // 27: task.service.v1.TaskService.StartAllTask:input_type -> google.protobuf.Empty
+	20, // 28: task.service.v1.TaskService.StopAllTask:input_type -> google.protobuf.Empty
+	11, // 29: task.service.v1.TaskService.ControlTask:input_type -> task.service.v1.ControlTaskRequest
+	14, // 30: task.service.v1.TaskService.ListNextRunTimes:input_type -> task.service.v1.ListNextRunTimesRequest
+	5,  // 31: task.service.v1.TaskService.List:output_type -> task.service.v1.ListTaskResponse
+	13, // 32: task.service.v1.TaskService.Count:output_type -> task.service.v1.CountTaskResponse
+	4,  // 33: task.service.v1.TaskService.Get:output_type -> task.service.v1.Task
+	20, // 34: task.service.v1.TaskService.Create:output_type -> google.protobuf.Empty
+	20, // 35: task.service.v1.TaskService.Update:output_type -> google.protobuf.Empty
+	20, // 36: task.service.v1.TaskService.Delete:output_type -> google.protobuf.Empty
+	12, // 37: task.service.v1.TaskService.ListTaskTypeName:output_type -> task.service.v1.ListTaskTypeNameResponse
+	10, // 38: task.service.v1.TaskService.RestartAllTask:output_type -> task.service.v1.RestartAllTaskResponse
+	20, // 39: task.service.v1.TaskService.StartAllTask:output_type -> google.protobuf.Empty
+	20, // 40: task.service.v1.TaskService.StopAllTask:output_type -> google.protobuf.Empty
+	20, // 41: task.service.v1.TaskService.ControlTask:output_type -> google.protobuf.Empty
+	15, // 42: task.service.v1.TaskService.ListNextRunTimes:output_type -> task.service.v1.ListNextRunTimesResponse
+	31, // [31:43] is the sub-list for method output_type
+	19, // [19:31] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_task_service_v1_task_proto_init() }
@@ -1134,13 +1385,15 @@ func file_task_service_v1_task_proto_init() {
 	file_task_service_v1_task_proto_msgTypes[6].OneofWrappers = []any{
 		(*DeleteTaskRequest_Id)(nil),
 	}
+	file_task_service_v1_task_proto_msgTypes[8].OneofWrappers = []any{}
+	file_task_service_v1_task_proto_msgTypes[11].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_task_service_v1_task_proto_rawDesc), len(file_task_service_v1_task_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   11,
+			NumEnums:      3,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -171,6 +171,17 @@ func (s *redactedTaskServiceServer) ControlTask(ctx context.Context, in *Control
 	return res, err
 }
 
+// ListNextRunTimes is the redacted wrapper for the actual TaskServiceServer.ListNextRunTimes method
+// Unary RPC
+func (s *redactedTaskServiceServer) ListNextRunTimes(ctx context.Context, in *ListNextRunTimesRequest) (*ListNextRunTimesResponse, error) {
+	res, err := s.srv.ListNextRunTimes(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
 // Redact method implementation for TaskOption
 func (x *TaskOption) Redact() string {
 	if x == nil {
@@ -215,6 +226,10 @@ func (x *Task) Redact() string {
 
 	// Safe field: TaskOptions
 
+	// Safe field: Timezone
+
+	// Safe field: MisfirePolicy
+
 	// Safe field: Enable
 
 	// Safe field: Remark
@@ -310,6 +325,8 @@ func (x *ControlTaskRequest) Redact() string {
 	// Safe field: ControlType
 
 	// Safe field: TypeName
+
+	// Safe field: WorkflowRunId
 	return x.String()
 }
 
@@ -332,3 +349,33 @@ func (x *CountTaskResponse) Redact() string {
 	// Safe field: Count
 	return x.String()
 }
+
+// Redact method implementation for ListNextRunTimesRequest
+func (x *ListNextRunTimesRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: CronSpec
+
+	// Safe field: Timezone
+
+	// Safe field: Count
+	return x.String()
+}
+
+// Redact method implementation for ListNextRunTimesResponse
+func (x *ListNextRunTimesResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Times
+
+	// Safe field: LocalTimes
+
+	// Safe field: Timezone
+	return x.String()
+}
@@ -418,6 +418,14 @@ func (m *Task) validate(all bool) error {
 
 	}
 
+	if m.Timezone != nil {
+		// no validation rules for Timezone
+	}
+
+	if m.MisfirePolicy != nil {
+		// no validation rules for MisfirePolicy
+	}
+
 	if m.Enable != nil {
 		// no validation rules for Enable
 	}
@@ -1462,6 +1470,10 @@ func (m *ControlTaskRequest) validate(all bool) error {
 
 	// no validation rules for TypeName
 
+	if m.WorkflowRunId != nil {
+		// no validation rules for WorkflowRunId
+	}
+
 	if len(errors) > 0 {
 		return ControlTaskRequestMultiError(errors)
 	}
@@ -1747,3 +1759,259 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = CountTaskResponseValidationError{}
+
+// Validate checks the field values on ListNextRunTimesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListNextRunTimesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListNextRunTimesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListNextRunTimesRequestMultiError, or nil if none found.
+func (m *ListNextRunTimesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListNextRunTimesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Id != nil {
+		// no validation rules for Id
+	}
+
+	if m.CronSpec != nil {
+		// no validation rules for CronSpec
+	}
+
+	if m.Timezone != nil {
+		// no validation rules for Timezone
+	}
+
+	if m.Count != nil {
+		// no validation rules for Count
+	}
+
+	if len(errors) > 0 {
+		return ListNextRunTimesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListNextRunTimesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListNextRunTimesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListNextRunTimesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListNextRunTimesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListNextRunTimesRequestMultiError) AllErrors() []error { return m }
+
+// ListNextRunTimesRequestValidationError is the validation error returned by
+// ListNextRunTimesRequest.Validate if the designated constraints aren't met.
+type ListNextRunTimesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNextRunTimesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNextRunTimesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNextRunTimesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNextRunTimesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNextRunTimesRequestValidationError) ErrorName() string {
+	return "ListNextRunTimesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListNextRunTimesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNextRunTimesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNextRunTimesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNextRunTimesRequestValidationError{}
+
+// Validate checks the field values on ListNextRunTimesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListNextRunTimesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListNextRunTimesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListNextRunTimesResponseMultiError, or nil if none found.
+func (m *ListNextRunTimesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListNextRunTimesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTimes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListNextRunTimesResponseValidationError{
+						field:  fmt.Sprintf("Times[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListNextRunTimesResponseValidationError{
+						field:  fmt.Sprintf("Times[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListNextRunTimesResponseValidationError{
+					field:  fmt.Sprintf("Times[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Timezone
+
+	if len(errors) > 0 {
+		return ListNextRunTimesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListNextRunTimesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListNextRunTimesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListNextRunTimesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListNextRunTimesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListNextRunTimesResponseMultiError) AllErrors() []error { return m }
+
+// ListNextRunTimesResponseValidationError is the validation error returned by
+// ListNextRunTimesResponse.Validate if the designated constraints aren't met.
+type ListNextRunTimesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNextRunTimesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNextRunTimesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNextRunTimesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNextRunTimesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNextRunTimesResponseValidationError) ErrorName() string {
+	return "ListNextRunTimesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListNextRunTimesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNextRunTimesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNextRunTimesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNextRunTimesResponseValidationError{}
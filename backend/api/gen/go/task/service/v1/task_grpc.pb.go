@@ -32,6 +32,7 @@ const (
 	TaskService_StartAllTask_FullMethodName     = "/task.service.v1.TaskService/StartAllTask"
 	TaskService_StopAllTask_FullMethodName      = "/task.service.v1.TaskService/StopAllTask"
 	TaskService_ControlTask_FullMethodName      = "/task.service.v1.TaskService/ControlTask"
+	TaskService_ListNextRunTimes_FullMethodName = "/task.service.v1.TaskService/ListNextRunTimes"
 )
 
 // TaskServiceClient is the client API for TaskService service.
@@ -62,6 +63,8 @@ type TaskServiceClient interface {
 	StopAllTask(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// 控制调度任务
 	ControlTask(ctx context.Context, in *ControlTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 预览周期任务的下次执行时间
+	ListNextRunTimes(ctx context.Context, in *ListNextRunTimesRequest, opts ...grpc.CallOption) (*ListNextRunTimesResponse, error)
 }
 
 type taskServiceClient struct {
@@ -182,6 +185,16 @@ func (c *taskServiceClient) ControlTask(ctx context.Context, in *ControlTaskRequ
 	return out, nil
 }
 
+func (c *taskServiceClient) ListNextRunTimes(ctx context.Context, in *ListNextRunTimesRequest, opts ...grpc.CallOption) (*ListNextRunTimesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNextRunTimesResponse)
+	err := c.cc.Invoke(ctx, TaskService_ListNextRunTimes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TaskServiceServer is the server API for TaskService service.
 // All implementations must embed UnimplementedTaskServiceServer
 // for forward compatibility.
@@ -210,6 +223,8 @@ type TaskServiceServer interface {
 	StopAllTask(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	// 控制调度任务
 	ControlTask(context.Context, *ControlTaskRequest) (*emptypb.Empty, error)
+	// 预览周期任务的下次执行时间
+	ListNextRunTimes(context.Context, *ListNextRunTimesRequest) (*ListNextRunTimesResponse, error)
 	mustEmbedUnimplementedTaskServiceServer()
 }
 
@@ -253,6 +268,9 @@ func (UnimplementedTaskServiceServer) StopAllTask(context.Context, *emptypb.Empt
 func (UnimplementedTaskServiceServer) ControlTask(context.Context, *ControlTaskRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method ControlTask not implemented")
 }
+func (UnimplementedTaskServiceServer) ListNextRunTimes(context.Context, *ListNextRunTimesRequest) (*ListNextRunTimesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListNextRunTimes not implemented")
+}
 func (UnimplementedTaskServiceServer) mustEmbedUnimplementedTaskServiceServer() {}
 func (UnimplementedTaskServiceServer) testEmbeddedByValue()                     {}
 
@@ -472,6 +490,24 @@ func _TaskService_ControlTask_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaskService_ListNextRunTimes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNextRunTimesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListNextRunTimes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListNextRunTimes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListNextRunTimes(ctx, req.(*ListNextRunTimesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TaskService_ServiceDesc is the grpc.ServiceDesc for TaskService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -523,6 +559,10 @@ var TaskService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ControlTask",
 			Handler:    _TaskService_ControlTask_Handler,
 		},
+		{
+			MethodName: "ListNextRunTimes",
+			Handler:    _TaskService_ListNextRunTimes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "task/service/v1/task.proto",
@@ -50,9 +50,17 @@ func initApp(context *bootstrap.Context) (*kratos.App, func(), error) {
 	apiRepo := data.NewApiRepo(context, entClient)
 	provider := data.NewAuthorizerProvider(context, roleRepo, apiRepo)
 	authorizerAuthorizer := authorizer.NewAuthorizer(context, provider)
-	apiAuditLogRepo := data.NewApiAuditLogRepo(context, entClient)
-	loginAuditLogRepo := data.NewLoginAuditLogRepo(context, entClient)
-	v := server.NewRestMiddleware(context, accessTokenChecker, authorizerAuthorizer, apiAuditLogRepo, loginAuditLogRepo)
+	auditForwarderRepo := data.NewAuditForwarderRepo(context, entClient)
+	auditEventForwarder, cleanup3, err := data.NewAuditEventForwarder(context, auditForwarderRepo)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	apiAuditLogRepo := data.NewApiAuditLogRepo(context, entClient, auditEventForwarder)
+	loginAuditLogRepo := data.NewLoginAuditLogRepo(context, entClient, auditEventForwarder)
+	loginRiskEngine := data.NewLoginRiskEngine(context, loginAuditLogRepo)
+	v := server.NewRestMiddleware(context, accessTokenChecker, authorizerAuthorizer, apiAuditLogRepo, loginAuditLogRepo, loginRiskEngine)
 	userRoleRepo := data.NewUserRoleRepo(context, entClient)
 	userOrgUnitRepo := data.NewUserOrgUnitRepo(context, entClient)
 	userPositionRepo := data.NewUserPositionRepo(context, entClient)
@@ -66,29 +74,99 @@ func initApp(context *bootstrap.Context) (*kratos.App, func(), error) {
 	tenantRepo := data.NewTenantRepo(context, entClient)
 	orgUnitRepo := data.NewOrgUnitRepo(context, entClient)
 	captcha := data.NewCaptcha(client)
-	authenticationService := service.NewAuthenticationService(context, userRepo, userCredentialRepo, roleRepo, tenantRepo, membershipRepo, orgUnitRepo, permissionRepo, authenticator, clientType, captcha)
+	manager, cleanup4, err := data.NewEventBusManager(context)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	minIOClient := data.NewMinIoClient(context)
+	objectStorageRouter, err := data.NewObjectStorageRouter(context, minIOClient, tenantRepo)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	objectStorage := data.NewObjectStorage(objectStorageRouter)
+	dictEntryI18nRepo := data.NewDictEntryI18nRepo(context, entClient)
+	dictEntryRepo := data.NewDictEntryRepo(context, entClient, dictEntryI18nRepo)
+	luaDataProvider := data.NewLuaDataProvider(context, userRepo, orgUnitRepo, roleRepo, dictEntryRepo, membershipRepo)
+	engine, cleanup5, err := data.NewLuaEngine(context, client, manager, objectStorage, luaDataProvider)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	luaHookRunner := data.NewLuaHookRunner(context, engine)
+	authenticationService := service.NewAuthenticationService(context, userRepo, userCredentialRepo, roleRepo, tenantRepo, membershipRepo, orgUnitRepo, permissionRepo, authenticator, clientType, captcha, loginRiskEngine, luaHookRunner)
 	loginPolicyRepo := data.NewLoginPolicyRepo(context, entClient)
 	loginPolicyService := service.NewLoginPolicyService(context, loginPolicyRepo)
 	menuRepo := data.NewMenuRepo(context, entClient)
 	adminPortalService := service.NewAdminPortalService(context, menuRepo, roleRepo, userRepo, permissionRepo)
 	taskRepo := data.NewTaskRepo(context, entClient)
-	taskService := service.NewTaskService(context, taskRepo, userRepo)
+	taskRunRepo := data.NewTaskRunRepo(context, entClient)
+	luaTaskService := service.NewLuaTaskService(context, engine)
+	taskWorkflowRepo := data.NewTaskWorkflowRepo(context, entClient)
+	taskWorkflowRunRepo := data.NewTaskWorkflowRunRepo(context, entClient)
+	taskWorkflowService := service.NewTaskWorkflowService(context, taskWorkflowRepo, taskWorkflowRunRepo)
+	periodicScheduler, cleanup6, err := data.NewPeriodicScheduler(context, client)
+	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	taskService := service.NewTaskService(context, taskRepo, taskRunRepo, userRepo, luaTaskService, taskWorkflowService, periodicScheduler)
 	fileRepo := data.NewFileRepo(context, entClient)
-	minIOClient := data.NewMinIoClient(context)
-	fileService := service.NewFileService(context, fileRepo, minIOClient)
-	fileTransferService := service.NewFileTransferService(context, minIOClient, fileRepo)
+	storageObjectRepo := data.NewStorageObjectRepo(context, entClient)
+	storageQuotaRepo := data.NewStorageQuotaRepo(context, entClient)
+	internalMessageRepo := data.NewInternalMessageRepo(context, entClient)
+	internalMessageCategoryRepo := data.NewInternalMessageCategoryRepo(context, entClient)
+	internalMessageRecipientRepo := data.NewInternalMessageRecipientRepo(context, entClient)
+	internalMessageService := service.NewInternalMessageService(context, internalMessageRepo, internalMessageCategoryRepo, internalMessageRecipientRepo, userRepo, authenticator, clientType, luaHookRunner)
+	storageQuotaService := service.NewStorageQuotaService(context, storageQuotaRepo, tenantRepo, fileRepo, objectStorageRouter, internalMessageService)
+	imageDerivativeService := service.NewImageDerivativeService(context)
+	scanner, err := data.NewMalwareScanner(context)
+	if err != nil {
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	fileScanService := service.NewFileScanService(context, scanner, objectStorageRouter, storageObjectRepo, fileRepo, tenantRepo, roleRepo, membershipRepo, imageDerivativeService, internalMessageService)
+	fileService := service.NewFileService(context, fileRepo, tenantRepo, objectStorageRouter, storageObjectRepo, storageQuotaService, imageDerivativeService, fileScanService)
+	uploader, cleanup7, err := data.NewTusUploader(context, client, objectStorageRouter)
+	if err != nil {
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	presignedUploadStore := data.NewPresignedUploadStore(context, client)
+	fileTransferService := service.NewFileTransferService(context, objectStorageRouter, storageObjectRepo, fileRepo, luaHookRunner, uploader, presignedUploadStore, storageQuotaService, imageDerivativeService, fileScanService)
 	dictTypeRepo := data.NewDictTypeRepo(context, entClient)
 	dictTypeService := service.NewDictTypeService(context, dictTypeRepo)
-	dictEntryI18nRepo := data.NewDictEntryI18nRepo(context, entClient)
-	dictEntryRepo := data.NewDictEntryRepo(context, entClient, dictEntryI18nRepo)
 	dictEntryService := service.NewDictEntryService(context, dictEntryRepo)
 	languageRepo := data.NewLanguageRepo(context, entClient)
 	languageService := service.NewLanguageService(context, languageRepo)
-	tenantService := service.NewTenantService(context, tenantRepo, userRepo, userCredentialRepo, roleRepo, authorizerAuthorizer)
+	tenantService := service.NewTenantService(context, tenantRepo, userRepo, userCredentialRepo, roleRepo, authorizerAuthorizer, objectStorageRouter)
 	positionRepo := data.NewPositionRepo(context, entClient)
-	userService := service.NewUserService(context, userRepo, roleRepo, userCredentialRepo, positionRepo, orgUnitRepo, tenantRepo, membershipRepo)
-	userProfileService := service.NewUserProfileService(context, userRepo, roleRepo, userCredentialRepo)
-	roleService := service.NewRoleService(context, authorizerAuthorizer, roleRepo, tenantRepo)
+	userService := service.NewUserService(context, userRepo, roleRepo, userCredentialRepo, positionRepo, orgUnitRepo, tenantRepo, membershipRepo, luaHookRunner)
+	userProfileService := service.NewUserProfileService(context, userRepo, roleRepo, userCredentialRepo, fileTransferService)
+	roleService := service.NewRoleService(context, authorizerAuthorizer, roleRepo, tenantRepo, luaHookRunner)
 	positionService := service.NewPositionService(context, positionRepo, orgUnitRepo)
 	orgUnitService := service.NewOrgUnitService(context, orgUnitRepo, userRepo)
 	menuService := service.NewMenuService(context, menuRepo)
@@ -96,37 +174,94 @@ func initApp(context *bootstrap.Context) (*kratos.App, func(), error) {
 	permissionGroupRepo := data.NewPermissionGroupRepo(context, entClient)
 	permissionService := service.NewPermissionService(context, permissionRepo, permissionGroupRepo, menuRepo, apiRepo, roleRepo, authorizerAuthorizer)
 	permissionGroupService := service.NewPermissionGroupService(context, permissionGroupRepo, permissionRepo)
-	permissionAuditLogRepo := data.NewPermissionAuditLogRepo(context, entClient)
-	permissionAuditLogService := service.NewPermissionAuditLogService(context, permissionAuditLogRepo)
-	policyEvaluationLogRepo := data.NewPolicyEvaluationLogRepo(context, entClient)
-	policyEvaluationLogService := service.NewPolicyEvaluationLogService(context, policyEvaluationLogRepo)
-	loginAuditLogService := service.NewLoginAuditLogService(context, loginAuditLogRepo)
-	apiAuditLogService := service.NewApiAuditLogService(context, apiAuditLogRepo, apiRepo)
+	permissionAuditLogRepo := data.NewPermissionAuditLogRepo(context, entClient, auditEventForwarder)
 	operationAuditLogRepo := data.NewOperationAuditLogRepo(context, entClient)
-	operationAuditLogService := service.NewOperationAuditLogService(context, operationAuditLogRepo)
 	dataAccessAuditLogRepo := data.NewDataAccessAuditLogRepo(context, entClient)
-	dataAccessAuditLogService := service.NewDataAccessAuditLogService(context, dataAccessAuditLogRepo)
-	internalMessageRepo := data.NewInternalMessageRepo(context, entClient)
-	internalMessageCategoryRepo := data.NewInternalMessageCategoryRepo(context, entClient)
-	internalMessageRecipientRepo := data.NewInternalMessageRecipientRepo(context, entClient)
-	internalMessageService := service.NewInternalMessageService(context, internalMessageRepo, internalMessageCategoryRepo, internalMessageRecipientRepo, userRepo, authenticator, clientType)
+	policyEvaluationLogRepo := data.NewPolicyEvaluationLogRepo(context, entClient)
+	auditLogExportService := service.NewAuditLogExportService(context, apiAuditLogRepo, loginAuditLogRepo, operationAuditLogRepo, dataAccessAuditLogRepo, permissionAuditLogRepo, policyEvaluationLogRepo, internalMessageService, objectStorage)
+	permissionAuditLogService := service.NewPermissionAuditLogService(context, permissionAuditLogRepo, auditLogExportService)
+	policyEvaluationLogService := service.NewPolicyEvaluationLogService(context, policyEvaluationLogRepo, auditLogExportService)
+	loginAuditLogService := service.NewLoginAuditLogService(context, loginAuditLogRepo, auditLogExportService)
+	apiAuditLogService := service.NewApiAuditLogService(context, apiAuditLogRepo, apiRepo, auditLogExportService)
+	operationAuditLogService := service.NewOperationAuditLogService(context, operationAuditLogRepo, auditLogExportService)
+	dataAccessAuditLogService := service.NewDataAccessAuditLogService(context, dataAccessAuditLogRepo, auditLogExportService)
+	auditLogRetentionPolicyRepo := data.NewAuditLogRetentionPolicyRepo(context, entClient)
+	auditLogArchiveRepo := data.NewAuditLogArchiveRepo(context, entClient)
+	auditLogArchiver := data.NewAuditLogArchiver(context, entClient)
+	auditLogArchiveService := service.NewAuditLogArchiveService(context, auditLogRetentionPolicyRepo, auditLogArchiveRepo, auditLogArchiver, objectStorage)
+	auditForwarderService := service.NewAuditForwarderService(context, auditForwarderRepo, auditEventForwarder)
+	auditAnalyticsRepo := data.NewAuditAnalyticsRepo(context, entClient, client)
+	auditAnalyticsService := service.NewAuditAnalyticsService(context, auditAnalyticsRepo)
+	luaScriptRepo := data.NewLuaScriptRepo(context, entClient)
+	luaScriptSyncer, cleanup8, err := data.NewLuaScriptSyncer(context, client, engine, luaScriptRepo)
+	if err != nil {
+		cleanup7()
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	luaScriptService := service.NewLuaScriptService(context, luaScriptRepo, luaScriptSyncer, engine)
 	internalMessageCategoryService := service.NewInternalMessageCategoryService(context, internalMessageCategoryRepo)
 	internalMessageRecipientService := service.NewInternalMessageRecipientService(context, internalMessageRepo, internalMessageRecipientRepo)
-	httpServer, err := server.NewRestServer(context, v, authorizerAuthorizer, authenticationService, loginPolicyService, adminPortalService, taskService, fileService, fileTransferService, dictTypeService, dictEntryService, languageService, tenantService, userService, userProfileService, roleService, positionService, orgUnitService, menuService, apiService, permissionService, permissionGroupService, permissionAuditLogService, policyEvaluationLogService, loginAuditLogService, apiAuditLogService, operationAuditLogService, dataAccessAuditLogService, internalMessageService, internalMessageCategoryService, internalMessageRecipientService)
+	fileShareLinkRepo := data.NewFileShareLinkRepo(context, entClient, crypto)
+	fileShareDownloadSessionStore := data.NewFileShareDownloadSessionStore(context, client)
+	fileShareLinkService := service.NewFileShareLinkService(context, fileShareLinkRepo, fileRepo, membershipRepo, dataAccessAuditLogRepo, objectStorageRouter, fileShareDownloadSessionStore, authenticator, clientType)
+	httpServer, err := server.NewRestServer(context, v, authorizerAuthorizer, authenticationService, loginPolicyService, adminPortalService, taskService, fileService, fileTransferService, dictTypeService, dictEntryService, languageService, tenantService, userService, userProfileService, roleService, positionService, orgUnitService, menuService, apiService, permissionService, permissionGroupService, permissionAuditLogService, policyEvaluationLogService, loginAuditLogService, apiAuditLogService, operationAuditLogService, dataAccessAuditLogService, auditLogArchiveService, auditForwarderService, auditAnalyticsService, luaScriptService, taskWorkflowService, internalMessageService, internalMessageCategoryService, internalMessageRecipientService, objectStorageRouter, storageQuotaService, fileShareLinkService)
+	if err != nil {
+		cleanup8()
+		cleanup7()
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	databaseDumper := data.NewDatabaseDumper(context, entClient)
+	databaseBackupService := service.NewDatabaseBackupService(context, databaseDumper, objectStorage)
+	storageReconcileService := service.NewStorageReconcileService(context, objectStorageRouter, fileRepo)
+	taskProgressBroker, cleanup9, err := data.NewTaskProgressBroker(context, client, taskRunRepo)
 	if err != nil {
+		cleanup8()
+		cleanup7()
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
 		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	asynqServer, err := server.NewAsynqServer(context, taskService)
+	eventBus := data.NewEventBus(manager)
+	asynqServer, err := server.NewAsynqServer(context, taskService, auditLogArchiveService, auditLogExportService, databaseBackupService, storageQuotaService, storageReconcileService, fileScanService, fileService, luaTaskService, taskWorkflowService, taskRunRepo, taskProgressBroker, eventBus)
 	if err != nil {
+		cleanup9()
+		cleanup8()
+		cleanup7()
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
 		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	sseServer := server.NewSseServer(context, internalMessageService)
+	taskProgressService := service.NewTaskProgressService(context, taskProgressBroker, taskRepo, authenticator, clientType)
+	sseServer := server.NewSseServer(context, internalMessageService, taskProgressService)
 	app := newApp(context, httpServer, asynqServer, sseServer)
 	return app, func() {
+		cleanup9()
+		cleanup8()
+		cleanup7()
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
 		cleanup2()
 		cleanup()
 	}, nil
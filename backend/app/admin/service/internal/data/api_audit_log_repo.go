@@ -30,6 +30,8 @@ type ApiAuditLogRepo struct {
 	entClient *entCrud.EntClient[*ent.Client]
 	log       *log.Helper
 
+	forwarder *AuditEventForwarder
+
 	mapper *mapper.CopierMapper[auditV1.ApiAuditLog, ent.ApiAuditLog]
 
 	repository *entCrud.Repository[
@@ -42,10 +44,15 @@ type ApiAuditLogRepo struct {
 	]
 }
 
-func NewApiAuditLogRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *ApiAuditLogRepo {
+func NewApiAuditLogRepo(
+	ctx *bootstrap.Context,
+	entClient *entCrud.EntClient[*ent.Client],
+	forwarder *AuditEventForwarder,
+) *ApiAuditLogRepo {
 	repo := &ApiAuditLogRepo{
 		log:       ctx.NewLoggerHelper("api-audit-log/repo/admin-service"),
 		entClient: entClient,
+		forwarder: forwarder,
 		mapper:    mapper.NewCopierMapper[auditV1.ApiAuditLog, ent.ApiAuditLog](),
 	}
 
@@ -183,11 +190,46 @@ func (r *ApiAuditLogRepo) Create(ctx context.Context, req *auditV1.CreateApiAudi
 		SetSignature(req.Data.Signature).
 		SetCreatedAt(time.Now())
 
-	err := builder.Exec(ctx)
+	entity, err := builder.Save(ctx)
 	if err != nil {
 		r.log.Errorf("insert api audit log failed: %s", err.Error())
 		return adminV1.ErrorInternalServerError("insert api audit log failed")
 	}
 
-	return err
+	// 转发到已配置的SIEM
+	if r.forwarder != nil {
+		r.forwarder.ForwardApiAuditLog(r.mapper.ToDTO(entity))
+	}
+
+	return nil
+}
+
+// ExportStream 按列表查询的过滤条件，以主键游标分批读取日志，用于大批量导出
+func (r *ApiAuditLogRepo) ExportStream(ctx context.Context, req *paginationV1.PagingRequest, batchSize int, fn func([]*auditV1.ApiAuditLog) error) error {
+	whereSelectors, _, err := r.repository.BuildListSelectorWithPaging(r.entClient.Client().ApiAuditLog.Query(), exportFilterRequest(req))
+	if err != nil {
+		return adminV1.ErrorBadRequest("invalid filter")
+	}
+
+	return streamByID(ctx, batchSize,
+		func(ctx context.Context, lastID uint32, limit int) ([]*ent.ApiAuditLog, error) {
+			builder := r.entClient.Client().ApiAuditLog.Query().
+				Where(apiauditlog.IDGT(lastID)).
+				Order(ent.Asc(apiauditlog.FieldID)).
+				Limit(limit)
+			if len(whereSelectors) != 0 {
+				builder.Modify(whereSelectors...)
+			}
+
+			entities, err := builder.All(ctx)
+			if err != nil {
+				r.log.Errorf("export api audit logs failed: %s", err.Error())
+				return nil, adminV1.ErrorInternalServerError("export api audit logs failed")
+			}
+			return entities, nil
+		},
+		func(e *ent.ApiAuditLog) uint32 { return e.ID },
+		r.mapper.ToDTO,
+		fn,
+	)
 }
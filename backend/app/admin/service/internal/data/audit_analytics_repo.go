@@ -0,0 +1,505 @@
+package data
+
+import (
+	"context"
+	"crypto/sha256"
+	stdsql "database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/apiauditlog"
+	"go-wind-admin/app/admin/service/internal/data/ent/loginauditlog"
+
+	auditV1 "go-wind-admin/api/gen/go/audit/service/v1"
+
+	"go-wind-admin/pkg/serviceid"
+)
+
+const (
+	auditAnalyticsCacheTTL    = time.Minute
+	auditAnalyticsCachePrefix = serviceid.ProjectName + ":audit-analytics:"
+)
+
+// AuditAnalyticsRepo 审计日志统计分析，按数据库方言（MySQL/PostgreSQL）生成聚合SQL，结果在Redis中短暂缓存。
+// 原生SQL绕过了 ent 的隐私规则，调用方需自行限定租户范围。
+type AuditAnalyticsRepo struct {
+	log       *log.Helper
+	entClient *entCrud.EntClient[*ent.Client]
+	rdb       *redis.Client
+}
+
+func NewAuditAnalyticsRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client], rdb *redis.Client) *AuditAnalyticsRepo {
+	return &AuditAnalyticsRepo{
+		log:       ctx.NewLoggerHelper("audit-analytics/repo/admin-service"),
+		entClient: entClient,
+		rdb:       rdb,
+	}
+}
+
+func (r *AuditAnalyticsRepo) dialect() string {
+	return r.entClient.Driver().Dialect()
+}
+
+// GetApiRequestStats API请求量与错误量时间序列，请求参数需已归一化
+func (r *AuditAnalyticsRepo) GetApiRequestStats(ctx context.Context, req *auditV1.GetApiRequestStatsRequest) (*auditV1.GetApiRequestStatsResponse, error) {
+	resp := &auditV1.GetApiRequestStatsResponse{BucketSeconds: req.GetBucketSeconds()}
+
+	err := r.cached(ctx, "api-requests", req, resp, func() error {
+		query, args := buildApiRequestStatsQuery(r.dialect(), req)
+
+		byOperation := req.GetGroupBy() == auditV1.ApiStatsGroupBy_API_STATS_GROUP_BY_OPERATION
+		return r.queryEach(ctx, query, args, func(rows *sql.Rows) error {
+			var (
+				bucket, requests, errs stdsql.NullInt64
+				module, operation      string
+			)
+			dest := []any{&bucket, &module}
+			if byOperation {
+				dest = append(dest, &operation)
+			}
+			dest = append(dest, &requests, &errs)
+			if err := rows.Scan(dest...); err != nil {
+				return err
+			}
+
+			resp.Items = append(resp.Items, &auditV1.ApiRequestStatsPoint{
+				BucketStart:  timestamppb.New(time.Unix(bucket.Int64, 0)),
+				ApiModule:    module,
+				ApiOperation: operation,
+				Requests:     uint64(requests.Int64),
+				Errors:       uint64(errs.Int64),
+			})
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetApiLatencyPercentiles API耗时分位数，请求参数需已归一化
+func (r *AuditAnalyticsRepo) GetApiLatencyPercentiles(ctx context.Context, req *auditV1.GetApiLatencyPercentilesRequest) (*auditV1.GetApiLatencyPercentilesResponse, error) {
+	resp := &auditV1.GetApiLatencyPercentilesResponse{}
+
+	err := r.cached(ctx, "api-latency", req, resp, func() error {
+		query, args := buildApiLatencyPercentilesQuery(r.dialect(), req)
+
+		byOperation := req.GetGroupBy() == auditV1.ApiStatsGroupBy_API_STATS_GROUP_BY_OPERATION
+		return r.queryEach(ctx, query, args, func(rows *sql.Rows) error {
+			var (
+				module, operation string
+				count             stdsql.NullInt64
+				p50, p95, p99     stdsql.NullFloat64
+			)
+			dest := []any{&module}
+			if byOperation {
+				dest = append(dest, &operation)
+			}
+			dest = append(dest, &count, &p50, &p95, &p99)
+			if err := rows.Scan(dest...); err != nil {
+				return err
+			}
+
+			resp.Items = append(resp.Items, &auditV1.ApiLatencyPercentiles{
+				ApiModule:    module,
+				ApiOperation: operation,
+				Count:        uint64(count.Int64),
+				P50:          p50.Float64,
+				P95:          p95.Float64,
+				P99:          p99.Float64,
+			})
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetTopActors 访问量最高的用户或IP，请求参数需已归一化
+func (r *AuditAnalyticsRepo) GetTopActors(ctx context.Context, req *auditV1.GetTopActorsRequest) (*auditV1.GetTopActorsResponse, error) {
+	resp := &auditV1.GetTopActorsResponse{}
+
+	err := r.cached(ctx, "top-actors", req, resp, func() error {
+		query, args, err := buildTopActorsQuery(r.dialect(), req)
+		if err != nil {
+			return err
+		}
+
+		return r.queryEach(ctx, query, args, func(rows *sql.Rows) error {
+			var (
+				key                    string
+				userID, total, failure stdsql.NullInt64
+			)
+			if err = rows.Scan(&key, &userID, &total, &failure); err != nil {
+				return err
+			}
+
+			item := &auditV1.TopActor{
+				Key:      key,
+				Count:    uint64(total.Int64),
+				Failures: uint64(failure.Int64),
+			}
+			if userID.Valid {
+				uid := uint32(userID.Int64)
+				item.UserId = &uid
+			}
+			resp.Items = append(resp.Items, item)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetFailedLoginsByCountry 按国家统计登录失败次数，请求参数需已归一化
+func (r *AuditAnalyticsRepo) GetFailedLoginsByCountry(ctx context.Context, req *auditV1.GetFailedLoginsByCountryRequest) (*auditV1.GetFailedLoginsByCountryResponse, error) {
+	resp := &auditV1.GetFailedLoginsByCountryResponse{}
+
+	err := r.cached(ctx, "failed-logins-by-country", req, resp, func() error {
+		query, args := buildFailedLoginsByCountryQuery(r.dialect(), req)
+
+		return r.queryEach(ctx, query, args, func(rows *sql.Rows) error {
+			var (
+				country stdsql.NullString
+				total   stdsql.NullInt64
+			)
+			if err := rows.Scan(&country, &total); err != nil {
+				return err
+			}
+
+			resp.Items = append(resp.Items, &auditV1.CountryCount{
+				CountryCode: country.String,
+				Count:       uint64(total.Int64),
+			})
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetLoginRiskDistribution 登录风险等级分布，请求参数需已归一化
+func (r *AuditAnalyticsRepo) GetLoginRiskDistribution(ctx context.Context, req *auditV1.GetLoginRiskDistributionRequest) (*auditV1.GetLoginRiskDistributionResponse, error) {
+	resp := &auditV1.GetLoginRiskDistributionResponse{}
+
+	err := r.cached(ctx, "login-risk-distribution", req, resp, func() error {
+		query, args := buildLoginRiskDistributionQuery(r.dialect(), req)
+
+		counts := make(map[auditV1.LoginAuditLog_RiskLevel]uint64)
+		err := r.queryEach(ctx, query, args, func(rows *sql.Rows) error {
+			var (
+				level string
+				total stdsql.NullInt64
+			)
+			if err := rows.Scan(&level, &total); err != nil {
+				return err
+			}
+
+			// 未评估或无法识别的等级归入 RISK_LEVEL_UNSPECIFIED
+			key := auditV1.LoginAuditLog_RiskLevel(auditV1.LoginAuditLog_RiskLevel_value[level])
+			counts[key] += uint64(total.Int64)
+			resp.Total += uint64(total.Int64)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for i := range len(auditV1.LoginAuditLog_RiskLevel_name) {
+			level := auditV1.LoginAuditLog_RiskLevel(i)
+			resp.Items = append(resp.Items, &auditV1.RiskLevelCount{RiskLevel: level, Count: counts[level]})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (r *AuditAnalyticsRepo) queryEach(ctx context.Context, query string, args []any, fn func(rows *sql.Rows) error) error {
+	var rows sql.Rows
+	if err := r.entClient.Query(ctx, query, args, &rows); err != nil {
+		r.log.Errorf("query audit analytics failed: %s", err.Error())
+		return auditV1.ErrorInternalServerError("query audit analytics failed")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		if err := fn(&rows); err != nil {
+			r.log.Errorf("scan audit analytics failed: %s", err.Error())
+			return auditV1.ErrorInternalServerError("query audit analytics failed")
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Errorf("iterate audit analytics failed: %s", err.Error())
+		return auditV1.ErrorInternalServerError("query audit analytics failed")
+	}
+
+	return nil
+}
+
+// cached 以请求内容为键缓存统计结果，缓存不可用时直接查询数据库
+func (r *AuditAnalyticsRepo) cached(ctx context.Context, name string, req, resp proto.Message, load func() error) error {
+	if r.rdb == nil {
+		return load()
+	}
+
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return load()
+	}
+	sum := sha256.Sum256(b)
+	key := auditAnalyticsCachePrefix + name + ":" + hex.EncodeToString(sum[:])
+
+	value, err := r.rdb.Get(ctx, key).Bytes()
+	switch {
+	case err == nil:
+		if err = protojson.Unmarshal(value, resp); err == nil {
+			return nil
+		}
+		proto.Reset(resp)
+	case !errors.Is(err, redis.Nil):
+		r.log.Warnf("read audit analytics cache failed: %s", err.Error())
+	}
+
+	if err = load(); err != nil {
+		return err
+	}
+
+	if value, err = protojson.Marshal(resp); err == nil {
+		if err = r.rdb.Set(ctx, key, value, auditAnalyticsCacheTTL).Err(); err != nil {
+			r.log.Warnf("write audit analytics cache failed: %s", err.Error())
+		}
+	}
+
+	return nil
+}
+
+// analyticsRangePredicate 时间范围与租户过滤，时间范围为左闭右开
+func analyticsRangePredicate(tenantID *uint32, start, end *timestamppb.Timestamp) *sql.Predicate {
+	preds := []*sql.Predicate{
+		sql.GTE(auditLogColumnCreatedAt, start.AsTime()),
+		sql.LT(auditLogColumnCreatedAt, end.AsTime()),
+	}
+	if tenantID != nil {
+		preds = append(preds, sql.EQ(auditLogColumnTenantID, *tenantID))
+	}
+	return sql.And(preds...)
+}
+
+// analyticsBucketExpr 将创建时间按秒数对齐到时间桶，返回Unix时间戳
+func analyticsBucketExpr(d string, seconds uint32) string {
+	if d == dialect.Postgres {
+		return fmt.Sprintf("CAST(FLOOR(EXTRACT(EPOCH FROM created_at) / %d) * %d AS BIGINT)", seconds, seconds)
+	}
+	return fmt.Sprintf("CAST(FLOOR(UNIX_TIMESTAMP(created_at) / %d) * %d AS SIGNED)", seconds, seconds)
+}
+
+// analyticsCountIf 统计满足条件的行数
+func analyticsCountIf(d string, cond string) string {
+	if d == dialect.Postgres {
+		return fmt.Sprintf("CAST(SUM(CASE WHEN %s THEN 1 ELSE 0 END) AS BIGINT)", cond)
+	}
+	return fmt.Sprintf("CAST(SUM(CASE WHEN %s THEN 1 ELSE 0 END) AS SIGNED)", cond)
+}
+
+// analyticsCountryExpr 从地理位置JSON中提取国家代码
+func analyticsCountryExpr(d string) string {
+	if d == dialect.Postgres {
+		return "geo_location->>'country_code'"
+	}
+	return "JSON_UNQUOTE(JSON_EXTRACT(geo_location, '$.country_code'))"
+}
+
+// apiGroupColumns API统计的分组列
+func apiGroupColumns(groupBy auditV1.ApiStatsGroupBy) []string {
+	if groupBy == auditV1.ApiStatsGroupBy_API_STATS_GROUP_BY_OPERATION {
+		return []string{apiauditlog.FieldAPIModule, apiauditlog.FieldAPIOperation}
+	}
+	return []string{apiauditlog.FieldAPIModule}
+}
+
+func apiModulePredicate(pred *sql.Predicate, module *string) *sql.Predicate {
+	if module == nil || *module == "" {
+		return pred
+	}
+	return sql.And(pred, sql.EQ(apiauditlog.FieldAPIModule, *module))
+}
+
+func buildApiRequestStatsQuery(d string, req *auditV1.GetApiRequestStatsRequest) (string, []any) {
+	groupColumns := apiGroupColumns(req.GetGroupBy())
+
+	selector := sql.Dialect(d).Select().
+		AppendSelectExprAs(sql.Raw(analyticsBucketExpr(d, req.GetBucketSeconds())), "bucket")
+	for _, c := range groupColumns {
+		selector.AppendSelectExprAs(sql.Raw(fmt.Sprintf("COALESCE(%s, '')", c)), c)
+	}
+	selector.
+		AppendSelectExprAs(sql.Raw("COUNT(*)"), "requests").
+		AppendSelectExprAs(sql.Raw(analyticsCountIf(d, apiauditlog.FieldSuccess+" = false")), "errors").
+		From(sql.Table(apiauditlog.Table)).
+		Where(apiModulePredicate(analyticsRangePredicate(req.TenantId, req.StartTime, req.EndTime), req.ApiModule)).
+		GroupBy(append([]string{"bucket"}, groupColumns...)...).
+		OrderBy(append([]string{"bucket"}, groupColumns...)...)
+
+	return selector.Query()
+}
+
+func buildApiLatencyPercentilesQuery(d string, req *auditV1.GetApiLatencyPercentilesRequest) (string, []any) {
+	groupColumns := apiGroupColumns(req.GetGroupBy())
+	where := sql.And(
+		apiModulePredicate(analyticsRangePredicate(req.TenantId, req.StartTime, req.EndTime), req.ApiModule),
+		sql.NotNull(apiauditlog.FieldLatencyMs),
+	)
+
+	percentiles := []struct {
+		alias string
+		value float64
+	}{
+		{"p50", 0.5}, {"p95", 0.95}, {"p99", 0.99},
+	}
+
+	if d == dialect.Postgres {
+		selector := sql.Dialect(d).Select()
+		for _, c := range groupColumns {
+			selector.AppendSelectExprAs(sql.Raw(fmt.Sprintf("COALESCE(%s, '')", c)), c)
+		}
+		selector.AppendSelectExprAs(sql.Raw("COUNT(*)"), "samples")
+		for _, p := range percentiles {
+			selector.AppendSelectExprAs(sql.Raw(fmt.Sprintf("percentile_cont(%g) WITHIN GROUP (ORDER BY %s)", p.value, apiauditlog.FieldLatencyMs)), p.alias)
+		}
+		selector.
+			From(sql.Table(apiauditlog.Table)).
+			Where(where).
+			GroupBy(groupColumns...).
+			OrderBy(groupColumns...)
+		return selector.Query()
+	}
+
+	// MySQL 没有 percentile_cont，用累积分布取最近秩分位数
+	partition := apiauditlog.FieldAPIModule
+	if len(groupColumns) > 1 {
+		partition += ", " + apiauditlog.FieldAPIOperation
+	}
+
+	inner := sql.Dialect(d).Select()
+	for _, c := range groupColumns {
+		inner.AppendSelectExprAs(sql.Raw(fmt.Sprintf("COALESCE(%s, '')", c)), c)
+	}
+	inner.
+		AppendSelectExprAs(sql.Raw(apiauditlog.FieldLatencyMs), apiauditlog.FieldLatencyMs).
+		AppendSelectExprAs(sql.Raw(fmt.Sprintf("CUME_DIST() OVER (PARTITION BY %s ORDER BY %s)", partition, apiauditlog.FieldLatencyMs)), "cd").
+		From(sql.Table(apiauditlog.Table)).
+		Where(where)
+
+	selector := sql.Dialect(d).Select(groupColumns...).
+		AppendSelectExprAs(sql.Raw("COUNT(*)"), "samples")
+	for _, p := range percentiles {
+		selector.AppendSelectExprAs(sql.Raw(fmt.Sprintf("MIN(CASE WHEN cd >= %g THEN %s END)", p.value, apiauditlog.FieldLatencyMs)), p.alias)
+	}
+	selector.
+		From(inner.As("t")).
+		GroupBy(groupColumns...).
+		OrderBy(groupColumns...)
+
+	return selector.Query()
+}
+
+func buildTopActorsQuery(d string, req *auditV1.GetTopActorsRequest) (string, []any, error) {
+	var (
+		table       string
+		failureCond string
+	)
+	switch req.GetLogType() {
+	case auditV1.AuditLogType_AUDIT_LOG_TYPE_UNSPECIFIED, auditV1.AuditLogType_API_AUDIT_LOG:
+		table = apiauditlog.Table
+		failureCond = apiauditlog.FieldSuccess + " = false"
+	case auditV1.AuditLogType_LOGIN_AUDIT_LOG:
+		table = loginauditlog.Table
+		failureCond = fmt.Sprintf("%s <> '%s'", loginauditlog.FieldStatus, loginauditlog.StatusSuccess)
+	default:
+		return "", nil, auditV1.ErrorBadRequest("unsupported audit log type: %s", req.GetLogType().String())
+	}
+
+	// API与登录审计日志的用户与IP列同名
+	keyColumn := apiauditlog.FieldUsername
+	userIDExpr := fmt.Sprintf("MAX(%s)", apiauditlog.FieldUserID)
+	if req.GetDimension() == auditV1.TopActorDimension_TOP_ACTOR_DIMENSION_IP {
+		keyColumn = apiauditlog.FieldIPAddress
+		userIDExpr = "NULL"
+	}
+
+	selector := sql.Dialect(d).Select().
+		AppendSelectExprAs(sql.Raw(keyColumn), "actor").
+		AppendSelectExprAs(sql.Raw(userIDExpr), "actor_user_id").
+		AppendSelectExprAs(sql.Raw("COUNT(*)"), "total").
+		AppendSelectExprAs(sql.Raw(analyticsCountIf(d, failureCond)), "failures").
+		From(sql.Table(table)).
+		Where(sql.And(
+			analyticsRangePredicate(req.TenantId, req.StartTime, req.EndTime),
+			sql.NotNull(keyColumn),
+			sql.NEQ(keyColumn, ""),
+		)).
+		GroupBy(keyColumn).
+		OrderExpr(sql.Raw("total DESC")).
+		Limit(int(req.GetLimit()))
+
+	query, args := selector.Query()
+	return query, args, nil
+}
+
+func buildFailedLoginsByCountryQuery(d string, req *auditV1.GetFailedLoginsByCountryRequest) (string, []any) {
+	selector := sql.Dialect(d).Select().
+		AppendSelectExprAs(sql.Raw(analyticsCountryExpr(d)), "country").
+		AppendSelectExprAs(sql.Raw("COUNT(*)"), "total").
+		From(sql.Table(loginauditlog.Table)).
+		Where(sql.And(
+			analyticsRangePredicate(req.TenantId, req.StartTime, req.EndTime),
+			// 被锁定拒绝的尝试同样计为失败
+			sql.In(loginauditlog.FieldStatus, loginauditlog.StatusFailed.String(), loginauditlog.StatusLocked.String()),
+		)).
+		GroupBy("country").
+		OrderExpr(sql.Raw("total DESC")).
+		Limit(int(req.GetLimit()))
+
+	return selector.Query()
+}
+
+func buildLoginRiskDistributionQuery(d string, req *auditV1.GetLoginRiskDistributionRequest) (string, []any) {
+	selector := sql.Dialect(d).Select().
+		AppendSelectExprAs(sql.Raw(fmt.Sprintf("COALESCE(%s, '')", loginauditlog.FieldRiskLevel)), "level").
+		AppendSelectExprAs(sql.Raw("COUNT(*)"), "total").
+		From(sql.Table(loginauditlog.Table)).
+		Where(analyticsRangePredicate(req.TenantId, req.StartTime, req.EndTime)).
+		GroupBy(loginauditlog.FieldRiskLevel)
+
+	return selector.Query()
+}
@@ -0,0 +1,121 @@
+package data
+
+import (
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/stretchr/testify/assert"
+	"github.com/tx7do/go-utils/trans"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	auditV1 "go-wind-admin/api/gen/go/audit/service/v1"
+)
+
+func analyticsTestRange() (*timestamppb.Timestamp, *timestamppb.Timestamp) {
+	end := time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)
+	return timestamppb.New(end.Add(-24 * time.Hour)), timestamppb.New(end)
+}
+
+func TestBuildApiRequestStatsQuery(t *testing.T) {
+	start, end := analyticsTestRange()
+	req := &auditV1.GetApiRequestStatsRequest{
+		TenantId:      trans.Ptr(uint32(3)),
+		StartTime:     start,
+		EndTime:       end,
+		BucketSeconds: trans.Ptr(uint32(300)),
+		GroupBy:       auditV1.ApiStatsGroupBy_API_STATS_GROUP_BY_OPERATION.Enum(),
+	}
+
+	query, args := buildApiRequestStatsQuery(dialect.MySQL, req)
+	assert.Contains(t, query, "FLOOR(UNIX_TIMESTAMP(created_at) / 300) * 300")
+	assert.Contains(t, query, "GROUP BY `bucket`, `api_module`, `api_operation`")
+	assert.Contains(t, query, "`tenant_id` = ?")
+	assert.Equal(t, []any{start.AsTime(), end.AsTime(), uint32(3)}, args)
+
+	query, args = buildApiRequestStatsQuery(dialect.Postgres, req)
+	assert.Contains(t, query, "FLOOR(EXTRACT(EPOCH FROM created_at) / 300) * 300")
+	assert.Contains(t, query, `"tenant_id" = $3`)
+	assert.Len(t, args, 3)
+
+	// 未指定租户时不过滤租户，按模块分组
+	req.TenantId = nil
+	req.GroupBy = nil
+	query, args = buildApiRequestStatsQuery(dialect.Postgres, req)
+	assert.NotContains(t, query, "tenant_id")
+	assert.Contains(t, query, `GROUP BY "bucket", "api_module" ORDER BY`)
+	assert.Len(t, args, 2)
+}
+
+func TestBuildApiLatencyPercentilesQuery(t *testing.T) {
+	start, end := analyticsTestRange()
+	req := &auditV1.GetApiLatencyPercentilesRequest{
+		StartTime: start,
+		EndTime:   end,
+		ApiModule: trans.Ptr("user"),
+	}
+
+	query, args := buildApiLatencyPercentilesQuery(dialect.Postgres, req)
+	assert.Contains(t, query, "percentile_cont(0.95) WITHIN GROUP (ORDER BY latency_ms)")
+	assert.Contains(t, query, `"api_module" = $3`)
+	assert.Len(t, args, 3)
+
+	query, args = buildApiLatencyPercentilesQuery(dialect.MySQL, req)
+	assert.NotContains(t, query, "percentile_cont")
+	assert.Contains(t, query, "CUME_DIST() OVER (PARTITION BY api_module ORDER BY latency_ms)")
+	assert.Contains(t, query, "MIN(CASE WHEN cd >= 0.99 THEN latency_ms END)")
+	assert.Len(t, args, 3)
+}
+
+func TestBuildTopActorsQuery(t *testing.T) {
+	start, end := analyticsTestRange()
+
+	query, _, err := buildTopActorsQuery(dialect.MySQL, &auditV1.GetTopActorsRequest{
+		StartTime: start,
+		EndTime:   end,
+		LogType:   auditV1.AuditLogType_LOGIN_AUDIT_LOG.Enum(),
+		Dimension: auditV1.TopActorDimension_TOP_ACTOR_DIMENSION_IP.Enum(),
+		Limit:     trans.Ptr(uint32(5)),
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, query, "FROM `sys_login_audit_logs`")
+	assert.Contains(t, query, "GROUP BY `ip_address`")
+	assert.Contains(t, query, "status <> 'SUCCESS'")
+	assert.Contains(t, query, "LIMIT 5")
+
+	query, _, err = buildTopActorsQuery(dialect.Postgres, &auditV1.GetTopActorsRequest{
+		StartTime: start,
+		EndTime:   end,
+		Limit:     trans.Ptr(uint32(10)),
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, query, `FROM "sys_api_audit_logs"`)
+	assert.Contains(t, query, "MAX(user_id)")
+	assert.Contains(t, query, "success = false")
+
+	_, _, err = buildTopActorsQuery(dialect.MySQL, &auditV1.GetTopActorsRequest{
+		LogType: auditV1.AuditLogType_OPERATION_AUDIT_LOG.Enum(),
+	})
+	assert.Error(t, err)
+}
+
+func TestBuildLoginAnalyticsQueries(t *testing.T) {
+	start, end := analyticsTestRange()
+
+	query, args := buildFailedLoginsByCountryQuery(dialect.MySQL, &auditV1.GetFailedLoginsByCountryRequest{
+		StartTime: start, EndTime: end, Limit: trans.Ptr(uint32(50)),
+	})
+	assert.Contains(t, query, "JSON_UNQUOTE(JSON_EXTRACT(geo_location, '$.country_code'))")
+	assert.Equal(t, []any{start.AsTime(), end.AsTime(), "FAILED", "LOCKED"}, args)
+
+	query, _ = buildFailedLoginsByCountryQuery(dialect.Postgres, &auditV1.GetFailedLoginsByCountryRequest{
+		StartTime: start, EndTime: end, Limit: trans.Ptr(uint32(50)),
+	})
+	assert.Contains(t, query, "geo_location->>'country_code'")
+
+	query, _ = buildLoginRiskDistributionQuery(dialect.Postgres, &auditV1.GetLoginRiskDistributionRequest{
+		TenantId: trans.Ptr(uint32(7)), StartTime: start, EndTime: end,
+	})
+	assert.Contains(t, query, `GROUP BY "risk_level"`)
+	assert.Contains(t, query, `"tenant_id" = $3`)
+}
@@ -0,0 +1,330 @@
+package data
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/proto"
+
+	auditV1 "go-wind-admin/api/gen/go/audit/service/v1"
+
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+	"go-wind-admin/pkg/serviceid"
+	"go-wind-admin/pkg/siem"
+)
+
+const (
+	auditForwarderRefreshInterval = time.Minute
+	auditForwarderSendTimeout     = 10 * time.Second
+	auditForwarderVersion         = "1.0"
+)
+
+// AuditEventForwarder 审计事件转发器，将审计日志按租户与日志类型路由到已配置的SIEM转发器
+type AuditEventForwarder struct {
+	log    *log.Helper
+	logger log.Logger
+
+	repo   *AuditForwarderRepo
+	router *siem.Router
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+func NewAuditEventForwarder(ctx *bootstrap.Context, repo *AuditForwarderRepo) (*AuditEventForwarder, func(), error) {
+	f := &AuditEventForwarder{
+		log:    ctx.NewLoggerHelper("audit-event-forwarder/data/admin-service"),
+		logger: ctx.GetLogger(),
+		repo:   repo,
+		router: siem.NewRouter(ctx.GetLogger()),
+		stop:   make(chan struct{}),
+	}
+
+	f.wg.Add(1)
+	go f.refreshLoop()
+
+	return f, func() {
+		close(f.stop)
+		f.wg.Wait()
+		f.router.Close()
+	}, nil
+}
+
+// refreshLoop 定期从数据库加载转发器配置，使其他实例上的修改也能生效
+func (f *AuditEventForwarder) refreshLoop() {
+	defer f.wg.Done()
+
+	ticker := time.NewTicker(auditForwarderRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := f.Reload(appViewer.NewSystemViewerContext(context.Background())); err != nil {
+			f.log.Warnf("reload audit forwarders failed: %s", err.Error())
+		}
+
+		select {
+		case <-f.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Reload 重新加载启用的转发器，配置未变化的转发器保持运行
+func (f *AuditEventForwarder) Reload(ctx context.Context) error {
+	configs, err := f.repo.ListEnabled(ctx)
+	if err != nil {
+		return err
+	}
+
+	specs := make([]siem.RouteSpec, 0, len(configs))
+	for _, cfg := range configs {
+		cfg := cfg
+		specs = append(specs, siem.RouteSpec{
+			Key:         strconv.FormatUint(uint64(cfg.GetId()), 10),
+			Fingerprint: auditForwarderFingerprint(cfg),
+			Route: siem.Route{
+				TenantID: cfg.GetTenantId(),
+				LogTypes: logTypesToStrings(cfg.GetLogTypes()),
+			},
+			Build: func() (*siem.Forwarder, error) {
+				return f.BuildForwarder(cfg)
+			},
+		})
+	}
+
+	f.router.Sync(specs)
+
+	return nil
+}
+
+// BuildForwarder 根据配置创建转发器，投递失败的事件缓存在本地磁盘
+func (f *AuditEventForwarder) BuildForwarder(cfg *auditV1.AuditForwarder) (*siem.Forwarder, error) {
+	formatter, sender, err := newAuditForwarderPipeline(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	dir := filepath.Join(os.TempDir(), serviceid.ProjectName, "siem-spool", strconv.FormatUint(uint64(cfg.GetId()), 10))
+	buffer, err := siem.NewDiskBuffer(dir, int64(cfg.GetMaxBufferBytes()))
+	if err != nil {
+		_ = sender.Close()
+		return nil, err
+	}
+
+	return siem.NewForwarder(cfg.GetName(), formatter, sender,
+		siem.WithBuffer(buffer),
+		siem.WithLogger(f.logger),
+	), nil
+}
+
+// SendTest 同步投递一条测试事件，用于验证转发器配置
+func (f *AuditEventForwarder) SendTest(ctx context.Context, cfg *auditV1.AuditForwarder) error {
+	formatter, sender, err := newAuditForwarderPipeline(cfg)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = sender.Close() }()
+
+	ev := &siem.Event{
+		Type:        "TEST",
+		TenantID:    cfg.GetTenantId(),
+		Time:        time.Now(),
+		SignatureID: "forwarder-test",
+		Name:        "Audit forwarder test event",
+		Severity:    siem.SeverityLow,
+		Fields: map[string]string{
+			"forwarderId":   strconv.FormatUint(uint64(cfg.GetId()), 10),
+			"forwarderName": cfg.GetName(),
+		},
+	}
+
+	payload, err := formatter.Format(ev)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, auditForwarderSendTimeout)
+	defer cancel()
+
+	return sender.Send(ctx, payload)
+}
+
+// ForwardApiAuditLog 转发API审计日志
+func (f *AuditEventForwarder) ForwardApiAuditLog(entity *auditV1.ApiAuditLog) {
+	if entity == nil || f.router.Len() == 0 {
+		return
+	}
+
+	severity := siem.SeverityLow
+	name := "API request"
+	if !entity.GetSuccess() {
+		severity = siem.SeverityMedium
+		name = "API request failed"
+	}
+
+	f.dispatch(auditV1.AuditLogType_API_AUDIT_LOG, entity.GetTenantId(), entity,
+		"api:"+entity.GetHttpMethod(), name, severity)
+}
+
+// ForwardLoginAuditLog 转发登录审计日志
+func (f *AuditEventForwarder) ForwardLoginAuditLog(entity *auditV1.LoginAuditLog) {
+	if entity == nil || f.router.Len() == 0 {
+		return
+	}
+
+	severity := siem.SeverityLow
+	switch entity.GetStatus() {
+	case auditV1.LoginAuditLog_FAILED, auditV1.LoginAuditLog_PARTIAL:
+		severity = siem.SeverityMedium
+	case auditV1.LoginAuditLog_LOCKED:
+		severity = siem.SeverityHigh
+	}
+	if entity.GetRiskLevel() == auditV1.LoginAuditLog_HIGH && severity < siem.SeverityHigh {
+		severity = siem.SeverityHigh
+	}
+
+	f.dispatch(auditV1.AuditLogType_LOGIN_AUDIT_LOG, entity.GetTenantId(), entity,
+		"login:"+entity.GetActionType().String(),
+		fmt.Sprintf("%s %s", entity.GetActionType().String(), entity.GetStatus().String()),
+		severity)
+}
+
+// ForwardPermissionAuditLog 转发权限变更审计日志
+func (f *AuditEventForwarder) ForwardPermissionAuditLog(entity *auditV1.PermissionAuditLog) {
+	if entity == nil || f.router.Len() == 0 {
+		return
+	}
+
+	severity := siem.SeverityMedium
+	switch entity.GetAction() {
+	case auditV1.PermissionAuditLog_GRANT, auditV1.PermissionAuditLog_BULK_GRANT,
+		auditV1.PermissionAuditLog_ASSIGN, auditV1.PermissionAuditLog_RESET:
+		severity = siem.SeverityHigh
+	}
+
+	f.dispatch(auditV1.AuditLogType_PERMISSION_AUDIT_LOG, entity.GetTenantId(), entity,
+		"permission:"+entity.GetAction().String(),
+		fmt.Sprintf("%s %s", entity.GetAction().String(), entity.GetTargetType()),
+		severity)
+}
+
+func (f *AuditEventForwarder) dispatch(
+	logType auditV1.AuditLogType,
+	tenantID uint32,
+	msg proto.Message,
+	signatureID, name string,
+	severity siem.Severity,
+) {
+	ev, err := siem.NewEvent(logType.String(), tenantID, time.Now(), msg)
+	if err != nil {
+		f.log.Warnf("build audit event failed: %s", err.Error())
+		return
+	}
+	ev.SignatureID = signatureID
+	ev.Name = name
+	ev.Severity = severity
+
+	f.router.Dispatch(ev)
+}
+
+// newAuditForwarderPipeline 根据配置创建格式化器与发送器
+func newAuditForwarderPipeline(cfg *auditV1.AuditForwarder) (siem.Formatter, siem.Sender, error) {
+	if cfg.GetEndpoint() == "" {
+		return nil, nil, errors.New("endpoint is required")
+	}
+
+	syslogFormatter := &siem.RFC5424Formatter{
+		AppName:  cfg.GetAppName(),
+		Facility: int(cfg.GetFacility()),
+		Mapping:  cfg.GetFieldMapping(),
+	}
+
+	var formatter siem.Formatter
+	switch cfg.GetFormat() {
+	case auditV1.AuditForwarder_RFC5424:
+		formatter = syslogFormatter
+	case auditV1.AuditForwarder_CEF:
+		formatter = &siem.CEFFormatter{
+			Vendor:  serviceid.ProjectName,
+			Product: cfg.GetAppName(),
+			Version: auditForwarderVersion,
+			Mapping: cfg.GetFieldMapping(),
+			Syslog:  syslogFormatter,
+		}
+	case auditV1.AuditForwarder_JSON:
+		formatter = &siem.JSONFormatter{Mapping: cfg.GetFieldMapping()}
+	default:
+		return nil, nil, fmt.Errorf("unsupported format: %s", cfg.GetFormat().String())
+	}
+
+	var sender siem.Sender
+	var err error
+	switch cfg.GetTransport() {
+	case auditV1.AuditForwarder_UDP:
+		sender, err = siem.NewSyslogSender(siem.NetworkUDP, cfg.GetEndpoint(), nil, auditForwarderSendTimeout)
+	case auditV1.AuditForwarder_TCP:
+		sender, err = siem.NewSyslogSender(siem.NetworkTCP, cfg.GetEndpoint(), nil, auditForwarderSendTimeout)
+	case auditV1.AuditForwarder_TLS:
+		var tlsConfig *tls.Config
+		if tlsConfig, err = newAuditForwarderTLSConfig(cfg); err != nil {
+			return nil, nil, err
+		}
+		sender, err = siem.NewSyslogSender(siem.NetworkTLS, cfg.GetEndpoint(), tlsConfig, auditForwarderSendTimeout)
+	case auditV1.AuditForwarder_HTTP:
+		sender, err = siem.NewWebhookSender(cfg.GetEndpoint(), cfg.GetSecret(), cfg.GetHeaders(),
+			&http.Client{Timeout: auditForwarderSendTimeout})
+	default:
+		return nil, nil, fmt.Errorf("unsupported transport: %s", cfg.GetTransport().String())
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return formatter, sender, nil
+}
+
+func newAuditForwarderTLSConfig(cfg *auditV1.AuditForwarder) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: cfg.GetTlsInsecureSkipVerify(),
+		ServerName:         cfg.GetTlsServerName(),
+	}
+
+	if tlsConfig.ServerName == "" {
+		if host, _, err := net.SplitHostPort(cfg.GetEndpoint()); err == nil {
+			tlsConfig.ServerName = host
+		}
+	}
+
+	if cfg.GetTlsCaPem() != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(cfg.GetTlsCaPem())) {
+			return nil, errors.New("invalid tls ca pem")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	return tlsConfig, nil
+}
+
+// auditForwarderFingerprint 计算配置指纹，配置变化时重建转发器
+func auditForwarderFingerprint(cfg *auditV1.AuditForwarder) string {
+	b, _ := proto.MarshalOptions{Deterministic: true}.Marshal(cfg)
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
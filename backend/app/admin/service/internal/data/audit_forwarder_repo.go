@@ -0,0 +1,294 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/jinzhu/copier"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/auditforwarder"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+
+	"github.com/tx7do/go-utils/copierutil"
+	"github.com/tx7do/go-utils/mapper"
+
+	auditV1 "go-wind-admin/api/gen/go/audit/service/v1"
+)
+
+type AuditForwarderRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+
+	mapper             *mapper.CopierMapper[auditV1.AuditForwarder, ent.AuditForwarder]
+	formatConverter    *mapper.EnumTypeConverter[auditV1.AuditForwarder_Format, auditforwarder.Format]
+	transportConverter *mapper.EnumTypeConverter[auditV1.AuditForwarder_Transport, auditforwarder.Transport]
+
+	repository *entCrud.Repository[
+		ent.AuditForwarderQuery, ent.AuditForwarderSelect,
+		ent.AuditForwarderCreate, ent.AuditForwarderCreateBulk,
+		ent.AuditForwarderUpdate, ent.AuditForwarderUpdateOne,
+		ent.AuditForwarderDelete,
+		predicate.AuditForwarder,
+		auditV1.AuditForwarder, ent.AuditForwarder,
+	]
+}
+
+func NewAuditForwarderRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *AuditForwarderRepo {
+	repo := &AuditForwarderRepo{
+		log:       ctx.NewLoggerHelper("audit-forwarder/repo/admin-service"),
+		entClient: entClient,
+		mapper:    mapper.NewCopierMapper[auditV1.AuditForwarder, ent.AuditForwarder](),
+		formatConverter: mapper.NewEnumTypeConverter[auditV1.AuditForwarder_Format, auditforwarder.Format](
+			auditV1.AuditForwarder_Format_name, auditV1.AuditForwarder_Format_value,
+		),
+		transportConverter: mapper.NewEnumTypeConverter[auditV1.AuditForwarder_Transport, auditforwarder.Transport](
+			auditV1.AuditForwarder_Transport_name, auditV1.AuditForwarder_Transport_value,
+		),
+	}
+
+	repo.init()
+
+	return repo
+}
+
+func (r *AuditForwarderRepo) init() {
+	r.repository = entCrud.NewRepository[
+		ent.AuditForwarderQuery, ent.AuditForwarderSelect,
+		ent.AuditForwarderCreate, ent.AuditForwarderCreateBulk,
+		ent.AuditForwarderUpdate, ent.AuditForwarderUpdateOne,
+		ent.AuditForwarderDelete,
+		predicate.AuditForwarder,
+		auditV1.AuditForwarder, ent.AuditForwarder,
+	](r.mapper)
+
+	r.mapper.AppendConverters(copierutil.NewTimeStringConverterPair())
+	r.mapper.AppendConverters(copierutil.NewTimeTimestamppbConverterPair())
+
+	r.mapper.AppendConverters(r.formatConverter.NewConverterPair())
+	r.mapper.AppendConverters(r.transportConverter.NewConverterPair())
+	r.mapper.AppendConverters(NewLogTypesConverterPair())
+}
+
+// NewLogTypesConverterPair 审计日志类型列表与字符串列表之间的转换
+func NewLogTypesConverterPair() []copier.TypeConverter {
+	srcType := []auditV1.AuditLogType{}
+	dstType := []string{}
+
+	fromFn := logTypesToStrings
+	toFn := func(src []string) []auditV1.AuditLogType {
+		if src == nil {
+			return nil
+		}
+		out := make([]auditV1.AuditLogType, 0, len(src))
+		for _, s := range src {
+			if v, ok := auditV1.AuditLogType_value[s]; ok {
+				out = append(out, auditV1.AuditLogType(v))
+			}
+		}
+		return out
+	}
+
+	return copierutil.NewGenericTypeConverterPair(srcType, dstType, fromFn, toFn)
+}
+
+func logTypesToStrings(src []auditV1.AuditLogType) []string {
+	if src == nil {
+		return nil
+	}
+	out := make([]string, 0, len(src))
+	for _, t := range src {
+		out = append(out, t.String())
+	}
+	return out
+}
+
+func (r *AuditForwarderRepo) List(ctx context.Context, req *paginationV1.PagingRequest) (*auditV1.ListAuditForwarderResponse, error) {
+	if req == nil {
+		return nil, auditV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().AuditForwarder.Query()
+
+	ret, err := r.repository.ListWithPaging(ctx, builder, builder.Clone(), req)
+	if err != nil {
+		return nil, err
+	}
+	if ret == nil {
+		return &auditV1.ListAuditForwarderResponse{Total: 0, Items: nil}, nil
+	}
+
+	return &auditV1.ListAuditForwarderResponse{
+		Total: ret.Total,
+		Items: ret.Items,
+	}, nil
+}
+
+// ListEnabled 查询所有启用的转发器（跨租户，供转发路由使用）
+func (r *AuditForwarderRepo) ListEnabled(ctx context.Context) ([]*auditV1.AuditForwarder, error) {
+	entities, err := r.entClient.Client().AuditForwarder.Query().
+		Where(auditforwarder.EnabledEQ(true)).
+		Order(ent.Asc(auditforwarder.FieldID)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query enabled audit forwarders failed: %s", err.Error())
+		return nil, auditV1.ErrorInternalServerError("query enabled audit forwarders failed")
+	}
+
+	dtos := make([]*auditV1.AuditForwarder, 0, len(entities))
+	for _, entity := range entities {
+		dtos = append(dtos, r.mapper.ToDTO(entity))
+	}
+
+	return dtos, nil
+}
+
+func (r *AuditForwarderRepo) IsExist(ctx context.Context, id uint32) (bool, error) {
+	exist, err := r.entClient.Client().AuditForwarder.Query().
+		Where(auditforwarder.IDEQ(id)).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("query exist failed: %s", err.Error())
+		return false, auditV1.ErrorInternalServerError("query exist failed")
+	}
+	return exist, nil
+}
+
+func (r *AuditForwarderRepo) Get(ctx context.Context, req *auditV1.GetAuditForwarderRequest) (*auditV1.AuditForwarder, error) {
+	if req == nil {
+		return nil, auditV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().AuditForwarder.Query()
+
+	var whereCond []func(s *sql.Selector)
+	switch req.QueryBy.(type) {
+	default:
+	case *auditV1.GetAuditForwarderRequest_Id:
+		whereCond = append(whereCond, auditforwarder.IDEQ(req.GetId()))
+	}
+
+	dto, err := r.repository.Get(ctx, builder, req.GetViewMask(), whereCond...)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto, err
+}
+
+func (r *AuditForwarderRepo) Create(ctx context.Context, req *auditV1.CreateAuditForwarderRequest) error {
+	if req == nil || req.Data == nil {
+		return auditV1.ErrorBadRequest("invalid request")
+	}
+
+	builder := r.entClient.Client().AuditForwarder.Create().
+		SetNillableTenantID(req.Data.TenantId).
+		SetNillableName(req.Data.Name).
+		SetNillableEnabled(req.Data.Enabled).
+		SetNillableFormat(r.formatConverter.ToEntity(req.Data.Format)).
+		SetNillableTransport(r.transportConverter.ToEntity(req.Data.Transport)).
+		SetNillableEndpoint(req.Data.Endpoint).
+		SetLogTypes(logTypesToStrings(req.Data.LogTypes)).
+		SetFieldMapping(req.Data.FieldMapping).
+		SetNillableSecret(req.Data.Secret).
+		SetHeaders(req.Data.Headers).
+		SetNillableTLSInsecureSkipVerify(req.Data.TlsInsecureSkipVerify).
+		SetNillableTLSCaPem(req.Data.TlsCaPem).
+		SetNillableTLSServerName(req.Data.TlsServerName).
+		SetNillableAppName(req.Data.AppName).
+		SetNillableFacility(req.Data.Facility).
+		SetNillableMaxBufferBytes(req.Data.MaxBufferBytes).
+		SetNillableRemark(req.Data.Remark).
+		SetNillableCreatedBy(req.Data.CreatedBy).
+		SetCreatedAt(time.Now())
+
+	if err := builder.Exec(ctx); err != nil {
+		r.log.Errorf("insert audit forwarder failed: %s", err.Error())
+		return auditV1.ErrorInternalServerError("insert audit forwarder failed")
+	}
+
+	return nil
+}
+
+func (r *AuditForwarderRepo) Update(ctx context.Context, req *auditV1.UpdateAuditForwarderRequest) error {
+	if req == nil || req.Data == nil {
+		return auditV1.ErrorBadRequest("invalid request")
+	}
+	if req.GetId() == 0 {
+		return auditV1.ErrorBadRequest("id is required")
+	}
+
+	// 如果不存在则创建
+	if req.GetAllowMissing() {
+		exist, err := r.IsExist(ctx, req.GetId())
+		if err != nil {
+			return err
+		}
+		if !exist {
+			createReq := &auditV1.CreateAuditForwarderRequest{Data: req.Data}
+			createReq.Data.CreatedBy = createReq.Data.UpdatedBy
+			createReq.Data.UpdatedBy = nil
+			return r.Create(ctx, createReq)
+		}
+	}
+
+	builder := r.entClient.Client().AuditForwarder.Update()
+	err := r.repository.UpdateX(ctx, builder, req.Data, req.GetUpdateMask(),
+		func(dto *auditV1.AuditForwarder) {
+			builder.
+				SetNillableName(req.Data.Name).
+				SetNillableEnabled(req.Data.Enabled).
+				SetNillableFormat(r.formatConverter.ToEntity(req.Data.Format)).
+				SetNillableTransport(r.transportConverter.ToEntity(req.Data.Transport)).
+				SetNillableEndpoint(req.Data.Endpoint).
+				SetNillableSecret(req.Data.Secret).
+				SetNillableTLSInsecureSkipVerify(req.Data.TlsInsecureSkipVerify).
+				SetNillableTLSCaPem(req.Data.TlsCaPem).
+				SetNillableTLSServerName(req.Data.TlsServerName).
+				SetNillableAppName(req.Data.AppName).
+				SetNillableFacility(req.Data.Facility).
+				SetNillableMaxBufferBytes(req.Data.MaxBufferBytes).
+				SetNillableRemark(req.Data.Remark).
+				SetNillableUpdatedBy(req.Data.UpdatedBy).
+				SetUpdatedAt(time.Now())
+
+			if req.Data.LogTypes != nil {
+				builder.SetLogTypes(logTypesToStrings(req.Data.LogTypes))
+			}
+			if req.Data.FieldMapping != nil {
+				builder.SetFieldMapping(req.Data.FieldMapping)
+			}
+			if req.Data.Headers != nil {
+				builder.SetHeaders(req.Data.Headers)
+			}
+		},
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(auditforwarder.FieldID, req.GetId()))
+		},
+	)
+
+	return err
+}
+
+func (r *AuditForwarderRepo) Delete(ctx context.Context, req *auditV1.DeleteAuditForwarderRequest) error {
+	if req == nil {
+		return auditV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().AuditForwarder.Delete()
+	_, err := r.repository.Delete(ctx, builder, func(s *sql.Selector) {
+		s.Where(sql.EQ(auditforwarder.FieldID, req.GetId()))
+	})
+	if err != nil {
+		r.log.Errorf("delete audit forwarder failed: %s", err.Error())
+		return auditV1.ErrorInternalServerError("delete audit forwarder failed")
+	}
+
+	return nil
+}
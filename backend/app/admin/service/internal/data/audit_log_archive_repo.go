@@ -0,0 +1,328 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/jinzhu/copier"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/auditlogarchive"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"go-wind-admin/app/admin/service/internal/data/ent/restoredauditlog"
+
+	"github.com/tx7do/go-utils/copierutil"
+	"github.com/tx7do/go-utils/mapper"
+
+	auditV1 "go-wind-admin/api/gen/go/audit/service/v1"
+)
+
+// AuditLogArchiveResult 一次归档导出的结果
+type AuditLogArchiveResult struct {
+	ObjectName         string
+	ManifestObjectName string
+	Sha256             string
+	SizeBytes          uint64
+	RowCount           uint64
+	MinLogID           uint32
+	MaxLogID           uint32
+}
+
+type AuditLogArchiveRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+
+	mapper          *mapper.CopierMapper[auditV1.AuditLogArchive, ent.AuditLogArchive]
+	restoredMapper  *mapper.CopierMapper[auditV1.RestoredAuditLog, ent.RestoredAuditLog]
+	statusConverter *mapper.EnumTypeConverter[auditV1.AuditLogArchive_Status, auditlogarchive.Status]
+
+	logTypeConverter         *mapper.EnumTypeConverter[auditV1.AuditLogType, auditlogarchive.LogType]
+	restoredLogTypeConverter *mapper.EnumTypeConverter[auditV1.AuditLogType, restoredauditlog.LogType]
+
+	repository *entCrud.Repository[
+		ent.AuditLogArchiveQuery, ent.AuditLogArchiveSelect,
+		ent.AuditLogArchiveCreate, ent.AuditLogArchiveCreateBulk,
+		ent.AuditLogArchiveUpdate, ent.AuditLogArchiveUpdateOne,
+		ent.AuditLogArchiveDelete,
+		predicate.AuditLogArchive,
+		auditV1.AuditLogArchive, ent.AuditLogArchive,
+	]
+
+	restoredRepository *entCrud.Repository[
+		ent.RestoredAuditLogQuery, ent.RestoredAuditLogSelect,
+		ent.RestoredAuditLogCreate, ent.RestoredAuditLogCreateBulk,
+		ent.RestoredAuditLogUpdate, ent.RestoredAuditLogUpdateOne,
+		ent.RestoredAuditLogDelete,
+		predicate.RestoredAuditLog,
+		auditV1.RestoredAuditLog, ent.RestoredAuditLog,
+	]
+}
+
+func NewAuditLogArchiveRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *AuditLogArchiveRepo {
+	repo := &AuditLogArchiveRepo{
+		log:            ctx.NewLoggerHelper("audit-log-archive/repo/admin-service"),
+		entClient:      entClient,
+		mapper:         mapper.NewCopierMapper[auditV1.AuditLogArchive, ent.AuditLogArchive](),
+		restoredMapper: mapper.NewCopierMapper[auditV1.RestoredAuditLog, ent.RestoredAuditLog](),
+		statusConverter: mapper.NewEnumTypeConverter[auditV1.AuditLogArchive_Status, auditlogarchive.Status](
+			auditV1.AuditLogArchive_Status_name, auditV1.AuditLogArchive_Status_value,
+		),
+		logTypeConverter: mapper.NewEnumTypeConverter[auditV1.AuditLogType, auditlogarchive.LogType](
+			auditV1.AuditLogType_name, auditV1.AuditLogType_value,
+		),
+		restoredLogTypeConverter: mapper.NewEnumTypeConverter[auditV1.AuditLogType, restoredauditlog.LogType](
+			auditV1.AuditLogType_name, auditV1.AuditLogType_value,
+		),
+	}
+
+	repo.init()
+
+	return repo
+}
+
+func (r *AuditLogArchiveRepo) init() {
+	r.repository = entCrud.NewRepository[
+		ent.AuditLogArchiveQuery, ent.AuditLogArchiveSelect,
+		ent.AuditLogArchiveCreate, ent.AuditLogArchiveCreateBulk,
+		ent.AuditLogArchiveUpdate, ent.AuditLogArchiveUpdateOne,
+		ent.AuditLogArchiveDelete,
+		predicate.AuditLogArchive,
+		auditV1.AuditLogArchive, ent.AuditLogArchive,
+	](r.mapper)
+
+	r.restoredRepository = entCrud.NewRepository[
+		ent.RestoredAuditLogQuery, ent.RestoredAuditLogSelect,
+		ent.RestoredAuditLogCreate, ent.RestoredAuditLogCreateBulk,
+		ent.RestoredAuditLogUpdate, ent.RestoredAuditLogUpdateOne,
+		ent.RestoredAuditLogDelete,
+		predicate.RestoredAuditLog,
+		auditV1.RestoredAuditLog, ent.RestoredAuditLog,
+	](r.restoredMapper)
+
+	r.mapper.AppendConverters(copierutil.NewTimeStringConverterPair())
+	r.mapper.AppendConverters(copierutil.NewTimeTimestamppbConverterPair())
+	r.mapper.AppendConverters(r.statusConverter.NewConverterPair())
+	r.mapper.AppendConverters(r.logTypeConverter.NewConverterPair())
+
+	r.restoredMapper.AppendConverters(copierutil.NewTimeStringConverterPair())
+	r.restoredMapper.AppendConverters(copierutil.NewTimeTimestamppbConverterPair())
+	r.restoredMapper.AppendConverters(r.restoredLogTypeConverter.NewConverterPair())
+	r.restoredMapper.AppendConverters(r.NewPayloadStructConverterPair())
+}
+
+// NewPayloadStructConverterPair 原始日志 JSON 与 protobuf Struct 之间的转换
+func (r *AuditLogArchiveRepo) NewPayloadStructConverterPair() []copier.TypeConverter {
+	srcType := &structpb.Struct{}
+	dstType := map[string]any{}
+
+	fromFn := func(src *structpb.Struct) map[string]any {
+		if src == nil {
+			return nil
+		}
+		return src.AsMap()
+	}
+	toFn := func(src map[string]any) *structpb.Struct {
+		if src == nil {
+			return nil
+		}
+		s, err := structpb.NewStruct(src)
+		if err != nil {
+			r.log.Warnf("convert restored audit log payload failed: %s", err.Error())
+			return nil
+		}
+		return s
+	}
+
+	return copierutil.NewGenericTypeConverterPair(srcType, dstType, fromFn, toFn)
+}
+
+func (r *AuditLogArchiveRepo) List(ctx context.Context, req *paginationV1.PagingRequest) (*auditV1.ListAuditLogArchiveResponse, error) {
+	if req == nil {
+		return nil, auditV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().AuditLogArchive.Query()
+
+	ret, err := r.repository.ListWithPaging(ctx, builder, builder.Clone(), req)
+	if err != nil {
+		return nil, err
+	}
+	if ret == nil {
+		return &auditV1.ListAuditLogArchiveResponse{Total: 0, Items: nil}, nil
+	}
+
+	return &auditV1.ListAuditLogArchiveResponse{
+		Total: ret.Total,
+		Items: ret.Items,
+	}, nil
+}
+
+func (r *AuditLogArchiveRepo) Get(ctx context.Context, req *auditV1.GetAuditLogArchiveRequest) (*auditV1.AuditLogArchive, error) {
+	if req == nil {
+		return nil, auditV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().AuditLogArchive.Query()
+
+	var whereCond []func(s *sql.Selector)
+	switch req.QueryBy.(type) {
+	default:
+	case *auditV1.GetAuditLogArchiveRequest_Id:
+		whereCond = append(whereCond, auditlogarchive.IDEQ(req.GetId()))
+	}
+
+	dto, err := r.repository.Get(ctx, builder, req.GetViewMask(), whereCond...)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto, err
+}
+
+// CreateExporting 创建一条导出中的归档记录，返回归档ID
+func (r *AuditLogArchiveRepo) CreateExporting(ctx context.Context, tenantID uint32, logType auditV1.AuditLogType, bucketName string, cutoff time.Time) (uint32, error) {
+	entity, err := r.entClient.Client().AuditLogArchive.Create().
+		SetTenantID(tenantID).
+		SetNillableLogType(r.logTypeConverter.ToEntity(&logType)).
+		SetStatus(auditlogarchive.StatusExporting).
+		SetBucketName(bucketName).
+		SetCutoffTime(cutoff).
+		SetCreatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("insert audit log archive failed: %s", err.Error())
+		return 0, auditV1.ErrorInternalServerError("insert audit log archive failed")
+	}
+
+	return entity.ID, nil
+}
+
+// MarkArchived 归档对象上传完成
+func (r *AuditLogArchiveRepo) MarkArchived(ctx context.Context, id uint32, result *AuditLogArchiveResult) error {
+	err := r.entClient.Client().AuditLogArchive.UpdateOneID(id).
+		SetStatus(auditlogarchive.StatusArchived).
+		SetObjectName(result.ObjectName).
+		SetManifestObjectName(result.ManifestObjectName).
+		SetSha256(result.Sha256).
+		SetSizeBytes(result.SizeBytes).
+		SetRowCount(result.RowCount).
+		SetMinLogID(result.MinLogID).
+		SetMaxLogID(result.MaxLogID).
+		SetUpdatedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("update audit log archive [%d] failed: %s", id, err.Error())
+		return auditV1.ErrorInternalServerError("update audit log archive failed")
+	}
+	return nil
+}
+
+// MarkPurged 在线数据清除完成
+func (r *AuditLogArchiveRepo) MarkPurged(ctx context.Context, id uint32, purgedCount uint64) error {
+	err := r.entClient.Client().AuditLogArchive.UpdateOneID(id).
+		SetStatus(auditlogarchive.StatusPurged).
+		SetPurgedCount(purgedCount).
+		SetUpdatedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("update audit log archive [%d] failed: %s", id, err.Error())
+		return auditV1.ErrorInternalServerError("update audit log archive failed")
+	}
+	return nil
+}
+
+// MarkFailed 归档失败
+func (r *AuditLogArchiveRepo) MarkFailed(ctx context.Context, id uint32, purgedCount uint64, cause error) error {
+	msg := ""
+	if cause != nil {
+		msg = cause.Error()
+	}
+
+	err := r.entClient.Client().AuditLogArchive.UpdateOneID(id).
+		SetStatus(auditlogarchive.StatusFailed).
+		SetPurgedCount(purgedCount).
+		SetErrorMessage(msg).
+		SetUpdatedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("update audit log archive [%d] failed: %s", id, err.Error())
+		return auditV1.ErrorInternalServerError("update audit log archive failed")
+	}
+	return nil
+}
+
+// MarkRestored 记录恢复结果
+func (r *AuditLogArchiveRepo) MarkRestored(ctx context.Context, id uint32, restoredCount uint64) error {
+	err := r.entClient.Client().AuditLogArchive.UpdateOneID(id).
+		SetRestoredCount(restoredCount).
+		SetRestoredAt(time.Now()).
+		SetUpdatedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("update audit log archive [%d] failed: %s", id, err.Error())
+		return auditV1.ErrorInternalServerError("update audit log archive failed")
+	}
+	return nil
+}
+
+func (r *AuditLogArchiveRepo) ListRestored(ctx context.Context, req *paginationV1.PagingRequest) (*auditV1.ListRestoredAuditLogResponse, error) {
+	if req == nil {
+		return nil, auditV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().RestoredAuditLog.Query()
+
+	ret, err := r.restoredRepository.ListWithPaging(ctx, builder, builder.Clone(), req)
+	if err != nil {
+		return nil, err
+	}
+	if ret == nil {
+		return &auditV1.ListRestoredAuditLogResponse{Total: 0, Items: nil}, nil
+	}
+
+	return &auditV1.ListRestoredAuditLogResponse{
+		Total: ret.Total,
+		Items: ret.Items,
+	}, nil
+}
+
+// BatchCreateRestored 批量写入恢复的日志，同一归档内已恢复的日志会被忽略
+func (r *AuditLogArchiveRepo) BatchCreateRestored(ctx context.Context, archive *auditV1.AuditLogArchive, rows []*ArchivedAuditLogRow) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	logType := r.restoredLogTypeConverter.ToEntity(archive.LogType)
+	now := time.Now()
+
+	builders := make([]*ent.RestoredAuditLogCreate, 0, len(rows))
+	for _, row := range rows {
+		builders = append(builders, r.entClient.Client().RestoredAuditLog.Create().
+			SetTenantID(archive.GetTenantId()).
+			SetArchiveID(archive.GetId()).
+			SetNillableLogType(logType).
+			SetLogID(row.ID).
+			SetNillableLogCreatedAt(row.CreatedAt).
+			SetPayload(row.Payload).
+			SetCreatedAt(now),
+		)
+	}
+
+	err := r.entClient.Client().RestoredAuditLog.CreateBulk(builders...).
+		OnConflictColumns(restoredauditlog.FieldArchiveID, restoredauditlog.FieldLogID).
+		DoNothing().
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("batch insert restored audit logs failed: %s", err.Error())
+		return auditV1.ErrorInternalServerError("batch insert restored audit logs failed")
+	}
+
+	return nil
+}
@@ -0,0 +1,395 @@
+package data
+
+import (
+	"context"
+	stdsql "database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/apiauditlog"
+	"go-wind-admin/app/admin/service/internal/data/ent/dataaccessauditlog"
+	"go-wind-admin/app/admin/service/internal/data/ent/loginauditlog"
+	"go-wind-admin/app/admin/service/internal/data/ent/operationauditlog"
+	"go-wind-admin/app/admin/service/internal/data/ent/permissionauditlog"
+	"go-wind-admin/app/admin/service/internal/data/ent/policyevaluationlog"
+
+	auditV1 "go-wind-admin/api/gen/go/audit/service/v1"
+)
+
+const (
+	auditLogColumnID        = "id"
+	auditLogColumnTenantID  = "tenant_id"
+	auditLogColumnCreatedAt = "created_at"
+)
+
+// ArchivedAuditLogRow 归档中的一行审计日志
+type ArchivedAuditLogRow struct {
+	ID        uint32
+	TenantID  uint32
+	CreatedAt *time.Time
+	Payload   map[string]any
+}
+
+// AuditLogExportStats 导出统计
+type AuditLogExportStats struct {
+	RowCount uint64
+	MinLogID uint32
+	MaxLogID uint32
+}
+
+// AuditLogArchiver 审计日志的批量导出与清除。
+// 各审计表结构不同，这里直接按表执行原生 SQL，整行导出为 JSON 对象，
+// 因此绕过了 ent 的隐私规则，调用方需自行限定租户范围。
+type AuditLogArchiver struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+func NewAuditLogArchiver(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *AuditLogArchiver {
+	return &AuditLogArchiver{
+		log:       ctx.NewLoggerHelper("audit-log-archiver/repo/admin-service"),
+		entClient: entClient,
+	}
+}
+
+// AuditLogTableName 审计日志类型对应的数据表
+func AuditLogTableName(logType auditV1.AuditLogType) (string, error) {
+	switch logType {
+	case auditV1.AuditLogType_API_AUDIT_LOG:
+		return apiauditlog.Table, nil
+	case auditV1.AuditLogType_LOGIN_AUDIT_LOG:
+		return loginauditlog.Table, nil
+	case auditV1.AuditLogType_OPERATION_AUDIT_LOG:
+		return operationauditlog.Table, nil
+	case auditV1.AuditLogType_DATA_ACCESS_AUDIT_LOG:
+		return dataaccessauditlog.Table, nil
+	case auditV1.AuditLogType_PERMISSION_AUDIT_LOG:
+		return permissionauditlog.Table, nil
+	case auditV1.AuditLogType_POLICY_EVALUATION_LOG:
+		return policyevaluationlog.Table, nil
+	default:
+		return "", auditV1.ErrorBadRequest("unsupported audit log type: %s", logType.String())
+	}
+}
+
+func (a *AuditLogArchiver) dialect() string {
+	return a.entClient.Driver().Dialect()
+}
+
+// agedPredicate 早于截止时间且属于指定租户（租户 0 包含未设置租户的日志）
+func agedPredicate(tenantID uint32, cutoff time.Time) *sql.Predicate {
+	var tenantCond *sql.Predicate
+	if tenantID == 0 {
+		tenantCond = sql.Or(sql.EQ(auditLogColumnTenantID, 0), sql.IsNull(auditLogColumnTenantID))
+	} else {
+		tenantCond = sql.EQ(auditLogColumnTenantID, tenantID)
+	}
+
+	return sql.And(sql.LT(auditLogColumnCreatedAt, cutoff), tenantCond)
+}
+
+// DistinctTenantIDs 查询存在过期日志的租户
+func (a *AuditLogArchiver) DistinctTenantIDs(ctx context.Context, logType auditV1.AuditLogType, cutoff time.Time) ([]uint32, error) {
+	table, err := AuditLogTableName(logType)
+	if err != nil {
+		return nil, err
+	}
+
+	query, args := sql.Dialect(a.dialect()).
+		Select(auditLogColumnTenantID).
+		Distinct().
+		From(sql.Table(table)).
+		Where(sql.LT(auditLogColumnCreatedAt, cutoff)).
+		Query()
+
+	var rows sql.Rows
+	if err = a.entClient.Query(ctx, query, args, &rows); err != nil {
+		a.log.Errorf("query aged tenants of [%s] failed: %s", table, err.Error())
+		return nil, auditV1.ErrorInternalServerError("query aged tenants failed")
+	}
+	defer rows.Close()
+
+	seen := make(map[uint32]struct{})
+	var tenantIDs []uint32
+	for rows.Next() {
+		var tid stdsql.NullInt64
+		if err = rows.Scan(&tid); err != nil {
+			a.log.Errorf("scan tenant id failed: %s", err.Error())
+			return nil, auditV1.ErrorInternalServerError("query aged tenants failed")
+		}
+		id := uint32(tid.Int64)
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		tenantIDs = append(tenantIDs, id)
+	}
+
+	return tenantIDs, rows.Err()
+}
+
+// ExportAged 按主键顺序分批读取过期日志，逐行回调
+func (a *AuditLogArchiver) ExportAged(
+	ctx context.Context,
+	logType auditV1.AuditLogType,
+	tenantID uint32,
+	cutoff time.Time,
+	batchSize int,
+	fn func(row *ArchivedAuditLogRow) error,
+) (*AuditLogExportStats, error) {
+	table, err := AuditLogTableName(logType)
+	if err != nil {
+		return nil, err
+	}
+	if batchSize <= 0 {
+		batchSize = 1000
+	}
+
+	stats := &AuditLogExportStats{}
+	var lastID uint32
+	for {
+		query, args := sql.Dialect(a.dialect()).
+			Select("*").
+			From(sql.Table(table)).
+			Where(sql.And(agedPredicate(tenantID, cutoff), sql.GT(auditLogColumnID, lastID))).
+			OrderBy(auditLogColumnID).
+			Limit(batchSize).
+			Query()
+
+		batch, err := a.queryRows(ctx, query, args)
+		if err != nil {
+			a.log.Errorf("export aged logs of [%s] failed: %s", table, err.Error())
+			return stats, auditV1.ErrorInternalServerError("export aged audit logs failed")
+		}
+
+		for _, row := range batch {
+			if err = fn(row); err != nil {
+				return stats, err
+			}
+
+			if stats.RowCount == 0 || row.ID < stats.MinLogID {
+				stats.MinLogID = row.ID
+			}
+			if row.ID > stats.MaxLogID {
+				stats.MaxLogID = row.ID
+			}
+			stats.RowCount++
+			lastID = row.ID
+		}
+
+		if len(batch) < batchSize {
+			break
+		}
+	}
+
+	return stats, nil
+}
+
+// PurgeAged 分批删除过期日志，maxID 为 0 时不限制主键上界
+func (a *AuditLogArchiver) PurgeAged(
+	ctx context.Context,
+	logType auditV1.AuditLogType,
+	tenantID uint32,
+	cutoff time.Time,
+	maxID uint32,
+	batchSize int,
+) (uint64, error) {
+	table, err := AuditLogTableName(logType)
+	if err != nil {
+		return 0, err
+	}
+	if batchSize <= 0 {
+		batchSize = 1000
+	}
+
+	cond := agedPredicate(tenantID, cutoff)
+	if maxID > 0 {
+		cond = sql.And(cond, sql.LTE(auditLogColumnID, maxID))
+	}
+
+	var purged uint64
+	for {
+		query, args := sql.Dialect(a.dialect()).
+			Select(auditLogColumnID).
+			From(sql.Table(table)).
+			Where(cond).
+			OrderBy(auditLogColumnID).
+			Limit(batchSize).
+			Query()
+
+		var rows sql.Rows
+		if err = a.entClient.Query(ctx, query, args, &rows); err != nil {
+			a.log.Errorf("select aged log ids of [%s] failed: %s", table, err.Error())
+			return purged, auditV1.ErrorInternalServerError("purge aged audit logs failed")
+		}
+		var ids []any
+		for rows.Next() {
+			var id int64
+			if err = rows.Scan(&id); err != nil {
+				_ = rows.Close()
+				return purged, auditV1.ErrorInternalServerError("purge aged audit logs failed")
+			}
+			ids = append(ids, id)
+		}
+		_ = rows.Close()
+
+		if len(ids) == 0 {
+			break
+		}
+
+		delQuery, delArgs := sql.Dialect(a.dialect()).
+			Delete(table).
+			Where(sql.In(auditLogColumnID, ids...)).
+			Query()
+
+		var res stdsql.Result
+		if err = a.entClient.Exec(ctx, delQuery, delArgs, &res); err != nil {
+			a.log.Errorf("delete aged logs of [%s] failed: %s", table, err.Error())
+			return purged, auditV1.ErrorInternalServerError("purge aged audit logs failed")
+		}
+		affected, _ := res.RowsAffected()
+		purged += uint64(affected)
+
+		if len(ids) < batchSize {
+			break
+		}
+	}
+
+	return purged, nil
+}
+
+// queryRows 执行查询并把每一行转换为归档行
+func (a *AuditLogArchiver) queryRows(ctx context.Context, query string, args []any) ([]*ArchivedAuditLogRow, error) {
+	rows, err := queryRowMaps(ctx, a.entClient, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*ArchivedAuditLogRow, 0, len(rows))
+	for _, payload := range rows {
+		out = append(out, NewArchivedAuditLogRow(payload))
+	}
+
+	return out, nil
+}
+
+// NewArchivedAuditLogRow 从整行数据中提取主键、租户与创建时间
+func NewArchivedAuditLogRow(payload map[string]any) *ArchivedAuditLogRow {
+	row := &ArchivedAuditLogRow{Payload: payload}
+	row.ID = uint32(toInt64(payload[auditLogColumnID]))
+	row.TenantID = uint32(toInt64(payload[auditLogColumnTenantID]))
+	row.CreatedAt = toTime(payload[auditLogColumnCreatedAt])
+	return row
+}
+
+// WriteArchivedAuditLogRow 以 NDJSON 格式写出一行
+func WriteArchivedAuditLogRow(w io.Writer, row *ArchivedAuditLogRow) error {
+	b, err := json.Marshal(row.Payload)
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+	_, err = w.Write(b)
+	return err
+}
+
+// ReadArchivedAuditLogRows 读取 NDJSON 归档，逐行回调
+func ReadArchivedAuditLogRows(r io.Reader, fn func(row *ArchivedAuditLogRow) error) error {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	for {
+		var payload map[string]any
+		if err := dec.Decode(&payload); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if err := fn(NewArchivedAuditLogRow(payload)); err != nil {
+			return err
+		}
+	}
+}
+
+// normalizeColumnValue 把驱动返回的原始值转换为可 JSON 序列化的值
+func normalizeColumnValue(v any) any {
+	switch val := v.(type) {
+	case []byte:
+		if len(val) > 0 && (val[0] == '{' || val[0] == '[') && json.Valid(val) {
+			return json.RawMessage(append([]byte(nil), val...))
+		}
+		return string(val)
+	case string:
+		if len(val) > 0 && (val[0] == '{' || val[0] == '[') && json.Valid([]byte(val)) {
+			return json.RawMessage(val)
+		}
+		return val
+	default:
+		return val
+	}
+}
+
+func toInt64(v any) int64 {
+	switch val := v.(type) {
+	case int64:
+		return val
+	case int32:
+		return int64(val)
+	case int:
+		return int64(val)
+	case uint64:
+		return int64(val)
+	case uint32:
+		return int64(val)
+	case float64:
+		return int64(val)
+	case json.Number:
+		n, _ := val.Int64()
+		return n
+	case string:
+		n, _ := strconv.ParseInt(val, 10, 64)
+		return n
+	case []byte:
+		n, _ := strconv.ParseInt(string(val), 10, 64)
+		return n
+	default:
+		return 0
+	}
+}
+
+var archivedTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02 15:04:05",
+}
+
+func toTime(v any) *time.Time {
+	switch val := v.(type) {
+	case time.Time:
+		return &val
+	case string:
+		for _, layout := range archivedTimeLayouts {
+			if t, err := time.Parse(layout, val); err == nil {
+				return &t
+			}
+		}
+	case []byte:
+		return toTime(string(val))
+	}
+	return nil
+}
+
+// AuditLogArchiveObjectName 归档对象名：{类型}/{租户}/{日期}/{归档ID}.ndjson.gz
+func AuditLogArchiveObjectName(logType auditV1.AuditLogType, tenantID, archiveID uint32, at time.Time) string {
+	return fmt.Sprintf("%s/%d/%s/%d.ndjson.gz", logType.String(), tenantID, at.UTC().Format("2006-01-02"), archiveID)
+}
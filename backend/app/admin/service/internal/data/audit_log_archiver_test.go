@@ -0,0 +1,80 @@
+package data
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	auditV1 "go-wind-admin/api/gen/go/audit/service/v1"
+)
+
+func TestNormalizeColumnValue(t *testing.T) {
+	assert.Equal(t, "hello", normalizeColumnValue([]byte("hello")))
+	assert.Equal(t, json.RawMessage(`{"a":1}`), normalizeColumnValue([]byte(`{"a":1}`)))
+	assert.Equal(t, json.RawMessage(`["x"]`), normalizeColumnValue(`["x"]`))
+	assert.Equal(t, "{broken", normalizeColumnValue([]byte("{broken")))
+	assert.Equal(t, int64(7), normalizeColumnValue(int64(7)))
+	assert.Nil(t, normalizeColumnValue(nil))
+}
+
+func TestArchivedAuditLogRowRoundTrip(t *testing.T) {
+	createdAt := time.Date(2024, 5, 1, 8, 30, 0, 0, time.UTC)
+
+	rows := []*ArchivedAuditLogRow{
+		NewArchivedAuditLogRow(map[string]any{
+			"id":           int64(11),
+			"tenant_id":    int64(2),
+			"created_at":   createdAt,
+			"username":     normalizeColumnValue([]byte("alice")),
+			"geo_location": normalizeColumnValue([]byte(`{"country_code":"CN"}`)),
+		}),
+		NewArchivedAuditLogRow(map[string]any{
+			"id":         normalizeColumnValue([]byte("12")),
+			"tenant_id":  nil,
+			"created_at": normalizeColumnValue([]byte("2024-05-01 09:00:00")),
+		}),
+	}
+
+	assert.Equal(t, uint32(11), rows[0].ID)
+	assert.Equal(t, uint32(2), rows[0].TenantID)
+	assert.Equal(t, createdAt, *rows[0].CreatedAt)
+	assert.Equal(t, uint32(12), rows[1].ID)
+	assert.Equal(t, uint32(0), rows[1].TenantID)
+	assert.NotNil(t, rows[1].CreatedAt)
+
+	var buf bytes.Buffer
+	for _, row := range rows {
+		assert.NoError(t, WriteArchivedAuditLogRow(&buf, row))
+	}
+	assert.Equal(t, 2, bytes.Count(buf.Bytes(), []byte("\n")))
+
+	var decoded []*ArchivedAuditLogRow
+	assert.NoError(t, ReadArchivedAuditLogRows(&buf, func(row *ArchivedAuditLogRow) error {
+		decoded = append(decoded, row)
+		return nil
+	}))
+
+	assert.Len(t, decoded, 2)
+	assert.Equal(t, uint32(11), decoded[0].ID)
+	assert.Equal(t, uint32(2), decoded[0].TenantID)
+	assert.Equal(t, createdAt, *decoded[0].CreatedAt)
+	assert.Equal(t, "alice", decoded[0].Payload["username"])
+	assert.Equal(t, map[string]any{"country_code": "CN"}, decoded[0].Payload["geo_location"])
+	assert.Equal(t, uint32(12), decoded[1].ID)
+}
+
+func TestAuditLogTableName(t *testing.T) {
+	table, err := AuditLogTableName(auditV1.AuditLogType_LOGIN_AUDIT_LOG)
+	assert.NoError(t, err)
+	assert.Equal(t, "sys_login_audit_logs", table)
+
+	_, err = AuditLogTableName(auditV1.AuditLogType_AUDIT_LOG_TYPE_UNSPECIFIED)
+	assert.Error(t, err)
+
+	at := time.Date(2024, 5, 1, 23, 0, 0, 0, time.UTC)
+	assert.Equal(t, "API_AUDIT_LOG/3/2024-05-01/9.ndjson.gz",
+		AuditLogArchiveObjectName(auditV1.AuditLogType_API_AUDIT_LOG, 3, 9, at))
+}
@@ -0,0 +1,74 @@
+package data
+
+import (
+	"context"
+
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	"github.com/tx7do/go-utils/trans"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultExportBatchSize 导出时每批读取的行数
+const defaultExportBatchSize = 1000
+
+// exportFilterRequest 只保留列表查询的过滤条件，去掉分页、排序与字段掩码（导出按主键游标顺序读取）
+func exportFilterRequest(req *paginationV1.PagingRequest) *paginationV1.PagingRequest {
+	out := &paginationV1.PagingRequest{}
+	if req != nil {
+		out = proto.Clone(req).(*paginationV1.PagingRequest)
+	}
+
+	out.Page = nil
+	out.PageSize = nil
+	out.Offset = nil
+	out.Limit = nil
+	out.Token = nil
+	out.Sorting = nil
+	out.OrderBy = nil
+	out.FieldMask = nil
+	out.NoPaging = trans.Ptr(true)
+
+	return out
+}
+
+// streamByID 以主键游标分批读取实体并转换为 DTO，逐批回调
+func streamByID[ENTITY any, DTO any](
+	ctx context.Context,
+	batchSize int,
+	fetch func(ctx context.Context, lastID uint32, limit int) ([]*ENTITY, error),
+	idOf func(*ENTITY) uint32,
+	toDTO func(*ENTITY) *DTO,
+	fn func([]*DTO) error,
+) error {
+	if batchSize <= 0 {
+		batchSize = defaultExportBatchSize
+	}
+
+	var lastID uint32
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		entities, err := fetch(ctx, lastID, batchSize)
+		if err != nil {
+			return err
+		}
+		if len(entities) == 0 {
+			return nil
+		}
+
+		dtos := make([]*DTO, 0, len(entities))
+		for _, entity := range entities {
+			dtos = append(dtos, toDTO(entity))
+		}
+		if err = fn(dtos); err != nil {
+			return err
+		}
+
+		lastID = idOf(entities[len(entities)-1])
+		if len(entities) < batchSize {
+			return nil
+		}
+	}
+}
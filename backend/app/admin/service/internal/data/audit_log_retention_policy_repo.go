@@ -0,0 +1,227 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/auditlogretentionpolicy"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+
+	"github.com/tx7do/go-utils/copierutil"
+	"github.com/tx7do/go-utils/mapper"
+
+	auditV1 "go-wind-admin/api/gen/go/audit/service/v1"
+)
+
+type AuditLogRetentionPolicyRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+
+	mapper           *mapper.CopierMapper[auditV1.AuditLogRetentionPolicy, ent.AuditLogRetentionPolicy]
+	logTypeConverter *mapper.EnumTypeConverter[auditV1.AuditLogType, auditlogretentionpolicy.LogType]
+
+	repository *entCrud.Repository[
+		ent.AuditLogRetentionPolicyQuery, ent.AuditLogRetentionPolicySelect,
+		ent.AuditLogRetentionPolicyCreate, ent.AuditLogRetentionPolicyCreateBulk,
+		ent.AuditLogRetentionPolicyUpdate, ent.AuditLogRetentionPolicyUpdateOne,
+		ent.AuditLogRetentionPolicyDelete,
+		predicate.AuditLogRetentionPolicy,
+		auditV1.AuditLogRetentionPolicy, ent.AuditLogRetentionPolicy,
+	]
+}
+
+func NewAuditLogRetentionPolicyRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *AuditLogRetentionPolicyRepo {
+	repo := &AuditLogRetentionPolicyRepo{
+		log:       ctx.NewLoggerHelper("audit-log-retention-policy/repo/admin-service"),
+		entClient: entClient,
+		mapper:    mapper.NewCopierMapper[auditV1.AuditLogRetentionPolicy, ent.AuditLogRetentionPolicy](),
+		logTypeConverter: mapper.NewEnumTypeConverter[auditV1.AuditLogType, auditlogretentionpolicy.LogType](
+			auditV1.AuditLogType_name, auditV1.AuditLogType_value,
+		),
+	}
+
+	repo.init()
+
+	return repo
+}
+
+func (r *AuditLogRetentionPolicyRepo) init() {
+	r.repository = entCrud.NewRepository[
+		ent.AuditLogRetentionPolicyQuery, ent.AuditLogRetentionPolicySelect,
+		ent.AuditLogRetentionPolicyCreate, ent.AuditLogRetentionPolicyCreateBulk,
+		ent.AuditLogRetentionPolicyUpdate, ent.AuditLogRetentionPolicyUpdateOne,
+		ent.AuditLogRetentionPolicyDelete,
+		predicate.AuditLogRetentionPolicy,
+		auditV1.AuditLogRetentionPolicy, ent.AuditLogRetentionPolicy,
+	](r.mapper)
+
+	r.mapper.AppendConverters(copierutil.NewTimeStringConverterPair())
+	r.mapper.AppendConverters(copierutil.NewTimeTimestamppbConverterPair())
+
+	r.mapper.AppendConverters(r.logTypeConverter.NewConverterPair())
+}
+
+func (r *AuditLogRetentionPolicyRepo) List(ctx context.Context, req *paginationV1.PagingRequest) (*auditV1.ListAuditLogRetentionPolicyResponse, error) {
+	if req == nil {
+		return nil, auditV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().AuditLogRetentionPolicy.Query()
+
+	ret, err := r.repository.ListWithPaging(ctx, builder, builder.Clone(), req)
+	if err != nil {
+		return nil, err
+	}
+	if ret == nil {
+		return &auditV1.ListAuditLogRetentionPolicyResponse{Total: 0, Items: nil}, nil
+	}
+
+	return &auditV1.ListAuditLogRetentionPolicyResponse{
+		Total: ret.Total,
+		Items: ret.Items,
+	}, nil
+}
+
+// ListEnabled 查询所有启用的保留策略（跨租户，供归档任务使用）
+func (r *AuditLogRetentionPolicyRepo) ListEnabled(ctx context.Context) ([]*auditV1.AuditLogRetentionPolicy, error) {
+	entities, err := r.entClient.Client().AuditLogRetentionPolicy.Query().
+		Where(auditlogretentionpolicy.EnabledEQ(true)).
+		Order(ent.Asc(auditlogretentionpolicy.FieldID)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query enabled retention policies failed: %s", err.Error())
+		return nil, auditV1.ErrorInternalServerError("query enabled retention policies failed")
+	}
+
+	dtos := make([]*auditV1.AuditLogRetentionPolicy, 0, len(entities))
+	for _, entity := range entities {
+		dtos = append(dtos, r.mapper.ToDTO(entity))
+	}
+
+	return dtos, nil
+}
+
+func (r *AuditLogRetentionPolicyRepo) IsExist(ctx context.Context, id uint32) (bool, error) {
+	exist, err := r.entClient.Client().AuditLogRetentionPolicy.Query().
+		Where(auditlogretentionpolicy.IDEQ(id)).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("query exist failed: %s", err.Error())
+		return false, auditV1.ErrorInternalServerError("query exist failed")
+	}
+	return exist, nil
+}
+
+func (r *AuditLogRetentionPolicyRepo) Get(ctx context.Context, req *auditV1.GetAuditLogRetentionPolicyRequest) (*auditV1.AuditLogRetentionPolicy, error) {
+	if req == nil {
+		return nil, auditV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().AuditLogRetentionPolicy.Query()
+
+	var whereCond []func(s *sql.Selector)
+	switch req.QueryBy.(type) {
+	default:
+	case *auditV1.GetAuditLogRetentionPolicyRequest_Id:
+		whereCond = append(whereCond, auditlogretentionpolicy.IDEQ(req.GetId()))
+	}
+
+	dto, err := r.repository.Get(ctx, builder, req.GetViewMask(), whereCond...)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto, err
+}
+
+func (r *AuditLogRetentionPolicyRepo) Create(ctx context.Context, req *auditV1.CreateAuditLogRetentionPolicyRequest) error {
+	if req == nil || req.Data == nil {
+		return auditV1.ErrorBadRequest("invalid request")
+	}
+
+	builder := r.entClient.Client().AuditLogRetentionPolicy.Create().
+		SetNillableTenantID(req.Data.TenantId).
+		SetNillableLogType(r.logTypeConverter.ToEntity(req.Data.LogType)).
+		SetNillableRetentionDays(req.Data.RetentionDays).
+		SetNillableArchiveEnabled(req.Data.ArchiveEnabled).
+		SetNillableEnabled(req.Data.Enabled).
+		SetNillableBatchSize(req.Data.BatchSize).
+		SetNillableRemark(req.Data.Remark).
+		SetNillableCreatedBy(req.Data.CreatedBy).
+		SetCreatedAt(time.Now())
+
+	if err := builder.Exec(ctx); err != nil {
+		r.log.Errorf("insert audit log retention policy failed: %s", err.Error())
+		return auditV1.ErrorInternalServerError("insert audit log retention policy failed")
+	}
+
+	return nil
+}
+
+func (r *AuditLogRetentionPolicyRepo) Update(ctx context.Context, req *auditV1.UpdateAuditLogRetentionPolicyRequest) error {
+	if req == nil || req.Data == nil {
+		return auditV1.ErrorBadRequest("invalid request")
+	}
+	if req.GetId() == 0 {
+		return auditV1.ErrorBadRequest("id is required")
+	}
+
+	// 如果不存在则创建
+	if req.GetAllowMissing() {
+		exist, err := r.IsExist(ctx, req.GetId())
+		if err != nil {
+			return err
+		}
+		if !exist {
+			createReq := &auditV1.CreateAuditLogRetentionPolicyRequest{Data: req.Data}
+			createReq.Data.CreatedBy = createReq.Data.UpdatedBy
+			createReq.Data.UpdatedBy = nil
+			return r.Create(ctx, createReq)
+		}
+	}
+
+	builder := r.entClient.Client().AuditLogRetentionPolicy.Update()
+	err := r.repository.UpdateX(ctx, builder, req.Data, req.GetUpdateMask(),
+		func(dto *auditV1.AuditLogRetentionPolicy) {
+			builder.
+				SetNillableLogType(r.logTypeConverter.ToEntity(req.Data.LogType)).
+				SetNillableRetentionDays(req.Data.RetentionDays).
+				SetNillableArchiveEnabled(req.Data.ArchiveEnabled).
+				SetNillableEnabled(req.Data.Enabled).
+				SetNillableBatchSize(req.Data.BatchSize).
+				SetNillableRemark(req.Data.Remark).
+				SetNillableUpdatedBy(req.Data.UpdatedBy).
+				SetUpdatedAt(time.Now())
+		},
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(auditlogretentionpolicy.FieldID, req.GetId()))
+		},
+	)
+
+	return err
+}
+
+func (r *AuditLogRetentionPolicyRepo) Delete(ctx context.Context, req *auditV1.DeleteAuditLogRetentionPolicyRequest) error {
+	if req == nil {
+		return auditV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().AuditLogRetentionPolicy.Delete()
+	_, err := r.repository.Delete(ctx, builder, func(s *sql.Selector) {
+		s.Where(sql.EQ(auditlogretentionpolicy.FieldID, req.GetId()))
+	})
+	if err != nil {
+		r.log.Errorf("delete audit log retention policy failed: %s", err.Error())
+		return auditV1.ErrorInternalServerError("delete audit log retention policy failed")
+	}
+
+	return nil
+}
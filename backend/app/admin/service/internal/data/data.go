@@ -13,6 +13,7 @@ import (
 
 	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
 
+	"go-wind-admin/pkg/eventbus"
 	"go-wind-admin/pkg/oss"
 )
 
@@ -38,6 +39,24 @@ func NewRedisClient(ctx *bootstrap.Context) (*redis.Client, func(), error) {
 	}, nil
 }
 
+// NewEventBusManager 创建进程内事件总线管理器
+func NewEventBusManager(ctx *bootstrap.Context) (*eventbus.Manager, func(), error) {
+	l := ctx.NewLoggerHelper("eventbus/data/admin-service")
+
+	manager := eventbus.NewManager(ctx.GetLogger())
+
+	return manager, func() {
+		if err := manager.Close(); err != nil {
+			l.Error(err)
+		}
+	}, nil
+}
+
+// NewEventBus 进程内全局事件总线，与 Lua 脚本共用
+func NewEventBus(manager *eventbus.Manager) eventbus.EventBus {
+	return manager.Global()
+}
+
 func NewMinIoClient(ctx *bootstrap.Context) *oss.MinIOClient {
 	return oss.NewMinIoClient(ctx.GetConfig(), ctx.GetLogger())
 }
@@ -180,3 +180,33 @@ func (r *DataAccessAuditLogRepo) Create(ctx context.Context, req *auditV1.Create
 
 	return nil
 }
+
+// ExportStream 按列表查询的过滤条件，以主键游标分批读取日志，用于大批量导出
+func (r *DataAccessAuditLogRepo) ExportStream(ctx context.Context, req *paginationV1.PagingRequest, batchSize int, fn func([]*auditV1.DataAccessAuditLog) error) error {
+	whereSelectors, _, err := r.repository.BuildListSelectorWithPaging(r.entClient.Client().DataAccessAuditLog.Query(), exportFilterRequest(req))
+	if err != nil {
+		return adminV1.ErrorBadRequest("invalid filter")
+	}
+
+	return streamByID(ctx, batchSize,
+		func(ctx context.Context, lastID uint32, limit int) ([]*ent.DataAccessAuditLog, error) {
+			builder := r.entClient.Client().DataAccessAuditLog.Query().
+				Where(dataaccessauditlog.IDGT(lastID)).
+				Order(ent.Asc(dataaccessauditlog.FieldID)).
+				Limit(limit)
+			if len(whereSelectors) != 0 {
+				builder.Modify(whereSelectors...)
+			}
+
+			entities, err := builder.All(ctx)
+			if err != nil {
+				r.log.Errorf("export data access audit logs failed: %s", err.Error())
+				return nil, adminV1.ErrorInternalServerError("export data access audit logs failed")
+			}
+			return entities, nil
+		},
+		func(e *ent.DataAccessAuditLog) uint32 { return e.ID },
+		r.mapper.ToDTO,
+		fn,
+	)
+}
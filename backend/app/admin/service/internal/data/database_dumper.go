@@ -0,0 +1,549 @@
+package data
+
+import (
+	"context"
+	stdsql "database/sql"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/migrate"
+	"go-wind-admin/app/admin/service/internal/data/ent/tenant"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+)
+
+const (
+	dumpColumnTenantID = "tenant_id"
+
+	defaultDumpBatchSize    = 1000
+	defaultRestoreBatchSize = 200
+)
+
+// DatabaseDumpTable 可备份的数据表
+type DatabaseDumpTable struct {
+	Name         string
+	PrimaryKey   string
+	TenantScoped bool
+
+	autoIncrement bool
+	columns       map[string]field.Type
+}
+
+// IncludedIn 租户备份只包含带租户字段的表和该租户本身
+func (t *DatabaseDumpTable) IncludedIn(tenantID *uint32) bool {
+	if tenantID == nil {
+		return true
+	}
+	return t.TenantScoped || t.Name == tenant.Table
+}
+
+// tenantPredicate 租户过滤条件，tenantID 为空时返回 nil
+func (t *DatabaseDumpTable) tenantPredicate(tenantID *uint32) *sql.Predicate {
+	switch {
+	case tenantID == nil:
+		return nil
+	case t.TenantScoped:
+		return sql.EQ(dumpColumnTenantID, *tenantID)
+	default:
+		return sql.EQ(t.PrimaryKey, *tenantID)
+	}
+}
+
+// DatabaseDumper 对 ent 管理的全部数据表做逻辑导出与导入。
+// 数据按行导出为 JSON 对象，导入时按当前表结构转换字段类型，因此可以跨 MySQL/PostgreSQL 恢复。
+// 直接执行原生 SQL，绕过了 ent 的隐私规则，只应在后台任务中使用。
+type DatabaseDumper struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+
+	tables []*DatabaseDumpTable
+	byName map[string]*DatabaseDumpTable
+}
+
+func NewDatabaseDumper(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *DatabaseDumper {
+	d := &DatabaseDumper{
+		log:       ctx.NewLoggerHelper("database-dumper/repo/admin-service"),
+		entClient: entClient,
+		byName:    make(map[string]*DatabaseDumpTable),
+	}
+
+	for _, t := range sortDumpTables(migrate.Tables) {
+		dt := newDatabaseDumpTable(t)
+		d.tables = append(d.tables, dt)
+		d.byName[dt.Name] = dt
+	}
+
+	return d
+}
+
+func newDatabaseDumpTable(t *schema.Table) *DatabaseDumpTable {
+	dt := &DatabaseDumpTable{
+		Name:    t.Name,
+		columns: make(map[string]field.Type, len(t.Columns)),
+	}
+	for _, c := range t.Columns {
+		dt.columns[c.Name] = c.Type
+		if c.Name == dumpColumnTenantID {
+			dt.TenantScoped = true
+		}
+	}
+	if len(t.PrimaryKey) > 0 {
+		dt.PrimaryKey = t.PrimaryKey[0].Name
+		dt.autoIncrement = t.PrimaryKey[0].Increment
+	}
+	return dt
+}
+
+// sortDumpTables 按外键依赖排序，被引用的表在前；忽略自引用，存在环时按原顺序追加
+func sortDumpTables(tables []*schema.Table) []*schema.Table {
+	pending := make(map[string]int, len(tables))
+	dependents := make(map[string][]*schema.Table)
+	for _, t := range tables {
+		refs := make(map[string]bool)
+		for _, fk := range t.ForeignKeys {
+			if fk.RefTable == nil || fk.RefTable.Name == t.Name || refs[fk.RefTable.Name] {
+				continue
+			}
+			refs[fk.RefTable.Name] = true
+			dependents[fk.RefTable.Name] = append(dependents[fk.RefTable.Name], t)
+		}
+		pending[t.Name] = len(refs)
+	}
+
+	sorted := make([]*schema.Table, 0, len(tables))
+	done := make(map[string]bool, len(tables))
+	for len(sorted) < len(tables) {
+		progressed := false
+		for _, t := range tables {
+			if done[t.Name] || pending[t.Name] > 0 {
+				continue
+			}
+			done[t.Name] = true
+			sorted = append(sorted, t)
+			progressed = true
+			for _, dep := range dependents[t.Name] {
+				pending[dep.Name]--
+			}
+		}
+		if progressed {
+			continue
+		}
+		for _, t := range tables {
+			if !done[t.Name] {
+				done[t.Name] = true
+				sorted = append(sorted, t)
+			}
+		}
+	}
+
+	return sorted
+}
+
+// Dialect 当前数据库方言
+func (d *DatabaseDumper) Dialect() string {
+	return d.entClient.Driver().Dialect()
+}
+
+// Tables 全部数据表，被引用的表在前
+func (d *DatabaseDumper) Tables() []*DatabaseDumpTable {
+	return d.tables
+}
+
+// Table 按表名查找数据表
+func (d *DatabaseDumper) Table(name string) (*DatabaseDumpTable, bool) {
+	t, ok := d.byName[name]
+	return t, ok
+}
+
+// ExportTable 按主键顺序分批导出一张表，tenantID 不为空时只导出该租户的数据
+func (d *DatabaseDumper) ExportTable(
+	ctx context.Context,
+	t *DatabaseDumpTable,
+	tenantID *uint32,
+	batchSize int,
+	fn func(row map[string]any) error,
+) (uint64, error) {
+	if !t.IncludedIn(tenantID) {
+		return 0, nil
+	}
+	if batchSize <= 0 {
+		batchSize = defaultDumpBatchSize
+	}
+
+	var count uint64
+	var lastID int64
+	for {
+		preds := []*sql.Predicate{sql.GT(t.PrimaryKey, lastID)}
+		if p := t.tenantPredicate(tenantID); p != nil {
+			preds = append(preds, p)
+		}
+
+		query, args := sql.Dialect(d.Dialect()).
+			Select("*").
+			From(sql.Table(t.Name)).
+			Where(sql.And(preds...)).
+			OrderBy(t.PrimaryKey).
+			Limit(batchSize).
+			Query()
+
+		rows, err := queryRowMaps(ctx, d.entClient, query, args)
+		if err != nil {
+			d.log.Errorf("export table [%s] failed: %s", t.Name, err.Error())
+			return count, adminV1.ErrorInternalServerError("export table failed")
+		}
+
+		for _, row := range rows {
+			if err = fn(row); err != nil {
+				return count, err
+			}
+			lastID = toInt64(row[t.PrimaryKey])
+			count++
+		}
+
+		if len(rows) < batchSize {
+			return count, nil
+		}
+	}
+}
+
+// RestoreOptions 数据恢复选项
+type RestoreOptions struct {
+	// Tables 待恢复的表
+	Tables []string
+	// TenantID 租户备份的租户ID，清空与校验只针对该租户的数据
+	TenantID *uint32
+	// ReplaceExisting 为 true 时先清空待恢复的数据，否则要求待恢复的数据为空
+	ReplaceExisting bool
+}
+
+// Restore 在一个事务中恢复数据。read 依次产生 (表名, 行)，同一张表的行需要连续出现。
+// 返回每张表写入的行数。
+func (d *DatabaseDumper) Restore(
+	ctx context.Context,
+	opts RestoreOptions,
+	read func(emit func(table string, row map[string]any) error) error,
+) (map[string]uint64, error) {
+	tables := make([]*DatabaseDumpTable, 0, len(opts.Tables))
+	for _, name := range opts.Tables {
+		t, ok := d.Table(name)
+		if !ok {
+			return nil, adminV1.ErrorBadRequest("unknown table [%s]", name)
+		}
+		tables = append(tables, t)
+	}
+
+	conn, err := d.entClient.DB().Conn(ctx)
+	if err != nil {
+		d.log.Errorf("get restore connection failed: %s", err.Error())
+		return nil, adminV1.ErrorInternalServerError("get restore connection failed")
+	}
+	defer func() { _ = conn.Close() }()
+
+	dia := d.Dialect()
+
+	// MySQL 在会话内关闭外键检查，自引用和环形依赖的行可以按任意顺序写入，
+	// 连接归还连接池之前需要恢复
+	if dia == dialect.MySQL {
+		if _, err = conn.ExecContext(ctx, "SET FOREIGN_KEY_CHECKS = 0"); err != nil {
+			return nil, err
+		}
+		defer func() { _, _ = conn.ExecContext(context.WithoutCancel(ctx), "SET FOREIGN_KEY_CHECKS = 1") }()
+	}
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		d.log.Errorf("begin restore transaction failed: %s", err.Error())
+		return nil, adminV1.ErrorInternalServerError("begin restore transaction failed")
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err = d.prepareRestoreTables(ctx, tx, tables, opts.TenantID, opts.ReplaceExisting); err != nil {
+		return nil, err
+	}
+
+	w := &restoreWriter{ctx: ctx, dumper: d, tx: tx, counts: make(map[string]uint64)}
+	if err = read(w.emit); err != nil {
+		return nil, err
+	}
+	if err = w.flush(); err != nil {
+		return nil, err
+	}
+
+	if dia == dialect.Postgres {
+		for _, t := range tables {
+			if w.counts[t.Name] == 0 || !t.autoIncrement {
+				continue
+			}
+			if err = d.resetSequence(ctx, tx, t); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		d.log.Errorf("commit restore transaction failed: %s", err.Error())
+		return nil, adminV1.ErrorInternalServerError("commit restore transaction failed")
+	}
+
+	return w.counts, nil
+}
+
+// prepareRestoreTables 清空或校验待恢复的表
+func (d *DatabaseDumper) prepareRestoreTables(
+	ctx context.Context,
+	tx *stdsql.Tx,
+	tables []*DatabaseDumpTable,
+	tenantID *uint32,
+	replace bool,
+) error {
+	b := sql.Dialect(d.Dialect())
+
+	if replace {
+		// 先删除引用方
+		for i := len(tables) - 1; i >= 0; i-- {
+			builder := b.Delete(tables[i].Name)
+			if p := tables[i].tenantPredicate(tenantID); p != nil {
+				builder.Where(p)
+			}
+			query, args := builder.Query()
+			if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+				d.log.Errorf("clear table [%s] failed: %s", tables[i].Name, err.Error())
+				return adminV1.ErrorInternalServerError("clear table failed")
+			}
+		}
+		return nil
+	}
+
+	var nonEmpty []string
+	for _, t := range tables {
+		selector := b.Select(sql.Count("*")).From(sql.Table(t.Name))
+		if p := t.tenantPredicate(tenantID); p != nil {
+			selector.Where(p)
+		}
+		query, args := selector.Query()
+
+		var count int64
+		if err := tx.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+			d.log.Errorf("count table [%s] failed: %s", t.Name, err.Error())
+			return adminV1.ErrorInternalServerError("count table failed")
+		}
+		if count > 0 {
+			nonEmpty = append(nonEmpty, t.Name)
+		}
+	}
+	if len(nonEmpty) > 0 {
+		return adminV1.ErrorBadRequest("restore requires empty tables, not empty: %s", strings.Join(nonEmpty, ", "))
+	}
+
+	return nil
+}
+
+// resetSequence 恢复后把 PostgreSQL 自增序列推进到当前最大主键
+func (d *DatabaseDumper) resetSequence(ctx context.Context, tx *stdsql.Tx, t *DatabaseDumpTable) error {
+	query := fmt.Sprintf(
+		`SELECT setval(pg_get_serial_sequence('%s', '%s'), (SELECT COALESCE(MAX("%s"), 1) FROM "%s"))`,
+		t.Name, t.PrimaryKey, t.PrimaryKey, t.Name,
+	)
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		d.log.Errorf("reset sequence of [%s] failed: %s", t.Name, err.Error())
+		return adminV1.ErrorInternalServerError("reset sequence failed")
+	}
+	return nil
+}
+
+// restoreWriter 按表缓存行并批量写入
+type restoreWriter struct {
+	ctx    context.Context
+	dumper *DatabaseDumper
+	tx     *stdsql.Tx
+
+	table   *DatabaseDumpTable
+	columns []string
+	pending [][]any
+
+	counts map[string]uint64
+}
+
+func (w *restoreWriter) emit(table string, row map[string]any) error {
+	t, ok := w.dumper.Table(table)
+	if !ok {
+		return adminV1.ErrorBadRequest("unknown table [%s]", table)
+	}
+
+	columns := slices.Sorted(maps.Keys(row))
+	if w.table != t || !slices.Equal(w.columns, columns) || len(w.pending) >= defaultRestoreBatchSize {
+		if err := w.flush(); err != nil {
+			return err
+		}
+		w.table = t
+		w.columns = columns
+	}
+
+	values, err := convertDumpRow(t, columns, row)
+	if err != nil {
+		return err
+	}
+	w.pending = append(w.pending, values)
+
+	return nil
+}
+
+func (w *restoreWriter) flush() error {
+	if w.table == nil || len(w.pending) == 0 {
+		return nil
+	}
+
+	builder := sql.Dialect(w.dumper.Dialect()).
+		Insert(w.table.Name).
+		Columns(w.columns...)
+	for _, values := range w.pending {
+		builder.Values(values...)
+	}
+
+	query, args := builder.Query()
+	if _, err := w.tx.ExecContext(w.ctx, query, args...); err != nil {
+		w.dumper.log.Errorf("restore table [%s] failed: %s", w.table.Name, err.Error())
+		return adminV1.ErrorInternalServerError("restore table [%s] failed", w.table.Name)
+	}
+
+	w.counts[w.table.Name] += uint64(len(w.pending))
+	w.pending = w.pending[:0]
+
+	return nil
+}
+
+// convertDumpRow 按当前表结构转换一行导出的数据
+func convertDumpRow(t *DatabaseDumpTable, columns []string, row map[string]any) ([]any, error) {
+	values := make([]any, len(columns))
+	for i, col := range columns {
+		typ, ok := t.columns[col]
+		if !ok {
+			return nil, adminV1.ErrorBadRequest("unknown column [%s] of table [%s]", col, t.Name)
+		}
+
+		v, err := convertDumpValue(typ, row[col])
+		if err != nil {
+			return nil, adminV1.ErrorBadRequest("invalid value of [%s.%s]: %s", t.Name, col, err.Error())
+		}
+		values[i] = v
+	}
+	return values, nil
+}
+
+// convertDumpValue 把 JSON 解码得到的值转换为可写入数据库的值
+func convertDumpValue(typ field.Type, v any) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	switch typ {
+	case field.TypeBool:
+		switch val := v.(type) {
+		case bool:
+			return val, nil
+		case json.Number:
+			return val.String() != "0", nil
+		case string:
+			return strconv.ParseBool(val)
+		}
+
+	case field.TypeInt, field.TypeInt8, field.TypeInt16, field.TypeInt32, field.TypeInt64:
+		if n, ok := v.(json.Number); ok {
+			return n.Int64()
+		}
+		if s, ok := v.(string); ok {
+			return strconv.ParseInt(s, 10, 64)
+		}
+
+	case field.TypeUint, field.TypeUint8, field.TypeUint16, field.TypeUint32, field.TypeUint64:
+		if n, ok := v.(json.Number); ok {
+			return strconv.ParseUint(n.String(), 10, 64)
+		}
+		if s, ok := v.(string); ok {
+			return strconv.ParseUint(s, 10, 64)
+		}
+
+	case field.TypeFloat32, field.TypeFloat64:
+		if n, ok := v.(json.Number); ok {
+			return n.Float64()
+		}
+		if s, ok := v.(string); ok {
+			return strconv.ParseFloat(s, 64)
+		}
+
+	case field.TypeTime:
+		if t := toTime(v); t != nil {
+			return *t, nil
+		}
+
+	case field.TypeJSON:
+		if s, ok := v.(string); ok && json.Valid([]byte(s)) {
+			return s, nil
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		return string(b), nil
+
+	default:
+		switch val := v.(type) {
+		case string:
+			return val, nil
+		case json.Number:
+			return val.String(), nil
+		case bool:
+			return strconv.FormatBool(val), nil
+		}
+	}
+
+	return nil, fmt.Errorf("unexpected %T for %s column", v, typ.String())
+}
+
+// queryRowMaps 执行查询并把每一行转换为列名到值的映射
+func queryRowMaps(ctx context.Context, entClient *entCrud.EntClient[*ent.Client], query string, args []any) ([]map[string]any, error) {
+	var rows sql.Rows
+	if err := entClient.Query(ctx, query, args, &rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	var out []map[string]any
+	for rows.Next() {
+		values := make([]any, len(columns))
+		pointers := make([]any, len(columns))
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+		if err = rows.Scan(pointers...); err != nil {
+			return nil, err
+		}
+
+		row := make(map[string]any, len(columns))
+		for i, col := range columns {
+			row[col] = normalizeColumnValue(values[i])
+		}
+		out = append(out, row)
+	}
+
+	return out, rows.Err()
+}
@@ -0,0 +1,95 @@
+package data
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+	"github.com/stretchr/testify/assert"
+
+	"go-wind-admin/app/admin/service/internal/data/ent/migrate"
+	"go-wind-admin/app/admin/service/internal/data/ent/tenant"
+)
+
+func TestSortDumpTables(t *testing.T) {
+	parent := &schema.Table{Name: "parent"}
+	child := &schema.Table{Name: "child"}
+	grandchild := &schema.Table{Name: "grandchild"}
+
+	child.ForeignKeys = []*schema.ForeignKey{{RefTable: parent}, {RefTable: child}}
+	grandchild.ForeignKeys = []*schema.ForeignKey{{RefTable: child}, {RefTable: parent}}
+
+	sorted := sortDumpTables([]*schema.Table{grandchild, child, parent})
+
+	var names []string
+	for _, tbl := range sorted {
+		names = append(names, tbl.Name)
+	}
+	assert.Equal(t, []string{"parent", "child", "grandchild"}, names)
+
+	// 所有 ent 表都必须出现且只出现一次
+	all := sortDumpTables(migrate.Tables)
+	assert.Len(t, all, len(migrate.Tables))
+	seen := make(map[string]bool)
+	for _, tbl := range all {
+		for _, fk := range tbl.ForeignKeys {
+			if fk.RefTable != nil && fk.RefTable.Name != tbl.Name {
+				assert.True(t, seen[fk.RefTable.Name], "%s must come after %s", tbl.Name, fk.RefTable.Name)
+			}
+		}
+		seen[tbl.Name] = true
+	}
+}
+
+func TestDatabaseDumpTableTenantFilter(t *testing.T) {
+	tenantID := uint32(3)
+
+	for _, tbl := range migrate.Tables {
+		dt := newDatabaseDumpTable(tbl)
+		assert.True(t, dt.IncludedIn(nil))
+		assert.Nil(t, dt.tenantPredicate(nil))
+
+		if dt.Name == tenant.Table {
+			assert.True(t, dt.IncludedIn(&tenantID))
+			assert.NotNil(t, dt.tenantPredicate(&tenantID))
+		}
+		if dt.TenantScoped {
+			assert.True(t, dt.IncludedIn(&tenantID))
+		}
+	}
+}
+
+func TestConvertDumpValue(t *testing.T) {
+	v, err := convertDumpValue(field.TypeUint32, json.Number("42"))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(42), v)
+
+	v, err = convertDumpValue(field.TypeInt64, json.Number("-7"))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-7), v)
+
+	v, err = convertDumpValue(field.TypeBool, json.Number("1"))
+	assert.NoError(t, err)
+	assert.Equal(t, true, v)
+
+	v, err = convertDumpValue(field.TypeJSON, map[string]any{"a": json.Number("1")})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"a":1}`, v)
+
+	v, err = convertDumpValue(field.TypeJSON, "plain")
+	assert.NoError(t, err)
+	assert.Equal(t, `"plain"`, v)
+
+	v, err = convertDumpValue(field.TypeTime, "2024-05-01T08:30:00Z")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 5, 1, 8, 30, 0, 0, time.UTC), v)
+
+	v, err = convertDumpValue(field.TypeString, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+
+	_, err = convertDumpValue(field.TypeInt, true)
+	assert.Error(t, err)
+}
@@ -0,0 +1,46 @@
+// Package datatest 提供数据层测试使用的内存数据库和启动上下文
+package datatest
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entSql "entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/glebarez/go-sqlite"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	conf "github.com/tx7do/kratos-bootstrap/api/gen/go/conf/v1"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/migrate"
+)
+
+// NewEntClient 创建使用内存 SQLite 数据库的 Ent 客户端，每个测试独立一个数据库
+func NewEntClient(t testing.TB) *entCrud.EntClient[*ent.Client] {
+	t.Helper()
+
+	name := strings.NewReplacer("/", "_", " ", "_").Replace(t.Name())
+	db, err := sql.Open("sqlite", "file:"+name+"?mode=memory&cache=shared&_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)")
+	require.NoError(t, err)
+
+	drv := entSql.OpenDB(dialect.SQLite, db)
+	client := ent.NewClient(ent.Driver(drv))
+	require.NoError(t, client.Schema.Create(context.Background(), migrate.WithForeignKeys(false)))
+
+	cli := entCrud.NewEntClient(client, drv)
+	t.Cleanup(func() { _ = cli.Close() })
+	return cli
+}
+
+// NewBootstrapContext 创建测试使用的最小启动上下文
+func NewBootstrapContext() *bootstrap.Context {
+	return bootstrap.NewContextWithParam(context.Background(), &conf.AppInfo{}, &conf.Bootstrap{}, log.DefaultLogger)
+}
@@ -0,0 +1,417 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/auditforwarder"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// 审计事件转发器（SIEM）表
+type AuditForwarder struct {
+	config `json:"-"`
+	// ID of the ent.
+	// id
+	ID uint32 `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	// 删除时间
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// 创建者ID
+	CreatedBy *uint32 `json:"created_by,omitempty"`
+	// 更新者ID
+	UpdatedBy *uint32 `json:"updated_by,omitempty"`
+	// 删除者ID
+	DeletedBy *uint32 `json:"deleted_by,omitempty"`
+	// 备注
+	Remark *string `json:"remark,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// 名称
+	Name *string `json:"name,omitempty"`
+	// 是否启用
+	Enabled *bool `json:"enabled,omitempty"`
+	// 输出格式
+	Format *auditforwarder.Format `json:"format,omitempty"`
+	// 传输方式
+	Transport *auditforwarder.Transport `json:"transport,omitempty"`
+	// 目标地址
+	Endpoint *string `json:"endpoint,omitempty"`
+	// 转发的审计日志类型
+	LogTypes []string `json:"log_types,omitempty"`
+	// 字段映射
+	FieldMapping map[string]string `json:"field_mapping,omitempty"`
+	// Webhook签名密钥
+	Secret *string `json:"-"`
+	// Webhook附加请求头
+	Headers map[string]string `json:"headers,omitempty"`
+	// TLS是否跳过证书校验
+	TLSInsecureSkipVerify *bool `json:"tls_insecure_skip_verify,omitempty"`
+	// TLS信任的CA证书
+	TLSCaPem *string `json:"tls_ca_pem,omitempty"`
+	// TLS服务器名称
+	TLSServerName *string `json:"tls_server_name,omitempty"`
+	// Syslog APP-NAME
+	AppName *string `json:"app_name,omitempty"`
+	// Syslog facility
+	Facility *uint32 `json:"facility,omitempty"`
+	// 磁盘缓冲上限（字节）
+	MaxBufferBytes *uint64 `json:"max_buffer_bytes,omitempty"`
+	selectValues   sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AuditForwarder) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case auditforwarder.FieldLogTypes, auditforwarder.FieldFieldMapping, auditforwarder.FieldHeaders:
+			values[i] = new([]byte)
+		case auditforwarder.FieldEnabled, auditforwarder.FieldTLSInsecureSkipVerify:
+			values[i] = new(sql.NullBool)
+		case auditforwarder.FieldID, auditforwarder.FieldCreatedBy, auditforwarder.FieldUpdatedBy, auditforwarder.FieldDeletedBy, auditforwarder.FieldTenantID, auditforwarder.FieldFacility, auditforwarder.FieldMaxBufferBytes:
+			values[i] = new(sql.NullInt64)
+		case auditforwarder.FieldRemark, auditforwarder.FieldName, auditforwarder.FieldFormat, auditforwarder.FieldTransport, auditforwarder.FieldEndpoint, auditforwarder.FieldSecret, auditforwarder.FieldTLSCaPem, auditforwarder.FieldTLSServerName, auditforwarder.FieldAppName:
+			values[i] = new(sql.NullString)
+		case auditforwarder.FieldCreatedAt, auditforwarder.FieldUpdatedAt, auditforwarder.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AuditForwarder fields.
+func (_m *AuditForwarder) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case auditforwarder.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint32(value.Int64)
+		case auditforwarder.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = new(time.Time)
+				*_m.CreatedAt = value.Time
+			}
+		case auditforwarder.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = new(time.Time)
+				*_m.UpdatedAt = value.Time
+			}
+		case auditforwarder.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case auditforwarder.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = new(uint32)
+				*_m.CreatedBy = uint32(value.Int64)
+			}
+		case auditforwarder.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				_m.UpdatedBy = new(uint32)
+				*_m.UpdatedBy = uint32(value.Int64)
+			}
+		case auditforwarder.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				_m.DeletedBy = new(uint32)
+				*_m.DeletedBy = uint32(value.Int64)
+			}
+		case auditforwarder.FieldRemark:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field remark", values[i])
+			} else if value.Valid {
+				_m.Remark = new(string)
+				*_m.Remark = value.String
+			}
+		case auditforwarder.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case auditforwarder.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = new(string)
+				*_m.Name = value.String
+			}
+		case auditforwarder.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = new(bool)
+				*_m.Enabled = value.Bool
+			}
+		case auditforwarder.FieldFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field format", values[i])
+			} else if value.Valid {
+				_m.Format = new(auditforwarder.Format)
+				*_m.Format = auditforwarder.Format(value.String)
+			}
+		case auditforwarder.FieldTransport:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field transport", values[i])
+			} else if value.Valid {
+				_m.Transport = new(auditforwarder.Transport)
+				*_m.Transport = auditforwarder.Transport(value.String)
+			}
+		case auditforwarder.FieldEndpoint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field endpoint", values[i])
+			} else if value.Valid {
+				_m.Endpoint = new(string)
+				*_m.Endpoint = value.String
+			}
+		case auditforwarder.FieldLogTypes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field log_types", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.LogTypes); err != nil {
+					return fmt.Errorf("unmarshal field log_types: %w", err)
+				}
+			}
+		case auditforwarder.FieldFieldMapping:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field field_mapping", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.FieldMapping); err != nil {
+					return fmt.Errorf("unmarshal field field_mapping: %w", err)
+				}
+			}
+		case auditforwarder.FieldSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field secret", values[i])
+			} else if value.Valid {
+				_m.Secret = new(string)
+				*_m.Secret = value.String
+			}
+		case auditforwarder.FieldHeaders:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field headers", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Headers); err != nil {
+					return fmt.Errorf("unmarshal field headers: %w", err)
+				}
+			}
+		case auditforwarder.FieldTLSInsecureSkipVerify:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field tls_insecure_skip_verify", values[i])
+			} else if value.Valid {
+				_m.TLSInsecureSkipVerify = new(bool)
+				*_m.TLSInsecureSkipVerify = value.Bool
+			}
+		case auditforwarder.FieldTLSCaPem:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tls_ca_pem", values[i])
+			} else if value.Valid {
+				_m.TLSCaPem = new(string)
+				*_m.TLSCaPem = value.String
+			}
+		case auditforwarder.FieldTLSServerName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tls_server_name", values[i])
+			} else if value.Valid {
+				_m.TLSServerName = new(string)
+				*_m.TLSServerName = value.String
+			}
+		case auditforwarder.FieldAppName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field app_name", values[i])
+			} else if value.Valid {
+				_m.AppName = new(string)
+				*_m.AppName = value.String
+			}
+		case auditforwarder.FieldFacility:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field facility", values[i])
+			} else if value.Valid {
+				_m.Facility = new(uint32)
+				*_m.Facility = uint32(value.Int64)
+			}
+		case auditforwarder.FieldMaxBufferBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_buffer_bytes", values[i])
+			} else if value.Valid {
+				_m.MaxBufferBytes = new(uint64)
+				*_m.MaxBufferBytes = uint64(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AuditForwarder.
+// This includes values selected through modifiers, order, etc.
+func (_m *AuditForwarder) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AuditForwarder.
+// Note that you need to call AuditForwarder.Unwrap() before calling this method if this AuditForwarder
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AuditForwarder) Update() *AuditForwarderUpdateOne {
+	return NewAuditForwarderClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AuditForwarder entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AuditForwarder) Unwrap() *AuditForwarder {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AuditForwarder is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AuditForwarder) String() string {
+	var builder strings.Builder
+	builder.WriteString("AuditForwarder(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreatedAt; v != nil {
+		builder.WriteString("created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdatedAt; v != nil {
+		builder.WriteString("updated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.CreatedBy; v != nil {
+		builder.WriteString("created_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdatedBy; v != nil {
+		builder.WriteString("updated_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeletedBy; v != nil {
+		builder.WriteString("deleted_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Remark; v != nil {
+		builder.WriteString("remark=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Name; v != nil {
+		builder.WriteString("name=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Enabled; v != nil {
+		builder.WriteString("enabled=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Format; v != nil {
+		builder.WriteString("format=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Transport; v != nil {
+		builder.WriteString("transport=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Endpoint; v != nil {
+		builder.WriteString("endpoint=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("log_types=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LogTypes))
+	builder.WriteString(", ")
+	builder.WriteString("field_mapping=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FieldMapping))
+	builder.WriteString(", ")
+	builder.WriteString("secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("headers=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Headers))
+	builder.WriteString(", ")
+	if v := _m.TLSInsecureSkipVerify; v != nil {
+		builder.WriteString("tls_insecure_skip_verify=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.TLSCaPem; v != nil {
+		builder.WriteString("tls_ca_pem=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.TLSServerName; v != nil {
+		builder.WriteString("tls_server_name=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.AppName; v != nil {
+		builder.WriteString("app_name=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Facility; v != nil {
+		builder.WriteString("facility=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.MaxBufferBytes; v != nil {
+		builder.WriteString("max_buffer_bytes=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AuditForwarders is a parsable slice of AuditForwarder.
+type AuditForwarders []*AuditForwarder
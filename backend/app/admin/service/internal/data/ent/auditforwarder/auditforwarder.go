@@ -0,0 +1,292 @@
+// Code generated by ent, DO NOT EDIT.
+
+package auditforwarder
+
+import (
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the auditforwarder type in the database.
+	Label = "audit_forwarder"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldUpdatedBy holds the string denoting the updated_by field in the database.
+	FieldUpdatedBy = "updated_by"
+	// FieldDeletedBy holds the string denoting the deleted_by field in the database.
+	FieldDeletedBy = "deleted_by"
+	// FieldRemark holds the string denoting the remark field in the database.
+	FieldRemark = "remark"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldFormat holds the string denoting the format field in the database.
+	FieldFormat = "format"
+	// FieldTransport holds the string denoting the transport field in the database.
+	FieldTransport = "transport"
+	// FieldEndpoint holds the string denoting the endpoint field in the database.
+	FieldEndpoint = "endpoint"
+	// FieldLogTypes holds the string denoting the log_types field in the database.
+	FieldLogTypes = "log_types"
+	// FieldFieldMapping holds the string denoting the field_mapping field in the database.
+	FieldFieldMapping = "field_mapping"
+	// FieldSecret holds the string denoting the secret field in the database.
+	FieldSecret = "secret"
+	// FieldHeaders holds the string denoting the headers field in the database.
+	FieldHeaders = "headers"
+	// FieldTLSInsecureSkipVerify holds the string denoting the tls_insecure_skip_verify field in the database.
+	FieldTLSInsecureSkipVerify = "tls_insecure_skip_verify"
+	// FieldTLSCaPem holds the string denoting the tls_ca_pem field in the database.
+	FieldTLSCaPem = "tls_ca_pem"
+	// FieldTLSServerName holds the string denoting the tls_server_name field in the database.
+	FieldTLSServerName = "tls_server_name"
+	// FieldAppName holds the string denoting the app_name field in the database.
+	FieldAppName = "app_name"
+	// FieldFacility holds the string denoting the facility field in the database.
+	FieldFacility = "facility"
+	// FieldMaxBufferBytes holds the string denoting the max_buffer_bytes field in the database.
+	FieldMaxBufferBytes = "max_buffer_bytes"
+	// Table holds the table name of the auditforwarder in the database.
+	Table = "sys_audit_forwarders"
+)
+
+// Columns holds all SQL columns for auditforwarder fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldCreatedBy,
+	FieldUpdatedBy,
+	FieldDeletedBy,
+	FieldRemark,
+	FieldTenantID,
+	FieldName,
+	FieldEnabled,
+	FieldFormat,
+	FieldTransport,
+	FieldEndpoint,
+	FieldLogTypes,
+	FieldFieldMapping,
+	FieldSecret,
+	FieldHeaders,
+	FieldTLSInsecureSkipVerify,
+	FieldTLSCaPem,
+	FieldTLSServerName,
+	FieldAppName,
+	FieldFacility,
+	FieldMaxBufferBytes,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "go-wind-admin/app/admin/service/internal/data/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID uint32
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// EndpointValidator is a validator for the "endpoint" field. It is called by the builders before save.
+	EndpointValidator func(string) error
+	// SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	SecretValidator func(string) error
+	// DefaultTLSInsecureSkipVerify holds the default value on creation for the "tls_insecure_skip_verify" field.
+	DefaultTLSInsecureSkipVerify bool
+	// TLSServerNameValidator is a validator for the "tls_server_name" field. It is called by the builders before save.
+	TLSServerNameValidator func(string) error
+	// AppNameValidator is a validator for the "app_name" field. It is called by the builders before save.
+	AppNameValidator func(string) error
+	// DefaultFacility holds the default value on creation for the "facility" field.
+	DefaultFacility uint32
+	// DefaultMaxBufferBytes holds the default value on creation for the "max_buffer_bytes" field.
+	DefaultMaxBufferBytes uint64
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(uint32) error
+)
+
+// Format defines the type for the "format" enum field.
+type Format string
+
+// Format values.
+const (
+	FormatRfc5424 Format = "RFC5424"
+	FormatCef     Format = "CEF"
+	FormatJson    Format = "JSON"
+)
+
+func (f Format) String() string {
+	return string(f)
+}
+
+// FormatValidator is a validator for the "format" field enum values. It is called by the builders before save.
+func FormatValidator(f Format) error {
+	switch f {
+	case FormatRfc5424, FormatCef, FormatJson:
+		return nil
+	default:
+		return fmt.Errorf("auditforwarder: invalid enum value for format field: %q", f)
+	}
+}
+
+// Transport defines the type for the "transport" enum field.
+type Transport string
+
+// Transport values.
+const (
+	TransportUdp  Transport = "UDP"
+	TransportTcp  Transport = "TCP"
+	TransportTls  Transport = "TLS"
+	TransportHttp Transport = "HTTP"
+)
+
+func (t Transport) String() string {
+	return string(t)
+}
+
+// TransportValidator is a validator for the "transport" field enum values. It is called by the builders before save.
+func TransportValidator(t Transport) error {
+	switch t {
+	case TransportUdp, TransportTcp, TransportTls, TransportHttp:
+		return nil
+	default:
+		return fmt.Errorf("auditforwarder: invalid enum value for transport field: %q", t)
+	}
+}
+
+// OrderOption defines the ordering options for the AuditForwarder queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByUpdatedBy orders the results by the updated_by field.
+func ByUpdatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedBy, opts...).ToFunc()
+}
+
+// ByDeletedBy orders the results by the deleted_by field.
+func ByDeletedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedBy, opts...).ToFunc()
+}
+
+// ByRemark orders the results by the remark field.
+func ByRemark(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRemark, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// ByFormat orders the results by the format field.
+func ByFormat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFormat, opts...).ToFunc()
+}
+
+// ByTransport orders the results by the transport field.
+func ByTransport(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTransport, opts...).ToFunc()
+}
+
+// ByEndpoint orders the results by the endpoint field.
+func ByEndpoint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndpoint, opts...).ToFunc()
+}
+
+// BySecret orders the results by the secret field.
+func BySecret(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSecret, opts...).ToFunc()
+}
+
+// ByTLSInsecureSkipVerify orders the results by the tls_insecure_skip_verify field.
+func ByTLSInsecureSkipVerify(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTLSInsecureSkipVerify, opts...).ToFunc()
+}
+
+// ByTLSCaPem orders the results by the tls_ca_pem field.
+func ByTLSCaPem(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTLSCaPem, opts...).ToFunc()
+}
+
+// ByTLSServerName orders the results by the tls_server_name field.
+func ByTLSServerName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTLSServerName, opts...).ToFunc()
+}
+
+// ByAppName orders the results by the app_name field.
+func ByAppName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAppName, opts...).ToFunc()
+}
+
+// ByFacility orders the results by the facility field.
+func ByFacility(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFacility, opts...).ToFunc()
+}
+
+// ByMaxBufferBytes orders the results by the max_buffer_bytes field.
+func ByMaxBufferBytes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxBufferBytes, opts...).ToFunc()
+}
@@ -0,0 +1,1265 @@
+// Code generated by ent, DO NOT EDIT.
+
+package auditforwarder
+
+import (
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// DeletedBy applies equality check predicate on the "deleted_by" field. It's identical to DeletedByEQ.
+func DeletedBy(v uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// Remark applies equality check predicate on the "remark" field. It's identical to RemarkEQ.
+func Remark(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEQ(FieldRemark, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEQ(FieldName, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEQ(FieldEnabled, v))
+}
+
+// Endpoint applies equality check predicate on the "endpoint" field. It's identical to EndpointEQ.
+func Endpoint(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEQ(FieldEndpoint, v))
+}
+
+// Secret applies equality check predicate on the "secret" field. It's identical to SecretEQ.
+func Secret(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEQ(FieldSecret, v))
+}
+
+// TLSInsecureSkipVerify applies equality check predicate on the "tls_insecure_skip_verify" field. It's identical to TLSInsecureSkipVerifyEQ.
+func TLSInsecureSkipVerify(v bool) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEQ(FieldTLSInsecureSkipVerify, v))
+}
+
+// TLSCaPem applies equality check predicate on the "tls_ca_pem" field. It's identical to TLSCaPemEQ.
+func TLSCaPem(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEQ(FieldTLSCaPem, v))
+}
+
+// TLSServerName applies equality check predicate on the "tls_server_name" field. It's identical to TLSServerNameEQ.
+func TLSServerName(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEQ(FieldTLSServerName, v))
+}
+
+// AppName applies equality check predicate on the "app_name" field. It's identical to AppNameEQ.
+func AppName(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEQ(FieldAppName, v))
+}
+
+// Facility applies equality check predicate on the "facility" field. It's identical to FacilityEQ.
+func Facility(v uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEQ(FieldFacility, v))
+}
+
+// MaxBufferBytes applies equality check predicate on the "max_buffer_bytes" field. It's identical to MaxBufferBytesEQ.
+func MaxBufferBytes(v uint64) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEQ(FieldMaxBufferBytes, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// DeletedByEQ applies the EQ predicate on the "deleted_by" field.
+func DeletedByEQ(v uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// DeletedByNEQ applies the NEQ predicate on the "deleted_by" field.
+func DeletedByNEQ(v uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNEQ(FieldDeletedBy, v))
+}
+
+// DeletedByIn applies the In predicate on the "deleted_by" field.
+func DeletedByIn(vs ...uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByNotIn applies the NotIn predicate on the "deleted_by" field.
+func DeletedByNotIn(vs ...uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByGT applies the GT predicate on the "deleted_by" field.
+func DeletedByGT(v uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldGT(FieldDeletedBy, v))
+}
+
+// DeletedByGTE applies the GTE predicate on the "deleted_by" field.
+func DeletedByGTE(v uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldGTE(FieldDeletedBy, v))
+}
+
+// DeletedByLT applies the LT predicate on the "deleted_by" field.
+func DeletedByLT(v uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldLT(FieldDeletedBy, v))
+}
+
+// DeletedByLTE applies the LTE predicate on the "deleted_by" field.
+func DeletedByLTE(v uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldLTE(FieldDeletedBy, v))
+}
+
+// DeletedByIsNil applies the IsNil predicate on the "deleted_by" field.
+func DeletedByIsNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIsNull(FieldDeletedBy))
+}
+
+// DeletedByNotNil applies the NotNil predicate on the "deleted_by" field.
+func DeletedByNotNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotNull(FieldDeletedBy))
+}
+
+// RemarkEQ applies the EQ predicate on the "remark" field.
+func RemarkEQ(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEQ(FieldRemark, v))
+}
+
+// RemarkNEQ applies the NEQ predicate on the "remark" field.
+func RemarkNEQ(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNEQ(FieldRemark, v))
+}
+
+// RemarkIn applies the In predicate on the "remark" field.
+func RemarkIn(vs ...string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIn(FieldRemark, vs...))
+}
+
+// RemarkNotIn applies the NotIn predicate on the "remark" field.
+func RemarkNotIn(vs ...string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotIn(FieldRemark, vs...))
+}
+
+// RemarkGT applies the GT predicate on the "remark" field.
+func RemarkGT(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldGT(FieldRemark, v))
+}
+
+// RemarkGTE applies the GTE predicate on the "remark" field.
+func RemarkGTE(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldGTE(FieldRemark, v))
+}
+
+// RemarkLT applies the LT predicate on the "remark" field.
+func RemarkLT(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldLT(FieldRemark, v))
+}
+
+// RemarkLTE applies the LTE predicate on the "remark" field.
+func RemarkLTE(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldLTE(FieldRemark, v))
+}
+
+// RemarkContains applies the Contains predicate on the "remark" field.
+func RemarkContains(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldContains(FieldRemark, v))
+}
+
+// RemarkHasPrefix applies the HasPrefix predicate on the "remark" field.
+func RemarkHasPrefix(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldHasPrefix(FieldRemark, v))
+}
+
+// RemarkHasSuffix applies the HasSuffix predicate on the "remark" field.
+func RemarkHasSuffix(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldHasSuffix(FieldRemark, v))
+}
+
+// RemarkIsNil applies the IsNil predicate on the "remark" field.
+func RemarkIsNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIsNull(FieldRemark))
+}
+
+// RemarkNotNil applies the NotNil predicate on the "remark" field.
+func RemarkNotNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotNull(FieldRemark))
+}
+
+// RemarkEqualFold applies the EqualFold predicate on the "remark" field.
+func RemarkEqualFold(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEqualFold(FieldRemark, v))
+}
+
+// RemarkContainsFold applies the ContainsFold predicate on the "remark" field.
+func RemarkContainsFold(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldContainsFold(FieldRemark, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotNull(FieldTenantID))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIsNull(FieldName))
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotNull(FieldName))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldContainsFold(FieldName, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// EnabledIsNil applies the IsNil predicate on the "enabled" field.
+func EnabledIsNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIsNull(FieldEnabled))
+}
+
+// EnabledNotNil applies the NotNil predicate on the "enabled" field.
+func EnabledNotNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotNull(FieldEnabled))
+}
+
+// FormatEQ applies the EQ predicate on the "format" field.
+func FormatEQ(v Format) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEQ(FieldFormat, v))
+}
+
+// FormatNEQ applies the NEQ predicate on the "format" field.
+func FormatNEQ(v Format) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNEQ(FieldFormat, v))
+}
+
+// FormatIn applies the In predicate on the "format" field.
+func FormatIn(vs ...Format) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIn(FieldFormat, vs...))
+}
+
+// FormatNotIn applies the NotIn predicate on the "format" field.
+func FormatNotIn(vs ...Format) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotIn(FieldFormat, vs...))
+}
+
+// FormatIsNil applies the IsNil predicate on the "format" field.
+func FormatIsNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIsNull(FieldFormat))
+}
+
+// FormatNotNil applies the NotNil predicate on the "format" field.
+func FormatNotNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotNull(FieldFormat))
+}
+
+// TransportEQ applies the EQ predicate on the "transport" field.
+func TransportEQ(v Transport) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEQ(FieldTransport, v))
+}
+
+// TransportNEQ applies the NEQ predicate on the "transport" field.
+func TransportNEQ(v Transport) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNEQ(FieldTransport, v))
+}
+
+// TransportIn applies the In predicate on the "transport" field.
+func TransportIn(vs ...Transport) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIn(FieldTransport, vs...))
+}
+
+// TransportNotIn applies the NotIn predicate on the "transport" field.
+func TransportNotIn(vs ...Transport) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotIn(FieldTransport, vs...))
+}
+
+// TransportIsNil applies the IsNil predicate on the "transport" field.
+func TransportIsNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIsNull(FieldTransport))
+}
+
+// TransportNotNil applies the NotNil predicate on the "transport" field.
+func TransportNotNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotNull(FieldTransport))
+}
+
+// EndpointEQ applies the EQ predicate on the "endpoint" field.
+func EndpointEQ(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEQ(FieldEndpoint, v))
+}
+
+// EndpointNEQ applies the NEQ predicate on the "endpoint" field.
+func EndpointNEQ(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNEQ(FieldEndpoint, v))
+}
+
+// EndpointIn applies the In predicate on the "endpoint" field.
+func EndpointIn(vs ...string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIn(FieldEndpoint, vs...))
+}
+
+// EndpointNotIn applies the NotIn predicate on the "endpoint" field.
+func EndpointNotIn(vs ...string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotIn(FieldEndpoint, vs...))
+}
+
+// EndpointGT applies the GT predicate on the "endpoint" field.
+func EndpointGT(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldGT(FieldEndpoint, v))
+}
+
+// EndpointGTE applies the GTE predicate on the "endpoint" field.
+func EndpointGTE(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldGTE(FieldEndpoint, v))
+}
+
+// EndpointLT applies the LT predicate on the "endpoint" field.
+func EndpointLT(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldLT(FieldEndpoint, v))
+}
+
+// EndpointLTE applies the LTE predicate on the "endpoint" field.
+func EndpointLTE(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldLTE(FieldEndpoint, v))
+}
+
+// EndpointContains applies the Contains predicate on the "endpoint" field.
+func EndpointContains(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldContains(FieldEndpoint, v))
+}
+
+// EndpointHasPrefix applies the HasPrefix predicate on the "endpoint" field.
+func EndpointHasPrefix(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldHasPrefix(FieldEndpoint, v))
+}
+
+// EndpointHasSuffix applies the HasSuffix predicate on the "endpoint" field.
+func EndpointHasSuffix(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldHasSuffix(FieldEndpoint, v))
+}
+
+// EndpointIsNil applies the IsNil predicate on the "endpoint" field.
+func EndpointIsNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIsNull(FieldEndpoint))
+}
+
+// EndpointNotNil applies the NotNil predicate on the "endpoint" field.
+func EndpointNotNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotNull(FieldEndpoint))
+}
+
+// EndpointEqualFold applies the EqualFold predicate on the "endpoint" field.
+func EndpointEqualFold(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEqualFold(FieldEndpoint, v))
+}
+
+// EndpointContainsFold applies the ContainsFold predicate on the "endpoint" field.
+func EndpointContainsFold(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldContainsFold(FieldEndpoint, v))
+}
+
+// LogTypesIsNil applies the IsNil predicate on the "log_types" field.
+func LogTypesIsNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIsNull(FieldLogTypes))
+}
+
+// LogTypesNotNil applies the NotNil predicate on the "log_types" field.
+func LogTypesNotNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotNull(FieldLogTypes))
+}
+
+// FieldMappingIsNil applies the IsNil predicate on the "field_mapping" field.
+func FieldMappingIsNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIsNull(FieldFieldMapping))
+}
+
+// FieldMappingNotNil applies the NotNil predicate on the "field_mapping" field.
+func FieldMappingNotNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotNull(FieldFieldMapping))
+}
+
+// SecretEQ applies the EQ predicate on the "secret" field.
+func SecretEQ(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEQ(FieldSecret, v))
+}
+
+// SecretNEQ applies the NEQ predicate on the "secret" field.
+func SecretNEQ(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNEQ(FieldSecret, v))
+}
+
+// SecretIn applies the In predicate on the "secret" field.
+func SecretIn(vs ...string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIn(FieldSecret, vs...))
+}
+
+// SecretNotIn applies the NotIn predicate on the "secret" field.
+func SecretNotIn(vs ...string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotIn(FieldSecret, vs...))
+}
+
+// SecretGT applies the GT predicate on the "secret" field.
+func SecretGT(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldGT(FieldSecret, v))
+}
+
+// SecretGTE applies the GTE predicate on the "secret" field.
+func SecretGTE(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldGTE(FieldSecret, v))
+}
+
+// SecretLT applies the LT predicate on the "secret" field.
+func SecretLT(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldLT(FieldSecret, v))
+}
+
+// SecretLTE applies the LTE predicate on the "secret" field.
+func SecretLTE(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldLTE(FieldSecret, v))
+}
+
+// SecretContains applies the Contains predicate on the "secret" field.
+func SecretContains(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldContains(FieldSecret, v))
+}
+
+// SecretHasPrefix applies the HasPrefix predicate on the "secret" field.
+func SecretHasPrefix(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldHasPrefix(FieldSecret, v))
+}
+
+// SecretHasSuffix applies the HasSuffix predicate on the "secret" field.
+func SecretHasSuffix(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldHasSuffix(FieldSecret, v))
+}
+
+// SecretIsNil applies the IsNil predicate on the "secret" field.
+func SecretIsNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIsNull(FieldSecret))
+}
+
+// SecretNotNil applies the NotNil predicate on the "secret" field.
+func SecretNotNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotNull(FieldSecret))
+}
+
+// SecretEqualFold applies the EqualFold predicate on the "secret" field.
+func SecretEqualFold(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEqualFold(FieldSecret, v))
+}
+
+// SecretContainsFold applies the ContainsFold predicate on the "secret" field.
+func SecretContainsFold(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldContainsFold(FieldSecret, v))
+}
+
+// HeadersIsNil applies the IsNil predicate on the "headers" field.
+func HeadersIsNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIsNull(FieldHeaders))
+}
+
+// HeadersNotNil applies the NotNil predicate on the "headers" field.
+func HeadersNotNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotNull(FieldHeaders))
+}
+
+// TLSInsecureSkipVerifyEQ applies the EQ predicate on the "tls_insecure_skip_verify" field.
+func TLSInsecureSkipVerifyEQ(v bool) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEQ(FieldTLSInsecureSkipVerify, v))
+}
+
+// TLSInsecureSkipVerifyNEQ applies the NEQ predicate on the "tls_insecure_skip_verify" field.
+func TLSInsecureSkipVerifyNEQ(v bool) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNEQ(FieldTLSInsecureSkipVerify, v))
+}
+
+// TLSInsecureSkipVerifyIsNil applies the IsNil predicate on the "tls_insecure_skip_verify" field.
+func TLSInsecureSkipVerifyIsNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIsNull(FieldTLSInsecureSkipVerify))
+}
+
+// TLSInsecureSkipVerifyNotNil applies the NotNil predicate on the "tls_insecure_skip_verify" field.
+func TLSInsecureSkipVerifyNotNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotNull(FieldTLSInsecureSkipVerify))
+}
+
+// TLSCaPemEQ applies the EQ predicate on the "tls_ca_pem" field.
+func TLSCaPemEQ(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEQ(FieldTLSCaPem, v))
+}
+
+// TLSCaPemNEQ applies the NEQ predicate on the "tls_ca_pem" field.
+func TLSCaPemNEQ(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNEQ(FieldTLSCaPem, v))
+}
+
+// TLSCaPemIn applies the In predicate on the "tls_ca_pem" field.
+func TLSCaPemIn(vs ...string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIn(FieldTLSCaPem, vs...))
+}
+
+// TLSCaPemNotIn applies the NotIn predicate on the "tls_ca_pem" field.
+func TLSCaPemNotIn(vs ...string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotIn(FieldTLSCaPem, vs...))
+}
+
+// TLSCaPemGT applies the GT predicate on the "tls_ca_pem" field.
+func TLSCaPemGT(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldGT(FieldTLSCaPem, v))
+}
+
+// TLSCaPemGTE applies the GTE predicate on the "tls_ca_pem" field.
+func TLSCaPemGTE(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldGTE(FieldTLSCaPem, v))
+}
+
+// TLSCaPemLT applies the LT predicate on the "tls_ca_pem" field.
+func TLSCaPemLT(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldLT(FieldTLSCaPem, v))
+}
+
+// TLSCaPemLTE applies the LTE predicate on the "tls_ca_pem" field.
+func TLSCaPemLTE(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldLTE(FieldTLSCaPem, v))
+}
+
+// TLSCaPemContains applies the Contains predicate on the "tls_ca_pem" field.
+func TLSCaPemContains(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldContains(FieldTLSCaPem, v))
+}
+
+// TLSCaPemHasPrefix applies the HasPrefix predicate on the "tls_ca_pem" field.
+func TLSCaPemHasPrefix(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldHasPrefix(FieldTLSCaPem, v))
+}
+
+// TLSCaPemHasSuffix applies the HasSuffix predicate on the "tls_ca_pem" field.
+func TLSCaPemHasSuffix(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldHasSuffix(FieldTLSCaPem, v))
+}
+
+// TLSCaPemIsNil applies the IsNil predicate on the "tls_ca_pem" field.
+func TLSCaPemIsNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIsNull(FieldTLSCaPem))
+}
+
+// TLSCaPemNotNil applies the NotNil predicate on the "tls_ca_pem" field.
+func TLSCaPemNotNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotNull(FieldTLSCaPem))
+}
+
+// TLSCaPemEqualFold applies the EqualFold predicate on the "tls_ca_pem" field.
+func TLSCaPemEqualFold(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEqualFold(FieldTLSCaPem, v))
+}
+
+// TLSCaPemContainsFold applies the ContainsFold predicate on the "tls_ca_pem" field.
+func TLSCaPemContainsFold(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldContainsFold(FieldTLSCaPem, v))
+}
+
+// TLSServerNameEQ applies the EQ predicate on the "tls_server_name" field.
+func TLSServerNameEQ(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEQ(FieldTLSServerName, v))
+}
+
+// TLSServerNameNEQ applies the NEQ predicate on the "tls_server_name" field.
+func TLSServerNameNEQ(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNEQ(FieldTLSServerName, v))
+}
+
+// TLSServerNameIn applies the In predicate on the "tls_server_name" field.
+func TLSServerNameIn(vs ...string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIn(FieldTLSServerName, vs...))
+}
+
+// TLSServerNameNotIn applies the NotIn predicate on the "tls_server_name" field.
+func TLSServerNameNotIn(vs ...string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotIn(FieldTLSServerName, vs...))
+}
+
+// TLSServerNameGT applies the GT predicate on the "tls_server_name" field.
+func TLSServerNameGT(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldGT(FieldTLSServerName, v))
+}
+
+// TLSServerNameGTE applies the GTE predicate on the "tls_server_name" field.
+func TLSServerNameGTE(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldGTE(FieldTLSServerName, v))
+}
+
+// TLSServerNameLT applies the LT predicate on the "tls_server_name" field.
+func TLSServerNameLT(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldLT(FieldTLSServerName, v))
+}
+
+// TLSServerNameLTE applies the LTE predicate on the "tls_server_name" field.
+func TLSServerNameLTE(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldLTE(FieldTLSServerName, v))
+}
+
+// TLSServerNameContains applies the Contains predicate on the "tls_server_name" field.
+func TLSServerNameContains(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldContains(FieldTLSServerName, v))
+}
+
+// TLSServerNameHasPrefix applies the HasPrefix predicate on the "tls_server_name" field.
+func TLSServerNameHasPrefix(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldHasPrefix(FieldTLSServerName, v))
+}
+
+// TLSServerNameHasSuffix applies the HasSuffix predicate on the "tls_server_name" field.
+func TLSServerNameHasSuffix(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldHasSuffix(FieldTLSServerName, v))
+}
+
+// TLSServerNameIsNil applies the IsNil predicate on the "tls_server_name" field.
+func TLSServerNameIsNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIsNull(FieldTLSServerName))
+}
+
+// TLSServerNameNotNil applies the NotNil predicate on the "tls_server_name" field.
+func TLSServerNameNotNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotNull(FieldTLSServerName))
+}
+
+// TLSServerNameEqualFold applies the EqualFold predicate on the "tls_server_name" field.
+func TLSServerNameEqualFold(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEqualFold(FieldTLSServerName, v))
+}
+
+// TLSServerNameContainsFold applies the ContainsFold predicate on the "tls_server_name" field.
+func TLSServerNameContainsFold(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldContainsFold(FieldTLSServerName, v))
+}
+
+// AppNameEQ applies the EQ predicate on the "app_name" field.
+func AppNameEQ(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEQ(FieldAppName, v))
+}
+
+// AppNameNEQ applies the NEQ predicate on the "app_name" field.
+func AppNameNEQ(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNEQ(FieldAppName, v))
+}
+
+// AppNameIn applies the In predicate on the "app_name" field.
+func AppNameIn(vs ...string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIn(FieldAppName, vs...))
+}
+
+// AppNameNotIn applies the NotIn predicate on the "app_name" field.
+func AppNameNotIn(vs ...string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotIn(FieldAppName, vs...))
+}
+
+// AppNameGT applies the GT predicate on the "app_name" field.
+func AppNameGT(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldGT(FieldAppName, v))
+}
+
+// AppNameGTE applies the GTE predicate on the "app_name" field.
+func AppNameGTE(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldGTE(FieldAppName, v))
+}
+
+// AppNameLT applies the LT predicate on the "app_name" field.
+func AppNameLT(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldLT(FieldAppName, v))
+}
+
+// AppNameLTE applies the LTE predicate on the "app_name" field.
+func AppNameLTE(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldLTE(FieldAppName, v))
+}
+
+// AppNameContains applies the Contains predicate on the "app_name" field.
+func AppNameContains(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldContains(FieldAppName, v))
+}
+
+// AppNameHasPrefix applies the HasPrefix predicate on the "app_name" field.
+func AppNameHasPrefix(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldHasPrefix(FieldAppName, v))
+}
+
+// AppNameHasSuffix applies the HasSuffix predicate on the "app_name" field.
+func AppNameHasSuffix(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldHasSuffix(FieldAppName, v))
+}
+
+// AppNameIsNil applies the IsNil predicate on the "app_name" field.
+func AppNameIsNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIsNull(FieldAppName))
+}
+
+// AppNameNotNil applies the NotNil predicate on the "app_name" field.
+func AppNameNotNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotNull(FieldAppName))
+}
+
+// AppNameEqualFold applies the EqualFold predicate on the "app_name" field.
+func AppNameEqualFold(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEqualFold(FieldAppName, v))
+}
+
+// AppNameContainsFold applies the ContainsFold predicate on the "app_name" field.
+func AppNameContainsFold(v string) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldContainsFold(FieldAppName, v))
+}
+
+// FacilityEQ applies the EQ predicate on the "facility" field.
+func FacilityEQ(v uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEQ(FieldFacility, v))
+}
+
+// FacilityNEQ applies the NEQ predicate on the "facility" field.
+func FacilityNEQ(v uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNEQ(FieldFacility, v))
+}
+
+// FacilityIn applies the In predicate on the "facility" field.
+func FacilityIn(vs ...uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIn(FieldFacility, vs...))
+}
+
+// FacilityNotIn applies the NotIn predicate on the "facility" field.
+func FacilityNotIn(vs ...uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotIn(FieldFacility, vs...))
+}
+
+// FacilityGT applies the GT predicate on the "facility" field.
+func FacilityGT(v uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldGT(FieldFacility, v))
+}
+
+// FacilityGTE applies the GTE predicate on the "facility" field.
+func FacilityGTE(v uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldGTE(FieldFacility, v))
+}
+
+// FacilityLT applies the LT predicate on the "facility" field.
+func FacilityLT(v uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldLT(FieldFacility, v))
+}
+
+// FacilityLTE applies the LTE predicate on the "facility" field.
+func FacilityLTE(v uint32) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldLTE(FieldFacility, v))
+}
+
+// FacilityIsNil applies the IsNil predicate on the "facility" field.
+func FacilityIsNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIsNull(FieldFacility))
+}
+
+// FacilityNotNil applies the NotNil predicate on the "facility" field.
+func FacilityNotNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotNull(FieldFacility))
+}
+
+// MaxBufferBytesEQ applies the EQ predicate on the "max_buffer_bytes" field.
+func MaxBufferBytesEQ(v uint64) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldEQ(FieldMaxBufferBytes, v))
+}
+
+// MaxBufferBytesNEQ applies the NEQ predicate on the "max_buffer_bytes" field.
+func MaxBufferBytesNEQ(v uint64) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNEQ(FieldMaxBufferBytes, v))
+}
+
+// MaxBufferBytesIn applies the In predicate on the "max_buffer_bytes" field.
+func MaxBufferBytesIn(vs ...uint64) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIn(FieldMaxBufferBytes, vs...))
+}
+
+// MaxBufferBytesNotIn applies the NotIn predicate on the "max_buffer_bytes" field.
+func MaxBufferBytesNotIn(vs ...uint64) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotIn(FieldMaxBufferBytes, vs...))
+}
+
+// MaxBufferBytesGT applies the GT predicate on the "max_buffer_bytes" field.
+func MaxBufferBytesGT(v uint64) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldGT(FieldMaxBufferBytes, v))
+}
+
+// MaxBufferBytesGTE applies the GTE predicate on the "max_buffer_bytes" field.
+func MaxBufferBytesGTE(v uint64) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldGTE(FieldMaxBufferBytes, v))
+}
+
+// MaxBufferBytesLT applies the LT predicate on the "max_buffer_bytes" field.
+func MaxBufferBytesLT(v uint64) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldLT(FieldMaxBufferBytes, v))
+}
+
+// MaxBufferBytesLTE applies the LTE predicate on the "max_buffer_bytes" field.
+func MaxBufferBytesLTE(v uint64) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldLTE(FieldMaxBufferBytes, v))
+}
+
+// MaxBufferBytesIsNil applies the IsNil predicate on the "max_buffer_bytes" field.
+func MaxBufferBytesIsNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldIsNull(FieldMaxBufferBytes))
+}
+
+// MaxBufferBytesNotNil applies the NotNil predicate on the "max_buffer_bytes" field.
+func MaxBufferBytesNotNil() predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.FieldNotNull(FieldMaxBufferBytes))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AuditForwarder) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AuditForwarder) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AuditForwarder) predicate.AuditForwarder {
+	return predicate.AuditForwarder(sql.NotPredicates(p))
+}
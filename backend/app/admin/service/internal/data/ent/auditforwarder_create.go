@@ -0,0 +1,2263 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/auditforwarder"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AuditForwarderCreate is the builder for creating a AuditForwarder entity.
+type AuditForwarderCreate struct {
+	config
+	mutation *AuditForwarderMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AuditForwarderCreate) SetCreatedAt(v time.Time) *AuditForwarderCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AuditForwarderCreate) SetNillableCreatedAt(v *time.Time) *AuditForwarderCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *AuditForwarderCreate) SetUpdatedAt(v time.Time) *AuditForwarderCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *AuditForwarderCreate) SetNillableUpdatedAt(v *time.Time) *AuditForwarderCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *AuditForwarderCreate) SetDeletedAt(v time.Time) *AuditForwarderCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *AuditForwarderCreate) SetNillableDeletedAt(v *time.Time) *AuditForwarderCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *AuditForwarderCreate) SetCreatedBy(v uint32) *AuditForwarderCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *AuditForwarderCreate) SetNillableCreatedBy(v *uint32) *AuditForwarderCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *AuditForwarderCreate) SetUpdatedBy(v uint32) *AuditForwarderCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *AuditForwarderCreate) SetNillableUpdatedBy(v *uint32) *AuditForwarderCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_c *AuditForwarderCreate) SetDeletedBy(v uint32) *AuditForwarderCreate {
+	_c.mutation.SetDeletedBy(v)
+	return _c
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_c *AuditForwarderCreate) SetNillableDeletedBy(v *uint32) *AuditForwarderCreate {
+	if v != nil {
+		_c.SetDeletedBy(*v)
+	}
+	return _c
+}
+
+// SetRemark sets the "remark" field.
+func (_c *AuditForwarderCreate) SetRemark(v string) *AuditForwarderCreate {
+	_c.mutation.SetRemark(v)
+	return _c
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (_c *AuditForwarderCreate) SetNillableRemark(v *string) *AuditForwarderCreate {
+	if v != nil {
+		_c.SetRemark(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *AuditForwarderCreate) SetTenantID(v uint32) *AuditForwarderCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *AuditForwarderCreate) SetNillableTenantID(v *uint32) *AuditForwarderCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *AuditForwarderCreate) SetName(v string) *AuditForwarderCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *AuditForwarderCreate) SetNillableName(v *string) *AuditForwarderCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *AuditForwarderCreate) SetEnabled(v bool) *AuditForwarderCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *AuditForwarderCreate) SetNillableEnabled(v *bool) *AuditForwarderCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetFormat sets the "format" field.
+func (_c *AuditForwarderCreate) SetFormat(v auditforwarder.Format) *AuditForwarderCreate {
+	_c.mutation.SetFormat(v)
+	return _c
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_c *AuditForwarderCreate) SetNillableFormat(v *auditforwarder.Format) *AuditForwarderCreate {
+	if v != nil {
+		_c.SetFormat(*v)
+	}
+	return _c
+}
+
+// SetTransport sets the "transport" field.
+func (_c *AuditForwarderCreate) SetTransport(v auditforwarder.Transport) *AuditForwarderCreate {
+	_c.mutation.SetTransport(v)
+	return _c
+}
+
+// SetNillableTransport sets the "transport" field if the given value is not nil.
+func (_c *AuditForwarderCreate) SetNillableTransport(v *auditforwarder.Transport) *AuditForwarderCreate {
+	if v != nil {
+		_c.SetTransport(*v)
+	}
+	return _c
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (_c *AuditForwarderCreate) SetEndpoint(v string) *AuditForwarderCreate {
+	_c.mutation.SetEndpoint(v)
+	return _c
+}
+
+// SetNillableEndpoint sets the "endpoint" field if the given value is not nil.
+func (_c *AuditForwarderCreate) SetNillableEndpoint(v *string) *AuditForwarderCreate {
+	if v != nil {
+		_c.SetEndpoint(*v)
+	}
+	return _c
+}
+
+// SetLogTypes sets the "log_types" field.
+func (_c *AuditForwarderCreate) SetLogTypes(v []string) *AuditForwarderCreate {
+	_c.mutation.SetLogTypes(v)
+	return _c
+}
+
+// SetFieldMapping sets the "field_mapping" field.
+func (_c *AuditForwarderCreate) SetFieldMapping(v map[string]string) *AuditForwarderCreate {
+	_c.mutation.SetFieldMapping(v)
+	return _c
+}
+
+// SetSecret sets the "secret" field.
+func (_c *AuditForwarderCreate) SetSecret(v string) *AuditForwarderCreate {
+	_c.mutation.SetSecret(v)
+	return _c
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (_c *AuditForwarderCreate) SetNillableSecret(v *string) *AuditForwarderCreate {
+	if v != nil {
+		_c.SetSecret(*v)
+	}
+	return _c
+}
+
+// SetHeaders sets the "headers" field.
+func (_c *AuditForwarderCreate) SetHeaders(v map[string]string) *AuditForwarderCreate {
+	_c.mutation.SetHeaders(v)
+	return _c
+}
+
+// SetTLSInsecureSkipVerify sets the "tls_insecure_skip_verify" field.
+func (_c *AuditForwarderCreate) SetTLSInsecureSkipVerify(v bool) *AuditForwarderCreate {
+	_c.mutation.SetTLSInsecureSkipVerify(v)
+	return _c
+}
+
+// SetNillableTLSInsecureSkipVerify sets the "tls_insecure_skip_verify" field if the given value is not nil.
+func (_c *AuditForwarderCreate) SetNillableTLSInsecureSkipVerify(v *bool) *AuditForwarderCreate {
+	if v != nil {
+		_c.SetTLSInsecureSkipVerify(*v)
+	}
+	return _c
+}
+
+// SetTLSCaPem sets the "tls_ca_pem" field.
+func (_c *AuditForwarderCreate) SetTLSCaPem(v string) *AuditForwarderCreate {
+	_c.mutation.SetTLSCaPem(v)
+	return _c
+}
+
+// SetNillableTLSCaPem sets the "tls_ca_pem" field if the given value is not nil.
+func (_c *AuditForwarderCreate) SetNillableTLSCaPem(v *string) *AuditForwarderCreate {
+	if v != nil {
+		_c.SetTLSCaPem(*v)
+	}
+	return _c
+}
+
+// SetTLSServerName sets the "tls_server_name" field.
+func (_c *AuditForwarderCreate) SetTLSServerName(v string) *AuditForwarderCreate {
+	_c.mutation.SetTLSServerName(v)
+	return _c
+}
+
+// SetNillableTLSServerName sets the "tls_server_name" field if the given value is not nil.
+func (_c *AuditForwarderCreate) SetNillableTLSServerName(v *string) *AuditForwarderCreate {
+	if v != nil {
+		_c.SetTLSServerName(*v)
+	}
+	return _c
+}
+
+// SetAppName sets the "app_name" field.
+func (_c *AuditForwarderCreate) SetAppName(v string) *AuditForwarderCreate {
+	_c.mutation.SetAppName(v)
+	return _c
+}
+
+// SetNillableAppName sets the "app_name" field if the given value is not nil.
+func (_c *AuditForwarderCreate) SetNillableAppName(v *string) *AuditForwarderCreate {
+	if v != nil {
+		_c.SetAppName(*v)
+	}
+	return _c
+}
+
+// SetFacility sets the "facility" field.
+func (_c *AuditForwarderCreate) SetFacility(v uint32) *AuditForwarderCreate {
+	_c.mutation.SetFacility(v)
+	return _c
+}
+
+// SetNillableFacility sets the "facility" field if the given value is not nil.
+func (_c *AuditForwarderCreate) SetNillableFacility(v *uint32) *AuditForwarderCreate {
+	if v != nil {
+		_c.SetFacility(*v)
+	}
+	return _c
+}
+
+// SetMaxBufferBytes sets the "max_buffer_bytes" field.
+func (_c *AuditForwarderCreate) SetMaxBufferBytes(v uint64) *AuditForwarderCreate {
+	_c.mutation.SetMaxBufferBytes(v)
+	return _c
+}
+
+// SetNillableMaxBufferBytes sets the "max_buffer_bytes" field if the given value is not nil.
+func (_c *AuditForwarderCreate) SetNillableMaxBufferBytes(v *uint64) *AuditForwarderCreate {
+	if v != nil {
+		_c.SetMaxBufferBytes(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AuditForwarderCreate) SetID(v uint32) *AuditForwarderCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the AuditForwarderMutation object of the builder.
+func (_c *AuditForwarderCreate) Mutation() *AuditForwarderMutation {
+	return _c.mutation
+}
+
+// Save creates the AuditForwarder in the database.
+func (_c *AuditForwarderCreate) Save(ctx context.Context) (*AuditForwarder, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AuditForwarderCreate) SaveX(ctx context.Context) *AuditForwarder {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AuditForwarderCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AuditForwarderCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AuditForwarderCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := auditforwarder.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := auditforwarder.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.TLSInsecureSkipVerify(); !ok {
+		v := auditforwarder.DefaultTLSInsecureSkipVerify
+		_c.mutation.SetTLSInsecureSkipVerify(v)
+	}
+	if _, ok := _c.mutation.Facility(); !ok {
+		v := auditforwarder.DefaultFacility
+		_c.mutation.SetFacility(v)
+	}
+	if _, ok := _c.mutation.MaxBufferBytes(); !ok {
+		v := auditforwarder.DefaultMaxBufferBytes
+		_c.mutation.SetMaxBufferBytes(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AuditForwarderCreate) check() error {
+	if v, ok := _c.mutation.Name(); ok {
+		if err := auditforwarder.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AuditForwarder.name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Format(); ok {
+		if err := auditforwarder.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "AuditForwarder.format": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Transport(); ok {
+		if err := auditforwarder.TransportValidator(v); err != nil {
+			return &ValidationError{Name: "transport", err: fmt.Errorf(`ent: validator failed for field "AuditForwarder.transport": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Endpoint(); ok {
+		if err := auditforwarder.EndpointValidator(v); err != nil {
+			return &ValidationError{Name: "endpoint", err: fmt.Errorf(`ent: validator failed for field "AuditForwarder.endpoint": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Secret(); ok {
+		if err := auditforwarder.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "AuditForwarder.secret": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.TLSServerName(); ok {
+		if err := auditforwarder.TLSServerNameValidator(v); err != nil {
+			return &ValidationError{Name: "tls_server_name", err: fmt.Errorf(`ent: validator failed for field "AuditForwarder.tls_server_name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.AppName(); ok {
+		if err := auditforwarder.AppNameValidator(v); err != nil {
+			return &ValidationError{Name: "app_name", err: fmt.Errorf(`ent: validator failed for field "AuditForwarder.app_name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := auditforwarder.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "AuditForwarder.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *AuditForwarderCreate) sqlSave(ctx context.Context) (*AuditForwarder, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AuditForwarderCreate) createSpec() (*AuditForwarder, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditForwarder{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(auditforwarder.Table, sqlgraph.NewFieldSpec(auditforwarder.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(auditforwarder.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(auditforwarder.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(auditforwarder.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(auditforwarder.FieldCreatedBy, field.TypeUint32, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(auditforwarder.FieldUpdatedBy, field.TypeUint32, value)
+		_node.UpdatedBy = &value
+	}
+	if value, ok := _c.mutation.DeletedBy(); ok {
+		_spec.SetField(auditforwarder.FieldDeletedBy, field.TypeUint32, value)
+		_node.DeletedBy = &value
+	}
+	if value, ok := _c.mutation.Remark(); ok {
+		_spec.SetField(auditforwarder.FieldRemark, field.TypeString, value)
+		_node.Remark = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(auditforwarder.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(auditforwarder.FieldName, field.TypeString, value)
+		_node.Name = &value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(auditforwarder.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = &value
+	}
+	if value, ok := _c.mutation.Format(); ok {
+		_spec.SetField(auditforwarder.FieldFormat, field.TypeEnum, value)
+		_node.Format = &value
+	}
+	if value, ok := _c.mutation.Transport(); ok {
+		_spec.SetField(auditforwarder.FieldTransport, field.TypeEnum, value)
+		_node.Transport = &value
+	}
+	if value, ok := _c.mutation.Endpoint(); ok {
+		_spec.SetField(auditforwarder.FieldEndpoint, field.TypeString, value)
+		_node.Endpoint = &value
+	}
+	if value, ok := _c.mutation.LogTypes(); ok {
+		_spec.SetField(auditforwarder.FieldLogTypes, field.TypeJSON, value)
+		_node.LogTypes = value
+	}
+	if value, ok := _c.mutation.FieldMapping(); ok {
+		_spec.SetField(auditforwarder.FieldFieldMapping, field.TypeJSON, value)
+		_node.FieldMapping = value
+	}
+	if value, ok := _c.mutation.Secret(); ok {
+		_spec.SetField(auditforwarder.FieldSecret, field.TypeString, value)
+		_node.Secret = &value
+	}
+	if value, ok := _c.mutation.Headers(); ok {
+		_spec.SetField(auditforwarder.FieldHeaders, field.TypeJSON, value)
+		_node.Headers = value
+	}
+	if value, ok := _c.mutation.TLSInsecureSkipVerify(); ok {
+		_spec.SetField(auditforwarder.FieldTLSInsecureSkipVerify, field.TypeBool, value)
+		_node.TLSInsecureSkipVerify = &value
+	}
+	if value, ok := _c.mutation.TLSCaPem(); ok {
+		_spec.SetField(auditforwarder.FieldTLSCaPem, field.TypeString, value)
+		_node.TLSCaPem = &value
+	}
+	if value, ok := _c.mutation.TLSServerName(); ok {
+		_spec.SetField(auditforwarder.FieldTLSServerName, field.TypeString, value)
+		_node.TLSServerName = &value
+	}
+	if value, ok := _c.mutation.AppName(); ok {
+		_spec.SetField(auditforwarder.FieldAppName, field.TypeString, value)
+		_node.AppName = &value
+	}
+	if value, ok := _c.mutation.Facility(); ok {
+		_spec.SetField(auditforwarder.FieldFacility, field.TypeUint32, value)
+		_node.Facility = &value
+	}
+	if value, ok := _c.mutation.MaxBufferBytes(); ok {
+		_spec.SetField(auditforwarder.FieldMaxBufferBytes, field.TypeUint64, value)
+		_node.MaxBufferBytes = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AuditForwarder.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AuditForwarderUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AuditForwarderCreate) OnConflict(opts ...sql.ConflictOption) *AuditForwarderUpsertOne {
+	_c.conflict = opts
+	return &AuditForwarderUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AuditForwarder.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AuditForwarderCreate) OnConflictColumns(columns ...string) *AuditForwarderUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AuditForwarderUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// AuditForwarderUpsertOne is the builder for "upsert"-ing
+	//  one AuditForwarder node.
+	AuditForwarderUpsertOne struct {
+		create *AuditForwarderCreate
+	}
+
+	// AuditForwarderUpsert is the "OnConflict" setter.
+	AuditForwarderUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AuditForwarderUpsert) SetUpdatedAt(v time.Time) *AuditForwarderUpsert {
+	u.Set(auditforwarder.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AuditForwarderUpsert) UpdateUpdatedAt() *AuditForwarderUpsert {
+	u.SetExcluded(auditforwarder.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *AuditForwarderUpsert) ClearUpdatedAt() *AuditForwarderUpsert {
+	u.SetNull(auditforwarder.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *AuditForwarderUpsert) SetDeletedAt(v time.Time) *AuditForwarderUpsert {
+	u.Set(auditforwarder.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *AuditForwarderUpsert) UpdateDeletedAt() *AuditForwarderUpsert {
+	u.SetExcluded(auditforwarder.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *AuditForwarderUpsert) ClearDeletedAt() *AuditForwarderUpsert {
+	u.SetNull(auditforwarder.FieldDeletedAt)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *AuditForwarderUpsert) SetCreatedBy(v uint32) *AuditForwarderUpsert {
+	u.Set(auditforwarder.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *AuditForwarderUpsert) UpdateCreatedBy() *AuditForwarderUpsert {
+	u.SetExcluded(auditforwarder.FieldCreatedBy)
+	return u
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *AuditForwarderUpsert) AddCreatedBy(v uint32) *AuditForwarderUpsert {
+	u.Add(auditforwarder.FieldCreatedBy, v)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *AuditForwarderUpsert) ClearCreatedBy() *AuditForwarderUpsert {
+	u.SetNull(auditforwarder.FieldCreatedBy)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *AuditForwarderUpsert) SetUpdatedBy(v uint32) *AuditForwarderUpsert {
+	u.Set(auditforwarder.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *AuditForwarderUpsert) UpdateUpdatedBy() *AuditForwarderUpsert {
+	u.SetExcluded(auditforwarder.FieldUpdatedBy)
+	return u
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *AuditForwarderUpsert) AddUpdatedBy(v uint32) *AuditForwarderUpsert {
+	u.Add(auditforwarder.FieldUpdatedBy, v)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *AuditForwarderUpsert) ClearUpdatedBy() *AuditForwarderUpsert {
+	u.SetNull(auditforwarder.FieldUpdatedBy)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *AuditForwarderUpsert) SetDeletedBy(v uint32) *AuditForwarderUpsert {
+	u.Set(auditforwarder.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *AuditForwarderUpsert) UpdateDeletedBy() *AuditForwarderUpsert {
+	u.SetExcluded(auditforwarder.FieldDeletedBy)
+	return u
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *AuditForwarderUpsert) AddDeletedBy(v uint32) *AuditForwarderUpsert {
+	u.Add(auditforwarder.FieldDeletedBy, v)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *AuditForwarderUpsert) ClearDeletedBy() *AuditForwarderUpsert {
+	u.SetNull(auditforwarder.FieldDeletedBy)
+	return u
+}
+
+// SetRemark sets the "remark" field.
+func (u *AuditForwarderUpsert) SetRemark(v string) *AuditForwarderUpsert {
+	u.Set(auditforwarder.FieldRemark, v)
+	return u
+}
+
+// UpdateRemark sets the "remark" field to the value that was provided on create.
+func (u *AuditForwarderUpsert) UpdateRemark() *AuditForwarderUpsert {
+	u.SetExcluded(auditforwarder.FieldRemark)
+	return u
+}
+
+// ClearRemark clears the value of the "remark" field.
+func (u *AuditForwarderUpsert) ClearRemark() *AuditForwarderUpsert {
+	u.SetNull(auditforwarder.FieldRemark)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *AuditForwarderUpsert) SetName(v string) *AuditForwarderUpsert {
+	u.Set(auditforwarder.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *AuditForwarderUpsert) UpdateName() *AuditForwarderUpsert {
+	u.SetExcluded(auditforwarder.FieldName)
+	return u
+}
+
+// ClearName clears the value of the "name" field.
+func (u *AuditForwarderUpsert) ClearName() *AuditForwarderUpsert {
+	u.SetNull(auditforwarder.FieldName)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *AuditForwarderUpsert) SetEnabled(v bool) *AuditForwarderUpsert {
+	u.Set(auditforwarder.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *AuditForwarderUpsert) UpdateEnabled() *AuditForwarderUpsert {
+	u.SetExcluded(auditforwarder.FieldEnabled)
+	return u
+}
+
+// ClearEnabled clears the value of the "enabled" field.
+func (u *AuditForwarderUpsert) ClearEnabled() *AuditForwarderUpsert {
+	u.SetNull(auditforwarder.FieldEnabled)
+	return u
+}
+
+// SetFormat sets the "format" field.
+func (u *AuditForwarderUpsert) SetFormat(v auditforwarder.Format) *AuditForwarderUpsert {
+	u.Set(auditforwarder.FieldFormat, v)
+	return u
+}
+
+// UpdateFormat sets the "format" field to the value that was provided on create.
+func (u *AuditForwarderUpsert) UpdateFormat() *AuditForwarderUpsert {
+	u.SetExcluded(auditforwarder.FieldFormat)
+	return u
+}
+
+// ClearFormat clears the value of the "format" field.
+func (u *AuditForwarderUpsert) ClearFormat() *AuditForwarderUpsert {
+	u.SetNull(auditforwarder.FieldFormat)
+	return u
+}
+
+// SetTransport sets the "transport" field.
+func (u *AuditForwarderUpsert) SetTransport(v auditforwarder.Transport) *AuditForwarderUpsert {
+	u.Set(auditforwarder.FieldTransport, v)
+	return u
+}
+
+// UpdateTransport sets the "transport" field to the value that was provided on create.
+func (u *AuditForwarderUpsert) UpdateTransport() *AuditForwarderUpsert {
+	u.SetExcluded(auditforwarder.FieldTransport)
+	return u
+}
+
+// ClearTransport clears the value of the "transport" field.
+func (u *AuditForwarderUpsert) ClearTransport() *AuditForwarderUpsert {
+	u.SetNull(auditforwarder.FieldTransport)
+	return u
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (u *AuditForwarderUpsert) SetEndpoint(v string) *AuditForwarderUpsert {
+	u.Set(auditforwarder.FieldEndpoint, v)
+	return u
+}
+
+// UpdateEndpoint sets the "endpoint" field to the value that was provided on create.
+func (u *AuditForwarderUpsert) UpdateEndpoint() *AuditForwarderUpsert {
+	u.SetExcluded(auditforwarder.FieldEndpoint)
+	return u
+}
+
+// ClearEndpoint clears the value of the "endpoint" field.
+func (u *AuditForwarderUpsert) ClearEndpoint() *AuditForwarderUpsert {
+	u.SetNull(auditforwarder.FieldEndpoint)
+	return u
+}
+
+// SetLogTypes sets the "log_types" field.
+func (u *AuditForwarderUpsert) SetLogTypes(v []string) *AuditForwarderUpsert {
+	u.Set(auditforwarder.FieldLogTypes, v)
+	return u
+}
+
+// UpdateLogTypes sets the "log_types" field to the value that was provided on create.
+func (u *AuditForwarderUpsert) UpdateLogTypes() *AuditForwarderUpsert {
+	u.SetExcluded(auditforwarder.FieldLogTypes)
+	return u
+}
+
+// ClearLogTypes clears the value of the "log_types" field.
+func (u *AuditForwarderUpsert) ClearLogTypes() *AuditForwarderUpsert {
+	u.SetNull(auditforwarder.FieldLogTypes)
+	return u
+}
+
+// SetFieldMapping sets the "field_mapping" field.
+func (u *AuditForwarderUpsert) SetFieldMapping(v map[string]string) *AuditForwarderUpsert {
+	u.Set(auditforwarder.FieldFieldMapping, v)
+	return u
+}
+
+// UpdateFieldMapping sets the "field_mapping" field to the value that was provided on create.
+func (u *AuditForwarderUpsert) UpdateFieldMapping() *AuditForwarderUpsert {
+	u.SetExcluded(auditforwarder.FieldFieldMapping)
+	return u
+}
+
+// ClearFieldMapping clears the value of the "field_mapping" field.
+func (u *AuditForwarderUpsert) ClearFieldMapping() *AuditForwarderUpsert {
+	u.SetNull(auditforwarder.FieldFieldMapping)
+	return u
+}
+
+// SetSecret sets the "secret" field.
+func (u *AuditForwarderUpsert) SetSecret(v string) *AuditForwarderUpsert {
+	u.Set(auditforwarder.FieldSecret, v)
+	return u
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *AuditForwarderUpsert) UpdateSecret() *AuditForwarderUpsert {
+	u.SetExcluded(auditforwarder.FieldSecret)
+	return u
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (u *AuditForwarderUpsert) ClearSecret() *AuditForwarderUpsert {
+	u.SetNull(auditforwarder.FieldSecret)
+	return u
+}
+
+// SetHeaders sets the "headers" field.
+func (u *AuditForwarderUpsert) SetHeaders(v map[string]string) *AuditForwarderUpsert {
+	u.Set(auditforwarder.FieldHeaders, v)
+	return u
+}
+
+// UpdateHeaders sets the "headers" field to the value that was provided on create.
+func (u *AuditForwarderUpsert) UpdateHeaders() *AuditForwarderUpsert {
+	u.SetExcluded(auditforwarder.FieldHeaders)
+	return u
+}
+
+// ClearHeaders clears the value of the "headers" field.
+func (u *AuditForwarderUpsert) ClearHeaders() *AuditForwarderUpsert {
+	u.SetNull(auditforwarder.FieldHeaders)
+	return u
+}
+
+// SetTLSInsecureSkipVerify sets the "tls_insecure_skip_verify" field.
+func (u *AuditForwarderUpsert) SetTLSInsecureSkipVerify(v bool) *AuditForwarderUpsert {
+	u.Set(auditforwarder.FieldTLSInsecureSkipVerify, v)
+	return u
+}
+
+// UpdateTLSInsecureSkipVerify sets the "tls_insecure_skip_verify" field to the value that was provided on create.
+func (u *AuditForwarderUpsert) UpdateTLSInsecureSkipVerify() *AuditForwarderUpsert {
+	u.SetExcluded(auditforwarder.FieldTLSInsecureSkipVerify)
+	return u
+}
+
+// ClearTLSInsecureSkipVerify clears the value of the "tls_insecure_skip_verify" field.
+func (u *AuditForwarderUpsert) ClearTLSInsecureSkipVerify() *AuditForwarderUpsert {
+	u.SetNull(auditforwarder.FieldTLSInsecureSkipVerify)
+	return u
+}
+
+// SetTLSCaPem sets the "tls_ca_pem" field.
+func (u *AuditForwarderUpsert) SetTLSCaPem(v string) *AuditForwarderUpsert {
+	u.Set(auditforwarder.FieldTLSCaPem, v)
+	return u
+}
+
+// UpdateTLSCaPem sets the "tls_ca_pem" field to the value that was provided on create.
+func (u *AuditForwarderUpsert) UpdateTLSCaPem() *AuditForwarderUpsert {
+	u.SetExcluded(auditforwarder.FieldTLSCaPem)
+	return u
+}
+
+// ClearTLSCaPem clears the value of the "tls_ca_pem" field.
+func (u *AuditForwarderUpsert) ClearTLSCaPem() *AuditForwarderUpsert {
+	u.SetNull(auditforwarder.FieldTLSCaPem)
+	return u
+}
+
+// SetTLSServerName sets the "tls_server_name" field.
+func (u *AuditForwarderUpsert) SetTLSServerName(v string) *AuditForwarderUpsert {
+	u.Set(auditforwarder.FieldTLSServerName, v)
+	return u
+}
+
+// UpdateTLSServerName sets the "tls_server_name" field to the value that was provided on create.
+func (u *AuditForwarderUpsert) UpdateTLSServerName() *AuditForwarderUpsert {
+	u.SetExcluded(auditforwarder.FieldTLSServerName)
+	return u
+}
+
+// ClearTLSServerName clears the value of the "tls_server_name" field.
+func (u *AuditForwarderUpsert) ClearTLSServerName() *AuditForwarderUpsert {
+	u.SetNull(auditforwarder.FieldTLSServerName)
+	return u
+}
+
+// SetAppName sets the "app_name" field.
+func (u *AuditForwarderUpsert) SetAppName(v string) *AuditForwarderUpsert {
+	u.Set(auditforwarder.FieldAppName, v)
+	return u
+}
+
+// UpdateAppName sets the "app_name" field to the value that was provided on create.
+func (u *AuditForwarderUpsert) UpdateAppName() *AuditForwarderUpsert {
+	u.SetExcluded(auditforwarder.FieldAppName)
+	return u
+}
+
+// ClearAppName clears the value of the "app_name" field.
+func (u *AuditForwarderUpsert) ClearAppName() *AuditForwarderUpsert {
+	u.SetNull(auditforwarder.FieldAppName)
+	return u
+}
+
+// SetFacility sets the "facility" field.
+func (u *AuditForwarderUpsert) SetFacility(v uint32) *AuditForwarderUpsert {
+	u.Set(auditforwarder.FieldFacility, v)
+	return u
+}
+
+// UpdateFacility sets the "facility" field to the value that was provided on create.
+func (u *AuditForwarderUpsert) UpdateFacility() *AuditForwarderUpsert {
+	u.SetExcluded(auditforwarder.FieldFacility)
+	return u
+}
+
+// AddFacility adds v to the "facility" field.
+func (u *AuditForwarderUpsert) AddFacility(v uint32) *AuditForwarderUpsert {
+	u.Add(auditforwarder.FieldFacility, v)
+	return u
+}
+
+// ClearFacility clears the value of the "facility" field.
+func (u *AuditForwarderUpsert) ClearFacility() *AuditForwarderUpsert {
+	u.SetNull(auditforwarder.FieldFacility)
+	return u
+}
+
+// SetMaxBufferBytes sets the "max_buffer_bytes" field.
+func (u *AuditForwarderUpsert) SetMaxBufferBytes(v uint64) *AuditForwarderUpsert {
+	u.Set(auditforwarder.FieldMaxBufferBytes, v)
+	return u
+}
+
+// UpdateMaxBufferBytes sets the "max_buffer_bytes" field to the value that was provided on create.
+func (u *AuditForwarderUpsert) UpdateMaxBufferBytes() *AuditForwarderUpsert {
+	u.SetExcluded(auditforwarder.FieldMaxBufferBytes)
+	return u
+}
+
+// AddMaxBufferBytes adds v to the "max_buffer_bytes" field.
+func (u *AuditForwarderUpsert) AddMaxBufferBytes(v uint64) *AuditForwarderUpsert {
+	u.Add(auditforwarder.FieldMaxBufferBytes, v)
+	return u
+}
+
+// ClearMaxBufferBytes clears the value of the "max_buffer_bytes" field.
+func (u *AuditForwarderUpsert) ClearMaxBufferBytes() *AuditForwarderUpsert {
+	u.SetNull(auditforwarder.FieldMaxBufferBytes)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AuditForwarder.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(auditforwarder.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AuditForwarderUpsertOne) UpdateNewValues() *AuditForwarderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(auditforwarder.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(auditforwarder.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(auditforwarder.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AuditForwarder.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AuditForwarderUpsertOne) Ignore() *AuditForwarderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AuditForwarderUpsertOne) DoNothing() *AuditForwarderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AuditForwarderCreate.OnConflict
+// documentation for more info.
+func (u *AuditForwarderUpsertOne) Update(set func(*AuditForwarderUpsert)) *AuditForwarderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AuditForwarderUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AuditForwarderUpsertOne) SetUpdatedAt(v time.Time) *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AuditForwarderUpsertOne) UpdateUpdatedAt() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *AuditForwarderUpsertOne) ClearUpdatedAt() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *AuditForwarderUpsertOne) SetDeletedAt(v time.Time) *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *AuditForwarderUpsertOne) UpdateDeletedAt() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *AuditForwarderUpsertOne) ClearDeletedAt() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *AuditForwarderUpsertOne) SetCreatedBy(v uint32) *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *AuditForwarderUpsertOne) AddCreatedBy(v uint32) *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *AuditForwarderUpsertOne) UpdateCreatedBy() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *AuditForwarderUpsertOne) ClearCreatedBy() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *AuditForwarderUpsertOne) SetUpdatedBy(v uint32) *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *AuditForwarderUpsertOne) AddUpdatedBy(v uint32) *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *AuditForwarderUpsertOne) UpdateUpdatedBy() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *AuditForwarderUpsertOne) ClearUpdatedBy() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *AuditForwarderUpsertOne) SetDeletedBy(v uint32) *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *AuditForwarderUpsertOne) AddDeletedBy(v uint32) *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *AuditForwarderUpsertOne) UpdateDeletedBy() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *AuditForwarderUpsertOne) ClearDeletedBy() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetRemark sets the "remark" field.
+func (u *AuditForwarderUpsertOne) SetRemark(v string) *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetRemark(v)
+	})
+}
+
+// UpdateRemark sets the "remark" field to the value that was provided on create.
+func (u *AuditForwarderUpsertOne) UpdateRemark() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateRemark()
+	})
+}
+
+// ClearRemark clears the value of the "remark" field.
+func (u *AuditForwarderUpsertOne) ClearRemark() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearRemark()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *AuditForwarderUpsertOne) SetName(v string) *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *AuditForwarderUpsertOne) UpdateName() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *AuditForwarderUpsertOne) ClearName() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *AuditForwarderUpsertOne) SetEnabled(v bool) *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *AuditForwarderUpsertOne) UpdateEnabled() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// ClearEnabled clears the value of the "enabled" field.
+func (u *AuditForwarderUpsertOne) ClearEnabled() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearEnabled()
+	})
+}
+
+// SetFormat sets the "format" field.
+func (u *AuditForwarderUpsertOne) SetFormat(v auditforwarder.Format) *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetFormat(v)
+	})
+}
+
+// UpdateFormat sets the "format" field to the value that was provided on create.
+func (u *AuditForwarderUpsertOne) UpdateFormat() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateFormat()
+	})
+}
+
+// ClearFormat clears the value of the "format" field.
+func (u *AuditForwarderUpsertOne) ClearFormat() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearFormat()
+	})
+}
+
+// SetTransport sets the "transport" field.
+func (u *AuditForwarderUpsertOne) SetTransport(v auditforwarder.Transport) *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetTransport(v)
+	})
+}
+
+// UpdateTransport sets the "transport" field to the value that was provided on create.
+func (u *AuditForwarderUpsertOne) UpdateTransport() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateTransport()
+	})
+}
+
+// ClearTransport clears the value of the "transport" field.
+func (u *AuditForwarderUpsertOne) ClearTransport() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearTransport()
+	})
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (u *AuditForwarderUpsertOne) SetEndpoint(v string) *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetEndpoint(v)
+	})
+}
+
+// UpdateEndpoint sets the "endpoint" field to the value that was provided on create.
+func (u *AuditForwarderUpsertOne) UpdateEndpoint() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateEndpoint()
+	})
+}
+
+// ClearEndpoint clears the value of the "endpoint" field.
+func (u *AuditForwarderUpsertOne) ClearEndpoint() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearEndpoint()
+	})
+}
+
+// SetLogTypes sets the "log_types" field.
+func (u *AuditForwarderUpsertOne) SetLogTypes(v []string) *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetLogTypes(v)
+	})
+}
+
+// UpdateLogTypes sets the "log_types" field to the value that was provided on create.
+func (u *AuditForwarderUpsertOne) UpdateLogTypes() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateLogTypes()
+	})
+}
+
+// ClearLogTypes clears the value of the "log_types" field.
+func (u *AuditForwarderUpsertOne) ClearLogTypes() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearLogTypes()
+	})
+}
+
+// SetFieldMapping sets the "field_mapping" field.
+func (u *AuditForwarderUpsertOne) SetFieldMapping(v map[string]string) *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetFieldMapping(v)
+	})
+}
+
+// UpdateFieldMapping sets the "field_mapping" field to the value that was provided on create.
+func (u *AuditForwarderUpsertOne) UpdateFieldMapping() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateFieldMapping()
+	})
+}
+
+// ClearFieldMapping clears the value of the "field_mapping" field.
+func (u *AuditForwarderUpsertOne) ClearFieldMapping() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearFieldMapping()
+	})
+}
+
+// SetSecret sets the "secret" field.
+func (u *AuditForwarderUpsertOne) SetSecret(v string) *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetSecret(v)
+	})
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *AuditForwarderUpsertOne) UpdateSecret() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateSecret()
+	})
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (u *AuditForwarderUpsertOne) ClearSecret() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearSecret()
+	})
+}
+
+// SetHeaders sets the "headers" field.
+func (u *AuditForwarderUpsertOne) SetHeaders(v map[string]string) *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetHeaders(v)
+	})
+}
+
+// UpdateHeaders sets the "headers" field to the value that was provided on create.
+func (u *AuditForwarderUpsertOne) UpdateHeaders() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateHeaders()
+	})
+}
+
+// ClearHeaders clears the value of the "headers" field.
+func (u *AuditForwarderUpsertOne) ClearHeaders() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearHeaders()
+	})
+}
+
+// SetTLSInsecureSkipVerify sets the "tls_insecure_skip_verify" field.
+func (u *AuditForwarderUpsertOne) SetTLSInsecureSkipVerify(v bool) *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetTLSInsecureSkipVerify(v)
+	})
+}
+
+// UpdateTLSInsecureSkipVerify sets the "tls_insecure_skip_verify" field to the value that was provided on create.
+func (u *AuditForwarderUpsertOne) UpdateTLSInsecureSkipVerify() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateTLSInsecureSkipVerify()
+	})
+}
+
+// ClearTLSInsecureSkipVerify clears the value of the "tls_insecure_skip_verify" field.
+func (u *AuditForwarderUpsertOne) ClearTLSInsecureSkipVerify() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearTLSInsecureSkipVerify()
+	})
+}
+
+// SetTLSCaPem sets the "tls_ca_pem" field.
+func (u *AuditForwarderUpsertOne) SetTLSCaPem(v string) *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetTLSCaPem(v)
+	})
+}
+
+// UpdateTLSCaPem sets the "tls_ca_pem" field to the value that was provided on create.
+func (u *AuditForwarderUpsertOne) UpdateTLSCaPem() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateTLSCaPem()
+	})
+}
+
+// ClearTLSCaPem clears the value of the "tls_ca_pem" field.
+func (u *AuditForwarderUpsertOne) ClearTLSCaPem() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearTLSCaPem()
+	})
+}
+
+// SetTLSServerName sets the "tls_server_name" field.
+func (u *AuditForwarderUpsertOne) SetTLSServerName(v string) *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetTLSServerName(v)
+	})
+}
+
+// UpdateTLSServerName sets the "tls_server_name" field to the value that was provided on create.
+func (u *AuditForwarderUpsertOne) UpdateTLSServerName() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateTLSServerName()
+	})
+}
+
+// ClearTLSServerName clears the value of the "tls_server_name" field.
+func (u *AuditForwarderUpsertOne) ClearTLSServerName() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearTLSServerName()
+	})
+}
+
+// SetAppName sets the "app_name" field.
+func (u *AuditForwarderUpsertOne) SetAppName(v string) *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetAppName(v)
+	})
+}
+
+// UpdateAppName sets the "app_name" field to the value that was provided on create.
+func (u *AuditForwarderUpsertOne) UpdateAppName() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateAppName()
+	})
+}
+
+// ClearAppName clears the value of the "app_name" field.
+func (u *AuditForwarderUpsertOne) ClearAppName() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearAppName()
+	})
+}
+
+// SetFacility sets the "facility" field.
+func (u *AuditForwarderUpsertOne) SetFacility(v uint32) *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetFacility(v)
+	})
+}
+
+// AddFacility adds v to the "facility" field.
+func (u *AuditForwarderUpsertOne) AddFacility(v uint32) *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.AddFacility(v)
+	})
+}
+
+// UpdateFacility sets the "facility" field to the value that was provided on create.
+func (u *AuditForwarderUpsertOne) UpdateFacility() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateFacility()
+	})
+}
+
+// ClearFacility clears the value of the "facility" field.
+func (u *AuditForwarderUpsertOne) ClearFacility() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearFacility()
+	})
+}
+
+// SetMaxBufferBytes sets the "max_buffer_bytes" field.
+func (u *AuditForwarderUpsertOne) SetMaxBufferBytes(v uint64) *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetMaxBufferBytes(v)
+	})
+}
+
+// AddMaxBufferBytes adds v to the "max_buffer_bytes" field.
+func (u *AuditForwarderUpsertOne) AddMaxBufferBytes(v uint64) *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.AddMaxBufferBytes(v)
+	})
+}
+
+// UpdateMaxBufferBytes sets the "max_buffer_bytes" field to the value that was provided on create.
+func (u *AuditForwarderUpsertOne) UpdateMaxBufferBytes() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateMaxBufferBytes()
+	})
+}
+
+// ClearMaxBufferBytes clears the value of the "max_buffer_bytes" field.
+func (u *AuditForwarderUpsertOne) ClearMaxBufferBytes() *AuditForwarderUpsertOne {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearMaxBufferBytes()
+	})
+}
+
+// Exec executes the query.
+func (u *AuditForwarderUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AuditForwarderCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AuditForwarderUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AuditForwarderUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AuditForwarderUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AuditForwarderCreateBulk is the builder for creating many AuditForwarder entities in bulk.
+type AuditForwarderCreateBulk struct {
+	config
+	err      error
+	builders []*AuditForwarderCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AuditForwarder entities in the database.
+func (_c *AuditForwarderCreateBulk) Save(ctx context.Context) ([]*AuditForwarder, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AuditForwarder, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditForwarderMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AuditForwarderCreateBulk) SaveX(ctx context.Context) []*AuditForwarder {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AuditForwarderCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AuditForwarderCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AuditForwarder.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AuditForwarderUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AuditForwarderCreateBulk) OnConflict(opts ...sql.ConflictOption) *AuditForwarderUpsertBulk {
+	_c.conflict = opts
+	return &AuditForwarderUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AuditForwarder.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AuditForwarderCreateBulk) OnConflictColumns(columns ...string) *AuditForwarderUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AuditForwarderUpsertBulk{
+		create: _c,
+	}
+}
+
+// AuditForwarderUpsertBulk is the builder for "upsert"-ing
+// a bulk of AuditForwarder nodes.
+type AuditForwarderUpsertBulk struct {
+	create *AuditForwarderCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AuditForwarder.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(auditforwarder.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AuditForwarderUpsertBulk) UpdateNewValues() *AuditForwarderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(auditforwarder.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(auditforwarder.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(auditforwarder.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AuditForwarder.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AuditForwarderUpsertBulk) Ignore() *AuditForwarderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AuditForwarderUpsertBulk) DoNothing() *AuditForwarderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AuditForwarderCreateBulk.OnConflict
+// documentation for more info.
+func (u *AuditForwarderUpsertBulk) Update(set func(*AuditForwarderUpsert)) *AuditForwarderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AuditForwarderUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AuditForwarderUpsertBulk) SetUpdatedAt(v time.Time) *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AuditForwarderUpsertBulk) UpdateUpdatedAt() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *AuditForwarderUpsertBulk) ClearUpdatedAt() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *AuditForwarderUpsertBulk) SetDeletedAt(v time.Time) *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *AuditForwarderUpsertBulk) UpdateDeletedAt() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *AuditForwarderUpsertBulk) ClearDeletedAt() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *AuditForwarderUpsertBulk) SetCreatedBy(v uint32) *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *AuditForwarderUpsertBulk) AddCreatedBy(v uint32) *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *AuditForwarderUpsertBulk) UpdateCreatedBy() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *AuditForwarderUpsertBulk) ClearCreatedBy() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *AuditForwarderUpsertBulk) SetUpdatedBy(v uint32) *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *AuditForwarderUpsertBulk) AddUpdatedBy(v uint32) *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *AuditForwarderUpsertBulk) UpdateUpdatedBy() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *AuditForwarderUpsertBulk) ClearUpdatedBy() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *AuditForwarderUpsertBulk) SetDeletedBy(v uint32) *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *AuditForwarderUpsertBulk) AddDeletedBy(v uint32) *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *AuditForwarderUpsertBulk) UpdateDeletedBy() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *AuditForwarderUpsertBulk) ClearDeletedBy() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetRemark sets the "remark" field.
+func (u *AuditForwarderUpsertBulk) SetRemark(v string) *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetRemark(v)
+	})
+}
+
+// UpdateRemark sets the "remark" field to the value that was provided on create.
+func (u *AuditForwarderUpsertBulk) UpdateRemark() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateRemark()
+	})
+}
+
+// ClearRemark clears the value of the "remark" field.
+func (u *AuditForwarderUpsertBulk) ClearRemark() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearRemark()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *AuditForwarderUpsertBulk) SetName(v string) *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *AuditForwarderUpsertBulk) UpdateName() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *AuditForwarderUpsertBulk) ClearName() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *AuditForwarderUpsertBulk) SetEnabled(v bool) *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *AuditForwarderUpsertBulk) UpdateEnabled() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// ClearEnabled clears the value of the "enabled" field.
+func (u *AuditForwarderUpsertBulk) ClearEnabled() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearEnabled()
+	})
+}
+
+// SetFormat sets the "format" field.
+func (u *AuditForwarderUpsertBulk) SetFormat(v auditforwarder.Format) *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetFormat(v)
+	})
+}
+
+// UpdateFormat sets the "format" field to the value that was provided on create.
+func (u *AuditForwarderUpsertBulk) UpdateFormat() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateFormat()
+	})
+}
+
+// ClearFormat clears the value of the "format" field.
+func (u *AuditForwarderUpsertBulk) ClearFormat() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearFormat()
+	})
+}
+
+// SetTransport sets the "transport" field.
+func (u *AuditForwarderUpsertBulk) SetTransport(v auditforwarder.Transport) *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetTransport(v)
+	})
+}
+
+// UpdateTransport sets the "transport" field to the value that was provided on create.
+func (u *AuditForwarderUpsertBulk) UpdateTransport() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateTransport()
+	})
+}
+
+// ClearTransport clears the value of the "transport" field.
+func (u *AuditForwarderUpsertBulk) ClearTransport() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearTransport()
+	})
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (u *AuditForwarderUpsertBulk) SetEndpoint(v string) *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetEndpoint(v)
+	})
+}
+
+// UpdateEndpoint sets the "endpoint" field to the value that was provided on create.
+func (u *AuditForwarderUpsertBulk) UpdateEndpoint() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateEndpoint()
+	})
+}
+
+// ClearEndpoint clears the value of the "endpoint" field.
+func (u *AuditForwarderUpsertBulk) ClearEndpoint() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearEndpoint()
+	})
+}
+
+// SetLogTypes sets the "log_types" field.
+func (u *AuditForwarderUpsertBulk) SetLogTypes(v []string) *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetLogTypes(v)
+	})
+}
+
+// UpdateLogTypes sets the "log_types" field to the value that was provided on create.
+func (u *AuditForwarderUpsertBulk) UpdateLogTypes() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateLogTypes()
+	})
+}
+
+// ClearLogTypes clears the value of the "log_types" field.
+func (u *AuditForwarderUpsertBulk) ClearLogTypes() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearLogTypes()
+	})
+}
+
+// SetFieldMapping sets the "field_mapping" field.
+func (u *AuditForwarderUpsertBulk) SetFieldMapping(v map[string]string) *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetFieldMapping(v)
+	})
+}
+
+// UpdateFieldMapping sets the "field_mapping" field to the value that was provided on create.
+func (u *AuditForwarderUpsertBulk) UpdateFieldMapping() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateFieldMapping()
+	})
+}
+
+// ClearFieldMapping clears the value of the "field_mapping" field.
+func (u *AuditForwarderUpsertBulk) ClearFieldMapping() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearFieldMapping()
+	})
+}
+
+// SetSecret sets the "secret" field.
+func (u *AuditForwarderUpsertBulk) SetSecret(v string) *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetSecret(v)
+	})
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *AuditForwarderUpsertBulk) UpdateSecret() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateSecret()
+	})
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (u *AuditForwarderUpsertBulk) ClearSecret() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearSecret()
+	})
+}
+
+// SetHeaders sets the "headers" field.
+func (u *AuditForwarderUpsertBulk) SetHeaders(v map[string]string) *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetHeaders(v)
+	})
+}
+
+// UpdateHeaders sets the "headers" field to the value that was provided on create.
+func (u *AuditForwarderUpsertBulk) UpdateHeaders() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateHeaders()
+	})
+}
+
+// ClearHeaders clears the value of the "headers" field.
+func (u *AuditForwarderUpsertBulk) ClearHeaders() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearHeaders()
+	})
+}
+
+// SetTLSInsecureSkipVerify sets the "tls_insecure_skip_verify" field.
+func (u *AuditForwarderUpsertBulk) SetTLSInsecureSkipVerify(v bool) *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetTLSInsecureSkipVerify(v)
+	})
+}
+
+// UpdateTLSInsecureSkipVerify sets the "tls_insecure_skip_verify" field to the value that was provided on create.
+func (u *AuditForwarderUpsertBulk) UpdateTLSInsecureSkipVerify() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateTLSInsecureSkipVerify()
+	})
+}
+
+// ClearTLSInsecureSkipVerify clears the value of the "tls_insecure_skip_verify" field.
+func (u *AuditForwarderUpsertBulk) ClearTLSInsecureSkipVerify() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearTLSInsecureSkipVerify()
+	})
+}
+
+// SetTLSCaPem sets the "tls_ca_pem" field.
+func (u *AuditForwarderUpsertBulk) SetTLSCaPem(v string) *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetTLSCaPem(v)
+	})
+}
+
+// UpdateTLSCaPem sets the "tls_ca_pem" field to the value that was provided on create.
+func (u *AuditForwarderUpsertBulk) UpdateTLSCaPem() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateTLSCaPem()
+	})
+}
+
+// ClearTLSCaPem clears the value of the "tls_ca_pem" field.
+func (u *AuditForwarderUpsertBulk) ClearTLSCaPem() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearTLSCaPem()
+	})
+}
+
+// SetTLSServerName sets the "tls_server_name" field.
+func (u *AuditForwarderUpsertBulk) SetTLSServerName(v string) *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetTLSServerName(v)
+	})
+}
+
+// UpdateTLSServerName sets the "tls_server_name" field to the value that was provided on create.
+func (u *AuditForwarderUpsertBulk) UpdateTLSServerName() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateTLSServerName()
+	})
+}
+
+// ClearTLSServerName clears the value of the "tls_server_name" field.
+func (u *AuditForwarderUpsertBulk) ClearTLSServerName() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearTLSServerName()
+	})
+}
+
+// SetAppName sets the "app_name" field.
+func (u *AuditForwarderUpsertBulk) SetAppName(v string) *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetAppName(v)
+	})
+}
+
+// UpdateAppName sets the "app_name" field to the value that was provided on create.
+func (u *AuditForwarderUpsertBulk) UpdateAppName() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateAppName()
+	})
+}
+
+// ClearAppName clears the value of the "app_name" field.
+func (u *AuditForwarderUpsertBulk) ClearAppName() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearAppName()
+	})
+}
+
+// SetFacility sets the "facility" field.
+func (u *AuditForwarderUpsertBulk) SetFacility(v uint32) *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetFacility(v)
+	})
+}
+
+// AddFacility adds v to the "facility" field.
+func (u *AuditForwarderUpsertBulk) AddFacility(v uint32) *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.AddFacility(v)
+	})
+}
+
+// UpdateFacility sets the "facility" field to the value that was provided on create.
+func (u *AuditForwarderUpsertBulk) UpdateFacility() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateFacility()
+	})
+}
+
+// ClearFacility clears the value of the "facility" field.
+func (u *AuditForwarderUpsertBulk) ClearFacility() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearFacility()
+	})
+}
+
+// SetMaxBufferBytes sets the "max_buffer_bytes" field.
+func (u *AuditForwarderUpsertBulk) SetMaxBufferBytes(v uint64) *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.SetMaxBufferBytes(v)
+	})
+}
+
+// AddMaxBufferBytes adds v to the "max_buffer_bytes" field.
+func (u *AuditForwarderUpsertBulk) AddMaxBufferBytes(v uint64) *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.AddMaxBufferBytes(v)
+	})
+}
+
+// UpdateMaxBufferBytes sets the "max_buffer_bytes" field to the value that was provided on create.
+func (u *AuditForwarderUpsertBulk) UpdateMaxBufferBytes() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.UpdateMaxBufferBytes()
+	})
+}
+
+// ClearMaxBufferBytes clears the value of the "max_buffer_bytes" field.
+func (u *AuditForwarderUpsertBulk) ClearMaxBufferBytes() *AuditForwarderUpsertBulk {
+	return u.Update(func(s *AuditForwarderUpsert) {
+		s.ClearMaxBufferBytes()
+	})
+}
+
+// Exec executes the query.
+func (u *AuditForwarderUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AuditForwarderCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AuditForwarderCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AuditForwarderUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
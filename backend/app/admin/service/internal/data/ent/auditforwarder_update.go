@@ -0,0 +1,1457 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/auditforwarder"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// AuditForwarderUpdate is the builder for updating AuditForwarder entities.
+type AuditForwarderUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AuditForwarderMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AuditForwarderUpdate builder.
+func (_u *AuditForwarderUpdate) Where(ps ...predicate.AuditForwarder) *AuditForwarderUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AuditForwarderUpdate) SetUpdatedAt(v time.Time) *AuditForwarderUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *AuditForwarderUpdate) SetNillableUpdatedAt(v *time.Time) *AuditForwarderUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *AuditForwarderUpdate) ClearUpdatedAt() *AuditForwarderUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *AuditForwarderUpdate) SetDeletedAt(v time.Time) *AuditForwarderUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *AuditForwarderUpdate) SetNillableDeletedAt(v *time.Time) *AuditForwarderUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *AuditForwarderUpdate) ClearDeletedAt() *AuditForwarderUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *AuditForwarderUpdate) SetCreatedBy(v uint32) *AuditForwarderUpdate {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *AuditForwarderUpdate) SetNillableCreatedBy(v *uint32) *AuditForwarderUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *AuditForwarderUpdate) AddCreatedBy(v int32) *AuditForwarderUpdate {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *AuditForwarderUpdate) ClearCreatedBy() *AuditForwarderUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *AuditForwarderUpdate) SetUpdatedBy(v uint32) *AuditForwarderUpdate {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *AuditForwarderUpdate) SetNillableUpdatedBy(v *uint32) *AuditForwarderUpdate {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *AuditForwarderUpdate) AddUpdatedBy(v int32) *AuditForwarderUpdate {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *AuditForwarderUpdate) ClearUpdatedBy() *AuditForwarderUpdate {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *AuditForwarderUpdate) SetDeletedBy(v uint32) *AuditForwarderUpdate {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *AuditForwarderUpdate) SetNillableDeletedBy(v *uint32) *AuditForwarderUpdate {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *AuditForwarderUpdate) AddDeletedBy(v int32) *AuditForwarderUpdate {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *AuditForwarderUpdate) ClearDeletedBy() *AuditForwarderUpdate {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetRemark sets the "remark" field.
+func (_u *AuditForwarderUpdate) SetRemark(v string) *AuditForwarderUpdate {
+	_u.mutation.SetRemark(v)
+	return _u
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (_u *AuditForwarderUpdate) SetNillableRemark(v *string) *AuditForwarderUpdate {
+	if v != nil {
+		_u.SetRemark(*v)
+	}
+	return _u
+}
+
+// ClearRemark clears the value of the "remark" field.
+func (_u *AuditForwarderUpdate) ClearRemark() *AuditForwarderUpdate {
+	_u.mutation.ClearRemark()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *AuditForwarderUpdate) SetName(v string) *AuditForwarderUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *AuditForwarderUpdate) SetNillableName(v *string) *AuditForwarderUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *AuditForwarderUpdate) ClearName() *AuditForwarderUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *AuditForwarderUpdate) SetEnabled(v bool) *AuditForwarderUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *AuditForwarderUpdate) SetNillableEnabled(v *bool) *AuditForwarderUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// ClearEnabled clears the value of the "enabled" field.
+func (_u *AuditForwarderUpdate) ClearEnabled() *AuditForwarderUpdate {
+	_u.mutation.ClearEnabled()
+	return _u
+}
+
+// SetFormat sets the "format" field.
+func (_u *AuditForwarderUpdate) SetFormat(v auditforwarder.Format) *AuditForwarderUpdate {
+	_u.mutation.SetFormat(v)
+	return _u
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_u *AuditForwarderUpdate) SetNillableFormat(v *auditforwarder.Format) *AuditForwarderUpdate {
+	if v != nil {
+		_u.SetFormat(*v)
+	}
+	return _u
+}
+
+// ClearFormat clears the value of the "format" field.
+func (_u *AuditForwarderUpdate) ClearFormat() *AuditForwarderUpdate {
+	_u.mutation.ClearFormat()
+	return _u
+}
+
+// SetTransport sets the "transport" field.
+func (_u *AuditForwarderUpdate) SetTransport(v auditforwarder.Transport) *AuditForwarderUpdate {
+	_u.mutation.SetTransport(v)
+	return _u
+}
+
+// SetNillableTransport sets the "transport" field if the given value is not nil.
+func (_u *AuditForwarderUpdate) SetNillableTransport(v *auditforwarder.Transport) *AuditForwarderUpdate {
+	if v != nil {
+		_u.SetTransport(*v)
+	}
+	return _u
+}
+
+// ClearTransport clears the value of the "transport" field.
+func (_u *AuditForwarderUpdate) ClearTransport() *AuditForwarderUpdate {
+	_u.mutation.ClearTransport()
+	return _u
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (_u *AuditForwarderUpdate) SetEndpoint(v string) *AuditForwarderUpdate {
+	_u.mutation.SetEndpoint(v)
+	return _u
+}
+
+// SetNillableEndpoint sets the "endpoint" field if the given value is not nil.
+func (_u *AuditForwarderUpdate) SetNillableEndpoint(v *string) *AuditForwarderUpdate {
+	if v != nil {
+		_u.SetEndpoint(*v)
+	}
+	return _u
+}
+
+// ClearEndpoint clears the value of the "endpoint" field.
+func (_u *AuditForwarderUpdate) ClearEndpoint() *AuditForwarderUpdate {
+	_u.mutation.ClearEndpoint()
+	return _u
+}
+
+// SetLogTypes sets the "log_types" field.
+func (_u *AuditForwarderUpdate) SetLogTypes(v []string) *AuditForwarderUpdate {
+	_u.mutation.SetLogTypes(v)
+	return _u
+}
+
+// AppendLogTypes appends value to the "log_types" field.
+func (_u *AuditForwarderUpdate) AppendLogTypes(v []string) *AuditForwarderUpdate {
+	_u.mutation.AppendLogTypes(v)
+	return _u
+}
+
+// ClearLogTypes clears the value of the "log_types" field.
+func (_u *AuditForwarderUpdate) ClearLogTypes() *AuditForwarderUpdate {
+	_u.mutation.ClearLogTypes()
+	return _u
+}
+
+// SetFieldMapping sets the "field_mapping" field.
+func (_u *AuditForwarderUpdate) SetFieldMapping(v map[string]string) *AuditForwarderUpdate {
+	_u.mutation.SetFieldMapping(v)
+	return _u
+}
+
+// ClearFieldMapping clears the value of the "field_mapping" field.
+func (_u *AuditForwarderUpdate) ClearFieldMapping() *AuditForwarderUpdate {
+	_u.mutation.ClearFieldMapping()
+	return _u
+}
+
+// SetSecret sets the "secret" field.
+func (_u *AuditForwarderUpdate) SetSecret(v string) *AuditForwarderUpdate {
+	_u.mutation.SetSecret(v)
+	return _u
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (_u *AuditForwarderUpdate) SetNillableSecret(v *string) *AuditForwarderUpdate {
+	if v != nil {
+		_u.SetSecret(*v)
+	}
+	return _u
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (_u *AuditForwarderUpdate) ClearSecret() *AuditForwarderUpdate {
+	_u.mutation.ClearSecret()
+	return _u
+}
+
+// SetHeaders sets the "headers" field.
+func (_u *AuditForwarderUpdate) SetHeaders(v map[string]string) *AuditForwarderUpdate {
+	_u.mutation.SetHeaders(v)
+	return _u
+}
+
+// ClearHeaders clears the value of the "headers" field.
+func (_u *AuditForwarderUpdate) ClearHeaders() *AuditForwarderUpdate {
+	_u.mutation.ClearHeaders()
+	return _u
+}
+
+// SetTLSInsecureSkipVerify sets the "tls_insecure_skip_verify" field.
+func (_u *AuditForwarderUpdate) SetTLSInsecureSkipVerify(v bool) *AuditForwarderUpdate {
+	_u.mutation.SetTLSInsecureSkipVerify(v)
+	return _u
+}
+
+// SetNillableTLSInsecureSkipVerify sets the "tls_insecure_skip_verify" field if the given value is not nil.
+func (_u *AuditForwarderUpdate) SetNillableTLSInsecureSkipVerify(v *bool) *AuditForwarderUpdate {
+	if v != nil {
+		_u.SetTLSInsecureSkipVerify(*v)
+	}
+	return _u
+}
+
+// ClearTLSInsecureSkipVerify clears the value of the "tls_insecure_skip_verify" field.
+func (_u *AuditForwarderUpdate) ClearTLSInsecureSkipVerify() *AuditForwarderUpdate {
+	_u.mutation.ClearTLSInsecureSkipVerify()
+	return _u
+}
+
+// SetTLSCaPem sets the "tls_ca_pem" field.
+func (_u *AuditForwarderUpdate) SetTLSCaPem(v string) *AuditForwarderUpdate {
+	_u.mutation.SetTLSCaPem(v)
+	return _u
+}
+
+// SetNillableTLSCaPem sets the "tls_ca_pem" field if the given value is not nil.
+func (_u *AuditForwarderUpdate) SetNillableTLSCaPem(v *string) *AuditForwarderUpdate {
+	if v != nil {
+		_u.SetTLSCaPem(*v)
+	}
+	return _u
+}
+
+// ClearTLSCaPem clears the value of the "tls_ca_pem" field.
+func (_u *AuditForwarderUpdate) ClearTLSCaPem() *AuditForwarderUpdate {
+	_u.mutation.ClearTLSCaPem()
+	return _u
+}
+
+// SetTLSServerName sets the "tls_server_name" field.
+func (_u *AuditForwarderUpdate) SetTLSServerName(v string) *AuditForwarderUpdate {
+	_u.mutation.SetTLSServerName(v)
+	return _u
+}
+
+// SetNillableTLSServerName sets the "tls_server_name" field if the given value is not nil.
+func (_u *AuditForwarderUpdate) SetNillableTLSServerName(v *string) *AuditForwarderUpdate {
+	if v != nil {
+		_u.SetTLSServerName(*v)
+	}
+	return _u
+}
+
+// ClearTLSServerName clears the value of the "tls_server_name" field.
+func (_u *AuditForwarderUpdate) ClearTLSServerName() *AuditForwarderUpdate {
+	_u.mutation.ClearTLSServerName()
+	return _u
+}
+
+// SetAppName sets the "app_name" field.
+func (_u *AuditForwarderUpdate) SetAppName(v string) *AuditForwarderUpdate {
+	_u.mutation.SetAppName(v)
+	return _u
+}
+
+// SetNillableAppName sets the "app_name" field if the given value is not nil.
+func (_u *AuditForwarderUpdate) SetNillableAppName(v *string) *AuditForwarderUpdate {
+	if v != nil {
+		_u.SetAppName(*v)
+	}
+	return _u
+}
+
+// ClearAppName clears the value of the "app_name" field.
+func (_u *AuditForwarderUpdate) ClearAppName() *AuditForwarderUpdate {
+	_u.mutation.ClearAppName()
+	return _u
+}
+
+// SetFacility sets the "facility" field.
+func (_u *AuditForwarderUpdate) SetFacility(v uint32) *AuditForwarderUpdate {
+	_u.mutation.ResetFacility()
+	_u.mutation.SetFacility(v)
+	return _u
+}
+
+// SetNillableFacility sets the "facility" field if the given value is not nil.
+func (_u *AuditForwarderUpdate) SetNillableFacility(v *uint32) *AuditForwarderUpdate {
+	if v != nil {
+		_u.SetFacility(*v)
+	}
+	return _u
+}
+
+// AddFacility adds value to the "facility" field.
+func (_u *AuditForwarderUpdate) AddFacility(v int32) *AuditForwarderUpdate {
+	_u.mutation.AddFacility(v)
+	return _u
+}
+
+// ClearFacility clears the value of the "facility" field.
+func (_u *AuditForwarderUpdate) ClearFacility() *AuditForwarderUpdate {
+	_u.mutation.ClearFacility()
+	return _u
+}
+
+// SetMaxBufferBytes sets the "max_buffer_bytes" field.
+func (_u *AuditForwarderUpdate) SetMaxBufferBytes(v uint64) *AuditForwarderUpdate {
+	_u.mutation.ResetMaxBufferBytes()
+	_u.mutation.SetMaxBufferBytes(v)
+	return _u
+}
+
+// SetNillableMaxBufferBytes sets the "max_buffer_bytes" field if the given value is not nil.
+func (_u *AuditForwarderUpdate) SetNillableMaxBufferBytes(v *uint64) *AuditForwarderUpdate {
+	if v != nil {
+		_u.SetMaxBufferBytes(*v)
+	}
+	return _u
+}
+
+// AddMaxBufferBytes adds value to the "max_buffer_bytes" field.
+func (_u *AuditForwarderUpdate) AddMaxBufferBytes(v int64) *AuditForwarderUpdate {
+	_u.mutation.AddMaxBufferBytes(v)
+	return _u
+}
+
+// ClearMaxBufferBytes clears the value of the "max_buffer_bytes" field.
+func (_u *AuditForwarderUpdate) ClearMaxBufferBytes() *AuditForwarderUpdate {
+	_u.mutation.ClearMaxBufferBytes()
+	return _u
+}
+
+// Mutation returns the AuditForwarderMutation object of the builder.
+func (_u *AuditForwarderUpdate) Mutation() *AuditForwarderMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AuditForwarderUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuditForwarderUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AuditForwarderUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuditForwarderUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AuditForwarderUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := auditforwarder.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AuditForwarder.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Format(); ok {
+		if err := auditforwarder.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "AuditForwarder.format": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Transport(); ok {
+		if err := auditforwarder.TransportValidator(v); err != nil {
+			return &ValidationError{Name: "transport", err: fmt.Errorf(`ent: validator failed for field "AuditForwarder.transport": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Endpoint(); ok {
+		if err := auditforwarder.EndpointValidator(v); err != nil {
+			return &ValidationError{Name: "endpoint", err: fmt.Errorf(`ent: validator failed for field "AuditForwarder.endpoint": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Secret(); ok {
+		if err := auditforwarder.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "AuditForwarder.secret": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TLSServerName(); ok {
+		if err := auditforwarder.TLSServerNameValidator(v); err != nil {
+			return &ValidationError{Name: "tls_server_name", err: fmt.Errorf(`ent: validator failed for field "AuditForwarder.tls_server_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AppName(); ok {
+		if err := auditforwarder.AppNameValidator(v); err != nil {
+			return &ValidationError{Name: "app_name", err: fmt.Errorf(`ent: validator failed for field "AuditForwarder.app_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AuditForwarderUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AuditForwarderUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AuditForwarderUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(auditforwarder.Table, auditforwarder.Columns, sqlgraph.NewFieldSpec(auditforwarder.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(auditforwarder.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(auditforwarder.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(auditforwarder.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(auditforwarder.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(auditforwarder.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(auditforwarder.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(auditforwarder.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(auditforwarder.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(auditforwarder.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(auditforwarder.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(auditforwarder.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(auditforwarder.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(auditforwarder.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(auditforwarder.FieldDeletedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Remark(); ok {
+		_spec.SetField(auditforwarder.FieldRemark, field.TypeString, value)
+	}
+	if _u.mutation.RemarkCleared() {
+		_spec.ClearField(auditforwarder.FieldRemark, field.TypeString)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(auditforwarder.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(auditforwarder.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(auditforwarder.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(auditforwarder.FieldEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.EnabledCleared() {
+		_spec.ClearField(auditforwarder.FieldEnabled, field.TypeBool)
+	}
+	if value, ok := _u.mutation.Format(); ok {
+		_spec.SetField(auditforwarder.FieldFormat, field.TypeEnum, value)
+	}
+	if _u.mutation.FormatCleared() {
+		_spec.ClearField(auditforwarder.FieldFormat, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.Transport(); ok {
+		_spec.SetField(auditforwarder.FieldTransport, field.TypeEnum, value)
+	}
+	if _u.mutation.TransportCleared() {
+		_spec.ClearField(auditforwarder.FieldTransport, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.Endpoint(); ok {
+		_spec.SetField(auditforwarder.FieldEndpoint, field.TypeString, value)
+	}
+	if _u.mutation.EndpointCleared() {
+		_spec.ClearField(auditforwarder.FieldEndpoint, field.TypeString)
+	}
+	if value, ok := _u.mutation.LogTypes(); ok {
+		_spec.SetField(auditforwarder.FieldLogTypes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLogTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, auditforwarder.FieldLogTypes, value)
+		})
+	}
+	if _u.mutation.LogTypesCleared() {
+		_spec.ClearField(auditforwarder.FieldLogTypes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.FieldMapping(); ok {
+		_spec.SetField(auditforwarder.FieldFieldMapping, field.TypeJSON, value)
+	}
+	if _u.mutation.FieldMappingCleared() {
+		_spec.ClearField(auditforwarder.FieldFieldMapping, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Secret(); ok {
+		_spec.SetField(auditforwarder.FieldSecret, field.TypeString, value)
+	}
+	if _u.mutation.SecretCleared() {
+		_spec.ClearField(auditforwarder.FieldSecret, field.TypeString)
+	}
+	if value, ok := _u.mutation.Headers(); ok {
+		_spec.SetField(auditforwarder.FieldHeaders, field.TypeJSON, value)
+	}
+	if _u.mutation.HeadersCleared() {
+		_spec.ClearField(auditforwarder.FieldHeaders, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TLSInsecureSkipVerify(); ok {
+		_spec.SetField(auditforwarder.FieldTLSInsecureSkipVerify, field.TypeBool, value)
+	}
+	if _u.mutation.TLSInsecureSkipVerifyCleared() {
+		_spec.ClearField(auditforwarder.FieldTLSInsecureSkipVerify, field.TypeBool)
+	}
+	if value, ok := _u.mutation.TLSCaPem(); ok {
+		_spec.SetField(auditforwarder.FieldTLSCaPem, field.TypeString, value)
+	}
+	if _u.mutation.TLSCaPemCleared() {
+		_spec.ClearField(auditforwarder.FieldTLSCaPem, field.TypeString)
+	}
+	if value, ok := _u.mutation.TLSServerName(); ok {
+		_spec.SetField(auditforwarder.FieldTLSServerName, field.TypeString, value)
+	}
+	if _u.mutation.TLSServerNameCleared() {
+		_spec.ClearField(auditforwarder.FieldTLSServerName, field.TypeString)
+	}
+	if value, ok := _u.mutation.AppName(); ok {
+		_spec.SetField(auditforwarder.FieldAppName, field.TypeString, value)
+	}
+	if _u.mutation.AppNameCleared() {
+		_spec.ClearField(auditforwarder.FieldAppName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Facility(); ok {
+		_spec.SetField(auditforwarder.FieldFacility, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedFacility(); ok {
+		_spec.AddField(auditforwarder.FieldFacility, field.TypeUint32, value)
+	}
+	if _u.mutation.FacilityCleared() {
+		_spec.ClearField(auditforwarder.FieldFacility, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.MaxBufferBytes(); ok {
+		_spec.SetField(auditforwarder.FieldMaxBufferBytes, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxBufferBytes(); ok {
+		_spec.AddField(auditforwarder.FieldMaxBufferBytes, field.TypeUint64, value)
+	}
+	if _u.mutation.MaxBufferBytesCleared() {
+		_spec.ClearField(auditforwarder.FieldMaxBufferBytes, field.TypeUint64)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditforwarder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AuditForwarderUpdateOne is the builder for updating a single AuditForwarder entity.
+type AuditForwarderUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AuditForwarderMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AuditForwarderUpdateOne) SetUpdatedAt(v time.Time) *AuditForwarderUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *AuditForwarderUpdateOne) SetNillableUpdatedAt(v *time.Time) *AuditForwarderUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *AuditForwarderUpdateOne) ClearUpdatedAt() *AuditForwarderUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *AuditForwarderUpdateOne) SetDeletedAt(v time.Time) *AuditForwarderUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *AuditForwarderUpdateOne) SetNillableDeletedAt(v *time.Time) *AuditForwarderUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *AuditForwarderUpdateOne) ClearDeletedAt() *AuditForwarderUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *AuditForwarderUpdateOne) SetCreatedBy(v uint32) *AuditForwarderUpdateOne {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *AuditForwarderUpdateOne) SetNillableCreatedBy(v *uint32) *AuditForwarderUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *AuditForwarderUpdateOne) AddCreatedBy(v int32) *AuditForwarderUpdateOne {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *AuditForwarderUpdateOne) ClearCreatedBy() *AuditForwarderUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *AuditForwarderUpdateOne) SetUpdatedBy(v uint32) *AuditForwarderUpdateOne {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *AuditForwarderUpdateOne) SetNillableUpdatedBy(v *uint32) *AuditForwarderUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *AuditForwarderUpdateOne) AddUpdatedBy(v int32) *AuditForwarderUpdateOne {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *AuditForwarderUpdateOne) ClearUpdatedBy() *AuditForwarderUpdateOne {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *AuditForwarderUpdateOne) SetDeletedBy(v uint32) *AuditForwarderUpdateOne {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *AuditForwarderUpdateOne) SetNillableDeletedBy(v *uint32) *AuditForwarderUpdateOne {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *AuditForwarderUpdateOne) AddDeletedBy(v int32) *AuditForwarderUpdateOne {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *AuditForwarderUpdateOne) ClearDeletedBy() *AuditForwarderUpdateOne {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetRemark sets the "remark" field.
+func (_u *AuditForwarderUpdateOne) SetRemark(v string) *AuditForwarderUpdateOne {
+	_u.mutation.SetRemark(v)
+	return _u
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (_u *AuditForwarderUpdateOne) SetNillableRemark(v *string) *AuditForwarderUpdateOne {
+	if v != nil {
+		_u.SetRemark(*v)
+	}
+	return _u
+}
+
+// ClearRemark clears the value of the "remark" field.
+func (_u *AuditForwarderUpdateOne) ClearRemark() *AuditForwarderUpdateOne {
+	_u.mutation.ClearRemark()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *AuditForwarderUpdateOne) SetName(v string) *AuditForwarderUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *AuditForwarderUpdateOne) SetNillableName(v *string) *AuditForwarderUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *AuditForwarderUpdateOne) ClearName() *AuditForwarderUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *AuditForwarderUpdateOne) SetEnabled(v bool) *AuditForwarderUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *AuditForwarderUpdateOne) SetNillableEnabled(v *bool) *AuditForwarderUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// ClearEnabled clears the value of the "enabled" field.
+func (_u *AuditForwarderUpdateOne) ClearEnabled() *AuditForwarderUpdateOne {
+	_u.mutation.ClearEnabled()
+	return _u
+}
+
+// SetFormat sets the "format" field.
+func (_u *AuditForwarderUpdateOne) SetFormat(v auditforwarder.Format) *AuditForwarderUpdateOne {
+	_u.mutation.SetFormat(v)
+	return _u
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_u *AuditForwarderUpdateOne) SetNillableFormat(v *auditforwarder.Format) *AuditForwarderUpdateOne {
+	if v != nil {
+		_u.SetFormat(*v)
+	}
+	return _u
+}
+
+// ClearFormat clears the value of the "format" field.
+func (_u *AuditForwarderUpdateOne) ClearFormat() *AuditForwarderUpdateOne {
+	_u.mutation.ClearFormat()
+	return _u
+}
+
+// SetTransport sets the "transport" field.
+func (_u *AuditForwarderUpdateOne) SetTransport(v auditforwarder.Transport) *AuditForwarderUpdateOne {
+	_u.mutation.SetTransport(v)
+	return _u
+}
+
+// SetNillableTransport sets the "transport" field if the given value is not nil.
+func (_u *AuditForwarderUpdateOne) SetNillableTransport(v *auditforwarder.Transport) *AuditForwarderUpdateOne {
+	if v != nil {
+		_u.SetTransport(*v)
+	}
+	return _u
+}
+
+// ClearTransport clears the value of the "transport" field.
+func (_u *AuditForwarderUpdateOne) ClearTransport() *AuditForwarderUpdateOne {
+	_u.mutation.ClearTransport()
+	return _u
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (_u *AuditForwarderUpdateOne) SetEndpoint(v string) *AuditForwarderUpdateOne {
+	_u.mutation.SetEndpoint(v)
+	return _u
+}
+
+// SetNillableEndpoint sets the "endpoint" field if the given value is not nil.
+func (_u *AuditForwarderUpdateOne) SetNillableEndpoint(v *string) *AuditForwarderUpdateOne {
+	if v != nil {
+		_u.SetEndpoint(*v)
+	}
+	return _u
+}
+
+// ClearEndpoint clears the value of the "endpoint" field.
+func (_u *AuditForwarderUpdateOne) ClearEndpoint() *AuditForwarderUpdateOne {
+	_u.mutation.ClearEndpoint()
+	return _u
+}
+
+// SetLogTypes sets the "log_types" field.
+func (_u *AuditForwarderUpdateOne) SetLogTypes(v []string) *AuditForwarderUpdateOne {
+	_u.mutation.SetLogTypes(v)
+	return _u
+}
+
+// AppendLogTypes appends value to the "log_types" field.
+func (_u *AuditForwarderUpdateOne) AppendLogTypes(v []string) *AuditForwarderUpdateOne {
+	_u.mutation.AppendLogTypes(v)
+	return _u
+}
+
+// ClearLogTypes clears the value of the "log_types" field.
+func (_u *AuditForwarderUpdateOne) ClearLogTypes() *AuditForwarderUpdateOne {
+	_u.mutation.ClearLogTypes()
+	return _u
+}
+
+// SetFieldMapping sets the "field_mapping" field.
+func (_u *AuditForwarderUpdateOne) SetFieldMapping(v map[string]string) *AuditForwarderUpdateOne {
+	_u.mutation.SetFieldMapping(v)
+	return _u
+}
+
+// ClearFieldMapping clears the value of the "field_mapping" field.
+func (_u *AuditForwarderUpdateOne) ClearFieldMapping() *AuditForwarderUpdateOne {
+	_u.mutation.ClearFieldMapping()
+	return _u
+}
+
+// SetSecret sets the "secret" field.
+func (_u *AuditForwarderUpdateOne) SetSecret(v string) *AuditForwarderUpdateOne {
+	_u.mutation.SetSecret(v)
+	return _u
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (_u *AuditForwarderUpdateOne) SetNillableSecret(v *string) *AuditForwarderUpdateOne {
+	if v != nil {
+		_u.SetSecret(*v)
+	}
+	return _u
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (_u *AuditForwarderUpdateOne) ClearSecret() *AuditForwarderUpdateOne {
+	_u.mutation.ClearSecret()
+	return _u
+}
+
+// SetHeaders sets the "headers" field.
+func (_u *AuditForwarderUpdateOne) SetHeaders(v map[string]string) *AuditForwarderUpdateOne {
+	_u.mutation.SetHeaders(v)
+	return _u
+}
+
+// ClearHeaders clears the value of the "headers" field.
+func (_u *AuditForwarderUpdateOne) ClearHeaders() *AuditForwarderUpdateOne {
+	_u.mutation.ClearHeaders()
+	return _u
+}
+
+// SetTLSInsecureSkipVerify sets the "tls_insecure_skip_verify" field.
+func (_u *AuditForwarderUpdateOne) SetTLSInsecureSkipVerify(v bool) *AuditForwarderUpdateOne {
+	_u.mutation.SetTLSInsecureSkipVerify(v)
+	return _u
+}
+
+// SetNillableTLSInsecureSkipVerify sets the "tls_insecure_skip_verify" field if the given value is not nil.
+func (_u *AuditForwarderUpdateOne) SetNillableTLSInsecureSkipVerify(v *bool) *AuditForwarderUpdateOne {
+	if v != nil {
+		_u.SetTLSInsecureSkipVerify(*v)
+	}
+	return _u
+}
+
+// ClearTLSInsecureSkipVerify clears the value of the "tls_insecure_skip_verify" field.
+func (_u *AuditForwarderUpdateOne) ClearTLSInsecureSkipVerify() *AuditForwarderUpdateOne {
+	_u.mutation.ClearTLSInsecureSkipVerify()
+	return _u
+}
+
+// SetTLSCaPem sets the "tls_ca_pem" field.
+func (_u *AuditForwarderUpdateOne) SetTLSCaPem(v string) *AuditForwarderUpdateOne {
+	_u.mutation.SetTLSCaPem(v)
+	return _u
+}
+
+// SetNillableTLSCaPem sets the "tls_ca_pem" field if the given value is not nil.
+func (_u *AuditForwarderUpdateOne) SetNillableTLSCaPem(v *string) *AuditForwarderUpdateOne {
+	if v != nil {
+		_u.SetTLSCaPem(*v)
+	}
+	return _u
+}
+
+// ClearTLSCaPem clears the value of the "tls_ca_pem" field.
+func (_u *AuditForwarderUpdateOne) ClearTLSCaPem() *AuditForwarderUpdateOne {
+	_u.mutation.ClearTLSCaPem()
+	return _u
+}
+
+// SetTLSServerName sets the "tls_server_name" field.
+func (_u *AuditForwarderUpdateOne) SetTLSServerName(v string) *AuditForwarderUpdateOne {
+	_u.mutation.SetTLSServerName(v)
+	return _u
+}
+
+// SetNillableTLSServerName sets the "tls_server_name" field if the given value is not nil.
+func (_u *AuditForwarderUpdateOne) SetNillableTLSServerName(v *string) *AuditForwarderUpdateOne {
+	if v != nil {
+		_u.SetTLSServerName(*v)
+	}
+	return _u
+}
+
+// ClearTLSServerName clears the value of the "tls_server_name" field.
+func (_u *AuditForwarderUpdateOne) ClearTLSServerName() *AuditForwarderUpdateOne {
+	_u.mutation.ClearTLSServerName()
+	return _u
+}
+
+// SetAppName sets the "app_name" field.
+func (_u *AuditForwarderUpdateOne) SetAppName(v string) *AuditForwarderUpdateOne {
+	_u.mutation.SetAppName(v)
+	return _u
+}
+
+// SetNillableAppName sets the "app_name" field if the given value is not nil.
+func (_u *AuditForwarderUpdateOne) SetNillableAppName(v *string) *AuditForwarderUpdateOne {
+	if v != nil {
+		_u.SetAppName(*v)
+	}
+	return _u
+}
+
+// ClearAppName clears the value of the "app_name" field.
+func (_u *AuditForwarderUpdateOne) ClearAppName() *AuditForwarderUpdateOne {
+	_u.mutation.ClearAppName()
+	return _u
+}
+
+// SetFacility sets the "facility" field.
+func (_u *AuditForwarderUpdateOne) SetFacility(v uint32) *AuditForwarderUpdateOne {
+	_u.mutation.ResetFacility()
+	_u.mutation.SetFacility(v)
+	return _u
+}
+
+// SetNillableFacility sets the "facility" field if the given value is not nil.
+func (_u *AuditForwarderUpdateOne) SetNillableFacility(v *uint32) *AuditForwarderUpdateOne {
+	if v != nil {
+		_u.SetFacility(*v)
+	}
+	return _u
+}
+
+// AddFacility adds value to the "facility" field.
+func (_u *AuditForwarderUpdateOne) AddFacility(v int32) *AuditForwarderUpdateOne {
+	_u.mutation.AddFacility(v)
+	return _u
+}
+
+// ClearFacility clears the value of the "facility" field.
+func (_u *AuditForwarderUpdateOne) ClearFacility() *AuditForwarderUpdateOne {
+	_u.mutation.ClearFacility()
+	return _u
+}
+
+// SetMaxBufferBytes sets the "max_buffer_bytes" field.
+func (_u *AuditForwarderUpdateOne) SetMaxBufferBytes(v uint64) *AuditForwarderUpdateOne {
+	_u.mutation.ResetMaxBufferBytes()
+	_u.mutation.SetMaxBufferBytes(v)
+	return _u
+}
+
+// SetNillableMaxBufferBytes sets the "max_buffer_bytes" field if the given value is not nil.
+func (_u *AuditForwarderUpdateOne) SetNillableMaxBufferBytes(v *uint64) *AuditForwarderUpdateOne {
+	if v != nil {
+		_u.SetMaxBufferBytes(*v)
+	}
+	return _u
+}
+
+// AddMaxBufferBytes adds value to the "max_buffer_bytes" field.
+func (_u *AuditForwarderUpdateOne) AddMaxBufferBytes(v int64) *AuditForwarderUpdateOne {
+	_u.mutation.AddMaxBufferBytes(v)
+	return _u
+}
+
+// ClearMaxBufferBytes clears the value of the "max_buffer_bytes" field.
+func (_u *AuditForwarderUpdateOne) ClearMaxBufferBytes() *AuditForwarderUpdateOne {
+	_u.mutation.ClearMaxBufferBytes()
+	return _u
+}
+
+// Mutation returns the AuditForwarderMutation object of the builder.
+func (_u *AuditForwarderUpdateOne) Mutation() *AuditForwarderMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AuditForwarderUpdate builder.
+func (_u *AuditForwarderUpdateOne) Where(ps ...predicate.AuditForwarder) *AuditForwarderUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AuditForwarderUpdateOne) Select(field string, fields ...string) *AuditForwarderUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AuditForwarder entity.
+func (_u *AuditForwarderUpdateOne) Save(ctx context.Context) (*AuditForwarder, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuditForwarderUpdateOne) SaveX(ctx context.Context) *AuditForwarder {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AuditForwarderUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuditForwarderUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AuditForwarderUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := auditforwarder.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AuditForwarder.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Format(); ok {
+		if err := auditforwarder.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "AuditForwarder.format": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Transport(); ok {
+		if err := auditforwarder.TransportValidator(v); err != nil {
+			return &ValidationError{Name: "transport", err: fmt.Errorf(`ent: validator failed for field "AuditForwarder.transport": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Endpoint(); ok {
+		if err := auditforwarder.EndpointValidator(v); err != nil {
+			return &ValidationError{Name: "endpoint", err: fmt.Errorf(`ent: validator failed for field "AuditForwarder.endpoint": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Secret(); ok {
+		if err := auditforwarder.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "AuditForwarder.secret": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TLSServerName(); ok {
+		if err := auditforwarder.TLSServerNameValidator(v); err != nil {
+			return &ValidationError{Name: "tls_server_name", err: fmt.Errorf(`ent: validator failed for field "AuditForwarder.tls_server_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AppName(); ok {
+		if err := auditforwarder.AppNameValidator(v); err != nil {
+			return &ValidationError{Name: "app_name", err: fmt.Errorf(`ent: validator failed for field "AuditForwarder.app_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AuditForwarderUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AuditForwarderUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AuditForwarderUpdateOne) sqlSave(ctx context.Context) (_node *AuditForwarder, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(auditforwarder.Table, auditforwarder.Columns, sqlgraph.NewFieldSpec(auditforwarder.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AuditForwarder.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, auditforwarder.FieldID)
+		for _, f := range fields {
+			if !auditforwarder.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != auditforwarder.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(auditforwarder.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(auditforwarder.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(auditforwarder.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(auditforwarder.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(auditforwarder.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(auditforwarder.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(auditforwarder.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(auditforwarder.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(auditforwarder.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(auditforwarder.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(auditforwarder.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(auditforwarder.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(auditforwarder.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(auditforwarder.FieldDeletedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Remark(); ok {
+		_spec.SetField(auditforwarder.FieldRemark, field.TypeString, value)
+	}
+	if _u.mutation.RemarkCleared() {
+		_spec.ClearField(auditforwarder.FieldRemark, field.TypeString)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(auditforwarder.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(auditforwarder.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(auditforwarder.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(auditforwarder.FieldEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.EnabledCleared() {
+		_spec.ClearField(auditforwarder.FieldEnabled, field.TypeBool)
+	}
+	if value, ok := _u.mutation.Format(); ok {
+		_spec.SetField(auditforwarder.FieldFormat, field.TypeEnum, value)
+	}
+	if _u.mutation.FormatCleared() {
+		_spec.ClearField(auditforwarder.FieldFormat, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.Transport(); ok {
+		_spec.SetField(auditforwarder.FieldTransport, field.TypeEnum, value)
+	}
+	if _u.mutation.TransportCleared() {
+		_spec.ClearField(auditforwarder.FieldTransport, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.Endpoint(); ok {
+		_spec.SetField(auditforwarder.FieldEndpoint, field.TypeString, value)
+	}
+	if _u.mutation.EndpointCleared() {
+		_spec.ClearField(auditforwarder.FieldEndpoint, field.TypeString)
+	}
+	if value, ok := _u.mutation.LogTypes(); ok {
+		_spec.SetField(auditforwarder.FieldLogTypes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLogTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, auditforwarder.FieldLogTypes, value)
+		})
+	}
+	if _u.mutation.LogTypesCleared() {
+		_spec.ClearField(auditforwarder.FieldLogTypes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.FieldMapping(); ok {
+		_spec.SetField(auditforwarder.FieldFieldMapping, field.TypeJSON, value)
+	}
+	if _u.mutation.FieldMappingCleared() {
+		_spec.ClearField(auditforwarder.FieldFieldMapping, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Secret(); ok {
+		_spec.SetField(auditforwarder.FieldSecret, field.TypeString, value)
+	}
+	if _u.mutation.SecretCleared() {
+		_spec.ClearField(auditforwarder.FieldSecret, field.TypeString)
+	}
+	if value, ok := _u.mutation.Headers(); ok {
+		_spec.SetField(auditforwarder.FieldHeaders, field.TypeJSON, value)
+	}
+	if _u.mutation.HeadersCleared() {
+		_spec.ClearField(auditforwarder.FieldHeaders, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TLSInsecureSkipVerify(); ok {
+		_spec.SetField(auditforwarder.FieldTLSInsecureSkipVerify, field.TypeBool, value)
+	}
+	if _u.mutation.TLSInsecureSkipVerifyCleared() {
+		_spec.ClearField(auditforwarder.FieldTLSInsecureSkipVerify, field.TypeBool)
+	}
+	if value, ok := _u.mutation.TLSCaPem(); ok {
+		_spec.SetField(auditforwarder.FieldTLSCaPem, field.TypeString, value)
+	}
+	if _u.mutation.TLSCaPemCleared() {
+		_spec.ClearField(auditforwarder.FieldTLSCaPem, field.TypeString)
+	}
+	if value, ok := _u.mutation.TLSServerName(); ok {
+		_spec.SetField(auditforwarder.FieldTLSServerName, field.TypeString, value)
+	}
+	if _u.mutation.TLSServerNameCleared() {
+		_spec.ClearField(auditforwarder.FieldTLSServerName, field.TypeString)
+	}
+	if value, ok := _u.mutation.AppName(); ok {
+		_spec.SetField(auditforwarder.FieldAppName, field.TypeString, value)
+	}
+	if _u.mutation.AppNameCleared() {
+		_spec.ClearField(auditforwarder.FieldAppName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Facility(); ok {
+		_spec.SetField(auditforwarder.FieldFacility, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedFacility(); ok {
+		_spec.AddField(auditforwarder.FieldFacility, field.TypeUint32, value)
+	}
+	if _u.mutation.FacilityCleared() {
+		_spec.ClearField(auditforwarder.FieldFacility, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.MaxBufferBytes(); ok {
+		_spec.SetField(auditforwarder.FieldMaxBufferBytes, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxBufferBytes(); ok {
+		_spec.AddField(auditforwarder.FieldMaxBufferBytes, field.TypeUint64, value)
+	}
+	if _u.mutation.MaxBufferBytesCleared() {
+		_spec.ClearField(auditforwarder.FieldMaxBufferBytes, field.TypeUint64)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &AuditForwarder{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditforwarder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,362 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/auditlogarchive"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// 审计日志归档表
+type AuditLogArchive struct {
+	config `json:"-"`
+	// ID of the ent.
+	// id
+	ID uint32 `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	// 删除时间
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// 审计日志类型
+	LogType *auditlogarchive.LogType `json:"log_type,omitempty"`
+	// 归档状态
+	Status *auditlogarchive.Status `json:"status,omitempty"`
+	// 存储桶名称
+	BucketName *string `json:"bucket_name,omitempty"`
+	// 归档数据对象名
+	ObjectName *string `json:"object_name,omitempty"`
+	// 归档清单对象名
+	ManifestObjectName *string `json:"manifest_object_name,omitempty"`
+	// 归档数据对象的SHA256摘要
+	Sha256 *string `json:"sha256,omitempty"`
+	// 归档数据对象大小
+	SizeBytes *uint64 `json:"size_bytes,omitempty"`
+	// 归档行数
+	RowCount *uint64 `json:"row_count,omitempty"`
+	// 已清除的在线行数
+	PurgedCount *uint64 `json:"purged_count,omitempty"`
+	// 归档的最小日志ID
+	MinLogID *uint32 `json:"min_log_id,omitempty"`
+	// 归档的最大日志ID
+	MaxLogID *uint32 `json:"max_log_id,omitempty"`
+	// 截止时间
+	CutoffTime *time.Time `json:"cutoff_time,omitempty"`
+	// 错误信息
+	ErrorMessage *string `json:"error_message,omitempty"`
+	// 已恢复到查询表的行数
+	RestoredCount *uint64 `json:"restored_count,omitempty"`
+	// 最近一次恢复时间
+	RestoredAt   *time.Time `json:"restored_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AuditLogArchive) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case auditlogarchive.FieldID, auditlogarchive.FieldTenantID, auditlogarchive.FieldSizeBytes, auditlogarchive.FieldRowCount, auditlogarchive.FieldPurgedCount, auditlogarchive.FieldMinLogID, auditlogarchive.FieldMaxLogID, auditlogarchive.FieldRestoredCount:
+			values[i] = new(sql.NullInt64)
+		case auditlogarchive.FieldLogType, auditlogarchive.FieldStatus, auditlogarchive.FieldBucketName, auditlogarchive.FieldObjectName, auditlogarchive.FieldManifestObjectName, auditlogarchive.FieldSha256, auditlogarchive.FieldErrorMessage:
+			values[i] = new(sql.NullString)
+		case auditlogarchive.FieldCreatedAt, auditlogarchive.FieldUpdatedAt, auditlogarchive.FieldDeletedAt, auditlogarchive.FieldCutoffTime, auditlogarchive.FieldRestoredAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AuditLogArchive fields.
+func (_m *AuditLogArchive) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case auditlogarchive.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint32(value.Int64)
+		case auditlogarchive.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = new(time.Time)
+				*_m.CreatedAt = value.Time
+			}
+		case auditlogarchive.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = new(time.Time)
+				*_m.UpdatedAt = value.Time
+			}
+		case auditlogarchive.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case auditlogarchive.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case auditlogarchive.FieldLogType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field log_type", values[i])
+			} else if value.Valid {
+				_m.LogType = new(auditlogarchive.LogType)
+				*_m.LogType = auditlogarchive.LogType(value.String)
+			}
+		case auditlogarchive.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = new(auditlogarchive.Status)
+				*_m.Status = auditlogarchive.Status(value.String)
+			}
+		case auditlogarchive.FieldBucketName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bucket_name", values[i])
+			} else if value.Valid {
+				_m.BucketName = new(string)
+				*_m.BucketName = value.String
+			}
+		case auditlogarchive.FieldObjectName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field object_name", values[i])
+			} else if value.Valid {
+				_m.ObjectName = new(string)
+				*_m.ObjectName = value.String
+			}
+		case auditlogarchive.FieldManifestObjectName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field manifest_object_name", values[i])
+			} else if value.Valid {
+				_m.ManifestObjectName = new(string)
+				*_m.ManifestObjectName = value.String
+			}
+		case auditlogarchive.FieldSha256:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sha256", values[i])
+			} else if value.Valid {
+				_m.Sha256 = new(string)
+				*_m.Sha256 = value.String
+			}
+		case auditlogarchive.FieldSizeBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size_bytes", values[i])
+			} else if value.Valid {
+				_m.SizeBytes = new(uint64)
+				*_m.SizeBytes = uint64(value.Int64)
+			}
+		case auditlogarchive.FieldRowCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field row_count", values[i])
+			} else if value.Valid {
+				_m.RowCount = new(uint64)
+				*_m.RowCount = uint64(value.Int64)
+			}
+		case auditlogarchive.FieldPurgedCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field purged_count", values[i])
+			} else if value.Valid {
+				_m.PurgedCount = new(uint64)
+				*_m.PurgedCount = uint64(value.Int64)
+			}
+		case auditlogarchive.FieldMinLogID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field min_log_id", values[i])
+			} else if value.Valid {
+				_m.MinLogID = new(uint32)
+				*_m.MinLogID = uint32(value.Int64)
+			}
+		case auditlogarchive.FieldMaxLogID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_log_id", values[i])
+			} else if value.Valid {
+				_m.MaxLogID = new(uint32)
+				*_m.MaxLogID = uint32(value.Int64)
+			}
+		case auditlogarchive.FieldCutoffTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cutoff_time", values[i])
+			} else if value.Valid {
+				_m.CutoffTime = new(time.Time)
+				*_m.CutoffTime = value.Time
+			}
+		case auditlogarchive.FieldErrorMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error_message", values[i])
+			} else if value.Valid {
+				_m.ErrorMessage = new(string)
+				*_m.ErrorMessage = value.String
+			}
+		case auditlogarchive.FieldRestoredCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field restored_count", values[i])
+			} else if value.Valid {
+				_m.RestoredCount = new(uint64)
+				*_m.RestoredCount = uint64(value.Int64)
+			}
+		case auditlogarchive.FieldRestoredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field restored_at", values[i])
+			} else if value.Valid {
+				_m.RestoredAt = new(time.Time)
+				*_m.RestoredAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AuditLogArchive.
+// This includes values selected through modifiers, order, etc.
+func (_m *AuditLogArchive) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AuditLogArchive.
+// Note that you need to call AuditLogArchive.Unwrap() before calling this method if this AuditLogArchive
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AuditLogArchive) Update() *AuditLogArchiveUpdateOne {
+	return NewAuditLogArchiveClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AuditLogArchive entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AuditLogArchive) Unwrap() *AuditLogArchive {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AuditLogArchive is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AuditLogArchive) String() string {
+	var builder strings.Builder
+	builder.WriteString("AuditLogArchive(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreatedAt; v != nil {
+		builder.WriteString("created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdatedAt; v != nil {
+		builder.WriteString("updated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.LogType; v != nil {
+		builder.WriteString("log_type=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Status; v != nil {
+		builder.WriteString("status=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.BucketName; v != nil {
+		builder.WriteString("bucket_name=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ObjectName; v != nil {
+		builder.WriteString("object_name=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ManifestObjectName; v != nil {
+		builder.WriteString("manifest_object_name=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Sha256; v != nil {
+		builder.WriteString("sha256=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.SizeBytes; v != nil {
+		builder.WriteString("size_bytes=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.RowCount; v != nil {
+		builder.WriteString("row_count=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.PurgedCount; v != nil {
+		builder.WriteString("purged_count=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.MinLogID; v != nil {
+		builder.WriteString("min_log_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.MaxLogID; v != nil {
+		builder.WriteString("max_log_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CutoffTime; v != nil {
+		builder.WriteString("cutoff_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ErrorMessage; v != nil {
+		builder.WriteString("error_message=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.RestoredCount; v != nil {
+		builder.WriteString("restored_count=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.RestoredAt; v != nil {
+		builder.WriteString("restored_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AuditLogArchives is a parsable slice of AuditLogArchive.
+type AuditLogArchives []*AuditLogArchive
@@ -0,0 +1,265 @@
+// Code generated by ent, DO NOT EDIT.
+
+package auditlogarchive
+
+import (
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the auditlogarchive type in the database.
+	Label = "audit_log_archive"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldLogType holds the string denoting the log_type field in the database.
+	FieldLogType = "log_type"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldBucketName holds the string denoting the bucket_name field in the database.
+	FieldBucketName = "bucket_name"
+	// FieldObjectName holds the string denoting the object_name field in the database.
+	FieldObjectName = "object_name"
+	// FieldManifestObjectName holds the string denoting the manifest_object_name field in the database.
+	FieldManifestObjectName = "manifest_object_name"
+	// FieldSha256 holds the string denoting the sha256 field in the database.
+	FieldSha256 = "sha256"
+	// FieldSizeBytes holds the string denoting the size_bytes field in the database.
+	FieldSizeBytes = "size_bytes"
+	// FieldRowCount holds the string denoting the row_count field in the database.
+	FieldRowCount = "row_count"
+	// FieldPurgedCount holds the string denoting the purged_count field in the database.
+	FieldPurgedCount = "purged_count"
+	// FieldMinLogID holds the string denoting the min_log_id field in the database.
+	FieldMinLogID = "min_log_id"
+	// FieldMaxLogID holds the string denoting the max_log_id field in the database.
+	FieldMaxLogID = "max_log_id"
+	// FieldCutoffTime holds the string denoting the cutoff_time field in the database.
+	FieldCutoffTime = "cutoff_time"
+	// FieldErrorMessage holds the string denoting the error_message field in the database.
+	FieldErrorMessage = "error_message"
+	// FieldRestoredCount holds the string denoting the restored_count field in the database.
+	FieldRestoredCount = "restored_count"
+	// FieldRestoredAt holds the string denoting the restored_at field in the database.
+	FieldRestoredAt = "restored_at"
+	// Table holds the table name of the auditlogarchive in the database.
+	Table = "sys_audit_log_archives"
+)
+
+// Columns holds all SQL columns for auditlogarchive fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldTenantID,
+	FieldLogType,
+	FieldStatus,
+	FieldBucketName,
+	FieldObjectName,
+	FieldManifestObjectName,
+	FieldSha256,
+	FieldSizeBytes,
+	FieldRowCount,
+	FieldPurgedCount,
+	FieldMinLogID,
+	FieldMaxLogID,
+	FieldCutoffTime,
+	FieldErrorMessage,
+	FieldRestoredCount,
+	FieldRestoredAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "go-wind-admin/app/admin/service/internal/data/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID uint32
+	// Sha256Validator is a validator for the "sha256" field. It is called by the builders before save.
+	Sha256Validator func(string) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(uint32) error
+)
+
+// LogType defines the type for the "log_type" enum field.
+type LogType string
+
+// LogType values.
+const (
+	LogTypeApiAuditLog         LogType = "API_AUDIT_LOG"
+	LogTypeLoginAuditLog       LogType = "LOGIN_AUDIT_LOG"
+	LogTypeOperationAuditLog   LogType = "OPERATION_AUDIT_LOG"
+	LogTypeDataAccessAuditLog  LogType = "DATA_ACCESS_AUDIT_LOG"
+	LogTypePermissionAuditLog  LogType = "PERMISSION_AUDIT_LOG"
+	LogTypePolicyEvaluationLog LogType = "POLICY_EVALUATION_LOG"
+)
+
+func (lt LogType) String() string {
+	return string(lt)
+}
+
+// LogTypeValidator is a validator for the "log_type" field enum values. It is called by the builders before save.
+func LogTypeValidator(lt LogType) error {
+	switch lt {
+	case LogTypeApiAuditLog, LogTypeLoginAuditLog, LogTypeOperationAuditLog, LogTypeDataAccessAuditLog, LogTypePermissionAuditLog, LogTypePolicyEvaluationLog:
+		return nil
+	default:
+		return fmt.Errorf("auditlogarchive: invalid enum value for log_type field: %q", lt)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusExporting is the default value of the Status enum.
+const DefaultStatus = StatusExporting
+
+// Status values.
+const (
+	StatusExporting Status = "EXPORTING"
+	StatusArchived  Status = "ARCHIVED"
+	StatusPurged    Status = "PURGED"
+	StatusFailed    Status = "FAILED"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusExporting, StatusArchived, StatusPurged, StatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("auditlogarchive: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the AuditLogArchive queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByLogType orders the results by the log_type field.
+func ByLogType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLogType, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByBucketName orders the results by the bucket_name field.
+func ByBucketName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBucketName, opts...).ToFunc()
+}
+
+// ByObjectName orders the results by the object_name field.
+func ByObjectName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldObjectName, opts...).ToFunc()
+}
+
+// ByManifestObjectName orders the results by the manifest_object_name field.
+func ByManifestObjectName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldManifestObjectName, opts...).ToFunc()
+}
+
+// BySha256 orders the results by the sha256 field.
+func BySha256(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSha256, opts...).ToFunc()
+}
+
+// BySizeBytes orders the results by the size_bytes field.
+func BySizeBytes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSizeBytes, opts...).ToFunc()
+}
+
+// ByRowCount orders the results by the row_count field.
+func ByRowCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRowCount, opts...).ToFunc()
+}
+
+// ByPurgedCount orders the results by the purged_count field.
+func ByPurgedCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPurgedCount, opts...).ToFunc()
+}
+
+// ByMinLogID orders the results by the min_log_id field.
+func ByMinLogID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinLogID, opts...).ToFunc()
+}
+
+// ByMaxLogID orders the results by the max_log_id field.
+func ByMaxLogID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxLogID, opts...).ToFunc()
+}
+
+// ByCutoffTime orders the results by the cutoff_time field.
+func ByCutoffTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCutoffTime, opts...).ToFunc()
+}
+
+// ByErrorMessage orders the results by the error_message field.
+func ByErrorMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldErrorMessage, opts...).ToFunc()
+}
+
+// ByRestoredCount orders the results by the restored_count field.
+func ByRestoredCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRestoredCount, opts...).ToFunc()
+}
+
+// ByRestoredAt orders the results by the restored_at field.
+func ByRestoredAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRestoredAt, opts...).ToFunc()
+}
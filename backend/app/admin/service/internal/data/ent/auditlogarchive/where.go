@@ -0,0 +1,1190 @@
+// Code generated by ent, DO NOT EDIT.
+
+package auditlogarchive
+
+import (
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldTenantID, v))
+}
+
+// BucketName applies equality check predicate on the "bucket_name" field. It's identical to BucketNameEQ.
+func BucketName(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldBucketName, v))
+}
+
+// ObjectName applies equality check predicate on the "object_name" field. It's identical to ObjectNameEQ.
+func ObjectName(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldObjectName, v))
+}
+
+// ManifestObjectName applies equality check predicate on the "manifest_object_name" field. It's identical to ManifestObjectNameEQ.
+func ManifestObjectName(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldManifestObjectName, v))
+}
+
+// Sha256 applies equality check predicate on the "sha256" field. It's identical to Sha256EQ.
+func Sha256(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldSha256, v))
+}
+
+// SizeBytes applies equality check predicate on the "size_bytes" field. It's identical to SizeBytesEQ.
+func SizeBytes(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldSizeBytes, v))
+}
+
+// RowCount applies equality check predicate on the "row_count" field. It's identical to RowCountEQ.
+func RowCount(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldRowCount, v))
+}
+
+// PurgedCount applies equality check predicate on the "purged_count" field. It's identical to PurgedCountEQ.
+func PurgedCount(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldPurgedCount, v))
+}
+
+// MinLogID applies equality check predicate on the "min_log_id" field. It's identical to MinLogIDEQ.
+func MinLogID(v uint32) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldMinLogID, v))
+}
+
+// MaxLogID applies equality check predicate on the "max_log_id" field. It's identical to MaxLogIDEQ.
+func MaxLogID(v uint32) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldMaxLogID, v))
+}
+
+// CutoffTime applies equality check predicate on the "cutoff_time" field. It's identical to CutoffTimeEQ.
+func CutoffTime(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldCutoffTime, v))
+}
+
+// ErrorMessage applies equality check predicate on the "error_message" field. It's identical to ErrorMessageEQ.
+func ErrorMessage(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldErrorMessage, v))
+}
+
+// RestoredCount applies equality check predicate on the "restored_count" field. It's identical to RestoredCountEQ.
+func RestoredCount(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldRestoredCount, v))
+}
+
+// RestoredAt applies equality check predicate on the "restored_at" field. It's identical to RestoredAtEQ.
+func RestoredAt(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldRestoredAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotNull(FieldTenantID))
+}
+
+// LogTypeEQ applies the EQ predicate on the "log_type" field.
+func LogTypeEQ(v LogType) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldLogType, v))
+}
+
+// LogTypeNEQ applies the NEQ predicate on the "log_type" field.
+func LogTypeNEQ(v LogType) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNEQ(FieldLogType, v))
+}
+
+// LogTypeIn applies the In predicate on the "log_type" field.
+func LogTypeIn(vs ...LogType) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIn(FieldLogType, vs...))
+}
+
+// LogTypeNotIn applies the NotIn predicate on the "log_type" field.
+func LogTypeNotIn(vs ...LogType) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotIn(FieldLogType, vs...))
+}
+
+// LogTypeIsNil applies the IsNil predicate on the "log_type" field.
+func LogTypeIsNil() predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIsNull(FieldLogType))
+}
+
+// LogTypeNotNil applies the NotNil predicate on the "log_type" field.
+func LogTypeNotNil() predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotNull(FieldLogType))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusIsNil applies the IsNil predicate on the "status" field.
+func StatusIsNil() predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIsNull(FieldStatus))
+}
+
+// StatusNotNil applies the NotNil predicate on the "status" field.
+func StatusNotNil() predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotNull(FieldStatus))
+}
+
+// BucketNameEQ applies the EQ predicate on the "bucket_name" field.
+func BucketNameEQ(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldBucketName, v))
+}
+
+// BucketNameNEQ applies the NEQ predicate on the "bucket_name" field.
+func BucketNameNEQ(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNEQ(FieldBucketName, v))
+}
+
+// BucketNameIn applies the In predicate on the "bucket_name" field.
+func BucketNameIn(vs ...string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIn(FieldBucketName, vs...))
+}
+
+// BucketNameNotIn applies the NotIn predicate on the "bucket_name" field.
+func BucketNameNotIn(vs ...string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotIn(FieldBucketName, vs...))
+}
+
+// BucketNameGT applies the GT predicate on the "bucket_name" field.
+func BucketNameGT(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGT(FieldBucketName, v))
+}
+
+// BucketNameGTE applies the GTE predicate on the "bucket_name" field.
+func BucketNameGTE(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGTE(FieldBucketName, v))
+}
+
+// BucketNameLT applies the LT predicate on the "bucket_name" field.
+func BucketNameLT(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLT(FieldBucketName, v))
+}
+
+// BucketNameLTE applies the LTE predicate on the "bucket_name" field.
+func BucketNameLTE(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLTE(FieldBucketName, v))
+}
+
+// BucketNameContains applies the Contains predicate on the "bucket_name" field.
+func BucketNameContains(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldContains(FieldBucketName, v))
+}
+
+// BucketNameHasPrefix applies the HasPrefix predicate on the "bucket_name" field.
+func BucketNameHasPrefix(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldHasPrefix(FieldBucketName, v))
+}
+
+// BucketNameHasSuffix applies the HasSuffix predicate on the "bucket_name" field.
+func BucketNameHasSuffix(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldHasSuffix(FieldBucketName, v))
+}
+
+// BucketNameIsNil applies the IsNil predicate on the "bucket_name" field.
+func BucketNameIsNil() predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIsNull(FieldBucketName))
+}
+
+// BucketNameNotNil applies the NotNil predicate on the "bucket_name" field.
+func BucketNameNotNil() predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotNull(FieldBucketName))
+}
+
+// BucketNameEqualFold applies the EqualFold predicate on the "bucket_name" field.
+func BucketNameEqualFold(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEqualFold(FieldBucketName, v))
+}
+
+// BucketNameContainsFold applies the ContainsFold predicate on the "bucket_name" field.
+func BucketNameContainsFold(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldContainsFold(FieldBucketName, v))
+}
+
+// ObjectNameEQ applies the EQ predicate on the "object_name" field.
+func ObjectNameEQ(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldObjectName, v))
+}
+
+// ObjectNameNEQ applies the NEQ predicate on the "object_name" field.
+func ObjectNameNEQ(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNEQ(FieldObjectName, v))
+}
+
+// ObjectNameIn applies the In predicate on the "object_name" field.
+func ObjectNameIn(vs ...string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIn(FieldObjectName, vs...))
+}
+
+// ObjectNameNotIn applies the NotIn predicate on the "object_name" field.
+func ObjectNameNotIn(vs ...string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotIn(FieldObjectName, vs...))
+}
+
+// ObjectNameGT applies the GT predicate on the "object_name" field.
+func ObjectNameGT(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGT(FieldObjectName, v))
+}
+
+// ObjectNameGTE applies the GTE predicate on the "object_name" field.
+func ObjectNameGTE(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGTE(FieldObjectName, v))
+}
+
+// ObjectNameLT applies the LT predicate on the "object_name" field.
+func ObjectNameLT(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLT(FieldObjectName, v))
+}
+
+// ObjectNameLTE applies the LTE predicate on the "object_name" field.
+func ObjectNameLTE(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLTE(FieldObjectName, v))
+}
+
+// ObjectNameContains applies the Contains predicate on the "object_name" field.
+func ObjectNameContains(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldContains(FieldObjectName, v))
+}
+
+// ObjectNameHasPrefix applies the HasPrefix predicate on the "object_name" field.
+func ObjectNameHasPrefix(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldHasPrefix(FieldObjectName, v))
+}
+
+// ObjectNameHasSuffix applies the HasSuffix predicate on the "object_name" field.
+func ObjectNameHasSuffix(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldHasSuffix(FieldObjectName, v))
+}
+
+// ObjectNameIsNil applies the IsNil predicate on the "object_name" field.
+func ObjectNameIsNil() predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIsNull(FieldObjectName))
+}
+
+// ObjectNameNotNil applies the NotNil predicate on the "object_name" field.
+func ObjectNameNotNil() predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotNull(FieldObjectName))
+}
+
+// ObjectNameEqualFold applies the EqualFold predicate on the "object_name" field.
+func ObjectNameEqualFold(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEqualFold(FieldObjectName, v))
+}
+
+// ObjectNameContainsFold applies the ContainsFold predicate on the "object_name" field.
+func ObjectNameContainsFold(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldContainsFold(FieldObjectName, v))
+}
+
+// ManifestObjectNameEQ applies the EQ predicate on the "manifest_object_name" field.
+func ManifestObjectNameEQ(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldManifestObjectName, v))
+}
+
+// ManifestObjectNameNEQ applies the NEQ predicate on the "manifest_object_name" field.
+func ManifestObjectNameNEQ(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNEQ(FieldManifestObjectName, v))
+}
+
+// ManifestObjectNameIn applies the In predicate on the "manifest_object_name" field.
+func ManifestObjectNameIn(vs ...string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIn(FieldManifestObjectName, vs...))
+}
+
+// ManifestObjectNameNotIn applies the NotIn predicate on the "manifest_object_name" field.
+func ManifestObjectNameNotIn(vs ...string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotIn(FieldManifestObjectName, vs...))
+}
+
+// ManifestObjectNameGT applies the GT predicate on the "manifest_object_name" field.
+func ManifestObjectNameGT(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGT(FieldManifestObjectName, v))
+}
+
+// ManifestObjectNameGTE applies the GTE predicate on the "manifest_object_name" field.
+func ManifestObjectNameGTE(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGTE(FieldManifestObjectName, v))
+}
+
+// ManifestObjectNameLT applies the LT predicate on the "manifest_object_name" field.
+func ManifestObjectNameLT(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLT(FieldManifestObjectName, v))
+}
+
+// ManifestObjectNameLTE applies the LTE predicate on the "manifest_object_name" field.
+func ManifestObjectNameLTE(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLTE(FieldManifestObjectName, v))
+}
+
+// ManifestObjectNameContains applies the Contains predicate on the "manifest_object_name" field.
+func ManifestObjectNameContains(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldContains(FieldManifestObjectName, v))
+}
+
+// ManifestObjectNameHasPrefix applies the HasPrefix predicate on the "manifest_object_name" field.
+func ManifestObjectNameHasPrefix(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldHasPrefix(FieldManifestObjectName, v))
+}
+
+// ManifestObjectNameHasSuffix applies the HasSuffix predicate on the "manifest_object_name" field.
+func ManifestObjectNameHasSuffix(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldHasSuffix(FieldManifestObjectName, v))
+}
+
+// ManifestObjectNameIsNil applies the IsNil predicate on the "manifest_object_name" field.
+func ManifestObjectNameIsNil() predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIsNull(FieldManifestObjectName))
+}
+
+// ManifestObjectNameNotNil applies the NotNil predicate on the "manifest_object_name" field.
+func ManifestObjectNameNotNil() predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotNull(FieldManifestObjectName))
+}
+
+// ManifestObjectNameEqualFold applies the EqualFold predicate on the "manifest_object_name" field.
+func ManifestObjectNameEqualFold(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEqualFold(FieldManifestObjectName, v))
+}
+
+// ManifestObjectNameContainsFold applies the ContainsFold predicate on the "manifest_object_name" field.
+func ManifestObjectNameContainsFold(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldContainsFold(FieldManifestObjectName, v))
+}
+
+// Sha256EQ applies the EQ predicate on the "sha256" field.
+func Sha256EQ(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldSha256, v))
+}
+
+// Sha256NEQ applies the NEQ predicate on the "sha256" field.
+func Sha256NEQ(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNEQ(FieldSha256, v))
+}
+
+// Sha256In applies the In predicate on the "sha256" field.
+func Sha256In(vs ...string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIn(FieldSha256, vs...))
+}
+
+// Sha256NotIn applies the NotIn predicate on the "sha256" field.
+func Sha256NotIn(vs ...string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotIn(FieldSha256, vs...))
+}
+
+// Sha256GT applies the GT predicate on the "sha256" field.
+func Sha256GT(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGT(FieldSha256, v))
+}
+
+// Sha256GTE applies the GTE predicate on the "sha256" field.
+func Sha256GTE(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGTE(FieldSha256, v))
+}
+
+// Sha256LT applies the LT predicate on the "sha256" field.
+func Sha256LT(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLT(FieldSha256, v))
+}
+
+// Sha256LTE applies the LTE predicate on the "sha256" field.
+func Sha256LTE(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLTE(FieldSha256, v))
+}
+
+// Sha256Contains applies the Contains predicate on the "sha256" field.
+func Sha256Contains(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldContains(FieldSha256, v))
+}
+
+// Sha256HasPrefix applies the HasPrefix predicate on the "sha256" field.
+func Sha256HasPrefix(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldHasPrefix(FieldSha256, v))
+}
+
+// Sha256HasSuffix applies the HasSuffix predicate on the "sha256" field.
+func Sha256HasSuffix(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldHasSuffix(FieldSha256, v))
+}
+
+// Sha256IsNil applies the IsNil predicate on the "sha256" field.
+func Sha256IsNil() predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIsNull(FieldSha256))
+}
+
+// Sha256NotNil applies the NotNil predicate on the "sha256" field.
+func Sha256NotNil() predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotNull(FieldSha256))
+}
+
+// Sha256EqualFold applies the EqualFold predicate on the "sha256" field.
+func Sha256EqualFold(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEqualFold(FieldSha256, v))
+}
+
+// Sha256ContainsFold applies the ContainsFold predicate on the "sha256" field.
+func Sha256ContainsFold(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldContainsFold(FieldSha256, v))
+}
+
+// SizeBytesEQ applies the EQ predicate on the "size_bytes" field.
+func SizeBytesEQ(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldSizeBytes, v))
+}
+
+// SizeBytesNEQ applies the NEQ predicate on the "size_bytes" field.
+func SizeBytesNEQ(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNEQ(FieldSizeBytes, v))
+}
+
+// SizeBytesIn applies the In predicate on the "size_bytes" field.
+func SizeBytesIn(vs ...uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIn(FieldSizeBytes, vs...))
+}
+
+// SizeBytesNotIn applies the NotIn predicate on the "size_bytes" field.
+func SizeBytesNotIn(vs ...uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotIn(FieldSizeBytes, vs...))
+}
+
+// SizeBytesGT applies the GT predicate on the "size_bytes" field.
+func SizeBytesGT(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGT(FieldSizeBytes, v))
+}
+
+// SizeBytesGTE applies the GTE predicate on the "size_bytes" field.
+func SizeBytesGTE(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGTE(FieldSizeBytes, v))
+}
+
+// SizeBytesLT applies the LT predicate on the "size_bytes" field.
+func SizeBytesLT(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLT(FieldSizeBytes, v))
+}
+
+// SizeBytesLTE applies the LTE predicate on the "size_bytes" field.
+func SizeBytesLTE(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLTE(FieldSizeBytes, v))
+}
+
+// SizeBytesIsNil applies the IsNil predicate on the "size_bytes" field.
+func SizeBytesIsNil() predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIsNull(FieldSizeBytes))
+}
+
+// SizeBytesNotNil applies the NotNil predicate on the "size_bytes" field.
+func SizeBytesNotNil() predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotNull(FieldSizeBytes))
+}
+
+// RowCountEQ applies the EQ predicate on the "row_count" field.
+func RowCountEQ(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldRowCount, v))
+}
+
+// RowCountNEQ applies the NEQ predicate on the "row_count" field.
+func RowCountNEQ(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNEQ(FieldRowCount, v))
+}
+
+// RowCountIn applies the In predicate on the "row_count" field.
+func RowCountIn(vs ...uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIn(FieldRowCount, vs...))
+}
+
+// RowCountNotIn applies the NotIn predicate on the "row_count" field.
+func RowCountNotIn(vs ...uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotIn(FieldRowCount, vs...))
+}
+
+// RowCountGT applies the GT predicate on the "row_count" field.
+func RowCountGT(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGT(FieldRowCount, v))
+}
+
+// RowCountGTE applies the GTE predicate on the "row_count" field.
+func RowCountGTE(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGTE(FieldRowCount, v))
+}
+
+// RowCountLT applies the LT predicate on the "row_count" field.
+func RowCountLT(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLT(FieldRowCount, v))
+}
+
+// RowCountLTE applies the LTE predicate on the "row_count" field.
+func RowCountLTE(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLTE(FieldRowCount, v))
+}
+
+// RowCountIsNil applies the IsNil predicate on the "row_count" field.
+func RowCountIsNil() predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIsNull(FieldRowCount))
+}
+
+// RowCountNotNil applies the NotNil predicate on the "row_count" field.
+func RowCountNotNil() predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotNull(FieldRowCount))
+}
+
+// PurgedCountEQ applies the EQ predicate on the "purged_count" field.
+func PurgedCountEQ(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldPurgedCount, v))
+}
+
+// PurgedCountNEQ applies the NEQ predicate on the "purged_count" field.
+func PurgedCountNEQ(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNEQ(FieldPurgedCount, v))
+}
+
+// PurgedCountIn applies the In predicate on the "purged_count" field.
+func PurgedCountIn(vs ...uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIn(FieldPurgedCount, vs...))
+}
+
+// PurgedCountNotIn applies the NotIn predicate on the "purged_count" field.
+func PurgedCountNotIn(vs ...uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotIn(FieldPurgedCount, vs...))
+}
+
+// PurgedCountGT applies the GT predicate on the "purged_count" field.
+func PurgedCountGT(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGT(FieldPurgedCount, v))
+}
+
+// PurgedCountGTE applies the GTE predicate on the "purged_count" field.
+func PurgedCountGTE(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGTE(FieldPurgedCount, v))
+}
+
+// PurgedCountLT applies the LT predicate on the "purged_count" field.
+func PurgedCountLT(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLT(FieldPurgedCount, v))
+}
+
+// PurgedCountLTE applies the LTE predicate on the "purged_count" field.
+func PurgedCountLTE(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLTE(FieldPurgedCount, v))
+}
+
+// PurgedCountIsNil applies the IsNil predicate on the "purged_count" field.
+func PurgedCountIsNil() predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIsNull(FieldPurgedCount))
+}
+
+// PurgedCountNotNil applies the NotNil predicate on the "purged_count" field.
+func PurgedCountNotNil() predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotNull(FieldPurgedCount))
+}
+
+// MinLogIDEQ applies the EQ predicate on the "min_log_id" field.
+func MinLogIDEQ(v uint32) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldMinLogID, v))
+}
+
+// MinLogIDNEQ applies the NEQ predicate on the "min_log_id" field.
+func MinLogIDNEQ(v uint32) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNEQ(FieldMinLogID, v))
+}
+
+// MinLogIDIn applies the In predicate on the "min_log_id" field.
+func MinLogIDIn(vs ...uint32) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIn(FieldMinLogID, vs...))
+}
+
+// MinLogIDNotIn applies the NotIn predicate on the "min_log_id" field.
+func MinLogIDNotIn(vs ...uint32) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotIn(FieldMinLogID, vs...))
+}
+
+// MinLogIDGT applies the GT predicate on the "min_log_id" field.
+func MinLogIDGT(v uint32) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGT(FieldMinLogID, v))
+}
+
+// MinLogIDGTE applies the GTE predicate on the "min_log_id" field.
+func MinLogIDGTE(v uint32) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGTE(FieldMinLogID, v))
+}
+
+// MinLogIDLT applies the LT predicate on the "min_log_id" field.
+func MinLogIDLT(v uint32) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLT(FieldMinLogID, v))
+}
+
+// MinLogIDLTE applies the LTE predicate on the "min_log_id" field.
+func MinLogIDLTE(v uint32) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLTE(FieldMinLogID, v))
+}
+
+// MinLogIDIsNil applies the IsNil predicate on the "min_log_id" field.
+func MinLogIDIsNil() predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIsNull(FieldMinLogID))
+}
+
+// MinLogIDNotNil applies the NotNil predicate on the "min_log_id" field.
+func MinLogIDNotNil() predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotNull(FieldMinLogID))
+}
+
+// MaxLogIDEQ applies the EQ predicate on the "max_log_id" field.
+func MaxLogIDEQ(v uint32) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldMaxLogID, v))
+}
+
+// MaxLogIDNEQ applies the NEQ predicate on the "max_log_id" field.
+func MaxLogIDNEQ(v uint32) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNEQ(FieldMaxLogID, v))
+}
+
+// MaxLogIDIn applies the In predicate on the "max_log_id" field.
+func MaxLogIDIn(vs ...uint32) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIn(FieldMaxLogID, vs...))
+}
+
+// MaxLogIDNotIn applies the NotIn predicate on the "max_log_id" field.
+func MaxLogIDNotIn(vs ...uint32) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotIn(FieldMaxLogID, vs...))
+}
+
+// MaxLogIDGT applies the GT predicate on the "max_log_id" field.
+func MaxLogIDGT(v uint32) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGT(FieldMaxLogID, v))
+}
+
+// MaxLogIDGTE applies the GTE predicate on the "max_log_id" field.
+func MaxLogIDGTE(v uint32) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGTE(FieldMaxLogID, v))
+}
+
+// MaxLogIDLT applies the LT predicate on the "max_log_id" field.
+func MaxLogIDLT(v uint32) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLT(FieldMaxLogID, v))
+}
+
+// MaxLogIDLTE applies the LTE predicate on the "max_log_id" field.
+func MaxLogIDLTE(v uint32) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLTE(FieldMaxLogID, v))
+}
+
+// MaxLogIDIsNil applies the IsNil predicate on the "max_log_id" field.
+func MaxLogIDIsNil() predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIsNull(FieldMaxLogID))
+}
+
+// MaxLogIDNotNil applies the NotNil predicate on the "max_log_id" field.
+func MaxLogIDNotNil() predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotNull(FieldMaxLogID))
+}
+
+// CutoffTimeEQ applies the EQ predicate on the "cutoff_time" field.
+func CutoffTimeEQ(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldCutoffTime, v))
+}
+
+// CutoffTimeNEQ applies the NEQ predicate on the "cutoff_time" field.
+func CutoffTimeNEQ(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNEQ(FieldCutoffTime, v))
+}
+
+// CutoffTimeIn applies the In predicate on the "cutoff_time" field.
+func CutoffTimeIn(vs ...time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIn(FieldCutoffTime, vs...))
+}
+
+// CutoffTimeNotIn applies the NotIn predicate on the "cutoff_time" field.
+func CutoffTimeNotIn(vs ...time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotIn(FieldCutoffTime, vs...))
+}
+
+// CutoffTimeGT applies the GT predicate on the "cutoff_time" field.
+func CutoffTimeGT(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGT(FieldCutoffTime, v))
+}
+
+// CutoffTimeGTE applies the GTE predicate on the "cutoff_time" field.
+func CutoffTimeGTE(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGTE(FieldCutoffTime, v))
+}
+
+// CutoffTimeLT applies the LT predicate on the "cutoff_time" field.
+func CutoffTimeLT(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLT(FieldCutoffTime, v))
+}
+
+// CutoffTimeLTE applies the LTE predicate on the "cutoff_time" field.
+func CutoffTimeLTE(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLTE(FieldCutoffTime, v))
+}
+
+// CutoffTimeIsNil applies the IsNil predicate on the "cutoff_time" field.
+func CutoffTimeIsNil() predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIsNull(FieldCutoffTime))
+}
+
+// CutoffTimeNotNil applies the NotNil predicate on the "cutoff_time" field.
+func CutoffTimeNotNil() predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotNull(FieldCutoffTime))
+}
+
+// ErrorMessageEQ applies the EQ predicate on the "error_message" field.
+func ErrorMessageEQ(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldErrorMessage, v))
+}
+
+// ErrorMessageNEQ applies the NEQ predicate on the "error_message" field.
+func ErrorMessageNEQ(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNEQ(FieldErrorMessage, v))
+}
+
+// ErrorMessageIn applies the In predicate on the "error_message" field.
+func ErrorMessageIn(vs ...string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIn(FieldErrorMessage, vs...))
+}
+
+// ErrorMessageNotIn applies the NotIn predicate on the "error_message" field.
+func ErrorMessageNotIn(vs ...string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotIn(FieldErrorMessage, vs...))
+}
+
+// ErrorMessageGT applies the GT predicate on the "error_message" field.
+func ErrorMessageGT(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGT(FieldErrorMessage, v))
+}
+
+// ErrorMessageGTE applies the GTE predicate on the "error_message" field.
+func ErrorMessageGTE(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGTE(FieldErrorMessage, v))
+}
+
+// ErrorMessageLT applies the LT predicate on the "error_message" field.
+func ErrorMessageLT(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLT(FieldErrorMessage, v))
+}
+
+// ErrorMessageLTE applies the LTE predicate on the "error_message" field.
+func ErrorMessageLTE(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLTE(FieldErrorMessage, v))
+}
+
+// ErrorMessageContains applies the Contains predicate on the "error_message" field.
+func ErrorMessageContains(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldContains(FieldErrorMessage, v))
+}
+
+// ErrorMessageHasPrefix applies the HasPrefix predicate on the "error_message" field.
+func ErrorMessageHasPrefix(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldHasPrefix(FieldErrorMessage, v))
+}
+
+// ErrorMessageHasSuffix applies the HasSuffix predicate on the "error_message" field.
+func ErrorMessageHasSuffix(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldHasSuffix(FieldErrorMessage, v))
+}
+
+// ErrorMessageIsNil applies the IsNil predicate on the "error_message" field.
+func ErrorMessageIsNil() predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIsNull(FieldErrorMessage))
+}
+
+// ErrorMessageNotNil applies the NotNil predicate on the "error_message" field.
+func ErrorMessageNotNil() predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotNull(FieldErrorMessage))
+}
+
+// ErrorMessageEqualFold applies the EqualFold predicate on the "error_message" field.
+func ErrorMessageEqualFold(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEqualFold(FieldErrorMessage, v))
+}
+
+// ErrorMessageContainsFold applies the ContainsFold predicate on the "error_message" field.
+func ErrorMessageContainsFold(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldContainsFold(FieldErrorMessage, v))
+}
+
+// RestoredCountEQ applies the EQ predicate on the "restored_count" field.
+func RestoredCountEQ(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldRestoredCount, v))
+}
+
+// RestoredCountNEQ applies the NEQ predicate on the "restored_count" field.
+func RestoredCountNEQ(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNEQ(FieldRestoredCount, v))
+}
+
+// RestoredCountIn applies the In predicate on the "restored_count" field.
+func RestoredCountIn(vs ...uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIn(FieldRestoredCount, vs...))
+}
+
+// RestoredCountNotIn applies the NotIn predicate on the "restored_count" field.
+func RestoredCountNotIn(vs ...uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotIn(FieldRestoredCount, vs...))
+}
+
+// RestoredCountGT applies the GT predicate on the "restored_count" field.
+func RestoredCountGT(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGT(FieldRestoredCount, v))
+}
+
+// RestoredCountGTE applies the GTE predicate on the "restored_count" field.
+func RestoredCountGTE(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGTE(FieldRestoredCount, v))
+}
+
+// RestoredCountLT applies the LT predicate on the "restored_count" field.
+func RestoredCountLT(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLT(FieldRestoredCount, v))
+}
+
+// RestoredCountLTE applies the LTE predicate on the "restored_count" field.
+func RestoredCountLTE(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLTE(FieldRestoredCount, v))
+}
+
+// RestoredCountIsNil applies the IsNil predicate on the "restored_count" field.
+func RestoredCountIsNil() predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIsNull(FieldRestoredCount))
+}
+
+// RestoredCountNotNil applies the NotNil predicate on the "restored_count" field.
+func RestoredCountNotNil() predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotNull(FieldRestoredCount))
+}
+
+// RestoredAtEQ applies the EQ predicate on the "restored_at" field.
+func RestoredAtEQ(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldRestoredAt, v))
+}
+
+// RestoredAtNEQ applies the NEQ predicate on the "restored_at" field.
+func RestoredAtNEQ(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNEQ(FieldRestoredAt, v))
+}
+
+// RestoredAtIn applies the In predicate on the "restored_at" field.
+func RestoredAtIn(vs ...time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIn(FieldRestoredAt, vs...))
+}
+
+// RestoredAtNotIn applies the NotIn predicate on the "restored_at" field.
+func RestoredAtNotIn(vs ...time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotIn(FieldRestoredAt, vs...))
+}
+
+// RestoredAtGT applies the GT predicate on the "restored_at" field.
+func RestoredAtGT(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGT(FieldRestoredAt, v))
+}
+
+// RestoredAtGTE applies the GTE predicate on the "restored_at" field.
+func RestoredAtGTE(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGTE(FieldRestoredAt, v))
+}
+
+// RestoredAtLT applies the LT predicate on the "restored_at" field.
+func RestoredAtLT(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLT(FieldRestoredAt, v))
+}
+
+// RestoredAtLTE applies the LTE predicate on the "restored_at" field.
+func RestoredAtLTE(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLTE(FieldRestoredAt, v))
+}
+
+// RestoredAtIsNil applies the IsNil predicate on the "restored_at" field.
+func RestoredAtIsNil() predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIsNull(FieldRestoredAt))
+}
+
+// RestoredAtNotNil applies the NotNil predicate on the "restored_at" field.
+func RestoredAtNotNil() predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotNull(FieldRestoredAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AuditLogArchive) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AuditLogArchive) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AuditLogArchive) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.NotPredicates(p))
+}
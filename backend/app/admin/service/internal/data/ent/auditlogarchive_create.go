@@ -0,0 +1,1963 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/auditlogarchive"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AuditLogArchiveCreate is the builder for creating a AuditLogArchive entity.
+type AuditLogArchiveCreate struct {
+	config
+	mutation *AuditLogArchiveMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AuditLogArchiveCreate) SetCreatedAt(v time.Time) *AuditLogArchiveCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AuditLogArchiveCreate) SetNillableCreatedAt(v *time.Time) *AuditLogArchiveCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *AuditLogArchiveCreate) SetUpdatedAt(v time.Time) *AuditLogArchiveCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *AuditLogArchiveCreate) SetNillableUpdatedAt(v *time.Time) *AuditLogArchiveCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *AuditLogArchiveCreate) SetDeletedAt(v time.Time) *AuditLogArchiveCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *AuditLogArchiveCreate) SetNillableDeletedAt(v *time.Time) *AuditLogArchiveCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *AuditLogArchiveCreate) SetTenantID(v uint32) *AuditLogArchiveCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *AuditLogArchiveCreate) SetNillableTenantID(v *uint32) *AuditLogArchiveCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetLogType sets the "log_type" field.
+func (_c *AuditLogArchiveCreate) SetLogType(v auditlogarchive.LogType) *AuditLogArchiveCreate {
+	_c.mutation.SetLogType(v)
+	return _c
+}
+
+// SetNillableLogType sets the "log_type" field if the given value is not nil.
+func (_c *AuditLogArchiveCreate) SetNillableLogType(v *auditlogarchive.LogType) *AuditLogArchiveCreate {
+	if v != nil {
+		_c.SetLogType(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *AuditLogArchiveCreate) SetStatus(v auditlogarchive.Status) *AuditLogArchiveCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *AuditLogArchiveCreate) SetNillableStatus(v *auditlogarchive.Status) *AuditLogArchiveCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetBucketName sets the "bucket_name" field.
+func (_c *AuditLogArchiveCreate) SetBucketName(v string) *AuditLogArchiveCreate {
+	_c.mutation.SetBucketName(v)
+	return _c
+}
+
+// SetNillableBucketName sets the "bucket_name" field if the given value is not nil.
+func (_c *AuditLogArchiveCreate) SetNillableBucketName(v *string) *AuditLogArchiveCreate {
+	if v != nil {
+		_c.SetBucketName(*v)
+	}
+	return _c
+}
+
+// SetObjectName sets the "object_name" field.
+func (_c *AuditLogArchiveCreate) SetObjectName(v string) *AuditLogArchiveCreate {
+	_c.mutation.SetObjectName(v)
+	return _c
+}
+
+// SetNillableObjectName sets the "object_name" field if the given value is not nil.
+func (_c *AuditLogArchiveCreate) SetNillableObjectName(v *string) *AuditLogArchiveCreate {
+	if v != nil {
+		_c.SetObjectName(*v)
+	}
+	return _c
+}
+
+// SetManifestObjectName sets the "manifest_object_name" field.
+func (_c *AuditLogArchiveCreate) SetManifestObjectName(v string) *AuditLogArchiveCreate {
+	_c.mutation.SetManifestObjectName(v)
+	return _c
+}
+
+// SetNillableManifestObjectName sets the "manifest_object_name" field if the given value is not nil.
+func (_c *AuditLogArchiveCreate) SetNillableManifestObjectName(v *string) *AuditLogArchiveCreate {
+	if v != nil {
+		_c.SetManifestObjectName(*v)
+	}
+	return _c
+}
+
+// SetSha256 sets the "sha256" field.
+func (_c *AuditLogArchiveCreate) SetSha256(v string) *AuditLogArchiveCreate {
+	_c.mutation.SetSha256(v)
+	return _c
+}
+
+// SetNillableSha256 sets the "sha256" field if the given value is not nil.
+func (_c *AuditLogArchiveCreate) SetNillableSha256(v *string) *AuditLogArchiveCreate {
+	if v != nil {
+		_c.SetSha256(*v)
+	}
+	return _c
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (_c *AuditLogArchiveCreate) SetSizeBytes(v uint64) *AuditLogArchiveCreate {
+	_c.mutation.SetSizeBytes(v)
+	return _c
+}
+
+// SetNillableSizeBytes sets the "size_bytes" field if the given value is not nil.
+func (_c *AuditLogArchiveCreate) SetNillableSizeBytes(v *uint64) *AuditLogArchiveCreate {
+	if v != nil {
+		_c.SetSizeBytes(*v)
+	}
+	return _c
+}
+
+// SetRowCount sets the "row_count" field.
+func (_c *AuditLogArchiveCreate) SetRowCount(v uint64) *AuditLogArchiveCreate {
+	_c.mutation.SetRowCount(v)
+	return _c
+}
+
+// SetNillableRowCount sets the "row_count" field if the given value is not nil.
+func (_c *AuditLogArchiveCreate) SetNillableRowCount(v *uint64) *AuditLogArchiveCreate {
+	if v != nil {
+		_c.SetRowCount(*v)
+	}
+	return _c
+}
+
+// SetPurgedCount sets the "purged_count" field.
+func (_c *AuditLogArchiveCreate) SetPurgedCount(v uint64) *AuditLogArchiveCreate {
+	_c.mutation.SetPurgedCount(v)
+	return _c
+}
+
+// SetNillablePurgedCount sets the "purged_count" field if the given value is not nil.
+func (_c *AuditLogArchiveCreate) SetNillablePurgedCount(v *uint64) *AuditLogArchiveCreate {
+	if v != nil {
+		_c.SetPurgedCount(*v)
+	}
+	return _c
+}
+
+// SetMinLogID sets the "min_log_id" field.
+func (_c *AuditLogArchiveCreate) SetMinLogID(v uint32) *AuditLogArchiveCreate {
+	_c.mutation.SetMinLogID(v)
+	return _c
+}
+
+// SetNillableMinLogID sets the "min_log_id" field if the given value is not nil.
+func (_c *AuditLogArchiveCreate) SetNillableMinLogID(v *uint32) *AuditLogArchiveCreate {
+	if v != nil {
+		_c.SetMinLogID(*v)
+	}
+	return _c
+}
+
+// SetMaxLogID sets the "max_log_id" field.
+func (_c *AuditLogArchiveCreate) SetMaxLogID(v uint32) *AuditLogArchiveCreate {
+	_c.mutation.SetMaxLogID(v)
+	return _c
+}
+
+// SetNillableMaxLogID sets the "max_log_id" field if the given value is not nil.
+func (_c *AuditLogArchiveCreate) SetNillableMaxLogID(v *uint32) *AuditLogArchiveCreate {
+	if v != nil {
+		_c.SetMaxLogID(*v)
+	}
+	return _c
+}
+
+// SetCutoffTime sets the "cutoff_time" field.
+func (_c *AuditLogArchiveCreate) SetCutoffTime(v time.Time) *AuditLogArchiveCreate {
+	_c.mutation.SetCutoffTime(v)
+	return _c
+}
+
+// SetNillableCutoffTime sets the "cutoff_time" field if the given value is not nil.
+func (_c *AuditLogArchiveCreate) SetNillableCutoffTime(v *time.Time) *AuditLogArchiveCreate {
+	if v != nil {
+		_c.SetCutoffTime(*v)
+	}
+	return _c
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_c *AuditLogArchiveCreate) SetErrorMessage(v string) *AuditLogArchiveCreate {
+	_c.mutation.SetErrorMessage(v)
+	return _c
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_c *AuditLogArchiveCreate) SetNillableErrorMessage(v *string) *AuditLogArchiveCreate {
+	if v != nil {
+		_c.SetErrorMessage(*v)
+	}
+	return _c
+}
+
+// SetRestoredCount sets the "restored_count" field.
+func (_c *AuditLogArchiveCreate) SetRestoredCount(v uint64) *AuditLogArchiveCreate {
+	_c.mutation.SetRestoredCount(v)
+	return _c
+}
+
+// SetNillableRestoredCount sets the "restored_count" field if the given value is not nil.
+func (_c *AuditLogArchiveCreate) SetNillableRestoredCount(v *uint64) *AuditLogArchiveCreate {
+	if v != nil {
+		_c.SetRestoredCount(*v)
+	}
+	return _c
+}
+
+// SetRestoredAt sets the "restored_at" field.
+func (_c *AuditLogArchiveCreate) SetRestoredAt(v time.Time) *AuditLogArchiveCreate {
+	_c.mutation.SetRestoredAt(v)
+	return _c
+}
+
+// SetNillableRestoredAt sets the "restored_at" field if the given value is not nil.
+func (_c *AuditLogArchiveCreate) SetNillableRestoredAt(v *time.Time) *AuditLogArchiveCreate {
+	if v != nil {
+		_c.SetRestoredAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AuditLogArchiveCreate) SetID(v uint32) *AuditLogArchiveCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the AuditLogArchiveMutation object of the builder.
+func (_c *AuditLogArchiveCreate) Mutation() *AuditLogArchiveMutation {
+	return _c.mutation
+}
+
+// Save creates the AuditLogArchive in the database.
+func (_c *AuditLogArchiveCreate) Save(ctx context.Context) (*AuditLogArchive, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AuditLogArchiveCreate) SaveX(ctx context.Context) *AuditLogArchive {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AuditLogArchiveCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AuditLogArchiveCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AuditLogArchiveCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := auditlogarchive.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := auditlogarchive.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AuditLogArchiveCreate) check() error {
+	if v, ok := _c.mutation.LogType(); ok {
+		if err := auditlogarchive.LogTypeValidator(v); err != nil {
+			return &ValidationError{Name: "log_type", err: fmt.Errorf(`ent: validator failed for field "AuditLogArchive.log_type": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := auditlogarchive.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "AuditLogArchive.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Sha256(); ok {
+		if err := auditlogarchive.Sha256Validator(v); err != nil {
+			return &ValidationError{Name: "sha256", err: fmt.Errorf(`ent: validator failed for field "AuditLogArchive.sha256": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := auditlogarchive.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "AuditLogArchive.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *AuditLogArchiveCreate) sqlSave(ctx context.Context) (*AuditLogArchive, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AuditLogArchiveCreate) createSpec() (*AuditLogArchive, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditLogArchive{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(auditlogarchive.Table, sqlgraph.NewFieldSpec(auditlogarchive.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(auditlogarchive.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(auditlogarchive.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(auditlogarchive.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(auditlogarchive.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.LogType(); ok {
+		_spec.SetField(auditlogarchive.FieldLogType, field.TypeEnum, value)
+		_node.LogType = &value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(auditlogarchive.FieldStatus, field.TypeEnum, value)
+		_node.Status = &value
+	}
+	if value, ok := _c.mutation.BucketName(); ok {
+		_spec.SetField(auditlogarchive.FieldBucketName, field.TypeString, value)
+		_node.BucketName = &value
+	}
+	if value, ok := _c.mutation.ObjectName(); ok {
+		_spec.SetField(auditlogarchive.FieldObjectName, field.TypeString, value)
+		_node.ObjectName = &value
+	}
+	if value, ok := _c.mutation.ManifestObjectName(); ok {
+		_spec.SetField(auditlogarchive.FieldManifestObjectName, field.TypeString, value)
+		_node.ManifestObjectName = &value
+	}
+	if value, ok := _c.mutation.Sha256(); ok {
+		_spec.SetField(auditlogarchive.FieldSha256, field.TypeString, value)
+		_node.Sha256 = &value
+	}
+	if value, ok := _c.mutation.SizeBytes(); ok {
+		_spec.SetField(auditlogarchive.FieldSizeBytes, field.TypeUint64, value)
+		_node.SizeBytes = &value
+	}
+	if value, ok := _c.mutation.RowCount(); ok {
+		_spec.SetField(auditlogarchive.FieldRowCount, field.TypeUint64, value)
+		_node.RowCount = &value
+	}
+	if value, ok := _c.mutation.PurgedCount(); ok {
+		_spec.SetField(auditlogarchive.FieldPurgedCount, field.TypeUint64, value)
+		_node.PurgedCount = &value
+	}
+	if value, ok := _c.mutation.MinLogID(); ok {
+		_spec.SetField(auditlogarchive.FieldMinLogID, field.TypeUint32, value)
+		_node.MinLogID = &value
+	}
+	if value, ok := _c.mutation.MaxLogID(); ok {
+		_spec.SetField(auditlogarchive.FieldMaxLogID, field.TypeUint32, value)
+		_node.MaxLogID = &value
+	}
+	if value, ok := _c.mutation.CutoffTime(); ok {
+		_spec.SetField(auditlogarchive.FieldCutoffTime, field.TypeTime, value)
+		_node.CutoffTime = &value
+	}
+	if value, ok := _c.mutation.ErrorMessage(); ok {
+		_spec.SetField(auditlogarchive.FieldErrorMessage, field.TypeString, value)
+		_node.ErrorMessage = &value
+	}
+	if value, ok := _c.mutation.RestoredCount(); ok {
+		_spec.SetField(auditlogarchive.FieldRestoredCount, field.TypeUint64, value)
+		_node.RestoredCount = &value
+	}
+	if value, ok := _c.mutation.RestoredAt(); ok {
+		_spec.SetField(auditlogarchive.FieldRestoredAt, field.TypeTime, value)
+		_node.RestoredAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AuditLogArchive.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AuditLogArchiveUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AuditLogArchiveCreate) OnConflict(opts ...sql.ConflictOption) *AuditLogArchiveUpsertOne {
+	_c.conflict = opts
+	return &AuditLogArchiveUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AuditLogArchive.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AuditLogArchiveCreate) OnConflictColumns(columns ...string) *AuditLogArchiveUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AuditLogArchiveUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// AuditLogArchiveUpsertOne is the builder for "upsert"-ing
+	//  one AuditLogArchive node.
+	AuditLogArchiveUpsertOne struct {
+		create *AuditLogArchiveCreate
+	}
+
+	// AuditLogArchiveUpsert is the "OnConflict" setter.
+	AuditLogArchiveUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AuditLogArchiveUpsert) SetUpdatedAt(v time.Time) *AuditLogArchiveUpsert {
+	u.Set(auditlogarchive.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsert) UpdateUpdatedAt() *AuditLogArchiveUpsert {
+	u.SetExcluded(auditlogarchive.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *AuditLogArchiveUpsert) ClearUpdatedAt() *AuditLogArchiveUpsert {
+	u.SetNull(auditlogarchive.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *AuditLogArchiveUpsert) SetDeletedAt(v time.Time) *AuditLogArchiveUpsert {
+	u.Set(auditlogarchive.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsert) UpdateDeletedAt() *AuditLogArchiveUpsert {
+	u.SetExcluded(auditlogarchive.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *AuditLogArchiveUpsert) ClearDeletedAt() *AuditLogArchiveUpsert {
+	u.SetNull(auditlogarchive.FieldDeletedAt)
+	return u
+}
+
+// SetLogType sets the "log_type" field.
+func (u *AuditLogArchiveUpsert) SetLogType(v auditlogarchive.LogType) *AuditLogArchiveUpsert {
+	u.Set(auditlogarchive.FieldLogType, v)
+	return u
+}
+
+// UpdateLogType sets the "log_type" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsert) UpdateLogType() *AuditLogArchiveUpsert {
+	u.SetExcluded(auditlogarchive.FieldLogType)
+	return u
+}
+
+// ClearLogType clears the value of the "log_type" field.
+func (u *AuditLogArchiveUpsert) ClearLogType() *AuditLogArchiveUpsert {
+	u.SetNull(auditlogarchive.FieldLogType)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *AuditLogArchiveUpsert) SetStatus(v auditlogarchive.Status) *AuditLogArchiveUpsert {
+	u.Set(auditlogarchive.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsert) UpdateStatus() *AuditLogArchiveUpsert {
+	u.SetExcluded(auditlogarchive.FieldStatus)
+	return u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *AuditLogArchiveUpsert) ClearStatus() *AuditLogArchiveUpsert {
+	u.SetNull(auditlogarchive.FieldStatus)
+	return u
+}
+
+// SetBucketName sets the "bucket_name" field.
+func (u *AuditLogArchiveUpsert) SetBucketName(v string) *AuditLogArchiveUpsert {
+	u.Set(auditlogarchive.FieldBucketName, v)
+	return u
+}
+
+// UpdateBucketName sets the "bucket_name" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsert) UpdateBucketName() *AuditLogArchiveUpsert {
+	u.SetExcluded(auditlogarchive.FieldBucketName)
+	return u
+}
+
+// ClearBucketName clears the value of the "bucket_name" field.
+func (u *AuditLogArchiveUpsert) ClearBucketName() *AuditLogArchiveUpsert {
+	u.SetNull(auditlogarchive.FieldBucketName)
+	return u
+}
+
+// SetObjectName sets the "object_name" field.
+func (u *AuditLogArchiveUpsert) SetObjectName(v string) *AuditLogArchiveUpsert {
+	u.Set(auditlogarchive.FieldObjectName, v)
+	return u
+}
+
+// UpdateObjectName sets the "object_name" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsert) UpdateObjectName() *AuditLogArchiveUpsert {
+	u.SetExcluded(auditlogarchive.FieldObjectName)
+	return u
+}
+
+// ClearObjectName clears the value of the "object_name" field.
+func (u *AuditLogArchiveUpsert) ClearObjectName() *AuditLogArchiveUpsert {
+	u.SetNull(auditlogarchive.FieldObjectName)
+	return u
+}
+
+// SetManifestObjectName sets the "manifest_object_name" field.
+func (u *AuditLogArchiveUpsert) SetManifestObjectName(v string) *AuditLogArchiveUpsert {
+	u.Set(auditlogarchive.FieldManifestObjectName, v)
+	return u
+}
+
+// UpdateManifestObjectName sets the "manifest_object_name" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsert) UpdateManifestObjectName() *AuditLogArchiveUpsert {
+	u.SetExcluded(auditlogarchive.FieldManifestObjectName)
+	return u
+}
+
+// ClearManifestObjectName clears the value of the "manifest_object_name" field.
+func (u *AuditLogArchiveUpsert) ClearManifestObjectName() *AuditLogArchiveUpsert {
+	u.SetNull(auditlogarchive.FieldManifestObjectName)
+	return u
+}
+
+// SetSha256 sets the "sha256" field.
+func (u *AuditLogArchiveUpsert) SetSha256(v string) *AuditLogArchiveUpsert {
+	u.Set(auditlogarchive.FieldSha256, v)
+	return u
+}
+
+// UpdateSha256 sets the "sha256" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsert) UpdateSha256() *AuditLogArchiveUpsert {
+	u.SetExcluded(auditlogarchive.FieldSha256)
+	return u
+}
+
+// ClearSha256 clears the value of the "sha256" field.
+func (u *AuditLogArchiveUpsert) ClearSha256() *AuditLogArchiveUpsert {
+	u.SetNull(auditlogarchive.FieldSha256)
+	return u
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (u *AuditLogArchiveUpsert) SetSizeBytes(v uint64) *AuditLogArchiveUpsert {
+	u.Set(auditlogarchive.FieldSizeBytes, v)
+	return u
+}
+
+// UpdateSizeBytes sets the "size_bytes" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsert) UpdateSizeBytes() *AuditLogArchiveUpsert {
+	u.SetExcluded(auditlogarchive.FieldSizeBytes)
+	return u
+}
+
+// AddSizeBytes adds v to the "size_bytes" field.
+func (u *AuditLogArchiveUpsert) AddSizeBytes(v uint64) *AuditLogArchiveUpsert {
+	u.Add(auditlogarchive.FieldSizeBytes, v)
+	return u
+}
+
+// ClearSizeBytes clears the value of the "size_bytes" field.
+func (u *AuditLogArchiveUpsert) ClearSizeBytes() *AuditLogArchiveUpsert {
+	u.SetNull(auditlogarchive.FieldSizeBytes)
+	return u
+}
+
+// SetRowCount sets the "row_count" field.
+func (u *AuditLogArchiveUpsert) SetRowCount(v uint64) *AuditLogArchiveUpsert {
+	u.Set(auditlogarchive.FieldRowCount, v)
+	return u
+}
+
+// UpdateRowCount sets the "row_count" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsert) UpdateRowCount() *AuditLogArchiveUpsert {
+	u.SetExcluded(auditlogarchive.FieldRowCount)
+	return u
+}
+
+// AddRowCount adds v to the "row_count" field.
+func (u *AuditLogArchiveUpsert) AddRowCount(v uint64) *AuditLogArchiveUpsert {
+	u.Add(auditlogarchive.FieldRowCount, v)
+	return u
+}
+
+// ClearRowCount clears the value of the "row_count" field.
+func (u *AuditLogArchiveUpsert) ClearRowCount() *AuditLogArchiveUpsert {
+	u.SetNull(auditlogarchive.FieldRowCount)
+	return u
+}
+
+// SetPurgedCount sets the "purged_count" field.
+func (u *AuditLogArchiveUpsert) SetPurgedCount(v uint64) *AuditLogArchiveUpsert {
+	u.Set(auditlogarchive.FieldPurgedCount, v)
+	return u
+}
+
+// UpdatePurgedCount sets the "purged_count" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsert) UpdatePurgedCount() *AuditLogArchiveUpsert {
+	u.SetExcluded(auditlogarchive.FieldPurgedCount)
+	return u
+}
+
+// AddPurgedCount adds v to the "purged_count" field.
+func (u *AuditLogArchiveUpsert) AddPurgedCount(v uint64) *AuditLogArchiveUpsert {
+	u.Add(auditlogarchive.FieldPurgedCount, v)
+	return u
+}
+
+// ClearPurgedCount clears the value of the "purged_count" field.
+func (u *AuditLogArchiveUpsert) ClearPurgedCount() *AuditLogArchiveUpsert {
+	u.SetNull(auditlogarchive.FieldPurgedCount)
+	return u
+}
+
+// SetMinLogID sets the "min_log_id" field.
+func (u *AuditLogArchiveUpsert) SetMinLogID(v uint32) *AuditLogArchiveUpsert {
+	u.Set(auditlogarchive.FieldMinLogID, v)
+	return u
+}
+
+// UpdateMinLogID sets the "min_log_id" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsert) UpdateMinLogID() *AuditLogArchiveUpsert {
+	u.SetExcluded(auditlogarchive.FieldMinLogID)
+	return u
+}
+
+// AddMinLogID adds v to the "min_log_id" field.
+func (u *AuditLogArchiveUpsert) AddMinLogID(v uint32) *AuditLogArchiveUpsert {
+	u.Add(auditlogarchive.FieldMinLogID, v)
+	return u
+}
+
+// ClearMinLogID clears the value of the "min_log_id" field.
+func (u *AuditLogArchiveUpsert) ClearMinLogID() *AuditLogArchiveUpsert {
+	u.SetNull(auditlogarchive.FieldMinLogID)
+	return u
+}
+
+// SetMaxLogID sets the "max_log_id" field.
+func (u *AuditLogArchiveUpsert) SetMaxLogID(v uint32) *AuditLogArchiveUpsert {
+	u.Set(auditlogarchive.FieldMaxLogID, v)
+	return u
+}
+
+// UpdateMaxLogID sets the "max_log_id" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsert) UpdateMaxLogID() *AuditLogArchiveUpsert {
+	u.SetExcluded(auditlogarchive.FieldMaxLogID)
+	return u
+}
+
+// AddMaxLogID adds v to the "max_log_id" field.
+func (u *AuditLogArchiveUpsert) AddMaxLogID(v uint32) *AuditLogArchiveUpsert {
+	u.Add(auditlogarchive.FieldMaxLogID, v)
+	return u
+}
+
+// ClearMaxLogID clears the value of the "max_log_id" field.
+func (u *AuditLogArchiveUpsert) ClearMaxLogID() *AuditLogArchiveUpsert {
+	u.SetNull(auditlogarchive.FieldMaxLogID)
+	return u
+}
+
+// SetCutoffTime sets the "cutoff_time" field.
+func (u *AuditLogArchiveUpsert) SetCutoffTime(v time.Time) *AuditLogArchiveUpsert {
+	u.Set(auditlogarchive.FieldCutoffTime, v)
+	return u
+}
+
+// UpdateCutoffTime sets the "cutoff_time" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsert) UpdateCutoffTime() *AuditLogArchiveUpsert {
+	u.SetExcluded(auditlogarchive.FieldCutoffTime)
+	return u
+}
+
+// ClearCutoffTime clears the value of the "cutoff_time" field.
+func (u *AuditLogArchiveUpsert) ClearCutoffTime() *AuditLogArchiveUpsert {
+	u.SetNull(auditlogarchive.FieldCutoffTime)
+	return u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *AuditLogArchiveUpsert) SetErrorMessage(v string) *AuditLogArchiveUpsert {
+	u.Set(auditlogarchive.FieldErrorMessage, v)
+	return u
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsert) UpdateErrorMessage() *AuditLogArchiveUpsert {
+	u.SetExcluded(auditlogarchive.FieldErrorMessage)
+	return u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *AuditLogArchiveUpsert) ClearErrorMessage() *AuditLogArchiveUpsert {
+	u.SetNull(auditlogarchive.FieldErrorMessage)
+	return u
+}
+
+// SetRestoredCount sets the "restored_count" field.
+func (u *AuditLogArchiveUpsert) SetRestoredCount(v uint64) *AuditLogArchiveUpsert {
+	u.Set(auditlogarchive.FieldRestoredCount, v)
+	return u
+}
+
+// UpdateRestoredCount sets the "restored_count" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsert) UpdateRestoredCount() *AuditLogArchiveUpsert {
+	u.SetExcluded(auditlogarchive.FieldRestoredCount)
+	return u
+}
+
+// AddRestoredCount adds v to the "restored_count" field.
+func (u *AuditLogArchiveUpsert) AddRestoredCount(v uint64) *AuditLogArchiveUpsert {
+	u.Add(auditlogarchive.FieldRestoredCount, v)
+	return u
+}
+
+// ClearRestoredCount clears the value of the "restored_count" field.
+func (u *AuditLogArchiveUpsert) ClearRestoredCount() *AuditLogArchiveUpsert {
+	u.SetNull(auditlogarchive.FieldRestoredCount)
+	return u
+}
+
+// SetRestoredAt sets the "restored_at" field.
+func (u *AuditLogArchiveUpsert) SetRestoredAt(v time.Time) *AuditLogArchiveUpsert {
+	u.Set(auditlogarchive.FieldRestoredAt, v)
+	return u
+}
+
+// UpdateRestoredAt sets the "restored_at" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsert) UpdateRestoredAt() *AuditLogArchiveUpsert {
+	u.SetExcluded(auditlogarchive.FieldRestoredAt)
+	return u
+}
+
+// ClearRestoredAt clears the value of the "restored_at" field.
+func (u *AuditLogArchiveUpsert) ClearRestoredAt() *AuditLogArchiveUpsert {
+	u.SetNull(auditlogarchive.FieldRestoredAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AuditLogArchive.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(auditlogarchive.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AuditLogArchiveUpsertOne) UpdateNewValues() *AuditLogArchiveUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(auditlogarchive.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(auditlogarchive.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(auditlogarchive.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AuditLogArchive.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AuditLogArchiveUpsertOne) Ignore() *AuditLogArchiveUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AuditLogArchiveUpsertOne) DoNothing() *AuditLogArchiveUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AuditLogArchiveCreate.OnConflict
+// documentation for more info.
+func (u *AuditLogArchiveUpsertOne) Update(set func(*AuditLogArchiveUpsert)) *AuditLogArchiveUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AuditLogArchiveUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AuditLogArchiveUpsertOne) SetUpdatedAt(v time.Time) *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsertOne) UpdateUpdatedAt() *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *AuditLogArchiveUpsertOne) ClearUpdatedAt() *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *AuditLogArchiveUpsertOne) SetDeletedAt(v time.Time) *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsertOne) UpdateDeletedAt() *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *AuditLogArchiveUpsertOne) ClearDeletedAt() *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetLogType sets the "log_type" field.
+func (u *AuditLogArchiveUpsertOne) SetLogType(v auditlogarchive.LogType) *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.SetLogType(v)
+	})
+}
+
+// UpdateLogType sets the "log_type" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsertOne) UpdateLogType() *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.UpdateLogType()
+	})
+}
+
+// ClearLogType clears the value of the "log_type" field.
+func (u *AuditLogArchiveUpsertOne) ClearLogType() *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.ClearLogType()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *AuditLogArchiveUpsertOne) SetStatus(v auditlogarchive.Status) *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsertOne) UpdateStatus() *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *AuditLogArchiveUpsertOne) ClearStatus() *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.ClearStatus()
+	})
+}
+
+// SetBucketName sets the "bucket_name" field.
+func (u *AuditLogArchiveUpsertOne) SetBucketName(v string) *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.SetBucketName(v)
+	})
+}
+
+// UpdateBucketName sets the "bucket_name" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsertOne) UpdateBucketName() *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.UpdateBucketName()
+	})
+}
+
+// ClearBucketName clears the value of the "bucket_name" field.
+func (u *AuditLogArchiveUpsertOne) ClearBucketName() *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.ClearBucketName()
+	})
+}
+
+// SetObjectName sets the "object_name" field.
+func (u *AuditLogArchiveUpsertOne) SetObjectName(v string) *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.SetObjectName(v)
+	})
+}
+
+// UpdateObjectName sets the "object_name" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsertOne) UpdateObjectName() *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.UpdateObjectName()
+	})
+}
+
+// ClearObjectName clears the value of the "object_name" field.
+func (u *AuditLogArchiveUpsertOne) ClearObjectName() *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.ClearObjectName()
+	})
+}
+
+// SetManifestObjectName sets the "manifest_object_name" field.
+func (u *AuditLogArchiveUpsertOne) SetManifestObjectName(v string) *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.SetManifestObjectName(v)
+	})
+}
+
+// UpdateManifestObjectName sets the "manifest_object_name" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsertOne) UpdateManifestObjectName() *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.UpdateManifestObjectName()
+	})
+}
+
+// ClearManifestObjectName clears the value of the "manifest_object_name" field.
+func (u *AuditLogArchiveUpsertOne) ClearManifestObjectName() *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.ClearManifestObjectName()
+	})
+}
+
+// SetSha256 sets the "sha256" field.
+func (u *AuditLogArchiveUpsertOne) SetSha256(v string) *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.SetSha256(v)
+	})
+}
+
+// UpdateSha256 sets the "sha256" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsertOne) UpdateSha256() *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.UpdateSha256()
+	})
+}
+
+// ClearSha256 clears the value of the "sha256" field.
+func (u *AuditLogArchiveUpsertOne) ClearSha256() *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.ClearSha256()
+	})
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (u *AuditLogArchiveUpsertOne) SetSizeBytes(v uint64) *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.SetSizeBytes(v)
+	})
+}
+
+// AddSizeBytes adds v to the "size_bytes" field.
+func (u *AuditLogArchiveUpsertOne) AddSizeBytes(v uint64) *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.AddSizeBytes(v)
+	})
+}
+
+// UpdateSizeBytes sets the "size_bytes" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsertOne) UpdateSizeBytes() *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.UpdateSizeBytes()
+	})
+}
+
+// ClearSizeBytes clears the value of the "size_bytes" field.
+func (u *AuditLogArchiveUpsertOne) ClearSizeBytes() *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.ClearSizeBytes()
+	})
+}
+
+// SetRowCount sets the "row_count" field.
+func (u *AuditLogArchiveUpsertOne) SetRowCount(v uint64) *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.SetRowCount(v)
+	})
+}
+
+// AddRowCount adds v to the "row_count" field.
+func (u *AuditLogArchiveUpsertOne) AddRowCount(v uint64) *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.AddRowCount(v)
+	})
+}
+
+// UpdateRowCount sets the "row_count" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsertOne) UpdateRowCount() *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.UpdateRowCount()
+	})
+}
+
+// ClearRowCount clears the value of the "row_count" field.
+func (u *AuditLogArchiveUpsertOne) ClearRowCount() *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.ClearRowCount()
+	})
+}
+
+// SetPurgedCount sets the "purged_count" field.
+func (u *AuditLogArchiveUpsertOne) SetPurgedCount(v uint64) *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.SetPurgedCount(v)
+	})
+}
+
+// AddPurgedCount adds v to the "purged_count" field.
+func (u *AuditLogArchiveUpsertOne) AddPurgedCount(v uint64) *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.AddPurgedCount(v)
+	})
+}
+
+// UpdatePurgedCount sets the "purged_count" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsertOne) UpdatePurgedCount() *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.UpdatePurgedCount()
+	})
+}
+
+// ClearPurgedCount clears the value of the "purged_count" field.
+func (u *AuditLogArchiveUpsertOne) ClearPurgedCount() *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.ClearPurgedCount()
+	})
+}
+
+// SetMinLogID sets the "min_log_id" field.
+func (u *AuditLogArchiveUpsertOne) SetMinLogID(v uint32) *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.SetMinLogID(v)
+	})
+}
+
+// AddMinLogID adds v to the "min_log_id" field.
+func (u *AuditLogArchiveUpsertOne) AddMinLogID(v uint32) *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.AddMinLogID(v)
+	})
+}
+
+// UpdateMinLogID sets the "min_log_id" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsertOne) UpdateMinLogID() *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.UpdateMinLogID()
+	})
+}
+
+// ClearMinLogID clears the value of the "min_log_id" field.
+func (u *AuditLogArchiveUpsertOne) ClearMinLogID() *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.ClearMinLogID()
+	})
+}
+
+// SetMaxLogID sets the "max_log_id" field.
+func (u *AuditLogArchiveUpsertOne) SetMaxLogID(v uint32) *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.SetMaxLogID(v)
+	})
+}
+
+// AddMaxLogID adds v to the "max_log_id" field.
+func (u *AuditLogArchiveUpsertOne) AddMaxLogID(v uint32) *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.AddMaxLogID(v)
+	})
+}
+
+// UpdateMaxLogID sets the "max_log_id" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsertOne) UpdateMaxLogID() *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.UpdateMaxLogID()
+	})
+}
+
+// ClearMaxLogID clears the value of the "max_log_id" field.
+func (u *AuditLogArchiveUpsertOne) ClearMaxLogID() *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.ClearMaxLogID()
+	})
+}
+
+// SetCutoffTime sets the "cutoff_time" field.
+func (u *AuditLogArchiveUpsertOne) SetCutoffTime(v time.Time) *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.SetCutoffTime(v)
+	})
+}
+
+// UpdateCutoffTime sets the "cutoff_time" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsertOne) UpdateCutoffTime() *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.UpdateCutoffTime()
+	})
+}
+
+// ClearCutoffTime clears the value of the "cutoff_time" field.
+func (u *AuditLogArchiveUpsertOne) ClearCutoffTime() *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.ClearCutoffTime()
+	})
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *AuditLogArchiveUpsertOne) SetErrorMessage(v string) *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.SetErrorMessage(v)
+	})
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsertOne) UpdateErrorMessage() *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.UpdateErrorMessage()
+	})
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *AuditLogArchiveUpsertOne) ClearErrorMessage() *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.ClearErrorMessage()
+	})
+}
+
+// SetRestoredCount sets the "restored_count" field.
+func (u *AuditLogArchiveUpsertOne) SetRestoredCount(v uint64) *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.SetRestoredCount(v)
+	})
+}
+
+// AddRestoredCount adds v to the "restored_count" field.
+func (u *AuditLogArchiveUpsertOne) AddRestoredCount(v uint64) *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.AddRestoredCount(v)
+	})
+}
+
+// UpdateRestoredCount sets the "restored_count" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsertOne) UpdateRestoredCount() *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.UpdateRestoredCount()
+	})
+}
+
+// ClearRestoredCount clears the value of the "restored_count" field.
+func (u *AuditLogArchiveUpsertOne) ClearRestoredCount() *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.ClearRestoredCount()
+	})
+}
+
+// SetRestoredAt sets the "restored_at" field.
+func (u *AuditLogArchiveUpsertOne) SetRestoredAt(v time.Time) *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.SetRestoredAt(v)
+	})
+}
+
+// UpdateRestoredAt sets the "restored_at" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsertOne) UpdateRestoredAt() *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.UpdateRestoredAt()
+	})
+}
+
+// ClearRestoredAt clears the value of the "restored_at" field.
+func (u *AuditLogArchiveUpsertOne) ClearRestoredAt() *AuditLogArchiveUpsertOne {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.ClearRestoredAt()
+	})
+}
+
+// Exec executes the query.
+func (u *AuditLogArchiveUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AuditLogArchiveCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AuditLogArchiveUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AuditLogArchiveUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AuditLogArchiveUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AuditLogArchiveCreateBulk is the builder for creating many AuditLogArchive entities in bulk.
+type AuditLogArchiveCreateBulk struct {
+	config
+	err      error
+	builders []*AuditLogArchiveCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AuditLogArchive entities in the database.
+func (_c *AuditLogArchiveCreateBulk) Save(ctx context.Context) ([]*AuditLogArchive, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AuditLogArchive, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditLogArchiveMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AuditLogArchiveCreateBulk) SaveX(ctx context.Context) []*AuditLogArchive {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AuditLogArchiveCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AuditLogArchiveCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AuditLogArchive.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AuditLogArchiveUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AuditLogArchiveCreateBulk) OnConflict(opts ...sql.ConflictOption) *AuditLogArchiveUpsertBulk {
+	_c.conflict = opts
+	return &AuditLogArchiveUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AuditLogArchive.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AuditLogArchiveCreateBulk) OnConflictColumns(columns ...string) *AuditLogArchiveUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AuditLogArchiveUpsertBulk{
+		create: _c,
+	}
+}
+
+// AuditLogArchiveUpsertBulk is the builder for "upsert"-ing
+// a bulk of AuditLogArchive nodes.
+type AuditLogArchiveUpsertBulk struct {
+	create *AuditLogArchiveCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AuditLogArchive.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(auditlogarchive.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AuditLogArchiveUpsertBulk) UpdateNewValues() *AuditLogArchiveUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(auditlogarchive.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(auditlogarchive.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(auditlogarchive.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AuditLogArchive.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AuditLogArchiveUpsertBulk) Ignore() *AuditLogArchiveUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AuditLogArchiveUpsertBulk) DoNothing() *AuditLogArchiveUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AuditLogArchiveCreateBulk.OnConflict
+// documentation for more info.
+func (u *AuditLogArchiveUpsertBulk) Update(set func(*AuditLogArchiveUpsert)) *AuditLogArchiveUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AuditLogArchiveUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AuditLogArchiveUpsertBulk) SetUpdatedAt(v time.Time) *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsertBulk) UpdateUpdatedAt() *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *AuditLogArchiveUpsertBulk) ClearUpdatedAt() *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *AuditLogArchiveUpsertBulk) SetDeletedAt(v time.Time) *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsertBulk) UpdateDeletedAt() *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *AuditLogArchiveUpsertBulk) ClearDeletedAt() *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetLogType sets the "log_type" field.
+func (u *AuditLogArchiveUpsertBulk) SetLogType(v auditlogarchive.LogType) *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.SetLogType(v)
+	})
+}
+
+// UpdateLogType sets the "log_type" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsertBulk) UpdateLogType() *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.UpdateLogType()
+	})
+}
+
+// ClearLogType clears the value of the "log_type" field.
+func (u *AuditLogArchiveUpsertBulk) ClearLogType() *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.ClearLogType()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *AuditLogArchiveUpsertBulk) SetStatus(v auditlogarchive.Status) *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsertBulk) UpdateStatus() *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *AuditLogArchiveUpsertBulk) ClearStatus() *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.ClearStatus()
+	})
+}
+
+// SetBucketName sets the "bucket_name" field.
+func (u *AuditLogArchiveUpsertBulk) SetBucketName(v string) *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.SetBucketName(v)
+	})
+}
+
+// UpdateBucketName sets the "bucket_name" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsertBulk) UpdateBucketName() *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.UpdateBucketName()
+	})
+}
+
+// ClearBucketName clears the value of the "bucket_name" field.
+func (u *AuditLogArchiveUpsertBulk) ClearBucketName() *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.ClearBucketName()
+	})
+}
+
+// SetObjectName sets the "object_name" field.
+func (u *AuditLogArchiveUpsertBulk) SetObjectName(v string) *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.SetObjectName(v)
+	})
+}
+
+// UpdateObjectName sets the "object_name" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsertBulk) UpdateObjectName() *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.UpdateObjectName()
+	})
+}
+
+// ClearObjectName clears the value of the "object_name" field.
+func (u *AuditLogArchiveUpsertBulk) ClearObjectName() *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.ClearObjectName()
+	})
+}
+
+// SetManifestObjectName sets the "manifest_object_name" field.
+func (u *AuditLogArchiveUpsertBulk) SetManifestObjectName(v string) *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.SetManifestObjectName(v)
+	})
+}
+
+// UpdateManifestObjectName sets the "manifest_object_name" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsertBulk) UpdateManifestObjectName() *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.UpdateManifestObjectName()
+	})
+}
+
+// ClearManifestObjectName clears the value of the "manifest_object_name" field.
+func (u *AuditLogArchiveUpsertBulk) ClearManifestObjectName() *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.ClearManifestObjectName()
+	})
+}
+
+// SetSha256 sets the "sha256" field.
+func (u *AuditLogArchiveUpsertBulk) SetSha256(v string) *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.SetSha256(v)
+	})
+}
+
+// UpdateSha256 sets the "sha256" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsertBulk) UpdateSha256() *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.UpdateSha256()
+	})
+}
+
+// ClearSha256 clears the value of the "sha256" field.
+func (u *AuditLogArchiveUpsertBulk) ClearSha256() *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.ClearSha256()
+	})
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (u *AuditLogArchiveUpsertBulk) SetSizeBytes(v uint64) *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.SetSizeBytes(v)
+	})
+}
+
+// AddSizeBytes adds v to the "size_bytes" field.
+func (u *AuditLogArchiveUpsertBulk) AddSizeBytes(v uint64) *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.AddSizeBytes(v)
+	})
+}
+
+// UpdateSizeBytes sets the "size_bytes" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsertBulk) UpdateSizeBytes() *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.UpdateSizeBytes()
+	})
+}
+
+// ClearSizeBytes clears the value of the "size_bytes" field.
+func (u *AuditLogArchiveUpsertBulk) ClearSizeBytes() *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.ClearSizeBytes()
+	})
+}
+
+// SetRowCount sets the "row_count" field.
+func (u *AuditLogArchiveUpsertBulk) SetRowCount(v uint64) *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.SetRowCount(v)
+	})
+}
+
+// AddRowCount adds v to the "row_count" field.
+func (u *AuditLogArchiveUpsertBulk) AddRowCount(v uint64) *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.AddRowCount(v)
+	})
+}
+
+// UpdateRowCount sets the "row_count" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsertBulk) UpdateRowCount() *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.UpdateRowCount()
+	})
+}
+
+// ClearRowCount clears the value of the "row_count" field.
+func (u *AuditLogArchiveUpsertBulk) ClearRowCount() *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.ClearRowCount()
+	})
+}
+
+// SetPurgedCount sets the "purged_count" field.
+func (u *AuditLogArchiveUpsertBulk) SetPurgedCount(v uint64) *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.SetPurgedCount(v)
+	})
+}
+
+// AddPurgedCount adds v to the "purged_count" field.
+func (u *AuditLogArchiveUpsertBulk) AddPurgedCount(v uint64) *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.AddPurgedCount(v)
+	})
+}
+
+// UpdatePurgedCount sets the "purged_count" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsertBulk) UpdatePurgedCount() *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.UpdatePurgedCount()
+	})
+}
+
+// ClearPurgedCount clears the value of the "purged_count" field.
+func (u *AuditLogArchiveUpsertBulk) ClearPurgedCount() *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.ClearPurgedCount()
+	})
+}
+
+// SetMinLogID sets the "min_log_id" field.
+func (u *AuditLogArchiveUpsertBulk) SetMinLogID(v uint32) *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.SetMinLogID(v)
+	})
+}
+
+// AddMinLogID adds v to the "min_log_id" field.
+func (u *AuditLogArchiveUpsertBulk) AddMinLogID(v uint32) *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.AddMinLogID(v)
+	})
+}
+
+// UpdateMinLogID sets the "min_log_id" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsertBulk) UpdateMinLogID() *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.UpdateMinLogID()
+	})
+}
+
+// ClearMinLogID clears the value of the "min_log_id" field.
+func (u *AuditLogArchiveUpsertBulk) ClearMinLogID() *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.ClearMinLogID()
+	})
+}
+
+// SetMaxLogID sets the "max_log_id" field.
+func (u *AuditLogArchiveUpsertBulk) SetMaxLogID(v uint32) *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.SetMaxLogID(v)
+	})
+}
+
+// AddMaxLogID adds v to the "max_log_id" field.
+func (u *AuditLogArchiveUpsertBulk) AddMaxLogID(v uint32) *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.AddMaxLogID(v)
+	})
+}
+
+// UpdateMaxLogID sets the "max_log_id" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsertBulk) UpdateMaxLogID() *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.UpdateMaxLogID()
+	})
+}
+
+// ClearMaxLogID clears the value of the "max_log_id" field.
+func (u *AuditLogArchiveUpsertBulk) ClearMaxLogID() *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.ClearMaxLogID()
+	})
+}
+
+// SetCutoffTime sets the "cutoff_time" field.
+func (u *AuditLogArchiveUpsertBulk) SetCutoffTime(v time.Time) *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.SetCutoffTime(v)
+	})
+}
+
+// UpdateCutoffTime sets the "cutoff_time" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsertBulk) UpdateCutoffTime() *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.UpdateCutoffTime()
+	})
+}
+
+// ClearCutoffTime clears the value of the "cutoff_time" field.
+func (u *AuditLogArchiveUpsertBulk) ClearCutoffTime() *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.ClearCutoffTime()
+	})
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *AuditLogArchiveUpsertBulk) SetErrorMessage(v string) *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.SetErrorMessage(v)
+	})
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsertBulk) UpdateErrorMessage() *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.UpdateErrorMessage()
+	})
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *AuditLogArchiveUpsertBulk) ClearErrorMessage() *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.ClearErrorMessage()
+	})
+}
+
+// SetRestoredCount sets the "restored_count" field.
+func (u *AuditLogArchiveUpsertBulk) SetRestoredCount(v uint64) *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.SetRestoredCount(v)
+	})
+}
+
+// AddRestoredCount adds v to the "restored_count" field.
+func (u *AuditLogArchiveUpsertBulk) AddRestoredCount(v uint64) *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.AddRestoredCount(v)
+	})
+}
+
+// UpdateRestoredCount sets the "restored_count" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsertBulk) UpdateRestoredCount() *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.UpdateRestoredCount()
+	})
+}
+
+// ClearRestoredCount clears the value of the "restored_count" field.
+func (u *AuditLogArchiveUpsertBulk) ClearRestoredCount() *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.ClearRestoredCount()
+	})
+}
+
+// SetRestoredAt sets the "restored_at" field.
+func (u *AuditLogArchiveUpsertBulk) SetRestoredAt(v time.Time) *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.SetRestoredAt(v)
+	})
+}
+
+// UpdateRestoredAt sets the "restored_at" field to the value that was provided on create.
+func (u *AuditLogArchiveUpsertBulk) UpdateRestoredAt() *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.UpdateRestoredAt()
+	})
+}
+
+// ClearRestoredAt clears the value of the "restored_at" field.
+func (u *AuditLogArchiveUpsertBulk) ClearRestoredAt() *AuditLogArchiveUpsertBulk {
+	return u.Update(func(s *AuditLogArchiveUpsert) {
+		s.ClearRestoredAt()
+	})
+}
+
+// Exec executes the query.
+func (u *AuditLogArchiveUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AuditLogArchiveCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AuditLogArchiveCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AuditLogArchiveUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1254 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/auditlogarchive"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AuditLogArchiveUpdate is the builder for updating AuditLogArchive entities.
+type AuditLogArchiveUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AuditLogArchiveMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AuditLogArchiveUpdate builder.
+func (_u *AuditLogArchiveUpdate) Where(ps ...predicate.AuditLogArchive) *AuditLogArchiveUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AuditLogArchiveUpdate) SetUpdatedAt(v time.Time) *AuditLogArchiveUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *AuditLogArchiveUpdate) SetNillableUpdatedAt(v *time.Time) *AuditLogArchiveUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *AuditLogArchiveUpdate) ClearUpdatedAt() *AuditLogArchiveUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *AuditLogArchiveUpdate) SetDeletedAt(v time.Time) *AuditLogArchiveUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *AuditLogArchiveUpdate) SetNillableDeletedAt(v *time.Time) *AuditLogArchiveUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *AuditLogArchiveUpdate) ClearDeletedAt() *AuditLogArchiveUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetLogType sets the "log_type" field.
+func (_u *AuditLogArchiveUpdate) SetLogType(v auditlogarchive.LogType) *AuditLogArchiveUpdate {
+	_u.mutation.SetLogType(v)
+	return _u
+}
+
+// SetNillableLogType sets the "log_type" field if the given value is not nil.
+func (_u *AuditLogArchiveUpdate) SetNillableLogType(v *auditlogarchive.LogType) *AuditLogArchiveUpdate {
+	if v != nil {
+		_u.SetLogType(*v)
+	}
+	return _u
+}
+
+// ClearLogType clears the value of the "log_type" field.
+func (_u *AuditLogArchiveUpdate) ClearLogType() *AuditLogArchiveUpdate {
+	_u.mutation.ClearLogType()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *AuditLogArchiveUpdate) SetStatus(v auditlogarchive.Status) *AuditLogArchiveUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *AuditLogArchiveUpdate) SetNillableStatus(v *auditlogarchive.Status) *AuditLogArchiveUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *AuditLogArchiveUpdate) ClearStatus() *AuditLogArchiveUpdate {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetBucketName sets the "bucket_name" field.
+func (_u *AuditLogArchiveUpdate) SetBucketName(v string) *AuditLogArchiveUpdate {
+	_u.mutation.SetBucketName(v)
+	return _u
+}
+
+// SetNillableBucketName sets the "bucket_name" field if the given value is not nil.
+func (_u *AuditLogArchiveUpdate) SetNillableBucketName(v *string) *AuditLogArchiveUpdate {
+	if v != nil {
+		_u.SetBucketName(*v)
+	}
+	return _u
+}
+
+// ClearBucketName clears the value of the "bucket_name" field.
+func (_u *AuditLogArchiveUpdate) ClearBucketName() *AuditLogArchiveUpdate {
+	_u.mutation.ClearBucketName()
+	return _u
+}
+
+// SetObjectName sets the "object_name" field.
+func (_u *AuditLogArchiveUpdate) SetObjectName(v string) *AuditLogArchiveUpdate {
+	_u.mutation.SetObjectName(v)
+	return _u
+}
+
+// SetNillableObjectName sets the "object_name" field if the given value is not nil.
+func (_u *AuditLogArchiveUpdate) SetNillableObjectName(v *string) *AuditLogArchiveUpdate {
+	if v != nil {
+		_u.SetObjectName(*v)
+	}
+	return _u
+}
+
+// ClearObjectName clears the value of the "object_name" field.
+func (_u *AuditLogArchiveUpdate) ClearObjectName() *AuditLogArchiveUpdate {
+	_u.mutation.ClearObjectName()
+	return _u
+}
+
+// SetManifestObjectName sets the "manifest_object_name" field.
+func (_u *AuditLogArchiveUpdate) SetManifestObjectName(v string) *AuditLogArchiveUpdate {
+	_u.mutation.SetManifestObjectName(v)
+	return _u
+}
+
+// SetNillableManifestObjectName sets the "manifest_object_name" field if the given value is not nil.
+func (_u *AuditLogArchiveUpdate) SetNillableManifestObjectName(v *string) *AuditLogArchiveUpdate {
+	if v != nil {
+		_u.SetManifestObjectName(*v)
+	}
+	return _u
+}
+
+// ClearManifestObjectName clears the value of the "manifest_object_name" field.
+func (_u *AuditLogArchiveUpdate) ClearManifestObjectName() *AuditLogArchiveUpdate {
+	_u.mutation.ClearManifestObjectName()
+	return _u
+}
+
+// SetSha256 sets the "sha256" field.
+func (_u *AuditLogArchiveUpdate) SetSha256(v string) *AuditLogArchiveUpdate {
+	_u.mutation.SetSha256(v)
+	return _u
+}
+
+// SetNillableSha256 sets the "sha256" field if the given value is not nil.
+func (_u *AuditLogArchiveUpdate) SetNillableSha256(v *string) *AuditLogArchiveUpdate {
+	if v != nil {
+		_u.SetSha256(*v)
+	}
+	return _u
+}
+
+// ClearSha256 clears the value of the "sha256" field.
+func (_u *AuditLogArchiveUpdate) ClearSha256() *AuditLogArchiveUpdate {
+	_u.mutation.ClearSha256()
+	return _u
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (_u *AuditLogArchiveUpdate) SetSizeBytes(v uint64) *AuditLogArchiveUpdate {
+	_u.mutation.ResetSizeBytes()
+	_u.mutation.SetSizeBytes(v)
+	return _u
+}
+
+// SetNillableSizeBytes sets the "size_bytes" field if the given value is not nil.
+func (_u *AuditLogArchiveUpdate) SetNillableSizeBytes(v *uint64) *AuditLogArchiveUpdate {
+	if v != nil {
+		_u.SetSizeBytes(*v)
+	}
+	return _u
+}
+
+// AddSizeBytes adds value to the "size_bytes" field.
+func (_u *AuditLogArchiveUpdate) AddSizeBytes(v int64) *AuditLogArchiveUpdate {
+	_u.mutation.AddSizeBytes(v)
+	return _u
+}
+
+// ClearSizeBytes clears the value of the "size_bytes" field.
+func (_u *AuditLogArchiveUpdate) ClearSizeBytes() *AuditLogArchiveUpdate {
+	_u.mutation.ClearSizeBytes()
+	return _u
+}
+
+// SetRowCount sets the "row_count" field.
+func (_u *AuditLogArchiveUpdate) SetRowCount(v uint64) *AuditLogArchiveUpdate {
+	_u.mutation.ResetRowCount()
+	_u.mutation.SetRowCount(v)
+	return _u
+}
+
+// SetNillableRowCount sets the "row_count" field if the given value is not nil.
+func (_u *AuditLogArchiveUpdate) SetNillableRowCount(v *uint64) *AuditLogArchiveUpdate {
+	if v != nil {
+		_u.SetRowCount(*v)
+	}
+	return _u
+}
+
+// AddRowCount adds value to the "row_count" field.
+func (_u *AuditLogArchiveUpdate) AddRowCount(v int64) *AuditLogArchiveUpdate {
+	_u.mutation.AddRowCount(v)
+	return _u
+}
+
+// ClearRowCount clears the value of the "row_count" field.
+func (_u *AuditLogArchiveUpdate) ClearRowCount() *AuditLogArchiveUpdate {
+	_u.mutation.ClearRowCount()
+	return _u
+}
+
+// SetPurgedCount sets the "purged_count" field.
+func (_u *AuditLogArchiveUpdate) SetPurgedCount(v uint64) *AuditLogArchiveUpdate {
+	_u.mutation.ResetPurgedCount()
+	_u.mutation.SetPurgedCount(v)
+	return _u
+}
+
+// SetNillablePurgedCount sets the "purged_count" field if the given value is not nil.
+func (_u *AuditLogArchiveUpdate) SetNillablePurgedCount(v *uint64) *AuditLogArchiveUpdate {
+	if v != nil {
+		_u.SetPurgedCount(*v)
+	}
+	return _u
+}
+
+// AddPurgedCount adds value to the "purged_count" field.
+func (_u *AuditLogArchiveUpdate) AddPurgedCount(v int64) *AuditLogArchiveUpdate {
+	_u.mutation.AddPurgedCount(v)
+	return _u
+}
+
+// ClearPurgedCount clears the value of the "purged_count" field.
+func (_u *AuditLogArchiveUpdate) ClearPurgedCount() *AuditLogArchiveUpdate {
+	_u.mutation.ClearPurgedCount()
+	return _u
+}
+
+// SetMinLogID sets the "min_log_id" field.
+func (_u *AuditLogArchiveUpdate) SetMinLogID(v uint32) *AuditLogArchiveUpdate {
+	_u.mutation.ResetMinLogID()
+	_u.mutation.SetMinLogID(v)
+	return _u
+}
+
+// SetNillableMinLogID sets the "min_log_id" field if the given value is not nil.
+func (_u *AuditLogArchiveUpdate) SetNillableMinLogID(v *uint32) *AuditLogArchiveUpdate {
+	if v != nil {
+		_u.SetMinLogID(*v)
+	}
+	return _u
+}
+
+// AddMinLogID adds value to the "min_log_id" field.
+func (_u *AuditLogArchiveUpdate) AddMinLogID(v int32) *AuditLogArchiveUpdate {
+	_u.mutation.AddMinLogID(v)
+	return _u
+}
+
+// ClearMinLogID clears the value of the "min_log_id" field.
+func (_u *AuditLogArchiveUpdate) ClearMinLogID() *AuditLogArchiveUpdate {
+	_u.mutation.ClearMinLogID()
+	return _u
+}
+
+// SetMaxLogID sets the "max_log_id" field.
+func (_u *AuditLogArchiveUpdate) SetMaxLogID(v uint32) *AuditLogArchiveUpdate {
+	_u.mutation.ResetMaxLogID()
+	_u.mutation.SetMaxLogID(v)
+	return _u
+}
+
+// SetNillableMaxLogID sets the "max_log_id" field if the given value is not nil.
+func (_u *AuditLogArchiveUpdate) SetNillableMaxLogID(v *uint32) *AuditLogArchiveUpdate {
+	if v != nil {
+		_u.SetMaxLogID(*v)
+	}
+	return _u
+}
+
+// AddMaxLogID adds value to the "max_log_id" field.
+func (_u *AuditLogArchiveUpdate) AddMaxLogID(v int32) *AuditLogArchiveUpdate {
+	_u.mutation.AddMaxLogID(v)
+	return _u
+}
+
+// ClearMaxLogID clears the value of the "max_log_id" field.
+func (_u *AuditLogArchiveUpdate) ClearMaxLogID() *AuditLogArchiveUpdate {
+	_u.mutation.ClearMaxLogID()
+	return _u
+}
+
+// SetCutoffTime sets the "cutoff_time" field.
+func (_u *AuditLogArchiveUpdate) SetCutoffTime(v time.Time) *AuditLogArchiveUpdate {
+	_u.mutation.SetCutoffTime(v)
+	return _u
+}
+
+// SetNillableCutoffTime sets the "cutoff_time" field if the given value is not nil.
+func (_u *AuditLogArchiveUpdate) SetNillableCutoffTime(v *time.Time) *AuditLogArchiveUpdate {
+	if v != nil {
+		_u.SetCutoffTime(*v)
+	}
+	return _u
+}
+
+// ClearCutoffTime clears the value of the "cutoff_time" field.
+func (_u *AuditLogArchiveUpdate) ClearCutoffTime() *AuditLogArchiveUpdate {
+	_u.mutation.ClearCutoffTime()
+	return _u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_u *AuditLogArchiveUpdate) SetErrorMessage(v string) *AuditLogArchiveUpdate {
+	_u.mutation.SetErrorMessage(v)
+	return _u
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_u *AuditLogArchiveUpdate) SetNillableErrorMessage(v *string) *AuditLogArchiveUpdate {
+	if v != nil {
+		_u.SetErrorMessage(*v)
+	}
+	return _u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (_u *AuditLogArchiveUpdate) ClearErrorMessage() *AuditLogArchiveUpdate {
+	_u.mutation.ClearErrorMessage()
+	return _u
+}
+
+// SetRestoredCount sets the "restored_count" field.
+func (_u *AuditLogArchiveUpdate) SetRestoredCount(v uint64) *AuditLogArchiveUpdate {
+	_u.mutation.ResetRestoredCount()
+	_u.mutation.SetRestoredCount(v)
+	return _u
+}
+
+// SetNillableRestoredCount sets the "restored_count" field if the given value is not nil.
+func (_u *AuditLogArchiveUpdate) SetNillableRestoredCount(v *uint64) *AuditLogArchiveUpdate {
+	if v != nil {
+		_u.SetRestoredCount(*v)
+	}
+	return _u
+}
+
+// AddRestoredCount adds value to the "restored_count" field.
+func (_u *AuditLogArchiveUpdate) AddRestoredCount(v int64) *AuditLogArchiveUpdate {
+	_u.mutation.AddRestoredCount(v)
+	return _u
+}
+
+// ClearRestoredCount clears the value of the "restored_count" field.
+func (_u *AuditLogArchiveUpdate) ClearRestoredCount() *AuditLogArchiveUpdate {
+	_u.mutation.ClearRestoredCount()
+	return _u
+}
+
+// SetRestoredAt sets the "restored_at" field.
+func (_u *AuditLogArchiveUpdate) SetRestoredAt(v time.Time) *AuditLogArchiveUpdate {
+	_u.mutation.SetRestoredAt(v)
+	return _u
+}
+
+// SetNillableRestoredAt sets the "restored_at" field if the given value is not nil.
+func (_u *AuditLogArchiveUpdate) SetNillableRestoredAt(v *time.Time) *AuditLogArchiveUpdate {
+	if v != nil {
+		_u.SetRestoredAt(*v)
+	}
+	return _u
+}
+
+// ClearRestoredAt clears the value of the "restored_at" field.
+func (_u *AuditLogArchiveUpdate) ClearRestoredAt() *AuditLogArchiveUpdate {
+	_u.mutation.ClearRestoredAt()
+	return _u
+}
+
+// Mutation returns the AuditLogArchiveMutation object of the builder.
+func (_u *AuditLogArchiveUpdate) Mutation() *AuditLogArchiveMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AuditLogArchiveUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuditLogArchiveUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AuditLogArchiveUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuditLogArchiveUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AuditLogArchiveUpdate) check() error {
+	if v, ok := _u.mutation.LogType(); ok {
+		if err := auditlogarchive.LogTypeValidator(v); err != nil {
+			return &ValidationError{Name: "log_type", err: fmt.Errorf(`ent: validator failed for field "AuditLogArchive.log_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := auditlogarchive.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "AuditLogArchive.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Sha256(); ok {
+		if err := auditlogarchive.Sha256Validator(v); err != nil {
+			return &ValidationError{Name: "sha256", err: fmt.Errorf(`ent: validator failed for field "AuditLogArchive.sha256": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AuditLogArchiveUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AuditLogArchiveUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AuditLogArchiveUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(auditlogarchive.Table, auditlogarchive.Columns, sqlgraph.NewFieldSpec(auditlogarchive.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(auditlogarchive.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(auditlogarchive.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(auditlogarchive.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(auditlogarchive.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(auditlogarchive.FieldDeletedAt, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(auditlogarchive.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.LogType(); ok {
+		_spec.SetField(auditlogarchive.FieldLogType, field.TypeEnum, value)
+	}
+	if _u.mutation.LogTypeCleared() {
+		_spec.ClearField(auditlogarchive.FieldLogType, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(auditlogarchive.FieldStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(auditlogarchive.FieldStatus, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.BucketName(); ok {
+		_spec.SetField(auditlogarchive.FieldBucketName, field.TypeString, value)
+	}
+	if _u.mutation.BucketNameCleared() {
+		_spec.ClearField(auditlogarchive.FieldBucketName, field.TypeString)
+	}
+	if value, ok := _u.mutation.ObjectName(); ok {
+		_spec.SetField(auditlogarchive.FieldObjectName, field.TypeString, value)
+	}
+	if _u.mutation.ObjectNameCleared() {
+		_spec.ClearField(auditlogarchive.FieldObjectName, field.TypeString)
+	}
+	if value, ok := _u.mutation.ManifestObjectName(); ok {
+		_spec.SetField(auditlogarchive.FieldManifestObjectName, field.TypeString, value)
+	}
+	if _u.mutation.ManifestObjectNameCleared() {
+		_spec.ClearField(auditlogarchive.FieldManifestObjectName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Sha256(); ok {
+		_spec.SetField(auditlogarchive.FieldSha256, field.TypeString, value)
+	}
+	if _u.mutation.Sha256Cleared() {
+		_spec.ClearField(auditlogarchive.FieldSha256, field.TypeString)
+	}
+	if value, ok := _u.mutation.SizeBytes(); ok {
+		_spec.SetField(auditlogarchive.FieldSizeBytes, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedSizeBytes(); ok {
+		_spec.AddField(auditlogarchive.FieldSizeBytes, field.TypeUint64, value)
+	}
+	if _u.mutation.SizeBytesCleared() {
+		_spec.ClearField(auditlogarchive.FieldSizeBytes, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.RowCount(); ok {
+		_spec.SetField(auditlogarchive.FieldRowCount, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedRowCount(); ok {
+		_spec.AddField(auditlogarchive.FieldRowCount, field.TypeUint64, value)
+	}
+	if _u.mutation.RowCountCleared() {
+		_spec.ClearField(auditlogarchive.FieldRowCount, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.PurgedCount(); ok {
+		_spec.SetField(auditlogarchive.FieldPurgedCount, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedPurgedCount(); ok {
+		_spec.AddField(auditlogarchive.FieldPurgedCount, field.TypeUint64, value)
+	}
+	if _u.mutation.PurgedCountCleared() {
+		_spec.ClearField(auditlogarchive.FieldPurgedCount, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.MinLogID(); ok {
+		_spec.SetField(auditlogarchive.FieldMinLogID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedMinLogID(); ok {
+		_spec.AddField(auditlogarchive.FieldMinLogID, field.TypeUint32, value)
+	}
+	if _u.mutation.MinLogIDCleared() {
+		_spec.ClearField(auditlogarchive.FieldMinLogID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.MaxLogID(); ok {
+		_spec.SetField(auditlogarchive.FieldMaxLogID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedMaxLogID(); ok {
+		_spec.AddField(auditlogarchive.FieldMaxLogID, field.TypeUint32, value)
+	}
+	if _u.mutation.MaxLogIDCleared() {
+		_spec.ClearField(auditlogarchive.FieldMaxLogID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.CutoffTime(); ok {
+		_spec.SetField(auditlogarchive.FieldCutoffTime, field.TypeTime, value)
+	}
+	if _u.mutation.CutoffTimeCleared() {
+		_spec.ClearField(auditlogarchive.FieldCutoffTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ErrorMessage(); ok {
+		_spec.SetField(auditlogarchive.FieldErrorMessage, field.TypeString, value)
+	}
+	if _u.mutation.ErrorMessageCleared() {
+		_spec.ClearField(auditlogarchive.FieldErrorMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.RestoredCount(); ok {
+		_spec.SetField(auditlogarchive.FieldRestoredCount, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedRestoredCount(); ok {
+		_spec.AddField(auditlogarchive.FieldRestoredCount, field.TypeUint64, value)
+	}
+	if _u.mutation.RestoredCountCleared() {
+		_spec.ClearField(auditlogarchive.FieldRestoredCount, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.RestoredAt(); ok {
+		_spec.SetField(auditlogarchive.FieldRestoredAt, field.TypeTime, value)
+	}
+	if _u.mutation.RestoredAtCleared() {
+		_spec.ClearField(auditlogarchive.FieldRestoredAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlogarchive.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AuditLogArchiveUpdateOne is the builder for updating a single AuditLogArchive entity.
+type AuditLogArchiveUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AuditLogArchiveMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AuditLogArchiveUpdateOne) SetUpdatedAt(v time.Time) *AuditLogArchiveUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *AuditLogArchiveUpdateOne) SetNillableUpdatedAt(v *time.Time) *AuditLogArchiveUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *AuditLogArchiveUpdateOne) ClearUpdatedAt() *AuditLogArchiveUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *AuditLogArchiveUpdateOne) SetDeletedAt(v time.Time) *AuditLogArchiveUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *AuditLogArchiveUpdateOne) SetNillableDeletedAt(v *time.Time) *AuditLogArchiveUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *AuditLogArchiveUpdateOne) ClearDeletedAt() *AuditLogArchiveUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetLogType sets the "log_type" field.
+func (_u *AuditLogArchiveUpdateOne) SetLogType(v auditlogarchive.LogType) *AuditLogArchiveUpdateOne {
+	_u.mutation.SetLogType(v)
+	return _u
+}
+
+// SetNillableLogType sets the "log_type" field if the given value is not nil.
+func (_u *AuditLogArchiveUpdateOne) SetNillableLogType(v *auditlogarchive.LogType) *AuditLogArchiveUpdateOne {
+	if v != nil {
+		_u.SetLogType(*v)
+	}
+	return _u
+}
+
+// ClearLogType clears the value of the "log_type" field.
+func (_u *AuditLogArchiveUpdateOne) ClearLogType() *AuditLogArchiveUpdateOne {
+	_u.mutation.ClearLogType()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *AuditLogArchiveUpdateOne) SetStatus(v auditlogarchive.Status) *AuditLogArchiveUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *AuditLogArchiveUpdateOne) SetNillableStatus(v *auditlogarchive.Status) *AuditLogArchiveUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *AuditLogArchiveUpdateOne) ClearStatus() *AuditLogArchiveUpdateOne {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetBucketName sets the "bucket_name" field.
+func (_u *AuditLogArchiveUpdateOne) SetBucketName(v string) *AuditLogArchiveUpdateOne {
+	_u.mutation.SetBucketName(v)
+	return _u
+}
+
+// SetNillableBucketName sets the "bucket_name" field if the given value is not nil.
+func (_u *AuditLogArchiveUpdateOne) SetNillableBucketName(v *string) *AuditLogArchiveUpdateOne {
+	if v != nil {
+		_u.SetBucketName(*v)
+	}
+	return _u
+}
+
+// ClearBucketName clears the value of the "bucket_name" field.
+func (_u *AuditLogArchiveUpdateOne) ClearBucketName() *AuditLogArchiveUpdateOne {
+	_u.mutation.ClearBucketName()
+	return _u
+}
+
+// SetObjectName sets the "object_name" field.
+func (_u *AuditLogArchiveUpdateOne) SetObjectName(v string) *AuditLogArchiveUpdateOne {
+	_u.mutation.SetObjectName(v)
+	return _u
+}
+
+// SetNillableObjectName sets the "object_name" field if the given value is not nil.
+func (_u *AuditLogArchiveUpdateOne) SetNillableObjectName(v *string) *AuditLogArchiveUpdateOne {
+	if v != nil {
+		_u.SetObjectName(*v)
+	}
+	return _u
+}
+
+// ClearObjectName clears the value of the "object_name" field.
+func (_u *AuditLogArchiveUpdateOne) ClearObjectName() *AuditLogArchiveUpdateOne {
+	_u.mutation.ClearObjectName()
+	return _u
+}
+
+// SetManifestObjectName sets the "manifest_object_name" field.
+func (_u *AuditLogArchiveUpdateOne) SetManifestObjectName(v string) *AuditLogArchiveUpdateOne {
+	_u.mutation.SetManifestObjectName(v)
+	return _u
+}
+
+// SetNillableManifestObjectName sets the "manifest_object_name" field if the given value is not nil.
+func (_u *AuditLogArchiveUpdateOne) SetNillableManifestObjectName(v *string) *AuditLogArchiveUpdateOne {
+	if v != nil {
+		_u.SetManifestObjectName(*v)
+	}
+	return _u
+}
+
+// ClearManifestObjectName clears the value of the "manifest_object_name" field.
+func (_u *AuditLogArchiveUpdateOne) ClearManifestObjectName() *AuditLogArchiveUpdateOne {
+	_u.mutation.ClearManifestObjectName()
+	return _u
+}
+
+// SetSha256 sets the "sha256" field.
+func (_u *AuditLogArchiveUpdateOne) SetSha256(v string) *AuditLogArchiveUpdateOne {
+	_u.mutation.SetSha256(v)
+	return _u
+}
+
+// SetNillableSha256 sets the "sha256" field if the given value is not nil.
+func (_u *AuditLogArchiveUpdateOne) SetNillableSha256(v *string) *AuditLogArchiveUpdateOne {
+	if v != nil {
+		_u.SetSha256(*v)
+	}
+	return _u
+}
+
+// ClearSha256 clears the value of the "sha256" field.
+func (_u *AuditLogArchiveUpdateOne) ClearSha256() *AuditLogArchiveUpdateOne {
+	_u.mutation.ClearSha256()
+	return _u
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (_u *AuditLogArchiveUpdateOne) SetSizeBytes(v uint64) *AuditLogArchiveUpdateOne {
+	_u.mutation.ResetSizeBytes()
+	_u.mutation.SetSizeBytes(v)
+	return _u
+}
+
+// SetNillableSizeBytes sets the "size_bytes" field if the given value is not nil.
+func (_u *AuditLogArchiveUpdateOne) SetNillableSizeBytes(v *uint64) *AuditLogArchiveUpdateOne {
+	if v != nil {
+		_u.SetSizeBytes(*v)
+	}
+	return _u
+}
+
+// AddSizeBytes adds value to the "size_bytes" field.
+func (_u *AuditLogArchiveUpdateOne) AddSizeBytes(v int64) *AuditLogArchiveUpdateOne {
+	_u.mutation.AddSizeBytes(v)
+	return _u
+}
+
+// ClearSizeBytes clears the value of the "size_bytes" field.
+func (_u *AuditLogArchiveUpdateOne) ClearSizeBytes() *AuditLogArchiveUpdateOne {
+	_u.mutation.ClearSizeBytes()
+	return _u
+}
+
+// SetRowCount sets the "row_count" field.
+func (_u *AuditLogArchiveUpdateOne) SetRowCount(v uint64) *AuditLogArchiveUpdateOne {
+	_u.mutation.ResetRowCount()
+	_u.mutation.SetRowCount(v)
+	return _u
+}
+
+// SetNillableRowCount sets the "row_count" field if the given value is not nil.
+func (_u *AuditLogArchiveUpdateOne) SetNillableRowCount(v *uint64) *AuditLogArchiveUpdateOne {
+	if v != nil {
+		_u.SetRowCount(*v)
+	}
+	return _u
+}
+
+// AddRowCount adds value to the "row_count" field.
+func (_u *AuditLogArchiveUpdateOne) AddRowCount(v int64) *AuditLogArchiveUpdateOne {
+	_u.mutation.AddRowCount(v)
+	return _u
+}
+
+// ClearRowCount clears the value of the "row_count" field.
+func (_u *AuditLogArchiveUpdateOne) ClearRowCount() *AuditLogArchiveUpdateOne {
+	_u.mutation.ClearRowCount()
+	return _u
+}
+
+// SetPurgedCount sets the "purged_count" field.
+func (_u *AuditLogArchiveUpdateOne) SetPurgedCount(v uint64) *AuditLogArchiveUpdateOne {
+	_u.mutation.ResetPurgedCount()
+	_u.mutation.SetPurgedCount(v)
+	return _u
+}
+
+// SetNillablePurgedCount sets the "purged_count" field if the given value is not nil.
+func (_u *AuditLogArchiveUpdateOne) SetNillablePurgedCount(v *uint64) *AuditLogArchiveUpdateOne {
+	if v != nil {
+		_u.SetPurgedCount(*v)
+	}
+	return _u
+}
+
+// AddPurgedCount adds value to the "purged_count" field.
+func (_u *AuditLogArchiveUpdateOne) AddPurgedCount(v int64) *AuditLogArchiveUpdateOne {
+	_u.mutation.AddPurgedCount(v)
+	return _u
+}
+
+// ClearPurgedCount clears the value of the "purged_count" field.
+func (_u *AuditLogArchiveUpdateOne) ClearPurgedCount() *AuditLogArchiveUpdateOne {
+	_u.mutation.ClearPurgedCount()
+	return _u
+}
+
+// SetMinLogID sets the "min_log_id" field.
+func (_u *AuditLogArchiveUpdateOne) SetMinLogID(v uint32) *AuditLogArchiveUpdateOne {
+	_u.mutation.ResetMinLogID()
+	_u.mutation.SetMinLogID(v)
+	return _u
+}
+
+// SetNillableMinLogID sets the "min_log_id" field if the given value is not nil.
+func (_u *AuditLogArchiveUpdateOne) SetNillableMinLogID(v *uint32) *AuditLogArchiveUpdateOne {
+	if v != nil {
+		_u.SetMinLogID(*v)
+	}
+	return _u
+}
+
+// AddMinLogID adds value to the "min_log_id" field.
+func (_u *AuditLogArchiveUpdateOne) AddMinLogID(v int32) *AuditLogArchiveUpdateOne {
+	_u.mutation.AddMinLogID(v)
+	return _u
+}
+
+// ClearMinLogID clears the value of the "min_log_id" field.
+func (_u *AuditLogArchiveUpdateOne) ClearMinLogID() *AuditLogArchiveUpdateOne {
+	_u.mutation.ClearMinLogID()
+	return _u
+}
+
+// SetMaxLogID sets the "max_log_id" field.
+func (_u *AuditLogArchiveUpdateOne) SetMaxLogID(v uint32) *AuditLogArchiveUpdateOne {
+	_u.mutation.ResetMaxLogID()
+	_u.mutation.SetMaxLogID(v)
+	return _u
+}
+
+// SetNillableMaxLogID sets the "max_log_id" field if the given value is not nil.
+func (_u *AuditLogArchiveUpdateOne) SetNillableMaxLogID(v *uint32) *AuditLogArchiveUpdateOne {
+	if v != nil {
+		_u.SetMaxLogID(*v)
+	}
+	return _u
+}
+
+// AddMaxLogID adds value to the "max_log_id" field.
+func (_u *AuditLogArchiveUpdateOne) AddMaxLogID(v int32) *AuditLogArchiveUpdateOne {
+	_u.mutation.AddMaxLogID(v)
+	return _u
+}
+
+// ClearMaxLogID clears the value of the "max_log_id" field.
+func (_u *AuditLogArchiveUpdateOne) ClearMaxLogID() *AuditLogArchiveUpdateOne {
+	_u.mutation.ClearMaxLogID()
+	return _u
+}
+
+// SetCutoffTime sets the "cutoff_time" field.
+func (_u *AuditLogArchiveUpdateOne) SetCutoffTime(v time.Time) *AuditLogArchiveUpdateOne {
+	_u.mutation.SetCutoffTime(v)
+	return _u
+}
+
+// SetNillableCutoffTime sets the "cutoff_time" field if the given value is not nil.
+func (_u *AuditLogArchiveUpdateOne) SetNillableCutoffTime(v *time.Time) *AuditLogArchiveUpdateOne {
+	if v != nil {
+		_u.SetCutoffTime(*v)
+	}
+	return _u
+}
+
+// ClearCutoffTime clears the value of the "cutoff_time" field.
+func (_u *AuditLogArchiveUpdateOne) ClearCutoffTime() *AuditLogArchiveUpdateOne {
+	_u.mutation.ClearCutoffTime()
+	return _u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_u *AuditLogArchiveUpdateOne) SetErrorMessage(v string) *AuditLogArchiveUpdateOne {
+	_u.mutation.SetErrorMessage(v)
+	return _u
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_u *AuditLogArchiveUpdateOne) SetNillableErrorMessage(v *string) *AuditLogArchiveUpdateOne {
+	if v != nil {
+		_u.SetErrorMessage(*v)
+	}
+	return _u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (_u *AuditLogArchiveUpdateOne) ClearErrorMessage() *AuditLogArchiveUpdateOne {
+	_u.mutation.ClearErrorMessage()
+	return _u
+}
+
+// SetRestoredCount sets the "restored_count" field.
+func (_u *AuditLogArchiveUpdateOne) SetRestoredCount(v uint64) *AuditLogArchiveUpdateOne {
+	_u.mutation.ResetRestoredCount()
+	_u.mutation.SetRestoredCount(v)
+	return _u
+}
+
+// SetNillableRestoredCount sets the "restored_count" field if the given value is not nil.
+func (_u *AuditLogArchiveUpdateOne) SetNillableRestoredCount(v *uint64) *AuditLogArchiveUpdateOne {
+	if v != nil {
+		_u.SetRestoredCount(*v)
+	}
+	return _u
+}
+
+// AddRestoredCount adds value to the "restored_count" field.
+func (_u *AuditLogArchiveUpdateOne) AddRestoredCount(v int64) *AuditLogArchiveUpdateOne {
+	_u.mutation.AddRestoredCount(v)
+	return _u
+}
+
+// ClearRestoredCount clears the value of the "restored_count" field.
+func (_u *AuditLogArchiveUpdateOne) ClearRestoredCount() *AuditLogArchiveUpdateOne {
+	_u.mutation.ClearRestoredCount()
+	return _u
+}
+
+// SetRestoredAt sets the "restored_at" field.
+func (_u *AuditLogArchiveUpdateOne) SetRestoredAt(v time.Time) *AuditLogArchiveUpdateOne {
+	_u.mutation.SetRestoredAt(v)
+	return _u
+}
+
+// SetNillableRestoredAt sets the "restored_at" field if the given value is not nil.
+func (_u *AuditLogArchiveUpdateOne) SetNillableRestoredAt(v *time.Time) *AuditLogArchiveUpdateOne {
+	if v != nil {
+		_u.SetRestoredAt(*v)
+	}
+	return _u
+}
+
+// ClearRestoredAt clears the value of the "restored_at" field.
+func (_u *AuditLogArchiveUpdateOne) ClearRestoredAt() *AuditLogArchiveUpdateOne {
+	_u.mutation.ClearRestoredAt()
+	return _u
+}
+
+// Mutation returns the AuditLogArchiveMutation object of the builder.
+func (_u *AuditLogArchiveUpdateOne) Mutation() *AuditLogArchiveMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AuditLogArchiveUpdate builder.
+func (_u *AuditLogArchiveUpdateOne) Where(ps ...predicate.AuditLogArchive) *AuditLogArchiveUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AuditLogArchiveUpdateOne) Select(field string, fields ...string) *AuditLogArchiveUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AuditLogArchive entity.
+func (_u *AuditLogArchiveUpdateOne) Save(ctx context.Context) (*AuditLogArchive, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuditLogArchiveUpdateOne) SaveX(ctx context.Context) *AuditLogArchive {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AuditLogArchiveUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuditLogArchiveUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AuditLogArchiveUpdateOne) check() error {
+	if v, ok := _u.mutation.LogType(); ok {
+		if err := auditlogarchive.LogTypeValidator(v); err != nil {
+			return &ValidationError{Name: "log_type", err: fmt.Errorf(`ent: validator failed for field "AuditLogArchive.log_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := auditlogarchive.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "AuditLogArchive.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Sha256(); ok {
+		if err := auditlogarchive.Sha256Validator(v); err != nil {
+			return &ValidationError{Name: "sha256", err: fmt.Errorf(`ent: validator failed for field "AuditLogArchive.sha256": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AuditLogArchiveUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AuditLogArchiveUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AuditLogArchiveUpdateOne) sqlSave(ctx context.Context) (_node *AuditLogArchive, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(auditlogarchive.Table, auditlogarchive.Columns, sqlgraph.NewFieldSpec(auditlogarchive.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AuditLogArchive.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, auditlogarchive.FieldID)
+		for _, f := range fields {
+			if !auditlogarchive.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != auditlogarchive.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(auditlogarchive.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(auditlogarchive.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(auditlogarchive.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(auditlogarchive.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(auditlogarchive.FieldDeletedAt, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(auditlogarchive.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.LogType(); ok {
+		_spec.SetField(auditlogarchive.FieldLogType, field.TypeEnum, value)
+	}
+	if _u.mutation.LogTypeCleared() {
+		_spec.ClearField(auditlogarchive.FieldLogType, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(auditlogarchive.FieldStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(auditlogarchive.FieldStatus, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.BucketName(); ok {
+		_spec.SetField(auditlogarchive.FieldBucketName, field.TypeString, value)
+	}
+	if _u.mutation.BucketNameCleared() {
+		_spec.ClearField(auditlogarchive.FieldBucketName, field.TypeString)
+	}
+	if value, ok := _u.mutation.ObjectName(); ok {
+		_spec.SetField(auditlogarchive.FieldObjectName, field.TypeString, value)
+	}
+	if _u.mutation.ObjectNameCleared() {
+		_spec.ClearField(auditlogarchive.FieldObjectName, field.TypeString)
+	}
+	if value, ok := _u.mutation.ManifestObjectName(); ok {
+		_spec.SetField(auditlogarchive.FieldManifestObjectName, field.TypeString, value)
+	}
+	if _u.mutation.ManifestObjectNameCleared() {
+		_spec.ClearField(auditlogarchive.FieldManifestObjectName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Sha256(); ok {
+		_spec.SetField(auditlogarchive.FieldSha256, field.TypeString, value)
+	}
+	if _u.mutation.Sha256Cleared() {
+		_spec.ClearField(auditlogarchive.FieldSha256, field.TypeString)
+	}
+	if value, ok := _u.mutation.SizeBytes(); ok {
+		_spec.SetField(auditlogarchive.FieldSizeBytes, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedSizeBytes(); ok {
+		_spec.AddField(auditlogarchive.FieldSizeBytes, field.TypeUint64, value)
+	}
+	if _u.mutation.SizeBytesCleared() {
+		_spec.ClearField(auditlogarchive.FieldSizeBytes, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.RowCount(); ok {
+		_spec.SetField(auditlogarchive.FieldRowCount, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedRowCount(); ok {
+		_spec.AddField(auditlogarchive.FieldRowCount, field.TypeUint64, value)
+	}
+	if _u.mutation.RowCountCleared() {
+		_spec.ClearField(auditlogarchive.FieldRowCount, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.PurgedCount(); ok {
+		_spec.SetField(auditlogarchive.FieldPurgedCount, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedPurgedCount(); ok {
+		_spec.AddField(auditlogarchive.FieldPurgedCount, field.TypeUint64, value)
+	}
+	if _u.mutation.PurgedCountCleared() {
+		_spec.ClearField(auditlogarchive.FieldPurgedCount, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.MinLogID(); ok {
+		_spec.SetField(auditlogarchive.FieldMinLogID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedMinLogID(); ok {
+		_spec.AddField(auditlogarchive.FieldMinLogID, field.TypeUint32, value)
+	}
+	if _u.mutation.MinLogIDCleared() {
+		_spec.ClearField(auditlogarchive.FieldMinLogID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.MaxLogID(); ok {
+		_spec.SetField(auditlogarchive.FieldMaxLogID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedMaxLogID(); ok {
+		_spec.AddField(auditlogarchive.FieldMaxLogID, field.TypeUint32, value)
+	}
+	if _u.mutation.MaxLogIDCleared() {
+		_spec.ClearField(auditlogarchive.FieldMaxLogID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.CutoffTime(); ok {
+		_spec.SetField(auditlogarchive.FieldCutoffTime, field.TypeTime, value)
+	}
+	if _u.mutation.CutoffTimeCleared() {
+		_spec.ClearField(auditlogarchive.FieldCutoffTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ErrorMessage(); ok {
+		_spec.SetField(auditlogarchive.FieldErrorMessage, field.TypeString, value)
+	}
+	if _u.mutation.ErrorMessageCleared() {
+		_spec.ClearField(auditlogarchive.FieldErrorMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.RestoredCount(); ok {
+		_spec.SetField(auditlogarchive.FieldRestoredCount, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedRestoredCount(); ok {
+		_spec.AddField(auditlogarchive.FieldRestoredCount, field.TypeUint64, value)
+	}
+	if _u.mutation.RestoredCountCleared() {
+		_spec.ClearField(auditlogarchive.FieldRestoredCount, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.RestoredAt(); ok {
+		_spec.SetField(auditlogarchive.FieldRestoredAt, field.TypeTime, value)
+	}
+	if _u.mutation.RestoredAtCleared() {
+		_spec.ClearField(auditlogarchive.FieldRestoredAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &AuditLogArchive{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlogarchive.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
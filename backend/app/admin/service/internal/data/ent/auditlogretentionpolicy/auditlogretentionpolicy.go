@@ -0,0 +1,195 @@
+// Code generated by ent, DO NOT EDIT.
+
+package auditlogretentionpolicy
+
+import (
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the auditlogretentionpolicy type in the database.
+	Label = "audit_log_retention_policy"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldUpdatedBy holds the string denoting the updated_by field in the database.
+	FieldUpdatedBy = "updated_by"
+	// FieldDeletedBy holds the string denoting the deleted_by field in the database.
+	FieldDeletedBy = "deleted_by"
+	// FieldRemark holds the string denoting the remark field in the database.
+	FieldRemark = "remark"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldLogType holds the string denoting the log_type field in the database.
+	FieldLogType = "log_type"
+	// FieldRetentionDays holds the string denoting the retention_days field in the database.
+	FieldRetentionDays = "retention_days"
+	// FieldArchiveEnabled holds the string denoting the archive_enabled field in the database.
+	FieldArchiveEnabled = "archive_enabled"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldBatchSize holds the string denoting the batch_size field in the database.
+	FieldBatchSize = "batch_size"
+	// Table holds the table name of the auditlogretentionpolicy in the database.
+	Table = "sys_audit_log_retention_policies"
+)
+
+// Columns holds all SQL columns for auditlogretentionpolicy fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldCreatedBy,
+	FieldUpdatedBy,
+	FieldDeletedBy,
+	FieldRemark,
+	FieldTenantID,
+	FieldLogType,
+	FieldRetentionDays,
+	FieldArchiveEnabled,
+	FieldEnabled,
+	FieldBatchSize,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "go-wind-admin/app/admin/service/internal/data/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID uint32
+	// DefaultRetentionDays holds the default value on creation for the "retention_days" field.
+	DefaultRetentionDays uint32
+	// DefaultArchiveEnabled holds the default value on creation for the "archive_enabled" field.
+	DefaultArchiveEnabled bool
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// DefaultBatchSize holds the default value on creation for the "batch_size" field.
+	DefaultBatchSize uint32
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(uint32) error
+)
+
+// LogType defines the type for the "log_type" enum field.
+type LogType string
+
+// LogType values.
+const (
+	LogTypeApiAuditLog         LogType = "API_AUDIT_LOG"
+	LogTypeLoginAuditLog       LogType = "LOGIN_AUDIT_LOG"
+	LogTypeOperationAuditLog   LogType = "OPERATION_AUDIT_LOG"
+	LogTypeDataAccessAuditLog  LogType = "DATA_ACCESS_AUDIT_LOG"
+	LogTypePermissionAuditLog  LogType = "PERMISSION_AUDIT_LOG"
+	LogTypePolicyEvaluationLog LogType = "POLICY_EVALUATION_LOG"
+)
+
+func (lt LogType) String() string {
+	return string(lt)
+}
+
+// LogTypeValidator is a validator for the "log_type" field enum values. It is called by the builders before save.
+func LogTypeValidator(lt LogType) error {
+	switch lt {
+	case LogTypeApiAuditLog, LogTypeLoginAuditLog, LogTypeOperationAuditLog, LogTypeDataAccessAuditLog, LogTypePermissionAuditLog, LogTypePolicyEvaluationLog:
+		return nil
+	default:
+		return fmt.Errorf("auditlogretentionpolicy: invalid enum value for log_type field: %q", lt)
+	}
+}
+
+// OrderOption defines the ordering options for the AuditLogRetentionPolicy queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByUpdatedBy orders the results by the updated_by field.
+func ByUpdatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedBy, opts...).ToFunc()
+}
+
+// ByDeletedBy orders the results by the deleted_by field.
+func ByDeletedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedBy, opts...).ToFunc()
+}
+
+// ByRemark orders the results by the remark field.
+func ByRemark(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRemark, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByLogType orders the results by the log_type field.
+func ByLogType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLogType, opts...).ToFunc()
+}
+
+// ByRetentionDays orders the results by the retention_days field.
+func ByRetentionDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRetentionDays, opts...).ToFunc()
+}
+
+// ByArchiveEnabled orders the results by the archive_enabled field.
+func ByArchiveEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArchiveEnabled, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// ByBatchSize orders the results by the batch_size field.
+func ByBatchSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBatchSize, opts...).ToFunc()
+}
@@ -0,0 +1,725 @@
+// Code generated by ent, DO NOT EDIT.
+
+package auditlogretentionpolicy
+
+import (
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// DeletedBy applies equality check predicate on the "deleted_by" field. It's identical to DeletedByEQ.
+func DeletedBy(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// Remark applies equality check predicate on the "remark" field. It's identical to RemarkEQ.
+func Remark(v string) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldEQ(FieldRemark, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldEQ(FieldTenantID, v))
+}
+
+// RetentionDays applies equality check predicate on the "retention_days" field. It's identical to RetentionDaysEQ.
+func RetentionDays(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldEQ(FieldRetentionDays, v))
+}
+
+// ArchiveEnabled applies equality check predicate on the "archive_enabled" field. It's identical to ArchiveEnabledEQ.
+func ArchiveEnabled(v bool) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldEQ(FieldArchiveEnabled, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldEQ(FieldEnabled, v))
+}
+
+// BatchSize applies equality check predicate on the "batch_size" field. It's identical to BatchSizeEQ.
+func BatchSize(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldEQ(FieldBatchSize, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// DeletedByEQ applies the EQ predicate on the "deleted_by" field.
+func DeletedByEQ(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// DeletedByNEQ applies the NEQ predicate on the "deleted_by" field.
+func DeletedByNEQ(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldNEQ(FieldDeletedBy, v))
+}
+
+// DeletedByIn applies the In predicate on the "deleted_by" field.
+func DeletedByIn(vs ...uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByNotIn applies the NotIn predicate on the "deleted_by" field.
+func DeletedByNotIn(vs ...uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldNotIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByGT applies the GT predicate on the "deleted_by" field.
+func DeletedByGT(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldGT(FieldDeletedBy, v))
+}
+
+// DeletedByGTE applies the GTE predicate on the "deleted_by" field.
+func DeletedByGTE(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldGTE(FieldDeletedBy, v))
+}
+
+// DeletedByLT applies the LT predicate on the "deleted_by" field.
+func DeletedByLT(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldLT(FieldDeletedBy, v))
+}
+
+// DeletedByLTE applies the LTE predicate on the "deleted_by" field.
+func DeletedByLTE(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldLTE(FieldDeletedBy, v))
+}
+
+// DeletedByIsNil applies the IsNil predicate on the "deleted_by" field.
+func DeletedByIsNil() predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldIsNull(FieldDeletedBy))
+}
+
+// DeletedByNotNil applies the NotNil predicate on the "deleted_by" field.
+func DeletedByNotNil() predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldNotNull(FieldDeletedBy))
+}
+
+// RemarkEQ applies the EQ predicate on the "remark" field.
+func RemarkEQ(v string) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldEQ(FieldRemark, v))
+}
+
+// RemarkNEQ applies the NEQ predicate on the "remark" field.
+func RemarkNEQ(v string) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldNEQ(FieldRemark, v))
+}
+
+// RemarkIn applies the In predicate on the "remark" field.
+func RemarkIn(vs ...string) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldIn(FieldRemark, vs...))
+}
+
+// RemarkNotIn applies the NotIn predicate on the "remark" field.
+func RemarkNotIn(vs ...string) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldNotIn(FieldRemark, vs...))
+}
+
+// RemarkGT applies the GT predicate on the "remark" field.
+func RemarkGT(v string) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldGT(FieldRemark, v))
+}
+
+// RemarkGTE applies the GTE predicate on the "remark" field.
+func RemarkGTE(v string) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldGTE(FieldRemark, v))
+}
+
+// RemarkLT applies the LT predicate on the "remark" field.
+func RemarkLT(v string) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldLT(FieldRemark, v))
+}
+
+// RemarkLTE applies the LTE predicate on the "remark" field.
+func RemarkLTE(v string) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldLTE(FieldRemark, v))
+}
+
+// RemarkContains applies the Contains predicate on the "remark" field.
+func RemarkContains(v string) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldContains(FieldRemark, v))
+}
+
+// RemarkHasPrefix applies the HasPrefix predicate on the "remark" field.
+func RemarkHasPrefix(v string) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldHasPrefix(FieldRemark, v))
+}
+
+// RemarkHasSuffix applies the HasSuffix predicate on the "remark" field.
+func RemarkHasSuffix(v string) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldHasSuffix(FieldRemark, v))
+}
+
+// RemarkIsNil applies the IsNil predicate on the "remark" field.
+func RemarkIsNil() predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldIsNull(FieldRemark))
+}
+
+// RemarkNotNil applies the NotNil predicate on the "remark" field.
+func RemarkNotNil() predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldNotNull(FieldRemark))
+}
+
+// RemarkEqualFold applies the EqualFold predicate on the "remark" field.
+func RemarkEqualFold(v string) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldEqualFold(FieldRemark, v))
+}
+
+// RemarkContainsFold applies the ContainsFold predicate on the "remark" field.
+func RemarkContainsFold(v string) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldContainsFold(FieldRemark, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldNotNull(FieldTenantID))
+}
+
+// LogTypeEQ applies the EQ predicate on the "log_type" field.
+func LogTypeEQ(v LogType) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldEQ(FieldLogType, v))
+}
+
+// LogTypeNEQ applies the NEQ predicate on the "log_type" field.
+func LogTypeNEQ(v LogType) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldNEQ(FieldLogType, v))
+}
+
+// LogTypeIn applies the In predicate on the "log_type" field.
+func LogTypeIn(vs ...LogType) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldIn(FieldLogType, vs...))
+}
+
+// LogTypeNotIn applies the NotIn predicate on the "log_type" field.
+func LogTypeNotIn(vs ...LogType) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldNotIn(FieldLogType, vs...))
+}
+
+// LogTypeIsNil applies the IsNil predicate on the "log_type" field.
+func LogTypeIsNil() predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldIsNull(FieldLogType))
+}
+
+// LogTypeNotNil applies the NotNil predicate on the "log_type" field.
+func LogTypeNotNil() predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldNotNull(FieldLogType))
+}
+
+// RetentionDaysEQ applies the EQ predicate on the "retention_days" field.
+func RetentionDaysEQ(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldEQ(FieldRetentionDays, v))
+}
+
+// RetentionDaysNEQ applies the NEQ predicate on the "retention_days" field.
+func RetentionDaysNEQ(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldNEQ(FieldRetentionDays, v))
+}
+
+// RetentionDaysIn applies the In predicate on the "retention_days" field.
+func RetentionDaysIn(vs ...uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldIn(FieldRetentionDays, vs...))
+}
+
+// RetentionDaysNotIn applies the NotIn predicate on the "retention_days" field.
+func RetentionDaysNotIn(vs ...uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldNotIn(FieldRetentionDays, vs...))
+}
+
+// RetentionDaysGT applies the GT predicate on the "retention_days" field.
+func RetentionDaysGT(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldGT(FieldRetentionDays, v))
+}
+
+// RetentionDaysGTE applies the GTE predicate on the "retention_days" field.
+func RetentionDaysGTE(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldGTE(FieldRetentionDays, v))
+}
+
+// RetentionDaysLT applies the LT predicate on the "retention_days" field.
+func RetentionDaysLT(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldLT(FieldRetentionDays, v))
+}
+
+// RetentionDaysLTE applies the LTE predicate on the "retention_days" field.
+func RetentionDaysLTE(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldLTE(FieldRetentionDays, v))
+}
+
+// RetentionDaysIsNil applies the IsNil predicate on the "retention_days" field.
+func RetentionDaysIsNil() predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldIsNull(FieldRetentionDays))
+}
+
+// RetentionDaysNotNil applies the NotNil predicate on the "retention_days" field.
+func RetentionDaysNotNil() predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldNotNull(FieldRetentionDays))
+}
+
+// ArchiveEnabledEQ applies the EQ predicate on the "archive_enabled" field.
+func ArchiveEnabledEQ(v bool) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldEQ(FieldArchiveEnabled, v))
+}
+
+// ArchiveEnabledNEQ applies the NEQ predicate on the "archive_enabled" field.
+func ArchiveEnabledNEQ(v bool) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldNEQ(FieldArchiveEnabled, v))
+}
+
+// ArchiveEnabledIsNil applies the IsNil predicate on the "archive_enabled" field.
+func ArchiveEnabledIsNil() predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldIsNull(FieldArchiveEnabled))
+}
+
+// ArchiveEnabledNotNil applies the NotNil predicate on the "archive_enabled" field.
+func ArchiveEnabledNotNil() predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldNotNull(FieldArchiveEnabled))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// EnabledIsNil applies the IsNil predicate on the "enabled" field.
+func EnabledIsNil() predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldIsNull(FieldEnabled))
+}
+
+// EnabledNotNil applies the NotNil predicate on the "enabled" field.
+func EnabledNotNil() predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldNotNull(FieldEnabled))
+}
+
+// BatchSizeEQ applies the EQ predicate on the "batch_size" field.
+func BatchSizeEQ(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldEQ(FieldBatchSize, v))
+}
+
+// BatchSizeNEQ applies the NEQ predicate on the "batch_size" field.
+func BatchSizeNEQ(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldNEQ(FieldBatchSize, v))
+}
+
+// BatchSizeIn applies the In predicate on the "batch_size" field.
+func BatchSizeIn(vs ...uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldIn(FieldBatchSize, vs...))
+}
+
+// BatchSizeNotIn applies the NotIn predicate on the "batch_size" field.
+func BatchSizeNotIn(vs ...uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldNotIn(FieldBatchSize, vs...))
+}
+
+// BatchSizeGT applies the GT predicate on the "batch_size" field.
+func BatchSizeGT(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldGT(FieldBatchSize, v))
+}
+
+// BatchSizeGTE applies the GTE predicate on the "batch_size" field.
+func BatchSizeGTE(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldGTE(FieldBatchSize, v))
+}
+
+// BatchSizeLT applies the LT predicate on the "batch_size" field.
+func BatchSizeLT(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldLT(FieldBatchSize, v))
+}
+
+// BatchSizeLTE applies the LTE predicate on the "batch_size" field.
+func BatchSizeLTE(v uint32) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldLTE(FieldBatchSize, v))
+}
+
+// BatchSizeIsNil applies the IsNil predicate on the "batch_size" field.
+func BatchSizeIsNil() predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldIsNull(FieldBatchSize))
+}
+
+// BatchSizeNotNil applies the NotNil predicate on the "batch_size" field.
+func BatchSizeNotNil() predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.FieldNotNull(FieldBatchSize))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AuditLogRetentionPolicy) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AuditLogRetentionPolicy) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AuditLogRetentionPolicy) predicate.AuditLogRetentionPolicy {
+	return predicate.AuditLogRetentionPolicy(sql.NotPredicates(p))
+}
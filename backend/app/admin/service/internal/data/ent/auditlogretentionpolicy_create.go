@@ -0,0 +1,1477 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/auditlogretentionpolicy"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AuditLogRetentionPolicyCreate is the builder for creating a AuditLogRetentionPolicy entity.
+type AuditLogRetentionPolicyCreate struct {
+	config
+	mutation *AuditLogRetentionPolicyMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AuditLogRetentionPolicyCreate) SetCreatedAt(v time.Time) *AuditLogRetentionPolicyCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AuditLogRetentionPolicyCreate) SetNillableCreatedAt(v *time.Time) *AuditLogRetentionPolicyCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *AuditLogRetentionPolicyCreate) SetUpdatedAt(v time.Time) *AuditLogRetentionPolicyCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *AuditLogRetentionPolicyCreate) SetNillableUpdatedAt(v *time.Time) *AuditLogRetentionPolicyCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *AuditLogRetentionPolicyCreate) SetDeletedAt(v time.Time) *AuditLogRetentionPolicyCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *AuditLogRetentionPolicyCreate) SetNillableDeletedAt(v *time.Time) *AuditLogRetentionPolicyCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *AuditLogRetentionPolicyCreate) SetCreatedBy(v uint32) *AuditLogRetentionPolicyCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *AuditLogRetentionPolicyCreate) SetNillableCreatedBy(v *uint32) *AuditLogRetentionPolicyCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *AuditLogRetentionPolicyCreate) SetUpdatedBy(v uint32) *AuditLogRetentionPolicyCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *AuditLogRetentionPolicyCreate) SetNillableUpdatedBy(v *uint32) *AuditLogRetentionPolicyCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_c *AuditLogRetentionPolicyCreate) SetDeletedBy(v uint32) *AuditLogRetentionPolicyCreate {
+	_c.mutation.SetDeletedBy(v)
+	return _c
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_c *AuditLogRetentionPolicyCreate) SetNillableDeletedBy(v *uint32) *AuditLogRetentionPolicyCreate {
+	if v != nil {
+		_c.SetDeletedBy(*v)
+	}
+	return _c
+}
+
+// SetRemark sets the "remark" field.
+func (_c *AuditLogRetentionPolicyCreate) SetRemark(v string) *AuditLogRetentionPolicyCreate {
+	_c.mutation.SetRemark(v)
+	return _c
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (_c *AuditLogRetentionPolicyCreate) SetNillableRemark(v *string) *AuditLogRetentionPolicyCreate {
+	if v != nil {
+		_c.SetRemark(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *AuditLogRetentionPolicyCreate) SetTenantID(v uint32) *AuditLogRetentionPolicyCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *AuditLogRetentionPolicyCreate) SetNillableTenantID(v *uint32) *AuditLogRetentionPolicyCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetLogType sets the "log_type" field.
+func (_c *AuditLogRetentionPolicyCreate) SetLogType(v auditlogretentionpolicy.LogType) *AuditLogRetentionPolicyCreate {
+	_c.mutation.SetLogType(v)
+	return _c
+}
+
+// SetNillableLogType sets the "log_type" field if the given value is not nil.
+func (_c *AuditLogRetentionPolicyCreate) SetNillableLogType(v *auditlogretentionpolicy.LogType) *AuditLogRetentionPolicyCreate {
+	if v != nil {
+		_c.SetLogType(*v)
+	}
+	return _c
+}
+
+// SetRetentionDays sets the "retention_days" field.
+func (_c *AuditLogRetentionPolicyCreate) SetRetentionDays(v uint32) *AuditLogRetentionPolicyCreate {
+	_c.mutation.SetRetentionDays(v)
+	return _c
+}
+
+// SetNillableRetentionDays sets the "retention_days" field if the given value is not nil.
+func (_c *AuditLogRetentionPolicyCreate) SetNillableRetentionDays(v *uint32) *AuditLogRetentionPolicyCreate {
+	if v != nil {
+		_c.SetRetentionDays(*v)
+	}
+	return _c
+}
+
+// SetArchiveEnabled sets the "archive_enabled" field.
+func (_c *AuditLogRetentionPolicyCreate) SetArchiveEnabled(v bool) *AuditLogRetentionPolicyCreate {
+	_c.mutation.SetArchiveEnabled(v)
+	return _c
+}
+
+// SetNillableArchiveEnabled sets the "archive_enabled" field if the given value is not nil.
+func (_c *AuditLogRetentionPolicyCreate) SetNillableArchiveEnabled(v *bool) *AuditLogRetentionPolicyCreate {
+	if v != nil {
+		_c.SetArchiveEnabled(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *AuditLogRetentionPolicyCreate) SetEnabled(v bool) *AuditLogRetentionPolicyCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *AuditLogRetentionPolicyCreate) SetNillableEnabled(v *bool) *AuditLogRetentionPolicyCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetBatchSize sets the "batch_size" field.
+func (_c *AuditLogRetentionPolicyCreate) SetBatchSize(v uint32) *AuditLogRetentionPolicyCreate {
+	_c.mutation.SetBatchSize(v)
+	return _c
+}
+
+// SetNillableBatchSize sets the "batch_size" field if the given value is not nil.
+func (_c *AuditLogRetentionPolicyCreate) SetNillableBatchSize(v *uint32) *AuditLogRetentionPolicyCreate {
+	if v != nil {
+		_c.SetBatchSize(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AuditLogRetentionPolicyCreate) SetID(v uint32) *AuditLogRetentionPolicyCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the AuditLogRetentionPolicyMutation object of the builder.
+func (_c *AuditLogRetentionPolicyCreate) Mutation() *AuditLogRetentionPolicyMutation {
+	return _c.mutation
+}
+
+// Save creates the AuditLogRetentionPolicy in the database.
+func (_c *AuditLogRetentionPolicyCreate) Save(ctx context.Context) (*AuditLogRetentionPolicy, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AuditLogRetentionPolicyCreate) SaveX(ctx context.Context) *AuditLogRetentionPolicy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AuditLogRetentionPolicyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AuditLogRetentionPolicyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AuditLogRetentionPolicyCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := auditlogretentionpolicy.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.RetentionDays(); !ok {
+		v := auditlogretentionpolicy.DefaultRetentionDays
+		_c.mutation.SetRetentionDays(v)
+	}
+	if _, ok := _c.mutation.ArchiveEnabled(); !ok {
+		v := auditlogretentionpolicy.DefaultArchiveEnabled
+		_c.mutation.SetArchiveEnabled(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := auditlogretentionpolicy.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.BatchSize(); !ok {
+		v := auditlogretentionpolicy.DefaultBatchSize
+		_c.mutation.SetBatchSize(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AuditLogRetentionPolicyCreate) check() error {
+	if v, ok := _c.mutation.LogType(); ok {
+		if err := auditlogretentionpolicy.LogTypeValidator(v); err != nil {
+			return &ValidationError{Name: "log_type", err: fmt.Errorf(`ent: validator failed for field "AuditLogRetentionPolicy.log_type": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := auditlogretentionpolicy.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "AuditLogRetentionPolicy.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *AuditLogRetentionPolicyCreate) sqlSave(ctx context.Context) (*AuditLogRetentionPolicy, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AuditLogRetentionPolicyCreate) createSpec() (*AuditLogRetentionPolicy, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditLogRetentionPolicy{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(auditlogretentionpolicy.Table, sqlgraph.NewFieldSpec(auditlogretentionpolicy.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(auditlogretentionpolicy.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(auditlogretentionpolicy.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(auditlogretentionpolicy.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(auditlogretentionpolicy.FieldCreatedBy, field.TypeUint32, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(auditlogretentionpolicy.FieldUpdatedBy, field.TypeUint32, value)
+		_node.UpdatedBy = &value
+	}
+	if value, ok := _c.mutation.DeletedBy(); ok {
+		_spec.SetField(auditlogretentionpolicy.FieldDeletedBy, field.TypeUint32, value)
+		_node.DeletedBy = &value
+	}
+	if value, ok := _c.mutation.Remark(); ok {
+		_spec.SetField(auditlogretentionpolicy.FieldRemark, field.TypeString, value)
+		_node.Remark = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(auditlogretentionpolicy.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.LogType(); ok {
+		_spec.SetField(auditlogretentionpolicy.FieldLogType, field.TypeEnum, value)
+		_node.LogType = &value
+	}
+	if value, ok := _c.mutation.RetentionDays(); ok {
+		_spec.SetField(auditlogretentionpolicy.FieldRetentionDays, field.TypeUint32, value)
+		_node.RetentionDays = &value
+	}
+	if value, ok := _c.mutation.ArchiveEnabled(); ok {
+		_spec.SetField(auditlogretentionpolicy.FieldArchiveEnabled, field.TypeBool, value)
+		_node.ArchiveEnabled = &value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(auditlogretentionpolicy.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = &value
+	}
+	if value, ok := _c.mutation.BatchSize(); ok {
+		_spec.SetField(auditlogretentionpolicy.FieldBatchSize, field.TypeUint32, value)
+		_node.BatchSize = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AuditLogRetentionPolicy.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AuditLogRetentionPolicyUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AuditLogRetentionPolicyCreate) OnConflict(opts ...sql.ConflictOption) *AuditLogRetentionPolicyUpsertOne {
+	_c.conflict = opts
+	return &AuditLogRetentionPolicyUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AuditLogRetentionPolicy.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AuditLogRetentionPolicyCreate) OnConflictColumns(columns ...string) *AuditLogRetentionPolicyUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AuditLogRetentionPolicyUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// AuditLogRetentionPolicyUpsertOne is the builder for "upsert"-ing
+	//  one AuditLogRetentionPolicy node.
+	AuditLogRetentionPolicyUpsertOne struct {
+		create *AuditLogRetentionPolicyCreate
+	}
+
+	// AuditLogRetentionPolicyUpsert is the "OnConflict" setter.
+	AuditLogRetentionPolicyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AuditLogRetentionPolicyUpsert) SetUpdatedAt(v time.Time) *AuditLogRetentionPolicyUpsert {
+	u.Set(auditlogretentionpolicy.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AuditLogRetentionPolicyUpsert) UpdateUpdatedAt() *AuditLogRetentionPolicyUpsert {
+	u.SetExcluded(auditlogretentionpolicy.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *AuditLogRetentionPolicyUpsert) ClearUpdatedAt() *AuditLogRetentionPolicyUpsert {
+	u.SetNull(auditlogretentionpolicy.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *AuditLogRetentionPolicyUpsert) SetDeletedAt(v time.Time) *AuditLogRetentionPolicyUpsert {
+	u.Set(auditlogretentionpolicy.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *AuditLogRetentionPolicyUpsert) UpdateDeletedAt() *AuditLogRetentionPolicyUpsert {
+	u.SetExcluded(auditlogretentionpolicy.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *AuditLogRetentionPolicyUpsert) ClearDeletedAt() *AuditLogRetentionPolicyUpsert {
+	u.SetNull(auditlogretentionpolicy.FieldDeletedAt)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *AuditLogRetentionPolicyUpsert) SetCreatedBy(v uint32) *AuditLogRetentionPolicyUpsert {
+	u.Set(auditlogretentionpolicy.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *AuditLogRetentionPolicyUpsert) UpdateCreatedBy() *AuditLogRetentionPolicyUpsert {
+	u.SetExcluded(auditlogretentionpolicy.FieldCreatedBy)
+	return u
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *AuditLogRetentionPolicyUpsert) AddCreatedBy(v uint32) *AuditLogRetentionPolicyUpsert {
+	u.Add(auditlogretentionpolicy.FieldCreatedBy, v)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *AuditLogRetentionPolicyUpsert) ClearCreatedBy() *AuditLogRetentionPolicyUpsert {
+	u.SetNull(auditlogretentionpolicy.FieldCreatedBy)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *AuditLogRetentionPolicyUpsert) SetUpdatedBy(v uint32) *AuditLogRetentionPolicyUpsert {
+	u.Set(auditlogretentionpolicy.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *AuditLogRetentionPolicyUpsert) UpdateUpdatedBy() *AuditLogRetentionPolicyUpsert {
+	u.SetExcluded(auditlogretentionpolicy.FieldUpdatedBy)
+	return u
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *AuditLogRetentionPolicyUpsert) AddUpdatedBy(v uint32) *AuditLogRetentionPolicyUpsert {
+	u.Add(auditlogretentionpolicy.FieldUpdatedBy, v)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *AuditLogRetentionPolicyUpsert) ClearUpdatedBy() *AuditLogRetentionPolicyUpsert {
+	u.SetNull(auditlogretentionpolicy.FieldUpdatedBy)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *AuditLogRetentionPolicyUpsert) SetDeletedBy(v uint32) *AuditLogRetentionPolicyUpsert {
+	u.Set(auditlogretentionpolicy.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *AuditLogRetentionPolicyUpsert) UpdateDeletedBy() *AuditLogRetentionPolicyUpsert {
+	u.SetExcluded(auditlogretentionpolicy.FieldDeletedBy)
+	return u
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *AuditLogRetentionPolicyUpsert) AddDeletedBy(v uint32) *AuditLogRetentionPolicyUpsert {
+	u.Add(auditlogretentionpolicy.FieldDeletedBy, v)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *AuditLogRetentionPolicyUpsert) ClearDeletedBy() *AuditLogRetentionPolicyUpsert {
+	u.SetNull(auditlogretentionpolicy.FieldDeletedBy)
+	return u
+}
+
+// SetRemark sets the "remark" field.
+func (u *AuditLogRetentionPolicyUpsert) SetRemark(v string) *AuditLogRetentionPolicyUpsert {
+	u.Set(auditlogretentionpolicy.FieldRemark, v)
+	return u
+}
+
+// UpdateRemark sets the "remark" field to the value that was provided on create.
+func (u *AuditLogRetentionPolicyUpsert) UpdateRemark() *AuditLogRetentionPolicyUpsert {
+	u.SetExcluded(auditlogretentionpolicy.FieldRemark)
+	return u
+}
+
+// ClearRemark clears the value of the "remark" field.
+func (u *AuditLogRetentionPolicyUpsert) ClearRemark() *AuditLogRetentionPolicyUpsert {
+	u.SetNull(auditlogretentionpolicy.FieldRemark)
+	return u
+}
+
+// SetLogType sets the "log_type" field.
+func (u *AuditLogRetentionPolicyUpsert) SetLogType(v auditlogretentionpolicy.LogType) *AuditLogRetentionPolicyUpsert {
+	u.Set(auditlogretentionpolicy.FieldLogType, v)
+	return u
+}
+
+// UpdateLogType sets the "log_type" field to the value that was provided on create.
+func (u *AuditLogRetentionPolicyUpsert) UpdateLogType() *AuditLogRetentionPolicyUpsert {
+	u.SetExcluded(auditlogretentionpolicy.FieldLogType)
+	return u
+}
+
+// ClearLogType clears the value of the "log_type" field.
+func (u *AuditLogRetentionPolicyUpsert) ClearLogType() *AuditLogRetentionPolicyUpsert {
+	u.SetNull(auditlogretentionpolicy.FieldLogType)
+	return u
+}
+
+// SetRetentionDays sets the "retention_days" field.
+func (u *AuditLogRetentionPolicyUpsert) SetRetentionDays(v uint32) *AuditLogRetentionPolicyUpsert {
+	u.Set(auditlogretentionpolicy.FieldRetentionDays, v)
+	return u
+}
+
+// UpdateRetentionDays sets the "retention_days" field to the value that was provided on create.
+func (u *AuditLogRetentionPolicyUpsert) UpdateRetentionDays() *AuditLogRetentionPolicyUpsert {
+	u.SetExcluded(auditlogretentionpolicy.FieldRetentionDays)
+	return u
+}
+
+// AddRetentionDays adds v to the "retention_days" field.
+func (u *AuditLogRetentionPolicyUpsert) AddRetentionDays(v uint32) *AuditLogRetentionPolicyUpsert {
+	u.Add(auditlogretentionpolicy.FieldRetentionDays, v)
+	return u
+}
+
+// ClearRetentionDays clears the value of the "retention_days" field.
+func (u *AuditLogRetentionPolicyUpsert) ClearRetentionDays() *AuditLogRetentionPolicyUpsert {
+	u.SetNull(auditlogretentionpolicy.FieldRetentionDays)
+	return u
+}
+
+// SetArchiveEnabled sets the "archive_enabled" field.
+func (u *AuditLogRetentionPolicyUpsert) SetArchiveEnabled(v bool) *AuditLogRetentionPolicyUpsert {
+	u.Set(auditlogretentionpolicy.FieldArchiveEnabled, v)
+	return u
+}
+
+// UpdateArchiveEnabled sets the "archive_enabled" field to the value that was provided on create.
+func (u *AuditLogRetentionPolicyUpsert) UpdateArchiveEnabled() *AuditLogRetentionPolicyUpsert {
+	u.SetExcluded(auditlogretentionpolicy.FieldArchiveEnabled)
+	return u
+}
+
+// ClearArchiveEnabled clears the value of the "archive_enabled" field.
+func (u *AuditLogRetentionPolicyUpsert) ClearArchiveEnabled() *AuditLogRetentionPolicyUpsert {
+	u.SetNull(auditlogretentionpolicy.FieldArchiveEnabled)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *AuditLogRetentionPolicyUpsert) SetEnabled(v bool) *AuditLogRetentionPolicyUpsert {
+	u.Set(auditlogretentionpolicy.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *AuditLogRetentionPolicyUpsert) UpdateEnabled() *AuditLogRetentionPolicyUpsert {
+	u.SetExcluded(auditlogretentionpolicy.FieldEnabled)
+	return u
+}
+
+// ClearEnabled clears the value of the "enabled" field.
+func (u *AuditLogRetentionPolicyUpsert) ClearEnabled() *AuditLogRetentionPolicyUpsert {
+	u.SetNull(auditlogretentionpolicy.FieldEnabled)
+	return u
+}
+
+// SetBatchSize sets the "batch_size" field.
+func (u *AuditLogRetentionPolicyUpsert) SetBatchSize(v uint32) *AuditLogRetentionPolicyUpsert {
+	u.Set(auditlogretentionpolicy.FieldBatchSize, v)
+	return u
+}
+
+// UpdateBatchSize sets the "batch_size" field to the value that was provided on create.
+func (u *AuditLogRetentionPolicyUpsert) UpdateBatchSize() *AuditLogRetentionPolicyUpsert {
+	u.SetExcluded(auditlogretentionpolicy.FieldBatchSize)
+	return u
+}
+
+// AddBatchSize adds v to the "batch_size" field.
+func (u *AuditLogRetentionPolicyUpsert) AddBatchSize(v uint32) *AuditLogRetentionPolicyUpsert {
+	u.Add(auditlogretentionpolicy.FieldBatchSize, v)
+	return u
+}
+
+// ClearBatchSize clears the value of the "batch_size" field.
+func (u *AuditLogRetentionPolicyUpsert) ClearBatchSize() *AuditLogRetentionPolicyUpsert {
+	u.SetNull(auditlogretentionpolicy.FieldBatchSize)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AuditLogRetentionPolicy.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(auditlogretentionpolicy.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AuditLogRetentionPolicyUpsertOne) UpdateNewValues() *AuditLogRetentionPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(auditlogretentionpolicy.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(auditlogretentionpolicy.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(auditlogretentionpolicy.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AuditLogRetentionPolicy.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AuditLogRetentionPolicyUpsertOne) Ignore() *AuditLogRetentionPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AuditLogRetentionPolicyUpsertOne) DoNothing() *AuditLogRetentionPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AuditLogRetentionPolicyCreate.OnConflict
+// documentation for more info.
+func (u *AuditLogRetentionPolicyUpsertOne) Update(set func(*AuditLogRetentionPolicyUpsert)) *AuditLogRetentionPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AuditLogRetentionPolicyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AuditLogRetentionPolicyUpsertOne) SetUpdatedAt(v time.Time) *AuditLogRetentionPolicyUpsertOne {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AuditLogRetentionPolicyUpsertOne) UpdateUpdatedAt() *AuditLogRetentionPolicyUpsertOne {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *AuditLogRetentionPolicyUpsertOne) ClearUpdatedAt() *AuditLogRetentionPolicyUpsertOne {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *AuditLogRetentionPolicyUpsertOne) SetDeletedAt(v time.Time) *AuditLogRetentionPolicyUpsertOne {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *AuditLogRetentionPolicyUpsertOne) UpdateDeletedAt() *AuditLogRetentionPolicyUpsertOne {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *AuditLogRetentionPolicyUpsertOne) ClearDeletedAt() *AuditLogRetentionPolicyUpsertOne {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *AuditLogRetentionPolicyUpsertOne) SetCreatedBy(v uint32) *AuditLogRetentionPolicyUpsertOne {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *AuditLogRetentionPolicyUpsertOne) AddCreatedBy(v uint32) *AuditLogRetentionPolicyUpsertOne {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *AuditLogRetentionPolicyUpsertOne) UpdateCreatedBy() *AuditLogRetentionPolicyUpsertOne {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *AuditLogRetentionPolicyUpsertOne) ClearCreatedBy() *AuditLogRetentionPolicyUpsertOne {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *AuditLogRetentionPolicyUpsertOne) SetUpdatedBy(v uint32) *AuditLogRetentionPolicyUpsertOne {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *AuditLogRetentionPolicyUpsertOne) AddUpdatedBy(v uint32) *AuditLogRetentionPolicyUpsertOne {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *AuditLogRetentionPolicyUpsertOne) UpdateUpdatedBy() *AuditLogRetentionPolicyUpsertOne {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *AuditLogRetentionPolicyUpsertOne) ClearUpdatedBy() *AuditLogRetentionPolicyUpsertOne {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *AuditLogRetentionPolicyUpsertOne) SetDeletedBy(v uint32) *AuditLogRetentionPolicyUpsertOne {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *AuditLogRetentionPolicyUpsertOne) AddDeletedBy(v uint32) *AuditLogRetentionPolicyUpsertOne {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *AuditLogRetentionPolicyUpsertOne) UpdateDeletedBy() *AuditLogRetentionPolicyUpsertOne {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *AuditLogRetentionPolicyUpsertOne) ClearDeletedBy() *AuditLogRetentionPolicyUpsertOne {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetRemark sets the "remark" field.
+func (u *AuditLogRetentionPolicyUpsertOne) SetRemark(v string) *AuditLogRetentionPolicyUpsertOne {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.SetRemark(v)
+	})
+}
+
+// UpdateRemark sets the "remark" field to the value that was provided on create.
+func (u *AuditLogRetentionPolicyUpsertOne) UpdateRemark() *AuditLogRetentionPolicyUpsertOne {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.UpdateRemark()
+	})
+}
+
+// ClearRemark clears the value of the "remark" field.
+func (u *AuditLogRetentionPolicyUpsertOne) ClearRemark() *AuditLogRetentionPolicyUpsertOne {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.ClearRemark()
+	})
+}
+
+// SetLogType sets the "log_type" field.
+func (u *AuditLogRetentionPolicyUpsertOne) SetLogType(v auditlogretentionpolicy.LogType) *AuditLogRetentionPolicyUpsertOne {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.SetLogType(v)
+	})
+}
+
+// UpdateLogType sets the "log_type" field to the value that was provided on create.
+func (u *AuditLogRetentionPolicyUpsertOne) UpdateLogType() *AuditLogRetentionPolicyUpsertOne {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.UpdateLogType()
+	})
+}
+
+// ClearLogType clears the value of the "log_type" field.
+func (u *AuditLogRetentionPolicyUpsertOne) ClearLogType() *AuditLogRetentionPolicyUpsertOne {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.ClearLogType()
+	})
+}
+
+// SetRetentionDays sets the "retention_days" field.
+func (u *AuditLogRetentionPolicyUpsertOne) SetRetentionDays(v uint32) *AuditLogRetentionPolicyUpsertOne {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.SetRetentionDays(v)
+	})
+}
+
+// AddRetentionDays adds v to the "retention_days" field.
+func (u *AuditLogRetentionPolicyUpsertOne) AddRetentionDays(v uint32) *AuditLogRetentionPolicyUpsertOne {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.AddRetentionDays(v)
+	})
+}
+
+// UpdateRetentionDays sets the "retention_days" field to the value that was provided on create.
+func (u *AuditLogRetentionPolicyUpsertOne) UpdateRetentionDays() *AuditLogRetentionPolicyUpsertOne {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.UpdateRetentionDays()
+	})
+}
+
+// ClearRetentionDays clears the value of the "retention_days" field.
+func (u *AuditLogRetentionPolicyUpsertOne) ClearRetentionDays() *AuditLogRetentionPolicyUpsertOne {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.ClearRetentionDays()
+	})
+}
+
+// SetArchiveEnabled sets the "archive_enabled" field.
+func (u *AuditLogRetentionPolicyUpsertOne) SetArchiveEnabled(v bool) *AuditLogRetentionPolicyUpsertOne {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.SetArchiveEnabled(v)
+	})
+}
+
+// UpdateArchiveEnabled sets the "archive_enabled" field to the value that was provided on create.
+func (u *AuditLogRetentionPolicyUpsertOne) UpdateArchiveEnabled() *AuditLogRetentionPolicyUpsertOne {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.UpdateArchiveEnabled()
+	})
+}
+
+// ClearArchiveEnabled clears the value of the "archive_enabled" field.
+func (u *AuditLogRetentionPolicyUpsertOne) ClearArchiveEnabled() *AuditLogRetentionPolicyUpsertOne {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.ClearArchiveEnabled()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *AuditLogRetentionPolicyUpsertOne) SetEnabled(v bool) *AuditLogRetentionPolicyUpsertOne {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *AuditLogRetentionPolicyUpsertOne) UpdateEnabled() *AuditLogRetentionPolicyUpsertOne {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// ClearEnabled clears the value of the "enabled" field.
+func (u *AuditLogRetentionPolicyUpsertOne) ClearEnabled() *AuditLogRetentionPolicyUpsertOne {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.ClearEnabled()
+	})
+}
+
+// SetBatchSize sets the "batch_size" field.
+func (u *AuditLogRetentionPolicyUpsertOne) SetBatchSize(v uint32) *AuditLogRetentionPolicyUpsertOne {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.SetBatchSize(v)
+	})
+}
+
+// AddBatchSize adds v to the "batch_size" field.
+func (u *AuditLogRetentionPolicyUpsertOne) AddBatchSize(v uint32) *AuditLogRetentionPolicyUpsertOne {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.AddBatchSize(v)
+	})
+}
+
+// UpdateBatchSize sets the "batch_size" field to the value that was provided on create.
+func (u *AuditLogRetentionPolicyUpsertOne) UpdateBatchSize() *AuditLogRetentionPolicyUpsertOne {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.UpdateBatchSize()
+	})
+}
+
+// ClearBatchSize clears the value of the "batch_size" field.
+func (u *AuditLogRetentionPolicyUpsertOne) ClearBatchSize() *AuditLogRetentionPolicyUpsertOne {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.ClearBatchSize()
+	})
+}
+
+// Exec executes the query.
+func (u *AuditLogRetentionPolicyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AuditLogRetentionPolicyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AuditLogRetentionPolicyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AuditLogRetentionPolicyUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AuditLogRetentionPolicyUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AuditLogRetentionPolicyCreateBulk is the builder for creating many AuditLogRetentionPolicy entities in bulk.
+type AuditLogRetentionPolicyCreateBulk struct {
+	config
+	err      error
+	builders []*AuditLogRetentionPolicyCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AuditLogRetentionPolicy entities in the database.
+func (_c *AuditLogRetentionPolicyCreateBulk) Save(ctx context.Context) ([]*AuditLogRetentionPolicy, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AuditLogRetentionPolicy, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditLogRetentionPolicyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AuditLogRetentionPolicyCreateBulk) SaveX(ctx context.Context) []*AuditLogRetentionPolicy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AuditLogRetentionPolicyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AuditLogRetentionPolicyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AuditLogRetentionPolicy.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AuditLogRetentionPolicyUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AuditLogRetentionPolicyCreateBulk) OnConflict(opts ...sql.ConflictOption) *AuditLogRetentionPolicyUpsertBulk {
+	_c.conflict = opts
+	return &AuditLogRetentionPolicyUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AuditLogRetentionPolicy.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AuditLogRetentionPolicyCreateBulk) OnConflictColumns(columns ...string) *AuditLogRetentionPolicyUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AuditLogRetentionPolicyUpsertBulk{
+		create: _c,
+	}
+}
+
+// AuditLogRetentionPolicyUpsertBulk is the builder for "upsert"-ing
+// a bulk of AuditLogRetentionPolicy nodes.
+type AuditLogRetentionPolicyUpsertBulk struct {
+	create *AuditLogRetentionPolicyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AuditLogRetentionPolicy.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(auditlogretentionpolicy.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AuditLogRetentionPolicyUpsertBulk) UpdateNewValues() *AuditLogRetentionPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(auditlogretentionpolicy.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(auditlogretentionpolicy.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(auditlogretentionpolicy.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AuditLogRetentionPolicy.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AuditLogRetentionPolicyUpsertBulk) Ignore() *AuditLogRetentionPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AuditLogRetentionPolicyUpsertBulk) DoNothing() *AuditLogRetentionPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AuditLogRetentionPolicyCreateBulk.OnConflict
+// documentation for more info.
+func (u *AuditLogRetentionPolicyUpsertBulk) Update(set func(*AuditLogRetentionPolicyUpsert)) *AuditLogRetentionPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AuditLogRetentionPolicyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AuditLogRetentionPolicyUpsertBulk) SetUpdatedAt(v time.Time) *AuditLogRetentionPolicyUpsertBulk {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AuditLogRetentionPolicyUpsertBulk) UpdateUpdatedAt() *AuditLogRetentionPolicyUpsertBulk {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *AuditLogRetentionPolicyUpsertBulk) ClearUpdatedAt() *AuditLogRetentionPolicyUpsertBulk {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *AuditLogRetentionPolicyUpsertBulk) SetDeletedAt(v time.Time) *AuditLogRetentionPolicyUpsertBulk {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *AuditLogRetentionPolicyUpsertBulk) UpdateDeletedAt() *AuditLogRetentionPolicyUpsertBulk {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *AuditLogRetentionPolicyUpsertBulk) ClearDeletedAt() *AuditLogRetentionPolicyUpsertBulk {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *AuditLogRetentionPolicyUpsertBulk) SetCreatedBy(v uint32) *AuditLogRetentionPolicyUpsertBulk {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *AuditLogRetentionPolicyUpsertBulk) AddCreatedBy(v uint32) *AuditLogRetentionPolicyUpsertBulk {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *AuditLogRetentionPolicyUpsertBulk) UpdateCreatedBy() *AuditLogRetentionPolicyUpsertBulk {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *AuditLogRetentionPolicyUpsertBulk) ClearCreatedBy() *AuditLogRetentionPolicyUpsertBulk {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *AuditLogRetentionPolicyUpsertBulk) SetUpdatedBy(v uint32) *AuditLogRetentionPolicyUpsertBulk {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *AuditLogRetentionPolicyUpsertBulk) AddUpdatedBy(v uint32) *AuditLogRetentionPolicyUpsertBulk {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *AuditLogRetentionPolicyUpsertBulk) UpdateUpdatedBy() *AuditLogRetentionPolicyUpsertBulk {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *AuditLogRetentionPolicyUpsertBulk) ClearUpdatedBy() *AuditLogRetentionPolicyUpsertBulk {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *AuditLogRetentionPolicyUpsertBulk) SetDeletedBy(v uint32) *AuditLogRetentionPolicyUpsertBulk {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *AuditLogRetentionPolicyUpsertBulk) AddDeletedBy(v uint32) *AuditLogRetentionPolicyUpsertBulk {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *AuditLogRetentionPolicyUpsertBulk) UpdateDeletedBy() *AuditLogRetentionPolicyUpsertBulk {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *AuditLogRetentionPolicyUpsertBulk) ClearDeletedBy() *AuditLogRetentionPolicyUpsertBulk {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetRemark sets the "remark" field.
+func (u *AuditLogRetentionPolicyUpsertBulk) SetRemark(v string) *AuditLogRetentionPolicyUpsertBulk {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.SetRemark(v)
+	})
+}
+
+// UpdateRemark sets the "remark" field to the value that was provided on create.
+func (u *AuditLogRetentionPolicyUpsertBulk) UpdateRemark() *AuditLogRetentionPolicyUpsertBulk {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.UpdateRemark()
+	})
+}
+
+// ClearRemark clears the value of the "remark" field.
+func (u *AuditLogRetentionPolicyUpsertBulk) ClearRemark() *AuditLogRetentionPolicyUpsertBulk {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.ClearRemark()
+	})
+}
+
+// SetLogType sets the "log_type" field.
+func (u *AuditLogRetentionPolicyUpsertBulk) SetLogType(v auditlogretentionpolicy.LogType) *AuditLogRetentionPolicyUpsertBulk {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.SetLogType(v)
+	})
+}
+
+// UpdateLogType sets the "log_type" field to the value that was provided on create.
+func (u *AuditLogRetentionPolicyUpsertBulk) UpdateLogType() *AuditLogRetentionPolicyUpsertBulk {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.UpdateLogType()
+	})
+}
+
+// ClearLogType clears the value of the "log_type" field.
+func (u *AuditLogRetentionPolicyUpsertBulk) ClearLogType() *AuditLogRetentionPolicyUpsertBulk {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.ClearLogType()
+	})
+}
+
+// SetRetentionDays sets the "retention_days" field.
+func (u *AuditLogRetentionPolicyUpsertBulk) SetRetentionDays(v uint32) *AuditLogRetentionPolicyUpsertBulk {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.SetRetentionDays(v)
+	})
+}
+
+// AddRetentionDays adds v to the "retention_days" field.
+func (u *AuditLogRetentionPolicyUpsertBulk) AddRetentionDays(v uint32) *AuditLogRetentionPolicyUpsertBulk {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.AddRetentionDays(v)
+	})
+}
+
+// UpdateRetentionDays sets the "retention_days" field to the value that was provided on create.
+func (u *AuditLogRetentionPolicyUpsertBulk) UpdateRetentionDays() *AuditLogRetentionPolicyUpsertBulk {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.UpdateRetentionDays()
+	})
+}
+
+// ClearRetentionDays clears the value of the "retention_days" field.
+func (u *AuditLogRetentionPolicyUpsertBulk) ClearRetentionDays() *AuditLogRetentionPolicyUpsertBulk {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.ClearRetentionDays()
+	})
+}
+
+// SetArchiveEnabled sets the "archive_enabled" field.
+func (u *AuditLogRetentionPolicyUpsertBulk) SetArchiveEnabled(v bool) *AuditLogRetentionPolicyUpsertBulk {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.SetArchiveEnabled(v)
+	})
+}
+
+// UpdateArchiveEnabled sets the "archive_enabled" field to the value that was provided on create.
+func (u *AuditLogRetentionPolicyUpsertBulk) UpdateArchiveEnabled() *AuditLogRetentionPolicyUpsertBulk {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.UpdateArchiveEnabled()
+	})
+}
+
+// ClearArchiveEnabled clears the value of the "archive_enabled" field.
+func (u *AuditLogRetentionPolicyUpsertBulk) ClearArchiveEnabled() *AuditLogRetentionPolicyUpsertBulk {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.ClearArchiveEnabled()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *AuditLogRetentionPolicyUpsertBulk) SetEnabled(v bool) *AuditLogRetentionPolicyUpsertBulk {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *AuditLogRetentionPolicyUpsertBulk) UpdateEnabled() *AuditLogRetentionPolicyUpsertBulk {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// ClearEnabled clears the value of the "enabled" field.
+func (u *AuditLogRetentionPolicyUpsertBulk) ClearEnabled() *AuditLogRetentionPolicyUpsertBulk {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.ClearEnabled()
+	})
+}
+
+// SetBatchSize sets the "batch_size" field.
+func (u *AuditLogRetentionPolicyUpsertBulk) SetBatchSize(v uint32) *AuditLogRetentionPolicyUpsertBulk {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.SetBatchSize(v)
+	})
+}
+
+// AddBatchSize adds v to the "batch_size" field.
+func (u *AuditLogRetentionPolicyUpsertBulk) AddBatchSize(v uint32) *AuditLogRetentionPolicyUpsertBulk {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.AddBatchSize(v)
+	})
+}
+
+// UpdateBatchSize sets the "batch_size" field to the value that was provided on create.
+func (u *AuditLogRetentionPolicyUpsertBulk) UpdateBatchSize() *AuditLogRetentionPolicyUpsertBulk {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.UpdateBatchSize()
+	})
+}
+
+// ClearBatchSize clears the value of the "batch_size" field.
+func (u *AuditLogRetentionPolicyUpsertBulk) ClearBatchSize() *AuditLogRetentionPolicyUpsertBulk {
+	return u.Update(func(s *AuditLogRetentionPolicyUpsert) {
+		s.ClearBatchSize()
+	})
+}
+
+// Exec executes the query.
+func (u *AuditLogRetentionPolicyUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AuditLogRetentionPolicyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AuditLogRetentionPolicyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AuditLogRetentionPolicyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"go-wind-admin/app/admin/service/internal/data/ent/auditlogretentionpolicy"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AuditLogRetentionPolicyDelete is the builder for deleting a AuditLogRetentionPolicy entity.
+type AuditLogRetentionPolicyDelete struct {
+	config
+	hooks    []Hook
+	mutation *AuditLogRetentionPolicyMutation
+}
+
+// Where appends a list predicates to the AuditLogRetentionPolicyDelete builder.
+func (_d *AuditLogRetentionPolicyDelete) Where(ps ...predicate.AuditLogRetentionPolicy) *AuditLogRetentionPolicyDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *AuditLogRetentionPolicyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AuditLogRetentionPolicyDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *AuditLogRetentionPolicyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(auditlogretentionpolicy.Table, sqlgraph.NewFieldSpec(auditlogretentionpolicy.FieldID, field.TypeUint32))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// AuditLogRetentionPolicyDeleteOne is the builder for deleting a single AuditLogRetentionPolicy entity.
+type AuditLogRetentionPolicyDeleteOne struct {
+	_d *AuditLogRetentionPolicyDelete
+}
+
+// Where appends a list predicates to the AuditLogRetentionPolicyDelete builder.
+func (_d *AuditLogRetentionPolicyDeleteOne) Where(ps ...predicate.AuditLogRetentionPolicy) *AuditLogRetentionPolicyDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *AuditLogRetentionPolicyDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{auditlogretentionpolicy.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AuditLogRetentionPolicyDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,902 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/auditlogretentionpolicy"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AuditLogRetentionPolicyUpdate is the builder for updating AuditLogRetentionPolicy entities.
+type AuditLogRetentionPolicyUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AuditLogRetentionPolicyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AuditLogRetentionPolicyUpdate builder.
+func (_u *AuditLogRetentionPolicyUpdate) Where(ps ...predicate.AuditLogRetentionPolicy) *AuditLogRetentionPolicyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AuditLogRetentionPolicyUpdate) SetUpdatedAt(v time.Time) *AuditLogRetentionPolicyUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *AuditLogRetentionPolicyUpdate) SetNillableUpdatedAt(v *time.Time) *AuditLogRetentionPolicyUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *AuditLogRetentionPolicyUpdate) ClearUpdatedAt() *AuditLogRetentionPolicyUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *AuditLogRetentionPolicyUpdate) SetDeletedAt(v time.Time) *AuditLogRetentionPolicyUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *AuditLogRetentionPolicyUpdate) SetNillableDeletedAt(v *time.Time) *AuditLogRetentionPolicyUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *AuditLogRetentionPolicyUpdate) ClearDeletedAt() *AuditLogRetentionPolicyUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *AuditLogRetentionPolicyUpdate) SetCreatedBy(v uint32) *AuditLogRetentionPolicyUpdate {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *AuditLogRetentionPolicyUpdate) SetNillableCreatedBy(v *uint32) *AuditLogRetentionPolicyUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *AuditLogRetentionPolicyUpdate) AddCreatedBy(v int32) *AuditLogRetentionPolicyUpdate {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *AuditLogRetentionPolicyUpdate) ClearCreatedBy() *AuditLogRetentionPolicyUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *AuditLogRetentionPolicyUpdate) SetUpdatedBy(v uint32) *AuditLogRetentionPolicyUpdate {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *AuditLogRetentionPolicyUpdate) SetNillableUpdatedBy(v *uint32) *AuditLogRetentionPolicyUpdate {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *AuditLogRetentionPolicyUpdate) AddUpdatedBy(v int32) *AuditLogRetentionPolicyUpdate {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *AuditLogRetentionPolicyUpdate) ClearUpdatedBy() *AuditLogRetentionPolicyUpdate {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *AuditLogRetentionPolicyUpdate) SetDeletedBy(v uint32) *AuditLogRetentionPolicyUpdate {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *AuditLogRetentionPolicyUpdate) SetNillableDeletedBy(v *uint32) *AuditLogRetentionPolicyUpdate {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *AuditLogRetentionPolicyUpdate) AddDeletedBy(v int32) *AuditLogRetentionPolicyUpdate {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *AuditLogRetentionPolicyUpdate) ClearDeletedBy() *AuditLogRetentionPolicyUpdate {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetRemark sets the "remark" field.
+func (_u *AuditLogRetentionPolicyUpdate) SetRemark(v string) *AuditLogRetentionPolicyUpdate {
+	_u.mutation.SetRemark(v)
+	return _u
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (_u *AuditLogRetentionPolicyUpdate) SetNillableRemark(v *string) *AuditLogRetentionPolicyUpdate {
+	if v != nil {
+		_u.SetRemark(*v)
+	}
+	return _u
+}
+
+// ClearRemark clears the value of the "remark" field.
+func (_u *AuditLogRetentionPolicyUpdate) ClearRemark() *AuditLogRetentionPolicyUpdate {
+	_u.mutation.ClearRemark()
+	return _u
+}
+
+// SetLogType sets the "log_type" field.
+func (_u *AuditLogRetentionPolicyUpdate) SetLogType(v auditlogretentionpolicy.LogType) *AuditLogRetentionPolicyUpdate {
+	_u.mutation.SetLogType(v)
+	return _u
+}
+
+// SetNillableLogType sets the "log_type" field if the given value is not nil.
+func (_u *AuditLogRetentionPolicyUpdate) SetNillableLogType(v *auditlogretentionpolicy.LogType) *AuditLogRetentionPolicyUpdate {
+	if v != nil {
+		_u.SetLogType(*v)
+	}
+	return _u
+}
+
+// ClearLogType clears the value of the "log_type" field.
+func (_u *AuditLogRetentionPolicyUpdate) ClearLogType() *AuditLogRetentionPolicyUpdate {
+	_u.mutation.ClearLogType()
+	return _u
+}
+
+// SetRetentionDays sets the "retention_days" field.
+func (_u *AuditLogRetentionPolicyUpdate) SetRetentionDays(v uint32) *AuditLogRetentionPolicyUpdate {
+	_u.mutation.ResetRetentionDays()
+	_u.mutation.SetRetentionDays(v)
+	return _u
+}
+
+// SetNillableRetentionDays sets the "retention_days" field if the given value is not nil.
+func (_u *AuditLogRetentionPolicyUpdate) SetNillableRetentionDays(v *uint32) *AuditLogRetentionPolicyUpdate {
+	if v != nil {
+		_u.SetRetentionDays(*v)
+	}
+	return _u
+}
+
+// AddRetentionDays adds value to the "retention_days" field.
+func (_u *AuditLogRetentionPolicyUpdate) AddRetentionDays(v int32) *AuditLogRetentionPolicyUpdate {
+	_u.mutation.AddRetentionDays(v)
+	return _u
+}
+
+// ClearRetentionDays clears the value of the "retention_days" field.
+func (_u *AuditLogRetentionPolicyUpdate) ClearRetentionDays() *AuditLogRetentionPolicyUpdate {
+	_u.mutation.ClearRetentionDays()
+	return _u
+}
+
+// SetArchiveEnabled sets the "archive_enabled" field.
+func (_u *AuditLogRetentionPolicyUpdate) SetArchiveEnabled(v bool) *AuditLogRetentionPolicyUpdate {
+	_u.mutation.SetArchiveEnabled(v)
+	return _u
+}
+
+// SetNillableArchiveEnabled sets the "archive_enabled" field if the given value is not nil.
+func (_u *AuditLogRetentionPolicyUpdate) SetNillableArchiveEnabled(v *bool) *AuditLogRetentionPolicyUpdate {
+	if v != nil {
+		_u.SetArchiveEnabled(*v)
+	}
+	return _u
+}
+
+// ClearArchiveEnabled clears the value of the "archive_enabled" field.
+func (_u *AuditLogRetentionPolicyUpdate) ClearArchiveEnabled() *AuditLogRetentionPolicyUpdate {
+	_u.mutation.ClearArchiveEnabled()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *AuditLogRetentionPolicyUpdate) SetEnabled(v bool) *AuditLogRetentionPolicyUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *AuditLogRetentionPolicyUpdate) SetNillableEnabled(v *bool) *AuditLogRetentionPolicyUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// ClearEnabled clears the value of the "enabled" field.
+func (_u *AuditLogRetentionPolicyUpdate) ClearEnabled() *AuditLogRetentionPolicyUpdate {
+	_u.mutation.ClearEnabled()
+	return _u
+}
+
+// SetBatchSize sets the "batch_size" field.
+func (_u *AuditLogRetentionPolicyUpdate) SetBatchSize(v uint32) *AuditLogRetentionPolicyUpdate {
+	_u.mutation.ResetBatchSize()
+	_u.mutation.SetBatchSize(v)
+	return _u
+}
+
+// SetNillableBatchSize sets the "batch_size" field if the given value is not nil.
+func (_u *AuditLogRetentionPolicyUpdate) SetNillableBatchSize(v *uint32) *AuditLogRetentionPolicyUpdate {
+	if v != nil {
+		_u.SetBatchSize(*v)
+	}
+	return _u
+}
+
+// AddBatchSize adds value to the "batch_size" field.
+func (_u *AuditLogRetentionPolicyUpdate) AddBatchSize(v int32) *AuditLogRetentionPolicyUpdate {
+	_u.mutation.AddBatchSize(v)
+	return _u
+}
+
+// ClearBatchSize clears the value of the "batch_size" field.
+func (_u *AuditLogRetentionPolicyUpdate) ClearBatchSize() *AuditLogRetentionPolicyUpdate {
+	_u.mutation.ClearBatchSize()
+	return _u
+}
+
+// Mutation returns the AuditLogRetentionPolicyMutation object of the builder.
+func (_u *AuditLogRetentionPolicyUpdate) Mutation() *AuditLogRetentionPolicyMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AuditLogRetentionPolicyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuditLogRetentionPolicyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AuditLogRetentionPolicyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuditLogRetentionPolicyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AuditLogRetentionPolicyUpdate) check() error {
+	if v, ok := _u.mutation.LogType(); ok {
+		if err := auditlogretentionpolicy.LogTypeValidator(v); err != nil {
+			return &ValidationError{Name: "log_type", err: fmt.Errorf(`ent: validator failed for field "AuditLogRetentionPolicy.log_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AuditLogRetentionPolicyUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AuditLogRetentionPolicyUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AuditLogRetentionPolicyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(auditlogretentionpolicy.Table, auditlogretentionpolicy.Columns, sqlgraph.NewFieldSpec(auditlogretentionpolicy.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(auditlogretentionpolicy.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(auditlogretentionpolicy.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(auditlogretentionpolicy.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(auditlogretentionpolicy.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(auditlogretentionpolicy.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(auditlogretentionpolicy.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(auditlogretentionpolicy.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(auditlogretentionpolicy.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(auditlogretentionpolicy.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(auditlogretentionpolicy.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(auditlogretentionpolicy.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(auditlogretentionpolicy.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(auditlogretentionpolicy.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(auditlogretentionpolicy.FieldDeletedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Remark(); ok {
+		_spec.SetField(auditlogretentionpolicy.FieldRemark, field.TypeString, value)
+	}
+	if _u.mutation.RemarkCleared() {
+		_spec.ClearField(auditlogretentionpolicy.FieldRemark, field.TypeString)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(auditlogretentionpolicy.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.LogType(); ok {
+		_spec.SetField(auditlogretentionpolicy.FieldLogType, field.TypeEnum, value)
+	}
+	if _u.mutation.LogTypeCleared() {
+		_spec.ClearField(auditlogretentionpolicy.FieldLogType, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.RetentionDays(); ok {
+		_spec.SetField(auditlogretentionpolicy.FieldRetentionDays, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedRetentionDays(); ok {
+		_spec.AddField(auditlogretentionpolicy.FieldRetentionDays, field.TypeUint32, value)
+	}
+	if _u.mutation.RetentionDaysCleared() {
+		_spec.ClearField(auditlogretentionpolicy.FieldRetentionDays, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ArchiveEnabled(); ok {
+		_spec.SetField(auditlogretentionpolicy.FieldArchiveEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.ArchiveEnabledCleared() {
+		_spec.ClearField(auditlogretentionpolicy.FieldArchiveEnabled, field.TypeBool)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(auditlogretentionpolicy.FieldEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.EnabledCleared() {
+		_spec.ClearField(auditlogretentionpolicy.FieldEnabled, field.TypeBool)
+	}
+	if value, ok := _u.mutation.BatchSize(); ok {
+		_spec.SetField(auditlogretentionpolicy.FieldBatchSize, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedBatchSize(); ok {
+		_spec.AddField(auditlogretentionpolicy.FieldBatchSize, field.TypeUint32, value)
+	}
+	if _u.mutation.BatchSizeCleared() {
+		_spec.ClearField(auditlogretentionpolicy.FieldBatchSize, field.TypeUint32)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlogretentionpolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AuditLogRetentionPolicyUpdateOne is the builder for updating a single AuditLogRetentionPolicy entity.
+type AuditLogRetentionPolicyUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AuditLogRetentionPolicyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AuditLogRetentionPolicyUpdateOne) SetUpdatedAt(v time.Time) *AuditLogRetentionPolicyUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *AuditLogRetentionPolicyUpdateOne) SetNillableUpdatedAt(v *time.Time) *AuditLogRetentionPolicyUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *AuditLogRetentionPolicyUpdateOne) ClearUpdatedAt() *AuditLogRetentionPolicyUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *AuditLogRetentionPolicyUpdateOne) SetDeletedAt(v time.Time) *AuditLogRetentionPolicyUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *AuditLogRetentionPolicyUpdateOne) SetNillableDeletedAt(v *time.Time) *AuditLogRetentionPolicyUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *AuditLogRetentionPolicyUpdateOne) ClearDeletedAt() *AuditLogRetentionPolicyUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *AuditLogRetentionPolicyUpdateOne) SetCreatedBy(v uint32) *AuditLogRetentionPolicyUpdateOne {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *AuditLogRetentionPolicyUpdateOne) SetNillableCreatedBy(v *uint32) *AuditLogRetentionPolicyUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *AuditLogRetentionPolicyUpdateOne) AddCreatedBy(v int32) *AuditLogRetentionPolicyUpdateOne {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *AuditLogRetentionPolicyUpdateOne) ClearCreatedBy() *AuditLogRetentionPolicyUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *AuditLogRetentionPolicyUpdateOne) SetUpdatedBy(v uint32) *AuditLogRetentionPolicyUpdateOne {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *AuditLogRetentionPolicyUpdateOne) SetNillableUpdatedBy(v *uint32) *AuditLogRetentionPolicyUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *AuditLogRetentionPolicyUpdateOne) AddUpdatedBy(v int32) *AuditLogRetentionPolicyUpdateOne {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *AuditLogRetentionPolicyUpdateOne) ClearUpdatedBy() *AuditLogRetentionPolicyUpdateOne {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *AuditLogRetentionPolicyUpdateOne) SetDeletedBy(v uint32) *AuditLogRetentionPolicyUpdateOne {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *AuditLogRetentionPolicyUpdateOne) SetNillableDeletedBy(v *uint32) *AuditLogRetentionPolicyUpdateOne {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *AuditLogRetentionPolicyUpdateOne) AddDeletedBy(v int32) *AuditLogRetentionPolicyUpdateOne {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *AuditLogRetentionPolicyUpdateOne) ClearDeletedBy() *AuditLogRetentionPolicyUpdateOne {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetRemark sets the "remark" field.
+func (_u *AuditLogRetentionPolicyUpdateOne) SetRemark(v string) *AuditLogRetentionPolicyUpdateOne {
+	_u.mutation.SetRemark(v)
+	return _u
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (_u *AuditLogRetentionPolicyUpdateOne) SetNillableRemark(v *string) *AuditLogRetentionPolicyUpdateOne {
+	if v != nil {
+		_u.SetRemark(*v)
+	}
+	return _u
+}
+
+// ClearRemark clears the value of the "remark" field.
+func (_u *AuditLogRetentionPolicyUpdateOne) ClearRemark() *AuditLogRetentionPolicyUpdateOne {
+	_u.mutation.ClearRemark()
+	return _u
+}
+
+// SetLogType sets the "log_type" field.
+func (_u *AuditLogRetentionPolicyUpdateOne) SetLogType(v auditlogretentionpolicy.LogType) *AuditLogRetentionPolicyUpdateOne {
+	_u.mutation.SetLogType(v)
+	return _u
+}
+
+// SetNillableLogType sets the "log_type" field if the given value is not nil.
+func (_u *AuditLogRetentionPolicyUpdateOne) SetNillableLogType(v *auditlogretentionpolicy.LogType) *AuditLogRetentionPolicyUpdateOne {
+	if v != nil {
+		_u.SetLogType(*v)
+	}
+	return _u
+}
+
+// ClearLogType clears the value of the "log_type" field.
+func (_u *AuditLogRetentionPolicyUpdateOne) ClearLogType() *AuditLogRetentionPolicyUpdateOne {
+	_u.mutation.ClearLogType()
+	return _u
+}
+
+// SetRetentionDays sets the "retention_days" field.
+func (_u *AuditLogRetentionPolicyUpdateOne) SetRetentionDays(v uint32) *AuditLogRetentionPolicyUpdateOne {
+	_u.mutation.ResetRetentionDays()
+	_u.mutation.SetRetentionDays(v)
+	return _u
+}
+
+// SetNillableRetentionDays sets the "retention_days" field if the given value is not nil.
+func (_u *AuditLogRetentionPolicyUpdateOne) SetNillableRetentionDays(v *uint32) *AuditLogRetentionPolicyUpdateOne {
+	if v != nil {
+		_u.SetRetentionDays(*v)
+	}
+	return _u
+}
+
+// AddRetentionDays adds value to the "retention_days" field.
+func (_u *AuditLogRetentionPolicyUpdateOne) AddRetentionDays(v int32) *AuditLogRetentionPolicyUpdateOne {
+	_u.mutation.AddRetentionDays(v)
+	return _u
+}
+
+// ClearRetentionDays clears the value of the "retention_days" field.
+func (_u *AuditLogRetentionPolicyUpdateOne) ClearRetentionDays() *AuditLogRetentionPolicyUpdateOne {
+	_u.mutation.ClearRetentionDays()
+	return _u
+}
+
+// SetArchiveEnabled sets the "archive_enabled" field.
+func (_u *AuditLogRetentionPolicyUpdateOne) SetArchiveEnabled(v bool) *AuditLogRetentionPolicyUpdateOne {
+	_u.mutation.SetArchiveEnabled(v)
+	return _u
+}
+
+// SetNillableArchiveEnabled sets the "archive_enabled" field if the given value is not nil.
+func (_u *AuditLogRetentionPolicyUpdateOne) SetNillableArchiveEnabled(v *bool) *AuditLogRetentionPolicyUpdateOne {
+	if v != nil {
+		_u.SetArchiveEnabled(*v)
+	}
+	return _u
+}
+
+// ClearArchiveEnabled clears the value of the "archive_enabled" field.
+func (_u *AuditLogRetentionPolicyUpdateOne) ClearArchiveEnabled() *AuditLogRetentionPolicyUpdateOne {
+	_u.mutation.ClearArchiveEnabled()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *AuditLogRetentionPolicyUpdateOne) SetEnabled(v bool) *AuditLogRetentionPolicyUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *AuditLogRetentionPolicyUpdateOne) SetNillableEnabled(v *bool) *AuditLogRetentionPolicyUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// ClearEnabled clears the value of the "enabled" field.
+func (_u *AuditLogRetentionPolicyUpdateOne) ClearEnabled() *AuditLogRetentionPolicyUpdateOne {
+	_u.mutation.ClearEnabled()
+	return _u
+}
+
+// SetBatchSize sets the "batch_size" field.
+func (_u *AuditLogRetentionPolicyUpdateOne) SetBatchSize(v uint32) *AuditLogRetentionPolicyUpdateOne {
+	_u.mutation.ResetBatchSize()
+	_u.mutation.SetBatchSize(v)
+	return _u
+}
+
+// SetNillableBatchSize sets the "batch_size" field if the given value is not nil.
+func (_u *AuditLogRetentionPolicyUpdateOne) SetNillableBatchSize(v *uint32) *AuditLogRetentionPolicyUpdateOne {
+	if v != nil {
+		_u.SetBatchSize(*v)
+	}
+	return _u
+}
+
+// AddBatchSize adds value to the "batch_size" field.
+func (_u *AuditLogRetentionPolicyUpdateOne) AddBatchSize(v int32) *AuditLogRetentionPolicyUpdateOne {
+	_u.mutation.AddBatchSize(v)
+	return _u
+}
+
+// ClearBatchSize clears the value of the "batch_size" field.
+func (_u *AuditLogRetentionPolicyUpdateOne) ClearBatchSize() *AuditLogRetentionPolicyUpdateOne {
+	_u.mutation.ClearBatchSize()
+	return _u
+}
+
+// Mutation returns the AuditLogRetentionPolicyMutation object of the builder.
+func (_u *AuditLogRetentionPolicyUpdateOne) Mutation() *AuditLogRetentionPolicyMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AuditLogRetentionPolicyUpdate builder.
+func (_u *AuditLogRetentionPolicyUpdateOne) Where(ps ...predicate.AuditLogRetentionPolicy) *AuditLogRetentionPolicyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AuditLogRetentionPolicyUpdateOne) Select(field string, fields ...string) *AuditLogRetentionPolicyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AuditLogRetentionPolicy entity.
+func (_u *AuditLogRetentionPolicyUpdateOne) Save(ctx context.Context) (*AuditLogRetentionPolicy, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuditLogRetentionPolicyUpdateOne) SaveX(ctx context.Context) *AuditLogRetentionPolicy {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AuditLogRetentionPolicyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuditLogRetentionPolicyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AuditLogRetentionPolicyUpdateOne) check() error {
+	if v, ok := _u.mutation.LogType(); ok {
+		if err := auditlogretentionpolicy.LogTypeValidator(v); err != nil {
+			return &ValidationError{Name: "log_type", err: fmt.Errorf(`ent: validator failed for field "AuditLogRetentionPolicy.log_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AuditLogRetentionPolicyUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AuditLogRetentionPolicyUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AuditLogRetentionPolicyUpdateOne) sqlSave(ctx context.Context) (_node *AuditLogRetentionPolicy, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(auditlogretentionpolicy.Table, auditlogretentionpolicy.Columns, sqlgraph.NewFieldSpec(auditlogretentionpolicy.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AuditLogRetentionPolicy.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, auditlogretentionpolicy.FieldID)
+		for _, f := range fields {
+			if !auditlogretentionpolicy.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != auditlogretentionpolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(auditlogretentionpolicy.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(auditlogretentionpolicy.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(auditlogretentionpolicy.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(auditlogretentionpolicy.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(auditlogretentionpolicy.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(auditlogretentionpolicy.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(auditlogretentionpolicy.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(auditlogretentionpolicy.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(auditlogretentionpolicy.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(auditlogretentionpolicy.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(auditlogretentionpolicy.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(auditlogretentionpolicy.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(auditlogretentionpolicy.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(auditlogretentionpolicy.FieldDeletedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Remark(); ok {
+		_spec.SetField(auditlogretentionpolicy.FieldRemark, field.TypeString, value)
+	}
+	if _u.mutation.RemarkCleared() {
+		_spec.ClearField(auditlogretentionpolicy.FieldRemark, field.TypeString)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(auditlogretentionpolicy.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.LogType(); ok {
+		_spec.SetField(auditlogretentionpolicy.FieldLogType, field.TypeEnum, value)
+	}
+	if _u.mutation.LogTypeCleared() {
+		_spec.ClearField(auditlogretentionpolicy.FieldLogType, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.RetentionDays(); ok {
+		_spec.SetField(auditlogretentionpolicy.FieldRetentionDays, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedRetentionDays(); ok {
+		_spec.AddField(auditlogretentionpolicy.FieldRetentionDays, field.TypeUint32, value)
+	}
+	if _u.mutation.RetentionDaysCleared() {
+		_spec.ClearField(auditlogretentionpolicy.FieldRetentionDays, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ArchiveEnabled(); ok {
+		_spec.SetField(auditlogretentionpolicy.FieldArchiveEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.ArchiveEnabledCleared() {
+		_spec.ClearField(auditlogretentionpolicy.FieldArchiveEnabled, field.TypeBool)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(auditlogretentionpolicy.FieldEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.EnabledCleared() {
+		_spec.ClearField(auditlogretentionpolicy.FieldEnabled, field.TypeBool)
+	}
+	if value, ok := _u.mutation.BatchSize(); ok {
+		_spec.SetField(auditlogretentionpolicy.FieldBatchSize, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedBatchSize(); ok {
+		_spec.AddField(auditlogretentionpolicy.FieldBatchSize, field.TypeUint32, value)
+	}
+	if _u.mutation.BatchSizeCleared() {
+		_spec.ClearField(auditlogretentionpolicy.FieldBatchSize, field.TypeUint32)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &AuditLogRetentionPolicy{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlogretentionpolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -13,17 +13,23 @@ import (
 
 	"go-wind-admin/app/admin/service/internal/data/ent/api"
 	"go-wind-admin/app/admin/service/internal/data/ent/apiauditlog"
+	"go-wind-admin/app/admin/service/internal/data/ent/auditforwarder"
+	"go-wind-admin/app/admin/service/internal/data/ent/auditlogarchive"
+	"go-wind-admin/app/admin/service/internal/data/ent/auditlogretentionpolicy"
 	"go-wind-admin/app/admin/service/internal/data/ent/dataaccessauditlog"
 	"go-wind-admin/app/admin/service/internal/data/ent/dictentry"
 	"go-wind-admin/app/admin/service/internal/data/ent/dictentryi18n"
 	"go-wind-admin/app/admin/service/internal/data/ent/dicttype"
 	"go-wind-admin/app/admin/service/internal/data/ent/file"
+	"go-wind-admin/app/admin/service/internal/data/ent/filesharelink"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessage"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagecategory"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagerecipient"
 	"go-wind-admin/app/admin/service/internal/data/ent/language"
 	"go-wind-admin/app/admin/service/internal/data/ent/loginauditlog"
 	"go-wind-admin/app/admin/service/internal/data/ent/loginpolicy"
+	"go-wind-admin/app/admin/service/internal/data/ent/luascript"
+	"go-wind-admin/app/admin/service/internal/data/ent/luascriptversion"
 	"go-wind-admin/app/admin/service/internal/data/ent/membership"
 	"go-wind-admin/app/admin/service/internal/data/ent/membershiporgunit"
 	"go-wind-admin/app/admin/service/internal/data/ent/membershipposition"
@@ -39,10 +45,16 @@ import (
 	"go-wind-admin/app/admin/service/internal/data/ent/permissionpolicy"
 	"go-wind-admin/app/admin/service/internal/data/ent/policyevaluationlog"
 	"go-wind-admin/app/admin/service/internal/data/ent/position"
+	"go-wind-admin/app/admin/service/internal/data/ent/restoredauditlog"
 	"go-wind-admin/app/admin/service/internal/data/ent/role"
 	"go-wind-admin/app/admin/service/internal/data/ent/rolemetadata"
 	"go-wind-admin/app/admin/service/internal/data/ent/rolepermission"
+	"go-wind-admin/app/admin/service/internal/data/ent/storageobject"
+	"go-wind-admin/app/admin/service/internal/data/ent/storagequota"
 	"go-wind-admin/app/admin/service/internal/data/ent/task"
+	"go-wind-admin/app/admin/service/internal/data/ent/taskrun"
+	"go-wind-admin/app/admin/service/internal/data/ent/taskworkflow"
+	"go-wind-admin/app/admin/service/internal/data/ent/taskworkflowrun"
 	"go-wind-admin/app/admin/service/internal/data/ent/tenant"
 	"go-wind-admin/app/admin/service/internal/data/ent/user"
 	"go-wind-admin/app/admin/service/internal/data/ent/usercredential"
@@ -65,6 +77,12 @@ type Client struct {
 	Api *APIClient
 	// ApiAuditLog is the client for interacting with the ApiAuditLog builders.
 	ApiAuditLog *ApiAuditLogClient
+	// AuditForwarder is the client for interacting with the AuditForwarder builders.
+	AuditForwarder *AuditForwarderClient
+	// AuditLogArchive is the client for interacting with the AuditLogArchive builders.
+	AuditLogArchive *AuditLogArchiveClient
+	// AuditLogRetentionPolicy is the client for interacting with the AuditLogRetentionPolicy builders.
+	AuditLogRetentionPolicy *AuditLogRetentionPolicyClient
 	// DataAccessAuditLog is the client for interacting with the DataAccessAuditLog builders.
 	DataAccessAuditLog *DataAccessAuditLogClient
 	// DictEntry is the client for interacting with the DictEntry builders.
@@ -75,6 +93,8 @@ type Client struct {
 	DictType *DictTypeClient
 	// File is the client for interacting with the File builders.
 	File *FileClient
+	// FileShareLink is the client for interacting with the FileShareLink builders.
+	FileShareLink *FileShareLinkClient
 	// InternalMessage is the client for interacting with the InternalMessage builders.
 	InternalMessage *InternalMessageClient
 	// InternalMessageCategory is the client for interacting with the InternalMessageCategory builders.
@@ -87,6 +107,10 @@ type Client struct {
 	LoginAuditLog *LoginAuditLogClient
 	// LoginPolicy is the client for interacting with the LoginPolicy builders.
 	LoginPolicy *LoginPolicyClient
+	// LuaScript is the client for interacting with the LuaScript builders.
+	LuaScript *LuaScriptClient
+	// LuaScriptVersion is the client for interacting with the LuaScriptVersion builders.
+	LuaScriptVersion *LuaScriptVersionClient
 	// Membership is the client for interacting with the Membership builders.
 	Membership *MembershipClient
 	// MembershipOrgUnit is the client for interacting with the MembershipOrgUnit builders.
@@ -117,14 +141,26 @@ type Client struct {
 	PolicyEvaluationLog *PolicyEvaluationLogClient
 	// Position is the client for interacting with the Position builders.
 	Position *PositionClient
+	// RestoredAuditLog is the client for interacting with the RestoredAuditLog builders.
+	RestoredAuditLog *RestoredAuditLogClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
 	// RoleMetadata is the client for interacting with the RoleMetadata builders.
 	RoleMetadata *RoleMetadataClient
 	// RolePermission is the client for interacting with the RolePermission builders.
 	RolePermission *RolePermissionClient
+	// StorageObject is the client for interacting with the StorageObject builders.
+	StorageObject *StorageObjectClient
+	// StorageQuota is the client for interacting with the StorageQuota builders.
+	StorageQuota *StorageQuotaClient
 	// Task is the client for interacting with the Task builders.
 	Task *TaskClient
+	// TaskRun is the client for interacting with the TaskRun builders.
+	TaskRun *TaskRunClient
+	// TaskWorkflow is the client for interacting with the TaskWorkflow builders.
+	TaskWorkflow *TaskWorkflowClient
+	// TaskWorkflowRun is the client for interacting with the TaskWorkflowRun builders.
+	TaskWorkflowRun *TaskWorkflowRunClient
 	// Tenant is the client for interacting with the Tenant builders.
 	Tenant *TenantClient
 	// User is the client for interacting with the User builders.
@@ -150,17 +186,23 @@ func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Api = NewAPIClient(c.config)
 	c.ApiAuditLog = NewApiAuditLogClient(c.config)
+	c.AuditForwarder = NewAuditForwarderClient(c.config)
+	c.AuditLogArchive = NewAuditLogArchiveClient(c.config)
+	c.AuditLogRetentionPolicy = NewAuditLogRetentionPolicyClient(c.config)
 	c.DataAccessAuditLog = NewDataAccessAuditLogClient(c.config)
 	c.DictEntry = NewDictEntryClient(c.config)
 	c.DictEntryI18n = NewDictEntryI18nClient(c.config)
 	c.DictType = NewDictTypeClient(c.config)
 	c.File = NewFileClient(c.config)
+	c.FileShareLink = NewFileShareLinkClient(c.config)
 	c.InternalMessage = NewInternalMessageClient(c.config)
 	c.InternalMessageCategory = NewInternalMessageCategoryClient(c.config)
 	c.InternalMessageRecipient = NewInternalMessageRecipientClient(c.config)
 	c.Language = NewLanguageClient(c.config)
 	c.LoginAuditLog = NewLoginAuditLogClient(c.config)
 	c.LoginPolicy = NewLoginPolicyClient(c.config)
+	c.LuaScript = NewLuaScriptClient(c.config)
+	c.LuaScriptVersion = NewLuaScriptVersionClient(c.config)
 	c.Membership = NewMembershipClient(c.config)
 	c.MembershipOrgUnit = NewMembershipOrgUnitClient(c.config)
 	c.MembershipPosition = NewMembershipPositionClient(c.config)
@@ -176,10 +218,16 @@ func (c *Client) init() {
 	c.PermissionPolicy = NewPermissionPolicyClient(c.config)
 	c.PolicyEvaluationLog = NewPolicyEvaluationLogClient(c.config)
 	c.Position = NewPositionClient(c.config)
+	c.RestoredAuditLog = NewRestoredAuditLogClient(c.config)
 	c.Role = NewRoleClient(c.config)
 	c.RoleMetadata = NewRoleMetadataClient(c.config)
 	c.RolePermission = NewRolePermissionClient(c.config)
+	c.StorageObject = NewStorageObjectClient(c.config)
+	c.StorageQuota = NewStorageQuotaClient(c.config)
 	c.Task = NewTaskClient(c.config)
+	c.TaskRun = NewTaskRunClient(c.config)
+	c.TaskWorkflow = NewTaskWorkflowClient(c.config)
+	c.TaskWorkflowRun = NewTaskWorkflowRunClient(c.config)
 	c.Tenant = NewTenantClient(c.config)
 	c.User = NewUserClient(c.config)
 	c.UserCredential = NewUserCredentialClient(c.config)
@@ -280,17 +328,23 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		config:                   cfg,
 		Api:                      NewAPIClient(cfg),
 		ApiAuditLog:              NewApiAuditLogClient(cfg),
+		AuditForwarder:           NewAuditForwarderClient(cfg),
+		AuditLogArchive:          NewAuditLogArchiveClient(cfg),
+		AuditLogRetentionPolicy:  NewAuditLogRetentionPolicyClient(cfg),
 		DataAccessAuditLog:       NewDataAccessAuditLogClient(cfg),
 		DictEntry:                NewDictEntryClient(cfg),
 		DictEntryI18n:            NewDictEntryI18nClient(cfg),
 		DictType:                 NewDictTypeClient(cfg),
 		File:                     NewFileClient(cfg),
+		FileShareLink:            NewFileShareLinkClient(cfg),
 		InternalMessage:          NewInternalMessageClient(cfg),
 		InternalMessageCategory:  NewInternalMessageCategoryClient(cfg),
 		InternalMessageRecipient: NewInternalMessageRecipientClient(cfg),
 		Language:                 NewLanguageClient(cfg),
 		LoginAuditLog:            NewLoginAuditLogClient(cfg),
 		LoginPolicy:              NewLoginPolicyClient(cfg),
+		LuaScript:                NewLuaScriptClient(cfg),
+		LuaScriptVersion:         NewLuaScriptVersionClient(cfg),
 		Membership:               NewMembershipClient(cfg),
 		MembershipOrgUnit:        NewMembershipOrgUnitClient(cfg),
 		MembershipPosition:       NewMembershipPositionClient(cfg),
@@ -306,10 +360,16 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		PermissionPolicy:         NewPermissionPolicyClient(cfg),
 		PolicyEvaluationLog:      NewPolicyEvaluationLogClient(cfg),
 		Position:                 NewPositionClient(cfg),
+		RestoredAuditLog:         NewRestoredAuditLogClient(cfg),
 		Role:                     NewRoleClient(cfg),
 		RoleMetadata:             NewRoleMetadataClient(cfg),
 		RolePermission:           NewRolePermissionClient(cfg),
+		StorageObject:            NewStorageObjectClient(cfg),
+		StorageQuota:             NewStorageQuotaClient(cfg),
 		Task:                     NewTaskClient(cfg),
+		TaskRun:                  NewTaskRunClient(cfg),
+		TaskWorkflow:             NewTaskWorkflowClient(cfg),
+		TaskWorkflowRun:          NewTaskWorkflowRunClient(cfg),
 		Tenant:                   NewTenantClient(cfg),
 		User:                     NewUserClient(cfg),
 		UserCredential:           NewUserCredentialClient(cfg),
@@ -337,17 +397,23 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		config:                   cfg,
 		Api:                      NewAPIClient(cfg),
 		ApiAuditLog:              NewApiAuditLogClient(cfg),
+		AuditForwarder:           NewAuditForwarderClient(cfg),
+		AuditLogArchive:          NewAuditLogArchiveClient(cfg),
+		AuditLogRetentionPolicy:  NewAuditLogRetentionPolicyClient(cfg),
 		DataAccessAuditLog:       NewDataAccessAuditLogClient(cfg),
 		DictEntry:                NewDictEntryClient(cfg),
 		DictEntryI18n:            NewDictEntryI18nClient(cfg),
 		DictType:                 NewDictTypeClient(cfg),
 		File:                     NewFileClient(cfg),
+		FileShareLink:            NewFileShareLinkClient(cfg),
 		InternalMessage:          NewInternalMessageClient(cfg),
 		InternalMessageCategory:  NewInternalMessageCategoryClient(cfg),
 		InternalMessageRecipient: NewInternalMessageRecipientClient(cfg),
 		Language:                 NewLanguageClient(cfg),
 		LoginAuditLog:            NewLoginAuditLogClient(cfg),
 		LoginPolicy:              NewLoginPolicyClient(cfg),
+		LuaScript:                NewLuaScriptClient(cfg),
+		LuaScriptVersion:         NewLuaScriptVersionClient(cfg),
 		Membership:               NewMembershipClient(cfg),
 		MembershipOrgUnit:        NewMembershipOrgUnitClient(cfg),
 		MembershipPosition:       NewMembershipPositionClient(cfg),
@@ -363,10 +429,16 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		PermissionPolicy:         NewPermissionPolicyClient(cfg),
 		PolicyEvaluationLog:      NewPolicyEvaluationLogClient(cfg),
 		Position:                 NewPositionClient(cfg),
+		RestoredAuditLog:         NewRestoredAuditLogClient(cfg),
 		Role:                     NewRoleClient(cfg),
 		RoleMetadata:             NewRoleMetadataClient(cfg),
 		RolePermission:           NewRolePermissionClient(cfg),
+		StorageObject:            NewStorageObjectClient(cfg),
+		StorageQuota:             NewStorageQuotaClient(cfg),
 		Task:                     NewTaskClient(cfg),
+		TaskRun:                  NewTaskRunClient(cfg),
+		TaskWorkflow:             NewTaskWorkflowClient(cfg),
+		TaskWorkflowRun:          NewTaskWorkflowRunClient(cfg),
 		Tenant:                   NewTenantClient(cfg),
 		User:                     NewUserClient(cfg),
 		UserCredential:           NewUserCredentialClient(cfg),
@@ -402,15 +474,18 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Api, c.ApiAuditLog, c.DataAccessAuditLog, c.DictEntry, c.DictEntryI18n,
-		c.DictType, c.File, c.InternalMessage, c.InternalMessageCategory,
-		c.InternalMessageRecipient, c.Language, c.LoginAuditLog, c.LoginPolicy,
-		c.Membership, c.MembershipOrgUnit, c.MembershipPosition, c.MembershipRole,
-		c.Menu, c.OperationAuditLog, c.OrgUnit, c.Permission, c.PermissionApi,
+		c.Api, c.ApiAuditLog, c.AuditForwarder, c.AuditLogArchive,
+		c.AuditLogRetentionPolicy, c.DataAccessAuditLog, c.DictEntry, c.DictEntryI18n,
+		c.DictType, c.File, c.FileShareLink, c.InternalMessage,
+		c.InternalMessageCategory, c.InternalMessageRecipient, c.Language,
+		c.LoginAuditLog, c.LoginPolicy, c.LuaScript, c.LuaScriptVersion, c.Membership,
+		c.MembershipOrgUnit, c.MembershipPosition, c.MembershipRole, c.Menu,
+		c.OperationAuditLog, c.OrgUnit, c.Permission, c.PermissionApi,
 		c.PermissionAuditLog, c.PermissionGroup, c.PermissionMenu, c.PermissionPolicy,
-		c.PolicyEvaluationLog, c.Position, c.Role, c.RoleMetadata, c.RolePermission,
-		c.Task, c.Tenant, c.User, c.UserCredential, c.UserOrgUnit, c.UserPosition,
-		c.UserRole,
+		c.PolicyEvaluationLog, c.Position, c.RestoredAuditLog, c.Role, c.RoleMetadata,
+		c.RolePermission, c.StorageObject, c.StorageQuota, c.Task, c.TaskRun,
+		c.TaskWorkflow, c.TaskWorkflowRun, c.Tenant, c.User, c.UserCredential,
+		c.UserOrgUnit, c.UserPosition, c.UserRole,
 	} {
 		n.Use(hooks...)
 	}
@@ -420,15 +495,18 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Api, c.ApiAuditLog, c.DataAccessAuditLog, c.DictEntry, c.DictEntryI18n,
-		c.DictType, c.File, c.InternalMessage, c.InternalMessageCategory,
-		c.InternalMessageRecipient, c.Language, c.LoginAuditLog, c.LoginPolicy,
-		c.Membership, c.MembershipOrgUnit, c.MembershipPosition, c.MembershipRole,
-		c.Menu, c.OperationAuditLog, c.OrgUnit, c.Permission, c.PermissionApi,
+		c.Api, c.ApiAuditLog, c.AuditForwarder, c.AuditLogArchive,
+		c.AuditLogRetentionPolicy, c.DataAccessAuditLog, c.DictEntry, c.DictEntryI18n,
+		c.DictType, c.File, c.FileShareLink, c.InternalMessage,
+		c.InternalMessageCategory, c.InternalMessageRecipient, c.Language,
+		c.LoginAuditLog, c.LoginPolicy, c.LuaScript, c.LuaScriptVersion, c.Membership,
+		c.MembershipOrgUnit, c.MembershipPosition, c.MembershipRole, c.Menu,
+		c.OperationAuditLog, c.OrgUnit, c.Permission, c.PermissionApi,
 		c.PermissionAuditLog, c.PermissionGroup, c.PermissionMenu, c.PermissionPolicy,
-		c.PolicyEvaluationLog, c.Position, c.Role, c.RoleMetadata, c.RolePermission,
-		c.Task, c.Tenant, c.User, c.UserCredential, c.UserOrgUnit, c.UserPosition,
-		c.UserRole,
+		c.PolicyEvaluationLog, c.Position, c.RestoredAuditLog, c.Role, c.RoleMetadata,
+		c.RolePermission, c.StorageObject, c.StorageQuota, c.Task, c.TaskRun,
+		c.TaskWorkflow, c.TaskWorkflowRun, c.Tenant, c.User, c.UserCredential,
+		c.UserOrgUnit, c.UserPosition, c.UserRole,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -441,6 +519,12 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Api.mutate(ctx, m)
 	case *ApiAuditLogMutation:
 		return c.ApiAuditLog.mutate(ctx, m)
+	case *AuditForwarderMutation:
+		return c.AuditForwarder.mutate(ctx, m)
+	case *AuditLogArchiveMutation:
+		return c.AuditLogArchive.mutate(ctx, m)
+	case *AuditLogRetentionPolicyMutation:
+		return c.AuditLogRetentionPolicy.mutate(ctx, m)
 	case *DataAccessAuditLogMutation:
 		return c.DataAccessAuditLog.mutate(ctx, m)
 	case *DictEntryMutation:
@@ -451,6 +535,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.DictType.mutate(ctx, m)
 	case *FileMutation:
 		return c.File.mutate(ctx, m)
+	case *FileShareLinkMutation:
+		return c.FileShareLink.mutate(ctx, m)
 	case *InternalMessageMutation:
 		return c.InternalMessage.mutate(ctx, m)
 	case *InternalMessageCategoryMutation:
@@ -463,6 +549,10 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.LoginAuditLog.mutate(ctx, m)
 	case *LoginPolicyMutation:
 		return c.LoginPolicy.mutate(ctx, m)
+	case *LuaScriptMutation:
+		return c.LuaScript.mutate(ctx, m)
+	case *LuaScriptVersionMutation:
+		return c.LuaScriptVersion.mutate(ctx, m)
 	case *MembershipMutation:
 		return c.Membership.mutate(ctx, m)
 	case *MembershipOrgUnitMutation:
@@ -493,14 +583,26 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.PolicyEvaluationLog.mutate(ctx, m)
 	case *PositionMutation:
 		return c.Position.mutate(ctx, m)
+	case *RestoredAuditLogMutation:
+		return c.RestoredAuditLog.mutate(ctx, m)
 	case *RoleMutation:
 		return c.Role.mutate(ctx, m)
 	case *RoleMetadataMutation:
 		return c.RoleMetadata.mutate(ctx, m)
 	case *RolePermissionMutation:
 		return c.RolePermission.mutate(ctx, m)
+	case *StorageObjectMutation:
+		return c.StorageObject.mutate(ctx, m)
+	case *StorageQuotaMutation:
+		return c.StorageQuota.mutate(ctx, m)
 	case *TaskMutation:
 		return c.Task.mutate(ctx, m)
+	case *TaskRunMutation:
+		return c.TaskRun.mutate(ctx, m)
+	case *TaskWorkflowMutation:
+		return c.TaskWorkflow.mutate(ctx, m)
+	case *TaskWorkflowRunMutation:
+		return c.TaskWorkflowRun.mutate(ctx, m)
 	case *TenantMutation:
 		return c.Tenant.mutate(ctx, m)
 	case *UserMutation:
@@ -785,6 +887,408 @@ func (c *ApiAuditLogClient) mutate(ctx context.Context, m *ApiAuditLogMutation)
 	}
 }
 
+// AuditForwarderClient is a client for the AuditForwarder schema.
+type AuditForwarderClient struct {
+	config
+}
+
+// NewAuditForwarderClient returns a client for the AuditForwarder from the given config.
+func NewAuditForwarderClient(c config) *AuditForwarderClient {
+	return &AuditForwarderClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditforwarder.Hooks(f(g(h())))`.
+func (c *AuditForwarderClient) Use(hooks ...Hook) {
+	c.hooks.AuditForwarder = append(c.hooks.AuditForwarder, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `auditforwarder.Intercept(f(g(h())))`.
+func (c *AuditForwarderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditForwarder = append(c.inters.AuditForwarder, interceptors...)
+}
+
+// Create returns a builder for creating a AuditForwarder entity.
+func (c *AuditForwarderClient) Create() *AuditForwarderCreate {
+	mutation := newAuditForwarderMutation(c.config, OpCreate)
+	return &AuditForwarderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuditForwarder entities.
+func (c *AuditForwarderClient) CreateBulk(builders ...*AuditForwarderCreate) *AuditForwarderCreateBulk {
+	return &AuditForwarderCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AuditForwarderClient) MapCreateBulk(slice any, setFunc func(*AuditForwarderCreate, int)) *AuditForwarderCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AuditForwarderCreateBulk{err: fmt.Errorf("calling to AuditForwarderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AuditForwarderCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AuditForwarderCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditForwarder.
+func (c *AuditForwarderClient) Update() *AuditForwarderUpdate {
+	mutation := newAuditForwarderMutation(c.config, OpUpdate)
+	return &AuditForwarderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditForwarderClient) UpdateOne(_m *AuditForwarder) *AuditForwarderUpdateOne {
+	mutation := newAuditForwarderMutation(c.config, OpUpdateOne, withAuditForwarder(_m))
+	return &AuditForwarderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditForwarderClient) UpdateOneID(id uint32) *AuditForwarderUpdateOne {
+	mutation := newAuditForwarderMutation(c.config, OpUpdateOne, withAuditForwarderID(id))
+	return &AuditForwarderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditForwarder.
+func (c *AuditForwarderClient) Delete() *AuditForwarderDelete {
+	mutation := newAuditForwarderMutation(c.config, OpDelete)
+	return &AuditForwarderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuditForwarderClient) DeleteOne(_m *AuditForwarder) *AuditForwarderDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuditForwarderClient) DeleteOneID(id uint32) *AuditForwarderDeleteOne {
+	builder := c.Delete().Where(auditforwarder.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditForwarderDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditForwarder.
+func (c *AuditForwarderClient) Query() *AuditForwarderQuery {
+	return &AuditForwarderQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuditForwarder},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AuditForwarder entity by its id.
+func (c *AuditForwarderClient) Get(ctx context.Context, id uint32) (*AuditForwarder, error) {
+	return c.Query().Where(auditforwarder.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditForwarderClient) GetX(ctx context.Context, id uint32) *AuditForwarder {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuditForwarderClient) Hooks() []Hook {
+	hooks := c.hooks.AuditForwarder
+	return append(hooks[:len(hooks):len(hooks)], auditforwarder.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuditForwarderClient) Interceptors() []Interceptor {
+	return c.inters.AuditForwarder
+}
+
+func (c *AuditForwarderClient) mutate(ctx context.Context, m *AuditForwarderMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuditForwarderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuditForwarderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuditForwarderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuditForwarderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AuditForwarder mutation op: %q", m.Op())
+	}
+}
+
+// AuditLogArchiveClient is a client for the AuditLogArchive schema.
+type AuditLogArchiveClient struct {
+	config
+}
+
+// NewAuditLogArchiveClient returns a client for the AuditLogArchive from the given config.
+func NewAuditLogArchiveClient(c config) *AuditLogArchiveClient {
+	return &AuditLogArchiveClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditlogarchive.Hooks(f(g(h())))`.
+func (c *AuditLogArchiveClient) Use(hooks ...Hook) {
+	c.hooks.AuditLogArchive = append(c.hooks.AuditLogArchive, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `auditlogarchive.Intercept(f(g(h())))`.
+func (c *AuditLogArchiveClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditLogArchive = append(c.inters.AuditLogArchive, interceptors...)
+}
+
+// Create returns a builder for creating a AuditLogArchive entity.
+func (c *AuditLogArchiveClient) Create() *AuditLogArchiveCreate {
+	mutation := newAuditLogArchiveMutation(c.config, OpCreate)
+	return &AuditLogArchiveCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuditLogArchive entities.
+func (c *AuditLogArchiveClient) CreateBulk(builders ...*AuditLogArchiveCreate) *AuditLogArchiveCreateBulk {
+	return &AuditLogArchiveCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AuditLogArchiveClient) MapCreateBulk(slice any, setFunc func(*AuditLogArchiveCreate, int)) *AuditLogArchiveCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AuditLogArchiveCreateBulk{err: fmt.Errorf("calling to AuditLogArchiveClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AuditLogArchiveCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AuditLogArchiveCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditLogArchive.
+func (c *AuditLogArchiveClient) Update() *AuditLogArchiveUpdate {
+	mutation := newAuditLogArchiveMutation(c.config, OpUpdate)
+	return &AuditLogArchiveUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditLogArchiveClient) UpdateOne(_m *AuditLogArchive) *AuditLogArchiveUpdateOne {
+	mutation := newAuditLogArchiveMutation(c.config, OpUpdateOne, withAuditLogArchive(_m))
+	return &AuditLogArchiveUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditLogArchiveClient) UpdateOneID(id uint32) *AuditLogArchiveUpdateOne {
+	mutation := newAuditLogArchiveMutation(c.config, OpUpdateOne, withAuditLogArchiveID(id))
+	return &AuditLogArchiveUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditLogArchive.
+func (c *AuditLogArchiveClient) Delete() *AuditLogArchiveDelete {
+	mutation := newAuditLogArchiveMutation(c.config, OpDelete)
+	return &AuditLogArchiveDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuditLogArchiveClient) DeleteOne(_m *AuditLogArchive) *AuditLogArchiveDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuditLogArchiveClient) DeleteOneID(id uint32) *AuditLogArchiveDeleteOne {
+	builder := c.Delete().Where(auditlogarchive.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditLogArchiveDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditLogArchive.
+func (c *AuditLogArchiveClient) Query() *AuditLogArchiveQuery {
+	return &AuditLogArchiveQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuditLogArchive},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AuditLogArchive entity by its id.
+func (c *AuditLogArchiveClient) Get(ctx context.Context, id uint32) (*AuditLogArchive, error) {
+	return c.Query().Where(auditlogarchive.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditLogArchiveClient) GetX(ctx context.Context, id uint32) *AuditLogArchive {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuditLogArchiveClient) Hooks() []Hook {
+	hooks := c.hooks.AuditLogArchive
+	return append(hooks[:len(hooks):len(hooks)], auditlogarchive.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuditLogArchiveClient) Interceptors() []Interceptor {
+	return c.inters.AuditLogArchive
+}
+
+func (c *AuditLogArchiveClient) mutate(ctx context.Context, m *AuditLogArchiveMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuditLogArchiveCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuditLogArchiveUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuditLogArchiveUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuditLogArchiveDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AuditLogArchive mutation op: %q", m.Op())
+	}
+}
+
+// AuditLogRetentionPolicyClient is a client for the AuditLogRetentionPolicy schema.
+type AuditLogRetentionPolicyClient struct {
+	config
+}
+
+// NewAuditLogRetentionPolicyClient returns a client for the AuditLogRetentionPolicy from the given config.
+func NewAuditLogRetentionPolicyClient(c config) *AuditLogRetentionPolicyClient {
+	return &AuditLogRetentionPolicyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditlogretentionpolicy.Hooks(f(g(h())))`.
+func (c *AuditLogRetentionPolicyClient) Use(hooks ...Hook) {
+	c.hooks.AuditLogRetentionPolicy = append(c.hooks.AuditLogRetentionPolicy, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `auditlogretentionpolicy.Intercept(f(g(h())))`.
+func (c *AuditLogRetentionPolicyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditLogRetentionPolicy = append(c.inters.AuditLogRetentionPolicy, interceptors...)
+}
+
+// Create returns a builder for creating a AuditLogRetentionPolicy entity.
+func (c *AuditLogRetentionPolicyClient) Create() *AuditLogRetentionPolicyCreate {
+	mutation := newAuditLogRetentionPolicyMutation(c.config, OpCreate)
+	return &AuditLogRetentionPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuditLogRetentionPolicy entities.
+func (c *AuditLogRetentionPolicyClient) CreateBulk(builders ...*AuditLogRetentionPolicyCreate) *AuditLogRetentionPolicyCreateBulk {
+	return &AuditLogRetentionPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AuditLogRetentionPolicyClient) MapCreateBulk(slice any, setFunc func(*AuditLogRetentionPolicyCreate, int)) *AuditLogRetentionPolicyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AuditLogRetentionPolicyCreateBulk{err: fmt.Errorf("calling to AuditLogRetentionPolicyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AuditLogRetentionPolicyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AuditLogRetentionPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditLogRetentionPolicy.
+func (c *AuditLogRetentionPolicyClient) Update() *AuditLogRetentionPolicyUpdate {
+	mutation := newAuditLogRetentionPolicyMutation(c.config, OpUpdate)
+	return &AuditLogRetentionPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditLogRetentionPolicyClient) UpdateOne(_m *AuditLogRetentionPolicy) *AuditLogRetentionPolicyUpdateOne {
+	mutation := newAuditLogRetentionPolicyMutation(c.config, OpUpdateOne, withAuditLogRetentionPolicy(_m))
+	return &AuditLogRetentionPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditLogRetentionPolicyClient) UpdateOneID(id uint32) *AuditLogRetentionPolicyUpdateOne {
+	mutation := newAuditLogRetentionPolicyMutation(c.config, OpUpdateOne, withAuditLogRetentionPolicyID(id))
+	return &AuditLogRetentionPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditLogRetentionPolicy.
+func (c *AuditLogRetentionPolicyClient) Delete() *AuditLogRetentionPolicyDelete {
+	mutation := newAuditLogRetentionPolicyMutation(c.config, OpDelete)
+	return &AuditLogRetentionPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuditLogRetentionPolicyClient) DeleteOne(_m *AuditLogRetentionPolicy) *AuditLogRetentionPolicyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuditLogRetentionPolicyClient) DeleteOneID(id uint32) *AuditLogRetentionPolicyDeleteOne {
+	builder := c.Delete().Where(auditlogretentionpolicy.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditLogRetentionPolicyDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditLogRetentionPolicy.
+func (c *AuditLogRetentionPolicyClient) Query() *AuditLogRetentionPolicyQuery {
+	return &AuditLogRetentionPolicyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuditLogRetentionPolicy},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AuditLogRetentionPolicy entity by its id.
+func (c *AuditLogRetentionPolicyClient) Get(ctx context.Context, id uint32) (*AuditLogRetentionPolicy, error) {
+	return c.Query().Where(auditlogretentionpolicy.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditLogRetentionPolicyClient) GetX(ctx context.Context, id uint32) *AuditLogRetentionPolicy {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuditLogRetentionPolicyClient) Hooks() []Hook {
+	hooks := c.hooks.AuditLogRetentionPolicy
+	return append(hooks[:len(hooks):len(hooks)], auditlogretentionpolicy.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuditLogRetentionPolicyClient) Interceptors() []Interceptor {
+	return c.inters.AuditLogRetentionPolicy
+}
+
+func (c *AuditLogRetentionPolicyClient) mutate(ctx context.Context, m *AuditLogRetentionPolicyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuditLogRetentionPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuditLogRetentionPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuditLogRetentionPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuditLogRetentionPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AuditLogRetentionPolicy mutation op: %q", m.Op())
+	}
+}
+
 // DataAccessAuditLogClient is a client for the DataAccessAuditLog schema.
 type DataAccessAuditLogClient struct {
 	config
@@ -1519,69 +2023,203 @@ func (c *FileClient) mutate(ctx context.Context, m *FileMutation) (Value, error)
 	}
 }
 
-// InternalMessageClient is a client for the InternalMessage schema.
-type InternalMessageClient struct {
+// FileShareLinkClient is a client for the FileShareLink schema.
+type FileShareLinkClient struct {
 	config
 }
 
-// NewInternalMessageClient returns a client for the InternalMessage from the given config.
-func NewInternalMessageClient(c config) *InternalMessageClient {
-	return &InternalMessageClient{config: c}
+// NewFileShareLinkClient returns a client for the FileShareLink from the given config.
+func NewFileShareLinkClient(c config) *FileShareLinkClient {
+	return &FileShareLinkClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `internalmessage.Hooks(f(g(h())))`.
-func (c *InternalMessageClient) Use(hooks ...Hook) {
-	c.hooks.InternalMessage = append(c.hooks.InternalMessage, hooks...)
+// A call to `Use(f, g, h)` equals to `filesharelink.Hooks(f(g(h())))`.
+func (c *FileShareLinkClient) Use(hooks ...Hook) {
+	c.hooks.FileShareLink = append(c.hooks.FileShareLink, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `internalmessage.Intercept(f(g(h())))`.
-func (c *InternalMessageClient) Intercept(interceptors ...Interceptor) {
-	c.inters.InternalMessage = append(c.inters.InternalMessage, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `filesharelink.Intercept(f(g(h())))`.
+func (c *FileShareLinkClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FileShareLink = append(c.inters.FileShareLink, interceptors...)
 }
 
-// Create returns a builder for creating a InternalMessage entity.
-func (c *InternalMessageClient) Create() *InternalMessageCreate {
-	mutation := newInternalMessageMutation(c.config, OpCreate)
-	return &InternalMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a FileShareLink entity.
+func (c *FileShareLinkClient) Create() *FileShareLinkCreate {
+	mutation := newFileShareLinkMutation(c.config, OpCreate)
+	return &FileShareLinkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of InternalMessage entities.
-func (c *InternalMessageClient) CreateBulk(builders ...*InternalMessageCreate) *InternalMessageCreateBulk {
-	return &InternalMessageCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of FileShareLink entities.
+func (c *FileShareLinkClient) CreateBulk(builders ...*FileShareLinkCreate) *FileShareLinkCreateBulk {
+	return &FileShareLinkCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *InternalMessageClient) MapCreateBulk(slice any, setFunc func(*InternalMessageCreate, int)) *InternalMessageCreateBulk {
+func (c *FileShareLinkClient) MapCreateBulk(slice any, setFunc func(*FileShareLinkCreate, int)) *FileShareLinkCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &InternalMessageCreateBulk{err: fmt.Errorf("calling to InternalMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &FileShareLinkCreateBulk{err: fmt.Errorf("calling to FileShareLinkClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*InternalMessageCreate, rv.Len())
+	builders := make([]*FileShareLinkCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &InternalMessageCreateBulk{config: c.config, builders: builders}
+	return &FileShareLinkCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for InternalMessage.
-func (c *InternalMessageClient) Update() *InternalMessageUpdate {
-	mutation := newInternalMessageMutation(c.config, OpUpdate)
-	return &InternalMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for FileShareLink.
+func (c *FileShareLinkClient) Update() *FileShareLinkUpdate {
+	mutation := newFileShareLinkMutation(c.config, OpUpdate)
+	return &FileShareLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *InternalMessageClient) UpdateOne(_m *InternalMessage) *InternalMessageUpdateOne {
-	mutation := newInternalMessageMutation(c.config, OpUpdateOne, withInternalMessage(_m))
-	return &InternalMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *FileShareLinkClient) UpdateOne(_m *FileShareLink) *FileShareLinkUpdateOne {
+	mutation := newFileShareLinkMutation(c.config, OpUpdateOne, withFileShareLink(_m))
+	return &FileShareLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *InternalMessageClient) UpdateOneID(id uint32) *InternalMessageUpdateOne {
-	mutation := newInternalMessageMutation(c.config, OpUpdateOne, withInternalMessageID(id))
+func (c *FileShareLinkClient) UpdateOneID(id uint32) *FileShareLinkUpdateOne {
+	mutation := newFileShareLinkMutation(c.config, OpUpdateOne, withFileShareLinkID(id))
+	return &FileShareLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FileShareLink.
+func (c *FileShareLinkClient) Delete() *FileShareLinkDelete {
+	mutation := newFileShareLinkMutation(c.config, OpDelete)
+	return &FileShareLinkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FileShareLinkClient) DeleteOne(_m *FileShareLink) *FileShareLinkDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FileShareLinkClient) DeleteOneID(id uint32) *FileShareLinkDeleteOne {
+	builder := c.Delete().Where(filesharelink.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FileShareLinkDeleteOne{builder}
+}
+
+// Query returns a query builder for FileShareLink.
+func (c *FileShareLinkClient) Query() *FileShareLinkQuery {
+	return &FileShareLinkQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFileShareLink},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FileShareLink entity by its id.
+func (c *FileShareLinkClient) Get(ctx context.Context, id uint32) (*FileShareLink, error) {
+	return c.Query().Where(filesharelink.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FileShareLinkClient) GetX(ctx context.Context, id uint32) *FileShareLink {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *FileShareLinkClient) Hooks() []Hook {
+	hooks := c.hooks.FileShareLink
+	return append(hooks[:len(hooks):len(hooks)], filesharelink.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *FileShareLinkClient) Interceptors() []Interceptor {
+	return c.inters.FileShareLink
+}
+
+func (c *FileShareLinkClient) mutate(ctx context.Context, m *FileShareLinkMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FileShareLinkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FileShareLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FileShareLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FileShareLinkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FileShareLink mutation op: %q", m.Op())
+	}
+}
+
+// InternalMessageClient is a client for the InternalMessage schema.
+type InternalMessageClient struct {
+	config
+}
+
+// NewInternalMessageClient returns a client for the InternalMessage from the given config.
+func NewInternalMessageClient(c config) *InternalMessageClient {
+	return &InternalMessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `internalmessage.Hooks(f(g(h())))`.
+func (c *InternalMessageClient) Use(hooks ...Hook) {
+	c.hooks.InternalMessage = append(c.hooks.InternalMessage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `internalmessage.Intercept(f(g(h())))`.
+func (c *InternalMessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.InternalMessage = append(c.inters.InternalMessage, interceptors...)
+}
+
+// Create returns a builder for creating a InternalMessage entity.
+func (c *InternalMessageClient) Create() *InternalMessageCreate {
+	mutation := newInternalMessageMutation(c.config, OpCreate)
+	return &InternalMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of InternalMessage entities.
+func (c *InternalMessageClient) CreateBulk(builders ...*InternalMessageCreate) *InternalMessageCreateBulk {
+	return &InternalMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *InternalMessageClient) MapCreateBulk(slice any, setFunc func(*InternalMessageCreate, int)) *InternalMessageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &InternalMessageCreateBulk{err: fmt.Errorf("calling to InternalMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*InternalMessageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &InternalMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for InternalMessage.
+func (c *InternalMessageClient) Update() *InternalMessageUpdate {
+	mutation := newInternalMessageMutation(c.config, OpUpdate)
+	return &InternalMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InternalMessageClient) UpdateOne(_m *InternalMessage) *InternalMessageUpdateOne {
+	mutation := newInternalMessageMutation(c.config, OpUpdateOne, withInternalMessage(_m))
+	return &InternalMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InternalMessageClient) UpdateOneID(id uint32) *InternalMessageUpdateOne {
+	mutation := newInternalMessageMutation(c.config, OpUpdateOne, withInternalMessageID(id))
 	return &InternalMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
@@ -2322,6 +2960,274 @@ func (c *LoginPolicyClient) mutate(ctx context.Context, m *LoginPolicyMutation)
 	}
 }
 
+// LuaScriptClient is a client for the LuaScript schema.
+type LuaScriptClient struct {
+	config
+}
+
+// NewLuaScriptClient returns a client for the LuaScript from the given config.
+func NewLuaScriptClient(c config) *LuaScriptClient {
+	return &LuaScriptClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `luascript.Hooks(f(g(h())))`.
+func (c *LuaScriptClient) Use(hooks ...Hook) {
+	c.hooks.LuaScript = append(c.hooks.LuaScript, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `luascript.Intercept(f(g(h())))`.
+func (c *LuaScriptClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LuaScript = append(c.inters.LuaScript, interceptors...)
+}
+
+// Create returns a builder for creating a LuaScript entity.
+func (c *LuaScriptClient) Create() *LuaScriptCreate {
+	mutation := newLuaScriptMutation(c.config, OpCreate)
+	return &LuaScriptCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LuaScript entities.
+func (c *LuaScriptClient) CreateBulk(builders ...*LuaScriptCreate) *LuaScriptCreateBulk {
+	return &LuaScriptCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LuaScriptClient) MapCreateBulk(slice any, setFunc func(*LuaScriptCreate, int)) *LuaScriptCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LuaScriptCreateBulk{err: fmt.Errorf("calling to LuaScriptClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LuaScriptCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LuaScriptCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LuaScript.
+func (c *LuaScriptClient) Update() *LuaScriptUpdate {
+	mutation := newLuaScriptMutation(c.config, OpUpdate)
+	return &LuaScriptUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LuaScriptClient) UpdateOne(_m *LuaScript) *LuaScriptUpdateOne {
+	mutation := newLuaScriptMutation(c.config, OpUpdateOne, withLuaScript(_m))
+	return &LuaScriptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LuaScriptClient) UpdateOneID(id uint32) *LuaScriptUpdateOne {
+	mutation := newLuaScriptMutation(c.config, OpUpdateOne, withLuaScriptID(id))
+	return &LuaScriptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LuaScript.
+func (c *LuaScriptClient) Delete() *LuaScriptDelete {
+	mutation := newLuaScriptMutation(c.config, OpDelete)
+	return &LuaScriptDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LuaScriptClient) DeleteOne(_m *LuaScript) *LuaScriptDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LuaScriptClient) DeleteOneID(id uint32) *LuaScriptDeleteOne {
+	builder := c.Delete().Where(luascript.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LuaScriptDeleteOne{builder}
+}
+
+// Query returns a query builder for LuaScript.
+func (c *LuaScriptClient) Query() *LuaScriptQuery {
+	return &LuaScriptQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLuaScript},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LuaScript entity by its id.
+func (c *LuaScriptClient) Get(ctx context.Context, id uint32) (*LuaScript, error) {
+	return c.Query().Where(luascript.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LuaScriptClient) GetX(ctx context.Context, id uint32) *LuaScript {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LuaScriptClient) Hooks() []Hook {
+	hooks := c.hooks.LuaScript
+	return append(hooks[:len(hooks):len(hooks)], luascript.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *LuaScriptClient) Interceptors() []Interceptor {
+	return c.inters.LuaScript
+}
+
+func (c *LuaScriptClient) mutate(ctx context.Context, m *LuaScriptMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LuaScriptCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LuaScriptUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LuaScriptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LuaScriptDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LuaScript mutation op: %q", m.Op())
+	}
+}
+
+// LuaScriptVersionClient is a client for the LuaScriptVersion schema.
+type LuaScriptVersionClient struct {
+	config
+}
+
+// NewLuaScriptVersionClient returns a client for the LuaScriptVersion from the given config.
+func NewLuaScriptVersionClient(c config) *LuaScriptVersionClient {
+	return &LuaScriptVersionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `luascriptversion.Hooks(f(g(h())))`.
+func (c *LuaScriptVersionClient) Use(hooks ...Hook) {
+	c.hooks.LuaScriptVersion = append(c.hooks.LuaScriptVersion, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `luascriptversion.Intercept(f(g(h())))`.
+func (c *LuaScriptVersionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LuaScriptVersion = append(c.inters.LuaScriptVersion, interceptors...)
+}
+
+// Create returns a builder for creating a LuaScriptVersion entity.
+func (c *LuaScriptVersionClient) Create() *LuaScriptVersionCreate {
+	mutation := newLuaScriptVersionMutation(c.config, OpCreate)
+	return &LuaScriptVersionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LuaScriptVersion entities.
+func (c *LuaScriptVersionClient) CreateBulk(builders ...*LuaScriptVersionCreate) *LuaScriptVersionCreateBulk {
+	return &LuaScriptVersionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LuaScriptVersionClient) MapCreateBulk(slice any, setFunc func(*LuaScriptVersionCreate, int)) *LuaScriptVersionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LuaScriptVersionCreateBulk{err: fmt.Errorf("calling to LuaScriptVersionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LuaScriptVersionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LuaScriptVersionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LuaScriptVersion.
+func (c *LuaScriptVersionClient) Update() *LuaScriptVersionUpdate {
+	mutation := newLuaScriptVersionMutation(c.config, OpUpdate)
+	return &LuaScriptVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LuaScriptVersionClient) UpdateOne(_m *LuaScriptVersion) *LuaScriptVersionUpdateOne {
+	mutation := newLuaScriptVersionMutation(c.config, OpUpdateOne, withLuaScriptVersion(_m))
+	return &LuaScriptVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LuaScriptVersionClient) UpdateOneID(id uint32) *LuaScriptVersionUpdateOne {
+	mutation := newLuaScriptVersionMutation(c.config, OpUpdateOne, withLuaScriptVersionID(id))
+	return &LuaScriptVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LuaScriptVersion.
+func (c *LuaScriptVersionClient) Delete() *LuaScriptVersionDelete {
+	mutation := newLuaScriptVersionMutation(c.config, OpDelete)
+	return &LuaScriptVersionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LuaScriptVersionClient) DeleteOne(_m *LuaScriptVersion) *LuaScriptVersionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LuaScriptVersionClient) DeleteOneID(id uint32) *LuaScriptVersionDeleteOne {
+	builder := c.Delete().Where(luascriptversion.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LuaScriptVersionDeleteOne{builder}
+}
+
+// Query returns a query builder for LuaScriptVersion.
+func (c *LuaScriptVersionClient) Query() *LuaScriptVersionQuery {
+	return &LuaScriptVersionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLuaScriptVersion},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LuaScriptVersion entity by its id.
+func (c *LuaScriptVersionClient) Get(ctx context.Context, id uint32) (*LuaScriptVersion, error) {
+	return c.Query().Where(luascriptversion.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LuaScriptVersionClient) GetX(ctx context.Context, id uint32) *LuaScriptVersion {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LuaScriptVersionClient) Hooks() []Hook {
+	hooks := c.hooks.LuaScriptVersion
+	return append(hooks[:len(hooks):len(hooks)], luascriptversion.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *LuaScriptVersionClient) Interceptors() []Interceptor {
+	return c.inters.LuaScriptVersion
+}
+
+func (c *LuaScriptVersionClient) mutate(ctx context.Context, m *LuaScriptVersionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LuaScriptVersionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LuaScriptVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LuaScriptVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LuaScriptVersionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LuaScriptVersion mutation op: %q", m.Op())
+	}
+}
+
 // MembershipClient is a client for the Membership schema.
 type MembershipClient struct {
 	config
@@ -4422,6 +5328,140 @@ func (c *PositionClient) mutate(ctx context.Context, m *PositionMutation) (Value
 	}
 }
 
+// RestoredAuditLogClient is a client for the RestoredAuditLog schema.
+type RestoredAuditLogClient struct {
+	config
+}
+
+// NewRestoredAuditLogClient returns a client for the RestoredAuditLog from the given config.
+func NewRestoredAuditLogClient(c config) *RestoredAuditLogClient {
+	return &RestoredAuditLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `restoredauditlog.Hooks(f(g(h())))`.
+func (c *RestoredAuditLogClient) Use(hooks ...Hook) {
+	c.hooks.RestoredAuditLog = append(c.hooks.RestoredAuditLog, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `restoredauditlog.Intercept(f(g(h())))`.
+func (c *RestoredAuditLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RestoredAuditLog = append(c.inters.RestoredAuditLog, interceptors...)
+}
+
+// Create returns a builder for creating a RestoredAuditLog entity.
+func (c *RestoredAuditLogClient) Create() *RestoredAuditLogCreate {
+	mutation := newRestoredAuditLogMutation(c.config, OpCreate)
+	return &RestoredAuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RestoredAuditLog entities.
+func (c *RestoredAuditLogClient) CreateBulk(builders ...*RestoredAuditLogCreate) *RestoredAuditLogCreateBulk {
+	return &RestoredAuditLogCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RestoredAuditLogClient) MapCreateBulk(slice any, setFunc func(*RestoredAuditLogCreate, int)) *RestoredAuditLogCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RestoredAuditLogCreateBulk{err: fmt.Errorf("calling to RestoredAuditLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RestoredAuditLogCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RestoredAuditLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RestoredAuditLog.
+func (c *RestoredAuditLogClient) Update() *RestoredAuditLogUpdate {
+	mutation := newRestoredAuditLogMutation(c.config, OpUpdate)
+	return &RestoredAuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RestoredAuditLogClient) UpdateOne(_m *RestoredAuditLog) *RestoredAuditLogUpdateOne {
+	mutation := newRestoredAuditLogMutation(c.config, OpUpdateOne, withRestoredAuditLog(_m))
+	return &RestoredAuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RestoredAuditLogClient) UpdateOneID(id uint32) *RestoredAuditLogUpdateOne {
+	mutation := newRestoredAuditLogMutation(c.config, OpUpdateOne, withRestoredAuditLogID(id))
+	return &RestoredAuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RestoredAuditLog.
+func (c *RestoredAuditLogClient) Delete() *RestoredAuditLogDelete {
+	mutation := newRestoredAuditLogMutation(c.config, OpDelete)
+	return &RestoredAuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RestoredAuditLogClient) DeleteOne(_m *RestoredAuditLog) *RestoredAuditLogDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RestoredAuditLogClient) DeleteOneID(id uint32) *RestoredAuditLogDeleteOne {
+	builder := c.Delete().Where(restoredauditlog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RestoredAuditLogDeleteOne{builder}
+}
+
+// Query returns a query builder for RestoredAuditLog.
+func (c *RestoredAuditLogClient) Query() *RestoredAuditLogQuery {
+	return &RestoredAuditLogQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRestoredAuditLog},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RestoredAuditLog entity by its id.
+func (c *RestoredAuditLogClient) Get(ctx context.Context, id uint32) (*RestoredAuditLog, error) {
+	return c.Query().Where(restoredauditlog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RestoredAuditLogClient) GetX(ctx context.Context, id uint32) *RestoredAuditLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RestoredAuditLogClient) Hooks() []Hook {
+	hooks := c.hooks.RestoredAuditLog
+	return append(hooks[:len(hooks):len(hooks)], restoredauditlog.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *RestoredAuditLogClient) Interceptors() []Interceptor {
+	return c.inters.RestoredAuditLog
+}
+
+func (c *RestoredAuditLogClient) mutate(ctx context.Context, m *RestoredAuditLogMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RestoredAuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RestoredAuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RestoredAuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RestoredAuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RestoredAuditLog mutation op: %q", m.Op())
+	}
+}
+
 // RoleClient is a client for the Role schema.
 type RoleClient struct {
 	config
@@ -4824,6 +5864,273 @@ func (c *RolePermissionClient) mutate(ctx context.Context, m *RolePermissionMuta
 	}
 }
 
+// StorageObjectClient is a client for the StorageObject schema.
+type StorageObjectClient struct {
+	config
+}
+
+// NewStorageObjectClient returns a client for the StorageObject from the given config.
+func NewStorageObjectClient(c config) *StorageObjectClient {
+	return &StorageObjectClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `storageobject.Hooks(f(g(h())))`.
+func (c *StorageObjectClient) Use(hooks ...Hook) {
+	c.hooks.StorageObject = append(c.hooks.StorageObject, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `storageobject.Intercept(f(g(h())))`.
+func (c *StorageObjectClient) Intercept(interceptors ...Interceptor) {
+	c.inters.StorageObject = append(c.inters.StorageObject, interceptors...)
+}
+
+// Create returns a builder for creating a StorageObject entity.
+func (c *StorageObjectClient) Create() *StorageObjectCreate {
+	mutation := newStorageObjectMutation(c.config, OpCreate)
+	return &StorageObjectCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of StorageObject entities.
+func (c *StorageObjectClient) CreateBulk(builders ...*StorageObjectCreate) *StorageObjectCreateBulk {
+	return &StorageObjectCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *StorageObjectClient) MapCreateBulk(slice any, setFunc func(*StorageObjectCreate, int)) *StorageObjectCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &StorageObjectCreateBulk{err: fmt.Errorf("calling to StorageObjectClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*StorageObjectCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &StorageObjectCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for StorageObject.
+func (c *StorageObjectClient) Update() *StorageObjectUpdate {
+	mutation := newStorageObjectMutation(c.config, OpUpdate)
+	return &StorageObjectUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *StorageObjectClient) UpdateOne(_m *StorageObject) *StorageObjectUpdateOne {
+	mutation := newStorageObjectMutation(c.config, OpUpdateOne, withStorageObject(_m))
+	return &StorageObjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *StorageObjectClient) UpdateOneID(id uint32) *StorageObjectUpdateOne {
+	mutation := newStorageObjectMutation(c.config, OpUpdateOne, withStorageObjectID(id))
+	return &StorageObjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for StorageObject.
+func (c *StorageObjectClient) Delete() *StorageObjectDelete {
+	mutation := newStorageObjectMutation(c.config, OpDelete)
+	return &StorageObjectDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *StorageObjectClient) DeleteOne(_m *StorageObject) *StorageObjectDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *StorageObjectClient) DeleteOneID(id uint32) *StorageObjectDeleteOne {
+	builder := c.Delete().Where(storageobject.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &StorageObjectDeleteOne{builder}
+}
+
+// Query returns a query builder for StorageObject.
+func (c *StorageObjectClient) Query() *StorageObjectQuery {
+	return &StorageObjectQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeStorageObject},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a StorageObject entity by its id.
+func (c *StorageObjectClient) Get(ctx context.Context, id uint32) (*StorageObject, error) {
+	return c.Query().Where(storageobject.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *StorageObjectClient) GetX(ctx context.Context, id uint32) *StorageObject {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *StorageObjectClient) Hooks() []Hook {
+	return c.hooks.StorageObject
+}
+
+// Interceptors returns the client interceptors.
+func (c *StorageObjectClient) Interceptors() []Interceptor {
+	return c.inters.StorageObject
+}
+
+func (c *StorageObjectClient) mutate(ctx context.Context, m *StorageObjectMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&StorageObjectCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&StorageObjectUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&StorageObjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&StorageObjectDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown StorageObject mutation op: %q", m.Op())
+	}
+}
+
+// StorageQuotaClient is a client for the StorageQuota schema.
+type StorageQuotaClient struct {
+	config
+}
+
+// NewStorageQuotaClient returns a client for the StorageQuota from the given config.
+func NewStorageQuotaClient(c config) *StorageQuotaClient {
+	return &StorageQuotaClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `storagequota.Hooks(f(g(h())))`.
+func (c *StorageQuotaClient) Use(hooks ...Hook) {
+	c.hooks.StorageQuota = append(c.hooks.StorageQuota, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `storagequota.Intercept(f(g(h())))`.
+func (c *StorageQuotaClient) Intercept(interceptors ...Interceptor) {
+	c.inters.StorageQuota = append(c.inters.StorageQuota, interceptors...)
+}
+
+// Create returns a builder for creating a StorageQuota entity.
+func (c *StorageQuotaClient) Create() *StorageQuotaCreate {
+	mutation := newStorageQuotaMutation(c.config, OpCreate)
+	return &StorageQuotaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of StorageQuota entities.
+func (c *StorageQuotaClient) CreateBulk(builders ...*StorageQuotaCreate) *StorageQuotaCreateBulk {
+	return &StorageQuotaCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *StorageQuotaClient) MapCreateBulk(slice any, setFunc func(*StorageQuotaCreate, int)) *StorageQuotaCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &StorageQuotaCreateBulk{err: fmt.Errorf("calling to StorageQuotaClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*StorageQuotaCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &StorageQuotaCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for StorageQuota.
+func (c *StorageQuotaClient) Update() *StorageQuotaUpdate {
+	mutation := newStorageQuotaMutation(c.config, OpUpdate)
+	return &StorageQuotaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *StorageQuotaClient) UpdateOne(_m *StorageQuota) *StorageQuotaUpdateOne {
+	mutation := newStorageQuotaMutation(c.config, OpUpdateOne, withStorageQuota(_m))
+	return &StorageQuotaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *StorageQuotaClient) UpdateOneID(id uint32) *StorageQuotaUpdateOne {
+	mutation := newStorageQuotaMutation(c.config, OpUpdateOne, withStorageQuotaID(id))
+	return &StorageQuotaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for StorageQuota.
+func (c *StorageQuotaClient) Delete() *StorageQuotaDelete {
+	mutation := newStorageQuotaMutation(c.config, OpDelete)
+	return &StorageQuotaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *StorageQuotaClient) DeleteOne(_m *StorageQuota) *StorageQuotaDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *StorageQuotaClient) DeleteOneID(id uint32) *StorageQuotaDeleteOne {
+	builder := c.Delete().Where(storagequota.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &StorageQuotaDeleteOne{builder}
+}
+
+// Query returns a query builder for StorageQuota.
+func (c *StorageQuotaClient) Query() *StorageQuotaQuery {
+	return &StorageQuotaQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeStorageQuota},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a StorageQuota entity by its id.
+func (c *StorageQuotaClient) Get(ctx context.Context, id uint32) (*StorageQuota, error) {
+	return c.Query().Where(storagequota.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *StorageQuotaClient) GetX(ctx context.Context, id uint32) *StorageQuota {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *StorageQuotaClient) Hooks() []Hook {
+	hooks := c.hooks.StorageQuota
+	return append(hooks[:len(hooks):len(hooks)], storagequota.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *StorageQuotaClient) Interceptors() []Interceptor {
+	return c.inters.StorageQuota
+}
+
+func (c *StorageQuotaClient) mutate(ctx context.Context, m *StorageQuotaMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&StorageQuotaCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&StorageQuotaUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&StorageQuotaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&StorageQuotaDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown StorageQuota mutation op: %q", m.Op())
+	}
+}
+
 // TaskClient is a client for the Task schema.
 type TaskClient struct {
 	config
@@ -4958,6 +6265,407 @@ func (c *TaskClient) mutate(ctx context.Context, m *TaskMutation) (Value, error)
 	}
 }
 
+// TaskRunClient is a client for the TaskRun schema.
+type TaskRunClient struct {
+	config
+}
+
+// NewTaskRunClient returns a client for the TaskRun from the given config.
+func NewTaskRunClient(c config) *TaskRunClient {
+	return &TaskRunClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `taskrun.Hooks(f(g(h())))`.
+func (c *TaskRunClient) Use(hooks ...Hook) {
+	c.hooks.TaskRun = append(c.hooks.TaskRun, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `taskrun.Intercept(f(g(h())))`.
+func (c *TaskRunClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TaskRun = append(c.inters.TaskRun, interceptors...)
+}
+
+// Create returns a builder for creating a TaskRun entity.
+func (c *TaskRunClient) Create() *TaskRunCreate {
+	mutation := newTaskRunMutation(c.config, OpCreate)
+	return &TaskRunCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TaskRun entities.
+func (c *TaskRunClient) CreateBulk(builders ...*TaskRunCreate) *TaskRunCreateBulk {
+	return &TaskRunCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TaskRunClient) MapCreateBulk(slice any, setFunc func(*TaskRunCreate, int)) *TaskRunCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TaskRunCreateBulk{err: fmt.Errorf("calling to TaskRunClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TaskRunCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TaskRunCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TaskRun.
+func (c *TaskRunClient) Update() *TaskRunUpdate {
+	mutation := newTaskRunMutation(c.config, OpUpdate)
+	return &TaskRunUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TaskRunClient) UpdateOne(_m *TaskRun) *TaskRunUpdateOne {
+	mutation := newTaskRunMutation(c.config, OpUpdateOne, withTaskRun(_m))
+	return &TaskRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TaskRunClient) UpdateOneID(id uint32) *TaskRunUpdateOne {
+	mutation := newTaskRunMutation(c.config, OpUpdateOne, withTaskRunID(id))
+	return &TaskRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TaskRun.
+func (c *TaskRunClient) Delete() *TaskRunDelete {
+	mutation := newTaskRunMutation(c.config, OpDelete)
+	return &TaskRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TaskRunClient) DeleteOne(_m *TaskRun) *TaskRunDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TaskRunClient) DeleteOneID(id uint32) *TaskRunDeleteOne {
+	builder := c.Delete().Where(taskrun.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TaskRunDeleteOne{builder}
+}
+
+// Query returns a query builder for TaskRun.
+func (c *TaskRunClient) Query() *TaskRunQuery {
+	return &TaskRunQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTaskRun},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TaskRun entity by its id.
+func (c *TaskRunClient) Get(ctx context.Context, id uint32) (*TaskRun, error) {
+	return c.Query().Where(taskrun.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TaskRunClient) GetX(ctx context.Context, id uint32) *TaskRun {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TaskRunClient) Hooks() []Hook {
+	return c.hooks.TaskRun
+}
+
+// Interceptors returns the client interceptors.
+func (c *TaskRunClient) Interceptors() []Interceptor {
+	return c.inters.TaskRun
+}
+
+func (c *TaskRunClient) mutate(ctx context.Context, m *TaskRunMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TaskRunCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TaskRunUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TaskRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TaskRunDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TaskRun mutation op: %q", m.Op())
+	}
+}
+
+// TaskWorkflowClient is a client for the TaskWorkflow schema.
+type TaskWorkflowClient struct {
+	config
+}
+
+// NewTaskWorkflowClient returns a client for the TaskWorkflow from the given config.
+func NewTaskWorkflowClient(c config) *TaskWorkflowClient {
+	return &TaskWorkflowClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `taskworkflow.Hooks(f(g(h())))`.
+func (c *TaskWorkflowClient) Use(hooks ...Hook) {
+	c.hooks.TaskWorkflow = append(c.hooks.TaskWorkflow, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `taskworkflow.Intercept(f(g(h())))`.
+func (c *TaskWorkflowClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TaskWorkflow = append(c.inters.TaskWorkflow, interceptors...)
+}
+
+// Create returns a builder for creating a TaskWorkflow entity.
+func (c *TaskWorkflowClient) Create() *TaskWorkflowCreate {
+	mutation := newTaskWorkflowMutation(c.config, OpCreate)
+	return &TaskWorkflowCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TaskWorkflow entities.
+func (c *TaskWorkflowClient) CreateBulk(builders ...*TaskWorkflowCreate) *TaskWorkflowCreateBulk {
+	return &TaskWorkflowCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TaskWorkflowClient) MapCreateBulk(slice any, setFunc func(*TaskWorkflowCreate, int)) *TaskWorkflowCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TaskWorkflowCreateBulk{err: fmt.Errorf("calling to TaskWorkflowClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TaskWorkflowCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TaskWorkflowCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TaskWorkflow.
+func (c *TaskWorkflowClient) Update() *TaskWorkflowUpdate {
+	mutation := newTaskWorkflowMutation(c.config, OpUpdate)
+	return &TaskWorkflowUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TaskWorkflowClient) UpdateOne(_m *TaskWorkflow) *TaskWorkflowUpdateOne {
+	mutation := newTaskWorkflowMutation(c.config, OpUpdateOne, withTaskWorkflow(_m))
+	return &TaskWorkflowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TaskWorkflowClient) UpdateOneID(id uint32) *TaskWorkflowUpdateOne {
+	mutation := newTaskWorkflowMutation(c.config, OpUpdateOne, withTaskWorkflowID(id))
+	return &TaskWorkflowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TaskWorkflow.
+func (c *TaskWorkflowClient) Delete() *TaskWorkflowDelete {
+	mutation := newTaskWorkflowMutation(c.config, OpDelete)
+	return &TaskWorkflowDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TaskWorkflowClient) DeleteOne(_m *TaskWorkflow) *TaskWorkflowDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TaskWorkflowClient) DeleteOneID(id uint32) *TaskWorkflowDeleteOne {
+	builder := c.Delete().Where(taskworkflow.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TaskWorkflowDeleteOne{builder}
+}
+
+// Query returns a query builder for TaskWorkflow.
+func (c *TaskWorkflowClient) Query() *TaskWorkflowQuery {
+	return &TaskWorkflowQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTaskWorkflow},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TaskWorkflow entity by its id.
+func (c *TaskWorkflowClient) Get(ctx context.Context, id uint32) (*TaskWorkflow, error) {
+	return c.Query().Where(taskworkflow.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TaskWorkflowClient) GetX(ctx context.Context, id uint32) *TaskWorkflow {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TaskWorkflowClient) Hooks() []Hook {
+	hooks := c.hooks.TaskWorkflow
+	return append(hooks[:len(hooks):len(hooks)], taskworkflow.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *TaskWorkflowClient) Interceptors() []Interceptor {
+	return c.inters.TaskWorkflow
+}
+
+func (c *TaskWorkflowClient) mutate(ctx context.Context, m *TaskWorkflowMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TaskWorkflowCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TaskWorkflowUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TaskWorkflowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TaskWorkflowDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TaskWorkflow mutation op: %q", m.Op())
+	}
+}
+
+// TaskWorkflowRunClient is a client for the TaskWorkflowRun schema.
+type TaskWorkflowRunClient struct {
+	config
+}
+
+// NewTaskWorkflowRunClient returns a client for the TaskWorkflowRun from the given config.
+func NewTaskWorkflowRunClient(c config) *TaskWorkflowRunClient {
+	return &TaskWorkflowRunClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `taskworkflowrun.Hooks(f(g(h())))`.
+func (c *TaskWorkflowRunClient) Use(hooks ...Hook) {
+	c.hooks.TaskWorkflowRun = append(c.hooks.TaskWorkflowRun, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `taskworkflowrun.Intercept(f(g(h())))`.
+func (c *TaskWorkflowRunClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TaskWorkflowRun = append(c.inters.TaskWorkflowRun, interceptors...)
+}
+
+// Create returns a builder for creating a TaskWorkflowRun entity.
+func (c *TaskWorkflowRunClient) Create() *TaskWorkflowRunCreate {
+	mutation := newTaskWorkflowRunMutation(c.config, OpCreate)
+	return &TaskWorkflowRunCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TaskWorkflowRun entities.
+func (c *TaskWorkflowRunClient) CreateBulk(builders ...*TaskWorkflowRunCreate) *TaskWorkflowRunCreateBulk {
+	return &TaskWorkflowRunCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TaskWorkflowRunClient) MapCreateBulk(slice any, setFunc func(*TaskWorkflowRunCreate, int)) *TaskWorkflowRunCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TaskWorkflowRunCreateBulk{err: fmt.Errorf("calling to TaskWorkflowRunClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TaskWorkflowRunCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TaskWorkflowRunCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TaskWorkflowRun.
+func (c *TaskWorkflowRunClient) Update() *TaskWorkflowRunUpdate {
+	mutation := newTaskWorkflowRunMutation(c.config, OpUpdate)
+	return &TaskWorkflowRunUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TaskWorkflowRunClient) UpdateOne(_m *TaskWorkflowRun) *TaskWorkflowRunUpdateOne {
+	mutation := newTaskWorkflowRunMutation(c.config, OpUpdateOne, withTaskWorkflowRun(_m))
+	return &TaskWorkflowRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TaskWorkflowRunClient) UpdateOneID(id uint32) *TaskWorkflowRunUpdateOne {
+	mutation := newTaskWorkflowRunMutation(c.config, OpUpdateOne, withTaskWorkflowRunID(id))
+	return &TaskWorkflowRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TaskWorkflowRun.
+func (c *TaskWorkflowRunClient) Delete() *TaskWorkflowRunDelete {
+	mutation := newTaskWorkflowRunMutation(c.config, OpDelete)
+	return &TaskWorkflowRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TaskWorkflowRunClient) DeleteOne(_m *TaskWorkflowRun) *TaskWorkflowRunDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TaskWorkflowRunClient) DeleteOneID(id uint32) *TaskWorkflowRunDeleteOne {
+	builder := c.Delete().Where(taskworkflowrun.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TaskWorkflowRunDeleteOne{builder}
+}
+
+// Query returns a query builder for TaskWorkflowRun.
+func (c *TaskWorkflowRunClient) Query() *TaskWorkflowRunQuery {
+	return &TaskWorkflowRunQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTaskWorkflowRun},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TaskWorkflowRun entity by its id.
+func (c *TaskWorkflowRunClient) Get(ctx context.Context, id uint32) (*TaskWorkflowRun, error) {
+	return c.Query().Where(taskworkflowrun.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TaskWorkflowRunClient) GetX(ctx context.Context, id uint32) *TaskWorkflowRun {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TaskWorkflowRunClient) Hooks() []Hook {
+	hooks := c.hooks.TaskWorkflowRun
+	return append(hooks[:len(hooks):len(hooks)], taskworkflowrun.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *TaskWorkflowRunClient) Interceptors() []Interceptor {
+	return c.inters.TaskWorkflowRun
+}
+
+func (c *TaskWorkflowRunClient) mutate(ctx context.Context, m *TaskWorkflowRunMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TaskWorkflowRunCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TaskWorkflowRunUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TaskWorkflowRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TaskWorkflowRunDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TaskWorkflowRun mutation op: %q", m.Op())
+	}
+}
+
 // TenantClient is a client for the Tenant schema.
 type TenantClient struct {
 	config
@@ -5764,22 +7472,27 @@ func (c *UserRoleClient) mutate(ctx context.Context, m *UserRoleMutation) (Value
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Api, ApiAuditLog, DataAccessAuditLog, DictEntry, DictEntryI18n, DictType, File,
+		Api, ApiAuditLog, AuditForwarder, AuditLogArchive, AuditLogRetentionPolicy,
+		DataAccessAuditLog, DictEntry, DictEntryI18n, DictType, File, FileShareLink,
 		InternalMessage, InternalMessageCategory, InternalMessageRecipient, Language,
-		LoginAuditLog, LoginPolicy, Membership, MembershipOrgUnit, MembershipPosition,
-		MembershipRole, Menu, OperationAuditLog, OrgUnit, Permission, PermissionApi,
-		PermissionAuditLog, PermissionGroup, PermissionMenu, PermissionPolicy,
-		PolicyEvaluationLog, Position, Role, RoleMetadata, RolePermission, Task,
-		Tenant, User, UserCredential, UserOrgUnit, UserPosition, UserRole []ent.Hook
+		LoginAuditLog, LoginPolicy, LuaScript, LuaScriptVersion, Membership,
+		MembershipOrgUnit, MembershipPosition, MembershipRole, Menu, OperationAuditLog,
+		OrgUnit, Permission, PermissionApi, PermissionAuditLog, PermissionGroup,
+		PermissionMenu, PermissionPolicy, PolicyEvaluationLog, Position,
+		RestoredAuditLog, Role, RoleMetadata, RolePermission, StorageObject,
+		StorageQuota, Task, TaskRun, TaskWorkflow, TaskWorkflowRun, Tenant, User,
+		UserCredential, UserOrgUnit, UserPosition, UserRole []ent.Hook
 	}
 	inters struct {
-		Api, ApiAuditLog, DataAccessAuditLog, DictEntry, DictEntryI18n, DictType, File,
+		Api, ApiAuditLog, AuditForwarder, AuditLogArchive, AuditLogRetentionPolicy,
+		DataAccessAuditLog, DictEntry, DictEntryI18n, DictType, File, FileShareLink,
 		InternalMessage, InternalMessageCategory, InternalMessageRecipient, Language,
-		LoginAuditLog, LoginPolicy, Membership, MembershipOrgUnit, MembershipPosition,
-		MembershipRole, Menu, OperationAuditLog, OrgUnit, Permission, PermissionApi,
-		PermissionAuditLog, PermissionGroup, PermissionMenu, PermissionPolicy,
-		PolicyEvaluationLog, Position, Role, RoleMetadata, RolePermission, Task,
-		Tenant, User, UserCredential, UserOrgUnit, UserPosition,
-		UserRole []ent.Interceptor
+		LoginAuditLog, LoginPolicy, LuaScript, LuaScriptVersion, Membership,
+		MembershipOrgUnit, MembershipPosition, MembershipRole, Menu, OperationAuditLog,
+		OrgUnit, Permission, PermissionApi, PermissionAuditLog, PermissionGroup,
+		PermissionMenu, PermissionPolicy, PolicyEvaluationLog, Position,
+		RestoredAuditLog, Role, RoleMetadata, RolePermission, StorageObject,
+		StorageQuota, Task, TaskRun, TaskWorkflow, TaskWorkflowRun, Tenant, User,
+		UserCredential, UserOrgUnit, UserPosition, UserRole []ent.Interceptor
 	}
 )
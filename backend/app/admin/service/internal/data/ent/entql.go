@@ -5,17 +5,23 @@ package ent
 import (
 	"go-wind-admin/app/admin/service/internal/data/ent/api"
 	"go-wind-admin/app/admin/service/internal/data/ent/apiauditlog"
+	"go-wind-admin/app/admin/service/internal/data/ent/auditforwarder"
+	"go-wind-admin/app/admin/service/internal/data/ent/auditlogarchive"
+	"go-wind-admin/app/admin/service/internal/data/ent/auditlogretentionpolicy"
 	"go-wind-admin/app/admin/service/internal/data/ent/dataaccessauditlog"
 	"go-wind-admin/app/admin/service/internal/data/ent/dictentry"
 	"go-wind-admin/app/admin/service/internal/data/ent/dictentryi18n"
 	"go-wind-admin/app/admin/service/internal/data/ent/dicttype"
 	"go-wind-admin/app/admin/service/internal/data/ent/file"
+	"go-wind-admin/app/admin/service/internal/data/ent/filesharelink"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessage"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagecategory"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagerecipient"
 	"go-wind-admin/app/admin/service/internal/data/ent/language"
 	"go-wind-admin/app/admin/service/internal/data/ent/loginauditlog"
 	"go-wind-admin/app/admin/service/internal/data/ent/loginpolicy"
+	"go-wind-admin/app/admin/service/internal/data/ent/luascript"
+	"go-wind-admin/app/admin/service/internal/data/ent/luascriptversion"
 	"go-wind-admin/app/admin/service/internal/data/ent/membership"
 	"go-wind-admin/app/admin/service/internal/data/ent/membershiporgunit"
 	"go-wind-admin/app/admin/service/internal/data/ent/membershipposition"
@@ -32,10 +38,16 @@ import (
 	"go-wind-admin/app/admin/service/internal/data/ent/policyevaluationlog"
 	"go-wind-admin/app/admin/service/internal/data/ent/position"
 	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"go-wind-admin/app/admin/service/internal/data/ent/restoredauditlog"
 	"go-wind-admin/app/admin/service/internal/data/ent/role"
 	"go-wind-admin/app/admin/service/internal/data/ent/rolemetadata"
 	"go-wind-admin/app/admin/service/internal/data/ent/rolepermission"
+	"go-wind-admin/app/admin/service/internal/data/ent/storageobject"
+	"go-wind-admin/app/admin/service/internal/data/ent/storagequota"
 	"go-wind-admin/app/admin/service/internal/data/ent/task"
+	"go-wind-admin/app/admin/service/internal/data/ent/taskrun"
+	"go-wind-admin/app/admin/service/internal/data/ent/taskworkflow"
+	"go-wind-admin/app/admin/service/internal/data/ent/taskworkflowrun"
 	"go-wind-admin/app/admin/service/internal/data/ent/tenant"
 	"go-wind-admin/app/admin/service/internal/data/ent/user"
 	"go-wind-admin/app/admin/service/internal/data/ent/usercredential"
@@ -51,7 +63,7 @@ import (
 
 // schemaGraph holds a representation of ent/schema at runtime.
 var schemaGraph = func() *sqlgraph.Schema {
-	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 38)}
+	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 50)}
 	graph.Nodes[0] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   api.Table,
@@ -120,6 +132,100 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 	}
 	graph.Nodes[2] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   auditforwarder.Table,
+			Columns: auditforwarder.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: auditforwarder.FieldID,
+			},
+		},
+		Type: "AuditForwarder",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			auditforwarder.FieldCreatedAt:             {Type: field.TypeTime, Column: auditforwarder.FieldCreatedAt},
+			auditforwarder.FieldUpdatedAt:             {Type: field.TypeTime, Column: auditforwarder.FieldUpdatedAt},
+			auditforwarder.FieldDeletedAt:             {Type: field.TypeTime, Column: auditforwarder.FieldDeletedAt},
+			auditforwarder.FieldCreatedBy:             {Type: field.TypeUint32, Column: auditforwarder.FieldCreatedBy},
+			auditforwarder.FieldUpdatedBy:             {Type: field.TypeUint32, Column: auditforwarder.FieldUpdatedBy},
+			auditforwarder.FieldDeletedBy:             {Type: field.TypeUint32, Column: auditforwarder.FieldDeletedBy},
+			auditforwarder.FieldRemark:                {Type: field.TypeString, Column: auditforwarder.FieldRemark},
+			auditforwarder.FieldTenantID:              {Type: field.TypeUint32, Column: auditforwarder.FieldTenantID},
+			auditforwarder.FieldName:                  {Type: field.TypeString, Column: auditforwarder.FieldName},
+			auditforwarder.FieldEnabled:               {Type: field.TypeBool, Column: auditforwarder.FieldEnabled},
+			auditforwarder.FieldFormat:                {Type: field.TypeEnum, Column: auditforwarder.FieldFormat},
+			auditforwarder.FieldTransport:             {Type: field.TypeEnum, Column: auditforwarder.FieldTransport},
+			auditforwarder.FieldEndpoint:              {Type: field.TypeString, Column: auditforwarder.FieldEndpoint},
+			auditforwarder.FieldLogTypes:              {Type: field.TypeJSON, Column: auditforwarder.FieldLogTypes},
+			auditforwarder.FieldFieldMapping:          {Type: field.TypeJSON, Column: auditforwarder.FieldFieldMapping},
+			auditforwarder.FieldSecret:                {Type: field.TypeString, Column: auditforwarder.FieldSecret},
+			auditforwarder.FieldHeaders:               {Type: field.TypeJSON, Column: auditforwarder.FieldHeaders},
+			auditforwarder.FieldTLSInsecureSkipVerify: {Type: field.TypeBool, Column: auditforwarder.FieldTLSInsecureSkipVerify},
+			auditforwarder.FieldTLSCaPem:              {Type: field.TypeString, Column: auditforwarder.FieldTLSCaPem},
+			auditforwarder.FieldTLSServerName:         {Type: field.TypeString, Column: auditforwarder.FieldTLSServerName},
+			auditforwarder.FieldAppName:               {Type: field.TypeString, Column: auditforwarder.FieldAppName},
+			auditforwarder.FieldFacility:              {Type: field.TypeUint32, Column: auditforwarder.FieldFacility},
+			auditforwarder.FieldMaxBufferBytes:        {Type: field.TypeUint64, Column: auditforwarder.FieldMaxBufferBytes},
+		},
+	}
+	graph.Nodes[3] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   auditlogarchive.Table,
+			Columns: auditlogarchive.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: auditlogarchive.FieldID,
+			},
+		},
+		Type: "AuditLogArchive",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			auditlogarchive.FieldCreatedAt:          {Type: field.TypeTime, Column: auditlogarchive.FieldCreatedAt},
+			auditlogarchive.FieldUpdatedAt:          {Type: field.TypeTime, Column: auditlogarchive.FieldUpdatedAt},
+			auditlogarchive.FieldDeletedAt:          {Type: field.TypeTime, Column: auditlogarchive.FieldDeletedAt},
+			auditlogarchive.FieldTenantID:           {Type: field.TypeUint32, Column: auditlogarchive.FieldTenantID},
+			auditlogarchive.FieldLogType:            {Type: field.TypeEnum, Column: auditlogarchive.FieldLogType},
+			auditlogarchive.FieldStatus:             {Type: field.TypeEnum, Column: auditlogarchive.FieldStatus},
+			auditlogarchive.FieldBucketName:         {Type: field.TypeString, Column: auditlogarchive.FieldBucketName},
+			auditlogarchive.FieldObjectName:         {Type: field.TypeString, Column: auditlogarchive.FieldObjectName},
+			auditlogarchive.FieldManifestObjectName: {Type: field.TypeString, Column: auditlogarchive.FieldManifestObjectName},
+			auditlogarchive.FieldSha256:             {Type: field.TypeString, Column: auditlogarchive.FieldSha256},
+			auditlogarchive.FieldSizeBytes:          {Type: field.TypeUint64, Column: auditlogarchive.FieldSizeBytes},
+			auditlogarchive.FieldRowCount:           {Type: field.TypeUint64, Column: auditlogarchive.FieldRowCount},
+			auditlogarchive.FieldPurgedCount:        {Type: field.TypeUint64, Column: auditlogarchive.FieldPurgedCount},
+			auditlogarchive.FieldMinLogID:           {Type: field.TypeUint32, Column: auditlogarchive.FieldMinLogID},
+			auditlogarchive.FieldMaxLogID:           {Type: field.TypeUint32, Column: auditlogarchive.FieldMaxLogID},
+			auditlogarchive.FieldCutoffTime:         {Type: field.TypeTime, Column: auditlogarchive.FieldCutoffTime},
+			auditlogarchive.FieldErrorMessage:       {Type: field.TypeString, Column: auditlogarchive.FieldErrorMessage},
+			auditlogarchive.FieldRestoredCount:      {Type: field.TypeUint64, Column: auditlogarchive.FieldRestoredCount},
+			auditlogarchive.FieldRestoredAt:         {Type: field.TypeTime, Column: auditlogarchive.FieldRestoredAt},
+		},
+	}
+	graph.Nodes[4] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   auditlogretentionpolicy.Table,
+			Columns: auditlogretentionpolicy.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: auditlogretentionpolicy.FieldID,
+			},
+		},
+		Type: "AuditLogRetentionPolicy",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			auditlogretentionpolicy.FieldCreatedAt:      {Type: field.TypeTime, Column: auditlogretentionpolicy.FieldCreatedAt},
+			auditlogretentionpolicy.FieldUpdatedAt:      {Type: field.TypeTime, Column: auditlogretentionpolicy.FieldUpdatedAt},
+			auditlogretentionpolicy.FieldDeletedAt:      {Type: field.TypeTime, Column: auditlogretentionpolicy.FieldDeletedAt},
+			auditlogretentionpolicy.FieldCreatedBy:      {Type: field.TypeUint32, Column: auditlogretentionpolicy.FieldCreatedBy},
+			auditlogretentionpolicy.FieldUpdatedBy:      {Type: field.TypeUint32, Column: auditlogretentionpolicy.FieldUpdatedBy},
+			auditlogretentionpolicy.FieldDeletedBy:      {Type: field.TypeUint32, Column: auditlogretentionpolicy.FieldDeletedBy},
+			auditlogretentionpolicy.FieldRemark:         {Type: field.TypeString, Column: auditlogretentionpolicy.FieldRemark},
+			auditlogretentionpolicy.FieldTenantID:       {Type: field.TypeUint32, Column: auditlogretentionpolicy.FieldTenantID},
+			auditlogretentionpolicy.FieldLogType:        {Type: field.TypeEnum, Column: auditlogretentionpolicy.FieldLogType},
+			auditlogretentionpolicy.FieldRetentionDays:  {Type: field.TypeUint32, Column: auditlogretentionpolicy.FieldRetentionDays},
+			auditlogretentionpolicy.FieldArchiveEnabled: {Type: field.TypeBool, Column: auditlogretentionpolicy.FieldArchiveEnabled},
+			auditlogretentionpolicy.FieldEnabled:        {Type: field.TypeBool, Column: auditlogretentionpolicy.FieldEnabled},
+			auditlogretentionpolicy.FieldBatchSize:      {Type: field.TypeUint32, Column: auditlogretentionpolicy.FieldBatchSize},
+		},
+	}
+	graph.Nodes[5] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   dataaccessauditlog.Table,
 			Columns: dataaccessauditlog.Columns,
@@ -158,7 +264,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			dataaccessauditlog.FieldSignature:       {Type: field.TypeBytes, Column: dataaccessauditlog.FieldSignature},
 		},
 	}
-	graph.Nodes[3] = &sqlgraph.Node{
+	graph.Nodes[6] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   dictentry.Table,
 			Columns: dictentry.Columns,
@@ -182,7 +288,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			dictentry.FieldNumericValue: {Type: field.TypeInt32, Column: dictentry.FieldNumericValue},
 		},
 	}
-	graph.Nodes[4] = &sqlgraph.Node{
+	graph.Nodes[7] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   dictentryi18n.Table,
 			Columns: dictentryi18n.Columns,
@@ -206,7 +312,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			dictentryi18n.FieldEntryLabel:   {Type: field.TypeString, Column: dictentryi18n.FieldEntryLabel},
 		},
 	}
-	graph.Nodes[5] = &sqlgraph.Node{
+	graph.Nodes[8] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   dicttype.Table,
 			Columns: dicttype.Columns,
@@ -230,7 +336,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			dicttype.FieldTypeName:  {Type: field.TypeString, Column: dicttype.FieldTypeName},
 		},
 	}
-	graph.Nodes[6] = &sqlgraph.Node{
+	graph.Nodes[9] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   file.Table,
 			Columns: file.Columns,
@@ -241,28 +347,69 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 		Type: "File",
 		Fields: map[string]*sqlgraph.FieldSpec{
-			file.FieldCreatedAt:     {Type: field.TypeTime, Column: file.FieldCreatedAt},
-			file.FieldUpdatedAt:     {Type: field.TypeTime, Column: file.FieldUpdatedAt},
-			file.FieldDeletedAt:     {Type: field.TypeTime, Column: file.FieldDeletedAt},
-			file.FieldCreatedBy:     {Type: field.TypeUint32, Column: file.FieldCreatedBy},
-			file.FieldUpdatedBy:     {Type: field.TypeUint32, Column: file.FieldUpdatedBy},
-			file.FieldDeletedBy:     {Type: field.TypeUint32, Column: file.FieldDeletedBy},
-			file.FieldRemark:        {Type: field.TypeString, Column: file.FieldRemark},
-			file.FieldTenantID:      {Type: field.TypeUint32, Column: file.FieldTenantID},
-			file.FieldProvider:      {Type: field.TypeEnum, Column: file.FieldProvider},
-			file.FieldBucketName:    {Type: field.TypeString, Column: file.FieldBucketName},
-			file.FieldFileDirectory: {Type: field.TypeString, Column: file.FieldFileDirectory},
-			file.FieldFileGUID:      {Type: field.TypeString, Column: file.FieldFileGUID},
-			file.FieldSaveFileName:  {Type: field.TypeString, Column: file.FieldSaveFileName},
-			file.FieldFileName:      {Type: field.TypeString, Column: file.FieldFileName},
-			file.FieldExtension:     {Type: field.TypeString, Column: file.FieldExtension},
-			file.FieldSize:          {Type: field.TypeUint64, Column: file.FieldSize},
-			file.FieldSizeFormat:    {Type: field.TypeString, Column: file.FieldSizeFormat},
-			file.FieldLinkURL:       {Type: field.TypeString, Column: file.FieldLinkURL},
-			file.FieldContentHash:   {Type: field.TypeString, Column: file.FieldContentHash},
+			file.FieldCreatedAt:       {Type: field.TypeTime, Column: file.FieldCreatedAt},
+			file.FieldUpdatedAt:       {Type: field.TypeTime, Column: file.FieldUpdatedAt},
+			file.FieldDeletedAt:       {Type: field.TypeTime, Column: file.FieldDeletedAt},
+			file.FieldCreatedBy:       {Type: field.TypeUint32, Column: file.FieldCreatedBy},
+			file.FieldUpdatedBy:       {Type: field.TypeUint32, Column: file.FieldUpdatedBy},
+			file.FieldDeletedBy:       {Type: field.TypeUint32, Column: file.FieldDeletedBy},
+			file.FieldRemark:          {Type: field.TypeString, Column: file.FieldRemark},
+			file.FieldTenantID:        {Type: field.TypeUint32, Column: file.FieldTenantID},
+			file.FieldProvider:        {Type: field.TypeEnum, Column: file.FieldProvider},
+			file.FieldBucketName:      {Type: field.TypeString, Column: file.FieldBucketName},
+			file.FieldFileDirectory:   {Type: field.TypeString, Column: file.FieldFileDirectory},
+			file.FieldFileGUID:        {Type: field.TypeString, Column: file.FieldFileGUID},
+			file.FieldSaveFileName:    {Type: field.TypeString, Column: file.FieldSaveFileName},
+			file.FieldFileName:        {Type: field.TypeString, Column: file.FieldFileName},
+			file.FieldExtension:       {Type: field.TypeString, Column: file.FieldExtension},
+			file.FieldSize:            {Type: field.TypeUint64, Column: file.FieldSize},
+			file.FieldSizeFormat:      {Type: field.TypeString, Column: file.FieldSizeFormat},
+			file.FieldLinkURL:         {Type: field.TypeString, Column: file.FieldLinkURL},
+			file.FieldContentHash:     {Type: field.TypeString, Column: file.FieldContentHash},
+			file.FieldStorageObjectID: {Type: field.TypeUint32, Column: file.FieldStorageObjectID},
+			file.FieldObjectName:      {Type: field.TypeString, Column: file.FieldObjectName},
+			file.FieldScanStatus:      {Type: field.TypeEnum, Column: file.FieldScanStatus},
+			file.FieldScanSignature:   {Type: field.TypeString, Column: file.FieldScanSignature},
+			file.FieldScannedAt:       {Type: field.TypeTime, Column: file.FieldScannedAt},
+			file.FieldVersion:         {Type: field.TypeUint32, Column: file.FieldVersion},
+			file.FieldIsLatest:        {Type: field.TypeBool, Column: file.FieldIsLatest},
+			file.FieldVersionGroup:    {Type: field.TypeString, Column: file.FieldVersionGroup},
+			file.FieldLatestKey:       {Type: field.TypeString, Column: file.FieldLatestKey},
 		},
 	}
-	graph.Nodes[7] = &sqlgraph.Node{
+	graph.Nodes[10] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   filesharelink.Table,
+			Columns: filesharelink.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: filesharelink.FieldID,
+			},
+		},
+		Type: "FileShareLink",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			filesharelink.FieldCreatedAt:         {Type: field.TypeTime, Column: filesharelink.FieldCreatedAt},
+			filesharelink.FieldUpdatedAt:         {Type: field.TypeTime, Column: filesharelink.FieldUpdatedAt},
+			filesharelink.FieldDeletedAt:         {Type: field.TypeTime, Column: filesharelink.FieldDeletedAt},
+			filesharelink.FieldCreatedBy:         {Type: field.TypeUint32, Column: filesharelink.FieldCreatedBy},
+			filesharelink.FieldUpdatedBy:         {Type: field.TypeUint32, Column: filesharelink.FieldUpdatedBy},
+			filesharelink.FieldDeletedBy:         {Type: field.TypeUint32, Column: filesharelink.FieldDeletedBy},
+			filesharelink.FieldTenantID:          {Type: field.TypeUint32, Column: filesharelink.FieldTenantID},
+			filesharelink.FieldFileID:            {Type: field.TypeUint32, Column: filesharelink.FieldFileID},
+			filesharelink.FieldToken:             {Type: field.TypeString, Column: filesharelink.FieldToken},
+			filesharelink.FieldPasswordHash:      {Type: field.TypeString, Column: filesharelink.FieldPasswordHash},
+			filesharelink.FieldExpiresAt:         {Type: field.TypeTime, Column: filesharelink.FieldExpiresAt},
+			filesharelink.FieldMaxDownloads:      {Type: field.TypeUint32, Column: filesharelink.FieldMaxDownloads},
+			filesharelink.FieldDownloadCount:     {Type: field.TypeUint32, Column: filesharelink.FieldDownloadCount},
+			filesharelink.FieldAllowedTenantIds:  {Type: field.TypeJSON, Column: filesharelink.FieldAllowedTenantIds},
+			filesharelink.FieldAllowedUserIds:    {Type: field.TypeJSON, Column: filesharelink.FieldAllowedUserIds},
+			filesharelink.FieldAllowedOrgUnitIds: {Type: field.TypeJSON, Column: filesharelink.FieldAllowedOrgUnitIds},
+			filesharelink.FieldRevokedAt:         {Type: field.TypeTime, Column: filesharelink.FieldRevokedAt},
+			filesharelink.FieldRevokedBy:         {Type: field.TypeUint32, Column: filesharelink.FieldRevokedBy},
+			filesharelink.FieldLastAccessedAt:    {Type: field.TypeTime, Column: filesharelink.FieldLastAccessedAt},
+		},
+	}
+	graph.Nodes[11] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   internalmessage.Table,
 			Columns: internalmessage.Columns,
@@ -288,7 +435,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			internalmessage.FieldType:       {Type: field.TypeEnum, Column: internalmessage.FieldType},
 		},
 	}
-	graph.Nodes[8] = &sqlgraph.Node{
+	graph.Nodes[12] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   internalmessagecategory.Table,
 			Columns: internalmessagecategory.Columns,
@@ -314,7 +461,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			internalmessagecategory.FieldIconURL:   {Type: field.TypeString, Column: internalmessagecategory.FieldIconURL},
 		},
 	}
-	graph.Nodes[9] = &sqlgraph.Node{
+	graph.Nodes[13] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   internalmessagerecipient.Table,
 			Columns: internalmessagerecipient.Columns,
@@ -336,7 +483,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			internalmessagerecipient.FieldReadAt:          {Type: field.TypeTime, Column: internalmessagerecipient.FieldReadAt},
 		},
 	}
-	graph.Nodes[10] = &sqlgraph.Node{
+	graph.Nodes[14] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   language.Table,
 			Columns: language.Columns,
@@ -361,7 +508,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			language.FieldIsDefault:    {Type: field.TypeBool, Column: language.FieldIsDefault},
 		},
 	}
-	graph.Nodes[11] = &sqlgraph.Node{
+	graph.Nodes[15] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   loginauditlog.Table,
 			Columns: loginauditlog.Columns,
@@ -394,7 +541,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			loginauditlog.FieldSignature:     {Type: field.TypeBytes, Column: loginauditlog.FieldSignature},
 		},
 	}
-	graph.Nodes[12] = &sqlgraph.Node{
+	graph.Nodes[16] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   loginpolicy.Table,
 			Columns: loginpolicy.Columns,
@@ -419,7 +566,61 @@ var schemaGraph = func() *sqlgraph.Schema {
 			loginpolicy.FieldMethod:    {Type: field.TypeEnum, Column: loginpolicy.FieldMethod},
 		},
 	}
-	graph.Nodes[13] = &sqlgraph.Node{
+	graph.Nodes[17] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   luascript.Table,
+			Columns: luascript.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: luascript.FieldID,
+			},
+		},
+		Type: "LuaScript",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			luascript.FieldCreatedAt:   {Type: field.TypeTime, Column: luascript.FieldCreatedAt},
+			luascript.FieldUpdatedAt:   {Type: field.TypeTime, Column: luascript.FieldUpdatedAt},
+			luascript.FieldDeletedAt:   {Type: field.TypeTime, Column: luascript.FieldDeletedAt},
+			luascript.FieldCreatedBy:   {Type: field.TypeUint32, Column: luascript.FieldCreatedBy},
+			luascript.FieldUpdatedBy:   {Type: field.TypeUint32, Column: luascript.FieldUpdatedBy},
+			luascript.FieldDeletedBy:   {Type: field.TypeUint32, Column: luascript.FieldDeletedBy},
+			luascript.FieldTenantID:    {Type: field.TypeUint32, Column: luascript.FieldTenantID},
+			luascript.FieldName:        {Type: field.TypeString, Column: luascript.FieldName},
+			luascript.FieldHook:        {Type: field.TypeString, Column: luascript.FieldHook},
+			luascript.FieldSource:      {Type: field.TypeString, Column: luascript.FieldSource},
+			luascript.FieldDescription: {Type: field.TypeString, Column: luascript.FieldDescription},
+			luascript.FieldEnabled:     {Type: field.TypeBool, Column: luascript.FieldEnabled},
+			luascript.FieldPriority:    {Type: field.TypeInt32, Column: luascript.FieldPriority},
+			luascript.FieldCritical:    {Type: field.TypeBool, Column: luascript.FieldCritical},
+			luascript.FieldVersion:     {Type: field.TypeUint32, Column: luascript.FieldVersion},
+			luascript.FieldAuthor:      {Type: field.TypeString, Column: luascript.FieldAuthor},
+			luascript.FieldDataWrite:   {Type: field.TypeBool, Column: luascript.FieldDataWrite},
+		},
+	}
+	graph.Nodes[18] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   luascriptversion.Table,
+			Columns: luascriptversion.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: luascriptversion.FieldID,
+			},
+		},
+		Type: "LuaScriptVersion",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			luascriptversion.FieldCreatedAt: {Type: field.TypeTime, Column: luascriptversion.FieldCreatedAt},
+			luascriptversion.FieldCreatedBy: {Type: field.TypeUint32, Column: luascriptversion.FieldCreatedBy},
+			luascriptversion.FieldTenantID:  {Type: field.TypeUint32, Column: luascriptversion.FieldTenantID},
+			luascriptversion.FieldScriptID:  {Type: field.TypeUint32, Column: luascriptversion.FieldScriptID},
+			luascriptversion.FieldVersion:   {Type: field.TypeUint32, Column: luascriptversion.FieldVersion},
+			luascriptversion.FieldHook:      {Type: field.TypeString, Column: luascriptversion.FieldHook},
+			luascriptversion.FieldSource:    {Type: field.TypeString, Column: luascriptversion.FieldSource},
+			luascriptversion.FieldPriority:  {Type: field.TypeInt32, Column: luascriptversion.FieldPriority},
+			luascriptversion.FieldCritical:  {Type: field.TypeBool, Column: luascriptversion.FieldCritical},
+			luascriptversion.FieldAuthor:    {Type: field.TypeString, Column: luascriptversion.FieldAuthor},
+			luascriptversion.FieldChangeLog: {Type: field.TypeString, Column: luascriptversion.FieldChangeLog},
+		},
+	}
+	graph.Nodes[19] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   membership.Table,
 			Columns: membership.Columns,
@@ -451,7 +652,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			membership.FieldStatus:     {Type: field.TypeEnum, Column: membership.FieldStatus},
 		},
 	}
-	graph.Nodes[14] = &sqlgraph.Node{
+	graph.Nodes[20] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   membershiporgunit.Table,
 			Columns: membershiporgunit.Columns,
@@ -482,7 +683,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			membershiporgunit.FieldStatus:       {Type: field.TypeEnum, Column: membershiporgunit.FieldStatus},
 		},
 	}
-	graph.Nodes[15] = &sqlgraph.Node{
+	graph.Nodes[21] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   membershipposition.Table,
 			Columns: membershipposition.Columns,
@@ -511,7 +712,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			membershipposition.FieldStatus:       {Type: field.TypeEnum, Column: membershipposition.FieldStatus},
 		},
 	}
-	graph.Nodes[16] = &sqlgraph.Node{
+	graph.Nodes[22] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   membershiprole.Table,
 			Columns: membershiprole.Columns,
@@ -539,7 +740,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			membershiprole.FieldStatus:       {Type: field.TypeEnum, Column: membershiprole.FieldStatus},
 		},
 	}
-	graph.Nodes[17] = &sqlgraph.Node{
+	graph.Nodes[23] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   menu.Table,
 			Columns: menu.Columns,
@@ -568,7 +769,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			menu.FieldMeta:      {Type: field.TypeJSON, Column: menu.FieldMeta},
 		},
 	}
-	graph.Nodes[18] = &sqlgraph.Node{
+	graph.Nodes[24] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   operationauditlog.Table,
 			Columns: operationauditlog.Columns,
@@ -600,7 +801,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			operationauditlog.FieldSignature:      {Type: field.TypeBytes, Column: operationauditlog.FieldSignature},
 		},
 	}
-	graph.Nodes[19] = &sqlgraph.Node{
+	graph.Nodes[25] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   orgunit.Table,
 			Columns: orgunit.Columns,
@@ -647,7 +848,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			orgunit.FieldPermissionTags:     {Type: field.TypeJSON, Column: orgunit.FieldPermissionTags},
 		},
 	}
-	graph.Nodes[20] = &sqlgraph.Node{
+	graph.Nodes[26] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   permission.Table,
 			Columns: permission.Columns,
@@ -671,7 +872,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			permission.FieldGroupID:     {Type: field.TypeUint32, Column: permission.FieldGroupID},
 		},
 	}
-	graph.Nodes[21] = &sqlgraph.Node{
+	graph.Nodes[27] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   permissionapi.Table,
 			Columns: permissionapi.Columns,
@@ -692,7 +893,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			permissionapi.FieldAPIID:        {Type: field.TypeUint32, Column: permissionapi.FieldAPIID},
 		},
 	}
-	graph.Nodes[22] = &sqlgraph.Node{
+	graph.Nodes[28] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   permissionauditlog.Table,
 			Columns: permissionauditlog.Columns,
@@ -718,7 +919,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			permissionauditlog.FieldSignature:  {Type: field.TypeBytes, Column: permissionauditlog.FieldSignature},
 		},
 	}
-	graph.Nodes[23] = &sqlgraph.Node{
+	graph.Nodes[29] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   permissiongroup.Table,
 			Columns: permissiongroup.Columns,
@@ -744,7 +945,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			permissiongroup.FieldModule:      {Type: field.TypeString, Column: permissiongroup.FieldModule},
 		},
 	}
-	graph.Nodes[24] = &sqlgraph.Node{
+	graph.Nodes[30] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   permissionmenu.Table,
 			Columns: permissionmenu.Columns,
@@ -765,7 +966,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			permissionmenu.FieldMenuID:       {Type: field.TypeUint32, Column: permissionmenu.FieldMenuID},
 		},
 	}
-	graph.Nodes[25] = &sqlgraph.Node{
+	graph.Nodes[31] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   permissionpolicy.Table,
 			Columns: permissionpolicy.Columns,
@@ -791,7 +992,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			permissionpolicy.FieldCacheTTL:     {Type: field.TypeUint32, Column: permissionpolicy.FieldCacheTTL},
 		},
 	}
-	graph.Nodes[26] = &sqlgraph.Node{
+	graph.Nodes[32] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   policyevaluationlog.Table,
 			Columns: policyevaluationlog.Columns,
@@ -820,7 +1021,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			policyevaluationlog.FieldSignature:         {Type: field.TypeBytes, Column: policyevaluationlog.FieldSignature},
 		},
 	}
-	graph.Nodes[27] = &sqlgraph.Node{
+	graph.Nodes[33] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   position.Table,
 			Columns: position.Columns,
@@ -856,7 +1057,27 @@ var schemaGraph = func() *sqlgraph.Schema {
 			position.FieldEndAt:               {Type: field.TypeTime, Column: position.FieldEndAt},
 		},
 	}
-	graph.Nodes[28] = &sqlgraph.Node{
+	graph.Nodes[34] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   restoredauditlog.Table,
+			Columns: restoredauditlog.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: restoredauditlog.FieldID,
+			},
+		},
+		Type: "RestoredAuditLog",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			restoredauditlog.FieldCreatedAt:    {Type: field.TypeTime, Column: restoredauditlog.FieldCreatedAt},
+			restoredauditlog.FieldTenantID:     {Type: field.TypeUint32, Column: restoredauditlog.FieldTenantID},
+			restoredauditlog.FieldArchiveID:    {Type: field.TypeUint32, Column: restoredauditlog.FieldArchiveID},
+			restoredauditlog.FieldLogType:      {Type: field.TypeEnum, Column: restoredauditlog.FieldLogType},
+			restoredauditlog.FieldLogID:        {Type: field.TypeUint32, Column: restoredauditlog.FieldLogID},
+			restoredauditlog.FieldLogCreatedAt: {Type: field.TypeTime, Column: restoredauditlog.FieldLogCreatedAt},
+			restoredauditlog.FieldPayload:      {Type: field.TypeJSON, Column: restoredauditlog.FieldPayload},
+		},
+	}
+	graph.Nodes[35] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   role.Table,
 			Columns: role.Columns,
@@ -884,7 +1105,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			role.FieldType:        {Type: field.TypeEnum, Column: role.FieldType},
 		},
 	}
-	graph.Nodes[29] = &sqlgraph.Node{
+	graph.Nodes[36] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   rolemetadata.Table,
 			Columns: rolemetadata.Columns,
@@ -913,7 +1134,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			rolemetadata.FieldCustomOverrides:   {Type: field.TypeJSON, Column: rolemetadata.FieldCustomOverrides},
 		},
 	}
-	graph.Nodes[30] = &sqlgraph.Node{
+	graph.Nodes[37] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   rolepermission.Table,
 			Columns: rolepermission.Columns,
@@ -938,7 +1159,58 @@ var schemaGraph = func() *sqlgraph.Schema {
 			rolepermission.FieldPriority:     {Type: field.TypeInt32, Column: rolepermission.FieldPriority},
 		},
 	}
-	graph.Nodes[31] = &sqlgraph.Node{
+	graph.Nodes[38] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   storageobject.Table,
+			Columns: storageobject.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: storageobject.FieldID,
+			},
+		},
+		Type: "StorageObject",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			storageobject.FieldCreatedAt:   {Type: field.TypeTime, Column: storageobject.FieldCreatedAt},
+			storageobject.FieldUpdatedAt:   {Type: field.TypeTime, Column: storageobject.FieldUpdatedAt},
+			storageobject.FieldDeletedAt:   {Type: field.TypeTime, Column: storageobject.FieldDeletedAt},
+			storageobject.FieldDedupScope:  {Type: field.TypeString, Column: storageobject.FieldDedupScope},
+			storageobject.FieldProvider:    {Type: field.TypeEnum, Column: storageobject.FieldProvider},
+			storageobject.FieldContentHash: {Type: field.TypeString, Column: storageobject.FieldContentHash},
+			storageobject.FieldBucketName:  {Type: field.TypeString, Column: storageobject.FieldBucketName},
+			storageobject.FieldObjectName:  {Type: field.TypeString, Column: storageobject.FieldObjectName},
+			storageobject.FieldSize:        {Type: field.TypeUint64, Column: storageobject.FieldSize},
+			storageobject.FieldContentType: {Type: field.TypeString, Column: storageobject.FieldContentType},
+			storageobject.FieldRefCount:    {Type: field.TypeInt32, Column: storageobject.FieldRefCount},
+		},
+	}
+	graph.Nodes[39] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   storagequota.Table,
+			Columns: storagequota.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: storagequota.FieldID,
+			},
+		},
+		Type: "StorageQuota",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			storagequota.FieldCreatedAt:       {Type: field.TypeTime, Column: storagequota.FieldCreatedAt},
+			storagequota.FieldUpdatedAt:       {Type: field.TypeTime, Column: storagequota.FieldUpdatedAt},
+			storagequota.FieldDeletedAt:       {Type: field.TypeTime, Column: storagequota.FieldDeletedAt},
+			storagequota.FieldCreatedBy:       {Type: field.TypeUint32, Column: storagequota.FieldCreatedBy},
+			storagequota.FieldUpdatedBy:       {Type: field.TypeUint32, Column: storagequota.FieldUpdatedBy},
+			storagequota.FieldDeletedBy:       {Type: field.TypeUint32, Column: storagequota.FieldDeletedBy},
+			storagequota.FieldTenantID:        {Type: field.TypeUint32, Column: storagequota.FieldTenantID},
+			storagequota.FieldUserID:          {Type: field.TypeUint32, Column: storagequota.FieldUserID},
+			storagequota.FieldMaxBytes:        {Type: field.TypeInt64, Column: storagequota.FieldMaxBytes},
+			storagequota.FieldMaxFiles:        {Type: field.TypeInt64, Column: storagequota.FieldMaxFiles},
+			storagequota.FieldUsedBytes:       {Type: field.TypeInt64, Column: storagequota.FieldUsedBytes},
+			storagequota.FieldUsedFiles:       {Type: field.TypeInt64, Column: storagequota.FieldUsedFiles},
+			storagequota.FieldNotifiedPercent: {Type: field.TypeUint32, Column: storagequota.FieldNotifiedPercent},
+			storagequota.FieldReconciledAt:    {Type: field.TypeTime, Column: storagequota.FieldReconciledAt},
+		},
+	}
+	graph.Nodes[40] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   task.Table,
 			Columns: task.Columns,
@@ -949,23 +1221,108 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 		Type: "Task",
 		Fields: map[string]*sqlgraph.FieldSpec{
-			task.FieldCreatedAt:   {Type: field.TypeTime, Column: task.FieldCreatedAt},
-			task.FieldUpdatedAt:   {Type: field.TypeTime, Column: task.FieldUpdatedAt},
-			task.FieldDeletedAt:   {Type: field.TypeTime, Column: task.FieldDeletedAt},
-			task.FieldCreatedBy:   {Type: field.TypeUint32, Column: task.FieldCreatedBy},
-			task.FieldUpdatedBy:   {Type: field.TypeUint32, Column: task.FieldUpdatedBy},
-			task.FieldDeletedBy:   {Type: field.TypeUint32, Column: task.FieldDeletedBy},
-			task.FieldRemark:      {Type: field.TypeString, Column: task.FieldRemark},
-			task.FieldTenantID:    {Type: field.TypeUint32, Column: task.FieldTenantID},
-			task.FieldType:        {Type: field.TypeEnum, Column: task.FieldType},
-			task.FieldTypeName:    {Type: field.TypeString, Column: task.FieldTypeName},
-			task.FieldTaskPayload: {Type: field.TypeString, Column: task.FieldTaskPayload},
-			task.FieldCronSpec:    {Type: field.TypeString, Column: task.FieldCronSpec},
-			task.FieldTaskOptions: {Type: field.TypeJSON, Column: task.FieldTaskOptions},
-			task.FieldEnable:      {Type: field.TypeBool, Column: task.FieldEnable},
+			task.FieldCreatedAt:     {Type: field.TypeTime, Column: task.FieldCreatedAt},
+			task.FieldUpdatedAt:     {Type: field.TypeTime, Column: task.FieldUpdatedAt},
+			task.FieldDeletedAt:     {Type: field.TypeTime, Column: task.FieldDeletedAt},
+			task.FieldCreatedBy:     {Type: field.TypeUint32, Column: task.FieldCreatedBy},
+			task.FieldUpdatedBy:     {Type: field.TypeUint32, Column: task.FieldUpdatedBy},
+			task.FieldDeletedBy:     {Type: field.TypeUint32, Column: task.FieldDeletedBy},
+			task.FieldRemark:        {Type: field.TypeString, Column: task.FieldRemark},
+			task.FieldTenantID:      {Type: field.TypeUint32, Column: task.FieldTenantID},
+			task.FieldType:          {Type: field.TypeEnum, Column: task.FieldType},
+			task.FieldTypeName:      {Type: field.TypeString, Column: task.FieldTypeName},
+			task.FieldTaskPayload:   {Type: field.TypeString, Column: task.FieldTaskPayload},
+			task.FieldCronSpec:      {Type: field.TypeString, Column: task.FieldCronSpec},
+			task.FieldTimezone:      {Type: field.TypeString, Column: task.FieldTimezone},
+			task.FieldMisfirePolicy: {Type: field.TypeEnum, Column: task.FieldMisfirePolicy},
+			task.FieldTaskOptions:   {Type: field.TypeJSON, Column: task.FieldTaskOptions},
+			task.FieldEnable:        {Type: field.TypeBool, Column: task.FieldEnable},
 		},
 	}
-	graph.Nodes[32] = &sqlgraph.Node{
+	graph.Nodes[41] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   taskrun.Table,
+			Columns: taskrun.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: taskrun.FieldID,
+			},
+		},
+		Type: "TaskRun",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			taskrun.FieldCreatedAt:     {Type: field.TypeTime, Column: taskrun.FieldCreatedAt},
+			taskrun.FieldUpdatedAt:     {Type: field.TypeTime, Column: taskrun.FieldUpdatedAt},
+			taskrun.FieldDeletedAt:     {Type: field.TypeTime, Column: taskrun.FieldDeletedAt},
+			taskrun.FieldTaskID:        {Type: field.TypeString, Column: taskrun.FieldTaskID},
+			taskrun.FieldTypeName:      {Type: field.TypeString, Column: taskrun.FieldTypeName},
+			taskrun.FieldQueue:         {Type: field.TypeString, Column: taskrun.FieldQueue},
+			taskrun.FieldPayload:       {Type: field.TypeString, Column: taskrun.FieldPayload},
+			taskrun.FieldWorker:        {Type: field.TypeString, Column: taskrun.FieldWorker},
+			taskrun.FieldAttempt:       {Type: field.TypeUint32, Column: taskrun.FieldAttempt},
+			taskrun.FieldMaxRetry:      {Type: field.TypeUint32, Column: taskrun.FieldMaxRetry},
+			taskrun.FieldStatus:        {Type: field.TypeEnum, Column: taskrun.FieldStatus},
+			taskrun.FieldStartedAt:     {Type: field.TypeTime, Column: taskrun.FieldStartedAt},
+			taskrun.FieldFinishedAt:    {Type: field.TypeTime, Column: taskrun.FieldFinishedAt},
+			taskrun.FieldDurationMs:    {Type: field.TypeUint64, Column: taskrun.FieldDurationMs},
+			taskrun.FieldResult:        {Type: field.TypeString, Column: taskrun.FieldResult},
+			taskrun.FieldErrorMessage:  {Type: field.TypeString, Column: taskrun.FieldErrorMessage},
+			taskrun.FieldLogs:          {Type: field.TypeJSON, Column: taskrun.FieldLogs},
+			taskrun.FieldLogsTruncated: {Type: field.TypeBool, Column: taskrun.FieldLogsTruncated},
+			taskrun.FieldProgress:      {Type: field.TypeJSON, Column: taskrun.FieldProgress},
+		},
+	}
+	graph.Nodes[42] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   taskworkflow.Table,
+			Columns: taskworkflow.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: taskworkflow.FieldID,
+			},
+		},
+		Type: "TaskWorkflow",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			taskworkflow.FieldCreatedAt:   {Type: field.TypeTime, Column: taskworkflow.FieldCreatedAt},
+			taskworkflow.FieldUpdatedAt:   {Type: field.TypeTime, Column: taskworkflow.FieldUpdatedAt},
+			taskworkflow.FieldDeletedAt:   {Type: field.TypeTime, Column: taskworkflow.FieldDeletedAt},
+			taskworkflow.FieldCreatedBy:   {Type: field.TypeUint32, Column: taskworkflow.FieldCreatedBy},
+			taskworkflow.FieldUpdatedBy:   {Type: field.TypeUint32, Column: taskworkflow.FieldUpdatedBy},
+			taskworkflow.FieldDeletedBy:   {Type: field.TypeUint32, Column: taskworkflow.FieldDeletedBy},
+			taskworkflow.FieldTenantID:    {Type: field.TypeUint32, Column: taskworkflow.FieldTenantID},
+			taskworkflow.FieldName:        {Type: field.TypeString, Column: taskworkflow.FieldName},
+			taskworkflow.FieldDescription: {Type: field.TypeString, Column: taskworkflow.FieldDescription},
+			taskworkflow.FieldSteps:       {Type: field.TypeJSON, Column: taskworkflow.FieldSteps},
+			taskworkflow.FieldEnable:      {Type: field.TypeBool, Column: taskworkflow.FieldEnable},
+		},
+	}
+	graph.Nodes[43] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   taskworkflowrun.Table,
+			Columns: taskworkflowrun.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: taskworkflowrun.FieldID,
+			},
+		},
+		Type: "TaskWorkflowRun",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			taskworkflowrun.FieldCreatedAt:    {Type: field.TypeTime, Column: taskworkflowrun.FieldCreatedAt},
+			taskworkflowrun.FieldUpdatedAt:    {Type: field.TypeTime, Column: taskworkflowrun.FieldUpdatedAt},
+			taskworkflowrun.FieldDeletedAt:    {Type: field.TypeTime, Column: taskworkflowrun.FieldDeletedAt},
+			taskworkflowrun.FieldCreatedBy:    {Type: field.TypeUint32, Column: taskworkflowrun.FieldCreatedBy},
+			taskworkflowrun.FieldTenantID:     {Type: field.TypeUint32, Column: taskworkflowrun.FieldTenantID},
+			taskworkflowrun.FieldWorkflowID:   {Type: field.TypeUint32, Column: taskworkflowrun.FieldWorkflowID},
+			taskworkflowrun.FieldWorkflowName: {Type: field.TypeString, Column: taskworkflowrun.FieldWorkflowName},
+			taskworkflowrun.FieldStatus:       {Type: field.TypeEnum, Column: taskworkflowrun.FieldStatus},
+			taskworkflowrun.FieldInput:        {Type: field.TypeString, Column: taskworkflowrun.FieldInput},
+			taskworkflowrun.FieldSteps:        {Type: field.TypeJSON, Column: taskworkflowrun.FieldSteps},
+			taskworkflowrun.FieldStepRuns:     {Type: field.TypeJSON, Column: taskworkflowrun.FieldStepRuns},
+			taskworkflowrun.FieldErrorMessage: {Type: field.TypeString, Column: taskworkflowrun.FieldErrorMessage},
+			taskworkflowrun.FieldStartedAt:    {Type: field.TypeTime, Column: taskworkflowrun.FieldStartedAt},
+			taskworkflowrun.FieldFinishedAt:   {Type: field.TypeTime, Column: taskworkflowrun.FieldFinishedAt},
+		},
+	}
+	graph.Nodes[44] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   tenant.Table,
 			Columns: tenant.Columns,
@@ -976,29 +1333,33 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 		Type: "Tenant",
 		Fields: map[string]*sqlgraph.FieldSpec{
-			tenant.FieldCreatedAt:        {Type: field.TypeTime, Column: tenant.FieldCreatedAt},
-			tenant.FieldUpdatedAt:        {Type: field.TypeTime, Column: tenant.FieldUpdatedAt},
-			tenant.FieldDeletedAt:        {Type: field.TypeTime, Column: tenant.FieldDeletedAt},
-			tenant.FieldCreatedBy:        {Type: field.TypeUint32, Column: tenant.FieldCreatedBy},
-			tenant.FieldUpdatedBy:        {Type: field.TypeUint32, Column: tenant.FieldUpdatedBy},
-			tenant.FieldDeletedBy:        {Type: field.TypeUint32, Column: tenant.FieldDeletedBy},
-			tenant.FieldRemark:           {Type: field.TypeString, Column: tenant.FieldRemark},
-			tenant.FieldName:             {Type: field.TypeString, Column: tenant.FieldName},
-			tenant.FieldCode:             {Type: field.TypeString, Column: tenant.FieldCode},
-			tenant.FieldLogoURL:          {Type: field.TypeString, Column: tenant.FieldLogoURL},
-			tenant.FieldDomain:           {Type: field.TypeString, Column: tenant.FieldDomain},
-			tenant.FieldIndustry:         {Type: field.TypeString, Column: tenant.FieldIndustry},
-			tenant.FieldAdminUserID:      {Type: field.TypeUint32, Column: tenant.FieldAdminUserID},
-			tenant.FieldStatus:           {Type: field.TypeEnum, Column: tenant.FieldStatus},
-			tenant.FieldType:             {Type: field.TypeEnum, Column: tenant.FieldType},
-			tenant.FieldAuditStatus:      {Type: field.TypeEnum, Column: tenant.FieldAuditStatus},
-			tenant.FieldSubscriptionAt:   {Type: field.TypeTime, Column: tenant.FieldSubscriptionAt},
-			tenant.FieldUnsubscribeAt:    {Type: field.TypeTime, Column: tenant.FieldUnsubscribeAt},
-			tenant.FieldSubscriptionPlan: {Type: field.TypeString, Column: tenant.FieldSubscriptionPlan},
-			tenant.FieldExpiredAt:        {Type: field.TypeTime, Column: tenant.FieldExpiredAt},
+			tenant.FieldCreatedAt:               {Type: field.TypeTime, Column: tenant.FieldCreatedAt},
+			tenant.FieldUpdatedAt:               {Type: field.TypeTime, Column: tenant.FieldUpdatedAt},
+			tenant.FieldDeletedAt:               {Type: field.TypeTime, Column: tenant.FieldDeletedAt},
+			tenant.FieldCreatedBy:               {Type: field.TypeUint32, Column: tenant.FieldCreatedBy},
+			tenant.FieldUpdatedBy:               {Type: field.TypeUint32, Column: tenant.FieldUpdatedBy},
+			tenant.FieldDeletedBy:               {Type: field.TypeUint32, Column: tenant.FieldDeletedBy},
+			tenant.FieldRemark:                  {Type: field.TypeString, Column: tenant.FieldRemark},
+			tenant.FieldName:                    {Type: field.TypeString, Column: tenant.FieldName},
+			tenant.FieldCode:                    {Type: field.TypeString, Column: tenant.FieldCode},
+			tenant.FieldLogoURL:                 {Type: field.TypeString, Column: tenant.FieldLogoURL},
+			tenant.FieldDomain:                  {Type: field.TypeString, Column: tenant.FieldDomain},
+			tenant.FieldIndustry:                {Type: field.TypeString, Column: tenant.FieldIndustry},
+			tenant.FieldAdminUserID:             {Type: field.TypeUint32, Column: tenant.FieldAdminUserID},
+			tenant.FieldStatus:                  {Type: field.TypeEnum, Column: tenant.FieldStatus},
+			tenant.FieldType:                    {Type: field.TypeEnum, Column: tenant.FieldType},
+			tenant.FieldAuditStatus:             {Type: field.TypeEnum, Column: tenant.FieldAuditStatus},
+			tenant.FieldSubscriptionAt:          {Type: field.TypeTime, Column: tenant.FieldSubscriptionAt},
+			tenant.FieldUnsubscribeAt:           {Type: field.TypeTime, Column: tenant.FieldUnsubscribeAt},
+			tenant.FieldSubscriptionPlan:        {Type: field.TypeString, Column: tenant.FieldSubscriptionPlan},
+			tenant.FieldExpiredAt:               {Type: field.TypeTime, Column: tenant.FieldExpiredAt},
+			tenant.FieldHighRiskLoginAction:     {Type: field.TypeEnum, Column: tenant.FieldHighRiskLoginAction},
+			tenant.FieldStorageProvider:         {Type: field.TypeEnum, Column: tenant.FieldStorageProvider},
+			tenant.FieldFileDedupPolicy:         {Type: field.TypeEnum, Column: tenant.FieldFileDedupPolicy},
+			tenant.FieldRecycleBinRetentionDays: {Type: field.TypeUint32, Column: tenant.FieldRecycleBinRetentionDays},
 		},
 	}
-	graph.Nodes[33] = &sqlgraph.Node{
+	graph.Nodes[45] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   user.Table,
 			Columns: user.Columns,
@@ -1034,7 +1395,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			user.FieldStatus:      {Type: field.TypeEnum, Column: user.FieldStatus},
 		},
 	}
-	graph.Nodes[34] = &sqlgraph.Node{
+	graph.Nodes[46] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   usercredential.Table,
 			Columns: usercredential.Columns,
@@ -1067,7 +1428,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			usercredential.FieldResetTokenUsedAt:       {Type: field.TypeTime, Column: usercredential.FieldResetTokenUsedAt},
 		},
 	}
-	graph.Nodes[35] = &sqlgraph.Node{
+	graph.Nodes[47] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   userorgunit.Table,
 			Columns: userorgunit.Columns,
@@ -1097,7 +1458,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			userorgunit.FieldStatus:     {Type: field.TypeEnum, Column: userorgunit.FieldStatus},
 		},
 	}
-	graph.Nodes[36] = &sqlgraph.Node{
+	graph.Nodes[48] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   userposition.Table,
 			Columns: userposition.Columns,
@@ -1126,7 +1487,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			userposition.FieldStatus:     {Type: field.TypeEnum, Column: userposition.FieldStatus},
 		},
 	}
-	graph.Nodes[37] = &sqlgraph.Node{
+	graph.Nodes[49] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   userrole.Table,
 			Columns: userrole.Columns,
@@ -1569,33 +1930,33 @@ func (f *ApiAuditLogFilter) WhereSignature(p entql.BytesP) {
 }
 
 // addPredicate implements the predicateAdder interface.
-func (_q *DataAccessAuditLogQuery) addPredicate(pred func(s *sql.Selector)) {
+func (_q *AuditForwarderQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the DataAccessAuditLogQuery builder.
-func (_q *DataAccessAuditLogQuery) Filter() *DataAccessAuditLogFilter {
-	return &DataAccessAuditLogFilter{config: _q.config, predicateAdder: _q}
+// Filter returns a Filter implementation to apply filters on the AuditForwarderQuery builder.
+func (_q *AuditForwarderQuery) Filter() *AuditForwarderFilter {
+	return &AuditForwarderFilter{config: _q.config, predicateAdder: _q}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *DataAccessAuditLogMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *AuditForwarderMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the DataAccessAuditLogMutation builder.
-func (m *DataAccessAuditLogMutation) Filter() *DataAccessAuditLogFilter {
-	return &DataAccessAuditLogFilter{config: m.config, predicateAdder: m}
+// Filter returns an entql.Where implementation to apply filters on the AuditForwarderMutation builder.
+func (m *AuditForwarderMutation) Filter() *AuditForwarderFilter {
+	return &AuditForwarderFilter{config: m.config, predicateAdder: m}
 }
 
-// DataAccessAuditLogFilter provides a generic filtering capability at runtime for DataAccessAuditLogQuery.
-type DataAccessAuditLogFilter struct {
+// AuditForwarderFilter provides a generic filtering capability at runtime for AuditForwarderQuery.
+type AuditForwarderFilter struct {
 	predicateAdder
 	config
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *DataAccessAuditLogFilter) Where(p entql.P) {
+func (f *AuditForwarderFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[2].Type, p, s); err != nil {
 			s.AddError(err)
@@ -1604,168 +1965,153 @@ func (f *DataAccessAuditLogFilter) Where(p entql.P) {
 }
 
 // WhereID applies the entql uint32 predicate on the id field.
-func (f *DataAccessAuditLogFilter) WhereID(p entql.Uint32P) {
-	f.Where(p.Field(dataaccessauditlog.FieldID))
+func (f *AuditForwarderFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(auditforwarder.FieldID))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *DataAccessAuditLogFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(dataaccessauditlog.FieldCreatedAt))
+func (f *AuditForwarderFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(auditforwarder.FieldCreatedAt))
 }
 
-// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
-func (f *DataAccessAuditLogFilter) WhereTenantID(p entql.Uint32P) {
-	f.Where(p.Field(dataaccessauditlog.FieldTenantID))
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *AuditForwarderFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(auditforwarder.FieldUpdatedAt))
 }
 
-// WhereUserID applies the entql uint32 predicate on the user_id field.
-func (f *DataAccessAuditLogFilter) WhereUserID(p entql.Uint32P) {
-	f.Where(p.Field(dataaccessauditlog.FieldUserID))
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *AuditForwarderFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(auditforwarder.FieldDeletedAt))
 }
 
-// WhereUsername applies the entql string predicate on the username field.
-func (f *DataAccessAuditLogFilter) WhereUsername(p entql.StringP) {
-	f.Where(p.Field(dataaccessauditlog.FieldUsername))
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *AuditForwarderFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(auditforwarder.FieldCreatedBy))
 }
 
-// WhereIPAddress applies the entql string predicate on the ip_address field.
-func (f *DataAccessAuditLogFilter) WhereIPAddress(p entql.StringP) {
-	f.Where(p.Field(dataaccessauditlog.FieldIPAddress))
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *AuditForwarderFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(auditforwarder.FieldUpdatedBy))
 }
 
-// WhereGeoLocation applies the entql json.RawMessage predicate on the geo_location field.
-func (f *DataAccessAuditLogFilter) WhereGeoLocation(p entql.BytesP) {
-	f.Where(p.Field(dataaccessauditlog.FieldGeoLocation))
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *AuditForwarderFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(auditforwarder.FieldDeletedBy))
 }
 
-// WhereDeviceInfo applies the entql json.RawMessage predicate on the device_info field.
-func (f *DataAccessAuditLogFilter) WhereDeviceInfo(p entql.BytesP) {
-	f.Where(p.Field(dataaccessauditlog.FieldDeviceInfo))
+// WhereRemark applies the entql string predicate on the remark field.
+func (f *AuditForwarderFilter) WhereRemark(p entql.StringP) {
+	f.Where(p.Field(auditforwarder.FieldRemark))
 }
 
-// WhereRequestID applies the entql string predicate on the request_id field.
-func (f *DataAccessAuditLogFilter) WhereRequestID(p entql.StringP) {
-	f.Where(p.Field(dataaccessauditlog.FieldRequestID))
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *AuditForwarderFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(auditforwarder.FieldTenantID))
 }
 
-// WhereTraceID applies the entql string predicate on the trace_id field.
-func (f *DataAccessAuditLogFilter) WhereTraceID(p entql.StringP) {
-	f.Where(p.Field(dataaccessauditlog.FieldTraceID))
+// WhereName applies the entql string predicate on the name field.
+func (f *AuditForwarderFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(auditforwarder.FieldName))
 }
 
-// WhereDataSource applies the entql string predicate on the data_source field.
-func (f *DataAccessAuditLogFilter) WhereDataSource(p entql.StringP) {
-	f.Where(p.Field(dataaccessauditlog.FieldDataSource))
+// WhereEnabled applies the entql bool predicate on the enabled field.
+func (f *AuditForwarderFilter) WhereEnabled(p entql.BoolP) {
+	f.Where(p.Field(auditforwarder.FieldEnabled))
 }
 
-// WhereTableName applies the entql string predicate on the table_name field.
-func (f *DataAccessAuditLogFilter) WhereTableName(p entql.StringP) {
-	f.Where(p.Field(dataaccessauditlog.FieldTableName))
+// WhereFormat applies the entql string predicate on the format field.
+func (f *AuditForwarderFilter) WhereFormat(p entql.StringP) {
+	f.Where(p.Field(auditforwarder.FieldFormat))
 }
 
-// WhereDataID applies the entql string predicate on the data_id field.
-func (f *DataAccessAuditLogFilter) WhereDataID(p entql.StringP) {
-	f.Where(p.Field(dataaccessauditlog.FieldDataID))
+// WhereTransport applies the entql string predicate on the transport field.
+func (f *AuditForwarderFilter) WhereTransport(p entql.StringP) {
+	f.Where(p.Field(auditforwarder.FieldTransport))
 }
 
-// WhereAccessType applies the entql string predicate on the access_type field.
-func (f *DataAccessAuditLogFilter) WhereAccessType(p entql.StringP) {
-	f.Where(p.Field(dataaccessauditlog.FieldAccessType))
+// WhereEndpoint applies the entql string predicate on the endpoint field.
+func (f *AuditForwarderFilter) WhereEndpoint(p entql.StringP) {
+	f.Where(p.Field(auditforwarder.FieldEndpoint))
 }
 
-// WhereSQLDigest applies the entql string predicate on the sql_digest field.
-func (f *DataAccessAuditLogFilter) WhereSQLDigest(p entql.StringP) {
-	f.Where(p.Field(dataaccessauditlog.FieldSQLDigest))
+// WhereLogTypes applies the entql json.RawMessage predicate on the log_types field.
+func (f *AuditForwarderFilter) WhereLogTypes(p entql.BytesP) {
+	f.Where(p.Field(auditforwarder.FieldLogTypes))
 }
 
-// WhereSQLText applies the entql string predicate on the sql_text field.
-func (f *DataAccessAuditLogFilter) WhereSQLText(p entql.StringP) {
-	f.Where(p.Field(dataaccessauditlog.FieldSQLText))
+// WhereFieldMapping applies the entql json.RawMessage predicate on the field_mapping field.
+func (f *AuditForwarderFilter) WhereFieldMapping(p entql.BytesP) {
+	f.Where(p.Field(auditforwarder.FieldFieldMapping))
 }
 
-// WhereAffectedRows applies the entql uint32 predicate on the affected_rows field.
-func (f *DataAccessAuditLogFilter) WhereAffectedRows(p entql.Uint32P) {
-	f.Where(p.Field(dataaccessauditlog.FieldAffectedRows))
+// WhereSecret applies the entql string predicate on the secret field.
+func (f *AuditForwarderFilter) WhereSecret(p entql.StringP) {
+	f.Where(p.Field(auditforwarder.FieldSecret))
 }
 
-// WhereLatencyMs applies the entql uint32 predicate on the latency_ms field.
-func (f *DataAccessAuditLogFilter) WhereLatencyMs(p entql.Uint32P) {
-	f.Where(p.Field(dataaccessauditlog.FieldLatencyMs))
+// WhereHeaders applies the entql json.RawMessage predicate on the headers field.
+func (f *AuditForwarderFilter) WhereHeaders(p entql.BytesP) {
+	f.Where(p.Field(auditforwarder.FieldHeaders))
 }
 
-// WhereSuccess applies the entql bool predicate on the success field.
-func (f *DataAccessAuditLogFilter) WhereSuccess(p entql.BoolP) {
-	f.Where(p.Field(dataaccessauditlog.FieldSuccess))
+// WhereTLSInsecureSkipVerify applies the entql bool predicate on the tls_insecure_skip_verify field.
+func (f *AuditForwarderFilter) WhereTLSInsecureSkipVerify(p entql.BoolP) {
+	f.Where(p.Field(auditforwarder.FieldTLSInsecureSkipVerify))
 }
 
-// WhereSensitiveLevel applies the entql string predicate on the sensitive_level field.
-func (f *DataAccessAuditLogFilter) WhereSensitiveLevel(p entql.StringP) {
-	f.Where(p.Field(dataaccessauditlog.FieldSensitiveLevel))
+// WhereTLSCaPem applies the entql string predicate on the tls_ca_pem field.
+func (f *AuditForwarderFilter) WhereTLSCaPem(p entql.StringP) {
+	f.Where(p.Field(auditforwarder.FieldTLSCaPem))
 }
 
-// WhereDataMasked applies the entql bool predicate on the data_masked field.
-func (f *DataAccessAuditLogFilter) WhereDataMasked(p entql.BoolP) {
-	f.Where(p.Field(dataaccessauditlog.FieldDataMasked))
+// WhereTLSServerName applies the entql string predicate on the tls_server_name field.
+func (f *AuditForwarderFilter) WhereTLSServerName(p entql.StringP) {
+	f.Where(p.Field(auditforwarder.FieldTLSServerName))
 }
 
-// WhereMaskingRules applies the entql string predicate on the masking_rules field.
-func (f *DataAccessAuditLogFilter) WhereMaskingRules(p entql.StringP) {
-	f.Where(p.Field(dataaccessauditlog.FieldMaskingRules))
+// WhereAppName applies the entql string predicate on the app_name field.
+func (f *AuditForwarderFilter) WhereAppName(p entql.StringP) {
+	f.Where(p.Field(auditforwarder.FieldAppName))
 }
 
-// WhereBusinessPurpose applies the entql string predicate on the business_purpose field.
-func (f *DataAccessAuditLogFilter) WhereBusinessPurpose(p entql.StringP) {
-	f.Where(p.Field(dataaccessauditlog.FieldBusinessPurpose))
+// WhereFacility applies the entql uint32 predicate on the facility field.
+func (f *AuditForwarderFilter) WhereFacility(p entql.Uint32P) {
+	f.Where(p.Field(auditforwarder.FieldFacility))
 }
 
-// WhereDataCategory applies the entql string predicate on the data_category field.
-func (f *DataAccessAuditLogFilter) WhereDataCategory(p entql.StringP) {
-	f.Where(p.Field(dataaccessauditlog.FieldDataCategory))
-}
-
-// WhereDbUser applies the entql string predicate on the db_user field.
-func (f *DataAccessAuditLogFilter) WhereDbUser(p entql.StringP) {
-	f.Where(p.Field(dataaccessauditlog.FieldDbUser))
-}
-
-// WhereLogHash applies the entql string predicate on the log_hash field.
-func (f *DataAccessAuditLogFilter) WhereLogHash(p entql.StringP) {
-	f.Where(p.Field(dataaccessauditlog.FieldLogHash))
-}
-
-// WhereSignature applies the entql []byte predicate on the signature field.
-func (f *DataAccessAuditLogFilter) WhereSignature(p entql.BytesP) {
-	f.Where(p.Field(dataaccessauditlog.FieldSignature))
+// WhereMaxBufferBytes applies the entql uint64 predicate on the max_buffer_bytes field.
+func (f *AuditForwarderFilter) WhereMaxBufferBytes(p entql.Uint64P) {
+	f.Where(p.Field(auditforwarder.FieldMaxBufferBytes))
 }
 
 // addPredicate implements the predicateAdder interface.
-func (_q *DictEntryQuery) addPredicate(pred func(s *sql.Selector)) {
+func (_q *AuditLogArchiveQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the DictEntryQuery builder.
-func (_q *DictEntryQuery) Filter() *DictEntryFilter {
-	return &DictEntryFilter{config: _q.config, predicateAdder: _q}
+// Filter returns a Filter implementation to apply filters on the AuditLogArchiveQuery builder.
+func (_q *AuditLogArchiveQuery) Filter() *AuditLogArchiveFilter {
+	return &AuditLogArchiveFilter{config: _q.config, predicateAdder: _q}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *DictEntryMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *AuditLogArchiveMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the DictEntryMutation builder.
-func (m *DictEntryMutation) Filter() *DictEntryFilter {
-	return &DictEntryFilter{config: m.config, predicateAdder: m}
+// Filter returns an entql.Where implementation to apply filters on the AuditLogArchiveMutation builder.
+func (m *AuditLogArchiveMutation) Filter() *AuditLogArchiveFilter {
+	return &AuditLogArchiveFilter{config: m.config, predicateAdder: m}
 }
 
-// DictEntryFilter provides a generic filtering capability at runtime for DictEntryQuery.
-type DictEntryFilter struct {
+// AuditLogArchiveFilter provides a generic filtering capability at runtime for AuditLogArchiveQuery.
+type AuditLogArchiveFilter struct {
 	predicateAdder
 	config
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *DictEntryFilter) Where(p entql.P) {
+func (f *AuditLogArchiveFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[3].Type, p, s); err != nil {
 			s.AddError(err)
@@ -1774,121 +2120,133 @@ func (f *DictEntryFilter) Where(p entql.P) {
 }
 
 // WhereID applies the entql uint32 predicate on the id field.
-func (f *DictEntryFilter) WhereID(p entql.Uint32P) {
-	f.Where(p.Field(dictentry.FieldID))
+func (f *AuditLogArchiveFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(auditlogarchive.FieldID))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *DictEntryFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(dictentry.FieldCreatedAt))
+func (f *AuditLogArchiveFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(auditlogarchive.FieldCreatedAt))
 }
 
 // WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
-func (f *DictEntryFilter) WhereUpdatedAt(p entql.TimeP) {
-	f.Where(p.Field(dictentry.FieldUpdatedAt))
+func (f *AuditLogArchiveFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(auditlogarchive.FieldUpdatedAt))
 }
 
 // WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
-func (f *DictEntryFilter) WhereDeletedAt(p entql.TimeP) {
-	f.Where(p.Field(dictentry.FieldDeletedAt))
+func (f *AuditLogArchiveFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(auditlogarchive.FieldDeletedAt))
 }
 
-// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
-func (f *DictEntryFilter) WhereCreatedBy(p entql.Uint32P) {
-	f.Where(p.Field(dictentry.FieldCreatedBy))
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *AuditLogArchiveFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(auditlogarchive.FieldTenantID))
 }
 
-// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
-func (f *DictEntryFilter) WhereUpdatedBy(p entql.Uint32P) {
-	f.Where(p.Field(dictentry.FieldUpdatedBy))
+// WhereLogType applies the entql string predicate on the log_type field.
+func (f *AuditLogArchiveFilter) WhereLogType(p entql.StringP) {
+	f.Where(p.Field(auditlogarchive.FieldLogType))
 }
 
-// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
-func (f *DictEntryFilter) WhereDeletedBy(p entql.Uint32P) {
-	f.Where(p.Field(dictentry.FieldDeletedBy))
+// WhereStatus applies the entql string predicate on the status field.
+func (f *AuditLogArchiveFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(auditlogarchive.FieldStatus))
 }
 
-// WhereSortOrder applies the entql uint32 predicate on the sort_order field.
-func (f *DictEntryFilter) WhereSortOrder(p entql.Uint32P) {
-	f.Where(p.Field(dictentry.FieldSortOrder))
+// WhereBucketName applies the entql string predicate on the bucket_name field.
+func (f *AuditLogArchiveFilter) WhereBucketName(p entql.StringP) {
+	f.Where(p.Field(auditlogarchive.FieldBucketName))
 }
 
-// WhereIsEnabled applies the entql bool predicate on the is_enabled field.
-func (f *DictEntryFilter) WhereIsEnabled(p entql.BoolP) {
-	f.Where(p.Field(dictentry.FieldIsEnabled))
+// WhereObjectName applies the entql string predicate on the object_name field.
+func (f *AuditLogArchiveFilter) WhereObjectName(p entql.StringP) {
+	f.Where(p.Field(auditlogarchive.FieldObjectName))
 }
 
-// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
-func (f *DictEntryFilter) WhereTenantID(p entql.Uint32P) {
-	f.Where(p.Field(dictentry.FieldTenantID))
+// WhereManifestObjectName applies the entql string predicate on the manifest_object_name field.
+func (f *AuditLogArchiveFilter) WhereManifestObjectName(p entql.StringP) {
+	f.Where(p.Field(auditlogarchive.FieldManifestObjectName))
 }
 
-// WhereEntryValue applies the entql string predicate on the entry_value field.
-func (f *DictEntryFilter) WhereEntryValue(p entql.StringP) {
-	f.Where(p.Field(dictentry.FieldEntryValue))
+// WhereSha256 applies the entql string predicate on the sha256 field.
+func (f *AuditLogArchiveFilter) WhereSha256(p entql.StringP) {
+	f.Where(p.Field(auditlogarchive.FieldSha256))
 }
 
-// WhereNumericValue applies the entql int32 predicate on the numeric_value field.
-func (f *DictEntryFilter) WhereNumericValue(p entql.Int32P) {
-	f.Where(p.Field(dictentry.FieldNumericValue))
+// WhereSizeBytes applies the entql uint64 predicate on the size_bytes field.
+func (f *AuditLogArchiveFilter) WhereSizeBytes(p entql.Uint64P) {
+	f.Where(p.Field(auditlogarchive.FieldSizeBytes))
 }
 
-// WhereHasDictType applies a predicate to check if query has an edge dict_type.
-func (f *DictEntryFilter) WhereHasDictType() {
-	f.Where(entql.HasEdge("dict_type"))
+// WhereRowCount applies the entql uint64 predicate on the row_count field.
+func (f *AuditLogArchiveFilter) WhereRowCount(p entql.Uint64P) {
+	f.Where(p.Field(auditlogarchive.FieldRowCount))
 }
 
-// WhereHasDictTypeWith applies a predicate to check if query has an edge dict_type with a given conditions (other predicates).
-func (f *DictEntryFilter) WhereHasDictTypeWith(preds ...predicate.DictType) {
-	f.Where(entql.HasEdgeWith("dict_type", sqlgraph.WrapFunc(func(s *sql.Selector) {
-		for _, p := range preds {
-			p(s)
-		}
-	})))
+// WherePurgedCount applies the entql uint64 predicate on the purged_count field.
+func (f *AuditLogArchiveFilter) WherePurgedCount(p entql.Uint64P) {
+	f.Where(p.Field(auditlogarchive.FieldPurgedCount))
 }
 
-// WhereHasI18ns applies a predicate to check if query has an edge i18ns.
-func (f *DictEntryFilter) WhereHasI18ns() {
-	f.Where(entql.HasEdge("i18ns"))
+// WhereMinLogID applies the entql uint32 predicate on the min_log_id field.
+func (f *AuditLogArchiveFilter) WhereMinLogID(p entql.Uint32P) {
+	f.Where(p.Field(auditlogarchive.FieldMinLogID))
 }
 
-// WhereHasI18nsWith applies a predicate to check if query has an edge i18ns with a given conditions (other predicates).
-func (f *DictEntryFilter) WhereHasI18nsWith(preds ...predicate.DictEntryI18n) {
-	f.Where(entql.HasEdgeWith("i18ns", sqlgraph.WrapFunc(func(s *sql.Selector) {
-		for _, p := range preds {
-			p(s)
-		}
-	})))
+// WhereMaxLogID applies the entql uint32 predicate on the max_log_id field.
+func (f *AuditLogArchiveFilter) WhereMaxLogID(p entql.Uint32P) {
+	f.Where(p.Field(auditlogarchive.FieldMaxLogID))
+}
+
+// WhereCutoffTime applies the entql time.Time predicate on the cutoff_time field.
+func (f *AuditLogArchiveFilter) WhereCutoffTime(p entql.TimeP) {
+	f.Where(p.Field(auditlogarchive.FieldCutoffTime))
+}
+
+// WhereErrorMessage applies the entql string predicate on the error_message field.
+func (f *AuditLogArchiveFilter) WhereErrorMessage(p entql.StringP) {
+	f.Where(p.Field(auditlogarchive.FieldErrorMessage))
+}
+
+// WhereRestoredCount applies the entql uint64 predicate on the restored_count field.
+func (f *AuditLogArchiveFilter) WhereRestoredCount(p entql.Uint64P) {
+	f.Where(p.Field(auditlogarchive.FieldRestoredCount))
+}
+
+// WhereRestoredAt applies the entql time.Time predicate on the restored_at field.
+func (f *AuditLogArchiveFilter) WhereRestoredAt(p entql.TimeP) {
+	f.Where(p.Field(auditlogarchive.FieldRestoredAt))
 }
 
 // addPredicate implements the predicateAdder interface.
-func (_q *DictEntryI18nQuery) addPredicate(pred func(s *sql.Selector)) {
+func (_q *AuditLogRetentionPolicyQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the DictEntryI18nQuery builder.
-func (_q *DictEntryI18nQuery) Filter() *DictEntryI18nFilter {
-	return &DictEntryI18nFilter{config: _q.config, predicateAdder: _q}
+// Filter returns a Filter implementation to apply filters on the AuditLogRetentionPolicyQuery builder.
+func (_q *AuditLogRetentionPolicyQuery) Filter() *AuditLogRetentionPolicyFilter {
+	return &AuditLogRetentionPolicyFilter{config: _q.config, predicateAdder: _q}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *DictEntryI18nMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *AuditLogRetentionPolicyMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the DictEntryI18nMutation builder.
-func (m *DictEntryI18nMutation) Filter() *DictEntryI18nFilter {
-	return &DictEntryI18nFilter{config: m.config, predicateAdder: m}
+// Filter returns an entql.Where implementation to apply filters on the AuditLogRetentionPolicyMutation builder.
+func (m *AuditLogRetentionPolicyMutation) Filter() *AuditLogRetentionPolicyFilter {
+	return &AuditLogRetentionPolicyFilter{config: m.config, predicateAdder: m}
 }
 
-// DictEntryI18nFilter provides a generic filtering capability at runtime for DictEntryI18nQuery.
-type DictEntryI18nFilter struct {
+// AuditLogRetentionPolicyFilter provides a generic filtering capability at runtime for AuditLogRetentionPolicyQuery.
+type AuditLogRetentionPolicyFilter struct {
 	predicateAdder
 	config
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *DictEntryI18nFilter) Where(p entql.P) {
+func (f *AuditLogRetentionPolicyFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[4].Type, p, s); err != nil {
 			s.AddError(err)
@@ -1897,107 +2255,103 @@ func (f *DictEntryI18nFilter) Where(p entql.P) {
 }
 
 // WhereID applies the entql uint32 predicate on the id field.
-func (f *DictEntryI18nFilter) WhereID(p entql.Uint32P) {
-	f.Where(p.Field(dictentryi18n.FieldID))
+func (f *AuditLogRetentionPolicyFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(auditlogretentionpolicy.FieldID))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *DictEntryI18nFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(dictentryi18n.FieldCreatedAt))
+func (f *AuditLogRetentionPolicyFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(auditlogretentionpolicy.FieldCreatedAt))
 }
 
 // WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
-func (f *DictEntryI18nFilter) WhereUpdatedAt(p entql.TimeP) {
-	f.Where(p.Field(dictentryi18n.FieldUpdatedAt))
+func (f *AuditLogRetentionPolicyFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(auditlogretentionpolicy.FieldUpdatedAt))
 }
 
 // WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
-func (f *DictEntryI18nFilter) WhereDeletedAt(p entql.TimeP) {
-	f.Where(p.Field(dictentryi18n.FieldDeletedAt))
+func (f *AuditLogRetentionPolicyFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(auditlogretentionpolicy.FieldDeletedAt))
 }
 
 // WhereCreatedBy applies the entql uint32 predicate on the created_by field.
-func (f *DictEntryI18nFilter) WhereCreatedBy(p entql.Uint32P) {
-	f.Where(p.Field(dictentryi18n.FieldCreatedBy))
+func (f *AuditLogRetentionPolicyFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(auditlogretentionpolicy.FieldCreatedBy))
 }
 
 // WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
-func (f *DictEntryI18nFilter) WhereUpdatedBy(p entql.Uint32P) {
-	f.Where(p.Field(dictentryi18n.FieldUpdatedBy))
+func (f *AuditLogRetentionPolicyFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(auditlogretentionpolicy.FieldUpdatedBy))
 }
 
 // WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
-func (f *DictEntryI18nFilter) WhereDeletedBy(p entql.Uint32P) {
-	f.Where(p.Field(dictentryi18n.FieldDeletedBy))
+func (f *AuditLogRetentionPolicyFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(auditlogretentionpolicy.FieldDeletedBy))
 }
 
-// WhereDescription applies the entql string predicate on the description field.
-func (f *DictEntryI18nFilter) WhereDescription(p entql.StringP) {
-	f.Where(p.Field(dictentryi18n.FieldDescription))
+// WhereRemark applies the entql string predicate on the remark field.
+func (f *AuditLogRetentionPolicyFilter) WhereRemark(p entql.StringP) {
+	f.Where(p.Field(auditlogretentionpolicy.FieldRemark))
 }
 
-// WhereSortOrder applies the entql uint32 predicate on the sort_order field.
-func (f *DictEntryI18nFilter) WhereSortOrder(p entql.Uint32P) {
-	f.Where(p.Field(dictentryi18n.FieldSortOrder))
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *AuditLogRetentionPolicyFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(auditlogretentionpolicy.FieldTenantID))
 }
 
-// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
-func (f *DictEntryI18nFilter) WhereTenantID(p entql.Uint32P) {
-	f.Where(p.Field(dictentryi18n.FieldTenantID))
+// WhereLogType applies the entql string predicate on the log_type field.
+func (f *AuditLogRetentionPolicyFilter) WhereLogType(p entql.StringP) {
+	f.Where(p.Field(auditlogretentionpolicy.FieldLogType))
 }
 
-// WhereLanguageCode applies the entql string predicate on the language_code field.
-func (f *DictEntryI18nFilter) WhereLanguageCode(p entql.StringP) {
-	f.Where(p.Field(dictentryi18n.FieldLanguageCode))
+// WhereRetentionDays applies the entql uint32 predicate on the retention_days field.
+func (f *AuditLogRetentionPolicyFilter) WhereRetentionDays(p entql.Uint32P) {
+	f.Where(p.Field(auditlogretentionpolicy.FieldRetentionDays))
 }
 
-// WhereEntryLabel applies the entql string predicate on the entry_label field.
-func (f *DictEntryI18nFilter) WhereEntryLabel(p entql.StringP) {
-	f.Where(p.Field(dictentryi18n.FieldEntryLabel))
+// WhereArchiveEnabled applies the entql bool predicate on the archive_enabled field.
+func (f *AuditLogRetentionPolicyFilter) WhereArchiveEnabled(p entql.BoolP) {
+	f.Where(p.Field(auditlogretentionpolicy.FieldArchiveEnabled))
 }
 
-// WhereHasDictEntry applies a predicate to check if query has an edge dict_entry.
-func (f *DictEntryI18nFilter) WhereHasDictEntry() {
-	f.Where(entql.HasEdge("dict_entry"))
+// WhereEnabled applies the entql bool predicate on the enabled field.
+func (f *AuditLogRetentionPolicyFilter) WhereEnabled(p entql.BoolP) {
+	f.Where(p.Field(auditlogretentionpolicy.FieldEnabled))
 }
 
-// WhereHasDictEntryWith applies a predicate to check if query has an edge dict_entry with a given conditions (other predicates).
-func (f *DictEntryI18nFilter) WhereHasDictEntryWith(preds ...predicate.DictEntry) {
-	f.Where(entql.HasEdgeWith("dict_entry", sqlgraph.WrapFunc(func(s *sql.Selector) {
-		for _, p := range preds {
-			p(s)
-		}
-	})))
+// WhereBatchSize applies the entql uint32 predicate on the batch_size field.
+func (f *AuditLogRetentionPolicyFilter) WhereBatchSize(p entql.Uint32P) {
+	f.Where(p.Field(auditlogretentionpolicy.FieldBatchSize))
 }
 
 // addPredicate implements the predicateAdder interface.
-func (_q *DictTypeQuery) addPredicate(pred func(s *sql.Selector)) {
+func (_q *DataAccessAuditLogQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the DictTypeQuery builder.
-func (_q *DictTypeQuery) Filter() *DictTypeFilter {
-	return &DictTypeFilter{config: _q.config, predicateAdder: _q}
+// Filter returns a Filter implementation to apply filters on the DataAccessAuditLogQuery builder.
+func (_q *DataAccessAuditLogQuery) Filter() *DataAccessAuditLogFilter {
+	return &DataAccessAuditLogFilter{config: _q.config, predicateAdder: _q}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *DictTypeMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *DataAccessAuditLogMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the DictTypeMutation builder.
-func (m *DictTypeMutation) Filter() *DictTypeFilter {
-	return &DictTypeFilter{config: m.config, predicateAdder: m}
+// Filter returns an entql.Where implementation to apply filters on the DataAccessAuditLogMutation builder.
+func (m *DataAccessAuditLogMutation) Filter() *DataAccessAuditLogFilter {
+	return &DataAccessAuditLogFilter{config: m.config, predicateAdder: m}
 }
 
-// DictTypeFilter provides a generic filtering capability at runtime for DictTypeQuery.
-type DictTypeFilter struct {
+// DataAccessAuditLogFilter provides a generic filtering capability at runtime for DataAccessAuditLogQuery.
+type DataAccessAuditLogFilter struct {
 	predicateAdder
 	config
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *DictTypeFilter) Where(p entql.P) {
+func (f *DataAccessAuditLogFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[5].Type, p, s); err != nil {
 			s.AddError(err)
@@ -2006,107 +2360,168 @@ func (f *DictTypeFilter) Where(p entql.P) {
 }
 
 // WhereID applies the entql uint32 predicate on the id field.
-func (f *DictTypeFilter) WhereID(p entql.Uint32P) {
-	f.Where(p.Field(dicttype.FieldID))
+func (f *DataAccessAuditLogFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(dataaccessauditlog.FieldID))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *DictTypeFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(dicttype.FieldCreatedAt))
+func (f *DataAccessAuditLogFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(dataaccessauditlog.FieldCreatedAt))
 }
 
-// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
-func (f *DictTypeFilter) WhereUpdatedAt(p entql.TimeP) {
-	f.Where(p.Field(dicttype.FieldUpdatedAt))
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *DataAccessAuditLogFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(dataaccessauditlog.FieldTenantID))
 }
 
-// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
-func (f *DictTypeFilter) WhereDeletedAt(p entql.TimeP) {
-	f.Where(p.Field(dicttype.FieldDeletedAt))
+// WhereUserID applies the entql uint32 predicate on the user_id field.
+func (f *DataAccessAuditLogFilter) WhereUserID(p entql.Uint32P) {
+	f.Where(p.Field(dataaccessauditlog.FieldUserID))
 }
 
-// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
-func (f *DictTypeFilter) WhereCreatedBy(p entql.Uint32P) {
-	f.Where(p.Field(dicttype.FieldCreatedBy))
+// WhereUsername applies the entql string predicate on the username field.
+func (f *DataAccessAuditLogFilter) WhereUsername(p entql.StringP) {
+	f.Where(p.Field(dataaccessauditlog.FieldUsername))
 }
 
-// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
-func (f *DictTypeFilter) WhereUpdatedBy(p entql.Uint32P) {
-	f.Where(p.Field(dicttype.FieldUpdatedBy))
+// WhereIPAddress applies the entql string predicate on the ip_address field.
+func (f *DataAccessAuditLogFilter) WhereIPAddress(p entql.StringP) {
+	f.Where(p.Field(dataaccessauditlog.FieldIPAddress))
 }
 
-// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
-func (f *DictTypeFilter) WhereDeletedBy(p entql.Uint32P) {
-	f.Where(p.Field(dicttype.FieldDeletedBy))
+// WhereGeoLocation applies the entql json.RawMessage predicate on the geo_location field.
+func (f *DataAccessAuditLogFilter) WhereGeoLocation(p entql.BytesP) {
+	f.Where(p.Field(dataaccessauditlog.FieldGeoLocation))
 }
 
-// WhereIsEnabled applies the entql bool predicate on the is_enabled field.
-func (f *DictTypeFilter) WhereIsEnabled(p entql.BoolP) {
-	f.Where(p.Field(dicttype.FieldIsEnabled))
+// WhereDeviceInfo applies the entql json.RawMessage predicate on the device_info field.
+func (f *DataAccessAuditLogFilter) WhereDeviceInfo(p entql.BytesP) {
+	f.Where(p.Field(dataaccessauditlog.FieldDeviceInfo))
 }
 
-// WhereSortOrder applies the entql uint32 predicate on the sort_order field.
-func (f *DictTypeFilter) WhereSortOrder(p entql.Uint32P) {
-	f.Where(p.Field(dicttype.FieldSortOrder))
+// WhereRequestID applies the entql string predicate on the request_id field.
+func (f *DataAccessAuditLogFilter) WhereRequestID(p entql.StringP) {
+	f.Where(p.Field(dataaccessauditlog.FieldRequestID))
 }
 
-// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
-func (f *DictTypeFilter) WhereTenantID(p entql.Uint32P) {
-	f.Where(p.Field(dicttype.FieldTenantID))
+// WhereTraceID applies the entql string predicate on the trace_id field.
+func (f *DataAccessAuditLogFilter) WhereTraceID(p entql.StringP) {
+	f.Where(p.Field(dataaccessauditlog.FieldTraceID))
 }
 
-// WhereTypeCode applies the entql string predicate on the type_code field.
-func (f *DictTypeFilter) WhereTypeCode(p entql.StringP) {
-	f.Where(p.Field(dicttype.FieldTypeCode))
+// WhereDataSource applies the entql string predicate on the data_source field.
+func (f *DataAccessAuditLogFilter) WhereDataSource(p entql.StringP) {
+	f.Where(p.Field(dataaccessauditlog.FieldDataSource))
 }
 
-// WhereTypeName applies the entql string predicate on the type_name field.
-func (f *DictTypeFilter) WhereTypeName(p entql.StringP) {
-	f.Where(p.Field(dicttype.FieldTypeName))
+// WhereTableName applies the entql string predicate on the table_name field.
+func (f *DataAccessAuditLogFilter) WhereTableName(p entql.StringP) {
+	f.Where(p.Field(dataaccessauditlog.FieldTableName))
 }
 
-// WhereHasEntries applies a predicate to check if query has an edge entries.
-func (f *DictTypeFilter) WhereHasEntries() {
-	f.Where(entql.HasEdge("entries"))
+// WhereDataID applies the entql string predicate on the data_id field.
+func (f *DataAccessAuditLogFilter) WhereDataID(p entql.StringP) {
+	f.Where(p.Field(dataaccessauditlog.FieldDataID))
 }
 
-// WhereHasEntriesWith applies a predicate to check if query has an edge entries with a given conditions (other predicates).
-func (f *DictTypeFilter) WhereHasEntriesWith(preds ...predicate.DictEntry) {
-	f.Where(entql.HasEdgeWith("entries", sqlgraph.WrapFunc(func(s *sql.Selector) {
-		for _, p := range preds {
-			p(s)
-		}
-	})))
+// WhereAccessType applies the entql string predicate on the access_type field.
+func (f *DataAccessAuditLogFilter) WhereAccessType(p entql.StringP) {
+	f.Where(p.Field(dataaccessauditlog.FieldAccessType))
 }
 
-// addPredicate implements the predicateAdder interface.
-func (_q *FileQuery) addPredicate(pred func(s *sql.Selector)) {
-	_q.predicates = append(_q.predicates, pred)
+// WhereSQLDigest applies the entql string predicate on the sql_digest field.
+func (f *DataAccessAuditLogFilter) WhereSQLDigest(p entql.StringP) {
+	f.Where(p.Field(dataaccessauditlog.FieldSQLDigest))
 }
 
-// Filter returns a Filter implementation to apply filters on the FileQuery builder.
-func (_q *FileQuery) Filter() *FileFilter {
-	return &FileFilter{config: _q.config, predicateAdder: _q}
-}
+// WhereSQLText applies the entql string predicate on the sql_text field.
+func (f *DataAccessAuditLogFilter) WhereSQLText(p entql.StringP) {
+	f.Where(p.Field(dataaccessauditlog.FieldSQLText))
+}
+
+// WhereAffectedRows applies the entql uint32 predicate on the affected_rows field.
+func (f *DataAccessAuditLogFilter) WhereAffectedRows(p entql.Uint32P) {
+	f.Where(p.Field(dataaccessauditlog.FieldAffectedRows))
+}
+
+// WhereLatencyMs applies the entql uint32 predicate on the latency_ms field.
+func (f *DataAccessAuditLogFilter) WhereLatencyMs(p entql.Uint32P) {
+	f.Where(p.Field(dataaccessauditlog.FieldLatencyMs))
+}
+
+// WhereSuccess applies the entql bool predicate on the success field.
+func (f *DataAccessAuditLogFilter) WhereSuccess(p entql.BoolP) {
+	f.Where(p.Field(dataaccessauditlog.FieldSuccess))
+}
+
+// WhereSensitiveLevel applies the entql string predicate on the sensitive_level field.
+func (f *DataAccessAuditLogFilter) WhereSensitiveLevel(p entql.StringP) {
+	f.Where(p.Field(dataaccessauditlog.FieldSensitiveLevel))
+}
+
+// WhereDataMasked applies the entql bool predicate on the data_masked field.
+func (f *DataAccessAuditLogFilter) WhereDataMasked(p entql.BoolP) {
+	f.Where(p.Field(dataaccessauditlog.FieldDataMasked))
+}
+
+// WhereMaskingRules applies the entql string predicate on the masking_rules field.
+func (f *DataAccessAuditLogFilter) WhereMaskingRules(p entql.StringP) {
+	f.Where(p.Field(dataaccessauditlog.FieldMaskingRules))
+}
+
+// WhereBusinessPurpose applies the entql string predicate on the business_purpose field.
+func (f *DataAccessAuditLogFilter) WhereBusinessPurpose(p entql.StringP) {
+	f.Where(p.Field(dataaccessauditlog.FieldBusinessPurpose))
+}
+
+// WhereDataCategory applies the entql string predicate on the data_category field.
+func (f *DataAccessAuditLogFilter) WhereDataCategory(p entql.StringP) {
+	f.Where(p.Field(dataaccessauditlog.FieldDataCategory))
+}
+
+// WhereDbUser applies the entql string predicate on the db_user field.
+func (f *DataAccessAuditLogFilter) WhereDbUser(p entql.StringP) {
+	f.Where(p.Field(dataaccessauditlog.FieldDbUser))
+}
+
+// WhereLogHash applies the entql string predicate on the log_hash field.
+func (f *DataAccessAuditLogFilter) WhereLogHash(p entql.StringP) {
+	f.Where(p.Field(dataaccessauditlog.FieldLogHash))
+}
+
+// WhereSignature applies the entql []byte predicate on the signature field.
+func (f *DataAccessAuditLogFilter) WhereSignature(p entql.BytesP) {
+	f.Where(p.Field(dataaccessauditlog.FieldSignature))
+}
 
 // addPredicate implements the predicateAdder interface.
-func (m *FileMutation) addPredicate(pred func(s *sql.Selector)) {
+func (_q *DictEntryQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the DictEntryQuery builder.
+func (_q *DictEntryQuery) Filter() *DictEntryFilter {
+	return &DictEntryFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *DictEntryMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the FileMutation builder.
-func (m *FileMutation) Filter() *FileFilter {
-	return &FileFilter{config: m.config, predicateAdder: m}
+// Filter returns an entql.Where implementation to apply filters on the DictEntryMutation builder.
+func (m *DictEntryMutation) Filter() *DictEntryFilter {
+	return &DictEntryFilter{config: m.config, predicateAdder: m}
 }
 
-// FileFilter provides a generic filtering capability at runtime for FileQuery.
-type FileFilter struct {
+// DictEntryFilter provides a generic filtering capability at runtime for DictEntryQuery.
+type DictEntryFilter struct {
 	predicateAdder
 	config
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *FileFilter) Where(p entql.P) {
+func (f *DictEntryFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[6].Type, p, s); err != nil {
 			s.AddError(err)
@@ -2115,133 +2530,121 @@ func (f *FileFilter) Where(p entql.P) {
 }
 
 // WhereID applies the entql uint32 predicate on the id field.
-func (f *FileFilter) WhereID(p entql.Uint32P) {
-	f.Where(p.Field(file.FieldID))
+func (f *DictEntryFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(dictentry.FieldID))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *FileFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(file.FieldCreatedAt))
+func (f *DictEntryFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(dictentry.FieldCreatedAt))
 }
 
 // WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
-func (f *FileFilter) WhereUpdatedAt(p entql.TimeP) {
-	f.Where(p.Field(file.FieldUpdatedAt))
+func (f *DictEntryFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(dictentry.FieldUpdatedAt))
 }
 
 // WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
-func (f *FileFilter) WhereDeletedAt(p entql.TimeP) {
-	f.Where(p.Field(file.FieldDeletedAt))
+func (f *DictEntryFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(dictentry.FieldDeletedAt))
 }
 
 // WhereCreatedBy applies the entql uint32 predicate on the created_by field.
-func (f *FileFilter) WhereCreatedBy(p entql.Uint32P) {
-	f.Where(p.Field(file.FieldCreatedBy))
+func (f *DictEntryFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(dictentry.FieldCreatedBy))
 }
 
 // WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
-func (f *FileFilter) WhereUpdatedBy(p entql.Uint32P) {
-	f.Where(p.Field(file.FieldUpdatedBy))
+func (f *DictEntryFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(dictentry.FieldUpdatedBy))
 }
 
 // WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
-func (f *FileFilter) WhereDeletedBy(p entql.Uint32P) {
-	f.Where(p.Field(file.FieldDeletedBy))
-}
-
-// WhereRemark applies the entql string predicate on the remark field.
-func (f *FileFilter) WhereRemark(p entql.StringP) {
-	f.Where(p.Field(file.FieldRemark))
-}
-
-// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
-func (f *FileFilter) WhereTenantID(p entql.Uint32P) {
-	f.Where(p.Field(file.FieldTenantID))
-}
-
-// WhereProvider applies the entql string predicate on the provider field.
-func (f *FileFilter) WhereProvider(p entql.StringP) {
-	f.Where(p.Field(file.FieldProvider))
-}
-
-// WhereBucketName applies the entql string predicate on the bucket_name field.
-func (f *FileFilter) WhereBucketName(p entql.StringP) {
-	f.Where(p.Field(file.FieldBucketName))
+func (f *DictEntryFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(dictentry.FieldDeletedBy))
 }
 
-// WhereFileDirectory applies the entql string predicate on the file_directory field.
-func (f *FileFilter) WhereFileDirectory(p entql.StringP) {
-	f.Where(p.Field(file.FieldFileDirectory))
+// WhereSortOrder applies the entql uint32 predicate on the sort_order field.
+func (f *DictEntryFilter) WhereSortOrder(p entql.Uint32P) {
+	f.Where(p.Field(dictentry.FieldSortOrder))
 }
 
-// WhereFileGUID applies the entql string predicate on the file_guid field.
-func (f *FileFilter) WhereFileGUID(p entql.StringP) {
-	f.Where(p.Field(file.FieldFileGUID))
+// WhereIsEnabled applies the entql bool predicate on the is_enabled field.
+func (f *DictEntryFilter) WhereIsEnabled(p entql.BoolP) {
+	f.Where(p.Field(dictentry.FieldIsEnabled))
 }
 
-// WhereSaveFileName applies the entql string predicate on the save_file_name field.
-func (f *FileFilter) WhereSaveFileName(p entql.StringP) {
-	f.Where(p.Field(file.FieldSaveFileName))
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *DictEntryFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(dictentry.FieldTenantID))
 }
 
-// WhereFileName applies the entql string predicate on the file_name field.
-func (f *FileFilter) WhereFileName(p entql.StringP) {
-	f.Where(p.Field(file.FieldFileName))
+// WhereEntryValue applies the entql string predicate on the entry_value field.
+func (f *DictEntryFilter) WhereEntryValue(p entql.StringP) {
+	f.Where(p.Field(dictentry.FieldEntryValue))
 }
 
-// WhereExtension applies the entql string predicate on the extension field.
-func (f *FileFilter) WhereExtension(p entql.StringP) {
-	f.Where(p.Field(file.FieldExtension))
+// WhereNumericValue applies the entql int32 predicate on the numeric_value field.
+func (f *DictEntryFilter) WhereNumericValue(p entql.Int32P) {
+	f.Where(p.Field(dictentry.FieldNumericValue))
 }
 
-// WhereSize applies the entql uint64 predicate on the size field.
-func (f *FileFilter) WhereSize(p entql.Uint64P) {
-	f.Where(p.Field(file.FieldSize))
+// WhereHasDictType applies a predicate to check if query has an edge dict_type.
+func (f *DictEntryFilter) WhereHasDictType() {
+	f.Where(entql.HasEdge("dict_type"))
 }
 
-// WhereSizeFormat applies the entql string predicate on the size_format field.
-func (f *FileFilter) WhereSizeFormat(p entql.StringP) {
-	f.Where(p.Field(file.FieldSizeFormat))
+// WhereHasDictTypeWith applies a predicate to check if query has an edge dict_type with a given conditions (other predicates).
+func (f *DictEntryFilter) WhereHasDictTypeWith(preds ...predicate.DictType) {
+	f.Where(entql.HasEdgeWith("dict_type", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
 }
 
-// WhereLinkURL applies the entql string predicate on the link_url field.
-func (f *FileFilter) WhereLinkURL(p entql.StringP) {
-	f.Where(p.Field(file.FieldLinkURL))
+// WhereHasI18ns applies a predicate to check if query has an edge i18ns.
+func (f *DictEntryFilter) WhereHasI18ns() {
+	f.Where(entql.HasEdge("i18ns"))
 }
 
-// WhereContentHash applies the entql string predicate on the content_hash field.
-func (f *FileFilter) WhereContentHash(p entql.StringP) {
-	f.Where(p.Field(file.FieldContentHash))
+// WhereHasI18nsWith applies a predicate to check if query has an edge i18ns with a given conditions (other predicates).
+func (f *DictEntryFilter) WhereHasI18nsWith(preds ...predicate.DictEntryI18n) {
+	f.Where(entql.HasEdgeWith("i18ns", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
 }
 
 // addPredicate implements the predicateAdder interface.
-func (_q *InternalMessageQuery) addPredicate(pred func(s *sql.Selector)) {
+func (_q *DictEntryI18nQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the InternalMessageQuery builder.
-func (_q *InternalMessageQuery) Filter() *InternalMessageFilter {
-	return &InternalMessageFilter{config: _q.config, predicateAdder: _q}
+// Filter returns a Filter implementation to apply filters on the DictEntryI18nQuery builder.
+func (_q *DictEntryI18nQuery) Filter() *DictEntryI18nFilter {
+	return &DictEntryI18nFilter{config: _q.config, predicateAdder: _q}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *InternalMessageMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *DictEntryI18nMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the InternalMessageMutation builder.
-func (m *InternalMessageMutation) Filter() *InternalMessageFilter {
-	return &InternalMessageFilter{config: m.config, predicateAdder: m}
+// Filter returns an entql.Where implementation to apply filters on the DictEntryI18nMutation builder.
+func (m *DictEntryI18nMutation) Filter() *DictEntryI18nFilter {
+	return &DictEntryI18nFilter{config: m.config, predicateAdder: m}
 }
 
-// InternalMessageFilter provides a generic filtering capability at runtime for InternalMessageQuery.
-type InternalMessageFilter struct {
+// DictEntryI18nFilter provides a generic filtering capability at runtime for DictEntryI18nQuery.
+type DictEntryI18nFilter struct {
 	predicateAdder
 	config
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *InternalMessageFilter) Where(p entql.P) {
+func (f *DictEntryI18nFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[7].Type, p, s); err != nil {
 			s.AddError(err)
@@ -2250,103 +2653,107 @@ func (f *InternalMessageFilter) Where(p entql.P) {
 }
 
 // WhereID applies the entql uint32 predicate on the id field.
-func (f *InternalMessageFilter) WhereID(p entql.Uint32P) {
-	f.Where(p.Field(internalmessage.FieldID))
+func (f *DictEntryI18nFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(dictentryi18n.FieldID))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *InternalMessageFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(internalmessage.FieldCreatedAt))
+func (f *DictEntryI18nFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(dictentryi18n.FieldCreatedAt))
 }
 
 // WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
-func (f *InternalMessageFilter) WhereUpdatedAt(p entql.TimeP) {
-	f.Where(p.Field(internalmessage.FieldUpdatedAt))
+func (f *DictEntryI18nFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(dictentryi18n.FieldUpdatedAt))
 }
 
 // WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
-func (f *InternalMessageFilter) WhereDeletedAt(p entql.TimeP) {
-	f.Where(p.Field(internalmessage.FieldDeletedAt))
+func (f *DictEntryI18nFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(dictentryi18n.FieldDeletedAt))
 }
 
 // WhereCreatedBy applies the entql uint32 predicate on the created_by field.
-func (f *InternalMessageFilter) WhereCreatedBy(p entql.Uint32P) {
-	f.Where(p.Field(internalmessage.FieldCreatedBy))
+func (f *DictEntryI18nFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(dictentryi18n.FieldCreatedBy))
 }
 
 // WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
-func (f *InternalMessageFilter) WhereUpdatedBy(p entql.Uint32P) {
-	f.Where(p.Field(internalmessage.FieldUpdatedBy))
+func (f *DictEntryI18nFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(dictentryi18n.FieldUpdatedBy))
 }
 
 // WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
-func (f *InternalMessageFilter) WhereDeletedBy(p entql.Uint32P) {
-	f.Where(p.Field(internalmessage.FieldDeletedBy))
+func (f *DictEntryI18nFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(dictentryi18n.FieldDeletedBy))
 }
 
-// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
-func (f *InternalMessageFilter) WhereTenantID(p entql.Uint32P) {
-	f.Where(p.Field(internalmessage.FieldTenantID))
+// WhereDescription applies the entql string predicate on the description field.
+func (f *DictEntryI18nFilter) WhereDescription(p entql.StringP) {
+	f.Where(p.Field(dictentryi18n.FieldDescription))
 }
 
-// WhereTitle applies the entql string predicate on the title field.
-func (f *InternalMessageFilter) WhereTitle(p entql.StringP) {
-	f.Where(p.Field(internalmessage.FieldTitle))
+// WhereSortOrder applies the entql uint32 predicate on the sort_order field.
+func (f *DictEntryI18nFilter) WhereSortOrder(p entql.Uint32P) {
+	f.Where(p.Field(dictentryi18n.FieldSortOrder))
 }
 
-// WhereContent applies the entql string predicate on the content field.
-func (f *InternalMessageFilter) WhereContent(p entql.StringP) {
-	f.Where(p.Field(internalmessage.FieldContent))
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *DictEntryI18nFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(dictentryi18n.FieldTenantID))
 }
 
-// WhereSenderID applies the entql uint32 predicate on the sender_id field.
-func (f *InternalMessageFilter) WhereSenderID(p entql.Uint32P) {
-	f.Where(p.Field(internalmessage.FieldSenderID))
+// WhereLanguageCode applies the entql string predicate on the language_code field.
+func (f *DictEntryI18nFilter) WhereLanguageCode(p entql.StringP) {
+	f.Where(p.Field(dictentryi18n.FieldLanguageCode))
 }
 
-// WhereCategoryID applies the entql uint32 predicate on the category_id field.
-func (f *InternalMessageFilter) WhereCategoryID(p entql.Uint32P) {
-	f.Where(p.Field(internalmessage.FieldCategoryID))
+// WhereEntryLabel applies the entql string predicate on the entry_label field.
+func (f *DictEntryI18nFilter) WhereEntryLabel(p entql.StringP) {
+	f.Where(p.Field(dictentryi18n.FieldEntryLabel))
 }
 
-// WhereStatus applies the entql string predicate on the status field.
-func (f *InternalMessageFilter) WhereStatus(p entql.StringP) {
-	f.Where(p.Field(internalmessage.FieldStatus))
+// WhereHasDictEntry applies a predicate to check if query has an edge dict_entry.
+func (f *DictEntryI18nFilter) WhereHasDictEntry() {
+	f.Where(entql.HasEdge("dict_entry"))
 }
 
-// WhereType applies the entql string predicate on the type field.
-func (f *InternalMessageFilter) WhereType(p entql.StringP) {
-	f.Where(p.Field(internalmessage.FieldType))
+// WhereHasDictEntryWith applies a predicate to check if query has an edge dict_entry with a given conditions (other predicates).
+func (f *DictEntryI18nFilter) WhereHasDictEntryWith(preds ...predicate.DictEntry) {
+	f.Where(entql.HasEdgeWith("dict_entry", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
 }
 
 // addPredicate implements the predicateAdder interface.
-func (_q *InternalMessageCategoryQuery) addPredicate(pred func(s *sql.Selector)) {
+func (_q *DictTypeQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the InternalMessageCategoryQuery builder.
-func (_q *InternalMessageCategoryQuery) Filter() *InternalMessageCategoryFilter {
-	return &InternalMessageCategoryFilter{config: _q.config, predicateAdder: _q}
+// Filter returns a Filter implementation to apply filters on the DictTypeQuery builder.
+func (_q *DictTypeQuery) Filter() *DictTypeFilter {
+	return &DictTypeFilter{config: _q.config, predicateAdder: _q}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *InternalMessageCategoryMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *DictTypeMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the InternalMessageCategoryMutation builder.
-func (m *InternalMessageCategoryMutation) Filter() *InternalMessageCategoryFilter {
-	return &InternalMessageCategoryFilter{config: m.config, predicateAdder: m}
+// Filter returns an entql.Where implementation to apply filters on the DictTypeMutation builder.
+func (m *DictTypeMutation) Filter() *DictTypeFilter {
+	return &DictTypeFilter{config: m.config, predicateAdder: m}
 }
 
-// InternalMessageCategoryFilter provides a generic filtering capability at runtime for InternalMessageCategoryQuery.
-type InternalMessageCategoryFilter struct {
+// DictTypeFilter provides a generic filtering capability at runtime for DictTypeQuery.
+type DictTypeFilter struct {
 	predicateAdder
 	config
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *InternalMessageCategoryFilter) Where(p entql.P) {
+func (f *DictTypeFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[8].Type, p, s); err != nil {
 			s.AddError(err)
@@ -2355,103 +2762,107 @@ func (f *InternalMessageCategoryFilter) Where(p entql.P) {
 }
 
 // WhereID applies the entql uint32 predicate on the id field.
-func (f *InternalMessageCategoryFilter) WhereID(p entql.Uint32P) {
-	f.Where(p.Field(internalmessagecategory.FieldID))
+func (f *DictTypeFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(dicttype.FieldID))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *InternalMessageCategoryFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(internalmessagecategory.FieldCreatedAt))
+func (f *DictTypeFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(dicttype.FieldCreatedAt))
 }
 
 // WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
-func (f *InternalMessageCategoryFilter) WhereUpdatedAt(p entql.TimeP) {
-	f.Where(p.Field(internalmessagecategory.FieldUpdatedAt))
+func (f *DictTypeFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(dicttype.FieldUpdatedAt))
 }
 
 // WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
-func (f *InternalMessageCategoryFilter) WhereDeletedAt(p entql.TimeP) {
-	f.Where(p.Field(internalmessagecategory.FieldDeletedAt))
+func (f *DictTypeFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(dicttype.FieldDeletedAt))
 }
 
 // WhereCreatedBy applies the entql uint32 predicate on the created_by field.
-func (f *InternalMessageCategoryFilter) WhereCreatedBy(p entql.Uint32P) {
-	f.Where(p.Field(internalmessagecategory.FieldCreatedBy))
+func (f *DictTypeFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(dicttype.FieldCreatedBy))
 }
 
 // WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
-func (f *InternalMessageCategoryFilter) WhereUpdatedBy(p entql.Uint32P) {
-	f.Where(p.Field(internalmessagecategory.FieldUpdatedBy))
+func (f *DictTypeFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(dicttype.FieldUpdatedBy))
 }
 
 // WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
-func (f *InternalMessageCategoryFilter) WhereDeletedBy(p entql.Uint32P) {
-	f.Where(p.Field(internalmessagecategory.FieldDeletedBy))
+func (f *DictTypeFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(dicttype.FieldDeletedBy))
 }
 
 // WhereIsEnabled applies the entql bool predicate on the is_enabled field.
-func (f *InternalMessageCategoryFilter) WhereIsEnabled(p entql.BoolP) {
-	f.Where(p.Field(internalmessagecategory.FieldIsEnabled))
+func (f *DictTypeFilter) WhereIsEnabled(p entql.BoolP) {
+	f.Where(p.Field(dicttype.FieldIsEnabled))
 }
 
 // WhereSortOrder applies the entql uint32 predicate on the sort_order field.
-func (f *InternalMessageCategoryFilter) WhereSortOrder(p entql.Uint32P) {
-	f.Where(p.Field(internalmessagecategory.FieldSortOrder))
+func (f *DictTypeFilter) WhereSortOrder(p entql.Uint32P) {
+	f.Where(p.Field(dicttype.FieldSortOrder))
 }
 
-// WhereRemark applies the entql string predicate on the remark field.
-func (f *InternalMessageCategoryFilter) WhereRemark(p entql.StringP) {
-	f.Where(p.Field(internalmessagecategory.FieldRemark))
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *DictTypeFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(dicttype.FieldTenantID))
 }
 
-// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
-func (f *InternalMessageCategoryFilter) WhereTenantID(p entql.Uint32P) {
-	f.Where(p.Field(internalmessagecategory.FieldTenantID))
+// WhereTypeCode applies the entql string predicate on the type_code field.
+func (f *DictTypeFilter) WhereTypeCode(p entql.StringP) {
+	f.Where(p.Field(dicttype.FieldTypeCode))
 }
 
-// WhereName applies the entql string predicate on the name field.
-func (f *InternalMessageCategoryFilter) WhereName(p entql.StringP) {
-	f.Where(p.Field(internalmessagecategory.FieldName))
+// WhereTypeName applies the entql string predicate on the type_name field.
+func (f *DictTypeFilter) WhereTypeName(p entql.StringP) {
+	f.Where(p.Field(dicttype.FieldTypeName))
 }
 
-// WhereCode applies the entql string predicate on the code field.
-func (f *InternalMessageCategoryFilter) WhereCode(p entql.StringP) {
-	f.Where(p.Field(internalmessagecategory.FieldCode))
+// WhereHasEntries applies a predicate to check if query has an edge entries.
+func (f *DictTypeFilter) WhereHasEntries() {
+	f.Where(entql.HasEdge("entries"))
 }
 
-// WhereIconURL applies the entql string predicate on the icon_url field.
-func (f *InternalMessageCategoryFilter) WhereIconURL(p entql.StringP) {
-	f.Where(p.Field(internalmessagecategory.FieldIconURL))
+// WhereHasEntriesWith applies a predicate to check if query has an edge entries with a given conditions (other predicates).
+func (f *DictTypeFilter) WhereHasEntriesWith(preds ...predicate.DictEntry) {
+	f.Where(entql.HasEdgeWith("entries", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
 }
 
 // addPredicate implements the predicateAdder interface.
-func (_q *InternalMessageRecipientQuery) addPredicate(pred func(s *sql.Selector)) {
+func (_q *FileQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the InternalMessageRecipientQuery builder.
-func (_q *InternalMessageRecipientQuery) Filter() *InternalMessageRecipientFilter {
-	return &InternalMessageRecipientFilter{config: _q.config, predicateAdder: _q}
+// Filter returns a Filter implementation to apply filters on the FileQuery builder.
+func (_q *FileQuery) Filter() *FileFilter {
+	return &FileFilter{config: _q.config, predicateAdder: _q}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *InternalMessageRecipientMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *FileMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the InternalMessageRecipientMutation builder.
-func (m *InternalMessageRecipientMutation) Filter() *InternalMessageRecipientFilter {
-	return &InternalMessageRecipientFilter{config: m.config, predicateAdder: m}
+// Filter returns an entql.Where implementation to apply filters on the FileMutation builder.
+func (m *FileMutation) Filter() *FileFilter {
+	return &FileFilter{config: m.config, predicateAdder: m}
 }
 
-// InternalMessageRecipientFilter provides a generic filtering capability at runtime for InternalMessageRecipientQuery.
-type InternalMessageRecipientFilter struct {
+// FileFilter provides a generic filtering capability at runtime for FileQuery.
+type FileFilter struct {
 	predicateAdder
 	config
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *InternalMessageRecipientFilter) Where(p entql.P) {
+func (f *FileFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[9].Type, p, s); err != nil {
 			s.AddError(err)
@@ -2460,558 +2871,608 @@ func (f *InternalMessageRecipientFilter) Where(p entql.P) {
 }
 
 // WhereID applies the entql uint32 predicate on the id field.
-func (f *InternalMessageRecipientFilter) WhereID(p entql.Uint32P) {
-	f.Where(p.Field(internalmessagerecipient.FieldID))
+func (f *FileFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(file.FieldID))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *InternalMessageRecipientFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(internalmessagerecipient.FieldCreatedAt))
+func (f *FileFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(file.FieldCreatedAt))
 }
 
 // WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
-func (f *InternalMessageRecipientFilter) WhereUpdatedAt(p entql.TimeP) {
-	f.Where(p.Field(internalmessagerecipient.FieldUpdatedAt))
+func (f *FileFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(file.FieldUpdatedAt))
 }
 
 // WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
-func (f *InternalMessageRecipientFilter) WhereDeletedAt(p entql.TimeP) {
-	f.Where(p.Field(internalmessagerecipient.FieldDeletedAt))
+func (f *FileFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(file.FieldDeletedAt))
 }
 
-// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
-func (f *InternalMessageRecipientFilter) WhereTenantID(p entql.Uint32P) {
-	f.Where(p.Field(internalmessagerecipient.FieldTenantID))
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *FileFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(file.FieldCreatedBy))
 }
 
-// WhereMessageID applies the entql uint32 predicate on the message_id field.
-func (f *InternalMessageRecipientFilter) WhereMessageID(p entql.Uint32P) {
-	f.Where(p.Field(internalmessagerecipient.FieldMessageID))
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *FileFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(file.FieldUpdatedBy))
 }
 
-// WhereRecipientUserID applies the entql uint32 predicate on the recipient_user_id field.
-func (f *InternalMessageRecipientFilter) WhereRecipientUserID(p entql.Uint32P) {
-	f.Where(p.Field(internalmessagerecipient.FieldRecipientUserID))
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *FileFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(file.FieldDeletedBy))
 }
 
-// WhereStatus applies the entql string predicate on the status field.
-func (f *InternalMessageRecipientFilter) WhereStatus(p entql.StringP) {
-	f.Where(p.Field(internalmessagerecipient.FieldStatus))
+// WhereRemark applies the entql string predicate on the remark field.
+func (f *FileFilter) WhereRemark(p entql.StringP) {
+	f.Where(p.Field(file.FieldRemark))
 }
 
-// WhereReceivedAt applies the entql time.Time predicate on the received_at field.
-func (f *InternalMessageRecipientFilter) WhereReceivedAt(p entql.TimeP) {
-	f.Where(p.Field(internalmessagerecipient.FieldReceivedAt))
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *FileFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(file.FieldTenantID))
 }
 
-// WhereReadAt applies the entql time.Time predicate on the read_at field.
-func (f *InternalMessageRecipientFilter) WhereReadAt(p entql.TimeP) {
-	f.Where(p.Field(internalmessagerecipient.FieldReadAt))
+// WhereProvider applies the entql string predicate on the provider field.
+func (f *FileFilter) WhereProvider(p entql.StringP) {
+	f.Where(p.Field(file.FieldProvider))
 }
 
-// addPredicate implements the predicateAdder interface.
-func (_q *LanguageQuery) addPredicate(pred func(s *sql.Selector)) {
-	_q.predicates = append(_q.predicates, pred)
+// WhereBucketName applies the entql string predicate on the bucket_name field.
+func (f *FileFilter) WhereBucketName(p entql.StringP) {
+	f.Where(p.Field(file.FieldBucketName))
 }
 
-// Filter returns a Filter implementation to apply filters on the LanguageQuery builder.
-func (_q *LanguageQuery) Filter() *LanguageFilter {
-	return &LanguageFilter{config: _q.config, predicateAdder: _q}
+// WhereFileDirectory applies the entql string predicate on the file_directory field.
+func (f *FileFilter) WhereFileDirectory(p entql.StringP) {
+	f.Where(p.Field(file.FieldFileDirectory))
 }
 
-// addPredicate implements the predicateAdder interface.
-func (m *LanguageMutation) addPredicate(pred func(s *sql.Selector)) {
-	m.predicates = append(m.predicates, pred)
+// WhereFileGUID applies the entql string predicate on the file_guid field.
+func (f *FileFilter) WhereFileGUID(p entql.StringP) {
+	f.Where(p.Field(file.FieldFileGUID))
 }
 
-// Filter returns an entql.Where implementation to apply filters on the LanguageMutation builder.
-func (m *LanguageMutation) Filter() *LanguageFilter {
-	return &LanguageFilter{config: m.config, predicateAdder: m}
+// WhereSaveFileName applies the entql string predicate on the save_file_name field.
+func (f *FileFilter) WhereSaveFileName(p entql.StringP) {
+	f.Where(p.Field(file.FieldSaveFileName))
 }
 
-// LanguageFilter provides a generic filtering capability at runtime for LanguageQuery.
-type LanguageFilter struct {
-	predicateAdder
-	config
+// WhereFileName applies the entql string predicate on the file_name field.
+func (f *FileFilter) WhereFileName(p entql.StringP) {
+	f.Where(p.Field(file.FieldFileName))
 }
 
-// Where applies the entql predicate on the query filter.
-func (f *LanguageFilter) Where(p entql.P) {
-	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[10].Type, p, s); err != nil {
-			s.AddError(err)
-		}
-	})
+// WhereExtension applies the entql string predicate on the extension field.
+func (f *FileFilter) WhereExtension(p entql.StringP) {
+	f.Where(p.Field(file.FieldExtension))
 }
 
-// WhereID applies the entql uint32 predicate on the id field.
-func (f *LanguageFilter) WhereID(p entql.Uint32P) {
-	f.Where(p.Field(language.FieldID))
+// WhereSize applies the entql uint64 predicate on the size field.
+func (f *FileFilter) WhereSize(p entql.Uint64P) {
+	f.Where(p.Field(file.FieldSize))
 }
 
-// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *LanguageFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(language.FieldCreatedAt))
+// WhereSizeFormat applies the entql string predicate on the size_format field.
+func (f *FileFilter) WhereSizeFormat(p entql.StringP) {
+	f.Where(p.Field(file.FieldSizeFormat))
 }
 
-// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
-func (f *LanguageFilter) WhereUpdatedAt(p entql.TimeP) {
-	f.Where(p.Field(language.FieldUpdatedAt))
-}
+// WhereLinkURL applies the entql string predicate on the link_url field.
+func (f *FileFilter) WhereLinkURL(p entql.StringP) {
+	f.Where(p.Field(file.FieldLinkURL))
+}
 
-// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
-func (f *LanguageFilter) WhereDeletedAt(p entql.TimeP) {
-	f.Where(p.Field(language.FieldDeletedAt))
+// WhereContentHash applies the entql string predicate on the content_hash field.
+func (f *FileFilter) WhereContentHash(p entql.StringP) {
+	f.Where(p.Field(file.FieldContentHash))
 }
 
-// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
-func (f *LanguageFilter) WhereCreatedBy(p entql.Uint32P) {
-	f.Where(p.Field(language.FieldCreatedBy))
+// WhereStorageObjectID applies the entql uint32 predicate on the storage_object_id field.
+func (f *FileFilter) WhereStorageObjectID(p entql.Uint32P) {
+	f.Where(p.Field(file.FieldStorageObjectID))
 }
 
-// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
-func (f *LanguageFilter) WhereUpdatedBy(p entql.Uint32P) {
-	f.Where(p.Field(language.FieldUpdatedBy))
+// WhereObjectName applies the entql string predicate on the object_name field.
+func (f *FileFilter) WhereObjectName(p entql.StringP) {
+	f.Where(p.Field(file.FieldObjectName))
 }
 
-// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
-func (f *LanguageFilter) WhereDeletedBy(p entql.Uint32P) {
-	f.Where(p.Field(language.FieldDeletedBy))
+// WhereScanStatus applies the entql string predicate on the scan_status field.
+func (f *FileFilter) WhereScanStatus(p entql.StringP) {
+	f.Where(p.Field(file.FieldScanStatus))
 }
 
-// WhereSortOrder applies the entql uint32 predicate on the sort_order field.
-func (f *LanguageFilter) WhereSortOrder(p entql.Uint32P) {
-	f.Where(p.Field(language.FieldSortOrder))
+// WhereScanSignature applies the entql string predicate on the scan_signature field.
+func (f *FileFilter) WhereScanSignature(p entql.StringP) {
+	f.Where(p.Field(file.FieldScanSignature))
 }
 
-// WhereIsEnabled applies the entql bool predicate on the is_enabled field.
-func (f *LanguageFilter) WhereIsEnabled(p entql.BoolP) {
-	f.Where(p.Field(language.FieldIsEnabled))
+// WhereScannedAt applies the entql time.Time predicate on the scanned_at field.
+func (f *FileFilter) WhereScannedAt(p entql.TimeP) {
+	f.Where(p.Field(file.FieldScannedAt))
 }
 
-// WhereLanguageCode applies the entql string predicate on the language_code field.
-func (f *LanguageFilter) WhereLanguageCode(p entql.StringP) {
-	f.Where(p.Field(language.FieldLanguageCode))
+// WhereVersion applies the entql uint32 predicate on the version field.
+func (f *FileFilter) WhereVersion(p entql.Uint32P) {
+	f.Where(p.Field(file.FieldVersion))
 }
 
-// WhereLanguageName applies the entql string predicate on the language_name field.
-func (f *LanguageFilter) WhereLanguageName(p entql.StringP) {
-	f.Where(p.Field(language.FieldLanguageName))
+// WhereIsLatest applies the entql bool predicate on the is_latest field.
+func (f *FileFilter) WhereIsLatest(p entql.BoolP) {
+	f.Where(p.Field(file.FieldIsLatest))
 }
 
-// WhereNativeName applies the entql string predicate on the native_name field.
-func (f *LanguageFilter) WhereNativeName(p entql.StringP) {
-	f.Where(p.Field(language.FieldNativeName))
+// WhereVersionGroup applies the entql string predicate on the version_group field.
+func (f *FileFilter) WhereVersionGroup(p entql.StringP) {
+	f.Where(p.Field(file.FieldVersionGroup))
 }
 
-// WhereIsDefault applies the entql bool predicate on the is_default field.
-func (f *LanguageFilter) WhereIsDefault(p entql.BoolP) {
-	f.Where(p.Field(language.FieldIsDefault))
+// WhereLatestKey applies the entql string predicate on the latest_key field.
+func (f *FileFilter) WhereLatestKey(p entql.StringP) {
+	f.Where(p.Field(file.FieldLatestKey))
 }
 
 // addPredicate implements the predicateAdder interface.
-func (_q *LoginAuditLogQuery) addPredicate(pred func(s *sql.Selector)) {
+func (_q *FileShareLinkQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the LoginAuditLogQuery builder.
-func (_q *LoginAuditLogQuery) Filter() *LoginAuditLogFilter {
-	return &LoginAuditLogFilter{config: _q.config, predicateAdder: _q}
+// Filter returns a Filter implementation to apply filters on the FileShareLinkQuery builder.
+func (_q *FileShareLinkQuery) Filter() *FileShareLinkFilter {
+	return &FileShareLinkFilter{config: _q.config, predicateAdder: _q}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *LoginAuditLogMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *FileShareLinkMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the LoginAuditLogMutation builder.
-func (m *LoginAuditLogMutation) Filter() *LoginAuditLogFilter {
-	return &LoginAuditLogFilter{config: m.config, predicateAdder: m}
+// Filter returns an entql.Where implementation to apply filters on the FileShareLinkMutation builder.
+func (m *FileShareLinkMutation) Filter() *FileShareLinkFilter {
+	return &FileShareLinkFilter{config: m.config, predicateAdder: m}
 }
 
-// LoginAuditLogFilter provides a generic filtering capability at runtime for LoginAuditLogQuery.
-type LoginAuditLogFilter struct {
+// FileShareLinkFilter provides a generic filtering capability at runtime for FileShareLinkQuery.
+type FileShareLinkFilter struct {
 	predicateAdder
 	config
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *LoginAuditLogFilter) Where(p entql.P) {
+func (f *FileShareLinkFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[11].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[10].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
 }
 
 // WhereID applies the entql uint32 predicate on the id field.
-func (f *LoginAuditLogFilter) WhereID(p entql.Uint32P) {
-	f.Where(p.Field(loginauditlog.FieldID))
+func (f *FileShareLinkFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(filesharelink.FieldID))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *LoginAuditLogFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(loginauditlog.FieldCreatedAt))
-}
-
-// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
-func (f *LoginAuditLogFilter) WhereTenantID(p entql.Uint32P) {
-	f.Where(p.Field(loginauditlog.FieldTenantID))
+func (f *FileShareLinkFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(filesharelink.FieldCreatedAt))
 }
 
-// WhereUserID applies the entql uint32 predicate on the user_id field.
-func (f *LoginAuditLogFilter) WhereUserID(p entql.Uint32P) {
-	f.Where(p.Field(loginauditlog.FieldUserID))
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *FileShareLinkFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(filesharelink.FieldUpdatedAt))
 }
 
-// WhereUsername applies the entql string predicate on the username field.
-func (f *LoginAuditLogFilter) WhereUsername(p entql.StringP) {
-	f.Where(p.Field(loginauditlog.FieldUsername))
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *FileShareLinkFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(filesharelink.FieldDeletedAt))
 }
 
-// WhereIPAddress applies the entql string predicate on the ip_address field.
-func (f *LoginAuditLogFilter) WhereIPAddress(p entql.StringP) {
-	f.Where(p.Field(loginauditlog.FieldIPAddress))
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *FileShareLinkFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(filesharelink.FieldCreatedBy))
 }
 
-// WhereGeoLocation applies the entql json.RawMessage predicate on the geo_location field.
-func (f *LoginAuditLogFilter) WhereGeoLocation(p entql.BytesP) {
-	f.Where(p.Field(loginauditlog.FieldGeoLocation))
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *FileShareLinkFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(filesharelink.FieldUpdatedBy))
 }
 
-// WhereSessionID applies the entql string predicate on the session_id field.
-func (f *LoginAuditLogFilter) WhereSessionID(p entql.StringP) {
-	f.Where(p.Field(loginauditlog.FieldSessionID))
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *FileShareLinkFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(filesharelink.FieldDeletedBy))
 }
 
-// WhereDeviceInfo applies the entql json.RawMessage predicate on the device_info field.
-func (f *LoginAuditLogFilter) WhereDeviceInfo(p entql.BytesP) {
-	f.Where(p.Field(loginauditlog.FieldDeviceInfo))
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *FileShareLinkFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(filesharelink.FieldTenantID))
 }
 
-// WhereRequestID applies the entql string predicate on the request_id field.
-func (f *LoginAuditLogFilter) WhereRequestID(p entql.StringP) {
-	f.Where(p.Field(loginauditlog.FieldRequestID))
+// WhereFileID applies the entql uint32 predicate on the file_id field.
+func (f *FileShareLinkFilter) WhereFileID(p entql.Uint32P) {
+	f.Where(p.Field(filesharelink.FieldFileID))
 }
 
-// WhereTraceID applies the entql string predicate on the trace_id field.
-func (f *LoginAuditLogFilter) WhereTraceID(p entql.StringP) {
-	f.Where(p.Field(loginauditlog.FieldTraceID))
+// WhereToken applies the entql string predicate on the token field.
+func (f *FileShareLinkFilter) WhereToken(p entql.StringP) {
+	f.Where(p.Field(filesharelink.FieldToken))
 }
 
-// WhereActionType applies the entql string predicate on the action_type field.
-func (f *LoginAuditLogFilter) WhereActionType(p entql.StringP) {
-	f.Where(p.Field(loginauditlog.FieldActionType))
+// WherePasswordHash applies the entql string predicate on the password_hash field.
+func (f *FileShareLinkFilter) WherePasswordHash(p entql.StringP) {
+	f.Where(p.Field(filesharelink.FieldPasswordHash))
 }
 
-// WhereStatus applies the entql string predicate on the status field.
-func (f *LoginAuditLogFilter) WhereStatus(p entql.StringP) {
-	f.Where(p.Field(loginauditlog.FieldStatus))
+// WhereExpiresAt applies the entql time.Time predicate on the expires_at field.
+func (f *FileShareLinkFilter) WhereExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(filesharelink.FieldExpiresAt))
 }
 
-// WhereLoginMethod applies the entql string predicate on the login_method field.
-func (f *LoginAuditLogFilter) WhereLoginMethod(p entql.StringP) {
-	f.Where(p.Field(loginauditlog.FieldLoginMethod))
+// WhereMaxDownloads applies the entql uint32 predicate on the max_downloads field.
+func (f *FileShareLinkFilter) WhereMaxDownloads(p entql.Uint32P) {
+	f.Where(p.Field(filesharelink.FieldMaxDownloads))
 }
 
-// WhereFailureReason applies the entql string predicate on the failure_reason field.
-func (f *LoginAuditLogFilter) WhereFailureReason(p entql.StringP) {
-	f.Where(p.Field(loginauditlog.FieldFailureReason))
+// WhereDownloadCount applies the entql uint32 predicate on the download_count field.
+func (f *FileShareLinkFilter) WhereDownloadCount(p entql.Uint32P) {
+	f.Where(p.Field(filesharelink.FieldDownloadCount))
 }
 
-// WhereMfaStatus applies the entql string predicate on the mfa_status field.
-func (f *LoginAuditLogFilter) WhereMfaStatus(p entql.StringP) {
-	f.Where(p.Field(loginauditlog.FieldMfaStatus))
+// WhereAllowedTenantIds applies the entql json.RawMessage predicate on the allowed_tenant_ids field.
+func (f *FileShareLinkFilter) WhereAllowedTenantIds(p entql.BytesP) {
+	f.Where(p.Field(filesharelink.FieldAllowedTenantIds))
 }
 
-// WhereRiskScore applies the entql uint32 predicate on the risk_score field.
-func (f *LoginAuditLogFilter) WhereRiskScore(p entql.Uint32P) {
-	f.Where(p.Field(loginauditlog.FieldRiskScore))
+// WhereAllowedUserIds applies the entql json.RawMessage predicate on the allowed_user_ids field.
+func (f *FileShareLinkFilter) WhereAllowedUserIds(p entql.BytesP) {
+	f.Where(p.Field(filesharelink.FieldAllowedUserIds))
 }
 
-// WhereRiskLevel applies the entql string predicate on the risk_level field.
-func (f *LoginAuditLogFilter) WhereRiskLevel(p entql.StringP) {
-	f.Where(p.Field(loginauditlog.FieldRiskLevel))
+// WhereAllowedOrgUnitIds applies the entql json.RawMessage predicate on the allowed_org_unit_ids field.
+func (f *FileShareLinkFilter) WhereAllowedOrgUnitIds(p entql.BytesP) {
+	f.Where(p.Field(filesharelink.FieldAllowedOrgUnitIds))
 }
 
-// WhereRiskFactors applies the entql json.RawMessage predicate on the risk_factors field.
-func (f *LoginAuditLogFilter) WhereRiskFactors(p entql.BytesP) {
-	f.Where(p.Field(loginauditlog.FieldRiskFactors))
+// WhereRevokedAt applies the entql time.Time predicate on the revoked_at field.
+func (f *FileShareLinkFilter) WhereRevokedAt(p entql.TimeP) {
+	f.Where(p.Field(filesharelink.FieldRevokedAt))
 }
 
-// WhereLogHash applies the entql string predicate on the log_hash field.
-func (f *LoginAuditLogFilter) WhereLogHash(p entql.StringP) {
-	f.Where(p.Field(loginauditlog.FieldLogHash))
+// WhereRevokedBy applies the entql uint32 predicate on the revoked_by field.
+func (f *FileShareLinkFilter) WhereRevokedBy(p entql.Uint32P) {
+	f.Where(p.Field(filesharelink.FieldRevokedBy))
 }
 
-// WhereSignature applies the entql []byte predicate on the signature field.
-func (f *LoginAuditLogFilter) WhereSignature(p entql.BytesP) {
-	f.Where(p.Field(loginauditlog.FieldSignature))
+// WhereLastAccessedAt applies the entql time.Time predicate on the last_accessed_at field.
+func (f *FileShareLinkFilter) WhereLastAccessedAt(p entql.TimeP) {
+	f.Where(p.Field(filesharelink.FieldLastAccessedAt))
 }
 
 // addPredicate implements the predicateAdder interface.
-func (_q *LoginPolicyQuery) addPredicate(pred func(s *sql.Selector)) {
+func (_q *InternalMessageQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the LoginPolicyQuery builder.
-func (_q *LoginPolicyQuery) Filter() *LoginPolicyFilter {
-	return &LoginPolicyFilter{config: _q.config, predicateAdder: _q}
+// Filter returns a Filter implementation to apply filters on the InternalMessageQuery builder.
+func (_q *InternalMessageQuery) Filter() *InternalMessageFilter {
+	return &InternalMessageFilter{config: _q.config, predicateAdder: _q}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *LoginPolicyMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *InternalMessageMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the LoginPolicyMutation builder.
-func (m *LoginPolicyMutation) Filter() *LoginPolicyFilter {
-	return &LoginPolicyFilter{config: m.config, predicateAdder: m}
+// Filter returns an entql.Where implementation to apply filters on the InternalMessageMutation builder.
+func (m *InternalMessageMutation) Filter() *InternalMessageFilter {
+	return &InternalMessageFilter{config: m.config, predicateAdder: m}
 }
 
-// LoginPolicyFilter provides a generic filtering capability at runtime for LoginPolicyQuery.
-type LoginPolicyFilter struct {
+// InternalMessageFilter provides a generic filtering capability at runtime for InternalMessageQuery.
+type InternalMessageFilter struct {
 	predicateAdder
 	config
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *LoginPolicyFilter) Where(p entql.P) {
+func (f *InternalMessageFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[12].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[11].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
 }
 
 // WhereID applies the entql uint32 predicate on the id field.
-func (f *LoginPolicyFilter) WhereID(p entql.Uint32P) {
-	f.Where(p.Field(loginpolicy.FieldID))
+func (f *InternalMessageFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(internalmessage.FieldID))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *LoginPolicyFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(loginpolicy.FieldCreatedAt))
+func (f *InternalMessageFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(internalmessage.FieldCreatedAt))
 }
 
 // WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
-func (f *LoginPolicyFilter) WhereUpdatedAt(p entql.TimeP) {
-	f.Where(p.Field(loginpolicy.FieldUpdatedAt))
+func (f *InternalMessageFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(internalmessage.FieldUpdatedAt))
 }
 
 // WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
-func (f *LoginPolicyFilter) WhereDeletedAt(p entql.TimeP) {
-	f.Where(p.Field(loginpolicy.FieldDeletedAt))
+func (f *InternalMessageFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(internalmessage.FieldDeletedAt))
 }
 
 // WhereCreatedBy applies the entql uint32 predicate on the created_by field.
-func (f *LoginPolicyFilter) WhereCreatedBy(p entql.Uint32P) {
-	f.Where(p.Field(loginpolicy.FieldCreatedBy))
+func (f *InternalMessageFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(internalmessage.FieldCreatedBy))
 }
 
 // WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
-func (f *LoginPolicyFilter) WhereUpdatedBy(p entql.Uint32P) {
-	f.Where(p.Field(loginpolicy.FieldUpdatedBy))
+func (f *InternalMessageFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(internalmessage.FieldUpdatedBy))
 }
 
 // WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
-func (f *LoginPolicyFilter) WhereDeletedBy(p entql.Uint32P) {
-	f.Where(p.Field(loginpolicy.FieldDeletedBy))
+func (f *InternalMessageFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(internalmessage.FieldDeletedBy))
 }
 
 // WhereTenantID applies the entql uint32 predicate on the tenant_id field.
-func (f *LoginPolicyFilter) WhereTenantID(p entql.Uint32P) {
-	f.Where(p.Field(loginpolicy.FieldTenantID))
+func (f *InternalMessageFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(internalmessage.FieldTenantID))
 }
 
-// WhereTargetID applies the entql uint32 predicate on the target_id field.
-func (f *LoginPolicyFilter) WhereTargetID(p entql.Uint32P) {
-	f.Where(p.Field(loginpolicy.FieldTargetID))
+// WhereTitle applies the entql string predicate on the title field.
+func (f *InternalMessageFilter) WhereTitle(p entql.StringP) {
+	f.Where(p.Field(internalmessage.FieldTitle))
 }
 
-// WhereValue applies the entql string predicate on the value field.
-func (f *LoginPolicyFilter) WhereValue(p entql.StringP) {
-	f.Where(p.Field(loginpolicy.FieldValue))
+// WhereContent applies the entql string predicate on the content field.
+func (f *InternalMessageFilter) WhereContent(p entql.StringP) {
+	f.Where(p.Field(internalmessage.FieldContent))
 }
 
-// WhereReason applies the entql string predicate on the reason field.
-func (f *LoginPolicyFilter) WhereReason(p entql.StringP) {
-	f.Where(p.Field(loginpolicy.FieldReason))
+// WhereSenderID applies the entql uint32 predicate on the sender_id field.
+func (f *InternalMessageFilter) WhereSenderID(p entql.Uint32P) {
+	f.Where(p.Field(internalmessage.FieldSenderID))
 }
 
-// WhereType applies the entql string predicate on the type field.
-func (f *LoginPolicyFilter) WhereType(p entql.StringP) {
-	f.Where(p.Field(loginpolicy.FieldType))
+// WhereCategoryID applies the entql uint32 predicate on the category_id field.
+func (f *InternalMessageFilter) WhereCategoryID(p entql.Uint32P) {
+	f.Where(p.Field(internalmessage.FieldCategoryID))
 }
 
-// WhereMethod applies the entql string predicate on the method field.
-func (f *LoginPolicyFilter) WhereMethod(p entql.StringP) {
-	f.Where(p.Field(loginpolicy.FieldMethod))
+// WhereStatus applies the entql string predicate on the status field.
+func (f *InternalMessageFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(internalmessage.FieldStatus))
+}
+
+// WhereType applies the entql string predicate on the type field.
+func (f *InternalMessageFilter) WhereType(p entql.StringP) {
+	f.Where(p.Field(internalmessage.FieldType))
 }
 
 // addPredicate implements the predicateAdder interface.
-func (_q *MembershipQuery) addPredicate(pred func(s *sql.Selector)) {
+func (_q *InternalMessageCategoryQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the MembershipQuery builder.
-func (_q *MembershipQuery) Filter() *MembershipFilter {
-	return &MembershipFilter{config: _q.config, predicateAdder: _q}
+// Filter returns a Filter implementation to apply filters on the InternalMessageCategoryQuery builder.
+func (_q *InternalMessageCategoryQuery) Filter() *InternalMessageCategoryFilter {
+	return &InternalMessageCategoryFilter{config: _q.config, predicateAdder: _q}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *MembershipMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *InternalMessageCategoryMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the MembershipMutation builder.
-func (m *MembershipMutation) Filter() *MembershipFilter {
-	return &MembershipFilter{config: m.config, predicateAdder: m}
+// Filter returns an entql.Where implementation to apply filters on the InternalMessageCategoryMutation builder.
+func (m *InternalMessageCategoryMutation) Filter() *InternalMessageCategoryFilter {
+	return &InternalMessageCategoryFilter{config: m.config, predicateAdder: m}
 }
 
-// MembershipFilter provides a generic filtering capability at runtime for MembershipQuery.
-type MembershipFilter struct {
+// InternalMessageCategoryFilter provides a generic filtering capability at runtime for InternalMessageCategoryQuery.
+type InternalMessageCategoryFilter struct {
 	predicateAdder
 	config
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *MembershipFilter) Where(p entql.P) {
+func (f *InternalMessageCategoryFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[13].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[12].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
 }
 
 // WhereID applies the entql uint32 predicate on the id field.
-func (f *MembershipFilter) WhereID(p entql.Uint32P) {
-	f.Where(p.Field(membership.FieldID))
+func (f *InternalMessageCategoryFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(internalmessagecategory.FieldID))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *MembershipFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(membership.FieldCreatedAt))
+func (f *InternalMessageCategoryFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(internalmessagecategory.FieldCreatedAt))
 }
 
 // WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
-func (f *MembershipFilter) WhereUpdatedAt(p entql.TimeP) {
-	f.Where(p.Field(membership.FieldUpdatedAt))
+func (f *InternalMessageCategoryFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(internalmessagecategory.FieldUpdatedAt))
 }
 
 // WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
-func (f *MembershipFilter) WhereDeletedAt(p entql.TimeP) {
-	f.Where(p.Field(membership.FieldDeletedAt))
+func (f *InternalMessageCategoryFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(internalmessagecategory.FieldDeletedAt))
 }
 
 // WhereCreatedBy applies the entql uint32 predicate on the created_by field.
-func (f *MembershipFilter) WhereCreatedBy(p entql.Uint32P) {
-	f.Where(p.Field(membership.FieldCreatedBy))
+func (f *InternalMessageCategoryFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(internalmessagecategory.FieldCreatedBy))
 }
 
 // WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
-func (f *MembershipFilter) WhereUpdatedBy(p entql.Uint32P) {
-	f.Where(p.Field(membership.FieldUpdatedBy))
+func (f *InternalMessageCategoryFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(internalmessagecategory.FieldUpdatedBy))
 }
 
 // WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
-func (f *MembershipFilter) WhereDeletedBy(p entql.Uint32P) {
-	f.Where(p.Field(membership.FieldDeletedBy))
+func (f *InternalMessageCategoryFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(internalmessagecategory.FieldDeletedBy))
 }
 
-// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
-func (f *MembershipFilter) WhereTenantID(p entql.Uint32P) {
-	f.Where(p.Field(membership.FieldTenantID))
+// WhereIsEnabled applies the entql bool predicate on the is_enabled field.
+func (f *InternalMessageCategoryFilter) WhereIsEnabled(p entql.BoolP) {
+	f.Where(p.Field(internalmessagecategory.FieldIsEnabled))
+}
+
+// WhereSortOrder applies the entql uint32 predicate on the sort_order field.
+func (f *InternalMessageCategoryFilter) WhereSortOrder(p entql.Uint32P) {
+	f.Where(p.Field(internalmessagecategory.FieldSortOrder))
 }
 
 // WhereRemark applies the entql string predicate on the remark field.
-func (f *MembershipFilter) WhereRemark(p entql.StringP) {
-	f.Where(p.Field(membership.FieldRemark))
+func (f *InternalMessageCategoryFilter) WhereRemark(p entql.StringP) {
+	f.Where(p.Field(internalmessagecategory.FieldRemark))
 }
 
-// WhereUserID applies the entql uint32 predicate on the user_id field.
-func (f *MembershipFilter) WhereUserID(p entql.Uint32P) {
-	f.Where(p.Field(membership.FieldUserID))
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *InternalMessageCategoryFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(internalmessagecategory.FieldTenantID))
 }
 
-// WhereOrgUnitID applies the entql uint32 predicate on the org_unit_id field.
-func (f *MembershipFilter) WhereOrgUnitID(p entql.Uint32P) {
-	f.Where(p.Field(membership.FieldOrgUnitID))
+// WhereName applies the entql string predicate on the name field.
+func (f *InternalMessageCategoryFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(internalmessagecategory.FieldName))
 }
 
-// WherePositionID applies the entql uint32 predicate on the position_id field.
-func (f *MembershipFilter) WherePositionID(p entql.Uint32P) {
-	f.Where(p.Field(membership.FieldPositionID))
+// WhereCode applies the entql string predicate on the code field.
+func (f *InternalMessageCategoryFilter) WhereCode(p entql.StringP) {
+	f.Where(p.Field(internalmessagecategory.FieldCode))
 }
 
-// WhereRoleID applies the entql uint32 predicate on the role_id field.
-func (f *MembershipFilter) WhereRoleID(p entql.Uint32P) {
-	f.Where(p.Field(membership.FieldRoleID))
+// WhereIconURL applies the entql string predicate on the icon_url field.
+func (f *InternalMessageCategoryFilter) WhereIconURL(p entql.StringP) {
+	f.Where(p.Field(internalmessagecategory.FieldIconURL))
 }
 
-// WhereIsPrimary applies the entql bool predicate on the is_primary field.
-func (f *MembershipFilter) WhereIsPrimary(p entql.BoolP) {
-	f.Where(p.Field(membership.FieldIsPrimary))
+// addPredicate implements the predicateAdder interface.
+func (_q *InternalMessageRecipientQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
 }
 
-// WhereStartAt applies the entql time.Time predicate on the start_at field.
-func (f *MembershipFilter) WhereStartAt(p entql.TimeP) {
-	f.Where(p.Field(membership.FieldStartAt))
+// Filter returns a Filter implementation to apply filters on the InternalMessageRecipientQuery builder.
+func (_q *InternalMessageRecipientQuery) Filter() *InternalMessageRecipientFilter {
+	return &InternalMessageRecipientFilter{config: _q.config, predicateAdder: _q}
 }
 
-// WhereEndAt applies the entql time.Time predicate on the end_at field.
-func (f *MembershipFilter) WhereEndAt(p entql.TimeP) {
-	f.Where(p.Field(membership.FieldEndAt))
+// addPredicate implements the predicateAdder interface.
+func (m *InternalMessageRecipientMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
 }
 
-// WhereAssignedAt applies the entql time.Time predicate on the assigned_at field.
-func (f *MembershipFilter) WhereAssignedAt(p entql.TimeP) {
-	f.Where(p.Field(membership.FieldAssignedAt))
+// Filter returns an entql.Where implementation to apply filters on the InternalMessageRecipientMutation builder.
+func (m *InternalMessageRecipientMutation) Filter() *InternalMessageRecipientFilter {
+	return &InternalMessageRecipientFilter{config: m.config, predicateAdder: m}
 }
 
-// WhereAssignedBy applies the entql uint32 predicate on the assigned_by field.
-func (f *MembershipFilter) WhereAssignedBy(p entql.Uint32P) {
-	f.Where(p.Field(membership.FieldAssignedBy))
+// InternalMessageRecipientFilter provides a generic filtering capability at runtime for InternalMessageRecipientQuery.
+type InternalMessageRecipientFilter struct {
+	predicateAdder
+	config
 }
 
-// WhereJoinedAt applies the entql time.Time predicate on the joined_at field.
-func (f *MembershipFilter) WhereJoinedAt(p entql.TimeP) {
-	f.Where(p.Field(membership.FieldJoinedAt))
+// Where applies the entql predicate on the query filter.
+func (f *InternalMessageRecipientFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[13].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *InternalMessageRecipientFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(internalmessagerecipient.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *InternalMessageRecipientFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(internalmessagerecipient.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *InternalMessageRecipientFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(internalmessagerecipient.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *InternalMessageRecipientFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(internalmessagerecipient.FieldDeletedAt))
+}
+
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *InternalMessageRecipientFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(internalmessagerecipient.FieldTenantID))
+}
+
+// WhereMessageID applies the entql uint32 predicate on the message_id field.
+func (f *InternalMessageRecipientFilter) WhereMessageID(p entql.Uint32P) {
+	f.Where(p.Field(internalmessagerecipient.FieldMessageID))
+}
+
+// WhereRecipientUserID applies the entql uint32 predicate on the recipient_user_id field.
+func (f *InternalMessageRecipientFilter) WhereRecipientUserID(p entql.Uint32P) {
+	f.Where(p.Field(internalmessagerecipient.FieldRecipientUserID))
 }
 
 // WhereStatus applies the entql string predicate on the status field.
-func (f *MembershipFilter) WhereStatus(p entql.StringP) {
-	f.Where(p.Field(membership.FieldStatus))
+func (f *InternalMessageRecipientFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(internalmessagerecipient.FieldStatus))
+}
+
+// WhereReceivedAt applies the entql time.Time predicate on the received_at field.
+func (f *InternalMessageRecipientFilter) WhereReceivedAt(p entql.TimeP) {
+	f.Where(p.Field(internalmessagerecipient.FieldReceivedAt))
+}
+
+// WhereReadAt applies the entql time.Time predicate on the read_at field.
+func (f *InternalMessageRecipientFilter) WhereReadAt(p entql.TimeP) {
+	f.Where(p.Field(internalmessagerecipient.FieldReadAt))
 }
 
 // addPredicate implements the predicateAdder interface.
-func (_q *MembershipOrgUnitQuery) addPredicate(pred func(s *sql.Selector)) {
+func (_q *LanguageQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the MembershipOrgUnitQuery builder.
-func (_q *MembershipOrgUnitQuery) Filter() *MembershipOrgUnitFilter {
-	return &MembershipOrgUnitFilter{config: _q.config, predicateAdder: _q}
+// Filter returns a Filter implementation to apply filters on the LanguageQuery builder.
+func (_q *LanguageQuery) Filter() *LanguageFilter {
+	return &LanguageFilter{config: _q.config, predicateAdder: _q}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *MembershipOrgUnitMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *LanguageMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the MembershipOrgUnitMutation builder.
-func (m *MembershipOrgUnitMutation) Filter() *MembershipOrgUnitFilter {
-	return &MembershipOrgUnitFilter{config: m.config, predicateAdder: m}
+// Filter returns an entql.Where implementation to apply filters on the LanguageMutation builder.
+func (m *LanguageMutation) Filter() *LanguageFilter {
+	return &LanguageFilter{config: m.config, predicateAdder: m}
 }
 
-// MembershipOrgUnitFilter provides a generic filtering capability at runtime for MembershipOrgUnitQuery.
-type MembershipOrgUnitFilter struct {
+// LanguageFilter provides a generic filtering capability at runtime for LanguageQuery.
+type LanguageFilter struct {
 	predicateAdder
 	config
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *MembershipOrgUnitFilter) Where(p entql.P) {
+func (f *LanguageFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[14].Type, p, s); err != nil {
 			s.AddError(err)
@@ -3020,462 +3481,1943 @@ func (f *MembershipOrgUnitFilter) Where(p entql.P) {
 }
 
 // WhereID applies the entql uint32 predicate on the id field.
-func (f *MembershipOrgUnitFilter) WhereID(p entql.Uint32P) {
-	f.Where(p.Field(membershiporgunit.FieldID))
+func (f *LanguageFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(language.FieldID))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *MembershipOrgUnitFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(membershiporgunit.FieldCreatedAt))
+func (f *LanguageFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(language.FieldCreatedAt))
 }
 
 // WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
-func (f *MembershipOrgUnitFilter) WhereUpdatedAt(p entql.TimeP) {
-	f.Where(p.Field(membershiporgunit.FieldUpdatedAt))
+func (f *LanguageFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(language.FieldUpdatedAt))
 }
 
 // WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
-func (f *MembershipOrgUnitFilter) WhereDeletedAt(p entql.TimeP) {
-	f.Where(p.Field(membershiporgunit.FieldDeletedAt))
+func (f *LanguageFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(language.FieldDeletedAt))
 }
 
 // WhereCreatedBy applies the entql uint32 predicate on the created_by field.
-func (f *MembershipOrgUnitFilter) WhereCreatedBy(p entql.Uint32P) {
-	f.Where(p.Field(membershiporgunit.FieldCreatedBy))
+func (f *LanguageFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(language.FieldCreatedBy))
 }
 
 // WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
-func (f *MembershipOrgUnitFilter) WhereUpdatedBy(p entql.Uint32P) {
-	f.Where(p.Field(membershiporgunit.FieldUpdatedBy))
+func (f *LanguageFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(language.FieldUpdatedBy))
 }
 
 // WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
-func (f *MembershipOrgUnitFilter) WhereDeletedBy(p entql.Uint32P) {
-	f.Where(p.Field(membershiporgunit.FieldDeletedBy))
+func (f *LanguageFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(language.FieldDeletedBy))
 }
 
-// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
-func (f *MembershipOrgUnitFilter) WhereTenantID(p entql.Uint32P) {
-	f.Where(p.Field(membershiporgunit.FieldTenantID))
+// WhereSortOrder applies the entql uint32 predicate on the sort_order field.
+func (f *LanguageFilter) WhereSortOrder(p entql.Uint32P) {
+	f.Where(p.Field(language.FieldSortOrder))
 }
 
-// WhereRemark applies the entql string predicate on the remark field.
-func (f *MembershipOrgUnitFilter) WhereRemark(p entql.StringP) {
-	f.Where(p.Field(membershiporgunit.FieldRemark))
+// WhereIsEnabled applies the entql bool predicate on the is_enabled field.
+func (f *LanguageFilter) WhereIsEnabled(p entql.BoolP) {
+	f.Where(p.Field(language.FieldIsEnabled))
+}
+
+// WhereLanguageCode applies the entql string predicate on the language_code field.
+func (f *LanguageFilter) WhereLanguageCode(p entql.StringP) {
+	f.Where(p.Field(language.FieldLanguageCode))
+}
+
+// WhereLanguageName applies the entql string predicate on the language_name field.
+func (f *LanguageFilter) WhereLanguageName(p entql.StringP) {
+	f.Where(p.Field(language.FieldLanguageName))
+}
+
+// WhereNativeName applies the entql string predicate on the native_name field.
+func (f *LanguageFilter) WhereNativeName(p entql.StringP) {
+	f.Where(p.Field(language.FieldNativeName))
+}
+
+// WhereIsDefault applies the entql bool predicate on the is_default field.
+func (f *LanguageFilter) WhereIsDefault(p entql.BoolP) {
+	f.Where(p.Field(language.FieldIsDefault))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *LoginAuditLogQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the LoginAuditLogQuery builder.
+func (_q *LoginAuditLogQuery) Filter() *LoginAuditLogFilter {
+	return &LoginAuditLogFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *LoginAuditLogMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the LoginAuditLogMutation builder.
+func (m *LoginAuditLogMutation) Filter() *LoginAuditLogFilter {
+	return &LoginAuditLogFilter{config: m.config, predicateAdder: m}
+}
+
+// LoginAuditLogFilter provides a generic filtering capability at runtime for LoginAuditLogQuery.
+type LoginAuditLogFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *LoginAuditLogFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[15].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *LoginAuditLogFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(loginauditlog.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *LoginAuditLogFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(loginauditlog.FieldCreatedAt))
+}
+
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *LoginAuditLogFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(loginauditlog.FieldTenantID))
+}
+
+// WhereUserID applies the entql uint32 predicate on the user_id field.
+func (f *LoginAuditLogFilter) WhereUserID(p entql.Uint32P) {
+	f.Where(p.Field(loginauditlog.FieldUserID))
+}
+
+// WhereUsername applies the entql string predicate on the username field.
+func (f *LoginAuditLogFilter) WhereUsername(p entql.StringP) {
+	f.Where(p.Field(loginauditlog.FieldUsername))
+}
+
+// WhereIPAddress applies the entql string predicate on the ip_address field.
+func (f *LoginAuditLogFilter) WhereIPAddress(p entql.StringP) {
+	f.Where(p.Field(loginauditlog.FieldIPAddress))
+}
+
+// WhereGeoLocation applies the entql json.RawMessage predicate on the geo_location field.
+func (f *LoginAuditLogFilter) WhereGeoLocation(p entql.BytesP) {
+	f.Where(p.Field(loginauditlog.FieldGeoLocation))
+}
+
+// WhereSessionID applies the entql string predicate on the session_id field.
+func (f *LoginAuditLogFilter) WhereSessionID(p entql.StringP) {
+	f.Where(p.Field(loginauditlog.FieldSessionID))
+}
+
+// WhereDeviceInfo applies the entql json.RawMessage predicate on the device_info field.
+func (f *LoginAuditLogFilter) WhereDeviceInfo(p entql.BytesP) {
+	f.Where(p.Field(loginauditlog.FieldDeviceInfo))
+}
+
+// WhereRequestID applies the entql string predicate on the request_id field.
+func (f *LoginAuditLogFilter) WhereRequestID(p entql.StringP) {
+	f.Where(p.Field(loginauditlog.FieldRequestID))
+}
+
+// WhereTraceID applies the entql string predicate on the trace_id field.
+func (f *LoginAuditLogFilter) WhereTraceID(p entql.StringP) {
+	f.Where(p.Field(loginauditlog.FieldTraceID))
+}
+
+// WhereActionType applies the entql string predicate on the action_type field.
+func (f *LoginAuditLogFilter) WhereActionType(p entql.StringP) {
+	f.Where(p.Field(loginauditlog.FieldActionType))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *LoginAuditLogFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(loginauditlog.FieldStatus))
+}
+
+// WhereLoginMethod applies the entql string predicate on the login_method field.
+func (f *LoginAuditLogFilter) WhereLoginMethod(p entql.StringP) {
+	f.Where(p.Field(loginauditlog.FieldLoginMethod))
+}
+
+// WhereFailureReason applies the entql string predicate on the failure_reason field.
+func (f *LoginAuditLogFilter) WhereFailureReason(p entql.StringP) {
+	f.Where(p.Field(loginauditlog.FieldFailureReason))
+}
+
+// WhereMfaStatus applies the entql string predicate on the mfa_status field.
+func (f *LoginAuditLogFilter) WhereMfaStatus(p entql.StringP) {
+	f.Where(p.Field(loginauditlog.FieldMfaStatus))
+}
+
+// WhereRiskScore applies the entql uint32 predicate on the risk_score field.
+func (f *LoginAuditLogFilter) WhereRiskScore(p entql.Uint32P) {
+	f.Where(p.Field(loginauditlog.FieldRiskScore))
+}
+
+// WhereRiskLevel applies the entql string predicate on the risk_level field.
+func (f *LoginAuditLogFilter) WhereRiskLevel(p entql.StringP) {
+	f.Where(p.Field(loginauditlog.FieldRiskLevel))
+}
+
+// WhereRiskFactors applies the entql json.RawMessage predicate on the risk_factors field.
+func (f *LoginAuditLogFilter) WhereRiskFactors(p entql.BytesP) {
+	f.Where(p.Field(loginauditlog.FieldRiskFactors))
+}
+
+// WhereLogHash applies the entql string predicate on the log_hash field.
+func (f *LoginAuditLogFilter) WhereLogHash(p entql.StringP) {
+	f.Where(p.Field(loginauditlog.FieldLogHash))
+}
+
+// WhereSignature applies the entql []byte predicate on the signature field.
+func (f *LoginAuditLogFilter) WhereSignature(p entql.BytesP) {
+	f.Where(p.Field(loginauditlog.FieldSignature))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *LoginPolicyQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the LoginPolicyQuery builder.
+func (_q *LoginPolicyQuery) Filter() *LoginPolicyFilter {
+	return &LoginPolicyFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *LoginPolicyMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the LoginPolicyMutation builder.
+func (m *LoginPolicyMutation) Filter() *LoginPolicyFilter {
+	return &LoginPolicyFilter{config: m.config, predicateAdder: m}
+}
+
+// LoginPolicyFilter provides a generic filtering capability at runtime for LoginPolicyQuery.
+type LoginPolicyFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *LoginPolicyFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[16].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *LoginPolicyFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(loginpolicy.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *LoginPolicyFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(loginpolicy.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *LoginPolicyFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(loginpolicy.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *LoginPolicyFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(loginpolicy.FieldDeletedAt))
+}
+
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *LoginPolicyFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(loginpolicy.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *LoginPolicyFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(loginpolicy.FieldUpdatedBy))
+}
+
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *LoginPolicyFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(loginpolicy.FieldDeletedBy))
+}
+
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *LoginPolicyFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(loginpolicy.FieldTenantID))
+}
+
+// WhereTargetID applies the entql uint32 predicate on the target_id field.
+func (f *LoginPolicyFilter) WhereTargetID(p entql.Uint32P) {
+	f.Where(p.Field(loginpolicy.FieldTargetID))
+}
+
+// WhereValue applies the entql string predicate on the value field.
+func (f *LoginPolicyFilter) WhereValue(p entql.StringP) {
+	f.Where(p.Field(loginpolicy.FieldValue))
+}
+
+// WhereReason applies the entql string predicate on the reason field.
+func (f *LoginPolicyFilter) WhereReason(p entql.StringP) {
+	f.Where(p.Field(loginpolicy.FieldReason))
+}
+
+// WhereType applies the entql string predicate on the type field.
+func (f *LoginPolicyFilter) WhereType(p entql.StringP) {
+	f.Where(p.Field(loginpolicy.FieldType))
+}
+
+// WhereMethod applies the entql string predicate on the method field.
+func (f *LoginPolicyFilter) WhereMethod(p entql.StringP) {
+	f.Where(p.Field(loginpolicy.FieldMethod))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *LuaScriptQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the LuaScriptQuery builder.
+func (_q *LuaScriptQuery) Filter() *LuaScriptFilter {
+	return &LuaScriptFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *LuaScriptMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the LuaScriptMutation builder.
+func (m *LuaScriptMutation) Filter() *LuaScriptFilter {
+	return &LuaScriptFilter{config: m.config, predicateAdder: m}
+}
+
+// LuaScriptFilter provides a generic filtering capability at runtime for LuaScriptQuery.
+type LuaScriptFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *LuaScriptFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[17].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *LuaScriptFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(luascript.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *LuaScriptFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(luascript.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *LuaScriptFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(luascript.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *LuaScriptFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(luascript.FieldDeletedAt))
+}
+
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *LuaScriptFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(luascript.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *LuaScriptFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(luascript.FieldUpdatedBy))
+}
+
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *LuaScriptFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(luascript.FieldDeletedBy))
+}
+
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *LuaScriptFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(luascript.FieldTenantID))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *LuaScriptFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(luascript.FieldName))
+}
+
+// WhereHook applies the entql string predicate on the hook field.
+func (f *LuaScriptFilter) WhereHook(p entql.StringP) {
+	f.Where(p.Field(luascript.FieldHook))
+}
+
+// WhereSource applies the entql string predicate on the source field.
+func (f *LuaScriptFilter) WhereSource(p entql.StringP) {
+	f.Where(p.Field(luascript.FieldSource))
+}
+
+// WhereDescription applies the entql string predicate on the description field.
+func (f *LuaScriptFilter) WhereDescription(p entql.StringP) {
+	f.Where(p.Field(luascript.FieldDescription))
+}
+
+// WhereEnabled applies the entql bool predicate on the enabled field.
+func (f *LuaScriptFilter) WhereEnabled(p entql.BoolP) {
+	f.Where(p.Field(luascript.FieldEnabled))
+}
+
+// WherePriority applies the entql int32 predicate on the priority field.
+func (f *LuaScriptFilter) WherePriority(p entql.Int32P) {
+	f.Where(p.Field(luascript.FieldPriority))
+}
+
+// WhereCritical applies the entql bool predicate on the critical field.
+func (f *LuaScriptFilter) WhereCritical(p entql.BoolP) {
+	f.Where(p.Field(luascript.FieldCritical))
+}
+
+// WhereVersion applies the entql uint32 predicate on the version field.
+func (f *LuaScriptFilter) WhereVersion(p entql.Uint32P) {
+	f.Where(p.Field(luascript.FieldVersion))
+}
+
+// WhereAuthor applies the entql string predicate on the author field.
+func (f *LuaScriptFilter) WhereAuthor(p entql.StringP) {
+	f.Where(p.Field(luascript.FieldAuthor))
+}
+
+// WhereDataWrite applies the entql bool predicate on the data_write field.
+func (f *LuaScriptFilter) WhereDataWrite(p entql.BoolP) {
+	f.Where(p.Field(luascript.FieldDataWrite))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *LuaScriptVersionQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the LuaScriptVersionQuery builder.
+func (_q *LuaScriptVersionQuery) Filter() *LuaScriptVersionFilter {
+	return &LuaScriptVersionFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *LuaScriptVersionMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the LuaScriptVersionMutation builder.
+func (m *LuaScriptVersionMutation) Filter() *LuaScriptVersionFilter {
+	return &LuaScriptVersionFilter{config: m.config, predicateAdder: m}
+}
+
+// LuaScriptVersionFilter provides a generic filtering capability at runtime for LuaScriptVersionQuery.
+type LuaScriptVersionFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *LuaScriptVersionFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[18].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *LuaScriptVersionFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(luascriptversion.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *LuaScriptVersionFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(luascriptversion.FieldCreatedAt))
+}
+
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *LuaScriptVersionFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(luascriptversion.FieldCreatedBy))
+}
+
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *LuaScriptVersionFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(luascriptversion.FieldTenantID))
+}
+
+// WhereScriptID applies the entql uint32 predicate on the script_id field.
+func (f *LuaScriptVersionFilter) WhereScriptID(p entql.Uint32P) {
+	f.Where(p.Field(luascriptversion.FieldScriptID))
+}
+
+// WhereVersion applies the entql uint32 predicate on the version field.
+func (f *LuaScriptVersionFilter) WhereVersion(p entql.Uint32P) {
+	f.Where(p.Field(luascriptversion.FieldVersion))
+}
+
+// WhereHook applies the entql string predicate on the hook field.
+func (f *LuaScriptVersionFilter) WhereHook(p entql.StringP) {
+	f.Where(p.Field(luascriptversion.FieldHook))
+}
+
+// WhereSource applies the entql string predicate on the source field.
+func (f *LuaScriptVersionFilter) WhereSource(p entql.StringP) {
+	f.Where(p.Field(luascriptversion.FieldSource))
+}
+
+// WherePriority applies the entql int32 predicate on the priority field.
+func (f *LuaScriptVersionFilter) WherePriority(p entql.Int32P) {
+	f.Where(p.Field(luascriptversion.FieldPriority))
+}
+
+// WhereCritical applies the entql bool predicate on the critical field.
+func (f *LuaScriptVersionFilter) WhereCritical(p entql.BoolP) {
+	f.Where(p.Field(luascriptversion.FieldCritical))
+}
+
+// WhereAuthor applies the entql string predicate on the author field.
+func (f *LuaScriptVersionFilter) WhereAuthor(p entql.StringP) {
+	f.Where(p.Field(luascriptversion.FieldAuthor))
+}
+
+// WhereChangeLog applies the entql string predicate on the change_log field.
+func (f *LuaScriptVersionFilter) WhereChangeLog(p entql.StringP) {
+	f.Where(p.Field(luascriptversion.FieldChangeLog))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *MembershipQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the MembershipQuery builder.
+func (_q *MembershipQuery) Filter() *MembershipFilter {
+	return &MembershipFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *MembershipMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the MembershipMutation builder.
+func (m *MembershipMutation) Filter() *MembershipFilter {
+	return &MembershipFilter{config: m.config, predicateAdder: m}
+}
+
+// MembershipFilter provides a generic filtering capability at runtime for MembershipQuery.
+type MembershipFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *MembershipFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[19].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *MembershipFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(membership.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *MembershipFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(membership.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *MembershipFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(membership.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *MembershipFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(membership.FieldDeletedAt))
+}
+
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *MembershipFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(membership.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *MembershipFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(membership.FieldUpdatedBy))
+}
+
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *MembershipFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(membership.FieldDeletedBy))
+}
+
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *MembershipFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(membership.FieldTenantID))
+}
+
+// WhereRemark applies the entql string predicate on the remark field.
+func (f *MembershipFilter) WhereRemark(p entql.StringP) {
+	f.Where(p.Field(membership.FieldRemark))
+}
+
+// WhereUserID applies the entql uint32 predicate on the user_id field.
+func (f *MembershipFilter) WhereUserID(p entql.Uint32P) {
+	f.Where(p.Field(membership.FieldUserID))
+}
+
+// WhereOrgUnitID applies the entql uint32 predicate on the org_unit_id field.
+func (f *MembershipFilter) WhereOrgUnitID(p entql.Uint32P) {
+	f.Where(p.Field(membership.FieldOrgUnitID))
+}
+
+// WherePositionID applies the entql uint32 predicate on the position_id field.
+func (f *MembershipFilter) WherePositionID(p entql.Uint32P) {
+	f.Where(p.Field(membership.FieldPositionID))
+}
+
+// WhereRoleID applies the entql uint32 predicate on the role_id field.
+func (f *MembershipFilter) WhereRoleID(p entql.Uint32P) {
+	f.Where(p.Field(membership.FieldRoleID))
+}
+
+// WhereIsPrimary applies the entql bool predicate on the is_primary field.
+func (f *MembershipFilter) WhereIsPrimary(p entql.BoolP) {
+	f.Where(p.Field(membership.FieldIsPrimary))
+}
+
+// WhereStartAt applies the entql time.Time predicate on the start_at field.
+func (f *MembershipFilter) WhereStartAt(p entql.TimeP) {
+	f.Where(p.Field(membership.FieldStartAt))
+}
+
+// WhereEndAt applies the entql time.Time predicate on the end_at field.
+func (f *MembershipFilter) WhereEndAt(p entql.TimeP) {
+	f.Where(p.Field(membership.FieldEndAt))
+}
+
+// WhereAssignedAt applies the entql time.Time predicate on the assigned_at field.
+func (f *MembershipFilter) WhereAssignedAt(p entql.TimeP) {
+	f.Where(p.Field(membership.FieldAssignedAt))
+}
+
+// WhereAssignedBy applies the entql uint32 predicate on the assigned_by field.
+func (f *MembershipFilter) WhereAssignedBy(p entql.Uint32P) {
+	f.Where(p.Field(membership.FieldAssignedBy))
+}
+
+// WhereJoinedAt applies the entql time.Time predicate on the joined_at field.
+func (f *MembershipFilter) WhereJoinedAt(p entql.TimeP) {
+	f.Where(p.Field(membership.FieldJoinedAt))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *MembershipFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(membership.FieldStatus))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *MembershipOrgUnitQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the MembershipOrgUnitQuery builder.
+func (_q *MembershipOrgUnitQuery) Filter() *MembershipOrgUnitFilter {
+	return &MembershipOrgUnitFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *MembershipOrgUnitMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the MembershipOrgUnitMutation builder.
+func (m *MembershipOrgUnitMutation) Filter() *MembershipOrgUnitFilter {
+	return &MembershipOrgUnitFilter{config: m.config, predicateAdder: m}
+}
+
+// MembershipOrgUnitFilter provides a generic filtering capability at runtime for MembershipOrgUnitQuery.
+type MembershipOrgUnitFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *MembershipOrgUnitFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[20].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *MembershipOrgUnitFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(membershiporgunit.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *MembershipOrgUnitFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(membershiporgunit.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *MembershipOrgUnitFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(membershiporgunit.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *MembershipOrgUnitFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(membershiporgunit.FieldDeletedAt))
+}
+
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *MembershipOrgUnitFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(membershiporgunit.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *MembershipOrgUnitFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(membershiporgunit.FieldUpdatedBy))
+}
+
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *MembershipOrgUnitFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(membershiporgunit.FieldDeletedBy))
+}
+
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *MembershipOrgUnitFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(membershiporgunit.FieldTenantID))
+}
+
+// WhereRemark applies the entql string predicate on the remark field.
+func (f *MembershipOrgUnitFilter) WhereRemark(p entql.StringP) {
+	f.Where(p.Field(membershiporgunit.FieldRemark))
+}
+
+// WhereMembershipID applies the entql uint32 predicate on the membership_id field.
+func (f *MembershipOrgUnitFilter) WhereMembershipID(p entql.Uint32P) {
+	f.Where(p.Field(membershiporgunit.FieldMembershipID))
+}
+
+// WhereOrgUnitID applies the entql uint32 predicate on the org_unit_id field.
+func (f *MembershipOrgUnitFilter) WhereOrgUnitID(p entql.Uint32P) {
+	f.Where(p.Field(membershiporgunit.FieldOrgUnitID))
+}
+
+// WherePositionID applies the entql uint32 predicate on the position_id field.
+func (f *MembershipOrgUnitFilter) WherePositionID(p entql.Uint32P) {
+	f.Where(p.Field(membershiporgunit.FieldPositionID))
+}
+
+// WhereRoleID applies the entql uint32 predicate on the role_id field.
+func (f *MembershipOrgUnitFilter) WhereRoleID(p entql.Uint32P) {
+	f.Where(p.Field(membershiporgunit.FieldRoleID))
+}
+
+// WhereStartAt applies the entql time.Time predicate on the start_at field.
+func (f *MembershipOrgUnitFilter) WhereStartAt(p entql.TimeP) {
+	f.Where(p.Field(membershiporgunit.FieldStartAt))
+}
+
+// WhereEndAt applies the entql time.Time predicate on the end_at field.
+func (f *MembershipOrgUnitFilter) WhereEndAt(p entql.TimeP) {
+	f.Where(p.Field(membershiporgunit.FieldEndAt))
+}
+
+// WhereAssignedAt applies the entql time.Time predicate on the assigned_at field.
+func (f *MembershipOrgUnitFilter) WhereAssignedAt(p entql.TimeP) {
+	f.Where(p.Field(membershiporgunit.FieldAssignedAt))
+}
+
+// WhereAssignedBy applies the entql uint32 predicate on the assigned_by field.
+func (f *MembershipOrgUnitFilter) WhereAssignedBy(p entql.Uint32P) {
+	f.Where(p.Field(membershiporgunit.FieldAssignedBy))
+}
+
+// WhereIsPrimary applies the entql bool predicate on the is_primary field.
+func (f *MembershipOrgUnitFilter) WhereIsPrimary(p entql.BoolP) {
+	f.Where(p.Field(membershiporgunit.FieldIsPrimary))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *MembershipOrgUnitFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(membershiporgunit.FieldStatus))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *MembershipPositionQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the MembershipPositionQuery builder.
+func (_q *MembershipPositionQuery) Filter() *MembershipPositionFilter {
+	return &MembershipPositionFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *MembershipPositionMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the MembershipPositionMutation builder.
+func (m *MembershipPositionMutation) Filter() *MembershipPositionFilter {
+	return &MembershipPositionFilter{config: m.config, predicateAdder: m}
+}
+
+// MembershipPositionFilter provides a generic filtering capability at runtime for MembershipPositionQuery.
+type MembershipPositionFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *MembershipPositionFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[21].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *MembershipPositionFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(membershipposition.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *MembershipPositionFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(membershipposition.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *MembershipPositionFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(membershipposition.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *MembershipPositionFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(membershipposition.FieldDeletedAt))
+}
+
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *MembershipPositionFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(membershipposition.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *MembershipPositionFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(membershipposition.FieldUpdatedBy))
+}
+
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *MembershipPositionFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(membershipposition.FieldDeletedBy))
+}
+
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *MembershipPositionFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(membershipposition.FieldTenantID))
+}
+
+// WhereRemark applies the entql string predicate on the remark field.
+func (f *MembershipPositionFilter) WhereRemark(p entql.StringP) {
+	f.Where(p.Field(membershipposition.FieldRemark))
+}
+
+// WhereMembershipID applies the entql uint32 predicate on the membership_id field.
+func (f *MembershipPositionFilter) WhereMembershipID(p entql.Uint32P) {
+	f.Where(p.Field(membershipposition.FieldMembershipID))
+}
+
+// WherePositionID applies the entql uint32 predicate on the position_id field.
+func (f *MembershipPositionFilter) WherePositionID(p entql.Uint32P) {
+	f.Where(p.Field(membershipposition.FieldPositionID))
+}
+
+// WhereIsPrimary applies the entql bool predicate on the is_primary field.
+func (f *MembershipPositionFilter) WhereIsPrimary(p entql.BoolP) {
+	f.Where(p.Field(membershipposition.FieldIsPrimary))
+}
+
+// WhereStartAt applies the entql time.Time predicate on the start_at field.
+func (f *MembershipPositionFilter) WhereStartAt(p entql.TimeP) {
+	f.Where(p.Field(membershipposition.FieldStartAt))
+}
+
+// WhereEndAt applies the entql time.Time predicate on the end_at field.
+func (f *MembershipPositionFilter) WhereEndAt(p entql.TimeP) {
+	f.Where(p.Field(membershipposition.FieldEndAt))
+}
+
+// WhereAssignedAt applies the entql time.Time predicate on the assigned_at field.
+func (f *MembershipPositionFilter) WhereAssignedAt(p entql.TimeP) {
+	f.Where(p.Field(membershipposition.FieldAssignedAt))
+}
+
+// WhereAssignedBy applies the entql uint32 predicate on the assigned_by field.
+func (f *MembershipPositionFilter) WhereAssignedBy(p entql.Uint32P) {
+	f.Where(p.Field(membershipposition.FieldAssignedBy))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *MembershipPositionFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(membershipposition.FieldStatus))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *MembershipRoleQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the MembershipRoleQuery builder.
+func (_q *MembershipRoleQuery) Filter() *MembershipRoleFilter {
+	return &MembershipRoleFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *MembershipRoleMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the MembershipRoleMutation builder.
+func (m *MembershipRoleMutation) Filter() *MembershipRoleFilter {
+	return &MembershipRoleFilter{config: m.config, predicateAdder: m}
+}
+
+// MembershipRoleFilter provides a generic filtering capability at runtime for MembershipRoleQuery.
+type MembershipRoleFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *MembershipRoleFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[22].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *MembershipRoleFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(membershiprole.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *MembershipRoleFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(membershiprole.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *MembershipRoleFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(membershiprole.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *MembershipRoleFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(membershiprole.FieldDeletedAt))
+}
+
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *MembershipRoleFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(membershiprole.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *MembershipRoleFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(membershiprole.FieldUpdatedBy))
+}
+
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *MembershipRoleFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(membershiprole.FieldDeletedBy))
+}
+
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *MembershipRoleFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(membershiprole.FieldTenantID))
+}
+
+// WhereMembershipID applies the entql uint32 predicate on the membership_id field.
+func (f *MembershipRoleFilter) WhereMembershipID(p entql.Uint32P) {
+	f.Where(p.Field(membershiprole.FieldMembershipID))
+}
+
+// WhereRoleID applies the entql uint32 predicate on the role_id field.
+func (f *MembershipRoleFilter) WhereRoleID(p entql.Uint32P) {
+	f.Where(p.Field(membershiprole.FieldRoleID))
+}
+
+// WhereStartAt applies the entql time.Time predicate on the start_at field.
+func (f *MembershipRoleFilter) WhereStartAt(p entql.TimeP) {
+	f.Where(p.Field(membershiprole.FieldStartAt))
+}
+
+// WhereEndAt applies the entql time.Time predicate on the end_at field.
+func (f *MembershipRoleFilter) WhereEndAt(p entql.TimeP) {
+	f.Where(p.Field(membershiprole.FieldEndAt))
+}
+
+// WhereAssignedAt applies the entql time.Time predicate on the assigned_at field.
+func (f *MembershipRoleFilter) WhereAssignedAt(p entql.TimeP) {
+	f.Where(p.Field(membershiprole.FieldAssignedAt))
+}
+
+// WhereAssignedBy applies the entql uint32 predicate on the assigned_by field.
+func (f *MembershipRoleFilter) WhereAssignedBy(p entql.Uint32P) {
+	f.Where(p.Field(membershiprole.FieldAssignedBy))
+}
+
+// WhereIsPrimary applies the entql bool predicate on the is_primary field.
+func (f *MembershipRoleFilter) WhereIsPrimary(p entql.BoolP) {
+	f.Where(p.Field(membershiprole.FieldIsPrimary))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *MembershipRoleFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(membershiprole.FieldStatus))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *MenuQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the MenuQuery builder.
+func (_q *MenuQuery) Filter() *MenuFilter {
+	return &MenuFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *MenuMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the MenuMutation builder.
+func (m *MenuMutation) Filter() *MenuFilter {
+	return &MenuFilter{config: m.config, predicateAdder: m}
+}
+
+// MenuFilter provides a generic filtering capability at runtime for MenuQuery.
+type MenuFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *MenuFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[23].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *MenuFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(menu.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *MenuFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(menu.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *MenuFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(menu.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *MenuFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(menu.FieldDeletedAt))
+}
+
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *MenuFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(menu.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *MenuFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(menu.FieldUpdatedBy))
+}
+
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *MenuFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(menu.FieldDeletedBy))
+}
+
+// WhereParentID applies the entql uint32 predicate on the parent_id field.
+func (f *MenuFilter) WhereParentID(p entql.Uint32P) {
+	f.Where(p.Field(menu.FieldParentID))
+}
+
+// WhereRemark applies the entql string predicate on the remark field.
+func (f *MenuFilter) WhereRemark(p entql.StringP) {
+	f.Where(p.Field(menu.FieldRemark))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *MenuFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(menu.FieldStatus))
+}
+
+// WhereType applies the entql string predicate on the type field.
+func (f *MenuFilter) WhereType(p entql.StringP) {
+	f.Where(p.Field(menu.FieldType))
+}
+
+// WherePath applies the entql string predicate on the path field.
+func (f *MenuFilter) WherePath(p entql.StringP) {
+	f.Where(p.Field(menu.FieldPath))
+}
+
+// WhereRedirect applies the entql string predicate on the redirect field.
+func (f *MenuFilter) WhereRedirect(p entql.StringP) {
+	f.Where(p.Field(menu.FieldRedirect))
+}
+
+// WhereAlias applies the entql string predicate on the alias field.
+func (f *MenuFilter) WhereAlias(p entql.StringP) {
+	f.Where(p.Field(menu.FieldAlias))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *MenuFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(menu.FieldName))
+}
+
+// WhereComponent applies the entql string predicate on the component field.
+func (f *MenuFilter) WhereComponent(p entql.StringP) {
+	f.Where(p.Field(menu.FieldComponent))
+}
+
+// WhereMeta applies the entql json.RawMessage predicate on the meta field.
+func (f *MenuFilter) WhereMeta(p entql.BytesP) {
+	f.Where(p.Field(menu.FieldMeta))
+}
+
+// WhereHasParent applies a predicate to check if query has an edge parent.
+func (f *MenuFilter) WhereHasParent() {
+	f.Where(entql.HasEdge("parent"))
+}
+
+// WhereHasParentWith applies a predicate to check if query has an edge parent with a given conditions (other predicates).
+func (f *MenuFilter) WhereHasParentWith(preds ...predicate.Menu) {
+	f.Where(entql.HasEdgeWith("parent", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasChildren applies a predicate to check if query has an edge children.
+func (f *MenuFilter) WhereHasChildren() {
+	f.Where(entql.HasEdge("children"))
+}
+
+// WhereHasChildrenWith applies a predicate to check if query has an edge children with a given conditions (other predicates).
+func (f *MenuFilter) WhereHasChildrenWith(preds ...predicate.Menu) {
+	f.Where(entql.HasEdgeWith("children", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *OperationAuditLogQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the OperationAuditLogQuery builder.
+func (_q *OperationAuditLogQuery) Filter() *OperationAuditLogFilter {
+	return &OperationAuditLogFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *OperationAuditLogMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the OperationAuditLogMutation builder.
+func (m *OperationAuditLogMutation) Filter() *OperationAuditLogFilter {
+	return &OperationAuditLogFilter{config: m.config, predicateAdder: m}
+}
+
+// OperationAuditLogFilter provides a generic filtering capability at runtime for OperationAuditLogQuery.
+type OperationAuditLogFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *OperationAuditLogFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[24].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *OperationAuditLogFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(operationauditlog.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *OperationAuditLogFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(operationauditlog.FieldCreatedAt))
+}
+
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *OperationAuditLogFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(operationauditlog.FieldTenantID))
+}
+
+// WhereUserID applies the entql uint32 predicate on the user_id field.
+func (f *OperationAuditLogFilter) WhereUserID(p entql.Uint32P) {
+	f.Where(p.Field(operationauditlog.FieldUserID))
+}
+
+// WhereUsername applies the entql string predicate on the username field.
+func (f *OperationAuditLogFilter) WhereUsername(p entql.StringP) {
+	f.Where(p.Field(operationauditlog.FieldUsername))
+}
+
+// WhereResourceType applies the entql string predicate on the resource_type field.
+func (f *OperationAuditLogFilter) WhereResourceType(p entql.StringP) {
+	f.Where(p.Field(operationauditlog.FieldResourceType))
+}
+
+// WhereResourceID applies the entql string predicate on the resource_id field.
+func (f *OperationAuditLogFilter) WhereResourceID(p entql.StringP) {
+	f.Where(p.Field(operationauditlog.FieldResourceID))
+}
+
+// WhereAction applies the entql string predicate on the action field.
+func (f *OperationAuditLogFilter) WhereAction(p entql.StringP) {
+	f.Where(p.Field(operationauditlog.FieldAction))
+}
+
+// WhereBeforeData applies the entql string predicate on the before_data field.
+func (f *OperationAuditLogFilter) WhereBeforeData(p entql.StringP) {
+	f.Where(p.Field(operationauditlog.FieldBeforeData))
+}
+
+// WhereAfterData applies the entql string predicate on the after_data field.
+func (f *OperationAuditLogFilter) WhereAfterData(p entql.StringP) {
+	f.Where(p.Field(operationauditlog.FieldAfterData))
+}
+
+// WhereSensitiveLevel applies the entql string predicate on the sensitive_level field.
+func (f *OperationAuditLogFilter) WhereSensitiveLevel(p entql.StringP) {
+	f.Where(p.Field(operationauditlog.FieldSensitiveLevel))
+}
+
+// WhereRequestID applies the entql string predicate on the request_id field.
+func (f *OperationAuditLogFilter) WhereRequestID(p entql.StringP) {
+	f.Where(p.Field(operationauditlog.FieldRequestID))
+}
+
+// WhereTraceID applies the entql string predicate on the trace_id field.
+func (f *OperationAuditLogFilter) WhereTraceID(p entql.StringP) {
+	f.Where(p.Field(operationauditlog.FieldTraceID))
+}
+
+// WhereSuccess applies the entql bool predicate on the success field.
+func (f *OperationAuditLogFilter) WhereSuccess(p entql.BoolP) {
+	f.Where(p.Field(operationauditlog.FieldSuccess))
+}
+
+// WhereFailureReason applies the entql string predicate on the failure_reason field.
+func (f *OperationAuditLogFilter) WhereFailureReason(p entql.StringP) {
+	f.Where(p.Field(operationauditlog.FieldFailureReason))
+}
+
+// WhereIPAddress applies the entql string predicate on the ip_address field.
+func (f *OperationAuditLogFilter) WhereIPAddress(p entql.StringP) {
+	f.Where(p.Field(operationauditlog.FieldIPAddress))
+}
+
+// WhereGeoLocation applies the entql json.RawMessage predicate on the geo_location field.
+func (f *OperationAuditLogFilter) WhereGeoLocation(p entql.BytesP) {
+	f.Where(p.Field(operationauditlog.FieldGeoLocation))
+}
+
+// WhereDeviceInfo applies the entql json.RawMessage predicate on the device_info field.
+func (f *OperationAuditLogFilter) WhereDeviceInfo(p entql.BytesP) {
+	f.Where(p.Field(operationauditlog.FieldDeviceInfo))
+}
+
+// WhereLogHash applies the entql string predicate on the log_hash field.
+func (f *OperationAuditLogFilter) WhereLogHash(p entql.StringP) {
+	f.Where(p.Field(operationauditlog.FieldLogHash))
+}
+
+// WhereSignature applies the entql []byte predicate on the signature field.
+func (f *OperationAuditLogFilter) WhereSignature(p entql.BytesP) {
+	f.Where(p.Field(operationauditlog.FieldSignature))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *OrgUnitQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the OrgUnitQuery builder.
+func (_q *OrgUnitQuery) Filter() *OrgUnitFilter {
+	return &OrgUnitFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *OrgUnitMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the OrgUnitMutation builder.
+func (m *OrgUnitMutation) Filter() *OrgUnitFilter {
+	return &OrgUnitFilter{config: m.config, predicateAdder: m}
+}
+
+// OrgUnitFilter provides a generic filtering capability at runtime for OrgUnitQuery.
+type OrgUnitFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *OrgUnitFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[25].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *OrgUnitFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(orgunit.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *OrgUnitFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(orgunit.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *OrgUnitFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(orgunit.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *OrgUnitFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(orgunit.FieldDeletedAt))
+}
+
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *OrgUnitFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(orgunit.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *OrgUnitFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(orgunit.FieldUpdatedBy))
+}
+
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *OrgUnitFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(orgunit.FieldDeletedBy))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *OrgUnitFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(orgunit.FieldStatus))
+}
+
+// WhereSortOrder applies the entql uint32 predicate on the sort_order field.
+func (f *OrgUnitFilter) WhereSortOrder(p entql.Uint32P) {
+	f.Where(p.Field(orgunit.FieldSortOrder))
+}
+
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *OrgUnitFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(orgunit.FieldTenantID))
+}
+
+// WhereRemark applies the entql string predicate on the remark field.
+func (f *OrgUnitFilter) WhereRemark(p entql.StringP) {
+	f.Where(p.Field(orgunit.FieldRemark))
+}
+
+// WhereDescription applies the entql string predicate on the description field.
+func (f *OrgUnitFilter) WhereDescription(p entql.StringP) {
+	f.Where(p.Field(orgunit.FieldDescription))
+}
+
+// WhereParentID applies the entql uint32 predicate on the parent_id field.
+func (f *OrgUnitFilter) WhereParentID(p entql.Uint32P) {
+	f.Where(p.Field(orgunit.FieldParentID))
+}
+
+// WherePath applies the entql string predicate on the path field.
+func (f *OrgUnitFilter) WherePath(p entql.StringP) {
+	f.Where(p.Field(orgunit.FieldPath))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *OrgUnitFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(orgunit.FieldName))
+}
+
+// WhereCode applies the entql string predicate on the code field.
+func (f *OrgUnitFilter) WhereCode(p entql.StringP) {
+	f.Where(p.Field(orgunit.FieldCode))
+}
+
+// WhereLeaderID applies the entql uint32 predicate on the leader_id field.
+func (f *OrgUnitFilter) WhereLeaderID(p entql.Uint32P) {
+	f.Where(p.Field(orgunit.FieldLeaderID))
+}
+
+// WhereType applies the entql string predicate on the type field.
+func (f *OrgUnitFilter) WhereType(p entql.StringP) {
+	f.Where(p.Field(orgunit.FieldType))
+}
+
+// WhereBusinessScopes applies the entql json.RawMessage predicate on the business_scopes field.
+func (f *OrgUnitFilter) WhereBusinessScopes(p entql.BytesP) {
+	f.Where(p.Field(orgunit.FieldBusinessScopes))
+}
+
+// WhereExternalID applies the entql string predicate on the external_id field.
+func (f *OrgUnitFilter) WhereExternalID(p entql.StringP) {
+	f.Where(p.Field(orgunit.FieldExternalID))
+}
+
+// WhereIsLegalEntity applies the entql bool predicate on the is_legal_entity field.
+func (f *OrgUnitFilter) WhereIsLegalEntity(p entql.BoolP) {
+	f.Where(p.Field(orgunit.FieldIsLegalEntity))
+}
+
+// WhereRegistrationNumber applies the entql string predicate on the registration_number field.
+func (f *OrgUnitFilter) WhereRegistrationNumber(p entql.StringP) {
+	f.Where(p.Field(orgunit.FieldRegistrationNumber))
+}
+
+// WhereTaxID applies the entql string predicate on the tax_id field.
+func (f *OrgUnitFilter) WhereTaxID(p entql.StringP) {
+	f.Where(p.Field(orgunit.FieldTaxID))
+}
+
+// WhereLegalEntityOrgID applies the entql uint32 predicate on the legal_entity_org_id field.
+func (f *OrgUnitFilter) WhereLegalEntityOrgID(p entql.Uint32P) {
+	f.Where(p.Field(orgunit.FieldLegalEntityOrgID))
+}
+
+// WhereAddress applies the entql string predicate on the address field.
+func (f *OrgUnitFilter) WhereAddress(p entql.StringP) {
+	f.Where(p.Field(orgunit.FieldAddress))
+}
+
+// WherePhone applies the entql string predicate on the phone field.
+func (f *OrgUnitFilter) WherePhone(p entql.StringP) {
+	f.Where(p.Field(orgunit.FieldPhone))
+}
+
+// WhereEmail applies the entql string predicate on the email field.
+func (f *OrgUnitFilter) WhereEmail(p entql.StringP) {
+	f.Where(p.Field(orgunit.FieldEmail))
+}
+
+// WhereTimezone applies the entql string predicate on the timezone field.
+func (f *OrgUnitFilter) WhereTimezone(p entql.StringP) {
+	f.Where(p.Field(orgunit.FieldTimezone))
+}
+
+// WhereCountry applies the entql string predicate on the country field.
+func (f *OrgUnitFilter) WhereCountry(p entql.StringP) {
+	f.Where(p.Field(orgunit.FieldCountry))
+}
+
+// WhereLatitude applies the entql float64 predicate on the latitude field.
+func (f *OrgUnitFilter) WhereLatitude(p entql.Float64P) {
+	f.Where(p.Field(orgunit.FieldLatitude))
+}
+
+// WhereLongitude applies the entql float64 predicate on the longitude field.
+func (f *OrgUnitFilter) WhereLongitude(p entql.Float64P) {
+	f.Where(p.Field(orgunit.FieldLongitude))
+}
+
+// WhereStartAt applies the entql time.Time predicate on the start_at field.
+func (f *OrgUnitFilter) WhereStartAt(p entql.TimeP) {
+	f.Where(p.Field(orgunit.FieldStartAt))
+}
+
+// WhereEndAt applies the entql time.Time predicate on the end_at field.
+func (f *OrgUnitFilter) WhereEndAt(p entql.TimeP) {
+	f.Where(p.Field(orgunit.FieldEndAt))
+}
+
+// WhereContactUserID applies the entql uint32 predicate on the contact_user_id field.
+func (f *OrgUnitFilter) WhereContactUserID(p entql.Uint32P) {
+	f.Where(p.Field(orgunit.FieldContactUserID))
+}
+
+// WherePermissionTags applies the entql json.RawMessage predicate on the permission_tags field.
+func (f *OrgUnitFilter) WherePermissionTags(p entql.BytesP) {
+	f.Where(p.Field(orgunit.FieldPermissionTags))
+}
+
+// WhereHasParent applies a predicate to check if query has an edge parent.
+func (f *OrgUnitFilter) WhereHasParent() {
+	f.Where(entql.HasEdge("parent"))
+}
+
+// WhereHasParentWith applies a predicate to check if query has an edge parent with a given conditions (other predicates).
+func (f *OrgUnitFilter) WhereHasParentWith(preds ...predicate.OrgUnit) {
+	f.Where(entql.HasEdgeWith("parent", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasChildren applies a predicate to check if query has an edge children.
+func (f *OrgUnitFilter) WhereHasChildren() {
+	f.Where(entql.HasEdge("children"))
+}
+
+// WhereHasChildrenWith applies a predicate to check if query has an edge children with a given conditions (other predicates).
+func (f *OrgUnitFilter) WhereHasChildrenWith(preds ...predicate.OrgUnit) {
+	f.Where(entql.HasEdgeWith("children", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *PermissionQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the PermissionQuery builder.
+func (_q *PermissionQuery) Filter() *PermissionFilter {
+	return &PermissionFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *PermissionMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the PermissionMutation builder.
+func (m *PermissionMutation) Filter() *PermissionFilter {
+	return &PermissionFilter{config: m.config, predicateAdder: m}
+}
+
+// PermissionFilter provides a generic filtering capability at runtime for PermissionQuery.
+type PermissionFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *PermissionFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[26].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *PermissionFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(permission.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *PermissionFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(permission.FieldCreatedAt))
 }
 
-// WhereMembershipID applies the entql uint32 predicate on the membership_id field.
-func (f *MembershipOrgUnitFilter) WhereMembershipID(p entql.Uint32P) {
-	f.Where(p.Field(membershiporgunit.FieldMembershipID))
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *PermissionFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(permission.FieldUpdatedAt))
 }
 
-// WhereOrgUnitID applies the entql uint32 predicate on the org_unit_id field.
-func (f *MembershipOrgUnitFilter) WhereOrgUnitID(p entql.Uint32P) {
-	f.Where(p.Field(membershiporgunit.FieldOrgUnitID))
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *PermissionFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(permission.FieldDeletedAt))
 }
 
-// WherePositionID applies the entql uint32 predicate on the position_id field.
-func (f *MembershipOrgUnitFilter) WherePositionID(p entql.Uint32P) {
-	f.Where(p.Field(membershiporgunit.FieldPositionID))
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *PermissionFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(permission.FieldCreatedBy))
 }
 
-// WhereRoleID applies the entql uint32 predicate on the role_id field.
-func (f *MembershipOrgUnitFilter) WhereRoleID(p entql.Uint32P) {
-	f.Where(p.Field(membershiporgunit.FieldRoleID))
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *PermissionFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(permission.FieldUpdatedBy))
 }
 
-// WhereStartAt applies the entql time.Time predicate on the start_at field.
-func (f *MembershipOrgUnitFilter) WhereStartAt(p entql.TimeP) {
-	f.Where(p.Field(membershiporgunit.FieldStartAt))
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *PermissionFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(permission.FieldDeletedBy))
 }
 
-// WhereEndAt applies the entql time.Time predicate on the end_at field.
-func (f *MembershipOrgUnitFilter) WhereEndAt(p entql.TimeP) {
-	f.Where(p.Field(membershiporgunit.FieldEndAt))
+// WhereStatus applies the entql string predicate on the status field.
+func (f *PermissionFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(permission.FieldStatus))
 }
 
-// WhereAssignedAt applies the entql time.Time predicate on the assigned_at field.
-func (f *MembershipOrgUnitFilter) WhereAssignedAt(p entql.TimeP) {
-	f.Where(p.Field(membershiporgunit.FieldAssignedAt))
+// WhereDescription applies the entql string predicate on the description field.
+func (f *PermissionFilter) WhereDescription(p entql.StringP) {
+	f.Where(p.Field(permission.FieldDescription))
 }
 
-// WhereAssignedBy applies the entql uint32 predicate on the assigned_by field.
-func (f *MembershipOrgUnitFilter) WhereAssignedBy(p entql.Uint32P) {
-	f.Where(p.Field(membershiporgunit.FieldAssignedBy))
+// WhereName applies the entql string predicate on the name field.
+func (f *PermissionFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(permission.FieldName))
 }
 
-// WhereIsPrimary applies the entql bool predicate on the is_primary field.
-func (f *MembershipOrgUnitFilter) WhereIsPrimary(p entql.BoolP) {
-	f.Where(p.Field(membershiporgunit.FieldIsPrimary))
+// WhereCode applies the entql string predicate on the code field.
+func (f *PermissionFilter) WhereCode(p entql.StringP) {
+	f.Where(p.Field(permission.FieldCode))
 }
 
-// WhereStatus applies the entql string predicate on the status field.
-func (f *MembershipOrgUnitFilter) WhereStatus(p entql.StringP) {
-	f.Where(p.Field(membershiporgunit.FieldStatus))
+// WhereGroupID applies the entql uint32 predicate on the group_id field.
+func (f *PermissionFilter) WhereGroupID(p entql.Uint32P) {
+	f.Where(p.Field(permission.FieldGroupID))
 }
 
 // addPredicate implements the predicateAdder interface.
-func (_q *MembershipPositionQuery) addPredicate(pred func(s *sql.Selector)) {
+func (_q *PermissionApiQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the MembershipPositionQuery builder.
-func (_q *MembershipPositionQuery) Filter() *MembershipPositionFilter {
-	return &MembershipPositionFilter{config: _q.config, predicateAdder: _q}
+// Filter returns a Filter implementation to apply filters on the PermissionApiQuery builder.
+func (_q *PermissionApiQuery) Filter() *PermissionApiFilter {
+	return &PermissionApiFilter{config: _q.config, predicateAdder: _q}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *MembershipPositionMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *PermissionApiMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the MembershipPositionMutation builder.
-func (m *MembershipPositionMutation) Filter() *MembershipPositionFilter {
-	return &MembershipPositionFilter{config: m.config, predicateAdder: m}
+// Filter returns an entql.Where implementation to apply filters on the PermissionApiMutation builder.
+func (m *PermissionApiMutation) Filter() *PermissionApiFilter {
+	return &PermissionApiFilter{config: m.config, predicateAdder: m}
 }
 
-// MembershipPositionFilter provides a generic filtering capability at runtime for MembershipPositionQuery.
-type MembershipPositionFilter struct {
+// PermissionApiFilter provides a generic filtering capability at runtime for PermissionApiQuery.
+type PermissionApiFilter struct {
 	predicateAdder
 	config
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *MembershipPositionFilter) Where(p entql.P) {
+func (f *PermissionApiFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[15].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[27].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
 }
 
 // WhereID applies the entql uint32 predicate on the id field.
-func (f *MembershipPositionFilter) WhereID(p entql.Uint32P) {
-	f.Where(p.Field(membershipposition.FieldID))
+func (f *PermissionApiFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(permissionapi.FieldID))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *MembershipPositionFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(membershipposition.FieldCreatedAt))
+func (f *PermissionApiFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(permissionapi.FieldCreatedAt))
 }
 
 // WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
-func (f *MembershipPositionFilter) WhereUpdatedAt(p entql.TimeP) {
-	f.Where(p.Field(membershipposition.FieldUpdatedAt))
+func (f *PermissionApiFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(permissionapi.FieldUpdatedAt))
 }
 
 // WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
-func (f *MembershipPositionFilter) WhereDeletedAt(p entql.TimeP) {
-	f.Where(p.Field(membershipposition.FieldDeletedAt))
+func (f *PermissionApiFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(permissionapi.FieldDeletedAt))
 }
 
 // WhereCreatedBy applies the entql uint32 predicate on the created_by field.
-func (f *MembershipPositionFilter) WhereCreatedBy(p entql.Uint32P) {
-	f.Where(p.Field(membershipposition.FieldCreatedBy))
+func (f *PermissionApiFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(permissionapi.FieldCreatedBy))
 }
 
 // WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
-func (f *MembershipPositionFilter) WhereUpdatedBy(p entql.Uint32P) {
-	f.Where(p.Field(membershipposition.FieldUpdatedBy))
+func (f *PermissionApiFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(permissionapi.FieldUpdatedBy))
 }
 
 // WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
-func (f *MembershipPositionFilter) WhereDeletedBy(p entql.Uint32P) {
-	f.Where(p.Field(membershipposition.FieldDeletedBy))
-}
-
-// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
-func (f *MembershipPositionFilter) WhereTenantID(p entql.Uint32P) {
-	f.Where(p.Field(membershipposition.FieldTenantID))
-}
-
-// WhereRemark applies the entql string predicate on the remark field.
-func (f *MembershipPositionFilter) WhereRemark(p entql.StringP) {
-	f.Where(p.Field(membershipposition.FieldRemark))
-}
-
-// WhereMembershipID applies the entql uint32 predicate on the membership_id field.
-func (f *MembershipPositionFilter) WhereMembershipID(p entql.Uint32P) {
-	f.Where(p.Field(membershipposition.FieldMembershipID))
-}
-
-// WherePositionID applies the entql uint32 predicate on the position_id field.
-func (f *MembershipPositionFilter) WherePositionID(p entql.Uint32P) {
-	f.Where(p.Field(membershipposition.FieldPositionID))
-}
-
-// WhereIsPrimary applies the entql bool predicate on the is_primary field.
-func (f *MembershipPositionFilter) WhereIsPrimary(p entql.BoolP) {
-	f.Where(p.Field(membershipposition.FieldIsPrimary))
-}
-
-// WhereStartAt applies the entql time.Time predicate on the start_at field.
-func (f *MembershipPositionFilter) WhereStartAt(p entql.TimeP) {
-	f.Where(p.Field(membershipposition.FieldStartAt))
-}
-
-// WhereEndAt applies the entql time.Time predicate on the end_at field.
-func (f *MembershipPositionFilter) WhereEndAt(p entql.TimeP) {
-	f.Where(p.Field(membershipposition.FieldEndAt))
-}
-
-// WhereAssignedAt applies the entql time.Time predicate on the assigned_at field.
-func (f *MembershipPositionFilter) WhereAssignedAt(p entql.TimeP) {
-	f.Where(p.Field(membershipposition.FieldAssignedAt))
+func (f *PermissionApiFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(permissionapi.FieldDeletedBy))
 }
 
-// WhereAssignedBy applies the entql uint32 predicate on the assigned_by field.
-func (f *MembershipPositionFilter) WhereAssignedBy(p entql.Uint32P) {
-	f.Where(p.Field(membershipposition.FieldAssignedBy))
+// WherePermissionID applies the entql uint32 predicate on the permission_id field.
+func (f *PermissionApiFilter) WherePermissionID(p entql.Uint32P) {
+	f.Where(p.Field(permissionapi.FieldPermissionID))
 }
 
-// WhereStatus applies the entql string predicate on the status field.
-func (f *MembershipPositionFilter) WhereStatus(p entql.StringP) {
-	f.Where(p.Field(membershipposition.FieldStatus))
+// WhereAPIID applies the entql uint32 predicate on the api_id field.
+func (f *PermissionApiFilter) WhereAPIID(p entql.Uint32P) {
+	f.Where(p.Field(permissionapi.FieldAPIID))
 }
 
 // addPredicate implements the predicateAdder interface.
-func (_q *MembershipRoleQuery) addPredicate(pred func(s *sql.Selector)) {
+func (_q *PermissionAuditLogQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the MembershipRoleQuery builder.
-func (_q *MembershipRoleQuery) Filter() *MembershipRoleFilter {
-	return &MembershipRoleFilter{config: _q.config, predicateAdder: _q}
+// Filter returns a Filter implementation to apply filters on the PermissionAuditLogQuery builder.
+func (_q *PermissionAuditLogQuery) Filter() *PermissionAuditLogFilter {
+	return &PermissionAuditLogFilter{config: _q.config, predicateAdder: _q}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *MembershipRoleMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *PermissionAuditLogMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the MembershipRoleMutation builder.
-func (m *MembershipRoleMutation) Filter() *MembershipRoleFilter {
-	return &MembershipRoleFilter{config: m.config, predicateAdder: m}
+// Filter returns an entql.Where implementation to apply filters on the PermissionAuditLogMutation builder.
+func (m *PermissionAuditLogMutation) Filter() *PermissionAuditLogFilter {
+	return &PermissionAuditLogFilter{config: m.config, predicateAdder: m}
 }
 
-// MembershipRoleFilter provides a generic filtering capability at runtime for MembershipRoleQuery.
-type MembershipRoleFilter struct {
+// PermissionAuditLogFilter provides a generic filtering capability at runtime for PermissionAuditLogQuery.
+type PermissionAuditLogFilter struct {
 	predicateAdder
 	config
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *MembershipRoleFilter) Where(p entql.P) {
+func (f *PermissionAuditLogFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[16].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[28].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
 }
 
 // WhereID applies the entql uint32 predicate on the id field.
-func (f *MembershipRoleFilter) WhereID(p entql.Uint32P) {
-	f.Where(p.Field(membershiprole.FieldID))
+func (f *PermissionAuditLogFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(permissionauditlog.FieldID))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *MembershipRoleFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(membershiprole.FieldCreatedAt))
-}
-
-// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
-func (f *MembershipRoleFilter) WhereUpdatedAt(p entql.TimeP) {
-	f.Where(p.Field(membershiprole.FieldUpdatedAt))
-}
-
-// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
-func (f *MembershipRoleFilter) WhereDeletedAt(p entql.TimeP) {
-	f.Where(p.Field(membershiprole.FieldDeletedAt))
+func (f *PermissionAuditLogFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(permissionauditlog.FieldCreatedAt))
 }
 
-// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
-func (f *MembershipRoleFilter) WhereCreatedBy(p entql.Uint32P) {
-	f.Where(p.Field(membershiprole.FieldCreatedBy))
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *PermissionAuditLogFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(permissionauditlog.FieldTenantID))
 }
 
-// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
-func (f *MembershipRoleFilter) WhereUpdatedBy(p entql.Uint32P) {
-	f.Where(p.Field(membershiprole.FieldUpdatedBy))
+// WhereOperatorID applies the entql uint32 predicate on the operator_id field.
+func (f *PermissionAuditLogFilter) WhereOperatorID(p entql.Uint32P) {
+	f.Where(p.Field(permissionauditlog.FieldOperatorID))
 }
 
-// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
-func (f *MembershipRoleFilter) WhereDeletedBy(p entql.Uint32P) {
-	f.Where(p.Field(membershiprole.FieldDeletedBy))
+// WhereTargetType applies the entql string predicate on the target_type field.
+func (f *PermissionAuditLogFilter) WhereTargetType(p entql.StringP) {
+	f.Where(p.Field(permissionauditlog.FieldTargetType))
 }
 
-// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
-func (f *MembershipRoleFilter) WhereTenantID(p entql.Uint32P) {
-	f.Where(p.Field(membershiprole.FieldTenantID))
+// WhereTargetID applies the entql string predicate on the target_id field.
+func (f *PermissionAuditLogFilter) WhereTargetID(p entql.StringP) {
+	f.Where(p.Field(permissionauditlog.FieldTargetID))
 }
 
-// WhereMembershipID applies the entql uint32 predicate on the membership_id field.
-func (f *MembershipRoleFilter) WhereMembershipID(p entql.Uint32P) {
-	f.Where(p.Field(membershiprole.FieldMembershipID))
+// WhereAction applies the entql string predicate on the action field.
+func (f *PermissionAuditLogFilter) WhereAction(p entql.StringP) {
+	f.Where(p.Field(permissionauditlog.FieldAction))
 }
 
-// WhereRoleID applies the entql uint32 predicate on the role_id field.
-func (f *MembershipRoleFilter) WhereRoleID(p entql.Uint32P) {
-	f.Where(p.Field(membershiprole.FieldRoleID))
+// WhereOldValue applies the entql string predicate on the old_value field.
+func (f *PermissionAuditLogFilter) WhereOldValue(p entql.StringP) {
+	f.Where(p.Field(permissionauditlog.FieldOldValue))
 }
 
-// WhereStartAt applies the entql time.Time predicate on the start_at field.
-func (f *MembershipRoleFilter) WhereStartAt(p entql.TimeP) {
-	f.Where(p.Field(membershiprole.FieldStartAt))
+// WhereNewValue applies the entql string predicate on the new_value field.
+func (f *PermissionAuditLogFilter) WhereNewValue(p entql.StringP) {
+	f.Where(p.Field(permissionauditlog.FieldNewValue))
 }
-
-// WhereEndAt applies the entql time.Time predicate on the end_at field.
-func (f *MembershipRoleFilter) WhereEndAt(p entql.TimeP) {
-	f.Where(p.Field(membershiprole.FieldEndAt))
+
+// WhereIPAddress applies the entql string predicate on the ip_address field.
+func (f *PermissionAuditLogFilter) WhereIPAddress(p entql.StringP) {
+	f.Where(p.Field(permissionauditlog.FieldIPAddress))
 }
 
-// WhereAssignedAt applies the entql time.Time predicate on the assigned_at field.
-func (f *MembershipRoleFilter) WhereAssignedAt(p entql.TimeP) {
-	f.Where(p.Field(membershiprole.FieldAssignedAt))
+// WhereRequestID applies the entql string predicate on the request_id field.
+func (f *PermissionAuditLogFilter) WhereRequestID(p entql.StringP) {
+	f.Where(p.Field(permissionauditlog.FieldRequestID))
 }
 
-// WhereAssignedBy applies the entql uint32 predicate on the assigned_by field.
-func (f *MembershipRoleFilter) WhereAssignedBy(p entql.Uint32P) {
-	f.Where(p.Field(membershiprole.FieldAssignedBy))
+// WhereReason applies the entql string predicate on the reason field.
+func (f *PermissionAuditLogFilter) WhereReason(p entql.StringP) {
+	f.Where(p.Field(permissionauditlog.FieldReason))
 }
 
-// WhereIsPrimary applies the entql bool predicate on the is_primary field.
-func (f *MembershipRoleFilter) WhereIsPrimary(p entql.BoolP) {
-	f.Where(p.Field(membershiprole.FieldIsPrimary))
+// WhereLogHash applies the entql string predicate on the log_hash field.
+func (f *PermissionAuditLogFilter) WhereLogHash(p entql.StringP) {
+	f.Where(p.Field(permissionauditlog.FieldLogHash))
 }
 
-// WhereStatus applies the entql string predicate on the status field.
-func (f *MembershipRoleFilter) WhereStatus(p entql.StringP) {
-	f.Where(p.Field(membershiprole.FieldStatus))
+// WhereSignature applies the entql []byte predicate on the signature field.
+func (f *PermissionAuditLogFilter) WhereSignature(p entql.BytesP) {
+	f.Where(p.Field(permissionauditlog.FieldSignature))
 }
 
 // addPredicate implements the predicateAdder interface.
-func (_q *MenuQuery) addPredicate(pred func(s *sql.Selector)) {
+func (_q *PermissionGroupQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the MenuQuery builder.
-func (_q *MenuQuery) Filter() *MenuFilter {
-	return &MenuFilter{config: _q.config, predicateAdder: _q}
+// Filter returns a Filter implementation to apply filters on the PermissionGroupQuery builder.
+func (_q *PermissionGroupQuery) Filter() *PermissionGroupFilter {
+	return &PermissionGroupFilter{config: _q.config, predicateAdder: _q}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *MenuMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *PermissionGroupMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the MenuMutation builder.
-func (m *MenuMutation) Filter() *MenuFilter {
-	return &MenuFilter{config: m.config, predicateAdder: m}
+// Filter returns an entql.Where implementation to apply filters on the PermissionGroupMutation builder.
+func (m *PermissionGroupMutation) Filter() *PermissionGroupFilter {
+	return &PermissionGroupFilter{config: m.config, predicateAdder: m}
 }
 
-// MenuFilter provides a generic filtering capability at runtime for MenuQuery.
-type MenuFilter struct {
+// PermissionGroupFilter provides a generic filtering capability at runtime for PermissionGroupQuery.
+type PermissionGroupFilter struct {
 	predicateAdder
 	config
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *MenuFilter) Where(p entql.P) {
+func (f *PermissionGroupFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[17].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[29].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
 }
 
 // WhereID applies the entql uint32 predicate on the id field.
-func (f *MenuFilter) WhereID(p entql.Uint32P) {
-	f.Where(p.Field(menu.FieldID))
+func (f *PermissionGroupFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(permissiongroup.FieldID))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *MenuFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(menu.FieldCreatedAt))
+func (f *PermissionGroupFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(permissiongroup.FieldCreatedAt))
 }
 
 // WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
-func (f *MenuFilter) WhereUpdatedAt(p entql.TimeP) {
-	f.Where(p.Field(menu.FieldUpdatedAt))
+func (f *PermissionGroupFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(permissiongroup.FieldUpdatedAt))
 }
 
 // WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
-func (f *MenuFilter) WhereDeletedAt(p entql.TimeP) {
-	f.Where(p.Field(menu.FieldDeletedAt))
+func (f *PermissionGroupFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(permissiongroup.FieldDeletedAt))
 }
 
 // WhereCreatedBy applies the entql uint32 predicate on the created_by field.
-func (f *MenuFilter) WhereCreatedBy(p entql.Uint32P) {
-	f.Where(p.Field(menu.FieldCreatedBy))
+func (f *PermissionGroupFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(permissiongroup.FieldCreatedBy))
 }
 
 // WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
-func (f *MenuFilter) WhereUpdatedBy(p entql.Uint32P) {
-	f.Where(p.Field(menu.FieldUpdatedBy))
+func (f *PermissionGroupFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(permissiongroup.FieldUpdatedBy))
 }
 
 // WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
-func (f *MenuFilter) WhereDeletedBy(p entql.Uint32P) {
-	f.Where(p.Field(menu.FieldDeletedBy))
-}
-
-// WhereParentID applies the entql uint32 predicate on the parent_id field.
-func (f *MenuFilter) WhereParentID(p entql.Uint32P) {
-	f.Where(p.Field(menu.FieldParentID))
+func (f *PermissionGroupFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(permissiongroup.FieldDeletedBy))
 }
 
-// WhereRemark applies the entql string predicate on the remark field.
-func (f *MenuFilter) WhereRemark(p entql.StringP) {
-	f.Where(p.Field(menu.FieldRemark))
+// WhereDescription applies the entql string predicate on the description field.
+func (f *PermissionGroupFilter) WhereDescription(p entql.StringP) {
+	f.Where(p.Field(permissiongroup.FieldDescription))
 }
 
 // WhereStatus applies the entql string predicate on the status field.
-func (f *MenuFilter) WhereStatus(p entql.StringP) {
-	f.Where(p.Field(menu.FieldStatus))
-}
-
-// WhereType applies the entql string predicate on the type field.
-func (f *MenuFilter) WhereType(p entql.StringP) {
-	f.Where(p.Field(menu.FieldType))
+func (f *PermissionGroupFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(permissiongroup.FieldStatus))
 }
 
-// WherePath applies the entql string predicate on the path field.
-func (f *MenuFilter) WherePath(p entql.StringP) {
-	f.Where(p.Field(menu.FieldPath))
+// WhereSortOrder applies the entql uint32 predicate on the sort_order field.
+func (f *PermissionGroupFilter) WhereSortOrder(p entql.Uint32P) {
+	f.Where(p.Field(permissiongroup.FieldSortOrder))
 }
 
-// WhereRedirect applies the entql string predicate on the redirect field.
-func (f *MenuFilter) WhereRedirect(p entql.StringP) {
-	f.Where(p.Field(menu.FieldRedirect))
+// WhereParentID applies the entql uint32 predicate on the parent_id field.
+func (f *PermissionGroupFilter) WhereParentID(p entql.Uint32P) {
+	f.Where(p.Field(permissiongroup.FieldParentID))
 }
 
-// WhereAlias applies the entql string predicate on the alias field.
-func (f *MenuFilter) WhereAlias(p entql.StringP) {
-	f.Where(p.Field(menu.FieldAlias))
+// WherePath applies the entql string predicate on the path field.
+func (f *PermissionGroupFilter) WherePath(p entql.StringP) {
+	f.Where(p.Field(permissiongroup.FieldPath))
 }
 
 // WhereName applies the entql string predicate on the name field.
-func (f *MenuFilter) WhereName(p entql.StringP) {
-	f.Where(p.Field(menu.FieldName))
-}
-
-// WhereComponent applies the entql string predicate on the component field.
-func (f *MenuFilter) WhereComponent(p entql.StringP) {
-	f.Where(p.Field(menu.FieldComponent))
+func (f *PermissionGroupFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(permissiongroup.FieldName))
 }
 
-// WhereMeta applies the entql json.RawMessage predicate on the meta field.
-func (f *MenuFilter) WhereMeta(p entql.BytesP) {
-	f.Where(p.Field(menu.FieldMeta))
+// WhereModule applies the entql string predicate on the module field.
+func (f *PermissionGroupFilter) WhereModule(p entql.StringP) {
+	f.Where(p.Field(permissiongroup.FieldModule))
 }
 
 // WhereHasParent applies a predicate to check if query has an edge parent.
-func (f *MenuFilter) WhereHasParent() {
+func (f *PermissionGroupFilter) WhereHasParent() {
 	f.Where(entql.HasEdge("parent"))
 }
 
 // WhereHasParentWith applies a predicate to check if query has an edge parent with a given conditions (other predicates).
-func (f *MenuFilter) WhereHasParentWith(preds ...predicate.Menu) {
+func (f *PermissionGroupFilter) WhereHasParentWith(preds ...predicate.PermissionGroup) {
 	f.Where(entql.HasEdgeWith("parent", sqlgraph.WrapFunc(func(s *sql.Selector) {
 		for _, p := range preds {
 			p(s)
@@ -3484,12 +5426,12 @@ func (f *MenuFilter) WhereHasParentWith(preds ...predicate.Menu) {
 }
 
 // WhereHasChildren applies a predicate to check if query has an edge children.
-func (f *MenuFilter) WhereHasChildren() {
+func (f *PermissionGroupFilter) WhereHasChildren() {
 	f.Where(entql.HasEdge("children"))
 }
 
 // WhereHasChildrenWith applies a predicate to check if query has an edge children with a given conditions (other predicates).
-func (f *MenuFilter) WhereHasChildrenWith(preds ...predicate.Menu) {
+func (f *PermissionGroupFilter) WhereHasChildrenWith(preds ...predicate.PermissionGroup) {
 	f.Where(entql.HasEdgeWith("children", sqlgraph.WrapFunc(func(s *sql.Selector) {
 		for _, p := range preds {
 			p(s)
@@ -3498,1694 +5440,1538 @@ func (f *MenuFilter) WhereHasChildrenWith(preds ...predicate.Menu) {
 }
 
 // addPredicate implements the predicateAdder interface.
-func (_q *OperationAuditLogQuery) addPredicate(pred func(s *sql.Selector)) {
+func (_q *PermissionMenuQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the OperationAuditLogQuery builder.
-func (_q *OperationAuditLogQuery) Filter() *OperationAuditLogFilter {
-	return &OperationAuditLogFilter{config: _q.config, predicateAdder: _q}
+// Filter returns a Filter implementation to apply filters on the PermissionMenuQuery builder.
+func (_q *PermissionMenuQuery) Filter() *PermissionMenuFilter {
+	return &PermissionMenuFilter{config: _q.config, predicateAdder: _q}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *OperationAuditLogMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *PermissionMenuMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the OperationAuditLogMutation builder.
-func (m *OperationAuditLogMutation) Filter() *OperationAuditLogFilter {
-	return &OperationAuditLogFilter{config: m.config, predicateAdder: m}
+// Filter returns an entql.Where implementation to apply filters on the PermissionMenuMutation builder.
+func (m *PermissionMenuMutation) Filter() *PermissionMenuFilter {
+	return &PermissionMenuFilter{config: m.config, predicateAdder: m}
 }
 
-// OperationAuditLogFilter provides a generic filtering capability at runtime for OperationAuditLogQuery.
-type OperationAuditLogFilter struct {
+// PermissionMenuFilter provides a generic filtering capability at runtime for PermissionMenuQuery.
+type PermissionMenuFilter struct {
 	predicateAdder
 	config
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *OperationAuditLogFilter) Where(p entql.P) {
+func (f *PermissionMenuFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[18].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[30].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
 }
 
 // WhereID applies the entql uint32 predicate on the id field.
-func (f *OperationAuditLogFilter) WhereID(p entql.Uint32P) {
-	f.Where(p.Field(operationauditlog.FieldID))
+func (f *PermissionMenuFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(permissionmenu.FieldID))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *OperationAuditLogFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(operationauditlog.FieldCreatedAt))
-}
-
-// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
-func (f *OperationAuditLogFilter) WhereTenantID(p entql.Uint32P) {
-	f.Where(p.Field(operationauditlog.FieldTenantID))
-}
-
-// WhereUserID applies the entql uint32 predicate on the user_id field.
-func (f *OperationAuditLogFilter) WhereUserID(p entql.Uint32P) {
-	f.Where(p.Field(operationauditlog.FieldUserID))
-}
-
-// WhereUsername applies the entql string predicate on the username field.
-func (f *OperationAuditLogFilter) WhereUsername(p entql.StringP) {
-	f.Where(p.Field(operationauditlog.FieldUsername))
-}
-
-// WhereResourceType applies the entql string predicate on the resource_type field.
-func (f *OperationAuditLogFilter) WhereResourceType(p entql.StringP) {
-	f.Where(p.Field(operationauditlog.FieldResourceType))
-}
-
-// WhereResourceID applies the entql string predicate on the resource_id field.
-func (f *OperationAuditLogFilter) WhereResourceID(p entql.StringP) {
-	f.Where(p.Field(operationauditlog.FieldResourceID))
-}
-
-// WhereAction applies the entql string predicate on the action field.
-func (f *OperationAuditLogFilter) WhereAction(p entql.StringP) {
-	f.Where(p.Field(operationauditlog.FieldAction))
-}
-
-// WhereBeforeData applies the entql string predicate on the before_data field.
-func (f *OperationAuditLogFilter) WhereBeforeData(p entql.StringP) {
-	f.Where(p.Field(operationauditlog.FieldBeforeData))
-}
-
-// WhereAfterData applies the entql string predicate on the after_data field.
-func (f *OperationAuditLogFilter) WhereAfterData(p entql.StringP) {
-	f.Where(p.Field(operationauditlog.FieldAfterData))
-}
-
-// WhereSensitiveLevel applies the entql string predicate on the sensitive_level field.
-func (f *OperationAuditLogFilter) WhereSensitiveLevel(p entql.StringP) {
-	f.Where(p.Field(operationauditlog.FieldSensitiveLevel))
-}
-
-// WhereRequestID applies the entql string predicate on the request_id field.
-func (f *OperationAuditLogFilter) WhereRequestID(p entql.StringP) {
-	f.Where(p.Field(operationauditlog.FieldRequestID))
-}
-
-// WhereTraceID applies the entql string predicate on the trace_id field.
-func (f *OperationAuditLogFilter) WhereTraceID(p entql.StringP) {
-	f.Where(p.Field(operationauditlog.FieldTraceID))
+func (f *PermissionMenuFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(permissionmenu.FieldCreatedAt))
 }
 
-// WhereSuccess applies the entql bool predicate on the success field.
-func (f *OperationAuditLogFilter) WhereSuccess(p entql.BoolP) {
-	f.Where(p.Field(operationauditlog.FieldSuccess))
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *PermissionMenuFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(permissionmenu.FieldUpdatedAt))
 }
 
-// WhereFailureReason applies the entql string predicate on the failure_reason field.
-func (f *OperationAuditLogFilter) WhereFailureReason(p entql.StringP) {
-	f.Where(p.Field(operationauditlog.FieldFailureReason))
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *PermissionMenuFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(permissionmenu.FieldDeletedAt))
 }
 
-// WhereIPAddress applies the entql string predicate on the ip_address field.
-func (f *OperationAuditLogFilter) WhereIPAddress(p entql.StringP) {
-	f.Where(p.Field(operationauditlog.FieldIPAddress))
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *PermissionMenuFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(permissionmenu.FieldCreatedBy))
 }
 
-// WhereGeoLocation applies the entql json.RawMessage predicate on the geo_location field.
-func (f *OperationAuditLogFilter) WhereGeoLocation(p entql.BytesP) {
-	f.Where(p.Field(operationauditlog.FieldGeoLocation))
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *PermissionMenuFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(permissionmenu.FieldUpdatedBy))
 }
 
-// WhereDeviceInfo applies the entql json.RawMessage predicate on the device_info field.
-func (f *OperationAuditLogFilter) WhereDeviceInfo(p entql.BytesP) {
-	f.Where(p.Field(operationauditlog.FieldDeviceInfo))
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *PermissionMenuFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(permissionmenu.FieldDeletedBy))
 }
 
-// WhereLogHash applies the entql string predicate on the log_hash field.
-func (f *OperationAuditLogFilter) WhereLogHash(p entql.StringP) {
-	f.Where(p.Field(operationauditlog.FieldLogHash))
+// WherePermissionID applies the entql uint32 predicate on the permission_id field.
+func (f *PermissionMenuFilter) WherePermissionID(p entql.Uint32P) {
+	f.Where(p.Field(permissionmenu.FieldPermissionID))
 }
 
-// WhereSignature applies the entql []byte predicate on the signature field.
-func (f *OperationAuditLogFilter) WhereSignature(p entql.BytesP) {
-	f.Where(p.Field(operationauditlog.FieldSignature))
+// WhereMenuID applies the entql uint32 predicate on the menu_id field.
+func (f *PermissionMenuFilter) WhereMenuID(p entql.Uint32P) {
+	f.Where(p.Field(permissionmenu.FieldMenuID))
 }
 
 // addPredicate implements the predicateAdder interface.
-func (_q *OrgUnitQuery) addPredicate(pred func(s *sql.Selector)) {
+func (_q *PermissionPolicyQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the OrgUnitQuery builder.
-func (_q *OrgUnitQuery) Filter() *OrgUnitFilter {
-	return &OrgUnitFilter{config: _q.config, predicateAdder: _q}
+// Filter returns a Filter implementation to apply filters on the PermissionPolicyQuery builder.
+func (_q *PermissionPolicyQuery) Filter() *PermissionPolicyFilter {
+	return &PermissionPolicyFilter{config: _q.config, predicateAdder: _q}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *OrgUnitMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *PermissionPolicyMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the OrgUnitMutation builder.
-func (m *OrgUnitMutation) Filter() *OrgUnitFilter {
-	return &OrgUnitFilter{config: m.config, predicateAdder: m}
+// Filter returns an entql.Where implementation to apply filters on the PermissionPolicyMutation builder.
+func (m *PermissionPolicyMutation) Filter() *PermissionPolicyFilter {
+	return &PermissionPolicyFilter{config: m.config, predicateAdder: m}
 }
 
-// OrgUnitFilter provides a generic filtering capability at runtime for OrgUnitQuery.
-type OrgUnitFilter struct {
+// PermissionPolicyFilter provides a generic filtering capability at runtime for PermissionPolicyQuery.
+type PermissionPolicyFilter struct {
 	predicateAdder
 	config
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *OrgUnitFilter) Where(p entql.P) {
+func (f *PermissionPolicyFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[19].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[31].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
 }
 
 // WhereID applies the entql uint32 predicate on the id field.
-func (f *OrgUnitFilter) WhereID(p entql.Uint32P) {
-	f.Where(p.Field(orgunit.FieldID))
+func (f *PermissionPolicyFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(permissionpolicy.FieldID))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *OrgUnitFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(orgunit.FieldCreatedAt))
+func (f *PermissionPolicyFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(permissionpolicy.FieldCreatedAt))
 }
 
 // WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
-func (f *OrgUnitFilter) WhereUpdatedAt(p entql.TimeP) {
-	f.Where(p.Field(orgunit.FieldUpdatedAt))
+func (f *PermissionPolicyFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(permissionpolicy.FieldUpdatedAt))
 }
 
 // WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
-func (f *OrgUnitFilter) WhereDeletedAt(p entql.TimeP) {
-	f.Where(p.Field(orgunit.FieldDeletedAt))
+func (f *PermissionPolicyFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(permissionpolicy.FieldDeletedAt))
 }
 
 // WhereCreatedBy applies the entql uint32 predicate on the created_by field.
-func (f *OrgUnitFilter) WhereCreatedBy(p entql.Uint32P) {
-	f.Where(p.Field(orgunit.FieldCreatedBy))
+func (f *PermissionPolicyFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(permissionpolicy.FieldCreatedBy))
 }
 
 // WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
-func (f *OrgUnitFilter) WhereUpdatedBy(p entql.Uint32P) {
-	f.Where(p.Field(orgunit.FieldUpdatedBy))
+func (f *PermissionPolicyFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(permissionpolicy.FieldUpdatedBy))
 }
 
 // WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
-func (f *OrgUnitFilter) WhereDeletedBy(p entql.Uint32P) {
-	f.Where(p.Field(orgunit.FieldDeletedBy))
+func (f *PermissionPolicyFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(permissionpolicy.FieldDeletedBy))
 }
 
 // WhereStatus applies the entql string predicate on the status field.
-func (f *OrgUnitFilter) WhereStatus(p entql.StringP) {
-	f.Where(p.Field(orgunit.FieldStatus))
-}
-
-// WhereSortOrder applies the entql uint32 predicate on the sort_order field.
-func (f *OrgUnitFilter) WhereSortOrder(p entql.Uint32P) {
-	f.Where(p.Field(orgunit.FieldSortOrder))
-}
-
-// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
-func (f *OrgUnitFilter) WhereTenantID(p entql.Uint32P) {
-	f.Where(p.Field(orgunit.FieldTenantID))
+func (f *PermissionPolicyFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(permissionpolicy.FieldStatus))
 }
 
-// WhereRemark applies the entql string predicate on the remark field.
-func (f *OrgUnitFilter) WhereRemark(p entql.StringP) {
-	f.Where(p.Field(orgunit.FieldRemark))
+// WherePermissionID applies the entql uint32 predicate on the permission_id field.
+func (f *PermissionPolicyFilter) WherePermissionID(p entql.Uint32P) {
+	f.Where(p.Field(permissionpolicy.FieldPermissionID))
 }
 
-// WhereDescription applies the entql string predicate on the description field.
-func (f *OrgUnitFilter) WhereDescription(p entql.StringP) {
-	f.Where(p.Field(orgunit.FieldDescription))
+// WherePolicyEngine applies the entql string predicate on the policy_engine field.
+func (f *PermissionPolicyFilter) WherePolicyEngine(p entql.StringP) {
+	f.Where(p.Field(permissionpolicy.FieldPolicyEngine))
 }
 
-// WhereParentID applies the entql uint32 predicate on the parent_id field.
-func (f *OrgUnitFilter) WhereParentID(p entql.Uint32P) {
-	f.Where(p.Field(orgunit.FieldParentID))
+// WhereDefinition applies the entql string predicate on the definition field.
+func (f *PermissionPolicyFilter) WhereDefinition(p entql.StringP) {
+	f.Where(p.Field(permissionpolicy.FieldDefinition))
 }
 
-// WherePath applies the entql string predicate on the path field.
-func (f *OrgUnitFilter) WherePath(p entql.StringP) {
-	f.Where(p.Field(orgunit.FieldPath))
+// WhereVersion applies the entql uint32 predicate on the version field.
+func (f *PermissionPolicyFilter) WhereVersion(p entql.Uint32P) {
+	f.Where(p.Field(permissionpolicy.FieldVersion))
 }
 
-// WhereName applies the entql string predicate on the name field.
-func (f *OrgUnitFilter) WhereName(p entql.StringP) {
-	f.Where(p.Field(orgunit.FieldName))
+// WhereEvalOrder applies the entql uint32 predicate on the eval_order field.
+func (f *PermissionPolicyFilter) WhereEvalOrder(p entql.Uint32P) {
+	f.Where(p.Field(permissionpolicy.FieldEvalOrder))
 }
 
-// WhereCode applies the entql string predicate on the code field.
-func (f *OrgUnitFilter) WhereCode(p entql.StringP) {
-	f.Where(p.Field(orgunit.FieldCode))
+// WhereCacheTTL applies the entql uint32 predicate on the cache_ttl field.
+func (f *PermissionPolicyFilter) WhereCacheTTL(p entql.Uint32P) {
+	f.Where(p.Field(permissionpolicy.FieldCacheTTL))
 }
 
-// WhereLeaderID applies the entql uint32 predicate on the leader_id field.
-func (f *OrgUnitFilter) WhereLeaderID(p entql.Uint32P) {
-	f.Where(p.Field(orgunit.FieldLeaderID))
+// addPredicate implements the predicateAdder interface.
+func (_q *PolicyEvaluationLogQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
 }
 
-// WhereType applies the entql string predicate on the type field.
-func (f *OrgUnitFilter) WhereType(p entql.StringP) {
-	f.Where(p.Field(orgunit.FieldType))
+// Filter returns a Filter implementation to apply filters on the PolicyEvaluationLogQuery builder.
+func (_q *PolicyEvaluationLogQuery) Filter() *PolicyEvaluationLogFilter {
+	return &PolicyEvaluationLogFilter{config: _q.config, predicateAdder: _q}
 }
 
-// WhereBusinessScopes applies the entql json.RawMessage predicate on the business_scopes field.
-func (f *OrgUnitFilter) WhereBusinessScopes(p entql.BytesP) {
-	f.Where(p.Field(orgunit.FieldBusinessScopes))
+// addPredicate implements the predicateAdder interface.
+func (m *PolicyEvaluationLogMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
 }
 
-// WhereExternalID applies the entql string predicate on the external_id field.
-func (f *OrgUnitFilter) WhereExternalID(p entql.StringP) {
-	f.Where(p.Field(orgunit.FieldExternalID))
+// Filter returns an entql.Where implementation to apply filters on the PolicyEvaluationLogMutation builder.
+func (m *PolicyEvaluationLogMutation) Filter() *PolicyEvaluationLogFilter {
+	return &PolicyEvaluationLogFilter{config: m.config, predicateAdder: m}
 }
 
-// WhereIsLegalEntity applies the entql bool predicate on the is_legal_entity field.
-func (f *OrgUnitFilter) WhereIsLegalEntity(p entql.BoolP) {
-	f.Where(p.Field(orgunit.FieldIsLegalEntity))
+// PolicyEvaluationLogFilter provides a generic filtering capability at runtime for PolicyEvaluationLogQuery.
+type PolicyEvaluationLogFilter struct {
+	predicateAdder
+	config
 }
 
-// WhereRegistrationNumber applies the entql string predicate on the registration_number field.
-func (f *OrgUnitFilter) WhereRegistrationNumber(p entql.StringP) {
-	f.Where(p.Field(orgunit.FieldRegistrationNumber))
+// Where applies the entql predicate on the query filter.
+func (f *PolicyEvaluationLogFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[32].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
 }
 
-// WhereTaxID applies the entql string predicate on the tax_id field.
-func (f *OrgUnitFilter) WhereTaxID(p entql.StringP) {
-	f.Where(p.Field(orgunit.FieldTaxID))
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *PolicyEvaluationLogFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(policyevaluationlog.FieldID))
 }
 
-// WhereLegalEntityOrgID applies the entql uint32 predicate on the legal_entity_org_id field.
-func (f *OrgUnitFilter) WhereLegalEntityOrgID(p entql.Uint32P) {
-	f.Where(p.Field(orgunit.FieldLegalEntityOrgID))
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *PolicyEvaluationLogFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(policyevaluationlog.FieldCreatedAt))
 }
 
-// WhereAddress applies the entql string predicate on the address field.
-func (f *OrgUnitFilter) WhereAddress(p entql.StringP) {
-	f.Where(p.Field(orgunit.FieldAddress))
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *PolicyEvaluationLogFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(policyevaluationlog.FieldTenantID))
 }
 
-// WherePhone applies the entql string predicate on the phone field.
-func (f *OrgUnitFilter) WherePhone(p entql.StringP) {
-	f.Where(p.Field(orgunit.FieldPhone))
+// WhereUserID applies the entql uint32 predicate on the user_id field.
+func (f *PolicyEvaluationLogFilter) WhereUserID(p entql.Uint32P) {
+	f.Where(p.Field(policyevaluationlog.FieldUserID))
 }
 
-// WhereEmail applies the entql string predicate on the email field.
-func (f *OrgUnitFilter) WhereEmail(p entql.StringP) {
-	f.Where(p.Field(orgunit.FieldEmail))
+// WhereMembershipID applies the entql uint32 predicate on the membership_id field.
+func (f *PolicyEvaluationLogFilter) WhereMembershipID(p entql.Uint32P) {
+	f.Where(p.Field(policyevaluationlog.FieldMembershipID))
 }
 
-// WhereTimezone applies the entql string predicate on the timezone field.
-func (f *OrgUnitFilter) WhereTimezone(p entql.StringP) {
-	f.Where(p.Field(orgunit.FieldTimezone))
+// WherePermissionID applies the entql uint32 predicate on the permission_id field.
+func (f *PolicyEvaluationLogFilter) WherePermissionID(p entql.Uint32P) {
+	f.Where(p.Field(policyevaluationlog.FieldPermissionID))
 }
 
-// WhereCountry applies the entql string predicate on the country field.
-func (f *OrgUnitFilter) WhereCountry(p entql.StringP) {
-	f.Where(p.Field(orgunit.FieldCountry))
+// WherePolicyID applies the entql uint32 predicate on the policy_id field.
+func (f *PolicyEvaluationLogFilter) WherePolicyID(p entql.Uint32P) {
+	f.Where(p.Field(policyevaluationlog.FieldPolicyID))
 }
 
-// WhereLatitude applies the entql float64 predicate on the latitude field.
-func (f *OrgUnitFilter) WhereLatitude(p entql.Float64P) {
-	f.Where(p.Field(orgunit.FieldLatitude))
+// WhereRequestPath applies the entql string predicate on the request_path field.
+func (f *PolicyEvaluationLogFilter) WhereRequestPath(p entql.StringP) {
+	f.Where(p.Field(policyevaluationlog.FieldRequestPath))
 }
 
-// WhereLongitude applies the entql float64 predicate on the longitude field.
-func (f *OrgUnitFilter) WhereLongitude(p entql.Float64P) {
-	f.Where(p.Field(orgunit.FieldLongitude))
+// WhereRequestMethod applies the entql string predicate on the request_method field.
+func (f *PolicyEvaluationLogFilter) WhereRequestMethod(p entql.StringP) {
+	f.Where(p.Field(policyevaluationlog.FieldRequestMethod))
 }
 
-// WhereStartAt applies the entql time.Time predicate on the start_at field.
-func (f *OrgUnitFilter) WhereStartAt(p entql.TimeP) {
-	f.Where(p.Field(orgunit.FieldStartAt))
+// WhereResult applies the entql bool predicate on the result field.
+func (f *PolicyEvaluationLogFilter) WhereResult(p entql.BoolP) {
+	f.Where(p.Field(policyevaluationlog.FieldResult))
 }
 
-// WhereEndAt applies the entql time.Time predicate on the end_at field.
-func (f *OrgUnitFilter) WhereEndAt(p entql.TimeP) {
-	f.Where(p.Field(orgunit.FieldEndAt))
+// WhereEffectDetails applies the entql string predicate on the effect_details field.
+func (f *PolicyEvaluationLogFilter) WhereEffectDetails(p entql.StringP) {
+	f.Where(p.Field(policyevaluationlog.FieldEffectDetails))
 }
 
-// WhereContactUserID applies the entql uint32 predicate on the contact_user_id field.
-func (f *OrgUnitFilter) WhereContactUserID(p entql.Uint32P) {
-	f.Where(p.Field(orgunit.FieldContactUserID))
+// WhereScopeSQL applies the entql string predicate on the scope_sql field.
+func (f *PolicyEvaluationLogFilter) WhereScopeSQL(p entql.StringP) {
+	f.Where(p.Field(policyevaluationlog.FieldScopeSQL))
 }
 
-// WherePermissionTags applies the entql json.RawMessage predicate on the permission_tags field.
-func (f *OrgUnitFilter) WherePermissionTags(p entql.BytesP) {
-	f.Where(p.Field(orgunit.FieldPermissionTags))
+// WhereIPAddress applies the entql string predicate on the ip_address field.
+func (f *PolicyEvaluationLogFilter) WhereIPAddress(p entql.StringP) {
+	f.Where(p.Field(policyevaluationlog.FieldIPAddress))
 }
 
-// WhereHasParent applies a predicate to check if query has an edge parent.
-func (f *OrgUnitFilter) WhereHasParent() {
-	f.Where(entql.HasEdge("parent"))
+// WhereTraceID applies the entql string predicate on the trace_id field.
+func (f *PolicyEvaluationLogFilter) WhereTraceID(p entql.StringP) {
+	f.Where(p.Field(policyevaluationlog.FieldTraceID))
 }
 
-// WhereHasParentWith applies a predicate to check if query has an edge parent with a given conditions (other predicates).
-func (f *OrgUnitFilter) WhereHasParentWith(preds ...predicate.OrgUnit) {
-	f.Where(entql.HasEdgeWith("parent", sqlgraph.WrapFunc(func(s *sql.Selector) {
-		for _, p := range preds {
-			p(s)
-		}
-	})))
+// WhereEvaluationContext applies the entql string predicate on the evaluation_context field.
+func (f *PolicyEvaluationLogFilter) WhereEvaluationContext(p entql.StringP) {
+	f.Where(p.Field(policyevaluationlog.FieldEvaluationContext))
 }
 
-// WhereHasChildren applies a predicate to check if query has an edge children.
-func (f *OrgUnitFilter) WhereHasChildren() {
-	f.Where(entql.HasEdge("children"))
+// WhereLogHash applies the entql string predicate on the log_hash field.
+func (f *PolicyEvaluationLogFilter) WhereLogHash(p entql.StringP) {
+	f.Where(p.Field(policyevaluationlog.FieldLogHash))
 }
 
-// WhereHasChildrenWith applies a predicate to check if query has an edge children with a given conditions (other predicates).
-func (f *OrgUnitFilter) WhereHasChildrenWith(preds ...predicate.OrgUnit) {
-	f.Where(entql.HasEdgeWith("children", sqlgraph.WrapFunc(func(s *sql.Selector) {
-		for _, p := range preds {
-			p(s)
-		}
-	})))
+// WhereSignature applies the entql []byte predicate on the signature field.
+func (f *PolicyEvaluationLogFilter) WhereSignature(p entql.BytesP) {
+	f.Where(p.Field(policyevaluationlog.FieldSignature))
 }
 
 // addPredicate implements the predicateAdder interface.
-func (_q *PermissionQuery) addPredicate(pred func(s *sql.Selector)) {
+func (_q *PositionQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the PermissionQuery builder.
-func (_q *PermissionQuery) Filter() *PermissionFilter {
-	return &PermissionFilter{config: _q.config, predicateAdder: _q}
+// Filter returns a Filter implementation to apply filters on the PositionQuery builder.
+func (_q *PositionQuery) Filter() *PositionFilter {
+	return &PositionFilter{config: _q.config, predicateAdder: _q}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *PermissionMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *PositionMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the PermissionMutation builder.
-func (m *PermissionMutation) Filter() *PermissionFilter {
-	return &PermissionFilter{config: m.config, predicateAdder: m}
+// Filter returns an entql.Where implementation to apply filters on the PositionMutation builder.
+func (m *PositionMutation) Filter() *PositionFilter {
+	return &PositionFilter{config: m.config, predicateAdder: m}
 }
 
-// PermissionFilter provides a generic filtering capability at runtime for PermissionQuery.
-type PermissionFilter struct {
+// PositionFilter provides a generic filtering capability at runtime for PositionQuery.
+type PositionFilter struct {
 	predicateAdder
 	config
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *PermissionFilter) Where(p entql.P) {
+func (f *PositionFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[20].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[33].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
 }
 
 // WhereID applies the entql uint32 predicate on the id field.
-func (f *PermissionFilter) WhereID(p entql.Uint32P) {
-	f.Where(p.Field(permission.FieldID))
+func (f *PositionFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(position.FieldID))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *PermissionFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(permission.FieldCreatedAt))
+func (f *PositionFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(position.FieldCreatedAt))
 }
 
 // WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
-func (f *PermissionFilter) WhereUpdatedAt(p entql.TimeP) {
-	f.Where(p.Field(permission.FieldUpdatedAt))
+func (f *PositionFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(position.FieldUpdatedAt))
 }
 
 // WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
-func (f *PermissionFilter) WhereDeletedAt(p entql.TimeP) {
-	f.Where(p.Field(permission.FieldDeletedAt))
+func (f *PositionFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(position.FieldDeletedAt))
 }
 
 // WhereCreatedBy applies the entql uint32 predicate on the created_by field.
-func (f *PermissionFilter) WhereCreatedBy(p entql.Uint32P) {
-	f.Where(p.Field(permission.FieldCreatedBy))
+func (f *PositionFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(position.FieldCreatedBy))
 }
 
 // WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
-func (f *PermissionFilter) WhereUpdatedBy(p entql.Uint32P) {
-	f.Where(p.Field(permission.FieldUpdatedBy))
+func (f *PositionFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(position.FieldUpdatedBy))
 }
 
 // WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
-func (f *PermissionFilter) WhereDeletedBy(p entql.Uint32P) {
-	f.Where(p.Field(permission.FieldDeletedBy))
-}
-
-// WhereStatus applies the entql string predicate on the status field.
-func (f *PermissionFilter) WhereStatus(p entql.StringP) {
-	f.Where(p.Field(permission.FieldStatus))
-}
-
-// WhereDescription applies the entql string predicate on the description field.
-func (f *PermissionFilter) WhereDescription(p entql.StringP) {
-	f.Where(p.Field(permission.FieldDescription))
-}
-
-// WhereName applies the entql string predicate on the name field.
-func (f *PermissionFilter) WhereName(p entql.StringP) {
-	f.Where(p.Field(permission.FieldName))
+func (f *PositionFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(position.FieldDeletedBy))
 }
 
-// WhereCode applies the entql string predicate on the code field.
-func (f *PermissionFilter) WhereCode(p entql.StringP) {
-	f.Where(p.Field(permission.FieldCode))
+// WhereSortOrder applies the entql uint32 predicate on the sort_order field.
+func (f *PositionFilter) WhereSortOrder(p entql.Uint32P) {
+	f.Where(p.Field(position.FieldSortOrder))
 }
 
-// WhereGroupID applies the entql uint32 predicate on the group_id field.
-func (f *PermissionFilter) WhereGroupID(p entql.Uint32P) {
-	f.Where(p.Field(permission.FieldGroupID))
+// WhereRemark applies the entql string predicate on the remark field.
+func (f *PositionFilter) WhereRemark(p entql.StringP) {
+	f.Where(p.Field(position.FieldRemark))
 }
 
-// addPredicate implements the predicateAdder interface.
-func (_q *PermissionApiQuery) addPredicate(pred func(s *sql.Selector)) {
-	_q.predicates = append(_q.predicates, pred)
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *PositionFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(position.FieldTenantID))
 }
 
-// Filter returns a Filter implementation to apply filters on the PermissionApiQuery builder.
-func (_q *PermissionApiQuery) Filter() *PermissionApiFilter {
-	return &PermissionApiFilter{config: _q.config, predicateAdder: _q}
+// WhereStatus applies the entql string predicate on the status field.
+func (f *PositionFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(position.FieldStatus))
 }
 
-// addPredicate implements the predicateAdder interface.
-func (m *PermissionApiMutation) addPredicate(pred func(s *sql.Selector)) {
-	m.predicates = append(m.predicates, pred)
+// WhereName applies the entql string predicate on the name field.
+func (f *PositionFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(position.FieldName))
 }
 
-// Filter returns an entql.Where implementation to apply filters on the PermissionApiMutation builder.
-func (m *PermissionApiMutation) Filter() *PermissionApiFilter {
-	return &PermissionApiFilter{config: m.config, predicateAdder: m}
+// WhereCode applies the entql string predicate on the code field.
+func (f *PositionFilter) WhereCode(p entql.StringP) {
+	f.Where(p.Field(position.FieldCode))
 }
 
-// PermissionApiFilter provides a generic filtering capability at runtime for PermissionApiQuery.
-type PermissionApiFilter struct {
-	predicateAdder
-	config
+// WhereOrgUnitID applies the entql uint32 predicate on the org_unit_id field.
+func (f *PositionFilter) WhereOrgUnitID(p entql.Uint32P) {
+	f.Where(p.Field(position.FieldOrgUnitID))
 }
 
-// Where applies the entql predicate on the query filter.
-func (f *PermissionApiFilter) Where(p entql.P) {
-	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[21].Type, p, s); err != nil {
-			s.AddError(err)
-		}
-	})
+// WhereReportsToPositionID applies the entql uint32 predicate on the reports_to_position_id field.
+func (f *PositionFilter) WhereReportsToPositionID(p entql.Uint32P) {
+	f.Where(p.Field(position.FieldReportsToPositionID))
 }
 
-// WhereID applies the entql uint32 predicate on the id field.
-func (f *PermissionApiFilter) WhereID(p entql.Uint32P) {
-	f.Where(p.Field(permissionapi.FieldID))
+// WhereDescription applies the entql string predicate on the description field.
+func (f *PositionFilter) WhereDescription(p entql.StringP) {
+	f.Where(p.Field(position.FieldDescription))
 }
 
-// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *PermissionApiFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(permissionapi.FieldCreatedAt))
+// WhereJobFamily applies the entql string predicate on the job_family field.
+func (f *PositionFilter) WhereJobFamily(p entql.StringP) {
+	f.Where(p.Field(position.FieldJobFamily))
 }
 
-// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
-func (f *PermissionApiFilter) WhereUpdatedAt(p entql.TimeP) {
-	f.Where(p.Field(permissionapi.FieldUpdatedAt))
+// WhereJobGrade applies the entql string predicate on the job_grade field.
+func (f *PositionFilter) WhereJobGrade(p entql.StringP) {
+	f.Where(p.Field(position.FieldJobGrade))
 }
 
-// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
-func (f *PermissionApiFilter) WhereDeletedAt(p entql.TimeP) {
-	f.Where(p.Field(permissionapi.FieldDeletedAt))
+// WhereLevel applies the entql int32 predicate on the level field.
+func (f *PositionFilter) WhereLevel(p entql.Int32P) {
+	f.Where(p.Field(position.FieldLevel))
 }
 
-// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
-func (f *PermissionApiFilter) WhereCreatedBy(p entql.Uint32P) {
-	f.Where(p.Field(permissionapi.FieldCreatedBy))
+// WhereHeadcount applies the entql uint32 predicate on the headcount field.
+func (f *PositionFilter) WhereHeadcount(p entql.Uint32P) {
+	f.Where(p.Field(position.FieldHeadcount))
 }
 
-// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
-func (f *PermissionApiFilter) WhereUpdatedBy(p entql.Uint32P) {
-	f.Where(p.Field(permissionapi.FieldUpdatedBy))
+// WhereIsKeyPosition applies the entql bool predicate on the is_key_position field.
+func (f *PositionFilter) WhereIsKeyPosition(p entql.BoolP) {
+	f.Where(p.Field(position.FieldIsKeyPosition))
 }
 
-// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
-func (f *PermissionApiFilter) WhereDeletedBy(p entql.Uint32P) {
-	f.Where(p.Field(permissionapi.FieldDeletedBy))
+// WhereType applies the entql string predicate on the type field.
+func (f *PositionFilter) WhereType(p entql.StringP) {
+	f.Where(p.Field(position.FieldType))
 }
 
-// WherePermissionID applies the entql uint32 predicate on the permission_id field.
-func (f *PermissionApiFilter) WherePermissionID(p entql.Uint32P) {
-	f.Where(p.Field(permissionapi.FieldPermissionID))
+// WhereStartAt applies the entql time.Time predicate on the start_at field.
+func (f *PositionFilter) WhereStartAt(p entql.TimeP) {
+	f.Where(p.Field(position.FieldStartAt))
 }
 
-// WhereAPIID applies the entql uint32 predicate on the api_id field.
-func (f *PermissionApiFilter) WhereAPIID(p entql.Uint32P) {
-	f.Where(p.Field(permissionapi.FieldAPIID))
+// WhereEndAt applies the entql time.Time predicate on the end_at field.
+func (f *PositionFilter) WhereEndAt(p entql.TimeP) {
+	f.Where(p.Field(position.FieldEndAt))
 }
 
 // addPredicate implements the predicateAdder interface.
-func (_q *PermissionAuditLogQuery) addPredicate(pred func(s *sql.Selector)) {
+func (_q *RestoredAuditLogQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the PermissionAuditLogQuery builder.
-func (_q *PermissionAuditLogQuery) Filter() *PermissionAuditLogFilter {
-	return &PermissionAuditLogFilter{config: _q.config, predicateAdder: _q}
+// Filter returns a Filter implementation to apply filters on the RestoredAuditLogQuery builder.
+func (_q *RestoredAuditLogQuery) Filter() *RestoredAuditLogFilter {
+	return &RestoredAuditLogFilter{config: _q.config, predicateAdder: _q}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *PermissionAuditLogMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *RestoredAuditLogMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the PermissionAuditLogMutation builder.
-func (m *PermissionAuditLogMutation) Filter() *PermissionAuditLogFilter {
-	return &PermissionAuditLogFilter{config: m.config, predicateAdder: m}
+// Filter returns an entql.Where implementation to apply filters on the RestoredAuditLogMutation builder.
+func (m *RestoredAuditLogMutation) Filter() *RestoredAuditLogFilter {
+	return &RestoredAuditLogFilter{config: m.config, predicateAdder: m}
 }
 
-// PermissionAuditLogFilter provides a generic filtering capability at runtime for PermissionAuditLogQuery.
-type PermissionAuditLogFilter struct {
+// RestoredAuditLogFilter provides a generic filtering capability at runtime for RestoredAuditLogQuery.
+type RestoredAuditLogFilter struct {
 	predicateAdder
 	config
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *PermissionAuditLogFilter) Where(p entql.P) {
+func (f *RestoredAuditLogFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[22].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[34].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
 }
 
 // WhereID applies the entql uint32 predicate on the id field.
-func (f *PermissionAuditLogFilter) WhereID(p entql.Uint32P) {
-	f.Where(p.Field(permissionauditlog.FieldID))
+func (f *RestoredAuditLogFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(restoredauditlog.FieldID))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *PermissionAuditLogFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(permissionauditlog.FieldCreatedAt))
+func (f *RestoredAuditLogFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(restoredauditlog.FieldCreatedAt))
 }
 
 // WhereTenantID applies the entql uint32 predicate on the tenant_id field.
-func (f *PermissionAuditLogFilter) WhereTenantID(p entql.Uint32P) {
-	f.Where(p.Field(permissionauditlog.FieldTenantID))
-}
-
-// WhereOperatorID applies the entql uint32 predicate on the operator_id field.
-func (f *PermissionAuditLogFilter) WhereOperatorID(p entql.Uint32P) {
-	f.Where(p.Field(permissionauditlog.FieldOperatorID))
-}
-
-// WhereTargetType applies the entql string predicate on the target_type field.
-func (f *PermissionAuditLogFilter) WhereTargetType(p entql.StringP) {
-	f.Where(p.Field(permissionauditlog.FieldTargetType))
-}
-
-// WhereTargetID applies the entql string predicate on the target_id field.
-func (f *PermissionAuditLogFilter) WhereTargetID(p entql.StringP) {
-	f.Where(p.Field(permissionauditlog.FieldTargetID))
-}
-
-// WhereAction applies the entql string predicate on the action field.
-func (f *PermissionAuditLogFilter) WhereAction(p entql.StringP) {
-	f.Where(p.Field(permissionauditlog.FieldAction))
+func (f *RestoredAuditLogFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(restoredauditlog.FieldTenantID))
 }
 
-// WhereOldValue applies the entql string predicate on the old_value field.
-func (f *PermissionAuditLogFilter) WhereOldValue(p entql.StringP) {
-	f.Where(p.Field(permissionauditlog.FieldOldValue))
-}
-
-// WhereNewValue applies the entql string predicate on the new_value field.
-func (f *PermissionAuditLogFilter) WhereNewValue(p entql.StringP) {
-	f.Where(p.Field(permissionauditlog.FieldNewValue))
+// WhereArchiveID applies the entql uint32 predicate on the archive_id field.
+func (f *RestoredAuditLogFilter) WhereArchiveID(p entql.Uint32P) {
+	f.Where(p.Field(restoredauditlog.FieldArchiveID))
 }
 
-// WhereIPAddress applies the entql string predicate on the ip_address field.
-func (f *PermissionAuditLogFilter) WhereIPAddress(p entql.StringP) {
-	f.Where(p.Field(permissionauditlog.FieldIPAddress))
-}
-
-// WhereRequestID applies the entql string predicate on the request_id field.
-func (f *PermissionAuditLogFilter) WhereRequestID(p entql.StringP) {
-	f.Where(p.Field(permissionauditlog.FieldRequestID))
+// WhereLogType applies the entql string predicate on the log_type field.
+func (f *RestoredAuditLogFilter) WhereLogType(p entql.StringP) {
+	f.Where(p.Field(restoredauditlog.FieldLogType))
 }
 
-// WhereReason applies the entql string predicate on the reason field.
-func (f *PermissionAuditLogFilter) WhereReason(p entql.StringP) {
-	f.Where(p.Field(permissionauditlog.FieldReason))
+// WhereLogID applies the entql uint32 predicate on the log_id field.
+func (f *RestoredAuditLogFilter) WhereLogID(p entql.Uint32P) {
+	f.Where(p.Field(restoredauditlog.FieldLogID))
 }
 
-// WhereLogHash applies the entql string predicate on the log_hash field.
-func (f *PermissionAuditLogFilter) WhereLogHash(p entql.StringP) {
-	f.Where(p.Field(permissionauditlog.FieldLogHash))
+// WhereLogCreatedAt applies the entql time.Time predicate on the log_created_at field.
+func (f *RestoredAuditLogFilter) WhereLogCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(restoredauditlog.FieldLogCreatedAt))
 }
 
-// WhereSignature applies the entql []byte predicate on the signature field.
-func (f *PermissionAuditLogFilter) WhereSignature(p entql.BytesP) {
-	f.Where(p.Field(permissionauditlog.FieldSignature))
+// WherePayload applies the entql json.RawMessage predicate on the payload field.
+func (f *RestoredAuditLogFilter) WherePayload(p entql.BytesP) {
+	f.Where(p.Field(restoredauditlog.FieldPayload))
 }
 
 // addPredicate implements the predicateAdder interface.
-func (_q *PermissionGroupQuery) addPredicate(pred func(s *sql.Selector)) {
+func (_q *RoleQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the PermissionGroupQuery builder.
-func (_q *PermissionGroupQuery) Filter() *PermissionGroupFilter {
-	return &PermissionGroupFilter{config: _q.config, predicateAdder: _q}
+// Filter returns a Filter implementation to apply filters on the RoleQuery builder.
+func (_q *RoleQuery) Filter() *RoleFilter {
+	return &RoleFilter{config: _q.config, predicateAdder: _q}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *PermissionGroupMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *RoleMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the PermissionGroupMutation builder.
-func (m *PermissionGroupMutation) Filter() *PermissionGroupFilter {
-	return &PermissionGroupFilter{config: m.config, predicateAdder: m}
+// Filter returns an entql.Where implementation to apply filters on the RoleMutation builder.
+func (m *RoleMutation) Filter() *RoleFilter {
+	return &RoleFilter{config: m.config, predicateAdder: m}
 }
 
-// PermissionGroupFilter provides a generic filtering capability at runtime for PermissionGroupQuery.
-type PermissionGroupFilter struct {
+// RoleFilter provides a generic filtering capability at runtime for RoleQuery.
+type RoleFilter struct {
 	predicateAdder
 	config
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *PermissionGroupFilter) Where(p entql.P) {
+func (f *RoleFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[23].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[35].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
 }
 
 // WhereID applies the entql uint32 predicate on the id field.
-func (f *PermissionGroupFilter) WhereID(p entql.Uint32P) {
-	f.Where(p.Field(permissiongroup.FieldID))
+func (f *RoleFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(role.FieldID))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *PermissionGroupFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(permissiongroup.FieldCreatedAt))
+func (f *RoleFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(role.FieldCreatedAt))
 }
 
 // WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
-func (f *PermissionGroupFilter) WhereUpdatedAt(p entql.TimeP) {
-	f.Where(p.Field(permissiongroup.FieldUpdatedAt))
+func (f *RoleFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(role.FieldUpdatedAt))
 }
 
 // WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
-func (f *PermissionGroupFilter) WhereDeletedAt(p entql.TimeP) {
-	f.Where(p.Field(permissiongroup.FieldDeletedAt))
+func (f *RoleFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(role.FieldDeletedAt))
 }
 
 // WhereCreatedBy applies the entql uint32 predicate on the created_by field.
-func (f *PermissionGroupFilter) WhereCreatedBy(p entql.Uint32P) {
-	f.Where(p.Field(permissiongroup.FieldCreatedBy))
+func (f *RoleFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(role.FieldCreatedBy))
 }
 
 // WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
-func (f *PermissionGroupFilter) WhereUpdatedBy(p entql.Uint32P) {
-	f.Where(p.Field(permissiongroup.FieldUpdatedBy))
+func (f *RoleFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(role.FieldUpdatedBy))
 }
 
 // WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
-func (f *PermissionGroupFilter) WhereDeletedBy(p entql.Uint32P) {
-	f.Where(p.Field(permissiongroup.FieldDeletedBy))
+func (f *RoleFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(role.FieldDeletedBy))
 }
 
-// WhereDescription applies the entql string predicate on the description field.
-func (f *PermissionGroupFilter) WhereDescription(p entql.StringP) {
-	f.Where(p.Field(permissiongroup.FieldDescription))
+// WhereRemark applies the entql string predicate on the remark field.
+func (f *RoleFilter) WhereRemark(p entql.StringP) {
+	f.Where(p.Field(role.FieldRemark))
 }
 
-// WhereStatus applies the entql string predicate on the status field.
-func (f *PermissionGroupFilter) WhereStatus(p entql.StringP) {
-	f.Where(p.Field(permissiongroup.FieldStatus))
+// WhereDescription applies the entql string predicate on the description field.
+func (f *RoleFilter) WhereDescription(p entql.StringP) {
+	f.Where(p.Field(role.FieldDescription))
 }
 
 // WhereSortOrder applies the entql uint32 predicate on the sort_order field.
-func (f *PermissionGroupFilter) WhereSortOrder(p entql.Uint32P) {
-	f.Where(p.Field(permissiongroup.FieldSortOrder))
+func (f *RoleFilter) WhereSortOrder(p entql.Uint32P) {
+	f.Where(p.Field(role.FieldSortOrder))
 }
 
-// WhereParentID applies the entql uint32 predicate on the parent_id field.
-func (f *PermissionGroupFilter) WhereParentID(p entql.Uint32P) {
-	f.Where(p.Field(permissiongroup.FieldParentID))
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *RoleFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(role.FieldTenantID))
 }
 
-// WherePath applies the entql string predicate on the path field.
-func (f *PermissionGroupFilter) WherePath(p entql.StringP) {
-	f.Where(p.Field(permissiongroup.FieldPath))
+// WhereStatus applies the entql string predicate on the status field.
+func (f *RoleFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(role.FieldStatus))
 }
 
 // WhereName applies the entql string predicate on the name field.
-func (f *PermissionGroupFilter) WhereName(p entql.StringP) {
-	f.Where(p.Field(permissiongroup.FieldName))
-}
-
-// WhereModule applies the entql string predicate on the module field.
-func (f *PermissionGroupFilter) WhereModule(p entql.StringP) {
-	f.Where(p.Field(permissiongroup.FieldModule))
-}
-
-// WhereHasParent applies a predicate to check if query has an edge parent.
-func (f *PermissionGroupFilter) WhereHasParent() {
-	f.Where(entql.HasEdge("parent"))
+func (f *RoleFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(role.FieldName))
 }
 
-// WhereHasParentWith applies a predicate to check if query has an edge parent with a given conditions (other predicates).
-func (f *PermissionGroupFilter) WhereHasParentWith(preds ...predicate.PermissionGroup) {
-	f.Where(entql.HasEdgeWith("parent", sqlgraph.WrapFunc(func(s *sql.Selector) {
-		for _, p := range preds {
-			p(s)
-		}
-	})))
+// WhereCode applies the entql string predicate on the code field.
+func (f *RoleFilter) WhereCode(p entql.StringP) {
+	f.Where(p.Field(role.FieldCode))
 }
 
-// WhereHasChildren applies a predicate to check if query has an edge children.
-func (f *PermissionGroupFilter) WhereHasChildren() {
-	f.Where(entql.HasEdge("children"))
+// WhereIsProtected applies the entql bool predicate on the is_protected field.
+func (f *RoleFilter) WhereIsProtected(p entql.BoolP) {
+	f.Where(p.Field(role.FieldIsProtected))
 }
 
-// WhereHasChildrenWith applies a predicate to check if query has an edge children with a given conditions (other predicates).
-func (f *PermissionGroupFilter) WhereHasChildrenWith(preds ...predicate.PermissionGroup) {
-	f.Where(entql.HasEdgeWith("children", sqlgraph.WrapFunc(func(s *sql.Selector) {
-		for _, p := range preds {
-			p(s)
-		}
-	})))
+// WhereType applies the entql string predicate on the type field.
+func (f *RoleFilter) WhereType(p entql.StringP) {
+	f.Where(p.Field(role.FieldType))
 }
 
 // addPredicate implements the predicateAdder interface.
-func (_q *PermissionMenuQuery) addPredicate(pred func(s *sql.Selector)) {
+func (_q *RoleMetadataQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the PermissionMenuQuery builder.
-func (_q *PermissionMenuQuery) Filter() *PermissionMenuFilter {
-	return &PermissionMenuFilter{config: _q.config, predicateAdder: _q}
+// Filter returns a Filter implementation to apply filters on the RoleMetadataQuery builder.
+func (_q *RoleMetadataQuery) Filter() *RoleMetadataFilter {
+	return &RoleMetadataFilter{config: _q.config, predicateAdder: _q}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *PermissionMenuMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *RoleMetadataMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the PermissionMenuMutation builder.
-func (m *PermissionMenuMutation) Filter() *PermissionMenuFilter {
-	return &PermissionMenuFilter{config: m.config, predicateAdder: m}
+// Filter returns an entql.Where implementation to apply filters on the RoleMetadataMutation builder.
+func (m *RoleMetadataMutation) Filter() *RoleMetadataFilter {
+	return &RoleMetadataFilter{config: m.config, predicateAdder: m}
 }
 
-// PermissionMenuFilter provides a generic filtering capability at runtime for PermissionMenuQuery.
-type PermissionMenuFilter struct {
+// RoleMetadataFilter provides a generic filtering capability at runtime for RoleMetadataQuery.
+type RoleMetadataFilter struct {
 	predicateAdder
 	config
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *PermissionMenuFilter) Where(p entql.P) {
+func (f *RoleMetadataFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[24].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[36].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
 }
 
 // WhereID applies the entql uint32 predicate on the id field.
-func (f *PermissionMenuFilter) WhereID(p entql.Uint32P) {
-	f.Where(p.Field(permissionmenu.FieldID))
+func (f *RoleMetadataFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(rolemetadata.FieldID))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *PermissionMenuFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(permissionmenu.FieldCreatedAt))
+func (f *RoleMetadataFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(rolemetadata.FieldCreatedAt))
 }
 
 // WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
-func (f *PermissionMenuFilter) WhereUpdatedAt(p entql.TimeP) {
-	f.Where(p.Field(permissionmenu.FieldUpdatedAt))
+func (f *RoleMetadataFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(rolemetadata.FieldUpdatedAt))
 }
 
 // WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
-func (f *PermissionMenuFilter) WhereDeletedAt(p entql.TimeP) {
-	f.Where(p.Field(permissionmenu.FieldDeletedAt))
+func (f *RoleMetadataFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(rolemetadata.FieldDeletedAt))
 }
 
 // WhereCreatedBy applies the entql uint32 predicate on the created_by field.
-func (f *PermissionMenuFilter) WhereCreatedBy(p entql.Uint32P) {
-	f.Where(p.Field(permissionmenu.FieldCreatedBy))
+func (f *RoleMetadataFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(rolemetadata.FieldCreatedBy))
 }
 
 // WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
-func (f *PermissionMenuFilter) WhereUpdatedBy(p entql.Uint32P) {
-	f.Where(p.Field(permissionmenu.FieldUpdatedBy))
+func (f *RoleMetadataFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(rolemetadata.FieldUpdatedBy))
 }
 
 // WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
-func (f *PermissionMenuFilter) WhereDeletedBy(p entql.Uint32P) {
-	f.Where(p.Field(permissionmenu.FieldDeletedBy))
+func (f *RoleMetadataFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(rolemetadata.FieldDeletedBy))
 }
 
-// WherePermissionID applies the entql uint32 predicate on the permission_id field.
-func (f *PermissionMenuFilter) WherePermissionID(p entql.Uint32P) {
-	f.Where(p.Field(permissionmenu.FieldPermissionID))
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *RoleMetadataFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(rolemetadata.FieldTenantID))
 }
 
-// WhereMenuID applies the entql uint32 predicate on the menu_id field.
-func (f *PermissionMenuFilter) WhereMenuID(p entql.Uint32P) {
-	f.Where(p.Field(permissionmenu.FieldMenuID))
+// WhereRoleID applies the entql uint32 predicate on the role_id field.
+func (f *RoleMetadataFilter) WhereRoleID(p entql.Uint32P) {
+	f.Where(p.Field(rolemetadata.FieldRoleID))
+}
+
+// WhereIsTemplate applies the entql bool predicate on the is_template field.
+func (f *RoleMetadataFilter) WhereIsTemplate(p entql.BoolP) {
+	f.Where(p.Field(rolemetadata.FieldIsTemplate))
+}
+
+// WhereTemplateFor applies the entql string predicate on the template_for field.
+func (f *RoleMetadataFilter) WhereTemplateFor(p entql.StringP) {
+	f.Where(p.Field(rolemetadata.FieldTemplateFor))
+}
+
+// WhereTemplateVersion applies the entql int32 predicate on the template_version field.
+func (f *RoleMetadataFilter) WhereTemplateVersion(p entql.Int32P) {
+	f.Where(p.Field(rolemetadata.FieldTemplateVersion))
+}
+
+// WhereLastSyncedVersion applies the entql int32 predicate on the last_synced_version field.
+func (f *RoleMetadataFilter) WhereLastSyncedVersion(p entql.Int32P) {
+	f.Where(p.Field(rolemetadata.FieldLastSyncedVersion))
+}
+
+// WhereLastSyncedAt applies the entql time.Time predicate on the last_synced_at field.
+func (f *RoleMetadataFilter) WhereLastSyncedAt(p entql.TimeP) {
+	f.Where(p.Field(rolemetadata.FieldLastSyncedAt))
+}
+
+// WhereSyncPolicy applies the entql string predicate on the sync_policy field.
+func (f *RoleMetadataFilter) WhereSyncPolicy(p entql.StringP) {
+	f.Where(p.Field(rolemetadata.FieldSyncPolicy))
+}
+
+// WhereScope applies the entql string predicate on the scope field.
+func (f *RoleMetadataFilter) WhereScope(p entql.StringP) {
+	f.Where(p.Field(rolemetadata.FieldScope))
+}
+
+// WhereCustomOverrides applies the entql json.RawMessage predicate on the custom_overrides field.
+func (f *RoleMetadataFilter) WhereCustomOverrides(p entql.BytesP) {
+	f.Where(p.Field(rolemetadata.FieldCustomOverrides))
 }
 
 // addPredicate implements the predicateAdder interface.
-func (_q *PermissionPolicyQuery) addPredicate(pred func(s *sql.Selector)) {
+func (_q *RolePermissionQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the PermissionPolicyQuery builder.
-func (_q *PermissionPolicyQuery) Filter() *PermissionPolicyFilter {
-	return &PermissionPolicyFilter{config: _q.config, predicateAdder: _q}
+// Filter returns a Filter implementation to apply filters on the RolePermissionQuery builder.
+func (_q *RolePermissionQuery) Filter() *RolePermissionFilter {
+	return &RolePermissionFilter{config: _q.config, predicateAdder: _q}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *PermissionPolicyMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *RolePermissionMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the PermissionPolicyMutation builder.
-func (m *PermissionPolicyMutation) Filter() *PermissionPolicyFilter {
-	return &PermissionPolicyFilter{config: m.config, predicateAdder: m}
+// Filter returns an entql.Where implementation to apply filters on the RolePermissionMutation builder.
+func (m *RolePermissionMutation) Filter() *RolePermissionFilter {
+	return &RolePermissionFilter{config: m.config, predicateAdder: m}
 }
 
-// PermissionPolicyFilter provides a generic filtering capability at runtime for PermissionPolicyQuery.
-type PermissionPolicyFilter struct {
+// RolePermissionFilter provides a generic filtering capability at runtime for RolePermissionQuery.
+type RolePermissionFilter struct {
 	predicateAdder
 	config
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *PermissionPolicyFilter) Where(p entql.P) {
+func (f *RolePermissionFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[25].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[37].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
 }
 
 // WhereID applies the entql uint32 predicate on the id field.
-func (f *PermissionPolicyFilter) WhereID(p entql.Uint32P) {
-	f.Where(p.Field(permissionpolicy.FieldID))
+func (f *RolePermissionFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(rolepermission.FieldID))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *PermissionPolicyFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(permissionpolicy.FieldCreatedAt))
+func (f *RolePermissionFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(rolepermission.FieldCreatedAt))
 }
 
 // WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
-func (f *PermissionPolicyFilter) WhereUpdatedAt(p entql.TimeP) {
-	f.Where(p.Field(permissionpolicy.FieldUpdatedAt))
+func (f *RolePermissionFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(rolepermission.FieldUpdatedAt))
 }
 
 // WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
-func (f *PermissionPolicyFilter) WhereDeletedAt(p entql.TimeP) {
-	f.Where(p.Field(permissionpolicy.FieldDeletedAt))
+func (f *RolePermissionFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(rolepermission.FieldDeletedAt))
 }
 
 // WhereCreatedBy applies the entql uint32 predicate on the created_by field.
-func (f *PermissionPolicyFilter) WhereCreatedBy(p entql.Uint32P) {
-	f.Where(p.Field(permissionpolicy.FieldCreatedBy))
+func (f *RolePermissionFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(rolepermission.FieldCreatedBy))
 }
 
 // WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
-func (f *PermissionPolicyFilter) WhereUpdatedBy(p entql.Uint32P) {
-	f.Where(p.Field(permissionpolicy.FieldUpdatedBy))
+func (f *RolePermissionFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(rolepermission.FieldUpdatedBy))
 }
 
 // WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
-func (f *PermissionPolicyFilter) WhereDeletedBy(p entql.Uint32P) {
-	f.Where(p.Field(permissionpolicy.FieldDeletedBy))
-}
-
-// WhereStatus applies the entql string predicate on the status field.
-func (f *PermissionPolicyFilter) WhereStatus(p entql.StringP) {
-	f.Where(p.Field(permissionpolicy.FieldStatus))
+func (f *RolePermissionFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(rolepermission.FieldDeletedBy))
 }
 
-// WherePermissionID applies the entql uint32 predicate on the permission_id field.
-func (f *PermissionPolicyFilter) WherePermissionID(p entql.Uint32P) {
-	f.Where(p.Field(permissionpolicy.FieldPermissionID))
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *RolePermissionFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(rolepermission.FieldTenantID))
 }
 
-// WherePolicyEngine applies the entql string predicate on the policy_engine field.
-func (f *PermissionPolicyFilter) WherePolicyEngine(p entql.StringP) {
-	f.Where(p.Field(permissionpolicy.FieldPolicyEngine))
+// WhereStatus applies the entql string predicate on the status field.
+func (f *RolePermissionFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(rolepermission.FieldStatus))
 }
 
-// WhereDefinition applies the entql string predicate on the definition field.
-func (f *PermissionPolicyFilter) WhereDefinition(p entql.StringP) {
-	f.Where(p.Field(permissionpolicy.FieldDefinition))
+// WhereRoleID applies the entql uint32 predicate on the role_id field.
+func (f *RolePermissionFilter) WhereRoleID(p entql.Uint32P) {
+	f.Where(p.Field(rolepermission.FieldRoleID))
 }
 
-// WhereVersion applies the entql uint32 predicate on the version field.
-func (f *PermissionPolicyFilter) WhereVersion(p entql.Uint32P) {
-	f.Where(p.Field(permissionpolicy.FieldVersion))
+// WherePermissionID applies the entql uint32 predicate on the permission_id field.
+func (f *RolePermissionFilter) WherePermissionID(p entql.Uint32P) {
+	f.Where(p.Field(rolepermission.FieldPermissionID))
 }
 
-// WhereEvalOrder applies the entql uint32 predicate on the eval_order field.
-func (f *PermissionPolicyFilter) WhereEvalOrder(p entql.Uint32P) {
-	f.Where(p.Field(permissionpolicy.FieldEvalOrder))
+// WhereEffect applies the entql string predicate on the effect field.
+func (f *RolePermissionFilter) WhereEffect(p entql.StringP) {
+	f.Where(p.Field(rolepermission.FieldEffect))
 }
 
-// WhereCacheTTL applies the entql uint32 predicate on the cache_ttl field.
-func (f *PermissionPolicyFilter) WhereCacheTTL(p entql.Uint32P) {
-	f.Where(p.Field(permissionpolicy.FieldCacheTTL))
+// WherePriority applies the entql int32 predicate on the priority field.
+func (f *RolePermissionFilter) WherePriority(p entql.Int32P) {
+	f.Where(p.Field(rolepermission.FieldPriority))
 }
 
 // addPredicate implements the predicateAdder interface.
-func (_q *PolicyEvaluationLogQuery) addPredicate(pred func(s *sql.Selector)) {
+func (_q *StorageObjectQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the PolicyEvaluationLogQuery builder.
-func (_q *PolicyEvaluationLogQuery) Filter() *PolicyEvaluationLogFilter {
-	return &PolicyEvaluationLogFilter{config: _q.config, predicateAdder: _q}
+// Filter returns a Filter implementation to apply filters on the StorageObjectQuery builder.
+func (_q *StorageObjectQuery) Filter() *StorageObjectFilter {
+	return &StorageObjectFilter{config: _q.config, predicateAdder: _q}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *PolicyEvaluationLogMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *StorageObjectMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the PolicyEvaluationLogMutation builder.
-func (m *PolicyEvaluationLogMutation) Filter() *PolicyEvaluationLogFilter {
-	return &PolicyEvaluationLogFilter{config: m.config, predicateAdder: m}
+// Filter returns an entql.Where implementation to apply filters on the StorageObjectMutation builder.
+func (m *StorageObjectMutation) Filter() *StorageObjectFilter {
+	return &StorageObjectFilter{config: m.config, predicateAdder: m}
 }
 
-// PolicyEvaluationLogFilter provides a generic filtering capability at runtime for PolicyEvaluationLogQuery.
-type PolicyEvaluationLogFilter struct {
+// StorageObjectFilter provides a generic filtering capability at runtime for StorageObjectQuery.
+type StorageObjectFilter struct {
 	predicateAdder
 	config
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *PolicyEvaluationLogFilter) Where(p entql.P) {
+func (f *StorageObjectFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[26].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[38].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
 }
 
 // WhereID applies the entql uint32 predicate on the id field.
-func (f *PolicyEvaluationLogFilter) WhereID(p entql.Uint32P) {
-	f.Where(p.Field(policyevaluationlog.FieldID))
+func (f *StorageObjectFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(storageobject.FieldID))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *PolicyEvaluationLogFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(policyevaluationlog.FieldCreatedAt))
-}
-
-// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
-func (f *PolicyEvaluationLogFilter) WhereTenantID(p entql.Uint32P) {
-	f.Where(p.Field(policyevaluationlog.FieldTenantID))
-}
-
-// WhereUserID applies the entql uint32 predicate on the user_id field.
-func (f *PolicyEvaluationLogFilter) WhereUserID(p entql.Uint32P) {
-	f.Where(p.Field(policyevaluationlog.FieldUserID))
+func (f *StorageObjectFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(storageobject.FieldCreatedAt))
 }
 
-// WhereMembershipID applies the entql uint32 predicate on the membership_id field.
-func (f *PolicyEvaluationLogFilter) WhereMembershipID(p entql.Uint32P) {
-	f.Where(p.Field(policyevaluationlog.FieldMembershipID))
-}
-
-// WherePermissionID applies the entql uint32 predicate on the permission_id field.
-func (f *PolicyEvaluationLogFilter) WherePermissionID(p entql.Uint32P) {
-	f.Where(p.Field(policyevaluationlog.FieldPermissionID))
-}
-
-// WherePolicyID applies the entql uint32 predicate on the policy_id field.
-func (f *PolicyEvaluationLogFilter) WherePolicyID(p entql.Uint32P) {
-	f.Where(p.Field(policyevaluationlog.FieldPolicyID))
-}
-
-// WhereRequestPath applies the entql string predicate on the request_path field.
-func (f *PolicyEvaluationLogFilter) WhereRequestPath(p entql.StringP) {
-	f.Where(p.Field(policyevaluationlog.FieldRequestPath))
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *StorageObjectFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(storageobject.FieldUpdatedAt))
 }
 
-// WhereRequestMethod applies the entql string predicate on the request_method field.
-func (f *PolicyEvaluationLogFilter) WhereRequestMethod(p entql.StringP) {
-	f.Where(p.Field(policyevaluationlog.FieldRequestMethod))
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *StorageObjectFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(storageobject.FieldDeletedAt))
 }
 
-// WhereResult applies the entql bool predicate on the result field.
-func (f *PolicyEvaluationLogFilter) WhereResult(p entql.BoolP) {
-	f.Where(p.Field(policyevaluationlog.FieldResult))
+// WhereDedupScope applies the entql string predicate on the dedup_scope field.
+func (f *StorageObjectFilter) WhereDedupScope(p entql.StringP) {
+	f.Where(p.Field(storageobject.FieldDedupScope))
 }
 
-// WhereEffectDetails applies the entql string predicate on the effect_details field.
-func (f *PolicyEvaluationLogFilter) WhereEffectDetails(p entql.StringP) {
-	f.Where(p.Field(policyevaluationlog.FieldEffectDetails))
+// WhereProvider applies the entql string predicate on the provider field.
+func (f *StorageObjectFilter) WhereProvider(p entql.StringP) {
+	f.Where(p.Field(storageobject.FieldProvider))
 }
 
-// WhereScopeSQL applies the entql string predicate on the scope_sql field.
-func (f *PolicyEvaluationLogFilter) WhereScopeSQL(p entql.StringP) {
-	f.Where(p.Field(policyevaluationlog.FieldScopeSQL))
+// WhereContentHash applies the entql string predicate on the content_hash field.
+func (f *StorageObjectFilter) WhereContentHash(p entql.StringP) {
+	f.Where(p.Field(storageobject.FieldContentHash))
 }
 
-// WhereIPAddress applies the entql string predicate on the ip_address field.
-func (f *PolicyEvaluationLogFilter) WhereIPAddress(p entql.StringP) {
-	f.Where(p.Field(policyevaluationlog.FieldIPAddress))
+// WhereBucketName applies the entql string predicate on the bucket_name field.
+func (f *StorageObjectFilter) WhereBucketName(p entql.StringP) {
+	f.Where(p.Field(storageobject.FieldBucketName))
 }
 
-// WhereTraceID applies the entql string predicate on the trace_id field.
-func (f *PolicyEvaluationLogFilter) WhereTraceID(p entql.StringP) {
-	f.Where(p.Field(policyevaluationlog.FieldTraceID))
+// WhereObjectName applies the entql string predicate on the object_name field.
+func (f *StorageObjectFilter) WhereObjectName(p entql.StringP) {
+	f.Where(p.Field(storageobject.FieldObjectName))
 }
 
-// WhereEvaluationContext applies the entql string predicate on the evaluation_context field.
-func (f *PolicyEvaluationLogFilter) WhereEvaluationContext(p entql.StringP) {
-	f.Where(p.Field(policyevaluationlog.FieldEvaluationContext))
+// WhereSize applies the entql uint64 predicate on the size field.
+func (f *StorageObjectFilter) WhereSize(p entql.Uint64P) {
+	f.Where(p.Field(storageobject.FieldSize))
 }
 
-// WhereLogHash applies the entql string predicate on the log_hash field.
-func (f *PolicyEvaluationLogFilter) WhereLogHash(p entql.StringP) {
-	f.Where(p.Field(policyevaluationlog.FieldLogHash))
+// WhereContentType applies the entql string predicate on the content_type field.
+func (f *StorageObjectFilter) WhereContentType(p entql.StringP) {
+	f.Where(p.Field(storageobject.FieldContentType))
 }
 
-// WhereSignature applies the entql []byte predicate on the signature field.
-func (f *PolicyEvaluationLogFilter) WhereSignature(p entql.BytesP) {
-	f.Where(p.Field(policyevaluationlog.FieldSignature))
+// WhereRefCount applies the entql int32 predicate on the ref_count field.
+func (f *StorageObjectFilter) WhereRefCount(p entql.Int32P) {
+	f.Where(p.Field(storageobject.FieldRefCount))
 }
 
 // addPredicate implements the predicateAdder interface.
-func (_q *PositionQuery) addPredicate(pred func(s *sql.Selector)) {
+func (_q *StorageQuotaQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the PositionQuery builder.
-func (_q *PositionQuery) Filter() *PositionFilter {
-	return &PositionFilter{config: _q.config, predicateAdder: _q}
+// Filter returns a Filter implementation to apply filters on the StorageQuotaQuery builder.
+func (_q *StorageQuotaQuery) Filter() *StorageQuotaFilter {
+	return &StorageQuotaFilter{config: _q.config, predicateAdder: _q}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *PositionMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *StorageQuotaMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the PositionMutation builder.
-func (m *PositionMutation) Filter() *PositionFilter {
-	return &PositionFilter{config: m.config, predicateAdder: m}
+// Filter returns an entql.Where implementation to apply filters on the StorageQuotaMutation builder.
+func (m *StorageQuotaMutation) Filter() *StorageQuotaFilter {
+	return &StorageQuotaFilter{config: m.config, predicateAdder: m}
 }
 
-// PositionFilter provides a generic filtering capability at runtime for PositionQuery.
-type PositionFilter struct {
+// StorageQuotaFilter provides a generic filtering capability at runtime for StorageQuotaQuery.
+type StorageQuotaFilter struct {
 	predicateAdder
 	config
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *PositionFilter) Where(p entql.P) {
+func (f *StorageQuotaFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[27].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[39].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
 }
 
 // WhereID applies the entql uint32 predicate on the id field.
-func (f *PositionFilter) WhereID(p entql.Uint32P) {
-	f.Where(p.Field(position.FieldID))
+func (f *StorageQuotaFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(storagequota.FieldID))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *PositionFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(position.FieldCreatedAt))
+func (f *StorageQuotaFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(storagequota.FieldCreatedAt))
 }
 
 // WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
-func (f *PositionFilter) WhereUpdatedAt(p entql.TimeP) {
-	f.Where(p.Field(position.FieldUpdatedAt))
+func (f *StorageQuotaFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(storagequota.FieldUpdatedAt))
 }
 
 // WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
-func (f *PositionFilter) WhereDeletedAt(p entql.TimeP) {
-	f.Where(p.Field(position.FieldDeletedAt))
+func (f *StorageQuotaFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(storagequota.FieldDeletedAt))
 }
 
 // WhereCreatedBy applies the entql uint32 predicate on the created_by field.
-func (f *PositionFilter) WhereCreatedBy(p entql.Uint32P) {
-	f.Where(p.Field(position.FieldCreatedBy))
+func (f *StorageQuotaFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(storagequota.FieldCreatedBy))
 }
 
 // WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
-func (f *PositionFilter) WhereUpdatedBy(p entql.Uint32P) {
-	f.Where(p.Field(position.FieldUpdatedBy))
+func (f *StorageQuotaFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(storagequota.FieldUpdatedBy))
 }
 
 // WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
-func (f *PositionFilter) WhereDeletedBy(p entql.Uint32P) {
-	f.Where(p.Field(position.FieldDeletedBy))
-}
-
-// WhereSortOrder applies the entql uint32 predicate on the sort_order field.
-func (f *PositionFilter) WhereSortOrder(p entql.Uint32P) {
-	f.Where(p.Field(position.FieldSortOrder))
-}
-
-// WhereRemark applies the entql string predicate on the remark field.
-func (f *PositionFilter) WhereRemark(p entql.StringP) {
-	f.Where(p.Field(position.FieldRemark))
+func (f *StorageQuotaFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(storagequota.FieldDeletedBy))
 }
 
 // WhereTenantID applies the entql uint32 predicate on the tenant_id field.
-func (f *PositionFilter) WhereTenantID(p entql.Uint32P) {
-	f.Where(p.Field(position.FieldTenantID))
-}
-
-// WhereStatus applies the entql string predicate on the status field.
-func (f *PositionFilter) WhereStatus(p entql.StringP) {
-	f.Where(p.Field(position.FieldStatus))
-}
-
-// WhereName applies the entql string predicate on the name field.
-func (f *PositionFilter) WhereName(p entql.StringP) {
-	f.Where(p.Field(position.FieldName))
-}
-
-// WhereCode applies the entql string predicate on the code field.
-func (f *PositionFilter) WhereCode(p entql.StringP) {
-	f.Where(p.Field(position.FieldCode))
-}
-
-// WhereOrgUnitID applies the entql uint32 predicate on the org_unit_id field.
-func (f *PositionFilter) WhereOrgUnitID(p entql.Uint32P) {
-	f.Where(p.Field(position.FieldOrgUnitID))
-}
-
-// WhereReportsToPositionID applies the entql uint32 predicate on the reports_to_position_id field.
-func (f *PositionFilter) WhereReportsToPositionID(p entql.Uint32P) {
-	f.Where(p.Field(position.FieldReportsToPositionID))
-}
-
-// WhereDescription applies the entql string predicate on the description field.
-func (f *PositionFilter) WhereDescription(p entql.StringP) {
-	f.Where(p.Field(position.FieldDescription))
-}
-
-// WhereJobFamily applies the entql string predicate on the job_family field.
-func (f *PositionFilter) WhereJobFamily(p entql.StringP) {
-	f.Where(p.Field(position.FieldJobFamily))
+func (f *StorageQuotaFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(storagequota.FieldTenantID))
 }
 
-// WhereJobGrade applies the entql string predicate on the job_grade field.
-func (f *PositionFilter) WhereJobGrade(p entql.StringP) {
-	f.Where(p.Field(position.FieldJobGrade))
+// WhereUserID applies the entql uint32 predicate on the user_id field.
+func (f *StorageQuotaFilter) WhereUserID(p entql.Uint32P) {
+	f.Where(p.Field(storagequota.FieldUserID))
 }
 
-// WhereLevel applies the entql int32 predicate on the level field.
-func (f *PositionFilter) WhereLevel(p entql.Int32P) {
-	f.Where(p.Field(position.FieldLevel))
+// WhereMaxBytes applies the entql int64 predicate on the max_bytes field.
+func (f *StorageQuotaFilter) WhereMaxBytes(p entql.Int64P) {
+	f.Where(p.Field(storagequota.FieldMaxBytes))
 }
 
-// WhereHeadcount applies the entql uint32 predicate on the headcount field.
-func (f *PositionFilter) WhereHeadcount(p entql.Uint32P) {
-	f.Where(p.Field(position.FieldHeadcount))
+// WhereMaxFiles applies the entql int64 predicate on the max_files field.
+func (f *StorageQuotaFilter) WhereMaxFiles(p entql.Int64P) {
+	f.Where(p.Field(storagequota.FieldMaxFiles))
 }
 
-// WhereIsKeyPosition applies the entql bool predicate on the is_key_position field.
-func (f *PositionFilter) WhereIsKeyPosition(p entql.BoolP) {
-	f.Where(p.Field(position.FieldIsKeyPosition))
+// WhereUsedBytes applies the entql int64 predicate on the used_bytes field.
+func (f *StorageQuotaFilter) WhereUsedBytes(p entql.Int64P) {
+	f.Where(p.Field(storagequota.FieldUsedBytes))
 }
 
-// WhereType applies the entql string predicate on the type field.
-func (f *PositionFilter) WhereType(p entql.StringP) {
-	f.Where(p.Field(position.FieldType))
+// WhereUsedFiles applies the entql int64 predicate on the used_files field.
+func (f *StorageQuotaFilter) WhereUsedFiles(p entql.Int64P) {
+	f.Where(p.Field(storagequota.FieldUsedFiles))
 }
 
-// WhereStartAt applies the entql time.Time predicate on the start_at field.
-func (f *PositionFilter) WhereStartAt(p entql.TimeP) {
-	f.Where(p.Field(position.FieldStartAt))
+// WhereNotifiedPercent applies the entql uint32 predicate on the notified_percent field.
+func (f *StorageQuotaFilter) WhereNotifiedPercent(p entql.Uint32P) {
+	f.Where(p.Field(storagequota.FieldNotifiedPercent))
 }
 
-// WhereEndAt applies the entql time.Time predicate on the end_at field.
-func (f *PositionFilter) WhereEndAt(p entql.TimeP) {
-	f.Where(p.Field(position.FieldEndAt))
+// WhereReconciledAt applies the entql time.Time predicate on the reconciled_at field.
+func (f *StorageQuotaFilter) WhereReconciledAt(p entql.TimeP) {
+	f.Where(p.Field(storagequota.FieldReconciledAt))
 }
 
 // addPredicate implements the predicateAdder interface.
-func (_q *RoleQuery) addPredicate(pred func(s *sql.Selector)) {
+func (_q *TaskQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the RoleQuery builder.
-func (_q *RoleQuery) Filter() *RoleFilter {
-	return &RoleFilter{config: _q.config, predicateAdder: _q}
+// Filter returns a Filter implementation to apply filters on the TaskQuery builder.
+func (_q *TaskQuery) Filter() *TaskFilter {
+	return &TaskFilter{config: _q.config, predicateAdder: _q}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *RoleMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *TaskMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the RoleMutation builder.
-func (m *RoleMutation) Filter() *RoleFilter {
-	return &RoleFilter{config: m.config, predicateAdder: m}
+// Filter returns an entql.Where implementation to apply filters on the TaskMutation builder.
+func (m *TaskMutation) Filter() *TaskFilter {
+	return &TaskFilter{config: m.config, predicateAdder: m}
 }
 
-// RoleFilter provides a generic filtering capability at runtime for RoleQuery.
-type RoleFilter struct {
+// TaskFilter provides a generic filtering capability at runtime for TaskQuery.
+type TaskFilter struct {
 	predicateAdder
 	config
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *RoleFilter) Where(p entql.P) {
+func (f *TaskFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[28].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[40].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
 }
 
 // WhereID applies the entql uint32 predicate on the id field.
-func (f *RoleFilter) WhereID(p entql.Uint32P) {
-	f.Where(p.Field(role.FieldID))
+func (f *TaskFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(task.FieldID))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *RoleFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(role.FieldCreatedAt))
+func (f *TaskFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(task.FieldCreatedAt))
 }
 
 // WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
-func (f *RoleFilter) WhereUpdatedAt(p entql.TimeP) {
-	f.Where(p.Field(role.FieldUpdatedAt))
+func (f *TaskFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(task.FieldUpdatedAt))
 }
 
 // WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
-func (f *RoleFilter) WhereDeletedAt(p entql.TimeP) {
-	f.Where(p.Field(role.FieldDeletedAt))
+func (f *TaskFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(task.FieldDeletedAt))
 }
 
 // WhereCreatedBy applies the entql uint32 predicate on the created_by field.
-func (f *RoleFilter) WhereCreatedBy(p entql.Uint32P) {
-	f.Where(p.Field(role.FieldCreatedBy))
+func (f *TaskFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(task.FieldCreatedBy))
 }
 
 // WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
-func (f *RoleFilter) WhereUpdatedBy(p entql.Uint32P) {
-	f.Where(p.Field(role.FieldUpdatedBy))
+func (f *TaskFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(task.FieldUpdatedBy))
 }
 
 // WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
-func (f *RoleFilter) WhereDeletedBy(p entql.Uint32P) {
-	f.Where(p.Field(role.FieldDeletedBy))
+func (f *TaskFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(task.FieldDeletedBy))
 }
 
 // WhereRemark applies the entql string predicate on the remark field.
-func (f *RoleFilter) WhereRemark(p entql.StringP) {
-	f.Where(p.Field(role.FieldRemark))
+func (f *TaskFilter) WhereRemark(p entql.StringP) {
+	f.Where(p.Field(task.FieldRemark))
 }
 
-// WhereDescription applies the entql string predicate on the description field.
-func (f *RoleFilter) WhereDescription(p entql.StringP) {
-	f.Where(p.Field(role.FieldDescription))
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *TaskFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(task.FieldTenantID))
 }
 
-// WhereSortOrder applies the entql uint32 predicate on the sort_order field.
-func (f *RoleFilter) WhereSortOrder(p entql.Uint32P) {
-	f.Where(p.Field(role.FieldSortOrder))
+// WhereType applies the entql string predicate on the type field.
+func (f *TaskFilter) WhereType(p entql.StringP) {
+	f.Where(p.Field(task.FieldType))
 }
 
-// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
-func (f *RoleFilter) WhereTenantID(p entql.Uint32P) {
-	f.Where(p.Field(role.FieldTenantID))
+// WhereTypeName applies the entql string predicate on the type_name field.
+func (f *TaskFilter) WhereTypeName(p entql.StringP) {
+	f.Where(p.Field(task.FieldTypeName))
 }
 
-// WhereStatus applies the entql string predicate on the status field.
-func (f *RoleFilter) WhereStatus(p entql.StringP) {
-	f.Where(p.Field(role.FieldStatus))
+// WhereTaskPayload applies the entql string predicate on the task_payload field.
+func (f *TaskFilter) WhereTaskPayload(p entql.StringP) {
+	f.Where(p.Field(task.FieldTaskPayload))
 }
 
-// WhereName applies the entql string predicate on the name field.
-func (f *RoleFilter) WhereName(p entql.StringP) {
-	f.Where(p.Field(role.FieldName))
+// WhereCronSpec applies the entql string predicate on the cron_spec field.
+func (f *TaskFilter) WhereCronSpec(p entql.StringP) {
+	f.Where(p.Field(task.FieldCronSpec))
 }
 
-// WhereCode applies the entql string predicate on the code field.
-func (f *RoleFilter) WhereCode(p entql.StringP) {
-	f.Where(p.Field(role.FieldCode))
+// WhereTimezone applies the entql string predicate on the timezone field.
+func (f *TaskFilter) WhereTimezone(p entql.StringP) {
+	f.Where(p.Field(task.FieldTimezone))
 }
 
-// WhereIsProtected applies the entql bool predicate on the is_protected field.
-func (f *RoleFilter) WhereIsProtected(p entql.BoolP) {
-	f.Where(p.Field(role.FieldIsProtected))
+// WhereMisfirePolicy applies the entql string predicate on the misfire_policy field.
+func (f *TaskFilter) WhereMisfirePolicy(p entql.StringP) {
+	f.Where(p.Field(task.FieldMisfirePolicy))
 }
 
-// WhereType applies the entql string predicate on the type field.
-func (f *RoleFilter) WhereType(p entql.StringP) {
-	f.Where(p.Field(role.FieldType))
+// WhereTaskOptions applies the entql json.RawMessage predicate on the task_options field.
+func (f *TaskFilter) WhereTaskOptions(p entql.BytesP) {
+	f.Where(p.Field(task.FieldTaskOptions))
+}
+
+// WhereEnable applies the entql bool predicate on the enable field.
+func (f *TaskFilter) WhereEnable(p entql.BoolP) {
+	f.Where(p.Field(task.FieldEnable))
 }
 
 // addPredicate implements the predicateAdder interface.
-func (_q *RoleMetadataQuery) addPredicate(pred func(s *sql.Selector)) {
+func (_q *TaskRunQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the RoleMetadataQuery builder.
-func (_q *RoleMetadataQuery) Filter() *RoleMetadataFilter {
-	return &RoleMetadataFilter{config: _q.config, predicateAdder: _q}
+// Filter returns a Filter implementation to apply filters on the TaskRunQuery builder.
+func (_q *TaskRunQuery) Filter() *TaskRunFilter {
+	return &TaskRunFilter{config: _q.config, predicateAdder: _q}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *RoleMetadataMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *TaskRunMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the RoleMetadataMutation builder.
-func (m *RoleMetadataMutation) Filter() *RoleMetadataFilter {
-	return &RoleMetadataFilter{config: m.config, predicateAdder: m}
+// Filter returns an entql.Where implementation to apply filters on the TaskRunMutation builder.
+func (m *TaskRunMutation) Filter() *TaskRunFilter {
+	return &TaskRunFilter{config: m.config, predicateAdder: m}
 }
 
-// RoleMetadataFilter provides a generic filtering capability at runtime for RoleMetadataQuery.
-type RoleMetadataFilter struct {
+// TaskRunFilter provides a generic filtering capability at runtime for TaskRunQuery.
+type TaskRunFilter struct {
 	predicateAdder
 	config
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *RoleMetadataFilter) Where(p entql.P) {
+func (f *TaskRunFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[29].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[41].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
 }
 
 // WhereID applies the entql uint32 predicate on the id field.
-func (f *RoleMetadataFilter) WhereID(p entql.Uint32P) {
-	f.Where(p.Field(rolemetadata.FieldID))
+func (f *TaskRunFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(taskrun.FieldID))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *RoleMetadataFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(rolemetadata.FieldCreatedAt))
+func (f *TaskRunFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(taskrun.FieldCreatedAt))
 }
 
 // WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
-func (f *RoleMetadataFilter) WhereUpdatedAt(p entql.TimeP) {
-	f.Where(p.Field(rolemetadata.FieldUpdatedAt))
+func (f *TaskRunFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(taskrun.FieldUpdatedAt))
 }
 
 // WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
-func (f *RoleMetadataFilter) WhereDeletedAt(p entql.TimeP) {
-	f.Where(p.Field(rolemetadata.FieldDeletedAt))
+func (f *TaskRunFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(taskrun.FieldDeletedAt))
 }
 
-// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
-func (f *RoleMetadataFilter) WhereCreatedBy(p entql.Uint32P) {
-	f.Where(p.Field(rolemetadata.FieldCreatedBy))
+// WhereTaskID applies the entql string predicate on the task_id field.
+func (f *TaskRunFilter) WhereTaskID(p entql.StringP) {
+	f.Where(p.Field(taskrun.FieldTaskID))
 }
 
-// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
-func (f *RoleMetadataFilter) WhereUpdatedBy(p entql.Uint32P) {
-	f.Where(p.Field(rolemetadata.FieldUpdatedBy))
+// WhereTypeName applies the entql string predicate on the type_name field.
+func (f *TaskRunFilter) WhereTypeName(p entql.StringP) {
+	f.Where(p.Field(taskrun.FieldTypeName))
 }
 
-// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
-func (f *RoleMetadataFilter) WhereDeletedBy(p entql.Uint32P) {
-	f.Where(p.Field(rolemetadata.FieldDeletedBy))
+// WhereQueue applies the entql string predicate on the queue field.
+func (f *TaskRunFilter) WhereQueue(p entql.StringP) {
+	f.Where(p.Field(taskrun.FieldQueue))
 }
 
-// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
-func (f *RoleMetadataFilter) WhereTenantID(p entql.Uint32P) {
-	f.Where(p.Field(rolemetadata.FieldTenantID))
+// WherePayload applies the entql string predicate on the payload field.
+func (f *TaskRunFilter) WherePayload(p entql.StringP) {
+	f.Where(p.Field(taskrun.FieldPayload))
 }
 
-// WhereRoleID applies the entql uint32 predicate on the role_id field.
-func (f *RoleMetadataFilter) WhereRoleID(p entql.Uint32P) {
-	f.Where(p.Field(rolemetadata.FieldRoleID))
+// WhereWorker applies the entql string predicate on the worker field.
+func (f *TaskRunFilter) WhereWorker(p entql.StringP) {
+	f.Where(p.Field(taskrun.FieldWorker))
 }
 
-// WhereIsTemplate applies the entql bool predicate on the is_template field.
-func (f *RoleMetadataFilter) WhereIsTemplate(p entql.BoolP) {
-	f.Where(p.Field(rolemetadata.FieldIsTemplate))
+// WhereAttempt applies the entql uint32 predicate on the attempt field.
+func (f *TaskRunFilter) WhereAttempt(p entql.Uint32P) {
+	f.Where(p.Field(taskrun.FieldAttempt))
 }
 
-// WhereTemplateFor applies the entql string predicate on the template_for field.
-func (f *RoleMetadataFilter) WhereTemplateFor(p entql.StringP) {
-	f.Where(p.Field(rolemetadata.FieldTemplateFor))
+// WhereMaxRetry applies the entql uint32 predicate on the max_retry field.
+func (f *TaskRunFilter) WhereMaxRetry(p entql.Uint32P) {
+	f.Where(p.Field(taskrun.FieldMaxRetry))
 }
 
-// WhereTemplateVersion applies the entql int32 predicate on the template_version field.
-func (f *RoleMetadataFilter) WhereTemplateVersion(p entql.Int32P) {
-	f.Where(p.Field(rolemetadata.FieldTemplateVersion))
+// WhereStatus applies the entql string predicate on the status field.
+func (f *TaskRunFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(taskrun.FieldStatus))
 }
 
-// WhereLastSyncedVersion applies the entql int32 predicate on the last_synced_version field.
-func (f *RoleMetadataFilter) WhereLastSyncedVersion(p entql.Int32P) {
-	f.Where(p.Field(rolemetadata.FieldLastSyncedVersion))
+// WhereStartedAt applies the entql time.Time predicate on the started_at field.
+func (f *TaskRunFilter) WhereStartedAt(p entql.TimeP) {
+	f.Where(p.Field(taskrun.FieldStartedAt))
 }
 
-// WhereLastSyncedAt applies the entql time.Time predicate on the last_synced_at field.
-func (f *RoleMetadataFilter) WhereLastSyncedAt(p entql.TimeP) {
-	f.Where(p.Field(rolemetadata.FieldLastSyncedAt))
+// WhereFinishedAt applies the entql time.Time predicate on the finished_at field.
+func (f *TaskRunFilter) WhereFinishedAt(p entql.TimeP) {
+	f.Where(p.Field(taskrun.FieldFinishedAt))
 }
 
-// WhereSyncPolicy applies the entql string predicate on the sync_policy field.
-func (f *RoleMetadataFilter) WhereSyncPolicy(p entql.StringP) {
-	f.Where(p.Field(rolemetadata.FieldSyncPolicy))
+// WhereDurationMs applies the entql uint64 predicate on the duration_ms field.
+func (f *TaskRunFilter) WhereDurationMs(p entql.Uint64P) {
+	f.Where(p.Field(taskrun.FieldDurationMs))
 }
 
-// WhereScope applies the entql string predicate on the scope field.
-func (f *RoleMetadataFilter) WhereScope(p entql.StringP) {
-	f.Where(p.Field(rolemetadata.FieldScope))
+// WhereResult applies the entql string predicate on the result field.
+func (f *TaskRunFilter) WhereResult(p entql.StringP) {
+	f.Where(p.Field(taskrun.FieldResult))
 }
 
-// WhereCustomOverrides applies the entql json.RawMessage predicate on the custom_overrides field.
-func (f *RoleMetadataFilter) WhereCustomOverrides(p entql.BytesP) {
-	f.Where(p.Field(rolemetadata.FieldCustomOverrides))
+// WhereErrorMessage applies the entql string predicate on the error_message field.
+func (f *TaskRunFilter) WhereErrorMessage(p entql.StringP) {
+	f.Where(p.Field(taskrun.FieldErrorMessage))
+}
+
+// WhereLogs applies the entql json.RawMessage predicate on the logs field.
+func (f *TaskRunFilter) WhereLogs(p entql.BytesP) {
+	f.Where(p.Field(taskrun.FieldLogs))
+}
+
+// WhereLogsTruncated applies the entql bool predicate on the logs_truncated field.
+func (f *TaskRunFilter) WhereLogsTruncated(p entql.BoolP) {
+	f.Where(p.Field(taskrun.FieldLogsTruncated))
+}
+
+// WhereProgress applies the entql json.RawMessage predicate on the progress field.
+func (f *TaskRunFilter) WhereProgress(p entql.BytesP) {
+	f.Where(p.Field(taskrun.FieldProgress))
 }
 
 // addPredicate implements the predicateAdder interface.
-func (_q *RolePermissionQuery) addPredicate(pred func(s *sql.Selector)) {
+func (_q *TaskWorkflowQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the RolePermissionQuery builder.
-func (_q *RolePermissionQuery) Filter() *RolePermissionFilter {
-	return &RolePermissionFilter{config: _q.config, predicateAdder: _q}
+// Filter returns a Filter implementation to apply filters on the TaskWorkflowQuery builder.
+func (_q *TaskWorkflowQuery) Filter() *TaskWorkflowFilter {
+	return &TaskWorkflowFilter{config: _q.config, predicateAdder: _q}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *RolePermissionMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *TaskWorkflowMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the RolePermissionMutation builder.
-func (m *RolePermissionMutation) Filter() *RolePermissionFilter {
-	return &RolePermissionFilter{config: m.config, predicateAdder: m}
+// Filter returns an entql.Where implementation to apply filters on the TaskWorkflowMutation builder.
+func (m *TaskWorkflowMutation) Filter() *TaskWorkflowFilter {
+	return &TaskWorkflowFilter{config: m.config, predicateAdder: m}
 }
 
-// RolePermissionFilter provides a generic filtering capability at runtime for RolePermissionQuery.
-type RolePermissionFilter struct {
+// TaskWorkflowFilter provides a generic filtering capability at runtime for TaskWorkflowQuery.
+type TaskWorkflowFilter struct {
 	predicateAdder
 	config
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *RolePermissionFilter) Where(p entql.P) {
+func (f *TaskWorkflowFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[30].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[42].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
 }
 
 // WhereID applies the entql uint32 predicate on the id field.
-func (f *RolePermissionFilter) WhereID(p entql.Uint32P) {
-	f.Where(p.Field(rolepermission.FieldID))
+func (f *TaskWorkflowFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(taskworkflow.FieldID))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *RolePermissionFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(rolepermission.FieldCreatedAt))
+func (f *TaskWorkflowFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(taskworkflow.FieldCreatedAt))
 }
 
 // WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
-func (f *RolePermissionFilter) WhereUpdatedAt(p entql.TimeP) {
-	f.Where(p.Field(rolepermission.FieldUpdatedAt))
+func (f *TaskWorkflowFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(taskworkflow.FieldUpdatedAt))
 }
 
 // WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
-func (f *RolePermissionFilter) WhereDeletedAt(p entql.TimeP) {
-	f.Where(p.Field(rolepermission.FieldDeletedAt))
+func (f *TaskWorkflowFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(taskworkflow.FieldDeletedAt))
 }
 
 // WhereCreatedBy applies the entql uint32 predicate on the created_by field.
-func (f *RolePermissionFilter) WhereCreatedBy(p entql.Uint32P) {
-	f.Where(p.Field(rolepermission.FieldCreatedBy))
+func (f *TaskWorkflowFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(taskworkflow.FieldCreatedBy))
 }
 
 // WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
-func (f *RolePermissionFilter) WhereUpdatedBy(p entql.Uint32P) {
-	f.Where(p.Field(rolepermission.FieldUpdatedBy))
+func (f *TaskWorkflowFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(taskworkflow.FieldUpdatedBy))
 }
 
 // WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
-func (f *RolePermissionFilter) WhereDeletedBy(p entql.Uint32P) {
-	f.Where(p.Field(rolepermission.FieldDeletedBy))
+func (f *TaskWorkflowFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(taskworkflow.FieldDeletedBy))
 }
 
 // WhereTenantID applies the entql uint32 predicate on the tenant_id field.
-func (f *RolePermissionFilter) WhereTenantID(p entql.Uint32P) {
-	f.Where(p.Field(rolepermission.FieldTenantID))
+func (f *TaskWorkflowFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(taskworkflow.FieldTenantID))
 }
 
-// WhereStatus applies the entql string predicate on the status field.
-func (f *RolePermissionFilter) WhereStatus(p entql.StringP) {
-	f.Where(p.Field(rolepermission.FieldStatus))
-}
-
-// WhereRoleID applies the entql uint32 predicate on the role_id field.
-func (f *RolePermissionFilter) WhereRoleID(p entql.Uint32P) {
-	f.Where(p.Field(rolepermission.FieldRoleID))
+// WhereName applies the entql string predicate on the name field.
+func (f *TaskWorkflowFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(taskworkflow.FieldName))
 }
 
-// WherePermissionID applies the entql uint32 predicate on the permission_id field.
-func (f *RolePermissionFilter) WherePermissionID(p entql.Uint32P) {
-	f.Where(p.Field(rolepermission.FieldPermissionID))
+// WhereDescription applies the entql string predicate on the description field.
+func (f *TaskWorkflowFilter) WhereDescription(p entql.StringP) {
+	f.Where(p.Field(taskworkflow.FieldDescription))
 }
 
-// WhereEffect applies the entql string predicate on the effect field.
-func (f *RolePermissionFilter) WhereEffect(p entql.StringP) {
-	f.Where(p.Field(rolepermission.FieldEffect))
+// WhereSteps applies the entql json.RawMessage predicate on the steps field.
+func (f *TaskWorkflowFilter) WhereSteps(p entql.BytesP) {
+	f.Where(p.Field(taskworkflow.FieldSteps))
 }
 
-// WherePriority applies the entql int32 predicate on the priority field.
-func (f *RolePermissionFilter) WherePriority(p entql.Int32P) {
-	f.Where(p.Field(rolepermission.FieldPriority))
+// WhereEnable applies the entql bool predicate on the enable field.
+func (f *TaskWorkflowFilter) WhereEnable(p entql.BoolP) {
+	f.Where(p.Field(taskworkflow.FieldEnable))
 }
 
 // addPredicate implements the predicateAdder interface.
-func (_q *TaskQuery) addPredicate(pred func(s *sql.Selector)) {
+func (_q *TaskWorkflowRunQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the TaskQuery builder.
-func (_q *TaskQuery) Filter() *TaskFilter {
-	return &TaskFilter{config: _q.config, predicateAdder: _q}
+// Filter returns a Filter implementation to apply filters on the TaskWorkflowRunQuery builder.
+func (_q *TaskWorkflowRunQuery) Filter() *TaskWorkflowRunFilter {
+	return &TaskWorkflowRunFilter{config: _q.config, predicateAdder: _q}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *TaskMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *TaskWorkflowRunMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the TaskMutation builder.
-func (m *TaskMutation) Filter() *TaskFilter {
-	return &TaskFilter{config: m.config, predicateAdder: m}
+// Filter returns an entql.Where implementation to apply filters on the TaskWorkflowRunMutation builder.
+func (m *TaskWorkflowRunMutation) Filter() *TaskWorkflowRunFilter {
+	return &TaskWorkflowRunFilter{config: m.config, predicateAdder: m}
 }
 
-// TaskFilter provides a generic filtering capability at runtime for TaskQuery.
-type TaskFilter struct {
+// TaskWorkflowRunFilter provides a generic filtering capability at runtime for TaskWorkflowRunQuery.
+type TaskWorkflowRunFilter struct {
 	predicateAdder
 	config
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *TaskFilter) Where(p entql.P) {
+func (f *TaskWorkflowRunFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[31].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[43].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
 }
 
 // WhereID applies the entql uint32 predicate on the id field.
-func (f *TaskFilter) WhereID(p entql.Uint32P) {
-	f.Where(p.Field(task.FieldID))
+func (f *TaskWorkflowRunFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(taskworkflowrun.FieldID))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *TaskFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(task.FieldCreatedAt))
+func (f *TaskWorkflowRunFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(taskworkflowrun.FieldCreatedAt))
 }
 
 // WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
-func (f *TaskFilter) WhereUpdatedAt(p entql.TimeP) {
-	f.Where(p.Field(task.FieldUpdatedAt))
+func (f *TaskWorkflowRunFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(taskworkflowrun.FieldUpdatedAt))
 }
 
 // WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
-func (f *TaskFilter) WhereDeletedAt(p entql.TimeP) {
-	f.Where(p.Field(task.FieldDeletedAt))
+func (f *TaskWorkflowRunFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(taskworkflowrun.FieldDeletedAt))
 }
 
 // WhereCreatedBy applies the entql uint32 predicate on the created_by field.
-func (f *TaskFilter) WhereCreatedBy(p entql.Uint32P) {
-	f.Where(p.Field(task.FieldCreatedBy))
+func (f *TaskWorkflowRunFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(taskworkflowrun.FieldCreatedBy))
 }
 
-// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
-func (f *TaskFilter) WhereUpdatedBy(p entql.Uint32P) {
-	f.Where(p.Field(task.FieldUpdatedBy))
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *TaskWorkflowRunFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(taskworkflowrun.FieldTenantID))
 }
 
-// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
-func (f *TaskFilter) WhereDeletedBy(p entql.Uint32P) {
-	f.Where(p.Field(task.FieldDeletedBy))
+// WhereWorkflowID applies the entql uint32 predicate on the workflow_id field.
+func (f *TaskWorkflowRunFilter) WhereWorkflowID(p entql.Uint32P) {
+	f.Where(p.Field(taskworkflowrun.FieldWorkflowID))
 }
 
-// WhereRemark applies the entql string predicate on the remark field.
-func (f *TaskFilter) WhereRemark(p entql.StringP) {
-	f.Where(p.Field(task.FieldRemark))
+// WhereWorkflowName applies the entql string predicate on the workflow_name field.
+func (f *TaskWorkflowRunFilter) WhereWorkflowName(p entql.StringP) {
+	f.Where(p.Field(taskworkflowrun.FieldWorkflowName))
 }
 
-// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
-func (f *TaskFilter) WhereTenantID(p entql.Uint32P) {
-	f.Where(p.Field(task.FieldTenantID))
+// WhereStatus applies the entql string predicate on the status field.
+func (f *TaskWorkflowRunFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(taskworkflowrun.FieldStatus))
 }
 
-// WhereType applies the entql string predicate on the type field.
-func (f *TaskFilter) WhereType(p entql.StringP) {
-	f.Where(p.Field(task.FieldType))
+// WhereInput applies the entql string predicate on the input field.
+func (f *TaskWorkflowRunFilter) WhereInput(p entql.StringP) {
+	f.Where(p.Field(taskworkflowrun.FieldInput))
 }
 
-// WhereTypeName applies the entql string predicate on the type_name field.
-func (f *TaskFilter) WhereTypeName(p entql.StringP) {
-	f.Where(p.Field(task.FieldTypeName))
+// WhereSteps applies the entql json.RawMessage predicate on the steps field.
+func (f *TaskWorkflowRunFilter) WhereSteps(p entql.BytesP) {
+	f.Where(p.Field(taskworkflowrun.FieldSteps))
 }
 
-// WhereTaskPayload applies the entql string predicate on the task_payload field.
-func (f *TaskFilter) WhereTaskPayload(p entql.StringP) {
-	f.Where(p.Field(task.FieldTaskPayload))
+// WhereStepRuns applies the entql json.RawMessage predicate on the step_runs field.
+func (f *TaskWorkflowRunFilter) WhereStepRuns(p entql.BytesP) {
+	f.Where(p.Field(taskworkflowrun.FieldStepRuns))
 }
 
-// WhereCronSpec applies the entql string predicate on the cron_spec field.
-func (f *TaskFilter) WhereCronSpec(p entql.StringP) {
-	f.Where(p.Field(task.FieldCronSpec))
+// WhereErrorMessage applies the entql string predicate on the error_message field.
+func (f *TaskWorkflowRunFilter) WhereErrorMessage(p entql.StringP) {
+	f.Where(p.Field(taskworkflowrun.FieldErrorMessage))
 }
 
-// WhereTaskOptions applies the entql json.RawMessage predicate on the task_options field.
-func (f *TaskFilter) WhereTaskOptions(p entql.BytesP) {
-	f.Where(p.Field(task.FieldTaskOptions))
+// WhereStartedAt applies the entql time.Time predicate on the started_at field.
+func (f *TaskWorkflowRunFilter) WhereStartedAt(p entql.TimeP) {
+	f.Where(p.Field(taskworkflowrun.FieldStartedAt))
 }
 
-// WhereEnable applies the entql bool predicate on the enable field.
-func (f *TaskFilter) WhereEnable(p entql.BoolP) {
-	f.Where(p.Field(task.FieldEnable))
+// WhereFinishedAt applies the entql time.Time predicate on the finished_at field.
+func (f *TaskWorkflowRunFilter) WhereFinishedAt(p entql.TimeP) {
+	f.Where(p.Field(taskworkflowrun.FieldFinishedAt))
 }
 
 // addPredicate implements the predicateAdder interface.
@@ -5217,7 +7003,7 @@ type TenantFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *TenantFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[32].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[44].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -5328,6 +7114,26 @@ func (f *TenantFilter) WhereExpiredAt(p entql.TimeP) {
 	f.Where(p.Field(tenant.FieldExpiredAt))
 }
 
+// WhereHighRiskLoginAction applies the entql string predicate on the high_risk_login_action field.
+func (f *TenantFilter) WhereHighRiskLoginAction(p entql.StringP) {
+	f.Where(p.Field(tenant.FieldHighRiskLoginAction))
+}
+
+// WhereStorageProvider applies the entql string predicate on the storage_provider field.
+func (f *TenantFilter) WhereStorageProvider(p entql.StringP) {
+	f.Where(p.Field(tenant.FieldStorageProvider))
+}
+
+// WhereFileDedupPolicy applies the entql string predicate on the file_dedup_policy field.
+func (f *TenantFilter) WhereFileDedupPolicy(p entql.StringP) {
+	f.Where(p.Field(tenant.FieldFileDedupPolicy))
+}
+
+// WhereRecycleBinRetentionDays applies the entql uint32 predicate on the recycle_bin_retention_days field.
+func (f *TenantFilter) WhereRecycleBinRetentionDays(p entql.Uint32P) {
+	f.Where(p.Field(tenant.FieldRecycleBinRetentionDays))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *UserQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -5357,7 +7163,7 @@ type UserFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UserFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[33].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[45].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -5512,7 +7318,7 @@ type UserCredentialFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UserCredentialFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[34].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[46].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -5652,7 +7458,7 @@ type UserOrgUnitFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UserOrgUnitFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[35].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[47].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -5777,7 +7583,7 @@ type UserPositionFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UserPositionFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[36].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[48].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -5897,7 +7703,7 @@ type UserRoleFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UserRoleFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[37].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[49].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
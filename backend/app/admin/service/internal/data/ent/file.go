@@ -55,7 +55,25 @@ type File struct {
 	// 链接地址
 	LinkURL *string `json:"link_url,omitempty"`
 	// 文件内容hash值，防止上传重复文件
-	ContentHash  *string `json:"content_hash,omitempty"`
+	ContentHash *string `json:"content_hash,omitempty"`
+	// 物理对象ID，去重后多个文件可引用同一物理对象
+	StorageObjectID *uint32 `json:"storage_object_id,omitempty"`
+	// 物理对象名，去重或隔离后可与文件目录不同，为空时由文件目录和保存文件名组成
+	ObjectName *string `json:"object_name,omitempty"`
+	// 恶意文件扫描状态，为空表示启用扫描前上传的文件
+	ScanStatus *file.ScanStatus `json:"scan_status,omitempty"`
+	// 检出的恶意特征名称或扫描失败原因
+	ScanSignature *string `json:"scan_signature,omitempty"`
+	// 扫描时间
+	ScannedAt *time.Time `json:"scanned_at,omitempty"`
+	// 版本号，同一目录下同名文件的每次上传为一个版本
+	Version uint32 `json:"version,omitempty"`
+	// 是否为当前版本
+	IsLatest bool `json:"is_latest,omitempty"`
+	// 版本组标识，同一文件的全部版本相同，为空时以文件Guid作为版本组
+	VersionGroup *string `json:"version_group,omitempty"`
+	// 当前版本唯一键，由租户、目录和文件名计算，只在未删除的当前版本上设置，保证同一文件只有一个当前版本
+	LatestKey    *string `json:"latest_key,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -64,11 +82,13 @@ func (*File) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case file.FieldID, file.FieldCreatedBy, file.FieldUpdatedBy, file.FieldDeletedBy, file.FieldTenantID, file.FieldSize:
+		case file.FieldIsLatest:
+			values[i] = new(sql.NullBool)
+		case file.FieldID, file.FieldCreatedBy, file.FieldUpdatedBy, file.FieldDeletedBy, file.FieldTenantID, file.FieldSize, file.FieldStorageObjectID, file.FieldVersion:
 			values[i] = new(sql.NullInt64)
-		case file.FieldRemark, file.FieldProvider, file.FieldBucketName, file.FieldFileDirectory, file.FieldFileGUID, file.FieldSaveFileName, file.FieldFileName, file.FieldExtension, file.FieldSizeFormat, file.FieldLinkURL, file.FieldContentHash:
+		case file.FieldRemark, file.FieldProvider, file.FieldBucketName, file.FieldFileDirectory, file.FieldFileGUID, file.FieldSaveFileName, file.FieldFileName, file.FieldExtension, file.FieldSizeFormat, file.FieldLinkURL, file.FieldContentHash, file.FieldObjectName, file.FieldScanStatus, file.FieldScanSignature, file.FieldVersionGroup, file.FieldLatestKey:
 			values[i] = new(sql.NullString)
-		case file.FieldCreatedAt, file.FieldUpdatedAt, file.FieldDeletedAt:
+		case file.FieldCreatedAt, file.FieldUpdatedAt, file.FieldDeletedAt, file.FieldScannedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -224,6 +244,67 @@ func (_m *File) assignValues(columns []string, values []any) error {
 				_m.ContentHash = new(string)
 				*_m.ContentHash = value.String
 			}
+		case file.FieldStorageObjectID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field storage_object_id", values[i])
+			} else if value.Valid {
+				_m.StorageObjectID = new(uint32)
+				*_m.StorageObjectID = uint32(value.Int64)
+			}
+		case file.FieldObjectName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field object_name", values[i])
+			} else if value.Valid {
+				_m.ObjectName = new(string)
+				*_m.ObjectName = value.String
+			}
+		case file.FieldScanStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scan_status", values[i])
+			} else if value.Valid {
+				_m.ScanStatus = new(file.ScanStatus)
+				*_m.ScanStatus = file.ScanStatus(value.String)
+			}
+		case file.FieldScanSignature:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scan_signature", values[i])
+			} else if value.Valid {
+				_m.ScanSignature = new(string)
+				*_m.ScanSignature = value.String
+			}
+		case file.FieldScannedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field scanned_at", values[i])
+			} else if value.Valid {
+				_m.ScannedAt = new(time.Time)
+				*_m.ScannedAt = value.Time
+			}
+		case file.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = uint32(value.Int64)
+			}
+		case file.FieldIsLatest:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_latest", values[i])
+			} else if value.Valid {
+				_m.IsLatest = value.Bool
+			}
+		case file.FieldVersionGroup:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field version_group", values[i])
+			} else if value.Valid {
+				_m.VersionGroup = new(string)
+				*_m.VersionGroup = value.String
+			}
+		case file.FieldLatestKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field latest_key", values[i])
+			} else if value.Valid {
+				_m.LatestKey = new(string)
+				*_m.LatestKey = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -354,6 +435,47 @@ func (_m *File) String() string {
 		builder.WriteString("content_hash=")
 		builder.WriteString(*v)
 	}
+	builder.WriteString(", ")
+	if v := _m.StorageObjectID; v != nil {
+		builder.WriteString("storage_object_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ObjectName; v != nil {
+		builder.WriteString("object_name=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ScanStatus; v != nil {
+		builder.WriteString("scan_status=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ScanSignature; v != nil {
+		builder.WriteString("scan_signature=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ScannedAt; v != nil {
+		builder.WriteString("scanned_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
+	builder.WriteString(", ")
+	builder.WriteString("is_latest=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsLatest))
+	builder.WriteString(", ")
+	if v := _m.VersionGroup; v != nil {
+		builder.WriteString("version_group=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.LatestKey; v != nil {
+		builder.WriteString("latest_key=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
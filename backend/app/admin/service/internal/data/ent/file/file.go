@@ -52,6 +52,24 @@ const (
 	FieldLinkURL = "link_url"
 	// FieldContentHash holds the string denoting the content_hash field in the database.
 	FieldContentHash = "content_hash"
+	// FieldStorageObjectID holds the string denoting the storage_object_id field in the database.
+	FieldStorageObjectID = "storage_object_id"
+	// FieldObjectName holds the string denoting the object_name field in the database.
+	FieldObjectName = "object_name"
+	// FieldScanStatus holds the string denoting the scan_status field in the database.
+	FieldScanStatus = "scan_status"
+	// FieldScanSignature holds the string denoting the scan_signature field in the database.
+	FieldScanSignature = "scan_signature"
+	// FieldScannedAt holds the string denoting the scanned_at field in the database.
+	FieldScannedAt = "scanned_at"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldIsLatest holds the string denoting the is_latest field in the database.
+	FieldIsLatest = "is_latest"
+	// FieldVersionGroup holds the string denoting the version_group field in the database.
+	FieldVersionGroup = "version_group"
+	// FieldLatestKey holds the string denoting the latest_key field in the database.
+	FieldLatestKey = "latest_key"
 	// Table holds the table name of the file in the database.
 	Table = "files"
 )
@@ -78,6 +96,15 @@ var Columns = []string{
 	FieldSizeFormat,
 	FieldLinkURL,
 	FieldContentHash,
+	FieldStorageObjectID,
+	FieldObjectName,
+	FieldScanStatus,
+	FieldScanSignature,
+	FieldScannedAt,
+	FieldVersion,
+	FieldIsLatest,
+	FieldVersionGroup,
+	FieldLatestKey,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -100,6 +127,10 @@ var (
 	Policy ent.Policy
 	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
 	DefaultTenantID uint32
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion uint32
+	// DefaultIsLatest holds the default value on creation for the "is_latest" field.
+	DefaultIsLatest bool
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(uint32) error
 )
@@ -139,6 +170,32 @@ func ProviderValidator(pr Provider) error {
 	}
 }
 
+// ScanStatus defines the type for the "scan_status" enum field.
+type ScanStatus string
+
+// ScanStatus values.
+const (
+	ScanStatusPending  ScanStatus = "SCAN_PENDING"
+	ScanStatusClean    ScanStatus = "SCAN_CLEAN"
+	ScanStatusInfected ScanStatus = "SCAN_INFECTED"
+	ScanStatusFailed   ScanStatus = "SCAN_FAILED"
+	ScanStatusSkipped  ScanStatus = "SCAN_SKIPPED"
+)
+
+func (ss ScanStatus) String() string {
+	return string(ss)
+}
+
+// ScanStatusValidator is a validator for the "scan_status" field enum values. It is called by the builders before save.
+func ScanStatusValidator(ss ScanStatus) error {
+	switch ss {
+	case ScanStatusPending, ScanStatusClean, ScanStatusInfected, ScanStatusFailed, ScanStatusSkipped:
+		return nil
+	default:
+		return fmt.Errorf("file: invalid enum value for scan_status field: %q", ss)
+	}
+}
+
 // OrderOption defines the ordering options for the File queries.
 type OrderOption func(*sql.Selector)
 
@@ -241,3 +298,48 @@ func ByLinkURL(opts ...sql.OrderTermOption) OrderOption {
 func ByContentHash(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldContentHash, opts...).ToFunc()
 }
+
+// ByStorageObjectID orders the results by the storage_object_id field.
+func ByStorageObjectID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStorageObjectID, opts...).ToFunc()
+}
+
+// ByObjectName orders the results by the object_name field.
+func ByObjectName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldObjectName, opts...).ToFunc()
+}
+
+// ByScanStatus orders the results by the scan_status field.
+func ByScanStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScanStatus, opts...).ToFunc()
+}
+
+// ByScanSignature orders the results by the scan_signature field.
+func ByScanSignature(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScanSignature, opts...).ToFunc()
+}
+
+// ByScannedAt orders the results by the scanned_at field.
+func ByScannedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScannedAt, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// ByIsLatest orders the results by the is_latest field.
+func ByIsLatest(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsLatest, opts...).ToFunc()
+}
+
+// ByVersionGroup orders the results by the version_group field.
+func ByVersionGroup(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersionGroup, opts...).ToFunc()
+}
+
+// ByLatestKey orders the results by the latest_key field.
+func ByLatestKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLatestKey, opts...).ToFunc()
+}
@@ -144,6 +144,46 @@ func ContentHash(v string) predicate.File {
 	return predicate.File(sql.FieldEQ(FieldContentHash, v))
 }
 
+// StorageObjectID applies equality check predicate on the "storage_object_id" field. It's identical to StorageObjectIDEQ.
+func StorageObjectID(v uint32) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldStorageObjectID, v))
+}
+
+// ObjectName applies equality check predicate on the "object_name" field. It's identical to ObjectNameEQ.
+func ObjectName(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldObjectName, v))
+}
+
+// ScanSignature applies equality check predicate on the "scan_signature" field. It's identical to ScanSignatureEQ.
+func ScanSignature(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldScanSignature, v))
+}
+
+// ScannedAt applies equality check predicate on the "scanned_at" field. It's identical to ScannedAtEQ.
+func ScannedAt(v time.Time) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldScannedAt, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v uint32) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldVersion, v))
+}
+
+// IsLatest applies equality check predicate on the "is_latest" field. It's identical to IsLatestEQ.
+func IsLatest(v bool) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldIsLatest, v))
+}
+
+// VersionGroup applies equality check predicate on the "version_group" field. It's identical to VersionGroupEQ.
+func VersionGroup(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldVersionGroup, v))
+}
+
+// LatestKey applies equality check predicate on the "latest_key" field. It's identical to LatestKeyEQ.
+func LatestKey(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldLatestKey, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.File {
 	return predicate.File(sql.FieldEQ(FieldCreatedAt, v))
@@ -1324,6 +1364,486 @@ func ContentHashContainsFold(v string) predicate.File {
 	return predicate.File(sql.FieldContainsFold(FieldContentHash, v))
 }
 
+// StorageObjectIDEQ applies the EQ predicate on the "storage_object_id" field.
+func StorageObjectIDEQ(v uint32) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldStorageObjectID, v))
+}
+
+// StorageObjectIDNEQ applies the NEQ predicate on the "storage_object_id" field.
+func StorageObjectIDNEQ(v uint32) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldStorageObjectID, v))
+}
+
+// StorageObjectIDIn applies the In predicate on the "storage_object_id" field.
+func StorageObjectIDIn(vs ...uint32) predicate.File {
+	return predicate.File(sql.FieldIn(FieldStorageObjectID, vs...))
+}
+
+// StorageObjectIDNotIn applies the NotIn predicate on the "storage_object_id" field.
+func StorageObjectIDNotIn(vs ...uint32) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldStorageObjectID, vs...))
+}
+
+// StorageObjectIDGT applies the GT predicate on the "storage_object_id" field.
+func StorageObjectIDGT(v uint32) predicate.File {
+	return predicate.File(sql.FieldGT(FieldStorageObjectID, v))
+}
+
+// StorageObjectIDGTE applies the GTE predicate on the "storage_object_id" field.
+func StorageObjectIDGTE(v uint32) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldStorageObjectID, v))
+}
+
+// StorageObjectIDLT applies the LT predicate on the "storage_object_id" field.
+func StorageObjectIDLT(v uint32) predicate.File {
+	return predicate.File(sql.FieldLT(FieldStorageObjectID, v))
+}
+
+// StorageObjectIDLTE applies the LTE predicate on the "storage_object_id" field.
+func StorageObjectIDLTE(v uint32) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldStorageObjectID, v))
+}
+
+// StorageObjectIDIsNil applies the IsNil predicate on the "storage_object_id" field.
+func StorageObjectIDIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldStorageObjectID))
+}
+
+// StorageObjectIDNotNil applies the NotNil predicate on the "storage_object_id" field.
+func StorageObjectIDNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldStorageObjectID))
+}
+
+// ObjectNameEQ applies the EQ predicate on the "object_name" field.
+func ObjectNameEQ(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldObjectName, v))
+}
+
+// ObjectNameNEQ applies the NEQ predicate on the "object_name" field.
+func ObjectNameNEQ(v string) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldObjectName, v))
+}
+
+// ObjectNameIn applies the In predicate on the "object_name" field.
+func ObjectNameIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldIn(FieldObjectName, vs...))
+}
+
+// ObjectNameNotIn applies the NotIn predicate on the "object_name" field.
+func ObjectNameNotIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldObjectName, vs...))
+}
+
+// ObjectNameGT applies the GT predicate on the "object_name" field.
+func ObjectNameGT(v string) predicate.File {
+	return predicate.File(sql.FieldGT(FieldObjectName, v))
+}
+
+// ObjectNameGTE applies the GTE predicate on the "object_name" field.
+func ObjectNameGTE(v string) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldObjectName, v))
+}
+
+// ObjectNameLT applies the LT predicate on the "object_name" field.
+func ObjectNameLT(v string) predicate.File {
+	return predicate.File(sql.FieldLT(FieldObjectName, v))
+}
+
+// ObjectNameLTE applies the LTE predicate on the "object_name" field.
+func ObjectNameLTE(v string) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldObjectName, v))
+}
+
+// ObjectNameContains applies the Contains predicate on the "object_name" field.
+func ObjectNameContains(v string) predicate.File {
+	return predicate.File(sql.FieldContains(FieldObjectName, v))
+}
+
+// ObjectNameHasPrefix applies the HasPrefix predicate on the "object_name" field.
+func ObjectNameHasPrefix(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefix(FieldObjectName, v))
+}
+
+// ObjectNameHasSuffix applies the HasSuffix predicate on the "object_name" field.
+func ObjectNameHasSuffix(v string) predicate.File {
+	return predicate.File(sql.FieldHasSuffix(FieldObjectName, v))
+}
+
+// ObjectNameIsNil applies the IsNil predicate on the "object_name" field.
+func ObjectNameIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldObjectName))
+}
+
+// ObjectNameNotNil applies the NotNil predicate on the "object_name" field.
+func ObjectNameNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldObjectName))
+}
+
+// ObjectNameEqualFold applies the EqualFold predicate on the "object_name" field.
+func ObjectNameEqualFold(v string) predicate.File {
+	return predicate.File(sql.FieldEqualFold(FieldObjectName, v))
+}
+
+// ObjectNameContainsFold applies the ContainsFold predicate on the "object_name" field.
+func ObjectNameContainsFold(v string) predicate.File {
+	return predicate.File(sql.FieldContainsFold(FieldObjectName, v))
+}
+
+// ScanStatusEQ applies the EQ predicate on the "scan_status" field.
+func ScanStatusEQ(v ScanStatus) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldScanStatus, v))
+}
+
+// ScanStatusNEQ applies the NEQ predicate on the "scan_status" field.
+func ScanStatusNEQ(v ScanStatus) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldScanStatus, v))
+}
+
+// ScanStatusIn applies the In predicate on the "scan_status" field.
+func ScanStatusIn(vs ...ScanStatus) predicate.File {
+	return predicate.File(sql.FieldIn(FieldScanStatus, vs...))
+}
+
+// ScanStatusNotIn applies the NotIn predicate on the "scan_status" field.
+func ScanStatusNotIn(vs ...ScanStatus) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldScanStatus, vs...))
+}
+
+// ScanStatusIsNil applies the IsNil predicate on the "scan_status" field.
+func ScanStatusIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldScanStatus))
+}
+
+// ScanStatusNotNil applies the NotNil predicate on the "scan_status" field.
+func ScanStatusNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldScanStatus))
+}
+
+// ScanSignatureEQ applies the EQ predicate on the "scan_signature" field.
+func ScanSignatureEQ(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldScanSignature, v))
+}
+
+// ScanSignatureNEQ applies the NEQ predicate on the "scan_signature" field.
+func ScanSignatureNEQ(v string) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldScanSignature, v))
+}
+
+// ScanSignatureIn applies the In predicate on the "scan_signature" field.
+func ScanSignatureIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldIn(FieldScanSignature, vs...))
+}
+
+// ScanSignatureNotIn applies the NotIn predicate on the "scan_signature" field.
+func ScanSignatureNotIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldScanSignature, vs...))
+}
+
+// ScanSignatureGT applies the GT predicate on the "scan_signature" field.
+func ScanSignatureGT(v string) predicate.File {
+	return predicate.File(sql.FieldGT(FieldScanSignature, v))
+}
+
+// ScanSignatureGTE applies the GTE predicate on the "scan_signature" field.
+func ScanSignatureGTE(v string) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldScanSignature, v))
+}
+
+// ScanSignatureLT applies the LT predicate on the "scan_signature" field.
+func ScanSignatureLT(v string) predicate.File {
+	return predicate.File(sql.FieldLT(FieldScanSignature, v))
+}
+
+// ScanSignatureLTE applies the LTE predicate on the "scan_signature" field.
+func ScanSignatureLTE(v string) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldScanSignature, v))
+}
+
+// ScanSignatureContains applies the Contains predicate on the "scan_signature" field.
+func ScanSignatureContains(v string) predicate.File {
+	return predicate.File(sql.FieldContains(FieldScanSignature, v))
+}
+
+// ScanSignatureHasPrefix applies the HasPrefix predicate on the "scan_signature" field.
+func ScanSignatureHasPrefix(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefix(FieldScanSignature, v))
+}
+
+// ScanSignatureHasSuffix applies the HasSuffix predicate on the "scan_signature" field.
+func ScanSignatureHasSuffix(v string) predicate.File {
+	return predicate.File(sql.FieldHasSuffix(FieldScanSignature, v))
+}
+
+// ScanSignatureIsNil applies the IsNil predicate on the "scan_signature" field.
+func ScanSignatureIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldScanSignature))
+}
+
+// ScanSignatureNotNil applies the NotNil predicate on the "scan_signature" field.
+func ScanSignatureNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldScanSignature))
+}
+
+// ScanSignatureEqualFold applies the EqualFold predicate on the "scan_signature" field.
+func ScanSignatureEqualFold(v string) predicate.File {
+	return predicate.File(sql.FieldEqualFold(FieldScanSignature, v))
+}
+
+// ScanSignatureContainsFold applies the ContainsFold predicate on the "scan_signature" field.
+func ScanSignatureContainsFold(v string) predicate.File {
+	return predicate.File(sql.FieldContainsFold(FieldScanSignature, v))
+}
+
+// ScannedAtEQ applies the EQ predicate on the "scanned_at" field.
+func ScannedAtEQ(v time.Time) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldScannedAt, v))
+}
+
+// ScannedAtNEQ applies the NEQ predicate on the "scanned_at" field.
+func ScannedAtNEQ(v time.Time) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldScannedAt, v))
+}
+
+// ScannedAtIn applies the In predicate on the "scanned_at" field.
+func ScannedAtIn(vs ...time.Time) predicate.File {
+	return predicate.File(sql.FieldIn(FieldScannedAt, vs...))
+}
+
+// ScannedAtNotIn applies the NotIn predicate on the "scanned_at" field.
+func ScannedAtNotIn(vs ...time.Time) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldScannedAt, vs...))
+}
+
+// ScannedAtGT applies the GT predicate on the "scanned_at" field.
+func ScannedAtGT(v time.Time) predicate.File {
+	return predicate.File(sql.FieldGT(FieldScannedAt, v))
+}
+
+// ScannedAtGTE applies the GTE predicate on the "scanned_at" field.
+func ScannedAtGTE(v time.Time) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldScannedAt, v))
+}
+
+// ScannedAtLT applies the LT predicate on the "scanned_at" field.
+func ScannedAtLT(v time.Time) predicate.File {
+	return predicate.File(sql.FieldLT(FieldScannedAt, v))
+}
+
+// ScannedAtLTE applies the LTE predicate on the "scanned_at" field.
+func ScannedAtLTE(v time.Time) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldScannedAt, v))
+}
+
+// ScannedAtIsNil applies the IsNil predicate on the "scanned_at" field.
+func ScannedAtIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldScannedAt))
+}
+
+// ScannedAtNotNil applies the NotNil predicate on the "scanned_at" field.
+func ScannedAtNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldScannedAt))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v uint32) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v uint32) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...uint32) predicate.File {
+	return predicate.File(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...uint32) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v uint32) predicate.File {
+	return predicate.File(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v uint32) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v uint32) predicate.File {
+	return predicate.File(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v uint32) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldVersion, v))
+}
+
+// IsLatestEQ applies the EQ predicate on the "is_latest" field.
+func IsLatestEQ(v bool) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldIsLatest, v))
+}
+
+// IsLatestNEQ applies the NEQ predicate on the "is_latest" field.
+func IsLatestNEQ(v bool) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldIsLatest, v))
+}
+
+// VersionGroupEQ applies the EQ predicate on the "version_group" field.
+func VersionGroupEQ(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldVersionGroup, v))
+}
+
+// VersionGroupNEQ applies the NEQ predicate on the "version_group" field.
+func VersionGroupNEQ(v string) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldVersionGroup, v))
+}
+
+// VersionGroupIn applies the In predicate on the "version_group" field.
+func VersionGroupIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldIn(FieldVersionGroup, vs...))
+}
+
+// VersionGroupNotIn applies the NotIn predicate on the "version_group" field.
+func VersionGroupNotIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldVersionGroup, vs...))
+}
+
+// VersionGroupGT applies the GT predicate on the "version_group" field.
+func VersionGroupGT(v string) predicate.File {
+	return predicate.File(sql.FieldGT(FieldVersionGroup, v))
+}
+
+// VersionGroupGTE applies the GTE predicate on the "version_group" field.
+func VersionGroupGTE(v string) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldVersionGroup, v))
+}
+
+// VersionGroupLT applies the LT predicate on the "version_group" field.
+func VersionGroupLT(v string) predicate.File {
+	return predicate.File(sql.FieldLT(FieldVersionGroup, v))
+}
+
+// VersionGroupLTE applies the LTE predicate on the "version_group" field.
+func VersionGroupLTE(v string) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldVersionGroup, v))
+}
+
+// VersionGroupContains applies the Contains predicate on the "version_group" field.
+func VersionGroupContains(v string) predicate.File {
+	return predicate.File(sql.FieldContains(FieldVersionGroup, v))
+}
+
+// VersionGroupHasPrefix applies the HasPrefix predicate on the "version_group" field.
+func VersionGroupHasPrefix(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefix(FieldVersionGroup, v))
+}
+
+// VersionGroupHasSuffix applies the HasSuffix predicate on the "version_group" field.
+func VersionGroupHasSuffix(v string) predicate.File {
+	return predicate.File(sql.FieldHasSuffix(FieldVersionGroup, v))
+}
+
+// VersionGroupIsNil applies the IsNil predicate on the "version_group" field.
+func VersionGroupIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldVersionGroup))
+}
+
+// VersionGroupNotNil applies the NotNil predicate on the "version_group" field.
+func VersionGroupNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldVersionGroup))
+}
+
+// VersionGroupEqualFold applies the EqualFold predicate on the "version_group" field.
+func VersionGroupEqualFold(v string) predicate.File {
+	return predicate.File(sql.FieldEqualFold(FieldVersionGroup, v))
+}
+
+// VersionGroupContainsFold applies the ContainsFold predicate on the "version_group" field.
+func VersionGroupContainsFold(v string) predicate.File {
+	return predicate.File(sql.FieldContainsFold(FieldVersionGroup, v))
+}
+
+// LatestKeyEQ applies the EQ predicate on the "latest_key" field.
+func LatestKeyEQ(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldLatestKey, v))
+}
+
+// LatestKeyNEQ applies the NEQ predicate on the "latest_key" field.
+func LatestKeyNEQ(v string) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldLatestKey, v))
+}
+
+// LatestKeyIn applies the In predicate on the "latest_key" field.
+func LatestKeyIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldIn(FieldLatestKey, vs...))
+}
+
+// LatestKeyNotIn applies the NotIn predicate on the "latest_key" field.
+func LatestKeyNotIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldLatestKey, vs...))
+}
+
+// LatestKeyGT applies the GT predicate on the "latest_key" field.
+func LatestKeyGT(v string) predicate.File {
+	return predicate.File(sql.FieldGT(FieldLatestKey, v))
+}
+
+// LatestKeyGTE applies the GTE predicate on the "latest_key" field.
+func LatestKeyGTE(v string) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldLatestKey, v))
+}
+
+// LatestKeyLT applies the LT predicate on the "latest_key" field.
+func LatestKeyLT(v string) predicate.File {
+	return predicate.File(sql.FieldLT(FieldLatestKey, v))
+}
+
+// LatestKeyLTE applies the LTE predicate on the "latest_key" field.
+func LatestKeyLTE(v string) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldLatestKey, v))
+}
+
+// LatestKeyContains applies the Contains predicate on the "latest_key" field.
+func LatestKeyContains(v string) predicate.File {
+	return predicate.File(sql.FieldContains(FieldLatestKey, v))
+}
+
+// LatestKeyHasPrefix applies the HasPrefix predicate on the "latest_key" field.
+func LatestKeyHasPrefix(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefix(FieldLatestKey, v))
+}
+
+// LatestKeyHasSuffix applies the HasSuffix predicate on the "latest_key" field.
+func LatestKeyHasSuffix(v string) predicate.File {
+	return predicate.File(sql.FieldHasSuffix(FieldLatestKey, v))
+}
+
+// LatestKeyIsNil applies the IsNil predicate on the "latest_key" field.
+func LatestKeyIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldLatestKey))
+}
+
+// LatestKeyNotNil applies the NotNil predicate on the "latest_key" field.
+func LatestKeyNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldLatestKey))
+}
+
+// LatestKeyEqualFold applies the EqualFold predicate on the "latest_key" field.
+func LatestKeyEqualFold(v string) predicate.File {
+	return predicate.File(sql.FieldEqualFold(FieldLatestKey, v))
+}
+
+// LatestKeyContainsFold applies the ContainsFold predicate on the "latest_key" field.
+func LatestKeyContainsFold(v string) predicate.File {
+	return predicate.File(sql.FieldContainsFold(FieldLatestKey, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.File) predicate.File {
 	return predicate.File(sql.AndPredicates(predicates...))
@@ -288,6 +288,132 @@ func (_c *FileCreate) SetNillableContentHash(v *string) *FileCreate {
 	return _c
 }
 
+// SetStorageObjectID sets the "storage_object_id" field.
+func (_c *FileCreate) SetStorageObjectID(v uint32) *FileCreate {
+	_c.mutation.SetStorageObjectID(v)
+	return _c
+}
+
+// SetNillableStorageObjectID sets the "storage_object_id" field if the given value is not nil.
+func (_c *FileCreate) SetNillableStorageObjectID(v *uint32) *FileCreate {
+	if v != nil {
+		_c.SetStorageObjectID(*v)
+	}
+	return _c
+}
+
+// SetObjectName sets the "object_name" field.
+func (_c *FileCreate) SetObjectName(v string) *FileCreate {
+	_c.mutation.SetObjectName(v)
+	return _c
+}
+
+// SetNillableObjectName sets the "object_name" field if the given value is not nil.
+func (_c *FileCreate) SetNillableObjectName(v *string) *FileCreate {
+	if v != nil {
+		_c.SetObjectName(*v)
+	}
+	return _c
+}
+
+// SetScanStatus sets the "scan_status" field.
+func (_c *FileCreate) SetScanStatus(v file.ScanStatus) *FileCreate {
+	_c.mutation.SetScanStatus(v)
+	return _c
+}
+
+// SetNillableScanStatus sets the "scan_status" field if the given value is not nil.
+func (_c *FileCreate) SetNillableScanStatus(v *file.ScanStatus) *FileCreate {
+	if v != nil {
+		_c.SetScanStatus(*v)
+	}
+	return _c
+}
+
+// SetScanSignature sets the "scan_signature" field.
+func (_c *FileCreate) SetScanSignature(v string) *FileCreate {
+	_c.mutation.SetScanSignature(v)
+	return _c
+}
+
+// SetNillableScanSignature sets the "scan_signature" field if the given value is not nil.
+func (_c *FileCreate) SetNillableScanSignature(v *string) *FileCreate {
+	if v != nil {
+		_c.SetScanSignature(*v)
+	}
+	return _c
+}
+
+// SetScannedAt sets the "scanned_at" field.
+func (_c *FileCreate) SetScannedAt(v time.Time) *FileCreate {
+	_c.mutation.SetScannedAt(v)
+	return _c
+}
+
+// SetNillableScannedAt sets the "scanned_at" field if the given value is not nil.
+func (_c *FileCreate) SetNillableScannedAt(v *time.Time) *FileCreate {
+	if v != nil {
+		_c.SetScannedAt(*v)
+	}
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *FileCreate) SetVersion(v uint32) *FileCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *FileCreate) SetNillableVersion(v *uint32) *FileCreate {
+	if v != nil {
+		_c.SetVersion(*v)
+	}
+	return _c
+}
+
+// SetIsLatest sets the "is_latest" field.
+func (_c *FileCreate) SetIsLatest(v bool) *FileCreate {
+	_c.mutation.SetIsLatest(v)
+	return _c
+}
+
+// SetNillableIsLatest sets the "is_latest" field if the given value is not nil.
+func (_c *FileCreate) SetNillableIsLatest(v *bool) *FileCreate {
+	if v != nil {
+		_c.SetIsLatest(*v)
+	}
+	return _c
+}
+
+// SetVersionGroup sets the "version_group" field.
+func (_c *FileCreate) SetVersionGroup(v string) *FileCreate {
+	_c.mutation.SetVersionGroup(v)
+	return _c
+}
+
+// SetNillableVersionGroup sets the "version_group" field if the given value is not nil.
+func (_c *FileCreate) SetNillableVersionGroup(v *string) *FileCreate {
+	if v != nil {
+		_c.SetVersionGroup(*v)
+	}
+	return _c
+}
+
+// SetLatestKey sets the "latest_key" field.
+func (_c *FileCreate) SetLatestKey(v string) *FileCreate {
+	_c.mutation.SetLatestKey(v)
+	return _c
+}
+
+// SetNillableLatestKey sets the "latest_key" field if the given value is not nil.
+func (_c *FileCreate) SetNillableLatestKey(v *string) *FileCreate {
+	if v != nil {
+		_c.SetLatestKey(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *FileCreate) SetID(v uint32) *FileCreate {
 	_c.mutation.SetID(v)
@@ -339,6 +465,14 @@ func (_c *FileCreate) defaults() error {
 		v := file.DefaultProvider
 		_c.mutation.SetProvider(v)
 	}
+	if _, ok := _c.mutation.Version(); !ok {
+		v := file.DefaultVersion
+		_c.mutation.SetVersion(v)
+	}
+	if _, ok := _c.mutation.IsLatest(); !ok {
+		v := file.DefaultIsLatest
+		_c.mutation.SetIsLatest(v)
+	}
 	return nil
 }
 
@@ -349,6 +483,17 @@ func (_c *FileCreate) check() error {
 			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "File.provider": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.ScanStatus(); ok {
+		if err := file.ScanStatusValidator(v); err != nil {
+			return &ValidationError{Name: "scan_status", err: fmt.Errorf(`ent: validator failed for field "File.scan_status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "File.version"`)}
+	}
+	if _, ok := _c.mutation.IsLatest(); !ok {
+		return &ValidationError{Name: "is_latest", err: errors.New(`ent: missing required field "File.is_latest"`)}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := file.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "File.id": %w`, err)}
@@ -463,6 +608,42 @@ func (_c *FileCreate) createSpec() (*File, *sqlgraph.CreateSpec) {
 		_spec.SetField(file.FieldContentHash, field.TypeString, value)
 		_node.ContentHash = &value
 	}
+	if value, ok := _c.mutation.StorageObjectID(); ok {
+		_spec.SetField(file.FieldStorageObjectID, field.TypeUint32, value)
+		_node.StorageObjectID = &value
+	}
+	if value, ok := _c.mutation.ObjectName(); ok {
+		_spec.SetField(file.FieldObjectName, field.TypeString, value)
+		_node.ObjectName = &value
+	}
+	if value, ok := _c.mutation.ScanStatus(); ok {
+		_spec.SetField(file.FieldScanStatus, field.TypeEnum, value)
+		_node.ScanStatus = &value
+	}
+	if value, ok := _c.mutation.ScanSignature(); ok {
+		_spec.SetField(file.FieldScanSignature, field.TypeString, value)
+		_node.ScanSignature = &value
+	}
+	if value, ok := _c.mutation.ScannedAt(); ok {
+		_spec.SetField(file.FieldScannedAt, field.TypeTime, value)
+		_node.ScannedAt = &value
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(file.FieldVersion, field.TypeUint32, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.IsLatest(); ok {
+		_spec.SetField(file.FieldIsLatest, field.TypeBool, value)
+		_node.IsLatest = value
+	}
+	if value, ok := _c.mutation.VersionGroup(); ok {
+		_spec.SetField(file.FieldVersionGroup, field.TypeString, value)
+		_node.VersionGroup = &value
+	}
+	if value, ok := _c.mutation.LatestKey(); ok {
+		_spec.SetField(file.FieldLatestKey, field.TypeString, value)
+		_node.LatestKey = &value
+	}
 	return _node, _spec
 }
 
@@ -845,6 +1026,168 @@ func (u *FileUpsert) ClearContentHash() *FileUpsert {
 	return u
 }
 
+// SetStorageObjectID sets the "storage_object_id" field.
+func (u *FileUpsert) SetStorageObjectID(v uint32) *FileUpsert {
+	u.Set(file.FieldStorageObjectID, v)
+	return u
+}
+
+// UpdateStorageObjectID sets the "storage_object_id" field to the value that was provided on create.
+func (u *FileUpsert) UpdateStorageObjectID() *FileUpsert {
+	u.SetExcluded(file.FieldStorageObjectID)
+	return u
+}
+
+// AddStorageObjectID adds v to the "storage_object_id" field.
+func (u *FileUpsert) AddStorageObjectID(v uint32) *FileUpsert {
+	u.Add(file.FieldStorageObjectID, v)
+	return u
+}
+
+// ClearStorageObjectID clears the value of the "storage_object_id" field.
+func (u *FileUpsert) ClearStorageObjectID() *FileUpsert {
+	u.SetNull(file.FieldStorageObjectID)
+	return u
+}
+
+// SetObjectName sets the "object_name" field.
+func (u *FileUpsert) SetObjectName(v string) *FileUpsert {
+	u.Set(file.FieldObjectName, v)
+	return u
+}
+
+// UpdateObjectName sets the "object_name" field to the value that was provided on create.
+func (u *FileUpsert) UpdateObjectName() *FileUpsert {
+	u.SetExcluded(file.FieldObjectName)
+	return u
+}
+
+// ClearObjectName clears the value of the "object_name" field.
+func (u *FileUpsert) ClearObjectName() *FileUpsert {
+	u.SetNull(file.FieldObjectName)
+	return u
+}
+
+// SetScanStatus sets the "scan_status" field.
+func (u *FileUpsert) SetScanStatus(v file.ScanStatus) *FileUpsert {
+	u.Set(file.FieldScanStatus, v)
+	return u
+}
+
+// UpdateScanStatus sets the "scan_status" field to the value that was provided on create.
+func (u *FileUpsert) UpdateScanStatus() *FileUpsert {
+	u.SetExcluded(file.FieldScanStatus)
+	return u
+}
+
+// ClearScanStatus clears the value of the "scan_status" field.
+func (u *FileUpsert) ClearScanStatus() *FileUpsert {
+	u.SetNull(file.FieldScanStatus)
+	return u
+}
+
+// SetScanSignature sets the "scan_signature" field.
+func (u *FileUpsert) SetScanSignature(v string) *FileUpsert {
+	u.Set(file.FieldScanSignature, v)
+	return u
+}
+
+// UpdateScanSignature sets the "scan_signature" field to the value that was provided on create.
+func (u *FileUpsert) UpdateScanSignature() *FileUpsert {
+	u.SetExcluded(file.FieldScanSignature)
+	return u
+}
+
+// ClearScanSignature clears the value of the "scan_signature" field.
+func (u *FileUpsert) ClearScanSignature() *FileUpsert {
+	u.SetNull(file.FieldScanSignature)
+	return u
+}
+
+// SetScannedAt sets the "scanned_at" field.
+func (u *FileUpsert) SetScannedAt(v time.Time) *FileUpsert {
+	u.Set(file.FieldScannedAt, v)
+	return u
+}
+
+// UpdateScannedAt sets the "scanned_at" field to the value that was provided on create.
+func (u *FileUpsert) UpdateScannedAt() *FileUpsert {
+	u.SetExcluded(file.FieldScannedAt)
+	return u
+}
+
+// ClearScannedAt clears the value of the "scanned_at" field.
+func (u *FileUpsert) ClearScannedAt() *FileUpsert {
+	u.SetNull(file.FieldScannedAt)
+	return u
+}
+
+// SetVersion sets the "version" field.
+func (u *FileUpsert) SetVersion(v uint32) *FileUpsert {
+	u.Set(file.FieldVersion, v)
+	return u
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *FileUpsert) UpdateVersion() *FileUpsert {
+	u.SetExcluded(file.FieldVersion)
+	return u
+}
+
+// AddVersion adds v to the "version" field.
+func (u *FileUpsert) AddVersion(v uint32) *FileUpsert {
+	u.Add(file.FieldVersion, v)
+	return u
+}
+
+// SetIsLatest sets the "is_latest" field.
+func (u *FileUpsert) SetIsLatest(v bool) *FileUpsert {
+	u.Set(file.FieldIsLatest, v)
+	return u
+}
+
+// UpdateIsLatest sets the "is_latest" field to the value that was provided on create.
+func (u *FileUpsert) UpdateIsLatest() *FileUpsert {
+	u.SetExcluded(file.FieldIsLatest)
+	return u
+}
+
+// SetVersionGroup sets the "version_group" field.
+func (u *FileUpsert) SetVersionGroup(v string) *FileUpsert {
+	u.Set(file.FieldVersionGroup, v)
+	return u
+}
+
+// UpdateVersionGroup sets the "version_group" field to the value that was provided on create.
+func (u *FileUpsert) UpdateVersionGroup() *FileUpsert {
+	u.SetExcluded(file.FieldVersionGroup)
+	return u
+}
+
+// ClearVersionGroup clears the value of the "version_group" field.
+func (u *FileUpsert) ClearVersionGroup() *FileUpsert {
+	u.SetNull(file.FieldVersionGroup)
+	return u
+}
+
+// SetLatestKey sets the "latest_key" field.
+func (u *FileUpsert) SetLatestKey(v string) *FileUpsert {
+	u.Set(file.FieldLatestKey, v)
+	return u
+}
+
+// UpdateLatestKey sets the "latest_key" field to the value that was provided on create.
+func (u *FileUpsert) UpdateLatestKey() *FileUpsert {
+	u.SetExcluded(file.FieldLatestKey)
+	return u
+}
+
+// ClearLatestKey clears the value of the "latest_key" field.
+func (u *FileUpsert) ClearLatestKey() *FileUpsert {
+	u.SetNull(file.FieldLatestKey)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -1284,6 +1627,195 @@ func (u *FileUpsertOne) ClearContentHash() *FileUpsertOne {
 	})
 }
 
+// SetStorageObjectID sets the "storage_object_id" field.
+func (u *FileUpsertOne) SetStorageObjectID(v uint32) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetStorageObjectID(v)
+	})
+}
+
+// AddStorageObjectID adds v to the "storage_object_id" field.
+func (u *FileUpsertOne) AddStorageObjectID(v uint32) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.AddStorageObjectID(v)
+	})
+}
+
+// UpdateStorageObjectID sets the "storage_object_id" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateStorageObjectID() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateStorageObjectID()
+	})
+}
+
+// ClearStorageObjectID clears the value of the "storage_object_id" field.
+func (u *FileUpsertOne) ClearStorageObjectID() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearStorageObjectID()
+	})
+}
+
+// SetObjectName sets the "object_name" field.
+func (u *FileUpsertOne) SetObjectName(v string) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetObjectName(v)
+	})
+}
+
+// UpdateObjectName sets the "object_name" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateObjectName() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateObjectName()
+	})
+}
+
+// ClearObjectName clears the value of the "object_name" field.
+func (u *FileUpsertOne) ClearObjectName() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearObjectName()
+	})
+}
+
+// SetScanStatus sets the "scan_status" field.
+func (u *FileUpsertOne) SetScanStatus(v file.ScanStatus) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetScanStatus(v)
+	})
+}
+
+// UpdateScanStatus sets the "scan_status" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateScanStatus() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateScanStatus()
+	})
+}
+
+// ClearScanStatus clears the value of the "scan_status" field.
+func (u *FileUpsertOne) ClearScanStatus() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearScanStatus()
+	})
+}
+
+// SetScanSignature sets the "scan_signature" field.
+func (u *FileUpsertOne) SetScanSignature(v string) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetScanSignature(v)
+	})
+}
+
+// UpdateScanSignature sets the "scan_signature" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateScanSignature() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateScanSignature()
+	})
+}
+
+// ClearScanSignature clears the value of the "scan_signature" field.
+func (u *FileUpsertOne) ClearScanSignature() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearScanSignature()
+	})
+}
+
+// SetScannedAt sets the "scanned_at" field.
+func (u *FileUpsertOne) SetScannedAt(v time.Time) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetScannedAt(v)
+	})
+}
+
+// UpdateScannedAt sets the "scanned_at" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateScannedAt() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateScannedAt()
+	})
+}
+
+// ClearScannedAt clears the value of the "scanned_at" field.
+func (u *FileUpsertOne) ClearScannedAt() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearScannedAt()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *FileUpsertOne) SetVersion(v uint32) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *FileUpsertOne) AddVersion(v uint32) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateVersion() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetIsLatest sets the "is_latest" field.
+func (u *FileUpsertOne) SetIsLatest(v bool) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetIsLatest(v)
+	})
+}
+
+// UpdateIsLatest sets the "is_latest" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateIsLatest() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateIsLatest()
+	})
+}
+
+// SetVersionGroup sets the "version_group" field.
+func (u *FileUpsertOne) SetVersionGroup(v string) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetVersionGroup(v)
+	})
+}
+
+// UpdateVersionGroup sets the "version_group" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateVersionGroup() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateVersionGroup()
+	})
+}
+
+// ClearVersionGroup clears the value of the "version_group" field.
+func (u *FileUpsertOne) ClearVersionGroup() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearVersionGroup()
+	})
+}
+
+// SetLatestKey sets the "latest_key" field.
+func (u *FileUpsertOne) SetLatestKey(v string) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetLatestKey(v)
+	})
+}
+
+// UpdateLatestKey sets the "latest_key" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateLatestKey() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateLatestKey()
+	})
+}
+
+// ClearLatestKey clears the value of the "latest_key" field.
+func (u *FileUpsertOne) ClearLatestKey() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearLatestKey()
+	})
+}
+
 // Exec executes the query.
 func (u *FileUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1889,6 +2421,195 @@ func (u *FileUpsertBulk) ClearContentHash() *FileUpsertBulk {
 	})
 }
 
+// SetStorageObjectID sets the "storage_object_id" field.
+func (u *FileUpsertBulk) SetStorageObjectID(v uint32) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetStorageObjectID(v)
+	})
+}
+
+// AddStorageObjectID adds v to the "storage_object_id" field.
+func (u *FileUpsertBulk) AddStorageObjectID(v uint32) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.AddStorageObjectID(v)
+	})
+}
+
+// UpdateStorageObjectID sets the "storage_object_id" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateStorageObjectID() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateStorageObjectID()
+	})
+}
+
+// ClearStorageObjectID clears the value of the "storage_object_id" field.
+func (u *FileUpsertBulk) ClearStorageObjectID() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearStorageObjectID()
+	})
+}
+
+// SetObjectName sets the "object_name" field.
+func (u *FileUpsertBulk) SetObjectName(v string) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetObjectName(v)
+	})
+}
+
+// UpdateObjectName sets the "object_name" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateObjectName() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateObjectName()
+	})
+}
+
+// ClearObjectName clears the value of the "object_name" field.
+func (u *FileUpsertBulk) ClearObjectName() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearObjectName()
+	})
+}
+
+// SetScanStatus sets the "scan_status" field.
+func (u *FileUpsertBulk) SetScanStatus(v file.ScanStatus) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetScanStatus(v)
+	})
+}
+
+// UpdateScanStatus sets the "scan_status" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateScanStatus() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateScanStatus()
+	})
+}
+
+// ClearScanStatus clears the value of the "scan_status" field.
+func (u *FileUpsertBulk) ClearScanStatus() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearScanStatus()
+	})
+}
+
+// SetScanSignature sets the "scan_signature" field.
+func (u *FileUpsertBulk) SetScanSignature(v string) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetScanSignature(v)
+	})
+}
+
+// UpdateScanSignature sets the "scan_signature" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateScanSignature() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateScanSignature()
+	})
+}
+
+// ClearScanSignature clears the value of the "scan_signature" field.
+func (u *FileUpsertBulk) ClearScanSignature() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearScanSignature()
+	})
+}
+
+// SetScannedAt sets the "scanned_at" field.
+func (u *FileUpsertBulk) SetScannedAt(v time.Time) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetScannedAt(v)
+	})
+}
+
+// UpdateScannedAt sets the "scanned_at" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateScannedAt() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateScannedAt()
+	})
+}
+
+// ClearScannedAt clears the value of the "scanned_at" field.
+func (u *FileUpsertBulk) ClearScannedAt() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearScannedAt()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *FileUpsertBulk) SetVersion(v uint32) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *FileUpsertBulk) AddVersion(v uint32) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateVersion() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetIsLatest sets the "is_latest" field.
+func (u *FileUpsertBulk) SetIsLatest(v bool) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetIsLatest(v)
+	})
+}
+
+// UpdateIsLatest sets the "is_latest" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateIsLatest() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateIsLatest()
+	})
+}
+
+// SetVersionGroup sets the "version_group" field.
+func (u *FileUpsertBulk) SetVersionGroup(v string) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetVersionGroup(v)
+	})
+}
+
+// UpdateVersionGroup sets the "version_group" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateVersionGroup() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateVersionGroup()
+	})
+}
+
+// ClearVersionGroup clears the value of the "version_group" field.
+func (u *FileUpsertBulk) ClearVersionGroup() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearVersionGroup()
+	})
+}
+
+// SetLatestKey sets the "latest_key" field.
+func (u *FileUpsertBulk) SetLatestKey(v string) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetLatestKey(v)
+	})
+}
+
+// UpdateLatestKey sets the "latest_key" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateLatestKey() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateLatestKey()
+	})
+}
+
+// ClearLatestKey clears the value of the "latest_key" field.
+func (u *FileUpsertBulk) ClearLatestKey() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearLatestKey()
+	})
+}
+
 // Exec executes the query.
 func (u *FileUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
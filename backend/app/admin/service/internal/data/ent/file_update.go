@@ -397,6 +397,188 @@ func (_u *FileUpdate) ClearContentHash() *FileUpdate {
 	return _u
 }
 
+// SetStorageObjectID sets the "storage_object_id" field.
+func (_u *FileUpdate) SetStorageObjectID(v uint32) *FileUpdate {
+	_u.mutation.ResetStorageObjectID()
+	_u.mutation.SetStorageObjectID(v)
+	return _u
+}
+
+// SetNillableStorageObjectID sets the "storage_object_id" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableStorageObjectID(v *uint32) *FileUpdate {
+	if v != nil {
+		_u.SetStorageObjectID(*v)
+	}
+	return _u
+}
+
+// AddStorageObjectID adds value to the "storage_object_id" field.
+func (_u *FileUpdate) AddStorageObjectID(v int32) *FileUpdate {
+	_u.mutation.AddStorageObjectID(v)
+	return _u
+}
+
+// ClearStorageObjectID clears the value of the "storage_object_id" field.
+func (_u *FileUpdate) ClearStorageObjectID() *FileUpdate {
+	_u.mutation.ClearStorageObjectID()
+	return _u
+}
+
+// SetObjectName sets the "object_name" field.
+func (_u *FileUpdate) SetObjectName(v string) *FileUpdate {
+	_u.mutation.SetObjectName(v)
+	return _u
+}
+
+// SetNillableObjectName sets the "object_name" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableObjectName(v *string) *FileUpdate {
+	if v != nil {
+		_u.SetObjectName(*v)
+	}
+	return _u
+}
+
+// ClearObjectName clears the value of the "object_name" field.
+func (_u *FileUpdate) ClearObjectName() *FileUpdate {
+	_u.mutation.ClearObjectName()
+	return _u
+}
+
+// SetScanStatus sets the "scan_status" field.
+func (_u *FileUpdate) SetScanStatus(v file.ScanStatus) *FileUpdate {
+	_u.mutation.SetScanStatus(v)
+	return _u
+}
+
+// SetNillableScanStatus sets the "scan_status" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableScanStatus(v *file.ScanStatus) *FileUpdate {
+	if v != nil {
+		_u.SetScanStatus(*v)
+	}
+	return _u
+}
+
+// ClearScanStatus clears the value of the "scan_status" field.
+func (_u *FileUpdate) ClearScanStatus() *FileUpdate {
+	_u.mutation.ClearScanStatus()
+	return _u
+}
+
+// SetScanSignature sets the "scan_signature" field.
+func (_u *FileUpdate) SetScanSignature(v string) *FileUpdate {
+	_u.mutation.SetScanSignature(v)
+	return _u
+}
+
+// SetNillableScanSignature sets the "scan_signature" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableScanSignature(v *string) *FileUpdate {
+	if v != nil {
+		_u.SetScanSignature(*v)
+	}
+	return _u
+}
+
+// ClearScanSignature clears the value of the "scan_signature" field.
+func (_u *FileUpdate) ClearScanSignature() *FileUpdate {
+	_u.mutation.ClearScanSignature()
+	return _u
+}
+
+// SetScannedAt sets the "scanned_at" field.
+func (_u *FileUpdate) SetScannedAt(v time.Time) *FileUpdate {
+	_u.mutation.SetScannedAt(v)
+	return _u
+}
+
+// SetNillableScannedAt sets the "scanned_at" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableScannedAt(v *time.Time) *FileUpdate {
+	if v != nil {
+		_u.SetScannedAt(*v)
+	}
+	return _u
+}
+
+// ClearScannedAt clears the value of the "scanned_at" field.
+func (_u *FileUpdate) ClearScannedAt() *FileUpdate {
+	_u.mutation.ClearScannedAt()
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *FileUpdate) SetVersion(v uint32) *FileUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableVersion(v *uint32) *FileUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *FileUpdate) AddVersion(v int32) *FileUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetIsLatest sets the "is_latest" field.
+func (_u *FileUpdate) SetIsLatest(v bool) *FileUpdate {
+	_u.mutation.SetIsLatest(v)
+	return _u
+}
+
+// SetNillableIsLatest sets the "is_latest" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableIsLatest(v *bool) *FileUpdate {
+	if v != nil {
+		_u.SetIsLatest(*v)
+	}
+	return _u
+}
+
+// SetVersionGroup sets the "version_group" field.
+func (_u *FileUpdate) SetVersionGroup(v string) *FileUpdate {
+	_u.mutation.SetVersionGroup(v)
+	return _u
+}
+
+// SetNillableVersionGroup sets the "version_group" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableVersionGroup(v *string) *FileUpdate {
+	if v != nil {
+		_u.SetVersionGroup(*v)
+	}
+	return _u
+}
+
+// ClearVersionGroup clears the value of the "version_group" field.
+func (_u *FileUpdate) ClearVersionGroup() *FileUpdate {
+	_u.mutation.ClearVersionGroup()
+	return _u
+}
+
+// SetLatestKey sets the "latest_key" field.
+func (_u *FileUpdate) SetLatestKey(v string) *FileUpdate {
+	_u.mutation.SetLatestKey(v)
+	return _u
+}
+
+// SetNillableLatestKey sets the "latest_key" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableLatestKey(v *string) *FileUpdate {
+	if v != nil {
+		_u.SetLatestKey(*v)
+	}
+	return _u
+}
+
+// ClearLatestKey clears the value of the "latest_key" field.
+func (_u *FileUpdate) ClearLatestKey() *FileUpdate {
+	_u.mutation.ClearLatestKey()
+	return _u
+}
+
 // Mutation returns the FileMutation object of the builder.
 func (_u *FileUpdate) Mutation() *FileMutation {
 	return _u.mutation
@@ -436,6 +618,11 @@ func (_u *FileUpdate) check() error {
 			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "File.provider": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.ScanStatus(); ok {
+		if err := file.ScanStatusValidator(v); err != nil {
+			return &ValidationError{Name: "scan_status", err: fmt.Errorf(`ent: validator failed for field "File.scan_status": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -577,6 +764,60 @@ func (_u *FileUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.ContentHashCleared() {
 		_spec.ClearField(file.FieldContentHash, field.TypeString)
 	}
+	if value, ok := _u.mutation.StorageObjectID(); ok {
+		_spec.SetField(file.FieldStorageObjectID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedStorageObjectID(); ok {
+		_spec.AddField(file.FieldStorageObjectID, field.TypeUint32, value)
+	}
+	if _u.mutation.StorageObjectIDCleared() {
+		_spec.ClearField(file.FieldStorageObjectID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ObjectName(); ok {
+		_spec.SetField(file.FieldObjectName, field.TypeString, value)
+	}
+	if _u.mutation.ObjectNameCleared() {
+		_spec.ClearField(file.FieldObjectName, field.TypeString)
+	}
+	if value, ok := _u.mutation.ScanStatus(); ok {
+		_spec.SetField(file.FieldScanStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.ScanStatusCleared() {
+		_spec.ClearField(file.FieldScanStatus, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.ScanSignature(); ok {
+		_spec.SetField(file.FieldScanSignature, field.TypeString, value)
+	}
+	if _u.mutation.ScanSignatureCleared() {
+		_spec.ClearField(file.FieldScanSignature, field.TypeString)
+	}
+	if value, ok := _u.mutation.ScannedAt(); ok {
+		_spec.SetField(file.FieldScannedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ScannedAtCleared() {
+		_spec.ClearField(file.FieldScannedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(file.FieldVersion, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(file.FieldVersion, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.IsLatest(); ok {
+		_spec.SetField(file.FieldIsLatest, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.VersionGroup(); ok {
+		_spec.SetField(file.FieldVersionGroup, field.TypeString, value)
+	}
+	if _u.mutation.VersionGroupCleared() {
+		_spec.ClearField(file.FieldVersionGroup, field.TypeString)
+	}
+	if value, ok := _u.mutation.LatestKey(); ok {
+		_spec.SetField(file.FieldLatestKey, field.TypeString, value)
+	}
+	if _u.mutation.LatestKeyCleared() {
+		_spec.ClearField(file.FieldLatestKey, field.TypeString)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -967,6 +1208,188 @@ func (_u *FileUpdateOne) ClearContentHash() *FileUpdateOne {
 	return _u
 }
 
+// SetStorageObjectID sets the "storage_object_id" field.
+func (_u *FileUpdateOne) SetStorageObjectID(v uint32) *FileUpdateOne {
+	_u.mutation.ResetStorageObjectID()
+	_u.mutation.SetStorageObjectID(v)
+	return _u
+}
+
+// SetNillableStorageObjectID sets the "storage_object_id" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableStorageObjectID(v *uint32) *FileUpdateOne {
+	if v != nil {
+		_u.SetStorageObjectID(*v)
+	}
+	return _u
+}
+
+// AddStorageObjectID adds value to the "storage_object_id" field.
+func (_u *FileUpdateOne) AddStorageObjectID(v int32) *FileUpdateOne {
+	_u.mutation.AddStorageObjectID(v)
+	return _u
+}
+
+// ClearStorageObjectID clears the value of the "storage_object_id" field.
+func (_u *FileUpdateOne) ClearStorageObjectID() *FileUpdateOne {
+	_u.mutation.ClearStorageObjectID()
+	return _u
+}
+
+// SetObjectName sets the "object_name" field.
+func (_u *FileUpdateOne) SetObjectName(v string) *FileUpdateOne {
+	_u.mutation.SetObjectName(v)
+	return _u
+}
+
+// SetNillableObjectName sets the "object_name" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableObjectName(v *string) *FileUpdateOne {
+	if v != nil {
+		_u.SetObjectName(*v)
+	}
+	return _u
+}
+
+// ClearObjectName clears the value of the "object_name" field.
+func (_u *FileUpdateOne) ClearObjectName() *FileUpdateOne {
+	_u.mutation.ClearObjectName()
+	return _u
+}
+
+// SetScanStatus sets the "scan_status" field.
+func (_u *FileUpdateOne) SetScanStatus(v file.ScanStatus) *FileUpdateOne {
+	_u.mutation.SetScanStatus(v)
+	return _u
+}
+
+// SetNillableScanStatus sets the "scan_status" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableScanStatus(v *file.ScanStatus) *FileUpdateOne {
+	if v != nil {
+		_u.SetScanStatus(*v)
+	}
+	return _u
+}
+
+// ClearScanStatus clears the value of the "scan_status" field.
+func (_u *FileUpdateOne) ClearScanStatus() *FileUpdateOne {
+	_u.mutation.ClearScanStatus()
+	return _u
+}
+
+// SetScanSignature sets the "scan_signature" field.
+func (_u *FileUpdateOne) SetScanSignature(v string) *FileUpdateOne {
+	_u.mutation.SetScanSignature(v)
+	return _u
+}
+
+// SetNillableScanSignature sets the "scan_signature" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableScanSignature(v *string) *FileUpdateOne {
+	if v != nil {
+		_u.SetScanSignature(*v)
+	}
+	return _u
+}
+
+// ClearScanSignature clears the value of the "scan_signature" field.
+func (_u *FileUpdateOne) ClearScanSignature() *FileUpdateOne {
+	_u.mutation.ClearScanSignature()
+	return _u
+}
+
+// SetScannedAt sets the "scanned_at" field.
+func (_u *FileUpdateOne) SetScannedAt(v time.Time) *FileUpdateOne {
+	_u.mutation.SetScannedAt(v)
+	return _u
+}
+
+// SetNillableScannedAt sets the "scanned_at" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableScannedAt(v *time.Time) *FileUpdateOne {
+	if v != nil {
+		_u.SetScannedAt(*v)
+	}
+	return _u
+}
+
+// ClearScannedAt clears the value of the "scanned_at" field.
+func (_u *FileUpdateOne) ClearScannedAt() *FileUpdateOne {
+	_u.mutation.ClearScannedAt()
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *FileUpdateOne) SetVersion(v uint32) *FileUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableVersion(v *uint32) *FileUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *FileUpdateOne) AddVersion(v int32) *FileUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetIsLatest sets the "is_latest" field.
+func (_u *FileUpdateOne) SetIsLatest(v bool) *FileUpdateOne {
+	_u.mutation.SetIsLatest(v)
+	return _u
+}
+
+// SetNillableIsLatest sets the "is_latest" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableIsLatest(v *bool) *FileUpdateOne {
+	if v != nil {
+		_u.SetIsLatest(*v)
+	}
+	return _u
+}
+
+// SetVersionGroup sets the "version_group" field.
+func (_u *FileUpdateOne) SetVersionGroup(v string) *FileUpdateOne {
+	_u.mutation.SetVersionGroup(v)
+	return _u
+}
+
+// SetNillableVersionGroup sets the "version_group" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableVersionGroup(v *string) *FileUpdateOne {
+	if v != nil {
+		_u.SetVersionGroup(*v)
+	}
+	return _u
+}
+
+// ClearVersionGroup clears the value of the "version_group" field.
+func (_u *FileUpdateOne) ClearVersionGroup() *FileUpdateOne {
+	_u.mutation.ClearVersionGroup()
+	return _u
+}
+
+// SetLatestKey sets the "latest_key" field.
+func (_u *FileUpdateOne) SetLatestKey(v string) *FileUpdateOne {
+	_u.mutation.SetLatestKey(v)
+	return _u
+}
+
+// SetNillableLatestKey sets the "latest_key" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableLatestKey(v *string) *FileUpdateOne {
+	if v != nil {
+		_u.SetLatestKey(*v)
+	}
+	return _u
+}
+
+// ClearLatestKey clears the value of the "latest_key" field.
+func (_u *FileUpdateOne) ClearLatestKey() *FileUpdateOne {
+	_u.mutation.ClearLatestKey()
+	return _u
+}
+
 // Mutation returns the FileMutation object of the builder.
 func (_u *FileUpdateOne) Mutation() *FileMutation {
 	return _u.mutation
@@ -1019,6 +1442,11 @@ func (_u *FileUpdateOne) check() error {
 			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "File.provider": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.ScanStatus(); ok {
+		if err := file.ScanStatusValidator(v); err != nil {
+			return &ValidationError{Name: "scan_status", err: fmt.Errorf(`ent: validator failed for field "File.scan_status": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1177,6 +1605,60 @@ func (_u *FileUpdateOne) sqlSave(ctx context.Context) (_node *File, err error) {
 	if _u.mutation.ContentHashCleared() {
 		_spec.ClearField(file.FieldContentHash, field.TypeString)
 	}
+	if value, ok := _u.mutation.StorageObjectID(); ok {
+		_spec.SetField(file.FieldStorageObjectID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedStorageObjectID(); ok {
+		_spec.AddField(file.FieldStorageObjectID, field.TypeUint32, value)
+	}
+	if _u.mutation.StorageObjectIDCleared() {
+		_spec.ClearField(file.FieldStorageObjectID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ObjectName(); ok {
+		_spec.SetField(file.FieldObjectName, field.TypeString, value)
+	}
+	if _u.mutation.ObjectNameCleared() {
+		_spec.ClearField(file.FieldObjectName, field.TypeString)
+	}
+	if value, ok := _u.mutation.ScanStatus(); ok {
+		_spec.SetField(file.FieldScanStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.ScanStatusCleared() {
+		_spec.ClearField(file.FieldScanStatus, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.ScanSignature(); ok {
+		_spec.SetField(file.FieldScanSignature, field.TypeString, value)
+	}
+	if _u.mutation.ScanSignatureCleared() {
+		_spec.ClearField(file.FieldScanSignature, field.TypeString)
+	}
+	if value, ok := _u.mutation.ScannedAt(); ok {
+		_spec.SetField(file.FieldScannedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ScannedAtCleared() {
+		_spec.ClearField(file.FieldScannedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(file.FieldVersion, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(file.FieldVersion, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.IsLatest(); ok {
+		_spec.SetField(file.FieldIsLatest, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.VersionGroup(); ok {
+		_spec.SetField(file.FieldVersionGroup, field.TypeString, value)
+	}
+	if _u.mutation.VersionGroupCleared() {
+		_spec.ClearField(file.FieldVersionGroup, field.TypeString)
+	}
+	if value, ok := _u.mutation.LatestKey(); ok {
+		_spec.SetField(file.FieldLatestKey, field.TypeString, value)
+	}
+	if _u.mutation.LatestKeyCleared() {
+		_spec.ClearField(file.FieldLatestKey, field.TypeString)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &File{config: _u.config}
 	_spec.Assign = _node.assignValues
@@ -0,0 +1,990 @@
+// Code generated by ent, DO NOT EDIT.
+
+package filesharelink
+
+import (
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// DeletedBy applies equality check predicate on the "deleted_by" field. It's identical to DeletedByEQ.
+func DeletedBy(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldEQ(FieldTenantID, v))
+}
+
+// FileID applies equality check predicate on the "file_id" field. It's identical to FileIDEQ.
+func FileID(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldEQ(FieldFileID, v))
+}
+
+// Token applies equality check predicate on the "token" field. It's identical to TokenEQ.
+func Token(v string) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldEQ(FieldToken, v))
+}
+
+// PasswordHash applies equality check predicate on the "password_hash" field. It's identical to PasswordHashEQ.
+func PasswordHash(v string) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldEQ(FieldPasswordHash, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// MaxDownloads applies equality check predicate on the "max_downloads" field. It's identical to MaxDownloadsEQ.
+func MaxDownloads(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldEQ(FieldMaxDownloads, v))
+}
+
+// DownloadCount applies equality check predicate on the "download_count" field. It's identical to DownloadCountEQ.
+func DownloadCount(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldEQ(FieldDownloadCount, v))
+}
+
+// RevokedAt applies equality check predicate on the "revoked_at" field. It's identical to RevokedAtEQ.
+func RevokedAt(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldEQ(FieldRevokedAt, v))
+}
+
+// RevokedBy applies equality check predicate on the "revoked_by" field. It's identical to RevokedByEQ.
+func RevokedBy(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldEQ(FieldRevokedBy, v))
+}
+
+// LastAccessedAt applies equality check predicate on the "last_accessed_at" field. It's identical to LastAccessedAtEQ.
+func LastAccessedAt(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldEQ(FieldLastAccessedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// DeletedByEQ applies the EQ predicate on the "deleted_by" field.
+func DeletedByEQ(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// DeletedByNEQ applies the NEQ predicate on the "deleted_by" field.
+func DeletedByNEQ(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNEQ(FieldDeletedBy, v))
+}
+
+// DeletedByIn applies the In predicate on the "deleted_by" field.
+func DeletedByIn(vs ...uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByNotIn applies the NotIn predicate on the "deleted_by" field.
+func DeletedByNotIn(vs ...uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNotIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByGT applies the GT predicate on the "deleted_by" field.
+func DeletedByGT(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldGT(FieldDeletedBy, v))
+}
+
+// DeletedByGTE applies the GTE predicate on the "deleted_by" field.
+func DeletedByGTE(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldGTE(FieldDeletedBy, v))
+}
+
+// DeletedByLT applies the LT predicate on the "deleted_by" field.
+func DeletedByLT(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldLT(FieldDeletedBy, v))
+}
+
+// DeletedByLTE applies the LTE predicate on the "deleted_by" field.
+func DeletedByLTE(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldLTE(FieldDeletedBy, v))
+}
+
+// DeletedByIsNil applies the IsNil predicate on the "deleted_by" field.
+func DeletedByIsNil() predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldIsNull(FieldDeletedBy))
+}
+
+// DeletedByNotNil applies the NotNil predicate on the "deleted_by" field.
+func DeletedByNotNil() predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNotNull(FieldDeletedBy))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNotNull(FieldTenantID))
+}
+
+// FileIDEQ applies the EQ predicate on the "file_id" field.
+func FileIDEQ(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldEQ(FieldFileID, v))
+}
+
+// FileIDNEQ applies the NEQ predicate on the "file_id" field.
+func FileIDNEQ(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNEQ(FieldFileID, v))
+}
+
+// FileIDIn applies the In predicate on the "file_id" field.
+func FileIDIn(vs ...uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldIn(FieldFileID, vs...))
+}
+
+// FileIDNotIn applies the NotIn predicate on the "file_id" field.
+func FileIDNotIn(vs ...uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNotIn(FieldFileID, vs...))
+}
+
+// FileIDGT applies the GT predicate on the "file_id" field.
+func FileIDGT(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldGT(FieldFileID, v))
+}
+
+// FileIDGTE applies the GTE predicate on the "file_id" field.
+func FileIDGTE(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldGTE(FieldFileID, v))
+}
+
+// FileIDLT applies the LT predicate on the "file_id" field.
+func FileIDLT(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldLT(FieldFileID, v))
+}
+
+// FileIDLTE applies the LTE predicate on the "file_id" field.
+func FileIDLTE(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldLTE(FieldFileID, v))
+}
+
+// TokenEQ applies the EQ predicate on the "token" field.
+func TokenEQ(v string) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldEQ(FieldToken, v))
+}
+
+// TokenNEQ applies the NEQ predicate on the "token" field.
+func TokenNEQ(v string) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNEQ(FieldToken, v))
+}
+
+// TokenIn applies the In predicate on the "token" field.
+func TokenIn(vs ...string) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldIn(FieldToken, vs...))
+}
+
+// TokenNotIn applies the NotIn predicate on the "token" field.
+func TokenNotIn(vs ...string) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNotIn(FieldToken, vs...))
+}
+
+// TokenGT applies the GT predicate on the "token" field.
+func TokenGT(v string) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldGT(FieldToken, v))
+}
+
+// TokenGTE applies the GTE predicate on the "token" field.
+func TokenGTE(v string) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldGTE(FieldToken, v))
+}
+
+// TokenLT applies the LT predicate on the "token" field.
+func TokenLT(v string) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldLT(FieldToken, v))
+}
+
+// TokenLTE applies the LTE predicate on the "token" field.
+func TokenLTE(v string) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldLTE(FieldToken, v))
+}
+
+// TokenContains applies the Contains predicate on the "token" field.
+func TokenContains(v string) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldContains(FieldToken, v))
+}
+
+// TokenHasPrefix applies the HasPrefix predicate on the "token" field.
+func TokenHasPrefix(v string) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldHasPrefix(FieldToken, v))
+}
+
+// TokenHasSuffix applies the HasSuffix predicate on the "token" field.
+func TokenHasSuffix(v string) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldHasSuffix(FieldToken, v))
+}
+
+// TokenEqualFold applies the EqualFold predicate on the "token" field.
+func TokenEqualFold(v string) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldEqualFold(FieldToken, v))
+}
+
+// TokenContainsFold applies the ContainsFold predicate on the "token" field.
+func TokenContainsFold(v string) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldContainsFold(FieldToken, v))
+}
+
+// PasswordHashEQ applies the EQ predicate on the "password_hash" field.
+func PasswordHashEQ(v string) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldEQ(FieldPasswordHash, v))
+}
+
+// PasswordHashNEQ applies the NEQ predicate on the "password_hash" field.
+func PasswordHashNEQ(v string) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNEQ(FieldPasswordHash, v))
+}
+
+// PasswordHashIn applies the In predicate on the "password_hash" field.
+func PasswordHashIn(vs ...string) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldIn(FieldPasswordHash, vs...))
+}
+
+// PasswordHashNotIn applies the NotIn predicate on the "password_hash" field.
+func PasswordHashNotIn(vs ...string) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNotIn(FieldPasswordHash, vs...))
+}
+
+// PasswordHashGT applies the GT predicate on the "password_hash" field.
+func PasswordHashGT(v string) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldGT(FieldPasswordHash, v))
+}
+
+// PasswordHashGTE applies the GTE predicate on the "password_hash" field.
+func PasswordHashGTE(v string) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldGTE(FieldPasswordHash, v))
+}
+
+// PasswordHashLT applies the LT predicate on the "password_hash" field.
+func PasswordHashLT(v string) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldLT(FieldPasswordHash, v))
+}
+
+// PasswordHashLTE applies the LTE predicate on the "password_hash" field.
+func PasswordHashLTE(v string) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldLTE(FieldPasswordHash, v))
+}
+
+// PasswordHashContains applies the Contains predicate on the "password_hash" field.
+func PasswordHashContains(v string) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldContains(FieldPasswordHash, v))
+}
+
+// PasswordHashHasPrefix applies the HasPrefix predicate on the "password_hash" field.
+func PasswordHashHasPrefix(v string) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldHasPrefix(FieldPasswordHash, v))
+}
+
+// PasswordHashHasSuffix applies the HasSuffix predicate on the "password_hash" field.
+func PasswordHashHasSuffix(v string) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldHasSuffix(FieldPasswordHash, v))
+}
+
+// PasswordHashIsNil applies the IsNil predicate on the "password_hash" field.
+func PasswordHashIsNil() predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldIsNull(FieldPasswordHash))
+}
+
+// PasswordHashNotNil applies the NotNil predicate on the "password_hash" field.
+func PasswordHashNotNil() predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNotNull(FieldPasswordHash))
+}
+
+// PasswordHashEqualFold applies the EqualFold predicate on the "password_hash" field.
+func PasswordHashEqualFold(v string) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldEqualFold(FieldPasswordHash, v))
+}
+
+// PasswordHashContainsFold applies the ContainsFold predicate on the "password_hash" field.
+func PasswordHashContainsFold(v string) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldContainsFold(FieldPasswordHash, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// MaxDownloadsEQ applies the EQ predicate on the "max_downloads" field.
+func MaxDownloadsEQ(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldEQ(FieldMaxDownloads, v))
+}
+
+// MaxDownloadsNEQ applies the NEQ predicate on the "max_downloads" field.
+func MaxDownloadsNEQ(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNEQ(FieldMaxDownloads, v))
+}
+
+// MaxDownloadsIn applies the In predicate on the "max_downloads" field.
+func MaxDownloadsIn(vs ...uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldIn(FieldMaxDownloads, vs...))
+}
+
+// MaxDownloadsNotIn applies the NotIn predicate on the "max_downloads" field.
+func MaxDownloadsNotIn(vs ...uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNotIn(FieldMaxDownloads, vs...))
+}
+
+// MaxDownloadsGT applies the GT predicate on the "max_downloads" field.
+func MaxDownloadsGT(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldGT(FieldMaxDownloads, v))
+}
+
+// MaxDownloadsGTE applies the GTE predicate on the "max_downloads" field.
+func MaxDownloadsGTE(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldGTE(FieldMaxDownloads, v))
+}
+
+// MaxDownloadsLT applies the LT predicate on the "max_downloads" field.
+func MaxDownloadsLT(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldLT(FieldMaxDownloads, v))
+}
+
+// MaxDownloadsLTE applies the LTE predicate on the "max_downloads" field.
+func MaxDownloadsLTE(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldLTE(FieldMaxDownloads, v))
+}
+
+// DownloadCountEQ applies the EQ predicate on the "download_count" field.
+func DownloadCountEQ(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldEQ(FieldDownloadCount, v))
+}
+
+// DownloadCountNEQ applies the NEQ predicate on the "download_count" field.
+func DownloadCountNEQ(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNEQ(FieldDownloadCount, v))
+}
+
+// DownloadCountIn applies the In predicate on the "download_count" field.
+func DownloadCountIn(vs ...uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldIn(FieldDownloadCount, vs...))
+}
+
+// DownloadCountNotIn applies the NotIn predicate on the "download_count" field.
+func DownloadCountNotIn(vs ...uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNotIn(FieldDownloadCount, vs...))
+}
+
+// DownloadCountGT applies the GT predicate on the "download_count" field.
+func DownloadCountGT(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldGT(FieldDownloadCount, v))
+}
+
+// DownloadCountGTE applies the GTE predicate on the "download_count" field.
+func DownloadCountGTE(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldGTE(FieldDownloadCount, v))
+}
+
+// DownloadCountLT applies the LT predicate on the "download_count" field.
+func DownloadCountLT(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldLT(FieldDownloadCount, v))
+}
+
+// DownloadCountLTE applies the LTE predicate on the "download_count" field.
+func DownloadCountLTE(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldLTE(FieldDownloadCount, v))
+}
+
+// AllowedTenantIdsIsNil applies the IsNil predicate on the "allowed_tenant_ids" field.
+func AllowedTenantIdsIsNil() predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldIsNull(FieldAllowedTenantIds))
+}
+
+// AllowedTenantIdsNotNil applies the NotNil predicate on the "allowed_tenant_ids" field.
+func AllowedTenantIdsNotNil() predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNotNull(FieldAllowedTenantIds))
+}
+
+// AllowedUserIdsIsNil applies the IsNil predicate on the "allowed_user_ids" field.
+func AllowedUserIdsIsNil() predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldIsNull(FieldAllowedUserIds))
+}
+
+// AllowedUserIdsNotNil applies the NotNil predicate on the "allowed_user_ids" field.
+func AllowedUserIdsNotNil() predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNotNull(FieldAllowedUserIds))
+}
+
+// AllowedOrgUnitIdsIsNil applies the IsNil predicate on the "allowed_org_unit_ids" field.
+func AllowedOrgUnitIdsIsNil() predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldIsNull(FieldAllowedOrgUnitIds))
+}
+
+// AllowedOrgUnitIdsNotNil applies the NotNil predicate on the "allowed_org_unit_ids" field.
+func AllowedOrgUnitIdsNotNil() predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNotNull(FieldAllowedOrgUnitIds))
+}
+
+// RevokedAtEQ applies the EQ predicate on the "revoked_at" field.
+func RevokedAtEQ(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldEQ(FieldRevokedAt, v))
+}
+
+// RevokedAtNEQ applies the NEQ predicate on the "revoked_at" field.
+func RevokedAtNEQ(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNEQ(FieldRevokedAt, v))
+}
+
+// RevokedAtIn applies the In predicate on the "revoked_at" field.
+func RevokedAtIn(vs ...time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldIn(FieldRevokedAt, vs...))
+}
+
+// RevokedAtNotIn applies the NotIn predicate on the "revoked_at" field.
+func RevokedAtNotIn(vs ...time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNotIn(FieldRevokedAt, vs...))
+}
+
+// RevokedAtGT applies the GT predicate on the "revoked_at" field.
+func RevokedAtGT(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldGT(FieldRevokedAt, v))
+}
+
+// RevokedAtGTE applies the GTE predicate on the "revoked_at" field.
+func RevokedAtGTE(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldGTE(FieldRevokedAt, v))
+}
+
+// RevokedAtLT applies the LT predicate on the "revoked_at" field.
+func RevokedAtLT(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldLT(FieldRevokedAt, v))
+}
+
+// RevokedAtLTE applies the LTE predicate on the "revoked_at" field.
+func RevokedAtLTE(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldLTE(FieldRevokedAt, v))
+}
+
+// RevokedAtIsNil applies the IsNil predicate on the "revoked_at" field.
+func RevokedAtIsNil() predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldIsNull(FieldRevokedAt))
+}
+
+// RevokedAtNotNil applies the NotNil predicate on the "revoked_at" field.
+func RevokedAtNotNil() predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNotNull(FieldRevokedAt))
+}
+
+// RevokedByEQ applies the EQ predicate on the "revoked_by" field.
+func RevokedByEQ(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldEQ(FieldRevokedBy, v))
+}
+
+// RevokedByNEQ applies the NEQ predicate on the "revoked_by" field.
+func RevokedByNEQ(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNEQ(FieldRevokedBy, v))
+}
+
+// RevokedByIn applies the In predicate on the "revoked_by" field.
+func RevokedByIn(vs ...uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldIn(FieldRevokedBy, vs...))
+}
+
+// RevokedByNotIn applies the NotIn predicate on the "revoked_by" field.
+func RevokedByNotIn(vs ...uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNotIn(FieldRevokedBy, vs...))
+}
+
+// RevokedByGT applies the GT predicate on the "revoked_by" field.
+func RevokedByGT(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldGT(FieldRevokedBy, v))
+}
+
+// RevokedByGTE applies the GTE predicate on the "revoked_by" field.
+func RevokedByGTE(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldGTE(FieldRevokedBy, v))
+}
+
+// RevokedByLT applies the LT predicate on the "revoked_by" field.
+func RevokedByLT(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldLT(FieldRevokedBy, v))
+}
+
+// RevokedByLTE applies the LTE predicate on the "revoked_by" field.
+func RevokedByLTE(v uint32) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldLTE(FieldRevokedBy, v))
+}
+
+// RevokedByIsNil applies the IsNil predicate on the "revoked_by" field.
+func RevokedByIsNil() predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldIsNull(FieldRevokedBy))
+}
+
+// RevokedByNotNil applies the NotNil predicate on the "revoked_by" field.
+func RevokedByNotNil() predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNotNull(FieldRevokedBy))
+}
+
+// LastAccessedAtEQ applies the EQ predicate on the "last_accessed_at" field.
+func LastAccessedAtEQ(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldEQ(FieldLastAccessedAt, v))
+}
+
+// LastAccessedAtNEQ applies the NEQ predicate on the "last_accessed_at" field.
+func LastAccessedAtNEQ(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNEQ(FieldLastAccessedAt, v))
+}
+
+// LastAccessedAtIn applies the In predicate on the "last_accessed_at" field.
+func LastAccessedAtIn(vs ...time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldIn(FieldLastAccessedAt, vs...))
+}
+
+// LastAccessedAtNotIn applies the NotIn predicate on the "last_accessed_at" field.
+func LastAccessedAtNotIn(vs ...time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNotIn(FieldLastAccessedAt, vs...))
+}
+
+// LastAccessedAtGT applies the GT predicate on the "last_accessed_at" field.
+func LastAccessedAtGT(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldGT(FieldLastAccessedAt, v))
+}
+
+// LastAccessedAtGTE applies the GTE predicate on the "last_accessed_at" field.
+func LastAccessedAtGTE(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldGTE(FieldLastAccessedAt, v))
+}
+
+// LastAccessedAtLT applies the LT predicate on the "last_accessed_at" field.
+func LastAccessedAtLT(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldLT(FieldLastAccessedAt, v))
+}
+
+// LastAccessedAtLTE applies the LTE predicate on the "last_accessed_at" field.
+func LastAccessedAtLTE(v time.Time) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldLTE(FieldLastAccessedAt, v))
+}
+
+// LastAccessedAtIsNil applies the IsNil predicate on the "last_accessed_at" field.
+func LastAccessedAtIsNil() predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldIsNull(FieldLastAccessedAt))
+}
+
+// LastAccessedAtNotNil applies the NotNil predicate on the "last_accessed_at" field.
+func LastAccessedAtNotNil() predicate.FileShareLink {
+	return predicate.FileShareLink(sql.FieldNotNull(FieldLastAccessedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.FileShareLink) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.FileShareLink) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.FileShareLink) predicate.FileShareLink {
+	return predicate.FileShareLink(sql.NotPredicates(p))
+}
@@ -0,0 +1,1869 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/filesharelink"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FileShareLinkCreate is the builder for creating a FileShareLink entity.
+type FileShareLinkCreate struct {
+	config
+	mutation *FileShareLinkMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *FileShareLinkCreate) SetCreatedAt(v time.Time) *FileShareLinkCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *FileShareLinkCreate) SetNillableCreatedAt(v *time.Time) *FileShareLinkCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *FileShareLinkCreate) SetUpdatedAt(v time.Time) *FileShareLinkCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *FileShareLinkCreate) SetNillableUpdatedAt(v *time.Time) *FileShareLinkCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *FileShareLinkCreate) SetDeletedAt(v time.Time) *FileShareLinkCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *FileShareLinkCreate) SetNillableDeletedAt(v *time.Time) *FileShareLinkCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *FileShareLinkCreate) SetCreatedBy(v uint32) *FileShareLinkCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *FileShareLinkCreate) SetNillableCreatedBy(v *uint32) *FileShareLinkCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *FileShareLinkCreate) SetUpdatedBy(v uint32) *FileShareLinkCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *FileShareLinkCreate) SetNillableUpdatedBy(v *uint32) *FileShareLinkCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_c *FileShareLinkCreate) SetDeletedBy(v uint32) *FileShareLinkCreate {
+	_c.mutation.SetDeletedBy(v)
+	return _c
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_c *FileShareLinkCreate) SetNillableDeletedBy(v *uint32) *FileShareLinkCreate {
+	if v != nil {
+		_c.SetDeletedBy(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *FileShareLinkCreate) SetTenantID(v uint32) *FileShareLinkCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *FileShareLinkCreate) SetNillableTenantID(v *uint32) *FileShareLinkCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetFileID sets the "file_id" field.
+func (_c *FileShareLinkCreate) SetFileID(v uint32) *FileShareLinkCreate {
+	_c.mutation.SetFileID(v)
+	return _c
+}
+
+// SetToken sets the "token" field.
+func (_c *FileShareLinkCreate) SetToken(v string) *FileShareLinkCreate {
+	_c.mutation.SetToken(v)
+	return _c
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (_c *FileShareLinkCreate) SetPasswordHash(v string) *FileShareLinkCreate {
+	_c.mutation.SetPasswordHash(v)
+	return _c
+}
+
+// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
+func (_c *FileShareLinkCreate) SetNillablePasswordHash(v *string) *FileShareLinkCreate {
+	if v != nil {
+		_c.SetPasswordHash(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *FileShareLinkCreate) SetExpiresAt(v time.Time) *FileShareLinkCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *FileShareLinkCreate) SetNillableExpiresAt(v *time.Time) *FileShareLinkCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetMaxDownloads sets the "max_downloads" field.
+func (_c *FileShareLinkCreate) SetMaxDownloads(v uint32) *FileShareLinkCreate {
+	_c.mutation.SetMaxDownloads(v)
+	return _c
+}
+
+// SetNillableMaxDownloads sets the "max_downloads" field if the given value is not nil.
+func (_c *FileShareLinkCreate) SetNillableMaxDownloads(v *uint32) *FileShareLinkCreate {
+	if v != nil {
+		_c.SetMaxDownloads(*v)
+	}
+	return _c
+}
+
+// SetDownloadCount sets the "download_count" field.
+func (_c *FileShareLinkCreate) SetDownloadCount(v uint32) *FileShareLinkCreate {
+	_c.mutation.SetDownloadCount(v)
+	return _c
+}
+
+// SetNillableDownloadCount sets the "download_count" field if the given value is not nil.
+func (_c *FileShareLinkCreate) SetNillableDownloadCount(v *uint32) *FileShareLinkCreate {
+	if v != nil {
+		_c.SetDownloadCount(*v)
+	}
+	return _c
+}
+
+// SetAllowedTenantIds sets the "allowed_tenant_ids" field.
+func (_c *FileShareLinkCreate) SetAllowedTenantIds(v []uint32) *FileShareLinkCreate {
+	_c.mutation.SetAllowedTenantIds(v)
+	return _c
+}
+
+// SetAllowedUserIds sets the "allowed_user_ids" field.
+func (_c *FileShareLinkCreate) SetAllowedUserIds(v []uint32) *FileShareLinkCreate {
+	_c.mutation.SetAllowedUserIds(v)
+	return _c
+}
+
+// SetAllowedOrgUnitIds sets the "allowed_org_unit_ids" field.
+func (_c *FileShareLinkCreate) SetAllowedOrgUnitIds(v []uint32) *FileShareLinkCreate {
+	_c.mutation.SetAllowedOrgUnitIds(v)
+	return _c
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_c *FileShareLinkCreate) SetRevokedAt(v time.Time) *FileShareLinkCreate {
+	_c.mutation.SetRevokedAt(v)
+	return _c
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_c *FileShareLinkCreate) SetNillableRevokedAt(v *time.Time) *FileShareLinkCreate {
+	if v != nil {
+		_c.SetRevokedAt(*v)
+	}
+	return _c
+}
+
+// SetRevokedBy sets the "revoked_by" field.
+func (_c *FileShareLinkCreate) SetRevokedBy(v uint32) *FileShareLinkCreate {
+	_c.mutation.SetRevokedBy(v)
+	return _c
+}
+
+// SetNillableRevokedBy sets the "revoked_by" field if the given value is not nil.
+func (_c *FileShareLinkCreate) SetNillableRevokedBy(v *uint32) *FileShareLinkCreate {
+	if v != nil {
+		_c.SetRevokedBy(*v)
+	}
+	return _c
+}
+
+// SetLastAccessedAt sets the "last_accessed_at" field.
+func (_c *FileShareLinkCreate) SetLastAccessedAt(v time.Time) *FileShareLinkCreate {
+	_c.mutation.SetLastAccessedAt(v)
+	return _c
+}
+
+// SetNillableLastAccessedAt sets the "last_accessed_at" field if the given value is not nil.
+func (_c *FileShareLinkCreate) SetNillableLastAccessedAt(v *time.Time) *FileShareLinkCreate {
+	if v != nil {
+		_c.SetLastAccessedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *FileShareLinkCreate) SetID(v uint32) *FileShareLinkCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the FileShareLinkMutation object of the builder.
+func (_c *FileShareLinkCreate) Mutation() *FileShareLinkMutation {
+	return _c.mutation
+}
+
+// Save creates the FileShareLink in the database.
+func (_c *FileShareLinkCreate) Save(ctx context.Context) (*FileShareLink, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *FileShareLinkCreate) SaveX(ctx context.Context) *FileShareLink {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FileShareLinkCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FileShareLinkCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *FileShareLinkCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := filesharelink.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.MaxDownloads(); !ok {
+		v := filesharelink.DefaultMaxDownloads
+		_c.mutation.SetMaxDownloads(v)
+	}
+	if _, ok := _c.mutation.DownloadCount(); !ok {
+		v := filesharelink.DefaultDownloadCount
+		_c.mutation.SetDownloadCount(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *FileShareLinkCreate) check() error {
+	if _, ok := _c.mutation.FileID(); !ok {
+		return &ValidationError{Name: "file_id", err: errors.New(`ent: missing required field "FileShareLink.file_id"`)}
+	}
+	if _, ok := _c.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "FileShareLink.token"`)}
+	}
+	if v, ok := _c.mutation.Token(); ok {
+		if err := filesharelink.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "FileShareLink.token": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MaxDownloads(); !ok {
+		return &ValidationError{Name: "max_downloads", err: errors.New(`ent: missing required field "FileShareLink.max_downloads"`)}
+	}
+	if _, ok := _c.mutation.DownloadCount(); !ok {
+		return &ValidationError{Name: "download_count", err: errors.New(`ent: missing required field "FileShareLink.download_count"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := filesharelink.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "FileShareLink.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *FileShareLinkCreate) sqlSave(ctx context.Context) (*FileShareLink, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *FileShareLinkCreate) createSpec() (*FileShareLink, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FileShareLink{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(filesharelink.Table, sqlgraph.NewFieldSpec(filesharelink.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(filesharelink.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(filesharelink.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(filesharelink.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(filesharelink.FieldCreatedBy, field.TypeUint32, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(filesharelink.FieldUpdatedBy, field.TypeUint32, value)
+		_node.UpdatedBy = &value
+	}
+	if value, ok := _c.mutation.DeletedBy(); ok {
+		_spec.SetField(filesharelink.FieldDeletedBy, field.TypeUint32, value)
+		_node.DeletedBy = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(filesharelink.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.FileID(); ok {
+		_spec.SetField(filesharelink.FieldFileID, field.TypeUint32, value)
+		_node.FileID = value
+	}
+	if value, ok := _c.mutation.Token(); ok {
+		_spec.SetField(filesharelink.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := _c.mutation.PasswordHash(); ok {
+		_spec.SetField(filesharelink.FieldPasswordHash, field.TypeString, value)
+		_node.PasswordHash = &value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(filesharelink.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := _c.mutation.MaxDownloads(); ok {
+		_spec.SetField(filesharelink.FieldMaxDownloads, field.TypeUint32, value)
+		_node.MaxDownloads = value
+	}
+	if value, ok := _c.mutation.DownloadCount(); ok {
+		_spec.SetField(filesharelink.FieldDownloadCount, field.TypeUint32, value)
+		_node.DownloadCount = value
+	}
+	if value, ok := _c.mutation.AllowedTenantIds(); ok {
+		_spec.SetField(filesharelink.FieldAllowedTenantIds, field.TypeJSON, value)
+		_node.AllowedTenantIds = value
+	}
+	if value, ok := _c.mutation.AllowedUserIds(); ok {
+		_spec.SetField(filesharelink.FieldAllowedUserIds, field.TypeJSON, value)
+		_node.AllowedUserIds = value
+	}
+	if value, ok := _c.mutation.AllowedOrgUnitIds(); ok {
+		_spec.SetField(filesharelink.FieldAllowedOrgUnitIds, field.TypeJSON, value)
+		_node.AllowedOrgUnitIds = value
+	}
+	if value, ok := _c.mutation.RevokedAt(); ok {
+		_spec.SetField(filesharelink.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
+	if value, ok := _c.mutation.RevokedBy(); ok {
+		_spec.SetField(filesharelink.FieldRevokedBy, field.TypeUint32, value)
+		_node.RevokedBy = &value
+	}
+	if value, ok := _c.mutation.LastAccessedAt(); ok {
+		_spec.SetField(filesharelink.FieldLastAccessedAt, field.TypeTime, value)
+		_node.LastAccessedAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.FileShareLink.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FileShareLinkUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *FileShareLinkCreate) OnConflict(opts ...sql.ConflictOption) *FileShareLinkUpsertOne {
+	_c.conflict = opts
+	return &FileShareLinkUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.FileShareLink.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *FileShareLinkCreate) OnConflictColumns(columns ...string) *FileShareLinkUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &FileShareLinkUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// FileShareLinkUpsertOne is the builder for "upsert"-ing
+	//  one FileShareLink node.
+	FileShareLinkUpsertOne struct {
+		create *FileShareLinkCreate
+	}
+
+	// FileShareLinkUpsert is the "OnConflict" setter.
+	FileShareLinkUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *FileShareLinkUpsert) SetUpdatedAt(v time.Time) *FileShareLinkUpsert {
+	u.Set(filesharelink.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *FileShareLinkUpsert) UpdateUpdatedAt() *FileShareLinkUpsert {
+	u.SetExcluded(filesharelink.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *FileShareLinkUpsert) ClearUpdatedAt() *FileShareLinkUpsert {
+	u.SetNull(filesharelink.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *FileShareLinkUpsert) SetDeletedAt(v time.Time) *FileShareLinkUpsert {
+	u.Set(filesharelink.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *FileShareLinkUpsert) UpdateDeletedAt() *FileShareLinkUpsert {
+	u.SetExcluded(filesharelink.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *FileShareLinkUpsert) ClearDeletedAt() *FileShareLinkUpsert {
+	u.SetNull(filesharelink.FieldDeletedAt)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *FileShareLinkUpsert) SetCreatedBy(v uint32) *FileShareLinkUpsert {
+	u.Set(filesharelink.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *FileShareLinkUpsert) UpdateCreatedBy() *FileShareLinkUpsert {
+	u.SetExcluded(filesharelink.FieldCreatedBy)
+	return u
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *FileShareLinkUpsert) AddCreatedBy(v uint32) *FileShareLinkUpsert {
+	u.Add(filesharelink.FieldCreatedBy, v)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *FileShareLinkUpsert) ClearCreatedBy() *FileShareLinkUpsert {
+	u.SetNull(filesharelink.FieldCreatedBy)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *FileShareLinkUpsert) SetUpdatedBy(v uint32) *FileShareLinkUpsert {
+	u.Set(filesharelink.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *FileShareLinkUpsert) UpdateUpdatedBy() *FileShareLinkUpsert {
+	u.SetExcluded(filesharelink.FieldUpdatedBy)
+	return u
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *FileShareLinkUpsert) AddUpdatedBy(v uint32) *FileShareLinkUpsert {
+	u.Add(filesharelink.FieldUpdatedBy, v)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *FileShareLinkUpsert) ClearUpdatedBy() *FileShareLinkUpsert {
+	u.SetNull(filesharelink.FieldUpdatedBy)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *FileShareLinkUpsert) SetDeletedBy(v uint32) *FileShareLinkUpsert {
+	u.Set(filesharelink.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *FileShareLinkUpsert) UpdateDeletedBy() *FileShareLinkUpsert {
+	u.SetExcluded(filesharelink.FieldDeletedBy)
+	return u
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *FileShareLinkUpsert) AddDeletedBy(v uint32) *FileShareLinkUpsert {
+	u.Add(filesharelink.FieldDeletedBy, v)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *FileShareLinkUpsert) ClearDeletedBy() *FileShareLinkUpsert {
+	u.SetNull(filesharelink.FieldDeletedBy)
+	return u
+}
+
+// SetFileID sets the "file_id" field.
+func (u *FileShareLinkUpsert) SetFileID(v uint32) *FileShareLinkUpsert {
+	u.Set(filesharelink.FieldFileID, v)
+	return u
+}
+
+// UpdateFileID sets the "file_id" field to the value that was provided on create.
+func (u *FileShareLinkUpsert) UpdateFileID() *FileShareLinkUpsert {
+	u.SetExcluded(filesharelink.FieldFileID)
+	return u
+}
+
+// AddFileID adds v to the "file_id" field.
+func (u *FileShareLinkUpsert) AddFileID(v uint32) *FileShareLinkUpsert {
+	u.Add(filesharelink.FieldFileID, v)
+	return u
+}
+
+// SetToken sets the "token" field.
+func (u *FileShareLinkUpsert) SetToken(v string) *FileShareLinkUpsert {
+	u.Set(filesharelink.FieldToken, v)
+	return u
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *FileShareLinkUpsert) UpdateToken() *FileShareLinkUpsert {
+	u.SetExcluded(filesharelink.FieldToken)
+	return u
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (u *FileShareLinkUpsert) SetPasswordHash(v string) *FileShareLinkUpsert {
+	u.Set(filesharelink.FieldPasswordHash, v)
+	return u
+}
+
+// UpdatePasswordHash sets the "password_hash" field to the value that was provided on create.
+func (u *FileShareLinkUpsert) UpdatePasswordHash() *FileShareLinkUpsert {
+	u.SetExcluded(filesharelink.FieldPasswordHash)
+	return u
+}
+
+// ClearPasswordHash clears the value of the "password_hash" field.
+func (u *FileShareLinkUpsert) ClearPasswordHash() *FileShareLinkUpsert {
+	u.SetNull(filesharelink.FieldPasswordHash)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *FileShareLinkUpsert) SetExpiresAt(v time.Time) *FileShareLinkUpsert {
+	u.Set(filesharelink.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *FileShareLinkUpsert) UpdateExpiresAt() *FileShareLinkUpsert {
+	u.SetExcluded(filesharelink.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *FileShareLinkUpsert) ClearExpiresAt() *FileShareLinkUpsert {
+	u.SetNull(filesharelink.FieldExpiresAt)
+	return u
+}
+
+// SetMaxDownloads sets the "max_downloads" field.
+func (u *FileShareLinkUpsert) SetMaxDownloads(v uint32) *FileShareLinkUpsert {
+	u.Set(filesharelink.FieldMaxDownloads, v)
+	return u
+}
+
+// UpdateMaxDownloads sets the "max_downloads" field to the value that was provided on create.
+func (u *FileShareLinkUpsert) UpdateMaxDownloads() *FileShareLinkUpsert {
+	u.SetExcluded(filesharelink.FieldMaxDownloads)
+	return u
+}
+
+// AddMaxDownloads adds v to the "max_downloads" field.
+func (u *FileShareLinkUpsert) AddMaxDownloads(v uint32) *FileShareLinkUpsert {
+	u.Add(filesharelink.FieldMaxDownloads, v)
+	return u
+}
+
+// SetDownloadCount sets the "download_count" field.
+func (u *FileShareLinkUpsert) SetDownloadCount(v uint32) *FileShareLinkUpsert {
+	u.Set(filesharelink.FieldDownloadCount, v)
+	return u
+}
+
+// UpdateDownloadCount sets the "download_count" field to the value that was provided on create.
+func (u *FileShareLinkUpsert) UpdateDownloadCount() *FileShareLinkUpsert {
+	u.SetExcluded(filesharelink.FieldDownloadCount)
+	return u
+}
+
+// AddDownloadCount adds v to the "download_count" field.
+func (u *FileShareLinkUpsert) AddDownloadCount(v uint32) *FileShareLinkUpsert {
+	u.Add(filesharelink.FieldDownloadCount, v)
+	return u
+}
+
+// SetAllowedTenantIds sets the "allowed_tenant_ids" field.
+func (u *FileShareLinkUpsert) SetAllowedTenantIds(v []uint32) *FileShareLinkUpsert {
+	u.Set(filesharelink.FieldAllowedTenantIds, v)
+	return u
+}
+
+// UpdateAllowedTenantIds sets the "allowed_tenant_ids" field to the value that was provided on create.
+func (u *FileShareLinkUpsert) UpdateAllowedTenantIds() *FileShareLinkUpsert {
+	u.SetExcluded(filesharelink.FieldAllowedTenantIds)
+	return u
+}
+
+// ClearAllowedTenantIds clears the value of the "allowed_tenant_ids" field.
+func (u *FileShareLinkUpsert) ClearAllowedTenantIds() *FileShareLinkUpsert {
+	u.SetNull(filesharelink.FieldAllowedTenantIds)
+	return u
+}
+
+// SetAllowedUserIds sets the "allowed_user_ids" field.
+func (u *FileShareLinkUpsert) SetAllowedUserIds(v []uint32) *FileShareLinkUpsert {
+	u.Set(filesharelink.FieldAllowedUserIds, v)
+	return u
+}
+
+// UpdateAllowedUserIds sets the "allowed_user_ids" field to the value that was provided on create.
+func (u *FileShareLinkUpsert) UpdateAllowedUserIds() *FileShareLinkUpsert {
+	u.SetExcluded(filesharelink.FieldAllowedUserIds)
+	return u
+}
+
+// ClearAllowedUserIds clears the value of the "allowed_user_ids" field.
+func (u *FileShareLinkUpsert) ClearAllowedUserIds() *FileShareLinkUpsert {
+	u.SetNull(filesharelink.FieldAllowedUserIds)
+	return u
+}
+
+// SetAllowedOrgUnitIds sets the "allowed_org_unit_ids" field.
+func (u *FileShareLinkUpsert) SetAllowedOrgUnitIds(v []uint32) *FileShareLinkUpsert {
+	u.Set(filesharelink.FieldAllowedOrgUnitIds, v)
+	return u
+}
+
+// UpdateAllowedOrgUnitIds sets the "allowed_org_unit_ids" field to the value that was provided on create.
+func (u *FileShareLinkUpsert) UpdateAllowedOrgUnitIds() *FileShareLinkUpsert {
+	u.SetExcluded(filesharelink.FieldAllowedOrgUnitIds)
+	return u
+}
+
+// ClearAllowedOrgUnitIds clears the value of the "allowed_org_unit_ids" field.
+func (u *FileShareLinkUpsert) ClearAllowedOrgUnitIds() *FileShareLinkUpsert {
+	u.SetNull(filesharelink.FieldAllowedOrgUnitIds)
+	return u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *FileShareLinkUpsert) SetRevokedAt(v time.Time) *FileShareLinkUpsert {
+	u.Set(filesharelink.FieldRevokedAt, v)
+	return u
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *FileShareLinkUpsert) UpdateRevokedAt() *FileShareLinkUpsert {
+	u.SetExcluded(filesharelink.FieldRevokedAt)
+	return u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *FileShareLinkUpsert) ClearRevokedAt() *FileShareLinkUpsert {
+	u.SetNull(filesharelink.FieldRevokedAt)
+	return u
+}
+
+// SetRevokedBy sets the "revoked_by" field.
+func (u *FileShareLinkUpsert) SetRevokedBy(v uint32) *FileShareLinkUpsert {
+	u.Set(filesharelink.FieldRevokedBy, v)
+	return u
+}
+
+// UpdateRevokedBy sets the "revoked_by" field to the value that was provided on create.
+func (u *FileShareLinkUpsert) UpdateRevokedBy() *FileShareLinkUpsert {
+	u.SetExcluded(filesharelink.FieldRevokedBy)
+	return u
+}
+
+// AddRevokedBy adds v to the "revoked_by" field.
+func (u *FileShareLinkUpsert) AddRevokedBy(v uint32) *FileShareLinkUpsert {
+	u.Add(filesharelink.FieldRevokedBy, v)
+	return u
+}
+
+// ClearRevokedBy clears the value of the "revoked_by" field.
+func (u *FileShareLinkUpsert) ClearRevokedBy() *FileShareLinkUpsert {
+	u.SetNull(filesharelink.FieldRevokedBy)
+	return u
+}
+
+// SetLastAccessedAt sets the "last_accessed_at" field.
+func (u *FileShareLinkUpsert) SetLastAccessedAt(v time.Time) *FileShareLinkUpsert {
+	u.Set(filesharelink.FieldLastAccessedAt, v)
+	return u
+}
+
+// UpdateLastAccessedAt sets the "last_accessed_at" field to the value that was provided on create.
+func (u *FileShareLinkUpsert) UpdateLastAccessedAt() *FileShareLinkUpsert {
+	u.SetExcluded(filesharelink.FieldLastAccessedAt)
+	return u
+}
+
+// ClearLastAccessedAt clears the value of the "last_accessed_at" field.
+func (u *FileShareLinkUpsert) ClearLastAccessedAt() *FileShareLinkUpsert {
+	u.SetNull(filesharelink.FieldLastAccessedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.FileShareLink.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(filesharelink.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *FileShareLinkUpsertOne) UpdateNewValues() *FileShareLinkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(filesharelink.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(filesharelink.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(filesharelink.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.FileShareLink.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *FileShareLinkUpsertOne) Ignore() *FileShareLinkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FileShareLinkUpsertOne) DoNothing() *FileShareLinkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FileShareLinkCreate.OnConflict
+// documentation for more info.
+func (u *FileShareLinkUpsertOne) Update(set func(*FileShareLinkUpsert)) *FileShareLinkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FileShareLinkUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *FileShareLinkUpsertOne) SetUpdatedAt(v time.Time) *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *FileShareLinkUpsertOne) UpdateUpdatedAt() *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *FileShareLinkUpsertOne) ClearUpdatedAt() *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *FileShareLinkUpsertOne) SetDeletedAt(v time.Time) *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *FileShareLinkUpsertOne) UpdateDeletedAt() *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *FileShareLinkUpsertOne) ClearDeletedAt() *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *FileShareLinkUpsertOne) SetCreatedBy(v uint32) *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *FileShareLinkUpsertOne) AddCreatedBy(v uint32) *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *FileShareLinkUpsertOne) UpdateCreatedBy() *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *FileShareLinkUpsertOne) ClearCreatedBy() *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *FileShareLinkUpsertOne) SetUpdatedBy(v uint32) *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *FileShareLinkUpsertOne) AddUpdatedBy(v uint32) *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *FileShareLinkUpsertOne) UpdateUpdatedBy() *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *FileShareLinkUpsertOne) ClearUpdatedBy() *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *FileShareLinkUpsertOne) SetDeletedBy(v uint32) *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *FileShareLinkUpsertOne) AddDeletedBy(v uint32) *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *FileShareLinkUpsertOne) UpdateDeletedBy() *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *FileShareLinkUpsertOne) ClearDeletedBy() *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetFileID sets the "file_id" field.
+func (u *FileShareLinkUpsertOne) SetFileID(v uint32) *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.SetFileID(v)
+	})
+}
+
+// AddFileID adds v to the "file_id" field.
+func (u *FileShareLinkUpsertOne) AddFileID(v uint32) *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.AddFileID(v)
+	})
+}
+
+// UpdateFileID sets the "file_id" field to the value that was provided on create.
+func (u *FileShareLinkUpsertOne) UpdateFileID() *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.UpdateFileID()
+	})
+}
+
+// SetToken sets the "token" field.
+func (u *FileShareLinkUpsertOne) SetToken(v string) *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *FileShareLinkUpsertOne) UpdateToken() *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.UpdateToken()
+	})
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (u *FileShareLinkUpsertOne) SetPasswordHash(v string) *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.SetPasswordHash(v)
+	})
+}
+
+// UpdatePasswordHash sets the "password_hash" field to the value that was provided on create.
+func (u *FileShareLinkUpsertOne) UpdatePasswordHash() *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.UpdatePasswordHash()
+	})
+}
+
+// ClearPasswordHash clears the value of the "password_hash" field.
+func (u *FileShareLinkUpsertOne) ClearPasswordHash() *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.ClearPasswordHash()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *FileShareLinkUpsertOne) SetExpiresAt(v time.Time) *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *FileShareLinkUpsertOne) UpdateExpiresAt() *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *FileShareLinkUpsertOne) ClearExpiresAt() *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetMaxDownloads sets the "max_downloads" field.
+func (u *FileShareLinkUpsertOne) SetMaxDownloads(v uint32) *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.SetMaxDownloads(v)
+	})
+}
+
+// AddMaxDownloads adds v to the "max_downloads" field.
+func (u *FileShareLinkUpsertOne) AddMaxDownloads(v uint32) *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.AddMaxDownloads(v)
+	})
+}
+
+// UpdateMaxDownloads sets the "max_downloads" field to the value that was provided on create.
+func (u *FileShareLinkUpsertOne) UpdateMaxDownloads() *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.UpdateMaxDownloads()
+	})
+}
+
+// SetDownloadCount sets the "download_count" field.
+func (u *FileShareLinkUpsertOne) SetDownloadCount(v uint32) *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.SetDownloadCount(v)
+	})
+}
+
+// AddDownloadCount adds v to the "download_count" field.
+func (u *FileShareLinkUpsertOne) AddDownloadCount(v uint32) *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.AddDownloadCount(v)
+	})
+}
+
+// UpdateDownloadCount sets the "download_count" field to the value that was provided on create.
+func (u *FileShareLinkUpsertOne) UpdateDownloadCount() *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.UpdateDownloadCount()
+	})
+}
+
+// SetAllowedTenantIds sets the "allowed_tenant_ids" field.
+func (u *FileShareLinkUpsertOne) SetAllowedTenantIds(v []uint32) *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.SetAllowedTenantIds(v)
+	})
+}
+
+// UpdateAllowedTenantIds sets the "allowed_tenant_ids" field to the value that was provided on create.
+func (u *FileShareLinkUpsertOne) UpdateAllowedTenantIds() *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.UpdateAllowedTenantIds()
+	})
+}
+
+// ClearAllowedTenantIds clears the value of the "allowed_tenant_ids" field.
+func (u *FileShareLinkUpsertOne) ClearAllowedTenantIds() *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.ClearAllowedTenantIds()
+	})
+}
+
+// SetAllowedUserIds sets the "allowed_user_ids" field.
+func (u *FileShareLinkUpsertOne) SetAllowedUserIds(v []uint32) *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.SetAllowedUserIds(v)
+	})
+}
+
+// UpdateAllowedUserIds sets the "allowed_user_ids" field to the value that was provided on create.
+func (u *FileShareLinkUpsertOne) UpdateAllowedUserIds() *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.UpdateAllowedUserIds()
+	})
+}
+
+// ClearAllowedUserIds clears the value of the "allowed_user_ids" field.
+func (u *FileShareLinkUpsertOne) ClearAllowedUserIds() *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.ClearAllowedUserIds()
+	})
+}
+
+// SetAllowedOrgUnitIds sets the "allowed_org_unit_ids" field.
+func (u *FileShareLinkUpsertOne) SetAllowedOrgUnitIds(v []uint32) *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.SetAllowedOrgUnitIds(v)
+	})
+}
+
+// UpdateAllowedOrgUnitIds sets the "allowed_org_unit_ids" field to the value that was provided on create.
+func (u *FileShareLinkUpsertOne) UpdateAllowedOrgUnitIds() *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.UpdateAllowedOrgUnitIds()
+	})
+}
+
+// ClearAllowedOrgUnitIds clears the value of the "allowed_org_unit_ids" field.
+func (u *FileShareLinkUpsertOne) ClearAllowedOrgUnitIds() *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.ClearAllowedOrgUnitIds()
+	})
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *FileShareLinkUpsertOne) SetRevokedAt(v time.Time) *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.SetRevokedAt(v)
+	})
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *FileShareLinkUpsertOne) UpdateRevokedAt() *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.UpdateRevokedAt()
+	})
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *FileShareLinkUpsertOne) ClearRevokedAt() *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.ClearRevokedAt()
+	})
+}
+
+// SetRevokedBy sets the "revoked_by" field.
+func (u *FileShareLinkUpsertOne) SetRevokedBy(v uint32) *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.SetRevokedBy(v)
+	})
+}
+
+// AddRevokedBy adds v to the "revoked_by" field.
+func (u *FileShareLinkUpsertOne) AddRevokedBy(v uint32) *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.AddRevokedBy(v)
+	})
+}
+
+// UpdateRevokedBy sets the "revoked_by" field to the value that was provided on create.
+func (u *FileShareLinkUpsertOne) UpdateRevokedBy() *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.UpdateRevokedBy()
+	})
+}
+
+// ClearRevokedBy clears the value of the "revoked_by" field.
+func (u *FileShareLinkUpsertOne) ClearRevokedBy() *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.ClearRevokedBy()
+	})
+}
+
+// SetLastAccessedAt sets the "last_accessed_at" field.
+func (u *FileShareLinkUpsertOne) SetLastAccessedAt(v time.Time) *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.SetLastAccessedAt(v)
+	})
+}
+
+// UpdateLastAccessedAt sets the "last_accessed_at" field to the value that was provided on create.
+func (u *FileShareLinkUpsertOne) UpdateLastAccessedAt() *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.UpdateLastAccessedAt()
+	})
+}
+
+// ClearLastAccessedAt clears the value of the "last_accessed_at" field.
+func (u *FileShareLinkUpsertOne) ClearLastAccessedAt() *FileShareLinkUpsertOne {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.ClearLastAccessedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *FileShareLinkUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FileShareLinkCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FileShareLinkUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *FileShareLinkUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *FileShareLinkUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// FileShareLinkCreateBulk is the builder for creating many FileShareLink entities in bulk.
+type FileShareLinkCreateBulk struct {
+	config
+	err      error
+	builders []*FileShareLinkCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the FileShareLink entities in the database.
+func (_c *FileShareLinkCreateBulk) Save(ctx context.Context) ([]*FileShareLink, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*FileShareLink, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FileShareLinkMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *FileShareLinkCreateBulk) SaveX(ctx context.Context) []*FileShareLink {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FileShareLinkCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FileShareLinkCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.FileShareLink.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FileShareLinkUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *FileShareLinkCreateBulk) OnConflict(opts ...sql.ConflictOption) *FileShareLinkUpsertBulk {
+	_c.conflict = opts
+	return &FileShareLinkUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.FileShareLink.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *FileShareLinkCreateBulk) OnConflictColumns(columns ...string) *FileShareLinkUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &FileShareLinkUpsertBulk{
+		create: _c,
+	}
+}
+
+// FileShareLinkUpsertBulk is the builder for "upsert"-ing
+// a bulk of FileShareLink nodes.
+type FileShareLinkUpsertBulk struct {
+	create *FileShareLinkCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.FileShareLink.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(filesharelink.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *FileShareLinkUpsertBulk) UpdateNewValues() *FileShareLinkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(filesharelink.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(filesharelink.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(filesharelink.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.FileShareLink.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *FileShareLinkUpsertBulk) Ignore() *FileShareLinkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FileShareLinkUpsertBulk) DoNothing() *FileShareLinkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FileShareLinkCreateBulk.OnConflict
+// documentation for more info.
+func (u *FileShareLinkUpsertBulk) Update(set func(*FileShareLinkUpsert)) *FileShareLinkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FileShareLinkUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *FileShareLinkUpsertBulk) SetUpdatedAt(v time.Time) *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *FileShareLinkUpsertBulk) UpdateUpdatedAt() *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *FileShareLinkUpsertBulk) ClearUpdatedAt() *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *FileShareLinkUpsertBulk) SetDeletedAt(v time.Time) *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *FileShareLinkUpsertBulk) UpdateDeletedAt() *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *FileShareLinkUpsertBulk) ClearDeletedAt() *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *FileShareLinkUpsertBulk) SetCreatedBy(v uint32) *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *FileShareLinkUpsertBulk) AddCreatedBy(v uint32) *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *FileShareLinkUpsertBulk) UpdateCreatedBy() *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *FileShareLinkUpsertBulk) ClearCreatedBy() *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *FileShareLinkUpsertBulk) SetUpdatedBy(v uint32) *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *FileShareLinkUpsertBulk) AddUpdatedBy(v uint32) *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *FileShareLinkUpsertBulk) UpdateUpdatedBy() *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *FileShareLinkUpsertBulk) ClearUpdatedBy() *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *FileShareLinkUpsertBulk) SetDeletedBy(v uint32) *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *FileShareLinkUpsertBulk) AddDeletedBy(v uint32) *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *FileShareLinkUpsertBulk) UpdateDeletedBy() *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *FileShareLinkUpsertBulk) ClearDeletedBy() *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetFileID sets the "file_id" field.
+func (u *FileShareLinkUpsertBulk) SetFileID(v uint32) *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.SetFileID(v)
+	})
+}
+
+// AddFileID adds v to the "file_id" field.
+func (u *FileShareLinkUpsertBulk) AddFileID(v uint32) *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.AddFileID(v)
+	})
+}
+
+// UpdateFileID sets the "file_id" field to the value that was provided on create.
+func (u *FileShareLinkUpsertBulk) UpdateFileID() *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.UpdateFileID()
+	})
+}
+
+// SetToken sets the "token" field.
+func (u *FileShareLinkUpsertBulk) SetToken(v string) *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *FileShareLinkUpsertBulk) UpdateToken() *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.UpdateToken()
+	})
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (u *FileShareLinkUpsertBulk) SetPasswordHash(v string) *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.SetPasswordHash(v)
+	})
+}
+
+// UpdatePasswordHash sets the "password_hash" field to the value that was provided on create.
+func (u *FileShareLinkUpsertBulk) UpdatePasswordHash() *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.UpdatePasswordHash()
+	})
+}
+
+// ClearPasswordHash clears the value of the "password_hash" field.
+func (u *FileShareLinkUpsertBulk) ClearPasswordHash() *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.ClearPasswordHash()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *FileShareLinkUpsertBulk) SetExpiresAt(v time.Time) *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *FileShareLinkUpsertBulk) UpdateExpiresAt() *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *FileShareLinkUpsertBulk) ClearExpiresAt() *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetMaxDownloads sets the "max_downloads" field.
+func (u *FileShareLinkUpsertBulk) SetMaxDownloads(v uint32) *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.SetMaxDownloads(v)
+	})
+}
+
+// AddMaxDownloads adds v to the "max_downloads" field.
+func (u *FileShareLinkUpsertBulk) AddMaxDownloads(v uint32) *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.AddMaxDownloads(v)
+	})
+}
+
+// UpdateMaxDownloads sets the "max_downloads" field to the value that was provided on create.
+func (u *FileShareLinkUpsertBulk) UpdateMaxDownloads() *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.UpdateMaxDownloads()
+	})
+}
+
+// SetDownloadCount sets the "download_count" field.
+func (u *FileShareLinkUpsertBulk) SetDownloadCount(v uint32) *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.SetDownloadCount(v)
+	})
+}
+
+// AddDownloadCount adds v to the "download_count" field.
+func (u *FileShareLinkUpsertBulk) AddDownloadCount(v uint32) *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.AddDownloadCount(v)
+	})
+}
+
+// UpdateDownloadCount sets the "download_count" field to the value that was provided on create.
+func (u *FileShareLinkUpsertBulk) UpdateDownloadCount() *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.UpdateDownloadCount()
+	})
+}
+
+// SetAllowedTenantIds sets the "allowed_tenant_ids" field.
+func (u *FileShareLinkUpsertBulk) SetAllowedTenantIds(v []uint32) *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.SetAllowedTenantIds(v)
+	})
+}
+
+// UpdateAllowedTenantIds sets the "allowed_tenant_ids" field to the value that was provided on create.
+func (u *FileShareLinkUpsertBulk) UpdateAllowedTenantIds() *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.UpdateAllowedTenantIds()
+	})
+}
+
+// ClearAllowedTenantIds clears the value of the "allowed_tenant_ids" field.
+func (u *FileShareLinkUpsertBulk) ClearAllowedTenantIds() *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.ClearAllowedTenantIds()
+	})
+}
+
+// SetAllowedUserIds sets the "allowed_user_ids" field.
+func (u *FileShareLinkUpsertBulk) SetAllowedUserIds(v []uint32) *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.SetAllowedUserIds(v)
+	})
+}
+
+// UpdateAllowedUserIds sets the "allowed_user_ids" field to the value that was provided on create.
+func (u *FileShareLinkUpsertBulk) UpdateAllowedUserIds() *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.UpdateAllowedUserIds()
+	})
+}
+
+// ClearAllowedUserIds clears the value of the "allowed_user_ids" field.
+func (u *FileShareLinkUpsertBulk) ClearAllowedUserIds() *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.ClearAllowedUserIds()
+	})
+}
+
+// SetAllowedOrgUnitIds sets the "allowed_org_unit_ids" field.
+func (u *FileShareLinkUpsertBulk) SetAllowedOrgUnitIds(v []uint32) *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.SetAllowedOrgUnitIds(v)
+	})
+}
+
+// UpdateAllowedOrgUnitIds sets the "allowed_org_unit_ids" field to the value that was provided on create.
+func (u *FileShareLinkUpsertBulk) UpdateAllowedOrgUnitIds() *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.UpdateAllowedOrgUnitIds()
+	})
+}
+
+// ClearAllowedOrgUnitIds clears the value of the "allowed_org_unit_ids" field.
+func (u *FileShareLinkUpsertBulk) ClearAllowedOrgUnitIds() *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.ClearAllowedOrgUnitIds()
+	})
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *FileShareLinkUpsertBulk) SetRevokedAt(v time.Time) *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.SetRevokedAt(v)
+	})
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *FileShareLinkUpsertBulk) UpdateRevokedAt() *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.UpdateRevokedAt()
+	})
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *FileShareLinkUpsertBulk) ClearRevokedAt() *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.ClearRevokedAt()
+	})
+}
+
+// SetRevokedBy sets the "revoked_by" field.
+func (u *FileShareLinkUpsertBulk) SetRevokedBy(v uint32) *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.SetRevokedBy(v)
+	})
+}
+
+// AddRevokedBy adds v to the "revoked_by" field.
+func (u *FileShareLinkUpsertBulk) AddRevokedBy(v uint32) *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.AddRevokedBy(v)
+	})
+}
+
+// UpdateRevokedBy sets the "revoked_by" field to the value that was provided on create.
+func (u *FileShareLinkUpsertBulk) UpdateRevokedBy() *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.UpdateRevokedBy()
+	})
+}
+
+// ClearRevokedBy clears the value of the "revoked_by" field.
+func (u *FileShareLinkUpsertBulk) ClearRevokedBy() *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.ClearRevokedBy()
+	})
+}
+
+// SetLastAccessedAt sets the "last_accessed_at" field.
+func (u *FileShareLinkUpsertBulk) SetLastAccessedAt(v time.Time) *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.SetLastAccessedAt(v)
+	})
+}
+
+// UpdateLastAccessedAt sets the "last_accessed_at" field to the value that was provided on create.
+func (u *FileShareLinkUpsertBulk) UpdateLastAccessedAt() *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.UpdateLastAccessedAt()
+	})
+}
+
+// ClearLastAccessedAt clears the value of the "last_accessed_at" field.
+func (u *FileShareLinkUpsertBulk) ClearLastAccessedAt() *FileShareLinkUpsertBulk {
+	return u.Update(func(s *FileShareLinkUpsert) {
+		s.ClearLastAccessedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *FileShareLinkUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the FileShareLinkCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FileShareLinkCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FileShareLinkUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1201 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/filesharelink"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// FileShareLinkUpdate is the builder for updating FileShareLink entities.
+type FileShareLinkUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *FileShareLinkMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the FileShareLinkUpdate builder.
+func (_u *FileShareLinkUpdate) Where(ps ...predicate.FileShareLink) *FileShareLinkUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *FileShareLinkUpdate) SetUpdatedAt(v time.Time) *FileShareLinkUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *FileShareLinkUpdate) SetNillableUpdatedAt(v *time.Time) *FileShareLinkUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *FileShareLinkUpdate) ClearUpdatedAt() *FileShareLinkUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *FileShareLinkUpdate) SetDeletedAt(v time.Time) *FileShareLinkUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *FileShareLinkUpdate) SetNillableDeletedAt(v *time.Time) *FileShareLinkUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *FileShareLinkUpdate) ClearDeletedAt() *FileShareLinkUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *FileShareLinkUpdate) SetCreatedBy(v uint32) *FileShareLinkUpdate {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *FileShareLinkUpdate) SetNillableCreatedBy(v *uint32) *FileShareLinkUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *FileShareLinkUpdate) AddCreatedBy(v int32) *FileShareLinkUpdate {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *FileShareLinkUpdate) ClearCreatedBy() *FileShareLinkUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *FileShareLinkUpdate) SetUpdatedBy(v uint32) *FileShareLinkUpdate {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *FileShareLinkUpdate) SetNillableUpdatedBy(v *uint32) *FileShareLinkUpdate {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *FileShareLinkUpdate) AddUpdatedBy(v int32) *FileShareLinkUpdate {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *FileShareLinkUpdate) ClearUpdatedBy() *FileShareLinkUpdate {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *FileShareLinkUpdate) SetDeletedBy(v uint32) *FileShareLinkUpdate {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *FileShareLinkUpdate) SetNillableDeletedBy(v *uint32) *FileShareLinkUpdate {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *FileShareLinkUpdate) AddDeletedBy(v int32) *FileShareLinkUpdate {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *FileShareLinkUpdate) ClearDeletedBy() *FileShareLinkUpdate {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetFileID sets the "file_id" field.
+func (_u *FileShareLinkUpdate) SetFileID(v uint32) *FileShareLinkUpdate {
+	_u.mutation.ResetFileID()
+	_u.mutation.SetFileID(v)
+	return _u
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (_u *FileShareLinkUpdate) SetNillableFileID(v *uint32) *FileShareLinkUpdate {
+	if v != nil {
+		_u.SetFileID(*v)
+	}
+	return _u
+}
+
+// AddFileID adds value to the "file_id" field.
+func (_u *FileShareLinkUpdate) AddFileID(v int32) *FileShareLinkUpdate {
+	_u.mutation.AddFileID(v)
+	return _u
+}
+
+// SetToken sets the "token" field.
+func (_u *FileShareLinkUpdate) SetToken(v string) *FileShareLinkUpdate {
+	_u.mutation.SetToken(v)
+	return _u
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (_u *FileShareLinkUpdate) SetNillableToken(v *string) *FileShareLinkUpdate {
+	if v != nil {
+		_u.SetToken(*v)
+	}
+	return _u
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (_u *FileShareLinkUpdate) SetPasswordHash(v string) *FileShareLinkUpdate {
+	_u.mutation.SetPasswordHash(v)
+	return _u
+}
+
+// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
+func (_u *FileShareLinkUpdate) SetNillablePasswordHash(v *string) *FileShareLinkUpdate {
+	if v != nil {
+		_u.SetPasswordHash(*v)
+	}
+	return _u
+}
+
+// ClearPasswordHash clears the value of the "password_hash" field.
+func (_u *FileShareLinkUpdate) ClearPasswordHash() *FileShareLinkUpdate {
+	_u.mutation.ClearPasswordHash()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *FileShareLinkUpdate) SetExpiresAt(v time.Time) *FileShareLinkUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *FileShareLinkUpdate) SetNillableExpiresAt(v *time.Time) *FileShareLinkUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *FileShareLinkUpdate) ClearExpiresAt() *FileShareLinkUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetMaxDownloads sets the "max_downloads" field.
+func (_u *FileShareLinkUpdate) SetMaxDownloads(v uint32) *FileShareLinkUpdate {
+	_u.mutation.ResetMaxDownloads()
+	_u.mutation.SetMaxDownloads(v)
+	return _u
+}
+
+// SetNillableMaxDownloads sets the "max_downloads" field if the given value is not nil.
+func (_u *FileShareLinkUpdate) SetNillableMaxDownloads(v *uint32) *FileShareLinkUpdate {
+	if v != nil {
+		_u.SetMaxDownloads(*v)
+	}
+	return _u
+}
+
+// AddMaxDownloads adds value to the "max_downloads" field.
+func (_u *FileShareLinkUpdate) AddMaxDownloads(v int32) *FileShareLinkUpdate {
+	_u.mutation.AddMaxDownloads(v)
+	return _u
+}
+
+// SetDownloadCount sets the "download_count" field.
+func (_u *FileShareLinkUpdate) SetDownloadCount(v uint32) *FileShareLinkUpdate {
+	_u.mutation.ResetDownloadCount()
+	_u.mutation.SetDownloadCount(v)
+	return _u
+}
+
+// SetNillableDownloadCount sets the "download_count" field if the given value is not nil.
+func (_u *FileShareLinkUpdate) SetNillableDownloadCount(v *uint32) *FileShareLinkUpdate {
+	if v != nil {
+		_u.SetDownloadCount(*v)
+	}
+	return _u
+}
+
+// AddDownloadCount adds value to the "download_count" field.
+func (_u *FileShareLinkUpdate) AddDownloadCount(v int32) *FileShareLinkUpdate {
+	_u.mutation.AddDownloadCount(v)
+	return _u
+}
+
+// SetAllowedTenantIds sets the "allowed_tenant_ids" field.
+func (_u *FileShareLinkUpdate) SetAllowedTenantIds(v []uint32) *FileShareLinkUpdate {
+	_u.mutation.SetAllowedTenantIds(v)
+	return _u
+}
+
+// AppendAllowedTenantIds appends value to the "allowed_tenant_ids" field.
+func (_u *FileShareLinkUpdate) AppendAllowedTenantIds(v []uint32) *FileShareLinkUpdate {
+	_u.mutation.AppendAllowedTenantIds(v)
+	return _u
+}
+
+// ClearAllowedTenantIds clears the value of the "allowed_tenant_ids" field.
+func (_u *FileShareLinkUpdate) ClearAllowedTenantIds() *FileShareLinkUpdate {
+	_u.mutation.ClearAllowedTenantIds()
+	return _u
+}
+
+// SetAllowedUserIds sets the "allowed_user_ids" field.
+func (_u *FileShareLinkUpdate) SetAllowedUserIds(v []uint32) *FileShareLinkUpdate {
+	_u.mutation.SetAllowedUserIds(v)
+	return _u
+}
+
+// AppendAllowedUserIds appends value to the "allowed_user_ids" field.
+func (_u *FileShareLinkUpdate) AppendAllowedUserIds(v []uint32) *FileShareLinkUpdate {
+	_u.mutation.AppendAllowedUserIds(v)
+	return _u
+}
+
+// ClearAllowedUserIds clears the value of the "allowed_user_ids" field.
+func (_u *FileShareLinkUpdate) ClearAllowedUserIds() *FileShareLinkUpdate {
+	_u.mutation.ClearAllowedUserIds()
+	return _u
+}
+
+// SetAllowedOrgUnitIds sets the "allowed_org_unit_ids" field.
+func (_u *FileShareLinkUpdate) SetAllowedOrgUnitIds(v []uint32) *FileShareLinkUpdate {
+	_u.mutation.SetAllowedOrgUnitIds(v)
+	return _u
+}
+
+// AppendAllowedOrgUnitIds appends value to the "allowed_org_unit_ids" field.
+func (_u *FileShareLinkUpdate) AppendAllowedOrgUnitIds(v []uint32) *FileShareLinkUpdate {
+	_u.mutation.AppendAllowedOrgUnitIds(v)
+	return _u
+}
+
+// ClearAllowedOrgUnitIds clears the value of the "allowed_org_unit_ids" field.
+func (_u *FileShareLinkUpdate) ClearAllowedOrgUnitIds() *FileShareLinkUpdate {
+	_u.mutation.ClearAllowedOrgUnitIds()
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *FileShareLinkUpdate) SetRevokedAt(v time.Time) *FileShareLinkUpdate {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *FileShareLinkUpdate) SetNillableRevokedAt(v *time.Time) *FileShareLinkUpdate {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *FileShareLinkUpdate) ClearRevokedAt() *FileShareLinkUpdate {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// SetRevokedBy sets the "revoked_by" field.
+func (_u *FileShareLinkUpdate) SetRevokedBy(v uint32) *FileShareLinkUpdate {
+	_u.mutation.ResetRevokedBy()
+	_u.mutation.SetRevokedBy(v)
+	return _u
+}
+
+// SetNillableRevokedBy sets the "revoked_by" field if the given value is not nil.
+func (_u *FileShareLinkUpdate) SetNillableRevokedBy(v *uint32) *FileShareLinkUpdate {
+	if v != nil {
+		_u.SetRevokedBy(*v)
+	}
+	return _u
+}
+
+// AddRevokedBy adds value to the "revoked_by" field.
+func (_u *FileShareLinkUpdate) AddRevokedBy(v int32) *FileShareLinkUpdate {
+	_u.mutation.AddRevokedBy(v)
+	return _u
+}
+
+// ClearRevokedBy clears the value of the "revoked_by" field.
+func (_u *FileShareLinkUpdate) ClearRevokedBy() *FileShareLinkUpdate {
+	_u.mutation.ClearRevokedBy()
+	return _u
+}
+
+// SetLastAccessedAt sets the "last_accessed_at" field.
+func (_u *FileShareLinkUpdate) SetLastAccessedAt(v time.Time) *FileShareLinkUpdate {
+	_u.mutation.SetLastAccessedAt(v)
+	return _u
+}
+
+// SetNillableLastAccessedAt sets the "last_accessed_at" field if the given value is not nil.
+func (_u *FileShareLinkUpdate) SetNillableLastAccessedAt(v *time.Time) *FileShareLinkUpdate {
+	if v != nil {
+		_u.SetLastAccessedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastAccessedAt clears the value of the "last_accessed_at" field.
+func (_u *FileShareLinkUpdate) ClearLastAccessedAt() *FileShareLinkUpdate {
+	_u.mutation.ClearLastAccessedAt()
+	return _u
+}
+
+// Mutation returns the FileShareLinkMutation object of the builder.
+func (_u *FileShareLinkUpdate) Mutation() *FileShareLinkMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *FileShareLinkUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FileShareLinkUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *FileShareLinkUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FileShareLinkUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FileShareLinkUpdate) check() error {
+	if v, ok := _u.mutation.Token(); ok {
+		if err := filesharelink.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "FileShareLink.token": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FileShareLinkUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FileShareLinkUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *FileShareLinkUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(filesharelink.Table, filesharelink.Columns, sqlgraph.NewFieldSpec(filesharelink.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(filesharelink.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(filesharelink.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(filesharelink.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(filesharelink.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(filesharelink.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(filesharelink.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(filesharelink.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(filesharelink.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(filesharelink.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(filesharelink.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(filesharelink.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(filesharelink.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(filesharelink.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(filesharelink.FieldDeletedBy, field.TypeUint32)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(filesharelink.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.FileID(); ok {
+		_spec.SetField(filesharelink.FieldFileID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedFileID(); ok {
+		_spec.AddField(filesharelink.FieldFileID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.Token(); ok {
+		_spec.SetField(filesharelink.FieldToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PasswordHash(); ok {
+		_spec.SetField(filesharelink.FieldPasswordHash, field.TypeString, value)
+	}
+	if _u.mutation.PasswordHashCleared() {
+		_spec.ClearField(filesharelink.FieldPasswordHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(filesharelink.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(filesharelink.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.MaxDownloads(); ok {
+		_spec.SetField(filesharelink.FieldMaxDownloads, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedMaxDownloads(); ok {
+		_spec.AddField(filesharelink.FieldMaxDownloads, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.DownloadCount(); ok {
+		_spec.SetField(filesharelink.FieldDownloadCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDownloadCount(); ok {
+		_spec.AddField(filesharelink.FieldDownloadCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AllowedTenantIds(); ok {
+		_spec.SetField(filesharelink.FieldAllowedTenantIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedTenantIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, filesharelink.FieldAllowedTenantIds, value)
+		})
+	}
+	if _u.mutation.AllowedTenantIdsCleared() {
+		_spec.ClearField(filesharelink.FieldAllowedTenantIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AllowedUserIds(); ok {
+		_spec.SetField(filesharelink.FieldAllowedUserIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedUserIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, filesharelink.FieldAllowedUserIds, value)
+		})
+	}
+	if _u.mutation.AllowedUserIdsCleared() {
+		_spec.ClearField(filesharelink.FieldAllowedUserIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AllowedOrgUnitIds(); ok {
+		_spec.SetField(filesharelink.FieldAllowedOrgUnitIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedOrgUnitIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, filesharelink.FieldAllowedOrgUnitIds, value)
+		})
+	}
+	if _u.mutation.AllowedOrgUnitIdsCleared() {
+		_spec.ClearField(filesharelink.FieldAllowedOrgUnitIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(filesharelink.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(filesharelink.FieldRevokedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RevokedBy(); ok {
+		_spec.SetField(filesharelink.FieldRevokedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedRevokedBy(); ok {
+		_spec.AddField(filesharelink.FieldRevokedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.RevokedByCleared() {
+		_spec.ClearField(filesharelink.FieldRevokedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.LastAccessedAt(); ok {
+		_spec.SetField(filesharelink.FieldLastAccessedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastAccessedAtCleared() {
+		_spec.ClearField(filesharelink.FieldLastAccessedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{filesharelink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// FileShareLinkUpdateOne is the builder for updating a single FileShareLink entity.
+type FileShareLinkUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *FileShareLinkMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *FileShareLinkUpdateOne) SetUpdatedAt(v time.Time) *FileShareLinkUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *FileShareLinkUpdateOne) SetNillableUpdatedAt(v *time.Time) *FileShareLinkUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *FileShareLinkUpdateOne) ClearUpdatedAt() *FileShareLinkUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *FileShareLinkUpdateOne) SetDeletedAt(v time.Time) *FileShareLinkUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *FileShareLinkUpdateOne) SetNillableDeletedAt(v *time.Time) *FileShareLinkUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *FileShareLinkUpdateOne) ClearDeletedAt() *FileShareLinkUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *FileShareLinkUpdateOne) SetCreatedBy(v uint32) *FileShareLinkUpdateOne {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *FileShareLinkUpdateOne) SetNillableCreatedBy(v *uint32) *FileShareLinkUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *FileShareLinkUpdateOne) AddCreatedBy(v int32) *FileShareLinkUpdateOne {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *FileShareLinkUpdateOne) ClearCreatedBy() *FileShareLinkUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *FileShareLinkUpdateOne) SetUpdatedBy(v uint32) *FileShareLinkUpdateOne {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *FileShareLinkUpdateOne) SetNillableUpdatedBy(v *uint32) *FileShareLinkUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *FileShareLinkUpdateOne) AddUpdatedBy(v int32) *FileShareLinkUpdateOne {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *FileShareLinkUpdateOne) ClearUpdatedBy() *FileShareLinkUpdateOne {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *FileShareLinkUpdateOne) SetDeletedBy(v uint32) *FileShareLinkUpdateOne {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *FileShareLinkUpdateOne) SetNillableDeletedBy(v *uint32) *FileShareLinkUpdateOne {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *FileShareLinkUpdateOne) AddDeletedBy(v int32) *FileShareLinkUpdateOne {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *FileShareLinkUpdateOne) ClearDeletedBy() *FileShareLinkUpdateOne {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetFileID sets the "file_id" field.
+func (_u *FileShareLinkUpdateOne) SetFileID(v uint32) *FileShareLinkUpdateOne {
+	_u.mutation.ResetFileID()
+	_u.mutation.SetFileID(v)
+	return _u
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (_u *FileShareLinkUpdateOne) SetNillableFileID(v *uint32) *FileShareLinkUpdateOne {
+	if v != nil {
+		_u.SetFileID(*v)
+	}
+	return _u
+}
+
+// AddFileID adds value to the "file_id" field.
+func (_u *FileShareLinkUpdateOne) AddFileID(v int32) *FileShareLinkUpdateOne {
+	_u.mutation.AddFileID(v)
+	return _u
+}
+
+// SetToken sets the "token" field.
+func (_u *FileShareLinkUpdateOne) SetToken(v string) *FileShareLinkUpdateOne {
+	_u.mutation.SetToken(v)
+	return _u
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (_u *FileShareLinkUpdateOne) SetNillableToken(v *string) *FileShareLinkUpdateOne {
+	if v != nil {
+		_u.SetToken(*v)
+	}
+	return _u
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (_u *FileShareLinkUpdateOne) SetPasswordHash(v string) *FileShareLinkUpdateOne {
+	_u.mutation.SetPasswordHash(v)
+	return _u
+}
+
+// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
+func (_u *FileShareLinkUpdateOne) SetNillablePasswordHash(v *string) *FileShareLinkUpdateOne {
+	if v != nil {
+		_u.SetPasswordHash(*v)
+	}
+	return _u
+}
+
+// ClearPasswordHash clears the value of the "password_hash" field.
+func (_u *FileShareLinkUpdateOne) ClearPasswordHash() *FileShareLinkUpdateOne {
+	_u.mutation.ClearPasswordHash()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *FileShareLinkUpdateOne) SetExpiresAt(v time.Time) *FileShareLinkUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *FileShareLinkUpdateOne) SetNillableExpiresAt(v *time.Time) *FileShareLinkUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *FileShareLinkUpdateOne) ClearExpiresAt() *FileShareLinkUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetMaxDownloads sets the "max_downloads" field.
+func (_u *FileShareLinkUpdateOne) SetMaxDownloads(v uint32) *FileShareLinkUpdateOne {
+	_u.mutation.ResetMaxDownloads()
+	_u.mutation.SetMaxDownloads(v)
+	return _u
+}
+
+// SetNillableMaxDownloads sets the "max_downloads" field if the given value is not nil.
+func (_u *FileShareLinkUpdateOne) SetNillableMaxDownloads(v *uint32) *FileShareLinkUpdateOne {
+	if v != nil {
+		_u.SetMaxDownloads(*v)
+	}
+	return _u
+}
+
+// AddMaxDownloads adds value to the "max_downloads" field.
+func (_u *FileShareLinkUpdateOne) AddMaxDownloads(v int32) *FileShareLinkUpdateOne {
+	_u.mutation.AddMaxDownloads(v)
+	return _u
+}
+
+// SetDownloadCount sets the "download_count" field.
+func (_u *FileShareLinkUpdateOne) SetDownloadCount(v uint32) *FileShareLinkUpdateOne {
+	_u.mutation.ResetDownloadCount()
+	_u.mutation.SetDownloadCount(v)
+	return _u
+}
+
+// SetNillableDownloadCount sets the "download_count" field if the given value is not nil.
+func (_u *FileShareLinkUpdateOne) SetNillableDownloadCount(v *uint32) *FileShareLinkUpdateOne {
+	if v != nil {
+		_u.SetDownloadCount(*v)
+	}
+	return _u
+}
+
+// AddDownloadCount adds value to the "download_count" field.
+func (_u *FileShareLinkUpdateOne) AddDownloadCount(v int32) *FileShareLinkUpdateOne {
+	_u.mutation.AddDownloadCount(v)
+	return _u
+}
+
+// SetAllowedTenantIds sets the "allowed_tenant_ids" field.
+func (_u *FileShareLinkUpdateOne) SetAllowedTenantIds(v []uint32) *FileShareLinkUpdateOne {
+	_u.mutation.SetAllowedTenantIds(v)
+	return _u
+}
+
+// AppendAllowedTenantIds appends value to the "allowed_tenant_ids" field.
+func (_u *FileShareLinkUpdateOne) AppendAllowedTenantIds(v []uint32) *FileShareLinkUpdateOne {
+	_u.mutation.AppendAllowedTenantIds(v)
+	return _u
+}
+
+// ClearAllowedTenantIds clears the value of the "allowed_tenant_ids" field.
+func (_u *FileShareLinkUpdateOne) ClearAllowedTenantIds() *FileShareLinkUpdateOne {
+	_u.mutation.ClearAllowedTenantIds()
+	return _u
+}
+
+// SetAllowedUserIds sets the "allowed_user_ids" field.
+func (_u *FileShareLinkUpdateOne) SetAllowedUserIds(v []uint32) *FileShareLinkUpdateOne {
+	_u.mutation.SetAllowedUserIds(v)
+	return _u
+}
+
+// AppendAllowedUserIds appends value to the "allowed_user_ids" field.
+func (_u *FileShareLinkUpdateOne) AppendAllowedUserIds(v []uint32) *FileShareLinkUpdateOne {
+	_u.mutation.AppendAllowedUserIds(v)
+	return _u
+}
+
+// ClearAllowedUserIds clears the value of the "allowed_user_ids" field.
+func (_u *FileShareLinkUpdateOne) ClearAllowedUserIds() *FileShareLinkUpdateOne {
+	_u.mutation.ClearAllowedUserIds()
+	return _u
+}
+
+// SetAllowedOrgUnitIds sets the "allowed_org_unit_ids" field.
+func (_u *FileShareLinkUpdateOne) SetAllowedOrgUnitIds(v []uint32) *FileShareLinkUpdateOne {
+	_u.mutation.SetAllowedOrgUnitIds(v)
+	return _u
+}
+
+// AppendAllowedOrgUnitIds appends value to the "allowed_org_unit_ids" field.
+func (_u *FileShareLinkUpdateOne) AppendAllowedOrgUnitIds(v []uint32) *FileShareLinkUpdateOne {
+	_u.mutation.AppendAllowedOrgUnitIds(v)
+	return _u
+}
+
+// ClearAllowedOrgUnitIds clears the value of the "allowed_org_unit_ids" field.
+func (_u *FileShareLinkUpdateOne) ClearAllowedOrgUnitIds() *FileShareLinkUpdateOne {
+	_u.mutation.ClearAllowedOrgUnitIds()
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *FileShareLinkUpdateOne) SetRevokedAt(v time.Time) *FileShareLinkUpdateOne {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *FileShareLinkUpdateOne) SetNillableRevokedAt(v *time.Time) *FileShareLinkUpdateOne {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *FileShareLinkUpdateOne) ClearRevokedAt() *FileShareLinkUpdateOne {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// SetRevokedBy sets the "revoked_by" field.
+func (_u *FileShareLinkUpdateOne) SetRevokedBy(v uint32) *FileShareLinkUpdateOne {
+	_u.mutation.ResetRevokedBy()
+	_u.mutation.SetRevokedBy(v)
+	return _u
+}
+
+// SetNillableRevokedBy sets the "revoked_by" field if the given value is not nil.
+func (_u *FileShareLinkUpdateOne) SetNillableRevokedBy(v *uint32) *FileShareLinkUpdateOne {
+	if v != nil {
+		_u.SetRevokedBy(*v)
+	}
+	return _u
+}
+
+// AddRevokedBy adds value to the "revoked_by" field.
+func (_u *FileShareLinkUpdateOne) AddRevokedBy(v int32) *FileShareLinkUpdateOne {
+	_u.mutation.AddRevokedBy(v)
+	return _u
+}
+
+// ClearRevokedBy clears the value of the "revoked_by" field.
+func (_u *FileShareLinkUpdateOne) ClearRevokedBy() *FileShareLinkUpdateOne {
+	_u.mutation.ClearRevokedBy()
+	return _u
+}
+
+// SetLastAccessedAt sets the "last_accessed_at" field.
+func (_u *FileShareLinkUpdateOne) SetLastAccessedAt(v time.Time) *FileShareLinkUpdateOne {
+	_u.mutation.SetLastAccessedAt(v)
+	return _u
+}
+
+// SetNillableLastAccessedAt sets the "last_accessed_at" field if the given value is not nil.
+func (_u *FileShareLinkUpdateOne) SetNillableLastAccessedAt(v *time.Time) *FileShareLinkUpdateOne {
+	if v != nil {
+		_u.SetLastAccessedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastAccessedAt clears the value of the "last_accessed_at" field.
+func (_u *FileShareLinkUpdateOne) ClearLastAccessedAt() *FileShareLinkUpdateOne {
+	_u.mutation.ClearLastAccessedAt()
+	return _u
+}
+
+// Mutation returns the FileShareLinkMutation object of the builder.
+func (_u *FileShareLinkUpdateOne) Mutation() *FileShareLinkMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the FileShareLinkUpdate builder.
+func (_u *FileShareLinkUpdateOne) Where(ps ...predicate.FileShareLink) *FileShareLinkUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *FileShareLinkUpdateOne) Select(field string, fields ...string) *FileShareLinkUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated FileShareLink entity.
+func (_u *FileShareLinkUpdateOne) Save(ctx context.Context) (*FileShareLink, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FileShareLinkUpdateOne) SaveX(ctx context.Context) *FileShareLink {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *FileShareLinkUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FileShareLinkUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FileShareLinkUpdateOne) check() error {
+	if v, ok := _u.mutation.Token(); ok {
+		if err := filesharelink.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "FileShareLink.token": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FileShareLinkUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FileShareLinkUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *FileShareLinkUpdateOne) sqlSave(ctx context.Context) (_node *FileShareLink, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(filesharelink.Table, filesharelink.Columns, sqlgraph.NewFieldSpec(filesharelink.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FileShareLink.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, filesharelink.FieldID)
+		for _, f := range fields {
+			if !filesharelink.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != filesharelink.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(filesharelink.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(filesharelink.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(filesharelink.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(filesharelink.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(filesharelink.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(filesharelink.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(filesharelink.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(filesharelink.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(filesharelink.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(filesharelink.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(filesharelink.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(filesharelink.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(filesharelink.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(filesharelink.FieldDeletedBy, field.TypeUint32)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(filesharelink.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.FileID(); ok {
+		_spec.SetField(filesharelink.FieldFileID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedFileID(); ok {
+		_spec.AddField(filesharelink.FieldFileID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.Token(); ok {
+		_spec.SetField(filesharelink.FieldToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PasswordHash(); ok {
+		_spec.SetField(filesharelink.FieldPasswordHash, field.TypeString, value)
+	}
+	if _u.mutation.PasswordHashCleared() {
+		_spec.ClearField(filesharelink.FieldPasswordHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(filesharelink.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(filesharelink.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.MaxDownloads(); ok {
+		_spec.SetField(filesharelink.FieldMaxDownloads, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedMaxDownloads(); ok {
+		_spec.AddField(filesharelink.FieldMaxDownloads, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.DownloadCount(); ok {
+		_spec.SetField(filesharelink.FieldDownloadCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDownloadCount(); ok {
+		_spec.AddField(filesharelink.FieldDownloadCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AllowedTenantIds(); ok {
+		_spec.SetField(filesharelink.FieldAllowedTenantIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedTenantIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, filesharelink.FieldAllowedTenantIds, value)
+		})
+	}
+	if _u.mutation.AllowedTenantIdsCleared() {
+		_spec.ClearField(filesharelink.FieldAllowedTenantIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AllowedUserIds(); ok {
+		_spec.SetField(filesharelink.FieldAllowedUserIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedUserIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, filesharelink.FieldAllowedUserIds, value)
+		})
+	}
+	if _u.mutation.AllowedUserIdsCleared() {
+		_spec.ClearField(filesharelink.FieldAllowedUserIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AllowedOrgUnitIds(); ok {
+		_spec.SetField(filesharelink.FieldAllowedOrgUnitIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedOrgUnitIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, filesharelink.FieldAllowedOrgUnitIds, value)
+		})
+	}
+	if _u.mutation.AllowedOrgUnitIdsCleared() {
+		_spec.ClearField(filesharelink.FieldAllowedOrgUnitIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(filesharelink.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(filesharelink.FieldRevokedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RevokedBy(); ok {
+		_spec.SetField(filesharelink.FieldRevokedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedRevokedBy(); ok {
+		_spec.AddField(filesharelink.FieldRevokedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.RevokedByCleared() {
+		_spec.ClearField(filesharelink.FieldRevokedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.LastAccessedAt(); ok {
+		_spec.SetField(filesharelink.FieldLastAccessedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastAccessedAtCleared() {
+		_spec.ClearField(filesharelink.FieldLastAccessedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &FileShareLink{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{filesharelink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
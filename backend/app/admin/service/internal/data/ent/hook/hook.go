@@ -32,6 +32,42 @@ func (f ApiAuditLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ApiAuditLogMutation", m)
 }
 
+// The AuditForwarderFunc type is an adapter to allow the use of ordinary
+// function as AuditForwarder mutator.
+type AuditForwarderFunc func(context.Context, *ent.AuditForwarderMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuditForwarderFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AuditForwarderMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditForwarderMutation", m)
+}
+
+// The AuditLogArchiveFunc type is an adapter to allow the use of ordinary
+// function as AuditLogArchive mutator.
+type AuditLogArchiveFunc func(context.Context, *ent.AuditLogArchiveMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuditLogArchiveFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AuditLogArchiveMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditLogArchiveMutation", m)
+}
+
+// The AuditLogRetentionPolicyFunc type is an adapter to allow the use of ordinary
+// function as AuditLogRetentionPolicy mutator.
+type AuditLogRetentionPolicyFunc func(context.Context, *ent.AuditLogRetentionPolicyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuditLogRetentionPolicyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AuditLogRetentionPolicyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditLogRetentionPolicyMutation", m)
+}
+
 // The DataAccessAuditLogFunc type is an adapter to allow the use of ordinary
 // function as DataAccessAuditLog mutator.
 type DataAccessAuditLogFunc func(context.Context, *ent.DataAccessAuditLogMutation) (ent.Value, error)
@@ -92,6 +128,18 @@ func (f FileFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FileMutation", m)
 }
 
+// The FileShareLinkFunc type is an adapter to allow the use of ordinary
+// function as FileShareLink mutator.
+type FileShareLinkFunc func(context.Context, *ent.FileShareLinkMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FileShareLinkFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FileShareLinkMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FileShareLinkMutation", m)
+}
+
 // The InternalMessageFunc type is an adapter to allow the use of ordinary
 // function as InternalMessage mutator.
 type InternalMessageFunc func(context.Context, *ent.InternalMessageMutation) (ent.Value, error)
@@ -164,6 +212,30 @@ func (f LoginPolicyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LoginPolicyMutation", m)
 }
 
+// The LuaScriptFunc type is an adapter to allow the use of ordinary
+// function as LuaScript mutator.
+type LuaScriptFunc func(context.Context, *ent.LuaScriptMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LuaScriptFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LuaScriptMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LuaScriptMutation", m)
+}
+
+// The LuaScriptVersionFunc type is an adapter to allow the use of ordinary
+// function as LuaScriptVersion mutator.
+type LuaScriptVersionFunc func(context.Context, *ent.LuaScriptVersionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LuaScriptVersionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LuaScriptVersionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LuaScriptVersionMutation", m)
+}
+
 // The MembershipFunc type is an adapter to allow the use of ordinary
 // function as Membership mutator.
 type MembershipFunc func(context.Context, *ent.MembershipMutation) (ent.Value, error)
@@ -344,6 +416,18 @@ func (f PositionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PositionMutation", m)
 }
 
+// The RestoredAuditLogFunc type is an adapter to allow the use of ordinary
+// function as RestoredAuditLog mutator.
+type RestoredAuditLogFunc func(context.Context, *ent.RestoredAuditLogMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RestoredAuditLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RestoredAuditLogMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RestoredAuditLogMutation", m)
+}
+
 // The RoleFunc type is an adapter to allow the use of ordinary
 // function as Role mutator.
 type RoleFunc func(context.Context, *ent.RoleMutation) (ent.Value, error)
@@ -380,6 +464,30 @@ func (f RolePermissionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RolePermissionMutation", m)
 }
 
+// The StorageObjectFunc type is an adapter to allow the use of ordinary
+// function as StorageObject mutator.
+type StorageObjectFunc func(context.Context, *ent.StorageObjectMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f StorageObjectFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.StorageObjectMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.StorageObjectMutation", m)
+}
+
+// The StorageQuotaFunc type is an adapter to allow the use of ordinary
+// function as StorageQuota mutator.
+type StorageQuotaFunc func(context.Context, *ent.StorageQuotaMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f StorageQuotaFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.StorageQuotaMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.StorageQuotaMutation", m)
+}
+
 // The TaskFunc type is an adapter to allow the use of ordinary
 // function as Task mutator.
 type TaskFunc func(context.Context, *ent.TaskMutation) (ent.Value, error)
@@ -392,6 +500,42 @@ func (f TaskFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TaskMutation", m)
 }
 
+// The TaskRunFunc type is an adapter to allow the use of ordinary
+// function as TaskRun mutator.
+type TaskRunFunc func(context.Context, *ent.TaskRunMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TaskRunFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TaskRunMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TaskRunMutation", m)
+}
+
+// The TaskWorkflowFunc type is an adapter to allow the use of ordinary
+// function as TaskWorkflow mutator.
+type TaskWorkflowFunc func(context.Context, *ent.TaskWorkflowMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TaskWorkflowFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TaskWorkflowMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TaskWorkflowMutation", m)
+}
+
+// The TaskWorkflowRunFunc type is an adapter to allow the use of ordinary
+// function as TaskWorkflowRun mutator.
+type TaskWorkflowRunFunc func(context.Context, *ent.TaskWorkflowRunMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TaskWorkflowRunFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TaskWorkflowRunMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TaskWorkflowRunMutation", m)
+}
+
 // The TenantFunc type is an adapter to allow the use of ordinary
 // function as Tenant mutator.
 type TenantFunc func(context.Context, *ent.TenantMutation) (ent.Value, error)
@@ -0,0 +1,1040 @@
+// Code generated by ent, DO NOT EDIT.
+
+package luascript
+
+import (
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// DeletedBy applies equality check predicate on the "deleted_by" field. It's identical to DeletedByEQ.
+func DeletedBy(v uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEQ(FieldName, v))
+}
+
+// Hook applies equality check predicate on the "hook" field. It's identical to HookEQ.
+func Hook(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEQ(FieldHook, v))
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEQ(FieldSource, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEQ(FieldDescription, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEQ(FieldEnabled, v))
+}
+
+// Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
+func Priority(v int32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEQ(FieldPriority, v))
+}
+
+// Critical applies equality check predicate on the "critical" field. It's identical to CriticalEQ.
+func Critical(v bool) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEQ(FieldCritical, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEQ(FieldVersion, v))
+}
+
+// Author applies equality check predicate on the "author" field. It's identical to AuthorEQ.
+func Author(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEQ(FieldAuthor, v))
+}
+
+// DataWrite applies equality check predicate on the "data_write" field. It's identical to DataWriteEQ.
+func DataWrite(v bool) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEQ(FieldDataWrite, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// DeletedByEQ applies the EQ predicate on the "deleted_by" field.
+func DeletedByEQ(v uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// DeletedByNEQ applies the NEQ predicate on the "deleted_by" field.
+func DeletedByNEQ(v uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNEQ(FieldDeletedBy, v))
+}
+
+// DeletedByIn applies the In predicate on the "deleted_by" field.
+func DeletedByIn(vs ...uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByNotIn applies the NotIn predicate on the "deleted_by" field.
+func DeletedByNotIn(vs ...uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNotIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByGT applies the GT predicate on the "deleted_by" field.
+func DeletedByGT(v uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldGT(FieldDeletedBy, v))
+}
+
+// DeletedByGTE applies the GTE predicate on the "deleted_by" field.
+func DeletedByGTE(v uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldGTE(FieldDeletedBy, v))
+}
+
+// DeletedByLT applies the LT predicate on the "deleted_by" field.
+func DeletedByLT(v uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldLT(FieldDeletedBy, v))
+}
+
+// DeletedByLTE applies the LTE predicate on the "deleted_by" field.
+func DeletedByLTE(v uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldLTE(FieldDeletedBy, v))
+}
+
+// DeletedByIsNil applies the IsNil predicate on the "deleted_by" field.
+func DeletedByIsNil() predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldIsNull(FieldDeletedBy))
+}
+
+// DeletedByNotNil applies the NotNil predicate on the "deleted_by" field.
+func DeletedByNotNil() predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNotNull(FieldDeletedBy))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNotNull(FieldTenantID))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldIsNull(FieldName))
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNotNull(FieldName))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldContainsFold(FieldName, v))
+}
+
+// HookEQ applies the EQ predicate on the "hook" field.
+func HookEQ(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEQ(FieldHook, v))
+}
+
+// HookNEQ applies the NEQ predicate on the "hook" field.
+func HookNEQ(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNEQ(FieldHook, v))
+}
+
+// HookIn applies the In predicate on the "hook" field.
+func HookIn(vs ...string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldIn(FieldHook, vs...))
+}
+
+// HookNotIn applies the NotIn predicate on the "hook" field.
+func HookNotIn(vs ...string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNotIn(FieldHook, vs...))
+}
+
+// HookGT applies the GT predicate on the "hook" field.
+func HookGT(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldGT(FieldHook, v))
+}
+
+// HookGTE applies the GTE predicate on the "hook" field.
+func HookGTE(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldGTE(FieldHook, v))
+}
+
+// HookLT applies the LT predicate on the "hook" field.
+func HookLT(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldLT(FieldHook, v))
+}
+
+// HookLTE applies the LTE predicate on the "hook" field.
+func HookLTE(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldLTE(FieldHook, v))
+}
+
+// HookContains applies the Contains predicate on the "hook" field.
+func HookContains(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldContains(FieldHook, v))
+}
+
+// HookHasPrefix applies the HasPrefix predicate on the "hook" field.
+func HookHasPrefix(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldHasPrefix(FieldHook, v))
+}
+
+// HookHasSuffix applies the HasSuffix predicate on the "hook" field.
+func HookHasSuffix(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldHasSuffix(FieldHook, v))
+}
+
+// HookIsNil applies the IsNil predicate on the "hook" field.
+func HookIsNil() predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldIsNull(FieldHook))
+}
+
+// HookNotNil applies the NotNil predicate on the "hook" field.
+func HookNotNil() predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNotNull(FieldHook))
+}
+
+// HookEqualFold applies the EqualFold predicate on the "hook" field.
+func HookEqualFold(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEqualFold(FieldHook, v))
+}
+
+// HookContainsFold applies the ContainsFold predicate on the "hook" field.
+func HookContainsFold(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldContainsFold(FieldHook, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceIsNil applies the IsNil predicate on the "source" field.
+func SourceIsNil() predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldIsNull(FieldSource))
+}
+
+// SourceNotNil applies the NotNil predicate on the "source" field.
+func SourceNotNil() predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNotNull(FieldSource))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldContainsFold(FieldSource, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// EnabledIsNil applies the IsNil predicate on the "enabled" field.
+func EnabledIsNil() predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldIsNull(FieldEnabled))
+}
+
+// EnabledNotNil applies the NotNil predicate on the "enabled" field.
+func EnabledNotNil() predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNotNull(FieldEnabled))
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v int32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v int32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...int32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...int32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// PriorityGT applies the GT predicate on the "priority" field.
+func PriorityGT(v int32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldGT(FieldPriority, v))
+}
+
+// PriorityGTE applies the GTE predicate on the "priority" field.
+func PriorityGTE(v int32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldGTE(FieldPriority, v))
+}
+
+// PriorityLT applies the LT predicate on the "priority" field.
+func PriorityLT(v int32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldLT(FieldPriority, v))
+}
+
+// PriorityLTE applies the LTE predicate on the "priority" field.
+func PriorityLTE(v int32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldLTE(FieldPriority, v))
+}
+
+// PriorityIsNil applies the IsNil predicate on the "priority" field.
+func PriorityIsNil() predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldIsNull(FieldPriority))
+}
+
+// PriorityNotNil applies the NotNil predicate on the "priority" field.
+func PriorityNotNil() predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNotNull(FieldPriority))
+}
+
+// CriticalEQ applies the EQ predicate on the "critical" field.
+func CriticalEQ(v bool) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEQ(FieldCritical, v))
+}
+
+// CriticalNEQ applies the NEQ predicate on the "critical" field.
+func CriticalNEQ(v bool) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNEQ(FieldCritical, v))
+}
+
+// CriticalIsNil applies the IsNil predicate on the "critical" field.
+func CriticalIsNil() predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldIsNull(FieldCritical))
+}
+
+// CriticalNotNil applies the NotNil predicate on the "critical" field.
+func CriticalNotNil() predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNotNull(FieldCritical))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v uint32) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldLTE(FieldVersion, v))
+}
+
+// VersionIsNil applies the IsNil predicate on the "version" field.
+func VersionIsNil() predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldIsNull(FieldVersion))
+}
+
+// VersionNotNil applies the NotNil predicate on the "version" field.
+func VersionNotNil() predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNotNull(FieldVersion))
+}
+
+// AuthorEQ applies the EQ predicate on the "author" field.
+func AuthorEQ(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEQ(FieldAuthor, v))
+}
+
+// AuthorNEQ applies the NEQ predicate on the "author" field.
+func AuthorNEQ(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNEQ(FieldAuthor, v))
+}
+
+// AuthorIn applies the In predicate on the "author" field.
+func AuthorIn(vs ...string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldIn(FieldAuthor, vs...))
+}
+
+// AuthorNotIn applies the NotIn predicate on the "author" field.
+func AuthorNotIn(vs ...string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNotIn(FieldAuthor, vs...))
+}
+
+// AuthorGT applies the GT predicate on the "author" field.
+func AuthorGT(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldGT(FieldAuthor, v))
+}
+
+// AuthorGTE applies the GTE predicate on the "author" field.
+func AuthorGTE(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldGTE(FieldAuthor, v))
+}
+
+// AuthorLT applies the LT predicate on the "author" field.
+func AuthorLT(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldLT(FieldAuthor, v))
+}
+
+// AuthorLTE applies the LTE predicate on the "author" field.
+func AuthorLTE(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldLTE(FieldAuthor, v))
+}
+
+// AuthorContains applies the Contains predicate on the "author" field.
+func AuthorContains(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldContains(FieldAuthor, v))
+}
+
+// AuthorHasPrefix applies the HasPrefix predicate on the "author" field.
+func AuthorHasPrefix(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldHasPrefix(FieldAuthor, v))
+}
+
+// AuthorHasSuffix applies the HasSuffix predicate on the "author" field.
+func AuthorHasSuffix(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldHasSuffix(FieldAuthor, v))
+}
+
+// AuthorIsNil applies the IsNil predicate on the "author" field.
+func AuthorIsNil() predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldIsNull(FieldAuthor))
+}
+
+// AuthorNotNil applies the NotNil predicate on the "author" field.
+func AuthorNotNil() predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNotNull(FieldAuthor))
+}
+
+// AuthorEqualFold applies the EqualFold predicate on the "author" field.
+func AuthorEqualFold(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEqualFold(FieldAuthor, v))
+}
+
+// AuthorContainsFold applies the ContainsFold predicate on the "author" field.
+func AuthorContainsFold(v string) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldContainsFold(FieldAuthor, v))
+}
+
+// DataWriteEQ applies the EQ predicate on the "data_write" field.
+func DataWriteEQ(v bool) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldEQ(FieldDataWrite, v))
+}
+
+// DataWriteNEQ applies the NEQ predicate on the "data_write" field.
+func DataWriteNEQ(v bool) predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNEQ(FieldDataWrite, v))
+}
+
+// DataWriteIsNil applies the IsNil predicate on the "data_write" field.
+func DataWriteIsNil() predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldIsNull(FieldDataWrite))
+}
+
+// DataWriteNotNil applies the NotNil predicate on the "data_write" field.
+func DataWriteNotNil() predicate.LuaScript {
+	return predicate.LuaScript(sql.FieldNotNull(FieldDataWrite))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LuaScript) predicate.LuaScript {
+	return predicate.LuaScript(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LuaScript) predicate.LuaScript {
+	return predicate.LuaScript(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LuaScript) predicate.LuaScript {
+	return predicate.LuaScript(sql.NotPredicates(p))
+}
@@ -0,0 +1,252 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/luascriptversion"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Lua脚本版本历史表
+type LuaScriptVersion struct {
+	config `json:"-"`
+	// ID of the ent.
+	// id
+	ID uint32 `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// 创建者ID
+	CreatedBy *uint32 `json:"created_by,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// 脚本ID
+	ScriptID *uint32 `json:"script_id,omitempty"`
+	// 版本号
+	Version *uint32 `json:"version,omitempty"`
+	// 挂载的钩子名称
+	Hook *string `json:"hook,omitempty"`
+	// 脚本源码
+	Source *string `json:"source,omitempty"`
+	// 执行顺序
+	Priority *int32 `json:"priority,omitempty"`
+	// 失败时是否中止钩子
+	Critical *bool `json:"critical,omitempty"`
+	// 作者
+	Author *string `json:"author,omitempty"`
+	// 变更说明
+	ChangeLog    *string `json:"change_log,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LuaScriptVersion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case luascriptversion.FieldCritical:
+			values[i] = new(sql.NullBool)
+		case luascriptversion.FieldID, luascriptversion.FieldCreatedBy, luascriptversion.FieldTenantID, luascriptversion.FieldScriptID, luascriptversion.FieldVersion, luascriptversion.FieldPriority:
+			values[i] = new(sql.NullInt64)
+		case luascriptversion.FieldHook, luascriptversion.FieldSource, luascriptversion.FieldAuthor, luascriptversion.FieldChangeLog:
+			values[i] = new(sql.NullString)
+		case luascriptversion.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LuaScriptVersion fields.
+func (_m *LuaScriptVersion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case luascriptversion.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint32(value.Int64)
+		case luascriptversion.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = new(time.Time)
+				*_m.CreatedAt = value.Time
+			}
+		case luascriptversion.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = new(uint32)
+				*_m.CreatedBy = uint32(value.Int64)
+			}
+		case luascriptversion.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case luascriptversion.FieldScriptID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field script_id", values[i])
+			} else if value.Valid {
+				_m.ScriptID = new(uint32)
+				*_m.ScriptID = uint32(value.Int64)
+			}
+		case luascriptversion.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = new(uint32)
+				*_m.Version = uint32(value.Int64)
+			}
+		case luascriptversion.FieldHook:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hook", values[i])
+			} else if value.Valid {
+				_m.Hook = new(string)
+				*_m.Hook = value.String
+			}
+		case luascriptversion.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				_m.Source = new(string)
+				*_m.Source = value.String
+			}
+		case luascriptversion.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				_m.Priority = new(int32)
+				*_m.Priority = int32(value.Int64)
+			}
+		case luascriptversion.FieldCritical:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field critical", values[i])
+			} else if value.Valid {
+				_m.Critical = new(bool)
+				*_m.Critical = value.Bool
+			}
+		case luascriptversion.FieldAuthor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field author", values[i])
+			} else if value.Valid {
+				_m.Author = new(string)
+				*_m.Author = value.String
+			}
+		case luascriptversion.FieldChangeLog:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field change_log", values[i])
+			} else if value.Valid {
+				_m.ChangeLog = new(string)
+				*_m.ChangeLog = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LuaScriptVersion.
+// This includes values selected through modifiers, order, etc.
+func (_m *LuaScriptVersion) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this LuaScriptVersion.
+// Note that you need to call LuaScriptVersion.Unwrap() before calling this method if this LuaScriptVersion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LuaScriptVersion) Update() *LuaScriptVersionUpdateOne {
+	return NewLuaScriptVersionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LuaScriptVersion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LuaScriptVersion) Unwrap() *LuaScriptVersion {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LuaScriptVersion is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LuaScriptVersion) String() string {
+	var builder strings.Builder
+	builder.WriteString("LuaScriptVersion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreatedAt; v != nil {
+		builder.WriteString("created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.CreatedBy; v != nil {
+		builder.WriteString("created_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ScriptID; v != nil {
+		builder.WriteString("script_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Version; v != nil {
+		builder.WriteString("version=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Hook; v != nil {
+		builder.WriteString("hook=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Source; v != nil {
+		builder.WriteString("source=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Priority; v != nil {
+		builder.WriteString("priority=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Critical; v != nil {
+		builder.WriteString("critical=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Author; v != nil {
+		builder.WriteString("author=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ChangeLog; v != nil {
+		builder.WriteString("change_log=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LuaScriptVersions is a parsable slice of LuaScriptVersion.
+type LuaScriptVersions []*LuaScriptVersion
@@ -0,0 +1,1295 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/luascriptversion"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LuaScriptVersionCreate is the builder for creating a LuaScriptVersion entity.
+type LuaScriptVersionCreate struct {
+	config
+	mutation *LuaScriptVersionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LuaScriptVersionCreate) SetCreatedAt(v time.Time) *LuaScriptVersionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LuaScriptVersionCreate) SetNillableCreatedAt(v *time.Time) *LuaScriptVersionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *LuaScriptVersionCreate) SetCreatedBy(v uint32) *LuaScriptVersionCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *LuaScriptVersionCreate) SetNillableCreatedBy(v *uint32) *LuaScriptVersionCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *LuaScriptVersionCreate) SetTenantID(v uint32) *LuaScriptVersionCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *LuaScriptVersionCreate) SetNillableTenantID(v *uint32) *LuaScriptVersionCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetScriptID sets the "script_id" field.
+func (_c *LuaScriptVersionCreate) SetScriptID(v uint32) *LuaScriptVersionCreate {
+	_c.mutation.SetScriptID(v)
+	return _c
+}
+
+// SetNillableScriptID sets the "script_id" field if the given value is not nil.
+func (_c *LuaScriptVersionCreate) SetNillableScriptID(v *uint32) *LuaScriptVersionCreate {
+	if v != nil {
+		_c.SetScriptID(*v)
+	}
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *LuaScriptVersionCreate) SetVersion(v uint32) *LuaScriptVersionCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *LuaScriptVersionCreate) SetNillableVersion(v *uint32) *LuaScriptVersionCreate {
+	if v != nil {
+		_c.SetVersion(*v)
+	}
+	return _c
+}
+
+// SetHook sets the "hook" field.
+func (_c *LuaScriptVersionCreate) SetHook(v string) *LuaScriptVersionCreate {
+	_c.mutation.SetHook(v)
+	return _c
+}
+
+// SetNillableHook sets the "hook" field if the given value is not nil.
+func (_c *LuaScriptVersionCreate) SetNillableHook(v *string) *LuaScriptVersionCreate {
+	if v != nil {
+		_c.SetHook(*v)
+	}
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *LuaScriptVersionCreate) SetSource(v string) *LuaScriptVersionCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_c *LuaScriptVersionCreate) SetNillableSource(v *string) *LuaScriptVersionCreate {
+	if v != nil {
+		_c.SetSource(*v)
+	}
+	return _c
+}
+
+// SetPriority sets the "priority" field.
+func (_c *LuaScriptVersionCreate) SetPriority(v int32) *LuaScriptVersionCreate {
+	_c.mutation.SetPriority(v)
+	return _c
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_c *LuaScriptVersionCreate) SetNillablePriority(v *int32) *LuaScriptVersionCreate {
+	if v != nil {
+		_c.SetPriority(*v)
+	}
+	return _c
+}
+
+// SetCritical sets the "critical" field.
+func (_c *LuaScriptVersionCreate) SetCritical(v bool) *LuaScriptVersionCreate {
+	_c.mutation.SetCritical(v)
+	return _c
+}
+
+// SetNillableCritical sets the "critical" field if the given value is not nil.
+func (_c *LuaScriptVersionCreate) SetNillableCritical(v *bool) *LuaScriptVersionCreate {
+	if v != nil {
+		_c.SetCritical(*v)
+	}
+	return _c
+}
+
+// SetAuthor sets the "author" field.
+func (_c *LuaScriptVersionCreate) SetAuthor(v string) *LuaScriptVersionCreate {
+	_c.mutation.SetAuthor(v)
+	return _c
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (_c *LuaScriptVersionCreate) SetNillableAuthor(v *string) *LuaScriptVersionCreate {
+	if v != nil {
+		_c.SetAuthor(*v)
+	}
+	return _c
+}
+
+// SetChangeLog sets the "change_log" field.
+func (_c *LuaScriptVersionCreate) SetChangeLog(v string) *LuaScriptVersionCreate {
+	_c.mutation.SetChangeLog(v)
+	return _c
+}
+
+// SetNillableChangeLog sets the "change_log" field if the given value is not nil.
+func (_c *LuaScriptVersionCreate) SetNillableChangeLog(v *string) *LuaScriptVersionCreate {
+	if v != nil {
+		_c.SetChangeLog(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LuaScriptVersionCreate) SetID(v uint32) *LuaScriptVersionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the LuaScriptVersionMutation object of the builder.
+func (_c *LuaScriptVersionCreate) Mutation() *LuaScriptVersionMutation {
+	return _c.mutation
+}
+
+// Save creates the LuaScriptVersion in the database.
+func (_c *LuaScriptVersionCreate) Save(ctx context.Context) (*LuaScriptVersion, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LuaScriptVersionCreate) SaveX(ctx context.Context) *LuaScriptVersion {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LuaScriptVersionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LuaScriptVersionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LuaScriptVersionCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := luascriptversion.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LuaScriptVersionCreate) check() error {
+	if v, ok := _c.mutation.Hook(); ok {
+		if err := luascriptversion.HookValidator(v); err != nil {
+			return &ValidationError{Name: "hook", err: fmt.Errorf(`ent: validator failed for field "LuaScriptVersion.hook": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Author(); ok {
+		if err := luascriptversion.AuthorValidator(v); err != nil {
+			return &ValidationError{Name: "author", err: fmt.Errorf(`ent: validator failed for field "LuaScriptVersion.author": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ChangeLog(); ok {
+		if err := luascriptversion.ChangeLogValidator(v); err != nil {
+			return &ValidationError{Name: "change_log", err: fmt.Errorf(`ent: validator failed for field "LuaScriptVersion.change_log": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := luascriptversion.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "LuaScriptVersion.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *LuaScriptVersionCreate) sqlSave(ctx context.Context) (*LuaScriptVersion, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LuaScriptVersionCreate) createSpec() (*LuaScriptVersion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LuaScriptVersion{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(luascriptversion.Table, sqlgraph.NewFieldSpec(luascriptversion.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(luascriptversion.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(luascriptversion.FieldCreatedBy, field.TypeUint32, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(luascriptversion.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.ScriptID(); ok {
+		_spec.SetField(luascriptversion.FieldScriptID, field.TypeUint32, value)
+		_node.ScriptID = &value
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(luascriptversion.FieldVersion, field.TypeUint32, value)
+		_node.Version = &value
+	}
+	if value, ok := _c.mutation.Hook(); ok {
+		_spec.SetField(luascriptversion.FieldHook, field.TypeString, value)
+		_node.Hook = &value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(luascriptversion.FieldSource, field.TypeString, value)
+		_node.Source = &value
+	}
+	if value, ok := _c.mutation.Priority(); ok {
+		_spec.SetField(luascriptversion.FieldPriority, field.TypeInt32, value)
+		_node.Priority = &value
+	}
+	if value, ok := _c.mutation.Critical(); ok {
+		_spec.SetField(luascriptversion.FieldCritical, field.TypeBool, value)
+		_node.Critical = &value
+	}
+	if value, ok := _c.mutation.Author(); ok {
+		_spec.SetField(luascriptversion.FieldAuthor, field.TypeString, value)
+		_node.Author = &value
+	}
+	if value, ok := _c.mutation.ChangeLog(); ok {
+		_spec.SetField(luascriptversion.FieldChangeLog, field.TypeString, value)
+		_node.ChangeLog = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LuaScriptVersion.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LuaScriptVersionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LuaScriptVersionCreate) OnConflict(opts ...sql.ConflictOption) *LuaScriptVersionUpsertOne {
+	_c.conflict = opts
+	return &LuaScriptVersionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LuaScriptVersion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LuaScriptVersionCreate) OnConflictColumns(columns ...string) *LuaScriptVersionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LuaScriptVersionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// LuaScriptVersionUpsertOne is the builder for "upsert"-ing
+	//  one LuaScriptVersion node.
+	LuaScriptVersionUpsertOne struct {
+		create *LuaScriptVersionCreate
+	}
+
+	// LuaScriptVersionUpsert is the "OnConflict" setter.
+	LuaScriptVersionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreatedBy sets the "created_by" field.
+func (u *LuaScriptVersionUpsert) SetCreatedBy(v uint32) *LuaScriptVersionUpsert {
+	u.Set(luascriptversion.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *LuaScriptVersionUpsert) UpdateCreatedBy() *LuaScriptVersionUpsert {
+	u.SetExcluded(luascriptversion.FieldCreatedBy)
+	return u
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *LuaScriptVersionUpsert) AddCreatedBy(v uint32) *LuaScriptVersionUpsert {
+	u.Add(luascriptversion.FieldCreatedBy, v)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *LuaScriptVersionUpsert) ClearCreatedBy() *LuaScriptVersionUpsert {
+	u.SetNull(luascriptversion.FieldCreatedBy)
+	return u
+}
+
+// SetScriptID sets the "script_id" field.
+func (u *LuaScriptVersionUpsert) SetScriptID(v uint32) *LuaScriptVersionUpsert {
+	u.Set(luascriptversion.FieldScriptID, v)
+	return u
+}
+
+// UpdateScriptID sets the "script_id" field to the value that was provided on create.
+func (u *LuaScriptVersionUpsert) UpdateScriptID() *LuaScriptVersionUpsert {
+	u.SetExcluded(luascriptversion.FieldScriptID)
+	return u
+}
+
+// AddScriptID adds v to the "script_id" field.
+func (u *LuaScriptVersionUpsert) AddScriptID(v uint32) *LuaScriptVersionUpsert {
+	u.Add(luascriptversion.FieldScriptID, v)
+	return u
+}
+
+// ClearScriptID clears the value of the "script_id" field.
+func (u *LuaScriptVersionUpsert) ClearScriptID() *LuaScriptVersionUpsert {
+	u.SetNull(luascriptversion.FieldScriptID)
+	return u
+}
+
+// SetVersion sets the "version" field.
+func (u *LuaScriptVersionUpsert) SetVersion(v uint32) *LuaScriptVersionUpsert {
+	u.Set(luascriptversion.FieldVersion, v)
+	return u
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *LuaScriptVersionUpsert) UpdateVersion() *LuaScriptVersionUpsert {
+	u.SetExcluded(luascriptversion.FieldVersion)
+	return u
+}
+
+// AddVersion adds v to the "version" field.
+func (u *LuaScriptVersionUpsert) AddVersion(v uint32) *LuaScriptVersionUpsert {
+	u.Add(luascriptversion.FieldVersion, v)
+	return u
+}
+
+// ClearVersion clears the value of the "version" field.
+func (u *LuaScriptVersionUpsert) ClearVersion() *LuaScriptVersionUpsert {
+	u.SetNull(luascriptversion.FieldVersion)
+	return u
+}
+
+// SetHook sets the "hook" field.
+func (u *LuaScriptVersionUpsert) SetHook(v string) *LuaScriptVersionUpsert {
+	u.Set(luascriptversion.FieldHook, v)
+	return u
+}
+
+// UpdateHook sets the "hook" field to the value that was provided on create.
+func (u *LuaScriptVersionUpsert) UpdateHook() *LuaScriptVersionUpsert {
+	u.SetExcluded(luascriptversion.FieldHook)
+	return u
+}
+
+// ClearHook clears the value of the "hook" field.
+func (u *LuaScriptVersionUpsert) ClearHook() *LuaScriptVersionUpsert {
+	u.SetNull(luascriptversion.FieldHook)
+	return u
+}
+
+// SetSource sets the "source" field.
+func (u *LuaScriptVersionUpsert) SetSource(v string) *LuaScriptVersionUpsert {
+	u.Set(luascriptversion.FieldSource, v)
+	return u
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *LuaScriptVersionUpsert) UpdateSource() *LuaScriptVersionUpsert {
+	u.SetExcluded(luascriptversion.FieldSource)
+	return u
+}
+
+// ClearSource clears the value of the "source" field.
+func (u *LuaScriptVersionUpsert) ClearSource() *LuaScriptVersionUpsert {
+	u.SetNull(luascriptversion.FieldSource)
+	return u
+}
+
+// SetPriority sets the "priority" field.
+func (u *LuaScriptVersionUpsert) SetPriority(v int32) *LuaScriptVersionUpsert {
+	u.Set(luascriptversion.FieldPriority, v)
+	return u
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *LuaScriptVersionUpsert) UpdatePriority() *LuaScriptVersionUpsert {
+	u.SetExcluded(luascriptversion.FieldPriority)
+	return u
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *LuaScriptVersionUpsert) AddPriority(v int32) *LuaScriptVersionUpsert {
+	u.Add(luascriptversion.FieldPriority, v)
+	return u
+}
+
+// ClearPriority clears the value of the "priority" field.
+func (u *LuaScriptVersionUpsert) ClearPriority() *LuaScriptVersionUpsert {
+	u.SetNull(luascriptversion.FieldPriority)
+	return u
+}
+
+// SetCritical sets the "critical" field.
+func (u *LuaScriptVersionUpsert) SetCritical(v bool) *LuaScriptVersionUpsert {
+	u.Set(luascriptversion.FieldCritical, v)
+	return u
+}
+
+// UpdateCritical sets the "critical" field to the value that was provided on create.
+func (u *LuaScriptVersionUpsert) UpdateCritical() *LuaScriptVersionUpsert {
+	u.SetExcluded(luascriptversion.FieldCritical)
+	return u
+}
+
+// ClearCritical clears the value of the "critical" field.
+func (u *LuaScriptVersionUpsert) ClearCritical() *LuaScriptVersionUpsert {
+	u.SetNull(luascriptversion.FieldCritical)
+	return u
+}
+
+// SetAuthor sets the "author" field.
+func (u *LuaScriptVersionUpsert) SetAuthor(v string) *LuaScriptVersionUpsert {
+	u.Set(luascriptversion.FieldAuthor, v)
+	return u
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *LuaScriptVersionUpsert) UpdateAuthor() *LuaScriptVersionUpsert {
+	u.SetExcluded(luascriptversion.FieldAuthor)
+	return u
+}
+
+// ClearAuthor clears the value of the "author" field.
+func (u *LuaScriptVersionUpsert) ClearAuthor() *LuaScriptVersionUpsert {
+	u.SetNull(luascriptversion.FieldAuthor)
+	return u
+}
+
+// SetChangeLog sets the "change_log" field.
+func (u *LuaScriptVersionUpsert) SetChangeLog(v string) *LuaScriptVersionUpsert {
+	u.Set(luascriptversion.FieldChangeLog, v)
+	return u
+}
+
+// UpdateChangeLog sets the "change_log" field to the value that was provided on create.
+func (u *LuaScriptVersionUpsert) UpdateChangeLog() *LuaScriptVersionUpsert {
+	u.SetExcluded(luascriptversion.FieldChangeLog)
+	return u
+}
+
+// ClearChangeLog clears the value of the "change_log" field.
+func (u *LuaScriptVersionUpsert) ClearChangeLog() *LuaScriptVersionUpsert {
+	u.SetNull(luascriptversion.FieldChangeLog)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.LuaScriptVersion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(luascriptversion.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LuaScriptVersionUpsertOne) UpdateNewValues() *LuaScriptVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(luascriptversion.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(luascriptversion.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(luascriptversion.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LuaScriptVersion.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LuaScriptVersionUpsertOne) Ignore() *LuaScriptVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LuaScriptVersionUpsertOne) DoNothing() *LuaScriptVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LuaScriptVersionCreate.OnConflict
+// documentation for more info.
+func (u *LuaScriptVersionUpsertOne) Update(set func(*LuaScriptVersionUpsert)) *LuaScriptVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LuaScriptVersionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *LuaScriptVersionUpsertOne) SetCreatedBy(v uint32) *LuaScriptVersionUpsertOne {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *LuaScriptVersionUpsertOne) AddCreatedBy(v uint32) *LuaScriptVersionUpsertOne {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *LuaScriptVersionUpsertOne) UpdateCreatedBy() *LuaScriptVersionUpsertOne {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *LuaScriptVersionUpsertOne) ClearCreatedBy() *LuaScriptVersionUpsertOne {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetScriptID sets the "script_id" field.
+func (u *LuaScriptVersionUpsertOne) SetScriptID(v uint32) *LuaScriptVersionUpsertOne {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.SetScriptID(v)
+	})
+}
+
+// AddScriptID adds v to the "script_id" field.
+func (u *LuaScriptVersionUpsertOne) AddScriptID(v uint32) *LuaScriptVersionUpsertOne {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.AddScriptID(v)
+	})
+}
+
+// UpdateScriptID sets the "script_id" field to the value that was provided on create.
+func (u *LuaScriptVersionUpsertOne) UpdateScriptID() *LuaScriptVersionUpsertOne {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.UpdateScriptID()
+	})
+}
+
+// ClearScriptID clears the value of the "script_id" field.
+func (u *LuaScriptVersionUpsertOne) ClearScriptID() *LuaScriptVersionUpsertOne {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.ClearScriptID()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *LuaScriptVersionUpsertOne) SetVersion(v uint32) *LuaScriptVersionUpsertOne {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *LuaScriptVersionUpsertOne) AddVersion(v uint32) *LuaScriptVersionUpsertOne {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *LuaScriptVersionUpsertOne) UpdateVersion() *LuaScriptVersionUpsertOne {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// ClearVersion clears the value of the "version" field.
+func (u *LuaScriptVersionUpsertOne) ClearVersion() *LuaScriptVersionUpsertOne {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.ClearVersion()
+	})
+}
+
+// SetHook sets the "hook" field.
+func (u *LuaScriptVersionUpsertOne) SetHook(v string) *LuaScriptVersionUpsertOne {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.SetHook(v)
+	})
+}
+
+// UpdateHook sets the "hook" field to the value that was provided on create.
+func (u *LuaScriptVersionUpsertOne) UpdateHook() *LuaScriptVersionUpsertOne {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.UpdateHook()
+	})
+}
+
+// ClearHook clears the value of the "hook" field.
+func (u *LuaScriptVersionUpsertOne) ClearHook() *LuaScriptVersionUpsertOne {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.ClearHook()
+	})
+}
+
+// SetSource sets the "source" field.
+func (u *LuaScriptVersionUpsertOne) SetSource(v string) *LuaScriptVersionUpsertOne {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.SetSource(v)
+	})
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *LuaScriptVersionUpsertOne) UpdateSource() *LuaScriptVersionUpsertOne {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.UpdateSource()
+	})
+}
+
+// ClearSource clears the value of the "source" field.
+func (u *LuaScriptVersionUpsertOne) ClearSource() *LuaScriptVersionUpsertOne {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.ClearSource()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *LuaScriptVersionUpsertOne) SetPriority(v int32) *LuaScriptVersionUpsertOne {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *LuaScriptVersionUpsertOne) AddPriority(v int32) *LuaScriptVersionUpsertOne {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *LuaScriptVersionUpsertOne) UpdatePriority() *LuaScriptVersionUpsertOne {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// ClearPriority clears the value of the "priority" field.
+func (u *LuaScriptVersionUpsertOne) ClearPriority() *LuaScriptVersionUpsertOne {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.ClearPriority()
+	})
+}
+
+// SetCritical sets the "critical" field.
+func (u *LuaScriptVersionUpsertOne) SetCritical(v bool) *LuaScriptVersionUpsertOne {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.SetCritical(v)
+	})
+}
+
+// UpdateCritical sets the "critical" field to the value that was provided on create.
+func (u *LuaScriptVersionUpsertOne) UpdateCritical() *LuaScriptVersionUpsertOne {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.UpdateCritical()
+	})
+}
+
+// ClearCritical clears the value of the "critical" field.
+func (u *LuaScriptVersionUpsertOne) ClearCritical() *LuaScriptVersionUpsertOne {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.ClearCritical()
+	})
+}
+
+// SetAuthor sets the "author" field.
+func (u *LuaScriptVersionUpsertOne) SetAuthor(v string) *LuaScriptVersionUpsertOne {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.SetAuthor(v)
+	})
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *LuaScriptVersionUpsertOne) UpdateAuthor() *LuaScriptVersionUpsertOne {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.UpdateAuthor()
+	})
+}
+
+// ClearAuthor clears the value of the "author" field.
+func (u *LuaScriptVersionUpsertOne) ClearAuthor() *LuaScriptVersionUpsertOne {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.ClearAuthor()
+	})
+}
+
+// SetChangeLog sets the "change_log" field.
+func (u *LuaScriptVersionUpsertOne) SetChangeLog(v string) *LuaScriptVersionUpsertOne {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.SetChangeLog(v)
+	})
+}
+
+// UpdateChangeLog sets the "change_log" field to the value that was provided on create.
+func (u *LuaScriptVersionUpsertOne) UpdateChangeLog() *LuaScriptVersionUpsertOne {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.UpdateChangeLog()
+	})
+}
+
+// ClearChangeLog clears the value of the "change_log" field.
+func (u *LuaScriptVersionUpsertOne) ClearChangeLog() *LuaScriptVersionUpsertOne {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.ClearChangeLog()
+	})
+}
+
+// Exec executes the query.
+func (u *LuaScriptVersionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LuaScriptVersionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LuaScriptVersionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LuaScriptVersionUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LuaScriptVersionUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LuaScriptVersionCreateBulk is the builder for creating many LuaScriptVersion entities in bulk.
+type LuaScriptVersionCreateBulk struct {
+	config
+	err      error
+	builders []*LuaScriptVersionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LuaScriptVersion entities in the database.
+func (_c *LuaScriptVersionCreateBulk) Save(ctx context.Context) ([]*LuaScriptVersion, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LuaScriptVersion, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LuaScriptVersionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LuaScriptVersionCreateBulk) SaveX(ctx context.Context) []*LuaScriptVersion {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LuaScriptVersionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LuaScriptVersionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LuaScriptVersion.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LuaScriptVersionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LuaScriptVersionCreateBulk) OnConflict(opts ...sql.ConflictOption) *LuaScriptVersionUpsertBulk {
+	_c.conflict = opts
+	return &LuaScriptVersionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LuaScriptVersion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LuaScriptVersionCreateBulk) OnConflictColumns(columns ...string) *LuaScriptVersionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LuaScriptVersionUpsertBulk{
+		create: _c,
+	}
+}
+
+// LuaScriptVersionUpsertBulk is the builder for "upsert"-ing
+// a bulk of LuaScriptVersion nodes.
+type LuaScriptVersionUpsertBulk struct {
+	create *LuaScriptVersionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LuaScriptVersion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(luascriptversion.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LuaScriptVersionUpsertBulk) UpdateNewValues() *LuaScriptVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(luascriptversion.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(luascriptversion.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(luascriptversion.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LuaScriptVersion.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LuaScriptVersionUpsertBulk) Ignore() *LuaScriptVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LuaScriptVersionUpsertBulk) DoNothing() *LuaScriptVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LuaScriptVersionCreateBulk.OnConflict
+// documentation for more info.
+func (u *LuaScriptVersionUpsertBulk) Update(set func(*LuaScriptVersionUpsert)) *LuaScriptVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LuaScriptVersionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *LuaScriptVersionUpsertBulk) SetCreatedBy(v uint32) *LuaScriptVersionUpsertBulk {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *LuaScriptVersionUpsertBulk) AddCreatedBy(v uint32) *LuaScriptVersionUpsertBulk {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *LuaScriptVersionUpsertBulk) UpdateCreatedBy() *LuaScriptVersionUpsertBulk {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *LuaScriptVersionUpsertBulk) ClearCreatedBy() *LuaScriptVersionUpsertBulk {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetScriptID sets the "script_id" field.
+func (u *LuaScriptVersionUpsertBulk) SetScriptID(v uint32) *LuaScriptVersionUpsertBulk {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.SetScriptID(v)
+	})
+}
+
+// AddScriptID adds v to the "script_id" field.
+func (u *LuaScriptVersionUpsertBulk) AddScriptID(v uint32) *LuaScriptVersionUpsertBulk {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.AddScriptID(v)
+	})
+}
+
+// UpdateScriptID sets the "script_id" field to the value that was provided on create.
+func (u *LuaScriptVersionUpsertBulk) UpdateScriptID() *LuaScriptVersionUpsertBulk {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.UpdateScriptID()
+	})
+}
+
+// ClearScriptID clears the value of the "script_id" field.
+func (u *LuaScriptVersionUpsertBulk) ClearScriptID() *LuaScriptVersionUpsertBulk {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.ClearScriptID()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *LuaScriptVersionUpsertBulk) SetVersion(v uint32) *LuaScriptVersionUpsertBulk {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *LuaScriptVersionUpsertBulk) AddVersion(v uint32) *LuaScriptVersionUpsertBulk {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *LuaScriptVersionUpsertBulk) UpdateVersion() *LuaScriptVersionUpsertBulk {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// ClearVersion clears the value of the "version" field.
+func (u *LuaScriptVersionUpsertBulk) ClearVersion() *LuaScriptVersionUpsertBulk {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.ClearVersion()
+	})
+}
+
+// SetHook sets the "hook" field.
+func (u *LuaScriptVersionUpsertBulk) SetHook(v string) *LuaScriptVersionUpsertBulk {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.SetHook(v)
+	})
+}
+
+// UpdateHook sets the "hook" field to the value that was provided on create.
+func (u *LuaScriptVersionUpsertBulk) UpdateHook() *LuaScriptVersionUpsertBulk {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.UpdateHook()
+	})
+}
+
+// ClearHook clears the value of the "hook" field.
+func (u *LuaScriptVersionUpsertBulk) ClearHook() *LuaScriptVersionUpsertBulk {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.ClearHook()
+	})
+}
+
+// SetSource sets the "source" field.
+func (u *LuaScriptVersionUpsertBulk) SetSource(v string) *LuaScriptVersionUpsertBulk {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.SetSource(v)
+	})
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *LuaScriptVersionUpsertBulk) UpdateSource() *LuaScriptVersionUpsertBulk {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.UpdateSource()
+	})
+}
+
+// ClearSource clears the value of the "source" field.
+func (u *LuaScriptVersionUpsertBulk) ClearSource() *LuaScriptVersionUpsertBulk {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.ClearSource()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *LuaScriptVersionUpsertBulk) SetPriority(v int32) *LuaScriptVersionUpsertBulk {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *LuaScriptVersionUpsertBulk) AddPriority(v int32) *LuaScriptVersionUpsertBulk {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *LuaScriptVersionUpsertBulk) UpdatePriority() *LuaScriptVersionUpsertBulk {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// ClearPriority clears the value of the "priority" field.
+func (u *LuaScriptVersionUpsertBulk) ClearPriority() *LuaScriptVersionUpsertBulk {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.ClearPriority()
+	})
+}
+
+// SetCritical sets the "critical" field.
+func (u *LuaScriptVersionUpsertBulk) SetCritical(v bool) *LuaScriptVersionUpsertBulk {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.SetCritical(v)
+	})
+}
+
+// UpdateCritical sets the "critical" field to the value that was provided on create.
+func (u *LuaScriptVersionUpsertBulk) UpdateCritical() *LuaScriptVersionUpsertBulk {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.UpdateCritical()
+	})
+}
+
+// ClearCritical clears the value of the "critical" field.
+func (u *LuaScriptVersionUpsertBulk) ClearCritical() *LuaScriptVersionUpsertBulk {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.ClearCritical()
+	})
+}
+
+// SetAuthor sets the "author" field.
+func (u *LuaScriptVersionUpsertBulk) SetAuthor(v string) *LuaScriptVersionUpsertBulk {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.SetAuthor(v)
+	})
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *LuaScriptVersionUpsertBulk) UpdateAuthor() *LuaScriptVersionUpsertBulk {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.UpdateAuthor()
+	})
+}
+
+// ClearAuthor clears the value of the "author" field.
+func (u *LuaScriptVersionUpsertBulk) ClearAuthor() *LuaScriptVersionUpsertBulk {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.ClearAuthor()
+	})
+}
+
+// SetChangeLog sets the "change_log" field.
+func (u *LuaScriptVersionUpsertBulk) SetChangeLog(v string) *LuaScriptVersionUpsertBulk {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.SetChangeLog(v)
+	})
+}
+
+// UpdateChangeLog sets the "change_log" field to the value that was provided on create.
+func (u *LuaScriptVersionUpsertBulk) UpdateChangeLog() *LuaScriptVersionUpsertBulk {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.UpdateChangeLog()
+	})
+}
+
+// ClearChangeLog clears the value of the "change_log" field.
+func (u *LuaScriptVersionUpsertBulk) ClearChangeLog() *LuaScriptVersionUpsertBulk {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.ClearChangeLog()
+	})
+}
+
+// Exec executes the query.
+func (u *LuaScriptVersionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LuaScriptVersionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LuaScriptVersionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LuaScriptVersionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
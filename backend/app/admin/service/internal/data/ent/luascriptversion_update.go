@@ -0,0 +1,797 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/luascriptversion"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LuaScriptVersionUpdate is the builder for updating LuaScriptVersion entities.
+type LuaScriptVersionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *LuaScriptVersionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the LuaScriptVersionUpdate builder.
+func (_u *LuaScriptVersionUpdate) Where(ps ...predicate.LuaScriptVersion) *LuaScriptVersionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *LuaScriptVersionUpdate) SetCreatedBy(v uint32) *LuaScriptVersionUpdate {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *LuaScriptVersionUpdate) SetNillableCreatedBy(v *uint32) *LuaScriptVersionUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *LuaScriptVersionUpdate) AddCreatedBy(v int32) *LuaScriptVersionUpdate {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *LuaScriptVersionUpdate) ClearCreatedBy() *LuaScriptVersionUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetScriptID sets the "script_id" field.
+func (_u *LuaScriptVersionUpdate) SetScriptID(v uint32) *LuaScriptVersionUpdate {
+	_u.mutation.ResetScriptID()
+	_u.mutation.SetScriptID(v)
+	return _u
+}
+
+// SetNillableScriptID sets the "script_id" field if the given value is not nil.
+func (_u *LuaScriptVersionUpdate) SetNillableScriptID(v *uint32) *LuaScriptVersionUpdate {
+	if v != nil {
+		_u.SetScriptID(*v)
+	}
+	return _u
+}
+
+// AddScriptID adds value to the "script_id" field.
+func (_u *LuaScriptVersionUpdate) AddScriptID(v int32) *LuaScriptVersionUpdate {
+	_u.mutation.AddScriptID(v)
+	return _u
+}
+
+// ClearScriptID clears the value of the "script_id" field.
+func (_u *LuaScriptVersionUpdate) ClearScriptID() *LuaScriptVersionUpdate {
+	_u.mutation.ClearScriptID()
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *LuaScriptVersionUpdate) SetVersion(v uint32) *LuaScriptVersionUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *LuaScriptVersionUpdate) SetNillableVersion(v *uint32) *LuaScriptVersionUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *LuaScriptVersionUpdate) AddVersion(v int32) *LuaScriptVersionUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// ClearVersion clears the value of the "version" field.
+func (_u *LuaScriptVersionUpdate) ClearVersion() *LuaScriptVersionUpdate {
+	_u.mutation.ClearVersion()
+	return _u
+}
+
+// SetHook sets the "hook" field.
+func (_u *LuaScriptVersionUpdate) SetHook(v string) *LuaScriptVersionUpdate {
+	_u.mutation.SetHook(v)
+	return _u
+}
+
+// SetNillableHook sets the "hook" field if the given value is not nil.
+func (_u *LuaScriptVersionUpdate) SetNillableHook(v *string) *LuaScriptVersionUpdate {
+	if v != nil {
+		_u.SetHook(*v)
+	}
+	return _u
+}
+
+// ClearHook clears the value of the "hook" field.
+func (_u *LuaScriptVersionUpdate) ClearHook() *LuaScriptVersionUpdate {
+	_u.mutation.ClearHook()
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *LuaScriptVersionUpdate) SetSource(v string) *LuaScriptVersionUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *LuaScriptVersionUpdate) SetNillableSource(v *string) *LuaScriptVersionUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// ClearSource clears the value of the "source" field.
+func (_u *LuaScriptVersionUpdate) ClearSource() *LuaScriptVersionUpdate {
+	_u.mutation.ClearSource()
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *LuaScriptVersionUpdate) SetPriority(v int32) *LuaScriptVersionUpdate {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *LuaScriptVersionUpdate) SetNillablePriority(v *int32) *LuaScriptVersionUpdate {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *LuaScriptVersionUpdate) AddPriority(v int32) *LuaScriptVersionUpdate {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// ClearPriority clears the value of the "priority" field.
+func (_u *LuaScriptVersionUpdate) ClearPriority() *LuaScriptVersionUpdate {
+	_u.mutation.ClearPriority()
+	return _u
+}
+
+// SetCritical sets the "critical" field.
+func (_u *LuaScriptVersionUpdate) SetCritical(v bool) *LuaScriptVersionUpdate {
+	_u.mutation.SetCritical(v)
+	return _u
+}
+
+// SetNillableCritical sets the "critical" field if the given value is not nil.
+func (_u *LuaScriptVersionUpdate) SetNillableCritical(v *bool) *LuaScriptVersionUpdate {
+	if v != nil {
+		_u.SetCritical(*v)
+	}
+	return _u
+}
+
+// ClearCritical clears the value of the "critical" field.
+func (_u *LuaScriptVersionUpdate) ClearCritical() *LuaScriptVersionUpdate {
+	_u.mutation.ClearCritical()
+	return _u
+}
+
+// SetAuthor sets the "author" field.
+func (_u *LuaScriptVersionUpdate) SetAuthor(v string) *LuaScriptVersionUpdate {
+	_u.mutation.SetAuthor(v)
+	return _u
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (_u *LuaScriptVersionUpdate) SetNillableAuthor(v *string) *LuaScriptVersionUpdate {
+	if v != nil {
+		_u.SetAuthor(*v)
+	}
+	return _u
+}
+
+// ClearAuthor clears the value of the "author" field.
+func (_u *LuaScriptVersionUpdate) ClearAuthor() *LuaScriptVersionUpdate {
+	_u.mutation.ClearAuthor()
+	return _u
+}
+
+// SetChangeLog sets the "change_log" field.
+func (_u *LuaScriptVersionUpdate) SetChangeLog(v string) *LuaScriptVersionUpdate {
+	_u.mutation.SetChangeLog(v)
+	return _u
+}
+
+// SetNillableChangeLog sets the "change_log" field if the given value is not nil.
+func (_u *LuaScriptVersionUpdate) SetNillableChangeLog(v *string) *LuaScriptVersionUpdate {
+	if v != nil {
+		_u.SetChangeLog(*v)
+	}
+	return _u
+}
+
+// ClearChangeLog clears the value of the "change_log" field.
+func (_u *LuaScriptVersionUpdate) ClearChangeLog() *LuaScriptVersionUpdate {
+	_u.mutation.ClearChangeLog()
+	return _u
+}
+
+// Mutation returns the LuaScriptVersionMutation object of the builder.
+func (_u *LuaScriptVersionUpdate) Mutation() *LuaScriptVersionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LuaScriptVersionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LuaScriptVersionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LuaScriptVersionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LuaScriptVersionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LuaScriptVersionUpdate) check() error {
+	if v, ok := _u.mutation.Hook(); ok {
+		if err := luascriptversion.HookValidator(v); err != nil {
+			return &ValidationError{Name: "hook", err: fmt.Errorf(`ent: validator failed for field "LuaScriptVersion.hook": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Author(); ok {
+		if err := luascriptversion.AuthorValidator(v); err != nil {
+			return &ValidationError{Name: "author", err: fmt.Errorf(`ent: validator failed for field "LuaScriptVersion.author": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ChangeLog(); ok {
+		if err := luascriptversion.ChangeLogValidator(v); err != nil {
+			return &ValidationError{Name: "change_log", err: fmt.Errorf(`ent: validator failed for field "LuaScriptVersion.change_log": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LuaScriptVersionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LuaScriptVersionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LuaScriptVersionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(luascriptversion.Table, luascriptversion.Columns, sqlgraph.NewFieldSpec(luascriptversion.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(luascriptversion.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(luascriptversion.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(luascriptversion.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(luascriptversion.FieldCreatedBy, field.TypeUint32)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(luascriptversion.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ScriptID(); ok {
+		_spec.SetField(luascriptversion.FieldScriptID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedScriptID(); ok {
+		_spec.AddField(luascriptversion.FieldScriptID, field.TypeUint32, value)
+	}
+	if _u.mutation.ScriptIDCleared() {
+		_spec.ClearField(luascriptversion.FieldScriptID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(luascriptversion.FieldVersion, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(luascriptversion.FieldVersion, field.TypeUint32, value)
+	}
+	if _u.mutation.VersionCleared() {
+		_spec.ClearField(luascriptversion.FieldVersion, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Hook(); ok {
+		_spec.SetField(luascriptversion.FieldHook, field.TypeString, value)
+	}
+	if _u.mutation.HookCleared() {
+		_spec.ClearField(luascriptversion.FieldHook, field.TypeString)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(luascriptversion.FieldSource, field.TypeString, value)
+	}
+	if _u.mutation.SourceCleared() {
+		_spec.ClearField(luascriptversion.FieldSource, field.TypeString)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(luascriptversion.FieldPriority, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(luascriptversion.FieldPriority, field.TypeInt32, value)
+	}
+	if _u.mutation.PriorityCleared() {
+		_spec.ClearField(luascriptversion.FieldPriority, field.TypeInt32)
+	}
+	if value, ok := _u.mutation.Critical(); ok {
+		_spec.SetField(luascriptversion.FieldCritical, field.TypeBool, value)
+	}
+	if _u.mutation.CriticalCleared() {
+		_spec.ClearField(luascriptversion.FieldCritical, field.TypeBool)
+	}
+	if value, ok := _u.mutation.Author(); ok {
+		_spec.SetField(luascriptversion.FieldAuthor, field.TypeString, value)
+	}
+	if _u.mutation.AuthorCleared() {
+		_spec.ClearField(luascriptversion.FieldAuthor, field.TypeString)
+	}
+	if value, ok := _u.mutation.ChangeLog(); ok {
+		_spec.SetField(luascriptversion.FieldChangeLog, field.TypeString, value)
+	}
+	if _u.mutation.ChangeLogCleared() {
+		_spec.ClearField(luascriptversion.FieldChangeLog, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{luascriptversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LuaScriptVersionUpdateOne is the builder for updating a single LuaScriptVersion entity.
+type LuaScriptVersionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *LuaScriptVersionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *LuaScriptVersionUpdateOne) SetCreatedBy(v uint32) *LuaScriptVersionUpdateOne {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *LuaScriptVersionUpdateOne) SetNillableCreatedBy(v *uint32) *LuaScriptVersionUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *LuaScriptVersionUpdateOne) AddCreatedBy(v int32) *LuaScriptVersionUpdateOne {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *LuaScriptVersionUpdateOne) ClearCreatedBy() *LuaScriptVersionUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetScriptID sets the "script_id" field.
+func (_u *LuaScriptVersionUpdateOne) SetScriptID(v uint32) *LuaScriptVersionUpdateOne {
+	_u.mutation.ResetScriptID()
+	_u.mutation.SetScriptID(v)
+	return _u
+}
+
+// SetNillableScriptID sets the "script_id" field if the given value is not nil.
+func (_u *LuaScriptVersionUpdateOne) SetNillableScriptID(v *uint32) *LuaScriptVersionUpdateOne {
+	if v != nil {
+		_u.SetScriptID(*v)
+	}
+	return _u
+}
+
+// AddScriptID adds value to the "script_id" field.
+func (_u *LuaScriptVersionUpdateOne) AddScriptID(v int32) *LuaScriptVersionUpdateOne {
+	_u.mutation.AddScriptID(v)
+	return _u
+}
+
+// ClearScriptID clears the value of the "script_id" field.
+func (_u *LuaScriptVersionUpdateOne) ClearScriptID() *LuaScriptVersionUpdateOne {
+	_u.mutation.ClearScriptID()
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *LuaScriptVersionUpdateOne) SetVersion(v uint32) *LuaScriptVersionUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *LuaScriptVersionUpdateOne) SetNillableVersion(v *uint32) *LuaScriptVersionUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *LuaScriptVersionUpdateOne) AddVersion(v int32) *LuaScriptVersionUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// ClearVersion clears the value of the "version" field.
+func (_u *LuaScriptVersionUpdateOne) ClearVersion() *LuaScriptVersionUpdateOne {
+	_u.mutation.ClearVersion()
+	return _u
+}
+
+// SetHook sets the "hook" field.
+func (_u *LuaScriptVersionUpdateOne) SetHook(v string) *LuaScriptVersionUpdateOne {
+	_u.mutation.SetHook(v)
+	return _u
+}
+
+// SetNillableHook sets the "hook" field if the given value is not nil.
+func (_u *LuaScriptVersionUpdateOne) SetNillableHook(v *string) *LuaScriptVersionUpdateOne {
+	if v != nil {
+		_u.SetHook(*v)
+	}
+	return _u
+}
+
+// ClearHook clears the value of the "hook" field.
+func (_u *LuaScriptVersionUpdateOne) ClearHook() *LuaScriptVersionUpdateOne {
+	_u.mutation.ClearHook()
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *LuaScriptVersionUpdateOne) SetSource(v string) *LuaScriptVersionUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *LuaScriptVersionUpdateOne) SetNillableSource(v *string) *LuaScriptVersionUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// ClearSource clears the value of the "source" field.
+func (_u *LuaScriptVersionUpdateOne) ClearSource() *LuaScriptVersionUpdateOne {
+	_u.mutation.ClearSource()
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *LuaScriptVersionUpdateOne) SetPriority(v int32) *LuaScriptVersionUpdateOne {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *LuaScriptVersionUpdateOne) SetNillablePriority(v *int32) *LuaScriptVersionUpdateOne {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *LuaScriptVersionUpdateOne) AddPriority(v int32) *LuaScriptVersionUpdateOne {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// ClearPriority clears the value of the "priority" field.
+func (_u *LuaScriptVersionUpdateOne) ClearPriority() *LuaScriptVersionUpdateOne {
+	_u.mutation.ClearPriority()
+	return _u
+}
+
+// SetCritical sets the "critical" field.
+func (_u *LuaScriptVersionUpdateOne) SetCritical(v bool) *LuaScriptVersionUpdateOne {
+	_u.mutation.SetCritical(v)
+	return _u
+}
+
+// SetNillableCritical sets the "critical" field if the given value is not nil.
+func (_u *LuaScriptVersionUpdateOne) SetNillableCritical(v *bool) *LuaScriptVersionUpdateOne {
+	if v != nil {
+		_u.SetCritical(*v)
+	}
+	return _u
+}
+
+// ClearCritical clears the value of the "critical" field.
+func (_u *LuaScriptVersionUpdateOne) ClearCritical() *LuaScriptVersionUpdateOne {
+	_u.mutation.ClearCritical()
+	return _u
+}
+
+// SetAuthor sets the "author" field.
+func (_u *LuaScriptVersionUpdateOne) SetAuthor(v string) *LuaScriptVersionUpdateOne {
+	_u.mutation.SetAuthor(v)
+	return _u
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (_u *LuaScriptVersionUpdateOne) SetNillableAuthor(v *string) *LuaScriptVersionUpdateOne {
+	if v != nil {
+		_u.SetAuthor(*v)
+	}
+	return _u
+}
+
+// ClearAuthor clears the value of the "author" field.
+func (_u *LuaScriptVersionUpdateOne) ClearAuthor() *LuaScriptVersionUpdateOne {
+	_u.mutation.ClearAuthor()
+	return _u
+}
+
+// SetChangeLog sets the "change_log" field.
+func (_u *LuaScriptVersionUpdateOne) SetChangeLog(v string) *LuaScriptVersionUpdateOne {
+	_u.mutation.SetChangeLog(v)
+	return _u
+}
+
+// SetNillableChangeLog sets the "change_log" field if the given value is not nil.
+func (_u *LuaScriptVersionUpdateOne) SetNillableChangeLog(v *string) *LuaScriptVersionUpdateOne {
+	if v != nil {
+		_u.SetChangeLog(*v)
+	}
+	return _u
+}
+
+// ClearChangeLog clears the value of the "change_log" field.
+func (_u *LuaScriptVersionUpdateOne) ClearChangeLog() *LuaScriptVersionUpdateOne {
+	_u.mutation.ClearChangeLog()
+	return _u
+}
+
+// Mutation returns the LuaScriptVersionMutation object of the builder.
+func (_u *LuaScriptVersionUpdateOne) Mutation() *LuaScriptVersionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the LuaScriptVersionUpdate builder.
+func (_u *LuaScriptVersionUpdateOne) Where(ps ...predicate.LuaScriptVersion) *LuaScriptVersionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LuaScriptVersionUpdateOne) Select(field string, fields ...string) *LuaScriptVersionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LuaScriptVersion entity.
+func (_u *LuaScriptVersionUpdateOne) Save(ctx context.Context) (*LuaScriptVersion, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LuaScriptVersionUpdateOne) SaveX(ctx context.Context) *LuaScriptVersion {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LuaScriptVersionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LuaScriptVersionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LuaScriptVersionUpdateOne) check() error {
+	if v, ok := _u.mutation.Hook(); ok {
+		if err := luascriptversion.HookValidator(v); err != nil {
+			return &ValidationError{Name: "hook", err: fmt.Errorf(`ent: validator failed for field "LuaScriptVersion.hook": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Author(); ok {
+		if err := luascriptversion.AuthorValidator(v); err != nil {
+			return &ValidationError{Name: "author", err: fmt.Errorf(`ent: validator failed for field "LuaScriptVersion.author": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ChangeLog(); ok {
+		if err := luascriptversion.ChangeLogValidator(v); err != nil {
+			return &ValidationError{Name: "change_log", err: fmt.Errorf(`ent: validator failed for field "LuaScriptVersion.change_log": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LuaScriptVersionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LuaScriptVersionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LuaScriptVersionUpdateOne) sqlSave(ctx context.Context) (_node *LuaScriptVersion, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(luascriptversion.Table, luascriptversion.Columns, sqlgraph.NewFieldSpec(luascriptversion.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LuaScriptVersion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, luascriptversion.FieldID)
+		for _, f := range fields {
+			if !luascriptversion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != luascriptversion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(luascriptversion.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(luascriptversion.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(luascriptversion.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(luascriptversion.FieldCreatedBy, field.TypeUint32)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(luascriptversion.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ScriptID(); ok {
+		_spec.SetField(luascriptversion.FieldScriptID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedScriptID(); ok {
+		_spec.AddField(luascriptversion.FieldScriptID, field.TypeUint32, value)
+	}
+	if _u.mutation.ScriptIDCleared() {
+		_spec.ClearField(luascriptversion.FieldScriptID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(luascriptversion.FieldVersion, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(luascriptversion.FieldVersion, field.TypeUint32, value)
+	}
+	if _u.mutation.VersionCleared() {
+		_spec.ClearField(luascriptversion.FieldVersion, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Hook(); ok {
+		_spec.SetField(luascriptversion.FieldHook, field.TypeString, value)
+	}
+	if _u.mutation.HookCleared() {
+		_spec.ClearField(luascriptversion.FieldHook, field.TypeString)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(luascriptversion.FieldSource, field.TypeString, value)
+	}
+	if _u.mutation.SourceCleared() {
+		_spec.ClearField(luascriptversion.FieldSource, field.TypeString)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(luascriptversion.FieldPriority, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(luascriptversion.FieldPriority, field.TypeInt32, value)
+	}
+	if _u.mutation.PriorityCleared() {
+		_spec.ClearField(luascriptversion.FieldPriority, field.TypeInt32)
+	}
+	if value, ok := _u.mutation.Critical(); ok {
+		_spec.SetField(luascriptversion.FieldCritical, field.TypeBool, value)
+	}
+	if _u.mutation.CriticalCleared() {
+		_spec.ClearField(luascriptversion.FieldCritical, field.TypeBool)
+	}
+	if value, ok := _u.mutation.Author(); ok {
+		_spec.SetField(luascriptversion.FieldAuthor, field.TypeString, value)
+	}
+	if _u.mutation.AuthorCleared() {
+		_spec.ClearField(luascriptversion.FieldAuthor, field.TypeString)
+	}
+	if value, ok := _u.mutation.ChangeLog(); ok {
+		_spec.SetField(luascriptversion.FieldChangeLog, field.TypeString, value)
+	}
+	if _u.mutation.ChangeLogCleared() {
+		_spec.ClearField(luascriptversion.FieldChangeLog, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &LuaScriptVersion{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{luascriptversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -161,6 +161,120 @@ var (
 			},
 		},
 	}
+	// SysAuditForwardersColumns holds the columns for the "sys_audit_forwarders" table.
+	SysAuditForwardersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "created_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "updated_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "deleted_by", Type: field.TypeUint32, Nullable: true, Comment: "删除者ID"},
+		{Name: "remark", Type: field.TypeString, Nullable: true, Comment: "备注"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "name", Type: field.TypeString, Nullable: true, Size: 128, Comment: "名称"},
+		{Name: "enabled", Type: field.TypeBool, Nullable: true, Comment: "是否启用", Default: true},
+		{Name: "format", Type: field.TypeEnum, Nullable: true, Comment: "输出格式", Enums: []string{"RFC5424", "CEF", "JSON"}},
+		{Name: "transport", Type: field.TypeEnum, Nullable: true, Comment: "传输方式", Enums: []string{"UDP", "TCP", "TLS", "HTTP"}},
+		{Name: "endpoint", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "目标地址"},
+		{Name: "log_types", Type: field.TypeJSON, Nullable: true, Comment: "转发的审计日志类型"},
+		{Name: "field_mapping", Type: field.TypeJSON, Nullable: true, Comment: "字段映射"},
+		{Name: "secret", Type: field.TypeString, Nullable: true, Size: 512, Comment: "Webhook签名密钥"},
+		{Name: "headers", Type: field.TypeJSON, Nullable: true, Comment: "Webhook附加请求头"},
+		{Name: "tls_insecure_skip_verify", Type: field.TypeBool, Nullable: true, Comment: "TLS是否跳过证书校验", Default: false},
+		{Name: "tls_ca_pem", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "TLS信任的CA证书"},
+		{Name: "tls_server_name", Type: field.TypeString, Nullable: true, Size: 255, Comment: "TLS服务器名称"},
+		{Name: "app_name", Type: field.TypeString, Nullable: true, Size: 48, Comment: "Syslog APP-NAME"},
+		{Name: "facility", Type: field.TypeUint32, Nullable: true, Comment: "Syslog facility", Default: 16},
+		{Name: "max_buffer_bytes", Type: field.TypeUint64, Nullable: true, Comment: "磁盘缓冲上限（字节）", Default: 67108864},
+	}
+	// SysAuditForwardersTable holds the schema information for the "sys_audit_forwarders" table.
+	SysAuditForwardersTable = &schema.Table{
+		Name:       "sys_audit_forwarders",
+		Comment:    "审计事件转发器（SIEM）表",
+		Columns:    SysAuditForwardersColumns,
+		PrimaryKey: []*schema.Column{SysAuditForwardersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "uidx_sys_audit_forwarder_tenant_name",
+				Unique:  true,
+				Columns: []*schema.Column{SysAuditForwardersColumns[8], SysAuditForwardersColumns[9]},
+			},
+		},
+	}
+	// SysAuditLogArchivesColumns holds the columns for the "sys_audit_log_archives" table.
+	SysAuditLogArchivesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "log_type", Type: field.TypeEnum, Nullable: true, Comment: "审计日志类型", Enums: []string{"API_AUDIT_LOG", "LOGIN_AUDIT_LOG", "OPERATION_AUDIT_LOG", "DATA_ACCESS_AUDIT_LOG", "PERMISSION_AUDIT_LOG", "POLICY_EVALUATION_LOG"}},
+		{Name: "status", Type: field.TypeEnum, Nullable: true, Comment: "归档状态", Enums: []string{"EXPORTING", "ARCHIVED", "PURGED", "FAILED"}, Default: "EXPORTING"},
+		{Name: "bucket_name", Type: field.TypeString, Nullable: true, Comment: "存储桶名称"},
+		{Name: "object_name", Type: field.TypeString, Nullable: true, Comment: "归档数据对象名"},
+		{Name: "manifest_object_name", Type: field.TypeString, Nullable: true, Comment: "归档清单对象名"},
+		{Name: "sha256", Type: field.TypeString, Nullable: true, Size: 64, Comment: "归档数据对象的SHA256摘要"},
+		{Name: "size_bytes", Type: field.TypeUint64, Nullable: true, Comment: "归档数据对象大小"},
+		{Name: "row_count", Type: field.TypeUint64, Nullable: true, Comment: "归档行数"},
+		{Name: "purged_count", Type: field.TypeUint64, Nullable: true, Comment: "已清除的在线行数"},
+		{Name: "min_log_id", Type: field.TypeUint32, Nullable: true, Comment: "归档的最小日志ID"},
+		{Name: "max_log_id", Type: field.TypeUint32, Nullable: true, Comment: "归档的最大日志ID"},
+		{Name: "cutoff_time", Type: field.TypeTime, Nullable: true, Comment: "截止时间"},
+		{Name: "error_message", Type: field.TypeString, Nullable: true, Comment: "错误信息"},
+		{Name: "restored_count", Type: field.TypeUint64, Nullable: true, Comment: "已恢复到查询表的行数"},
+		{Name: "restored_at", Type: field.TypeTime, Nullable: true, Comment: "最近一次恢复时间"},
+	}
+	// SysAuditLogArchivesTable holds the schema information for the "sys_audit_log_archives" table.
+	SysAuditLogArchivesTable = &schema.Table{
+		Name:       "sys_audit_log_archives",
+		Comment:    "审计日志归档表",
+		Columns:    SysAuditLogArchivesColumns,
+		PrimaryKey: []*schema.Column{SysAuditLogArchivesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_sys_audit_log_archive_tenant_type_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SysAuditLogArchivesColumns[4], SysAuditLogArchivesColumns[5], SysAuditLogArchivesColumns[1]},
+			},
+			{
+				Name:    "idx_sys_audit_log_archive_status",
+				Unique:  false,
+				Columns: []*schema.Column{SysAuditLogArchivesColumns[6]},
+			},
+		},
+	}
+	// SysAuditLogRetentionPoliciesColumns holds the columns for the "sys_audit_log_retention_policies" table.
+	SysAuditLogRetentionPoliciesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "created_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "updated_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "deleted_by", Type: field.TypeUint32, Nullable: true, Comment: "删除者ID"},
+		{Name: "remark", Type: field.TypeString, Nullable: true, Comment: "备注"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "log_type", Type: field.TypeEnum, Nullable: true, Comment: "审计日志类型", Enums: []string{"API_AUDIT_LOG", "LOGIN_AUDIT_LOG", "OPERATION_AUDIT_LOG", "DATA_ACCESS_AUDIT_LOG", "PERMISSION_AUDIT_LOG", "POLICY_EVALUATION_LOG"}},
+		{Name: "retention_days", Type: field.TypeUint32, Nullable: true, Comment: "在线保留天数", Default: 180},
+		{Name: "archive_enabled", Type: field.TypeBool, Nullable: true, Comment: "清除前是否导出归档", Default: true},
+		{Name: "enabled", Type: field.TypeBool, Nullable: true, Comment: "是否启用", Default: true},
+		{Name: "batch_size", Type: field.TypeUint32, Nullable: true, Comment: "分批导出/删除的批次大小", Default: 1000},
+	}
+	// SysAuditLogRetentionPoliciesTable holds the schema information for the "sys_audit_log_retention_policies" table.
+	SysAuditLogRetentionPoliciesTable = &schema.Table{
+		Name:       "sys_audit_log_retention_policies",
+		Comment:    "审计日志保留策略表",
+		Columns:    SysAuditLogRetentionPoliciesColumns,
+		PrimaryKey: []*schema.Column{SysAuditLogRetentionPoliciesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "uidx_sys_audit_log_retention_policy_tenant_type",
+				Unique:  true,
+				Columns: []*schema.Column{SysAuditLogRetentionPoliciesColumns[8], SysAuditLogRetentionPoliciesColumns[9]},
+			},
+		},
+	}
 	// SysDataAccessAuditLogsColumns holds the columns for the "sys_data_access_audit_logs" table.
 	SysDataAccessAuditLogsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
@@ -442,6 +556,15 @@ var (
 		{Name: "size_format", Type: field.TypeString, Nullable: true, Comment: "格式化后的文件长度字符串"},
 		{Name: "link_url", Type: field.TypeString, Nullable: true, Comment: "链接地址"},
 		{Name: "content_hash", Type: field.TypeString, Nullable: true, Comment: "文件内容hash值，防止上传重复文件"},
+		{Name: "storage_object_id", Type: field.TypeUint32, Nullable: true, Comment: "物理对象ID，去重后多个文件可引用同一物理对象"},
+		{Name: "object_name", Type: field.TypeString, Nullable: true, Comment: "物理对象名，去重或隔离后可与文件目录不同，为空时由文件目录和保存文件名组成"},
+		{Name: "scan_status", Type: field.TypeEnum, Nullable: true, Comment: "恶意文件扫描状态，为空表示启用扫描前上传的文件", Enums: []string{"SCAN_PENDING", "SCAN_CLEAN", "SCAN_INFECTED", "SCAN_FAILED", "SCAN_SKIPPED"}},
+		{Name: "scan_signature", Type: field.TypeString, Nullable: true, Comment: "检出的恶意特征名称或扫描失败原因"},
+		{Name: "scanned_at", Type: field.TypeTime, Nullable: true, Comment: "扫描时间"},
+		{Name: "version", Type: field.TypeUint32, Comment: "版本号，同一目录下同名文件的每次上传为一个版本", Default: 1},
+		{Name: "is_latest", Type: field.TypeBool, Comment: "是否为当前版本", Default: true},
+		{Name: "version_group", Type: field.TypeString, Nullable: true, Comment: "版本组标识，同一文件的全部版本相同，为空时以文件Guid作为版本组"},
+		{Name: "latest_key", Type: field.TypeString, Unique: true, Nullable: true, Comment: "当前版本唯一键，由租户、目录和文件名计算，只在未删除的当前版本上设置，保证同一文件只有一个当前版本"},
 	}
 	// FilesTable holds the schema information for the "files" table.
 	FilesTable = &schema.Table{
@@ -470,6 +593,36 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{FilesColumns[19]},
 			},
+			{
+				Name:    "idx_files_storage_object_id",
+				Unique:  false,
+				Columns: []*schema.Column{FilesColumns[20]},
+			},
+			{
+				Name:    "idx_files_bucket_object_name",
+				Unique:  false,
+				Columns: []*schema.Column{FilesColumns[10], FilesColumns[21]},
+			},
+			{
+				Name:    "idx_files_scan_status",
+				Unique:  false,
+				Columns: []*schema.Column{FilesColumns[22]},
+			},
+			{
+				Name:    "idx_files_tenant_directory_name_latest",
+				Unique:  false,
+				Columns: []*schema.Column{FilesColumns[8], FilesColumns[11], FilesColumns[14], FilesColumns[26]},
+			},
+			{
+				Name:    "idx_files_version_group",
+				Unique:  false,
+				Columns: []*schema.Column{FilesColumns[27]},
+			},
+			{
+				Name:    "idx_files_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{FilesColumns[3]},
+			},
 			{
 				Name:    "idx_files_bucket_name",
 				Unique:  false,
@@ -497,6 +650,48 @@ var (
 			},
 		},
 	}
+	// FileShareLinksColumns holds the columns for the "file_share_links" table.
+	FileShareLinksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "created_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "updated_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "deleted_by", Type: field.TypeUint32, Nullable: true, Comment: "删除者ID"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "file_id", Type: field.TypeUint32, Comment: "分享的文件ID"},
+		{Name: "token", Type: field.TypeString, Unique: true, Comment: "分享令牌"},
+		{Name: "password_hash", Type: field.TypeString, Nullable: true, Comment: "访问密码的 bcrypt 哈希，为空时不需要密码"},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true, Comment: "过期时间，为空时不过期"},
+		{Name: "max_downloads", Type: field.TypeUint32, Comment: "最大下载次数，0表示不限制", Default: 0},
+		{Name: "download_count", Type: field.TypeUint32, Comment: "已下载次数", Default: 0},
+		{Name: "allowed_tenant_ids", Type: field.TypeJSON, Nullable: true, Comment: "允许访问的租户ID"},
+		{Name: "allowed_user_ids", Type: field.TypeJSON, Nullable: true, Comment: "允许访问的用户ID"},
+		{Name: "allowed_org_unit_ids", Type: field.TypeJSON, Nullable: true, Comment: "允许访问的组织单元ID"},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true, Comment: "撤销时间"},
+		{Name: "revoked_by", Type: field.TypeUint32, Nullable: true, Comment: "撤销者ID"},
+		{Name: "last_accessed_at", Type: field.TypeTime, Nullable: true, Comment: "最近访问时间"},
+	}
+	// FileShareLinksTable holds the schema information for the "file_share_links" table.
+	FileShareLinksTable = &schema.Table{
+		Name:       "file_share_links",
+		Comment:    "文件分享链接表",
+		Columns:    FileShareLinksColumns,
+		PrimaryKey: []*schema.Column{FileShareLinksColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_file_share_links_tenant_file",
+				Unique:  false,
+				Columns: []*schema.Column{FileShareLinksColumns[7], FileShareLinksColumns[8]},
+			},
+			{
+				Name:    "idx_file_share_links_created_by",
+				Unique:  false,
+				Columns: []*schema.Column{FileShareLinksColumns[4]},
+			},
+		},
+	}
 	// InternalMessagesColumns holds the columns for the "internal_messages" table.
 	InternalMessagesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
@@ -844,6 +1039,75 @@ var (
 			},
 		},
 	}
+	// SysLuaScriptsColumns holds the columns for the "sys_lua_scripts" table.
+	SysLuaScriptsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "created_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "updated_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "deleted_by", Type: field.TypeUint32, Nullable: true, Comment: "删除者ID"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "name", Type: field.TypeString, Nullable: true, Size: 128, Comment: "脚本名称"},
+		{Name: "hook", Type: field.TypeString, Nullable: true, Size: 128, Comment: "挂载的钩子名称"},
+		{Name: "source", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "脚本源码"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "描述"},
+		{Name: "enabled", Type: field.TypeBool, Nullable: true, Comment: "是否启用", Default: true},
+		{Name: "priority", Type: field.TypeInt32, Nullable: true, Comment: "执行顺序（越小越先执行）", Default: 0},
+		{Name: "critical", Type: field.TypeBool, Nullable: true, Comment: "失败时是否中止钩子", Default: false},
+		{Name: "version", Type: field.TypeUint32, Nullable: true, Comment: "当前版本号", Default: 1},
+		{Name: "author", Type: field.TypeString, Nullable: true, Size: 128, Comment: "作者"},
+		{Name: "data_write", Type: field.TypeBool, Nullable: true, Comment: "是否允许通过 kratos_data 模块写入数据", Default: false},
+	}
+	// SysLuaScriptsTable holds the schema information for the "sys_lua_scripts" table.
+	SysLuaScriptsTable = &schema.Table{
+		Name:       "sys_lua_scripts",
+		Comment:    "Lua脚本表",
+		Columns:    SysLuaScriptsColumns,
+		PrimaryKey: []*schema.Column{SysLuaScriptsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "uidx_sys_lua_script_tenant_name",
+				Unique:  true,
+				Columns: []*schema.Column{SysLuaScriptsColumns[7], SysLuaScriptsColumns[8]},
+			},
+			{
+				Name:    "idx_sys_lua_script_hook",
+				Unique:  false,
+				Columns: []*schema.Column{SysLuaScriptsColumns[9]},
+			},
+		},
+	}
+	// SysLuaScriptVersionsColumns holds the columns for the "sys_lua_script_versions" table.
+	SysLuaScriptVersionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "created_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "script_id", Type: field.TypeUint32, Nullable: true, Comment: "脚本ID"},
+		{Name: "version", Type: field.TypeUint32, Nullable: true, Comment: "版本号"},
+		{Name: "hook", Type: field.TypeString, Nullable: true, Size: 128, Comment: "挂载的钩子名称"},
+		{Name: "source", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "脚本源码"},
+		{Name: "priority", Type: field.TypeInt32, Nullable: true, Comment: "执行顺序"},
+		{Name: "critical", Type: field.TypeBool, Nullable: true, Comment: "失败时是否中止钩子"},
+		{Name: "author", Type: field.TypeString, Nullable: true, Size: 128, Comment: "作者"},
+		{Name: "change_log", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "变更说明"},
+	}
+	// SysLuaScriptVersionsTable holds the schema information for the "sys_lua_script_versions" table.
+	SysLuaScriptVersionsTable = &schema.Table{
+		Name:       "sys_lua_script_versions",
+		Comment:    "Lua脚本版本历史表",
+		Columns:    SysLuaScriptVersionsColumns,
+		PrimaryKey: []*schema.Column{SysLuaScriptVersionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "uidx_sys_lua_script_version",
+				Unique:  true,
+				Columns: []*schema.Column{SysLuaScriptVersionsColumns[4], SysLuaScriptVersionsColumns[5]},
+			},
+		},
+	}
 	// SysMembershipsColumns holds the columns for the "sys_memberships" table.
 	SysMembershipsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
@@ -2040,6 +2304,36 @@ var (
 			},
 		},
 	}
+	// SysRestoredAuditLogsColumns holds the columns for the "sys_restored_audit_logs" table.
+	SysRestoredAuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "archive_id", Type: field.TypeUint32, Nullable: true, Comment: "归档ID"},
+		{Name: "log_type", Type: field.TypeEnum, Nullable: true, Comment: "审计日志类型", Enums: []string{"API_AUDIT_LOG", "LOGIN_AUDIT_LOG", "OPERATION_AUDIT_LOG", "DATA_ACCESS_AUDIT_LOG", "PERMISSION_AUDIT_LOG", "POLICY_EVALUATION_LOG"}},
+		{Name: "log_id", Type: field.TypeUint32, Nullable: true, Comment: "原始日志ID"},
+		{Name: "log_created_at", Type: field.TypeTime, Nullable: true, Comment: "原始日志创建时间"},
+		{Name: "payload", Type: field.TypeJSON, Nullable: true, Comment: "原始日志内容"},
+	}
+	// SysRestoredAuditLogsTable holds the schema information for the "sys_restored_audit_logs" table.
+	SysRestoredAuditLogsTable = &schema.Table{
+		Name:       "sys_restored_audit_logs",
+		Comment:    "已恢复审计日志表（只读查询）",
+		Columns:    SysRestoredAuditLogsColumns,
+		PrimaryKey: []*schema.Column{SysRestoredAuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "uidx_sys_restored_audit_log_archive_log",
+				Unique:  true,
+				Columns: []*schema.Column{SysRestoredAuditLogsColumns[3], SysRestoredAuditLogsColumns[5]},
+			},
+			{
+				Name:    "idx_sys_restored_audit_log_tenant_type_log_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SysRestoredAuditLogsColumns[2], SysRestoredAuditLogsColumns[4], SysRestoredAuditLogsColumns[6]},
+			},
+		},
+	}
 	// SysRolesColumns holds the columns for the "sys_roles" table.
 	SysRolesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
@@ -2242,6 +2536,72 @@ var (
 			},
 		},
 	}
+	// StorageObjectsColumns holds the columns for the "storage_objects" table.
+	StorageObjectsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "dedup_scope", Type: field.TypeString, Size: 64, Comment: "去重范围：tenant:<租户ID> 或 shared"},
+		{Name: "provider", Type: field.TypeEnum, Comment: "OSS供应商", Enums: []string{"UNKNOWN", "MINIO", "ALIYUN", "QINIU", "TENCENT", "AWS", "GOOGLE", "AZURE", "BAIDU", "HUAWEI", "LOCAL"}},
+		{Name: "content_hash", Type: field.TypeString, Size: 64, Comment: "文件内容SHA256"},
+		{Name: "bucket_name", Type: field.TypeString, Comment: "存储桶名称"},
+		{Name: "object_name", Type: field.TypeString, Comment: "对象名"},
+		{Name: "size", Type: field.TypeUint64, Comment: "对象长度，单位：字节", Default: 0},
+		{Name: "content_type", Type: field.TypeString, Nullable: true, Comment: "内容类型"},
+		{Name: "ref_count", Type: field.TypeInt32, Comment: "引用该对象的文件数量，归零时删除物理对象", Default: 1},
+	}
+	// StorageObjectsTable holds the schema information for the "storage_objects" table.
+	StorageObjectsTable = &schema.Table{
+		Name:       "storage_objects",
+		Comment:    "物理存储对象表",
+		Columns:    StorageObjectsColumns,
+		PrimaryKey: []*schema.Column{StorageObjectsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "uix_storage_objects_scope_provider_hash",
+				Unique:  true,
+				Columns: []*schema.Column{StorageObjectsColumns[4], StorageObjectsColumns[5], StorageObjectsColumns[6]},
+			},
+			{
+				Name:    "idx_storage_objects_location",
+				Unique:  false,
+				Columns: []*schema.Column{StorageObjectsColumns[5], StorageObjectsColumns[7], StorageObjectsColumns[8]},
+			},
+		},
+	}
+	// StorageQuotasColumns holds the columns for the "storage_quotas" table.
+	StorageQuotasColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "created_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "updated_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "deleted_by", Type: field.TypeUint32, Nullable: true, Comment: "删除者ID"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "user_id", Type: field.TypeUint32, Comment: "用户ID，0表示租户整体", Default: 0},
+		{Name: "max_bytes", Type: field.TypeInt64, Nullable: true, Comment: "最大存储字节数，为空时使用订阅套餐的默认配额，0表示不限制"},
+		{Name: "max_files", Type: field.TypeInt64, Nullable: true, Comment: "最大文件数量，为空时使用订阅套餐的默认配额，0表示不限制"},
+		{Name: "used_bytes", Type: field.TypeInt64, Comment: "已用字节数", Default: 0},
+		{Name: "used_files", Type: field.TypeInt64, Comment: "已用文件数量", Default: 0},
+		{Name: "notified_percent", Type: field.TypeUint32, Comment: "已发送提醒的用量阈值百分比", Default: 0},
+		{Name: "reconciled_at", Type: field.TypeTime, Nullable: true, Comment: "最近一次对账时间"},
+	}
+	// StorageQuotasTable holds the schema information for the "storage_quotas" table.
+	StorageQuotasTable = &schema.Table{
+		Name:       "storage_quotas",
+		Comment:    "存储配额与用量表",
+		Columns:    StorageQuotasColumns,
+		PrimaryKey: []*schema.Column{StorageQuotasColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "uix_storage_quotas_tenant_user",
+				Unique:  true,
+				Columns: []*schema.Column{StorageQuotasColumns[7], StorageQuotasColumns[8]},
+			},
+		},
+	}
 	// SysTasksColumns holds the columns for the "sys_tasks" table.
 	SysTasksColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
@@ -2257,6 +2617,8 @@ var (
 		{Name: "type_name", Type: field.TypeString, Nullable: true, Comment: "任务执行类型名"},
 		{Name: "task_payload", Type: field.TypeString, Nullable: true, Comment: "任务数据", SchemaType: map[string]string{"mysql": "json", "postgres": "jsonb"}},
 		{Name: "cron_spec", Type: field.TypeString, Nullable: true, Comment: "cron表达式"},
+		{Name: "timezone", Type: field.TypeString, Nullable: true, Size: 64, Comment: "cron表达式使用的IANA时区"},
+		{Name: "misfire_policy", Type: field.TypeEnum, Nullable: true, Comment: "错过触发时间后的补偿策略", Enums: []string{"FIRE_ONCE", "SKIP", "CATCH_UP"}, Default: "FIRE_ONCE"},
 		{Name: "task_options", Type: field.TypeJSON, Nullable: true, Comment: "任务选项"},
 		{Name: "enable", Type: field.TypeBool, Nullable: true, Comment: "启用/禁用任务", Default: false},
 	}
@@ -2280,7 +2642,7 @@ var (
 			{
 				Name:    "idx_sys_task_tenant_enable_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{SysTasksColumns[8], SysTasksColumns[14], SysTasksColumns[1]},
+				Columns: []*schema.Column{SysTasksColumns[8], SysTasksColumns[16], SysTasksColumns[1]},
 			},
 			{
 				Name:    "idx_sys_task_tenant_created_by_created_at",
@@ -2294,6 +2656,119 @@ var (
 			},
 		},
 	}
+	// SysTaskRunsColumns holds the columns for the "sys_task_runs" table.
+	SysTaskRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "task_id", Type: field.TypeString, Nullable: true, Size: 255, Comment: "asynq任务ID"},
+		{Name: "type_name", Type: field.TypeString, Nullable: true, Size: 128, Comment: "任务类型名称"},
+		{Name: "queue", Type: field.TypeString, Nullable: true, Size: 128, Comment: "队列名称"},
+		{Name: "payload", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "任务负载"},
+		{Name: "worker", Type: field.TypeString, Nullable: true, Size: 255, Comment: "执行节点"},
+		{Name: "attempt", Type: field.TypeUint32, Nullable: true, Comment: "第几次执行（从1开始）"},
+		{Name: "max_retry", Type: field.TypeUint32, Nullable: true, Comment: "最大重试次数"},
+		{Name: "status", Type: field.TypeEnum, Nullable: true, Comment: "执行状态", Enums: []string{"RUNNING", "SUCCEEDED", "FAILED"}, Default: "RUNNING"},
+		{Name: "started_at", Type: field.TypeTime, Nullable: true, Comment: "开始时间"},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true, Comment: "结束时间"},
+		{Name: "duration_ms", Type: field.TypeUint64, Nullable: true, Comment: "执行耗时（毫秒）"},
+		{Name: "result", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "执行结果"},
+		{Name: "error_message", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "错误信息"},
+		{Name: "logs", Type: field.TypeJSON, Nullable: true, Comment: "执行日志"},
+		{Name: "logs_truncated", Type: field.TypeBool, Nullable: true, Comment: "执行日志是否被截断", Default: false},
+		{Name: "progress", Type: field.TypeJSON, Nullable: true, Comment: "最新的执行进度快照"},
+	}
+	// SysTaskRunsTable holds the schema information for the "sys_task_runs" table.
+	SysTaskRunsTable = &schema.Table{
+		Name:       "sys_task_runs",
+		Comment:    "任务执行记录表",
+		Columns:    SysTaskRunsColumns,
+		PrimaryKey: []*schema.Column{SysTaskRunsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_sys_task_run_type_started",
+				Unique:  false,
+				Columns: []*schema.Column{SysTaskRunsColumns[5], SysTaskRunsColumns[12]},
+			},
+			{
+				Name:    "idx_sys_task_run_task_id",
+				Unique:  false,
+				Columns: []*schema.Column{SysTaskRunsColumns[4]},
+			},
+			{
+				Name:    "idx_sys_task_run_started",
+				Unique:  false,
+				Columns: []*schema.Column{SysTaskRunsColumns[12]},
+			},
+		},
+	}
+	// SysTaskWorkflowsColumns holds the columns for the "sys_task_workflows" table.
+	SysTaskWorkflowsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "created_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "updated_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "deleted_by", Type: field.TypeUint32, Nullable: true, Comment: "删除者ID"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "name", Type: field.TypeString, Nullable: true, Size: 128, Comment: "工作流名称"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "描述"},
+		{Name: "steps", Type: field.TypeJSON, Nullable: true, Comment: "步骤定义"},
+		{Name: "enable", Type: field.TypeBool, Nullable: true, Comment: "启用/禁用工作流", Default: true},
+	}
+	// SysTaskWorkflowsTable holds the schema information for the "sys_task_workflows" table.
+	SysTaskWorkflowsTable = &schema.Table{
+		Name:       "sys_task_workflows",
+		Comment:    "任务工作流表",
+		Columns:    SysTaskWorkflowsColumns,
+		PrimaryKey: []*schema.Column{SysTaskWorkflowsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_sys_task_workflow_tenant_name",
+				Unique:  true,
+				Columns: []*schema.Column{SysTaskWorkflowsColumns[7], SysTaskWorkflowsColumns[8]},
+			},
+		},
+	}
+	// SysTaskWorkflowRunsColumns holds the columns for the "sys_task_workflow_runs" table.
+	SysTaskWorkflowRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "created_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "workflow_id", Type: field.TypeUint32, Nullable: true, Comment: "工作流ID"},
+		{Name: "workflow_name", Type: field.TypeString, Nullable: true, Size: 128, Comment: "工作流名称"},
+		{Name: "status", Type: field.TypeEnum, Nullable: true, Comment: "运行状态", Enums: []string{"RUNNING", "PAUSED", "SUCCEEDED", "FAILED", "CANCELLED"}, Default: "RUNNING"},
+		{Name: "input", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "运行输入"},
+		{Name: "steps", Type: field.TypeJSON, Nullable: true, Comment: "步骤定义快照"},
+		{Name: "step_runs", Type: field.TypeJSON, Nullable: true, Comment: "各步骤的执行状态"},
+		{Name: "error_message", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "错误信息"},
+		{Name: "started_at", Type: field.TypeTime, Nullable: true, Comment: "开始时间"},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true, Comment: "结束时间"},
+	}
+	// SysTaskWorkflowRunsTable holds the schema information for the "sys_task_workflow_runs" table.
+	SysTaskWorkflowRunsTable = &schema.Table{
+		Name:       "sys_task_workflow_runs",
+		Comment:    "任务工作流运行表",
+		Columns:    SysTaskWorkflowRunsColumns,
+		PrimaryKey: []*schema.Column{SysTaskWorkflowRunsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_sys_task_workflow_run_status",
+				Unique:  false,
+				Columns: []*schema.Column{SysTaskWorkflowRunsColumns[8]},
+			},
+			{
+				Name:    "idx_sys_task_workflow_run_tenant_workflow_created",
+				Unique:  false,
+				Columns: []*schema.Column{SysTaskWorkflowRunsColumns[5], SysTaskWorkflowRunsColumns[6], SysTaskWorkflowRunsColumns[1]},
+			},
+		},
+	}
 	// SysTenantsColumns holds the columns for the "sys_tenants" table.
 	SysTenantsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
@@ -2317,6 +2792,10 @@ var (
 		{Name: "unsubscribe_at", Type: field.TypeTime, Nullable: true, Comment: "取消订阅时间"},
 		{Name: "subscription_plan", Type: field.TypeString, Nullable: true, Comment: "订阅套餐"},
 		{Name: "expired_at", Type: field.TypeTime, Nullable: true, Comment: "租户有效期"},
+		{Name: "high_risk_login_action", Type: field.TypeEnum, Nullable: true, Comment: "高风险登录处置方式", Enums: []string{"ALLOW", "BLOCK", "REQUIRE_MFA"}, Default: "ALLOW"},
+		{Name: "storage_provider", Type: field.TypeEnum, Nullable: true, Comment: "文件存储后端，为空时使用平台默认存储", Enums: []string{"UNKNOWN", "MINIO", "ALIYUN", "QINIU", "TENCENT", "AWS", "GOOGLE", "AZURE", "BAIDU", "HUAWEI", "LOCAL"}},
+		{Name: "file_dedup_policy", Type: field.TypeEnum, Nullable: true, Comment: "文件去重策略，为空时仅在本租户内去重", Enums: []string{"DEDUP_DISABLED", "DEDUP_TENANT", "DEDUP_SHARED"}},
+		{Name: "recycle_bin_retention_days", Type: field.TypeUint32, Nullable: true, Comment: "回收站文件保留天数，为空或0时使用平台默认值"},
 	}
 	// SysTenantsTable holds the schema information for the "sys_tenants" table.
 	SysTenantsTable = &schema.Table{
@@ -2802,17 +3281,23 @@ var (
 	Tables = []*schema.Table{
 		SysApisTable,
 		SysAPIAuditLogsTable,
+		SysAuditForwardersTable,
+		SysAuditLogArchivesTable,
+		SysAuditLogRetentionPoliciesTable,
 		SysDataAccessAuditLogsTable,
 		SysDictEntriesTable,
 		SysDictEntryI18nTable,
 		SysDictTypesTable,
 		FilesTable,
+		FileShareLinksTable,
 		InternalMessagesTable,
 		InternalMessageCategoriesTable,
 		InternalMessageRecipientsTable,
 		SysLanguagesTable,
 		SysLoginAuditLogsTable,
 		SysLoginPoliciesTable,
+		SysLuaScriptsTable,
+		SysLuaScriptVersionsTable,
 		SysMembershipsTable,
 		SysMembershipOrgUnitsTable,
 		SysMembershipPositionsTable,
@@ -2828,10 +3313,16 @@ var (
 		SysPermissionPoliciesTable,
 		SysPolicyEvaluationLogsTable,
 		SysPositionsTable,
+		SysRestoredAuditLogsTable,
 		SysRolesTable,
 		SysRoleMetadataTable,
 		SysRolePermissionsTable,
+		StorageObjectsTable,
+		StorageQuotasTable,
 		SysTasksTable,
+		SysTaskRunsTable,
+		SysTaskWorkflowsTable,
+		SysTaskWorkflowRunsTable,
 		SysTenantsTable,
 		SysUsersTable,
 		SysUserCredentialsTable,
@@ -2852,6 +3343,21 @@ func init() {
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_bin",
 	}
+	SysAuditForwardersTable.Annotation = &entsql.Annotation{
+		Table:     "sys_audit_forwarders",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
+	SysAuditLogArchivesTable.Annotation = &entsql.Annotation{
+		Table:     "sys_audit_log_archives",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
+	SysAuditLogRetentionPoliciesTable.Annotation = &entsql.Annotation{
+		Table:     "sys_audit_log_retention_policies",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
 	SysDataAccessAuditLogsTable.Annotation = &entsql.Annotation{
 		Table:     "sys_data_access_audit_logs",
 		Charset:   "utf8mb4",
@@ -2879,6 +3385,11 @@ func init() {
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_bin",
 	}
+	FileShareLinksTable.Annotation = &entsql.Annotation{
+		Table:     "file_share_links",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
 	InternalMessagesTable.Annotation = &entsql.Annotation{
 		Table:     "internal_messages",
 		Charset:   "utf8mb4",
@@ -2909,6 +3420,16 @@ func init() {
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_bin",
 	}
+	SysLuaScriptsTable.Annotation = &entsql.Annotation{
+		Table:     "sys_lua_scripts",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
+	SysLuaScriptVersionsTable.Annotation = &entsql.Annotation{
+		Table:     "sys_lua_script_versions",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
 	SysMembershipsTable.Annotation = &entsql.Annotation{
 		Table:     "sys_memberships",
 		Charset:   "utf8mb4",
@@ -2987,6 +3508,11 @@ func init() {
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_bin",
 	}
+	SysRestoredAuditLogsTable.Annotation = &entsql.Annotation{
+		Table:     "sys_restored_audit_logs",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
 	SysRolesTable.Annotation = &entsql.Annotation{
 		Table:     "sys_roles",
 		Charset:   "utf8mb4",
@@ -3002,11 +3528,36 @@ func init() {
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_bin",
 	}
+	StorageObjectsTable.Annotation = &entsql.Annotation{
+		Table:     "storage_objects",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
+	StorageQuotasTable.Annotation = &entsql.Annotation{
+		Table:     "storage_quotas",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
 	SysTasksTable.Annotation = &entsql.Annotation{
 		Table:     "sys_tasks",
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_bin",
 	}
+	SysTaskRunsTable.Annotation = &entsql.Annotation{
+		Table:     "sys_task_runs",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
+	SysTaskWorkflowsTable.Annotation = &entsql.Annotation{
+		Table:     "sys_task_workflows",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
+	SysTaskWorkflowRunsTable.Annotation = &entsql.Annotation{
+		Table:     "sys_task_workflow_runs",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
 	SysTenantsTable.Annotation = &entsql.Annotation{
 		Table:     "sys_tenants",
 		Charset:   "utf8mb4",
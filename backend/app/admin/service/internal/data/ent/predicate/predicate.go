@@ -12,6 +12,15 @@ type Api func(*sql.Selector)
 // ApiAuditLog is the predicate function for apiauditlog builders.
 type ApiAuditLog func(*sql.Selector)
 
+// AuditForwarder is the predicate function for auditforwarder builders.
+type AuditForwarder func(*sql.Selector)
+
+// AuditLogArchive is the predicate function for auditlogarchive builders.
+type AuditLogArchive func(*sql.Selector)
+
+// AuditLogRetentionPolicy is the predicate function for auditlogretentionpolicy builders.
+type AuditLogRetentionPolicy func(*sql.Selector)
+
 // DataAccessAuditLog is the predicate function for dataaccessauditlog builders.
 type DataAccessAuditLog func(*sql.Selector)
 
@@ -27,6 +36,9 @@ type DictType func(*sql.Selector)
 // File is the predicate function for file builders.
 type File func(*sql.Selector)
 
+// FileShareLink is the predicate function for filesharelink builders.
+type FileShareLink func(*sql.Selector)
+
 // InternalMessage is the predicate function for internalmessage builders.
 type InternalMessage func(*sql.Selector)
 
@@ -45,6 +57,12 @@ type LoginAuditLog func(*sql.Selector)
 // LoginPolicy is the predicate function for loginpolicy builders.
 type LoginPolicy func(*sql.Selector)
 
+// LuaScript is the predicate function for luascript builders.
+type LuaScript func(*sql.Selector)
+
+// LuaScriptVersion is the predicate function for luascriptversion builders.
+type LuaScriptVersion func(*sql.Selector)
+
 // Membership is the predicate function for membership builders.
 type Membership func(*sql.Selector)
 
@@ -90,6 +108,9 @@ type PolicyEvaluationLog func(*sql.Selector)
 // Position is the predicate function for position builders.
 type Position func(*sql.Selector)
 
+// RestoredAuditLog is the predicate function for restoredauditlog builders.
+type RestoredAuditLog func(*sql.Selector)
+
 // Role is the predicate function for role builders.
 type Role func(*sql.Selector)
 
@@ -99,9 +120,24 @@ type RoleMetadata func(*sql.Selector)
 // RolePermission is the predicate function for rolepermission builders.
 type RolePermission func(*sql.Selector)
 
+// StorageObject is the predicate function for storageobject builders.
+type StorageObject func(*sql.Selector)
+
+// StorageQuota is the predicate function for storagequota builders.
+type StorageQuota func(*sql.Selector)
+
 // Task is the predicate function for task builders.
 type Task func(*sql.Selector)
 
+// TaskRun is the predicate function for taskrun builders.
+type TaskRun func(*sql.Selector)
+
+// TaskWorkflow is the predicate function for taskworkflow builders.
+type TaskWorkflow func(*sql.Selector)
+
+// TaskWorkflowRun is the predicate function for taskworkflowrun builders.
+type TaskWorkflowRun func(*sql.Selector)
+
 // Tenant is the predicate function for tenant builders.
 type Tenant func(*sql.Selector)
 
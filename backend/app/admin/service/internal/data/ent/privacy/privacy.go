@@ -159,6 +159,78 @@ func (f ApiAuditLogMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mut
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ApiAuditLogMutation", m)
 }
 
+// The AuditForwarderQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type AuditForwarderQueryRuleFunc func(context.Context, *ent.AuditForwarderQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f AuditForwarderQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AuditForwarderQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.AuditForwarderQuery", q)
+}
+
+// The AuditForwarderMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type AuditForwarderMutationRuleFunc func(context.Context, *ent.AuditForwarderMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f AuditForwarderMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.AuditForwarderMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.AuditForwarderMutation", m)
+}
+
+// The AuditLogArchiveQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type AuditLogArchiveQueryRuleFunc func(context.Context, *ent.AuditLogArchiveQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f AuditLogArchiveQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AuditLogArchiveQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.AuditLogArchiveQuery", q)
+}
+
+// The AuditLogArchiveMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type AuditLogArchiveMutationRuleFunc func(context.Context, *ent.AuditLogArchiveMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f AuditLogArchiveMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.AuditLogArchiveMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.AuditLogArchiveMutation", m)
+}
+
+// The AuditLogRetentionPolicyQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type AuditLogRetentionPolicyQueryRuleFunc func(context.Context, *ent.AuditLogRetentionPolicyQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f AuditLogRetentionPolicyQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AuditLogRetentionPolicyQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.AuditLogRetentionPolicyQuery", q)
+}
+
+// The AuditLogRetentionPolicyMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type AuditLogRetentionPolicyMutationRuleFunc func(context.Context, *ent.AuditLogRetentionPolicyMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f AuditLogRetentionPolicyMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.AuditLogRetentionPolicyMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.AuditLogRetentionPolicyMutation", m)
+}
+
 // The DataAccessAuditLogQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type DataAccessAuditLogQueryRuleFunc func(context.Context, *ent.DataAccessAuditLogQuery) error
@@ -279,6 +351,30 @@ func (f FileMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation)
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.FileMutation", m)
 }
 
+// The FileShareLinkQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type FileShareLinkQueryRuleFunc func(context.Context, *ent.FileShareLinkQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f FileShareLinkQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.FileShareLinkQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.FileShareLinkQuery", q)
+}
+
+// The FileShareLinkMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type FileShareLinkMutationRuleFunc func(context.Context, *ent.FileShareLinkMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f FileShareLinkMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.FileShareLinkMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.FileShareLinkMutation", m)
+}
+
 // The InternalMessageQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type InternalMessageQueryRuleFunc func(context.Context, *ent.InternalMessageQuery) error
@@ -423,6 +519,54 @@ func (f LoginPolicyMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mut
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.LoginPolicyMutation", m)
 }
 
+// The LuaScriptQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type LuaScriptQueryRuleFunc func(context.Context, *ent.LuaScriptQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f LuaScriptQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.LuaScriptQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.LuaScriptQuery", q)
+}
+
+// The LuaScriptMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type LuaScriptMutationRuleFunc func(context.Context, *ent.LuaScriptMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f LuaScriptMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.LuaScriptMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.LuaScriptMutation", m)
+}
+
+// The LuaScriptVersionQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type LuaScriptVersionQueryRuleFunc func(context.Context, *ent.LuaScriptVersionQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f LuaScriptVersionQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.LuaScriptVersionQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.LuaScriptVersionQuery", q)
+}
+
+// The LuaScriptVersionMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type LuaScriptVersionMutationRuleFunc func(context.Context, *ent.LuaScriptVersionMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f LuaScriptVersionMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.LuaScriptVersionMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.LuaScriptVersionMutation", m)
+}
+
 // The MembershipQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type MembershipQueryRuleFunc func(context.Context, *ent.MembershipQuery) error
@@ -783,6 +927,30 @@ func (f PositionMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutati
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.PositionMutation", m)
 }
 
+// The RestoredAuditLogQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type RestoredAuditLogQueryRuleFunc func(context.Context, *ent.RestoredAuditLogQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f RestoredAuditLogQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.RestoredAuditLogQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.RestoredAuditLogQuery", q)
+}
+
+// The RestoredAuditLogMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type RestoredAuditLogMutationRuleFunc func(context.Context, *ent.RestoredAuditLogMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f RestoredAuditLogMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.RestoredAuditLogMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.RestoredAuditLogMutation", m)
+}
+
 // The RoleQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type RoleQueryRuleFunc func(context.Context, *ent.RoleQuery) error
@@ -855,6 +1023,54 @@ func (f RolePermissionMutationRuleFunc) EvalMutation(ctx context.Context, m ent.
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.RolePermissionMutation", m)
 }
 
+// The StorageObjectQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type StorageObjectQueryRuleFunc func(context.Context, *ent.StorageObjectQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f StorageObjectQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.StorageObjectQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.StorageObjectQuery", q)
+}
+
+// The StorageObjectMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type StorageObjectMutationRuleFunc func(context.Context, *ent.StorageObjectMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f StorageObjectMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.StorageObjectMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.StorageObjectMutation", m)
+}
+
+// The StorageQuotaQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type StorageQuotaQueryRuleFunc func(context.Context, *ent.StorageQuotaQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f StorageQuotaQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.StorageQuotaQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.StorageQuotaQuery", q)
+}
+
+// The StorageQuotaMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type StorageQuotaMutationRuleFunc func(context.Context, *ent.StorageQuotaMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f StorageQuotaMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.StorageQuotaMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.StorageQuotaMutation", m)
+}
+
 // The TaskQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type TaskQueryRuleFunc func(context.Context, *ent.TaskQuery) error
@@ -879,6 +1095,78 @@ func (f TaskMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation)
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.TaskMutation", m)
 }
 
+// The TaskRunQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type TaskRunQueryRuleFunc func(context.Context, *ent.TaskRunQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f TaskRunQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TaskRunQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.TaskRunQuery", q)
+}
+
+// The TaskRunMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type TaskRunMutationRuleFunc func(context.Context, *ent.TaskRunMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f TaskRunMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.TaskRunMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.TaskRunMutation", m)
+}
+
+// The TaskWorkflowQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type TaskWorkflowQueryRuleFunc func(context.Context, *ent.TaskWorkflowQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f TaskWorkflowQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TaskWorkflowQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.TaskWorkflowQuery", q)
+}
+
+// The TaskWorkflowMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type TaskWorkflowMutationRuleFunc func(context.Context, *ent.TaskWorkflowMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f TaskWorkflowMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.TaskWorkflowMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.TaskWorkflowMutation", m)
+}
+
+// The TaskWorkflowRunQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type TaskWorkflowRunQueryRuleFunc func(context.Context, *ent.TaskWorkflowRunQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f TaskWorkflowRunQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TaskWorkflowRunQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.TaskWorkflowRunQuery", q)
+}
+
+// The TaskWorkflowRunMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type TaskWorkflowRunMutationRuleFunc func(context.Context, *ent.TaskWorkflowRunMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f TaskWorkflowRunMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.TaskWorkflowRunMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.TaskWorkflowRunMutation", m)
+}
+
 // The TenantQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type TenantQueryRuleFunc func(context.Context, *ent.TenantQuery) error
@@ -1062,6 +1350,12 @@ func queryFilter(q ent.Query) (Filter, error) {
 		return q.Filter(), nil
 	case *ent.ApiAuditLogQuery:
 		return q.Filter(), nil
+	case *ent.AuditForwarderQuery:
+		return q.Filter(), nil
+	case *ent.AuditLogArchiveQuery:
+		return q.Filter(), nil
+	case *ent.AuditLogRetentionPolicyQuery:
+		return q.Filter(), nil
 	case *ent.DataAccessAuditLogQuery:
 		return q.Filter(), nil
 	case *ent.DictEntryQuery:
@@ -1072,6 +1366,8 @@ func queryFilter(q ent.Query) (Filter, error) {
 		return q.Filter(), nil
 	case *ent.FileQuery:
 		return q.Filter(), nil
+	case *ent.FileShareLinkQuery:
+		return q.Filter(), nil
 	case *ent.InternalMessageQuery:
 		return q.Filter(), nil
 	case *ent.InternalMessageCategoryQuery:
@@ -1084,6 +1380,10 @@ func queryFilter(q ent.Query) (Filter, error) {
 		return q.Filter(), nil
 	case *ent.LoginPolicyQuery:
 		return q.Filter(), nil
+	case *ent.LuaScriptQuery:
+		return q.Filter(), nil
+	case *ent.LuaScriptVersionQuery:
+		return q.Filter(), nil
 	case *ent.MembershipQuery:
 		return q.Filter(), nil
 	case *ent.MembershipOrgUnitQuery:
@@ -1114,14 +1414,26 @@ func queryFilter(q ent.Query) (Filter, error) {
 		return q.Filter(), nil
 	case *ent.PositionQuery:
 		return q.Filter(), nil
+	case *ent.RestoredAuditLogQuery:
+		return q.Filter(), nil
 	case *ent.RoleQuery:
 		return q.Filter(), nil
 	case *ent.RoleMetadataQuery:
 		return q.Filter(), nil
 	case *ent.RolePermissionQuery:
 		return q.Filter(), nil
+	case *ent.StorageObjectQuery:
+		return q.Filter(), nil
+	case *ent.StorageQuotaQuery:
+		return q.Filter(), nil
 	case *ent.TaskQuery:
 		return q.Filter(), nil
+	case *ent.TaskRunQuery:
+		return q.Filter(), nil
+	case *ent.TaskWorkflowQuery:
+		return q.Filter(), nil
+	case *ent.TaskWorkflowRunQuery:
+		return q.Filter(), nil
 	case *ent.TenantQuery:
 		return q.Filter(), nil
 	case *ent.UserQuery:
@@ -1145,6 +1457,12 @@ func mutationFilter(m ent.Mutation) (Filter, error) {
 		return m.Filter(), nil
 	case *ent.ApiAuditLogMutation:
 		return m.Filter(), nil
+	case *ent.AuditForwarderMutation:
+		return m.Filter(), nil
+	case *ent.AuditLogArchiveMutation:
+		return m.Filter(), nil
+	case *ent.AuditLogRetentionPolicyMutation:
+		return m.Filter(), nil
 	case *ent.DataAccessAuditLogMutation:
 		return m.Filter(), nil
 	case *ent.DictEntryMutation:
@@ -1155,6 +1473,8 @@ func mutationFilter(m ent.Mutation) (Filter, error) {
 		return m.Filter(), nil
 	case *ent.FileMutation:
 		return m.Filter(), nil
+	case *ent.FileShareLinkMutation:
+		return m.Filter(), nil
 	case *ent.InternalMessageMutation:
 		return m.Filter(), nil
 	case *ent.InternalMessageCategoryMutation:
@@ -1167,6 +1487,10 @@ func mutationFilter(m ent.Mutation) (Filter, error) {
 		return m.Filter(), nil
 	case *ent.LoginPolicyMutation:
 		return m.Filter(), nil
+	case *ent.LuaScriptMutation:
+		return m.Filter(), nil
+	case *ent.LuaScriptVersionMutation:
+		return m.Filter(), nil
 	case *ent.MembershipMutation:
 		return m.Filter(), nil
 	case *ent.MembershipOrgUnitMutation:
@@ -1197,14 +1521,26 @@ func mutationFilter(m ent.Mutation) (Filter, error) {
 		return m.Filter(), nil
 	case *ent.PositionMutation:
 		return m.Filter(), nil
+	case *ent.RestoredAuditLogMutation:
+		return m.Filter(), nil
 	case *ent.RoleMutation:
 		return m.Filter(), nil
 	case *ent.RoleMetadataMutation:
 		return m.Filter(), nil
 	case *ent.RolePermissionMutation:
 		return m.Filter(), nil
+	case *ent.StorageObjectMutation:
+		return m.Filter(), nil
+	case *ent.StorageQuotaMutation:
+		return m.Filter(), nil
 	case *ent.TaskMutation:
 		return m.Filter(), nil
+	case *ent.TaskRunMutation:
+		return m.Filter(), nil
+	case *ent.TaskWorkflowMutation:
+		return m.Filter(), nil
+	case *ent.TaskWorkflowRunMutation:
+		return m.Filter(), nil
 	case *ent.TenantMutation:
 		return m.Filter(), nil
 	case *ent.UserMutation:
@@ -0,0 +1,196 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/restoredauditlog"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// 已恢复审计日志表（只读查询）
+type RestoredAuditLog struct {
+	config `json:"-"`
+	// ID of the ent.
+	// id
+	ID uint32 `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// 归档ID
+	ArchiveID *uint32 `json:"archive_id,omitempty"`
+	// 审计日志类型
+	LogType *restoredauditlog.LogType `json:"log_type,omitempty"`
+	// 原始日志ID
+	LogID *uint32 `json:"log_id,omitempty"`
+	// 原始日志创建时间
+	LogCreatedAt *time.Time `json:"log_created_at,omitempty"`
+	// 原始日志内容
+	Payload      map[string]interface{} `json:"payload,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RestoredAuditLog) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case restoredauditlog.FieldPayload:
+			values[i] = new([]byte)
+		case restoredauditlog.FieldID, restoredauditlog.FieldTenantID, restoredauditlog.FieldArchiveID, restoredauditlog.FieldLogID:
+			values[i] = new(sql.NullInt64)
+		case restoredauditlog.FieldLogType:
+			values[i] = new(sql.NullString)
+		case restoredauditlog.FieldCreatedAt, restoredauditlog.FieldLogCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RestoredAuditLog fields.
+func (_m *RestoredAuditLog) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case restoredauditlog.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint32(value.Int64)
+		case restoredauditlog.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = new(time.Time)
+				*_m.CreatedAt = value.Time
+			}
+		case restoredauditlog.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case restoredauditlog.FieldArchiveID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field archive_id", values[i])
+			} else if value.Valid {
+				_m.ArchiveID = new(uint32)
+				*_m.ArchiveID = uint32(value.Int64)
+			}
+		case restoredauditlog.FieldLogType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field log_type", values[i])
+			} else if value.Valid {
+				_m.LogType = new(restoredauditlog.LogType)
+				*_m.LogType = restoredauditlog.LogType(value.String)
+			}
+		case restoredauditlog.FieldLogID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field log_id", values[i])
+			} else if value.Valid {
+				_m.LogID = new(uint32)
+				*_m.LogID = uint32(value.Int64)
+			}
+		case restoredauditlog.FieldLogCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field log_created_at", values[i])
+			} else if value.Valid {
+				_m.LogCreatedAt = new(time.Time)
+				*_m.LogCreatedAt = value.Time
+			}
+		case restoredauditlog.FieldPayload:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field payload", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Payload); err != nil {
+					return fmt.Errorf("unmarshal field payload: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RestoredAuditLog.
+// This includes values selected through modifiers, order, etc.
+func (_m *RestoredAuditLog) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RestoredAuditLog.
+// Note that you need to call RestoredAuditLog.Unwrap() before calling this method if this RestoredAuditLog
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RestoredAuditLog) Update() *RestoredAuditLogUpdateOne {
+	return NewRestoredAuditLogClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RestoredAuditLog entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RestoredAuditLog) Unwrap() *RestoredAuditLog {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RestoredAuditLog is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RestoredAuditLog) String() string {
+	var builder strings.Builder
+	builder.WriteString("RestoredAuditLog(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreatedAt; v != nil {
+		builder.WriteString("created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ArchiveID; v != nil {
+		builder.WriteString("archive_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.LogType; v != nil {
+		builder.WriteString("log_type=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.LogID; v != nil {
+		builder.WriteString("log_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.LogCreatedAt; v != nil {
+		builder.WriteString("log_created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("payload=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Payload))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RestoredAuditLogs is a parsable slice of RestoredAuditLog.
+type RestoredAuditLogs []*RestoredAuditLog
@@ -0,0 +1,134 @@
+// Code generated by ent, DO NOT EDIT.
+
+package restoredauditlog
+
+import (
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the restoredauditlog type in the database.
+	Label = "restored_audit_log"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldArchiveID holds the string denoting the archive_id field in the database.
+	FieldArchiveID = "archive_id"
+	// FieldLogType holds the string denoting the log_type field in the database.
+	FieldLogType = "log_type"
+	// FieldLogID holds the string denoting the log_id field in the database.
+	FieldLogID = "log_id"
+	// FieldLogCreatedAt holds the string denoting the log_created_at field in the database.
+	FieldLogCreatedAt = "log_created_at"
+	// FieldPayload holds the string denoting the payload field in the database.
+	FieldPayload = "payload"
+	// Table holds the table name of the restoredauditlog in the database.
+	Table = "sys_restored_audit_logs"
+)
+
+// Columns holds all SQL columns for restoredauditlog fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldTenantID,
+	FieldArchiveID,
+	FieldLogType,
+	FieldLogID,
+	FieldLogCreatedAt,
+	FieldPayload,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "go-wind-admin/app/admin/service/internal/data/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID uint32
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(uint32) error
+)
+
+// LogType defines the type for the "log_type" enum field.
+type LogType string
+
+// LogType values.
+const (
+	LogTypeApiAuditLog         LogType = "API_AUDIT_LOG"
+	LogTypeLoginAuditLog       LogType = "LOGIN_AUDIT_LOG"
+	LogTypeOperationAuditLog   LogType = "OPERATION_AUDIT_LOG"
+	LogTypeDataAccessAuditLog  LogType = "DATA_ACCESS_AUDIT_LOG"
+	LogTypePermissionAuditLog  LogType = "PERMISSION_AUDIT_LOG"
+	LogTypePolicyEvaluationLog LogType = "POLICY_EVALUATION_LOG"
+)
+
+func (lt LogType) String() string {
+	return string(lt)
+}
+
+// LogTypeValidator is a validator for the "log_type" field enum values. It is called by the builders before save.
+func LogTypeValidator(lt LogType) error {
+	switch lt {
+	case LogTypeApiAuditLog, LogTypeLoginAuditLog, LogTypeOperationAuditLog, LogTypeDataAccessAuditLog, LogTypePermissionAuditLog, LogTypePolicyEvaluationLog:
+		return nil
+	default:
+		return fmt.Errorf("restoredauditlog: invalid enum value for log_type field: %q", lt)
+	}
+}
+
+// OrderOption defines the ordering options for the RestoredAuditLog queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByArchiveID orders the results by the archive_id field.
+func ByArchiveID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArchiveID, opts...).ToFunc()
+}
+
+// ByLogType orders the results by the log_type field.
+func ByLogType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLogType, opts...).ToFunc()
+}
+
+// ByLogID orders the results by the log_id field.
+func ByLogID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLogID, opts...).ToFunc()
+}
+
+// ByLogCreatedAt orders the results by the log_created_at field.
+func ByLogCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLogCreatedAt, opts...).ToFunc()
+}
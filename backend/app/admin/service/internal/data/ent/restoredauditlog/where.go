@@ -0,0 +1,385 @@
+// Code generated by ent, DO NOT EDIT.
+
+package restoredauditlog
+
+import (
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldEQ(FieldTenantID, v))
+}
+
+// ArchiveID applies equality check predicate on the "archive_id" field. It's identical to ArchiveIDEQ.
+func ArchiveID(v uint32) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldEQ(FieldArchiveID, v))
+}
+
+// LogID applies equality check predicate on the "log_id" field. It's identical to LogIDEQ.
+func LogID(v uint32) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldEQ(FieldLogID, v))
+}
+
+// LogCreatedAt applies equality check predicate on the "log_created_at" field. It's identical to LogCreatedAtEQ.
+func LogCreatedAt(v time.Time) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldEQ(FieldLogCreatedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldNotNull(FieldTenantID))
+}
+
+// ArchiveIDEQ applies the EQ predicate on the "archive_id" field.
+func ArchiveIDEQ(v uint32) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldEQ(FieldArchiveID, v))
+}
+
+// ArchiveIDNEQ applies the NEQ predicate on the "archive_id" field.
+func ArchiveIDNEQ(v uint32) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldNEQ(FieldArchiveID, v))
+}
+
+// ArchiveIDIn applies the In predicate on the "archive_id" field.
+func ArchiveIDIn(vs ...uint32) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldIn(FieldArchiveID, vs...))
+}
+
+// ArchiveIDNotIn applies the NotIn predicate on the "archive_id" field.
+func ArchiveIDNotIn(vs ...uint32) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldNotIn(FieldArchiveID, vs...))
+}
+
+// ArchiveIDGT applies the GT predicate on the "archive_id" field.
+func ArchiveIDGT(v uint32) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldGT(FieldArchiveID, v))
+}
+
+// ArchiveIDGTE applies the GTE predicate on the "archive_id" field.
+func ArchiveIDGTE(v uint32) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldGTE(FieldArchiveID, v))
+}
+
+// ArchiveIDLT applies the LT predicate on the "archive_id" field.
+func ArchiveIDLT(v uint32) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldLT(FieldArchiveID, v))
+}
+
+// ArchiveIDLTE applies the LTE predicate on the "archive_id" field.
+func ArchiveIDLTE(v uint32) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldLTE(FieldArchiveID, v))
+}
+
+// ArchiveIDIsNil applies the IsNil predicate on the "archive_id" field.
+func ArchiveIDIsNil() predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldIsNull(FieldArchiveID))
+}
+
+// ArchiveIDNotNil applies the NotNil predicate on the "archive_id" field.
+func ArchiveIDNotNil() predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldNotNull(FieldArchiveID))
+}
+
+// LogTypeEQ applies the EQ predicate on the "log_type" field.
+func LogTypeEQ(v LogType) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldEQ(FieldLogType, v))
+}
+
+// LogTypeNEQ applies the NEQ predicate on the "log_type" field.
+func LogTypeNEQ(v LogType) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldNEQ(FieldLogType, v))
+}
+
+// LogTypeIn applies the In predicate on the "log_type" field.
+func LogTypeIn(vs ...LogType) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldIn(FieldLogType, vs...))
+}
+
+// LogTypeNotIn applies the NotIn predicate on the "log_type" field.
+func LogTypeNotIn(vs ...LogType) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldNotIn(FieldLogType, vs...))
+}
+
+// LogTypeIsNil applies the IsNil predicate on the "log_type" field.
+func LogTypeIsNil() predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldIsNull(FieldLogType))
+}
+
+// LogTypeNotNil applies the NotNil predicate on the "log_type" field.
+func LogTypeNotNil() predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldNotNull(FieldLogType))
+}
+
+// LogIDEQ applies the EQ predicate on the "log_id" field.
+func LogIDEQ(v uint32) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldEQ(FieldLogID, v))
+}
+
+// LogIDNEQ applies the NEQ predicate on the "log_id" field.
+func LogIDNEQ(v uint32) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldNEQ(FieldLogID, v))
+}
+
+// LogIDIn applies the In predicate on the "log_id" field.
+func LogIDIn(vs ...uint32) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldIn(FieldLogID, vs...))
+}
+
+// LogIDNotIn applies the NotIn predicate on the "log_id" field.
+func LogIDNotIn(vs ...uint32) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldNotIn(FieldLogID, vs...))
+}
+
+// LogIDGT applies the GT predicate on the "log_id" field.
+func LogIDGT(v uint32) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldGT(FieldLogID, v))
+}
+
+// LogIDGTE applies the GTE predicate on the "log_id" field.
+func LogIDGTE(v uint32) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldGTE(FieldLogID, v))
+}
+
+// LogIDLT applies the LT predicate on the "log_id" field.
+func LogIDLT(v uint32) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldLT(FieldLogID, v))
+}
+
+// LogIDLTE applies the LTE predicate on the "log_id" field.
+func LogIDLTE(v uint32) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldLTE(FieldLogID, v))
+}
+
+// LogIDIsNil applies the IsNil predicate on the "log_id" field.
+func LogIDIsNil() predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldIsNull(FieldLogID))
+}
+
+// LogIDNotNil applies the NotNil predicate on the "log_id" field.
+func LogIDNotNil() predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldNotNull(FieldLogID))
+}
+
+// LogCreatedAtEQ applies the EQ predicate on the "log_created_at" field.
+func LogCreatedAtEQ(v time.Time) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldEQ(FieldLogCreatedAt, v))
+}
+
+// LogCreatedAtNEQ applies the NEQ predicate on the "log_created_at" field.
+func LogCreatedAtNEQ(v time.Time) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldNEQ(FieldLogCreatedAt, v))
+}
+
+// LogCreatedAtIn applies the In predicate on the "log_created_at" field.
+func LogCreatedAtIn(vs ...time.Time) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldIn(FieldLogCreatedAt, vs...))
+}
+
+// LogCreatedAtNotIn applies the NotIn predicate on the "log_created_at" field.
+func LogCreatedAtNotIn(vs ...time.Time) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldNotIn(FieldLogCreatedAt, vs...))
+}
+
+// LogCreatedAtGT applies the GT predicate on the "log_created_at" field.
+func LogCreatedAtGT(v time.Time) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldGT(FieldLogCreatedAt, v))
+}
+
+// LogCreatedAtGTE applies the GTE predicate on the "log_created_at" field.
+func LogCreatedAtGTE(v time.Time) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldGTE(FieldLogCreatedAt, v))
+}
+
+// LogCreatedAtLT applies the LT predicate on the "log_created_at" field.
+func LogCreatedAtLT(v time.Time) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldLT(FieldLogCreatedAt, v))
+}
+
+// LogCreatedAtLTE applies the LTE predicate on the "log_created_at" field.
+func LogCreatedAtLTE(v time.Time) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldLTE(FieldLogCreatedAt, v))
+}
+
+// LogCreatedAtIsNil applies the IsNil predicate on the "log_created_at" field.
+func LogCreatedAtIsNil() predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldIsNull(FieldLogCreatedAt))
+}
+
+// LogCreatedAtNotNil applies the NotNil predicate on the "log_created_at" field.
+func LogCreatedAtNotNil() predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldNotNull(FieldLogCreatedAt))
+}
+
+// PayloadIsNil applies the IsNil predicate on the "payload" field.
+func PayloadIsNil() predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldIsNull(FieldPayload))
+}
+
+// PayloadNotNil applies the NotNil predicate on the "payload" field.
+func PayloadNotNil() predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.FieldNotNull(FieldPayload))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RestoredAuditLog) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RestoredAuditLog) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RestoredAuditLog) predicate.RestoredAuditLog {
+	return predicate.RestoredAuditLog(sql.NotPredicates(p))
+}
@@ -0,0 +1,925 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/restoredauditlog"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RestoredAuditLogCreate is the builder for creating a RestoredAuditLog entity.
+type RestoredAuditLogCreate struct {
+	config
+	mutation *RestoredAuditLogMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RestoredAuditLogCreate) SetCreatedAt(v time.Time) *RestoredAuditLogCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RestoredAuditLogCreate) SetNillableCreatedAt(v *time.Time) *RestoredAuditLogCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *RestoredAuditLogCreate) SetTenantID(v uint32) *RestoredAuditLogCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *RestoredAuditLogCreate) SetNillableTenantID(v *uint32) *RestoredAuditLogCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetArchiveID sets the "archive_id" field.
+func (_c *RestoredAuditLogCreate) SetArchiveID(v uint32) *RestoredAuditLogCreate {
+	_c.mutation.SetArchiveID(v)
+	return _c
+}
+
+// SetNillableArchiveID sets the "archive_id" field if the given value is not nil.
+func (_c *RestoredAuditLogCreate) SetNillableArchiveID(v *uint32) *RestoredAuditLogCreate {
+	if v != nil {
+		_c.SetArchiveID(*v)
+	}
+	return _c
+}
+
+// SetLogType sets the "log_type" field.
+func (_c *RestoredAuditLogCreate) SetLogType(v restoredauditlog.LogType) *RestoredAuditLogCreate {
+	_c.mutation.SetLogType(v)
+	return _c
+}
+
+// SetNillableLogType sets the "log_type" field if the given value is not nil.
+func (_c *RestoredAuditLogCreate) SetNillableLogType(v *restoredauditlog.LogType) *RestoredAuditLogCreate {
+	if v != nil {
+		_c.SetLogType(*v)
+	}
+	return _c
+}
+
+// SetLogID sets the "log_id" field.
+func (_c *RestoredAuditLogCreate) SetLogID(v uint32) *RestoredAuditLogCreate {
+	_c.mutation.SetLogID(v)
+	return _c
+}
+
+// SetNillableLogID sets the "log_id" field if the given value is not nil.
+func (_c *RestoredAuditLogCreate) SetNillableLogID(v *uint32) *RestoredAuditLogCreate {
+	if v != nil {
+		_c.SetLogID(*v)
+	}
+	return _c
+}
+
+// SetLogCreatedAt sets the "log_created_at" field.
+func (_c *RestoredAuditLogCreate) SetLogCreatedAt(v time.Time) *RestoredAuditLogCreate {
+	_c.mutation.SetLogCreatedAt(v)
+	return _c
+}
+
+// SetNillableLogCreatedAt sets the "log_created_at" field if the given value is not nil.
+func (_c *RestoredAuditLogCreate) SetNillableLogCreatedAt(v *time.Time) *RestoredAuditLogCreate {
+	if v != nil {
+		_c.SetLogCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetPayload sets the "payload" field.
+func (_c *RestoredAuditLogCreate) SetPayload(v map[string]interface{}) *RestoredAuditLogCreate {
+	_c.mutation.SetPayload(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *RestoredAuditLogCreate) SetID(v uint32) *RestoredAuditLogCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the RestoredAuditLogMutation object of the builder.
+func (_c *RestoredAuditLogCreate) Mutation() *RestoredAuditLogMutation {
+	return _c.mutation
+}
+
+// Save creates the RestoredAuditLog in the database.
+func (_c *RestoredAuditLogCreate) Save(ctx context.Context) (*RestoredAuditLog, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RestoredAuditLogCreate) SaveX(ctx context.Context) *RestoredAuditLog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RestoredAuditLogCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RestoredAuditLogCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RestoredAuditLogCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := restoredauditlog.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RestoredAuditLogCreate) check() error {
+	if v, ok := _c.mutation.LogType(); ok {
+		if err := restoredauditlog.LogTypeValidator(v); err != nil {
+			return &ValidationError{Name: "log_type", err: fmt.Errorf(`ent: validator failed for field "RestoredAuditLog.log_type": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := restoredauditlog.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "RestoredAuditLog.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *RestoredAuditLogCreate) sqlSave(ctx context.Context) (*RestoredAuditLog, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RestoredAuditLogCreate) createSpec() (*RestoredAuditLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RestoredAuditLog{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(restoredauditlog.Table, sqlgraph.NewFieldSpec(restoredauditlog.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(restoredauditlog.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(restoredauditlog.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.ArchiveID(); ok {
+		_spec.SetField(restoredauditlog.FieldArchiveID, field.TypeUint32, value)
+		_node.ArchiveID = &value
+	}
+	if value, ok := _c.mutation.LogType(); ok {
+		_spec.SetField(restoredauditlog.FieldLogType, field.TypeEnum, value)
+		_node.LogType = &value
+	}
+	if value, ok := _c.mutation.LogID(); ok {
+		_spec.SetField(restoredauditlog.FieldLogID, field.TypeUint32, value)
+		_node.LogID = &value
+	}
+	if value, ok := _c.mutation.LogCreatedAt(); ok {
+		_spec.SetField(restoredauditlog.FieldLogCreatedAt, field.TypeTime, value)
+		_node.LogCreatedAt = &value
+	}
+	if value, ok := _c.mutation.Payload(); ok {
+		_spec.SetField(restoredauditlog.FieldPayload, field.TypeJSON, value)
+		_node.Payload = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RestoredAuditLog.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RestoredAuditLogUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RestoredAuditLogCreate) OnConflict(opts ...sql.ConflictOption) *RestoredAuditLogUpsertOne {
+	_c.conflict = opts
+	return &RestoredAuditLogUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RestoredAuditLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RestoredAuditLogCreate) OnConflictColumns(columns ...string) *RestoredAuditLogUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RestoredAuditLogUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// RestoredAuditLogUpsertOne is the builder for "upsert"-ing
+	//  one RestoredAuditLog node.
+	RestoredAuditLogUpsertOne struct {
+		create *RestoredAuditLogCreate
+	}
+
+	// RestoredAuditLogUpsert is the "OnConflict" setter.
+	RestoredAuditLogUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetArchiveID sets the "archive_id" field.
+func (u *RestoredAuditLogUpsert) SetArchiveID(v uint32) *RestoredAuditLogUpsert {
+	u.Set(restoredauditlog.FieldArchiveID, v)
+	return u
+}
+
+// UpdateArchiveID sets the "archive_id" field to the value that was provided on create.
+func (u *RestoredAuditLogUpsert) UpdateArchiveID() *RestoredAuditLogUpsert {
+	u.SetExcluded(restoredauditlog.FieldArchiveID)
+	return u
+}
+
+// AddArchiveID adds v to the "archive_id" field.
+func (u *RestoredAuditLogUpsert) AddArchiveID(v uint32) *RestoredAuditLogUpsert {
+	u.Add(restoredauditlog.FieldArchiveID, v)
+	return u
+}
+
+// ClearArchiveID clears the value of the "archive_id" field.
+func (u *RestoredAuditLogUpsert) ClearArchiveID() *RestoredAuditLogUpsert {
+	u.SetNull(restoredauditlog.FieldArchiveID)
+	return u
+}
+
+// SetLogType sets the "log_type" field.
+func (u *RestoredAuditLogUpsert) SetLogType(v restoredauditlog.LogType) *RestoredAuditLogUpsert {
+	u.Set(restoredauditlog.FieldLogType, v)
+	return u
+}
+
+// UpdateLogType sets the "log_type" field to the value that was provided on create.
+func (u *RestoredAuditLogUpsert) UpdateLogType() *RestoredAuditLogUpsert {
+	u.SetExcluded(restoredauditlog.FieldLogType)
+	return u
+}
+
+// ClearLogType clears the value of the "log_type" field.
+func (u *RestoredAuditLogUpsert) ClearLogType() *RestoredAuditLogUpsert {
+	u.SetNull(restoredauditlog.FieldLogType)
+	return u
+}
+
+// SetLogID sets the "log_id" field.
+func (u *RestoredAuditLogUpsert) SetLogID(v uint32) *RestoredAuditLogUpsert {
+	u.Set(restoredauditlog.FieldLogID, v)
+	return u
+}
+
+// UpdateLogID sets the "log_id" field to the value that was provided on create.
+func (u *RestoredAuditLogUpsert) UpdateLogID() *RestoredAuditLogUpsert {
+	u.SetExcluded(restoredauditlog.FieldLogID)
+	return u
+}
+
+// AddLogID adds v to the "log_id" field.
+func (u *RestoredAuditLogUpsert) AddLogID(v uint32) *RestoredAuditLogUpsert {
+	u.Add(restoredauditlog.FieldLogID, v)
+	return u
+}
+
+// ClearLogID clears the value of the "log_id" field.
+func (u *RestoredAuditLogUpsert) ClearLogID() *RestoredAuditLogUpsert {
+	u.SetNull(restoredauditlog.FieldLogID)
+	return u
+}
+
+// SetLogCreatedAt sets the "log_created_at" field.
+func (u *RestoredAuditLogUpsert) SetLogCreatedAt(v time.Time) *RestoredAuditLogUpsert {
+	u.Set(restoredauditlog.FieldLogCreatedAt, v)
+	return u
+}
+
+// UpdateLogCreatedAt sets the "log_created_at" field to the value that was provided on create.
+func (u *RestoredAuditLogUpsert) UpdateLogCreatedAt() *RestoredAuditLogUpsert {
+	u.SetExcluded(restoredauditlog.FieldLogCreatedAt)
+	return u
+}
+
+// ClearLogCreatedAt clears the value of the "log_created_at" field.
+func (u *RestoredAuditLogUpsert) ClearLogCreatedAt() *RestoredAuditLogUpsert {
+	u.SetNull(restoredauditlog.FieldLogCreatedAt)
+	return u
+}
+
+// SetPayload sets the "payload" field.
+func (u *RestoredAuditLogUpsert) SetPayload(v map[string]interface{}) *RestoredAuditLogUpsert {
+	u.Set(restoredauditlog.FieldPayload, v)
+	return u
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *RestoredAuditLogUpsert) UpdatePayload() *RestoredAuditLogUpsert {
+	u.SetExcluded(restoredauditlog.FieldPayload)
+	return u
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (u *RestoredAuditLogUpsert) ClearPayload() *RestoredAuditLogUpsert {
+	u.SetNull(restoredauditlog.FieldPayload)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.RestoredAuditLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(restoredauditlog.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RestoredAuditLogUpsertOne) UpdateNewValues() *RestoredAuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(restoredauditlog.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(restoredauditlog.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(restoredauditlog.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RestoredAuditLog.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RestoredAuditLogUpsertOne) Ignore() *RestoredAuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RestoredAuditLogUpsertOne) DoNothing() *RestoredAuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RestoredAuditLogCreate.OnConflict
+// documentation for more info.
+func (u *RestoredAuditLogUpsertOne) Update(set func(*RestoredAuditLogUpsert)) *RestoredAuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RestoredAuditLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetArchiveID sets the "archive_id" field.
+func (u *RestoredAuditLogUpsertOne) SetArchiveID(v uint32) *RestoredAuditLogUpsertOne {
+	return u.Update(func(s *RestoredAuditLogUpsert) {
+		s.SetArchiveID(v)
+	})
+}
+
+// AddArchiveID adds v to the "archive_id" field.
+func (u *RestoredAuditLogUpsertOne) AddArchiveID(v uint32) *RestoredAuditLogUpsertOne {
+	return u.Update(func(s *RestoredAuditLogUpsert) {
+		s.AddArchiveID(v)
+	})
+}
+
+// UpdateArchiveID sets the "archive_id" field to the value that was provided on create.
+func (u *RestoredAuditLogUpsertOne) UpdateArchiveID() *RestoredAuditLogUpsertOne {
+	return u.Update(func(s *RestoredAuditLogUpsert) {
+		s.UpdateArchiveID()
+	})
+}
+
+// ClearArchiveID clears the value of the "archive_id" field.
+func (u *RestoredAuditLogUpsertOne) ClearArchiveID() *RestoredAuditLogUpsertOne {
+	return u.Update(func(s *RestoredAuditLogUpsert) {
+		s.ClearArchiveID()
+	})
+}
+
+// SetLogType sets the "log_type" field.
+func (u *RestoredAuditLogUpsertOne) SetLogType(v restoredauditlog.LogType) *RestoredAuditLogUpsertOne {
+	return u.Update(func(s *RestoredAuditLogUpsert) {
+		s.SetLogType(v)
+	})
+}
+
+// UpdateLogType sets the "log_type" field to the value that was provided on create.
+func (u *RestoredAuditLogUpsertOne) UpdateLogType() *RestoredAuditLogUpsertOne {
+	return u.Update(func(s *RestoredAuditLogUpsert) {
+		s.UpdateLogType()
+	})
+}
+
+// ClearLogType clears the value of the "log_type" field.
+func (u *RestoredAuditLogUpsertOne) ClearLogType() *RestoredAuditLogUpsertOne {
+	return u.Update(func(s *RestoredAuditLogUpsert) {
+		s.ClearLogType()
+	})
+}
+
+// SetLogID sets the "log_id" field.
+func (u *RestoredAuditLogUpsertOne) SetLogID(v uint32) *RestoredAuditLogUpsertOne {
+	return u.Update(func(s *RestoredAuditLogUpsert) {
+		s.SetLogID(v)
+	})
+}
+
+// AddLogID adds v to the "log_id" field.
+func (u *RestoredAuditLogUpsertOne) AddLogID(v uint32) *RestoredAuditLogUpsertOne {
+	return u.Update(func(s *RestoredAuditLogUpsert) {
+		s.AddLogID(v)
+	})
+}
+
+// UpdateLogID sets the "log_id" field to the value that was provided on create.
+func (u *RestoredAuditLogUpsertOne) UpdateLogID() *RestoredAuditLogUpsertOne {
+	return u.Update(func(s *RestoredAuditLogUpsert) {
+		s.UpdateLogID()
+	})
+}
+
+// ClearLogID clears the value of the "log_id" field.
+func (u *RestoredAuditLogUpsertOne) ClearLogID() *RestoredAuditLogUpsertOne {
+	return u.Update(func(s *RestoredAuditLogUpsert) {
+		s.ClearLogID()
+	})
+}
+
+// SetLogCreatedAt sets the "log_created_at" field.
+func (u *RestoredAuditLogUpsertOne) SetLogCreatedAt(v time.Time) *RestoredAuditLogUpsertOne {
+	return u.Update(func(s *RestoredAuditLogUpsert) {
+		s.SetLogCreatedAt(v)
+	})
+}
+
+// UpdateLogCreatedAt sets the "log_created_at" field to the value that was provided on create.
+func (u *RestoredAuditLogUpsertOne) UpdateLogCreatedAt() *RestoredAuditLogUpsertOne {
+	return u.Update(func(s *RestoredAuditLogUpsert) {
+		s.UpdateLogCreatedAt()
+	})
+}
+
+// ClearLogCreatedAt clears the value of the "log_created_at" field.
+func (u *RestoredAuditLogUpsertOne) ClearLogCreatedAt() *RestoredAuditLogUpsertOne {
+	return u.Update(func(s *RestoredAuditLogUpsert) {
+		s.ClearLogCreatedAt()
+	})
+}
+
+// SetPayload sets the "payload" field.
+func (u *RestoredAuditLogUpsertOne) SetPayload(v map[string]interface{}) *RestoredAuditLogUpsertOne {
+	return u.Update(func(s *RestoredAuditLogUpsert) {
+		s.SetPayload(v)
+	})
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *RestoredAuditLogUpsertOne) UpdatePayload() *RestoredAuditLogUpsertOne {
+	return u.Update(func(s *RestoredAuditLogUpsert) {
+		s.UpdatePayload()
+	})
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (u *RestoredAuditLogUpsertOne) ClearPayload() *RestoredAuditLogUpsertOne {
+	return u.Update(func(s *RestoredAuditLogUpsert) {
+		s.ClearPayload()
+	})
+}
+
+// Exec executes the query.
+func (u *RestoredAuditLogUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RestoredAuditLogCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RestoredAuditLogUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RestoredAuditLogUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RestoredAuditLogUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// RestoredAuditLogCreateBulk is the builder for creating many RestoredAuditLog entities in bulk.
+type RestoredAuditLogCreateBulk struct {
+	config
+	err      error
+	builders []*RestoredAuditLogCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the RestoredAuditLog entities in the database.
+func (_c *RestoredAuditLogCreateBulk) Save(ctx context.Context) ([]*RestoredAuditLog, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RestoredAuditLog, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RestoredAuditLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RestoredAuditLogCreateBulk) SaveX(ctx context.Context) []*RestoredAuditLog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RestoredAuditLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RestoredAuditLogCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RestoredAuditLog.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RestoredAuditLogUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RestoredAuditLogCreateBulk) OnConflict(opts ...sql.ConflictOption) *RestoredAuditLogUpsertBulk {
+	_c.conflict = opts
+	return &RestoredAuditLogUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RestoredAuditLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RestoredAuditLogCreateBulk) OnConflictColumns(columns ...string) *RestoredAuditLogUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RestoredAuditLogUpsertBulk{
+		create: _c,
+	}
+}
+
+// RestoredAuditLogUpsertBulk is the builder for "upsert"-ing
+// a bulk of RestoredAuditLog nodes.
+type RestoredAuditLogUpsertBulk struct {
+	create *RestoredAuditLogCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.RestoredAuditLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(restoredauditlog.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RestoredAuditLogUpsertBulk) UpdateNewValues() *RestoredAuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(restoredauditlog.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(restoredauditlog.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(restoredauditlog.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RestoredAuditLog.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RestoredAuditLogUpsertBulk) Ignore() *RestoredAuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RestoredAuditLogUpsertBulk) DoNothing() *RestoredAuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RestoredAuditLogCreateBulk.OnConflict
+// documentation for more info.
+func (u *RestoredAuditLogUpsertBulk) Update(set func(*RestoredAuditLogUpsert)) *RestoredAuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RestoredAuditLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetArchiveID sets the "archive_id" field.
+func (u *RestoredAuditLogUpsertBulk) SetArchiveID(v uint32) *RestoredAuditLogUpsertBulk {
+	return u.Update(func(s *RestoredAuditLogUpsert) {
+		s.SetArchiveID(v)
+	})
+}
+
+// AddArchiveID adds v to the "archive_id" field.
+func (u *RestoredAuditLogUpsertBulk) AddArchiveID(v uint32) *RestoredAuditLogUpsertBulk {
+	return u.Update(func(s *RestoredAuditLogUpsert) {
+		s.AddArchiveID(v)
+	})
+}
+
+// UpdateArchiveID sets the "archive_id" field to the value that was provided on create.
+func (u *RestoredAuditLogUpsertBulk) UpdateArchiveID() *RestoredAuditLogUpsertBulk {
+	return u.Update(func(s *RestoredAuditLogUpsert) {
+		s.UpdateArchiveID()
+	})
+}
+
+// ClearArchiveID clears the value of the "archive_id" field.
+func (u *RestoredAuditLogUpsertBulk) ClearArchiveID() *RestoredAuditLogUpsertBulk {
+	return u.Update(func(s *RestoredAuditLogUpsert) {
+		s.ClearArchiveID()
+	})
+}
+
+// SetLogType sets the "log_type" field.
+func (u *RestoredAuditLogUpsertBulk) SetLogType(v restoredauditlog.LogType) *RestoredAuditLogUpsertBulk {
+	return u.Update(func(s *RestoredAuditLogUpsert) {
+		s.SetLogType(v)
+	})
+}
+
+// UpdateLogType sets the "log_type" field to the value that was provided on create.
+func (u *RestoredAuditLogUpsertBulk) UpdateLogType() *RestoredAuditLogUpsertBulk {
+	return u.Update(func(s *RestoredAuditLogUpsert) {
+		s.UpdateLogType()
+	})
+}
+
+// ClearLogType clears the value of the "log_type" field.
+func (u *RestoredAuditLogUpsertBulk) ClearLogType() *RestoredAuditLogUpsertBulk {
+	return u.Update(func(s *RestoredAuditLogUpsert) {
+		s.ClearLogType()
+	})
+}
+
+// SetLogID sets the "log_id" field.
+func (u *RestoredAuditLogUpsertBulk) SetLogID(v uint32) *RestoredAuditLogUpsertBulk {
+	return u.Update(func(s *RestoredAuditLogUpsert) {
+		s.SetLogID(v)
+	})
+}
+
+// AddLogID adds v to the "log_id" field.
+func (u *RestoredAuditLogUpsertBulk) AddLogID(v uint32) *RestoredAuditLogUpsertBulk {
+	return u.Update(func(s *RestoredAuditLogUpsert) {
+		s.AddLogID(v)
+	})
+}
+
+// UpdateLogID sets the "log_id" field to the value that was provided on create.
+func (u *RestoredAuditLogUpsertBulk) UpdateLogID() *RestoredAuditLogUpsertBulk {
+	return u.Update(func(s *RestoredAuditLogUpsert) {
+		s.UpdateLogID()
+	})
+}
+
+// ClearLogID clears the value of the "log_id" field.
+func (u *RestoredAuditLogUpsertBulk) ClearLogID() *RestoredAuditLogUpsertBulk {
+	return u.Update(func(s *RestoredAuditLogUpsert) {
+		s.ClearLogID()
+	})
+}
+
+// SetLogCreatedAt sets the "log_created_at" field.
+func (u *RestoredAuditLogUpsertBulk) SetLogCreatedAt(v time.Time) *RestoredAuditLogUpsertBulk {
+	return u.Update(func(s *RestoredAuditLogUpsert) {
+		s.SetLogCreatedAt(v)
+	})
+}
+
+// UpdateLogCreatedAt sets the "log_created_at" field to the value that was provided on create.
+func (u *RestoredAuditLogUpsertBulk) UpdateLogCreatedAt() *RestoredAuditLogUpsertBulk {
+	return u.Update(func(s *RestoredAuditLogUpsert) {
+		s.UpdateLogCreatedAt()
+	})
+}
+
+// ClearLogCreatedAt clears the value of the "log_created_at" field.
+func (u *RestoredAuditLogUpsertBulk) ClearLogCreatedAt() *RestoredAuditLogUpsertBulk {
+	return u.Update(func(s *RestoredAuditLogUpsert) {
+		s.ClearLogCreatedAt()
+	})
+}
+
+// SetPayload sets the "payload" field.
+func (u *RestoredAuditLogUpsertBulk) SetPayload(v map[string]interface{}) *RestoredAuditLogUpsertBulk {
+	return u.Update(func(s *RestoredAuditLogUpsert) {
+		s.SetPayload(v)
+	})
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *RestoredAuditLogUpsertBulk) UpdatePayload() *RestoredAuditLogUpsertBulk {
+	return u.Update(func(s *RestoredAuditLogUpsert) {
+		s.UpdatePayload()
+	})
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (u *RestoredAuditLogUpsertBulk) ClearPayload() *RestoredAuditLogUpsertBulk {
+	return u.Update(func(s *RestoredAuditLogUpsert) {
+		s.ClearPayload()
+	})
+}
+
+// Exec executes the query.
+func (u *RestoredAuditLogUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RestoredAuditLogCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RestoredAuditLogCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RestoredAuditLogUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,514 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"go-wind-admin/app/admin/service/internal/data/ent/restoredauditlog"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RestoredAuditLogUpdate is the builder for updating RestoredAuditLog entities.
+type RestoredAuditLogUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *RestoredAuditLogMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the RestoredAuditLogUpdate builder.
+func (_u *RestoredAuditLogUpdate) Where(ps ...predicate.RestoredAuditLog) *RestoredAuditLogUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetArchiveID sets the "archive_id" field.
+func (_u *RestoredAuditLogUpdate) SetArchiveID(v uint32) *RestoredAuditLogUpdate {
+	_u.mutation.ResetArchiveID()
+	_u.mutation.SetArchiveID(v)
+	return _u
+}
+
+// SetNillableArchiveID sets the "archive_id" field if the given value is not nil.
+func (_u *RestoredAuditLogUpdate) SetNillableArchiveID(v *uint32) *RestoredAuditLogUpdate {
+	if v != nil {
+		_u.SetArchiveID(*v)
+	}
+	return _u
+}
+
+// AddArchiveID adds value to the "archive_id" field.
+func (_u *RestoredAuditLogUpdate) AddArchiveID(v int32) *RestoredAuditLogUpdate {
+	_u.mutation.AddArchiveID(v)
+	return _u
+}
+
+// ClearArchiveID clears the value of the "archive_id" field.
+func (_u *RestoredAuditLogUpdate) ClearArchiveID() *RestoredAuditLogUpdate {
+	_u.mutation.ClearArchiveID()
+	return _u
+}
+
+// SetLogType sets the "log_type" field.
+func (_u *RestoredAuditLogUpdate) SetLogType(v restoredauditlog.LogType) *RestoredAuditLogUpdate {
+	_u.mutation.SetLogType(v)
+	return _u
+}
+
+// SetNillableLogType sets the "log_type" field if the given value is not nil.
+func (_u *RestoredAuditLogUpdate) SetNillableLogType(v *restoredauditlog.LogType) *RestoredAuditLogUpdate {
+	if v != nil {
+		_u.SetLogType(*v)
+	}
+	return _u
+}
+
+// ClearLogType clears the value of the "log_type" field.
+func (_u *RestoredAuditLogUpdate) ClearLogType() *RestoredAuditLogUpdate {
+	_u.mutation.ClearLogType()
+	return _u
+}
+
+// SetLogID sets the "log_id" field.
+func (_u *RestoredAuditLogUpdate) SetLogID(v uint32) *RestoredAuditLogUpdate {
+	_u.mutation.ResetLogID()
+	_u.mutation.SetLogID(v)
+	return _u
+}
+
+// SetNillableLogID sets the "log_id" field if the given value is not nil.
+func (_u *RestoredAuditLogUpdate) SetNillableLogID(v *uint32) *RestoredAuditLogUpdate {
+	if v != nil {
+		_u.SetLogID(*v)
+	}
+	return _u
+}
+
+// AddLogID adds value to the "log_id" field.
+func (_u *RestoredAuditLogUpdate) AddLogID(v int32) *RestoredAuditLogUpdate {
+	_u.mutation.AddLogID(v)
+	return _u
+}
+
+// ClearLogID clears the value of the "log_id" field.
+func (_u *RestoredAuditLogUpdate) ClearLogID() *RestoredAuditLogUpdate {
+	_u.mutation.ClearLogID()
+	return _u
+}
+
+// SetLogCreatedAt sets the "log_created_at" field.
+func (_u *RestoredAuditLogUpdate) SetLogCreatedAt(v time.Time) *RestoredAuditLogUpdate {
+	_u.mutation.SetLogCreatedAt(v)
+	return _u
+}
+
+// SetNillableLogCreatedAt sets the "log_created_at" field if the given value is not nil.
+func (_u *RestoredAuditLogUpdate) SetNillableLogCreatedAt(v *time.Time) *RestoredAuditLogUpdate {
+	if v != nil {
+		_u.SetLogCreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearLogCreatedAt clears the value of the "log_created_at" field.
+func (_u *RestoredAuditLogUpdate) ClearLogCreatedAt() *RestoredAuditLogUpdate {
+	_u.mutation.ClearLogCreatedAt()
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *RestoredAuditLogUpdate) SetPayload(v map[string]interface{}) *RestoredAuditLogUpdate {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (_u *RestoredAuditLogUpdate) ClearPayload() *RestoredAuditLogUpdate {
+	_u.mutation.ClearPayload()
+	return _u
+}
+
+// Mutation returns the RestoredAuditLogMutation object of the builder.
+func (_u *RestoredAuditLogUpdate) Mutation() *RestoredAuditLogMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RestoredAuditLogUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RestoredAuditLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RestoredAuditLogUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RestoredAuditLogUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RestoredAuditLogUpdate) check() error {
+	if v, ok := _u.mutation.LogType(); ok {
+		if err := restoredauditlog.LogTypeValidator(v); err != nil {
+			return &ValidationError{Name: "log_type", err: fmt.Errorf(`ent: validator failed for field "RestoredAuditLog.log_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *RestoredAuditLogUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RestoredAuditLogUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *RestoredAuditLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(restoredauditlog.Table, restoredauditlog.Columns, sqlgraph.NewFieldSpec(restoredauditlog.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(restoredauditlog.FieldCreatedAt, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(restoredauditlog.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ArchiveID(); ok {
+		_spec.SetField(restoredauditlog.FieldArchiveID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedArchiveID(); ok {
+		_spec.AddField(restoredauditlog.FieldArchiveID, field.TypeUint32, value)
+	}
+	if _u.mutation.ArchiveIDCleared() {
+		_spec.ClearField(restoredauditlog.FieldArchiveID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.LogType(); ok {
+		_spec.SetField(restoredauditlog.FieldLogType, field.TypeEnum, value)
+	}
+	if _u.mutation.LogTypeCleared() {
+		_spec.ClearField(restoredauditlog.FieldLogType, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.LogID(); ok {
+		_spec.SetField(restoredauditlog.FieldLogID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedLogID(); ok {
+		_spec.AddField(restoredauditlog.FieldLogID, field.TypeUint32, value)
+	}
+	if _u.mutation.LogIDCleared() {
+		_spec.ClearField(restoredauditlog.FieldLogID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.LogCreatedAt(); ok {
+		_spec.SetField(restoredauditlog.FieldLogCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LogCreatedAtCleared() {
+		_spec.ClearField(restoredauditlog.FieldLogCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(restoredauditlog.FieldPayload, field.TypeJSON, value)
+	}
+	if _u.mutation.PayloadCleared() {
+		_spec.ClearField(restoredauditlog.FieldPayload, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{restoredauditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RestoredAuditLogUpdateOne is the builder for updating a single RestoredAuditLog entity.
+type RestoredAuditLogUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *RestoredAuditLogMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetArchiveID sets the "archive_id" field.
+func (_u *RestoredAuditLogUpdateOne) SetArchiveID(v uint32) *RestoredAuditLogUpdateOne {
+	_u.mutation.ResetArchiveID()
+	_u.mutation.SetArchiveID(v)
+	return _u
+}
+
+// SetNillableArchiveID sets the "archive_id" field if the given value is not nil.
+func (_u *RestoredAuditLogUpdateOne) SetNillableArchiveID(v *uint32) *RestoredAuditLogUpdateOne {
+	if v != nil {
+		_u.SetArchiveID(*v)
+	}
+	return _u
+}
+
+// AddArchiveID adds value to the "archive_id" field.
+func (_u *RestoredAuditLogUpdateOne) AddArchiveID(v int32) *RestoredAuditLogUpdateOne {
+	_u.mutation.AddArchiveID(v)
+	return _u
+}
+
+// ClearArchiveID clears the value of the "archive_id" field.
+func (_u *RestoredAuditLogUpdateOne) ClearArchiveID() *RestoredAuditLogUpdateOne {
+	_u.mutation.ClearArchiveID()
+	return _u
+}
+
+// SetLogType sets the "log_type" field.
+func (_u *RestoredAuditLogUpdateOne) SetLogType(v restoredauditlog.LogType) *RestoredAuditLogUpdateOne {
+	_u.mutation.SetLogType(v)
+	return _u
+}
+
+// SetNillableLogType sets the "log_type" field if the given value is not nil.
+func (_u *RestoredAuditLogUpdateOne) SetNillableLogType(v *restoredauditlog.LogType) *RestoredAuditLogUpdateOne {
+	if v != nil {
+		_u.SetLogType(*v)
+	}
+	return _u
+}
+
+// ClearLogType clears the value of the "log_type" field.
+func (_u *RestoredAuditLogUpdateOne) ClearLogType() *RestoredAuditLogUpdateOne {
+	_u.mutation.ClearLogType()
+	return _u
+}
+
+// SetLogID sets the "log_id" field.
+func (_u *RestoredAuditLogUpdateOne) SetLogID(v uint32) *RestoredAuditLogUpdateOne {
+	_u.mutation.ResetLogID()
+	_u.mutation.SetLogID(v)
+	return _u
+}
+
+// SetNillableLogID sets the "log_id" field if the given value is not nil.
+func (_u *RestoredAuditLogUpdateOne) SetNillableLogID(v *uint32) *RestoredAuditLogUpdateOne {
+	if v != nil {
+		_u.SetLogID(*v)
+	}
+	return _u
+}
+
+// AddLogID adds value to the "log_id" field.
+func (_u *RestoredAuditLogUpdateOne) AddLogID(v int32) *RestoredAuditLogUpdateOne {
+	_u.mutation.AddLogID(v)
+	return _u
+}
+
+// ClearLogID clears the value of the "log_id" field.
+func (_u *RestoredAuditLogUpdateOne) ClearLogID() *RestoredAuditLogUpdateOne {
+	_u.mutation.ClearLogID()
+	return _u
+}
+
+// SetLogCreatedAt sets the "log_created_at" field.
+func (_u *RestoredAuditLogUpdateOne) SetLogCreatedAt(v time.Time) *RestoredAuditLogUpdateOne {
+	_u.mutation.SetLogCreatedAt(v)
+	return _u
+}
+
+// SetNillableLogCreatedAt sets the "log_created_at" field if the given value is not nil.
+func (_u *RestoredAuditLogUpdateOne) SetNillableLogCreatedAt(v *time.Time) *RestoredAuditLogUpdateOne {
+	if v != nil {
+		_u.SetLogCreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearLogCreatedAt clears the value of the "log_created_at" field.
+func (_u *RestoredAuditLogUpdateOne) ClearLogCreatedAt() *RestoredAuditLogUpdateOne {
+	_u.mutation.ClearLogCreatedAt()
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *RestoredAuditLogUpdateOne) SetPayload(v map[string]interface{}) *RestoredAuditLogUpdateOne {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (_u *RestoredAuditLogUpdateOne) ClearPayload() *RestoredAuditLogUpdateOne {
+	_u.mutation.ClearPayload()
+	return _u
+}
+
+// Mutation returns the RestoredAuditLogMutation object of the builder.
+func (_u *RestoredAuditLogUpdateOne) Mutation() *RestoredAuditLogMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the RestoredAuditLogUpdate builder.
+func (_u *RestoredAuditLogUpdateOne) Where(ps ...predicate.RestoredAuditLog) *RestoredAuditLogUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RestoredAuditLogUpdateOne) Select(field string, fields ...string) *RestoredAuditLogUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RestoredAuditLog entity.
+func (_u *RestoredAuditLogUpdateOne) Save(ctx context.Context) (*RestoredAuditLog, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RestoredAuditLogUpdateOne) SaveX(ctx context.Context) *RestoredAuditLog {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RestoredAuditLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RestoredAuditLogUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RestoredAuditLogUpdateOne) check() error {
+	if v, ok := _u.mutation.LogType(); ok {
+		if err := restoredauditlog.LogTypeValidator(v); err != nil {
+			return &ValidationError{Name: "log_type", err: fmt.Errorf(`ent: validator failed for field "RestoredAuditLog.log_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *RestoredAuditLogUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RestoredAuditLogUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *RestoredAuditLogUpdateOne) sqlSave(ctx context.Context) (_node *RestoredAuditLog, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(restoredauditlog.Table, restoredauditlog.Columns, sqlgraph.NewFieldSpec(restoredauditlog.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RestoredAuditLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, restoredauditlog.FieldID)
+		for _, f := range fields {
+			if !restoredauditlog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != restoredauditlog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(restoredauditlog.FieldCreatedAt, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(restoredauditlog.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ArchiveID(); ok {
+		_spec.SetField(restoredauditlog.FieldArchiveID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedArchiveID(); ok {
+		_spec.AddField(restoredauditlog.FieldArchiveID, field.TypeUint32, value)
+	}
+	if _u.mutation.ArchiveIDCleared() {
+		_spec.ClearField(restoredauditlog.FieldArchiveID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.LogType(); ok {
+		_spec.SetField(restoredauditlog.FieldLogType, field.TypeEnum, value)
+	}
+	if _u.mutation.LogTypeCleared() {
+		_spec.ClearField(restoredauditlog.FieldLogType, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.LogID(); ok {
+		_spec.SetField(restoredauditlog.FieldLogID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedLogID(); ok {
+		_spec.AddField(restoredauditlog.FieldLogID, field.TypeUint32, value)
+	}
+	if _u.mutation.LogIDCleared() {
+		_spec.ClearField(restoredauditlog.FieldLogID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.LogCreatedAt(); ok {
+		_spec.SetField(restoredauditlog.FieldLogCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LogCreatedAtCleared() {
+		_spec.ClearField(restoredauditlog.FieldLogCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(restoredauditlog.FieldPayload, field.TypeJSON, value)
+	}
+	if _u.mutation.PayloadCleared() {
+		_spec.ClearField(restoredauditlog.FieldPayload, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &RestoredAuditLog{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{restoredauditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
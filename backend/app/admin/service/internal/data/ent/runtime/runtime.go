@@ -7,17 +7,23 @@ import (
 	permissionpb "go-wind-admin/api/gen/go/permission/service/v1"
 	"go-wind-admin/app/admin/service/internal/data/ent/api"
 	"go-wind-admin/app/admin/service/internal/data/ent/apiauditlog"
+	"go-wind-admin/app/admin/service/internal/data/ent/auditforwarder"
+	"go-wind-admin/app/admin/service/internal/data/ent/auditlogarchive"
+	"go-wind-admin/app/admin/service/internal/data/ent/auditlogretentionpolicy"
 	"go-wind-admin/app/admin/service/internal/data/ent/dataaccessauditlog"
 	"go-wind-admin/app/admin/service/internal/data/ent/dictentry"
 	"go-wind-admin/app/admin/service/internal/data/ent/dictentryi18n"
 	"go-wind-admin/app/admin/service/internal/data/ent/dicttype"
 	"go-wind-admin/app/admin/service/internal/data/ent/file"
+	"go-wind-admin/app/admin/service/internal/data/ent/filesharelink"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessage"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagecategory"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagerecipient"
 	"go-wind-admin/app/admin/service/internal/data/ent/language"
 	"go-wind-admin/app/admin/service/internal/data/ent/loginauditlog"
 	"go-wind-admin/app/admin/service/internal/data/ent/loginpolicy"
+	"go-wind-admin/app/admin/service/internal/data/ent/luascript"
+	"go-wind-admin/app/admin/service/internal/data/ent/luascriptversion"
 	"go-wind-admin/app/admin/service/internal/data/ent/membership"
 	"go-wind-admin/app/admin/service/internal/data/ent/membershiporgunit"
 	"go-wind-admin/app/admin/service/internal/data/ent/membershipposition"
@@ -33,11 +39,17 @@ import (
 	"go-wind-admin/app/admin/service/internal/data/ent/permissionpolicy"
 	"go-wind-admin/app/admin/service/internal/data/ent/policyevaluationlog"
 	"go-wind-admin/app/admin/service/internal/data/ent/position"
+	"go-wind-admin/app/admin/service/internal/data/ent/restoredauditlog"
 	"go-wind-admin/app/admin/service/internal/data/ent/role"
 	"go-wind-admin/app/admin/service/internal/data/ent/rolemetadata"
 	"go-wind-admin/app/admin/service/internal/data/ent/rolepermission"
 	"go-wind-admin/app/admin/service/internal/data/ent/schema"
+	"go-wind-admin/app/admin/service/internal/data/ent/storageobject"
+	"go-wind-admin/app/admin/service/internal/data/ent/storagequota"
 	"go-wind-admin/app/admin/service/internal/data/ent/task"
+	"go-wind-admin/app/admin/service/internal/data/ent/taskrun"
+	"go-wind-admin/app/admin/service/internal/data/ent/taskworkflow"
+	"go-wind-admin/app/admin/service/internal/data/ent/taskworkflowrun"
 	"go-wind-admin/app/admin/service/internal/data/ent/tenant"
 	"go-wind-admin/app/admin/service/internal/data/ent/user"
 	"go-wind-admin/app/admin/service/internal/data/ent/usercredential"
@@ -88,6 +100,134 @@ func init() {
 	apiauditlogDescID := apiauditlogMixinFields0[0].Descriptor()
 	// apiauditlog.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	apiauditlog.IDValidator = apiauditlogDescID.Validators[0].(func(uint32) error)
+	auditforwarderMixin := schema.AuditForwarder{}.Mixin()
+	auditforwarder.Policy = privacy.NewPolicies(auditforwarderMixin[4], schema.AuditForwarder{})
+	auditforwarder.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := auditforwarder.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	auditforwarderMixinFields0 := auditforwarderMixin[0].Fields()
+	_ = auditforwarderMixinFields0
+	auditforwarderMixinFields4 := auditforwarderMixin[4].Fields()
+	_ = auditforwarderMixinFields4
+	auditforwarderFields := schema.AuditForwarder{}.Fields()
+	_ = auditforwarderFields
+	// auditforwarderDescTenantID is the schema descriptor for tenant_id field.
+	auditforwarderDescTenantID := auditforwarderMixinFields4[0].Descriptor()
+	// auditforwarder.DefaultTenantID holds the default value on creation for the tenant_id field.
+	auditforwarder.DefaultTenantID = auditforwarderDescTenantID.Default.(uint32)
+	// auditforwarderDescName is the schema descriptor for name field.
+	auditforwarderDescName := auditforwarderFields[0].Descriptor()
+	// auditforwarder.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	auditforwarder.NameValidator = auditforwarderDescName.Validators[0].(func(string) error)
+	// auditforwarderDescEnabled is the schema descriptor for enabled field.
+	auditforwarderDescEnabled := auditforwarderFields[1].Descriptor()
+	// auditforwarder.DefaultEnabled holds the default value on creation for the enabled field.
+	auditforwarder.DefaultEnabled = auditforwarderDescEnabled.Default.(bool)
+	// auditforwarderDescEndpoint is the schema descriptor for endpoint field.
+	auditforwarderDescEndpoint := auditforwarderFields[4].Descriptor()
+	// auditforwarder.EndpointValidator is a validator for the "endpoint" field. It is called by the builders before save.
+	auditforwarder.EndpointValidator = auditforwarderDescEndpoint.Validators[0].(func(string) error)
+	// auditforwarderDescSecret is the schema descriptor for secret field.
+	auditforwarderDescSecret := auditforwarderFields[7].Descriptor()
+	// auditforwarder.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	auditforwarder.SecretValidator = auditforwarderDescSecret.Validators[0].(func(string) error)
+	// auditforwarderDescTLSInsecureSkipVerify is the schema descriptor for tls_insecure_skip_verify field.
+	auditforwarderDescTLSInsecureSkipVerify := auditforwarderFields[9].Descriptor()
+	// auditforwarder.DefaultTLSInsecureSkipVerify holds the default value on creation for the tls_insecure_skip_verify field.
+	auditforwarder.DefaultTLSInsecureSkipVerify = auditforwarderDescTLSInsecureSkipVerify.Default.(bool)
+	// auditforwarderDescTLSServerName is the schema descriptor for tls_server_name field.
+	auditforwarderDescTLSServerName := auditforwarderFields[11].Descriptor()
+	// auditforwarder.TLSServerNameValidator is a validator for the "tls_server_name" field. It is called by the builders before save.
+	auditforwarder.TLSServerNameValidator = auditforwarderDescTLSServerName.Validators[0].(func(string) error)
+	// auditforwarderDescAppName is the schema descriptor for app_name field.
+	auditforwarderDescAppName := auditforwarderFields[12].Descriptor()
+	// auditforwarder.AppNameValidator is a validator for the "app_name" field. It is called by the builders before save.
+	auditforwarder.AppNameValidator = auditforwarderDescAppName.Validators[0].(func(string) error)
+	// auditforwarderDescFacility is the schema descriptor for facility field.
+	auditforwarderDescFacility := auditforwarderFields[13].Descriptor()
+	// auditforwarder.DefaultFacility holds the default value on creation for the facility field.
+	auditforwarder.DefaultFacility = auditforwarderDescFacility.Default.(uint32)
+	// auditforwarderDescMaxBufferBytes is the schema descriptor for max_buffer_bytes field.
+	auditforwarderDescMaxBufferBytes := auditforwarderFields[14].Descriptor()
+	// auditforwarder.DefaultMaxBufferBytes holds the default value on creation for the max_buffer_bytes field.
+	auditforwarder.DefaultMaxBufferBytes = auditforwarderDescMaxBufferBytes.Default.(uint64)
+	// auditforwarderDescID is the schema descriptor for id field.
+	auditforwarderDescID := auditforwarderMixinFields0[0].Descriptor()
+	// auditforwarder.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	auditforwarder.IDValidator = auditforwarderDescID.Validators[0].(func(uint32) error)
+	auditlogarchiveMixin := schema.AuditLogArchive{}.Mixin()
+	auditlogarchive.Policy = privacy.NewPolicies(auditlogarchiveMixin[2], schema.AuditLogArchive{})
+	auditlogarchive.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := auditlogarchive.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	auditlogarchiveMixinFields0 := auditlogarchiveMixin[0].Fields()
+	_ = auditlogarchiveMixinFields0
+	auditlogarchiveMixinFields2 := auditlogarchiveMixin[2].Fields()
+	_ = auditlogarchiveMixinFields2
+	auditlogarchiveFields := schema.AuditLogArchive{}.Fields()
+	_ = auditlogarchiveFields
+	// auditlogarchiveDescTenantID is the schema descriptor for tenant_id field.
+	auditlogarchiveDescTenantID := auditlogarchiveMixinFields2[0].Descriptor()
+	// auditlogarchive.DefaultTenantID holds the default value on creation for the tenant_id field.
+	auditlogarchive.DefaultTenantID = auditlogarchiveDescTenantID.Default.(uint32)
+	// auditlogarchiveDescSha256 is the schema descriptor for sha256 field.
+	auditlogarchiveDescSha256 := auditlogarchiveFields[5].Descriptor()
+	// auditlogarchive.Sha256Validator is a validator for the "sha256" field. It is called by the builders before save.
+	auditlogarchive.Sha256Validator = auditlogarchiveDescSha256.Validators[0].(func(string) error)
+	// auditlogarchiveDescID is the schema descriptor for id field.
+	auditlogarchiveDescID := auditlogarchiveMixinFields0[0].Descriptor()
+	// auditlogarchive.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	auditlogarchive.IDValidator = auditlogarchiveDescID.Validators[0].(func(uint32) error)
+	auditlogretentionpolicyMixin := schema.AuditLogRetentionPolicy{}.Mixin()
+	auditlogretentionpolicy.Policy = privacy.NewPolicies(auditlogretentionpolicyMixin[4], schema.AuditLogRetentionPolicy{})
+	auditlogretentionpolicy.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := auditlogretentionpolicy.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	auditlogretentionpolicyMixinFields0 := auditlogretentionpolicyMixin[0].Fields()
+	_ = auditlogretentionpolicyMixinFields0
+	auditlogretentionpolicyMixinFields4 := auditlogretentionpolicyMixin[4].Fields()
+	_ = auditlogretentionpolicyMixinFields4
+	auditlogretentionpolicyFields := schema.AuditLogRetentionPolicy{}.Fields()
+	_ = auditlogretentionpolicyFields
+	// auditlogretentionpolicyDescTenantID is the schema descriptor for tenant_id field.
+	auditlogretentionpolicyDescTenantID := auditlogretentionpolicyMixinFields4[0].Descriptor()
+	// auditlogretentionpolicy.DefaultTenantID holds the default value on creation for the tenant_id field.
+	auditlogretentionpolicy.DefaultTenantID = auditlogretentionpolicyDescTenantID.Default.(uint32)
+	// auditlogretentionpolicyDescRetentionDays is the schema descriptor for retention_days field.
+	auditlogretentionpolicyDescRetentionDays := auditlogretentionpolicyFields[1].Descriptor()
+	// auditlogretentionpolicy.DefaultRetentionDays holds the default value on creation for the retention_days field.
+	auditlogretentionpolicy.DefaultRetentionDays = auditlogretentionpolicyDescRetentionDays.Default.(uint32)
+	// auditlogretentionpolicyDescArchiveEnabled is the schema descriptor for archive_enabled field.
+	auditlogretentionpolicyDescArchiveEnabled := auditlogretentionpolicyFields[2].Descriptor()
+	// auditlogretentionpolicy.DefaultArchiveEnabled holds the default value on creation for the archive_enabled field.
+	auditlogretentionpolicy.DefaultArchiveEnabled = auditlogretentionpolicyDescArchiveEnabled.Default.(bool)
+	// auditlogretentionpolicyDescEnabled is the schema descriptor for enabled field.
+	auditlogretentionpolicyDescEnabled := auditlogretentionpolicyFields[3].Descriptor()
+	// auditlogretentionpolicy.DefaultEnabled holds the default value on creation for the enabled field.
+	auditlogretentionpolicy.DefaultEnabled = auditlogretentionpolicyDescEnabled.Default.(bool)
+	// auditlogretentionpolicyDescBatchSize is the schema descriptor for batch_size field.
+	auditlogretentionpolicyDescBatchSize := auditlogretentionpolicyFields[4].Descriptor()
+	// auditlogretentionpolicy.DefaultBatchSize holds the default value on creation for the batch_size field.
+	auditlogretentionpolicy.DefaultBatchSize = auditlogretentionpolicyDescBatchSize.Default.(uint32)
+	// auditlogretentionpolicyDescID is the schema descriptor for id field.
+	auditlogretentionpolicyDescID := auditlogretentionpolicyMixinFields0[0].Descriptor()
+	// auditlogretentionpolicy.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	auditlogretentionpolicy.IDValidator = auditlogretentionpolicyDescID.Validators[0].(func(uint32) error)
 	dataaccessauditlogMixin := schema.DataAccessAuditLog{}.Mixin()
 	dataaccessauditlog.Policy = privacy.NewPolicies(dataaccessauditlogMixin[2], schema.DataAccessAuditLog{})
 	dataaccessauditlog.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -254,10 +394,54 @@ func init() {
 	fileDescTenantID := fileMixinFields4[0].Descriptor()
 	// file.DefaultTenantID holds the default value on creation for the tenant_id field.
 	file.DefaultTenantID = fileDescTenantID.Default.(uint32)
+	// fileDescVersion is the schema descriptor for version field.
+	fileDescVersion := fileFields[16].Descriptor()
+	// file.DefaultVersion holds the default value on creation for the version field.
+	file.DefaultVersion = fileDescVersion.Default.(uint32)
+	// fileDescIsLatest is the schema descriptor for is_latest field.
+	fileDescIsLatest := fileFields[17].Descriptor()
+	// file.DefaultIsLatest holds the default value on creation for the is_latest field.
+	file.DefaultIsLatest = fileDescIsLatest.Default.(bool)
 	// fileDescID is the schema descriptor for id field.
 	fileDescID := fileMixinFields0[0].Descriptor()
 	// file.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	file.IDValidator = fileDescID.Validators[0].(func(uint32) error)
+	filesharelinkMixin := schema.FileShareLink{}.Mixin()
+	filesharelink.Policy = privacy.NewPolicies(filesharelinkMixin[3], schema.FileShareLink{})
+	filesharelink.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := filesharelink.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	filesharelinkMixinFields0 := filesharelinkMixin[0].Fields()
+	_ = filesharelinkMixinFields0
+	filesharelinkMixinFields3 := filesharelinkMixin[3].Fields()
+	_ = filesharelinkMixinFields3
+	filesharelinkFields := schema.FileShareLink{}.Fields()
+	_ = filesharelinkFields
+	// filesharelinkDescTenantID is the schema descriptor for tenant_id field.
+	filesharelinkDescTenantID := filesharelinkMixinFields3[0].Descriptor()
+	// filesharelink.DefaultTenantID holds the default value on creation for the tenant_id field.
+	filesharelink.DefaultTenantID = filesharelinkDescTenantID.Default.(uint32)
+	// filesharelinkDescToken is the schema descriptor for token field.
+	filesharelinkDescToken := filesharelinkFields[1].Descriptor()
+	// filesharelink.TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	filesharelink.TokenValidator = filesharelinkDescToken.Validators[0].(func(string) error)
+	// filesharelinkDescMaxDownloads is the schema descriptor for max_downloads field.
+	filesharelinkDescMaxDownloads := filesharelinkFields[4].Descriptor()
+	// filesharelink.DefaultMaxDownloads holds the default value on creation for the max_downloads field.
+	filesharelink.DefaultMaxDownloads = filesharelinkDescMaxDownloads.Default.(uint32)
+	// filesharelinkDescDownloadCount is the schema descriptor for download_count field.
+	filesharelinkDescDownloadCount := filesharelinkFields[5].Descriptor()
+	// filesharelink.DefaultDownloadCount holds the default value on creation for the download_count field.
+	filesharelink.DefaultDownloadCount = filesharelinkDescDownloadCount.Default.(uint32)
+	// filesharelinkDescID is the schema descriptor for id field.
+	filesharelinkDescID := filesharelinkMixinFields0[0].Descriptor()
+	// filesharelink.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	filesharelink.IDValidator = filesharelinkDescID.Validators[0].(func(uint32) error)
 	internalmessageMixin := schema.InternalMessage{}.Mixin()
 	internalmessage.Policy = privacy.NewPolicies(internalmessageMixin[3], schema.InternalMessage{})
 	internalmessage.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -435,6 +619,102 @@ func init() {
 	loginpolicyDescID := loginpolicyMixinFields0[0].Descriptor()
 	// loginpolicy.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	loginpolicy.IDValidator = loginpolicyDescID.Validators[0].(func(uint32) error)
+	luascriptMixin := schema.LuaScript{}.Mixin()
+	luascript.Policy = privacy.NewPolicies(luascriptMixin[3], schema.LuaScript{})
+	luascript.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := luascript.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	luascriptMixinFields0 := luascriptMixin[0].Fields()
+	_ = luascriptMixinFields0
+	luascriptMixinFields3 := luascriptMixin[3].Fields()
+	_ = luascriptMixinFields3
+	luascriptFields := schema.LuaScript{}.Fields()
+	_ = luascriptFields
+	// luascriptDescTenantID is the schema descriptor for tenant_id field.
+	luascriptDescTenantID := luascriptMixinFields3[0].Descriptor()
+	// luascript.DefaultTenantID holds the default value on creation for the tenant_id field.
+	luascript.DefaultTenantID = luascriptDescTenantID.Default.(uint32)
+	// luascriptDescName is the schema descriptor for name field.
+	luascriptDescName := luascriptFields[0].Descriptor()
+	// luascript.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	luascript.NameValidator = luascriptDescName.Validators[0].(func(string) error)
+	// luascriptDescHook is the schema descriptor for hook field.
+	luascriptDescHook := luascriptFields[1].Descriptor()
+	// luascript.HookValidator is a validator for the "hook" field. It is called by the builders before save.
+	luascript.HookValidator = luascriptDescHook.Validators[0].(func(string) error)
+	// luascriptDescDescription is the schema descriptor for description field.
+	luascriptDescDescription := luascriptFields[3].Descriptor()
+	// luascript.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	luascript.DescriptionValidator = luascriptDescDescription.Validators[0].(func(string) error)
+	// luascriptDescEnabled is the schema descriptor for enabled field.
+	luascriptDescEnabled := luascriptFields[4].Descriptor()
+	// luascript.DefaultEnabled holds the default value on creation for the enabled field.
+	luascript.DefaultEnabled = luascriptDescEnabled.Default.(bool)
+	// luascriptDescPriority is the schema descriptor for priority field.
+	luascriptDescPriority := luascriptFields[5].Descriptor()
+	// luascript.DefaultPriority holds the default value on creation for the priority field.
+	luascript.DefaultPriority = luascriptDescPriority.Default.(int32)
+	// luascriptDescCritical is the schema descriptor for critical field.
+	luascriptDescCritical := luascriptFields[6].Descriptor()
+	// luascript.DefaultCritical holds the default value on creation for the critical field.
+	luascript.DefaultCritical = luascriptDescCritical.Default.(bool)
+	// luascriptDescVersion is the schema descriptor for version field.
+	luascriptDescVersion := luascriptFields[7].Descriptor()
+	// luascript.DefaultVersion holds the default value on creation for the version field.
+	luascript.DefaultVersion = luascriptDescVersion.Default.(uint32)
+	// luascriptDescAuthor is the schema descriptor for author field.
+	luascriptDescAuthor := luascriptFields[8].Descriptor()
+	// luascript.AuthorValidator is a validator for the "author" field. It is called by the builders before save.
+	luascript.AuthorValidator = luascriptDescAuthor.Validators[0].(func(string) error)
+	// luascriptDescDataWrite is the schema descriptor for data_write field.
+	luascriptDescDataWrite := luascriptFields[9].Descriptor()
+	// luascript.DefaultDataWrite holds the default value on creation for the data_write field.
+	luascript.DefaultDataWrite = luascriptDescDataWrite.Default.(bool)
+	// luascriptDescID is the schema descriptor for id field.
+	luascriptDescID := luascriptMixinFields0[0].Descriptor()
+	// luascript.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	luascript.IDValidator = luascriptDescID.Validators[0].(func(uint32) error)
+	luascriptversionMixin := schema.LuaScriptVersion{}.Mixin()
+	luascriptversion.Policy = privacy.NewPolicies(luascriptversionMixin[3], schema.LuaScriptVersion{})
+	luascriptversion.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := luascriptversion.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	luascriptversionMixinFields0 := luascriptversionMixin[0].Fields()
+	_ = luascriptversionMixinFields0
+	luascriptversionMixinFields3 := luascriptversionMixin[3].Fields()
+	_ = luascriptversionMixinFields3
+	luascriptversionFields := schema.LuaScriptVersion{}.Fields()
+	_ = luascriptversionFields
+	// luascriptversionDescTenantID is the schema descriptor for tenant_id field.
+	luascriptversionDescTenantID := luascriptversionMixinFields3[0].Descriptor()
+	// luascriptversion.DefaultTenantID holds the default value on creation for the tenant_id field.
+	luascriptversion.DefaultTenantID = luascriptversionDescTenantID.Default.(uint32)
+	// luascriptversionDescHook is the schema descriptor for hook field.
+	luascriptversionDescHook := luascriptversionFields[2].Descriptor()
+	// luascriptversion.HookValidator is a validator for the "hook" field. It is called by the builders before save.
+	luascriptversion.HookValidator = luascriptversionDescHook.Validators[0].(func(string) error)
+	// luascriptversionDescAuthor is the schema descriptor for author field.
+	luascriptversionDescAuthor := luascriptversionFields[6].Descriptor()
+	// luascriptversion.AuthorValidator is a validator for the "author" field. It is called by the builders before save.
+	luascriptversion.AuthorValidator = luascriptversionDescAuthor.Validators[0].(func(string) error)
+	// luascriptversionDescChangeLog is the schema descriptor for change_log field.
+	luascriptversionDescChangeLog := luascriptversionFields[7].Descriptor()
+	// luascriptversion.ChangeLogValidator is a validator for the "change_log" field. It is called by the builders before save.
+	luascriptversion.ChangeLogValidator = luascriptversionDescChangeLog.Validators[0].(func(string) error)
+	// luascriptversionDescID is the schema descriptor for id field.
+	luascriptversionDescID := luascriptversionMixinFields0[0].Descriptor()
+	// luascriptversion.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	luascriptversion.IDValidator = luascriptversionDescID.Validators[0].(func(uint32) error)
 	membershipMixin := schema.Membership{}.Mixin()
 	membership.Policy = privacy.NewPolicies(membershipMixin[3], schema.Membership{})
 	membership.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -819,6 +1099,30 @@ func init() {
 	positionDescID := positionMixinFields0[0].Descriptor()
 	// position.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	position.IDValidator = positionDescID.Validators[0].(func(uint32) error)
+	restoredauditlogMixin := schema.RestoredAuditLog{}.Mixin()
+	restoredauditlog.Policy = privacy.NewPolicies(restoredauditlogMixin[2], schema.RestoredAuditLog{})
+	restoredauditlog.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := restoredauditlog.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	restoredauditlogMixinFields0 := restoredauditlogMixin[0].Fields()
+	_ = restoredauditlogMixinFields0
+	restoredauditlogMixinFields2 := restoredauditlogMixin[2].Fields()
+	_ = restoredauditlogMixinFields2
+	restoredauditlogFields := schema.RestoredAuditLog{}.Fields()
+	_ = restoredauditlogFields
+	// restoredauditlogDescTenantID is the schema descriptor for tenant_id field.
+	restoredauditlogDescTenantID := restoredauditlogMixinFields2[0].Descriptor()
+	// restoredauditlog.DefaultTenantID holds the default value on creation for the tenant_id field.
+	restoredauditlog.DefaultTenantID = restoredauditlogDescTenantID.Default.(uint32)
+	// restoredauditlogDescID is the schema descriptor for id field.
+	restoredauditlogDescID := restoredauditlogMixinFields0[0].Descriptor()
+	// restoredauditlog.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	restoredauditlog.IDValidator = restoredauditlogDescID.Validators[0].(func(uint32) error)
 	roleMixin := schema.Role{}.Mixin()
 	role.Policy = privacy.NewPolicies(roleMixin[6], schema.Role{})
 	role.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -929,6 +1233,107 @@ func init() {
 	rolepermissionDescID := rolepermissionMixinFields0[0].Descriptor()
 	// rolepermission.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	rolepermission.IDValidator = rolepermissionDescID.Validators[0].(func(uint32) error)
+	storageobjectMixin := schema.StorageObject{}.Mixin()
+	storageobjectMixinFields0 := storageobjectMixin[0].Fields()
+	_ = storageobjectMixinFields0
+	storageobjectFields := schema.StorageObject{}.Fields()
+	_ = storageobjectFields
+	// storageobjectDescDedupScope is the schema descriptor for dedup_scope field.
+	storageobjectDescDedupScope := storageobjectFields[0].Descriptor()
+	// storageobject.DedupScopeValidator is a validator for the "dedup_scope" field. It is called by the builders before save.
+	storageobject.DedupScopeValidator = func() func(string) error {
+		validators := storageobjectDescDedupScope.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(dedup_scope string) error {
+			for _, fn := range fns {
+				if err := fn(dedup_scope); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// storageobjectDescContentHash is the schema descriptor for content_hash field.
+	storageobjectDescContentHash := storageobjectFields[2].Descriptor()
+	// storageobject.ContentHashValidator is a validator for the "content_hash" field. It is called by the builders before save.
+	storageobject.ContentHashValidator = func() func(string) error {
+		validators := storageobjectDescContentHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(content_hash string) error {
+			for _, fn := range fns {
+				if err := fn(content_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// storageobjectDescBucketName is the schema descriptor for bucket_name field.
+	storageobjectDescBucketName := storageobjectFields[3].Descriptor()
+	// storageobject.BucketNameValidator is a validator for the "bucket_name" field. It is called by the builders before save.
+	storageobject.BucketNameValidator = storageobjectDescBucketName.Validators[0].(func(string) error)
+	// storageobjectDescObjectName is the schema descriptor for object_name field.
+	storageobjectDescObjectName := storageobjectFields[4].Descriptor()
+	// storageobject.ObjectNameValidator is a validator for the "object_name" field. It is called by the builders before save.
+	storageobject.ObjectNameValidator = storageobjectDescObjectName.Validators[0].(func(string) error)
+	// storageobjectDescSize is the schema descriptor for size field.
+	storageobjectDescSize := storageobjectFields[5].Descriptor()
+	// storageobject.DefaultSize holds the default value on creation for the size field.
+	storageobject.DefaultSize = storageobjectDescSize.Default.(uint64)
+	// storageobjectDescRefCount is the schema descriptor for ref_count field.
+	storageobjectDescRefCount := storageobjectFields[7].Descriptor()
+	// storageobject.DefaultRefCount holds the default value on creation for the ref_count field.
+	storageobject.DefaultRefCount = storageobjectDescRefCount.Default.(int32)
+	// storageobjectDescID is the schema descriptor for id field.
+	storageobjectDescID := storageobjectMixinFields0[0].Descriptor()
+	// storageobject.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	storageobject.IDValidator = storageobjectDescID.Validators[0].(func(uint32) error)
+	storagequotaMixin := schema.StorageQuota{}.Mixin()
+	storagequota.Policy = privacy.NewPolicies(storagequotaMixin[3], schema.StorageQuota{})
+	storagequota.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := storagequota.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	storagequotaMixinFields0 := storagequotaMixin[0].Fields()
+	_ = storagequotaMixinFields0
+	storagequotaMixinFields3 := storagequotaMixin[3].Fields()
+	_ = storagequotaMixinFields3
+	storagequotaFields := schema.StorageQuota{}.Fields()
+	_ = storagequotaFields
+	// storagequotaDescTenantID is the schema descriptor for tenant_id field.
+	storagequotaDescTenantID := storagequotaMixinFields3[0].Descriptor()
+	// storagequota.DefaultTenantID holds the default value on creation for the tenant_id field.
+	storagequota.DefaultTenantID = storagequotaDescTenantID.Default.(uint32)
+	// storagequotaDescUserID is the schema descriptor for user_id field.
+	storagequotaDescUserID := storagequotaFields[0].Descriptor()
+	// storagequota.DefaultUserID holds the default value on creation for the user_id field.
+	storagequota.DefaultUserID = storagequotaDescUserID.Default.(uint32)
+	// storagequotaDescUsedBytes is the schema descriptor for used_bytes field.
+	storagequotaDescUsedBytes := storagequotaFields[3].Descriptor()
+	// storagequota.DefaultUsedBytes holds the default value on creation for the used_bytes field.
+	storagequota.DefaultUsedBytes = storagequotaDescUsedBytes.Default.(int64)
+	// storagequotaDescUsedFiles is the schema descriptor for used_files field.
+	storagequotaDescUsedFiles := storagequotaFields[4].Descriptor()
+	// storagequota.DefaultUsedFiles holds the default value on creation for the used_files field.
+	storagequota.DefaultUsedFiles = storagequotaDescUsedFiles.Default.(int64)
+	// storagequotaDescNotifiedPercent is the schema descriptor for notified_percent field.
+	storagequotaDescNotifiedPercent := storagequotaFields[5].Descriptor()
+	// storagequota.DefaultNotifiedPercent holds the default value on creation for the notified_percent field.
+	storagequota.DefaultNotifiedPercent = storagequotaDescNotifiedPercent.Default.(uint32)
+	// storagequotaDescID is the schema descriptor for id field.
+	storagequotaDescID := storagequotaMixinFields0[0].Descriptor()
+	// storagequota.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	storagequota.IDValidator = storagequotaDescID.Validators[0].(func(uint32) error)
 	taskMixin := schema.Task{}.Mixin()
 	task.Policy = privacy.NewPolicies(taskMixin[4], schema.Task{})
 	task.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -949,14 +1354,111 @@ func init() {
 	taskDescTenantID := taskMixinFields4[0].Descriptor()
 	// task.DefaultTenantID holds the default value on creation for the tenant_id field.
 	task.DefaultTenantID = taskDescTenantID.Default.(uint32)
+	// taskDescTimezone is the schema descriptor for timezone field.
+	taskDescTimezone := taskFields[4].Descriptor()
+	// task.TimezoneValidator is a validator for the "timezone" field. It is called by the builders before save.
+	task.TimezoneValidator = taskDescTimezone.Validators[0].(func(string) error)
 	// taskDescEnable is the schema descriptor for enable field.
-	taskDescEnable := taskFields[5].Descriptor()
+	taskDescEnable := taskFields[7].Descriptor()
 	// task.DefaultEnable holds the default value on creation for the enable field.
 	task.DefaultEnable = taskDescEnable.Default.(bool)
 	// taskDescID is the schema descriptor for id field.
 	taskDescID := taskMixinFields0[0].Descriptor()
 	// task.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	task.IDValidator = taskDescID.Validators[0].(func(uint32) error)
+	taskrunMixin := schema.TaskRun{}.Mixin()
+	taskrunMixinFields0 := taskrunMixin[0].Fields()
+	_ = taskrunMixinFields0
+	taskrunFields := schema.TaskRun{}.Fields()
+	_ = taskrunFields
+	// taskrunDescTaskID is the schema descriptor for task_id field.
+	taskrunDescTaskID := taskrunFields[0].Descriptor()
+	// taskrun.TaskIDValidator is a validator for the "task_id" field. It is called by the builders before save.
+	taskrun.TaskIDValidator = taskrunDescTaskID.Validators[0].(func(string) error)
+	// taskrunDescTypeName is the schema descriptor for type_name field.
+	taskrunDescTypeName := taskrunFields[1].Descriptor()
+	// taskrun.TypeNameValidator is a validator for the "type_name" field. It is called by the builders before save.
+	taskrun.TypeNameValidator = taskrunDescTypeName.Validators[0].(func(string) error)
+	// taskrunDescQueue is the schema descriptor for queue field.
+	taskrunDescQueue := taskrunFields[2].Descriptor()
+	// taskrun.QueueValidator is a validator for the "queue" field. It is called by the builders before save.
+	taskrun.QueueValidator = taskrunDescQueue.Validators[0].(func(string) error)
+	// taskrunDescWorker is the schema descriptor for worker field.
+	taskrunDescWorker := taskrunFields[4].Descriptor()
+	// taskrun.WorkerValidator is a validator for the "worker" field. It is called by the builders before save.
+	taskrun.WorkerValidator = taskrunDescWorker.Validators[0].(func(string) error)
+	// taskrunDescLogsTruncated is the schema descriptor for logs_truncated field.
+	taskrunDescLogsTruncated := taskrunFields[14].Descriptor()
+	// taskrun.DefaultLogsTruncated holds the default value on creation for the logs_truncated field.
+	taskrun.DefaultLogsTruncated = taskrunDescLogsTruncated.Default.(bool)
+	// taskrunDescID is the schema descriptor for id field.
+	taskrunDescID := taskrunMixinFields0[0].Descriptor()
+	// taskrun.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	taskrun.IDValidator = taskrunDescID.Validators[0].(func(uint32) error)
+	taskworkflowMixin := schema.TaskWorkflow{}.Mixin()
+	taskworkflow.Policy = privacy.NewPolicies(taskworkflowMixin[3], schema.TaskWorkflow{})
+	taskworkflow.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := taskworkflow.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	taskworkflowMixinFields0 := taskworkflowMixin[0].Fields()
+	_ = taskworkflowMixinFields0
+	taskworkflowMixinFields3 := taskworkflowMixin[3].Fields()
+	_ = taskworkflowMixinFields3
+	taskworkflowFields := schema.TaskWorkflow{}.Fields()
+	_ = taskworkflowFields
+	// taskworkflowDescTenantID is the schema descriptor for tenant_id field.
+	taskworkflowDescTenantID := taskworkflowMixinFields3[0].Descriptor()
+	// taskworkflow.DefaultTenantID holds the default value on creation for the tenant_id field.
+	taskworkflow.DefaultTenantID = taskworkflowDescTenantID.Default.(uint32)
+	// taskworkflowDescName is the schema descriptor for name field.
+	taskworkflowDescName := taskworkflowFields[0].Descriptor()
+	// taskworkflow.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	taskworkflow.NameValidator = taskworkflowDescName.Validators[0].(func(string) error)
+	// taskworkflowDescDescription is the schema descriptor for description field.
+	taskworkflowDescDescription := taskworkflowFields[1].Descriptor()
+	// taskworkflow.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	taskworkflow.DescriptionValidator = taskworkflowDescDescription.Validators[0].(func(string) error)
+	// taskworkflowDescEnable is the schema descriptor for enable field.
+	taskworkflowDescEnable := taskworkflowFields[3].Descriptor()
+	// taskworkflow.DefaultEnable holds the default value on creation for the enable field.
+	taskworkflow.DefaultEnable = taskworkflowDescEnable.Default.(bool)
+	// taskworkflowDescID is the schema descriptor for id field.
+	taskworkflowDescID := taskworkflowMixinFields0[0].Descriptor()
+	// taskworkflow.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	taskworkflow.IDValidator = taskworkflowDescID.Validators[0].(func(uint32) error)
+	taskworkflowrunMixin := schema.TaskWorkflowRun{}.Mixin()
+	taskworkflowrun.Policy = privacy.NewPolicies(taskworkflowrunMixin[3], schema.TaskWorkflowRun{})
+	taskworkflowrun.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := taskworkflowrun.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	taskworkflowrunMixinFields0 := taskworkflowrunMixin[0].Fields()
+	_ = taskworkflowrunMixinFields0
+	taskworkflowrunMixinFields3 := taskworkflowrunMixin[3].Fields()
+	_ = taskworkflowrunMixinFields3
+	taskworkflowrunFields := schema.TaskWorkflowRun{}.Fields()
+	_ = taskworkflowrunFields
+	// taskworkflowrunDescTenantID is the schema descriptor for tenant_id field.
+	taskworkflowrunDescTenantID := taskworkflowrunMixinFields3[0].Descriptor()
+	// taskworkflowrun.DefaultTenantID holds the default value on creation for the tenant_id field.
+	taskworkflowrun.DefaultTenantID = taskworkflowrunDescTenantID.Default.(uint32)
+	// taskworkflowrunDescWorkflowName is the schema descriptor for workflow_name field.
+	taskworkflowrunDescWorkflowName := taskworkflowrunFields[1].Descriptor()
+	// taskworkflowrun.WorkflowNameValidator is a validator for the "workflow_name" field. It is called by the builders before save.
+	taskworkflowrun.WorkflowNameValidator = taskworkflowrunDescWorkflowName.Validators[0].(func(string) error)
+	// taskworkflowrunDescID is the schema descriptor for id field.
+	taskworkflowrunDescID := taskworkflowrunMixinFields0[0].Descriptor()
+	// taskworkflowrun.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	taskworkflowrun.IDValidator = taskworkflowrunDescID.Validators[0].(func(uint32) error)
 	tenantMixin := schema.Tenant{}.Mixin()
 	tenantMixinFields0 := tenantMixin[0].Fields()
 	_ = tenantMixinFields0
@@ -1161,6 +1663,6 @@ func init() {
 }
 
 const (
-	Version = "v0.14.5"                                         // Version of ent codegen.
-	Sum     = "h1:Rj2WOYJtCkWyFo6a+5wB3EfBRP0rnx1fMk6gGA0UUe4=" // Sum of ent codegen.
+	Version = "v0.14.6"                                         // Version of ent codegen.
+	Sum     = "h1:/f2696BpwuWAEEG6PVGWflg6+Inrpq4pRWuNlWz/Skk=" // Sum of ent codegen.
 )
@@ -0,0 +1,146 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// AuditForwarder holds the schema definition for the AuditForwarder entity.
+type AuditForwarder struct {
+	ent.Schema
+}
+
+func (AuditForwarder) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "sys_audit_forwarders",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("审计事件转发器（SIEM）表"),
+	}
+}
+
+// Fields of the AuditForwarder.
+func (AuditForwarder) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			Comment("名称").
+			MaxLen(128).
+			Optional().
+			Nillable(),
+
+		field.Bool("enabled").
+			Comment("是否启用").
+			Default(true).
+			Optional().
+			Nillable(),
+
+		field.Enum("format").
+			Comment("输出格式").
+			NamedValues(
+				"Rfc5424", "RFC5424",
+				"Cef", "CEF",
+				"Json", "JSON",
+			).
+			Optional().
+			Nillable(),
+
+		field.Enum("transport").
+			Comment("传输方式").
+			NamedValues(
+				"Udp", "UDP",
+				"Tcp", "TCP",
+				"Tls", "TLS",
+				"Http", "HTTP",
+			).
+			Optional().
+			Nillable(),
+
+		field.String("endpoint").
+			Comment("目标地址").
+			MaxLen(1024).
+			Optional().
+			Nillable(),
+
+		field.Strings("log_types").
+			Comment("转发的审计日志类型").
+			Optional(),
+
+		field.JSON("field_mapping", map[string]string{}).
+			Comment("字段映射").
+			Optional(),
+
+		field.String("secret").
+			Comment("Webhook签名密钥").
+			MaxLen(512).
+			Sensitive().
+			Optional().
+			Nillable(),
+
+		field.JSON("headers", map[string]string{}).
+			Comment("Webhook附加请求头").
+			Optional(),
+
+		field.Bool("tls_insecure_skip_verify").
+			Comment("TLS是否跳过证书校验").
+			Default(false).
+			Optional().
+			Nillable(),
+
+		field.Text("tls_ca_pem").
+			Comment("TLS信任的CA证书").
+			Optional().
+			Nillable(),
+
+		field.String("tls_server_name").
+			Comment("TLS服务器名称").
+			MaxLen(255).
+			Optional().
+			Nillable(),
+
+		field.String("app_name").
+			Comment("Syslog APP-NAME").
+			MaxLen(48).
+			Optional().
+			Nillable(),
+
+		field.Uint32("facility").
+			Comment("Syslog facility").
+			Default(16).
+			Optional().
+			Nillable(),
+
+		field.Uint64("max_buffer_bytes").
+			Comment("磁盘缓冲上限（字节）").
+			Default(64 << 20).
+			Optional().
+			Nillable(),
+	}
+}
+
+// Mixin of the AuditForwarder.
+func (AuditForwarder) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TimeAt{},
+		mixin.OperatorID{},
+		mixin.Remark{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the AuditForwarder.
+func (AuditForwarder) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "name").
+			Unique().
+			StorageKey("uidx_sys_audit_forwarder_tenant_name"),
+	}
+}
@@ -0,0 +1,146 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// AuditLogArchive holds the schema definition for the AuditLogArchive entity.
+type AuditLogArchive struct {
+	ent.Schema
+}
+
+func (AuditLogArchive) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "sys_audit_log_archives",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("审计日志归档表"),
+	}
+}
+
+// Fields of the AuditLogArchive.
+func (AuditLogArchive) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("log_type").
+			Comment("审计日志类型").
+			NamedValues(
+				"ApiAuditLog", "API_AUDIT_LOG",
+				"LoginAuditLog", "LOGIN_AUDIT_LOG",
+				"OperationAuditLog", "OPERATION_AUDIT_LOG",
+				"DataAccessAuditLog", "DATA_ACCESS_AUDIT_LOG",
+				"PermissionAuditLog", "PERMISSION_AUDIT_LOG",
+				"PolicyEvaluationLog", "POLICY_EVALUATION_LOG",
+			).
+			Optional().
+			Nillable(),
+
+		field.Enum("status").
+			Comment("归档状态").
+			NamedValues(
+				"Exporting", "EXPORTING",
+				"Archived", "ARCHIVED",
+				"Purged", "PURGED",
+				"Failed", "FAILED",
+			).
+			Default("EXPORTING").
+			Optional().
+			Nillable(),
+
+		field.String("bucket_name").
+			Comment("存储桶名称").
+			Optional().
+			Nillable(),
+
+		field.String("object_name").
+			Comment("归档数据对象名").
+			Optional().
+			Nillable(),
+
+		field.String("manifest_object_name").
+			Comment("归档清单对象名").
+			Optional().
+			Nillable(),
+
+		field.String("sha256").
+			Comment("归档数据对象的SHA256摘要").
+			MaxLen(64).
+			Optional().
+			Nillable(),
+
+		field.Uint64("size_bytes").
+			Comment("归档数据对象大小").
+			Optional().
+			Nillable(),
+
+		field.Uint64("row_count").
+			Comment("归档行数").
+			Optional().
+			Nillable(),
+
+		field.Uint64("purged_count").
+			Comment("已清除的在线行数").
+			Optional().
+			Nillable(),
+
+		field.Uint32("min_log_id").
+			Comment("归档的最小日志ID").
+			Optional().
+			Nillable(),
+
+		field.Uint32("max_log_id").
+			Comment("归档的最大日志ID").
+			Optional().
+			Nillable(),
+
+		field.Time("cutoff_time").
+			Comment("截止时间").
+			Optional().
+			Nillable(),
+
+		field.String("error_message").
+			Comment("错误信息").
+			Optional().
+			Nillable(),
+
+		field.Uint64("restored_count").
+			Comment("已恢复到查询表的行数").
+			Optional().
+			Nillable(),
+
+		field.Time("restored_at").
+			Comment("最近一次恢复时间").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Mixin of the AuditLogArchive.
+func (AuditLogArchive) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TimeAt{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the AuditLogArchive.
+func (AuditLogArchive) Indexes() []ent.Index {
+	return []ent.Index{
+		// 按租户 + 类型 + 时间查询归档
+		index.Fields("tenant_id", "log_type", "created_at").
+			StorageKey("idx_sys_audit_log_archive_tenant_type_created_at"),
+
+		// 按状态筛选（如查找失败的归档）
+		index.Fields("status").
+			StorageKey("idx_sys_audit_log_archive_status"),
+	}
+}
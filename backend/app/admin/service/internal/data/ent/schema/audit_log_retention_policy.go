@@ -0,0 +1,91 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// AuditLogRetentionPolicy holds the schema definition for the AuditLogRetentionPolicy entity.
+type AuditLogRetentionPolicy struct {
+	ent.Schema
+}
+
+func (AuditLogRetentionPolicy) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "sys_audit_log_retention_policies",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("审计日志保留策略表"),
+	}
+}
+
+// Fields of the AuditLogRetentionPolicy.
+func (AuditLogRetentionPolicy) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("log_type").
+			Comment("审计日志类型").
+			NamedValues(
+				"ApiAuditLog", "API_AUDIT_LOG",
+				"LoginAuditLog", "LOGIN_AUDIT_LOG",
+				"OperationAuditLog", "OPERATION_AUDIT_LOG",
+				"DataAccessAuditLog", "DATA_ACCESS_AUDIT_LOG",
+				"PermissionAuditLog", "PERMISSION_AUDIT_LOG",
+				"PolicyEvaluationLog", "POLICY_EVALUATION_LOG",
+			).
+			Optional().
+			Nillable(),
+
+		field.Uint32("retention_days").
+			Comment("在线保留天数").
+			Default(180).
+			Optional().
+			Nillable(),
+
+		field.Bool("archive_enabled").
+			Comment("清除前是否导出归档").
+			Default(true).
+			Optional().
+			Nillable(),
+
+		field.Bool("enabled").
+			Comment("是否启用").
+			Default(true).
+			Optional().
+			Nillable(),
+
+		field.Uint32("batch_size").
+			Comment("分批导出/删除的批次大小").
+			Default(1000).
+			Optional().
+			Nillable(),
+	}
+}
+
+// Mixin of the AuditLogRetentionPolicy.
+func (AuditLogRetentionPolicy) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TimeAt{},
+		mixin.OperatorID{},
+		mixin.Remark{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the AuditLogRetentionPolicy.
+func (AuditLogRetentionPolicy) Indexes() []ent.Index {
+	return []ent.Index{
+		// 每个租户每种日志类型仅允许一条策略
+		index.Fields("tenant_id", "log_type").
+			Unique().
+			StorageKey("uidx_sys_audit_log_retention_policy_tenant_type"),
+	}
+}
@@ -98,6 +98,57 @@ func (File) Fields() []ent.Field {
 			Comment("文件内容hash值，防止上传重复文件").
 			Optional().
 			Nillable(),
+
+		field.Uint32("storage_object_id").
+			Comment("物理对象ID，去重后多个文件可引用同一物理对象").
+			Optional().
+			Nillable(),
+
+		field.String("object_name").
+			Comment("物理对象名，去重或隔离后可与文件目录不同，为空时由文件目录和保存文件名组成").
+			Optional().
+			Nillable(),
+
+		field.Enum("scan_status").
+			Comment("恶意文件扫描状态，为空表示启用扫描前上传的文件").
+			NamedValues(
+				"Pending", "SCAN_PENDING",
+				"Clean", "SCAN_CLEAN",
+				"Infected", "SCAN_INFECTED",
+				"Failed", "SCAN_FAILED",
+				"Skipped", "SCAN_SKIPPED",
+			).
+			Optional().
+			Nillable(),
+
+		field.String("scan_signature").
+			Comment("检出的恶意特征名称或扫描失败原因").
+			Optional().
+			Nillable(),
+
+		field.Time("scanned_at").
+			Comment("扫描时间").
+			Optional().
+			Nillable(),
+
+		field.Uint32("version").
+			Comment("版本号，同一目录下同名文件的每次上传为一个版本").
+			Default(1),
+
+		field.Bool("is_latest").
+			Comment("是否为当前版本").
+			Default(true),
+
+		field.String("version_group").
+			Comment("版本组标识，同一文件的全部版本相同，为空时以文件Guid作为版本组").
+			Optional().
+			Nillable(),
+
+		field.String("latest_key").
+			Comment("当前版本唯一键，由租户、目录和文件名计算，只在未删除的当前版本上设置，保证同一文件只有一个当前版本").
+			Optional().
+			Nillable().
+			Unique(),
 	}
 }
 
@@ -130,6 +181,23 @@ func (File) Indexes() []ent.Index {
 		index.Fields("content_hash").
 			StorageKey("idx_files_content_hash"),
 
+		index.Fields("storage_object_id").
+			StorageKey("idx_files_storage_object_id"),
+		index.Fields("bucket_name", "object_name").
+			StorageKey("idx_files_bucket_object_name"),
+
+		index.Fields("scan_status").
+			StorageKey("idx_files_scan_status"),
+
+		// 按目录和文件名查找同一文件的当前版本
+		index.Fields("tenant_id", "file_directory", "file_name", "is_latest").
+			StorageKey("idx_files_tenant_directory_name_latest"),
+		index.Fields("version_group").
+			StorageKey("idx_files_version_group"),
+		// 回收站按删除时间清理
+		index.Fields("deleted_at").
+			StorageKey("idx_files_deleted_at"),
+
 		// 常用查询字段索引
 		index.Fields("bucket_name").
 			StorageKey("idx_files_bucket_name"),
@@ -0,0 +1,107 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// FileShareLink holds the schema definition for the FileShareLink entity.
+type FileShareLink struct {
+	ent.Schema
+}
+
+func (FileShareLink) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "file_share_links",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("文件分享链接表"),
+	}
+}
+
+// Fields of the FileShareLink.
+func (FileShareLink) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint32("file_id").
+			Comment("分享的文件ID"),
+
+		field.String("token").
+			Comment("分享令牌").
+			NotEmpty().
+			Unique().
+			Sensitive(),
+
+		field.String("password_hash").
+			Comment("访问密码的 bcrypt 哈希，为空时不需要密码").
+			Optional().
+			Nillable().
+			Sensitive(),
+
+		field.Time("expires_at").
+			Comment("过期时间，为空时不过期").
+			Optional().
+			Nillable(),
+
+		field.Uint32("max_downloads").
+			Comment("最大下载次数，0表示不限制").
+			Default(0),
+
+		field.Uint32("download_count").
+			Comment("已下载次数").
+			Default(0),
+
+		field.JSON("allowed_tenant_ids", []uint32{}).
+			Comment("允许访问的租户ID").
+			Optional(),
+
+		field.JSON("allowed_user_ids", []uint32{}).
+			Comment("允许访问的用户ID").
+			Optional(),
+
+		field.JSON("allowed_org_unit_ids", []uint32{}).
+			Comment("允许访问的组织单元ID").
+			Optional(),
+
+		field.Time("revoked_at").
+			Comment("撤销时间").
+			Optional().
+			Nillable(),
+
+		field.Uint32("revoked_by").
+			Comment("撤销者ID").
+			Optional().
+			Nillable(),
+
+		field.Time("last_accessed_at").
+			Comment("最近访问时间").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Mixin of the FileShareLink.
+func (FileShareLink) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TimeAt{},
+		mixin.OperatorID{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+func (FileShareLink) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "file_id").
+			StorageKey("idx_file_share_links_tenant_file"),
+		index.Fields("created_by").
+			StorageKey("idx_file_share_links_created_by"),
+	}
+}
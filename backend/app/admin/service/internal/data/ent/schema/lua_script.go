@@ -0,0 +1,113 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// LuaScript holds the schema definition for the LuaScript entity.
+type LuaScript struct {
+	ent.Schema
+}
+
+func (LuaScript) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "sys_lua_scripts",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("Lua脚本表"),
+	}
+}
+
+// Fields of the LuaScript.
+func (LuaScript) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			Comment("脚本名称").
+			MaxLen(128).
+			Optional().
+			Nillable(),
+
+		field.String("hook").
+			Comment("挂载的钩子名称").
+			MaxLen(128).
+			Optional().
+			Nillable(),
+
+		field.Text("source").
+			Comment("脚本源码").
+			Optional().
+			Nillable(),
+
+		field.String("description").
+			Comment("描述").
+			MaxLen(1024).
+			Optional().
+			Nillable(),
+
+		field.Bool("enabled").
+			Comment("是否启用").
+			Default(true).
+			Optional().
+			Nillable(),
+
+		field.Int32("priority").
+			Comment("执行顺序（越小越先执行）").
+			Default(0).
+			Optional().
+			Nillable(),
+
+		field.Bool("critical").
+			Comment("失败时是否中止钩子").
+			Default(false).
+			Optional().
+			Nillable(),
+
+		field.Uint32("version").
+			Comment("当前版本号").
+			Default(1).
+			Optional().
+			Nillable(),
+
+		field.String("author").
+			Comment("作者").
+			MaxLen(128).
+			Optional().
+			Nillable(),
+
+		field.Bool("data_write").
+			Comment("是否允许通过 kratos_data 模块写入数据").
+			Default(false).
+			Optional().
+			Nillable(),
+	}
+}
+
+// Mixin of the LuaScript.
+func (LuaScript) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TimeAt{},
+		mixin.OperatorID{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the LuaScript.
+func (LuaScript) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "name").
+			Unique().
+			StorageKey("uidx_sys_lua_script_tenant_name"),
+		index.Fields("hook").
+			StorageKey("idx_sys_lua_script_hook"),
+	}
+}
@@ -0,0 +1,95 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// LuaScriptVersion holds the schema definition for the LuaScriptVersion entity.
+type LuaScriptVersion struct {
+	ent.Schema
+}
+
+func (LuaScriptVersion) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "sys_lua_script_versions",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("Lua脚本版本历史表"),
+	}
+}
+
+// Fields of the LuaScriptVersion.
+func (LuaScriptVersion) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint32("script_id").
+			Comment("脚本ID").
+			Optional().
+			Nillable(),
+
+		field.Uint32("version").
+			Comment("版本号").
+			Optional().
+			Nillable(),
+
+		field.String("hook").
+			Comment("挂载的钩子名称").
+			MaxLen(128).
+			Optional().
+			Nillable(),
+
+		field.Text("source").
+			Comment("脚本源码").
+			Optional().
+			Nillable(),
+
+		field.Int32("priority").
+			Comment("执行顺序").
+			Optional().
+			Nillable(),
+
+		field.Bool("critical").
+			Comment("失败时是否中止钩子").
+			Optional().
+			Nillable(),
+
+		field.String("author").
+			Comment("作者").
+			MaxLen(128).
+			Optional().
+			Nillable(),
+
+		field.String("change_log").
+			Comment("变更说明").
+			MaxLen(1024).
+			Optional().
+			Nillable(),
+	}
+}
+
+// Mixin of the LuaScriptVersion.
+func (LuaScriptVersion) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.CreatedAt{},
+		mixin.CreatedBy{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the LuaScriptVersion.
+func (LuaScriptVersion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("script_id", "version").
+			Unique().
+			StorageKey("uidx_sys_lua_script_version"),
+	}
+}
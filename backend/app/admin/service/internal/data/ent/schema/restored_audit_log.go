@@ -0,0 +1,89 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// RestoredAuditLog holds the schema definition for the RestoredAuditLog entity.
+// 从归档恢复的审计日志，仅供查询，不参与在线审计链路。
+type RestoredAuditLog struct {
+	ent.Schema
+}
+
+func (RestoredAuditLog) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "sys_restored_audit_logs",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("已恢复审计日志表（只读查询）"),
+	}
+}
+
+// Fields of the RestoredAuditLog.
+func (RestoredAuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint32("archive_id").
+			Comment("归档ID").
+			Optional().
+			Nillable(),
+
+		field.Enum("log_type").
+			Comment("审计日志类型").
+			NamedValues(
+				"ApiAuditLog", "API_AUDIT_LOG",
+				"LoginAuditLog", "LOGIN_AUDIT_LOG",
+				"OperationAuditLog", "OPERATION_AUDIT_LOG",
+				"DataAccessAuditLog", "DATA_ACCESS_AUDIT_LOG",
+				"PermissionAuditLog", "PERMISSION_AUDIT_LOG",
+				"PolicyEvaluationLog", "POLICY_EVALUATION_LOG",
+			).
+			Optional().
+			Nillable(),
+
+		field.Uint32("log_id").
+			Comment("原始日志ID").
+			Optional().
+			Nillable(),
+
+		field.Time("log_created_at").
+			Comment("原始日志创建时间").
+			Optional().
+			Nillable(),
+
+		field.JSON("payload", map[string]any{}).
+			Comment("原始日志内容").
+			Optional(),
+	}
+}
+
+// Mixin of the RestoredAuditLog.
+func (RestoredAuditLog) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.CreatedAt{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the RestoredAuditLog.
+func (RestoredAuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		// 同一归档内原始日志ID唯一，重复恢复时用于去重
+		index.Fields("archive_id", "log_id").
+			Unique().
+			StorageKey("uidx_sys_restored_audit_log_archive_log"),
+
+		// 按租户 + 类型 + 原始时间查询
+		index.Fields("tenant_id", "log_type", "log_created_at").
+			StorageKey("idx_sys_restored_audit_log_tenant_type_log_created_at"),
+	}
+}
@@ -0,0 +1,100 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// StorageObject holds the schema definition for the StorageObject entity.
+type StorageObject struct {
+	ent.Schema
+}
+
+func (StorageObject) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "storage_objects",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("物理存储对象表"),
+	}
+}
+
+// Fields of the StorageObject.
+func (StorageObject) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("dedup_scope").
+			Comment("去重范围：tenant:<租户ID> 或 shared").
+			MaxLen(64).
+			NotEmpty(),
+
+		field.Enum("provider").
+			Comment("OSS供应商").
+			NamedValues(
+				"Unknown", "UNKNOWN",
+				"MinIO", "MINIO",
+				"Aliyun", "ALIYUN",
+				"Qiniu", "QINIU",
+				"Tencent", "TENCENT",
+				"AWS", "AWS",
+				"Google", "GOOGLE",
+				"Azure", "AZURE",
+				"Baidu", "BAIDU",
+				"Huawei", "HUAWEI",
+				"Local", "LOCAL",
+			),
+
+		field.String("content_hash").
+			Comment("文件内容SHA256").
+			MaxLen(64).
+			NotEmpty(),
+
+		field.String("bucket_name").
+			Comment("存储桶名称").
+			NotEmpty(),
+
+		field.String("object_name").
+			Comment("对象名").
+			NotEmpty(),
+
+		field.Uint64("size").
+			Comment("对象长度，单位：字节").
+			Default(0),
+
+		field.String("content_type").
+			Comment("内容类型").
+			Optional().
+			Nillable(),
+
+		field.Int32("ref_count").
+			Comment("引用该对象的文件数量，归零时删除物理对象").
+			Default(1),
+	}
+}
+
+// Mixin of the StorageObject.
+func (StorageObject) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TimeAt{},
+	}
+}
+
+func (StorageObject) Indexes() []ent.Index {
+	return []ent.Index{
+		// 同一去重范围、同一存储后端内相同内容只保存一份
+		index.Fields("dedup_scope", "provider", "content_hash").
+			Unique().
+			StorageKey("uix_storage_objects_scope_provider_hash"),
+
+		index.Fields("provider", "bucket_name", "object_name").
+			StorageKey("idx_storage_objects_location"),
+	}
+}
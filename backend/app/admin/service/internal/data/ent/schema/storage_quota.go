@@ -0,0 +1,82 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// StorageQuota holds the schema definition for the StorageQuota entity.
+type StorageQuota struct {
+	ent.Schema
+}
+
+func (StorageQuota) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "storage_quotas",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("存储配额与用量表"),
+	}
+}
+
+// Fields of the StorageQuota.
+func (StorageQuota) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint32("user_id").
+			Comment("用户ID，0表示租户整体").
+			Default(0),
+
+		field.Int64("max_bytes").
+			Comment("最大存储字节数，为空时使用订阅套餐的默认配额，0表示不限制").
+			Optional().
+			Nillable(),
+
+		field.Int64("max_files").
+			Comment("最大文件数量，为空时使用订阅套餐的默认配额，0表示不限制").
+			Optional().
+			Nillable(),
+
+		field.Int64("used_bytes").
+			Comment("已用字节数").
+			Default(0),
+
+		field.Int64("used_files").
+			Comment("已用文件数量").
+			Default(0),
+
+		field.Uint32("notified_percent").
+			Comment("已发送提醒的用量阈值百分比").
+			Default(0),
+
+		field.Time("reconciled_at").
+			Comment("最近一次对账时间").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Mixin of the StorageQuota.
+func (StorageQuota) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TimeAt{},
+		mixin.OperatorID{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+func (StorageQuota) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "user_id").
+			Unique().
+			StorageKey("uix_storage_quotas_tenant_user"),
+	}
+}
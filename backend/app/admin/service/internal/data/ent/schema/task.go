@@ -64,6 +64,23 @@ func (Task) Fields() []ent.Field {
 			Optional().
 			Nillable(),
 
+		field.String("timezone").
+			Comment("cron表达式使用的IANA时区").
+			MaxLen(64).
+			Optional().
+			Nillable(),
+
+		field.Enum("misfire_policy").
+			Comment("错过触发时间后的补偿策略").
+			NamedValues(
+				"FireOnce", "FIRE_ONCE",
+				"Skip", "SKIP",
+				"CatchUp", "CATCH_UP",
+			).
+			Default("FIRE_ONCE").
+			Optional().
+			Nillable(),
+
 		field.JSON("task_options", &taskV1.TaskOption{}).
 			Comment("任务选项").
 			Optional(),
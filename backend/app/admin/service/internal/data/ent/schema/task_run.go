@@ -0,0 +1,146 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+
+	taskV1 "go-wind-admin/api/gen/go/task/service/v1"
+)
+
+// TaskRun holds the schema definition for the TaskRun entity.
+type TaskRun struct {
+	ent.Schema
+}
+
+func (TaskRun) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "sys_task_runs",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("任务执行记录表"),
+	}
+}
+
+// Fields of the TaskRun.
+func (TaskRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("task_id").
+			Comment("asynq任务ID").
+			MaxLen(255).
+			Optional().
+			Nillable(),
+
+		field.String("type_name").
+			Comment("任务类型名称").
+			MaxLen(128).
+			Optional().
+			Nillable(),
+
+		field.String("queue").
+			Comment("队列名称").
+			MaxLen(128).
+			Optional().
+			Nillable(),
+
+		field.Text("payload").
+			Comment("任务负载").
+			Optional().
+			Nillable(),
+
+		field.String("worker").
+			Comment("执行节点").
+			MaxLen(255).
+			Optional().
+			Nillable(),
+
+		field.Uint32("attempt").
+			Comment("第几次执行（从1开始）").
+			Optional().
+			Nillable(),
+
+		field.Uint32("max_retry").
+			Comment("最大重试次数").
+			Optional().
+			Nillable(),
+
+		field.Enum("status").
+			Comment("执行状态").
+			NamedValues(
+				"Running", "RUNNING",
+				"Succeeded", "SUCCEEDED",
+				"Failed", "FAILED",
+			).
+			Default("RUNNING").
+			Optional().
+			Nillable(),
+
+		field.Time("started_at").
+			Comment("开始时间").
+			Optional().
+			Nillable(),
+
+		field.Time("finished_at").
+			Comment("结束时间").
+			Optional().
+			Nillable(),
+
+		field.Uint64("duration_ms").
+			Comment("执行耗时（毫秒）").
+			Optional().
+			Nillable(),
+
+		field.Text("result").
+			Comment("执行结果").
+			Optional().
+			Nillable(),
+
+		field.Text("error_message").
+			Comment("错误信息").
+			Optional().
+			Nillable(),
+
+		field.Strings("logs").
+			Comment("执行日志").
+			Optional(),
+
+		field.Bool("logs_truncated").
+			Comment("执行日志是否被截断").
+			Default(false).
+			Optional().
+			Nillable(),
+
+		field.JSON("progress", &taskV1.TaskRunProgress{}).
+			Comment("最新的执行进度快照").
+			Optional(),
+	}
+}
+
+// Mixin of the TaskRun.
+func (TaskRun) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TimeAt{},
+	}
+}
+
+// Indexes of the TaskRun.
+func (TaskRun) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("type_name", "started_at").
+			StorageKey("idx_sys_task_run_type_started"),
+
+		index.Fields("task_id").
+			StorageKey("idx_sys_task_run_task_id"),
+
+		index.Fields("started_at").
+			StorageKey("idx_sys_task_run_started"),
+	}
+}
@@ -0,0 +1,77 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+
+	taskV1 "go-wind-admin/api/gen/go/task/service/v1"
+)
+
+// TaskWorkflow holds the schema definition for the TaskWorkflow entity.
+type TaskWorkflow struct {
+	ent.Schema
+}
+
+func (TaskWorkflow) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "sys_task_workflows",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("任务工作流表"),
+	}
+}
+
+// Fields of the TaskWorkflow.
+func (TaskWorkflow) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			Comment("工作流名称").
+			MaxLen(128).
+			Optional().
+			Nillable(),
+
+		field.String("description").
+			Comment("描述").
+			MaxLen(1024).
+			Optional().
+			Nillable(),
+
+		field.JSON("steps", []*taskV1.TaskWorkflowStep{}).
+			Comment("步骤定义").
+			Optional(),
+
+		field.Bool("enable").
+			Comment("启用/禁用工作流").
+			Default(true).
+			Optional().
+			Nillable(),
+	}
+}
+
+// Mixin of the TaskWorkflow.
+func (TaskWorkflow) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TimeAt{},
+		mixin.OperatorID{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the TaskWorkflow.
+func (TaskWorkflow) Indexes() []ent.Index {
+	return []ent.Index{
+		// 在租户范围内保证工作流名称唯一
+		index.Fields("tenant_id", "name").
+			Unique().
+			StorageKey("idx_sys_task_workflow_tenant_name"),
+	}
+}
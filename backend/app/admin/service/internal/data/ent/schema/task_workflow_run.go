@@ -0,0 +1,108 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+
+	taskV1 "go-wind-admin/api/gen/go/task/service/v1"
+)
+
+// TaskWorkflowRun holds the schema definition for the TaskWorkflowRun entity.
+type TaskWorkflowRun struct {
+	ent.Schema
+}
+
+func (TaskWorkflowRun) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "sys_task_workflow_runs",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("任务工作流运行表"),
+	}
+}
+
+// Fields of the TaskWorkflowRun.
+func (TaskWorkflowRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint32("workflow_id").
+			Comment("工作流ID").
+			Optional().
+			Nillable(),
+
+		field.String("workflow_name").
+			Comment("工作流名称").
+			MaxLen(128).
+			Optional().
+			Nillable(),
+
+		field.Enum("status").
+			Comment("运行状态").
+			NamedValues(
+				"Running", "RUNNING",
+				"Paused", "PAUSED",
+				"Succeeded", "SUCCEEDED",
+				"Failed", "FAILED",
+				"Cancelled", "CANCELLED",
+			).
+			Default("RUNNING").
+			Optional().
+			Nillable(),
+
+		field.Text("input").
+			Comment("运行输入").
+			Optional().
+			Nillable(),
+
+		field.JSON("steps", []*taskV1.TaskWorkflowStep{}).
+			Comment("步骤定义快照").
+			Optional(),
+
+		field.JSON("step_runs", []*taskV1.TaskWorkflowStepRun{}).
+			Comment("各步骤的执行状态").
+			Optional(),
+
+		field.Text("error_message").
+			Comment("错误信息").
+			Optional().
+			Nillable(),
+
+		field.Time("started_at").
+			Comment("开始时间").
+			Optional().
+			Nillable(),
+
+		field.Time("finished_at").
+			Comment("结束时间").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Mixin of the TaskWorkflowRun.
+func (TaskWorkflowRun) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TimeAt{},
+		mixin.CreatedBy{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the TaskWorkflowRun.
+func (TaskWorkflowRun) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status").
+			StorageKey("idx_sys_task_workflow_run_status"),
+
+		index.Fields("tenant_id", "workflow_id", "created_at").
+			StorageKey("idx_sys_task_workflow_run_tenant_workflow_created"),
+	}
+}
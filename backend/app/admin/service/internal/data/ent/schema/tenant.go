@@ -118,6 +118,50 @@ func (Tenant) Fields() []ent.Field {
 			Comment("租户有效期").
 			Optional().
 			Nillable(),
+
+		field.Enum("high_risk_login_action").
+			Comment("高风险登录处置方式").
+			NamedValues(
+				"Allow", "ALLOW",
+				"Block", "BLOCK",
+				"RequireMfa", "REQUIRE_MFA",
+			).
+			Default("ALLOW").
+			Optional().
+			Nillable(),
+
+		field.Enum("storage_provider").
+			Comment("文件存储后端，为空时使用平台默认存储").
+			NamedValues(
+				"Unknown", "UNKNOWN",
+				"MinIO", "MINIO",
+				"Aliyun", "ALIYUN",
+				"Qiniu", "QINIU",
+				"Tencent", "TENCENT",
+				"AWS", "AWS",
+				"Google", "GOOGLE",
+				"Azure", "AZURE",
+				"Baidu", "BAIDU",
+				"Huawei", "HUAWEI",
+				"Local", "LOCAL",
+			).
+			Optional().
+			Nillable(),
+
+		field.Enum("file_dedup_policy").
+			Comment("文件去重策略，为空时仅在本租户内去重").
+			NamedValues(
+				"Disabled", "DEDUP_DISABLED",
+				"Tenant", "DEDUP_TENANT",
+				"Shared", "DEDUP_SHARED",
+			).
+			Optional().
+			Nillable(),
+
+		field.Uint32("recycle_bin_retention_days").
+			Comment("回收站文件保留天数，为空或0时使用平台默认值").
+			Optional().
+			Nillable(),
 	}
 }
 
@@ -0,0 +1,229 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/storageobject"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// 物理存储对象表
+type StorageObject struct {
+	config `json:"-"`
+	// ID of the ent.
+	// id
+	ID uint32 `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	// 删除时间
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// 去重范围：tenant:<租户ID> 或 shared
+	DedupScope string `json:"dedup_scope,omitempty"`
+	// OSS供应商
+	Provider storageobject.Provider `json:"provider,omitempty"`
+	// 文件内容SHA256
+	ContentHash string `json:"content_hash,omitempty"`
+	// 存储桶名称
+	BucketName string `json:"bucket_name,omitempty"`
+	// 对象名
+	ObjectName string `json:"object_name,omitempty"`
+	// 对象长度，单位：字节
+	Size uint64 `json:"size,omitempty"`
+	// 内容类型
+	ContentType *string `json:"content_type,omitempty"`
+	// 引用该对象的文件数量，归零时删除物理对象
+	RefCount     int32 `json:"ref_count,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*StorageObject) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case storageobject.FieldID, storageobject.FieldSize, storageobject.FieldRefCount:
+			values[i] = new(sql.NullInt64)
+		case storageobject.FieldDedupScope, storageobject.FieldProvider, storageobject.FieldContentHash, storageobject.FieldBucketName, storageobject.FieldObjectName, storageobject.FieldContentType:
+			values[i] = new(sql.NullString)
+		case storageobject.FieldCreatedAt, storageobject.FieldUpdatedAt, storageobject.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the StorageObject fields.
+func (_m *StorageObject) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case storageobject.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint32(value.Int64)
+		case storageobject.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = new(time.Time)
+				*_m.CreatedAt = value.Time
+			}
+		case storageobject.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = new(time.Time)
+				*_m.UpdatedAt = value.Time
+			}
+		case storageobject.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case storageobject.FieldDedupScope:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field dedup_scope", values[i])
+			} else if value.Valid {
+				_m.DedupScope = value.String
+			}
+		case storageobject.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				_m.Provider = storageobject.Provider(value.String)
+			}
+		case storageobject.FieldContentHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_hash", values[i])
+			} else if value.Valid {
+				_m.ContentHash = value.String
+			}
+		case storageobject.FieldBucketName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bucket_name", values[i])
+			} else if value.Valid {
+				_m.BucketName = value.String
+			}
+		case storageobject.FieldObjectName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field object_name", values[i])
+			} else if value.Valid {
+				_m.ObjectName = value.String
+			}
+		case storageobject.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				_m.Size = uint64(value.Int64)
+			}
+		case storageobject.FieldContentType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_type", values[i])
+			} else if value.Valid {
+				_m.ContentType = new(string)
+				*_m.ContentType = value.String
+			}
+		case storageobject.FieldRefCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field ref_count", values[i])
+			} else if value.Valid {
+				_m.RefCount = int32(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the StorageObject.
+// This includes values selected through modifiers, order, etc.
+func (_m *StorageObject) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this StorageObject.
+// Note that you need to call StorageObject.Unwrap() before calling this method if this StorageObject
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *StorageObject) Update() *StorageObjectUpdateOne {
+	return NewStorageObjectClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the StorageObject entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *StorageObject) Unwrap() *StorageObject {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: StorageObject is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *StorageObject) String() string {
+	var builder strings.Builder
+	builder.WriteString("StorageObject(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreatedAt; v != nil {
+		builder.WriteString("created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdatedAt; v != nil {
+		builder.WriteString("updated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("dedup_scope=")
+	builder.WriteString(_m.DedupScope)
+	builder.WriteString(", ")
+	builder.WriteString("provider=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Provider))
+	builder.WriteString(", ")
+	builder.WriteString("content_hash=")
+	builder.WriteString(_m.ContentHash)
+	builder.WriteString(", ")
+	builder.WriteString("bucket_name=")
+	builder.WriteString(_m.BucketName)
+	builder.WriteString(", ")
+	builder.WriteString("object_name=")
+	builder.WriteString(_m.ObjectName)
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Size))
+	builder.WriteString(", ")
+	if v := _m.ContentType; v != nil {
+		builder.WriteString("content_type=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("ref_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RefCount))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// StorageObjects is a parsable slice of StorageObject.
+type StorageObjects []*StorageObject
@@ -0,0 +1,178 @@
+// Code generated by ent, DO NOT EDIT.
+
+package storageobject
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the storageobject type in the database.
+	Label = "storage_object"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldDedupScope holds the string denoting the dedup_scope field in the database.
+	FieldDedupScope = "dedup_scope"
+	// FieldProvider holds the string denoting the provider field in the database.
+	FieldProvider = "provider"
+	// FieldContentHash holds the string denoting the content_hash field in the database.
+	FieldContentHash = "content_hash"
+	// FieldBucketName holds the string denoting the bucket_name field in the database.
+	FieldBucketName = "bucket_name"
+	// FieldObjectName holds the string denoting the object_name field in the database.
+	FieldObjectName = "object_name"
+	// FieldSize holds the string denoting the size field in the database.
+	FieldSize = "size"
+	// FieldContentType holds the string denoting the content_type field in the database.
+	FieldContentType = "content_type"
+	// FieldRefCount holds the string denoting the ref_count field in the database.
+	FieldRefCount = "ref_count"
+	// Table holds the table name of the storageobject in the database.
+	Table = "storage_objects"
+)
+
+// Columns holds all SQL columns for storageobject fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldDedupScope,
+	FieldProvider,
+	FieldContentHash,
+	FieldBucketName,
+	FieldObjectName,
+	FieldSize,
+	FieldContentType,
+	FieldRefCount,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DedupScopeValidator is a validator for the "dedup_scope" field. It is called by the builders before save.
+	DedupScopeValidator func(string) error
+	// ContentHashValidator is a validator for the "content_hash" field. It is called by the builders before save.
+	ContentHashValidator func(string) error
+	// BucketNameValidator is a validator for the "bucket_name" field. It is called by the builders before save.
+	BucketNameValidator func(string) error
+	// ObjectNameValidator is a validator for the "object_name" field. It is called by the builders before save.
+	ObjectNameValidator func(string) error
+	// DefaultSize holds the default value on creation for the "size" field.
+	DefaultSize uint64
+	// DefaultRefCount holds the default value on creation for the "ref_count" field.
+	DefaultRefCount int32
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(uint32) error
+)
+
+// Provider defines the type for the "provider" enum field.
+type Provider string
+
+// Provider values.
+const (
+	ProviderUnknown Provider = "UNKNOWN"
+	ProviderMinIO   Provider = "MINIO"
+	ProviderAliyun  Provider = "ALIYUN"
+	ProviderQiniu   Provider = "QINIU"
+	ProviderTencent Provider = "TENCENT"
+	ProviderAWS     Provider = "AWS"
+	ProviderGoogle  Provider = "GOOGLE"
+	ProviderAzure   Provider = "AZURE"
+	ProviderBaidu   Provider = "BAIDU"
+	ProviderHuawei  Provider = "HUAWEI"
+	ProviderLocal   Provider = "LOCAL"
+)
+
+func (pr Provider) String() string {
+	return string(pr)
+}
+
+// ProviderValidator is a validator for the "provider" field enum values. It is called by the builders before save.
+func ProviderValidator(pr Provider) error {
+	switch pr {
+	case ProviderUnknown, ProviderMinIO, ProviderAliyun, ProviderQiniu, ProviderTencent, ProviderAWS, ProviderGoogle, ProviderAzure, ProviderBaidu, ProviderHuawei, ProviderLocal:
+		return nil
+	default:
+		return fmt.Errorf("storageobject: invalid enum value for provider field: %q", pr)
+	}
+}
+
+// OrderOption defines the ordering options for the StorageObject queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByDedupScope orders the results by the dedup_scope field.
+func ByDedupScope(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDedupScope, opts...).ToFunc()
+}
+
+// ByProvider orders the results by the provider field.
+func ByProvider(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProvider, opts...).ToFunc()
+}
+
+// ByContentHash orders the results by the content_hash field.
+func ByContentHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentHash, opts...).ToFunc()
+}
+
+// ByBucketName orders the results by the bucket_name field.
+func ByBucketName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBucketName, opts...).ToFunc()
+}
+
+// ByObjectName orders the results by the object_name field.
+func ByObjectName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldObjectName, opts...).ToFunc()
+}
+
+// BySize orders the results by the size field.
+func BySize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSize, opts...).ToFunc()
+}
+
+// ByContentType orders the results by the content_type field.
+func ByContentType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentType, opts...).ToFunc()
+}
+
+// ByRefCount orders the results by the ref_count field.
+func ByRefCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRefCount, opts...).ToFunc()
+}
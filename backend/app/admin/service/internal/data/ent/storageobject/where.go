@@ -0,0 +1,705 @@
+// Code generated by ent, DO NOT EDIT.
+
+package storageobject
+
+import (
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DedupScope applies equality check predicate on the "dedup_scope" field. It's identical to DedupScopeEQ.
+func DedupScope(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldEQ(FieldDedupScope, v))
+}
+
+// ContentHash applies equality check predicate on the "content_hash" field. It's identical to ContentHashEQ.
+func ContentHash(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldEQ(FieldContentHash, v))
+}
+
+// BucketName applies equality check predicate on the "bucket_name" field. It's identical to BucketNameEQ.
+func BucketName(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldEQ(FieldBucketName, v))
+}
+
+// ObjectName applies equality check predicate on the "object_name" field. It's identical to ObjectNameEQ.
+func ObjectName(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldEQ(FieldObjectName, v))
+}
+
+// Size applies equality check predicate on the "size" field. It's identical to SizeEQ.
+func Size(v uint64) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldEQ(FieldSize, v))
+}
+
+// ContentType applies equality check predicate on the "content_type" field. It's identical to ContentTypeEQ.
+func ContentType(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldEQ(FieldContentType, v))
+}
+
+// RefCount applies equality check predicate on the "ref_count" field. It's identical to RefCountEQ.
+func RefCount(v int32) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldEQ(FieldRefCount, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// DedupScopeEQ applies the EQ predicate on the "dedup_scope" field.
+func DedupScopeEQ(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldEQ(FieldDedupScope, v))
+}
+
+// DedupScopeNEQ applies the NEQ predicate on the "dedup_scope" field.
+func DedupScopeNEQ(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldNEQ(FieldDedupScope, v))
+}
+
+// DedupScopeIn applies the In predicate on the "dedup_scope" field.
+func DedupScopeIn(vs ...string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldIn(FieldDedupScope, vs...))
+}
+
+// DedupScopeNotIn applies the NotIn predicate on the "dedup_scope" field.
+func DedupScopeNotIn(vs ...string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldNotIn(FieldDedupScope, vs...))
+}
+
+// DedupScopeGT applies the GT predicate on the "dedup_scope" field.
+func DedupScopeGT(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldGT(FieldDedupScope, v))
+}
+
+// DedupScopeGTE applies the GTE predicate on the "dedup_scope" field.
+func DedupScopeGTE(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldGTE(FieldDedupScope, v))
+}
+
+// DedupScopeLT applies the LT predicate on the "dedup_scope" field.
+func DedupScopeLT(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldLT(FieldDedupScope, v))
+}
+
+// DedupScopeLTE applies the LTE predicate on the "dedup_scope" field.
+func DedupScopeLTE(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldLTE(FieldDedupScope, v))
+}
+
+// DedupScopeContains applies the Contains predicate on the "dedup_scope" field.
+func DedupScopeContains(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldContains(FieldDedupScope, v))
+}
+
+// DedupScopeHasPrefix applies the HasPrefix predicate on the "dedup_scope" field.
+func DedupScopeHasPrefix(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldHasPrefix(FieldDedupScope, v))
+}
+
+// DedupScopeHasSuffix applies the HasSuffix predicate on the "dedup_scope" field.
+func DedupScopeHasSuffix(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldHasSuffix(FieldDedupScope, v))
+}
+
+// DedupScopeEqualFold applies the EqualFold predicate on the "dedup_scope" field.
+func DedupScopeEqualFold(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldEqualFold(FieldDedupScope, v))
+}
+
+// DedupScopeContainsFold applies the ContainsFold predicate on the "dedup_scope" field.
+func DedupScopeContainsFold(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldContainsFold(FieldDedupScope, v))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v Provider) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v Provider) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...Provider) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...Provider) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// ContentHashEQ applies the EQ predicate on the "content_hash" field.
+func ContentHashEQ(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldEQ(FieldContentHash, v))
+}
+
+// ContentHashNEQ applies the NEQ predicate on the "content_hash" field.
+func ContentHashNEQ(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldNEQ(FieldContentHash, v))
+}
+
+// ContentHashIn applies the In predicate on the "content_hash" field.
+func ContentHashIn(vs ...string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldIn(FieldContentHash, vs...))
+}
+
+// ContentHashNotIn applies the NotIn predicate on the "content_hash" field.
+func ContentHashNotIn(vs ...string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldNotIn(FieldContentHash, vs...))
+}
+
+// ContentHashGT applies the GT predicate on the "content_hash" field.
+func ContentHashGT(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldGT(FieldContentHash, v))
+}
+
+// ContentHashGTE applies the GTE predicate on the "content_hash" field.
+func ContentHashGTE(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldGTE(FieldContentHash, v))
+}
+
+// ContentHashLT applies the LT predicate on the "content_hash" field.
+func ContentHashLT(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldLT(FieldContentHash, v))
+}
+
+// ContentHashLTE applies the LTE predicate on the "content_hash" field.
+func ContentHashLTE(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldLTE(FieldContentHash, v))
+}
+
+// ContentHashContains applies the Contains predicate on the "content_hash" field.
+func ContentHashContains(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldContains(FieldContentHash, v))
+}
+
+// ContentHashHasPrefix applies the HasPrefix predicate on the "content_hash" field.
+func ContentHashHasPrefix(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldHasPrefix(FieldContentHash, v))
+}
+
+// ContentHashHasSuffix applies the HasSuffix predicate on the "content_hash" field.
+func ContentHashHasSuffix(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldHasSuffix(FieldContentHash, v))
+}
+
+// ContentHashEqualFold applies the EqualFold predicate on the "content_hash" field.
+func ContentHashEqualFold(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldEqualFold(FieldContentHash, v))
+}
+
+// ContentHashContainsFold applies the ContainsFold predicate on the "content_hash" field.
+func ContentHashContainsFold(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldContainsFold(FieldContentHash, v))
+}
+
+// BucketNameEQ applies the EQ predicate on the "bucket_name" field.
+func BucketNameEQ(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldEQ(FieldBucketName, v))
+}
+
+// BucketNameNEQ applies the NEQ predicate on the "bucket_name" field.
+func BucketNameNEQ(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldNEQ(FieldBucketName, v))
+}
+
+// BucketNameIn applies the In predicate on the "bucket_name" field.
+func BucketNameIn(vs ...string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldIn(FieldBucketName, vs...))
+}
+
+// BucketNameNotIn applies the NotIn predicate on the "bucket_name" field.
+func BucketNameNotIn(vs ...string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldNotIn(FieldBucketName, vs...))
+}
+
+// BucketNameGT applies the GT predicate on the "bucket_name" field.
+func BucketNameGT(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldGT(FieldBucketName, v))
+}
+
+// BucketNameGTE applies the GTE predicate on the "bucket_name" field.
+func BucketNameGTE(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldGTE(FieldBucketName, v))
+}
+
+// BucketNameLT applies the LT predicate on the "bucket_name" field.
+func BucketNameLT(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldLT(FieldBucketName, v))
+}
+
+// BucketNameLTE applies the LTE predicate on the "bucket_name" field.
+func BucketNameLTE(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldLTE(FieldBucketName, v))
+}
+
+// BucketNameContains applies the Contains predicate on the "bucket_name" field.
+func BucketNameContains(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldContains(FieldBucketName, v))
+}
+
+// BucketNameHasPrefix applies the HasPrefix predicate on the "bucket_name" field.
+func BucketNameHasPrefix(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldHasPrefix(FieldBucketName, v))
+}
+
+// BucketNameHasSuffix applies the HasSuffix predicate on the "bucket_name" field.
+func BucketNameHasSuffix(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldHasSuffix(FieldBucketName, v))
+}
+
+// BucketNameEqualFold applies the EqualFold predicate on the "bucket_name" field.
+func BucketNameEqualFold(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldEqualFold(FieldBucketName, v))
+}
+
+// BucketNameContainsFold applies the ContainsFold predicate on the "bucket_name" field.
+func BucketNameContainsFold(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldContainsFold(FieldBucketName, v))
+}
+
+// ObjectNameEQ applies the EQ predicate on the "object_name" field.
+func ObjectNameEQ(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldEQ(FieldObjectName, v))
+}
+
+// ObjectNameNEQ applies the NEQ predicate on the "object_name" field.
+func ObjectNameNEQ(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldNEQ(FieldObjectName, v))
+}
+
+// ObjectNameIn applies the In predicate on the "object_name" field.
+func ObjectNameIn(vs ...string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldIn(FieldObjectName, vs...))
+}
+
+// ObjectNameNotIn applies the NotIn predicate on the "object_name" field.
+func ObjectNameNotIn(vs ...string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldNotIn(FieldObjectName, vs...))
+}
+
+// ObjectNameGT applies the GT predicate on the "object_name" field.
+func ObjectNameGT(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldGT(FieldObjectName, v))
+}
+
+// ObjectNameGTE applies the GTE predicate on the "object_name" field.
+func ObjectNameGTE(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldGTE(FieldObjectName, v))
+}
+
+// ObjectNameLT applies the LT predicate on the "object_name" field.
+func ObjectNameLT(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldLT(FieldObjectName, v))
+}
+
+// ObjectNameLTE applies the LTE predicate on the "object_name" field.
+func ObjectNameLTE(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldLTE(FieldObjectName, v))
+}
+
+// ObjectNameContains applies the Contains predicate on the "object_name" field.
+func ObjectNameContains(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldContains(FieldObjectName, v))
+}
+
+// ObjectNameHasPrefix applies the HasPrefix predicate on the "object_name" field.
+func ObjectNameHasPrefix(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldHasPrefix(FieldObjectName, v))
+}
+
+// ObjectNameHasSuffix applies the HasSuffix predicate on the "object_name" field.
+func ObjectNameHasSuffix(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldHasSuffix(FieldObjectName, v))
+}
+
+// ObjectNameEqualFold applies the EqualFold predicate on the "object_name" field.
+func ObjectNameEqualFold(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldEqualFold(FieldObjectName, v))
+}
+
+// ObjectNameContainsFold applies the ContainsFold predicate on the "object_name" field.
+func ObjectNameContainsFold(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldContainsFold(FieldObjectName, v))
+}
+
+// SizeEQ applies the EQ predicate on the "size" field.
+func SizeEQ(v uint64) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldEQ(FieldSize, v))
+}
+
+// SizeNEQ applies the NEQ predicate on the "size" field.
+func SizeNEQ(v uint64) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldNEQ(FieldSize, v))
+}
+
+// SizeIn applies the In predicate on the "size" field.
+func SizeIn(vs ...uint64) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldIn(FieldSize, vs...))
+}
+
+// SizeNotIn applies the NotIn predicate on the "size" field.
+func SizeNotIn(vs ...uint64) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldNotIn(FieldSize, vs...))
+}
+
+// SizeGT applies the GT predicate on the "size" field.
+func SizeGT(v uint64) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldGT(FieldSize, v))
+}
+
+// SizeGTE applies the GTE predicate on the "size" field.
+func SizeGTE(v uint64) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldGTE(FieldSize, v))
+}
+
+// SizeLT applies the LT predicate on the "size" field.
+func SizeLT(v uint64) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldLT(FieldSize, v))
+}
+
+// SizeLTE applies the LTE predicate on the "size" field.
+func SizeLTE(v uint64) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldLTE(FieldSize, v))
+}
+
+// ContentTypeEQ applies the EQ predicate on the "content_type" field.
+func ContentTypeEQ(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldEQ(FieldContentType, v))
+}
+
+// ContentTypeNEQ applies the NEQ predicate on the "content_type" field.
+func ContentTypeNEQ(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldNEQ(FieldContentType, v))
+}
+
+// ContentTypeIn applies the In predicate on the "content_type" field.
+func ContentTypeIn(vs ...string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldIn(FieldContentType, vs...))
+}
+
+// ContentTypeNotIn applies the NotIn predicate on the "content_type" field.
+func ContentTypeNotIn(vs ...string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldNotIn(FieldContentType, vs...))
+}
+
+// ContentTypeGT applies the GT predicate on the "content_type" field.
+func ContentTypeGT(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldGT(FieldContentType, v))
+}
+
+// ContentTypeGTE applies the GTE predicate on the "content_type" field.
+func ContentTypeGTE(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldGTE(FieldContentType, v))
+}
+
+// ContentTypeLT applies the LT predicate on the "content_type" field.
+func ContentTypeLT(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldLT(FieldContentType, v))
+}
+
+// ContentTypeLTE applies the LTE predicate on the "content_type" field.
+func ContentTypeLTE(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldLTE(FieldContentType, v))
+}
+
+// ContentTypeContains applies the Contains predicate on the "content_type" field.
+func ContentTypeContains(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldContains(FieldContentType, v))
+}
+
+// ContentTypeHasPrefix applies the HasPrefix predicate on the "content_type" field.
+func ContentTypeHasPrefix(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldHasPrefix(FieldContentType, v))
+}
+
+// ContentTypeHasSuffix applies the HasSuffix predicate on the "content_type" field.
+func ContentTypeHasSuffix(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldHasSuffix(FieldContentType, v))
+}
+
+// ContentTypeIsNil applies the IsNil predicate on the "content_type" field.
+func ContentTypeIsNil() predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldIsNull(FieldContentType))
+}
+
+// ContentTypeNotNil applies the NotNil predicate on the "content_type" field.
+func ContentTypeNotNil() predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldNotNull(FieldContentType))
+}
+
+// ContentTypeEqualFold applies the EqualFold predicate on the "content_type" field.
+func ContentTypeEqualFold(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldEqualFold(FieldContentType, v))
+}
+
+// ContentTypeContainsFold applies the ContainsFold predicate on the "content_type" field.
+func ContentTypeContainsFold(v string) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldContainsFold(FieldContentType, v))
+}
+
+// RefCountEQ applies the EQ predicate on the "ref_count" field.
+func RefCountEQ(v int32) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldEQ(FieldRefCount, v))
+}
+
+// RefCountNEQ applies the NEQ predicate on the "ref_count" field.
+func RefCountNEQ(v int32) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldNEQ(FieldRefCount, v))
+}
+
+// RefCountIn applies the In predicate on the "ref_count" field.
+func RefCountIn(vs ...int32) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldIn(FieldRefCount, vs...))
+}
+
+// RefCountNotIn applies the NotIn predicate on the "ref_count" field.
+func RefCountNotIn(vs ...int32) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldNotIn(FieldRefCount, vs...))
+}
+
+// RefCountGT applies the GT predicate on the "ref_count" field.
+func RefCountGT(v int32) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldGT(FieldRefCount, v))
+}
+
+// RefCountGTE applies the GTE predicate on the "ref_count" field.
+func RefCountGTE(v int32) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldGTE(FieldRefCount, v))
+}
+
+// RefCountLT applies the LT predicate on the "ref_count" field.
+func RefCountLT(v int32) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldLT(FieldRefCount, v))
+}
+
+// RefCountLTE applies the LTE predicate on the "ref_count" field.
+func RefCountLTE(v int32) predicate.StorageObject {
+	return predicate.StorageObject(sql.FieldLTE(FieldRefCount, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.StorageObject) predicate.StorageObject {
+	return predicate.StorageObject(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.StorageObject) predicate.StorageObject {
+	return predicate.StorageObject(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.StorageObject) predicate.StorageObject {
+	return predicate.StorageObject(sql.NotPredicates(p))
+}
@@ -0,0 +1,1161 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/storageobject"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// StorageObjectCreate is the builder for creating a StorageObject entity.
+type StorageObjectCreate struct {
+	config
+	mutation *StorageObjectMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *StorageObjectCreate) SetCreatedAt(v time.Time) *StorageObjectCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *StorageObjectCreate) SetNillableCreatedAt(v *time.Time) *StorageObjectCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *StorageObjectCreate) SetUpdatedAt(v time.Time) *StorageObjectCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *StorageObjectCreate) SetNillableUpdatedAt(v *time.Time) *StorageObjectCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *StorageObjectCreate) SetDeletedAt(v time.Time) *StorageObjectCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *StorageObjectCreate) SetNillableDeletedAt(v *time.Time) *StorageObjectCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetDedupScope sets the "dedup_scope" field.
+func (_c *StorageObjectCreate) SetDedupScope(v string) *StorageObjectCreate {
+	_c.mutation.SetDedupScope(v)
+	return _c
+}
+
+// SetProvider sets the "provider" field.
+func (_c *StorageObjectCreate) SetProvider(v storageobject.Provider) *StorageObjectCreate {
+	_c.mutation.SetProvider(v)
+	return _c
+}
+
+// SetContentHash sets the "content_hash" field.
+func (_c *StorageObjectCreate) SetContentHash(v string) *StorageObjectCreate {
+	_c.mutation.SetContentHash(v)
+	return _c
+}
+
+// SetBucketName sets the "bucket_name" field.
+func (_c *StorageObjectCreate) SetBucketName(v string) *StorageObjectCreate {
+	_c.mutation.SetBucketName(v)
+	return _c
+}
+
+// SetObjectName sets the "object_name" field.
+func (_c *StorageObjectCreate) SetObjectName(v string) *StorageObjectCreate {
+	_c.mutation.SetObjectName(v)
+	return _c
+}
+
+// SetSize sets the "size" field.
+func (_c *StorageObjectCreate) SetSize(v uint64) *StorageObjectCreate {
+	_c.mutation.SetSize(v)
+	return _c
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_c *StorageObjectCreate) SetNillableSize(v *uint64) *StorageObjectCreate {
+	if v != nil {
+		_c.SetSize(*v)
+	}
+	return _c
+}
+
+// SetContentType sets the "content_type" field.
+func (_c *StorageObjectCreate) SetContentType(v string) *StorageObjectCreate {
+	_c.mutation.SetContentType(v)
+	return _c
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (_c *StorageObjectCreate) SetNillableContentType(v *string) *StorageObjectCreate {
+	if v != nil {
+		_c.SetContentType(*v)
+	}
+	return _c
+}
+
+// SetRefCount sets the "ref_count" field.
+func (_c *StorageObjectCreate) SetRefCount(v int32) *StorageObjectCreate {
+	_c.mutation.SetRefCount(v)
+	return _c
+}
+
+// SetNillableRefCount sets the "ref_count" field if the given value is not nil.
+func (_c *StorageObjectCreate) SetNillableRefCount(v *int32) *StorageObjectCreate {
+	if v != nil {
+		_c.SetRefCount(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *StorageObjectCreate) SetID(v uint32) *StorageObjectCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the StorageObjectMutation object of the builder.
+func (_c *StorageObjectCreate) Mutation() *StorageObjectMutation {
+	return _c.mutation
+}
+
+// Save creates the StorageObject in the database.
+func (_c *StorageObjectCreate) Save(ctx context.Context) (*StorageObject, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *StorageObjectCreate) SaveX(ctx context.Context) *StorageObject {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StorageObjectCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StorageObjectCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *StorageObjectCreate) defaults() {
+	if _, ok := _c.mutation.Size(); !ok {
+		v := storageobject.DefaultSize
+		_c.mutation.SetSize(v)
+	}
+	if _, ok := _c.mutation.RefCount(); !ok {
+		v := storageobject.DefaultRefCount
+		_c.mutation.SetRefCount(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *StorageObjectCreate) check() error {
+	if _, ok := _c.mutation.DedupScope(); !ok {
+		return &ValidationError{Name: "dedup_scope", err: errors.New(`ent: missing required field "StorageObject.dedup_scope"`)}
+	}
+	if v, ok := _c.mutation.DedupScope(); ok {
+		if err := storageobject.DedupScopeValidator(v); err != nil {
+			return &ValidationError{Name: "dedup_scope", err: fmt.Errorf(`ent: validator failed for field "StorageObject.dedup_scope": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "StorageObject.provider"`)}
+	}
+	if v, ok := _c.mutation.Provider(); ok {
+		if err := storageobject.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "StorageObject.provider": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ContentHash(); !ok {
+		return &ValidationError{Name: "content_hash", err: errors.New(`ent: missing required field "StorageObject.content_hash"`)}
+	}
+	if v, ok := _c.mutation.ContentHash(); ok {
+		if err := storageobject.ContentHashValidator(v); err != nil {
+			return &ValidationError{Name: "content_hash", err: fmt.Errorf(`ent: validator failed for field "StorageObject.content_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BucketName(); !ok {
+		return &ValidationError{Name: "bucket_name", err: errors.New(`ent: missing required field "StorageObject.bucket_name"`)}
+	}
+	if v, ok := _c.mutation.BucketName(); ok {
+		if err := storageobject.BucketNameValidator(v); err != nil {
+			return &ValidationError{Name: "bucket_name", err: fmt.Errorf(`ent: validator failed for field "StorageObject.bucket_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ObjectName(); !ok {
+		return &ValidationError{Name: "object_name", err: errors.New(`ent: missing required field "StorageObject.object_name"`)}
+	}
+	if v, ok := _c.mutation.ObjectName(); ok {
+		if err := storageobject.ObjectNameValidator(v); err != nil {
+			return &ValidationError{Name: "object_name", err: fmt.Errorf(`ent: validator failed for field "StorageObject.object_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "StorageObject.size"`)}
+	}
+	if _, ok := _c.mutation.RefCount(); !ok {
+		return &ValidationError{Name: "ref_count", err: errors.New(`ent: missing required field "StorageObject.ref_count"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := storageobject.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "StorageObject.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *StorageObjectCreate) sqlSave(ctx context.Context) (*StorageObject, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *StorageObjectCreate) createSpec() (*StorageObject, *sqlgraph.CreateSpec) {
+	var (
+		_node = &StorageObject{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(storageobject.Table, sqlgraph.NewFieldSpec(storageobject.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(storageobject.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(storageobject.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(storageobject.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.DedupScope(); ok {
+		_spec.SetField(storageobject.FieldDedupScope, field.TypeString, value)
+		_node.DedupScope = value
+	}
+	if value, ok := _c.mutation.Provider(); ok {
+		_spec.SetField(storageobject.FieldProvider, field.TypeEnum, value)
+		_node.Provider = value
+	}
+	if value, ok := _c.mutation.ContentHash(); ok {
+		_spec.SetField(storageobject.FieldContentHash, field.TypeString, value)
+		_node.ContentHash = value
+	}
+	if value, ok := _c.mutation.BucketName(); ok {
+		_spec.SetField(storageobject.FieldBucketName, field.TypeString, value)
+		_node.BucketName = value
+	}
+	if value, ok := _c.mutation.ObjectName(); ok {
+		_spec.SetField(storageobject.FieldObjectName, field.TypeString, value)
+		_node.ObjectName = value
+	}
+	if value, ok := _c.mutation.Size(); ok {
+		_spec.SetField(storageobject.FieldSize, field.TypeUint64, value)
+		_node.Size = value
+	}
+	if value, ok := _c.mutation.ContentType(); ok {
+		_spec.SetField(storageobject.FieldContentType, field.TypeString, value)
+		_node.ContentType = &value
+	}
+	if value, ok := _c.mutation.RefCount(); ok {
+		_spec.SetField(storageobject.FieldRefCount, field.TypeInt32, value)
+		_node.RefCount = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.StorageObject.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.StorageObjectUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *StorageObjectCreate) OnConflict(opts ...sql.ConflictOption) *StorageObjectUpsertOne {
+	_c.conflict = opts
+	return &StorageObjectUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.StorageObject.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *StorageObjectCreate) OnConflictColumns(columns ...string) *StorageObjectUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &StorageObjectUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// StorageObjectUpsertOne is the builder for "upsert"-ing
+	//  one StorageObject node.
+	StorageObjectUpsertOne struct {
+		create *StorageObjectCreate
+	}
+
+	// StorageObjectUpsert is the "OnConflict" setter.
+	StorageObjectUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *StorageObjectUpsert) SetUpdatedAt(v time.Time) *StorageObjectUpsert {
+	u.Set(storageobject.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *StorageObjectUpsert) UpdateUpdatedAt() *StorageObjectUpsert {
+	u.SetExcluded(storageobject.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *StorageObjectUpsert) ClearUpdatedAt() *StorageObjectUpsert {
+	u.SetNull(storageobject.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *StorageObjectUpsert) SetDeletedAt(v time.Time) *StorageObjectUpsert {
+	u.Set(storageobject.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *StorageObjectUpsert) UpdateDeletedAt() *StorageObjectUpsert {
+	u.SetExcluded(storageobject.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *StorageObjectUpsert) ClearDeletedAt() *StorageObjectUpsert {
+	u.SetNull(storageobject.FieldDeletedAt)
+	return u
+}
+
+// SetDedupScope sets the "dedup_scope" field.
+func (u *StorageObjectUpsert) SetDedupScope(v string) *StorageObjectUpsert {
+	u.Set(storageobject.FieldDedupScope, v)
+	return u
+}
+
+// UpdateDedupScope sets the "dedup_scope" field to the value that was provided on create.
+func (u *StorageObjectUpsert) UpdateDedupScope() *StorageObjectUpsert {
+	u.SetExcluded(storageobject.FieldDedupScope)
+	return u
+}
+
+// SetProvider sets the "provider" field.
+func (u *StorageObjectUpsert) SetProvider(v storageobject.Provider) *StorageObjectUpsert {
+	u.Set(storageobject.FieldProvider, v)
+	return u
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *StorageObjectUpsert) UpdateProvider() *StorageObjectUpsert {
+	u.SetExcluded(storageobject.FieldProvider)
+	return u
+}
+
+// SetContentHash sets the "content_hash" field.
+func (u *StorageObjectUpsert) SetContentHash(v string) *StorageObjectUpsert {
+	u.Set(storageobject.FieldContentHash, v)
+	return u
+}
+
+// UpdateContentHash sets the "content_hash" field to the value that was provided on create.
+func (u *StorageObjectUpsert) UpdateContentHash() *StorageObjectUpsert {
+	u.SetExcluded(storageobject.FieldContentHash)
+	return u
+}
+
+// SetBucketName sets the "bucket_name" field.
+func (u *StorageObjectUpsert) SetBucketName(v string) *StorageObjectUpsert {
+	u.Set(storageobject.FieldBucketName, v)
+	return u
+}
+
+// UpdateBucketName sets the "bucket_name" field to the value that was provided on create.
+func (u *StorageObjectUpsert) UpdateBucketName() *StorageObjectUpsert {
+	u.SetExcluded(storageobject.FieldBucketName)
+	return u
+}
+
+// SetObjectName sets the "object_name" field.
+func (u *StorageObjectUpsert) SetObjectName(v string) *StorageObjectUpsert {
+	u.Set(storageobject.FieldObjectName, v)
+	return u
+}
+
+// UpdateObjectName sets the "object_name" field to the value that was provided on create.
+func (u *StorageObjectUpsert) UpdateObjectName() *StorageObjectUpsert {
+	u.SetExcluded(storageobject.FieldObjectName)
+	return u
+}
+
+// SetSize sets the "size" field.
+func (u *StorageObjectUpsert) SetSize(v uint64) *StorageObjectUpsert {
+	u.Set(storageobject.FieldSize, v)
+	return u
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *StorageObjectUpsert) UpdateSize() *StorageObjectUpsert {
+	u.SetExcluded(storageobject.FieldSize)
+	return u
+}
+
+// AddSize adds v to the "size" field.
+func (u *StorageObjectUpsert) AddSize(v uint64) *StorageObjectUpsert {
+	u.Add(storageobject.FieldSize, v)
+	return u
+}
+
+// SetContentType sets the "content_type" field.
+func (u *StorageObjectUpsert) SetContentType(v string) *StorageObjectUpsert {
+	u.Set(storageobject.FieldContentType, v)
+	return u
+}
+
+// UpdateContentType sets the "content_type" field to the value that was provided on create.
+func (u *StorageObjectUpsert) UpdateContentType() *StorageObjectUpsert {
+	u.SetExcluded(storageobject.FieldContentType)
+	return u
+}
+
+// ClearContentType clears the value of the "content_type" field.
+func (u *StorageObjectUpsert) ClearContentType() *StorageObjectUpsert {
+	u.SetNull(storageobject.FieldContentType)
+	return u
+}
+
+// SetRefCount sets the "ref_count" field.
+func (u *StorageObjectUpsert) SetRefCount(v int32) *StorageObjectUpsert {
+	u.Set(storageobject.FieldRefCount, v)
+	return u
+}
+
+// UpdateRefCount sets the "ref_count" field to the value that was provided on create.
+func (u *StorageObjectUpsert) UpdateRefCount() *StorageObjectUpsert {
+	u.SetExcluded(storageobject.FieldRefCount)
+	return u
+}
+
+// AddRefCount adds v to the "ref_count" field.
+func (u *StorageObjectUpsert) AddRefCount(v int32) *StorageObjectUpsert {
+	u.Add(storageobject.FieldRefCount, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.StorageObject.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(storageobject.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *StorageObjectUpsertOne) UpdateNewValues() *StorageObjectUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(storageobject.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(storageobject.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.StorageObject.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *StorageObjectUpsertOne) Ignore() *StorageObjectUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *StorageObjectUpsertOne) DoNothing() *StorageObjectUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the StorageObjectCreate.OnConflict
+// documentation for more info.
+func (u *StorageObjectUpsertOne) Update(set func(*StorageObjectUpsert)) *StorageObjectUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&StorageObjectUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *StorageObjectUpsertOne) SetUpdatedAt(v time.Time) *StorageObjectUpsertOne {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *StorageObjectUpsertOne) UpdateUpdatedAt() *StorageObjectUpsertOne {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *StorageObjectUpsertOne) ClearUpdatedAt() *StorageObjectUpsertOne {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *StorageObjectUpsertOne) SetDeletedAt(v time.Time) *StorageObjectUpsertOne {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *StorageObjectUpsertOne) UpdateDeletedAt() *StorageObjectUpsertOne {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *StorageObjectUpsertOne) ClearDeletedAt() *StorageObjectUpsertOne {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetDedupScope sets the "dedup_scope" field.
+func (u *StorageObjectUpsertOne) SetDedupScope(v string) *StorageObjectUpsertOne {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.SetDedupScope(v)
+	})
+}
+
+// UpdateDedupScope sets the "dedup_scope" field to the value that was provided on create.
+func (u *StorageObjectUpsertOne) UpdateDedupScope() *StorageObjectUpsertOne {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.UpdateDedupScope()
+	})
+}
+
+// SetProvider sets the "provider" field.
+func (u *StorageObjectUpsertOne) SetProvider(v storageobject.Provider) *StorageObjectUpsertOne {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *StorageObjectUpsertOne) UpdateProvider() *StorageObjectUpsertOne {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetContentHash sets the "content_hash" field.
+func (u *StorageObjectUpsertOne) SetContentHash(v string) *StorageObjectUpsertOne {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.SetContentHash(v)
+	})
+}
+
+// UpdateContentHash sets the "content_hash" field to the value that was provided on create.
+func (u *StorageObjectUpsertOne) UpdateContentHash() *StorageObjectUpsertOne {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.UpdateContentHash()
+	})
+}
+
+// SetBucketName sets the "bucket_name" field.
+func (u *StorageObjectUpsertOne) SetBucketName(v string) *StorageObjectUpsertOne {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.SetBucketName(v)
+	})
+}
+
+// UpdateBucketName sets the "bucket_name" field to the value that was provided on create.
+func (u *StorageObjectUpsertOne) UpdateBucketName() *StorageObjectUpsertOne {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.UpdateBucketName()
+	})
+}
+
+// SetObjectName sets the "object_name" field.
+func (u *StorageObjectUpsertOne) SetObjectName(v string) *StorageObjectUpsertOne {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.SetObjectName(v)
+	})
+}
+
+// UpdateObjectName sets the "object_name" field to the value that was provided on create.
+func (u *StorageObjectUpsertOne) UpdateObjectName() *StorageObjectUpsertOne {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.UpdateObjectName()
+	})
+}
+
+// SetSize sets the "size" field.
+func (u *StorageObjectUpsertOne) SetSize(v uint64) *StorageObjectUpsertOne {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.SetSize(v)
+	})
+}
+
+// AddSize adds v to the "size" field.
+func (u *StorageObjectUpsertOne) AddSize(v uint64) *StorageObjectUpsertOne {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.AddSize(v)
+	})
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *StorageObjectUpsertOne) UpdateSize() *StorageObjectUpsertOne {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.UpdateSize()
+	})
+}
+
+// SetContentType sets the "content_type" field.
+func (u *StorageObjectUpsertOne) SetContentType(v string) *StorageObjectUpsertOne {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.SetContentType(v)
+	})
+}
+
+// UpdateContentType sets the "content_type" field to the value that was provided on create.
+func (u *StorageObjectUpsertOne) UpdateContentType() *StorageObjectUpsertOne {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.UpdateContentType()
+	})
+}
+
+// ClearContentType clears the value of the "content_type" field.
+func (u *StorageObjectUpsertOne) ClearContentType() *StorageObjectUpsertOne {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.ClearContentType()
+	})
+}
+
+// SetRefCount sets the "ref_count" field.
+func (u *StorageObjectUpsertOne) SetRefCount(v int32) *StorageObjectUpsertOne {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.SetRefCount(v)
+	})
+}
+
+// AddRefCount adds v to the "ref_count" field.
+func (u *StorageObjectUpsertOne) AddRefCount(v int32) *StorageObjectUpsertOne {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.AddRefCount(v)
+	})
+}
+
+// UpdateRefCount sets the "ref_count" field to the value that was provided on create.
+func (u *StorageObjectUpsertOne) UpdateRefCount() *StorageObjectUpsertOne {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.UpdateRefCount()
+	})
+}
+
+// Exec executes the query.
+func (u *StorageObjectUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for StorageObjectCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *StorageObjectUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *StorageObjectUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *StorageObjectUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// StorageObjectCreateBulk is the builder for creating many StorageObject entities in bulk.
+type StorageObjectCreateBulk struct {
+	config
+	err      error
+	builders []*StorageObjectCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the StorageObject entities in the database.
+func (_c *StorageObjectCreateBulk) Save(ctx context.Context) ([]*StorageObject, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*StorageObject, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StorageObjectMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *StorageObjectCreateBulk) SaveX(ctx context.Context) []*StorageObject {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StorageObjectCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StorageObjectCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.StorageObject.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.StorageObjectUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *StorageObjectCreateBulk) OnConflict(opts ...sql.ConflictOption) *StorageObjectUpsertBulk {
+	_c.conflict = opts
+	return &StorageObjectUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.StorageObject.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *StorageObjectCreateBulk) OnConflictColumns(columns ...string) *StorageObjectUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &StorageObjectUpsertBulk{
+		create: _c,
+	}
+}
+
+// StorageObjectUpsertBulk is the builder for "upsert"-ing
+// a bulk of StorageObject nodes.
+type StorageObjectUpsertBulk struct {
+	create *StorageObjectCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.StorageObject.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(storageobject.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *StorageObjectUpsertBulk) UpdateNewValues() *StorageObjectUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(storageobject.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(storageobject.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.StorageObject.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *StorageObjectUpsertBulk) Ignore() *StorageObjectUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *StorageObjectUpsertBulk) DoNothing() *StorageObjectUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the StorageObjectCreateBulk.OnConflict
+// documentation for more info.
+func (u *StorageObjectUpsertBulk) Update(set func(*StorageObjectUpsert)) *StorageObjectUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&StorageObjectUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *StorageObjectUpsertBulk) SetUpdatedAt(v time.Time) *StorageObjectUpsertBulk {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *StorageObjectUpsertBulk) UpdateUpdatedAt() *StorageObjectUpsertBulk {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *StorageObjectUpsertBulk) ClearUpdatedAt() *StorageObjectUpsertBulk {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *StorageObjectUpsertBulk) SetDeletedAt(v time.Time) *StorageObjectUpsertBulk {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *StorageObjectUpsertBulk) UpdateDeletedAt() *StorageObjectUpsertBulk {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *StorageObjectUpsertBulk) ClearDeletedAt() *StorageObjectUpsertBulk {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetDedupScope sets the "dedup_scope" field.
+func (u *StorageObjectUpsertBulk) SetDedupScope(v string) *StorageObjectUpsertBulk {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.SetDedupScope(v)
+	})
+}
+
+// UpdateDedupScope sets the "dedup_scope" field to the value that was provided on create.
+func (u *StorageObjectUpsertBulk) UpdateDedupScope() *StorageObjectUpsertBulk {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.UpdateDedupScope()
+	})
+}
+
+// SetProvider sets the "provider" field.
+func (u *StorageObjectUpsertBulk) SetProvider(v storageobject.Provider) *StorageObjectUpsertBulk {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *StorageObjectUpsertBulk) UpdateProvider() *StorageObjectUpsertBulk {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetContentHash sets the "content_hash" field.
+func (u *StorageObjectUpsertBulk) SetContentHash(v string) *StorageObjectUpsertBulk {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.SetContentHash(v)
+	})
+}
+
+// UpdateContentHash sets the "content_hash" field to the value that was provided on create.
+func (u *StorageObjectUpsertBulk) UpdateContentHash() *StorageObjectUpsertBulk {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.UpdateContentHash()
+	})
+}
+
+// SetBucketName sets the "bucket_name" field.
+func (u *StorageObjectUpsertBulk) SetBucketName(v string) *StorageObjectUpsertBulk {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.SetBucketName(v)
+	})
+}
+
+// UpdateBucketName sets the "bucket_name" field to the value that was provided on create.
+func (u *StorageObjectUpsertBulk) UpdateBucketName() *StorageObjectUpsertBulk {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.UpdateBucketName()
+	})
+}
+
+// SetObjectName sets the "object_name" field.
+func (u *StorageObjectUpsertBulk) SetObjectName(v string) *StorageObjectUpsertBulk {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.SetObjectName(v)
+	})
+}
+
+// UpdateObjectName sets the "object_name" field to the value that was provided on create.
+func (u *StorageObjectUpsertBulk) UpdateObjectName() *StorageObjectUpsertBulk {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.UpdateObjectName()
+	})
+}
+
+// SetSize sets the "size" field.
+func (u *StorageObjectUpsertBulk) SetSize(v uint64) *StorageObjectUpsertBulk {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.SetSize(v)
+	})
+}
+
+// AddSize adds v to the "size" field.
+func (u *StorageObjectUpsertBulk) AddSize(v uint64) *StorageObjectUpsertBulk {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.AddSize(v)
+	})
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *StorageObjectUpsertBulk) UpdateSize() *StorageObjectUpsertBulk {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.UpdateSize()
+	})
+}
+
+// SetContentType sets the "content_type" field.
+func (u *StorageObjectUpsertBulk) SetContentType(v string) *StorageObjectUpsertBulk {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.SetContentType(v)
+	})
+}
+
+// UpdateContentType sets the "content_type" field to the value that was provided on create.
+func (u *StorageObjectUpsertBulk) UpdateContentType() *StorageObjectUpsertBulk {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.UpdateContentType()
+	})
+}
+
+// ClearContentType clears the value of the "content_type" field.
+func (u *StorageObjectUpsertBulk) ClearContentType() *StorageObjectUpsertBulk {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.ClearContentType()
+	})
+}
+
+// SetRefCount sets the "ref_count" field.
+func (u *StorageObjectUpsertBulk) SetRefCount(v int32) *StorageObjectUpsertBulk {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.SetRefCount(v)
+	})
+}
+
+// AddRefCount adds v to the "ref_count" field.
+func (u *StorageObjectUpsertBulk) AddRefCount(v int32) *StorageObjectUpsertBulk {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.AddRefCount(v)
+	})
+}
+
+// UpdateRefCount sets the "ref_count" field to the value that was provided on create.
+func (u *StorageObjectUpsertBulk) UpdateRefCount() *StorageObjectUpsertBulk {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.UpdateRefCount()
+	})
+}
+
+// Exec executes the query.
+func (u *StorageObjectUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the StorageObjectCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for StorageObjectCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *StorageObjectUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,698 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"go-wind-admin/app/admin/service/internal/data/ent/storageobject"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// StorageObjectUpdate is the builder for updating StorageObject entities.
+type StorageObjectUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *StorageObjectMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the StorageObjectUpdate builder.
+func (_u *StorageObjectUpdate) Where(ps ...predicate.StorageObject) *StorageObjectUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *StorageObjectUpdate) SetUpdatedAt(v time.Time) *StorageObjectUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *StorageObjectUpdate) SetNillableUpdatedAt(v *time.Time) *StorageObjectUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *StorageObjectUpdate) ClearUpdatedAt() *StorageObjectUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *StorageObjectUpdate) SetDeletedAt(v time.Time) *StorageObjectUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *StorageObjectUpdate) SetNillableDeletedAt(v *time.Time) *StorageObjectUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *StorageObjectUpdate) ClearDeletedAt() *StorageObjectUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetDedupScope sets the "dedup_scope" field.
+func (_u *StorageObjectUpdate) SetDedupScope(v string) *StorageObjectUpdate {
+	_u.mutation.SetDedupScope(v)
+	return _u
+}
+
+// SetNillableDedupScope sets the "dedup_scope" field if the given value is not nil.
+func (_u *StorageObjectUpdate) SetNillableDedupScope(v *string) *StorageObjectUpdate {
+	if v != nil {
+		_u.SetDedupScope(*v)
+	}
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *StorageObjectUpdate) SetProvider(v storageobject.Provider) *StorageObjectUpdate {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *StorageObjectUpdate) SetNillableProvider(v *storageobject.Provider) *StorageObjectUpdate {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetContentHash sets the "content_hash" field.
+func (_u *StorageObjectUpdate) SetContentHash(v string) *StorageObjectUpdate {
+	_u.mutation.SetContentHash(v)
+	return _u
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (_u *StorageObjectUpdate) SetNillableContentHash(v *string) *StorageObjectUpdate {
+	if v != nil {
+		_u.SetContentHash(*v)
+	}
+	return _u
+}
+
+// SetBucketName sets the "bucket_name" field.
+func (_u *StorageObjectUpdate) SetBucketName(v string) *StorageObjectUpdate {
+	_u.mutation.SetBucketName(v)
+	return _u
+}
+
+// SetNillableBucketName sets the "bucket_name" field if the given value is not nil.
+func (_u *StorageObjectUpdate) SetNillableBucketName(v *string) *StorageObjectUpdate {
+	if v != nil {
+		_u.SetBucketName(*v)
+	}
+	return _u
+}
+
+// SetObjectName sets the "object_name" field.
+func (_u *StorageObjectUpdate) SetObjectName(v string) *StorageObjectUpdate {
+	_u.mutation.SetObjectName(v)
+	return _u
+}
+
+// SetNillableObjectName sets the "object_name" field if the given value is not nil.
+func (_u *StorageObjectUpdate) SetNillableObjectName(v *string) *StorageObjectUpdate {
+	if v != nil {
+		_u.SetObjectName(*v)
+	}
+	return _u
+}
+
+// SetSize sets the "size" field.
+func (_u *StorageObjectUpdate) SetSize(v uint64) *StorageObjectUpdate {
+	_u.mutation.ResetSize()
+	_u.mutation.SetSize(v)
+	return _u
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_u *StorageObjectUpdate) SetNillableSize(v *uint64) *StorageObjectUpdate {
+	if v != nil {
+		_u.SetSize(*v)
+	}
+	return _u
+}
+
+// AddSize adds value to the "size" field.
+func (_u *StorageObjectUpdate) AddSize(v int64) *StorageObjectUpdate {
+	_u.mutation.AddSize(v)
+	return _u
+}
+
+// SetContentType sets the "content_type" field.
+func (_u *StorageObjectUpdate) SetContentType(v string) *StorageObjectUpdate {
+	_u.mutation.SetContentType(v)
+	return _u
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (_u *StorageObjectUpdate) SetNillableContentType(v *string) *StorageObjectUpdate {
+	if v != nil {
+		_u.SetContentType(*v)
+	}
+	return _u
+}
+
+// ClearContentType clears the value of the "content_type" field.
+func (_u *StorageObjectUpdate) ClearContentType() *StorageObjectUpdate {
+	_u.mutation.ClearContentType()
+	return _u
+}
+
+// SetRefCount sets the "ref_count" field.
+func (_u *StorageObjectUpdate) SetRefCount(v int32) *StorageObjectUpdate {
+	_u.mutation.ResetRefCount()
+	_u.mutation.SetRefCount(v)
+	return _u
+}
+
+// SetNillableRefCount sets the "ref_count" field if the given value is not nil.
+func (_u *StorageObjectUpdate) SetNillableRefCount(v *int32) *StorageObjectUpdate {
+	if v != nil {
+		_u.SetRefCount(*v)
+	}
+	return _u
+}
+
+// AddRefCount adds value to the "ref_count" field.
+func (_u *StorageObjectUpdate) AddRefCount(v int32) *StorageObjectUpdate {
+	_u.mutation.AddRefCount(v)
+	return _u
+}
+
+// Mutation returns the StorageObjectMutation object of the builder.
+func (_u *StorageObjectUpdate) Mutation() *StorageObjectMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *StorageObjectUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StorageObjectUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *StorageObjectUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StorageObjectUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *StorageObjectUpdate) check() error {
+	if v, ok := _u.mutation.DedupScope(); ok {
+		if err := storageobject.DedupScopeValidator(v); err != nil {
+			return &ValidationError{Name: "dedup_scope", err: fmt.Errorf(`ent: validator failed for field "StorageObject.dedup_scope": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Provider(); ok {
+		if err := storageobject.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "StorageObject.provider": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ContentHash(); ok {
+		if err := storageobject.ContentHashValidator(v); err != nil {
+			return &ValidationError{Name: "content_hash", err: fmt.Errorf(`ent: validator failed for field "StorageObject.content_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BucketName(); ok {
+		if err := storageobject.BucketNameValidator(v); err != nil {
+			return &ValidationError{Name: "bucket_name", err: fmt.Errorf(`ent: validator failed for field "StorageObject.bucket_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ObjectName(); ok {
+		if err := storageobject.ObjectNameValidator(v); err != nil {
+			return &ValidationError{Name: "object_name", err: fmt.Errorf(`ent: validator failed for field "StorageObject.object_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *StorageObjectUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *StorageObjectUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *StorageObjectUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(storageobject.Table, storageobject.Columns, sqlgraph.NewFieldSpec(storageobject.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(storageobject.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(storageobject.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(storageobject.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(storageobject.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(storageobject.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DedupScope(); ok {
+		_spec.SetField(storageobject.FieldDedupScope, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(storageobject.FieldProvider, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ContentHash(); ok {
+		_spec.SetField(storageobject.FieldContentHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BucketName(); ok {
+		_spec.SetField(storageobject.FieldBucketName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ObjectName(); ok {
+		_spec.SetField(storageobject.FieldObjectName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Size(); ok {
+		_spec.SetField(storageobject.FieldSize, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedSize(); ok {
+		_spec.AddField(storageobject.FieldSize, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.ContentType(); ok {
+		_spec.SetField(storageobject.FieldContentType, field.TypeString, value)
+	}
+	if _u.mutation.ContentTypeCleared() {
+		_spec.ClearField(storageobject.FieldContentType, field.TypeString)
+	}
+	if value, ok := _u.mutation.RefCount(); ok {
+		_spec.SetField(storageobject.FieldRefCount, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedRefCount(); ok {
+		_spec.AddField(storageobject.FieldRefCount, field.TypeInt32, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{storageobject.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// StorageObjectUpdateOne is the builder for updating a single StorageObject entity.
+type StorageObjectUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *StorageObjectMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *StorageObjectUpdateOne) SetUpdatedAt(v time.Time) *StorageObjectUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *StorageObjectUpdateOne) SetNillableUpdatedAt(v *time.Time) *StorageObjectUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *StorageObjectUpdateOne) ClearUpdatedAt() *StorageObjectUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *StorageObjectUpdateOne) SetDeletedAt(v time.Time) *StorageObjectUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *StorageObjectUpdateOne) SetNillableDeletedAt(v *time.Time) *StorageObjectUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *StorageObjectUpdateOne) ClearDeletedAt() *StorageObjectUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetDedupScope sets the "dedup_scope" field.
+func (_u *StorageObjectUpdateOne) SetDedupScope(v string) *StorageObjectUpdateOne {
+	_u.mutation.SetDedupScope(v)
+	return _u
+}
+
+// SetNillableDedupScope sets the "dedup_scope" field if the given value is not nil.
+func (_u *StorageObjectUpdateOne) SetNillableDedupScope(v *string) *StorageObjectUpdateOne {
+	if v != nil {
+		_u.SetDedupScope(*v)
+	}
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *StorageObjectUpdateOne) SetProvider(v storageobject.Provider) *StorageObjectUpdateOne {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *StorageObjectUpdateOne) SetNillableProvider(v *storageobject.Provider) *StorageObjectUpdateOne {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetContentHash sets the "content_hash" field.
+func (_u *StorageObjectUpdateOne) SetContentHash(v string) *StorageObjectUpdateOne {
+	_u.mutation.SetContentHash(v)
+	return _u
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (_u *StorageObjectUpdateOne) SetNillableContentHash(v *string) *StorageObjectUpdateOne {
+	if v != nil {
+		_u.SetContentHash(*v)
+	}
+	return _u
+}
+
+// SetBucketName sets the "bucket_name" field.
+func (_u *StorageObjectUpdateOne) SetBucketName(v string) *StorageObjectUpdateOne {
+	_u.mutation.SetBucketName(v)
+	return _u
+}
+
+// SetNillableBucketName sets the "bucket_name" field if the given value is not nil.
+func (_u *StorageObjectUpdateOne) SetNillableBucketName(v *string) *StorageObjectUpdateOne {
+	if v != nil {
+		_u.SetBucketName(*v)
+	}
+	return _u
+}
+
+// SetObjectName sets the "object_name" field.
+func (_u *StorageObjectUpdateOne) SetObjectName(v string) *StorageObjectUpdateOne {
+	_u.mutation.SetObjectName(v)
+	return _u
+}
+
+// SetNillableObjectName sets the "object_name" field if the given value is not nil.
+func (_u *StorageObjectUpdateOne) SetNillableObjectName(v *string) *StorageObjectUpdateOne {
+	if v != nil {
+		_u.SetObjectName(*v)
+	}
+	return _u
+}
+
+// SetSize sets the "size" field.
+func (_u *StorageObjectUpdateOne) SetSize(v uint64) *StorageObjectUpdateOne {
+	_u.mutation.ResetSize()
+	_u.mutation.SetSize(v)
+	return _u
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_u *StorageObjectUpdateOne) SetNillableSize(v *uint64) *StorageObjectUpdateOne {
+	if v != nil {
+		_u.SetSize(*v)
+	}
+	return _u
+}
+
+// AddSize adds value to the "size" field.
+func (_u *StorageObjectUpdateOne) AddSize(v int64) *StorageObjectUpdateOne {
+	_u.mutation.AddSize(v)
+	return _u
+}
+
+// SetContentType sets the "content_type" field.
+func (_u *StorageObjectUpdateOne) SetContentType(v string) *StorageObjectUpdateOne {
+	_u.mutation.SetContentType(v)
+	return _u
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (_u *StorageObjectUpdateOne) SetNillableContentType(v *string) *StorageObjectUpdateOne {
+	if v != nil {
+		_u.SetContentType(*v)
+	}
+	return _u
+}
+
+// ClearContentType clears the value of the "content_type" field.
+func (_u *StorageObjectUpdateOne) ClearContentType() *StorageObjectUpdateOne {
+	_u.mutation.ClearContentType()
+	return _u
+}
+
+// SetRefCount sets the "ref_count" field.
+func (_u *StorageObjectUpdateOne) SetRefCount(v int32) *StorageObjectUpdateOne {
+	_u.mutation.ResetRefCount()
+	_u.mutation.SetRefCount(v)
+	return _u
+}
+
+// SetNillableRefCount sets the "ref_count" field if the given value is not nil.
+func (_u *StorageObjectUpdateOne) SetNillableRefCount(v *int32) *StorageObjectUpdateOne {
+	if v != nil {
+		_u.SetRefCount(*v)
+	}
+	return _u
+}
+
+// AddRefCount adds value to the "ref_count" field.
+func (_u *StorageObjectUpdateOne) AddRefCount(v int32) *StorageObjectUpdateOne {
+	_u.mutation.AddRefCount(v)
+	return _u
+}
+
+// Mutation returns the StorageObjectMutation object of the builder.
+func (_u *StorageObjectUpdateOne) Mutation() *StorageObjectMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the StorageObjectUpdate builder.
+func (_u *StorageObjectUpdateOne) Where(ps ...predicate.StorageObject) *StorageObjectUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *StorageObjectUpdateOne) Select(field string, fields ...string) *StorageObjectUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated StorageObject entity.
+func (_u *StorageObjectUpdateOne) Save(ctx context.Context) (*StorageObject, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StorageObjectUpdateOne) SaveX(ctx context.Context) *StorageObject {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *StorageObjectUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StorageObjectUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *StorageObjectUpdateOne) check() error {
+	if v, ok := _u.mutation.DedupScope(); ok {
+		if err := storageobject.DedupScopeValidator(v); err != nil {
+			return &ValidationError{Name: "dedup_scope", err: fmt.Errorf(`ent: validator failed for field "StorageObject.dedup_scope": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Provider(); ok {
+		if err := storageobject.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "StorageObject.provider": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ContentHash(); ok {
+		if err := storageobject.ContentHashValidator(v); err != nil {
+			return &ValidationError{Name: "content_hash", err: fmt.Errorf(`ent: validator failed for field "StorageObject.content_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BucketName(); ok {
+		if err := storageobject.BucketNameValidator(v); err != nil {
+			return &ValidationError{Name: "bucket_name", err: fmt.Errorf(`ent: validator failed for field "StorageObject.bucket_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ObjectName(); ok {
+		if err := storageobject.ObjectNameValidator(v); err != nil {
+			return &ValidationError{Name: "object_name", err: fmt.Errorf(`ent: validator failed for field "StorageObject.object_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *StorageObjectUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *StorageObjectUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *StorageObjectUpdateOne) sqlSave(ctx context.Context) (_node *StorageObject, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(storageobject.Table, storageobject.Columns, sqlgraph.NewFieldSpec(storageobject.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "StorageObject.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, storageobject.FieldID)
+		for _, f := range fields {
+			if !storageobject.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != storageobject.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(storageobject.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(storageobject.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(storageobject.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(storageobject.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(storageobject.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DedupScope(); ok {
+		_spec.SetField(storageobject.FieldDedupScope, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(storageobject.FieldProvider, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ContentHash(); ok {
+		_spec.SetField(storageobject.FieldContentHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BucketName(); ok {
+		_spec.SetField(storageobject.FieldBucketName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ObjectName(); ok {
+		_spec.SetField(storageobject.FieldObjectName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Size(); ok {
+		_spec.SetField(storageobject.FieldSize, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedSize(); ok {
+		_spec.AddField(storageobject.FieldSize, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.ContentType(); ok {
+		_spec.SetField(storageobject.FieldContentType, field.TypeString, value)
+	}
+	if _u.mutation.ContentTypeCleared() {
+		_spec.ClearField(storageobject.FieldContentType, field.TypeString)
+	}
+	if value, ok := _u.mutation.RefCount(); ok {
+		_spec.SetField(storageobject.FieldRefCount, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedRefCount(); ok {
+		_spec.AddField(storageobject.FieldRefCount, field.TypeInt32, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &StorageObject{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{storageobject.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,800 @@
+// Code generated by ent, DO NOT EDIT.
+
+package storagequota
+
+import (
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// DeletedBy applies equality check predicate on the "deleted_by" field. It's identical to DeletedByEQ.
+func DeletedBy(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldEQ(FieldTenantID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldEQ(FieldUserID, v))
+}
+
+// MaxBytes applies equality check predicate on the "max_bytes" field. It's identical to MaxBytesEQ.
+func MaxBytes(v int64) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldEQ(FieldMaxBytes, v))
+}
+
+// MaxFiles applies equality check predicate on the "max_files" field. It's identical to MaxFilesEQ.
+func MaxFiles(v int64) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldEQ(FieldMaxFiles, v))
+}
+
+// UsedBytes applies equality check predicate on the "used_bytes" field. It's identical to UsedBytesEQ.
+func UsedBytes(v int64) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldEQ(FieldUsedBytes, v))
+}
+
+// UsedFiles applies equality check predicate on the "used_files" field. It's identical to UsedFilesEQ.
+func UsedFiles(v int64) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldEQ(FieldUsedFiles, v))
+}
+
+// NotifiedPercent applies equality check predicate on the "notified_percent" field. It's identical to NotifiedPercentEQ.
+func NotifiedPercent(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldEQ(FieldNotifiedPercent, v))
+}
+
+// ReconciledAt applies equality check predicate on the "reconciled_at" field. It's identical to ReconciledAtEQ.
+func ReconciledAt(v time.Time) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldEQ(FieldReconciledAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// DeletedByEQ applies the EQ predicate on the "deleted_by" field.
+func DeletedByEQ(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// DeletedByNEQ applies the NEQ predicate on the "deleted_by" field.
+func DeletedByNEQ(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldNEQ(FieldDeletedBy, v))
+}
+
+// DeletedByIn applies the In predicate on the "deleted_by" field.
+func DeletedByIn(vs ...uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByNotIn applies the NotIn predicate on the "deleted_by" field.
+func DeletedByNotIn(vs ...uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldNotIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByGT applies the GT predicate on the "deleted_by" field.
+func DeletedByGT(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldGT(FieldDeletedBy, v))
+}
+
+// DeletedByGTE applies the GTE predicate on the "deleted_by" field.
+func DeletedByGTE(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldGTE(FieldDeletedBy, v))
+}
+
+// DeletedByLT applies the LT predicate on the "deleted_by" field.
+func DeletedByLT(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldLT(FieldDeletedBy, v))
+}
+
+// DeletedByLTE applies the LTE predicate on the "deleted_by" field.
+func DeletedByLTE(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldLTE(FieldDeletedBy, v))
+}
+
+// DeletedByIsNil applies the IsNil predicate on the "deleted_by" field.
+func DeletedByIsNil() predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldIsNull(FieldDeletedBy))
+}
+
+// DeletedByNotNil applies the NotNil predicate on the "deleted_by" field.
+func DeletedByNotNil() predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldNotNull(FieldDeletedBy))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldNotNull(FieldTenantID))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldLTE(FieldUserID, v))
+}
+
+// MaxBytesEQ applies the EQ predicate on the "max_bytes" field.
+func MaxBytesEQ(v int64) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldEQ(FieldMaxBytes, v))
+}
+
+// MaxBytesNEQ applies the NEQ predicate on the "max_bytes" field.
+func MaxBytesNEQ(v int64) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldNEQ(FieldMaxBytes, v))
+}
+
+// MaxBytesIn applies the In predicate on the "max_bytes" field.
+func MaxBytesIn(vs ...int64) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldIn(FieldMaxBytes, vs...))
+}
+
+// MaxBytesNotIn applies the NotIn predicate on the "max_bytes" field.
+func MaxBytesNotIn(vs ...int64) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldNotIn(FieldMaxBytes, vs...))
+}
+
+// MaxBytesGT applies the GT predicate on the "max_bytes" field.
+func MaxBytesGT(v int64) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldGT(FieldMaxBytes, v))
+}
+
+// MaxBytesGTE applies the GTE predicate on the "max_bytes" field.
+func MaxBytesGTE(v int64) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldGTE(FieldMaxBytes, v))
+}
+
+// MaxBytesLT applies the LT predicate on the "max_bytes" field.
+func MaxBytesLT(v int64) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldLT(FieldMaxBytes, v))
+}
+
+// MaxBytesLTE applies the LTE predicate on the "max_bytes" field.
+func MaxBytesLTE(v int64) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldLTE(FieldMaxBytes, v))
+}
+
+// MaxBytesIsNil applies the IsNil predicate on the "max_bytes" field.
+func MaxBytesIsNil() predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldIsNull(FieldMaxBytes))
+}
+
+// MaxBytesNotNil applies the NotNil predicate on the "max_bytes" field.
+func MaxBytesNotNil() predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldNotNull(FieldMaxBytes))
+}
+
+// MaxFilesEQ applies the EQ predicate on the "max_files" field.
+func MaxFilesEQ(v int64) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldEQ(FieldMaxFiles, v))
+}
+
+// MaxFilesNEQ applies the NEQ predicate on the "max_files" field.
+func MaxFilesNEQ(v int64) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldNEQ(FieldMaxFiles, v))
+}
+
+// MaxFilesIn applies the In predicate on the "max_files" field.
+func MaxFilesIn(vs ...int64) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldIn(FieldMaxFiles, vs...))
+}
+
+// MaxFilesNotIn applies the NotIn predicate on the "max_files" field.
+func MaxFilesNotIn(vs ...int64) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldNotIn(FieldMaxFiles, vs...))
+}
+
+// MaxFilesGT applies the GT predicate on the "max_files" field.
+func MaxFilesGT(v int64) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldGT(FieldMaxFiles, v))
+}
+
+// MaxFilesGTE applies the GTE predicate on the "max_files" field.
+func MaxFilesGTE(v int64) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldGTE(FieldMaxFiles, v))
+}
+
+// MaxFilesLT applies the LT predicate on the "max_files" field.
+func MaxFilesLT(v int64) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldLT(FieldMaxFiles, v))
+}
+
+// MaxFilesLTE applies the LTE predicate on the "max_files" field.
+func MaxFilesLTE(v int64) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldLTE(FieldMaxFiles, v))
+}
+
+// MaxFilesIsNil applies the IsNil predicate on the "max_files" field.
+func MaxFilesIsNil() predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldIsNull(FieldMaxFiles))
+}
+
+// MaxFilesNotNil applies the NotNil predicate on the "max_files" field.
+func MaxFilesNotNil() predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldNotNull(FieldMaxFiles))
+}
+
+// UsedBytesEQ applies the EQ predicate on the "used_bytes" field.
+func UsedBytesEQ(v int64) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldEQ(FieldUsedBytes, v))
+}
+
+// UsedBytesNEQ applies the NEQ predicate on the "used_bytes" field.
+func UsedBytesNEQ(v int64) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldNEQ(FieldUsedBytes, v))
+}
+
+// UsedBytesIn applies the In predicate on the "used_bytes" field.
+func UsedBytesIn(vs ...int64) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldIn(FieldUsedBytes, vs...))
+}
+
+// UsedBytesNotIn applies the NotIn predicate on the "used_bytes" field.
+func UsedBytesNotIn(vs ...int64) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldNotIn(FieldUsedBytes, vs...))
+}
+
+// UsedBytesGT applies the GT predicate on the "used_bytes" field.
+func UsedBytesGT(v int64) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldGT(FieldUsedBytes, v))
+}
+
+// UsedBytesGTE applies the GTE predicate on the "used_bytes" field.
+func UsedBytesGTE(v int64) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldGTE(FieldUsedBytes, v))
+}
+
+// UsedBytesLT applies the LT predicate on the "used_bytes" field.
+func UsedBytesLT(v int64) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldLT(FieldUsedBytes, v))
+}
+
+// UsedBytesLTE applies the LTE predicate on the "used_bytes" field.
+func UsedBytesLTE(v int64) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldLTE(FieldUsedBytes, v))
+}
+
+// UsedFilesEQ applies the EQ predicate on the "used_files" field.
+func UsedFilesEQ(v int64) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldEQ(FieldUsedFiles, v))
+}
+
+// UsedFilesNEQ applies the NEQ predicate on the "used_files" field.
+func UsedFilesNEQ(v int64) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldNEQ(FieldUsedFiles, v))
+}
+
+// UsedFilesIn applies the In predicate on the "used_files" field.
+func UsedFilesIn(vs ...int64) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldIn(FieldUsedFiles, vs...))
+}
+
+// UsedFilesNotIn applies the NotIn predicate on the "used_files" field.
+func UsedFilesNotIn(vs ...int64) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldNotIn(FieldUsedFiles, vs...))
+}
+
+// UsedFilesGT applies the GT predicate on the "used_files" field.
+func UsedFilesGT(v int64) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldGT(FieldUsedFiles, v))
+}
+
+// UsedFilesGTE applies the GTE predicate on the "used_files" field.
+func UsedFilesGTE(v int64) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldGTE(FieldUsedFiles, v))
+}
+
+// UsedFilesLT applies the LT predicate on the "used_files" field.
+func UsedFilesLT(v int64) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldLT(FieldUsedFiles, v))
+}
+
+// UsedFilesLTE applies the LTE predicate on the "used_files" field.
+func UsedFilesLTE(v int64) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldLTE(FieldUsedFiles, v))
+}
+
+// NotifiedPercentEQ applies the EQ predicate on the "notified_percent" field.
+func NotifiedPercentEQ(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldEQ(FieldNotifiedPercent, v))
+}
+
+// NotifiedPercentNEQ applies the NEQ predicate on the "notified_percent" field.
+func NotifiedPercentNEQ(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldNEQ(FieldNotifiedPercent, v))
+}
+
+// NotifiedPercentIn applies the In predicate on the "notified_percent" field.
+func NotifiedPercentIn(vs ...uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldIn(FieldNotifiedPercent, vs...))
+}
+
+// NotifiedPercentNotIn applies the NotIn predicate on the "notified_percent" field.
+func NotifiedPercentNotIn(vs ...uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldNotIn(FieldNotifiedPercent, vs...))
+}
+
+// NotifiedPercentGT applies the GT predicate on the "notified_percent" field.
+func NotifiedPercentGT(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldGT(FieldNotifiedPercent, v))
+}
+
+// NotifiedPercentGTE applies the GTE predicate on the "notified_percent" field.
+func NotifiedPercentGTE(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldGTE(FieldNotifiedPercent, v))
+}
+
+// NotifiedPercentLT applies the LT predicate on the "notified_percent" field.
+func NotifiedPercentLT(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldLT(FieldNotifiedPercent, v))
+}
+
+// NotifiedPercentLTE applies the LTE predicate on the "notified_percent" field.
+func NotifiedPercentLTE(v uint32) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldLTE(FieldNotifiedPercent, v))
+}
+
+// ReconciledAtEQ applies the EQ predicate on the "reconciled_at" field.
+func ReconciledAtEQ(v time.Time) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldEQ(FieldReconciledAt, v))
+}
+
+// ReconciledAtNEQ applies the NEQ predicate on the "reconciled_at" field.
+func ReconciledAtNEQ(v time.Time) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldNEQ(FieldReconciledAt, v))
+}
+
+// ReconciledAtIn applies the In predicate on the "reconciled_at" field.
+func ReconciledAtIn(vs ...time.Time) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldIn(FieldReconciledAt, vs...))
+}
+
+// ReconciledAtNotIn applies the NotIn predicate on the "reconciled_at" field.
+func ReconciledAtNotIn(vs ...time.Time) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldNotIn(FieldReconciledAt, vs...))
+}
+
+// ReconciledAtGT applies the GT predicate on the "reconciled_at" field.
+func ReconciledAtGT(v time.Time) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldGT(FieldReconciledAt, v))
+}
+
+// ReconciledAtGTE applies the GTE predicate on the "reconciled_at" field.
+func ReconciledAtGTE(v time.Time) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldGTE(FieldReconciledAt, v))
+}
+
+// ReconciledAtLT applies the LT predicate on the "reconciled_at" field.
+func ReconciledAtLT(v time.Time) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldLT(FieldReconciledAt, v))
+}
+
+// ReconciledAtLTE applies the LTE predicate on the "reconciled_at" field.
+func ReconciledAtLTE(v time.Time) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldLTE(FieldReconciledAt, v))
+}
+
+// ReconciledAtIsNil applies the IsNil predicate on the "reconciled_at" field.
+func ReconciledAtIsNil() predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldIsNull(FieldReconciledAt))
+}
+
+// ReconciledAtNotNil applies the NotNil predicate on the "reconciled_at" field.
+func ReconciledAtNotNil() predicate.StorageQuota {
+	return predicate.StorageQuota(sql.FieldNotNull(FieldReconciledAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.StorageQuota) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.StorageQuota) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.StorageQuota) predicate.StorageQuota {
+	return predicate.StorageQuota(sql.NotPredicates(p))
+}
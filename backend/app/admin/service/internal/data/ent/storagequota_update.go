@@ -0,0 +1,936 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"go-wind-admin/app/admin/service/internal/data/ent/storagequota"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// StorageQuotaUpdate is the builder for updating StorageQuota entities.
+type StorageQuotaUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *StorageQuotaMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the StorageQuotaUpdate builder.
+func (_u *StorageQuotaUpdate) Where(ps ...predicate.StorageQuota) *StorageQuotaUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *StorageQuotaUpdate) SetUpdatedAt(v time.Time) *StorageQuotaUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *StorageQuotaUpdate) SetNillableUpdatedAt(v *time.Time) *StorageQuotaUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *StorageQuotaUpdate) ClearUpdatedAt() *StorageQuotaUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *StorageQuotaUpdate) SetDeletedAt(v time.Time) *StorageQuotaUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *StorageQuotaUpdate) SetNillableDeletedAt(v *time.Time) *StorageQuotaUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *StorageQuotaUpdate) ClearDeletedAt() *StorageQuotaUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *StorageQuotaUpdate) SetCreatedBy(v uint32) *StorageQuotaUpdate {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *StorageQuotaUpdate) SetNillableCreatedBy(v *uint32) *StorageQuotaUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *StorageQuotaUpdate) AddCreatedBy(v int32) *StorageQuotaUpdate {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *StorageQuotaUpdate) ClearCreatedBy() *StorageQuotaUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *StorageQuotaUpdate) SetUpdatedBy(v uint32) *StorageQuotaUpdate {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *StorageQuotaUpdate) SetNillableUpdatedBy(v *uint32) *StorageQuotaUpdate {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *StorageQuotaUpdate) AddUpdatedBy(v int32) *StorageQuotaUpdate {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *StorageQuotaUpdate) ClearUpdatedBy() *StorageQuotaUpdate {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *StorageQuotaUpdate) SetDeletedBy(v uint32) *StorageQuotaUpdate {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *StorageQuotaUpdate) SetNillableDeletedBy(v *uint32) *StorageQuotaUpdate {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *StorageQuotaUpdate) AddDeletedBy(v int32) *StorageQuotaUpdate {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *StorageQuotaUpdate) ClearDeletedBy() *StorageQuotaUpdate {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *StorageQuotaUpdate) SetUserID(v uint32) *StorageQuotaUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *StorageQuotaUpdate) SetNillableUserID(v *uint32) *StorageQuotaUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *StorageQuotaUpdate) AddUserID(v int32) *StorageQuotaUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetMaxBytes sets the "max_bytes" field.
+func (_u *StorageQuotaUpdate) SetMaxBytes(v int64) *StorageQuotaUpdate {
+	_u.mutation.ResetMaxBytes()
+	_u.mutation.SetMaxBytes(v)
+	return _u
+}
+
+// SetNillableMaxBytes sets the "max_bytes" field if the given value is not nil.
+func (_u *StorageQuotaUpdate) SetNillableMaxBytes(v *int64) *StorageQuotaUpdate {
+	if v != nil {
+		_u.SetMaxBytes(*v)
+	}
+	return _u
+}
+
+// AddMaxBytes adds value to the "max_bytes" field.
+func (_u *StorageQuotaUpdate) AddMaxBytes(v int64) *StorageQuotaUpdate {
+	_u.mutation.AddMaxBytes(v)
+	return _u
+}
+
+// ClearMaxBytes clears the value of the "max_bytes" field.
+func (_u *StorageQuotaUpdate) ClearMaxBytes() *StorageQuotaUpdate {
+	_u.mutation.ClearMaxBytes()
+	return _u
+}
+
+// SetMaxFiles sets the "max_files" field.
+func (_u *StorageQuotaUpdate) SetMaxFiles(v int64) *StorageQuotaUpdate {
+	_u.mutation.ResetMaxFiles()
+	_u.mutation.SetMaxFiles(v)
+	return _u
+}
+
+// SetNillableMaxFiles sets the "max_files" field if the given value is not nil.
+func (_u *StorageQuotaUpdate) SetNillableMaxFiles(v *int64) *StorageQuotaUpdate {
+	if v != nil {
+		_u.SetMaxFiles(*v)
+	}
+	return _u
+}
+
+// AddMaxFiles adds value to the "max_files" field.
+func (_u *StorageQuotaUpdate) AddMaxFiles(v int64) *StorageQuotaUpdate {
+	_u.mutation.AddMaxFiles(v)
+	return _u
+}
+
+// ClearMaxFiles clears the value of the "max_files" field.
+func (_u *StorageQuotaUpdate) ClearMaxFiles() *StorageQuotaUpdate {
+	_u.mutation.ClearMaxFiles()
+	return _u
+}
+
+// SetUsedBytes sets the "used_bytes" field.
+func (_u *StorageQuotaUpdate) SetUsedBytes(v int64) *StorageQuotaUpdate {
+	_u.mutation.ResetUsedBytes()
+	_u.mutation.SetUsedBytes(v)
+	return _u
+}
+
+// SetNillableUsedBytes sets the "used_bytes" field if the given value is not nil.
+func (_u *StorageQuotaUpdate) SetNillableUsedBytes(v *int64) *StorageQuotaUpdate {
+	if v != nil {
+		_u.SetUsedBytes(*v)
+	}
+	return _u
+}
+
+// AddUsedBytes adds value to the "used_bytes" field.
+func (_u *StorageQuotaUpdate) AddUsedBytes(v int64) *StorageQuotaUpdate {
+	_u.mutation.AddUsedBytes(v)
+	return _u
+}
+
+// SetUsedFiles sets the "used_files" field.
+func (_u *StorageQuotaUpdate) SetUsedFiles(v int64) *StorageQuotaUpdate {
+	_u.mutation.ResetUsedFiles()
+	_u.mutation.SetUsedFiles(v)
+	return _u
+}
+
+// SetNillableUsedFiles sets the "used_files" field if the given value is not nil.
+func (_u *StorageQuotaUpdate) SetNillableUsedFiles(v *int64) *StorageQuotaUpdate {
+	if v != nil {
+		_u.SetUsedFiles(*v)
+	}
+	return _u
+}
+
+// AddUsedFiles adds value to the "used_files" field.
+func (_u *StorageQuotaUpdate) AddUsedFiles(v int64) *StorageQuotaUpdate {
+	_u.mutation.AddUsedFiles(v)
+	return _u
+}
+
+// SetNotifiedPercent sets the "notified_percent" field.
+func (_u *StorageQuotaUpdate) SetNotifiedPercent(v uint32) *StorageQuotaUpdate {
+	_u.mutation.ResetNotifiedPercent()
+	_u.mutation.SetNotifiedPercent(v)
+	return _u
+}
+
+// SetNillableNotifiedPercent sets the "notified_percent" field if the given value is not nil.
+func (_u *StorageQuotaUpdate) SetNillableNotifiedPercent(v *uint32) *StorageQuotaUpdate {
+	if v != nil {
+		_u.SetNotifiedPercent(*v)
+	}
+	return _u
+}
+
+// AddNotifiedPercent adds value to the "notified_percent" field.
+func (_u *StorageQuotaUpdate) AddNotifiedPercent(v int32) *StorageQuotaUpdate {
+	_u.mutation.AddNotifiedPercent(v)
+	return _u
+}
+
+// SetReconciledAt sets the "reconciled_at" field.
+func (_u *StorageQuotaUpdate) SetReconciledAt(v time.Time) *StorageQuotaUpdate {
+	_u.mutation.SetReconciledAt(v)
+	return _u
+}
+
+// SetNillableReconciledAt sets the "reconciled_at" field if the given value is not nil.
+func (_u *StorageQuotaUpdate) SetNillableReconciledAt(v *time.Time) *StorageQuotaUpdate {
+	if v != nil {
+		_u.SetReconciledAt(*v)
+	}
+	return _u
+}
+
+// ClearReconciledAt clears the value of the "reconciled_at" field.
+func (_u *StorageQuotaUpdate) ClearReconciledAt() *StorageQuotaUpdate {
+	_u.mutation.ClearReconciledAt()
+	return _u
+}
+
+// Mutation returns the StorageQuotaMutation object of the builder.
+func (_u *StorageQuotaUpdate) Mutation() *StorageQuotaMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *StorageQuotaUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StorageQuotaUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *StorageQuotaUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StorageQuotaUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *StorageQuotaUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *StorageQuotaUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *StorageQuotaUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(storagequota.Table, storagequota.Columns, sqlgraph.NewFieldSpec(storagequota.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(storagequota.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(storagequota.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(storagequota.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(storagequota.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(storagequota.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(storagequota.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(storagequota.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(storagequota.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(storagequota.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(storagequota.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(storagequota.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(storagequota.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(storagequota.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(storagequota.FieldDeletedBy, field.TypeUint32)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(storagequota.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(storagequota.FieldUserID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(storagequota.FieldUserID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.MaxBytes(); ok {
+		_spec.SetField(storagequota.FieldMaxBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxBytes(); ok {
+		_spec.AddField(storagequota.FieldMaxBytes, field.TypeInt64, value)
+	}
+	if _u.mutation.MaxBytesCleared() {
+		_spec.ClearField(storagequota.FieldMaxBytes, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.MaxFiles(); ok {
+		_spec.SetField(storagequota.FieldMaxFiles, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxFiles(); ok {
+		_spec.AddField(storagequota.FieldMaxFiles, field.TypeInt64, value)
+	}
+	if _u.mutation.MaxFilesCleared() {
+		_spec.ClearField(storagequota.FieldMaxFiles, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.UsedBytes(); ok {
+		_spec.SetField(storagequota.FieldUsedBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUsedBytes(); ok {
+		_spec.AddField(storagequota.FieldUsedBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.UsedFiles(); ok {
+		_spec.SetField(storagequota.FieldUsedFiles, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUsedFiles(); ok {
+		_spec.AddField(storagequota.FieldUsedFiles, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.NotifiedPercent(); ok {
+		_spec.SetField(storagequota.FieldNotifiedPercent, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedNotifiedPercent(); ok {
+		_spec.AddField(storagequota.FieldNotifiedPercent, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.ReconciledAt(); ok {
+		_spec.SetField(storagequota.FieldReconciledAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReconciledAtCleared() {
+		_spec.ClearField(storagequota.FieldReconciledAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{storagequota.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// StorageQuotaUpdateOne is the builder for updating a single StorageQuota entity.
+type StorageQuotaUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *StorageQuotaMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *StorageQuotaUpdateOne) SetUpdatedAt(v time.Time) *StorageQuotaUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *StorageQuotaUpdateOne) SetNillableUpdatedAt(v *time.Time) *StorageQuotaUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *StorageQuotaUpdateOne) ClearUpdatedAt() *StorageQuotaUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *StorageQuotaUpdateOne) SetDeletedAt(v time.Time) *StorageQuotaUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *StorageQuotaUpdateOne) SetNillableDeletedAt(v *time.Time) *StorageQuotaUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *StorageQuotaUpdateOne) ClearDeletedAt() *StorageQuotaUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *StorageQuotaUpdateOne) SetCreatedBy(v uint32) *StorageQuotaUpdateOne {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *StorageQuotaUpdateOne) SetNillableCreatedBy(v *uint32) *StorageQuotaUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *StorageQuotaUpdateOne) AddCreatedBy(v int32) *StorageQuotaUpdateOne {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *StorageQuotaUpdateOne) ClearCreatedBy() *StorageQuotaUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *StorageQuotaUpdateOne) SetUpdatedBy(v uint32) *StorageQuotaUpdateOne {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *StorageQuotaUpdateOne) SetNillableUpdatedBy(v *uint32) *StorageQuotaUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *StorageQuotaUpdateOne) AddUpdatedBy(v int32) *StorageQuotaUpdateOne {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *StorageQuotaUpdateOne) ClearUpdatedBy() *StorageQuotaUpdateOne {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *StorageQuotaUpdateOne) SetDeletedBy(v uint32) *StorageQuotaUpdateOne {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *StorageQuotaUpdateOne) SetNillableDeletedBy(v *uint32) *StorageQuotaUpdateOne {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *StorageQuotaUpdateOne) AddDeletedBy(v int32) *StorageQuotaUpdateOne {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *StorageQuotaUpdateOne) ClearDeletedBy() *StorageQuotaUpdateOne {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *StorageQuotaUpdateOne) SetUserID(v uint32) *StorageQuotaUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *StorageQuotaUpdateOne) SetNillableUserID(v *uint32) *StorageQuotaUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *StorageQuotaUpdateOne) AddUserID(v int32) *StorageQuotaUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetMaxBytes sets the "max_bytes" field.
+func (_u *StorageQuotaUpdateOne) SetMaxBytes(v int64) *StorageQuotaUpdateOne {
+	_u.mutation.ResetMaxBytes()
+	_u.mutation.SetMaxBytes(v)
+	return _u
+}
+
+// SetNillableMaxBytes sets the "max_bytes" field if the given value is not nil.
+func (_u *StorageQuotaUpdateOne) SetNillableMaxBytes(v *int64) *StorageQuotaUpdateOne {
+	if v != nil {
+		_u.SetMaxBytes(*v)
+	}
+	return _u
+}
+
+// AddMaxBytes adds value to the "max_bytes" field.
+func (_u *StorageQuotaUpdateOne) AddMaxBytes(v int64) *StorageQuotaUpdateOne {
+	_u.mutation.AddMaxBytes(v)
+	return _u
+}
+
+// ClearMaxBytes clears the value of the "max_bytes" field.
+func (_u *StorageQuotaUpdateOne) ClearMaxBytes() *StorageQuotaUpdateOne {
+	_u.mutation.ClearMaxBytes()
+	return _u
+}
+
+// SetMaxFiles sets the "max_files" field.
+func (_u *StorageQuotaUpdateOne) SetMaxFiles(v int64) *StorageQuotaUpdateOne {
+	_u.mutation.ResetMaxFiles()
+	_u.mutation.SetMaxFiles(v)
+	return _u
+}
+
+// SetNillableMaxFiles sets the "max_files" field if the given value is not nil.
+func (_u *StorageQuotaUpdateOne) SetNillableMaxFiles(v *int64) *StorageQuotaUpdateOne {
+	if v != nil {
+		_u.SetMaxFiles(*v)
+	}
+	return _u
+}
+
+// AddMaxFiles adds value to the "max_files" field.
+func (_u *StorageQuotaUpdateOne) AddMaxFiles(v int64) *StorageQuotaUpdateOne {
+	_u.mutation.AddMaxFiles(v)
+	return _u
+}
+
+// ClearMaxFiles clears the value of the "max_files" field.
+func (_u *StorageQuotaUpdateOne) ClearMaxFiles() *StorageQuotaUpdateOne {
+	_u.mutation.ClearMaxFiles()
+	return _u
+}
+
+// SetUsedBytes sets the "used_bytes" field.
+func (_u *StorageQuotaUpdateOne) SetUsedBytes(v int64) *StorageQuotaUpdateOne {
+	_u.mutation.ResetUsedBytes()
+	_u.mutation.SetUsedBytes(v)
+	return _u
+}
+
+// SetNillableUsedBytes sets the "used_bytes" field if the given value is not nil.
+func (_u *StorageQuotaUpdateOne) SetNillableUsedBytes(v *int64) *StorageQuotaUpdateOne {
+	if v != nil {
+		_u.SetUsedBytes(*v)
+	}
+	return _u
+}
+
+// AddUsedBytes adds value to the "used_bytes" field.
+func (_u *StorageQuotaUpdateOne) AddUsedBytes(v int64) *StorageQuotaUpdateOne {
+	_u.mutation.AddUsedBytes(v)
+	return _u
+}
+
+// SetUsedFiles sets the "used_files" field.
+func (_u *StorageQuotaUpdateOne) SetUsedFiles(v int64) *StorageQuotaUpdateOne {
+	_u.mutation.ResetUsedFiles()
+	_u.mutation.SetUsedFiles(v)
+	return _u
+}
+
+// SetNillableUsedFiles sets the "used_files" field if the given value is not nil.
+func (_u *StorageQuotaUpdateOne) SetNillableUsedFiles(v *int64) *StorageQuotaUpdateOne {
+	if v != nil {
+		_u.SetUsedFiles(*v)
+	}
+	return _u
+}
+
+// AddUsedFiles adds value to the "used_files" field.
+func (_u *StorageQuotaUpdateOne) AddUsedFiles(v int64) *StorageQuotaUpdateOne {
+	_u.mutation.AddUsedFiles(v)
+	return _u
+}
+
+// SetNotifiedPercent sets the "notified_percent" field.
+func (_u *StorageQuotaUpdateOne) SetNotifiedPercent(v uint32) *StorageQuotaUpdateOne {
+	_u.mutation.ResetNotifiedPercent()
+	_u.mutation.SetNotifiedPercent(v)
+	return _u
+}
+
+// SetNillableNotifiedPercent sets the "notified_percent" field if the given value is not nil.
+func (_u *StorageQuotaUpdateOne) SetNillableNotifiedPercent(v *uint32) *StorageQuotaUpdateOne {
+	if v != nil {
+		_u.SetNotifiedPercent(*v)
+	}
+	return _u
+}
+
+// AddNotifiedPercent adds value to the "notified_percent" field.
+func (_u *StorageQuotaUpdateOne) AddNotifiedPercent(v int32) *StorageQuotaUpdateOne {
+	_u.mutation.AddNotifiedPercent(v)
+	return _u
+}
+
+// SetReconciledAt sets the "reconciled_at" field.
+func (_u *StorageQuotaUpdateOne) SetReconciledAt(v time.Time) *StorageQuotaUpdateOne {
+	_u.mutation.SetReconciledAt(v)
+	return _u
+}
+
+// SetNillableReconciledAt sets the "reconciled_at" field if the given value is not nil.
+func (_u *StorageQuotaUpdateOne) SetNillableReconciledAt(v *time.Time) *StorageQuotaUpdateOne {
+	if v != nil {
+		_u.SetReconciledAt(*v)
+	}
+	return _u
+}
+
+// ClearReconciledAt clears the value of the "reconciled_at" field.
+func (_u *StorageQuotaUpdateOne) ClearReconciledAt() *StorageQuotaUpdateOne {
+	_u.mutation.ClearReconciledAt()
+	return _u
+}
+
+// Mutation returns the StorageQuotaMutation object of the builder.
+func (_u *StorageQuotaUpdateOne) Mutation() *StorageQuotaMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the StorageQuotaUpdate builder.
+func (_u *StorageQuotaUpdateOne) Where(ps ...predicate.StorageQuota) *StorageQuotaUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *StorageQuotaUpdateOne) Select(field string, fields ...string) *StorageQuotaUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated StorageQuota entity.
+func (_u *StorageQuotaUpdateOne) Save(ctx context.Context) (*StorageQuota, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StorageQuotaUpdateOne) SaveX(ctx context.Context) *StorageQuota {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *StorageQuotaUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StorageQuotaUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *StorageQuotaUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *StorageQuotaUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *StorageQuotaUpdateOne) sqlSave(ctx context.Context) (_node *StorageQuota, err error) {
+	_spec := sqlgraph.NewUpdateSpec(storagequota.Table, storagequota.Columns, sqlgraph.NewFieldSpec(storagequota.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "StorageQuota.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, storagequota.FieldID)
+		for _, f := range fields {
+			if !storagequota.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != storagequota.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(storagequota.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(storagequota.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(storagequota.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(storagequota.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(storagequota.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(storagequota.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(storagequota.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(storagequota.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(storagequota.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(storagequota.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(storagequota.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(storagequota.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(storagequota.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(storagequota.FieldDeletedBy, field.TypeUint32)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(storagequota.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(storagequota.FieldUserID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(storagequota.FieldUserID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.MaxBytes(); ok {
+		_spec.SetField(storagequota.FieldMaxBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxBytes(); ok {
+		_spec.AddField(storagequota.FieldMaxBytes, field.TypeInt64, value)
+	}
+	if _u.mutation.MaxBytesCleared() {
+		_spec.ClearField(storagequota.FieldMaxBytes, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.MaxFiles(); ok {
+		_spec.SetField(storagequota.FieldMaxFiles, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxFiles(); ok {
+		_spec.AddField(storagequota.FieldMaxFiles, field.TypeInt64, value)
+	}
+	if _u.mutation.MaxFilesCleared() {
+		_spec.ClearField(storagequota.FieldMaxFiles, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.UsedBytes(); ok {
+		_spec.SetField(storagequota.FieldUsedBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUsedBytes(); ok {
+		_spec.AddField(storagequota.FieldUsedBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.UsedFiles(); ok {
+		_spec.SetField(storagequota.FieldUsedFiles, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUsedFiles(); ok {
+		_spec.AddField(storagequota.FieldUsedFiles, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.NotifiedPercent(); ok {
+		_spec.SetField(storagequota.FieldNotifiedPercent, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedNotifiedPercent(); ok {
+		_spec.AddField(storagequota.FieldNotifiedPercent, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.ReconciledAt(); ok {
+		_spec.SetField(storagequota.FieldReconciledAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReconciledAtCleared() {
+		_spec.ClearField(storagequota.FieldReconciledAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &StorageQuota{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{storagequota.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
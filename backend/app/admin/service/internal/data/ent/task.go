@@ -44,6 +44,10 @@ type Task struct {
 	TaskPayload *string `json:"task_payload,omitempty"`
 	// cron表达式
 	CronSpec *string `json:"cron_spec,omitempty"`
+	// cron表达式使用的IANA时区
+	Timezone *string `json:"timezone,omitempty"`
+	// 错过触发时间后的补偿策略
+	MisfirePolicy *task.MisfirePolicy `json:"misfire_policy,omitempty"`
 	// 任务选项
 	TaskOptions *taskpb.TaskOption `json:"task_options,omitempty"`
 	// 启用/禁用任务
@@ -62,7 +66,7 @@ func (*Task) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case task.FieldID, task.FieldCreatedBy, task.FieldUpdatedBy, task.FieldDeletedBy, task.FieldTenantID:
 			values[i] = new(sql.NullInt64)
-		case task.FieldRemark, task.FieldType, task.FieldTypeName, task.FieldTaskPayload, task.FieldCronSpec:
+		case task.FieldRemark, task.FieldType, task.FieldTypeName, task.FieldTaskPayload, task.FieldCronSpec, task.FieldTimezone, task.FieldMisfirePolicy:
 			values[i] = new(sql.NullString)
 		case task.FieldCreatedAt, task.FieldUpdatedAt, task.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
@@ -171,6 +175,20 @@ func (_m *Task) assignValues(columns []string, values []any) error {
 				_m.CronSpec = new(string)
 				*_m.CronSpec = value.String
 			}
+		case task.FieldTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field timezone", values[i])
+			} else if value.Valid {
+				_m.Timezone = new(string)
+				*_m.Timezone = value.String
+			}
+		case task.FieldMisfirePolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field misfire_policy", values[i])
+			} else if value.Valid {
+				_m.MisfirePolicy = new(task.MisfirePolicy)
+				*_m.MisfirePolicy = task.MisfirePolicy(value.String)
+			}
 		case task.FieldTaskOptions:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field task_options", values[i])
@@ -282,6 +300,16 @@ func (_m *Task) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	if v := _m.Timezone; v != nil {
+		builder.WriteString("timezone=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.MisfirePolicy; v != nil {
+		builder.WriteString("misfire_policy=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("task_options=")
 	builder.WriteString(fmt.Sprintf("%v", _m.TaskOptions))
 	builder.WriteString(", ")
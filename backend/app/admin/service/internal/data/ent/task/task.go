@@ -38,6 +38,10 @@ const (
 	FieldTaskPayload = "task_payload"
 	// FieldCronSpec holds the string denoting the cron_spec field in the database.
 	FieldCronSpec = "cron_spec"
+	// FieldTimezone holds the string denoting the timezone field in the database.
+	FieldTimezone = "timezone"
+	// FieldMisfirePolicy holds the string denoting the misfire_policy field in the database.
+	FieldMisfirePolicy = "misfire_policy"
 	// FieldTaskOptions holds the string denoting the task_options field in the database.
 	FieldTaskOptions = "task_options"
 	// FieldEnable holds the string denoting the enable field in the database.
@@ -61,6 +65,8 @@ var Columns = []string{
 	FieldTypeName,
 	FieldTaskPayload,
 	FieldCronSpec,
+	FieldTimezone,
+	FieldMisfirePolicy,
 	FieldTaskOptions,
 	FieldEnable,
 }
@@ -85,6 +91,8 @@ var (
 	Policy ent.Policy
 	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
 	DefaultTenantID uint32
+	// TimezoneValidator is a validator for the "timezone" field. It is called by the builders before save.
+	TimezoneValidator func(string) error
 	// DefaultEnable holds the default value on creation for the "enable" field.
 	DefaultEnable bool
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
@@ -118,6 +126,33 @@ func TypeValidator(_type Type) error {
 	}
 }
 
+// MisfirePolicy defines the type for the "misfire_policy" enum field.
+type MisfirePolicy string
+
+// MisfirePolicyFireOnce is the default value of the MisfirePolicy enum.
+const DefaultMisfirePolicy = MisfirePolicyFireOnce
+
+// MisfirePolicy values.
+const (
+	MisfirePolicyFireOnce MisfirePolicy = "FIRE_ONCE"
+	MisfirePolicySkip     MisfirePolicy = "SKIP"
+	MisfirePolicyCatchUp  MisfirePolicy = "CATCH_UP"
+)
+
+func (mp MisfirePolicy) String() string {
+	return string(mp)
+}
+
+// MisfirePolicyValidator is a validator for the "misfire_policy" field enum values. It is called by the builders before save.
+func MisfirePolicyValidator(mp MisfirePolicy) error {
+	switch mp {
+	case MisfirePolicyFireOnce, MisfirePolicySkip, MisfirePolicyCatchUp:
+		return nil
+	default:
+		return fmt.Errorf("task: invalid enum value for misfire_policy field: %q", mp)
+	}
+}
+
 // OrderOption defines the ordering options for the Task queries.
 type OrderOption func(*sql.Selector)
 
@@ -186,6 +221,16 @@ func ByCronSpec(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCronSpec, opts...).ToFunc()
 }
 
+// ByTimezone orders the results by the timezone field.
+func ByTimezone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimezone, opts...).ToFunc()
+}
+
+// ByMisfirePolicy orders the results by the misfire_policy field.
+func ByMisfirePolicy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMisfirePolicy, opts...).ToFunc()
+}
+
 // ByEnable orders the results by the enable field.
 func ByEnable(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEnable, opts...).ToFunc()
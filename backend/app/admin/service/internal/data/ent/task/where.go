@@ -109,6 +109,11 @@ func CronSpec(v string) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldCronSpec, v))
 }
 
+// Timezone applies equality check predicate on the "timezone" field. It's identical to TimezoneEQ.
+func Timezone(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldTimezone, v))
+}
+
 // Enable applies equality check predicate on the "enable" field. It's identical to EnableEQ.
 func Enable(v bool) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldEnable, v))
@@ -794,6 +799,111 @@ func CronSpecContainsFold(v string) predicate.Task {
 	return predicate.Task(sql.FieldContainsFold(FieldCronSpec, v))
 }
 
+// TimezoneEQ applies the EQ predicate on the "timezone" field.
+func TimezoneEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldTimezone, v))
+}
+
+// TimezoneNEQ applies the NEQ predicate on the "timezone" field.
+func TimezoneNEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldTimezone, v))
+}
+
+// TimezoneIn applies the In predicate on the "timezone" field.
+func TimezoneIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldTimezone, vs...))
+}
+
+// TimezoneNotIn applies the NotIn predicate on the "timezone" field.
+func TimezoneNotIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldTimezone, vs...))
+}
+
+// TimezoneGT applies the GT predicate on the "timezone" field.
+func TimezoneGT(v string) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldTimezone, v))
+}
+
+// TimezoneGTE applies the GTE predicate on the "timezone" field.
+func TimezoneGTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldTimezone, v))
+}
+
+// TimezoneLT applies the LT predicate on the "timezone" field.
+func TimezoneLT(v string) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldTimezone, v))
+}
+
+// TimezoneLTE applies the LTE predicate on the "timezone" field.
+func TimezoneLTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldTimezone, v))
+}
+
+// TimezoneContains applies the Contains predicate on the "timezone" field.
+func TimezoneContains(v string) predicate.Task {
+	return predicate.Task(sql.FieldContains(FieldTimezone, v))
+}
+
+// TimezoneHasPrefix applies the HasPrefix predicate on the "timezone" field.
+func TimezoneHasPrefix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasPrefix(FieldTimezone, v))
+}
+
+// TimezoneHasSuffix applies the HasSuffix predicate on the "timezone" field.
+func TimezoneHasSuffix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasSuffix(FieldTimezone, v))
+}
+
+// TimezoneIsNil applies the IsNil predicate on the "timezone" field.
+func TimezoneIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldTimezone))
+}
+
+// TimezoneNotNil applies the NotNil predicate on the "timezone" field.
+func TimezoneNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldTimezone))
+}
+
+// TimezoneEqualFold applies the EqualFold predicate on the "timezone" field.
+func TimezoneEqualFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldEqualFold(FieldTimezone, v))
+}
+
+// TimezoneContainsFold applies the ContainsFold predicate on the "timezone" field.
+func TimezoneContainsFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldContainsFold(FieldTimezone, v))
+}
+
+// MisfirePolicyEQ applies the EQ predicate on the "misfire_policy" field.
+func MisfirePolicyEQ(v MisfirePolicy) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldMisfirePolicy, v))
+}
+
+// MisfirePolicyNEQ applies the NEQ predicate on the "misfire_policy" field.
+func MisfirePolicyNEQ(v MisfirePolicy) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldMisfirePolicy, v))
+}
+
+// MisfirePolicyIn applies the In predicate on the "misfire_policy" field.
+func MisfirePolicyIn(vs ...MisfirePolicy) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldMisfirePolicy, vs...))
+}
+
+// MisfirePolicyNotIn applies the NotIn predicate on the "misfire_policy" field.
+func MisfirePolicyNotIn(vs ...MisfirePolicy) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldMisfirePolicy, vs...))
+}
+
+// MisfirePolicyIsNil applies the IsNil predicate on the "misfire_policy" field.
+func MisfirePolicyIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldMisfirePolicy))
+}
+
+// MisfirePolicyNotNil applies the NotNil predicate on the "misfire_policy" field.
+func MisfirePolicyNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldMisfirePolicy))
+}
+
 // TaskOptionsIsNil applies the IsNil predicate on the "task_options" field.
 func TaskOptionsIsNil() predicate.Task {
 	return predicate.Task(sql.FieldIsNull(FieldTaskOptions))
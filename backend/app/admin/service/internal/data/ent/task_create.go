@@ -191,6 +191,34 @@ func (_c *TaskCreate) SetNillableCronSpec(v *string) *TaskCreate {
 	return _c
 }
 
+// SetTimezone sets the "timezone" field.
+func (_c *TaskCreate) SetTimezone(v string) *TaskCreate {
+	_c.mutation.SetTimezone(v)
+	return _c
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_c *TaskCreate) SetNillableTimezone(v *string) *TaskCreate {
+	if v != nil {
+		_c.SetTimezone(*v)
+	}
+	return _c
+}
+
+// SetMisfirePolicy sets the "misfire_policy" field.
+func (_c *TaskCreate) SetMisfirePolicy(v task.MisfirePolicy) *TaskCreate {
+	_c.mutation.SetMisfirePolicy(v)
+	return _c
+}
+
+// SetNillableMisfirePolicy sets the "misfire_policy" field if the given value is not nil.
+func (_c *TaskCreate) SetNillableMisfirePolicy(v *task.MisfirePolicy) *TaskCreate {
+	if v != nil {
+		_c.SetMisfirePolicy(*v)
+	}
+	return _c
+}
+
 // SetTaskOptions sets the "task_options" field.
 func (_c *TaskCreate) SetTaskOptions(v *taskpb.TaskOption) *TaskCreate {
 	_c.mutation.SetTaskOptions(v)
@@ -262,6 +290,10 @@ func (_c *TaskCreate) defaults() error {
 		v := task.DefaultType
 		_c.mutation.SetType(v)
 	}
+	if _, ok := _c.mutation.MisfirePolicy(); !ok {
+		v := task.DefaultMisfirePolicy
+		_c.mutation.SetMisfirePolicy(v)
+	}
 	if _, ok := _c.mutation.Enable(); !ok {
 		v := task.DefaultEnable
 		_c.mutation.SetEnable(v)
@@ -276,6 +308,16 @@ func (_c *TaskCreate) check() error {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Task.type": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.Timezone(); ok {
+		if err := task.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "Task.timezone": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.MisfirePolicy(); ok {
+		if err := task.MisfirePolicyValidator(v); err != nil {
+			return &ValidationError{Name: "misfire_policy", err: fmt.Errorf(`ent: validator failed for field "Task.misfire_policy": %w`, err)}
+		}
+	}
 	if v, ok := _c.mutation.TaskOptions(); ok {
 		if err := v.Validate(); err != nil {
 			return &ValidationError{Name: "task_options", err: fmt.Errorf(`ent: validator failed for field "Task.task_options": %w`, err)}
@@ -367,6 +409,14 @@ func (_c *TaskCreate) createSpec() (*Task, *sqlgraph.CreateSpec) {
 		_spec.SetField(task.FieldCronSpec, field.TypeString, value)
 		_node.CronSpec = &value
 	}
+	if value, ok := _c.mutation.Timezone(); ok {
+		_spec.SetField(task.FieldTimezone, field.TypeString, value)
+		_node.Timezone = &value
+	}
+	if value, ok := _c.mutation.MisfirePolicy(); ok {
+		_spec.SetField(task.FieldMisfirePolicy, field.TypeEnum, value)
+		_node.MisfirePolicy = &value
+	}
 	if value, ok := _c.mutation.TaskOptions(); ok {
 		_spec.SetField(task.FieldTaskOptions, field.TypeJSON, value)
 		_node.TaskOptions = value
@@ -625,6 +675,42 @@ func (u *TaskUpsert) ClearCronSpec() *TaskUpsert {
 	return u
 }
 
+// SetTimezone sets the "timezone" field.
+func (u *TaskUpsert) SetTimezone(v string) *TaskUpsert {
+	u.Set(task.FieldTimezone, v)
+	return u
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *TaskUpsert) UpdateTimezone() *TaskUpsert {
+	u.SetExcluded(task.FieldTimezone)
+	return u
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (u *TaskUpsert) ClearTimezone() *TaskUpsert {
+	u.SetNull(task.FieldTimezone)
+	return u
+}
+
+// SetMisfirePolicy sets the "misfire_policy" field.
+func (u *TaskUpsert) SetMisfirePolicy(v task.MisfirePolicy) *TaskUpsert {
+	u.Set(task.FieldMisfirePolicy, v)
+	return u
+}
+
+// UpdateMisfirePolicy sets the "misfire_policy" field to the value that was provided on create.
+func (u *TaskUpsert) UpdateMisfirePolicy() *TaskUpsert {
+	u.SetExcluded(task.FieldMisfirePolicy)
+	return u
+}
+
+// ClearMisfirePolicy clears the value of the "misfire_policy" field.
+func (u *TaskUpsert) ClearMisfirePolicy() *TaskUpsert {
+	u.SetNull(task.FieldMisfirePolicy)
+	return u
+}
+
 // SetTaskOptions sets the "task_options" field.
 func (u *TaskUpsert) SetTaskOptions(v *taskpb.TaskOption) *TaskUpsert {
 	u.Set(task.FieldTaskOptions, v)
@@ -946,6 +1032,48 @@ func (u *TaskUpsertOne) ClearCronSpec() *TaskUpsertOne {
 	})
 }
 
+// SetTimezone sets the "timezone" field.
+func (u *TaskUpsertOne) SetTimezone(v string) *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetTimezone(v)
+	})
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *TaskUpsertOne) UpdateTimezone() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateTimezone()
+	})
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (u *TaskUpsertOne) ClearTimezone() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearTimezone()
+	})
+}
+
+// SetMisfirePolicy sets the "misfire_policy" field.
+func (u *TaskUpsertOne) SetMisfirePolicy(v task.MisfirePolicy) *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetMisfirePolicy(v)
+	})
+}
+
+// UpdateMisfirePolicy sets the "misfire_policy" field to the value that was provided on create.
+func (u *TaskUpsertOne) UpdateMisfirePolicy() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateMisfirePolicy()
+	})
+}
+
+// ClearMisfirePolicy clears the value of the "misfire_policy" field.
+func (u *TaskUpsertOne) ClearMisfirePolicy() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearMisfirePolicy()
+	})
+}
+
 // SetTaskOptions sets the "task_options" field.
 func (u *TaskUpsertOne) SetTaskOptions(v *taskpb.TaskOption) *TaskUpsertOne {
 	return u.Update(func(s *TaskUpsert) {
@@ -1439,6 +1567,48 @@ func (u *TaskUpsertBulk) ClearCronSpec() *TaskUpsertBulk {
 	})
 }
 
+// SetTimezone sets the "timezone" field.
+func (u *TaskUpsertBulk) SetTimezone(v string) *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetTimezone(v)
+	})
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *TaskUpsertBulk) UpdateTimezone() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateTimezone()
+	})
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (u *TaskUpsertBulk) ClearTimezone() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearTimezone()
+	})
+}
+
+// SetMisfirePolicy sets the "misfire_policy" field.
+func (u *TaskUpsertBulk) SetMisfirePolicy(v task.MisfirePolicy) *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetMisfirePolicy(v)
+	})
+}
+
+// UpdateMisfirePolicy sets the "misfire_policy" field to the value that was provided on create.
+func (u *TaskUpsertBulk) UpdateMisfirePolicy() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateMisfirePolicy()
+	})
+}
+
+// ClearMisfirePolicy clears the value of the "misfire_policy" field.
+func (u *TaskUpsertBulk) ClearMisfirePolicy() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearMisfirePolicy()
+	})
+}
+
 // SetTaskOptions sets the "task_options" field.
 func (u *TaskUpsertBulk) SetTaskOptions(v *taskpb.TaskOption) *TaskUpsertBulk {
 	return u.Update(func(s *TaskUpsert) {
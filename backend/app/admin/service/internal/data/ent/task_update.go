@@ -251,6 +251,46 @@ func (_u *TaskUpdate) ClearCronSpec() *TaskUpdate {
 	return _u
 }
 
+// SetTimezone sets the "timezone" field.
+func (_u *TaskUpdate) SetTimezone(v string) *TaskUpdate {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *TaskUpdate) SetNillableTimezone(v *string) *TaskUpdate {
+	if v != nil {
+		_u.SetTimezone(*v)
+	}
+	return _u
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (_u *TaskUpdate) ClearTimezone() *TaskUpdate {
+	_u.mutation.ClearTimezone()
+	return _u
+}
+
+// SetMisfirePolicy sets the "misfire_policy" field.
+func (_u *TaskUpdate) SetMisfirePolicy(v task.MisfirePolicy) *TaskUpdate {
+	_u.mutation.SetMisfirePolicy(v)
+	return _u
+}
+
+// SetNillableMisfirePolicy sets the "misfire_policy" field if the given value is not nil.
+func (_u *TaskUpdate) SetNillableMisfirePolicy(v *task.MisfirePolicy) *TaskUpdate {
+	if v != nil {
+		_u.SetMisfirePolicy(*v)
+	}
+	return _u
+}
+
+// ClearMisfirePolicy clears the value of the "misfire_policy" field.
+func (_u *TaskUpdate) ClearMisfirePolicy() *TaskUpdate {
+	_u.mutation.ClearMisfirePolicy()
+	return _u
+}
+
 // SetTaskOptions sets the "task_options" field.
 func (_u *TaskUpdate) SetTaskOptions(v *taskpb.TaskOption) *TaskUpdate {
 	_u.mutation.SetTaskOptions(v)
@@ -322,6 +362,16 @@ func (_u *TaskUpdate) check() error {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Task.type": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Timezone(); ok {
+		if err := task.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "Task.timezone": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MisfirePolicy(); ok {
+		if err := task.MisfirePolicyValidator(v); err != nil {
+			return &ValidationError{Name: "misfire_policy", err: fmt.Errorf(`ent: validator failed for field "Task.misfire_policy": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.TaskOptions(); ok {
 		if err := v.Validate(); err != nil {
 			return &ValidationError{Name: "task_options", err: fmt.Errorf(`ent: validator failed for field "Task.task_options": %w`, err)}
@@ -423,6 +473,18 @@ func (_u *TaskUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.CronSpecCleared() {
 		_spec.ClearField(task.FieldCronSpec, field.TypeString)
 	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(task.FieldTimezone, field.TypeString, value)
+	}
+	if _u.mutation.TimezoneCleared() {
+		_spec.ClearField(task.FieldTimezone, field.TypeString)
+	}
+	if value, ok := _u.mutation.MisfirePolicy(); ok {
+		_spec.SetField(task.FieldMisfirePolicy, field.TypeEnum, value)
+	}
+	if _u.mutation.MisfirePolicyCleared() {
+		_spec.ClearField(task.FieldMisfirePolicy, field.TypeEnum)
+	}
 	if value, ok := _u.mutation.TaskOptions(); ok {
 		_spec.SetField(task.FieldTaskOptions, field.TypeJSON, value)
 	}
@@ -678,6 +740,46 @@ func (_u *TaskUpdateOne) ClearCronSpec() *TaskUpdateOne {
 	return _u
 }
 
+// SetTimezone sets the "timezone" field.
+func (_u *TaskUpdateOne) SetTimezone(v string) *TaskUpdateOne {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *TaskUpdateOne) SetNillableTimezone(v *string) *TaskUpdateOne {
+	if v != nil {
+		_u.SetTimezone(*v)
+	}
+	return _u
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (_u *TaskUpdateOne) ClearTimezone() *TaskUpdateOne {
+	_u.mutation.ClearTimezone()
+	return _u
+}
+
+// SetMisfirePolicy sets the "misfire_policy" field.
+func (_u *TaskUpdateOne) SetMisfirePolicy(v task.MisfirePolicy) *TaskUpdateOne {
+	_u.mutation.SetMisfirePolicy(v)
+	return _u
+}
+
+// SetNillableMisfirePolicy sets the "misfire_policy" field if the given value is not nil.
+func (_u *TaskUpdateOne) SetNillableMisfirePolicy(v *task.MisfirePolicy) *TaskUpdateOne {
+	if v != nil {
+		_u.SetMisfirePolicy(*v)
+	}
+	return _u
+}
+
+// ClearMisfirePolicy clears the value of the "misfire_policy" field.
+func (_u *TaskUpdateOne) ClearMisfirePolicy() *TaskUpdateOne {
+	_u.mutation.ClearMisfirePolicy()
+	return _u
+}
+
 // SetTaskOptions sets the "task_options" field.
 func (_u *TaskUpdateOne) SetTaskOptions(v *taskpb.TaskOption) *TaskUpdateOne {
 	_u.mutation.SetTaskOptions(v)
@@ -762,6 +864,16 @@ func (_u *TaskUpdateOne) check() error {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Task.type": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Timezone(); ok {
+		if err := task.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "Task.timezone": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MisfirePolicy(); ok {
+		if err := task.MisfirePolicyValidator(v); err != nil {
+			return &ValidationError{Name: "misfire_policy", err: fmt.Errorf(`ent: validator failed for field "Task.misfire_policy": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.TaskOptions(); ok {
 		if err := v.Validate(); err != nil {
 			return &ValidationError{Name: "task_options", err: fmt.Errorf(`ent: validator failed for field "Task.task_options": %w`, err)}
@@ -880,6 +992,18 @@ func (_u *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error) {
 	if _u.mutation.CronSpecCleared() {
 		_spec.ClearField(task.FieldCronSpec, field.TypeString)
 	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(task.FieldTimezone, field.TypeString, value)
+	}
+	if _u.mutation.TimezoneCleared() {
+		_spec.ClearField(task.FieldTimezone, field.TypeString)
+	}
+	if value, ok := _u.mutation.MisfirePolicy(); ok {
+		_spec.SetField(task.FieldMisfirePolicy, field.TypeEnum, value)
+	}
+	if _u.mutation.MisfirePolicyCleared() {
+		_spec.ClearField(task.FieldMisfirePolicy, field.TypeEnum)
+	}
 	if value, ok := _u.mutation.TaskOptions(); ok {
 		_spec.SetField(task.FieldTaskOptions, field.TypeJSON, value)
 	}
@@ -0,0 +1,366 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	taskpb "go-wind-admin/api/gen/go/task/service/v1"
+	"go-wind-admin/app/admin/service/internal/data/ent/taskrun"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// 任务执行记录表
+type TaskRun struct {
+	config `json:"-"`
+	// ID of the ent.
+	// id
+	ID uint32 `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	// 删除时间
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// asynq任务ID
+	TaskID *string `json:"task_id,omitempty"`
+	// 任务类型名称
+	TypeName *string `json:"type_name,omitempty"`
+	// 队列名称
+	Queue *string `json:"queue,omitempty"`
+	// 任务负载
+	Payload *string `json:"payload,omitempty"`
+	// 执行节点
+	Worker *string `json:"worker,omitempty"`
+	// 第几次执行（从1开始）
+	Attempt *uint32 `json:"attempt,omitempty"`
+	// 最大重试次数
+	MaxRetry *uint32 `json:"max_retry,omitempty"`
+	// 执行状态
+	Status *taskrun.Status `json:"status,omitempty"`
+	// 开始时间
+	StartedAt *time.Time `json:"started_at,omitempty"`
+	// 结束时间
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	// 执行耗时（毫秒）
+	DurationMs *uint64 `json:"duration_ms,omitempty"`
+	// 执行结果
+	Result *string `json:"result,omitempty"`
+	// 错误信息
+	ErrorMessage *string `json:"error_message,omitempty"`
+	// 执行日志
+	Logs []string `json:"logs,omitempty"`
+	// 执行日志是否被截断
+	LogsTruncated *bool `json:"logs_truncated,omitempty"`
+	// 最新的执行进度快照
+	Progress     *taskpb.TaskRunProgress `json:"progress,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TaskRun) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case taskrun.FieldLogs, taskrun.FieldProgress:
+			values[i] = new([]byte)
+		case taskrun.FieldLogsTruncated:
+			values[i] = new(sql.NullBool)
+		case taskrun.FieldID, taskrun.FieldAttempt, taskrun.FieldMaxRetry, taskrun.FieldDurationMs:
+			values[i] = new(sql.NullInt64)
+		case taskrun.FieldTaskID, taskrun.FieldTypeName, taskrun.FieldQueue, taskrun.FieldPayload, taskrun.FieldWorker, taskrun.FieldStatus, taskrun.FieldResult, taskrun.FieldErrorMessage:
+			values[i] = new(sql.NullString)
+		case taskrun.FieldCreatedAt, taskrun.FieldUpdatedAt, taskrun.FieldDeletedAt, taskrun.FieldStartedAt, taskrun.FieldFinishedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TaskRun fields.
+func (_m *TaskRun) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case taskrun.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint32(value.Int64)
+		case taskrun.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = new(time.Time)
+				*_m.CreatedAt = value.Time
+			}
+		case taskrun.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = new(time.Time)
+				*_m.UpdatedAt = value.Time
+			}
+		case taskrun.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case taskrun.FieldTaskID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value.Valid {
+				_m.TaskID = new(string)
+				*_m.TaskID = value.String
+			}
+		case taskrun.FieldTypeName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type_name", values[i])
+			} else if value.Valid {
+				_m.TypeName = new(string)
+				*_m.TypeName = value.String
+			}
+		case taskrun.FieldQueue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field queue", values[i])
+			} else if value.Valid {
+				_m.Queue = new(string)
+				*_m.Queue = value.String
+			}
+		case taskrun.FieldPayload:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payload", values[i])
+			} else if value.Valid {
+				_m.Payload = new(string)
+				*_m.Payload = value.String
+			}
+		case taskrun.FieldWorker:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field worker", values[i])
+			} else if value.Valid {
+				_m.Worker = new(string)
+				*_m.Worker = value.String
+			}
+		case taskrun.FieldAttempt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempt", values[i])
+			} else if value.Valid {
+				_m.Attempt = new(uint32)
+				*_m.Attempt = uint32(value.Int64)
+			}
+		case taskrun.FieldMaxRetry:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_retry", values[i])
+			} else if value.Valid {
+				_m.MaxRetry = new(uint32)
+				*_m.MaxRetry = uint32(value.Int64)
+			}
+		case taskrun.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = new(taskrun.Status)
+				*_m.Status = taskrun.Status(value.String)
+			}
+		case taskrun.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				_m.StartedAt = new(time.Time)
+				*_m.StartedAt = value.Time
+			}
+		case taskrun.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				_m.FinishedAt = new(time.Time)
+				*_m.FinishedAt = value.Time
+			}
+		case taskrun.FieldDurationMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_ms", values[i])
+			} else if value.Valid {
+				_m.DurationMs = new(uint64)
+				*_m.DurationMs = uint64(value.Int64)
+			}
+		case taskrun.FieldResult:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field result", values[i])
+			} else if value.Valid {
+				_m.Result = new(string)
+				*_m.Result = value.String
+			}
+		case taskrun.FieldErrorMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error_message", values[i])
+			} else if value.Valid {
+				_m.ErrorMessage = new(string)
+				*_m.ErrorMessage = value.String
+			}
+		case taskrun.FieldLogs:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field logs", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Logs); err != nil {
+					return fmt.Errorf("unmarshal field logs: %w", err)
+				}
+			}
+		case taskrun.FieldLogsTruncated:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field logs_truncated", values[i])
+			} else if value.Valid {
+				_m.LogsTruncated = new(bool)
+				*_m.LogsTruncated = value.Bool
+			}
+		case taskrun.FieldProgress:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field progress", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Progress); err != nil {
+					return fmt.Errorf("unmarshal field progress: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TaskRun.
+// This includes values selected through modifiers, order, etc.
+func (_m *TaskRun) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TaskRun.
+// Note that you need to call TaskRun.Unwrap() before calling this method if this TaskRun
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TaskRun) Update() *TaskRunUpdateOne {
+	return NewTaskRunClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TaskRun entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TaskRun) Unwrap() *TaskRun {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TaskRun is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TaskRun) String() string {
+	var builder strings.Builder
+	builder.WriteString("TaskRun(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreatedAt; v != nil {
+		builder.WriteString("created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdatedAt; v != nil {
+		builder.WriteString("updated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TaskID; v != nil {
+		builder.WriteString("task_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.TypeName; v != nil {
+		builder.WriteString("type_name=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Queue; v != nil {
+		builder.WriteString("queue=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Payload; v != nil {
+		builder.WriteString("payload=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Worker; v != nil {
+		builder.WriteString("worker=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Attempt; v != nil {
+		builder.WriteString("attempt=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.MaxRetry; v != nil {
+		builder.WriteString("max_retry=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Status; v != nil {
+		builder.WriteString("status=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.StartedAt; v != nil {
+		builder.WriteString("started_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.FinishedAt; v != nil {
+		builder.WriteString("finished_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DurationMs; v != nil {
+		builder.WriteString("duration_ms=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Result; v != nil {
+		builder.WriteString("result=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ErrorMessage; v != nil {
+		builder.WriteString("error_message=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("logs=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Logs))
+	builder.WriteString(", ")
+	if v := _m.LogsTruncated; v != nil {
+		builder.WriteString("logs_truncated=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("progress=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Progress))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TaskRuns is a parsable slice of TaskRun.
+type TaskRuns []*TaskRun
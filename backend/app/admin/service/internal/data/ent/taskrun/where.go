@@ -0,0 +1,1145 @@
+// Code generated by ent, DO NOT EDIT.
+
+package taskrun
+
+import (
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// TaskID applies equality check predicate on the "task_id" field. It's identical to TaskIDEQ.
+func TaskID(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldTaskID, v))
+}
+
+// TypeName applies equality check predicate on the "type_name" field. It's identical to TypeNameEQ.
+func TypeName(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldTypeName, v))
+}
+
+// Queue applies equality check predicate on the "queue" field. It's identical to QueueEQ.
+func Queue(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldQueue, v))
+}
+
+// Payload applies equality check predicate on the "payload" field. It's identical to PayloadEQ.
+func Payload(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldPayload, v))
+}
+
+// Worker applies equality check predicate on the "worker" field. It's identical to WorkerEQ.
+func Worker(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldWorker, v))
+}
+
+// Attempt applies equality check predicate on the "attempt" field. It's identical to AttemptEQ.
+func Attempt(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldAttempt, v))
+}
+
+// MaxRetry applies equality check predicate on the "max_retry" field. It's identical to MaxRetryEQ.
+func MaxRetry(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldMaxRetry, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// DurationMs applies equality check predicate on the "duration_ms" field. It's identical to DurationMsEQ.
+func DurationMs(v uint64) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldDurationMs, v))
+}
+
+// Result applies equality check predicate on the "result" field. It's identical to ResultEQ.
+func Result(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldResult, v))
+}
+
+// ErrorMessage applies equality check predicate on the "error_message" field. It's identical to ErrorMessageEQ.
+func ErrorMessage(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldErrorMessage, v))
+}
+
+// LogsTruncated applies equality check predicate on the "logs_truncated" field. It's identical to LogsTruncatedEQ.
+func LogsTruncated(v bool) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldLogsTruncated, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// TaskIDEQ applies the EQ predicate on the "task_id" field.
+func TaskIDEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldTaskID, v))
+}
+
+// TaskIDNEQ applies the NEQ predicate on the "task_id" field.
+func TaskIDNEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldTaskID, v))
+}
+
+// TaskIDIn applies the In predicate on the "task_id" field.
+func TaskIDIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldTaskID, vs...))
+}
+
+// TaskIDNotIn applies the NotIn predicate on the "task_id" field.
+func TaskIDNotIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldTaskID, vs...))
+}
+
+// TaskIDGT applies the GT predicate on the "task_id" field.
+func TaskIDGT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldTaskID, v))
+}
+
+// TaskIDGTE applies the GTE predicate on the "task_id" field.
+func TaskIDGTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldTaskID, v))
+}
+
+// TaskIDLT applies the LT predicate on the "task_id" field.
+func TaskIDLT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldTaskID, v))
+}
+
+// TaskIDLTE applies the LTE predicate on the "task_id" field.
+func TaskIDLTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldTaskID, v))
+}
+
+// TaskIDContains applies the Contains predicate on the "task_id" field.
+func TaskIDContains(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContains(FieldTaskID, v))
+}
+
+// TaskIDHasPrefix applies the HasPrefix predicate on the "task_id" field.
+func TaskIDHasPrefix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasPrefix(FieldTaskID, v))
+}
+
+// TaskIDHasSuffix applies the HasSuffix predicate on the "task_id" field.
+func TaskIDHasSuffix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasSuffix(FieldTaskID, v))
+}
+
+// TaskIDIsNil applies the IsNil predicate on the "task_id" field.
+func TaskIDIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldTaskID))
+}
+
+// TaskIDNotNil applies the NotNil predicate on the "task_id" field.
+func TaskIDNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldTaskID))
+}
+
+// TaskIDEqualFold applies the EqualFold predicate on the "task_id" field.
+func TaskIDEqualFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEqualFold(FieldTaskID, v))
+}
+
+// TaskIDContainsFold applies the ContainsFold predicate on the "task_id" field.
+func TaskIDContainsFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContainsFold(FieldTaskID, v))
+}
+
+// TypeNameEQ applies the EQ predicate on the "type_name" field.
+func TypeNameEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldTypeName, v))
+}
+
+// TypeNameNEQ applies the NEQ predicate on the "type_name" field.
+func TypeNameNEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldTypeName, v))
+}
+
+// TypeNameIn applies the In predicate on the "type_name" field.
+func TypeNameIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldTypeName, vs...))
+}
+
+// TypeNameNotIn applies the NotIn predicate on the "type_name" field.
+func TypeNameNotIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldTypeName, vs...))
+}
+
+// TypeNameGT applies the GT predicate on the "type_name" field.
+func TypeNameGT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldTypeName, v))
+}
+
+// TypeNameGTE applies the GTE predicate on the "type_name" field.
+func TypeNameGTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldTypeName, v))
+}
+
+// TypeNameLT applies the LT predicate on the "type_name" field.
+func TypeNameLT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldTypeName, v))
+}
+
+// TypeNameLTE applies the LTE predicate on the "type_name" field.
+func TypeNameLTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldTypeName, v))
+}
+
+// TypeNameContains applies the Contains predicate on the "type_name" field.
+func TypeNameContains(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContains(FieldTypeName, v))
+}
+
+// TypeNameHasPrefix applies the HasPrefix predicate on the "type_name" field.
+func TypeNameHasPrefix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasPrefix(FieldTypeName, v))
+}
+
+// TypeNameHasSuffix applies the HasSuffix predicate on the "type_name" field.
+func TypeNameHasSuffix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasSuffix(FieldTypeName, v))
+}
+
+// TypeNameIsNil applies the IsNil predicate on the "type_name" field.
+func TypeNameIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldTypeName))
+}
+
+// TypeNameNotNil applies the NotNil predicate on the "type_name" field.
+func TypeNameNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldTypeName))
+}
+
+// TypeNameEqualFold applies the EqualFold predicate on the "type_name" field.
+func TypeNameEqualFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEqualFold(FieldTypeName, v))
+}
+
+// TypeNameContainsFold applies the ContainsFold predicate on the "type_name" field.
+func TypeNameContainsFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContainsFold(FieldTypeName, v))
+}
+
+// QueueEQ applies the EQ predicate on the "queue" field.
+func QueueEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldQueue, v))
+}
+
+// QueueNEQ applies the NEQ predicate on the "queue" field.
+func QueueNEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldQueue, v))
+}
+
+// QueueIn applies the In predicate on the "queue" field.
+func QueueIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldQueue, vs...))
+}
+
+// QueueNotIn applies the NotIn predicate on the "queue" field.
+func QueueNotIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldQueue, vs...))
+}
+
+// QueueGT applies the GT predicate on the "queue" field.
+func QueueGT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldQueue, v))
+}
+
+// QueueGTE applies the GTE predicate on the "queue" field.
+func QueueGTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldQueue, v))
+}
+
+// QueueLT applies the LT predicate on the "queue" field.
+func QueueLT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldQueue, v))
+}
+
+// QueueLTE applies the LTE predicate on the "queue" field.
+func QueueLTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldQueue, v))
+}
+
+// QueueContains applies the Contains predicate on the "queue" field.
+func QueueContains(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContains(FieldQueue, v))
+}
+
+// QueueHasPrefix applies the HasPrefix predicate on the "queue" field.
+func QueueHasPrefix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasPrefix(FieldQueue, v))
+}
+
+// QueueHasSuffix applies the HasSuffix predicate on the "queue" field.
+func QueueHasSuffix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasSuffix(FieldQueue, v))
+}
+
+// QueueIsNil applies the IsNil predicate on the "queue" field.
+func QueueIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldQueue))
+}
+
+// QueueNotNil applies the NotNil predicate on the "queue" field.
+func QueueNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldQueue))
+}
+
+// QueueEqualFold applies the EqualFold predicate on the "queue" field.
+func QueueEqualFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEqualFold(FieldQueue, v))
+}
+
+// QueueContainsFold applies the ContainsFold predicate on the "queue" field.
+func QueueContainsFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContainsFold(FieldQueue, v))
+}
+
+// PayloadEQ applies the EQ predicate on the "payload" field.
+func PayloadEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldPayload, v))
+}
+
+// PayloadNEQ applies the NEQ predicate on the "payload" field.
+func PayloadNEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldPayload, v))
+}
+
+// PayloadIn applies the In predicate on the "payload" field.
+func PayloadIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldPayload, vs...))
+}
+
+// PayloadNotIn applies the NotIn predicate on the "payload" field.
+func PayloadNotIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldPayload, vs...))
+}
+
+// PayloadGT applies the GT predicate on the "payload" field.
+func PayloadGT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldPayload, v))
+}
+
+// PayloadGTE applies the GTE predicate on the "payload" field.
+func PayloadGTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldPayload, v))
+}
+
+// PayloadLT applies the LT predicate on the "payload" field.
+func PayloadLT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldPayload, v))
+}
+
+// PayloadLTE applies the LTE predicate on the "payload" field.
+func PayloadLTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldPayload, v))
+}
+
+// PayloadContains applies the Contains predicate on the "payload" field.
+func PayloadContains(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContains(FieldPayload, v))
+}
+
+// PayloadHasPrefix applies the HasPrefix predicate on the "payload" field.
+func PayloadHasPrefix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasPrefix(FieldPayload, v))
+}
+
+// PayloadHasSuffix applies the HasSuffix predicate on the "payload" field.
+func PayloadHasSuffix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasSuffix(FieldPayload, v))
+}
+
+// PayloadIsNil applies the IsNil predicate on the "payload" field.
+func PayloadIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldPayload))
+}
+
+// PayloadNotNil applies the NotNil predicate on the "payload" field.
+func PayloadNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldPayload))
+}
+
+// PayloadEqualFold applies the EqualFold predicate on the "payload" field.
+func PayloadEqualFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEqualFold(FieldPayload, v))
+}
+
+// PayloadContainsFold applies the ContainsFold predicate on the "payload" field.
+func PayloadContainsFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContainsFold(FieldPayload, v))
+}
+
+// WorkerEQ applies the EQ predicate on the "worker" field.
+func WorkerEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldWorker, v))
+}
+
+// WorkerNEQ applies the NEQ predicate on the "worker" field.
+func WorkerNEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldWorker, v))
+}
+
+// WorkerIn applies the In predicate on the "worker" field.
+func WorkerIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldWorker, vs...))
+}
+
+// WorkerNotIn applies the NotIn predicate on the "worker" field.
+func WorkerNotIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldWorker, vs...))
+}
+
+// WorkerGT applies the GT predicate on the "worker" field.
+func WorkerGT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldWorker, v))
+}
+
+// WorkerGTE applies the GTE predicate on the "worker" field.
+func WorkerGTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldWorker, v))
+}
+
+// WorkerLT applies the LT predicate on the "worker" field.
+func WorkerLT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldWorker, v))
+}
+
+// WorkerLTE applies the LTE predicate on the "worker" field.
+func WorkerLTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldWorker, v))
+}
+
+// WorkerContains applies the Contains predicate on the "worker" field.
+func WorkerContains(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContains(FieldWorker, v))
+}
+
+// WorkerHasPrefix applies the HasPrefix predicate on the "worker" field.
+func WorkerHasPrefix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasPrefix(FieldWorker, v))
+}
+
+// WorkerHasSuffix applies the HasSuffix predicate on the "worker" field.
+func WorkerHasSuffix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasSuffix(FieldWorker, v))
+}
+
+// WorkerIsNil applies the IsNil predicate on the "worker" field.
+func WorkerIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldWorker))
+}
+
+// WorkerNotNil applies the NotNil predicate on the "worker" field.
+func WorkerNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldWorker))
+}
+
+// WorkerEqualFold applies the EqualFold predicate on the "worker" field.
+func WorkerEqualFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEqualFold(FieldWorker, v))
+}
+
+// WorkerContainsFold applies the ContainsFold predicate on the "worker" field.
+func WorkerContainsFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContainsFold(FieldWorker, v))
+}
+
+// AttemptEQ applies the EQ predicate on the "attempt" field.
+func AttemptEQ(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldAttempt, v))
+}
+
+// AttemptNEQ applies the NEQ predicate on the "attempt" field.
+func AttemptNEQ(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldAttempt, v))
+}
+
+// AttemptIn applies the In predicate on the "attempt" field.
+func AttemptIn(vs ...uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldAttempt, vs...))
+}
+
+// AttemptNotIn applies the NotIn predicate on the "attempt" field.
+func AttemptNotIn(vs ...uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldAttempt, vs...))
+}
+
+// AttemptGT applies the GT predicate on the "attempt" field.
+func AttemptGT(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldAttempt, v))
+}
+
+// AttemptGTE applies the GTE predicate on the "attempt" field.
+func AttemptGTE(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldAttempt, v))
+}
+
+// AttemptLT applies the LT predicate on the "attempt" field.
+func AttemptLT(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldAttempt, v))
+}
+
+// AttemptLTE applies the LTE predicate on the "attempt" field.
+func AttemptLTE(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldAttempt, v))
+}
+
+// AttemptIsNil applies the IsNil predicate on the "attempt" field.
+func AttemptIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldAttempt))
+}
+
+// AttemptNotNil applies the NotNil predicate on the "attempt" field.
+func AttemptNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldAttempt))
+}
+
+// MaxRetryEQ applies the EQ predicate on the "max_retry" field.
+func MaxRetryEQ(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldMaxRetry, v))
+}
+
+// MaxRetryNEQ applies the NEQ predicate on the "max_retry" field.
+func MaxRetryNEQ(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldMaxRetry, v))
+}
+
+// MaxRetryIn applies the In predicate on the "max_retry" field.
+func MaxRetryIn(vs ...uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldMaxRetry, vs...))
+}
+
+// MaxRetryNotIn applies the NotIn predicate on the "max_retry" field.
+func MaxRetryNotIn(vs ...uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldMaxRetry, vs...))
+}
+
+// MaxRetryGT applies the GT predicate on the "max_retry" field.
+func MaxRetryGT(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldMaxRetry, v))
+}
+
+// MaxRetryGTE applies the GTE predicate on the "max_retry" field.
+func MaxRetryGTE(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldMaxRetry, v))
+}
+
+// MaxRetryLT applies the LT predicate on the "max_retry" field.
+func MaxRetryLT(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldMaxRetry, v))
+}
+
+// MaxRetryLTE applies the LTE predicate on the "max_retry" field.
+func MaxRetryLTE(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldMaxRetry, v))
+}
+
+// MaxRetryIsNil applies the IsNil predicate on the "max_retry" field.
+func MaxRetryIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldMaxRetry))
+}
+
+// MaxRetryNotNil applies the NotNil predicate on the "max_retry" field.
+func MaxRetryNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldMaxRetry))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusIsNil applies the IsNil predicate on the "status" field.
+func StatusIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldStatus))
+}
+
+// StatusNotNil applies the NotNil predicate on the "status" field.
+func StatusNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldStatus))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// StartedAtIsNil applies the IsNil predicate on the "started_at" field.
+func StartedAtIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldStartedAt))
+}
+
+// StartedAtNotNil applies the NotNil predicate on the "started_at" field.
+func StartedAtNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldStartedAt))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// FinishedAtIsNil applies the IsNil predicate on the "finished_at" field.
+func FinishedAtIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldFinishedAt))
+}
+
+// FinishedAtNotNil applies the NotNil predicate on the "finished_at" field.
+func FinishedAtNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldFinishedAt))
+}
+
+// DurationMsEQ applies the EQ predicate on the "duration_ms" field.
+func DurationMsEQ(v uint64) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldDurationMs, v))
+}
+
+// DurationMsNEQ applies the NEQ predicate on the "duration_ms" field.
+func DurationMsNEQ(v uint64) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldDurationMs, v))
+}
+
+// DurationMsIn applies the In predicate on the "duration_ms" field.
+func DurationMsIn(vs ...uint64) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldDurationMs, vs...))
+}
+
+// DurationMsNotIn applies the NotIn predicate on the "duration_ms" field.
+func DurationMsNotIn(vs ...uint64) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldDurationMs, vs...))
+}
+
+// DurationMsGT applies the GT predicate on the "duration_ms" field.
+func DurationMsGT(v uint64) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldDurationMs, v))
+}
+
+// DurationMsGTE applies the GTE predicate on the "duration_ms" field.
+func DurationMsGTE(v uint64) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldDurationMs, v))
+}
+
+// DurationMsLT applies the LT predicate on the "duration_ms" field.
+func DurationMsLT(v uint64) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldDurationMs, v))
+}
+
+// DurationMsLTE applies the LTE predicate on the "duration_ms" field.
+func DurationMsLTE(v uint64) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldDurationMs, v))
+}
+
+// DurationMsIsNil applies the IsNil predicate on the "duration_ms" field.
+func DurationMsIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldDurationMs))
+}
+
+// DurationMsNotNil applies the NotNil predicate on the "duration_ms" field.
+func DurationMsNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldDurationMs))
+}
+
+// ResultEQ applies the EQ predicate on the "result" field.
+func ResultEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldResult, v))
+}
+
+// ResultNEQ applies the NEQ predicate on the "result" field.
+func ResultNEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldResult, v))
+}
+
+// ResultIn applies the In predicate on the "result" field.
+func ResultIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldResult, vs...))
+}
+
+// ResultNotIn applies the NotIn predicate on the "result" field.
+func ResultNotIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldResult, vs...))
+}
+
+// ResultGT applies the GT predicate on the "result" field.
+func ResultGT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldResult, v))
+}
+
+// ResultGTE applies the GTE predicate on the "result" field.
+func ResultGTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldResult, v))
+}
+
+// ResultLT applies the LT predicate on the "result" field.
+func ResultLT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldResult, v))
+}
+
+// ResultLTE applies the LTE predicate on the "result" field.
+func ResultLTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldResult, v))
+}
+
+// ResultContains applies the Contains predicate on the "result" field.
+func ResultContains(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContains(FieldResult, v))
+}
+
+// ResultHasPrefix applies the HasPrefix predicate on the "result" field.
+func ResultHasPrefix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasPrefix(FieldResult, v))
+}
+
+// ResultHasSuffix applies the HasSuffix predicate on the "result" field.
+func ResultHasSuffix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasSuffix(FieldResult, v))
+}
+
+// ResultIsNil applies the IsNil predicate on the "result" field.
+func ResultIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldResult))
+}
+
+// ResultNotNil applies the NotNil predicate on the "result" field.
+func ResultNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldResult))
+}
+
+// ResultEqualFold applies the EqualFold predicate on the "result" field.
+func ResultEqualFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEqualFold(FieldResult, v))
+}
+
+// ResultContainsFold applies the ContainsFold predicate on the "result" field.
+func ResultContainsFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContainsFold(FieldResult, v))
+}
+
+// ErrorMessageEQ applies the EQ predicate on the "error_message" field.
+func ErrorMessageEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldErrorMessage, v))
+}
+
+// ErrorMessageNEQ applies the NEQ predicate on the "error_message" field.
+func ErrorMessageNEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldErrorMessage, v))
+}
+
+// ErrorMessageIn applies the In predicate on the "error_message" field.
+func ErrorMessageIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldErrorMessage, vs...))
+}
+
+// ErrorMessageNotIn applies the NotIn predicate on the "error_message" field.
+func ErrorMessageNotIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldErrorMessage, vs...))
+}
+
+// ErrorMessageGT applies the GT predicate on the "error_message" field.
+func ErrorMessageGT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldErrorMessage, v))
+}
+
+// ErrorMessageGTE applies the GTE predicate on the "error_message" field.
+func ErrorMessageGTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldErrorMessage, v))
+}
+
+// ErrorMessageLT applies the LT predicate on the "error_message" field.
+func ErrorMessageLT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldErrorMessage, v))
+}
+
+// ErrorMessageLTE applies the LTE predicate on the "error_message" field.
+func ErrorMessageLTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldErrorMessage, v))
+}
+
+// ErrorMessageContains applies the Contains predicate on the "error_message" field.
+func ErrorMessageContains(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContains(FieldErrorMessage, v))
+}
+
+// ErrorMessageHasPrefix applies the HasPrefix predicate on the "error_message" field.
+func ErrorMessageHasPrefix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasPrefix(FieldErrorMessage, v))
+}
+
+// ErrorMessageHasSuffix applies the HasSuffix predicate on the "error_message" field.
+func ErrorMessageHasSuffix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasSuffix(FieldErrorMessage, v))
+}
+
+// ErrorMessageIsNil applies the IsNil predicate on the "error_message" field.
+func ErrorMessageIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldErrorMessage))
+}
+
+// ErrorMessageNotNil applies the NotNil predicate on the "error_message" field.
+func ErrorMessageNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldErrorMessage))
+}
+
+// ErrorMessageEqualFold applies the EqualFold predicate on the "error_message" field.
+func ErrorMessageEqualFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEqualFold(FieldErrorMessage, v))
+}
+
+// ErrorMessageContainsFold applies the ContainsFold predicate on the "error_message" field.
+func ErrorMessageContainsFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContainsFold(FieldErrorMessage, v))
+}
+
+// LogsIsNil applies the IsNil predicate on the "logs" field.
+func LogsIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldLogs))
+}
+
+// LogsNotNil applies the NotNil predicate on the "logs" field.
+func LogsNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldLogs))
+}
+
+// LogsTruncatedEQ applies the EQ predicate on the "logs_truncated" field.
+func LogsTruncatedEQ(v bool) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldLogsTruncated, v))
+}
+
+// LogsTruncatedNEQ applies the NEQ predicate on the "logs_truncated" field.
+func LogsTruncatedNEQ(v bool) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldLogsTruncated, v))
+}
+
+// LogsTruncatedIsNil applies the IsNil predicate on the "logs_truncated" field.
+func LogsTruncatedIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldLogsTruncated))
+}
+
+// LogsTruncatedNotNil applies the NotNil predicate on the "logs_truncated" field.
+func LogsTruncatedNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldLogsTruncated))
+}
+
+// ProgressIsNil applies the IsNil predicate on the "progress" field.
+func ProgressIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldProgress))
+}
+
+// ProgressNotNil applies the NotNil predicate on the "progress" field.
+func ProgressNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldProgress))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TaskRun) predicate.TaskRun {
+	return predicate.TaskRun(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TaskRun) predicate.TaskRun {
+	return predicate.TaskRun(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TaskRun) predicate.TaskRun {
+	return predicate.TaskRun(sql.NotPredicates(p))
+}
@@ -0,0 +1,1954 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	taskpb "go-wind-admin/api/gen/go/task/service/v1"
+	"go-wind-admin/app/admin/service/internal/data/ent/taskrun"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TaskRunCreate is the builder for creating a TaskRun entity.
+type TaskRunCreate struct {
+	config
+	mutation *TaskRunMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TaskRunCreate) SetCreatedAt(v time.Time) *TaskRunCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableCreatedAt(v *time.Time) *TaskRunCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *TaskRunCreate) SetUpdatedAt(v time.Time) *TaskRunCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableUpdatedAt(v *time.Time) *TaskRunCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *TaskRunCreate) SetDeletedAt(v time.Time) *TaskRunCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableDeletedAt(v *time.Time) *TaskRunCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetTaskID sets the "task_id" field.
+func (_c *TaskRunCreate) SetTaskID(v string) *TaskRunCreate {
+	_c.mutation.SetTaskID(v)
+	return _c
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableTaskID(v *string) *TaskRunCreate {
+	if v != nil {
+		_c.SetTaskID(*v)
+	}
+	return _c
+}
+
+// SetTypeName sets the "type_name" field.
+func (_c *TaskRunCreate) SetTypeName(v string) *TaskRunCreate {
+	_c.mutation.SetTypeName(v)
+	return _c
+}
+
+// SetNillableTypeName sets the "type_name" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableTypeName(v *string) *TaskRunCreate {
+	if v != nil {
+		_c.SetTypeName(*v)
+	}
+	return _c
+}
+
+// SetQueue sets the "queue" field.
+func (_c *TaskRunCreate) SetQueue(v string) *TaskRunCreate {
+	_c.mutation.SetQueue(v)
+	return _c
+}
+
+// SetNillableQueue sets the "queue" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableQueue(v *string) *TaskRunCreate {
+	if v != nil {
+		_c.SetQueue(*v)
+	}
+	return _c
+}
+
+// SetPayload sets the "payload" field.
+func (_c *TaskRunCreate) SetPayload(v string) *TaskRunCreate {
+	_c.mutation.SetPayload(v)
+	return _c
+}
+
+// SetNillablePayload sets the "payload" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillablePayload(v *string) *TaskRunCreate {
+	if v != nil {
+		_c.SetPayload(*v)
+	}
+	return _c
+}
+
+// SetWorker sets the "worker" field.
+func (_c *TaskRunCreate) SetWorker(v string) *TaskRunCreate {
+	_c.mutation.SetWorker(v)
+	return _c
+}
+
+// SetNillableWorker sets the "worker" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableWorker(v *string) *TaskRunCreate {
+	if v != nil {
+		_c.SetWorker(*v)
+	}
+	return _c
+}
+
+// SetAttempt sets the "attempt" field.
+func (_c *TaskRunCreate) SetAttempt(v uint32) *TaskRunCreate {
+	_c.mutation.SetAttempt(v)
+	return _c
+}
+
+// SetNillableAttempt sets the "attempt" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableAttempt(v *uint32) *TaskRunCreate {
+	if v != nil {
+		_c.SetAttempt(*v)
+	}
+	return _c
+}
+
+// SetMaxRetry sets the "max_retry" field.
+func (_c *TaskRunCreate) SetMaxRetry(v uint32) *TaskRunCreate {
+	_c.mutation.SetMaxRetry(v)
+	return _c
+}
+
+// SetNillableMaxRetry sets the "max_retry" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableMaxRetry(v *uint32) *TaskRunCreate {
+	if v != nil {
+		_c.SetMaxRetry(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *TaskRunCreate) SetStatus(v taskrun.Status) *TaskRunCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableStatus(v *taskrun.Status) *TaskRunCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *TaskRunCreate) SetStartedAt(v time.Time) *TaskRunCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableStartedAt(v *time.Time) *TaskRunCreate {
+	if v != nil {
+		_c.SetStartedAt(*v)
+	}
+	return _c
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_c *TaskRunCreate) SetFinishedAt(v time.Time) *TaskRunCreate {
+	_c.mutation.SetFinishedAt(v)
+	return _c
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableFinishedAt(v *time.Time) *TaskRunCreate {
+	if v != nil {
+		_c.SetFinishedAt(*v)
+	}
+	return _c
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_c *TaskRunCreate) SetDurationMs(v uint64) *TaskRunCreate {
+	_c.mutation.SetDurationMs(v)
+	return _c
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableDurationMs(v *uint64) *TaskRunCreate {
+	if v != nil {
+		_c.SetDurationMs(*v)
+	}
+	return _c
+}
+
+// SetResult sets the "result" field.
+func (_c *TaskRunCreate) SetResult(v string) *TaskRunCreate {
+	_c.mutation.SetResult(v)
+	return _c
+}
+
+// SetNillableResult sets the "result" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableResult(v *string) *TaskRunCreate {
+	if v != nil {
+		_c.SetResult(*v)
+	}
+	return _c
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_c *TaskRunCreate) SetErrorMessage(v string) *TaskRunCreate {
+	_c.mutation.SetErrorMessage(v)
+	return _c
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableErrorMessage(v *string) *TaskRunCreate {
+	if v != nil {
+		_c.SetErrorMessage(*v)
+	}
+	return _c
+}
+
+// SetLogs sets the "logs" field.
+func (_c *TaskRunCreate) SetLogs(v []string) *TaskRunCreate {
+	_c.mutation.SetLogs(v)
+	return _c
+}
+
+// SetLogsTruncated sets the "logs_truncated" field.
+func (_c *TaskRunCreate) SetLogsTruncated(v bool) *TaskRunCreate {
+	_c.mutation.SetLogsTruncated(v)
+	return _c
+}
+
+// SetNillableLogsTruncated sets the "logs_truncated" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableLogsTruncated(v *bool) *TaskRunCreate {
+	if v != nil {
+		_c.SetLogsTruncated(*v)
+	}
+	return _c
+}
+
+// SetProgress sets the "progress" field.
+func (_c *TaskRunCreate) SetProgress(v *taskpb.TaskRunProgress) *TaskRunCreate {
+	_c.mutation.SetProgress(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TaskRunCreate) SetID(v uint32) *TaskRunCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the TaskRunMutation object of the builder.
+func (_c *TaskRunCreate) Mutation() *TaskRunMutation {
+	return _c.mutation
+}
+
+// Save creates the TaskRun in the database.
+func (_c *TaskRunCreate) Save(ctx context.Context) (*TaskRun, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TaskRunCreate) SaveX(ctx context.Context) *TaskRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TaskRunCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TaskRunCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TaskRunCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := taskrun.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.LogsTruncated(); !ok {
+		v := taskrun.DefaultLogsTruncated
+		_c.mutation.SetLogsTruncated(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TaskRunCreate) check() error {
+	if v, ok := _c.mutation.TaskID(); ok {
+		if err := taskrun.TaskIDValidator(v); err != nil {
+			return &ValidationError{Name: "task_id", err: fmt.Errorf(`ent: validator failed for field "TaskRun.task_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.TypeName(); ok {
+		if err := taskrun.TypeNameValidator(v); err != nil {
+			return &ValidationError{Name: "type_name", err: fmt.Errorf(`ent: validator failed for field "TaskRun.type_name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Queue(); ok {
+		if err := taskrun.QueueValidator(v); err != nil {
+			return &ValidationError{Name: "queue", err: fmt.Errorf(`ent: validator failed for field "TaskRun.queue": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Worker(); ok {
+		if err := taskrun.WorkerValidator(v); err != nil {
+			return &ValidationError{Name: "worker", err: fmt.Errorf(`ent: validator failed for field "TaskRun.worker": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := taskrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TaskRun.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Progress(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "progress", err: fmt.Errorf(`ent: validator failed for field "TaskRun.progress": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := taskrun.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "TaskRun.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *TaskRunCreate) sqlSave(ctx context.Context) (*TaskRun, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TaskRunCreate) createSpec() (*TaskRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TaskRun{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(taskrun.Table, sqlgraph.NewFieldSpec(taskrun.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(taskrun.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(taskrun.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(taskrun.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.TaskID(); ok {
+		_spec.SetField(taskrun.FieldTaskID, field.TypeString, value)
+		_node.TaskID = &value
+	}
+	if value, ok := _c.mutation.TypeName(); ok {
+		_spec.SetField(taskrun.FieldTypeName, field.TypeString, value)
+		_node.TypeName = &value
+	}
+	if value, ok := _c.mutation.Queue(); ok {
+		_spec.SetField(taskrun.FieldQueue, field.TypeString, value)
+		_node.Queue = &value
+	}
+	if value, ok := _c.mutation.Payload(); ok {
+		_spec.SetField(taskrun.FieldPayload, field.TypeString, value)
+		_node.Payload = &value
+	}
+	if value, ok := _c.mutation.Worker(); ok {
+		_spec.SetField(taskrun.FieldWorker, field.TypeString, value)
+		_node.Worker = &value
+	}
+	if value, ok := _c.mutation.Attempt(); ok {
+		_spec.SetField(taskrun.FieldAttempt, field.TypeUint32, value)
+		_node.Attempt = &value
+	}
+	if value, ok := _c.mutation.MaxRetry(); ok {
+		_spec.SetField(taskrun.FieldMaxRetry, field.TypeUint32, value)
+		_node.MaxRetry = &value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(taskrun.FieldStatus, field.TypeEnum, value)
+		_node.Status = &value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(taskrun.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = &value
+	}
+	if value, ok := _c.mutation.FinishedAt(); ok {
+		_spec.SetField(taskrun.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	if value, ok := _c.mutation.DurationMs(); ok {
+		_spec.SetField(taskrun.FieldDurationMs, field.TypeUint64, value)
+		_node.DurationMs = &value
+	}
+	if value, ok := _c.mutation.Result(); ok {
+		_spec.SetField(taskrun.FieldResult, field.TypeString, value)
+		_node.Result = &value
+	}
+	if value, ok := _c.mutation.ErrorMessage(); ok {
+		_spec.SetField(taskrun.FieldErrorMessage, field.TypeString, value)
+		_node.ErrorMessage = &value
+	}
+	if value, ok := _c.mutation.Logs(); ok {
+		_spec.SetField(taskrun.FieldLogs, field.TypeJSON, value)
+		_node.Logs = value
+	}
+	if value, ok := _c.mutation.LogsTruncated(); ok {
+		_spec.SetField(taskrun.FieldLogsTruncated, field.TypeBool, value)
+		_node.LogsTruncated = &value
+	}
+	if value, ok := _c.mutation.Progress(); ok {
+		_spec.SetField(taskrun.FieldProgress, field.TypeJSON, value)
+		_node.Progress = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TaskRun.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TaskRunUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TaskRunCreate) OnConflict(opts ...sql.ConflictOption) *TaskRunUpsertOne {
+	_c.conflict = opts
+	return &TaskRunUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TaskRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TaskRunCreate) OnConflictColumns(columns ...string) *TaskRunUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TaskRunUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// TaskRunUpsertOne is the builder for "upsert"-ing
+	//  one TaskRun node.
+	TaskRunUpsertOne struct {
+		create *TaskRunCreate
+	}
+
+	// TaskRunUpsert is the "OnConflict" setter.
+	TaskRunUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TaskRunUpsert) SetUpdatedAt(v time.Time) *TaskRunUpsert {
+	u.Set(taskrun.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateUpdatedAt() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *TaskRunUpsert) ClearUpdatedAt() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *TaskRunUpsert) SetDeletedAt(v time.Time) *TaskRunUpsert {
+	u.Set(taskrun.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateDeletedAt() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *TaskRunUpsert) ClearDeletedAt() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldDeletedAt)
+	return u
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *TaskRunUpsert) SetTaskID(v string) *TaskRunUpsert {
+	u.Set(taskrun.FieldTaskID, v)
+	return u
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateTaskID() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldTaskID)
+	return u
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (u *TaskRunUpsert) ClearTaskID() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldTaskID)
+	return u
+}
+
+// SetTypeName sets the "type_name" field.
+func (u *TaskRunUpsert) SetTypeName(v string) *TaskRunUpsert {
+	u.Set(taskrun.FieldTypeName, v)
+	return u
+}
+
+// UpdateTypeName sets the "type_name" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateTypeName() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldTypeName)
+	return u
+}
+
+// ClearTypeName clears the value of the "type_name" field.
+func (u *TaskRunUpsert) ClearTypeName() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldTypeName)
+	return u
+}
+
+// SetQueue sets the "queue" field.
+func (u *TaskRunUpsert) SetQueue(v string) *TaskRunUpsert {
+	u.Set(taskrun.FieldQueue, v)
+	return u
+}
+
+// UpdateQueue sets the "queue" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateQueue() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldQueue)
+	return u
+}
+
+// ClearQueue clears the value of the "queue" field.
+func (u *TaskRunUpsert) ClearQueue() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldQueue)
+	return u
+}
+
+// SetPayload sets the "payload" field.
+func (u *TaskRunUpsert) SetPayload(v string) *TaskRunUpsert {
+	u.Set(taskrun.FieldPayload, v)
+	return u
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdatePayload() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldPayload)
+	return u
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (u *TaskRunUpsert) ClearPayload() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldPayload)
+	return u
+}
+
+// SetWorker sets the "worker" field.
+func (u *TaskRunUpsert) SetWorker(v string) *TaskRunUpsert {
+	u.Set(taskrun.FieldWorker, v)
+	return u
+}
+
+// UpdateWorker sets the "worker" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateWorker() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldWorker)
+	return u
+}
+
+// ClearWorker clears the value of the "worker" field.
+func (u *TaskRunUpsert) ClearWorker() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldWorker)
+	return u
+}
+
+// SetAttempt sets the "attempt" field.
+func (u *TaskRunUpsert) SetAttempt(v uint32) *TaskRunUpsert {
+	u.Set(taskrun.FieldAttempt, v)
+	return u
+}
+
+// UpdateAttempt sets the "attempt" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateAttempt() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldAttempt)
+	return u
+}
+
+// AddAttempt adds v to the "attempt" field.
+func (u *TaskRunUpsert) AddAttempt(v uint32) *TaskRunUpsert {
+	u.Add(taskrun.FieldAttempt, v)
+	return u
+}
+
+// ClearAttempt clears the value of the "attempt" field.
+func (u *TaskRunUpsert) ClearAttempt() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldAttempt)
+	return u
+}
+
+// SetMaxRetry sets the "max_retry" field.
+func (u *TaskRunUpsert) SetMaxRetry(v uint32) *TaskRunUpsert {
+	u.Set(taskrun.FieldMaxRetry, v)
+	return u
+}
+
+// UpdateMaxRetry sets the "max_retry" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateMaxRetry() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldMaxRetry)
+	return u
+}
+
+// AddMaxRetry adds v to the "max_retry" field.
+func (u *TaskRunUpsert) AddMaxRetry(v uint32) *TaskRunUpsert {
+	u.Add(taskrun.FieldMaxRetry, v)
+	return u
+}
+
+// ClearMaxRetry clears the value of the "max_retry" field.
+func (u *TaskRunUpsert) ClearMaxRetry() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldMaxRetry)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *TaskRunUpsert) SetStatus(v taskrun.Status) *TaskRunUpsert {
+	u.Set(taskrun.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateStatus() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldStatus)
+	return u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *TaskRunUpsert) ClearStatus() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldStatus)
+	return u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *TaskRunUpsert) SetStartedAt(v time.Time) *TaskRunUpsert {
+	u.Set(taskrun.FieldStartedAt, v)
+	return u
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateStartedAt() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldStartedAt)
+	return u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *TaskRunUpsert) ClearStartedAt() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldStartedAt)
+	return u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *TaskRunUpsert) SetFinishedAt(v time.Time) *TaskRunUpsert {
+	u.Set(taskrun.FieldFinishedAt, v)
+	return u
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateFinishedAt() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldFinishedAt)
+	return u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *TaskRunUpsert) ClearFinishedAt() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldFinishedAt)
+	return u
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (u *TaskRunUpsert) SetDurationMs(v uint64) *TaskRunUpsert {
+	u.Set(taskrun.FieldDurationMs, v)
+	return u
+}
+
+// UpdateDurationMs sets the "duration_ms" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateDurationMs() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldDurationMs)
+	return u
+}
+
+// AddDurationMs adds v to the "duration_ms" field.
+func (u *TaskRunUpsert) AddDurationMs(v uint64) *TaskRunUpsert {
+	u.Add(taskrun.FieldDurationMs, v)
+	return u
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (u *TaskRunUpsert) ClearDurationMs() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldDurationMs)
+	return u
+}
+
+// SetResult sets the "result" field.
+func (u *TaskRunUpsert) SetResult(v string) *TaskRunUpsert {
+	u.Set(taskrun.FieldResult, v)
+	return u
+}
+
+// UpdateResult sets the "result" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateResult() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldResult)
+	return u
+}
+
+// ClearResult clears the value of the "result" field.
+func (u *TaskRunUpsert) ClearResult() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldResult)
+	return u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *TaskRunUpsert) SetErrorMessage(v string) *TaskRunUpsert {
+	u.Set(taskrun.FieldErrorMessage, v)
+	return u
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateErrorMessage() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldErrorMessage)
+	return u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *TaskRunUpsert) ClearErrorMessage() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldErrorMessage)
+	return u
+}
+
+// SetLogs sets the "logs" field.
+func (u *TaskRunUpsert) SetLogs(v []string) *TaskRunUpsert {
+	u.Set(taskrun.FieldLogs, v)
+	return u
+}
+
+// UpdateLogs sets the "logs" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateLogs() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldLogs)
+	return u
+}
+
+// ClearLogs clears the value of the "logs" field.
+func (u *TaskRunUpsert) ClearLogs() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldLogs)
+	return u
+}
+
+// SetLogsTruncated sets the "logs_truncated" field.
+func (u *TaskRunUpsert) SetLogsTruncated(v bool) *TaskRunUpsert {
+	u.Set(taskrun.FieldLogsTruncated, v)
+	return u
+}
+
+// UpdateLogsTruncated sets the "logs_truncated" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateLogsTruncated() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldLogsTruncated)
+	return u
+}
+
+// ClearLogsTruncated clears the value of the "logs_truncated" field.
+func (u *TaskRunUpsert) ClearLogsTruncated() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldLogsTruncated)
+	return u
+}
+
+// SetProgress sets the "progress" field.
+func (u *TaskRunUpsert) SetProgress(v *taskpb.TaskRunProgress) *TaskRunUpsert {
+	u.Set(taskrun.FieldProgress, v)
+	return u
+}
+
+// UpdateProgress sets the "progress" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateProgress() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldProgress)
+	return u
+}
+
+// ClearProgress clears the value of the "progress" field.
+func (u *TaskRunUpsert) ClearProgress() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldProgress)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TaskRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(taskrun.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TaskRunUpsertOne) UpdateNewValues() *TaskRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(taskrun.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(taskrun.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TaskRun.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TaskRunUpsertOne) Ignore() *TaskRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TaskRunUpsertOne) DoNothing() *TaskRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TaskRunCreate.OnConflict
+// documentation for more info.
+func (u *TaskRunUpsertOne) Update(set func(*TaskRunUpsert)) *TaskRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TaskRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TaskRunUpsertOne) SetUpdatedAt(v time.Time) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateUpdatedAt() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *TaskRunUpsertOne) ClearUpdatedAt() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *TaskRunUpsertOne) SetDeletedAt(v time.Time) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateDeletedAt() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *TaskRunUpsertOne) ClearDeletedAt() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *TaskRunUpsertOne) SetTaskID(v string) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateTaskID() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (u *TaskRunUpsertOne) ClearTaskID() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearTaskID()
+	})
+}
+
+// SetTypeName sets the "type_name" field.
+func (u *TaskRunUpsertOne) SetTypeName(v string) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetTypeName(v)
+	})
+}
+
+// UpdateTypeName sets the "type_name" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateTypeName() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateTypeName()
+	})
+}
+
+// ClearTypeName clears the value of the "type_name" field.
+func (u *TaskRunUpsertOne) ClearTypeName() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearTypeName()
+	})
+}
+
+// SetQueue sets the "queue" field.
+func (u *TaskRunUpsertOne) SetQueue(v string) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetQueue(v)
+	})
+}
+
+// UpdateQueue sets the "queue" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateQueue() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateQueue()
+	})
+}
+
+// ClearQueue clears the value of the "queue" field.
+func (u *TaskRunUpsertOne) ClearQueue() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearQueue()
+	})
+}
+
+// SetPayload sets the "payload" field.
+func (u *TaskRunUpsertOne) SetPayload(v string) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetPayload(v)
+	})
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdatePayload() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdatePayload()
+	})
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (u *TaskRunUpsertOne) ClearPayload() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearPayload()
+	})
+}
+
+// SetWorker sets the "worker" field.
+func (u *TaskRunUpsertOne) SetWorker(v string) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetWorker(v)
+	})
+}
+
+// UpdateWorker sets the "worker" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateWorker() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateWorker()
+	})
+}
+
+// ClearWorker clears the value of the "worker" field.
+func (u *TaskRunUpsertOne) ClearWorker() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearWorker()
+	})
+}
+
+// SetAttempt sets the "attempt" field.
+func (u *TaskRunUpsertOne) SetAttempt(v uint32) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetAttempt(v)
+	})
+}
+
+// AddAttempt adds v to the "attempt" field.
+func (u *TaskRunUpsertOne) AddAttempt(v uint32) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.AddAttempt(v)
+	})
+}
+
+// UpdateAttempt sets the "attempt" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateAttempt() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateAttempt()
+	})
+}
+
+// ClearAttempt clears the value of the "attempt" field.
+func (u *TaskRunUpsertOne) ClearAttempt() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearAttempt()
+	})
+}
+
+// SetMaxRetry sets the "max_retry" field.
+func (u *TaskRunUpsertOne) SetMaxRetry(v uint32) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetMaxRetry(v)
+	})
+}
+
+// AddMaxRetry adds v to the "max_retry" field.
+func (u *TaskRunUpsertOne) AddMaxRetry(v uint32) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.AddMaxRetry(v)
+	})
+}
+
+// UpdateMaxRetry sets the "max_retry" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateMaxRetry() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateMaxRetry()
+	})
+}
+
+// ClearMaxRetry clears the value of the "max_retry" field.
+func (u *TaskRunUpsertOne) ClearMaxRetry() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearMaxRetry()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *TaskRunUpsertOne) SetStatus(v taskrun.Status) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateStatus() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *TaskRunUpsertOne) ClearStatus() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearStatus()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *TaskRunUpsertOne) SetStartedAt(v time.Time) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateStartedAt() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *TaskRunUpsertOne) ClearStartedAt() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *TaskRunUpsertOne) SetFinishedAt(v time.Time) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateFinishedAt() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *TaskRunUpsertOne) ClearFinishedAt() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (u *TaskRunUpsertOne) SetDurationMs(v uint64) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetDurationMs(v)
+	})
+}
+
+// AddDurationMs adds v to the "duration_ms" field.
+func (u *TaskRunUpsertOne) AddDurationMs(v uint64) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.AddDurationMs(v)
+	})
+}
+
+// UpdateDurationMs sets the "duration_ms" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateDurationMs() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateDurationMs()
+	})
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (u *TaskRunUpsertOne) ClearDurationMs() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearDurationMs()
+	})
+}
+
+// SetResult sets the "result" field.
+func (u *TaskRunUpsertOne) SetResult(v string) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetResult(v)
+	})
+}
+
+// UpdateResult sets the "result" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateResult() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateResult()
+	})
+}
+
+// ClearResult clears the value of the "result" field.
+func (u *TaskRunUpsertOne) ClearResult() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearResult()
+	})
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *TaskRunUpsertOne) SetErrorMessage(v string) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetErrorMessage(v)
+	})
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateErrorMessage() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateErrorMessage()
+	})
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *TaskRunUpsertOne) ClearErrorMessage() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearErrorMessage()
+	})
+}
+
+// SetLogs sets the "logs" field.
+func (u *TaskRunUpsertOne) SetLogs(v []string) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetLogs(v)
+	})
+}
+
+// UpdateLogs sets the "logs" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateLogs() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateLogs()
+	})
+}
+
+// ClearLogs clears the value of the "logs" field.
+func (u *TaskRunUpsertOne) ClearLogs() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearLogs()
+	})
+}
+
+// SetLogsTruncated sets the "logs_truncated" field.
+func (u *TaskRunUpsertOne) SetLogsTruncated(v bool) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetLogsTruncated(v)
+	})
+}
+
+// UpdateLogsTruncated sets the "logs_truncated" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateLogsTruncated() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateLogsTruncated()
+	})
+}
+
+// ClearLogsTruncated clears the value of the "logs_truncated" field.
+func (u *TaskRunUpsertOne) ClearLogsTruncated() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearLogsTruncated()
+	})
+}
+
+// SetProgress sets the "progress" field.
+func (u *TaskRunUpsertOne) SetProgress(v *taskpb.TaskRunProgress) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetProgress(v)
+	})
+}
+
+// UpdateProgress sets the "progress" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateProgress() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateProgress()
+	})
+}
+
+// ClearProgress clears the value of the "progress" field.
+func (u *TaskRunUpsertOne) ClearProgress() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearProgress()
+	})
+}
+
+// Exec executes the query.
+func (u *TaskRunUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TaskRunCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TaskRunUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TaskRunUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TaskRunUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TaskRunCreateBulk is the builder for creating many TaskRun entities in bulk.
+type TaskRunCreateBulk struct {
+	config
+	err      error
+	builders []*TaskRunCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TaskRun entities in the database.
+func (_c *TaskRunCreateBulk) Save(ctx context.Context) ([]*TaskRun, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TaskRun, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TaskRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TaskRunCreateBulk) SaveX(ctx context.Context) []*TaskRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TaskRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TaskRunCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TaskRun.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TaskRunUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TaskRunCreateBulk) OnConflict(opts ...sql.ConflictOption) *TaskRunUpsertBulk {
+	_c.conflict = opts
+	return &TaskRunUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TaskRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TaskRunCreateBulk) OnConflictColumns(columns ...string) *TaskRunUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TaskRunUpsertBulk{
+		create: _c,
+	}
+}
+
+// TaskRunUpsertBulk is the builder for "upsert"-ing
+// a bulk of TaskRun nodes.
+type TaskRunUpsertBulk struct {
+	create *TaskRunCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TaskRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(taskrun.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TaskRunUpsertBulk) UpdateNewValues() *TaskRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(taskrun.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(taskrun.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TaskRun.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TaskRunUpsertBulk) Ignore() *TaskRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TaskRunUpsertBulk) DoNothing() *TaskRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TaskRunCreateBulk.OnConflict
+// documentation for more info.
+func (u *TaskRunUpsertBulk) Update(set func(*TaskRunUpsert)) *TaskRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TaskRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TaskRunUpsertBulk) SetUpdatedAt(v time.Time) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateUpdatedAt() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *TaskRunUpsertBulk) ClearUpdatedAt() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *TaskRunUpsertBulk) SetDeletedAt(v time.Time) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateDeletedAt() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *TaskRunUpsertBulk) ClearDeletedAt() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *TaskRunUpsertBulk) SetTaskID(v string) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateTaskID() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (u *TaskRunUpsertBulk) ClearTaskID() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearTaskID()
+	})
+}
+
+// SetTypeName sets the "type_name" field.
+func (u *TaskRunUpsertBulk) SetTypeName(v string) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetTypeName(v)
+	})
+}
+
+// UpdateTypeName sets the "type_name" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateTypeName() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateTypeName()
+	})
+}
+
+// ClearTypeName clears the value of the "type_name" field.
+func (u *TaskRunUpsertBulk) ClearTypeName() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearTypeName()
+	})
+}
+
+// SetQueue sets the "queue" field.
+func (u *TaskRunUpsertBulk) SetQueue(v string) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetQueue(v)
+	})
+}
+
+// UpdateQueue sets the "queue" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateQueue() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateQueue()
+	})
+}
+
+// ClearQueue clears the value of the "queue" field.
+func (u *TaskRunUpsertBulk) ClearQueue() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearQueue()
+	})
+}
+
+// SetPayload sets the "payload" field.
+func (u *TaskRunUpsertBulk) SetPayload(v string) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetPayload(v)
+	})
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdatePayload() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdatePayload()
+	})
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (u *TaskRunUpsertBulk) ClearPayload() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearPayload()
+	})
+}
+
+// SetWorker sets the "worker" field.
+func (u *TaskRunUpsertBulk) SetWorker(v string) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetWorker(v)
+	})
+}
+
+// UpdateWorker sets the "worker" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateWorker() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateWorker()
+	})
+}
+
+// ClearWorker clears the value of the "worker" field.
+func (u *TaskRunUpsertBulk) ClearWorker() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearWorker()
+	})
+}
+
+// SetAttempt sets the "attempt" field.
+func (u *TaskRunUpsertBulk) SetAttempt(v uint32) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetAttempt(v)
+	})
+}
+
+// AddAttempt adds v to the "attempt" field.
+func (u *TaskRunUpsertBulk) AddAttempt(v uint32) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.AddAttempt(v)
+	})
+}
+
+// UpdateAttempt sets the "attempt" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateAttempt() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateAttempt()
+	})
+}
+
+// ClearAttempt clears the value of the "attempt" field.
+func (u *TaskRunUpsertBulk) ClearAttempt() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearAttempt()
+	})
+}
+
+// SetMaxRetry sets the "max_retry" field.
+func (u *TaskRunUpsertBulk) SetMaxRetry(v uint32) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetMaxRetry(v)
+	})
+}
+
+// AddMaxRetry adds v to the "max_retry" field.
+func (u *TaskRunUpsertBulk) AddMaxRetry(v uint32) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.AddMaxRetry(v)
+	})
+}
+
+// UpdateMaxRetry sets the "max_retry" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateMaxRetry() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateMaxRetry()
+	})
+}
+
+// ClearMaxRetry clears the value of the "max_retry" field.
+func (u *TaskRunUpsertBulk) ClearMaxRetry() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearMaxRetry()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *TaskRunUpsertBulk) SetStatus(v taskrun.Status) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateStatus() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *TaskRunUpsertBulk) ClearStatus() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearStatus()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *TaskRunUpsertBulk) SetStartedAt(v time.Time) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateStartedAt() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *TaskRunUpsertBulk) ClearStartedAt() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *TaskRunUpsertBulk) SetFinishedAt(v time.Time) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateFinishedAt() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *TaskRunUpsertBulk) ClearFinishedAt() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (u *TaskRunUpsertBulk) SetDurationMs(v uint64) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetDurationMs(v)
+	})
+}
+
+// AddDurationMs adds v to the "duration_ms" field.
+func (u *TaskRunUpsertBulk) AddDurationMs(v uint64) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.AddDurationMs(v)
+	})
+}
+
+// UpdateDurationMs sets the "duration_ms" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateDurationMs() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateDurationMs()
+	})
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (u *TaskRunUpsertBulk) ClearDurationMs() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearDurationMs()
+	})
+}
+
+// SetResult sets the "result" field.
+func (u *TaskRunUpsertBulk) SetResult(v string) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetResult(v)
+	})
+}
+
+// UpdateResult sets the "result" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateResult() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateResult()
+	})
+}
+
+// ClearResult clears the value of the "result" field.
+func (u *TaskRunUpsertBulk) ClearResult() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearResult()
+	})
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *TaskRunUpsertBulk) SetErrorMessage(v string) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetErrorMessage(v)
+	})
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateErrorMessage() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateErrorMessage()
+	})
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *TaskRunUpsertBulk) ClearErrorMessage() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearErrorMessage()
+	})
+}
+
+// SetLogs sets the "logs" field.
+func (u *TaskRunUpsertBulk) SetLogs(v []string) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetLogs(v)
+	})
+}
+
+// UpdateLogs sets the "logs" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateLogs() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateLogs()
+	})
+}
+
+// ClearLogs clears the value of the "logs" field.
+func (u *TaskRunUpsertBulk) ClearLogs() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearLogs()
+	})
+}
+
+// SetLogsTruncated sets the "logs_truncated" field.
+func (u *TaskRunUpsertBulk) SetLogsTruncated(v bool) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetLogsTruncated(v)
+	})
+}
+
+// UpdateLogsTruncated sets the "logs_truncated" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateLogsTruncated() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateLogsTruncated()
+	})
+}
+
+// ClearLogsTruncated clears the value of the "logs_truncated" field.
+func (u *TaskRunUpsertBulk) ClearLogsTruncated() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearLogsTruncated()
+	})
+}
+
+// SetProgress sets the "progress" field.
+func (u *TaskRunUpsertBulk) SetProgress(v *taskpb.TaskRunProgress) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetProgress(v)
+	})
+}
+
+// UpdateProgress sets the "progress" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateProgress() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateProgress()
+	})
+}
+
+// ClearProgress clears the value of the "progress" field.
+func (u *TaskRunUpsertBulk) ClearProgress() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearProgress()
+	})
+}
+
+// Exec executes the query.
+func (u *TaskRunUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TaskRunCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TaskRunCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TaskRunUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
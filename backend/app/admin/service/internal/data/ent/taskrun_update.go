@@ -0,0 +1,1262 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	taskpb "go-wind-admin/api/gen/go/task/service/v1"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"go-wind-admin/app/admin/service/internal/data/ent/taskrun"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// TaskRunUpdate is the builder for updating TaskRun entities.
+type TaskRunUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TaskRunMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TaskRunUpdate builder.
+func (_u *TaskRunUpdate) Where(ps ...predicate.TaskRun) *TaskRunUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TaskRunUpdate) SetUpdatedAt(v time.Time) *TaskRunUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableUpdatedAt(v *time.Time) *TaskRunUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *TaskRunUpdate) ClearUpdatedAt() *TaskRunUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *TaskRunUpdate) SetDeletedAt(v time.Time) *TaskRunUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableDeletedAt(v *time.Time) *TaskRunUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *TaskRunUpdate) ClearDeletedAt() *TaskRunUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetTaskID sets the "task_id" field.
+func (_u *TaskRunUpdate) SetTaskID(v string) *TaskRunUpdate {
+	_u.mutation.SetTaskID(v)
+	return _u
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableTaskID(v *string) *TaskRunUpdate {
+	if v != nil {
+		_u.SetTaskID(*v)
+	}
+	return _u
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (_u *TaskRunUpdate) ClearTaskID() *TaskRunUpdate {
+	_u.mutation.ClearTaskID()
+	return _u
+}
+
+// SetTypeName sets the "type_name" field.
+func (_u *TaskRunUpdate) SetTypeName(v string) *TaskRunUpdate {
+	_u.mutation.SetTypeName(v)
+	return _u
+}
+
+// SetNillableTypeName sets the "type_name" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableTypeName(v *string) *TaskRunUpdate {
+	if v != nil {
+		_u.SetTypeName(*v)
+	}
+	return _u
+}
+
+// ClearTypeName clears the value of the "type_name" field.
+func (_u *TaskRunUpdate) ClearTypeName() *TaskRunUpdate {
+	_u.mutation.ClearTypeName()
+	return _u
+}
+
+// SetQueue sets the "queue" field.
+func (_u *TaskRunUpdate) SetQueue(v string) *TaskRunUpdate {
+	_u.mutation.SetQueue(v)
+	return _u
+}
+
+// SetNillableQueue sets the "queue" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableQueue(v *string) *TaskRunUpdate {
+	if v != nil {
+		_u.SetQueue(*v)
+	}
+	return _u
+}
+
+// ClearQueue clears the value of the "queue" field.
+func (_u *TaskRunUpdate) ClearQueue() *TaskRunUpdate {
+	_u.mutation.ClearQueue()
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *TaskRunUpdate) SetPayload(v string) *TaskRunUpdate {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// SetNillablePayload sets the "payload" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillablePayload(v *string) *TaskRunUpdate {
+	if v != nil {
+		_u.SetPayload(*v)
+	}
+	return _u
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (_u *TaskRunUpdate) ClearPayload() *TaskRunUpdate {
+	_u.mutation.ClearPayload()
+	return _u
+}
+
+// SetWorker sets the "worker" field.
+func (_u *TaskRunUpdate) SetWorker(v string) *TaskRunUpdate {
+	_u.mutation.SetWorker(v)
+	return _u
+}
+
+// SetNillableWorker sets the "worker" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableWorker(v *string) *TaskRunUpdate {
+	if v != nil {
+		_u.SetWorker(*v)
+	}
+	return _u
+}
+
+// ClearWorker clears the value of the "worker" field.
+func (_u *TaskRunUpdate) ClearWorker() *TaskRunUpdate {
+	_u.mutation.ClearWorker()
+	return _u
+}
+
+// SetAttempt sets the "attempt" field.
+func (_u *TaskRunUpdate) SetAttempt(v uint32) *TaskRunUpdate {
+	_u.mutation.ResetAttempt()
+	_u.mutation.SetAttempt(v)
+	return _u
+}
+
+// SetNillableAttempt sets the "attempt" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableAttempt(v *uint32) *TaskRunUpdate {
+	if v != nil {
+		_u.SetAttempt(*v)
+	}
+	return _u
+}
+
+// AddAttempt adds value to the "attempt" field.
+func (_u *TaskRunUpdate) AddAttempt(v int32) *TaskRunUpdate {
+	_u.mutation.AddAttempt(v)
+	return _u
+}
+
+// ClearAttempt clears the value of the "attempt" field.
+func (_u *TaskRunUpdate) ClearAttempt() *TaskRunUpdate {
+	_u.mutation.ClearAttempt()
+	return _u
+}
+
+// SetMaxRetry sets the "max_retry" field.
+func (_u *TaskRunUpdate) SetMaxRetry(v uint32) *TaskRunUpdate {
+	_u.mutation.ResetMaxRetry()
+	_u.mutation.SetMaxRetry(v)
+	return _u
+}
+
+// SetNillableMaxRetry sets the "max_retry" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableMaxRetry(v *uint32) *TaskRunUpdate {
+	if v != nil {
+		_u.SetMaxRetry(*v)
+	}
+	return _u
+}
+
+// AddMaxRetry adds value to the "max_retry" field.
+func (_u *TaskRunUpdate) AddMaxRetry(v int32) *TaskRunUpdate {
+	_u.mutation.AddMaxRetry(v)
+	return _u
+}
+
+// ClearMaxRetry clears the value of the "max_retry" field.
+func (_u *TaskRunUpdate) ClearMaxRetry() *TaskRunUpdate {
+	_u.mutation.ClearMaxRetry()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *TaskRunUpdate) SetStatus(v taskrun.Status) *TaskRunUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableStatus(v *taskrun.Status) *TaskRunUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *TaskRunUpdate) ClearStatus() *TaskRunUpdate {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *TaskRunUpdate) SetStartedAt(v time.Time) *TaskRunUpdate {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableStartedAt(v *time.Time) *TaskRunUpdate {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *TaskRunUpdate) ClearStartedAt() *TaskRunUpdate {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *TaskRunUpdate) SetFinishedAt(v time.Time) *TaskRunUpdate {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableFinishedAt(v *time.Time) *TaskRunUpdate {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *TaskRunUpdate) ClearFinishedAt() *TaskRunUpdate {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_u *TaskRunUpdate) SetDurationMs(v uint64) *TaskRunUpdate {
+	_u.mutation.ResetDurationMs()
+	_u.mutation.SetDurationMs(v)
+	return _u
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableDurationMs(v *uint64) *TaskRunUpdate {
+	if v != nil {
+		_u.SetDurationMs(*v)
+	}
+	return _u
+}
+
+// AddDurationMs adds value to the "duration_ms" field.
+func (_u *TaskRunUpdate) AddDurationMs(v int64) *TaskRunUpdate {
+	_u.mutation.AddDurationMs(v)
+	return _u
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (_u *TaskRunUpdate) ClearDurationMs() *TaskRunUpdate {
+	_u.mutation.ClearDurationMs()
+	return _u
+}
+
+// SetResult sets the "result" field.
+func (_u *TaskRunUpdate) SetResult(v string) *TaskRunUpdate {
+	_u.mutation.SetResult(v)
+	return _u
+}
+
+// SetNillableResult sets the "result" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableResult(v *string) *TaskRunUpdate {
+	if v != nil {
+		_u.SetResult(*v)
+	}
+	return _u
+}
+
+// ClearResult clears the value of the "result" field.
+func (_u *TaskRunUpdate) ClearResult() *TaskRunUpdate {
+	_u.mutation.ClearResult()
+	return _u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_u *TaskRunUpdate) SetErrorMessage(v string) *TaskRunUpdate {
+	_u.mutation.SetErrorMessage(v)
+	return _u
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableErrorMessage(v *string) *TaskRunUpdate {
+	if v != nil {
+		_u.SetErrorMessage(*v)
+	}
+	return _u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (_u *TaskRunUpdate) ClearErrorMessage() *TaskRunUpdate {
+	_u.mutation.ClearErrorMessage()
+	return _u
+}
+
+// SetLogs sets the "logs" field.
+func (_u *TaskRunUpdate) SetLogs(v []string) *TaskRunUpdate {
+	_u.mutation.SetLogs(v)
+	return _u
+}
+
+// AppendLogs appends value to the "logs" field.
+func (_u *TaskRunUpdate) AppendLogs(v []string) *TaskRunUpdate {
+	_u.mutation.AppendLogs(v)
+	return _u
+}
+
+// ClearLogs clears the value of the "logs" field.
+func (_u *TaskRunUpdate) ClearLogs() *TaskRunUpdate {
+	_u.mutation.ClearLogs()
+	return _u
+}
+
+// SetLogsTruncated sets the "logs_truncated" field.
+func (_u *TaskRunUpdate) SetLogsTruncated(v bool) *TaskRunUpdate {
+	_u.mutation.SetLogsTruncated(v)
+	return _u
+}
+
+// SetNillableLogsTruncated sets the "logs_truncated" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableLogsTruncated(v *bool) *TaskRunUpdate {
+	if v != nil {
+		_u.SetLogsTruncated(*v)
+	}
+	return _u
+}
+
+// ClearLogsTruncated clears the value of the "logs_truncated" field.
+func (_u *TaskRunUpdate) ClearLogsTruncated() *TaskRunUpdate {
+	_u.mutation.ClearLogsTruncated()
+	return _u
+}
+
+// SetProgress sets the "progress" field.
+func (_u *TaskRunUpdate) SetProgress(v *taskpb.TaskRunProgress) *TaskRunUpdate {
+	_u.mutation.SetProgress(v)
+	return _u
+}
+
+// ClearProgress clears the value of the "progress" field.
+func (_u *TaskRunUpdate) ClearProgress() *TaskRunUpdate {
+	_u.mutation.ClearProgress()
+	return _u
+}
+
+// Mutation returns the TaskRunMutation object of the builder.
+func (_u *TaskRunUpdate) Mutation() *TaskRunMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TaskRunUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TaskRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TaskRunUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TaskRunUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TaskRunUpdate) check() error {
+	if v, ok := _u.mutation.TaskID(); ok {
+		if err := taskrun.TaskIDValidator(v); err != nil {
+			return &ValidationError{Name: "task_id", err: fmt.Errorf(`ent: validator failed for field "TaskRun.task_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TypeName(); ok {
+		if err := taskrun.TypeNameValidator(v); err != nil {
+			return &ValidationError{Name: "type_name", err: fmt.Errorf(`ent: validator failed for field "TaskRun.type_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Queue(); ok {
+		if err := taskrun.QueueValidator(v); err != nil {
+			return &ValidationError{Name: "queue", err: fmt.Errorf(`ent: validator failed for field "TaskRun.queue": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Worker(); ok {
+		if err := taskrun.WorkerValidator(v); err != nil {
+			return &ValidationError{Name: "worker", err: fmt.Errorf(`ent: validator failed for field "TaskRun.worker": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := taskrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TaskRun.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Progress(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "progress", err: fmt.Errorf(`ent: validator failed for field "TaskRun.progress": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TaskRunUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TaskRunUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TaskRunUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskrun.Table, taskrun.Columns, sqlgraph.NewFieldSpec(taskrun.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(taskrun.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(taskrun.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(taskrun.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(taskrun.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(taskrun.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TaskID(); ok {
+		_spec.SetField(taskrun.FieldTaskID, field.TypeString, value)
+	}
+	if _u.mutation.TaskIDCleared() {
+		_spec.ClearField(taskrun.FieldTaskID, field.TypeString)
+	}
+	if value, ok := _u.mutation.TypeName(); ok {
+		_spec.SetField(taskrun.FieldTypeName, field.TypeString, value)
+	}
+	if _u.mutation.TypeNameCleared() {
+		_spec.ClearField(taskrun.FieldTypeName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Queue(); ok {
+		_spec.SetField(taskrun.FieldQueue, field.TypeString, value)
+	}
+	if _u.mutation.QueueCleared() {
+		_spec.ClearField(taskrun.FieldQueue, field.TypeString)
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(taskrun.FieldPayload, field.TypeString, value)
+	}
+	if _u.mutation.PayloadCleared() {
+		_spec.ClearField(taskrun.FieldPayload, field.TypeString)
+	}
+	if value, ok := _u.mutation.Worker(); ok {
+		_spec.SetField(taskrun.FieldWorker, field.TypeString, value)
+	}
+	if _u.mutation.WorkerCleared() {
+		_spec.ClearField(taskrun.FieldWorker, field.TypeString)
+	}
+	if value, ok := _u.mutation.Attempt(); ok {
+		_spec.SetField(taskrun.FieldAttempt, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedAttempt(); ok {
+		_spec.AddField(taskrun.FieldAttempt, field.TypeUint32, value)
+	}
+	if _u.mutation.AttemptCleared() {
+		_spec.ClearField(taskrun.FieldAttempt, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.MaxRetry(); ok {
+		_spec.SetField(taskrun.FieldMaxRetry, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedMaxRetry(); ok {
+		_spec.AddField(taskrun.FieldMaxRetry, field.TypeUint32, value)
+	}
+	if _u.mutation.MaxRetryCleared() {
+		_spec.ClearField(taskrun.FieldMaxRetry, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(taskrun.FieldStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(taskrun.FieldStatus, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(taskrun.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(taskrun.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(taskrun.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(taskrun.FieldFinishedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DurationMs(); ok {
+		_spec.SetField(taskrun.FieldDurationMs, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMs(); ok {
+		_spec.AddField(taskrun.FieldDurationMs, field.TypeUint64, value)
+	}
+	if _u.mutation.DurationMsCleared() {
+		_spec.ClearField(taskrun.FieldDurationMs, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.Result(); ok {
+		_spec.SetField(taskrun.FieldResult, field.TypeString, value)
+	}
+	if _u.mutation.ResultCleared() {
+		_spec.ClearField(taskrun.FieldResult, field.TypeString)
+	}
+	if value, ok := _u.mutation.ErrorMessage(); ok {
+		_spec.SetField(taskrun.FieldErrorMessage, field.TypeString, value)
+	}
+	if _u.mutation.ErrorMessageCleared() {
+		_spec.ClearField(taskrun.FieldErrorMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.Logs(); ok {
+		_spec.SetField(taskrun.FieldLogs, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLogs(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, taskrun.FieldLogs, value)
+		})
+	}
+	if _u.mutation.LogsCleared() {
+		_spec.ClearField(taskrun.FieldLogs, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LogsTruncated(); ok {
+		_spec.SetField(taskrun.FieldLogsTruncated, field.TypeBool, value)
+	}
+	if _u.mutation.LogsTruncatedCleared() {
+		_spec.ClearField(taskrun.FieldLogsTruncated, field.TypeBool)
+	}
+	if value, ok := _u.mutation.Progress(); ok {
+		_spec.SetField(taskrun.FieldProgress, field.TypeJSON, value)
+	}
+	if _u.mutation.ProgressCleared() {
+		_spec.ClearField(taskrun.FieldProgress, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TaskRunUpdateOne is the builder for updating a single TaskRun entity.
+type TaskRunUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TaskRunMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TaskRunUpdateOne) SetUpdatedAt(v time.Time) *TaskRunUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableUpdatedAt(v *time.Time) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *TaskRunUpdateOne) ClearUpdatedAt() *TaskRunUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *TaskRunUpdateOne) SetDeletedAt(v time.Time) *TaskRunUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableDeletedAt(v *time.Time) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *TaskRunUpdateOne) ClearDeletedAt() *TaskRunUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetTaskID sets the "task_id" field.
+func (_u *TaskRunUpdateOne) SetTaskID(v string) *TaskRunUpdateOne {
+	_u.mutation.SetTaskID(v)
+	return _u
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableTaskID(v *string) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetTaskID(*v)
+	}
+	return _u
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (_u *TaskRunUpdateOne) ClearTaskID() *TaskRunUpdateOne {
+	_u.mutation.ClearTaskID()
+	return _u
+}
+
+// SetTypeName sets the "type_name" field.
+func (_u *TaskRunUpdateOne) SetTypeName(v string) *TaskRunUpdateOne {
+	_u.mutation.SetTypeName(v)
+	return _u
+}
+
+// SetNillableTypeName sets the "type_name" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableTypeName(v *string) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetTypeName(*v)
+	}
+	return _u
+}
+
+// ClearTypeName clears the value of the "type_name" field.
+func (_u *TaskRunUpdateOne) ClearTypeName() *TaskRunUpdateOne {
+	_u.mutation.ClearTypeName()
+	return _u
+}
+
+// SetQueue sets the "queue" field.
+func (_u *TaskRunUpdateOne) SetQueue(v string) *TaskRunUpdateOne {
+	_u.mutation.SetQueue(v)
+	return _u
+}
+
+// SetNillableQueue sets the "queue" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableQueue(v *string) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetQueue(*v)
+	}
+	return _u
+}
+
+// ClearQueue clears the value of the "queue" field.
+func (_u *TaskRunUpdateOne) ClearQueue() *TaskRunUpdateOne {
+	_u.mutation.ClearQueue()
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *TaskRunUpdateOne) SetPayload(v string) *TaskRunUpdateOne {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// SetNillablePayload sets the "payload" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillablePayload(v *string) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetPayload(*v)
+	}
+	return _u
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (_u *TaskRunUpdateOne) ClearPayload() *TaskRunUpdateOne {
+	_u.mutation.ClearPayload()
+	return _u
+}
+
+// SetWorker sets the "worker" field.
+func (_u *TaskRunUpdateOne) SetWorker(v string) *TaskRunUpdateOne {
+	_u.mutation.SetWorker(v)
+	return _u
+}
+
+// SetNillableWorker sets the "worker" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableWorker(v *string) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetWorker(*v)
+	}
+	return _u
+}
+
+// ClearWorker clears the value of the "worker" field.
+func (_u *TaskRunUpdateOne) ClearWorker() *TaskRunUpdateOne {
+	_u.mutation.ClearWorker()
+	return _u
+}
+
+// SetAttempt sets the "attempt" field.
+func (_u *TaskRunUpdateOne) SetAttempt(v uint32) *TaskRunUpdateOne {
+	_u.mutation.ResetAttempt()
+	_u.mutation.SetAttempt(v)
+	return _u
+}
+
+// SetNillableAttempt sets the "attempt" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableAttempt(v *uint32) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetAttempt(*v)
+	}
+	return _u
+}
+
+// AddAttempt adds value to the "attempt" field.
+func (_u *TaskRunUpdateOne) AddAttempt(v int32) *TaskRunUpdateOne {
+	_u.mutation.AddAttempt(v)
+	return _u
+}
+
+// ClearAttempt clears the value of the "attempt" field.
+func (_u *TaskRunUpdateOne) ClearAttempt() *TaskRunUpdateOne {
+	_u.mutation.ClearAttempt()
+	return _u
+}
+
+// SetMaxRetry sets the "max_retry" field.
+func (_u *TaskRunUpdateOne) SetMaxRetry(v uint32) *TaskRunUpdateOne {
+	_u.mutation.ResetMaxRetry()
+	_u.mutation.SetMaxRetry(v)
+	return _u
+}
+
+// SetNillableMaxRetry sets the "max_retry" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableMaxRetry(v *uint32) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetMaxRetry(*v)
+	}
+	return _u
+}
+
+// AddMaxRetry adds value to the "max_retry" field.
+func (_u *TaskRunUpdateOne) AddMaxRetry(v int32) *TaskRunUpdateOne {
+	_u.mutation.AddMaxRetry(v)
+	return _u
+}
+
+// ClearMaxRetry clears the value of the "max_retry" field.
+func (_u *TaskRunUpdateOne) ClearMaxRetry() *TaskRunUpdateOne {
+	_u.mutation.ClearMaxRetry()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *TaskRunUpdateOne) SetStatus(v taskrun.Status) *TaskRunUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableStatus(v *taskrun.Status) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *TaskRunUpdateOne) ClearStatus() *TaskRunUpdateOne {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *TaskRunUpdateOne) SetStartedAt(v time.Time) *TaskRunUpdateOne {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableStartedAt(v *time.Time) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *TaskRunUpdateOne) ClearStartedAt() *TaskRunUpdateOne {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *TaskRunUpdateOne) SetFinishedAt(v time.Time) *TaskRunUpdateOne {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableFinishedAt(v *time.Time) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *TaskRunUpdateOne) ClearFinishedAt() *TaskRunUpdateOne {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_u *TaskRunUpdateOne) SetDurationMs(v uint64) *TaskRunUpdateOne {
+	_u.mutation.ResetDurationMs()
+	_u.mutation.SetDurationMs(v)
+	return _u
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableDurationMs(v *uint64) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetDurationMs(*v)
+	}
+	return _u
+}
+
+// AddDurationMs adds value to the "duration_ms" field.
+func (_u *TaskRunUpdateOne) AddDurationMs(v int64) *TaskRunUpdateOne {
+	_u.mutation.AddDurationMs(v)
+	return _u
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (_u *TaskRunUpdateOne) ClearDurationMs() *TaskRunUpdateOne {
+	_u.mutation.ClearDurationMs()
+	return _u
+}
+
+// SetResult sets the "result" field.
+func (_u *TaskRunUpdateOne) SetResult(v string) *TaskRunUpdateOne {
+	_u.mutation.SetResult(v)
+	return _u
+}
+
+// SetNillableResult sets the "result" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableResult(v *string) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetResult(*v)
+	}
+	return _u
+}
+
+// ClearResult clears the value of the "result" field.
+func (_u *TaskRunUpdateOne) ClearResult() *TaskRunUpdateOne {
+	_u.mutation.ClearResult()
+	return _u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_u *TaskRunUpdateOne) SetErrorMessage(v string) *TaskRunUpdateOne {
+	_u.mutation.SetErrorMessage(v)
+	return _u
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableErrorMessage(v *string) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetErrorMessage(*v)
+	}
+	return _u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (_u *TaskRunUpdateOne) ClearErrorMessage() *TaskRunUpdateOne {
+	_u.mutation.ClearErrorMessage()
+	return _u
+}
+
+// SetLogs sets the "logs" field.
+func (_u *TaskRunUpdateOne) SetLogs(v []string) *TaskRunUpdateOne {
+	_u.mutation.SetLogs(v)
+	return _u
+}
+
+// AppendLogs appends value to the "logs" field.
+func (_u *TaskRunUpdateOne) AppendLogs(v []string) *TaskRunUpdateOne {
+	_u.mutation.AppendLogs(v)
+	return _u
+}
+
+// ClearLogs clears the value of the "logs" field.
+func (_u *TaskRunUpdateOne) ClearLogs() *TaskRunUpdateOne {
+	_u.mutation.ClearLogs()
+	return _u
+}
+
+// SetLogsTruncated sets the "logs_truncated" field.
+func (_u *TaskRunUpdateOne) SetLogsTruncated(v bool) *TaskRunUpdateOne {
+	_u.mutation.SetLogsTruncated(v)
+	return _u
+}
+
+// SetNillableLogsTruncated sets the "logs_truncated" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableLogsTruncated(v *bool) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetLogsTruncated(*v)
+	}
+	return _u
+}
+
+// ClearLogsTruncated clears the value of the "logs_truncated" field.
+func (_u *TaskRunUpdateOne) ClearLogsTruncated() *TaskRunUpdateOne {
+	_u.mutation.ClearLogsTruncated()
+	return _u
+}
+
+// SetProgress sets the "progress" field.
+func (_u *TaskRunUpdateOne) SetProgress(v *taskpb.TaskRunProgress) *TaskRunUpdateOne {
+	_u.mutation.SetProgress(v)
+	return _u
+}
+
+// ClearProgress clears the value of the "progress" field.
+func (_u *TaskRunUpdateOne) ClearProgress() *TaskRunUpdateOne {
+	_u.mutation.ClearProgress()
+	return _u
+}
+
+// Mutation returns the TaskRunMutation object of the builder.
+func (_u *TaskRunUpdateOne) Mutation() *TaskRunMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TaskRunUpdate builder.
+func (_u *TaskRunUpdateOne) Where(ps ...predicate.TaskRun) *TaskRunUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TaskRunUpdateOne) Select(field string, fields ...string) *TaskRunUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TaskRun entity.
+func (_u *TaskRunUpdateOne) Save(ctx context.Context) (*TaskRun, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TaskRunUpdateOne) SaveX(ctx context.Context) *TaskRun {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TaskRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TaskRunUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TaskRunUpdateOne) check() error {
+	if v, ok := _u.mutation.TaskID(); ok {
+		if err := taskrun.TaskIDValidator(v); err != nil {
+			return &ValidationError{Name: "task_id", err: fmt.Errorf(`ent: validator failed for field "TaskRun.task_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TypeName(); ok {
+		if err := taskrun.TypeNameValidator(v); err != nil {
+			return &ValidationError{Name: "type_name", err: fmt.Errorf(`ent: validator failed for field "TaskRun.type_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Queue(); ok {
+		if err := taskrun.QueueValidator(v); err != nil {
+			return &ValidationError{Name: "queue", err: fmt.Errorf(`ent: validator failed for field "TaskRun.queue": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Worker(); ok {
+		if err := taskrun.WorkerValidator(v); err != nil {
+			return &ValidationError{Name: "worker", err: fmt.Errorf(`ent: validator failed for field "TaskRun.worker": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := taskrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TaskRun.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Progress(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "progress", err: fmt.Errorf(`ent: validator failed for field "TaskRun.progress": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TaskRunUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TaskRunUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TaskRunUpdateOne) sqlSave(ctx context.Context) (_node *TaskRun, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskrun.Table, taskrun.Columns, sqlgraph.NewFieldSpec(taskrun.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TaskRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskrun.FieldID)
+		for _, f := range fields {
+			if !taskrun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != taskrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(taskrun.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(taskrun.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(taskrun.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(taskrun.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(taskrun.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TaskID(); ok {
+		_spec.SetField(taskrun.FieldTaskID, field.TypeString, value)
+	}
+	if _u.mutation.TaskIDCleared() {
+		_spec.ClearField(taskrun.FieldTaskID, field.TypeString)
+	}
+	if value, ok := _u.mutation.TypeName(); ok {
+		_spec.SetField(taskrun.FieldTypeName, field.TypeString, value)
+	}
+	if _u.mutation.TypeNameCleared() {
+		_spec.ClearField(taskrun.FieldTypeName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Queue(); ok {
+		_spec.SetField(taskrun.FieldQueue, field.TypeString, value)
+	}
+	if _u.mutation.QueueCleared() {
+		_spec.ClearField(taskrun.FieldQueue, field.TypeString)
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(taskrun.FieldPayload, field.TypeString, value)
+	}
+	if _u.mutation.PayloadCleared() {
+		_spec.ClearField(taskrun.FieldPayload, field.TypeString)
+	}
+	if value, ok := _u.mutation.Worker(); ok {
+		_spec.SetField(taskrun.FieldWorker, field.TypeString, value)
+	}
+	if _u.mutation.WorkerCleared() {
+		_spec.ClearField(taskrun.FieldWorker, field.TypeString)
+	}
+	if value, ok := _u.mutation.Attempt(); ok {
+		_spec.SetField(taskrun.FieldAttempt, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedAttempt(); ok {
+		_spec.AddField(taskrun.FieldAttempt, field.TypeUint32, value)
+	}
+	if _u.mutation.AttemptCleared() {
+		_spec.ClearField(taskrun.FieldAttempt, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.MaxRetry(); ok {
+		_spec.SetField(taskrun.FieldMaxRetry, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedMaxRetry(); ok {
+		_spec.AddField(taskrun.FieldMaxRetry, field.TypeUint32, value)
+	}
+	if _u.mutation.MaxRetryCleared() {
+		_spec.ClearField(taskrun.FieldMaxRetry, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(taskrun.FieldStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(taskrun.FieldStatus, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(taskrun.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(taskrun.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(taskrun.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(taskrun.FieldFinishedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DurationMs(); ok {
+		_spec.SetField(taskrun.FieldDurationMs, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMs(); ok {
+		_spec.AddField(taskrun.FieldDurationMs, field.TypeUint64, value)
+	}
+	if _u.mutation.DurationMsCleared() {
+		_spec.ClearField(taskrun.FieldDurationMs, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.Result(); ok {
+		_spec.SetField(taskrun.FieldResult, field.TypeString, value)
+	}
+	if _u.mutation.ResultCleared() {
+		_spec.ClearField(taskrun.FieldResult, field.TypeString)
+	}
+	if value, ok := _u.mutation.ErrorMessage(); ok {
+		_spec.SetField(taskrun.FieldErrorMessage, field.TypeString, value)
+	}
+	if _u.mutation.ErrorMessageCleared() {
+		_spec.ClearField(taskrun.FieldErrorMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.Logs(); ok {
+		_spec.SetField(taskrun.FieldLogs, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLogs(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, taskrun.FieldLogs, value)
+		})
+	}
+	if _u.mutation.LogsCleared() {
+		_spec.ClearField(taskrun.FieldLogs, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LogsTruncated(); ok {
+		_spec.SetField(taskrun.FieldLogsTruncated, field.TypeBool, value)
+	}
+	if _u.mutation.LogsTruncatedCleared() {
+		_spec.ClearField(taskrun.FieldLogsTruncated, field.TypeBool)
+	}
+	if value, ok := _u.mutation.Progress(); ok {
+		_spec.SetField(taskrun.FieldProgress, field.TypeJSON, value)
+	}
+	if _u.mutation.ProgressCleared() {
+		_spec.ClearField(taskrun.FieldProgress, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &TaskRun{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
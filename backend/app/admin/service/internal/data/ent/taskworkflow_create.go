@@ -0,0 +1,1267 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	taskpb "go-wind-admin/api/gen/go/task/service/v1"
+	"go-wind-admin/app/admin/service/internal/data/ent/taskworkflow"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TaskWorkflowCreate is the builder for creating a TaskWorkflow entity.
+type TaskWorkflowCreate struct {
+	config
+	mutation *TaskWorkflowMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TaskWorkflowCreate) SetCreatedAt(v time.Time) *TaskWorkflowCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TaskWorkflowCreate) SetNillableCreatedAt(v *time.Time) *TaskWorkflowCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *TaskWorkflowCreate) SetUpdatedAt(v time.Time) *TaskWorkflowCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *TaskWorkflowCreate) SetNillableUpdatedAt(v *time.Time) *TaskWorkflowCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *TaskWorkflowCreate) SetDeletedAt(v time.Time) *TaskWorkflowCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *TaskWorkflowCreate) SetNillableDeletedAt(v *time.Time) *TaskWorkflowCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *TaskWorkflowCreate) SetCreatedBy(v uint32) *TaskWorkflowCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *TaskWorkflowCreate) SetNillableCreatedBy(v *uint32) *TaskWorkflowCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *TaskWorkflowCreate) SetUpdatedBy(v uint32) *TaskWorkflowCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *TaskWorkflowCreate) SetNillableUpdatedBy(v *uint32) *TaskWorkflowCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_c *TaskWorkflowCreate) SetDeletedBy(v uint32) *TaskWorkflowCreate {
+	_c.mutation.SetDeletedBy(v)
+	return _c
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_c *TaskWorkflowCreate) SetNillableDeletedBy(v *uint32) *TaskWorkflowCreate {
+	if v != nil {
+		_c.SetDeletedBy(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *TaskWorkflowCreate) SetTenantID(v uint32) *TaskWorkflowCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *TaskWorkflowCreate) SetNillableTenantID(v *uint32) *TaskWorkflowCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *TaskWorkflowCreate) SetName(v string) *TaskWorkflowCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *TaskWorkflowCreate) SetNillableName(v *string) *TaskWorkflowCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *TaskWorkflowCreate) SetDescription(v string) *TaskWorkflowCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *TaskWorkflowCreate) SetNillableDescription(v *string) *TaskWorkflowCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetSteps sets the "steps" field.
+func (_c *TaskWorkflowCreate) SetSteps(v []*taskpb.TaskWorkflowStep) *TaskWorkflowCreate {
+	_c.mutation.SetSteps(v)
+	return _c
+}
+
+// SetEnable sets the "enable" field.
+func (_c *TaskWorkflowCreate) SetEnable(v bool) *TaskWorkflowCreate {
+	_c.mutation.SetEnable(v)
+	return _c
+}
+
+// SetNillableEnable sets the "enable" field if the given value is not nil.
+func (_c *TaskWorkflowCreate) SetNillableEnable(v *bool) *TaskWorkflowCreate {
+	if v != nil {
+		_c.SetEnable(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TaskWorkflowCreate) SetID(v uint32) *TaskWorkflowCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the TaskWorkflowMutation object of the builder.
+func (_c *TaskWorkflowCreate) Mutation() *TaskWorkflowMutation {
+	return _c.mutation
+}
+
+// Save creates the TaskWorkflow in the database.
+func (_c *TaskWorkflowCreate) Save(ctx context.Context) (*TaskWorkflow, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TaskWorkflowCreate) SaveX(ctx context.Context) *TaskWorkflow {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TaskWorkflowCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TaskWorkflowCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TaskWorkflowCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := taskworkflow.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.Enable(); !ok {
+		v := taskworkflow.DefaultEnable
+		_c.mutation.SetEnable(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TaskWorkflowCreate) check() error {
+	if v, ok := _c.mutation.Name(); ok {
+		if err := taskworkflow.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TaskWorkflow.name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Description(); ok {
+		if err := taskworkflow.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "TaskWorkflow.description": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := taskworkflow.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "TaskWorkflow.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *TaskWorkflowCreate) sqlSave(ctx context.Context) (*TaskWorkflow, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TaskWorkflowCreate) createSpec() (*TaskWorkflow, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TaskWorkflow{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(taskworkflow.Table, sqlgraph.NewFieldSpec(taskworkflow.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(taskworkflow.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(taskworkflow.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(taskworkflow.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(taskworkflow.FieldCreatedBy, field.TypeUint32, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(taskworkflow.FieldUpdatedBy, field.TypeUint32, value)
+		_node.UpdatedBy = &value
+	}
+	if value, ok := _c.mutation.DeletedBy(); ok {
+		_spec.SetField(taskworkflow.FieldDeletedBy, field.TypeUint32, value)
+		_node.DeletedBy = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(taskworkflow.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(taskworkflow.FieldName, field.TypeString, value)
+		_node.Name = &value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(taskworkflow.FieldDescription, field.TypeString, value)
+		_node.Description = &value
+	}
+	if value, ok := _c.mutation.Steps(); ok {
+		_spec.SetField(taskworkflow.FieldSteps, field.TypeJSON, value)
+		_node.Steps = value
+	}
+	if value, ok := _c.mutation.Enable(); ok {
+		_spec.SetField(taskworkflow.FieldEnable, field.TypeBool, value)
+		_node.Enable = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TaskWorkflow.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TaskWorkflowUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TaskWorkflowCreate) OnConflict(opts ...sql.ConflictOption) *TaskWorkflowUpsertOne {
+	_c.conflict = opts
+	return &TaskWorkflowUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TaskWorkflow.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TaskWorkflowCreate) OnConflictColumns(columns ...string) *TaskWorkflowUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TaskWorkflowUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// TaskWorkflowUpsertOne is the builder for "upsert"-ing
+	//  one TaskWorkflow node.
+	TaskWorkflowUpsertOne struct {
+		create *TaskWorkflowCreate
+	}
+
+	// TaskWorkflowUpsert is the "OnConflict" setter.
+	TaskWorkflowUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TaskWorkflowUpsert) SetUpdatedAt(v time.Time) *TaskWorkflowUpsert {
+	u.Set(taskworkflow.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TaskWorkflowUpsert) UpdateUpdatedAt() *TaskWorkflowUpsert {
+	u.SetExcluded(taskworkflow.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *TaskWorkflowUpsert) ClearUpdatedAt() *TaskWorkflowUpsert {
+	u.SetNull(taskworkflow.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *TaskWorkflowUpsert) SetDeletedAt(v time.Time) *TaskWorkflowUpsert {
+	u.Set(taskworkflow.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *TaskWorkflowUpsert) UpdateDeletedAt() *TaskWorkflowUpsert {
+	u.SetExcluded(taskworkflow.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *TaskWorkflowUpsert) ClearDeletedAt() *TaskWorkflowUpsert {
+	u.SetNull(taskworkflow.FieldDeletedAt)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *TaskWorkflowUpsert) SetCreatedBy(v uint32) *TaskWorkflowUpsert {
+	u.Set(taskworkflow.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *TaskWorkflowUpsert) UpdateCreatedBy() *TaskWorkflowUpsert {
+	u.SetExcluded(taskworkflow.FieldCreatedBy)
+	return u
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *TaskWorkflowUpsert) AddCreatedBy(v uint32) *TaskWorkflowUpsert {
+	u.Add(taskworkflow.FieldCreatedBy, v)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *TaskWorkflowUpsert) ClearCreatedBy() *TaskWorkflowUpsert {
+	u.SetNull(taskworkflow.FieldCreatedBy)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *TaskWorkflowUpsert) SetUpdatedBy(v uint32) *TaskWorkflowUpsert {
+	u.Set(taskworkflow.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *TaskWorkflowUpsert) UpdateUpdatedBy() *TaskWorkflowUpsert {
+	u.SetExcluded(taskworkflow.FieldUpdatedBy)
+	return u
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *TaskWorkflowUpsert) AddUpdatedBy(v uint32) *TaskWorkflowUpsert {
+	u.Add(taskworkflow.FieldUpdatedBy, v)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *TaskWorkflowUpsert) ClearUpdatedBy() *TaskWorkflowUpsert {
+	u.SetNull(taskworkflow.FieldUpdatedBy)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *TaskWorkflowUpsert) SetDeletedBy(v uint32) *TaskWorkflowUpsert {
+	u.Set(taskworkflow.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *TaskWorkflowUpsert) UpdateDeletedBy() *TaskWorkflowUpsert {
+	u.SetExcluded(taskworkflow.FieldDeletedBy)
+	return u
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *TaskWorkflowUpsert) AddDeletedBy(v uint32) *TaskWorkflowUpsert {
+	u.Add(taskworkflow.FieldDeletedBy, v)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *TaskWorkflowUpsert) ClearDeletedBy() *TaskWorkflowUpsert {
+	u.SetNull(taskworkflow.FieldDeletedBy)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *TaskWorkflowUpsert) SetName(v string) *TaskWorkflowUpsert {
+	u.Set(taskworkflow.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TaskWorkflowUpsert) UpdateName() *TaskWorkflowUpsert {
+	u.SetExcluded(taskworkflow.FieldName)
+	return u
+}
+
+// ClearName clears the value of the "name" field.
+func (u *TaskWorkflowUpsert) ClearName() *TaskWorkflowUpsert {
+	u.SetNull(taskworkflow.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *TaskWorkflowUpsert) SetDescription(v string) *TaskWorkflowUpsert {
+	u.Set(taskworkflow.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *TaskWorkflowUpsert) UpdateDescription() *TaskWorkflowUpsert {
+	u.SetExcluded(taskworkflow.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *TaskWorkflowUpsert) ClearDescription() *TaskWorkflowUpsert {
+	u.SetNull(taskworkflow.FieldDescription)
+	return u
+}
+
+// SetSteps sets the "steps" field.
+func (u *TaskWorkflowUpsert) SetSteps(v []*taskpb.TaskWorkflowStep) *TaskWorkflowUpsert {
+	u.Set(taskworkflow.FieldSteps, v)
+	return u
+}
+
+// UpdateSteps sets the "steps" field to the value that was provided on create.
+func (u *TaskWorkflowUpsert) UpdateSteps() *TaskWorkflowUpsert {
+	u.SetExcluded(taskworkflow.FieldSteps)
+	return u
+}
+
+// ClearSteps clears the value of the "steps" field.
+func (u *TaskWorkflowUpsert) ClearSteps() *TaskWorkflowUpsert {
+	u.SetNull(taskworkflow.FieldSteps)
+	return u
+}
+
+// SetEnable sets the "enable" field.
+func (u *TaskWorkflowUpsert) SetEnable(v bool) *TaskWorkflowUpsert {
+	u.Set(taskworkflow.FieldEnable, v)
+	return u
+}
+
+// UpdateEnable sets the "enable" field to the value that was provided on create.
+func (u *TaskWorkflowUpsert) UpdateEnable() *TaskWorkflowUpsert {
+	u.SetExcluded(taskworkflow.FieldEnable)
+	return u
+}
+
+// ClearEnable clears the value of the "enable" field.
+func (u *TaskWorkflowUpsert) ClearEnable() *TaskWorkflowUpsert {
+	u.SetNull(taskworkflow.FieldEnable)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TaskWorkflow.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(taskworkflow.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TaskWorkflowUpsertOne) UpdateNewValues() *TaskWorkflowUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(taskworkflow.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(taskworkflow.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(taskworkflow.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TaskWorkflow.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TaskWorkflowUpsertOne) Ignore() *TaskWorkflowUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TaskWorkflowUpsertOne) DoNothing() *TaskWorkflowUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TaskWorkflowCreate.OnConflict
+// documentation for more info.
+func (u *TaskWorkflowUpsertOne) Update(set func(*TaskWorkflowUpsert)) *TaskWorkflowUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TaskWorkflowUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TaskWorkflowUpsertOne) SetUpdatedAt(v time.Time) *TaskWorkflowUpsertOne {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TaskWorkflowUpsertOne) UpdateUpdatedAt() *TaskWorkflowUpsertOne {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *TaskWorkflowUpsertOne) ClearUpdatedAt() *TaskWorkflowUpsertOne {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *TaskWorkflowUpsertOne) SetDeletedAt(v time.Time) *TaskWorkflowUpsertOne {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *TaskWorkflowUpsertOne) UpdateDeletedAt() *TaskWorkflowUpsertOne {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *TaskWorkflowUpsertOne) ClearDeletedAt() *TaskWorkflowUpsertOne {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *TaskWorkflowUpsertOne) SetCreatedBy(v uint32) *TaskWorkflowUpsertOne {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *TaskWorkflowUpsertOne) AddCreatedBy(v uint32) *TaskWorkflowUpsertOne {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *TaskWorkflowUpsertOne) UpdateCreatedBy() *TaskWorkflowUpsertOne {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *TaskWorkflowUpsertOne) ClearCreatedBy() *TaskWorkflowUpsertOne {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *TaskWorkflowUpsertOne) SetUpdatedBy(v uint32) *TaskWorkflowUpsertOne {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *TaskWorkflowUpsertOne) AddUpdatedBy(v uint32) *TaskWorkflowUpsertOne {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *TaskWorkflowUpsertOne) UpdateUpdatedBy() *TaskWorkflowUpsertOne {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *TaskWorkflowUpsertOne) ClearUpdatedBy() *TaskWorkflowUpsertOne {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *TaskWorkflowUpsertOne) SetDeletedBy(v uint32) *TaskWorkflowUpsertOne {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *TaskWorkflowUpsertOne) AddDeletedBy(v uint32) *TaskWorkflowUpsertOne {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *TaskWorkflowUpsertOne) UpdateDeletedBy() *TaskWorkflowUpsertOne {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *TaskWorkflowUpsertOne) ClearDeletedBy() *TaskWorkflowUpsertOne {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *TaskWorkflowUpsertOne) SetName(v string) *TaskWorkflowUpsertOne {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TaskWorkflowUpsertOne) UpdateName() *TaskWorkflowUpsertOne {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *TaskWorkflowUpsertOne) ClearName() *TaskWorkflowUpsertOne {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *TaskWorkflowUpsertOne) SetDescription(v string) *TaskWorkflowUpsertOne {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *TaskWorkflowUpsertOne) UpdateDescription() *TaskWorkflowUpsertOne {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *TaskWorkflowUpsertOne) ClearDescription() *TaskWorkflowUpsertOne {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetSteps sets the "steps" field.
+func (u *TaskWorkflowUpsertOne) SetSteps(v []*taskpb.TaskWorkflowStep) *TaskWorkflowUpsertOne {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.SetSteps(v)
+	})
+}
+
+// UpdateSteps sets the "steps" field to the value that was provided on create.
+func (u *TaskWorkflowUpsertOne) UpdateSteps() *TaskWorkflowUpsertOne {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.UpdateSteps()
+	})
+}
+
+// ClearSteps clears the value of the "steps" field.
+func (u *TaskWorkflowUpsertOne) ClearSteps() *TaskWorkflowUpsertOne {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.ClearSteps()
+	})
+}
+
+// SetEnable sets the "enable" field.
+func (u *TaskWorkflowUpsertOne) SetEnable(v bool) *TaskWorkflowUpsertOne {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.SetEnable(v)
+	})
+}
+
+// UpdateEnable sets the "enable" field to the value that was provided on create.
+func (u *TaskWorkflowUpsertOne) UpdateEnable() *TaskWorkflowUpsertOne {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.UpdateEnable()
+	})
+}
+
+// ClearEnable clears the value of the "enable" field.
+func (u *TaskWorkflowUpsertOne) ClearEnable() *TaskWorkflowUpsertOne {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.ClearEnable()
+	})
+}
+
+// Exec executes the query.
+func (u *TaskWorkflowUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TaskWorkflowCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TaskWorkflowUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TaskWorkflowUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TaskWorkflowUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TaskWorkflowCreateBulk is the builder for creating many TaskWorkflow entities in bulk.
+type TaskWorkflowCreateBulk struct {
+	config
+	err      error
+	builders []*TaskWorkflowCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TaskWorkflow entities in the database.
+func (_c *TaskWorkflowCreateBulk) Save(ctx context.Context) ([]*TaskWorkflow, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TaskWorkflow, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TaskWorkflowMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TaskWorkflowCreateBulk) SaveX(ctx context.Context) []*TaskWorkflow {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TaskWorkflowCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TaskWorkflowCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TaskWorkflow.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TaskWorkflowUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TaskWorkflowCreateBulk) OnConflict(opts ...sql.ConflictOption) *TaskWorkflowUpsertBulk {
+	_c.conflict = opts
+	return &TaskWorkflowUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TaskWorkflow.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TaskWorkflowCreateBulk) OnConflictColumns(columns ...string) *TaskWorkflowUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TaskWorkflowUpsertBulk{
+		create: _c,
+	}
+}
+
+// TaskWorkflowUpsertBulk is the builder for "upsert"-ing
+// a bulk of TaskWorkflow nodes.
+type TaskWorkflowUpsertBulk struct {
+	create *TaskWorkflowCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TaskWorkflow.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(taskworkflow.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TaskWorkflowUpsertBulk) UpdateNewValues() *TaskWorkflowUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(taskworkflow.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(taskworkflow.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(taskworkflow.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TaskWorkflow.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TaskWorkflowUpsertBulk) Ignore() *TaskWorkflowUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TaskWorkflowUpsertBulk) DoNothing() *TaskWorkflowUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TaskWorkflowCreateBulk.OnConflict
+// documentation for more info.
+func (u *TaskWorkflowUpsertBulk) Update(set func(*TaskWorkflowUpsert)) *TaskWorkflowUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TaskWorkflowUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TaskWorkflowUpsertBulk) SetUpdatedAt(v time.Time) *TaskWorkflowUpsertBulk {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TaskWorkflowUpsertBulk) UpdateUpdatedAt() *TaskWorkflowUpsertBulk {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *TaskWorkflowUpsertBulk) ClearUpdatedAt() *TaskWorkflowUpsertBulk {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *TaskWorkflowUpsertBulk) SetDeletedAt(v time.Time) *TaskWorkflowUpsertBulk {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *TaskWorkflowUpsertBulk) UpdateDeletedAt() *TaskWorkflowUpsertBulk {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *TaskWorkflowUpsertBulk) ClearDeletedAt() *TaskWorkflowUpsertBulk {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *TaskWorkflowUpsertBulk) SetCreatedBy(v uint32) *TaskWorkflowUpsertBulk {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *TaskWorkflowUpsertBulk) AddCreatedBy(v uint32) *TaskWorkflowUpsertBulk {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *TaskWorkflowUpsertBulk) UpdateCreatedBy() *TaskWorkflowUpsertBulk {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *TaskWorkflowUpsertBulk) ClearCreatedBy() *TaskWorkflowUpsertBulk {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *TaskWorkflowUpsertBulk) SetUpdatedBy(v uint32) *TaskWorkflowUpsertBulk {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *TaskWorkflowUpsertBulk) AddUpdatedBy(v uint32) *TaskWorkflowUpsertBulk {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *TaskWorkflowUpsertBulk) UpdateUpdatedBy() *TaskWorkflowUpsertBulk {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *TaskWorkflowUpsertBulk) ClearUpdatedBy() *TaskWorkflowUpsertBulk {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *TaskWorkflowUpsertBulk) SetDeletedBy(v uint32) *TaskWorkflowUpsertBulk {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *TaskWorkflowUpsertBulk) AddDeletedBy(v uint32) *TaskWorkflowUpsertBulk {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *TaskWorkflowUpsertBulk) UpdateDeletedBy() *TaskWorkflowUpsertBulk {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *TaskWorkflowUpsertBulk) ClearDeletedBy() *TaskWorkflowUpsertBulk {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *TaskWorkflowUpsertBulk) SetName(v string) *TaskWorkflowUpsertBulk {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TaskWorkflowUpsertBulk) UpdateName() *TaskWorkflowUpsertBulk {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *TaskWorkflowUpsertBulk) ClearName() *TaskWorkflowUpsertBulk {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *TaskWorkflowUpsertBulk) SetDescription(v string) *TaskWorkflowUpsertBulk {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *TaskWorkflowUpsertBulk) UpdateDescription() *TaskWorkflowUpsertBulk {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *TaskWorkflowUpsertBulk) ClearDescription() *TaskWorkflowUpsertBulk {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetSteps sets the "steps" field.
+func (u *TaskWorkflowUpsertBulk) SetSteps(v []*taskpb.TaskWorkflowStep) *TaskWorkflowUpsertBulk {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.SetSteps(v)
+	})
+}
+
+// UpdateSteps sets the "steps" field to the value that was provided on create.
+func (u *TaskWorkflowUpsertBulk) UpdateSteps() *TaskWorkflowUpsertBulk {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.UpdateSteps()
+	})
+}
+
+// ClearSteps clears the value of the "steps" field.
+func (u *TaskWorkflowUpsertBulk) ClearSteps() *TaskWorkflowUpsertBulk {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.ClearSteps()
+	})
+}
+
+// SetEnable sets the "enable" field.
+func (u *TaskWorkflowUpsertBulk) SetEnable(v bool) *TaskWorkflowUpsertBulk {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.SetEnable(v)
+	})
+}
+
+// UpdateEnable sets the "enable" field to the value that was provided on create.
+func (u *TaskWorkflowUpsertBulk) UpdateEnable() *TaskWorkflowUpsertBulk {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.UpdateEnable()
+	})
+}
+
+// ClearEnable clears the value of the "enable" field.
+func (u *TaskWorkflowUpsertBulk) ClearEnable() *TaskWorkflowUpsertBulk {
+	return u.Update(func(s *TaskWorkflowUpsert) {
+		s.ClearEnable()
+	})
+}
+
+// Exec executes the query.
+func (u *TaskWorkflowUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TaskWorkflowCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TaskWorkflowCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TaskWorkflowUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,776 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	taskpb "go-wind-admin/api/gen/go/task/service/v1"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"go-wind-admin/app/admin/service/internal/data/ent/taskworkflow"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// TaskWorkflowUpdate is the builder for updating TaskWorkflow entities.
+type TaskWorkflowUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TaskWorkflowMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TaskWorkflowUpdate builder.
+func (_u *TaskWorkflowUpdate) Where(ps ...predicate.TaskWorkflow) *TaskWorkflowUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TaskWorkflowUpdate) SetUpdatedAt(v time.Time) *TaskWorkflowUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *TaskWorkflowUpdate) SetNillableUpdatedAt(v *time.Time) *TaskWorkflowUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *TaskWorkflowUpdate) ClearUpdatedAt() *TaskWorkflowUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *TaskWorkflowUpdate) SetDeletedAt(v time.Time) *TaskWorkflowUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *TaskWorkflowUpdate) SetNillableDeletedAt(v *time.Time) *TaskWorkflowUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *TaskWorkflowUpdate) ClearDeletedAt() *TaskWorkflowUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *TaskWorkflowUpdate) SetCreatedBy(v uint32) *TaskWorkflowUpdate {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *TaskWorkflowUpdate) SetNillableCreatedBy(v *uint32) *TaskWorkflowUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *TaskWorkflowUpdate) AddCreatedBy(v int32) *TaskWorkflowUpdate {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *TaskWorkflowUpdate) ClearCreatedBy() *TaskWorkflowUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *TaskWorkflowUpdate) SetUpdatedBy(v uint32) *TaskWorkflowUpdate {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *TaskWorkflowUpdate) SetNillableUpdatedBy(v *uint32) *TaskWorkflowUpdate {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *TaskWorkflowUpdate) AddUpdatedBy(v int32) *TaskWorkflowUpdate {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *TaskWorkflowUpdate) ClearUpdatedBy() *TaskWorkflowUpdate {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *TaskWorkflowUpdate) SetDeletedBy(v uint32) *TaskWorkflowUpdate {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *TaskWorkflowUpdate) SetNillableDeletedBy(v *uint32) *TaskWorkflowUpdate {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *TaskWorkflowUpdate) AddDeletedBy(v int32) *TaskWorkflowUpdate {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *TaskWorkflowUpdate) ClearDeletedBy() *TaskWorkflowUpdate {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *TaskWorkflowUpdate) SetName(v string) *TaskWorkflowUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TaskWorkflowUpdate) SetNillableName(v *string) *TaskWorkflowUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *TaskWorkflowUpdate) ClearName() *TaskWorkflowUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *TaskWorkflowUpdate) SetDescription(v string) *TaskWorkflowUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *TaskWorkflowUpdate) SetNillableDescription(v *string) *TaskWorkflowUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *TaskWorkflowUpdate) ClearDescription() *TaskWorkflowUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetSteps sets the "steps" field.
+func (_u *TaskWorkflowUpdate) SetSteps(v []*taskpb.TaskWorkflowStep) *TaskWorkflowUpdate {
+	_u.mutation.SetSteps(v)
+	return _u
+}
+
+// AppendSteps appends value to the "steps" field.
+func (_u *TaskWorkflowUpdate) AppendSteps(v []*taskpb.TaskWorkflowStep) *TaskWorkflowUpdate {
+	_u.mutation.AppendSteps(v)
+	return _u
+}
+
+// ClearSteps clears the value of the "steps" field.
+func (_u *TaskWorkflowUpdate) ClearSteps() *TaskWorkflowUpdate {
+	_u.mutation.ClearSteps()
+	return _u
+}
+
+// SetEnable sets the "enable" field.
+func (_u *TaskWorkflowUpdate) SetEnable(v bool) *TaskWorkflowUpdate {
+	_u.mutation.SetEnable(v)
+	return _u
+}
+
+// SetNillableEnable sets the "enable" field if the given value is not nil.
+func (_u *TaskWorkflowUpdate) SetNillableEnable(v *bool) *TaskWorkflowUpdate {
+	if v != nil {
+		_u.SetEnable(*v)
+	}
+	return _u
+}
+
+// ClearEnable clears the value of the "enable" field.
+func (_u *TaskWorkflowUpdate) ClearEnable() *TaskWorkflowUpdate {
+	_u.mutation.ClearEnable()
+	return _u
+}
+
+// Mutation returns the TaskWorkflowMutation object of the builder.
+func (_u *TaskWorkflowUpdate) Mutation() *TaskWorkflowMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TaskWorkflowUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TaskWorkflowUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TaskWorkflowUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TaskWorkflowUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TaskWorkflowUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := taskworkflow.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TaskWorkflow.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := taskworkflow.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "TaskWorkflow.description": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TaskWorkflowUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TaskWorkflowUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TaskWorkflowUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskworkflow.Table, taskworkflow.Columns, sqlgraph.NewFieldSpec(taskworkflow.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(taskworkflow.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(taskworkflow.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(taskworkflow.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(taskworkflow.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(taskworkflow.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(taskworkflow.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(taskworkflow.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(taskworkflow.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(taskworkflow.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(taskworkflow.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(taskworkflow.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(taskworkflow.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(taskworkflow.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(taskworkflow.FieldDeletedBy, field.TypeUint32)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(taskworkflow.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(taskworkflow.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(taskworkflow.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(taskworkflow.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(taskworkflow.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Steps(); ok {
+		_spec.SetField(taskworkflow.FieldSteps, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSteps(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, taskworkflow.FieldSteps, value)
+		})
+	}
+	if _u.mutation.StepsCleared() {
+		_spec.ClearField(taskworkflow.FieldSteps, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Enable(); ok {
+		_spec.SetField(taskworkflow.FieldEnable, field.TypeBool, value)
+	}
+	if _u.mutation.EnableCleared() {
+		_spec.ClearField(taskworkflow.FieldEnable, field.TypeBool)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskworkflow.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TaskWorkflowUpdateOne is the builder for updating a single TaskWorkflow entity.
+type TaskWorkflowUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TaskWorkflowMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TaskWorkflowUpdateOne) SetUpdatedAt(v time.Time) *TaskWorkflowUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *TaskWorkflowUpdateOne) SetNillableUpdatedAt(v *time.Time) *TaskWorkflowUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *TaskWorkflowUpdateOne) ClearUpdatedAt() *TaskWorkflowUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *TaskWorkflowUpdateOne) SetDeletedAt(v time.Time) *TaskWorkflowUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *TaskWorkflowUpdateOne) SetNillableDeletedAt(v *time.Time) *TaskWorkflowUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *TaskWorkflowUpdateOne) ClearDeletedAt() *TaskWorkflowUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *TaskWorkflowUpdateOne) SetCreatedBy(v uint32) *TaskWorkflowUpdateOne {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *TaskWorkflowUpdateOne) SetNillableCreatedBy(v *uint32) *TaskWorkflowUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *TaskWorkflowUpdateOne) AddCreatedBy(v int32) *TaskWorkflowUpdateOne {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *TaskWorkflowUpdateOne) ClearCreatedBy() *TaskWorkflowUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *TaskWorkflowUpdateOne) SetUpdatedBy(v uint32) *TaskWorkflowUpdateOne {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *TaskWorkflowUpdateOne) SetNillableUpdatedBy(v *uint32) *TaskWorkflowUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *TaskWorkflowUpdateOne) AddUpdatedBy(v int32) *TaskWorkflowUpdateOne {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *TaskWorkflowUpdateOne) ClearUpdatedBy() *TaskWorkflowUpdateOne {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *TaskWorkflowUpdateOne) SetDeletedBy(v uint32) *TaskWorkflowUpdateOne {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *TaskWorkflowUpdateOne) SetNillableDeletedBy(v *uint32) *TaskWorkflowUpdateOne {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *TaskWorkflowUpdateOne) AddDeletedBy(v int32) *TaskWorkflowUpdateOne {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *TaskWorkflowUpdateOne) ClearDeletedBy() *TaskWorkflowUpdateOne {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *TaskWorkflowUpdateOne) SetName(v string) *TaskWorkflowUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TaskWorkflowUpdateOne) SetNillableName(v *string) *TaskWorkflowUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *TaskWorkflowUpdateOne) ClearName() *TaskWorkflowUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *TaskWorkflowUpdateOne) SetDescription(v string) *TaskWorkflowUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *TaskWorkflowUpdateOne) SetNillableDescription(v *string) *TaskWorkflowUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *TaskWorkflowUpdateOne) ClearDescription() *TaskWorkflowUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetSteps sets the "steps" field.
+func (_u *TaskWorkflowUpdateOne) SetSteps(v []*taskpb.TaskWorkflowStep) *TaskWorkflowUpdateOne {
+	_u.mutation.SetSteps(v)
+	return _u
+}
+
+// AppendSteps appends value to the "steps" field.
+func (_u *TaskWorkflowUpdateOne) AppendSteps(v []*taskpb.TaskWorkflowStep) *TaskWorkflowUpdateOne {
+	_u.mutation.AppendSteps(v)
+	return _u
+}
+
+// ClearSteps clears the value of the "steps" field.
+func (_u *TaskWorkflowUpdateOne) ClearSteps() *TaskWorkflowUpdateOne {
+	_u.mutation.ClearSteps()
+	return _u
+}
+
+// SetEnable sets the "enable" field.
+func (_u *TaskWorkflowUpdateOne) SetEnable(v bool) *TaskWorkflowUpdateOne {
+	_u.mutation.SetEnable(v)
+	return _u
+}
+
+// SetNillableEnable sets the "enable" field if the given value is not nil.
+func (_u *TaskWorkflowUpdateOne) SetNillableEnable(v *bool) *TaskWorkflowUpdateOne {
+	if v != nil {
+		_u.SetEnable(*v)
+	}
+	return _u
+}
+
+// ClearEnable clears the value of the "enable" field.
+func (_u *TaskWorkflowUpdateOne) ClearEnable() *TaskWorkflowUpdateOne {
+	_u.mutation.ClearEnable()
+	return _u
+}
+
+// Mutation returns the TaskWorkflowMutation object of the builder.
+func (_u *TaskWorkflowUpdateOne) Mutation() *TaskWorkflowMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TaskWorkflowUpdate builder.
+func (_u *TaskWorkflowUpdateOne) Where(ps ...predicate.TaskWorkflow) *TaskWorkflowUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TaskWorkflowUpdateOne) Select(field string, fields ...string) *TaskWorkflowUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TaskWorkflow entity.
+func (_u *TaskWorkflowUpdateOne) Save(ctx context.Context) (*TaskWorkflow, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TaskWorkflowUpdateOne) SaveX(ctx context.Context) *TaskWorkflow {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TaskWorkflowUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TaskWorkflowUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TaskWorkflowUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := taskworkflow.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TaskWorkflow.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := taskworkflow.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "TaskWorkflow.description": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TaskWorkflowUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TaskWorkflowUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TaskWorkflowUpdateOne) sqlSave(ctx context.Context) (_node *TaskWorkflow, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskworkflow.Table, taskworkflow.Columns, sqlgraph.NewFieldSpec(taskworkflow.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TaskWorkflow.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskworkflow.FieldID)
+		for _, f := range fields {
+			if !taskworkflow.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != taskworkflow.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(taskworkflow.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(taskworkflow.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(taskworkflow.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(taskworkflow.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(taskworkflow.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(taskworkflow.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(taskworkflow.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(taskworkflow.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(taskworkflow.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(taskworkflow.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(taskworkflow.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(taskworkflow.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(taskworkflow.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(taskworkflow.FieldDeletedBy, field.TypeUint32)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(taskworkflow.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(taskworkflow.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(taskworkflow.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(taskworkflow.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(taskworkflow.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Steps(); ok {
+		_spec.SetField(taskworkflow.FieldSteps, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSteps(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, taskworkflow.FieldSteps, value)
+		})
+	}
+	if _u.mutation.StepsCleared() {
+		_spec.ClearField(taskworkflow.FieldSteps, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Enable(); ok {
+		_spec.SetField(taskworkflow.FieldEnable, field.TypeBool, value)
+	}
+	if _u.mutation.EnableCleared() {
+		_spec.ClearField(taskworkflow.FieldEnable, field.TypeBool)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &TaskWorkflow{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskworkflow.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
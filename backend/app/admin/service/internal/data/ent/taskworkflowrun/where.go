@@ -0,0 +1,800 @@
+// Code generated by ent, DO NOT EDIT.
+
+package taskworkflowrun
+
+import (
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v uint32) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldEQ(FieldTenantID, v))
+}
+
+// WorkflowID applies equality check predicate on the "workflow_id" field. It's identical to WorkflowIDEQ.
+func WorkflowID(v uint32) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldEQ(FieldWorkflowID, v))
+}
+
+// WorkflowName applies equality check predicate on the "workflow_name" field. It's identical to WorkflowNameEQ.
+func WorkflowName(v string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldEQ(FieldWorkflowName, v))
+}
+
+// Input applies equality check predicate on the "input" field. It's identical to InputEQ.
+func Input(v string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldEQ(FieldInput, v))
+}
+
+// ErrorMessage applies equality check predicate on the "error_message" field. It's identical to ErrorMessageEQ.
+func ErrorMessage(v string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldEQ(FieldErrorMessage, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v uint32) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v uint32) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...uint32) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...uint32) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v uint32) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v uint32) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v uint32) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v uint32) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldNotNull(FieldTenantID))
+}
+
+// WorkflowIDEQ applies the EQ predicate on the "workflow_id" field.
+func WorkflowIDEQ(v uint32) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldEQ(FieldWorkflowID, v))
+}
+
+// WorkflowIDNEQ applies the NEQ predicate on the "workflow_id" field.
+func WorkflowIDNEQ(v uint32) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldNEQ(FieldWorkflowID, v))
+}
+
+// WorkflowIDIn applies the In predicate on the "workflow_id" field.
+func WorkflowIDIn(vs ...uint32) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldIn(FieldWorkflowID, vs...))
+}
+
+// WorkflowIDNotIn applies the NotIn predicate on the "workflow_id" field.
+func WorkflowIDNotIn(vs ...uint32) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldNotIn(FieldWorkflowID, vs...))
+}
+
+// WorkflowIDGT applies the GT predicate on the "workflow_id" field.
+func WorkflowIDGT(v uint32) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldGT(FieldWorkflowID, v))
+}
+
+// WorkflowIDGTE applies the GTE predicate on the "workflow_id" field.
+func WorkflowIDGTE(v uint32) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldGTE(FieldWorkflowID, v))
+}
+
+// WorkflowIDLT applies the LT predicate on the "workflow_id" field.
+func WorkflowIDLT(v uint32) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldLT(FieldWorkflowID, v))
+}
+
+// WorkflowIDLTE applies the LTE predicate on the "workflow_id" field.
+func WorkflowIDLTE(v uint32) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldLTE(FieldWorkflowID, v))
+}
+
+// WorkflowIDIsNil applies the IsNil predicate on the "workflow_id" field.
+func WorkflowIDIsNil() predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldIsNull(FieldWorkflowID))
+}
+
+// WorkflowIDNotNil applies the NotNil predicate on the "workflow_id" field.
+func WorkflowIDNotNil() predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldNotNull(FieldWorkflowID))
+}
+
+// WorkflowNameEQ applies the EQ predicate on the "workflow_name" field.
+func WorkflowNameEQ(v string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldEQ(FieldWorkflowName, v))
+}
+
+// WorkflowNameNEQ applies the NEQ predicate on the "workflow_name" field.
+func WorkflowNameNEQ(v string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldNEQ(FieldWorkflowName, v))
+}
+
+// WorkflowNameIn applies the In predicate on the "workflow_name" field.
+func WorkflowNameIn(vs ...string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldIn(FieldWorkflowName, vs...))
+}
+
+// WorkflowNameNotIn applies the NotIn predicate on the "workflow_name" field.
+func WorkflowNameNotIn(vs ...string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldNotIn(FieldWorkflowName, vs...))
+}
+
+// WorkflowNameGT applies the GT predicate on the "workflow_name" field.
+func WorkflowNameGT(v string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldGT(FieldWorkflowName, v))
+}
+
+// WorkflowNameGTE applies the GTE predicate on the "workflow_name" field.
+func WorkflowNameGTE(v string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldGTE(FieldWorkflowName, v))
+}
+
+// WorkflowNameLT applies the LT predicate on the "workflow_name" field.
+func WorkflowNameLT(v string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldLT(FieldWorkflowName, v))
+}
+
+// WorkflowNameLTE applies the LTE predicate on the "workflow_name" field.
+func WorkflowNameLTE(v string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldLTE(FieldWorkflowName, v))
+}
+
+// WorkflowNameContains applies the Contains predicate on the "workflow_name" field.
+func WorkflowNameContains(v string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldContains(FieldWorkflowName, v))
+}
+
+// WorkflowNameHasPrefix applies the HasPrefix predicate on the "workflow_name" field.
+func WorkflowNameHasPrefix(v string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldHasPrefix(FieldWorkflowName, v))
+}
+
+// WorkflowNameHasSuffix applies the HasSuffix predicate on the "workflow_name" field.
+func WorkflowNameHasSuffix(v string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldHasSuffix(FieldWorkflowName, v))
+}
+
+// WorkflowNameIsNil applies the IsNil predicate on the "workflow_name" field.
+func WorkflowNameIsNil() predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldIsNull(FieldWorkflowName))
+}
+
+// WorkflowNameNotNil applies the NotNil predicate on the "workflow_name" field.
+func WorkflowNameNotNil() predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldNotNull(FieldWorkflowName))
+}
+
+// WorkflowNameEqualFold applies the EqualFold predicate on the "workflow_name" field.
+func WorkflowNameEqualFold(v string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldEqualFold(FieldWorkflowName, v))
+}
+
+// WorkflowNameContainsFold applies the ContainsFold predicate on the "workflow_name" field.
+func WorkflowNameContainsFold(v string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldContainsFold(FieldWorkflowName, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusIsNil applies the IsNil predicate on the "status" field.
+func StatusIsNil() predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldIsNull(FieldStatus))
+}
+
+// StatusNotNil applies the NotNil predicate on the "status" field.
+func StatusNotNil() predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldNotNull(FieldStatus))
+}
+
+// InputEQ applies the EQ predicate on the "input" field.
+func InputEQ(v string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldEQ(FieldInput, v))
+}
+
+// InputNEQ applies the NEQ predicate on the "input" field.
+func InputNEQ(v string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldNEQ(FieldInput, v))
+}
+
+// InputIn applies the In predicate on the "input" field.
+func InputIn(vs ...string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldIn(FieldInput, vs...))
+}
+
+// InputNotIn applies the NotIn predicate on the "input" field.
+func InputNotIn(vs ...string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldNotIn(FieldInput, vs...))
+}
+
+// InputGT applies the GT predicate on the "input" field.
+func InputGT(v string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldGT(FieldInput, v))
+}
+
+// InputGTE applies the GTE predicate on the "input" field.
+func InputGTE(v string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldGTE(FieldInput, v))
+}
+
+// InputLT applies the LT predicate on the "input" field.
+func InputLT(v string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldLT(FieldInput, v))
+}
+
+// InputLTE applies the LTE predicate on the "input" field.
+func InputLTE(v string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldLTE(FieldInput, v))
+}
+
+// InputContains applies the Contains predicate on the "input" field.
+func InputContains(v string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldContains(FieldInput, v))
+}
+
+// InputHasPrefix applies the HasPrefix predicate on the "input" field.
+func InputHasPrefix(v string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldHasPrefix(FieldInput, v))
+}
+
+// InputHasSuffix applies the HasSuffix predicate on the "input" field.
+func InputHasSuffix(v string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldHasSuffix(FieldInput, v))
+}
+
+// InputIsNil applies the IsNil predicate on the "input" field.
+func InputIsNil() predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldIsNull(FieldInput))
+}
+
+// InputNotNil applies the NotNil predicate on the "input" field.
+func InputNotNil() predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldNotNull(FieldInput))
+}
+
+// InputEqualFold applies the EqualFold predicate on the "input" field.
+func InputEqualFold(v string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldEqualFold(FieldInput, v))
+}
+
+// InputContainsFold applies the ContainsFold predicate on the "input" field.
+func InputContainsFold(v string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldContainsFold(FieldInput, v))
+}
+
+// StepsIsNil applies the IsNil predicate on the "steps" field.
+func StepsIsNil() predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldIsNull(FieldSteps))
+}
+
+// StepsNotNil applies the NotNil predicate on the "steps" field.
+func StepsNotNil() predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldNotNull(FieldSteps))
+}
+
+// StepRunsIsNil applies the IsNil predicate on the "step_runs" field.
+func StepRunsIsNil() predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldIsNull(FieldStepRuns))
+}
+
+// StepRunsNotNil applies the NotNil predicate on the "step_runs" field.
+func StepRunsNotNil() predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldNotNull(FieldStepRuns))
+}
+
+// ErrorMessageEQ applies the EQ predicate on the "error_message" field.
+func ErrorMessageEQ(v string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldEQ(FieldErrorMessage, v))
+}
+
+// ErrorMessageNEQ applies the NEQ predicate on the "error_message" field.
+func ErrorMessageNEQ(v string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldNEQ(FieldErrorMessage, v))
+}
+
+// ErrorMessageIn applies the In predicate on the "error_message" field.
+func ErrorMessageIn(vs ...string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldIn(FieldErrorMessage, vs...))
+}
+
+// ErrorMessageNotIn applies the NotIn predicate on the "error_message" field.
+func ErrorMessageNotIn(vs ...string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldNotIn(FieldErrorMessage, vs...))
+}
+
+// ErrorMessageGT applies the GT predicate on the "error_message" field.
+func ErrorMessageGT(v string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldGT(FieldErrorMessage, v))
+}
+
+// ErrorMessageGTE applies the GTE predicate on the "error_message" field.
+func ErrorMessageGTE(v string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldGTE(FieldErrorMessage, v))
+}
+
+// ErrorMessageLT applies the LT predicate on the "error_message" field.
+func ErrorMessageLT(v string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldLT(FieldErrorMessage, v))
+}
+
+// ErrorMessageLTE applies the LTE predicate on the "error_message" field.
+func ErrorMessageLTE(v string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldLTE(FieldErrorMessage, v))
+}
+
+// ErrorMessageContains applies the Contains predicate on the "error_message" field.
+func ErrorMessageContains(v string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldContains(FieldErrorMessage, v))
+}
+
+// ErrorMessageHasPrefix applies the HasPrefix predicate on the "error_message" field.
+func ErrorMessageHasPrefix(v string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldHasPrefix(FieldErrorMessage, v))
+}
+
+// ErrorMessageHasSuffix applies the HasSuffix predicate on the "error_message" field.
+func ErrorMessageHasSuffix(v string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldHasSuffix(FieldErrorMessage, v))
+}
+
+// ErrorMessageIsNil applies the IsNil predicate on the "error_message" field.
+func ErrorMessageIsNil() predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldIsNull(FieldErrorMessage))
+}
+
+// ErrorMessageNotNil applies the NotNil predicate on the "error_message" field.
+func ErrorMessageNotNil() predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldNotNull(FieldErrorMessage))
+}
+
+// ErrorMessageEqualFold applies the EqualFold predicate on the "error_message" field.
+func ErrorMessageEqualFold(v string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldEqualFold(FieldErrorMessage, v))
+}
+
+// ErrorMessageContainsFold applies the ContainsFold predicate on the "error_message" field.
+func ErrorMessageContainsFold(v string) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldContainsFold(FieldErrorMessage, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// StartedAtIsNil applies the IsNil predicate on the "started_at" field.
+func StartedAtIsNil() predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldIsNull(FieldStartedAt))
+}
+
+// StartedAtNotNil applies the NotNil predicate on the "started_at" field.
+func StartedAtNotNil() predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldNotNull(FieldStartedAt))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v time.Time) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// FinishedAtIsNil applies the IsNil predicate on the "finished_at" field.
+func FinishedAtIsNil() predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldIsNull(FieldFinishedAt))
+}
+
+// FinishedAtNotNil applies the NotNil predicate on the "finished_at" field.
+func FinishedAtNotNil() predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.FieldNotNull(FieldFinishedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TaskWorkflowRun) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TaskWorkflowRun) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TaskWorkflowRun) predicate.TaskWorkflowRun {
+	return predicate.TaskWorkflowRun(sql.NotPredicates(p))
+}
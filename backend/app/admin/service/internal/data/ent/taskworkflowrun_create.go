@@ -0,0 +1,1473 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	taskpb "go-wind-admin/api/gen/go/task/service/v1"
+	"go-wind-admin/app/admin/service/internal/data/ent/taskworkflowrun"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TaskWorkflowRunCreate is the builder for creating a TaskWorkflowRun entity.
+type TaskWorkflowRunCreate struct {
+	config
+	mutation *TaskWorkflowRunMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TaskWorkflowRunCreate) SetCreatedAt(v time.Time) *TaskWorkflowRunCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TaskWorkflowRunCreate) SetNillableCreatedAt(v *time.Time) *TaskWorkflowRunCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *TaskWorkflowRunCreate) SetUpdatedAt(v time.Time) *TaskWorkflowRunCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *TaskWorkflowRunCreate) SetNillableUpdatedAt(v *time.Time) *TaskWorkflowRunCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *TaskWorkflowRunCreate) SetDeletedAt(v time.Time) *TaskWorkflowRunCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *TaskWorkflowRunCreate) SetNillableDeletedAt(v *time.Time) *TaskWorkflowRunCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *TaskWorkflowRunCreate) SetCreatedBy(v uint32) *TaskWorkflowRunCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *TaskWorkflowRunCreate) SetNillableCreatedBy(v *uint32) *TaskWorkflowRunCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *TaskWorkflowRunCreate) SetTenantID(v uint32) *TaskWorkflowRunCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *TaskWorkflowRunCreate) SetNillableTenantID(v *uint32) *TaskWorkflowRunCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetWorkflowID sets the "workflow_id" field.
+func (_c *TaskWorkflowRunCreate) SetWorkflowID(v uint32) *TaskWorkflowRunCreate {
+	_c.mutation.SetWorkflowID(v)
+	return _c
+}
+
+// SetNillableWorkflowID sets the "workflow_id" field if the given value is not nil.
+func (_c *TaskWorkflowRunCreate) SetNillableWorkflowID(v *uint32) *TaskWorkflowRunCreate {
+	if v != nil {
+		_c.SetWorkflowID(*v)
+	}
+	return _c
+}
+
+// SetWorkflowName sets the "workflow_name" field.
+func (_c *TaskWorkflowRunCreate) SetWorkflowName(v string) *TaskWorkflowRunCreate {
+	_c.mutation.SetWorkflowName(v)
+	return _c
+}
+
+// SetNillableWorkflowName sets the "workflow_name" field if the given value is not nil.
+func (_c *TaskWorkflowRunCreate) SetNillableWorkflowName(v *string) *TaskWorkflowRunCreate {
+	if v != nil {
+		_c.SetWorkflowName(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *TaskWorkflowRunCreate) SetStatus(v taskworkflowrun.Status) *TaskWorkflowRunCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *TaskWorkflowRunCreate) SetNillableStatus(v *taskworkflowrun.Status) *TaskWorkflowRunCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetInput sets the "input" field.
+func (_c *TaskWorkflowRunCreate) SetInput(v string) *TaskWorkflowRunCreate {
+	_c.mutation.SetInput(v)
+	return _c
+}
+
+// SetNillableInput sets the "input" field if the given value is not nil.
+func (_c *TaskWorkflowRunCreate) SetNillableInput(v *string) *TaskWorkflowRunCreate {
+	if v != nil {
+		_c.SetInput(*v)
+	}
+	return _c
+}
+
+// SetSteps sets the "steps" field.
+func (_c *TaskWorkflowRunCreate) SetSteps(v []*taskpb.TaskWorkflowStep) *TaskWorkflowRunCreate {
+	_c.mutation.SetSteps(v)
+	return _c
+}
+
+// SetStepRuns sets the "step_runs" field.
+func (_c *TaskWorkflowRunCreate) SetStepRuns(v []*taskpb.TaskWorkflowStepRun) *TaskWorkflowRunCreate {
+	_c.mutation.SetStepRuns(v)
+	return _c
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_c *TaskWorkflowRunCreate) SetErrorMessage(v string) *TaskWorkflowRunCreate {
+	_c.mutation.SetErrorMessage(v)
+	return _c
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_c *TaskWorkflowRunCreate) SetNillableErrorMessage(v *string) *TaskWorkflowRunCreate {
+	if v != nil {
+		_c.SetErrorMessage(*v)
+	}
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *TaskWorkflowRunCreate) SetStartedAt(v time.Time) *TaskWorkflowRunCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_c *TaskWorkflowRunCreate) SetNillableStartedAt(v *time.Time) *TaskWorkflowRunCreate {
+	if v != nil {
+		_c.SetStartedAt(*v)
+	}
+	return _c
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_c *TaskWorkflowRunCreate) SetFinishedAt(v time.Time) *TaskWorkflowRunCreate {
+	_c.mutation.SetFinishedAt(v)
+	return _c
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_c *TaskWorkflowRunCreate) SetNillableFinishedAt(v *time.Time) *TaskWorkflowRunCreate {
+	if v != nil {
+		_c.SetFinishedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TaskWorkflowRunCreate) SetID(v uint32) *TaskWorkflowRunCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the TaskWorkflowRunMutation object of the builder.
+func (_c *TaskWorkflowRunCreate) Mutation() *TaskWorkflowRunMutation {
+	return _c.mutation
+}
+
+// Save creates the TaskWorkflowRun in the database.
+func (_c *TaskWorkflowRunCreate) Save(ctx context.Context) (*TaskWorkflowRun, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TaskWorkflowRunCreate) SaveX(ctx context.Context) *TaskWorkflowRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TaskWorkflowRunCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TaskWorkflowRunCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TaskWorkflowRunCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := taskworkflowrun.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := taskworkflowrun.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TaskWorkflowRunCreate) check() error {
+	if v, ok := _c.mutation.WorkflowName(); ok {
+		if err := taskworkflowrun.WorkflowNameValidator(v); err != nil {
+			return &ValidationError{Name: "workflow_name", err: fmt.Errorf(`ent: validator failed for field "TaskWorkflowRun.workflow_name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := taskworkflowrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TaskWorkflowRun.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := taskworkflowrun.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "TaskWorkflowRun.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *TaskWorkflowRunCreate) sqlSave(ctx context.Context) (*TaskWorkflowRun, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TaskWorkflowRunCreate) createSpec() (*TaskWorkflowRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TaskWorkflowRun{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(taskworkflowrun.Table, sqlgraph.NewFieldSpec(taskworkflowrun.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(taskworkflowrun.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(taskworkflowrun.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(taskworkflowrun.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(taskworkflowrun.FieldCreatedBy, field.TypeUint32, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(taskworkflowrun.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.WorkflowID(); ok {
+		_spec.SetField(taskworkflowrun.FieldWorkflowID, field.TypeUint32, value)
+		_node.WorkflowID = &value
+	}
+	if value, ok := _c.mutation.WorkflowName(); ok {
+		_spec.SetField(taskworkflowrun.FieldWorkflowName, field.TypeString, value)
+		_node.WorkflowName = &value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(taskworkflowrun.FieldStatus, field.TypeEnum, value)
+		_node.Status = &value
+	}
+	if value, ok := _c.mutation.Input(); ok {
+		_spec.SetField(taskworkflowrun.FieldInput, field.TypeString, value)
+		_node.Input = &value
+	}
+	if value, ok := _c.mutation.Steps(); ok {
+		_spec.SetField(taskworkflowrun.FieldSteps, field.TypeJSON, value)
+		_node.Steps = value
+	}
+	if value, ok := _c.mutation.StepRuns(); ok {
+		_spec.SetField(taskworkflowrun.FieldStepRuns, field.TypeJSON, value)
+		_node.StepRuns = value
+	}
+	if value, ok := _c.mutation.ErrorMessage(); ok {
+		_spec.SetField(taskworkflowrun.FieldErrorMessage, field.TypeString, value)
+		_node.ErrorMessage = &value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(taskworkflowrun.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = &value
+	}
+	if value, ok := _c.mutation.FinishedAt(); ok {
+		_spec.SetField(taskworkflowrun.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TaskWorkflowRun.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TaskWorkflowRunUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TaskWorkflowRunCreate) OnConflict(opts ...sql.ConflictOption) *TaskWorkflowRunUpsertOne {
+	_c.conflict = opts
+	return &TaskWorkflowRunUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TaskWorkflowRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TaskWorkflowRunCreate) OnConflictColumns(columns ...string) *TaskWorkflowRunUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TaskWorkflowRunUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// TaskWorkflowRunUpsertOne is the builder for "upsert"-ing
+	//  one TaskWorkflowRun node.
+	TaskWorkflowRunUpsertOne struct {
+		create *TaskWorkflowRunCreate
+	}
+
+	// TaskWorkflowRunUpsert is the "OnConflict" setter.
+	TaskWorkflowRunUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TaskWorkflowRunUpsert) SetUpdatedAt(v time.Time) *TaskWorkflowRunUpsert {
+	u.Set(taskworkflowrun.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TaskWorkflowRunUpsert) UpdateUpdatedAt() *TaskWorkflowRunUpsert {
+	u.SetExcluded(taskworkflowrun.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *TaskWorkflowRunUpsert) ClearUpdatedAt() *TaskWorkflowRunUpsert {
+	u.SetNull(taskworkflowrun.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *TaskWorkflowRunUpsert) SetDeletedAt(v time.Time) *TaskWorkflowRunUpsert {
+	u.Set(taskworkflowrun.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *TaskWorkflowRunUpsert) UpdateDeletedAt() *TaskWorkflowRunUpsert {
+	u.SetExcluded(taskworkflowrun.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *TaskWorkflowRunUpsert) ClearDeletedAt() *TaskWorkflowRunUpsert {
+	u.SetNull(taskworkflowrun.FieldDeletedAt)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *TaskWorkflowRunUpsert) SetCreatedBy(v uint32) *TaskWorkflowRunUpsert {
+	u.Set(taskworkflowrun.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *TaskWorkflowRunUpsert) UpdateCreatedBy() *TaskWorkflowRunUpsert {
+	u.SetExcluded(taskworkflowrun.FieldCreatedBy)
+	return u
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *TaskWorkflowRunUpsert) AddCreatedBy(v uint32) *TaskWorkflowRunUpsert {
+	u.Add(taskworkflowrun.FieldCreatedBy, v)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *TaskWorkflowRunUpsert) ClearCreatedBy() *TaskWorkflowRunUpsert {
+	u.SetNull(taskworkflowrun.FieldCreatedBy)
+	return u
+}
+
+// SetWorkflowID sets the "workflow_id" field.
+func (u *TaskWorkflowRunUpsert) SetWorkflowID(v uint32) *TaskWorkflowRunUpsert {
+	u.Set(taskworkflowrun.FieldWorkflowID, v)
+	return u
+}
+
+// UpdateWorkflowID sets the "workflow_id" field to the value that was provided on create.
+func (u *TaskWorkflowRunUpsert) UpdateWorkflowID() *TaskWorkflowRunUpsert {
+	u.SetExcluded(taskworkflowrun.FieldWorkflowID)
+	return u
+}
+
+// AddWorkflowID adds v to the "workflow_id" field.
+func (u *TaskWorkflowRunUpsert) AddWorkflowID(v uint32) *TaskWorkflowRunUpsert {
+	u.Add(taskworkflowrun.FieldWorkflowID, v)
+	return u
+}
+
+// ClearWorkflowID clears the value of the "workflow_id" field.
+func (u *TaskWorkflowRunUpsert) ClearWorkflowID() *TaskWorkflowRunUpsert {
+	u.SetNull(taskworkflowrun.FieldWorkflowID)
+	return u
+}
+
+// SetWorkflowName sets the "workflow_name" field.
+func (u *TaskWorkflowRunUpsert) SetWorkflowName(v string) *TaskWorkflowRunUpsert {
+	u.Set(taskworkflowrun.FieldWorkflowName, v)
+	return u
+}
+
+// UpdateWorkflowName sets the "workflow_name" field to the value that was provided on create.
+func (u *TaskWorkflowRunUpsert) UpdateWorkflowName() *TaskWorkflowRunUpsert {
+	u.SetExcluded(taskworkflowrun.FieldWorkflowName)
+	return u
+}
+
+// ClearWorkflowName clears the value of the "workflow_name" field.
+func (u *TaskWorkflowRunUpsert) ClearWorkflowName() *TaskWorkflowRunUpsert {
+	u.SetNull(taskworkflowrun.FieldWorkflowName)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *TaskWorkflowRunUpsert) SetStatus(v taskworkflowrun.Status) *TaskWorkflowRunUpsert {
+	u.Set(taskworkflowrun.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TaskWorkflowRunUpsert) UpdateStatus() *TaskWorkflowRunUpsert {
+	u.SetExcluded(taskworkflowrun.FieldStatus)
+	return u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *TaskWorkflowRunUpsert) ClearStatus() *TaskWorkflowRunUpsert {
+	u.SetNull(taskworkflowrun.FieldStatus)
+	return u
+}
+
+// SetInput sets the "input" field.
+func (u *TaskWorkflowRunUpsert) SetInput(v string) *TaskWorkflowRunUpsert {
+	u.Set(taskworkflowrun.FieldInput, v)
+	return u
+}
+
+// UpdateInput sets the "input" field to the value that was provided on create.
+func (u *TaskWorkflowRunUpsert) UpdateInput() *TaskWorkflowRunUpsert {
+	u.SetExcluded(taskworkflowrun.FieldInput)
+	return u
+}
+
+// ClearInput clears the value of the "input" field.
+func (u *TaskWorkflowRunUpsert) ClearInput() *TaskWorkflowRunUpsert {
+	u.SetNull(taskworkflowrun.FieldInput)
+	return u
+}
+
+// SetSteps sets the "steps" field.
+func (u *TaskWorkflowRunUpsert) SetSteps(v []*taskpb.TaskWorkflowStep) *TaskWorkflowRunUpsert {
+	u.Set(taskworkflowrun.FieldSteps, v)
+	return u
+}
+
+// UpdateSteps sets the "steps" field to the value that was provided on create.
+func (u *TaskWorkflowRunUpsert) UpdateSteps() *TaskWorkflowRunUpsert {
+	u.SetExcluded(taskworkflowrun.FieldSteps)
+	return u
+}
+
+// ClearSteps clears the value of the "steps" field.
+func (u *TaskWorkflowRunUpsert) ClearSteps() *TaskWorkflowRunUpsert {
+	u.SetNull(taskworkflowrun.FieldSteps)
+	return u
+}
+
+// SetStepRuns sets the "step_runs" field.
+func (u *TaskWorkflowRunUpsert) SetStepRuns(v []*taskpb.TaskWorkflowStepRun) *TaskWorkflowRunUpsert {
+	u.Set(taskworkflowrun.FieldStepRuns, v)
+	return u
+}
+
+// UpdateStepRuns sets the "step_runs" field to the value that was provided on create.
+func (u *TaskWorkflowRunUpsert) UpdateStepRuns() *TaskWorkflowRunUpsert {
+	u.SetExcluded(taskworkflowrun.FieldStepRuns)
+	return u
+}
+
+// ClearStepRuns clears the value of the "step_runs" field.
+func (u *TaskWorkflowRunUpsert) ClearStepRuns() *TaskWorkflowRunUpsert {
+	u.SetNull(taskworkflowrun.FieldStepRuns)
+	return u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *TaskWorkflowRunUpsert) SetErrorMessage(v string) *TaskWorkflowRunUpsert {
+	u.Set(taskworkflowrun.FieldErrorMessage, v)
+	return u
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *TaskWorkflowRunUpsert) UpdateErrorMessage() *TaskWorkflowRunUpsert {
+	u.SetExcluded(taskworkflowrun.FieldErrorMessage)
+	return u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *TaskWorkflowRunUpsert) ClearErrorMessage() *TaskWorkflowRunUpsert {
+	u.SetNull(taskworkflowrun.FieldErrorMessage)
+	return u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *TaskWorkflowRunUpsert) SetStartedAt(v time.Time) *TaskWorkflowRunUpsert {
+	u.Set(taskworkflowrun.FieldStartedAt, v)
+	return u
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *TaskWorkflowRunUpsert) UpdateStartedAt() *TaskWorkflowRunUpsert {
+	u.SetExcluded(taskworkflowrun.FieldStartedAt)
+	return u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *TaskWorkflowRunUpsert) ClearStartedAt() *TaskWorkflowRunUpsert {
+	u.SetNull(taskworkflowrun.FieldStartedAt)
+	return u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *TaskWorkflowRunUpsert) SetFinishedAt(v time.Time) *TaskWorkflowRunUpsert {
+	u.Set(taskworkflowrun.FieldFinishedAt, v)
+	return u
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *TaskWorkflowRunUpsert) UpdateFinishedAt() *TaskWorkflowRunUpsert {
+	u.SetExcluded(taskworkflowrun.FieldFinishedAt)
+	return u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *TaskWorkflowRunUpsert) ClearFinishedAt() *TaskWorkflowRunUpsert {
+	u.SetNull(taskworkflowrun.FieldFinishedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TaskWorkflowRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(taskworkflowrun.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TaskWorkflowRunUpsertOne) UpdateNewValues() *TaskWorkflowRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(taskworkflowrun.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(taskworkflowrun.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(taskworkflowrun.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TaskWorkflowRun.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TaskWorkflowRunUpsertOne) Ignore() *TaskWorkflowRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TaskWorkflowRunUpsertOne) DoNothing() *TaskWorkflowRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TaskWorkflowRunCreate.OnConflict
+// documentation for more info.
+func (u *TaskWorkflowRunUpsertOne) Update(set func(*TaskWorkflowRunUpsert)) *TaskWorkflowRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TaskWorkflowRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TaskWorkflowRunUpsertOne) SetUpdatedAt(v time.Time) *TaskWorkflowRunUpsertOne {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TaskWorkflowRunUpsertOne) UpdateUpdatedAt() *TaskWorkflowRunUpsertOne {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *TaskWorkflowRunUpsertOne) ClearUpdatedAt() *TaskWorkflowRunUpsertOne {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *TaskWorkflowRunUpsertOne) SetDeletedAt(v time.Time) *TaskWorkflowRunUpsertOne {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *TaskWorkflowRunUpsertOne) UpdateDeletedAt() *TaskWorkflowRunUpsertOne {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *TaskWorkflowRunUpsertOne) ClearDeletedAt() *TaskWorkflowRunUpsertOne {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *TaskWorkflowRunUpsertOne) SetCreatedBy(v uint32) *TaskWorkflowRunUpsertOne {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *TaskWorkflowRunUpsertOne) AddCreatedBy(v uint32) *TaskWorkflowRunUpsertOne {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *TaskWorkflowRunUpsertOne) UpdateCreatedBy() *TaskWorkflowRunUpsertOne {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *TaskWorkflowRunUpsertOne) ClearCreatedBy() *TaskWorkflowRunUpsertOne {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetWorkflowID sets the "workflow_id" field.
+func (u *TaskWorkflowRunUpsertOne) SetWorkflowID(v uint32) *TaskWorkflowRunUpsertOne {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.SetWorkflowID(v)
+	})
+}
+
+// AddWorkflowID adds v to the "workflow_id" field.
+func (u *TaskWorkflowRunUpsertOne) AddWorkflowID(v uint32) *TaskWorkflowRunUpsertOne {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.AddWorkflowID(v)
+	})
+}
+
+// UpdateWorkflowID sets the "workflow_id" field to the value that was provided on create.
+func (u *TaskWorkflowRunUpsertOne) UpdateWorkflowID() *TaskWorkflowRunUpsertOne {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.UpdateWorkflowID()
+	})
+}
+
+// ClearWorkflowID clears the value of the "workflow_id" field.
+func (u *TaskWorkflowRunUpsertOne) ClearWorkflowID() *TaskWorkflowRunUpsertOne {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.ClearWorkflowID()
+	})
+}
+
+// SetWorkflowName sets the "workflow_name" field.
+func (u *TaskWorkflowRunUpsertOne) SetWorkflowName(v string) *TaskWorkflowRunUpsertOne {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.SetWorkflowName(v)
+	})
+}
+
+// UpdateWorkflowName sets the "workflow_name" field to the value that was provided on create.
+func (u *TaskWorkflowRunUpsertOne) UpdateWorkflowName() *TaskWorkflowRunUpsertOne {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.UpdateWorkflowName()
+	})
+}
+
+// ClearWorkflowName clears the value of the "workflow_name" field.
+func (u *TaskWorkflowRunUpsertOne) ClearWorkflowName() *TaskWorkflowRunUpsertOne {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.ClearWorkflowName()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *TaskWorkflowRunUpsertOne) SetStatus(v taskworkflowrun.Status) *TaskWorkflowRunUpsertOne {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TaskWorkflowRunUpsertOne) UpdateStatus() *TaskWorkflowRunUpsertOne {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *TaskWorkflowRunUpsertOne) ClearStatus() *TaskWorkflowRunUpsertOne {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.ClearStatus()
+	})
+}
+
+// SetInput sets the "input" field.
+func (u *TaskWorkflowRunUpsertOne) SetInput(v string) *TaskWorkflowRunUpsertOne {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.SetInput(v)
+	})
+}
+
+// UpdateInput sets the "input" field to the value that was provided on create.
+func (u *TaskWorkflowRunUpsertOne) UpdateInput() *TaskWorkflowRunUpsertOne {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.UpdateInput()
+	})
+}
+
+// ClearInput clears the value of the "input" field.
+func (u *TaskWorkflowRunUpsertOne) ClearInput() *TaskWorkflowRunUpsertOne {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.ClearInput()
+	})
+}
+
+// SetSteps sets the "steps" field.
+func (u *TaskWorkflowRunUpsertOne) SetSteps(v []*taskpb.TaskWorkflowStep) *TaskWorkflowRunUpsertOne {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.SetSteps(v)
+	})
+}
+
+// UpdateSteps sets the "steps" field to the value that was provided on create.
+func (u *TaskWorkflowRunUpsertOne) UpdateSteps() *TaskWorkflowRunUpsertOne {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.UpdateSteps()
+	})
+}
+
+// ClearSteps clears the value of the "steps" field.
+func (u *TaskWorkflowRunUpsertOne) ClearSteps() *TaskWorkflowRunUpsertOne {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.ClearSteps()
+	})
+}
+
+// SetStepRuns sets the "step_runs" field.
+func (u *TaskWorkflowRunUpsertOne) SetStepRuns(v []*taskpb.TaskWorkflowStepRun) *TaskWorkflowRunUpsertOne {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.SetStepRuns(v)
+	})
+}
+
+// UpdateStepRuns sets the "step_runs" field to the value that was provided on create.
+func (u *TaskWorkflowRunUpsertOne) UpdateStepRuns() *TaskWorkflowRunUpsertOne {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.UpdateStepRuns()
+	})
+}
+
+// ClearStepRuns clears the value of the "step_runs" field.
+func (u *TaskWorkflowRunUpsertOne) ClearStepRuns() *TaskWorkflowRunUpsertOne {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.ClearStepRuns()
+	})
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *TaskWorkflowRunUpsertOne) SetErrorMessage(v string) *TaskWorkflowRunUpsertOne {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.SetErrorMessage(v)
+	})
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *TaskWorkflowRunUpsertOne) UpdateErrorMessage() *TaskWorkflowRunUpsertOne {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.UpdateErrorMessage()
+	})
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *TaskWorkflowRunUpsertOne) ClearErrorMessage() *TaskWorkflowRunUpsertOne {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.ClearErrorMessage()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *TaskWorkflowRunUpsertOne) SetStartedAt(v time.Time) *TaskWorkflowRunUpsertOne {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *TaskWorkflowRunUpsertOne) UpdateStartedAt() *TaskWorkflowRunUpsertOne {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *TaskWorkflowRunUpsertOne) ClearStartedAt() *TaskWorkflowRunUpsertOne {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *TaskWorkflowRunUpsertOne) SetFinishedAt(v time.Time) *TaskWorkflowRunUpsertOne {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *TaskWorkflowRunUpsertOne) UpdateFinishedAt() *TaskWorkflowRunUpsertOne {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *TaskWorkflowRunUpsertOne) ClearFinishedAt() *TaskWorkflowRunUpsertOne {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TaskWorkflowRunUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TaskWorkflowRunCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TaskWorkflowRunUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TaskWorkflowRunUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TaskWorkflowRunUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TaskWorkflowRunCreateBulk is the builder for creating many TaskWorkflowRun entities in bulk.
+type TaskWorkflowRunCreateBulk struct {
+	config
+	err      error
+	builders []*TaskWorkflowRunCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TaskWorkflowRun entities in the database.
+func (_c *TaskWorkflowRunCreateBulk) Save(ctx context.Context) ([]*TaskWorkflowRun, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TaskWorkflowRun, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TaskWorkflowRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TaskWorkflowRunCreateBulk) SaveX(ctx context.Context) []*TaskWorkflowRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TaskWorkflowRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TaskWorkflowRunCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TaskWorkflowRun.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TaskWorkflowRunUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TaskWorkflowRunCreateBulk) OnConflict(opts ...sql.ConflictOption) *TaskWorkflowRunUpsertBulk {
+	_c.conflict = opts
+	return &TaskWorkflowRunUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TaskWorkflowRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TaskWorkflowRunCreateBulk) OnConflictColumns(columns ...string) *TaskWorkflowRunUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TaskWorkflowRunUpsertBulk{
+		create: _c,
+	}
+}
+
+// TaskWorkflowRunUpsertBulk is the builder for "upsert"-ing
+// a bulk of TaskWorkflowRun nodes.
+type TaskWorkflowRunUpsertBulk struct {
+	create *TaskWorkflowRunCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TaskWorkflowRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(taskworkflowrun.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TaskWorkflowRunUpsertBulk) UpdateNewValues() *TaskWorkflowRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(taskworkflowrun.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(taskworkflowrun.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(taskworkflowrun.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TaskWorkflowRun.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TaskWorkflowRunUpsertBulk) Ignore() *TaskWorkflowRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TaskWorkflowRunUpsertBulk) DoNothing() *TaskWorkflowRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TaskWorkflowRunCreateBulk.OnConflict
+// documentation for more info.
+func (u *TaskWorkflowRunUpsertBulk) Update(set func(*TaskWorkflowRunUpsert)) *TaskWorkflowRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TaskWorkflowRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TaskWorkflowRunUpsertBulk) SetUpdatedAt(v time.Time) *TaskWorkflowRunUpsertBulk {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TaskWorkflowRunUpsertBulk) UpdateUpdatedAt() *TaskWorkflowRunUpsertBulk {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *TaskWorkflowRunUpsertBulk) ClearUpdatedAt() *TaskWorkflowRunUpsertBulk {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *TaskWorkflowRunUpsertBulk) SetDeletedAt(v time.Time) *TaskWorkflowRunUpsertBulk {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *TaskWorkflowRunUpsertBulk) UpdateDeletedAt() *TaskWorkflowRunUpsertBulk {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *TaskWorkflowRunUpsertBulk) ClearDeletedAt() *TaskWorkflowRunUpsertBulk {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *TaskWorkflowRunUpsertBulk) SetCreatedBy(v uint32) *TaskWorkflowRunUpsertBulk {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *TaskWorkflowRunUpsertBulk) AddCreatedBy(v uint32) *TaskWorkflowRunUpsertBulk {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *TaskWorkflowRunUpsertBulk) UpdateCreatedBy() *TaskWorkflowRunUpsertBulk {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *TaskWorkflowRunUpsertBulk) ClearCreatedBy() *TaskWorkflowRunUpsertBulk {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetWorkflowID sets the "workflow_id" field.
+func (u *TaskWorkflowRunUpsertBulk) SetWorkflowID(v uint32) *TaskWorkflowRunUpsertBulk {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.SetWorkflowID(v)
+	})
+}
+
+// AddWorkflowID adds v to the "workflow_id" field.
+func (u *TaskWorkflowRunUpsertBulk) AddWorkflowID(v uint32) *TaskWorkflowRunUpsertBulk {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.AddWorkflowID(v)
+	})
+}
+
+// UpdateWorkflowID sets the "workflow_id" field to the value that was provided on create.
+func (u *TaskWorkflowRunUpsertBulk) UpdateWorkflowID() *TaskWorkflowRunUpsertBulk {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.UpdateWorkflowID()
+	})
+}
+
+// ClearWorkflowID clears the value of the "workflow_id" field.
+func (u *TaskWorkflowRunUpsertBulk) ClearWorkflowID() *TaskWorkflowRunUpsertBulk {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.ClearWorkflowID()
+	})
+}
+
+// SetWorkflowName sets the "workflow_name" field.
+func (u *TaskWorkflowRunUpsertBulk) SetWorkflowName(v string) *TaskWorkflowRunUpsertBulk {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.SetWorkflowName(v)
+	})
+}
+
+// UpdateWorkflowName sets the "workflow_name" field to the value that was provided on create.
+func (u *TaskWorkflowRunUpsertBulk) UpdateWorkflowName() *TaskWorkflowRunUpsertBulk {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.UpdateWorkflowName()
+	})
+}
+
+// ClearWorkflowName clears the value of the "workflow_name" field.
+func (u *TaskWorkflowRunUpsertBulk) ClearWorkflowName() *TaskWorkflowRunUpsertBulk {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.ClearWorkflowName()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *TaskWorkflowRunUpsertBulk) SetStatus(v taskworkflowrun.Status) *TaskWorkflowRunUpsertBulk {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TaskWorkflowRunUpsertBulk) UpdateStatus() *TaskWorkflowRunUpsertBulk {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *TaskWorkflowRunUpsertBulk) ClearStatus() *TaskWorkflowRunUpsertBulk {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.ClearStatus()
+	})
+}
+
+// SetInput sets the "input" field.
+func (u *TaskWorkflowRunUpsertBulk) SetInput(v string) *TaskWorkflowRunUpsertBulk {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.SetInput(v)
+	})
+}
+
+// UpdateInput sets the "input" field to the value that was provided on create.
+func (u *TaskWorkflowRunUpsertBulk) UpdateInput() *TaskWorkflowRunUpsertBulk {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.UpdateInput()
+	})
+}
+
+// ClearInput clears the value of the "input" field.
+func (u *TaskWorkflowRunUpsertBulk) ClearInput() *TaskWorkflowRunUpsertBulk {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.ClearInput()
+	})
+}
+
+// SetSteps sets the "steps" field.
+func (u *TaskWorkflowRunUpsertBulk) SetSteps(v []*taskpb.TaskWorkflowStep) *TaskWorkflowRunUpsertBulk {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.SetSteps(v)
+	})
+}
+
+// UpdateSteps sets the "steps" field to the value that was provided on create.
+func (u *TaskWorkflowRunUpsertBulk) UpdateSteps() *TaskWorkflowRunUpsertBulk {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.UpdateSteps()
+	})
+}
+
+// ClearSteps clears the value of the "steps" field.
+func (u *TaskWorkflowRunUpsertBulk) ClearSteps() *TaskWorkflowRunUpsertBulk {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.ClearSteps()
+	})
+}
+
+// SetStepRuns sets the "step_runs" field.
+func (u *TaskWorkflowRunUpsertBulk) SetStepRuns(v []*taskpb.TaskWorkflowStepRun) *TaskWorkflowRunUpsertBulk {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.SetStepRuns(v)
+	})
+}
+
+// UpdateStepRuns sets the "step_runs" field to the value that was provided on create.
+func (u *TaskWorkflowRunUpsertBulk) UpdateStepRuns() *TaskWorkflowRunUpsertBulk {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.UpdateStepRuns()
+	})
+}
+
+// ClearStepRuns clears the value of the "step_runs" field.
+func (u *TaskWorkflowRunUpsertBulk) ClearStepRuns() *TaskWorkflowRunUpsertBulk {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.ClearStepRuns()
+	})
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *TaskWorkflowRunUpsertBulk) SetErrorMessage(v string) *TaskWorkflowRunUpsertBulk {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.SetErrorMessage(v)
+	})
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *TaskWorkflowRunUpsertBulk) UpdateErrorMessage() *TaskWorkflowRunUpsertBulk {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.UpdateErrorMessage()
+	})
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *TaskWorkflowRunUpsertBulk) ClearErrorMessage() *TaskWorkflowRunUpsertBulk {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.ClearErrorMessage()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *TaskWorkflowRunUpsertBulk) SetStartedAt(v time.Time) *TaskWorkflowRunUpsertBulk {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *TaskWorkflowRunUpsertBulk) UpdateStartedAt() *TaskWorkflowRunUpsertBulk {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *TaskWorkflowRunUpsertBulk) ClearStartedAt() *TaskWorkflowRunUpsertBulk {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *TaskWorkflowRunUpsertBulk) SetFinishedAt(v time.Time) *TaskWorkflowRunUpsertBulk {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *TaskWorkflowRunUpsertBulk) UpdateFinishedAt() *TaskWorkflowRunUpsertBulk {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *TaskWorkflowRunUpsertBulk) ClearFinishedAt() *TaskWorkflowRunUpsertBulk {
+	return u.Update(func(s *TaskWorkflowRunUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TaskWorkflowRunUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TaskWorkflowRunCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TaskWorkflowRunCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TaskWorkflowRunUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,918 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	taskpb "go-wind-admin/api/gen/go/task/service/v1"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"go-wind-admin/app/admin/service/internal/data/ent/taskworkflowrun"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// TaskWorkflowRunUpdate is the builder for updating TaskWorkflowRun entities.
+type TaskWorkflowRunUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TaskWorkflowRunMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TaskWorkflowRunUpdate builder.
+func (_u *TaskWorkflowRunUpdate) Where(ps ...predicate.TaskWorkflowRun) *TaskWorkflowRunUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TaskWorkflowRunUpdate) SetUpdatedAt(v time.Time) *TaskWorkflowRunUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *TaskWorkflowRunUpdate) SetNillableUpdatedAt(v *time.Time) *TaskWorkflowRunUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *TaskWorkflowRunUpdate) ClearUpdatedAt() *TaskWorkflowRunUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *TaskWorkflowRunUpdate) SetDeletedAt(v time.Time) *TaskWorkflowRunUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *TaskWorkflowRunUpdate) SetNillableDeletedAt(v *time.Time) *TaskWorkflowRunUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *TaskWorkflowRunUpdate) ClearDeletedAt() *TaskWorkflowRunUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *TaskWorkflowRunUpdate) SetCreatedBy(v uint32) *TaskWorkflowRunUpdate {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *TaskWorkflowRunUpdate) SetNillableCreatedBy(v *uint32) *TaskWorkflowRunUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *TaskWorkflowRunUpdate) AddCreatedBy(v int32) *TaskWorkflowRunUpdate {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *TaskWorkflowRunUpdate) ClearCreatedBy() *TaskWorkflowRunUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetWorkflowID sets the "workflow_id" field.
+func (_u *TaskWorkflowRunUpdate) SetWorkflowID(v uint32) *TaskWorkflowRunUpdate {
+	_u.mutation.ResetWorkflowID()
+	_u.mutation.SetWorkflowID(v)
+	return _u
+}
+
+// SetNillableWorkflowID sets the "workflow_id" field if the given value is not nil.
+func (_u *TaskWorkflowRunUpdate) SetNillableWorkflowID(v *uint32) *TaskWorkflowRunUpdate {
+	if v != nil {
+		_u.SetWorkflowID(*v)
+	}
+	return _u
+}
+
+// AddWorkflowID adds value to the "workflow_id" field.
+func (_u *TaskWorkflowRunUpdate) AddWorkflowID(v int32) *TaskWorkflowRunUpdate {
+	_u.mutation.AddWorkflowID(v)
+	return _u
+}
+
+// ClearWorkflowID clears the value of the "workflow_id" field.
+func (_u *TaskWorkflowRunUpdate) ClearWorkflowID() *TaskWorkflowRunUpdate {
+	_u.mutation.ClearWorkflowID()
+	return _u
+}
+
+// SetWorkflowName sets the "workflow_name" field.
+func (_u *TaskWorkflowRunUpdate) SetWorkflowName(v string) *TaskWorkflowRunUpdate {
+	_u.mutation.SetWorkflowName(v)
+	return _u
+}
+
+// SetNillableWorkflowName sets the "workflow_name" field if the given value is not nil.
+func (_u *TaskWorkflowRunUpdate) SetNillableWorkflowName(v *string) *TaskWorkflowRunUpdate {
+	if v != nil {
+		_u.SetWorkflowName(*v)
+	}
+	return _u
+}
+
+// ClearWorkflowName clears the value of the "workflow_name" field.
+func (_u *TaskWorkflowRunUpdate) ClearWorkflowName() *TaskWorkflowRunUpdate {
+	_u.mutation.ClearWorkflowName()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *TaskWorkflowRunUpdate) SetStatus(v taskworkflowrun.Status) *TaskWorkflowRunUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *TaskWorkflowRunUpdate) SetNillableStatus(v *taskworkflowrun.Status) *TaskWorkflowRunUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *TaskWorkflowRunUpdate) ClearStatus() *TaskWorkflowRunUpdate {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetInput sets the "input" field.
+func (_u *TaskWorkflowRunUpdate) SetInput(v string) *TaskWorkflowRunUpdate {
+	_u.mutation.SetInput(v)
+	return _u
+}
+
+// SetNillableInput sets the "input" field if the given value is not nil.
+func (_u *TaskWorkflowRunUpdate) SetNillableInput(v *string) *TaskWorkflowRunUpdate {
+	if v != nil {
+		_u.SetInput(*v)
+	}
+	return _u
+}
+
+// ClearInput clears the value of the "input" field.
+func (_u *TaskWorkflowRunUpdate) ClearInput() *TaskWorkflowRunUpdate {
+	_u.mutation.ClearInput()
+	return _u
+}
+
+// SetSteps sets the "steps" field.
+func (_u *TaskWorkflowRunUpdate) SetSteps(v []*taskpb.TaskWorkflowStep) *TaskWorkflowRunUpdate {
+	_u.mutation.SetSteps(v)
+	return _u
+}
+
+// AppendSteps appends value to the "steps" field.
+func (_u *TaskWorkflowRunUpdate) AppendSteps(v []*taskpb.TaskWorkflowStep) *TaskWorkflowRunUpdate {
+	_u.mutation.AppendSteps(v)
+	return _u
+}
+
+// ClearSteps clears the value of the "steps" field.
+func (_u *TaskWorkflowRunUpdate) ClearSteps() *TaskWorkflowRunUpdate {
+	_u.mutation.ClearSteps()
+	return _u
+}
+
+// SetStepRuns sets the "step_runs" field.
+func (_u *TaskWorkflowRunUpdate) SetStepRuns(v []*taskpb.TaskWorkflowStepRun) *TaskWorkflowRunUpdate {
+	_u.mutation.SetStepRuns(v)
+	return _u
+}
+
+// AppendStepRuns appends value to the "step_runs" field.
+func (_u *TaskWorkflowRunUpdate) AppendStepRuns(v []*taskpb.TaskWorkflowStepRun) *TaskWorkflowRunUpdate {
+	_u.mutation.AppendStepRuns(v)
+	return _u
+}
+
+// ClearStepRuns clears the value of the "step_runs" field.
+func (_u *TaskWorkflowRunUpdate) ClearStepRuns() *TaskWorkflowRunUpdate {
+	_u.mutation.ClearStepRuns()
+	return _u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_u *TaskWorkflowRunUpdate) SetErrorMessage(v string) *TaskWorkflowRunUpdate {
+	_u.mutation.SetErrorMessage(v)
+	return _u
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_u *TaskWorkflowRunUpdate) SetNillableErrorMessage(v *string) *TaskWorkflowRunUpdate {
+	if v != nil {
+		_u.SetErrorMessage(*v)
+	}
+	return _u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (_u *TaskWorkflowRunUpdate) ClearErrorMessage() *TaskWorkflowRunUpdate {
+	_u.mutation.ClearErrorMessage()
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *TaskWorkflowRunUpdate) SetStartedAt(v time.Time) *TaskWorkflowRunUpdate {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *TaskWorkflowRunUpdate) SetNillableStartedAt(v *time.Time) *TaskWorkflowRunUpdate {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *TaskWorkflowRunUpdate) ClearStartedAt() *TaskWorkflowRunUpdate {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *TaskWorkflowRunUpdate) SetFinishedAt(v time.Time) *TaskWorkflowRunUpdate {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *TaskWorkflowRunUpdate) SetNillableFinishedAt(v *time.Time) *TaskWorkflowRunUpdate {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *TaskWorkflowRunUpdate) ClearFinishedAt() *TaskWorkflowRunUpdate {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// Mutation returns the TaskWorkflowRunMutation object of the builder.
+func (_u *TaskWorkflowRunUpdate) Mutation() *TaskWorkflowRunMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TaskWorkflowRunUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TaskWorkflowRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TaskWorkflowRunUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TaskWorkflowRunUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TaskWorkflowRunUpdate) check() error {
+	if v, ok := _u.mutation.WorkflowName(); ok {
+		if err := taskworkflowrun.WorkflowNameValidator(v); err != nil {
+			return &ValidationError{Name: "workflow_name", err: fmt.Errorf(`ent: validator failed for field "TaskWorkflowRun.workflow_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := taskworkflowrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TaskWorkflowRun.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TaskWorkflowRunUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TaskWorkflowRunUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TaskWorkflowRunUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskworkflowrun.Table, taskworkflowrun.Columns, sqlgraph.NewFieldSpec(taskworkflowrun.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(taskworkflowrun.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(taskworkflowrun.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(taskworkflowrun.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(taskworkflowrun.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(taskworkflowrun.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(taskworkflowrun.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(taskworkflowrun.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(taskworkflowrun.FieldCreatedBy, field.TypeUint32)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(taskworkflowrun.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.WorkflowID(); ok {
+		_spec.SetField(taskworkflowrun.FieldWorkflowID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedWorkflowID(); ok {
+		_spec.AddField(taskworkflowrun.FieldWorkflowID, field.TypeUint32, value)
+	}
+	if _u.mutation.WorkflowIDCleared() {
+		_spec.ClearField(taskworkflowrun.FieldWorkflowID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.WorkflowName(); ok {
+		_spec.SetField(taskworkflowrun.FieldWorkflowName, field.TypeString, value)
+	}
+	if _u.mutation.WorkflowNameCleared() {
+		_spec.ClearField(taskworkflowrun.FieldWorkflowName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(taskworkflowrun.FieldStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(taskworkflowrun.FieldStatus, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.Input(); ok {
+		_spec.SetField(taskworkflowrun.FieldInput, field.TypeString, value)
+	}
+	if _u.mutation.InputCleared() {
+		_spec.ClearField(taskworkflowrun.FieldInput, field.TypeString)
+	}
+	if value, ok := _u.mutation.Steps(); ok {
+		_spec.SetField(taskworkflowrun.FieldSteps, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSteps(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, taskworkflowrun.FieldSteps, value)
+		})
+	}
+	if _u.mutation.StepsCleared() {
+		_spec.ClearField(taskworkflowrun.FieldSteps, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.StepRuns(); ok {
+		_spec.SetField(taskworkflowrun.FieldStepRuns, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedStepRuns(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, taskworkflowrun.FieldStepRuns, value)
+		})
+	}
+	if _u.mutation.StepRunsCleared() {
+		_spec.ClearField(taskworkflowrun.FieldStepRuns, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ErrorMessage(); ok {
+		_spec.SetField(taskworkflowrun.FieldErrorMessage, field.TypeString, value)
+	}
+	if _u.mutation.ErrorMessageCleared() {
+		_spec.ClearField(taskworkflowrun.FieldErrorMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(taskworkflowrun.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(taskworkflowrun.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(taskworkflowrun.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(taskworkflowrun.FieldFinishedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskworkflowrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TaskWorkflowRunUpdateOne is the builder for updating a single TaskWorkflowRun entity.
+type TaskWorkflowRunUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TaskWorkflowRunMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TaskWorkflowRunUpdateOne) SetUpdatedAt(v time.Time) *TaskWorkflowRunUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *TaskWorkflowRunUpdateOne) SetNillableUpdatedAt(v *time.Time) *TaskWorkflowRunUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *TaskWorkflowRunUpdateOne) ClearUpdatedAt() *TaskWorkflowRunUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *TaskWorkflowRunUpdateOne) SetDeletedAt(v time.Time) *TaskWorkflowRunUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *TaskWorkflowRunUpdateOne) SetNillableDeletedAt(v *time.Time) *TaskWorkflowRunUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *TaskWorkflowRunUpdateOne) ClearDeletedAt() *TaskWorkflowRunUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *TaskWorkflowRunUpdateOne) SetCreatedBy(v uint32) *TaskWorkflowRunUpdateOne {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *TaskWorkflowRunUpdateOne) SetNillableCreatedBy(v *uint32) *TaskWorkflowRunUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *TaskWorkflowRunUpdateOne) AddCreatedBy(v int32) *TaskWorkflowRunUpdateOne {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *TaskWorkflowRunUpdateOne) ClearCreatedBy() *TaskWorkflowRunUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetWorkflowID sets the "workflow_id" field.
+func (_u *TaskWorkflowRunUpdateOne) SetWorkflowID(v uint32) *TaskWorkflowRunUpdateOne {
+	_u.mutation.ResetWorkflowID()
+	_u.mutation.SetWorkflowID(v)
+	return _u
+}
+
+// SetNillableWorkflowID sets the "workflow_id" field if the given value is not nil.
+func (_u *TaskWorkflowRunUpdateOne) SetNillableWorkflowID(v *uint32) *TaskWorkflowRunUpdateOne {
+	if v != nil {
+		_u.SetWorkflowID(*v)
+	}
+	return _u
+}
+
+// AddWorkflowID adds value to the "workflow_id" field.
+func (_u *TaskWorkflowRunUpdateOne) AddWorkflowID(v int32) *TaskWorkflowRunUpdateOne {
+	_u.mutation.AddWorkflowID(v)
+	return _u
+}
+
+// ClearWorkflowID clears the value of the "workflow_id" field.
+func (_u *TaskWorkflowRunUpdateOne) ClearWorkflowID() *TaskWorkflowRunUpdateOne {
+	_u.mutation.ClearWorkflowID()
+	return _u
+}
+
+// SetWorkflowName sets the "workflow_name" field.
+func (_u *TaskWorkflowRunUpdateOne) SetWorkflowName(v string) *TaskWorkflowRunUpdateOne {
+	_u.mutation.SetWorkflowName(v)
+	return _u
+}
+
+// SetNillableWorkflowName sets the "workflow_name" field if the given value is not nil.
+func (_u *TaskWorkflowRunUpdateOne) SetNillableWorkflowName(v *string) *TaskWorkflowRunUpdateOne {
+	if v != nil {
+		_u.SetWorkflowName(*v)
+	}
+	return _u
+}
+
+// ClearWorkflowName clears the value of the "workflow_name" field.
+func (_u *TaskWorkflowRunUpdateOne) ClearWorkflowName() *TaskWorkflowRunUpdateOne {
+	_u.mutation.ClearWorkflowName()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *TaskWorkflowRunUpdateOne) SetStatus(v taskworkflowrun.Status) *TaskWorkflowRunUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *TaskWorkflowRunUpdateOne) SetNillableStatus(v *taskworkflowrun.Status) *TaskWorkflowRunUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *TaskWorkflowRunUpdateOne) ClearStatus() *TaskWorkflowRunUpdateOne {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetInput sets the "input" field.
+func (_u *TaskWorkflowRunUpdateOne) SetInput(v string) *TaskWorkflowRunUpdateOne {
+	_u.mutation.SetInput(v)
+	return _u
+}
+
+// SetNillableInput sets the "input" field if the given value is not nil.
+func (_u *TaskWorkflowRunUpdateOne) SetNillableInput(v *string) *TaskWorkflowRunUpdateOne {
+	if v != nil {
+		_u.SetInput(*v)
+	}
+	return _u
+}
+
+// ClearInput clears the value of the "input" field.
+func (_u *TaskWorkflowRunUpdateOne) ClearInput() *TaskWorkflowRunUpdateOne {
+	_u.mutation.ClearInput()
+	return _u
+}
+
+// SetSteps sets the "steps" field.
+func (_u *TaskWorkflowRunUpdateOne) SetSteps(v []*taskpb.TaskWorkflowStep) *TaskWorkflowRunUpdateOne {
+	_u.mutation.SetSteps(v)
+	return _u
+}
+
+// AppendSteps appends value to the "steps" field.
+func (_u *TaskWorkflowRunUpdateOne) AppendSteps(v []*taskpb.TaskWorkflowStep) *TaskWorkflowRunUpdateOne {
+	_u.mutation.AppendSteps(v)
+	return _u
+}
+
+// ClearSteps clears the value of the "steps" field.
+func (_u *TaskWorkflowRunUpdateOne) ClearSteps() *TaskWorkflowRunUpdateOne {
+	_u.mutation.ClearSteps()
+	return _u
+}
+
+// SetStepRuns sets the "step_runs" field.
+func (_u *TaskWorkflowRunUpdateOne) SetStepRuns(v []*taskpb.TaskWorkflowStepRun) *TaskWorkflowRunUpdateOne {
+	_u.mutation.SetStepRuns(v)
+	return _u
+}
+
+// AppendStepRuns appends value to the "step_runs" field.
+func (_u *TaskWorkflowRunUpdateOne) AppendStepRuns(v []*taskpb.TaskWorkflowStepRun) *TaskWorkflowRunUpdateOne {
+	_u.mutation.AppendStepRuns(v)
+	return _u
+}
+
+// ClearStepRuns clears the value of the "step_runs" field.
+func (_u *TaskWorkflowRunUpdateOne) ClearStepRuns() *TaskWorkflowRunUpdateOne {
+	_u.mutation.ClearStepRuns()
+	return _u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_u *TaskWorkflowRunUpdateOne) SetErrorMessage(v string) *TaskWorkflowRunUpdateOne {
+	_u.mutation.SetErrorMessage(v)
+	return _u
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_u *TaskWorkflowRunUpdateOne) SetNillableErrorMessage(v *string) *TaskWorkflowRunUpdateOne {
+	if v != nil {
+		_u.SetErrorMessage(*v)
+	}
+	return _u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (_u *TaskWorkflowRunUpdateOne) ClearErrorMessage() *TaskWorkflowRunUpdateOne {
+	_u.mutation.ClearErrorMessage()
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *TaskWorkflowRunUpdateOne) SetStartedAt(v time.Time) *TaskWorkflowRunUpdateOne {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *TaskWorkflowRunUpdateOne) SetNillableStartedAt(v *time.Time) *TaskWorkflowRunUpdateOne {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *TaskWorkflowRunUpdateOne) ClearStartedAt() *TaskWorkflowRunUpdateOne {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *TaskWorkflowRunUpdateOne) SetFinishedAt(v time.Time) *TaskWorkflowRunUpdateOne {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *TaskWorkflowRunUpdateOne) SetNillableFinishedAt(v *time.Time) *TaskWorkflowRunUpdateOne {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *TaskWorkflowRunUpdateOne) ClearFinishedAt() *TaskWorkflowRunUpdateOne {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// Mutation returns the TaskWorkflowRunMutation object of the builder.
+func (_u *TaskWorkflowRunUpdateOne) Mutation() *TaskWorkflowRunMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TaskWorkflowRunUpdate builder.
+func (_u *TaskWorkflowRunUpdateOne) Where(ps ...predicate.TaskWorkflowRun) *TaskWorkflowRunUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TaskWorkflowRunUpdateOne) Select(field string, fields ...string) *TaskWorkflowRunUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TaskWorkflowRun entity.
+func (_u *TaskWorkflowRunUpdateOne) Save(ctx context.Context) (*TaskWorkflowRun, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TaskWorkflowRunUpdateOne) SaveX(ctx context.Context) *TaskWorkflowRun {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TaskWorkflowRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TaskWorkflowRunUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TaskWorkflowRunUpdateOne) check() error {
+	if v, ok := _u.mutation.WorkflowName(); ok {
+		if err := taskworkflowrun.WorkflowNameValidator(v); err != nil {
+			return &ValidationError{Name: "workflow_name", err: fmt.Errorf(`ent: validator failed for field "TaskWorkflowRun.workflow_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := taskworkflowrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TaskWorkflowRun.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TaskWorkflowRunUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TaskWorkflowRunUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TaskWorkflowRunUpdateOne) sqlSave(ctx context.Context) (_node *TaskWorkflowRun, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskworkflowrun.Table, taskworkflowrun.Columns, sqlgraph.NewFieldSpec(taskworkflowrun.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TaskWorkflowRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskworkflowrun.FieldID)
+		for _, f := range fields {
+			if !taskworkflowrun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != taskworkflowrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(taskworkflowrun.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(taskworkflowrun.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(taskworkflowrun.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(taskworkflowrun.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(taskworkflowrun.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(taskworkflowrun.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(taskworkflowrun.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(taskworkflowrun.FieldCreatedBy, field.TypeUint32)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(taskworkflowrun.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.WorkflowID(); ok {
+		_spec.SetField(taskworkflowrun.FieldWorkflowID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedWorkflowID(); ok {
+		_spec.AddField(taskworkflowrun.FieldWorkflowID, field.TypeUint32, value)
+	}
+	if _u.mutation.WorkflowIDCleared() {
+		_spec.ClearField(taskworkflowrun.FieldWorkflowID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.WorkflowName(); ok {
+		_spec.SetField(taskworkflowrun.FieldWorkflowName, field.TypeString, value)
+	}
+	if _u.mutation.WorkflowNameCleared() {
+		_spec.ClearField(taskworkflowrun.FieldWorkflowName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(taskworkflowrun.FieldStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(taskworkflowrun.FieldStatus, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.Input(); ok {
+		_spec.SetField(taskworkflowrun.FieldInput, field.TypeString, value)
+	}
+	if _u.mutation.InputCleared() {
+		_spec.ClearField(taskworkflowrun.FieldInput, field.TypeString)
+	}
+	if value, ok := _u.mutation.Steps(); ok {
+		_spec.SetField(taskworkflowrun.FieldSteps, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSteps(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, taskworkflowrun.FieldSteps, value)
+		})
+	}
+	if _u.mutation.StepsCleared() {
+		_spec.ClearField(taskworkflowrun.FieldSteps, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.StepRuns(); ok {
+		_spec.SetField(taskworkflowrun.FieldStepRuns, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedStepRuns(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, taskworkflowrun.FieldStepRuns, value)
+		})
+	}
+	if _u.mutation.StepRunsCleared() {
+		_spec.ClearField(taskworkflowrun.FieldStepRuns, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ErrorMessage(); ok {
+		_spec.SetField(taskworkflowrun.FieldErrorMessage, field.TypeString, value)
+	}
+	if _u.mutation.ErrorMessageCleared() {
+		_spec.ClearField(taskworkflowrun.FieldErrorMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(taskworkflowrun.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(taskworkflowrun.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(taskworkflowrun.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(taskworkflowrun.FieldFinishedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &TaskWorkflowRun{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskworkflowrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -57,8 +57,16 @@ type Tenant struct {
 	// 订阅套餐
 	SubscriptionPlan *string `json:"subscription_plan,omitempty"`
 	// 租户有效期
-	ExpiredAt    *time.Time `json:"expired_at,omitempty"`
-	selectValues sql.SelectValues
+	ExpiredAt *time.Time `json:"expired_at,omitempty"`
+	// 高风险登录处置方式
+	HighRiskLoginAction *tenant.HighRiskLoginAction `json:"high_risk_login_action,omitempty"`
+	// 文件存储后端，为空时使用平台默认存储
+	StorageProvider *tenant.StorageProvider `json:"storage_provider,omitempty"`
+	// 文件去重策略，为空时仅在本租户内去重
+	FileDedupPolicy *tenant.FileDedupPolicy `json:"file_dedup_policy,omitempty"`
+	// 回收站文件保留天数，为空或0时使用平台默认值
+	RecycleBinRetentionDays *uint32 `json:"recycle_bin_retention_days,omitempty"`
+	selectValues            sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -66,9 +74,9 @@ func (*Tenant) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case tenant.FieldID, tenant.FieldCreatedBy, tenant.FieldUpdatedBy, tenant.FieldDeletedBy, tenant.FieldAdminUserID:
+		case tenant.FieldID, tenant.FieldCreatedBy, tenant.FieldUpdatedBy, tenant.FieldDeletedBy, tenant.FieldAdminUserID, tenant.FieldRecycleBinRetentionDays:
 			values[i] = new(sql.NullInt64)
-		case tenant.FieldRemark, tenant.FieldName, tenant.FieldCode, tenant.FieldLogoURL, tenant.FieldDomain, tenant.FieldIndustry, tenant.FieldStatus, tenant.FieldType, tenant.FieldAuditStatus, tenant.FieldSubscriptionPlan:
+		case tenant.FieldRemark, tenant.FieldName, tenant.FieldCode, tenant.FieldLogoURL, tenant.FieldDomain, tenant.FieldIndustry, tenant.FieldStatus, tenant.FieldType, tenant.FieldAuditStatus, tenant.FieldSubscriptionPlan, tenant.FieldHighRiskLoginAction, tenant.FieldStorageProvider, tenant.FieldFileDedupPolicy:
 			values[i] = new(sql.NullString)
 		case tenant.FieldCreatedAt, tenant.FieldUpdatedAt, tenant.FieldDeletedAt, tenant.FieldSubscriptionAt, tenant.FieldUnsubscribeAt, tenant.FieldExpiredAt:
 			values[i] = new(sql.NullTime)
@@ -233,6 +241,34 @@ func (_m *Tenant) assignValues(columns []string, values []any) error {
 				_m.ExpiredAt = new(time.Time)
 				*_m.ExpiredAt = value.Time
 			}
+		case tenant.FieldHighRiskLoginAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field high_risk_login_action", values[i])
+			} else if value.Valid {
+				_m.HighRiskLoginAction = new(tenant.HighRiskLoginAction)
+				*_m.HighRiskLoginAction = tenant.HighRiskLoginAction(value.String)
+			}
+		case tenant.FieldStorageProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field storage_provider", values[i])
+			} else if value.Valid {
+				_m.StorageProvider = new(tenant.StorageProvider)
+				*_m.StorageProvider = tenant.StorageProvider(value.String)
+			}
+		case tenant.FieldFileDedupPolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field file_dedup_policy", values[i])
+			} else if value.Valid {
+				_m.FileDedupPolicy = new(tenant.FileDedupPolicy)
+				*_m.FileDedupPolicy = tenant.FileDedupPolicy(value.String)
+			}
+		case tenant.FieldRecycleBinRetentionDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field recycle_bin_retention_days", values[i])
+			} else if value.Valid {
+				_m.RecycleBinRetentionDays = new(uint32)
+				*_m.RecycleBinRetentionDays = uint32(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -368,6 +404,26 @@ func (_m *Tenant) String() string {
 		builder.WriteString("expired_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	if v := _m.HighRiskLoginAction; v != nil {
+		builder.WriteString("high_risk_login_action=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.StorageProvider; v != nil {
+		builder.WriteString("storage_provider=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.FileDedupPolicy; v != nil {
+		builder.WriteString("file_dedup_policy=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.RecycleBinRetentionDays; v != nil {
+		builder.WriteString("recycle_bin_retention_days=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
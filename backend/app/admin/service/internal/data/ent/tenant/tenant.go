@@ -53,6 +53,14 @@ const (
 	FieldSubscriptionPlan = "subscription_plan"
 	// FieldExpiredAt holds the string denoting the expired_at field in the database.
 	FieldExpiredAt = "expired_at"
+	// FieldHighRiskLoginAction holds the string denoting the high_risk_login_action field in the database.
+	FieldHighRiskLoginAction = "high_risk_login_action"
+	// FieldStorageProvider holds the string denoting the storage_provider field in the database.
+	FieldStorageProvider = "storage_provider"
+	// FieldFileDedupPolicy holds the string denoting the file_dedup_policy field in the database.
+	FieldFileDedupPolicy = "file_dedup_policy"
+	// FieldRecycleBinRetentionDays holds the string denoting the recycle_bin_retention_days field in the database.
+	FieldRecycleBinRetentionDays = "recycle_bin_retention_days"
 	// Table holds the table name of the tenant in the database.
 	Table = "sys_tenants"
 )
@@ -80,6 +88,10 @@ var Columns = []string{
 	FieldUnsubscribeAt,
 	FieldSubscriptionPlan,
 	FieldExpiredAt,
+	FieldHighRiskLoginAction,
+	FieldStorageProvider,
+	FieldFileDedupPolicy,
+	FieldRecycleBinRetentionDays,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -182,6 +194,89 @@ func AuditStatusValidator(as AuditStatus) error {
 	}
 }
 
+// HighRiskLoginAction defines the type for the "high_risk_login_action" enum field.
+type HighRiskLoginAction string
+
+// HighRiskLoginActionAllow is the default value of the HighRiskLoginAction enum.
+const DefaultHighRiskLoginAction = HighRiskLoginActionAllow
+
+// HighRiskLoginAction values.
+const (
+	HighRiskLoginActionAllow      HighRiskLoginAction = "ALLOW"
+	HighRiskLoginActionBlock      HighRiskLoginAction = "BLOCK"
+	HighRiskLoginActionRequireMfa HighRiskLoginAction = "REQUIRE_MFA"
+)
+
+func (hrla HighRiskLoginAction) String() string {
+	return string(hrla)
+}
+
+// HighRiskLoginActionValidator is a validator for the "high_risk_login_action" field enum values. It is called by the builders before save.
+func HighRiskLoginActionValidator(hrla HighRiskLoginAction) error {
+	switch hrla {
+	case HighRiskLoginActionAllow, HighRiskLoginActionBlock, HighRiskLoginActionRequireMfa:
+		return nil
+	default:
+		return fmt.Errorf("tenant: invalid enum value for high_risk_login_action field: %q", hrla)
+	}
+}
+
+// StorageProvider defines the type for the "storage_provider" enum field.
+type StorageProvider string
+
+// StorageProvider values.
+const (
+	StorageProviderUnknown StorageProvider = "UNKNOWN"
+	StorageProviderMinIO   StorageProvider = "MINIO"
+	StorageProviderAliyun  StorageProvider = "ALIYUN"
+	StorageProviderQiniu   StorageProvider = "QINIU"
+	StorageProviderTencent StorageProvider = "TENCENT"
+	StorageProviderAWS     StorageProvider = "AWS"
+	StorageProviderGoogle  StorageProvider = "GOOGLE"
+	StorageProviderAzure   StorageProvider = "AZURE"
+	StorageProviderBaidu   StorageProvider = "BAIDU"
+	StorageProviderHuawei  StorageProvider = "HUAWEI"
+	StorageProviderLocal   StorageProvider = "LOCAL"
+)
+
+func (sp StorageProvider) String() string {
+	return string(sp)
+}
+
+// StorageProviderValidator is a validator for the "storage_provider" field enum values. It is called by the builders before save.
+func StorageProviderValidator(sp StorageProvider) error {
+	switch sp {
+	case StorageProviderUnknown, StorageProviderMinIO, StorageProviderAliyun, StorageProviderQiniu, StorageProviderTencent, StorageProviderAWS, StorageProviderGoogle, StorageProviderAzure, StorageProviderBaidu, StorageProviderHuawei, StorageProviderLocal:
+		return nil
+	default:
+		return fmt.Errorf("tenant: invalid enum value for storage_provider field: %q", sp)
+	}
+}
+
+// FileDedupPolicy defines the type for the "file_dedup_policy" enum field.
+type FileDedupPolicy string
+
+// FileDedupPolicy values.
+const (
+	FileDedupPolicyDisabled FileDedupPolicy = "DEDUP_DISABLED"
+	FileDedupPolicyTenant   FileDedupPolicy = "DEDUP_TENANT"
+	FileDedupPolicyShared   FileDedupPolicy = "DEDUP_SHARED"
+)
+
+func (fdp FileDedupPolicy) String() string {
+	return string(fdp)
+}
+
+// FileDedupPolicyValidator is a validator for the "file_dedup_policy" field enum values. It is called by the builders before save.
+func FileDedupPolicyValidator(fdp FileDedupPolicy) error {
+	switch fdp {
+	case FileDedupPolicyDisabled, FileDedupPolicyTenant, FileDedupPolicyShared:
+		return nil
+	default:
+		return fmt.Errorf("tenant: invalid enum value for file_dedup_policy field: %q", fdp)
+	}
+}
+
 // OrderOption defines the ordering options for the Tenant queries.
 type OrderOption func(*sql.Selector)
 
@@ -289,3 +384,23 @@ func BySubscriptionPlan(opts ...sql.OrderTermOption) OrderOption {
 func ByExpiredAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldExpiredAt, opts...).ToFunc()
 }
+
+// ByHighRiskLoginAction orders the results by the high_risk_login_action field.
+func ByHighRiskLoginAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHighRiskLoginAction, opts...).ToFunc()
+}
+
+// ByStorageProvider orders the results by the storage_provider field.
+func ByStorageProvider(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStorageProvider, opts...).ToFunc()
+}
+
+// ByFileDedupPolicy orders the results by the file_dedup_policy field.
+func ByFileDedupPolicy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFileDedupPolicy, opts...).ToFunc()
+}
+
+// ByRecycleBinRetentionDays orders the results by the recycle_bin_retention_days field.
+func ByRecycleBinRetentionDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecycleBinRetentionDays, opts...).ToFunc()
+}
@@ -139,6 +139,11 @@ func ExpiredAt(v time.Time) predicate.Tenant {
 	return predicate.Tenant(sql.FieldEQ(FieldExpiredAt, v))
 }
 
+// RecycleBinRetentionDays applies equality check predicate on the "recycle_bin_retention_days" field. It's identical to RecycleBinRetentionDaysEQ.
+func RecycleBinRetentionDays(v uint32) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldRecycleBinRetentionDays, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Tenant {
 	return predicate.Tenant(sql.FieldEQ(FieldCreatedAt, v))
@@ -1254,6 +1259,146 @@ func ExpiredAtNotNil() predicate.Tenant {
 	return predicate.Tenant(sql.FieldNotNull(FieldExpiredAt))
 }
 
+// HighRiskLoginActionEQ applies the EQ predicate on the "high_risk_login_action" field.
+func HighRiskLoginActionEQ(v HighRiskLoginAction) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldHighRiskLoginAction, v))
+}
+
+// HighRiskLoginActionNEQ applies the NEQ predicate on the "high_risk_login_action" field.
+func HighRiskLoginActionNEQ(v HighRiskLoginAction) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNEQ(FieldHighRiskLoginAction, v))
+}
+
+// HighRiskLoginActionIn applies the In predicate on the "high_risk_login_action" field.
+func HighRiskLoginActionIn(vs ...HighRiskLoginAction) predicate.Tenant {
+	return predicate.Tenant(sql.FieldIn(FieldHighRiskLoginAction, vs...))
+}
+
+// HighRiskLoginActionNotIn applies the NotIn predicate on the "high_risk_login_action" field.
+func HighRiskLoginActionNotIn(vs ...HighRiskLoginAction) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotIn(FieldHighRiskLoginAction, vs...))
+}
+
+// HighRiskLoginActionIsNil applies the IsNil predicate on the "high_risk_login_action" field.
+func HighRiskLoginActionIsNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldIsNull(FieldHighRiskLoginAction))
+}
+
+// HighRiskLoginActionNotNil applies the NotNil predicate on the "high_risk_login_action" field.
+func HighRiskLoginActionNotNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotNull(FieldHighRiskLoginAction))
+}
+
+// StorageProviderEQ applies the EQ predicate on the "storage_provider" field.
+func StorageProviderEQ(v StorageProvider) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldStorageProvider, v))
+}
+
+// StorageProviderNEQ applies the NEQ predicate on the "storage_provider" field.
+func StorageProviderNEQ(v StorageProvider) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNEQ(FieldStorageProvider, v))
+}
+
+// StorageProviderIn applies the In predicate on the "storage_provider" field.
+func StorageProviderIn(vs ...StorageProvider) predicate.Tenant {
+	return predicate.Tenant(sql.FieldIn(FieldStorageProvider, vs...))
+}
+
+// StorageProviderNotIn applies the NotIn predicate on the "storage_provider" field.
+func StorageProviderNotIn(vs ...StorageProvider) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotIn(FieldStorageProvider, vs...))
+}
+
+// StorageProviderIsNil applies the IsNil predicate on the "storage_provider" field.
+func StorageProviderIsNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldIsNull(FieldStorageProvider))
+}
+
+// StorageProviderNotNil applies the NotNil predicate on the "storage_provider" field.
+func StorageProviderNotNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotNull(FieldStorageProvider))
+}
+
+// FileDedupPolicyEQ applies the EQ predicate on the "file_dedup_policy" field.
+func FileDedupPolicyEQ(v FileDedupPolicy) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldFileDedupPolicy, v))
+}
+
+// FileDedupPolicyNEQ applies the NEQ predicate on the "file_dedup_policy" field.
+func FileDedupPolicyNEQ(v FileDedupPolicy) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNEQ(FieldFileDedupPolicy, v))
+}
+
+// FileDedupPolicyIn applies the In predicate on the "file_dedup_policy" field.
+func FileDedupPolicyIn(vs ...FileDedupPolicy) predicate.Tenant {
+	return predicate.Tenant(sql.FieldIn(FieldFileDedupPolicy, vs...))
+}
+
+// FileDedupPolicyNotIn applies the NotIn predicate on the "file_dedup_policy" field.
+func FileDedupPolicyNotIn(vs ...FileDedupPolicy) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotIn(FieldFileDedupPolicy, vs...))
+}
+
+// FileDedupPolicyIsNil applies the IsNil predicate on the "file_dedup_policy" field.
+func FileDedupPolicyIsNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldIsNull(FieldFileDedupPolicy))
+}
+
+// FileDedupPolicyNotNil applies the NotNil predicate on the "file_dedup_policy" field.
+func FileDedupPolicyNotNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotNull(FieldFileDedupPolicy))
+}
+
+// RecycleBinRetentionDaysEQ applies the EQ predicate on the "recycle_bin_retention_days" field.
+func RecycleBinRetentionDaysEQ(v uint32) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldRecycleBinRetentionDays, v))
+}
+
+// RecycleBinRetentionDaysNEQ applies the NEQ predicate on the "recycle_bin_retention_days" field.
+func RecycleBinRetentionDaysNEQ(v uint32) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNEQ(FieldRecycleBinRetentionDays, v))
+}
+
+// RecycleBinRetentionDaysIn applies the In predicate on the "recycle_bin_retention_days" field.
+func RecycleBinRetentionDaysIn(vs ...uint32) predicate.Tenant {
+	return predicate.Tenant(sql.FieldIn(FieldRecycleBinRetentionDays, vs...))
+}
+
+// RecycleBinRetentionDaysNotIn applies the NotIn predicate on the "recycle_bin_retention_days" field.
+func RecycleBinRetentionDaysNotIn(vs ...uint32) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotIn(FieldRecycleBinRetentionDays, vs...))
+}
+
+// RecycleBinRetentionDaysGT applies the GT predicate on the "recycle_bin_retention_days" field.
+func RecycleBinRetentionDaysGT(v uint32) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGT(FieldRecycleBinRetentionDays, v))
+}
+
+// RecycleBinRetentionDaysGTE applies the GTE predicate on the "recycle_bin_retention_days" field.
+func RecycleBinRetentionDaysGTE(v uint32) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGTE(FieldRecycleBinRetentionDays, v))
+}
+
+// RecycleBinRetentionDaysLT applies the LT predicate on the "recycle_bin_retention_days" field.
+func RecycleBinRetentionDaysLT(v uint32) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLT(FieldRecycleBinRetentionDays, v))
+}
+
+// RecycleBinRetentionDaysLTE applies the LTE predicate on the "recycle_bin_retention_days" field.
+func RecycleBinRetentionDaysLTE(v uint32) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLTE(FieldRecycleBinRetentionDays, v))
+}
+
+// RecycleBinRetentionDaysIsNil applies the IsNil predicate on the "recycle_bin_retention_days" field.
+func RecycleBinRetentionDaysIsNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldIsNull(FieldRecycleBinRetentionDays))
+}
+
+// RecycleBinRetentionDaysNotNil applies the NotNil predicate on the "recycle_bin_retention_days" field.
+func RecycleBinRetentionDaysNotNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotNull(FieldRecycleBinRetentionDays))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Tenant) predicate.Tenant {
 	return predicate.Tenant(sql.AndPredicates(predicates...))
@@ -302,6 +302,62 @@ func (_c *TenantCreate) SetNillableExpiredAt(v *time.Time) *TenantCreate {
 	return _c
 }
 
+// SetHighRiskLoginAction sets the "high_risk_login_action" field.
+func (_c *TenantCreate) SetHighRiskLoginAction(v tenant.HighRiskLoginAction) *TenantCreate {
+	_c.mutation.SetHighRiskLoginAction(v)
+	return _c
+}
+
+// SetNillableHighRiskLoginAction sets the "high_risk_login_action" field if the given value is not nil.
+func (_c *TenantCreate) SetNillableHighRiskLoginAction(v *tenant.HighRiskLoginAction) *TenantCreate {
+	if v != nil {
+		_c.SetHighRiskLoginAction(*v)
+	}
+	return _c
+}
+
+// SetStorageProvider sets the "storage_provider" field.
+func (_c *TenantCreate) SetStorageProvider(v tenant.StorageProvider) *TenantCreate {
+	_c.mutation.SetStorageProvider(v)
+	return _c
+}
+
+// SetNillableStorageProvider sets the "storage_provider" field if the given value is not nil.
+func (_c *TenantCreate) SetNillableStorageProvider(v *tenant.StorageProvider) *TenantCreate {
+	if v != nil {
+		_c.SetStorageProvider(*v)
+	}
+	return _c
+}
+
+// SetFileDedupPolicy sets the "file_dedup_policy" field.
+func (_c *TenantCreate) SetFileDedupPolicy(v tenant.FileDedupPolicy) *TenantCreate {
+	_c.mutation.SetFileDedupPolicy(v)
+	return _c
+}
+
+// SetNillableFileDedupPolicy sets the "file_dedup_policy" field if the given value is not nil.
+func (_c *TenantCreate) SetNillableFileDedupPolicy(v *tenant.FileDedupPolicy) *TenantCreate {
+	if v != nil {
+		_c.SetFileDedupPolicy(*v)
+	}
+	return _c
+}
+
+// SetRecycleBinRetentionDays sets the "recycle_bin_retention_days" field.
+func (_c *TenantCreate) SetRecycleBinRetentionDays(v uint32) *TenantCreate {
+	_c.mutation.SetRecycleBinRetentionDays(v)
+	return _c
+}
+
+// SetNillableRecycleBinRetentionDays sets the "recycle_bin_retention_days" field if the given value is not nil.
+func (_c *TenantCreate) SetNillableRecycleBinRetentionDays(v *uint32) *TenantCreate {
+	if v != nil {
+		_c.SetRecycleBinRetentionDays(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *TenantCreate) SetID(v uint32) *TenantCreate {
 	_c.mutation.SetID(v)
@@ -351,6 +407,10 @@ func (_c *TenantCreate) defaults() {
 		v := tenant.DefaultType
 		_c.mutation.SetType(v)
 	}
+	if _, ok := _c.mutation.HighRiskLoginAction(); !ok {
+		v := tenant.DefaultHighRiskLoginAction
+		_c.mutation.SetHighRiskLoginAction(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -380,6 +440,21 @@ func (_c *TenantCreate) check() error {
 			return &ValidationError{Name: "audit_status", err: fmt.Errorf(`ent: validator failed for field "Tenant.audit_status": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.HighRiskLoginAction(); ok {
+		if err := tenant.HighRiskLoginActionValidator(v); err != nil {
+			return &ValidationError{Name: "high_risk_login_action", err: fmt.Errorf(`ent: validator failed for field "Tenant.high_risk_login_action": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.StorageProvider(); ok {
+		if err := tenant.StorageProviderValidator(v); err != nil {
+			return &ValidationError{Name: "storage_provider", err: fmt.Errorf(`ent: validator failed for field "Tenant.storage_provider": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.FileDedupPolicy(); ok {
+		if err := tenant.FileDedupPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "file_dedup_policy", err: fmt.Errorf(`ent: validator failed for field "Tenant.file_dedup_policy": %w`, err)}
+		}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := tenant.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Tenant.id": %w`, err)}
@@ -498,6 +573,22 @@ func (_c *TenantCreate) createSpec() (*Tenant, *sqlgraph.CreateSpec) {
 		_spec.SetField(tenant.FieldExpiredAt, field.TypeTime, value)
 		_node.ExpiredAt = &value
 	}
+	if value, ok := _c.mutation.HighRiskLoginAction(); ok {
+		_spec.SetField(tenant.FieldHighRiskLoginAction, field.TypeEnum, value)
+		_node.HighRiskLoginAction = &value
+	}
+	if value, ok := _c.mutation.StorageProvider(); ok {
+		_spec.SetField(tenant.FieldStorageProvider, field.TypeEnum, value)
+		_node.StorageProvider = &value
+	}
+	if value, ok := _c.mutation.FileDedupPolicy(); ok {
+		_spec.SetField(tenant.FieldFileDedupPolicy, field.TypeEnum, value)
+		_node.FileDedupPolicy = &value
+	}
+	if value, ok := _c.mutation.RecycleBinRetentionDays(); ok {
+		_spec.SetField(tenant.FieldRecycleBinRetentionDays, field.TypeUint32, value)
+		_node.RecycleBinRetentionDays = &value
+	}
 	return _node, _spec
 }
 
@@ -916,6 +1007,84 @@ func (u *TenantUpsert) ClearExpiredAt() *TenantUpsert {
 	return u
 }
 
+// SetHighRiskLoginAction sets the "high_risk_login_action" field.
+func (u *TenantUpsert) SetHighRiskLoginAction(v tenant.HighRiskLoginAction) *TenantUpsert {
+	u.Set(tenant.FieldHighRiskLoginAction, v)
+	return u
+}
+
+// UpdateHighRiskLoginAction sets the "high_risk_login_action" field to the value that was provided on create.
+func (u *TenantUpsert) UpdateHighRiskLoginAction() *TenantUpsert {
+	u.SetExcluded(tenant.FieldHighRiskLoginAction)
+	return u
+}
+
+// ClearHighRiskLoginAction clears the value of the "high_risk_login_action" field.
+func (u *TenantUpsert) ClearHighRiskLoginAction() *TenantUpsert {
+	u.SetNull(tenant.FieldHighRiskLoginAction)
+	return u
+}
+
+// SetStorageProvider sets the "storage_provider" field.
+func (u *TenantUpsert) SetStorageProvider(v tenant.StorageProvider) *TenantUpsert {
+	u.Set(tenant.FieldStorageProvider, v)
+	return u
+}
+
+// UpdateStorageProvider sets the "storage_provider" field to the value that was provided on create.
+func (u *TenantUpsert) UpdateStorageProvider() *TenantUpsert {
+	u.SetExcluded(tenant.FieldStorageProvider)
+	return u
+}
+
+// ClearStorageProvider clears the value of the "storage_provider" field.
+func (u *TenantUpsert) ClearStorageProvider() *TenantUpsert {
+	u.SetNull(tenant.FieldStorageProvider)
+	return u
+}
+
+// SetFileDedupPolicy sets the "file_dedup_policy" field.
+func (u *TenantUpsert) SetFileDedupPolicy(v tenant.FileDedupPolicy) *TenantUpsert {
+	u.Set(tenant.FieldFileDedupPolicy, v)
+	return u
+}
+
+// UpdateFileDedupPolicy sets the "file_dedup_policy" field to the value that was provided on create.
+func (u *TenantUpsert) UpdateFileDedupPolicy() *TenantUpsert {
+	u.SetExcluded(tenant.FieldFileDedupPolicy)
+	return u
+}
+
+// ClearFileDedupPolicy clears the value of the "file_dedup_policy" field.
+func (u *TenantUpsert) ClearFileDedupPolicy() *TenantUpsert {
+	u.SetNull(tenant.FieldFileDedupPolicy)
+	return u
+}
+
+// SetRecycleBinRetentionDays sets the "recycle_bin_retention_days" field.
+func (u *TenantUpsert) SetRecycleBinRetentionDays(v uint32) *TenantUpsert {
+	u.Set(tenant.FieldRecycleBinRetentionDays, v)
+	return u
+}
+
+// UpdateRecycleBinRetentionDays sets the "recycle_bin_retention_days" field to the value that was provided on create.
+func (u *TenantUpsert) UpdateRecycleBinRetentionDays() *TenantUpsert {
+	u.SetExcluded(tenant.FieldRecycleBinRetentionDays)
+	return u
+}
+
+// AddRecycleBinRetentionDays adds v to the "recycle_bin_retention_days" field.
+func (u *TenantUpsert) AddRecycleBinRetentionDays(v uint32) *TenantUpsert {
+	u.Add(tenant.FieldRecycleBinRetentionDays, v)
+	return u
+}
+
+// ClearRecycleBinRetentionDays clears the value of the "recycle_bin_retention_days" field.
+func (u *TenantUpsert) ClearRecycleBinRetentionDays() *TenantUpsert {
+	u.SetNull(tenant.FieldRecycleBinRetentionDays)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -1394,6 +1563,97 @@ func (u *TenantUpsertOne) ClearExpiredAt() *TenantUpsertOne {
 	})
 }
 
+// SetHighRiskLoginAction sets the "high_risk_login_action" field.
+func (u *TenantUpsertOne) SetHighRiskLoginAction(v tenant.HighRiskLoginAction) *TenantUpsertOne {
+	return u.Update(func(s *TenantUpsert) {
+		s.SetHighRiskLoginAction(v)
+	})
+}
+
+// UpdateHighRiskLoginAction sets the "high_risk_login_action" field to the value that was provided on create.
+func (u *TenantUpsertOne) UpdateHighRiskLoginAction() *TenantUpsertOne {
+	return u.Update(func(s *TenantUpsert) {
+		s.UpdateHighRiskLoginAction()
+	})
+}
+
+// ClearHighRiskLoginAction clears the value of the "high_risk_login_action" field.
+func (u *TenantUpsertOne) ClearHighRiskLoginAction() *TenantUpsertOne {
+	return u.Update(func(s *TenantUpsert) {
+		s.ClearHighRiskLoginAction()
+	})
+}
+
+// SetStorageProvider sets the "storage_provider" field.
+func (u *TenantUpsertOne) SetStorageProvider(v tenant.StorageProvider) *TenantUpsertOne {
+	return u.Update(func(s *TenantUpsert) {
+		s.SetStorageProvider(v)
+	})
+}
+
+// UpdateStorageProvider sets the "storage_provider" field to the value that was provided on create.
+func (u *TenantUpsertOne) UpdateStorageProvider() *TenantUpsertOne {
+	return u.Update(func(s *TenantUpsert) {
+		s.UpdateStorageProvider()
+	})
+}
+
+// ClearStorageProvider clears the value of the "storage_provider" field.
+func (u *TenantUpsertOne) ClearStorageProvider() *TenantUpsertOne {
+	return u.Update(func(s *TenantUpsert) {
+		s.ClearStorageProvider()
+	})
+}
+
+// SetFileDedupPolicy sets the "file_dedup_policy" field.
+func (u *TenantUpsertOne) SetFileDedupPolicy(v tenant.FileDedupPolicy) *TenantUpsertOne {
+	return u.Update(func(s *TenantUpsert) {
+		s.SetFileDedupPolicy(v)
+	})
+}
+
+// UpdateFileDedupPolicy sets the "file_dedup_policy" field to the value that was provided on create.
+func (u *TenantUpsertOne) UpdateFileDedupPolicy() *TenantUpsertOne {
+	return u.Update(func(s *TenantUpsert) {
+		s.UpdateFileDedupPolicy()
+	})
+}
+
+// ClearFileDedupPolicy clears the value of the "file_dedup_policy" field.
+func (u *TenantUpsertOne) ClearFileDedupPolicy() *TenantUpsertOne {
+	return u.Update(func(s *TenantUpsert) {
+		s.ClearFileDedupPolicy()
+	})
+}
+
+// SetRecycleBinRetentionDays sets the "recycle_bin_retention_days" field.
+func (u *TenantUpsertOne) SetRecycleBinRetentionDays(v uint32) *TenantUpsertOne {
+	return u.Update(func(s *TenantUpsert) {
+		s.SetRecycleBinRetentionDays(v)
+	})
+}
+
+// AddRecycleBinRetentionDays adds v to the "recycle_bin_retention_days" field.
+func (u *TenantUpsertOne) AddRecycleBinRetentionDays(v uint32) *TenantUpsertOne {
+	return u.Update(func(s *TenantUpsert) {
+		s.AddRecycleBinRetentionDays(v)
+	})
+}
+
+// UpdateRecycleBinRetentionDays sets the "recycle_bin_retention_days" field to the value that was provided on create.
+func (u *TenantUpsertOne) UpdateRecycleBinRetentionDays() *TenantUpsertOne {
+	return u.Update(func(s *TenantUpsert) {
+		s.UpdateRecycleBinRetentionDays()
+	})
+}
+
+// ClearRecycleBinRetentionDays clears the value of the "recycle_bin_retention_days" field.
+func (u *TenantUpsertOne) ClearRecycleBinRetentionDays() *TenantUpsertOne {
+	return u.Update(func(s *TenantUpsert) {
+		s.ClearRecycleBinRetentionDays()
+	})
+}
+
 // Exec executes the query.
 func (u *TenantUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -2038,6 +2298,97 @@ func (u *TenantUpsertBulk) ClearExpiredAt() *TenantUpsertBulk {
 	})
 }
 
+// SetHighRiskLoginAction sets the "high_risk_login_action" field.
+func (u *TenantUpsertBulk) SetHighRiskLoginAction(v tenant.HighRiskLoginAction) *TenantUpsertBulk {
+	return u.Update(func(s *TenantUpsert) {
+		s.SetHighRiskLoginAction(v)
+	})
+}
+
+// UpdateHighRiskLoginAction sets the "high_risk_login_action" field to the value that was provided on create.
+func (u *TenantUpsertBulk) UpdateHighRiskLoginAction() *TenantUpsertBulk {
+	return u.Update(func(s *TenantUpsert) {
+		s.UpdateHighRiskLoginAction()
+	})
+}
+
+// ClearHighRiskLoginAction clears the value of the "high_risk_login_action" field.
+func (u *TenantUpsertBulk) ClearHighRiskLoginAction() *TenantUpsertBulk {
+	return u.Update(func(s *TenantUpsert) {
+		s.ClearHighRiskLoginAction()
+	})
+}
+
+// SetStorageProvider sets the "storage_provider" field.
+func (u *TenantUpsertBulk) SetStorageProvider(v tenant.StorageProvider) *TenantUpsertBulk {
+	return u.Update(func(s *TenantUpsert) {
+		s.SetStorageProvider(v)
+	})
+}
+
+// UpdateStorageProvider sets the "storage_provider" field to the value that was provided on create.
+func (u *TenantUpsertBulk) UpdateStorageProvider() *TenantUpsertBulk {
+	return u.Update(func(s *TenantUpsert) {
+		s.UpdateStorageProvider()
+	})
+}
+
+// ClearStorageProvider clears the value of the "storage_provider" field.
+func (u *TenantUpsertBulk) ClearStorageProvider() *TenantUpsertBulk {
+	return u.Update(func(s *TenantUpsert) {
+		s.ClearStorageProvider()
+	})
+}
+
+// SetFileDedupPolicy sets the "file_dedup_policy" field.
+func (u *TenantUpsertBulk) SetFileDedupPolicy(v tenant.FileDedupPolicy) *TenantUpsertBulk {
+	return u.Update(func(s *TenantUpsert) {
+		s.SetFileDedupPolicy(v)
+	})
+}
+
+// UpdateFileDedupPolicy sets the "file_dedup_policy" field to the value that was provided on create.
+func (u *TenantUpsertBulk) UpdateFileDedupPolicy() *TenantUpsertBulk {
+	return u.Update(func(s *TenantUpsert) {
+		s.UpdateFileDedupPolicy()
+	})
+}
+
+// ClearFileDedupPolicy clears the value of the "file_dedup_policy" field.
+func (u *TenantUpsertBulk) ClearFileDedupPolicy() *TenantUpsertBulk {
+	return u.Update(func(s *TenantUpsert) {
+		s.ClearFileDedupPolicy()
+	})
+}
+
+// SetRecycleBinRetentionDays sets the "recycle_bin_retention_days" field.
+func (u *TenantUpsertBulk) SetRecycleBinRetentionDays(v uint32) *TenantUpsertBulk {
+	return u.Update(func(s *TenantUpsert) {
+		s.SetRecycleBinRetentionDays(v)
+	})
+}
+
+// AddRecycleBinRetentionDays adds v to the "recycle_bin_retention_days" field.
+func (u *TenantUpsertBulk) AddRecycleBinRetentionDays(v uint32) *TenantUpsertBulk {
+	return u.Update(func(s *TenantUpsert) {
+		s.AddRecycleBinRetentionDays(v)
+	})
+}
+
+// UpdateRecycleBinRetentionDays sets the "recycle_bin_retention_days" field to the value that was provided on create.
+func (u *TenantUpsertBulk) UpdateRecycleBinRetentionDays() *TenantUpsertBulk {
+	return u.Update(func(s *TenantUpsert) {
+		s.UpdateRecycleBinRetentionDays()
+	})
+}
+
+// ClearRecycleBinRetentionDays clears the value of the "recycle_bin_retention_days" field.
+func (u *TenantUpsertBulk) ClearRecycleBinRetentionDays() *TenantUpsertBulk {
+	return u.Update(func(s *TenantUpsert) {
+		s.ClearRecycleBinRetentionDays()
+	})
+}
+
 // Exec executes the query.
 func (u *TenantUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
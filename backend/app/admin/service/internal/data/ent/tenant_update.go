@@ -437,6 +437,93 @@ func (_u *TenantUpdate) ClearExpiredAt() *TenantUpdate {
 	return _u
 }
 
+// SetHighRiskLoginAction sets the "high_risk_login_action" field.
+func (_u *TenantUpdate) SetHighRiskLoginAction(v tenant.HighRiskLoginAction) *TenantUpdate {
+	_u.mutation.SetHighRiskLoginAction(v)
+	return _u
+}
+
+// SetNillableHighRiskLoginAction sets the "high_risk_login_action" field if the given value is not nil.
+func (_u *TenantUpdate) SetNillableHighRiskLoginAction(v *tenant.HighRiskLoginAction) *TenantUpdate {
+	if v != nil {
+		_u.SetHighRiskLoginAction(*v)
+	}
+	return _u
+}
+
+// ClearHighRiskLoginAction clears the value of the "high_risk_login_action" field.
+func (_u *TenantUpdate) ClearHighRiskLoginAction() *TenantUpdate {
+	_u.mutation.ClearHighRiskLoginAction()
+	return _u
+}
+
+// SetStorageProvider sets the "storage_provider" field.
+func (_u *TenantUpdate) SetStorageProvider(v tenant.StorageProvider) *TenantUpdate {
+	_u.mutation.SetStorageProvider(v)
+	return _u
+}
+
+// SetNillableStorageProvider sets the "storage_provider" field if the given value is not nil.
+func (_u *TenantUpdate) SetNillableStorageProvider(v *tenant.StorageProvider) *TenantUpdate {
+	if v != nil {
+		_u.SetStorageProvider(*v)
+	}
+	return _u
+}
+
+// ClearStorageProvider clears the value of the "storage_provider" field.
+func (_u *TenantUpdate) ClearStorageProvider() *TenantUpdate {
+	_u.mutation.ClearStorageProvider()
+	return _u
+}
+
+// SetFileDedupPolicy sets the "file_dedup_policy" field.
+func (_u *TenantUpdate) SetFileDedupPolicy(v tenant.FileDedupPolicy) *TenantUpdate {
+	_u.mutation.SetFileDedupPolicy(v)
+	return _u
+}
+
+// SetNillableFileDedupPolicy sets the "file_dedup_policy" field if the given value is not nil.
+func (_u *TenantUpdate) SetNillableFileDedupPolicy(v *tenant.FileDedupPolicy) *TenantUpdate {
+	if v != nil {
+		_u.SetFileDedupPolicy(*v)
+	}
+	return _u
+}
+
+// ClearFileDedupPolicy clears the value of the "file_dedup_policy" field.
+func (_u *TenantUpdate) ClearFileDedupPolicy() *TenantUpdate {
+	_u.mutation.ClearFileDedupPolicy()
+	return _u
+}
+
+// SetRecycleBinRetentionDays sets the "recycle_bin_retention_days" field.
+func (_u *TenantUpdate) SetRecycleBinRetentionDays(v uint32) *TenantUpdate {
+	_u.mutation.ResetRecycleBinRetentionDays()
+	_u.mutation.SetRecycleBinRetentionDays(v)
+	return _u
+}
+
+// SetNillableRecycleBinRetentionDays sets the "recycle_bin_retention_days" field if the given value is not nil.
+func (_u *TenantUpdate) SetNillableRecycleBinRetentionDays(v *uint32) *TenantUpdate {
+	if v != nil {
+		_u.SetRecycleBinRetentionDays(*v)
+	}
+	return _u
+}
+
+// AddRecycleBinRetentionDays adds value to the "recycle_bin_retention_days" field.
+func (_u *TenantUpdate) AddRecycleBinRetentionDays(v int32) *TenantUpdate {
+	_u.mutation.AddRecycleBinRetentionDays(v)
+	return _u
+}
+
+// ClearRecycleBinRetentionDays clears the value of the "recycle_bin_retention_days" field.
+func (_u *TenantUpdate) ClearRecycleBinRetentionDays() *TenantUpdate {
+	_u.mutation.ClearRecycleBinRetentionDays()
+	return _u
+}
+
 // Mutation returns the TenantMutation object of the builder.
 func (_u *TenantUpdate) Mutation() *TenantMutation {
 	return _u.mutation
@@ -496,6 +583,21 @@ func (_u *TenantUpdate) check() error {
 			return &ValidationError{Name: "audit_status", err: fmt.Errorf(`ent: validator failed for field "Tenant.audit_status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.HighRiskLoginAction(); ok {
+		if err := tenant.HighRiskLoginActionValidator(v); err != nil {
+			return &ValidationError{Name: "high_risk_login_action", err: fmt.Errorf(`ent: validator failed for field "Tenant.high_risk_login_action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StorageProvider(); ok {
+		if err := tenant.StorageProviderValidator(v); err != nil {
+			return &ValidationError{Name: "storage_provider", err: fmt.Errorf(`ent: validator failed for field "Tenant.storage_provider": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FileDedupPolicy(); ok {
+		if err := tenant.FileDedupPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "file_dedup_policy", err: fmt.Errorf(`ent: validator failed for field "Tenant.file_dedup_policy": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -646,6 +748,33 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.ExpiredAtCleared() {
 		_spec.ClearField(tenant.FieldExpiredAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.HighRiskLoginAction(); ok {
+		_spec.SetField(tenant.FieldHighRiskLoginAction, field.TypeEnum, value)
+	}
+	if _u.mutation.HighRiskLoginActionCleared() {
+		_spec.ClearField(tenant.FieldHighRiskLoginAction, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.StorageProvider(); ok {
+		_spec.SetField(tenant.FieldStorageProvider, field.TypeEnum, value)
+	}
+	if _u.mutation.StorageProviderCleared() {
+		_spec.ClearField(tenant.FieldStorageProvider, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.FileDedupPolicy(); ok {
+		_spec.SetField(tenant.FieldFileDedupPolicy, field.TypeEnum, value)
+	}
+	if _u.mutation.FileDedupPolicyCleared() {
+		_spec.ClearField(tenant.FieldFileDedupPolicy, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.RecycleBinRetentionDays(); ok {
+		_spec.SetField(tenant.FieldRecycleBinRetentionDays, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedRecycleBinRetentionDays(); ok {
+		_spec.AddField(tenant.FieldRecycleBinRetentionDays, field.TypeUint32, value)
+	}
+	if _u.mutation.RecycleBinRetentionDaysCleared() {
+		_spec.ClearField(tenant.FieldRecycleBinRetentionDays, field.TypeUint32)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -1076,6 +1205,93 @@ func (_u *TenantUpdateOne) ClearExpiredAt() *TenantUpdateOne {
 	return _u
 }
 
+// SetHighRiskLoginAction sets the "high_risk_login_action" field.
+func (_u *TenantUpdateOne) SetHighRiskLoginAction(v tenant.HighRiskLoginAction) *TenantUpdateOne {
+	_u.mutation.SetHighRiskLoginAction(v)
+	return _u
+}
+
+// SetNillableHighRiskLoginAction sets the "high_risk_login_action" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableHighRiskLoginAction(v *tenant.HighRiskLoginAction) *TenantUpdateOne {
+	if v != nil {
+		_u.SetHighRiskLoginAction(*v)
+	}
+	return _u
+}
+
+// ClearHighRiskLoginAction clears the value of the "high_risk_login_action" field.
+func (_u *TenantUpdateOne) ClearHighRiskLoginAction() *TenantUpdateOne {
+	_u.mutation.ClearHighRiskLoginAction()
+	return _u
+}
+
+// SetStorageProvider sets the "storage_provider" field.
+func (_u *TenantUpdateOne) SetStorageProvider(v tenant.StorageProvider) *TenantUpdateOne {
+	_u.mutation.SetStorageProvider(v)
+	return _u
+}
+
+// SetNillableStorageProvider sets the "storage_provider" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableStorageProvider(v *tenant.StorageProvider) *TenantUpdateOne {
+	if v != nil {
+		_u.SetStorageProvider(*v)
+	}
+	return _u
+}
+
+// ClearStorageProvider clears the value of the "storage_provider" field.
+func (_u *TenantUpdateOne) ClearStorageProvider() *TenantUpdateOne {
+	_u.mutation.ClearStorageProvider()
+	return _u
+}
+
+// SetFileDedupPolicy sets the "file_dedup_policy" field.
+func (_u *TenantUpdateOne) SetFileDedupPolicy(v tenant.FileDedupPolicy) *TenantUpdateOne {
+	_u.mutation.SetFileDedupPolicy(v)
+	return _u
+}
+
+// SetNillableFileDedupPolicy sets the "file_dedup_policy" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableFileDedupPolicy(v *tenant.FileDedupPolicy) *TenantUpdateOne {
+	if v != nil {
+		_u.SetFileDedupPolicy(*v)
+	}
+	return _u
+}
+
+// ClearFileDedupPolicy clears the value of the "file_dedup_policy" field.
+func (_u *TenantUpdateOne) ClearFileDedupPolicy() *TenantUpdateOne {
+	_u.mutation.ClearFileDedupPolicy()
+	return _u
+}
+
+// SetRecycleBinRetentionDays sets the "recycle_bin_retention_days" field.
+func (_u *TenantUpdateOne) SetRecycleBinRetentionDays(v uint32) *TenantUpdateOne {
+	_u.mutation.ResetRecycleBinRetentionDays()
+	_u.mutation.SetRecycleBinRetentionDays(v)
+	return _u
+}
+
+// SetNillableRecycleBinRetentionDays sets the "recycle_bin_retention_days" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableRecycleBinRetentionDays(v *uint32) *TenantUpdateOne {
+	if v != nil {
+		_u.SetRecycleBinRetentionDays(*v)
+	}
+	return _u
+}
+
+// AddRecycleBinRetentionDays adds value to the "recycle_bin_retention_days" field.
+func (_u *TenantUpdateOne) AddRecycleBinRetentionDays(v int32) *TenantUpdateOne {
+	_u.mutation.AddRecycleBinRetentionDays(v)
+	return _u
+}
+
+// ClearRecycleBinRetentionDays clears the value of the "recycle_bin_retention_days" field.
+func (_u *TenantUpdateOne) ClearRecycleBinRetentionDays() *TenantUpdateOne {
+	_u.mutation.ClearRecycleBinRetentionDays()
+	return _u
+}
+
 // Mutation returns the TenantMutation object of the builder.
 func (_u *TenantUpdateOne) Mutation() *TenantMutation {
 	return _u.mutation
@@ -1148,6 +1364,21 @@ func (_u *TenantUpdateOne) check() error {
 			return &ValidationError{Name: "audit_status", err: fmt.Errorf(`ent: validator failed for field "Tenant.audit_status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.HighRiskLoginAction(); ok {
+		if err := tenant.HighRiskLoginActionValidator(v); err != nil {
+			return &ValidationError{Name: "high_risk_login_action", err: fmt.Errorf(`ent: validator failed for field "Tenant.high_risk_login_action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StorageProvider(); ok {
+		if err := tenant.StorageProviderValidator(v); err != nil {
+			return &ValidationError{Name: "storage_provider", err: fmt.Errorf(`ent: validator failed for field "Tenant.storage_provider": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FileDedupPolicy(); ok {
+		if err := tenant.FileDedupPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "file_dedup_policy", err: fmt.Errorf(`ent: validator failed for field "Tenant.file_dedup_policy": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1315,6 +1546,33 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 	if _u.mutation.ExpiredAtCleared() {
 		_spec.ClearField(tenant.FieldExpiredAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.HighRiskLoginAction(); ok {
+		_spec.SetField(tenant.FieldHighRiskLoginAction, field.TypeEnum, value)
+	}
+	if _u.mutation.HighRiskLoginActionCleared() {
+		_spec.ClearField(tenant.FieldHighRiskLoginAction, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.StorageProvider(); ok {
+		_spec.SetField(tenant.FieldStorageProvider, field.TypeEnum, value)
+	}
+	if _u.mutation.StorageProviderCleared() {
+		_spec.ClearField(tenant.FieldStorageProvider, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.FileDedupPolicy(); ok {
+		_spec.SetField(tenant.FieldFileDedupPolicy, field.TypeEnum, value)
+	}
+	if _u.mutation.FileDedupPolicyCleared() {
+		_spec.ClearField(tenant.FieldFileDedupPolicy, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.RecycleBinRetentionDays(); ok {
+		_spec.SetField(tenant.FieldRecycleBinRetentionDays, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedRecycleBinRetentionDays(); ok {
+		_spec.AddField(tenant.FieldRecycleBinRetentionDays, field.TypeUint32, value)
+	}
+	if _u.mutation.RecycleBinRetentionDaysCleared() {
+		_spec.ClearField(tenant.FieldRecycleBinRetentionDays, field.TypeUint32)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &Tenant{config: _u.config}
 	_spec.Assign = _node.assignValues
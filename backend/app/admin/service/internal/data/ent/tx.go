@@ -16,6 +16,12 @@ type Tx struct {
 	Api *APIClient
 	// ApiAuditLog is the client for interacting with the ApiAuditLog builders.
 	ApiAuditLog *ApiAuditLogClient
+	// AuditForwarder is the client for interacting with the AuditForwarder builders.
+	AuditForwarder *AuditForwarderClient
+	// AuditLogArchive is the client for interacting with the AuditLogArchive builders.
+	AuditLogArchive *AuditLogArchiveClient
+	// AuditLogRetentionPolicy is the client for interacting with the AuditLogRetentionPolicy builders.
+	AuditLogRetentionPolicy *AuditLogRetentionPolicyClient
 	// DataAccessAuditLog is the client for interacting with the DataAccessAuditLog builders.
 	DataAccessAuditLog *DataAccessAuditLogClient
 	// DictEntry is the client for interacting with the DictEntry builders.
@@ -26,6 +32,8 @@ type Tx struct {
 	DictType *DictTypeClient
 	// File is the client for interacting with the File builders.
 	File *FileClient
+	// FileShareLink is the client for interacting with the FileShareLink builders.
+	FileShareLink *FileShareLinkClient
 	// InternalMessage is the client for interacting with the InternalMessage builders.
 	InternalMessage *InternalMessageClient
 	// InternalMessageCategory is the client for interacting with the InternalMessageCategory builders.
@@ -38,6 +46,10 @@ type Tx struct {
 	LoginAuditLog *LoginAuditLogClient
 	// LoginPolicy is the client for interacting with the LoginPolicy builders.
 	LoginPolicy *LoginPolicyClient
+	// LuaScript is the client for interacting with the LuaScript builders.
+	LuaScript *LuaScriptClient
+	// LuaScriptVersion is the client for interacting with the LuaScriptVersion builders.
+	LuaScriptVersion *LuaScriptVersionClient
 	// Membership is the client for interacting with the Membership builders.
 	Membership *MembershipClient
 	// MembershipOrgUnit is the client for interacting with the MembershipOrgUnit builders.
@@ -68,14 +80,26 @@ type Tx struct {
 	PolicyEvaluationLog *PolicyEvaluationLogClient
 	// Position is the client for interacting with the Position builders.
 	Position *PositionClient
+	// RestoredAuditLog is the client for interacting with the RestoredAuditLog builders.
+	RestoredAuditLog *RestoredAuditLogClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
 	// RoleMetadata is the client for interacting with the RoleMetadata builders.
 	RoleMetadata *RoleMetadataClient
 	// RolePermission is the client for interacting with the RolePermission builders.
 	RolePermission *RolePermissionClient
+	// StorageObject is the client for interacting with the StorageObject builders.
+	StorageObject *StorageObjectClient
+	// StorageQuota is the client for interacting with the StorageQuota builders.
+	StorageQuota *StorageQuotaClient
 	// Task is the client for interacting with the Task builders.
 	Task *TaskClient
+	// TaskRun is the client for interacting with the TaskRun builders.
+	TaskRun *TaskRunClient
+	// TaskWorkflow is the client for interacting with the TaskWorkflow builders.
+	TaskWorkflow *TaskWorkflowClient
+	// TaskWorkflowRun is the client for interacting with the TaskWorkflowRun builders.
+	TaskWorkflowRun *TaskWorkflowRunClient
 	// Tenant is the client for interacting with the Tenant builders.
 	Tenant *TenantClient
 	// User is the client for interacting with the User builders.
@@ -221,17 +245,23 @@ func (tx *Tx) Client() *Client {
 func (tx *Tx) init() {
 	tx.Api = NewAPIClient(tx.config)
 	tx.ApiAuditLog = NewApiAuditLogClient(tx.config)
+	tx.AuditForwarder = NewAuditForwarderClient(tx.config)
+	tx.AuditLogArchive = NewAuditLogArchiveClient(tx.config)
+	tx.AuditLogRetentionPolicy = NewAuditLogRetentionPolicyClient(tx.config)
 	tx.DataAccessAuditLog = NewDataAccessAuditLogClient(tx.config)
 	tx.DictEntry = NewDictEntryClient(tx.config)
 	tx.DictEntryI18n = NewDictEntryI18nClient(tx.config)
 	tx.DictType = NewDictTypeClient(tx.config)
 	tx.File = NewFileClient(tx.config)
+	tx.FileShareLink = NewFileShareLinkClient(tx.config)
 	tx.InternalMessage = NewInternalMessageClient(tx.config)
 	tx.InternalMessageCategory = NewInternalMessageCategoryClient(tx.config)
 	tx.InternalMessageRecipient = NewInternalMessageRecipientClient(tx.config)
 	tx.Language = NewLanguageClient(tx.config)
 	tx.LoginAuditLog = NewLoginAuditLogClient(tx.config)
 	tx.LoginPolicy = NewLoginPolicyClient(tx.config)
+	tx.LuaScript = NewLuaScriptClient(tx.config)
+	tx.LuaScriptVersion = NewLuaScriptVersionClient(tx.config)
 	tx.Membership = NewMembershipClient(tx.config)
 	tx.MembershipOrgUnit = NewMembershipOrgUnitClient(tx.config)
 	tx.MembershipPosition = NewMembershipPositionClient(tx.config)
@@ -247,10 +277,16 @@ func (tx *Tx) init() {
 	tx.PermissionPolicy = NewPermissionPolicyClient(tx.config)
 	tx.PolicyEvaluationLog = NewPolicyEvaluationLogClient(tx.config)
 	tx.Position = NewPositionClient(tx.config)
+	tx.RestoredAuditLog = NewRestoredAuditLogClient(tx.config)
 	tx.Role = NewRoleClient(tx.config)
 	tx.RoleMetadata = NewRoleMetadataClient(tx.config)
 	tx.RolePermission = NewRolePermissionClient(tx.config)
+	tx.StorageObject = NewStorageObjectClient(tx.config)
+	tx.StorageQuota = NewStorageQuotaClient(tx.config)
 	tx.Task = NewTaskClient(tx.config)
+	tx.TaskRun = NewTaskRunClient(tx.config)
+	tx.TaskWorkflow = NewTaskWorkflowClient(tx.config)
+	tx.TaskWorkflowRun = NewTaskWorkflowRunClient(tx.config)
 	tx.Tenant = NewTenantClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 	tx.UserCredential = NewUserCredentialClient(tx.config)
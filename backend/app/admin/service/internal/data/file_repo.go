@@ -2,8 +2,12 @@ package data
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"math"
+	"path"
 	"strings"
 	"time"
 
@@ -23,14 +27,23 @@ import (
 	"github.com/tx7do/go-utils/mapper"
 
 	storageV1 "go-wind-admin/api/gen/go/storage/service/v1"
+
+	appViewer "go-wind-admin/pkg/entgo/viewer"
 )
 
+// createFileRetries 并发上传同一文件的新版本冲突时创建文件记录的尝试次数
+const createFileRetries = 3
+
+// errLatestVersionChanged 创建新版本期间当前版本被并发修改，需要重新创建
+var errLatestVersionChanged = errors.New("latest file version changed")
+
 type FileRepo struct {
 	entClient *entCrud.EntClient[*ent.Client]
 	log       *log.Helper
 
-	mapper            *mapper.CopierMapper[storageV1.File, ent.File]
-	providerConverter *mapper.EnumTypeConverter[storageV1.OSSProvider, file.Provider]
+	mapper              *mapper.CopierMapper[storageV1.File, ent.File]
+	providerConverter   *mapper.EnumTypeConverter[storageV1.OSSProvider, file.Provider]
+	scanStatusConverter *mapper.EnumTypeConverter[storageV1.File_ScanStatus, file.ScanStatus]
 
 	repository *entCrud.Repository[
 		ent.FileQuery, ent.FileSelect,
@@ -48,6 +61,9 @@ func NewFileRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Clien
 		entClient:         entClient,
 		mapper:            mapper.NewCopierMapper[storageV1.File, ent.File](),
 		providerConverter: mapper.NewEnumTypeConverter[storageV1.OSSProvider, file.Provider](storageV1.OSSProvider_name, storageV1.OSSProvider_value),
+		scanStatusConverter: mapper.NewEnumTypeConverter[storageV1.File_ScanStatus, file.ScanStatus](
+			storageV1.File_ScanStatus_name, storageV1.File_ScanStatus_value,
+		),
 	}
 
 	repo.init()
@@ -69,6 +85,7 @@ func (r *FileRepo) init() {
 	r.mapper.AppendConverters(copierutil.NewTimeTimestamppbConverterPair())
 
 	r.mapper.AppendConverters(r.providerConverter.NewConverterPair())
+	r.mapper.AppendConverters(r.scanStatusConverter.NewConverterPair())
 }
 
 // formatSize 返回格式化后的文本，例如 "512B", "1.5KB"。
@@ -113,7 +130,38 @@ func (r *FileRepo) List(ctx context.Context, req *paginationV1.PagingRequest) (*
 		return nil, storageV1.ErrorBadRequest("invalid parameter")
 	}
 
-	builder := r.entClient.Client().File.Query()
+	// 只列出未删除文件的当前版本，历史版本和回收站中的文件分别查询
+	builder := r.entClient.Client().File.Query().
+		Where(
+			file.IsLatest(true),
+			file.DeletedAtIsNil(),
+		)
+
+	ret, err := r.repository.ListWithPaging(ctx, builder, builder.Clone(), req)
+	if err != nil {
+		return nil, err
+	}
+	if ret == nil {
+		return &storageV1.ListFileResponse{Total: 0, Items: nil}, nil
+	}
+
+	return &storageV1.ListFileResponse{
+		Total: ret.Total,
+		Items: ret.Items,
+	}, nil
+}
+
+// ListRecycleBin 查询回收站中的文件，每个文件只返回删除时的当前版本
+func (r *FileRepo) ListRecycleBin(ctx context.Context, req *paginationV1.PagingRequest) (*storageV1.ListFileResponse, error) {
+	if req == nil {
+		return nil, storageV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().File.Query().
+		Where(
+			file.IsLatest(true),
+			file.DeletedAtNotNil(),
+		)
 
 	ret, err := r.repository.ListWithPaging(ctx, builder, builder.Clone(), req)
 	if err != nil {
@@ -141,13 +189,21 @@ func (r *FileRepo) IsExist(ctx context.Context, id uint32) (bool, error) {
 }
 
 func (r *FileRepo) Get(ctx context.Context, req *storageV1.GetFileRequest) (*storageV1.File, error) {
+	return r.get(ctx, req)
+}
+
+// GetActive 查询未移入回收站的文件记录
+func (r *FileRepo) GetActive(ctx context.Context, req *storageV1.GetFileRequest) (*storageV1.File, error) {
+	return r.get(ctx, req, file.DeletedAtIsNil())
+}
+
+func (r *FileRepo) get(ctx context.Context, req *storageV1.GetFileRequest, whereCond ...func(s *sql.Selector)) (*storageV1.File, error) {
 	if req == nil {
 		return nil, storageV1.ErrorBadRequest("invalid parameter")
 	}
 
 	builder := r.entClient.Client().File.Query()
 
-	var whereCond []func(s *sql.Selector)
 	switch req.QueryBy.(type) {
 	default:
 	case *storageV1.GetFileRequest_Id:
@@ -162,43 +218,567 @@ func (r *FileRepo) Get(ctx context.Context, req *storageV1.GetFileRequest) (*sto
 	return dto, err
 }
 
-func (r *FileRepo) Create(ctx context.Context, req *storageV1.CreateFileRequest) error {
+// GetByObject 根据存储桶和对象名查询未删除的文件记录，不存在时返回 nil。
+// 去重后多个文件记录可能指向同一对象，tenantID 不为 0 时只查询该租户的文件记录。
+func (r *FileRepo) GetByObject(ctx context.Context, tenantID uint32, bucketName, objectName string) (*storageV1.File, error) {
+	builder := r.entClient.Client().File.Query().
+		Where(
+			objectPredicate(bucketName, objectName),
+			file.DeletedAtIsNil(),
+		)
+	if tenantID != 0 {
+		builder.Where(file.TenantIDEQ(tenantID))
+	}
+
+	entity, err := builder.Order(ent.Desc(file.FieldID)).First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("query file by object failed: %s", err.Error())
+		return nil, storageV1.ErrorInternalServerError("query file by object failed")
+	}
+
+	return r.mapper.ToDTO(entity), nil
+}
+
+// ObjectNameInUse 是否有文件记录（包括回收站中的文件和历史版本）指向该对象，跨租户查询
+func (r *FileRepo) ObjectNameInUse(ctx context.Context, bucketName, objectName string) (bool, error) {
+	exist, err := r.entClient.Client().File.Query().
+		Where(objectPredicate(bucketName, objectName)).
+		Exist(appViewer.NewSystemViewerContext(ctx))
+	if err != nil {
+		r.log.Errorf("query file by object failed: %s", err.Error())
+		return false, storageV1.ErrorInternalServerError("query file by object failed")
+	}
+	return exist, nil
+}
+
+// objectPredicate 按存储桶和对象名匹配文件记录，没有物理对象名的记录按文件目录和保存文件名匹配
+func objectPredicate(bucketName, objectName string) predicate.File {
+	objectName = strings.TrimPrefix(objectName, "/")
+
+	dir, saveFileName := path.Split(objectName)
+	dir = strings.TrimSuffix(dir, "/")
+
+	dirPredicate := file.FileDirectoryEQ(dir)
+	if dir == "" {
+		dirPredicate = file.Or(file.FileDirectoryIsNil(), file.FileDirectoryEQ(""))
+	}
+
+	return file.And(
+		file.BucketNameEQ(bucketName),
+		file.Or(
+			file.ObjectNameEQ(objectName),
+			file.And(
+				file.Or(file.ObjectNameIsNil(), file.ObjectNameEQ("")),
+				file.SaveFileNameEQ(saveFileName),
+				dirPredicate,
+			),
+		),
+	)
+}
+
+// FileScanResult 恶意文件扫描结果，QuarantineBucket 不为空时文件记录改为指向隔离区中的对象
+type FileScanResult struct {
+	Status    storageV1.File_ScanStatus
+	Signature string
+
+	QuarantineBucket string
+	QuarantineObject string
+}
+
+// UpdateScanResult 更新指向同一对象的全部文件记录的扫描结果（去重后多个文件记录共用一个对象），
+// 返回更新后的文件记录
+func (r *FileRepo) UpdateScanResult(
+	ctx context.Context,
+	provider storageV1.OSSProvider,
+	bucketName, objectName string,
+	result *FileScanResult,
+) ([]*storageV1.File, error) {
+	entityProvider := r.providerConverter.ToEntity(&provider)
+	if entityProvider == nil {
+		return nil, storageV1.ErrorBadRequest("unknown storage provider")
+	}
+	where := file.And(
+		file.ProviderEQ(*entityProvider),
+		objectPredicate(bucketName, objectName),
+	)
+
+	ids, err := r.entClient.Client().File.Query().
+		Where(where).
+		IDs(ctx)
+	if err != nil {
+		r.log.Errorf("query files by object failed: %s", err.Error())
+		return nil, storageV1.ErrorInternalServerError("query files by object failed")
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	builder := r.entClient.Client().File.Update().
+		Where(file.IDIn(ids...)).
+		SetNillableScanStatus(r.scanStatusConverter.ToEntity(&result.Status)).
+		SetScannedAt(time.Now())
+	if result.Signature != "" {
+		builder.SetScanSignature(result.Signature)
+	} else {
+		builder.ClearScanSignature()
+	}
+	if result.QuarantineBucket != "" {
+		// 只改变物理位置，文件目录和文件名不变
+		builder.
+			SetBucketName(result.QuarantineBucket).
+			SetObjectName(result.QuarantineObject).
+			ClearLinkURL()
+	}
+
+	if err = builder.Exec(ctx); err != nil {
+		r.log.Errorf("update file scan result failed: %s", err.Error())
+		return nil, storageV1.ErrorInternalServerError("update file scan result failed")
+	}
+
+	entities, err := r.entClient.Client().File.Query().
+		Where(file.IDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query files failed: %s", err.Error())
+		return nil, storageV1.ErrorInternalServerError("query files failed")
+	}
+
+	dtos := make([]*storageV1.File, 0, len(entities))
+	for _, entity := range entities {
+		dtos = append(dtos, r.mapper.ToDTO(entity))
+	}
+
+	return dtos, nil
+}
+
+// ListAfter 按ID顺序分批查询文件记录，tenantID 为空时查询全部租户（供对账任务使用）
+func (r *FileRepo) ListAfter(ctx context.Context, tenantID *uint32, afterID uint32, limit int) ([]*storageV1.File, error) {
+	builder := r.entClient.Client().File.Query().
+		Where(file.IDGT(afterID))
+	if tenantID != nil {
+		builder.Where(file.TenantIDEQ(*tenantID))
+	}
+
+	entities, err := builder.
+		Order(ent.Asc(file.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query files failed: %s", err.Error())
+		return nil, storageV1.ErrorInternalServerError("query files failed")
+	}
+
+	dtos := make([]*storageV1.File, 0, len(entities))
+	for _, entity := range entities {
+		dtos = append(dtos, r.mapper.ToDTO(entity))
+	}
+
+	return dtos, nil
+}
+
+// Create 创建文件记录。同一租户同一目录下已有同名文件时，新记录作为该文件的新版本成为当前版本，
+// 原当前版本保留为历史版本，各版本指向各自的对象
+func (r *FileRepo) Create(ctx context.Context, req *storageV1.CreateFileRequest) (*storageV1.File, error) {
 	if req == nil || req.Data == nil {
-		return storageV1.ErrorBadRequest("invalid parameter")
+		return nil, storageV1.ErrorBadRequest("invalid parameter")
 	}
 
 	if req.Data.Size != nil {
 		req.Data.SizeFormat = trans.Ptr(r.formatSize(int64(req.Data.GetSize())))
 	}
 
-	builder := r.entClient.Client().File.Create().
-		SetNillableTenantID(req.Data.TenantId).
-		SetNillableProvider(r.providerConverter.ToEntity(req.Data.Provider)).
-		SetNillableBucketName(req.Data.BucketName).
-		SetNillableFileDirectory(req.Data.FileDirectory).
-		SetNillableFileGUID(req.Data.FileGuid).
-		SetNillableSaveFileName(req.Data.SaveFileName).
-		SetNillableFileName(req.Data.FileName).
-		SetNillableExtension(req.Data.Extension).
-		SetNillableSize(req.Data.Size).
-		SetNillableSizeFormat(req.Data.SizeFormat).
-		SetNillableLinkURL(req.Data.LinkUrl).
-		SetNillableContentHash(req.Data.ContentHash).
-		SetNillableCreatedBy(req.Data.CreatedBy).
+	// 当前版本唯一键保证并发上传同一文件时只有一个成为当前版本，冲突的一方重新读取当前版本后重试
+	for attempt := 1; ; attempt++ {
+		dto, err := r.createVersion(ctx, req.Data)
+		if !errors.Is(err, errLatestVersionChanged) {
+			return dto, err
+		}
+		if attempt >= createFileRetries {
+			return nil, storageV1.ErrorConflict("file [%s] is being uploaded concurrently", req.Data.GetFileName())
+		}
+	}
+}
+
+// createVersion 在一个事务中把同名文件的当前版本降为历史版本并插入新版本
+func (r *FileRepo) createVersion(ctx context.Context, data *storageV1.File) (dto *storageV1.File, err error) {
+	var tx *ent.Tx
+	tx, err = r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return nil, storageV1.ErrorInternalServerError("start transaction failed")
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				r.log.Errorf("transaction rollback failed: %s", rollbackErr.Error())
+			}
+			return
+		}
+		if commitErr := tx.Commit(); commitErr != nil {
+			r.log.Errorf("transaction commit failed: %s", commitErr.Error())
+			dto, err = nil, storageV1.ErrorInternalServerError("transaction commit failed")
+		}
+	}()
+
+	builder := tx.File.Create().
+		SetNillableTenantID(data.TenantId).
+		SetNillableProvider(r.providerConverter.ToEntity(data.Provider)).
+		SetNillableBucketName(data.BucketName).
+		SetNillableFileDirectory(data.FileDirectory).
+		SetNillableFileGUID(data.FileGuid).
+		SetNillableSaveFileName(data.SaveFileName).
+		SetNillableFileName(data.FileName).
+		SetNillableExtension(data.Extension).
+		SetNillableSize(data.Size).
+		SetNillableSizeFormat(data.SizeFormat).
+		SetNillableLinkURL(data.LinkUrl).
+		SetNillableContentHash(data.ContentHash).
+		SetNillableStorageObjectID(data.StorageObjectId).
+		SetNillableObjectName(data.ObjectName).
+		SetNillableScanStatus(r.scanStatusConverter.ToEntity(data.ScanStatus)).
+		SetNillableCreatedBy(data.CreatedBy).
 		SetCreatedAt(time.Now())
 
-	if req.Data.Id != nil {
-		builder.SetID(req.GetData().GetId())
+	if data.Id != nil {
+		builder.SetID(data.GetId())
+	}
+	if key := latestVersionKey(data.GetTenantId(), data.GetFileDirectory(), data.GetFileName()); key != "" {
+		builder.SetLatestKey(key)
 	}
 
-	if err := builder.Exec(ctx); err != nil {
+	var latest *ent.File
+	if latest, err = r.queryLatestVersion(ctx, tx, data.GetTenantId(), data.GetFileDirectory(), data.GetFileName()); err != nil {
+		return nil, err
+	}
+	if latest != nil {
+		group := entityVersionGroup(latest)
+
+		// 只降级仍是当前版本的记录，已被并发上传降级时重试
+		var affected int
+		if affected, err = tx.File.Update().
+			Where(
+				file.IDEQ(latest.ID),
+				file.IsLatest(true),
+				file.DeletedAtIsNil(),
+			).
+			SetIsLatest(false).
+			ClearLatestKey().
+			SetVersionGroup(group).
+			Save(ctx); err != nil {
+			r.log.Errorf("update previous file version failed: %s", err.Error())
+			return nil, storageV1.ErrorInternalServerError("insert file failed")
+		}
+		if affected == 0 {
+			err = errLatestVersionChanged
+			return nil, err
+		}
+
+		// 恢复过旧版本后当前版本不一定是最大的版本号，新版本号取版本组内的最大值加一
+		var maxVersion []struct {
+			Max uint32 `json:"max"`
+		}
+		if err = tx.File.Query().
+			Where(file.VersionGroupEQ(group)).
+			Aggregate(ent.Max(file.FieldVersion)).
+			Scan(ctx, &maxVersion); err != nil {
+			r.log.Errorf("query file versions failed: %s", err.Error())
+			return nil, storageV1.ErrorInternalServerError("insert file failed")
+		}
+		version := latest.Version
+		if len(maxVersion) > 0 {
+			version = max(version, maxVersion[0].Max)
+		}
+
+		builder.
+			SetVersion(version + 1).
+			SetVersionGroup(group)
+	} else if data.GetFileGuid() != "" {
+		builder.SetVersionGroup(data.GetFileGuid())
+	}
+
+	var entity *ent.File
+	if entity, err = builder.Save(ctx); err != nil {
+		if ent.IsConstraintError(err) {
+			r.log.Warnf("insert file version conflicted, retrying: %s", err.Error())
+			err = errLatestVersionChanged
+			return nil, err
+		}
 		r.log.Errorf("insert file failed: %s", err.Error())
-		return storageV1.ErrorInternalServerError("insert file failed")
+		return nil, storageV1.ErrorInternalServerError("insert file failed")
+	}
+
+	return r.mapper.ToDTO(entity), nil
+}
+
+// queryLatestVersion 查询同一目录下同名文件未删除的当前版本，不存在或未指定文件名时返回 nil
+func (r *FileRepo) queryLatestVersion(ctx context.Context, tx *ent.Tx, tenantID uint32, fileDirectory, fileName string) (*ent.File, error) {
+	if fileName == "" {
+		return nil, nil
+	}
+
+	entity, err := tx.File.Query().
+		Where(
+			logicalFilePredicate(tenantID, fileDirectory, fileName),
+			file.IsLatest(true),
+			file.DeletedAtIsNil(),
+		).
+		Order(ent.Desc(file.FieldVersion), ent.Desc(file.FieldID)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("query latest file version failed: %s", err.Error())
+		return nil, storageV1.ErrorInternalServerError("query latest file version failed")
+	}
+
+	return entity, nil
+}
+
+// latestVersionKey 同一租户同一目录下同名文件当前版本的唯一键，未指定文件名时返回空
+func latestVersionKey(tenantID uint32, fileDirectory, fileName string) string {
+	if fileName == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%d\x00%s\x00%s", tenantID, fileDirectory, fileName)))
+	return hex.EncodeToString(sum[:])
+}
+
+// logicalFilePredicate 按租户、目录和原始文件名匹配同一文件的各个版本
+func logicalFilePredicate(tenantID uint32, fileDirectory, fileName string) predicate.File {
+	dirPredicate := file.FileDirectoryEQ(fileDirectory)
+	if fileDirectory == "" {
+		dirPredicate = file.Or(file.FileDirectoryIsNil(), file.FileDirectoryEQ(""))
+	}
+
+	return file.And(
+		file.TenantIDEQ(tenantID),
+		file.FileNameEQ(fileName),
+		dirPredicate,
+	)
+}
+
+// entityVersionGroup 文件的版本组标识，早期文件没有记录版本组时使用文件Guid或ID
+func entityVersionGroup(entity *ent.File) string {
+	switch {
+	case entity.VersionGroup != nil && *entity.VersionGroup != "":
+		return *entity.VersionGroup
+	case entity.FileGUID != nil && *entity.FileGUID != "":
+		return *entity.FileGUID
+	default:
+		return fmt.Sprintf("file:%d", entity.ID)
+	}
+}
+
+// versionGroupPredicate 匹配与文件同一版本组的全部版本，没有版本组的文件只有一个版本
+func versionGroupPredicate(f *storageV1.File) predicate.File {
+	if f.GetVersionGroup() != "" {
+		return file.VersionGroupEQ(f.GetVersionGroup())
+	}
+	return file.IDEQ(f.GetId())
+}
+
+// ListVersions 查询与文件同一版本组的全部版本，按版本号从新到旧排列
+func (r *FileRepo) ListVersions(ctx context.Context, f *storageV1.File) ([]*storageV1.File, error) {
+	entities, err := r.entClient.Client().File.Query().
+		Where(versionGroupPredicate(f)).
+		Order(ent.Desc(file.FieldVersion), ent.Desc(file.FieldID)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query file versions failed: %s", err.Error())
+		return nil, storageV1.ErrorInternalServerError("query file versions failed")
+	}
+
+	dtos := make([]*storageV1.File, 0, len(entities))
+	for _, entity := range entities {
+		dtos = append(dtos, r.mapper.ToDTO(entity))
+	}
+
+	return dtos, nil
+}
+
+// SetLatestVersion 把指定版本设为当前版本，其余版本保留为历史版本
+func (r *FileRepo) SetLatestVersion(ctx context.Context, f *storageV1.File, operatorID uint32) (err error) {
+	var tx *ent.Tx
+	tx, err = r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return storageV1.ErrorInternalServerError("start transaction failed")
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				r.log.Errorf("transaction rollback failed: %s", rollbackErr.Error())
+			}
+			return
+		}
+		if commitErr := tx.Commit(); commitErr != nil {
+			r.log.Errorf("transaction commit failed: %s", commitErr.Error())
+			err = storageV1.ErrorInternalServerError("transaction commit failed")
+		}
+	}()
+
+	if _, err = tx.File.Update().
+		Where(
+			versionGroupPredicate(f),
+			file.IsLatest(true),
+		).
+		SetIsLatest(false).
+		ClearLatestKey().
+		Save(ctx); err != nil {
+		r.log.Errorf("update file versions failed: %s", err.Error())
+		return storageV1.ErrorInternalServerError("restore file version failed")
+	}
+
+	builder := tx.File.UpdateOneID(f.GetId()).
+		SetIsLatest(true).
+		SetUpdatedBy(operatorID).
+		SetUpdatedAt(time.Now())
+	if key := latestVersionKey(f.GetTenantId(), f.GetFileDirectory(), f.GetFileName()); key != "" && f.DeletedAt == nil {
+		builder.SetLatestKey(key)
+	}
+	if err = builder.Exec(ctx); err != nil {
+		if ent.IsConstraintError(err) {
+			return storageV1.ErrorFileNameConflict("file [%s] already exists", f.GetFileName())
+		}
+		r.log.Errorf("update file version failed: %s", err.Error())
+		return storageV1.ErrorInternalServerError("restore file version failed")
 	}
 
 	return nil
 }
 
+// MoveToRecycleBin 把文件的全部版本移入回收站
+func (r *FileRepo) MoveToRecycleBin(ctx context.Context, f *storageV1.File, operatorID uint32) error {
+	if _, err := r.entClient.Client().File.Update().
+		Where(
+			versionGroupPredicate(f),
+			file.DeletedAtIsNil(),
+		).
+		SetDeletedAt(time.Now()).
+		SetDeletedBy(operatorID).
+		ClearLatestKey().
+		Save(ctx); err != nil {
+		r.log.Errorf("move file to recycle bin failed: %s", err.Error())
+		return storageV1.ErrorInternalServerError("delete file failed")
+	}
+	return nil
+}
+
+// RestoreFromRecycleBin 从回收站恢复文件的全部版本，同一目录下已有同名文件时不能恢复
+func (r *FileRepo) RestoreFromRecycleBin(ctx context.Context, f *storageV1.File, operatorID uint32) (err error) {
+	var tx *ent.Tx
+	tx, err = r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return storageV1.ErrorInternalServerError("start transaction failed")
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				r.log.Errorf("transaction rollback failed: %s", rollbackErr.Error())
+			}
+			return
+		}
+		if commitErr := tx.Commit(); commitErr != nil {
+			r.log.Errorf("transaction commit failed: %s", commitErr.Error())
+			err = storageV1.ErrorInternalServerError("transaction commit failed")
+		}
+	}()
+
+	var latest *ent.File
+	if latest, err = r.queryLatestVersion(ctx, tx, f.GetTenantId(), f.GetFileDirectory(), f.GetFileName()); err != nil {
+		return err
+	}
+	if latest != nil {
+		return storageV1.ErrorFileNameConflict("file [%s] already exists", f.GetFileName())
+	}
+
+	if _, err = tx.File.Update().
+		Where(
+			versionGroupPredicate(f),
+			file.DeletedAtNotNil(),
+		).
+		ClearDeletedAt().
+		ClearDeletedBy().
+		SetUpdatedBy(operatorID).
+		SetUpdatedAt(time.Now()).
+		Save(ctx); err != nil {
+		r.log.Errorf("restore file from recycle bin failed: %s", err.Error())
+		return storageV1.ErrorInternalServerError("restore file failed")
+	}
+
+	// 恢复后的当前版本重新占用当前版本唯一键，并发上传了同名文件时不能恢复
+	if key := latestVersionKey(f.GetTenantId(), f.GetFileDirectory(), f.GetFileName()); key != "" {
+		var restored *ent.File
+		if restored, err = tx.File.Query().
+			Where(
+				versionGroupPredicate(f),
+				file.IsLatest(true),
+			).
+			Order(ent.Desc(file.FieldVersion), ent.Desc(file.FieldID)).
+			First(ctx); err != nil {
+			r.log.Errorf("query restored file version failed: %s", err.Error())
+			return storageV1.ErrorInternalServerError("restore file failed")
+		}
+		if err = tx.File.UpdateOneID(restored.ID).
+			SetLatestKey(key).
+			Exec(ctx); err != nil {
+			if ent.IsConstraintError(err) {
+				return storageV1.ErrorFileNameConflict("file [%s] already exists", f.GetFileName())
+			}
+			r.log.Errorf("restore file from recycle bin failed: %s", err.Error())
+			return storageV1.ErrorInternalServerError("restore file failed")
+		}
+	}
+
+	return nil
+}
+
+// ListRecycleBinTenantIDs 查询回收站中有文件的租户
+func (r *FileRepo) ListRecycleBinTenantIDs(ctx context.Context) ([]uint32, error) {
+	var tenantIDs []uint32
+	if err := r.entClient.Client().File.Query().
+		Where(
+			file.IsLatest(true),
+			file.DeletedAtNotNil(),
+		).
+		Unique(true).
+		Select(file.FieldTenantID).
+		Scan(ctx, &tenantIDs); err != nil {
+		r.log.Errorf("query recycle bin tenants failed: %s", err.Error())
+		return nil, storageV1.ErrorInternalServerError("query recycle bin tenants failed")
+	}
+	return tenantIDs, nil
+}
+
+// ListRecycledBefore 查询租户回收站中在指定时间之前删除的文件（删除时的当前版本）
+func (r *FileRepo) ListRecycledBefore(ctx context.Context, tenantID uint32, before time.Time, limit int) ([]*storageV1.File, error) {
+	entities, err := r.entClient.Client().File.Query().
+		Where(
+			file.TenantIDEQ(tenantID),
+			file.IsLatest(true),
+			file.DeletedAtLT(before),
+		).
+		Order(ent.Asc(file.FieldDeletedAt), ent.Asc(file.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query recycled files failed: %s", err.Error())
+		return nil, storageV1.ErrorInternalServerError("query recycled files failed")
+	}
+
+	dtos := make([]*storageV1.File, 0, len(entities))
+	for _, entity := range entities {
+		dtos = append(dtos, r.mapper.ToDTO(entity))
+	}
+
+	return dtos, nil
+}
+
 func (r *FileRepo) Update(ctx context.Context, req *storageV1.UpdateFileRequest) error {
 	if req == nil || req.Data == nil {
 		return storageV1.ErrorBadRequest("invalid parameter")
@@ -221,7 +801,16 @@ func (r *FileRepo) Update(ctx context.Context, req *storageV1.UpdateFileRequest)
 			createReq := &storageV1.CreateFileRequest{Data: req.Data}
 			createReq.Data.CreatedBy = createReq.Data.UpdatedBy
 			createReq.Data.UpdatedBy = nil
-			return r.Create(ctx, createReq)
+			_, err = r.Create(ctx, createReq)
+			return err
+		}
+	}
+
+	// 修改当前版本的目录或文件名时，同一位置不能已有其他文件的当前版本
+	renamed := req.Data.FileDirectory != nil || req.Data.FileName != nil
+	if renamed {
+		if err := r.checkRenameConflict(ctx, req.GetId(), req.Data); err != nil {
+			return err
 		}
 	}
 
@@ -247,8 +836,86 @@ func (r *FileRepo) Update(ctx context.Context, req *storageV1.UpdateFileRequest)
 			s.Where(sql.EQ(file.FieldID, req.GetId()))
 		},
 	)
+	if err != nil || !renamed {
+		return err
+	}
+
+	return r.refreshLatestKey(ctx, req.GetId())
+}
+
+// checkRenameConflict 检查文件改名后的位置是否已有其他文件的当前版本
+func (r *FileRepo) checkRenameConflict(ctx context.Context, id uint32, data *storageV1.File) error {
+	entity, err := r.entClient.Client().File.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		r.log.Errorf("query file failed: %s", err.Error())
+		return storageV1.ErrorInternalServerError("query file failed")
+	}
+	current := r.mapper.ToDTO(entity)
+	if !current.GetIsLatest() || current.DeletedAt != nil {
+		return nil
+	}
+
+	fileDirectory := current.GetFileDirectory()
+	if data.FileDirectory != nil {
+		fileDirectory = data.GetFileDirectory()
+	}
+	fileName := current.GetFileName()
+	if data.FileName != nil {
+		fileName = data.GetFileName()
+	}
 
-	return err
+	key := latestVersionKey(current.GetTenantId(), fileDirectory, fileName)
+	if key == "" {
+		return nil
+	}
+
+	exist, err := r.entClient.Client().File.Query().
+		Where(
+			file.LatestKeyEQ(key),
+			file.IDNEQ(id),
+		).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("query latest file version failed: %s", err.Error())
+		return storageV1.ErrorInternalServerError("query latest file version failed")
+	}
+	if exist {
+		return storageV1.ErrorFileNameConflict("file [%s] already exists", fileName)
+	}
+
+	return nil
+}
+
+// refreshLatestKey 按文件当前的租户、目录和文件名重新计算当前版本唯一键
+func (r *FileRepo) refreshLatestKey(ctx context.Context, id uint32) error {
+	entity, err := r.entClient.Client().File.Get(ctx, id)
+	if err != nil {
+		r.log.Errorf("query file failed: %s", err.Error())
+		return storageV1.ErrorInternalServerError("query file failed")
+	}
+	f := r.mapper.ToDTO(entity)
+	if !f.GetIsLatest() || f.DeletedAt != nil {
+		return nil
+	}
+
+	builder := r.entClient.Client().File.UpdateOneID(id)
+	if key := latestVersionKey(f.GetTenantId(), f.GetFileDirectory(), f.GetFileName()); key != "" {
+		builder.SetLatestKey(key)
+	} else {
+		builder.ClearLatestKey()
+	}
+	if err = builder.Exec(ctx); err != nil {
+		if ent.IsConstraintError(err) {
+			return storageV1.ErrorFileNameConflict("file [%s] already exists", f.GetFileName())
+		}
+		r.log.Errorf("update file failed: %s", err.Error())
+		return storageV1.ErrorInternalServerError("update file failed")
+	}
+
+	return nil
 }
 
 func (r *FileRepo) Delete(ctx context.Context, req *storageV1.DeleteFileRequest) error {
@@ -0,0 +1,229 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tx7do/go-utils/trans"
+
+	"go-wind-admin/app/admin/service/internal/data/datatest"
+	"go-wind-admin/app/admin/service/internal/data/ent"
+
+	storageV1 "go-wind-admin/api/gen/go/storage/service/v1"
+
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+)
+
+func TestEntityVersionGroup(t *testing.T) {
+	assert.Equal(t, "group-1", entityVersionGroup(&ent.File{
+		ID:           1,
+		VersionGroup: trans.Ptr("group-1"),
+		FileGUID:     trans.Ptr("guid-1"),
+	}))
+
+	assert.Equal(t, "guid-1", entityVersionGroup(&ent.File{
+		ID:           1,
+		VersionGroup: trans.Ptr(""),
+		FileGUID:     trans.Ptr("guid-1"),
+	}))
+
+	assert.Equal(t, "file:7", entityVersionGroup(&ent.File{ID: 7}))
+}
+
+func TestFileRepo_GetByObject(t *testing.T) {
+	ctx := appViewer.NewSystemViewerContext(context.Background())
+	cli := datatest.NewEntClient(t)
+	repo := NewFileRepo(datatest.NewBootstrapContext(), cli)
+
+	// 去重后的文件：目录和文件名来自请求的键，物理对象是另一个上传的对象
+	deduped := cli.Client().File.Create().
+		SetTenantID(1).
+		SetBucketName("docs").
+		SetFileDirectory("reports").
+		SetSaveFileName("a.pdf").
+		SetFileName("a.pdf").
+		SetObjectName("shared/obj.pdf").
+		SaveX(ctx)
+	// 没有物理对象名的旧记录
+	legacy := cli.Client().File.Create().
+		SetTenantID(1).
+		SetBucketName("docs").
+		SetFileDirectory("legacy").
+		SetSaveFileName("b.pdf").
+		SetFileName("b.pdf").
+		SaveX(ctx)
+
+	f, err := repo.GetByObject(ctx, 1, "docs", "shared/obj.pdf")
+	require.NoError(t, err)
+	require.NotNil(t, f)
+	assert.Equal(t, deduped.ID, f.GetId())
+	assert.Equal(t, "reports", f.GetFileDirectory())
+
+	f, err = repo.GetByObject(ctx, 1, "docs", "reports/a.pdf")
+	require.NoError(t, err)
+	assert.Nil(t, f)
+
+	f, err = repo.GetByObject(ctx, 1, "docs", "legacy/b.pdf")
+	require.NoError(t, err)
+	require.NotNil(t, f)
+	assert.Equal(t, legacy.ID, f.GetId())
+
+	f, err = repo.GetByObject(ctx, 2, "docs", "shared/obj.pdf")
+	require.NoError(t, err)
+	assert.Nil(t, f)
+}
+
+func newTestFile(tenantID uint32, fileDirectory, fileName, objectName string) *storageV1.CreateFileRequest {
+	return &storageV1.CreateFileRequest{
+		Data: &storageV1.File{
+			TenantId:      trans.Ptr(tenantID),
+			Provider:      trans.Ptr(storageV1.OSSProvider_LOCAL),
+			BucketName:    trans.Ptr("docs"),
+			FileDirectory: trans.Ptr(fileDirectory),
+			FileName:      trans.Ptr(fileName),
+			ObjectName:    trans.Ptr(objectName),
+			FileGuid:      trans.Ptr(objectName),
+			Size:          trans.Ptr(uint64(1)),
+		},
+	}
+}
+
+func TestFileRepo_CreateVersions(t *testing.T) {
+	ctx := appViewer.NewSystemViewerContext(context.Background())
+	cli := datatest.NewEntClient(t)
+	repo := NewFileRepo(datatest.NewBootstrapContext(), cli)
+
+	v1, err := repo.Create(ctx, newTestFile(1, "reports", "a.pdf", "reports/1.pdf"))
+	require.NoError(t, err)
+	v2, err := repo.Create(ctx, newTestFile(1, "reports", "a.pdf", "reports/2.pdf"))
+	require.NoError(t, err)
+	v3, err := repo.Create(ctx, newTestFile(1, "reports", "a.pdf", "reports/3.pdf"))
+	require.NoError(t, err)
+
+	assert.Equal(t, uint32(3), v3.GetVersion())
+	assert.True(t, v3.GetIsLatest())
+	assert.Equal(t, v1.GetVersionGroup(), v3.GetVersionGroup())
+
+	versions, err := repo.ListVersions(ctx, v3)
+	require.NoError(t, err)
+	require.Len(t, versions, 3)
+	for _, v := range versions {
+		assert.Equal(t, v.GetId() == v3.GetId(), v.GetIsLatest())
+	}
+
+	// 其他目录、其他租户的同名文件是不同的文件
+	other, err := repo.Create(ctx, newTestFile(1, "archive", "a.pdf", "archive/1.pdf"))
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), other.GetVersion())
+	other, err = repo.Create(ctx, newTestFile(2, "reports", "a.pdf", "reports/4.pdf"))
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), other.GetVersion())
+
+	// 恢复旧版本后再上传，版本号继续递增
+	require.NoError(t, repo.SetLatestVersion(ctx, v2, 1))
+	latest, err := repo.Get(ctx, &storageV1.GetFileRequest{QueryBy: &storageV1.GetFileRequest_Id{Id: v2.GetId()}})
+	require.NoError(t, err)
+	assert.True(t, latest.GetIsLatest())
+
+	v4, err := repo.Create(ctx, newTestFile(1, "reports", "a.pdf", "reports/5.pdf"))
+	require.NoError(t, err)
+	assert.Equal(t, uint32(4), v4.GetVersion())
+
+	versions, err = repo.ListVersions(ctx, v4)
+	require.NoError(t, err)
+	require.Len(t, versions, 4)
+	var latestCount int
+	for _, v := range versions {
+		if v.GetIsLatest() {
+			latestCount++
+			assert.Equal(t, v4.GetId(), v.GetId())
+		}
+	}
+	assert.Equal(t, 1, latestCount)
+}
+
+func TestFileRepo_LatestKeyUnique(t *testing.T) {
+	ctx := appViewer.NewSystemViewerContext(context.Background())
+	cli := datatest.NewEntClient(t)
+	repo := NewFileRepo(datatest.NewBootstrapContext(), cli)
+
+	_, err := repo.Create(ctx, newTestFile(1, "", "a.pdf", "1.pdf"))
+	require.NoError(t, err)
+
+	// 并发的首次上传各自插入当前版本时，当前版本唯一键只允许一个成功
+	_, err = cli.Client().File.Create().
+		SetTenantID(1).
+		SetFileName("a.pdf").
+		SetLatestKey(latestVersionKey(1, "", "a.pdf")).
+		Save(ctx)
+	assert.True(t, ent.IsConstraintError(err))
+	_, err = cli.Client().File.Create().
+		SetTenantID(1).
+		SetFileName("b.pdf").
+		SetLatestKey(latestVersionKey(1, "", "b.pdf")).
+		Save(ctx)
+	assert.NoError(t, err)
+
+	// 没有目录和空目录是同一位置
+	assert.Equal(t, latestVersionKey(1, "", "a.pdf"), latestVersionKey(1, "", "a.pdf"))
+	assert.NotEqual(t, latestVersionKey(1, "", "a.pdf"), latestVersionKey(2, "", "a.pdf"))
+	assert.NotEqual(t, latestVersionKey(1, "a", "b.pdf"), latestVersionKey(1, "a\x00b", ".pdf"))
+	assert.Empty(t, latestVersionKey(1, "reports", ""))
+}
+
+func TestFileRepo_RecycleBin(t *testing.T) {
+	ctx := appViewer.NewSystemViewerContext(context.Background())
+	cli := datatest.NewEntClient(t)
+	repo := NewFileRepo(datatest.NewBootstrapContext(), cli)
+
+	_, err := repo.Create(ctx, newTestFile(1, "reports", "a.pdf", "reports/1.pdf"))
+	require.NoError(t, err)
+	v2, err := repo.Create(ctx, newTestFile(1, "reports", "a.pdf", "reports/2.pdf"))
+	require.NoError(t, err)
+
+	require.NoError(t, repo.MoveToRecycleBin(ctx, v2, 1))
+
+	_, err = repo.GetActive(ctx, &storageV1.GetFileRequest{QueryBy: &storageV1.GetFileRequest_Id{Id: v2.GetId()}})
+	assert.Error(t, err)
+	recycled, err := repo.Get(ctx, &storageV1.GetFileRequest{QueryBy: &storageV1.GetFileRequest_Id{Id: v2.GetId()}})
+	require.NoError(t, err)
+	assert.NotNil(t, recycled.DeletedAt)
+
+	// 回收站中的对象仍被占用，不能被新上传覆盖
+	inUse, err := repo.ObjectNameInUse(ctx, "docs", "reports/1.pdf")
+	require.NoError(t, err)
+	assert.True(t, inUse)
+
+	// 回收站中的文件不参与版本，同名上传是新文件
+	replacement, err := repo.Create(ctx, newTestFile(1, "reports", "a.pdf", "reports/3.pdf"))
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), replacement.GetVersion())
+
+	assert.True(t, storageV1.IsFileNameConflict(repo.RestoreFromRecycleBin(ctx, recycled, 1)))
+
+	// 超过保留期的文件按删除时的当前版本列出
+	files, err := repo.ListRecycledBefore(ctx, 1, time.Now().Add(time.Second), 10)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	assert.Equal(t, v2.GetId(), files[0].GetId())
+
+	files, err = repo.ListRecycledBefore(ctx, 1, time.Now().Add(-time.Hour), 10)
+	require.NoError(t, err)
+	assert.Empty(t, files)
+
+	// 删除同名文件后可以恢复，恢复的当前版本重新占用当前版本唯一键
+	require.NoError(t, repo.MoveToRecycleBin(ctx, replacement, 1))
+	require.NoError(t, repo.RestoreFromRecycleBin(ctx, recycled, 1))
+
+	restored, err := repo.GetActive(ctx, &storageV1.GetFileRequest{QueryBy: &storageV1.GetFileRequest_Id{Id: v2.GetId()}})
+	require.NoError(t, err)
+	assert.True(t, restored.GetIsLatest())
+
+	v3, err := repo.Create(ctx, newTestFile(1, "reports", "a.pdf", "reports/4.pdf"))
+	require.NoError(t, err)
+	assert.Equal(t, uint32(3), v3.GetVersion())
+	assert.Equal(t, v2.GetVersionGroup(), v3.GetVersionGroup())
+}
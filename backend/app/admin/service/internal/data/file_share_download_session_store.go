@@ -0,0 +1,123 @@
+package data
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	storageV1 "go-wind-admin/api/gen/go/storage/service/v1"
+)
+
+const (
+	// FileShareDownloadSessionKeyFormat 已计数的分享链接下载会话 file:share:download:{session}
+	FileShareDownloadSessionKeyFormat = "file:share:download:%s"
+)
+
+// ByteRange 已输出的字节范围，包含首尾
+type ByteRange struct {
+	Start int64
+	End   int64
+}
+
+// fileShareDownloadSession 下载会话：所属分享链接和本次下载已输出的字节范围
+type fileShareDownloadSession struct {
+	LinkID uint32     `json:"link_id"`
+	Ranges [][2]int64 `json:"ranges"`
+}
+
+// 会话属于该分享链接且请求的范围与已输出的范围都不重叠时记录该范围并返回 1，否则返回 0
+var continueDownloadScript = redis.NewScript(`
+local value = redis.call("GET", KEYS[1])
+if not value then
+	return 0
+end
+local session = cjson.decode(value)
+if tonumber(session.link_id) ~= tonumber(ARGV[1]) then
+	return 0
+end
+local first, last = tonumber(ARGV[2]), tonumber(ARGV[3])
+for _, r in ipairs(session.ranges) do
+	if first <= r[2] and r[1] <= last then
+		return 0
+	end
+end
+table.insert(session.ranges, {first, last})
+redis.call("SET", KEYS[1], cjson.encode(session), "KEEPTTL")
+return 1
+`)
+
+// FileShareDownloadSessionStore 保存已计入下载次数的分享链接下载会话。
+// 会话记录本次下载已输出的字节范围，同一会话内互不重叠的分段请求视为继续该次下载，
+// 请求已输出过的字节时按新的下载计数，会话被多个客户端共用时合计也只能取得一份完整文件
+type FileShareDownloadSessionStore struct {
+	log *log.Helper
+	rdb *redis.Client
+}
+
+func NewFileShareDownloadSessionStore(ctx *bootstrap.Context, rdb *redis.Client) *FileShareDownloadSessionStore {
+	return &FileShareDownloadSessionStore{
+		log: ctx.NewLoggerHelper("file-share-download-session/data/admin-service"),
+		rdb: rdb,
+	}
+}
+
+func (s *FileShareDownloadSessionStore) key(session string) string {
+	return fmt.Sprintf(FileShareDownloadSessionKeyFormat, session)
+}
+
+// Create 为分享链接一次已计数的下载创建会话，served 为本次请求输出的字节范围，返回会话令牌
+func (s *FileShareDownloadSessionStore) Create(ctx context.Context, linkID uint32, served ByteRange, ttl time.Duration) (string, error) {
+	if s.rdb == nil {
+		return "", storageV1.ErrorServiceUnavailable("redis is not configured")
+	}
+
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	session := base64.RawURLEncoding.EncodeToString(buf)
+
+	data, err := json.Marshal(&fileShareDownloadSession{
+		LinkID: linkID,
+		Ranges: [][2]int64{{served.Start, served.End}},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if err = s.rdb.Set(ctx, s.key(session), data, ttl).Err(); err != nil {
+		s.log.Errorf("save share link [%d] download session failed: %s", linkID, err.Error())
+		return "", storageV1.ErrorInternalServerError("save share download session failed")
+	}
+
+	return session, nil
+}
+
+// Continue 请求的范围是否继续该会话的下载：会话存在、属于该分享链接且范围与已输出的字节不重叠时
+// 原子地记录该范围并返回 true。Redis 不可用时返回 false，请求按新的下载计数
+func (s *FileShareDownloadSessionStore) Continue(ctx context.Context, session string, linkID uint32, requested ByteRange) bool {
+	if s.rdb == nil || session == "" {
+		return false
+	}
+
+	ok, err := continueDownloadScript.Run(ctx, s.rdb,
+		[]string{s.key(session)},
+		linkID, requested.Start, requested.End,
+	).Int()
+	if err != nil {
+		if !errors.Is(err, redis.Nil) {
+			s.log.Warnf("continue share download session failed: %s", err.Error())
+		}
+		return false
+	}
+
+	return ok == 1
+}
@@ -0,0 +1,44 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go-wind-admin/app/admin/service/internal/data/datatest"
+)
+
+func TestFileShareDownloadSessionStore(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+
+	ctx := context.Background()
+	store := NewFileShareDownloadSessionStore(datatest.NewBootstrapContext(), rdb)
+
+	session, err := store.Create(ctx, 1, ByteRange{Start: 0, End: 99}, time.Hour)
+	require.NoError(t, err)
+
+	// 互不重叠的分段继续同一次下载
+	assert.True(t, store.Continue(ctx, session, 1, ByteRange{Start: 100, End: 199}))
+	assert.True(t, store.Continue(ctx, session, 1, ByteRange{Start: 300, End: 399}))
+	assert.True(t, store.Continue(ctx, session, 1, ByteRange{Start: 200, End: 299}))
+
+	// 再次请求已输出的字节是新的下载
+	assert.False(t, store.Continue(ctx, session, 1, ByteRange{Start: 0, End: 0}))
+	assert.False(t, store.Continue(ctx, session, 1, ByteRange{Start: 350, End: 450}))
+
+	// 会话只属于创建它的分享链接
+	assert.False(t, store.Continue(ctx, session, 2, ByteRange{Start: 1000, End: 1099}))
+	assert.False(t, store.Continue(ctx, "unknown", 1, ByteRange{Start: 1000, End: 1099}))
+
+	// 记录范围不延长会话有效期
+	assert.Greater(t, mr.TTL(store.key(session)), time.Duration(0))
+	mr.FastForward(time.Hour)
+	assert.False(t, store.Continue(ctx, session, 1, ByteRange{Start: 1000, End: 1099}))
+}
@@ -0,0 +1,284 @@
+package data
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/go-utils/password"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/filesharelink"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+
+	"github.com/tx7do/go-utils/copierutil"
+	"github.com/tx7do/go-utils/mapper"
+
+	storageV1 "go-wind-admin/api/gen/go/storage/service/v1"
+)
+
+// fileShareTokenBytes 分享令牌的随机字节数
+const fileShareTokenBytes = 24
+
+type FileShareLinkRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+
+	passwordCrypto password.Crypto
+
+	mapper *mapper.CopierMapper[storageV1.FileShareLink, ent.FileShareLink]
+
+	repository *entCrud.Repository[
+		ent.FileShareLinkQuery, ent.FileShareLinkSelect,
+		ent.FileShareLinkCreate, ent.FileShareLinkCreateBulk,
+		ent.FileShareLinkUpdate, ent.FileShareLinkUpdateOne,
+		ent.FileShareLinkDelete,
+		predicate.FileShareLink,
+		storageV1.FileShareLink, ent.FileShareLink,
+	]
+}
+
+func NewFileShareLinkRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client], passwordCrypto password.Crypto) *FileShareLinkRepo {
+	repo := &FileShareLinkRepo{
+		log:            ctx.NewLoggerHelper("file-share-link/repo/admin-service"),
+		entClient:      entClient,
+		passwordCrypto: passwordCrypto,
+		mapper:         mapper.NewCopierMapper[storageV1.FileShareLink, ent.FileShareLink](),
+	}
+
+	repo.init()
+
+	return repo
+}
+
+func (r *FileShareLinkRepo) init() {
+	r.repository = entCrud.NewRepository[
+		ent.FileShareLinkQuery, ent.FileShareLinkSelect,
+		ent.FileShareLinkCreate, ent.FileShareLinkCreateBulk,
+		ent.FileShareLinkUpdate, ent.FileShareLinkUpdateOne,
+		ent.FileShareLinkDelete,
+		predicate.FileShareLink,
+		storageV1.FileShareLink, ent.FileShareLink,
+	](r.mapper)
+
+	r.mapper.AppendConverters(copierutil.NewTimeStringConverterPair())
+	r.mapper.AppendConverters(copierutil.NewTimeTimestamppbConverterPair())
+}
+
+// toDTO 转换为接口对象，密码哈希不对外返回
+func (r *FileShareLinkRepo) toDTO(entity *ent.FileShareLink) *storageV1.FileShareLink {
+	dto := r.mapper.ToDTO(entity)
+	dto.Password = nil
+	dto.HasPassword = trans.Ptr(entity.PasswordHash != nil && *entity.PasswordHash != "")
+	return dto
+}
+
+// List 查询分享链接列表，createdBy 不为空时只查询该用户创建的链接
+func (r *FileShareLinkRepo) List(ctx context.Context, req *paginationV1.PagingRequest, createdBy *uint32) (*storageV1.ListFileShareLinkResponse, error) {
+	if req == nil {
+		return nil, storageV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().FileShareLink.Query()
+	if createdBy != nil {
+		builder.Where(filesharelink.CreatedByEQ(*createdBy))
+	}
+
+	ret, err := r.repository.ListWithPaging(ctx, builder, builder.Clone(), req)
+	if err != nil {
+		return nil, err
+	}
+	if ret == nil {
+		return &storageV1.ListFileShareLinkResponse{Total: 0, Items: nil}, nil
+	}
+
+	for _, item := range ret.Items {
+		item.Password = nil
+	}
+
+	return &storageV1.ListFileShareLinkResponse{
+		Total: ret.Total,
+		Items: ret.Items,
+	}, nil
+}
+
+// Get 根据ID查询分享链接
+func (r *FileShareLinkRepo) Get(ctx context.Context, id uint32) (*storageV1.FileShareLink, error) {
+	entity, err := r.entClient.Client().FileShareLink.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, storageV1.ErrorNotFound("share link not found")
+		}
+		r.log.Errorf("query share link failed: %s", err.Error())
+		return nil, storageV1.ErrorInternalServerError("query share link failed")
+	}
+
+	return r.toDTO(entity), nil
+}
+
+// GetByToken 根据分享令牌查询分享链接
+func (r *FileShareLinkRepo) GetByToken(ctx context.Context, token string) (*storageV1.FileShareLink, error) {
+	if token == "" {
+		return nil, storageV1.ErrorNotFound("share link not found")
+	}
+
+	entity, err := r.entClient.Client().FileShareLink.Query().
+		Where(filesharelink.TokenEQ(token)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, storageV1.ErrorNotFound("share link not found")
+		}
+		r.log.Errorf("query share link failed: %s", err.Error())
+		return nil, storageV1.ErrorInternalServerError("query share link failed")
+	}
+
+	return r.toDTO(entity), nil
+}
+
+// Create 创建分享链接，生成随机令牌，访问密码以 bcrypt 哈希保存
+func (r *FileShareLinkRepo) Create(ctx context.Context, data *storageV1.FileShareLink) (*storageV1.FileShareLink, error) {
+	if data == nil {
+		return nil, storageV1.ErrorBadRequest("invalid parameter")
+	}
+
+	token, err := newFileShareToken()
+	if err != nil {
+		r.log.Errorf("generate share token failed: %s", err.Error())
+		return nil, storageV1.ErrorInternalServerError("generate share token failed")
+	}
+
+	builder := r.entClient.Client().FileShareLink.Create().
+		SetNillableTenantID(data.TenantId).
+		SetFileID(data.GetFileId()).
+		SetToken(token).
+		SetMaxDownloads(data.GetMaxDownloads()).
+		SetAllowedTenantIds(data.GetAllowedTenantIds()).
+		SetAllowedUserIds(data.GetAllowedUserIds()).
+		SetAllowedOrgUnitIds(data.GetAllowedOrgUnitIds()).
+		SetNillableCreatedBy(data.CreatedBy).
+		SetCreatedAt(time.Now())
+
+	if data.ExpiresAt != nil {
+		builder.SetExpiresAt(data.GetExpiresAt().AsTime())
+	}
+
+	if data.GetPassword() != "" {
+		var hash string
+		if hash, err = r.passwordCrypto.Encrypt(data.GetPassword()); err != nil {
+			r.log.Errorf("hash share link password failed: %s", err.Error())
+			return nil, storageV1.ErrorInternalServerError("hash share link password failed")
+		}
+		builder.SetPasswordHash(hash)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("insert share link failed: %s", err.Error())
+		return nil, storageV1.ErrorInternalServerError("insert share link failed")
+	}
+
+	return r.toDTO(entity), nil
+}
+
+// VerifyPassword 校验分享链接的访问密码，未设置密码时总是通过
+func (r *FileShareLinkRepo) VerifyPassword(ctx context.Context, id uint32, plain string) (bool, error) {
+	entity, err := r.entClient.Client().FileShareLink.Query().
+		Where(filesharelink.IDEQ(id)).
+		Select(filesharelink.FieldPasswordHash).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return false, storageV1.ErrorNotFound("share link not found")
+		}
+		r.log.Errorf("query share link password failed: %s", err.Error())
+		return false, storageV1.ErrorInternalServerError("query share link failed")
+	}
+
+	if entity.PasswordHash == nil || *entity.PasswordHash == "" {
+		return true, nil
+	}
+	if plain == "" {
+		return false, nil
+	}
+
+	ok, err := r.passwordCrypto.Verify(plain, *entity.PasswordHash)
+	if err != nil {
+		r.log.Errorf("verify share link password failed: %s", err.Error())
+		return false, nil
+	}
+	return ok, nil
+}
+
+// Revoke 撤销分享链接，已撤销的链接保持原撤销信息
+func (r *FileShareLinkRepo) Revoke(ctx context.Context, id uint32, operatorID uint32) error {
+	now := time.Now()
+	if _, err := r.entClient.Client().FileShareLink.Update().
+		Where(
+			filesharelink.IDEQ(id),
+			filesharelink.RevokedAtIsNil(),
+		).
+		SetRevokedAt(now).
+		SetRevokedBy(operatorID).
+		SetUpdatedBy(operatorID).
+		SetUpdatedAt(now).
+		Save(ctx); err != nil {
+		r.log.Errorf("revoke share link failed: %s", err.Error())
+		return storageV1.ErrorInternalServerError("revoke share link failed")
+	}
+	return nil
+}
+
+// RecordAccess 记录一次访问并返回链接是否仍然可用。
+// 计入下载次数时，只有未撤销、未过期且未达到下载次数上限的链接才会累加，
+// 并发访问时由数据库保证不会超过上限。
+func (r *FileShareLinkRepo) RecordAccess(ctx context.Context, id uint32, countDownload bool) (bool, error) {
+	now := time.Now()
+
+	builder := r.entClient.Client().FileShareLink.Update().
+		Where(
+			filesharelink.IDEQ(id),
+			filesharelink.RevokedAtIsNil(),
+			filesharelink.Or(
+				filesharelink.ExpiresAtIsNil(),
+				filesharelink.ExpiresAtGT(now),
+			),
+		).
+		SetLastAccessedAt(now)
+
+	if countDownload {
+		builder.
+			Where(func(s *sql.Selector) {
+				s.Where(sql.Or(
+					sql.EQ(s.C(filesharelink.FieldMaxDownloads), 0),
+					sql.ColumnsLT(s.C(filesharelink.FieldDownloadCount), s.C(filesharelink.FieldMaxDownloads)),
+				))
+			}).
+			AddDownloadCount(1)
+	}
+
+	affected, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("record share link access failed: %s", err.Error())
+		return false, storageV1.ErrorInternalServerError("record share link access failed")
+	}
+
+	return affected > 0, nil
+}
+
+// newFileShareToken 生成URL安全的随机分享令牌
+func newFileShareToken() (string, error) {
+	b := make([]byte, fileShareTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
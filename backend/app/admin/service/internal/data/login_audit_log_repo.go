@@ -26,6 +26,8 @@ type LoginAuditLogRepo struct {
 	entClient *entCrud.EntClient[*ent.Client]
 	log       *log.Helper
 
+	forwarder *AuditEventForwarder
+
 	mapper *mapper.CopierMapper[auditV1.LoginAuditLog, ent.LoginAuditLog]
 
 	statusConverter      *mapper.EnumTypeConverter[auditV1.LoginAuditLog_Status, loginauditlog.Status]
@@ -42,10 +44,15 @@ type LoginAuditLogRepo struct {
 	]
 }
 
-func NewLoginAuditLogRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *LoginAuditLogRepo {
+func NewLoginAuditLogRepo(
+	ctx *bootstrap.Context,
+	entClient *entCrud.EntClient[*ent.Client],
+	forwarder *AuditEventForwarder,
+) *LoginAuditLogRepo {
 	repo := &LoginAuditLogRepo{
 		log:       ctx.NewLoggerHelper("login-audit-log/repo/admin-service"),
 		entClient: entClient,
+		forwarder: forwarder,
 		mapper:    mapper.NewCopierMapper[auditV1.LoginAuditLog, ent.LoginAuditLog](),
 		statusConverter: mapper.NewEnumTypeConverter[auditV1.LoginAuditLog_Status, loginauditlog.Status](
 			auditV1.LoginAuditLog_Status_name, auditV1.LoginAuditLog_Status_value,
@@ -179,10 +186,114 @@ func (r *LoginAuditLogRepo) Create(ctx context.Context, req *auditV1.CreateLogin
 		SetSignature(req.Data.Signature).
 		SetCreatedAt(time.Now())
 
-	if err := builder.Exec(ctx); err != nil {
+	entity, err := builder.Save(ctx)
+	if err != nil {
 		r.log.Errorf("insert login audit log failed: %s", err.Error())
 		return adminV1.ErrorInternalServerError("insert login audit log failed")
 	}
 
+	// 转发到已配置的SIEM
+	if r.forwarder != nil {
+		r.forwarder.ForwardLoginAuditLog(r.mapper.ToDTO(entity))
+	}
+
 	return nil
 }
+
+// ListRecentSuccessLogins 查询用户最近的成功登录记录（按时间倒序），供登录风险引擎比对历史
+func (r *LoginAuditLogRepo) ListRecentSuccessLogins(ctx context.Context, tenantID uint32, username string, limit int) ([]*auditV1.LoginAuditLog, error) {
+	if username == "" {
+		return nil, nil
+	}
+
+	builder := r.entClient.Client().LoginAuditLog.Query().
+		Where(
+			loginauditlog.UsernameEQ(username),
+			loginauditlog.ActionTypeEQ(loginauditlog.ActionTypeLogin),
+			loginauditlog.StatusEQ(loginauditlog.StatusSuccess),
+		).
+		Order(ent.Desc(loginauditlog.FieldCreatedAt)).
+		Limit(limit)
+	if tenantID > 0 {
+		builder.Where(loginauditlog.TenantIDEQ(tenantID))
+	}
+
+	entities, err := builder.All(ctx)
+	if err != nil {
+		r.log.Errorf("query recent success logins failed: %s", err.Error())
+		return nil, adminV1.ErrorInternalServerError("query recent success logins failed")
+	}
+
+	dtos := make([]*auditV1.LoginAuditLog, 0, len(entities))
+	for _, entity := range entities {
+		dtos = append(dtos, r.mapper.ToDTO(entity))
+	}
+
+	return dtos, nil
+}
+
+// CountFailedLoginsByIP 统计某 IP 自 since 起的失败登录次数以及涉及的不同用户名数量
+func (r *LoginAuditLogRepo) CountFailedLoginsByIP(ctx context.Context, ip string, since time.Time) (int, int, error) {
+	if ip == "" {
+		return 0, 0, nil
+	}
+
+	builder := r.entClient.Client().LoginAuditLog.Query().
+		Where(
+			loginauditlog.IPAddressEQ(ip),
+			loginauditlog.ActionTypeEQ(loginauditlog.ActionTypeLogin),
+			loginauditlog.StatusEQ(loginauditlog.StatusFailed),
+			loginauditlog.CreatedAtGTE(since),
+		)
+
+	failures, err := builder.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count failed logins by ip failed: %s", err.Error())
+		return 0, 0, adminV1.ErrorInternalServerError("count failed logins by ip failed")
+	}
+	if failures == 0 {
+		return 0, 0, nil
+	}
+
+	usernames, err := builder.
+		Where(loginauditlog.UsernameNotNil()).
+		Unique(true).
+		Select(loginauditlog.FieldUsername).
+		Strings(ctx)
+	if err != nil {
+		r.log.Errorf("query failed login usernames by ip failed: %s", err.Error())
+		return 0, 0, adminV1.ErrorInternalServerError("query failed login usernames by ip failed")
+	}
+
+	return failures, len(usernames), nil
+}
+
+// ExportStream 按列表查询的过滤条件，以主键游标分批读取日志，用于大批量导出
+func (r *LoginAuditLogRepo) ExportStream(ctx context.Context, req *paginationV1.PagingRequest, batchSize int, fn func([]*auditV1.LoginAuditLog) error) error {
+	whereSelectors, _, err := r.repository.BuildListSelectorWithPaging(r.entClient.Client().LoginAuditLog.Query(), exportFilterRequest(req))
+	if err != nil {
+		return adminV1.ErrorBadRequest("invalid filter")
+	}
+
+	return streamByID(ctx, batchSize,
+		func(ctx context.Context, lastID uint32, limit int) ([]*ent.LoginAuditLog, error) {
+			builder := r.entClient.Client().LoginAuditLog.Query().
+				Where(loginauditlog.IDGT(lastID)).
+				Order(ent.Asc(loginauditlog.FieldID)).
+				Limit(limit)
+			if len(whereSelectors) != 0 {
+				builder.Modify(whereSelectors...)
+			}
+
+			entities, err := builder.All(ctx)
+			if err != nil {
+				r.log.Errorf("export login audit logs failed: %s", err.Error())
+				return nil, adminV1.ErrorInternalServerError("export login audit logs failed")
+			}
+			return entities, nil
+		},
+		func(e *ent.LoginAuditLog) uint32 { return e.ID },
+		r.mapper.ToDTO,
+		fn,
+	)
+}
@@ -0,0 +1,12 @@
+package data
+
+import (
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	applogging "go-wind-admin/pkg/middleware/logging"
+)
+
+// NewLoginRiskEngine 创建基于登录审计日志历史的登录风险引擎
+func NewLoginRiskEngine(ctx *bootstrap.Context, loginAuditLogRepo *LoginAuditLogRepo) *applogging.LoginRiskEngine {
+	return applogging.NewLoginRiskEngine(loginAuditLogRepo, ctx.GetLogger())
+}
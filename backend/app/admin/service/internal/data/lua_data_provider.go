@@ -0,0 +1,388 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/go-kratos/kratos/v2/log"
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	dictV1 "go-wind-admin/api/gen/go/dict/service/v1"
+	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
+	permissionV1 "go-wind-admin/api/gen/go/permission/service/v1"
+
+	"go-wind-admin/pkg/lua/api"
+	"go-wind-admin/pkg/middleware/auth"
+)
+
+const (
+	// luaDataDefaultPageSize 脚本查询列表时的默认分页大小
+	luaDataDefaultPageSize = 20
+	// luaDataMaxPageSize 脚本查询列表时的最大分页大小，脚本不能关闭分页
+	luaDataMaxPageSize = 100
+)
+
+// luaDataUserWritableFields 脚本可修改的用户字段
+var luaDataUserWritableFields = map[string]struct{}{
+	"nickname":    {},
+	"realname":    {},
+	"avatar":      {},
+	"email":       {},
+	"mobile":      {},
+	"telephone":   {},
+	"gender":      {},
+	"address":     {},
+	"region":      {},
+	"description": {},
+	"remark":      {},
+	"status":      {},
+}
+
+// LuaDataProvider 为 Lua 脚本的 kratos_data 模块提供数据查询。
+//
+// 查询始终使用调用脚本时的上下文，因此视图（viewer）的租户隔离与数据权限同样生效；
+// 写入函数只对被授予写权限的脚本开放。
+type LuaDataProvider struct {
+	log *log.Helper
+
+	userRepo       UserRepo
+	orgUnitRepo    *OrgUnitRepo
+	roleRepo       *RoleRepo
+	dictEntryRepo  *DictEntryRepo
+	membershipRepo *MembershipRepo
+}
+
+func NewLuaDataProvider(
+	ctx *bootstrap.Context,
+	userRepo UserRepo,
+	orgUnitRepo *OrgUnitRepo,
+	roleRepo *RoleRepo,
+	dictEntryRepo *DictEntryRepo,
+	membershipRepo *MembershipRepo,
+) *LuaDataProvider {
+	return &LuaDataProvider{
+		log:            ctx.NewLoggerHelper("lua-data/data/admin-service"),
+		userRepo:       userRepo,
+		orgUnitRepo:    orgUnitRepo,
+		roleRepo:       roleRepo,
+		dictEntryRepo:  dictEntryRepo,
+		membershipRepo: membershipRepo,
+	}
+}
+
+// DataFunctions 实现 api.DataProvider
+func (p *LuaDataProvider) DataFunctions() []api.DataFunction {
+	return []api.DataFunction{
+		{Name: "get_user", Description: "Get a user by {id} or {username}", Fn: p.getUser},
+		{Name: "list_users", Description: "List users with {page, page_size, query, order_by}", Fn: p.listUsers},
+		{Name: "get_org_unit", Description: "Get an org unit by {id}", Fn: p.getOrgUnit},
+		{Name: "list_org_units", Description: "List org units with {page, page_size, query, order_by}", Fn: p.listOrgUnits},
+		{Name: "get_role", Description: "Get a role by {id} or {code}", Fn: p.getRole},
+		{Name: "list_roles", Description: "List roles with {page, page_size, query, order_by}", Fn: p.listRoles},
+		{Name: "get_dict_entry", Description: "Get a dict entry by {id}", Fn: p.getDictEntry},
+		{Name: "list_dict_entries", Description: "List the entries of a dict type by {type_code}", Fn: p.listDictEntries},
+		{Name: "get_membership", Description: "Get the membership of {user_id} in the current tenant", Fn: p.getMembership},
+		{Name: "list_user_ids", Description: "List active user ids by {role_id}, {org_unit_id} or {position_id}", Fn: p.listUserIDs},
+		{Name: "update_user", Description: "Update profile fields of user {id}", Write: true, Fn: p.updateUser},
+	}
+}
+
+func (p *LuaDataProvider) getUser(ctx context.Context, args map[string]any) (any, error) {
+	req := &identityV1.GetUserRequest{}
+	switch {
+	case luaDataUint32(args, "id") > 0:
+		req.QueryBy = &identityV1.GetUserRequest_Id{Id: luaDataUint32(args, "id")}
+	case luaDataString(args, "username") != "":
+		req.QueryBy = &identityV1.GetUserRequest_Username{Username: luaDataString(args, "username")}
+	default:
+		return nil, fmt.Errorf("id or username is required")
+	}
+
+	u, err := p.userRepo.Get(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return luaDataValue(u)
+}
+
+func (p *LuaDataProvider) listUsers(ctx context.Context, args map[string]any) (any, error) {
+	req, err := luaDataPagingRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.userRepo.List(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return luaDataValue(resp)
+}
+
+func (p *LuaDataProvider) getOrgUnit(ctx context.Context, args map[string]any) (any, error) {
+	id := luaDataUint32(args, "id")
+	if id == 0 {
+		return nil, fmt.Errorf("id is required")
+	}
+
+	o, err := p.orgUnitRepo.Get(ctx, &identityV1.GetOrgUnitRequest{
+		QueryBy: &identityV1.GetOrgUnitRequest_Id{Id: id},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return luaDataValue(o)
+}
+
+func (p *LuaDataProvider) listOrgUnits(ctx context.Context, args map[string]any) (any, error) {
+	req, err := luaDataPagingRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.orgUnitRepo.List(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return luaDataValue(resp)
+}
+
+func (p *LuaDataProvider) getRole(ctx context.Context, args map[string]any) (any, error) {
+	req := &permissionV1.GetRoleRequest{}
+	switch {
+	case luaDataUint32(args, "id") > 0:
+		req.QueryBy = &permissionV1.GetRoleRequest_Id{Id: luaDataUint32(args, "id")}
+	case luaDataString(args, "code") != "":
+		req.QueryBy = &permissionV1.GetRoleRequest_Code{Code: luaDataString(args, "code")}
+	default:
+		return nil, fmt.Errorf("id or code is required")
+	}
+
+	r, err := p.roleRepo.Get(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return luaDataValue(r)
+}
+
+func (p *LuaDataProvider) listRoles(ctx context.Context, args map[string]any) (any, error) {
+	req, err := luaDataPagingRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.roleRepo.List(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return luaDataValue(resp)
+}
+
+func (p *LuaDataProvider) getDictEntry(ctx context.Context, args map[string]any) (any, error) {
+	id := luaDataUint32(args, "id")
+	if id == 0 {
+		return nil, fmt.Errorf("id is required")
+	}
+
+	e, err := p.dictEntryRepo.Get(ctx, &dictV1.GetDictEntryRequest{
+		QueryBy: &dictV1.GetDictEntryRequest_Id{Id: id},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return luaDataValue(e)
+}
+
+func (p *LuaDataProvider) listDictEntries(ctx context.Context, args map[string]any) (any, error) {
+	typeCode := luaDataString(args, "type_code")
+	if typeCode == "" {
+		return nil, fmt.Errorf("type_code is required")
+	}
+
+	req := &dictV1.ListDictEntryByTypeCodeRequest{TypeCode: typeCode}
+	if local := luaDataString(args, "local"); local != "" {
+		req.Local = trans.Ptr(local)
+	}
+
+	resp, err := p.dictEntryRepo.ListByTypeCode(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return luaDataValue(resp)
+}
+
+func (p *LuaDataProvider) getMembership(ctx context.Context, args map[string]any) (any, error) {
+	userID := luaDataUint32(args, "user_id")
+	if userID == 0 {
+		return nil, fmt.Errorf("user_id is required")
+	}
+
+	m, err := p.membershipRepo.GetMembershipByUserTenant(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, nil
+	}
+	return luaDataValue(m)
+}
+
+func (p *LuaDataProvider) listUserIDs(ctx context.Context, args map[string]any) (any, error) {
+	var (
+		ids []uint32
+		err error
+	)
+	switch {
+	case luaDataUint32(args, "role_id") > 0:
+		ids, err = p.membershipRepo.ListUserIDsByRoleID(ctx, luaDataUint32(args, "role_id"), true)
+	case luaDataUint32(args, "org_unit_id") > 0:
+		ids, err = p.membershipRepo.ListUserIDsByOrgUnitID(ctx, luaDataUint32(args, "org_unit_id"), true)
+	case luaDataUint32(args, "position_id") > 0:
+		ids, err = p.membershipRepo.ListUserIDsByPositionID(ctx, luaDataUint32(args, "position_id"), true)
+	default:
+		return nil, fmt.Errorf("role_id, org_unit_id or position_id is required")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]any, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, id)
+	}
+	return result, nil
+}
+
+func (p *LuaDataProvider) updateUser(ctx context.Context, args map[string]any) (any, error) {
+	id := luaDataUint32(args, "id")
+	if id == 0 {
+		return nil, fmt.Errorf("id is required")
+	}
+
+	fields := make(map[string]any, len(args))
+	var paths []string
+	for k, v := range args {
+		if k == "id" {
+			continue
+		}
+		if _, ok := luaDataUserWritableFields[k]; !ok {
+			return nil, fmt.Errorf("field %s is not writable", k)
+		}
+		fields[k] = v
+		paths = append(paths, k)
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no fields to update")
+	}
+	sort.Strings(paths)
+
+	raw, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	u := &identityV1.User{}
+	if err = protojson.Unmarshal(raw, u); err != nil {
+		return nil, fmt.Errorf("invalid user fields: %w", err)
+	}
+
+	if operator, authErr := auth.FromContext(ctx); authErr == nil {
+		u.UpdatedBy = trans.Ptr(operator.GetUserId())
+		paths = append(paths, "updated_by")
+	}
+
+	if err = p.userRepo.Update(ctx, &identityV1.UpdateUserRequest{
+		Id:         id,
+		Data:       u,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: paths},
+	}); err != nil {
+		return nil, err
+	}
+
+	p.log.Infof("user [%d] updated by lua script, fields: %v", id, paths)
+
+	return true, nil
+}
+
+// luaDataPagingRequest 由脚本参数构造分页查询，query 可为 JSON 字符串或表
+func luaDataPagingRequest(args map[string]any) (*paginationV1.PagingRequest, error) {
+	page := luaDataUint32(args, "page")
+	if page == 0 {
+		page = 1
+	}
+	pageSize := luaDataUint32(args, "page_size")
+	if pageSize == 0 {
+		pageSize = luaDataDefaultPageSize
+	}
+	if pageSize > luaDataMaxPageSize {
+		pageSize = luaDataMaxPageSize
+	}
+
+	req := &paginationV1.PagingRequest{
+		Page:     trans.Ptr(page),
+		PageSize: trans.Ptr(pageSize),
+	}
+
+	switch q := args["query"].(type) {
+	case nil:
+	case string:
+		if q != "" {
+			req.FilteringType = &paginationV1.PagingRequest_Query{Query: q}
+		}
+	case map[string]any:
+		raw, err := json.Marshal(q)
+		if err != nil {
+			return nil, err
+		}
+		req.FilteringType = &paginationV1.PagingRequest_Query{Query: string(raw)}
+	default:
+		return nil, fmt.Errorf("query must be a string or a table")
+	}
+
+	if orderBy := luaDataString(args, "order_by"); orderBy != "" {
+		req.OrderBy = trans.Ptr(orderBy)
+	}
+
+	return req, nil
+}
+
+// luaDataValue 把 proto 消息转换为脚本可读的值，字段名使用 proto 字段名
+func luaDataValue(msg proto.Message) (any, error) {
+	raw, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	var v any
+	if err = json.Unmarshal(raw, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func luaDataUint32(args map[string]any, key string) uint32 {
+	switch v := args[key].(type) {
+	case float64:
+		if v > 0 {
+			return uint32(v)
+		}
+	case string:
+		if n, err := strconv.ParseUint(v, 10, 32); err == nil {
+			return uint32(n)
+		}
+	}
+	return 0
+}
+
+func luaDataString(args map[string]any, key string) string {
+	if v, ok := args[key].(string); ok {
+		return v
+	}
+	return ""
+}
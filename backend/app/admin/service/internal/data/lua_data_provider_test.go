@@ -0,0 +1,72 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	"github.com/tx7do/go-utils/trans"
+
+	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
+)
+
+func TestLuaDataPagingRequest(t *testing.T) {
+	req, err := luaDataPagingRequest(map[string]any{})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(1), req.GetPage())
+	assert.Equal(t, uint32(luaDataDefaultPageSize), req.GetPageSize())
+	assert.False(t, req.GetNoPaging())
+
+	req, err = luaDataPagingRequest(map[string]any{
+		"page":      float64(3),
+		"page_size": float64(10000),
+		"query":     map[string]any{"status": "ON"},
+		"order_by":  "-id",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(3), req.GetPage())
+	assert.Equal(t, uint32(luaDataMaxPageSize), req.GetPageSize())
+	assert.Equal(t, `{"status":"ON"}`, req.FilteringType.(*paginationV1.PagingRequest_Query).Query)
+	assert.Equal(t, "-id", req.GetOrderBy())
+
+	_, err = luaDataPagingRequest(map[string]any{"query": float64(1)})
+	assert.Error(t, err)
+}
+
+func TestLuaDataValue(t *testing.T) {
+	v, err := luaDataValue(&identityV1.User{
+		Id:       trans.Ptr(uint32(5)),
+		Username: trans.Ptr("alice"),
+		Status:   identityV1.User_NORMAL.Enum(),
+	})
+	assert.NoError(t, err)
+
+	m := v.(map[string]any)
+	assert.Equal(t, float64(5), m["id"])
+	assert.Equal(t, "alice", m["username"])
+	assert.Equal(t, "NORMAL", m["status"])
+}
+
+func TestLuaDataArgs(t *testing.T) {
+	args := map[string]any{"id": float64(7), "code": "42", "neg": float64(-1), "name": "x"}
+	assert.Equal(t, uint32(7), luaDataUint32(args, "id"))
+	assert.Equal(t, uint32(42), luaDataUint32(args, "code"))
+	assert.Equal(t, uint32(0), luaDataUint32(args, "neg"))
+	assert.Equal(t, uint32(0), luaDataUint32(args, "missing"))
+	assert.Equal(t, "x", luaDataString(args, "name"))
+	assert.Equal(t, "", luaDataString(args, "id"))
+}
+
+func TestLuaDataProvider_UpdateUserRejectsProtectedFields(t *testing.T) {
+	p := &LuaDataProvider{}
+
+	_, err := p.updateUser(context.Background(), map[string]any{"id": float64(1), "tenant_id": float64(2)})
+	assert.ErrorContains(t, err, "tenant_id is not writable")
+
+	_, err = p.updateUser(context.Background(), map[string]any{"id": float64(1)})
+	assert.ErrorContains(t, err, "no fields to update")
+
+	_, err = p.updateUser(context.Background(), map[string]any{"nickname": "bob"})
+	assert.ErrorContains(t, err, "id is required")
+}
@@ -0,0 +1,93 @@
+package data
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"go-wind-admin/pkg/eventbus"
+	"go-wind-admin/pkg/lua"
+	"go-wind-admin/pkg/oss"
+)
+
+const (
+	// LuaScriptDirsEnv Lua 脚本目录，多个目录以系统路径分隔符分隔
+	LuaScriptDirsEnv = "LUA_SCRIPT_DIRS"
+
+	luaServerStartHook = "on_server_start"
+)
+
+// defaultLuaScriptDirs 默认脚本目录：源码运行时位于 configs 同级，容器中位于工作目录下
+var defaultLuaScriptDirs = []string{"../../scripts", "scripts"}
+
+// luaScriptDirs 获取 Lua 脚本目录
+func luaScriptDirs() []string {
+	env := strings.TrimSpace(os.Getenv(LuaScriptDirsEnv))
+	if env == "" {
+		return defaultLuaScriptDirs
+	}
+
+	var dirs []string
+	for _, dir := range filepath.SplitList(env) {
+		if dir = strings.TrimSpace(dir); dir != "" {
+			dirs = append(dirs, dir)
+		}
+	}
+	return dirs
+}
+
+// NewLuaEngine 创建 Lua 引擎，注入缓存、事件总线、对象存储与数据查询后加载脚本
+func NewLuaEngine(
+	ctx *bootstrap.Context,
+	rdb *redis.Client,
+	manager *eventbus.Manager,
+	storage oss.ObjectStorage,
+	dataProvider *LuaDataProvider,
+) (*lua.Engine, func(), error) {
+	l := ctx.NewLoggerHelper("lua/data/admin-service")
+
+	cfg := lua.DefaultConfig()
+	// 先注入依赖再加载脚本，脚本中才能使用对应的模块
+	cfg.ScriptDir = ""
+
+	engine := lua.NewEngine(cfg, ctx.GetLogger())
+
+	if rdb != nil {
+		engine.SetRedis(rdb)
+	}
+	if manager != nil {
+		engine.SetEventBus(manager)
+	}
+	if storage != nil {
+		engine.SetOSS(storage)
+	}
+	if dataProvider != nil {
+		engine.SetDataProvider(dataProvider)
+	}
+
+	if err := registerLuaHooks(engine); err != nil {
+		l.Warnf("register lua hooks failed: %s", err.Error())
+	}
+
+	if err := engine.LoadScriptsFromDirs(ctx.Context(), luaScriptDirs()...); err != nil {
+		l.Errorf("load lua scripts failed: %s", err.Error())
+	}
+
+	execCtx := lua.NewContext(luaServerStartHook)
+	execCtx.Set("service", map[string]interface{}{
+		"name":    ctx.GetAppInfo().GetAppId(),
+		"version": ctx.GetAppInfo().GetVersion(),
+	})
+	if err := engine.ExecuteHook(ctx.Context(), luaServerStartHook, execCtx); err != nil {
+		l.Errorf("execute lua hook [%s] failed: %s", luaServerStartHook, err.Error())
+	}
+
+	return engine, func() {
+		if err := engine.Close(); err != nil {
+			l.Error(err)
+		}
+	}, nil
+}
@@ -0,0 +1,272 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+	kratosHttp "github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+
+	"go-wind-admin/pkg/lua"
+	"go-wind-admin/pkg/middleware/auth"
+	applogging "go-wind-admin/pkg/middleware/logging"
+)
+
+// 标准钩子点
+//
+// 所有钩子的脚本上下文都包含：
+//   - ctx.hook      钩子名称
+//   - ctx.user      当前用户 {id, username, email, roles, tenant_id}，匿名请求时为空
+//   - ctx.tenant_id 当前租户ID
+//   - ctx.request   HTTP 请求 {method, path, remote_addr, headers, query}，不含 Authorization 与 Cookie
+//   - ctx.get("payload") 钩子的业务数据（字段名为 proto 字段名），前置钩子中
+//     可通过 ctx.set("payload", p) 修改，通过 ctx.stop(reason) 否决操作
+//
+// 前置钩子（before_*）中，脚本否决或关键脚本（Critical）执行失败时操作被拒绝；
+// 后置钩子（after_*）只记录失败，不影响已完成的操作。
+const (
+	// LuaHookBeforeLogin 校验凭证前，payload: {username, client_id, device_id, client_type}，只读
+	LuaHookBeforeLogin = "auth.before_login"
+	// LuaHookAfterLogin 签发令牌后，payload: {user_id, username, tenant_id, client_id, device_id, client_type, roles}
+	LuaHookAfterLogin = "auth.after_login"
+
+	// LuaHookBeforeUserCreate 创建用户前，payload: 待创建的 User，可修改
+	LuaHookBeforeUserCreate = "user.before_create"
+	// LuaHookAfterUserCreate 创建用户后，payload: 已创建的 User
+	LuaHookAfterUserCreate = "user.after_create"
+
+	// LuaHookAfterRoleUpdate 更新角色后，payload: 更新后的 Role，另有 update_mask
+	LuaHookAfterRoleUpdate = "role.after_update"
+
+	// LuaHookBeforeFileUpload 上传文件前，payload: StorageObject {bucket_name, object_name, file_directory}，可修改；
+	// 另有 mime、source_file_name、size（直传时）与 method（direct/presign）
+	LuaHookBeforeFileUpload = "file.before_upload"
+
+	// LuaHookBeforeMessageSend 发送站内信前，payload: SendMessageRequest，可修改
+	LuaHookBeforeMessageSend = "message.before_send"
+)
+
+// luaHookPayloadKey 业务数据在脚本上下文中的键
+const luaHookPayloadKey = "payload"
+
+// luaHookDescriptions 标准钩子点说明
+var luaHookDescriptions = map[string]string{
+	luaServerStartHook:       "Called once after the admin service has loaded its scripts",
+	LuaHookBeforeLogin:       "Called before the password credential is verified; stop() rejects the login",
+	LuaHookAfterLogin:        "Called after the access token has been issued",
+	LuaHookBeforeUserCreate:  "Called before a user is created; the payload may be modified or rejected",
+	LuaHookAfterUserCreate:   "Called after a user has been created",
+	LuaHookAfterRoleUpdate:   "Called after a role has been updated",
+	LuaHookBeforeFileUpload:  "Called before a file is uploaded; the target object may be modified or rejected",
+	LuaHookBeforeMessageSend: "Called before an internal message is sent; the message may be modified or rejected",
+}
+
+// luaHookHiddenHeaders 不暴露给脚本的请求头
+var luaHookHiddenHeaders = map[string]struct{}{
+	"authorization": {},
+	"cookie":        {},
+	"set-cookie":    {},
+}
+
+// registerLuaHooks 注册标准钩子点，需在加载脚本前调用
+func registerLuaHooks(engine *lua.Engine) error {
+	var errs []error
+	for name, description := range luaHookDescriptions {
+		if err := engine.RegisterHook(name, description); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// LuaHookEvent 一次钩子调用
+type LuaHookEvent struct {
+	// Hook 钩子名称
+	Hook string
+
+	// Payload 业务数据，前置钩子中脚本的修改会写回
+	Payload proto.Message
+
+	// Fields 附加的只读数据，与 payload 一起放入脚本上下文
+	Fields map[string]any
+
+	// User 当前用户，为空时从请求上下文获取
+	User *authenticationV1.UserTokenPayload
+}
+
+// LuaHookRunner 在业务流程中调用 Lua 钩子
+type LuaHookRunner struct {
+	log *log.Helper
+
+	engine *lua.Engine
+}
+
+func NewLuaHookRunner(ctx *bootstrap.Context, engine *lua.Engine) *LuaHookRunner {
+	return &LuaHookRunner{
+		log:    ctx.NewLoggerHelper("lua-hook/data/admin-service"),
+		engine: engine,
+	}
+}
+
+// Has 钩子上是否挂载了脚本
+func (r *LuaHookRunner) Has(hook string) bool {
+	return r != nil && r.engine != nil && r.engine.HasScripts(hook)
+}
+
+// Before 执行前置钩子，脚本否决时返回 Forbidden，关键脚本失败时返回 InternalServerError
+func (r *LuaHookRunner) Before(ctx context.Context, event *LuaHookEvent) error {
+	err := r.run(ctx, event, true)
+	if err == nil {
+		return nil
+	}
+
+	if abort, ok := lua.AsHookAbort(err); ok {
+		r.log.Infof("lua hook [%s] rejected by script [%s]: %s", event.Hook, abort.Script, abort.Reason)
+		reason := abort.Reason
+		if reason == "" {
+			reason = "operation rejected"
+		}
+		return adminV1.ErrorForbidden("%s", reason)
+	}
+
+	r.log.Errorf("lua hook [%s] failed: %s", event.Hook, err.Error())
+	return adminV1.ErrorInternalServerError("hook %s failed", event.Hook)
+}
+
+// After 执行后置钩子，失败只记录日志
+func (r *LuaHookRunner) After(ctx context.Context, event *LuaHookEvent) {
+	if err := r.run(ctx, event, false); err != nil {
+		r.log.Warnf("lua hook [%s] failed: %s", event.Hook, err.Error())
+	}
+}
+
+func (r *LuaHookRunner) run(ctx context.Context, event *LuaHookEvent, writeBack bool) error {
+	if !r.Has(event.Hook) {
+		return nil
+	}
+
+	execCtx := lua.NewContext(event.Hook).WithContext(ctx).WithLogger(r.log)
+	for k, v := range event.Fields {
+		execCtx.Set(k, v)
+	}
+
+	user := event.User
+	if user == nil {
+		user, _ = auth.FromContext(ctx)
+	}
+	if user != nil {
+		execCtx.WithUser(&lua.UserContext{
+			ID:       user.GetUserId(),
+			Username: user.GetUsername(),
+			Roles:    user.GetRoles(),
+			TenantID: user.GetTenantId(),
+		})
+	}
+	execCtx.WithRequest(luaHookRequest(ctx))
+
+	var original []byte
+	if event.Payload != nil {
+		payload, raw, err := luaHookPayload(event.Payload)
+		if err != nil {
+			return err
+		}
+		original = raw
+		execCtx.Set(luaHookPayloadKey, payload)
+	}
+
+	if err := r.engine.ExecuteHook(ctx, event.Hook, execCtx); err != nil {
+		return err
+	}
+
+	if !writeBack || event.Payload == nil {
+		return nil
+	}
+
+	// 只有脚本修改了 payload 才写回，避免数值精度等无意义的改动
+	modified, err := json.Marshal(execCtx.Get(luaHookPayloadKey))
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(modified, original) {
+		return nil
+	}
+
+	// 先解析到新消息，失败时保持原 payload 不变
+	updated := event.Payload.ProtoReflect().New().Interface()
+	if err = (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(modified, updated); err != nil {
+		return fmt.Errorf("invalid payload modified by hook scripts: %w", err)
+	}
+	proto.Reset(event.Payload)
+	proto.Merge(event.Payload, updated)
+
+	return nil
+}
+
+// luaHookPayload 把 proto 消息转换为脚本可读写的 map
+func luaHookPayload(msg proto.Message) (map[string]any, []byte, error) {
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	payload := make(map[string]any)
+	if err = json.Unmarshal(data, &payload); err != nil {
+		return nil, nil, err
+	}
+
+	// 以 map 的序列化结果为基准，便于比较脚本是否做了修改
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return payload, raw, nil
+}
+
+// luaHookRequest 提取请求信息，非 HTTP 请求时返回空
+func luaHookRequest(ctx context.Context) *lua.HTTPContext {
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return nil
+	}
+	htr, ok := tr.(*kratosHttp.Transport)
+	if !ok || htr.Request() == nil {
+		return nil
+	}
+
+	req := htr.Request()
+
+	headers := make(map[string]string, len(req.Header))
+	for k, v := range req.Header {
+		if _, hidden := luaHookHiddenHeaders[strings.ToLower(k)]; hidden || len(v) == 0 {
+			continue
+		}
+		headers[k] = v[0]
+	}
+
+	query := make(map[string]string)
+	for k, v := range req.URL.Query() {
+		if len(v) > 0 {
+			query[k] = v[0]
+		}
+	}
+
+	return &lua.HTTPContext{
+		Method:     req.Method,
+		Path:       req.URL.Path,
+		RemoteAddr: applogging.ClientRealIP(req),
+		Headers:    headers,
+		Query:      query,
+	}
+}
@@ -0,0 +1,106 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/tx7do/go-utils/trans"
+
+	conf "github.com/tx7do/kratos-bootstrap/api/gen/go/conf/v1"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
+
+	"go-wind-admin/pkg/lua"
+)
+
+func newTestLuaHookRunner(t *testing.T, hook, source string, critical bool) *LuaHookRunner {
+	cfg := lua.DefaultConfig()
+	cfg.ScriptDir = ""
+	engine := lua.NewEngine(cfg, log.DefaultLogger)
+	t.Cleanup(func() { _ = engine.Close() })
+
+	assert.NoError(t, registerLuaHooks(engine))
+	if source != "" {
+		assert.NoError(t, engine.AddScript(hook, &lua.Script{
+			Name:     "test",
+			Hook:     hook,
+			Source:   source,
+			Enabled:  true,
+			Critical: critical,
+		}))
+	}
+
+	bctx := bootstrap.NewContextWithParam(context.Background(), &conf.AppInfo{}, &conf.Bootstrap{}, log.DefaultLogger)
+	return NewLuaHookRunner(bctx, engine)
+}
+
+func TestLuaHookRunner_BeforeModifiesPayload(t *testing.T) {
+	runner := newTestLuaHookRunner(t, LuaHookBeforeUserCreate, `function execute(ctx)
+		local user = ctx.get("payload")
+		user.nickname = string.upper(user.username) .. "@" .. ctx.user.username
+		ctx.set("payload", user)
+	end`, false)
+
+	user := &identityV1.User{Username: trans.Ptr("bob")}
+	err := runner.Before(context.Background(), &LuaHookEvent{
+		Hook:    LuaHookBeforeUserCreate,
+		Payload: user,
+		User:    &authenticationV1.UserTokenPayload{UserId: 1, Username: trans.Ptr("admin")},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "bob", user.GetUsername())
+	assert.Equal(t, "BOB@admin", user.GetNickname())
+}
+
+func TestLuaHookRunner_BeforeVeto(t *testing.T) {
+	runner := newTestLuaHookRunner(t, LuaHookBeforeLogin, `function execute(ctx)
+		if ctx.get("payload").username == "blocked" then
+			ctx.stop("login disabled")
+		end
+	end`, false)
+
+	event := func(username string) *LuaHookEvent {
+		return &LuaHookEvent{
+			Hook:   LuaHookBeforeLogin,
+			Fields: map[string]any{"payload": map[string]any{"username": username}},
+		}
+	}
+
+	assert.NoError(t, runner.Before(context.Background(), event("alice")))
+
+	err := runner.Before(context.Background(), event("blocked"))
+	assert.True(t, adminV1.IsForbidden(err))
+	assert.Contains(t, err.Error(), "login disabled")
+}
+
+func TestLuaHookRunner_ScriptFailure(t *testing.T) {
+	failing := `function execute(ctx) error("boom") end`
+
+	user := &identityV1.User{Username: trans.Ptr("bob")}
+	event := &LuaHookEvent{Hook: LuaHookBeforeUserCreate, Payload: user}
+
+	runner := newTestLuaHookRunner(t, LuaHookBeforeUserCreate, failing, false)
+	assert.NoError(t, runner.Before(context.Background(), event))
+
+	runner = newTestLuaHookRunner(t, LuaHookBeforeUserCreate, failing, true)
+	err := runner.Before(context.Background(), event)
+	assert.True(t, adminV1.IsInternalServerError(err))
+	assert.Equal(t, "bob", user.GetUsername())
+
+	// 后置钩子的失败不影响调用方
+	runner.After(context.Background(), &LuaHookEvent{Hook: LuaHookBeforeUserCreate, Payload: user})
+}
+
+func TestLuaHookRunner_NoScripts(t *testing.T) {
+	runner := newTestLuaHookRunner(t, "", "", false)
+	assert.False(t, runner.Has(LuaHookAfterRoleUpdate))
+	assert.NoError(t, runner.Before(context.Background(), &LuaHookEvent{Hook: LuaHookBeforeMessageSend}))
+
+	var nilRunner *LuaHookRunner
+	assert.NoError(t, nilRunner.Before(context.Background(), &LuaHookEvent{Hook: LuaHookBeforeMessageSend}))
+}
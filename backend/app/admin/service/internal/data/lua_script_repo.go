@@ -0,0 +1,411 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/luascript"
+	"go-wind-admin/app/admin/service/internal/data/ent/luascriptversion"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+
+	"github.com/tx7do/go-utils/copierutil"
+	"github.com/tx7do/go-utils/mapper"
+	"github.com/tx7do/go-utils/trans"
+
+	taskV1 "go-wind-admin/api/gen/go/task/service/v1"
+)
+
+type LuaScriptRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+
+	mapper        *mapper.CopierMapper[taskV1.LuaScript, ent.LuaScript]
+	versionMapper *mapper.CopierMapper[taskV1.LuaScriptVersion, ent.LuaScriptVersion]
+
+	repository *entCrud.Repository[
+		ent.LuaScriptQuery, ent.LuaScriptSelect,
+		ent.LuaScriptCreate, ent.LuaScriptCreateBulk,
+		ent.LuaScriptUpdate, ent.LuaScriptUpdateOne,
+		ent.LuaScriptDelete,
+		predicate.LuaScript,
+		taskV1.LuaScript, ent.LuaScript,
+	]
+}
+
+func NewLuaScriptRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *LuaScriptRepo {
+	repo := &LuaScriptRepo{
+		log:           ctx.NewLoggerHelper("lua-script/repo/admin-service"),
+		entClient:     entClient,
+		mapper:        mapper.NewCopierMapper[taskV1.LuaScript, ent.LuaScript](),
+		versionMapper: mapper.NewCopierMapper[taskV1.LuaScriptVersion, ent.LuaScriptVersion](),
+	}
+
+	repo.init()
+
+	return repo
+}
+
+func (r *LuaScriptRepo) init() {
+	r.repository = entCrud.NewRepository[
+		ent.LuaScriptQuery, ent.LuaScriptSelect,
+		ent.LuaScriptCreate, ent.LuaScriptCreateBulk,
+		ent.LuaScriptUpdate, ent.LuaScriptUpdateOne,
+		ent.LuaScriptDelete,
+		predicate.LuaScript,
+		taskV1.LuaScript, ent.LuaScript,
+	](r.mapper)
+
+	r.mapper.AppendConverters(copierutil.NewTimeStringConverterPair())
+	r.mapper.AppendConverters(copierutil.NewTimeTimestamppbConverterPair())
+
+	r.versionMapper.AppendConverters(copierutil.NewTimeStringConverterPair())
+	r.versionMapper.AppendConverters(copierutil.NewTimeTimestamppbConverterPair())
+}
+
+func (r *LuaScriptRepo) List(ctx context.Context, req *paginationV1.PagingRequest) (*taskV1.ListLuaScriptResponse, error) {
+	if req == nil {
+		return nil, taskV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().LuaScript.Query()
+
+	ret, err := r.repository.ListWithPaging(ctx, builder, builder.Clone(), req)
+	if err != nil {
+		return nil, err
+	}
+	if ret == nil {
+		return &taskV1.ListLuaScriptResponse{Total: 0, Items: nil}, nil
+	}
+
+	return &taskV1.ListLuaScriptResponse{
+		Total: ret.Total,
+		Items: ret.Items,
+	}, nil
+}
+
+// ListEnabled 查询所有启用的脚本（跨租户，供引擎加载使用）
+func (r *LuaScriptRepo) ListEnabled(ctx context.Context) ([]*taskV1.LuaScript, error) {
+	entities, err := r.entClient.Client().LuaScript.Query().
+		Where(luascript.EnabledEQ(true)).
+		Order(ent.Asc(luascript.FieldID)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query enabled lua scripts failed: %s", err.Error())
+		return nil, taskV1.ErrorInternalServerError("query enabled lua scripts failed")
+	}
+
+	dtos := make([]*taskV1.LuaScript, 0, len(entities))
+	for _, entity := range entities {
+		dtos = append(dtos, r.mapper.ToDTO(entity))
+	}
+
+	return dtos, nil
+}
+
+func (r *LuaScriptRepo) IsExist(ctx context.Context, id uint32) (bool, error) {
+	exist, err := r.entClient.Client().LuaScript.Query().
+		Where(luascript.IDEQ(id)).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("query exist failed: %s", err.Error())
+		return false, taskV1.ErrorInternalServerError("query exist failed")
+	}
+	return exist, nil
+}
+
+func (r *LuaScriptRepo) Get(ctx context.Context, req *taskV1.GetLuaScriptRequest) (*taskV1.LuaScript, error) {
+	if req == nil {
+		return nil, taskV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().LuaScript.Query()
+
+	var whereCond []func(s *sql.Selector)
+	switch req.QueryBy.(type) {
+	default:
+	case *taskV1.GetLuaScriptRequest_Id:
+		whereCond = append(whereCond, luascript.IDEQ(req.GetId()))
+	}
+
+	dto, err := r.repository.Get(ctx, builder, req.GetViewMask(), whereCond...)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto, err
+}
+
+// GetByID 查询脚本，不存在时返回 NotFound
+func (r *LuaScriptRepo) GetByID(ctx context.Context, id uint32) (*taskV1.LuaScript, error) {
+	entity, err := r.entClient.Client().LuaScript.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, taskV1.ErrorNotFound("lua script not found")
+		}
+		r.log.Errorf("query lua script failed: %s", err.Error())
+		return nil, taskV1.ErrorInternalServerError("query lua script failed")
+	}
+
+	return r.mapper.ToDTO(entity), nil
+}
+
+// Create 创建脚本，并记录为第1个版本
+func (r *LuaScriptRepo) Create(ctx context.Context, data *taskV1.LuaScript, changeLog string) (dto *taskV1.LuaScript, err error) {
+	if data == nil {
+		return nil, taskV1.ErrorBadRequest("invalid parameter")
+	}
+
+	var tx *ent.Tx
+	tx, err = r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return nil, taskV1.ErrorInternalServerError("start transaction failed")
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				r.log.Errorf("transaction rollback failed: %s", rollbackErr.Error())
+			}
+			return
+		}
+		if commitErr := tx.Commit(); commitErr != nil {
+			r.log.Errorf("transaction commit failed: %s", commitErr.Error())
+			dto, err = nil, taskV1.ErrorInternalServerError("transaction commit failed")
+		}
+	}()
+
+	now := time.Now()
+
+	var entity *ent.LuaScript
+	entity, err = tx.LuaScript.Create().
+		SetNillableTenantID(data.TenantId).
+		SetNillableName(data.Name).
+		SetNillableHook(data.Hook).
+		SetNillableSource(data.Source).
+		SetNillableDescription(data.Description).
+		SetNillableEnabled(data.Enabled).
+		SetNillablePriority(data.Priority).
+		SetNillableCritical(data.Critical).
+		SetNillableDataWrite(data.DataWrite).
+		SetVersion(1).
+		SetNillableAuthor(data.Author).
+		SetNillableCreatedBy(data.CreatedBy).
+		SetCreatedAt(now).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, taskV1.ErrorConflict("lua script name already exists")
+		}
+		r.log.Errorf("insert lua script failed: %s", err.Error())
+		return nil, taskV1.ErrorInternalServerError("insert lua script failed")
+	}
+
+	if err = r.createVersion(ctx, tx, entity, changeLog, data.CreatedBy, now); err != nil {
+		return nil, err
+	}
+
+	return r.mapper.ToDTO(entity), nil
+}
+
+// Update 以合并后的完整数据更新脚本。
+// 源码、钩子、执行顺序或中止标记发生变化时版本号加1并记录版本历史。
+func (r *LuaScriptRepo) Update(ctx context.Context, data *taskV1.LuaScript, changeLog string) (dto *taskV1.LuaScript, err error) {
+	if data == nil || data.GetId() == 0 {
+		return nil, taskV1.ErrorBadRequest("id is required")
+	}
+
+	var tx *ent.Tx
+	tx, err = r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return nil, taskV1.ErrorInternalServerError("start transaction failed")
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				r.log.Errorf("transaction rollback failed: %s", rollbackErr.Error())
+			}
+			return
+		}
+		if commitErr := tx.Commit(); commitErr != nil {
+			r.log.Errorf("transaction commit failed: %s", commitErr.Error())
+			dto, err = nil, taskV1.ErrorInternalServerError("transaction commit failed")
+		}
+	}()
+
+	var current *ent.LuaScript
+	current, err = tx.LuaScript.Get(ctx, data.GetId())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, taskV1.ErrorNotFound("lua script not found")
+		}
+		r.log.Errorf("query lua script failed: %s", err.Error())
+		return nil, taskV1.ErrorInternalServerError("query lua script failed")
+	}
+
+	now := time.Now()
+	version := trans.Uint32Value(current.Version)
+	changed := luaScriptContentChanged(current, data)
+	if changed {
+		version++
+	}
+
+	var entity *ent.LuaScript
+	entity, err = tx.LuaScript.UpdateOneID(data.GetId()).
+		SetNillableName(data.Name).
+		SetNillableHook(data.Hook).
+		SetNillableSource(data.Source).
+		SetNillableDescription(data.Description).
+		SetNillableEnabled(data.Enabled).
+		SetNillablePriority(data.Priority).
+		SetNillableCritical(data.Critical).
+		SetNillableDataWrite(data.DataWrite).
+		SetVersion(version).
+		SetNillableAuthor(data.Author).
+		SetNillableUpdatedBy(data.UpdatedBy).
+		SetUpdatedAt(now).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, taskV1.ErrorConflict("lua script name already exists")
+		}
+		r.log.Errorf("update lua script failed: %s", err.Error())
+		return nil, taskV1.ErrorInternalServerError("update lua script failed")
+	}
+
+	if changed {
+		if err = r.createVersion(ctx, tx, entity, changeLog, data.UpdatedBy, now); err != nil {
+			return nil, err
+		}
+	}
+
+	return r.mapper.ToDTO(entity), nil
+}
+
+// createVersion 记录脚本当前内容为一个版本
+func (r *LuaScriptRepo) createVersion(ctx context.Context, tx *ent.Tx, entity *ent.LuaScript, changeLog string, operatorID *uint32, now time.Time) error {
+	builder := tx.LuaScriptVersion.Create().
+		SetNillableTenantID(entity.TenantID).
+		SetScriptID(entity.ID).
+		SetNillableVersion(entity.Version).
+		SetNillableHook(entity.Hook).
+		SetNillableSource(entity.Source).
+		SetNillablePriority(entity.Priority).
+		SetNillableCritical(entity.Critical).
+		SetNillableAuthor(entity.Author).
+		SetNillableCreatedBy(operatorID).
+		SetCreatedAt(now)
+	if changeLog != "" {
+		builder.SetChangeLog(changeLog)
+	}
+
+	if err := builder.Exec(ctx); err != nil {
+		// 并发修改同一脚本时版本号冲突
+		if ent.IsConstraintError(err) {
+			return taskV1.ErrorConflict("lua script was modified concurrently, please retry")
+		}
+		r.log.Errorf("insert lua script version failed: %s", err.Error())
+		return taskV1.ErrorInternalServerError("insert lua script version failed")
+	}
+
+	return nil
+}
+
+func (r *LuaScriptRepo) Delete(ctx context.Context, req *taskV1.DeleteLuaScriptRequest) (err error) {
+	if req == nil || req.GetId() == 0 {
+		return taskV1.ErrorBadRequest("invalid parameter")
+	}
+
+	var tx *ent.Tx
+	tx, err = r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return taskV1.ErrorInternalServerError("start transaction failed")
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				r.log.Errorf("transaction rollback failed: %s", rollbackErr.Error())
+			}
+			return
+		}
+		if commitErr := tx.Commit(); commitErr != nil {
+			r.log.Errorf("transaction commit failed: %s", commitErr.Error())
+			err = taskV1.ErrorInternalServerError("transaction commit failed")
+		}
+	}()
+
+	if err = tx.LuaScript.DeleteOneID(req.GetId()).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return taskV1.ErrorNotFound("lua script not found")
+		}
+		r.log.Errorf("delete lua script failed: %s", err.Error())
+		return taskV1.ErrorInternalServerError("delete lua script failed")
+	}
+
+	if _, err = tx.LuaScriptVersion.Delete().
+		Where(luascriptversion.ScriptIDEQ(req.GetId())).
+		Exec(ctx); err != nil {
+		r.log.Errorf("delete lua script versions failed: %s", err.Error())
+		return taskV1.ErrorInternalServerError("delete lua script versions failed")
+	}
+
+	return nil
+}
+
+// ListVersions 查询脚本的版本历史，新版本在前
+func (r *LuaScriptRepo) ListVersions(ctx context.Context, scriptID uint32) (*taskV1.ListLuaScriptVersionsResponse, error) {
+	entities, err := r.entClient.Client().LuaScriptVersion.Query().
+		Where(luascriptversion.ScriptIDEQ(scriptID)).
+		Order(ent.Desc(luascriptversion.FieldVersion)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query lua script versions failed: %s", err.Error())
+		return nil, taskV1.ErrorInternalServerError("query lua script versions failed")
+	}
+
+	items := make([]*taskV1.LuaScriptVersion, 0, len(entities))
+	for _, entity := range entities {
+		items = append(items, r.versionMapper.ToDTO(entity))
+	}
+
+	return &taskV1.ListLuaScriptVersionsResponse{
+		Items: items,
+		Total: uint64(len(items)),
+	}, nil
+}
+
+// GetVersion 查询脚本的指定版本
+func (r *LuaScriptRepo) GetVersion(ctx context.Context, scriptID, version uint32) (*taskV1.LuaScriptVersion, error) {
+	entity, err := r.entClient.Client().LuaScriptVersion.Query().
+		Where(
+			luascriptversion.ScriptIDEQ(scriptID),
+			luascriptversion.VersionEQ(version),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, taskV1.ErrorNotFound("lua script version not found")
+		}
+		r.log.Errorf("query lua script version failed: %s", err.Error())
+		return nil, taskV1.ErrorInternalServerError("query lua script version failed")
+	}
+
+	return r.versionMapper.ToDTO(entity), nil
+}
+
+// luaScriptContentChanged 源码、钩子、执行顺序或中止标记是否发生变化
+func luaScriptContentChanged(current *ent.LuaScript, data *taskV1.LuaScript) bool {
+	return (data.Source != nil && data.GetSource() != trans.StringValue(current.Source)) ||
+		(data.Hook != nil && data.GetHook() != trans.StringValue(current.Hook)) ||
+		(data.Priority != nil && data.GetPriority() != trans.Int32Value(current.Priority)) ||
+		(data.Critical != nil && data.GetCritical() != trans.BoolValue(current.Critical))
+}
@@ -0,0 +1,275 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/tx7do/go-utils/id"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	taskV1 "go-wind-admin/api/gen/go/task/service/v1"
+
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+	"go-wind-admin/pkg/lua"
+)
+
+const (
+	// luaScriptChangedChannel 脚本变更通知频道
+	luaScriptChangedChannel = "lua:scripts:changed"
+
+	// luaScriptResyncInterval 全量同步间隔，用于补偿Redis断线期间丢失的通知
+	luaScriptResyncInterval = 5 * time.Minute
+)
+
+// luaScriptChange 脚本变更通知
+type luaScriptChange struct {
+	ID      uint32 `json:"id"`
+	Deleted bool   `json:"deleted,omitempty"`
+	Origin  string `json:"origin"`
+}
+
+// LuaScriptSyncer 把数据库中的脚本同步到本实例的 Lua 引擎，
+// 并通过 Redis 发布/订阅让所有实例在脚本变更后立即热加载
+type LuaScriptSyncer struct {
+	log *log.Helper
+
+	rdb    *redis.Client
+	engine *lua.Engine
+	repo   *LuaScriptRepo
+
+	// 本实例标识，用于忽略自己发布的通知
+	origin string
+
+	// 已加载的脚本ID -> 加载时的版本指纹
+	mu     sync.Mutex
+	loaded map[uint32]string
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+func NewLuaScriptSyncer(
+	ctx *bootstrap.Context,
+	rdb *redis.Client,
+	engine *lua.Engine,
+	repo *LuaScriptRepo,
+) (*LuaScriptSyncer, func(), error) {
+	s := &LuaScriptSyncer{
+		log:    ctx.NewLoggerHelper("lua-script-syncer/data/admin-service"),
+		rdb:    rdb,
+		engine: engine,
+		repo:   repo,
+		origin: id.NewGUIDv4(false),
+		loaded: make(map[uint32]string),
+		stop:   make(chan struct{}),
+	}
+
+	if err := s.Resync(appViewer.NewSystemViewerContext(context.Background())); err != nil {
+		s.log.Errorf("load lua scripts from database failed: %s", err.Error())
+	}
+
+	var pubsub *redis.PubSub
+	if rdb != nil {
+		pubsub = rdb.Subscribe(ctx.Context(), luaScriptChangedChannel)
+		s.wg.Add(1)
+		go s.listen(pubsub)
+	}
+
+	s.wg.Add(1)
+	go s.resyncLoop()
+
+	return s, func() {
+		close(s.stop)
+		if pubsub != nil {
+			if err := pubsub.Close(); err != nil {
+				s.log.Warnf("close lua script subscription failed: %s", err.Error())
+			}
+		}
+		s.wg.Wait()
+	}, nil
+}
+
+// Publish 在本实例立即应用脚本变更，并通知其他实例
+func (s *LuaScriptSyncer) Publish(ctx context.Context, scriptID uint32, deleted bool) error {
+	var err error
+	if deleted {
+		s.unload(scriptID)
+	} else {
+		err = s.Reload(ctx, scriptID)
+	}
+
+	if s.rdb != nil {
+		payload, _ := json.Marshal(&luaScriptChange{ID: scriptID, Deleted: deleted, Origin: s.origin})
+		if pubErr := s.rdb.Publish(ctx, luaScriptChangedChannel, payload).Err(); pubErr != nil {
+			s.log.Warnf("publish lua script change [%d] failed: %s", scriptID, pubErr.Error())
+		}
+	}
+
+	return err
+}
+
+// Reload 从数据库重新加载一个脚本，脚本不存在或已禁用时从引擎中移除
+func (s *LuaScriptSyncer) Reload(ctx context.Context, scriptID uint32) error {
+	script, err := s.repo.GetByID(ctx, scriptID)
+	if err != nil {
+		if taskV1.IsNotFound(err) {
+			s.unload(scriptID)
+			return nil
+		}
+		return err
+	}
+
+	return s.apply(script)
+}
+
+// Resync 全量同步：加载新增或变化的脚本，移除已删除或禁用的脚本
+func (s *LuaScriptSyncer) Resync(ctx context.Context) error {
+	scripts, err := s.repo.ListEnabled(ctx)
+	if err != nil {
+		return err
+	}
+
+	present := make(map[uint32]struct{}, len(scripts))
+	var errs []error
+	for _, script := range scripts {
+		present[script.GetId()] = struct{}{}
+		if err = s.apply(script); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	s.mu.Lock()
+	var stale []uint32
+	for scriptID := range s.loaded {
+		if _, ok := present[scriptID]; !ok {
+			stale = append(stale, scriptID)
+		}
+	}
+	s.mu.Unlock()
+
+	for _, scriptID := range stale {
+		s.unload(scriptID)
+	}
+
+	return errors.Join(errs...)
+}
+
+// apply 把脚本加载到引擎，版本指纹未变化时跳过
+func (s *LuaScriptSyncer) apply(script *taskV1.LuaScript) error {
+	fingerprint := luaScriptFingerprint(script)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.loaded[script.GetId()] == fingerprint {
+		return nil
+	}
+
+	if err := s.engine.ReloadScript(ToLuaScript(script)); err != nil {
+		return fmt.Errorf("reload lua script [%s] failed: %w", script.GetName(), err)
+	}
+
+	if script.GetEnabled() {
+		s.loaded[script.GetId()] = fingerprint
+	} else {
+		delete(s.loaded, script.GetId())
+	}
+
+	return nil
+}
+
+func (s *LuaScriptSyncer) unload(scriptID uint32) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.engine.UnloadScript(scriptID)
+	delete(s.loaded, scriptID)
+}
+
+// listen 处理其他实例发布的变更通知
+func (s *LuaScriptSyncer) listen(pubsub *redis.PubSub) {
+	defer s.wg.Done()
+
+	ch := pubsub.Channel()
+	for {
+		select {
+		case <-s.stop:
+			return
+		case msg, ok := <-ch:
+			if !ok {
+				return
+			}
+			s.handleMessage(msg.Payload)
+		}
+	}
+}
+
+func (s *LuaScriptSyncer) handleMessage(payload string) {
+	var change luaScriptChange
+	if err := json.Unmarshal([]byte(payload), &change); err != nil {
+		s.log.Warnf("invalid lua script change message: %s", err.Error())
+		return
+	}
+	if change.Origin == s.origin || change.ID == 0 {
+		return
+	}
+
+	if change.Deleted {
+		s.unload(change.ID)
+		return
+	}
+
+	if err := s.Reload(appViewer.NewSystemViewerContext(context.Background()), change.ID); err != nil {
+		s.log.Errorf("hot reload lua script [%d] failed: %s", change.ID, err.Error())
+	}
+}
+
+// resyncLoop 定期全量同步
+func (s *LuaScriptSyncer) resyncLoop() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(luaScriptResyncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			if err := s.Resync(appViewer.NewSystemViewerContext(context.Background())); err != nil {
+				s.log.Warnf("resync lua scripts failed: %s", err.Error())
+			}
+		}
+	}
+}
+
+// ToLuaScript 转换为引擎使用的脚本
+func ToLuaScript(script *taskV1.LuaScript) *lua.Script {
+	return &lua.Script{
+		ID:          script.GetId(),
+		Name:        script.GetName(),
+		Hook:        script.GetHook(),
+		Source:      script.GetSource(),
+		Enabled:     script.GetEnabled(),
+		Priority:    int(script.GetPriority()),
+		Description: script.GetDescription(),
+		Version:     int(script.GetVersion()),
+		Author:      script.GetAuthor(),
+		Critical:    script.GetCritical(),
+		TenantID:    script.GetTenantId(),
+		DataWrite:   script.GetDataWrite(),
+	}
+}
+
+// luaScriptFingerprint 影响引擎中脚本行为的字段
+func luaScriptFingerprint(script *taskV1.LuaScript) string {
+	return fmt.Sprintf("%d|%t|%s|%s|%d|%t|%t|%d",
+		script.GetVersion(), script.GetEnabled(), script.GetName(), script.GetHook(),
+		script.GetPriority(), script.GetCritical(), script.GetDataWrite(), script.GetUpdatedAt().AsTime().UnixNano())
+}
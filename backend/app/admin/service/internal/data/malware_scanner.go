@@ -0,0 +1,48 @@
+package data
+
+import (
+	"os"
+	"strings"
+	"time"
+
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"go-wind-admin/pkg/antivirus"
+)
+
+const (
+	// ClamdAddressEnv clamd 地址（tcp://host:3310、unix:///run/clamav/clamd.ctl），未设置时不扫描上传的文件
+	ClamdAddressEnv = "CLAMD_ADDRESS"
+	// ClamdTimeoutEnv 单个文件的扫描超时时间，例如 "5m"
+	ClamdTimeoutEnv = "CLAMD_TIMEOUT"
+)
+
+// NewMalwareScanner 按环境变量创建恶意文件扫描引擎，未配置时返回 nil
+func NewMalwareScanner(ctx *bootstrap.Context) (antivirus.Scanner, error) {
+	l := ctx.NewLoggerHelper("malware-scanner/data/admin-service")
+
+	address := strings.TrimSpace(os.Getenv(ClamdAddressEnv))
+	if address == "" {
+		l.Infof("%s is not set, uploaded files will not be scanned", ClamdAddressEnv)
+		return nil, nil
+	}
+
+	var opts []antivirus.ClamdOption
+	if env := strings.TrimSpace(os.Getenv(ClamdTimeoutEnv)); env != "" {
+		timeout, err := time.ParseDuration(env)
+		if err != nil {
+			l.Warnf("invalid %s [%s]: %s", ClamdTimeoutEnv, env, err.Error())
+		} else {
+			opts = append(opts, antivirus.WithClamdTimeout(timeout))
+		}
+	}
+
+	scanner, err := antivirus.NewClamdScanner(address, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	l.Infof("uploaded files will be scanned by clamd at [%s]", address)
+
+	return scanner, nil
+}
@@ -0,0 +1,255 @@
+package data
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
+	storageV1 "go-wind-admin/api/gen/go/storage/service/v1"
+
+	"go-wind-admin/pkg/crypto"
+	"go-wind-admin/pkg/oss"
+)
+
+const (
+	// StorageProviderEnv 平台默认存储后端（MINIO、LOCAL），未设置时优先使用已配置的 MinIO
+	StorageProviderEnv = "STORAGE_PROVIDER"
+	// LocalStorageRootEnv 本地存储根目录
+	LocalStorageRootEnv = "LOCAL_STORAGE_ROOT"
+	// LocalStorageBaseUrlEnv 本地存储签名路由的访问前缀，跨域部署时需配置为完整地址
+	LocalStorageBaseUrlEnv = "LOCAL_STORAGE_BASE_URL"
+	// LocalStorageSecretEnv 本地存储签名密钥，多实例部署时必须一致
+	LocalStorageSecretEnv = "LOCAL_STORAGE_SECRET"
+
+	defaultLocalStorageRoot    = "data/storage"
+	defaultLocalStorageBaseUrl = "/admin/v1/storage/local"
+
+	tenantStorageCacheTTL = time.Minute
+
+	// SharedDedupScope 允许共享物理对象的租户共用的去重范围
+	SharedDedupScope = "shared"
+)
+
+// tenantStorageEntry 租户存储设置的缓存项
+type tenantStorageEntry struct {
+	provider    *storageV1.OSSProvider
+	dedupPolicy identityV1.Tenant_FileDedupPolicy
+	expiresAt   time.Time
+}
+
+// ObjectStorageRouter 按租户选择对象存储后端。
+// 租户设置了存储后端时使用租户的设置，否则使用平台默认存储；
+// 已存在的文件按文件记录中的存储提供商访问，不受租户设置变更影响。
+type ObjectStorageRouter struct {
+	log        *log.Helper
+	tenantRepo *TenantRepo
+
+	backends        map[storageV1.OSSProvider]oss.ObjectStorage
+	defaultProvider storageV1.OSSProvider
+	local           *oss.LocalStorage
+
+	tenants sync.Map // uint32 -> tenantStorageEntry
+}
+
+func NewObjectStorageRouter(ctx *bootstrap.Context, mc *oss.MinIOClient, tenantRepo *TenantRepo) (*ObjectStorageRouter, error) {
+	r := &ObjectStorageRouter{
+		log:        ctx.NewLoggerHelper("object-storage/data/admin-service"),
+		tenantRepo: tenantRepo,
+		backends:   make(map[storageV1.OSSProvider]oss.ObjectStorage),
+	}
+
+	if cfg := ctx.GetConfig(); cfg != nil && cfg.Oss != nil && cfg.Oss.Minio != nil {
+		r.backends[storageV1.OSSProvider_MINIO] = mc
+	}
+
+	local, err := newLocalStorage(ctx, r.log)
+	if err != nil {
+		return nil, err
+	}
+	r.local = local
+	r.backends[storageV1.OSSProvider_LOCAL] = local
+
+	r.defaultProvider = storageV1.OSSProvider_LOCAL
+	if _, ok := r.backends[storageV1.OSSProvider_MINIO]; ok {
+		r.defaultProvider = storageV1.OSSProvider_MINIO
+	}
+	if env := strings.ToUpper(strings.TrimSpace(os.Getenv(StorageProviderEnv))); env != "" {
+		provider, ok := storageV1.OSSProvider_value[env]
+		if !ok {
+			return nil, storageV1.ErrorBadRequest("unknown storage provider [%s]", env)
+		}
+		if _, ok = r.backends[storageV1.OSSProvider(provider)]; !ok {
+			return nil, storageV1.ErrorNotImplemented("storage provider [%s] is not configured", env)
+		}
+		r.defaultProvider = storageV1.OSSProvider(provider)
+	}
+
+	r.log.Infof("default object storage: %s", r.defaultProvider.String())
+
+	return r, nil
+}
+
+// newLocalStorage 按环境变量创建本地存储，未配置密钥时使用加密密钥，仍未配置时生成随机密钥
+func newLocalStorage(ctx *bootstrap.Context, l *log.Helper) (*oss.LocalStorage, error) {
+	root := strings.TrimSpace(os.Getenv(LocalStorageRootEnv))
+	if root == "" {
+		root = defaultLocalStorageRoot
+	}
+
+	baseUrl := strings.TrimSpace(os.Getenv(LocalStorageBaseUrlEnv))
+	if baseUrl == "" {
+		baseUrl = defaultLocalStorageBaseUrl
+	}
+
+	secret := []byte(os.Getenv(LocalStorageSecretEnv))
+	if len(secret) == 0 {
+		secret = []byte(os.Getenv(crypto.EncryptionKeyEnv))
+	}
+	if len(secret) == 0 {
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, err
+		}
+		l.Warnf("%s is not set, local storage presigned urls are only valid on this instance until restart", LocalStorageSecretEnv)
+	}
+
+	return oss.NewLocalStorage(root, baseUrl, secret, ctx.GetLogger())
+}
+
+// Default 平台默认存储
+func (r *ObjectStorageRouter) Default() oss.ObjectStorage {
+	return r.backends[r.defaultProvider]
+}
+
+// Local 本地文件系统存储
+func (r *ObjectStorageRouter) Local() *oss.LocalStorage {
+	return r.local
+}
+
+// Providers 已配置的存储提供商
+func (r *ObjectStorageRouter) Providers() []storageV1.OSSProvider {
+	providers := make([]storageV1.OSSProvider, 0, len(r.backends))
+	for provider := range r.backends {
+		providers = append(providers, provider)
+	}
+	slices.Sort(providers)
+	return providers
+}
+
+// Provider 获取指定提供商的存储
+func (r *ObjectStorageRouter) Provider(provider storageV1.OSSProvider) (oss.ObjectStorage, error) {
+	storage, ok := r.backends[provider]
+	if !ok {
+		return nil, storageV1.ErrorNotImplemented("storage provider [%s] is not configured", provider.String())
+	}
+	return storage, nil
+}
+
+// ProviderByName 按提供商名称获取存储，名称为空时使用默认存储
+func (r *ObjectStorageRouter) ProviderByName(name string) (oss.ObjectStorage, error) {
+	if name == "" {
+		return r.Default(), nil
+	}
+
+	provider, ok := storageV1.OSSProvider_value[name]
+	if !ok {
+		return nil, storageV1.ErrorNotImplemented("unknown storage provider [%s]", name)
+	}
+	return r.Provider(storageV1.OSSProvider(provider))
+}
+
+// ForTenant 获取租户使用的存储，平台（租户ID为0）使用默认存储
+func (r *ObjectStorageRouter) ForTenant(ctx context.Context, tenantID uint32) (oss.ObjectStorage, error) {
+	if tenantID == 0 {
+		return r.Default(), nil
+	}
+
+	settings, err := r.tenantSettings(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if settings.provider == nil {
+		return r.Default(), nil
+	}
+
+	return r.Provider(*settings.provider)
+}
+
+// DedupScope 获取租户上传文件的去重范围，返回空字符串表示不去重。
+// 默认只在租户内部去重，租户之间只有双方都允许共享时才会共用物理对象。
+func (r *ObjectStorageRouter) DedupScope(ctx context.Context, tenantID uint32) (string, error) {
+	if tenantID == 0 {
+		return TenantDedupScope(0), nil
+	}
+
+	settings, err := r.tenantSettings(ctx, tenantID)
+	if err != nil {
+		return "", err
+	}
+
+	switch settings.dedupPolicy {
+	case identityV1.Tenant_DEDUP_DISABLED:
+		return "", nil
+	case identityV1.Tenant_DEDUP_SHARED:
+		return SharedDedupScope, nil
+	default:
+		return TenantDedupScope(tenantID), nil
+	}
+}
+
+// TenantDedupScope 租户内部的去重范围
+func TenantDedupScope(tenantID uint32) string {
+	return fmt.Sprintf("tenant:%d", tenantID)
+}
+
+// ForFile 获取文件记录所在的存储，文件记录为空时使用租户的存储
+func (r *ObjectStorageRouter) ForFile(ctx context.Context, f *storageV1.File, tenantID uint32) (oss.ObjectStorage, error) {
+	if f != nil && f.Provider != nil {
+		return r.Provider(f.GetProvider())
+	}
+	return r.ForTenant(ctx, tenantID)
+}
+
+// tenantSettings 查询租户的存储设置，结果缓存一段时间
+func (r *ObjectStorageRouter) tenantSettings(ctx context.Context, tenantID uint32) (tenantStorageEntry, error) {
+	if v, ok := r.tenants.Load(tenantID); ok {
+		entry := v.(tenantStorageEntry)
+		if time.Now().Before(entry.expiresAt) {
+			return entry, nil
+		}
+	}
+
+	tenant, err := r.tenantRepo.Get(ctx, &identityV1.GetTenantRequest{QueryBy: &identityV1.GetTenantRequest_Id{Id: tenantID}})
+	if err != nil {
+		r.log.Errorf("get tenant [%d] storage settings failed: %s", tenantID, err.Error())
+		return tenantStorageEntry{}, err
+	}
+
+	entry := tenantStorageEntry{
+		provider:    tenant.StorageProvider,
+		dedupPolicy: tenant.GetFileDedupPolicy(),
+		expiresAt:   time.Now().Add(tenantStorageCacheTTL),
+	}
+	r.tenants.Store(tenantID, entry)
+
+	return entry, nil
+}
+
+// ForgetTenant 清除租户存储设置的缓存，租户修改存储设置后调用
+func (r *ObjectStorageRouter) ForgetTenant(tenantID uint32) {
+	r.tenants.Delete(tenantID)
+}
+
+// NewObjectStorage 平台默认存储，供备份、审计归档等平台级数据使用
+func NewObjectStorage(router *ObjectStorageRouter) oss.ObjectStorage {
+	return router.Default()
+}
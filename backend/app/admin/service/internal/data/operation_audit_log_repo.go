@@ -176,3 +176,33 @@ func (r *OperationAuditLogRepo) Create(ctx context.Context, req *auditV1.CreateO
 
 	return nil
 }
+
+// ExportStream 按列表查询的过滤条件，以主键游标分批读取日志，用于大批量导出
+func (r *OperationAuditLogRepo) ExportStream(ctx context.Context, req *paginationV1.PagingRequest, batchSize int, fn func([]*auditV1.OperationAuditLog) error) error {
+	whereSelectors, _, err := r.repository.BuildListSelectorWithPaging(r.entClient.Client().OperationAuditLog.Query(), exportFilterRequest(req))
+	if err != nil {
+		return adminV1.ErrorBadRequest("invalid filter")
+	}
+
+	return streamByID(ctx, batchSize,
+		func(ctx context.Context, lastID uint32, limit int) ([]*ent.OperationAuditLog, error) {
+			builder := r.entClient.Client().OperationAuditLog.Query().
+				Where(operationauditlog.IDGT(lastID)).
+				Order(ent.Asc(operationauditlog.FieldID)).
+				Limit(limit)
+			if len(whereSelectors) != 0 {
+				builder.Modify(whereSelectors...)
+			}
+
+			entities, err := builder.All(ctx)
+			if err != nil {
+				r.log.Errorf("export operation audit logs failed: %s", err.Error())
+				return nil, adminV1.ErrorInternalServerError("export operation audit logs failed")
+			}
+			return entities, nil
+		},
+		func(e *ent.OperationAuditLog) uint32 { return e.ID },
+		r.mapper.ToDTO,
+		fn,
+	)
+}
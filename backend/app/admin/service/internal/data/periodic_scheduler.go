@@ -0,0 +1,22 @@
+package data
+
+import (
+	"github.com/redis/go-redis/v9"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"go-wind-admin/pkg/task"
+)
+
+// NewPeriodicScheduler 创建集群安全的周期任务调度器，
+// 所有实例通过 Redis 选出唯一的主节点负责周期任务入队
+func NewPeriodicScheduler(ctx *bootstrap.Context, rdb *redis.Client) (*task.PeriodicScheduler, func(), error) {
+	var client redis.UniversalClient
+	if rdb != nil {
+		client = rdb
+	}
+
+	s := task.NewPeriodicScheduler(client, ctx.GetLogger())
+	s.Start()
+
+	return s, s.Stop, nil
+}
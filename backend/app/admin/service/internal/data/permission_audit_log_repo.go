@@ -24,6 +24,8 @@ type PermissionAuditLogRepo struct {
 	entClient *entCrud.EntClient[*ent.Client]
 	log       *log.Helper
 
+	forwarder *AuditEventForwarder
+
 	mapper              *mapper.CopierMapper[auditV1.PermissionAuditLog, ent.PermissionAuditLog]
 	actionTypeConverter *mapper.EnumTypeConverter[auditV1.PermissionAuditLog_ActionType, permissionauditlog.Action]
 
@@ -37,10 +39,15 @@ type PermissionAuditLogRepo struct {
 	]
 }
 
-func NewPermissionAuditLogRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *PermissionAuditLogRepo {
+func NewPermissionAuditLogRepo(
+	ctx *bootstrap.Context,
+	entClient *entCrud.EntClient[*ent.Client],
+	forwarder *AuditEventForwarder,
+) *PermissionAuditLogRepo {
 	repo := &PermissionAuditLogRepo{
 		log:       ctx.NewLoggerHelper("permission-audit-log/repo/admin-service"),
 		entClient: entClient,
+		forwarder: forwarder,
 		mapper:    mapper.NewCopierMapper[auditV1.PermissionAuditLog, ent.PermissionAuditLog](),
 		actionTypeConverter: mapper.NewEnumTypeConverter[auditV1.PermissionAuditLog_ActionType, permissionauditlog.Action](
 			auditV1.PermissionAuditLog_ActionType_name, auditV1.PermissionAuditLog_ActionType_value,
@@ -157,11 +164,46 @@ func (r *PermissionAuditLogRepo) Create(ctx context.Context, req *auditV1.Create
 		SetSignature(req.Data.Signature).
 		SetCreatedAt(time.Now())
 
-	err := builder.Exec(ctx)
+	entity, err := builder.Save(ctx)
 	if err != nil {
 		r.log.Errorf("insert permission audit log failed: %s", err.Error())
 		return auditV1.ErrorInternalServerError("insert permission audit log failed")
 	}
 
-	return err
+	// 转发到已配置的SIEM
+	if r.forwarder != nil {
+		r.forwarder.ForwardPermissionAuditLog(r.mapper.ToDTO(entity))
+	}
+
+	return nil
+}
+
+// ExportStream 按列表查询的过滤条件，以主键游标分批读取日志，用于大批量导出
+func (r *PermissionAuditLogRepo) ExportStream(ctx context.Context, req *paginationV1.PagingRequest, batchSize int, fn func([]*auditV1.PermissionAuditLog) error) error {
+	whereSelectors, _, err := r.repository.BuildListSelectorWithPaging(r.entClient.Client().PermissionAuditLog.Query(), exportFilterRequest(req))
+	if err != nil {
+		return auditV1.ErrorBadRequest("invalid filter")
+	}
+
+	return streamByID(ctx, batchSize,
+		func(ctx context.Context, lastID uint32, limit int) ([]*ent.PermissionAuditLog, error) {
+			builder := r.entClient.Client().PermissionAuditLog.Query().
+				Where(permissionauditlog.IDGT(lastID)).
+				Order(ent.Asc(permissionauditlog.FieldID)).
+				Limit(limit)
+			if len(whereSelectors) != 0 {
+				builder.Modify(whereSelectors...)
+			}
+
+			entities, err := builder.All(ctx)
+			if err != nil {
+				r.log.Errorf("export permission audit logs failed: %s", err.Error())
+				return nil, auditV1.ErrorInternalServerError("export permission audit logs failed")
+			}
+			return entities, nil
+		},
+		func(e *ent.PermissionAuditLog) uint32 { return e.ID },
+		r.mapper.ToDTO,
+		fn,
+	)
 }
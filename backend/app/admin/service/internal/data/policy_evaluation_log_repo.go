@@ -163,3 +163,33 @@ func (r *PolicyEvaluationLogRepo) Create(ctx context.Context, req *permissionV1.
 
 	return err
 }
+
+// ExportStream 按列表查询的过滤条件，以主键游标分批读取日志，用于大批量导出
+func (r *PolicyEvaluationLogRepo) ExportStream(ctx context.Context, req *paginationV1.PagingRequest, batchSize int, fn func([]*permissionV1.PolicyEvaluationLog) error) error {
+	whereSelectors, _, err := r.repository.BuildListSelectorWithPaging(r.entClient.Client().PolicyEvaluationLog.Query(), exportFilterRequest(req))
+	if err != nil {
+		return permissionV1.ErrorBadRequest("invalid filter")
+	}
+
+	return streamByID(ctx, batchSize,
+		func(ctx context.Context, lastID uint32, limit int) ([]*ent.PolicyEvaluationLog, error) {
+			builder := r.entClient.Client().PolicyEvaluationLog.Query().
+				Where(policyevaluationlog.IDGT(lastID)).
+				Order(ent.Asc(policyevaluationlog.FieldID)).
+				Limit(limit)
+			if len(whereSelectors) != 0 {
+				builder.Modify(whereSelectors...)
+			}
+
+			entities, err := builder.All(ctx)
+			if err != nil {
+				r.log.Errorf("export policy evaluation logs failed: %s", err.Error())
+				return nil, permissionV1.ErrorInternalServerError("export policy evaluation logs failed")
+			}
+			return entities, nil
+		},
+		func(e *ent.PolicyEvaluationLog) uint32 { return e.ID },
+		r.mapper.ToDTO,
+		fn,
+	)
+}
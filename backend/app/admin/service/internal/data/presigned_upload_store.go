@@ -0,0 +1,101 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	storageV1 "go-wind-admin/api/gen/go/storage/service/v1"
+)
+
+const (
+	// PresignedUploadKeyFormat 待完成的预签名上传 file:presign:{bucket}/{object}
+	PresignedUploadKeyFormat = "file:presign:%s/%s"
+
+	// presignedUploadGrace 预签名地址过期后仍允许登记的时间，覆盖客户端上传完成到调用完成接口之间的延迟
+	presignedUploadGrace = time.Hour
+)
+
+// PresignedUpload 已签发但尚未登记文件记录的预签名上传
+type PresignedUpload struct {
+	TenantID       uint32 `json:"tenant_id"`
+	UserID         uint32 `json:"user_id"`
+	Storage        string `json:"storage"`
+	Bucket         string `json:"bucket"`
+	Object         string `json:"object"`
+	ContentType    string `json:"content_type"`
+	SourceFileName string `json:"source_file_name"`
+	Size           int64  `json:"size"`
+}
+
+// PresignedUploadStore 保存预签名上传的上下文，客户端上传完成后据此登记文件记录并提交扫描
+type PresignedUploadStore struct {
+	log *log.Helper
+	rdb *redis.Client
+}
+
+func NewPresignedUploadStore(ctx *bootstrap.Context, rdb *redis.Client) *PresignedUploadStore {
+	return &PresignedUploadStore{
+		log: ctx.NewLoggerHelper("presigned-upload/data/admin-service"),
+		rdb: rdb,
+	}
+}
+
+func (s *PresignedUploadStore) key(bucketName, objectName string) string {
+	return fmt.Sprintf(PresignedUploadKeyFormat, bucketName, objectName)
+}
+
+// Save 保存预签名上传，expiry 为预签名地址的有效期
+func (s *PresignedUploadStore) Save(ctx context.Context, upload *PresignedUpload, expiry time.Duration) error {
+	if s.rdb == nil {
+		return storageV1.ErrorServiceUnavailable("redis is not configured")
+	}
+
+	data, err := json.Marshal(upload)
+	if err != nil {
+		return err
+	}
+
+	if err = s.rdb.Set(ctx, s.key(upload.Bucket, upload.Object), data, expiry+presignedUploadGrace).Err(); err != nil {
+		s.log.Errorf("save presigned upload [%s/%s] failed: %s", upload.Bucket, upload.Object, err.Error())
+		return storageV1.ErrorInternalServerError("save presigned upload failed")
+	}
+
+	return nil
+}
+
+// Take 取出并删除预签名上传，每个上传只能登记一次，不存在或已过期时返回 nil
+func (s *PresignedUploadStore) Take(ctx context.Context, bucketName, objectName string) (*PresignedUpload, error) {
+	if s.rdb == nil {
+		return nil, storageV1.ErrorServiceUnavailable("redis is not configured")
+	}
+
+	data, err := s.rdb.GetDel(ctx, s.key(bucketName, objectName)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		s.log.Errorf("take presigned upload [%s/%s] failed: %s", bucketName, objectName, err.Error())
+		return nil, storageV1.ErrorInternalServerError("take presigned upload failed")
+	}
+
+	var upload PresignedUpload
+	if err = json.Unmarshal(data, &upload); err != nil {
+		return nil, err
+	}
+
+	return &upload, nil
+}
+
+// Restore 登记失败时放回预签名上传，允许客户端重试
+func (s *PresignedUploadStore) Restore(ctx context.Context, upload *PresignedUpload) {
+	if err := s.Save(ctx, upload, 0); err != nil {
+		s.log.Warnf("restore presigned upload [%s/%s] failed: %s", upload.Bucket, upload.Object, err.Error())
+	}
+}
@@ -23,6 +23,12 @@ var ProviderSet = wire.NewSet(
 	data.NewRedisClient,
 	data.NewEntClient,
 	data.NewMinIoClient,
+	data.NewObjectStorageRouter,
+	data.NewObjectStorage,
+	data.NewEventBusManager,
+	data.NewEventBus,
+	data.NewLuaEngine,
+	data.NewLuaDataProvider,
 
 	data.NewClientType,
 
@@ -42,6 +48,11 @@ var ProviderSet = wire.NewSet(
 	data.NewLanguageRepo,
 
 	data.NewTaskRepo,
+	data.NewTaskRunRepo,
+	data.NewTaskWorkflowRepo,
+	data.NewTaskWorkflowRunRepo,
+	data.NewPeriodicScheduler,
+	data.NewTaskProgressBroker,
 	data.NewLoginPolicyRepo,
 
 	data.NewOrgUnitRepo,
@@ -74,11 +85,29 @@ var ProviderSet = wire.NewSet(
 	data.NewPolicyEvaluationLogRepo,
 
 	data.NewLoginAuditLogRepo,
+	data.NewLoginRiskEngine,
 	data.NewApiAuditLogRepo,
 	data.NewOperationAuditLogRepo,
 	data.NewDataAccessAuditLogRepo,
+	data.NewAuditLogRetentionPolicyRepo,
+	data.NewAuditLogArchiveRepo,
+	data.NewAuditLogArchiver,
+	data.NewAuditForwarderRepo,
+	data.NewLuaScriptRepo,
+	data.NewLuaScriptSyncer,
+	data.NewLuaHookRunner,
+	data.NewAuditEventForwarder,
+	data.NewAuditAnalyticsRepo,
+	data.NewDatabaseDumper,
 
 	data.NewFileRepo,
+	data.NewFileShareLinkRepo,
+	data.NewStorageObjectRepo,
+	data.NewStorageQuotaRepo,
+	data.NewTusUploader,
+	data.NewPresignedUploadStore,
+	data.NewFileShareDownloadSessionStore,
+	data.NewMalwareScanner,
 
 	data.NewInternalMessageRepo,
 	data.NewInternalMessageCategoryRepo,
@@ -0,0 +1,229 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/go-utils/mapper"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/storageobject"
+
+	storageV1 "go-wind-admin/api/gen/go/storage/service/v1"
+)
+
+// StoredObject 物理存储对象，内容相同的文件在同一去重范围内共用一个物理对象
+type StoredObject struct {
+	ID          uint32
+	DedupScope  string
+	Provider    storageV1.OSSProvider
+	ContentHash string
+	BucketName  string
+	ObjectName  string
+	Size        uint64
+	ContentType string
+	RefCount    int32
+}
+
+// StorageObjectRepo 物理存储对象的引用计数
+type StorageObjectRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+
+	providerConverter *mapper.EnumTypeConverter[storageV1.OSSProvider, storageobject.Provider]
+}
+
+func NewStorageObjectRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *StorageObjectRepo {
+	return &StorageObjectRepo{
+		log:               ctx.NewLoggerHelper("storage-object/repo/admin-service"),
+		entClient:         entClient,
+		providerConverter: mapper.NewEnumTypeConverter[storageV1.OSSProvider, storageobject.Provider](storageV1.OSSProvider_name, storageV1.OSSProvider_value),
+	}
+}
+
+func (r *StorageObjectRepo) toStoredObject(entity *ent.StorageObject) *StoredObject {
+	obj := &StoredObject{
+		ID:          entity.ID,
+		DedupScope:  entity.DedupScope,
+		ContentHash: entity.ContentHash,
+		BucketName:  entity.BucketName,
+		ObjectName:  entity.ObjectName,
+		Size:        entity.Size,
+		RefCount:    entity.RefCount,
+	}
+	if provider := r.providerConverter.ToDTO(&entity.Provider); provider != nil {
+		obj.Provider = *provider
+	}
+	if entity.ContentType != nil {
+		obj.ContentType = *entity.ContentType
+	}
+	return obj
+}
+
+// Acquire 查找去重范围内内容相同的物理对象并增加一次引用，不存在时返回 nil
+func (r *StorageObjectRepo) Acquire(ctx context.Context, scope string, provider storageV1.OSSProvider, contentHash string) (*StoredObject, error) {
+	where := storageobject.And(
+		storageobject.DedupScopeEQ(scope),
+		storageobject.ProviderEQ(*r.providerConverter.ToEntity(&provider)),
+		storageobject.ContentHashEQ(contentHash),
+	)
+
+	// 原子地增加引用计数，与 Release 的行锁互斥，不会复用正在被删除的对象
+	affected, err := r.entClient.Client().StorageObject.Update().
+		Where(where).
+		AddRefCount(1).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("acquire storage object failed: %s", err.Error())
+		return nil, storageV1.ErrorInternalServerError("acquire storage object failed")
+	}
+	if affected == 0 {
+		return nil, nil
+	}
+
+	entity, err := r.entClient.Client().StorageObject.Query().Where(where).Only(ctx)
+	if err != nil {
+		r.log.Errorf("query storage object failed: %s", err.Error())
+		return nil, storageV1.ErrorInternalServerError("query storage object failed")
+	}
+
+	return r.toStoredObject(entity), nil
+}
+
+// LocationInUse 是否有物理对象登记在该存储位置
+func (r *StorageObjectRepo) LocationInUse(ctx context.Context, provider storageV1.OSSProvider, bucketName, objectName string) (bool, error) {
+	exist, err := r.entClient.Client().StorageObject.Query().
+		Where(
+			storageobject.ProviderEQ(*r.providerConverter.ToEntity(&provider)),
+			storageobject.BucketNameEQ(bucketName),
+			storageobject.ObjectNameEQ(objectName),
+		).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("query storage object failed: %s", err.Error())
+		return false, storageV1.ErrorInternalServerError("query storage object failed")
+	}
+	return exist, nil
+}
+
+// Register 登记新写入的物理对象，引用计数为1。
+// 并发上传相同内容时以先登记者为准，返回已存在的对象（已增加引用）且 created 为 false，
+// 调用方需删除自己写入的重复对象。
+func (r *StorageObjectRepo) Register(ctx context.Context, obj *StoredObject) (_ *StoredObject, created bool, err error) {
+	if obj == nil || obj.DedupScope == "" || obj.ContentHash == "" {
+		return nil, false, storageV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().StorageObject.Create().
+		SetDedupScope(obj.DedupScope).
+		SetProvider(*r.providerConverter.ToEntity(&obj.Provider)).
+		SetContentHash(obj.ContentHash).
+		SetBucketName(obj.BucketName).
+		SetObjectName(obj.ObjectName).
+		SetSize(obj.Size).
+		SetRefCount(1).
+		SetCreatedAt(time.Now())
+	if obj.ContentType != "" {
+		builder.SetContentType(obj.ContentType)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			existing, err := r.Acquire(ctx, obj.DedupScope, obj.Provider, obj.ContentHash)
+			if err != nil {
+				return nil, false, err
+			}
+			if existing != nil {
+				return existing, false, nil
+			}
+		}
+		r.log.Errorf("insert storage object failed: %s", err.Error())
+		return nil, false, storageV1.ErrorInternalServerError("insert storage object failed")
+	}
+
+	return r.toStoredObject(entity), true, nil
+}
+
+// Release 释放一次引用，最后一个引用释放时删除记录并返回该对象，调用方负责删除物理对象；
+// 仍有其他引用时返回 nil。
+func (r *StorageObjectRepo) Release(ctx context.Context, id uint32) (obj *StoredObject, err error) {
+	var tx *ent.Tx
+	tx, err = r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return nil, storageV1.ErrorInternalServerError("start transaction failed")
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				r.log.Errorf("transaction rollback failed: %s", rollbackErr.Error())
+			}
+			return
+		}
+		if commitErr := tx.Commit(); commitErr != nil {
+			r.log.Errorf("transaction commit failed: %s", commitErr.Error())
+			obj, err = nil, storageV1.ErrorInternalServerError("transaction commit failed")
+		}
+	}()
+
+	var entity *ent.StorageObject
+	entity, err = tx.StorageObject.Query().
+		Where(storageobject.IDEQ(id)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			// 记录已被删除（例如对账任务已清理），无需处理
+			return nil, nil
+		}
+		r.log.Errorf("query storage object failed: %s", err.Error())
+		return nil, storageV1.ErrorInternalServerError("query storage object failed")
+	}
+
+	if entity.RefCount > 1 {
+		if err = tx.StorageObject.UpdateOneID(id).
+			AddRefCount(-1).
+			SetUpdatedAt(time.Now()).
+			Exec(ctx); err != nil {
+			r.log.Errorf("release storage object failed: %s", err.Error())
+			return nil, storageV1.ErrorInternalServerError("release storage object failed")
+		}
+		return nil, nil
+	}
+
+	if err = tx.StorageObject.DeleteOneID(id).Exec(ctx); err != nil {
+		r.log.Errorf("delete storage object failed: %s", err.Error())
+		return nil, storageV1.ErrorInternalServerError("delete storage object failed")
+	}
+
+	return r.toStoredObject(entity), nil
+}
+
+// Relocate 物理对象被移动（例如隔离）后更新其存储位置，之后去重命中该对象的文件记录也指向新位置
+func (r *StorageObjectRepo) Relocate(
+	ctx context.Context,
+	provider storageV1.OSSProvider,
+	bucketName, objectName string,
+	newBucketName, newObjectName string,
+) error {
+	if err := r.entClient.Client().StorageObject.Update().
+		Where(
+			storageobject.ProviderEQ(*r.providerConverter.ToEntity(&provider)),
+			storageobject.BucketNameEQ(bucketName),
+			storageobject.ObjectNameEQ(objectName),
+		).
+		SetBucketName(newBucketName).
+		SetObjectName(newObjectName).
+		SetUpdatedAt(time.Now()).
+		Exec(ctx); err != nil {
+		r.log.Errorf("relocate storage object failed: %s", err.Error())
+		return storageV1.ErrorInternalServerError("relocate storage object failed")
+	}
+	return nil
+}
@@ -0,0 +1,310 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"go-wind-admin/app/admin/service/internal/data/ent/storagequota"
+
+	"github.com/tx7do/go-utils/copierutil"
+	"github.com/tx7do/go-utils/mapper"
+
+	storageV1 "go-wind-admin/api/gen/go/storage/service/v1"
+)
+
+// StorageQuotaPlansEnv 订阅套餐的默认存储配额（JSON），键为订阅套餐名称，例如：
+// {"基础版": {"max_bytes": 10737418240, "max_files": 100000, "user_max_bytes": 1073741824}}
+const StorageQuotaPlansEnv = "STORAGE_QUOTA_PLANS"
+
+// StorageQuotaPlan 订阅套餐的默认存储配额，0表示不限制
+type StorageQuotaPlan struct {
+	MaxBytes     int64 `json:"max_bytes,omitempty"`
+	MaxFiles     int64 `json:"max_files,omitempty"`
+	UserMaxBytes int64 `json:"user_max_bytes,omitempty"`
+	UserMaxFiles int64 `json:"user_max_files,omitempty"`
+}
+
+type StorageQuotaRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+
+	mapper *mapper.CopierMapper[storageV1.StorageQuota, ent.StorageQuota]
+
+	repository *entCrud.Repository[
+		ent.StorageQuotaQuery, ent.StorageQuotaSelect,
+		ent.StorageQuotaCreate, ent.StorageQuotaCreateBulk,
+		ent.StorageQuotaUpdate, ent.StorageQuotaUpdateOne,
+		ent.StorageQuotaDelete,
+		predicate.StorageQuota,
+		storageV1.StorageQuota, ent.StorageQuota,
+	]
+
+	plans map[string]StorageQuotaPlan
+}
+
+func NewStorageQuotaRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *StorageQuotaRepo {
+	repo := &StorageQuotaRepo{
+		log:       ctx.NewLoggerHelper("storage-quota/repo/admin-service"),
+		entClient: entClient,
+		mapper:    mapper.NewCopierMapper[storageV1.StorageQuota, ent.StorageQuota](),
+	}
+
+	repo.init()
+
+	return repo
+}
+
+func (r *StorageQuotaRepo) init() {
+	r.repository = entCrud.NewRepository[
+		ent.StorageQuotaQuery, ent.StorageQuotaSelect,
+		ent.StorageQuotaCreate, ent.StorageQuotaCreateBulk,
+		ent.StorageQuotaUpdate, ent.StorageQuotaUpdateOne,
+		ent.StorageQuotaDelete,
+		predicate.StorageQuota,
+		storageV1.StorageQuota, ent.StorageQuota,
+	](r.mapper)
+
+	r.mapper.AppendConverters(copierutil.NewTimeStringConverterPair())
+	r.mapper.AppendConverters(copierutil.NewTimeTimestamppbConverterPair())
+
+	if raw := strings.TrimSpace(os.Getenv(StorageQuotaPlansEnv)); raw != "" {
+		if err := json.Unmarshal([]byte(raw), &r.plans); err != nil {
+			r.log.Errorf("parse %s failed, plan default quotas are disabled: %s", StorageQuotaPlansEnv, err.Error())
+			r.plans = nil
+		}
+	}
+}
+
+// PlanQuota 获取订阅套餐的默认配额，套餐名称不能精确匹配时使用包含在名称中的最长套餐键，
+// 例如 "企业版1年" 匹配 "企业版"
+func (r *StorageQuotaRepo) PlanQuota(plan string) *StorageQuotaPlan {
+	plan = strings.TrimSpace(plan)
+	if plan == "" || len(r.plans) == 0 {
+		return nil
+	}
+
+	if p, ok := r.plans[plan]; ok {
+		return &p
+	}
+
+	var matched string
+	for key := range r.plans {
+		if key != "" && strings.Contains(plan, key) && len(key) > len(matched) {
+			matched = key
+		}
+	}
+	if matched == "" {
+		return nil
+	}
+
+	p := r.plans[matched]
+	return &p
+}
+
+func (r *StorageQuotaRepo) List(ctx context.Context, req *paginationV1.PagingRequest) (*storageV1.ListStorageQuotaResponse, error) {
+	if req == nil {
+		return nil, storageV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().StorageQuota.Query()
+
+	ret, err := r.repository.ListWithPaging(ctx, builder, builder.Clone(), req)
+	if err != nil {
+		return nil, err
+	}
+	if ret == nil {
+		return &storageV1.ListStorageQuotaResponse{Total: 0, Items: nil}, nil
+	}
+
+	return &storageV1.ListStorageQuotaResponse{
+		Total: ret.Total,
+		Items: ret.Items,
+	}, nil
+}
+
+// ListByTenant 查询配额记录，tenantID 为空时查询全部租户（供对账任务使用）
+func (r *StorageQuotaRepo) ListByTenant(ctx context.Context, tenantID *uint32) ([]*storageV1.StorageQuota, error) {
+	builder := r.entClient.Client().StorageQuota.Query()
+	if tenantID != nil {
+		builder.Where(storagequota.TenantIDEQ(*tenantID))
+	}
+
+	entities, err := builder.Order(ent.Asc(storagequota.FieldID)).All(ctx)
+	if err != nil {
+		r.log.Errorf("query storage quotas failed: %s", err.Error())
+		return nil, storageV1.ErrorInternalServerError("query storage quotas failed")
+	}
+
+	dtos := make([]*storageV1.StorageQuota, 0, len(entities))
+	for _, entity := range entities {
+		dtos = append(dtos, r.mapper.ToDTO(entity))
+	}
+
+	return dtos, nil
+}
+
+// GetByID 根据ID查询配额记录
+func (r *StorageQuotaRepo) GetByID(ctx context.Context, id uint32) (*storageV1.StorageQuota, error) {
+	entity, err := r.entClient.Client().StorageQuota.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, storageV1.ErrorNotFound("storage quota not found")
+		}
+		r.log.Errorf("query storage quota failed: %s", err.Error())
+		return nil, storageV1.ErrorInternalServerError("query storage quota failed")
+	}
+
+	return r.mapper.ToDTO(entity), nil
+}
+
+// Get 查询租户（userID 为 0）或用户的配额记录，不存在时返回 nil
+func (r *StorageQuotaRepo) Get(ctx context.Context, tenantID, userID uint32) (*storageV1.StorageQuota, error) {
+	entity, err := r.entClient.Client().StorageQuota.Query().
+		Where(
+			storagequota.TenantIDEQ(tenantID),
+			storagequota.UserIDEQ(userID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("query storage quota failed: %s", err.Error())
+		return nil, storageV1.ErrorInternalServerError("query storage quota failed")
+	}
+
+	return r.mapper.ToDTO(entity), nil
+}
+
+// upsert 按租户和用户插入或更新配额记录，返回更新后的记录
+func (r *StorageQuotaRepo) upsert(
+	ctx context.Context,
+	tenantID, userID uint32,
+	create func(*ent.StorageQuotaCreate),
+	update func(*ent.StorageQuotaUpsert),
+) (*storageV1.StorageQuota, error) {
+	now := time.Now()
+
+	builder := r.entClient.Client().StorageQuota.Create().
+		SetTenantID(tenantID).
+		SetUserID(userID).
+		SetCreatedAt(now)
+	create(builder)
+
+	id, err := builder.
+		OnConflict(
+			sql.ConflictColumns(storagequota.FieldTenantID, storagequota.FieldUserID),
+		).
+		Update(func(u *ent.StorageQuotaUpsert) {
+			update(u)
+			u.SetUpdatedAt(now)
+		}).
+		ID(ctx)
+	if err != nil {
+		r.log.Errorf("upsert storage quota failed: %s", err.Error())
+		return nil, storageV1.ErrorInternalServerError("upsert storage quota failed")
+	}
+
+	return r.GetByID(ctx, id)
+}
+
+// SetLimits 设置配额上限，为空的上限改为使用订阅套餐的默认配额
+func (r *StorageQuotaRepo) SetLimits(ctx context.Context, tenantID, userID uint32, maxBytes, maxFiles *int64, operatorID uint32) (*storageV1.StorageQuota, error) {
+	return r.upsert(ctx, tenantID, userID,
+		func(c *ent.StorageQuotaCreate) {
+			c.SetNillableMaxBytes(maxBytes).
+				SetNillableMaxFiles(maxFiles).
+				SetCreatedBy(operatorID)
+		},
+		func(u *ent.StorageQuotaUpsert) {
+			if maxBytes != nil {
+				u.SetMaxBytes(*maxBytes)
+			} else {
+				u.ClearMaxBytes()
+			}
+			if maxFiles != nil {
+				u.SetMaxFiles(*maxFiles)
+			} else {
+				u.ClearMaxFiles()
+			}
+			u.SetUpdatedBy(operatorID)
+		},
+	)
+}
+
+// ClearLimits 清除配额上限，恢复为订阅套餐的默认配额，已用量保留
+func (r *StorageQuotaRepo) ClearLimits(ctx context.Context, id uint32, operatorID uint32) error {
+	if err := r.entClient.Client().StorageQuota.UpdateOneID(id).
+		ClearMaxBytes().
+		ClearMaxFiles().
+		SetUpdatedBy(operatorID).
+		SetUpdatedAt(time.Now()).
+		Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return storageV1.ErrorNotFound("storage quota not found")
+		}
+		r.log.Errorf("clear storage quota failed: %s", err.Error())
+		return storageV1.ErrorInternalServerError("clear storage quota failed")
+	}
+	return nil
+}
+
+// AddUsage 原子地累加已用量，返回更新后的记录
+func (r *StorageQuotaRepo) AddUsage(ctx context.Context, tenantID, userID uint32, deltaBytes, deltaFiles int64) (*storageV1.StorageQuota, error) {
+	return r.upsert(ctx, tenantID, userID,
+		func(c *ent.StorageQuotaCreate) {
+			c.SetUsedBytes(max(deltaBytes, 0)).
+				SetUsedFiles(max(deltaFiles, 0))
+		},
+		func(u *ent.StorageQuotaUpsert) {
+			u.AddUsedBytes(deltaBytes).
+				AddUsedFiles(deltaFiles)
+		},
+	)
+}
+
+// SetUsage 以对账结果覆盖已用量
+func (r *StorageQuotaRepo) SetUsage(ctx context.Context, tenantID, userID uint32, usedBytes, usedFiles int64) (*storageV1.StorageQuota, error) {
+	now := time.Now()
+	return r.upsert(ctx, tenantID, userID,
+		func(c *ent.StorageQuotaCreate) {
+			c.SetUsedBytes(usedBytes).
+				SetUsedFiles(usedFiles).
+				SetReconciledAt(now)
+		},
+		func(u *ent.StorageQuotaUpsert) {
+			u.SetUsedBytes(usedBytes).
+				SetUsedFiles(usedFiles).
+				SetReconciledAt(now)
+		},
+	)
+}
+
+// MarkNotified 把已提醒的阈值从 from 改为 to，返回是否由本次调用完成修改，
+// 多个实例同时越过阈值时只有一个实例会发送提醒
+func (r *StorageQuotaRepo) MarkNotified(ctx context.Context, id uint32, from, to uint32) (bool, error) {
+	affected, err := r.entClient.Client().StorageQuota.Update().
+		Where(
+			storagequota.IDEQ(id),
+			storagequota.NotifiedPercentEQ(from),
+		).
+		SetNotifiedPercent(to).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("update storage quota notified percent failed: %s", err.Error())
+		return false, storageV1.ErrorInternalServerError("update storage quota failed")
+	}
+	return affected > 0, nil
+}
@@ -0,0 +1,115 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"go-wind-admin/pkg/task"
+)
+
+// taskProgressChannel 任务进度通知频道
+const taskProgressChannel = "task:progress"
+
+// TaskProgressBroker 持久化任务进度快照，并通过 Redis 发布/订阅把进度分发到所有实例，
+// 使订阅者无论连接到哪个实例都能收到其他实例上执行的任务进度
+type TaskProgressBroker struct {
+	log *log.Helper
+
+	rdb  *redis.Client
+	repo *TaskRunRepo
+
+	mu        sync.RWMutex
+	listeners []func(*task.Progress)
+
+	wg sync.WaitGroup
+}
+
+var _ task.ProgressSink = (*TaskProgressBroker)(nil)
+
+func NewTaskProgressBroker(
+	ctx *bootstrap.Context,
+	rdb *redis.Client,
+	repo *TaskRunRepo,
+) (*TaskProgressBroker, func(), error) {
+	b := &TaskProgressBroker{
+		log:  ctx.NewLoggerHelper("task-progress-broker/data/admin-service"),
+		rdb:  rdb,
+		repo: repo,
+	}
+
+	var pubsub *redis.PubSub
+	if rdb != nil {
+		pubsub = rdb.Subscribe(ctx.Context(), taskProgressChannel)
+		b.wg.Add(1)
+		go b.listen(pubsub)
+	}
+
+	return b, func() {
+		if pubsub != nil {
+			if err := pubsub.Close(); err != nil {
+				b.log.Warnf("close task progress subscription failed: %s", err.Error())
+			}
+		}
+		b.wg.Wait()
+	}, nil
+}
+
+// OnProgress 注册进度监听器，所有实例上执行的任务进度都会回调
+func (b *TaskProgressBroker) OnProgress(fn func(*task.Progress)) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.listeners = append(b.listeners, fn)
+}
+
+// ReportProgress 保存进度快照并通知所有实例
+func (b *TaskProgressBroker) ReportProgress(ctx context.Context, p *task.Progress) error {
+	if err := b.repo.SaveProgress(ctx, p); err != nil {
+		return err
+	}
+
+	if b.rdb == nil {
+		b.dispatch(p)
+		return nil
+	}
+
+	data, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+
+	return b.rdb.Publish(ctx, taskProgressChannel, data).Err()
+}
+
+// LatestProgress 查询任务最近一次执行的进度快照
+func (b *TaskProgressBroker) LatestProgress(ctx context.Context, taskID string) (*task.Progress, error) {
+	return b.repo.GetLatestProgress(ctx, taskID)
+}
+
+func (b *TaskProgressBroker) listen(pubsub *redis.PubSub) {
+	defer b.wg.Done()
+
+	for msg := range pubsub.Channel() {
+		var p task.Progress
+		if err := json.Unmarshal([]byte(msg.Payload), &p); err != nil {
+			b.log.Warnf("invalid task progress notification: %s", err.Error())
+			continue
+		}
+
+		b.dispatch(&p)
+	}
+}
+
+func (b *TaskProgressBroker) dispatch(p *task.Progress) {
+	b.mu.RLock()
+	listeners := b.listeners
+	b.mu.RUnlock()
+
+	for _, fn := range listeners {
+		fn(p)
+	}
+}
@@ -19,14 +19,17 @@ import (
 	"github.com/tx7do/go-utils/mapper"
 
 	taskV1 "go-wind-admin/api/gen/go/task/service/v1"
+
+	appViewer "go-wind-admin/pkg/entgo/viewer"
 )
 
 type TaskRepo struct {
 	entClient *entCrud.EntClient[*ent.Client]
 	log       *log.Helper
 
-	mapper        *mapper.CopierMapper[taskV1.Task, ent.Task]
-	typeConverter *mapper.EnumTypeConverter[taskV1.Task_Type, task.Type]
+	mapper                 *mapper.CopierMapper[taskV1.Task, ent.Task]
+	typeConverter          *mapper.EnumTypeConverter[taskV1.Task_Type, task.Type]
+	misfirePolicyConverter *mapper.EnumTypeConverter[taskV1.Task_MisfirePolicy, task.MisfirePolicy]
 
 	repository *entCrud.Repository[
 		ent.TaskQuery, ent.TaskSelect,
@@ -40,10 +43,11 @@ type TaskRepo struct {
 
 func NewTaskRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *TaskRepo {
 	repo := &TaskRepo{
-		log:           ctx.NewLoggerHelper("task/repo/admin-service"),
-		entClient:     entClient,
-		mapper:        mapper.NewCopierMapper[taskV1.Task, ent.Task](),
-		typeConverter: mapper.NewEnumTypeConverter[taskV1.Task_Type, task.Type](taskV1.Task_Type_name, taskV1.Task_Type_value),
+		log:                    ctx.NewLoggerHelper("task/repo/admin-service"),
+		entClient:              entClient,
+		mapper:                 mapper.NewCopierMapper[taskV1.Task, ent.Task](),
+		typeConverter:          mapper.NewEnumTypeConverter[taskV1.Task_Type, task.Type](taskV1.Task_Type_name, taskV1.Task_Type_value),
+		misfirePolicyConverter: mapper.NewEnumTypeConverter[taskV1.Task_MisfirePolicy, task.MisfirePolicy](taskV1.Task_MisfirePolicy_name, taskV1.Task_MisfirePolicy_value),
 	}
 
 	repo.init()
@@ -65,6 +69,7 @@ func (r *TaskRepo) init() {
 	r.mapper.AppendConverters(copierutil.NewTimeTimestamppbConverterPair())
 
 	r.mapper.AppendConverters(r.typeConverter.NewConverterPair())
+	r.mapper.AppendConverters(r.misfirePolicyConverter.NewConverterPair())
 }
 
 func (r *TaskRepo) Count(ctx context.Context, whereCond []func(s *sql.Selector)) (int, error) {
@@ -114,6 +119,21 @@ func (r *TaskRepo) IsExist(ctx context.Context, id uint32) (bool, error) {
 	return exist, nil
 }
 
+// TypeNameExistsInTenant 租户下是否存在指定类型名称的任务
+func (r *TaskRepo) TypeNameExistsInTenant(ctx context.Context, tenantID uint32, typeName string) (bool, error) {
+	exist, err := r.entClient.Client().Task.Query().
+		Where(
+			task.TenantIDEQ(tenantID),
+			task.TypeNameEQ(typeName),
+		).
+		Exist(appViewer.NewSystemViewerContext(ctx))
+	if err != nil {
+		r.log.Errorf("query task type name exist failed: %s", err.Error())
+		return false, taskV1.ErrorInternalServerError("query exist failed")
+	}
+	return exist, nil
+}
+
 func (r *TaskRepo) Get(ctx context.Context, req *taskV1.GetTaskRequest) (*taskV1.Task, error) {
 	if req == nil {
 		return nil, taskV1.ErrorBadRequest("invalid parameter")
@@ -150,6 +170,8 @@ func (r *TaskRepo) Create(ctx context.Context, req *taskV1.CreateTaskRequest) (*
 		SetNillableTypeName(req.Data.TypeName).
 		SetNillableTaskPayload(req.Data.TaskPayload).
 		SetNillableCronSpec(req.Data.CronSpec).
+		SetNillableTimezone(req.Data.Timezone).
+		SetNillableMisfirePolicy(r.misfirePolicyConverter.ToEntity(req.Data.MisfirePolicy)).
 		SetNillableEnable(req.Data.Enable).
 		SetNillableRemark(req.Data.Remark).
 		SetNillableCreatedBy(req.Data.CreatedBy).
@@ -202,6 +224,8 @@ func (r *TaskRepo) Update(ctx context.Context, req *taskV1.UpdateTaskRequest) (*
 				SetNillableTypeName(req.Data.TypeName).
 				SetNillableTaskPayload(req.Data.TaskPayload).
 				SetNillableCronSpec(req.Data.CronSpec).
+				SetNillableTimezone(req.Data.Timezone).
+				SetNillableMisfirePolicy(r.misfirePolicyConverter.ToEntity(req.Data.MisfirePolicy)).
 				SetNillableEnable(req.Data.Enable).
 				SetNillableRemark(req.Data.Remark).
 				SetNillableUpdatedBy(req.Data.UpdatedBy).
@@ -0,0 +1,264 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/go-utils/timeutil"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"go-wind-admin/app/admin/service/internal/data/ent/taskrun"
+
+	"github.com/tx7do/go-utils/copierutil"
+	"github.com/tx7do/go-utils/mapper"
+
+	taskV1 "go-wind-admin/api/gen/go/task/service/v1"
+
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+	"go-wind-admin/pkg/task"
+)
+
+// TaskRunRepo 任务执行记录，同时实现 task.RunStore 供asynq中间件写入
+type TaskRunRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+
+	mapper          *mapper.CopierMapper[taskV1.TaskRun, ent.TaskRun]
+	statusConverter *mapper.EnumTypeConverter[taskV1.TaskRun_Status, taskrun.Status]
+
+	repository *entCrud.Repository[
+		ent.TaskRunQuery, ent.TaskRunSelect,
+		ent.TaskRunCreate, ent.TaskRunCreateBulk,
+		ent.TaskRunUpdate, ent.TaskRunUpdateOne,
+		ent.TaskRunDelete,
+		predicate.TaskRun,
+		taskV1.TaskRun, ent.TaskRun,
+	]
+}
+
+var _ task.RunStore = (*TaskRunRepo)(nil)
+
+func NewTaskRunRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *TaskRunRepo {
+	repo := &TaskRunRepo{
+		log:             ctx.NewLoggerHelper("task-run/repo/admin-service"),
+		entClient:       entClient,
+		mapper:          mapper.NewCopierMapper[taskV1.TaskRun, ent.TaskRun](),
+		statusConverter: mapper.NewEnumTypeConverter[taskV1.TaskRun_Status, taskrun.Status](taskV1.TaskRun_Status_name, taskV1.TaskRun_Status_value),
+	}
+
+	repo.init()
+
+	return repo
+}
+
+func (r *TaskRunRepo) init() {
+	r.repository = entCrud.NewRepository[
+		ent.TaskRunQuery, ent.TaskRunSelect,
+		ent.TaskRunCreate, ent.TaskRunCreateBulk,
+		ent.TaskRunUpdate, ent.TaskRunUpdateOne,
+		ent.TaskRunDelete,
+		predicate.TaskRun,
+		taskV1.TaskRun, ent.TaskRun,
+	](r.mapper)
+
+	r.mapper.AppendConverters(copierutil.NewTimeStringConverterPair())
+	r.mapper.AppendConverters(copierutil.NewTimeTimestamppbConverterPair())
+
+	r.mapper.AppendConverters(r.statusConverter.NewConverterPair())
+}
+
+func (r *TaskRunRepo) List(ctx context.Context, req *paginationV1.PagingRequest) (*taskV1.ListTaskRunResponse, error) {
+	if req == nil {
+		return nil, taskV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().TaskRun.Query()
+
+	// 列表不返回日志内容，避免响应过大
+	builder.Select(
+		taskrun.FieldID,
+		taskrun.FieldTaskID,
+		taskrun.FieldTypeName,
+		taskrun.FieldQueue,
+		taskrun.FieldWorker,
+		taskrun.FieldAttempt,
+		taskrun.FieldMaxRetry,
+		taskrun.FieldStatus,
+		taskrun.FieldStartedAt,
+		taskrun.FieldFinishedAt,
+		taskrun.FieldDurationMs,
+		taskrun.FieldErrorMessage,
+		taskrun.FieldLogsTruncated,
+		taskrun.FieldCreatedAt,
+		taskrun.FieldUpdatedAt,
+	)
+
+	if req.GetOrderBy() == "" && len(req.GetSorting()) == 0 {
+		req.OrderBy = trans.Ptr("id desc")
+	}
+
+	ret, err := r.repository.ListWithPaging(ctx, builder, builder.Clone(), req)
+	if err != nil {
+		return nil, err
+	}
+	if ret == nil {
+		return &taskV1.ListTaskRunResponse{Total: 0, Items: nil}, nil
+	}
+
+	return &taskV1.ListTaskRunResponse{
+		Total: ret.Total,
+		Items: ret.Items,
+	}, nil
+}
+
+func (r *TaskRunRepo) Get(ctx context.Context, req *taskV1.GetTaskRunRequest) (*taskV1.TaskRun, error) {
+	if req == nil {
+		return nil, taskV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().TaskRun.Query()
+
+	return r.repository.Get(ctx, builder, req.GetViewMask(), taskrun.IDEQ(req.GetId()))
+}
+
+// PurgeBefore 删除开始时间早于before的执行记录，typeName为空时不限任务类型
+func (r *TaskRunRepo) PurgeBefore(ctx context.Context, before time.Time, typeName string) (uint64, error) {
+	builder := r.entClient.Client().TaskRun.Delete().
+		Where(taskrun.StartedAtLT(before))
+	if typeName != "" {
+		builder.Where(taskrun.TypeNameEQ(typeName))
+	}
+
+	affected, err := builder.Exec(ctx)
+	if err != nil {
+		r.log.Errorf("purge task runs failed: %s", err.Error())
+		return 0, taskV1.ErrorInternalServerError("purge task runs failed")
+	}
+
+	return uint64(affected), nil
+}
+
+// CreateRun 写入一条执行中的记录
+func (r *TaskRunRepo) CreateRun(ctx context.Context, run *task.Run) (uint32, error) {
+	ctx = appViewer.NewSystemViewerContext(ctx)
+
+	entity, err := r.entClient.Client().TaskRun.Create().
+		SetTaskID(run.TaskID).
+		SetTypeName(run.TaskType).
+		SetQueue(run.Queue).
+		SetPayload(run.Payload).
+		SetWorker(run.Worker).
+		SetAttempt(run.Attempt).
+		SetMaxRetry(run.MaxRetry).
+		SetStatus(taskrun.Status(run.Status)).
+		SetStartedAt(run.StartedAt).
+		SetCreatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("insert task run failed: %s", err.Error())
+		return 0, taskV1.ErrorInternalServerError("insert task run failed")
+	}
+
+	return entity.ID, nil
+}
+
+// FinishRun 更新执行结果、耗时和日志
+func (r *TaskRunRepo) FinishRun(ctx context.Context, run *task.Run) error {
+	ctx = appViewer.NewSystemViewerContext(ctx)
+
+	builder := r.entClient.Client().TaskRun.UpdateOneID(run.ID).
+		SetStatus(taskrun.Status(run.Status)).
+		SetFinishedAt(run.FinishedAt).
+		SetDurationMs(run.DurationMs).
+		SetLogs(run.Logs).
+		SetLogsTruncated(run.LogsTruncated).
+		SetUpdatedAt(time.Now())
+	if run.Result != "" {
+		builder.SetResult(run.Result)
+	}
+	if run.Error != "" {
+		builder.SetErrorMessage(run.Error)
+	}
+
+	if err := builder.Exec(ctx); err != nil {
+		r.log.Errorf("update task run failed: %s", err.Error())
+		return taskV1.ErrorInternalServerError("update task run failed")
+	}
+
+	return nil
+}
+
+// SaveProgress 保存执行进度快照
+func (r *TaskRunRepo) SaveProgress(ctx context.Context, p *task.Progress) error {
+	if p == nil || p.RunID == 0 {
+		return nil
+	}
+
+	ctx = appViewer.NewSystemViewerContext(ctx)
+
+	progress := &taskV1.TaskRunProgress{
+		Percent:   p.Percent,
+		Logs:      p.Logs,
+		LogSeq:    p.LogSeq,
+		UpdatedAt: timeutil.TimeToTimestamppb(&p.UpdatedAt),
+	}
+	if p.Stage != "" {
+		progress.Stage = trans.Ptr(p.Stage)
+	}
+	if p.Message != "" {
+		progress.Message = trans.Ptr(p.Message)
+	}
+
+	if err := r.entClient.Client().TaskRun.UpdateOneID(p.RunID).
+		SetProgress(progress).
+		Exec(ctx); err != nil {
+		r.log.Errorf("save task run progress failed: %s", err.Error())
+		return taskV1.ErrorInternalServerError("save task run progress failed")
+	}
+
+	return nil
+}
+
+// GetLatestProgress 查询任务最近一次执行的进度快照，不存在时返回nil
+func (r *TaskRunRepo) GetLatestProgress(ctx context.Context, taskID string) (*task.Progress, error) {
+	entity, err := r.entClient.Client().TaskRun.Query().
+		Where(taskrun.TaskIDEQ(taskID)).
+		Order(ent.Desc(taskrun.FieldID)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("query task run progress failed: %s", err.Error())
+		return nil, taskV1.ErrorInternalServerError("query task run progress failed")
+	}
+
+	p := &task.Progress{
+		RunID:    entity.ID,
+		TaskID:   taskID,
+		TaskType: trans.StringValue(entity.TypeName),
+		Attempt:  trans.Uint32Value(entity.Attempt),
+	}
+	if entity.Status != nil {
+		p.Status = task.RunStatus(*entity.Status)
+	}
+	if entity.Progress != nil {
+		p.Percent = entity.Progress.GetPercent()
+		p.Stage = entity.Progress.GetStage()
+		p.Message = entity.Progress.GetMessage()
+		p.Logs = entity.Progress.GetLogs()
+		p.LogSeq = entity.Progress.GetLogSeq()
+		if entity.Progress.UpdatedAt != nil {
+			p.UpdatedAt = entity.Progress.GetUpdatedAt().AsTime()
+		}
+	}
+
+	return p, nil
+}
@@ -0,0 +1,206 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"go-wind-admin/app/admin/service/internal/data/ent/taskworkflow"
+
+	"github.com/tx7do/go-utils/copierutil"
+	"github.com/tx7do/go-utils/mapper"
+
+	taskV1 "go-wind-admin/api/gen/go/task/service/v1"
+)
+
+// TaskWorkflowRepo 任务工作流定义
+type TaskWorkflowRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+
+	mapper *mapper.CopierMapper[taskV1.TaskWorkflow, ent.TaskWorkflow]
+
+	repository *entCrud.Repository[
+		ent.TaskWorkflowQuery, ent.TaskWorkflowSelect,
+		ent.TaskWorkflowCreate, ent.TaskWorkflowCreateBulk,
+		ent.TaskWorkflowUpdate, ent.TaskWorkflowUpdateOne,
+		ent.TaskWorkflowDelete,
+		predicate.TaskWorkflow,
+		taskV1.TaskWorkflow, ent.TaskWorkflow,
+	]
+}
+
+func NewTaskWorkflowRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *TaskWorkflowRepo {
+	repo := &TaskWorkflowRepo{
+		log:       ctx.NewLoggerHelper("task-workflow/repo/admin-service"),
+		entClient: entClient,
+		mapper:    mapper.NewCopierMapper[taskV1.TaskWorkflow, ent.TaskWorkflow](),
+	}
+
+	repo.init()
+
+	return repo
+}
+
+func (r *TaskWorkflowRepo) init() {
+	r.repository = entCrud.NewRepository[
+		ent.TaskWorkflowQuery, ent.TaskWorkflowSelect,
+		ent.TaskWorkflowCreate, ent.TaskWorkflowCreateBulk,
+		ent.TaskWorkflowUpdate, ent.TaskWorkflowUpdateOne,
+		ent.TaskWorkflowDelete,
+		predicate.TaskWorkflow,
+		taskV1.TaskWorkflow, ent.TaskWorkflow,
+	](r.mapper)
+
+	r.mapper.AppendConverters(copierutil.NewTimeStringConverterPair())
+	r.mapper.AppendConverters(copierutil.NewTimeTimestamppbConverterPair())
+}
+
+func (r *TaskWorkflowRepo) List(ctx context.Context, req *paginationV1.PagingRequest) (*taskV1.ListTaskWorkflowResponse, error) {
+	if req == nil {
+		return nil, taskV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().TaskWorkflow.Query()
+
+	ret, err := r.repository.ListWithPaging(ctx, builder, builder.Clone(), req)
+	if err != nil {
+		return nil, err
+	}
+	if ret == nil {
+		return &taskV1.ListTaskWorkflowResponse{Total: 0, Items: nil}, nil
+	}
+
+	return &taskV1.ListTaskWorkflowResponse{
+		Total: ret.Total,
+		Items: ret.Items,
+	}, nil
+}
+
+func (r *TaskWorkflowRepo) IsExist(ctx context.Context, id uint32) (bool, error) {
+	exist, err := r.entClient.Client().TaskWorkflow.Query().
+		Where(taskworkflow.IDEQ(id)).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("query exist failed: %s", err.Error())
+		return false, taskV1.ErrorInternalServerError("query exist failed")
+	}
+	return exist, nil
+}
+
+func (r *TaskWorkflowRepo) Get(ctx context.Context, req *taskV1.GetTaskWorkflowRequest) (*taskV1.TaskWorkflow, error) {
+	if req == nil {
+		return nil, taskV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().TaskWorkflow.Query()
+
+	var whereCond []func(s *sql.Selector)
+	switch req.QueryBy.(type) {
+	default:
+	case *taskV1.GetTaskWorkflowRequest_Id:
+		whereCond = append(whereCond, taskworkflow.IDEQ(req.GetId()))
+	}
+
+	return r.repository.Get(ctx, builder, req.GetViewMask(), whereCond...)
+}
+
+func (r *TaskWorkflowRepo) Create(ctx context.Context, req *taskV1.CreateTaskWorkflowRequest) (*taskV1.TaskWorkflow, error) {
+	if req == nil || req.Data == nil {
+		return nil, taskV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().TaskWorkflow.Create().
+		SetNillableTenantID(req.Data.TenantId).
+		SetNillableName(req.Data.Name).
+		SetNillableDescription(req.Data.Description).
+		SetNillableEnable(req.Data.Enable).
+		SetNillableCreatedBy(req.Data.CreatedBy).
+		SetSteps(req.Data.Steps).
+		SetCreatedAt(time.Now())
+
+	if req.Data.Id != nil {
+		builder.SetID(req.GetData().GetId())
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, taskV1.ErrorConflict("task workflow name already exists")
+		}
+		r.log.Errorf("insert task workflow failed: %s", err.Error())
+		return nil, taskV1.ErrorInternalServerError("insert task workflow failed")
+	}
+
+	return r.mapper.ToDTO(entity), nil
+}
+
+func (r *TaskWorkflowRepo) Update(ctx context.Context, req *taskV1.UpdateTaskWorkflowRequest) (*taskV1.TaskWorkflow, error) {
+	if req == nil || req.Data == nil {
+		return nil, taskV1.ErrorBadRequest("invalid parameter")
+	}
+	if req.GetId() == 0 {
+		return nil, taskV1.ErrorBadRequest("id is required")
+	}
+
+	// 如果不存在则创建
+	if req.GetAllowMissing() {
+		exist, err := r.IsExist(ctx, req.GetId())
+		if err != nil {
+			return nil, err
+		}
+		if !exist {
+			createReq := &taskV1.CreateTaskWorkflowRequest{Data: req.Data}
+			createReq.Data.CreatedBy = createReq.Data.UpdatedBy
+			createReq.Data.UpdatedBy = nil
+			return r.Create(ctx, createReq)
+		}
+	}
+
+	builder := r.entClient.Client().TaskWorkflow.UpdateOneID(req.GetId())
+	result, err := r.repository.UpdateOne(ctx, builder, req.Data, req.GetUpdateMask(),
+		func(dto *taskV1.TaskWorkflow) {
+			builder.
+				SetNillableName(req.Data.Name).
+				SetNillableDescription(req.Data.Description).
+				SetNillableEnable(req.Data.Enable).
+				SetNillableUpdatedBy(req.Data.UpdatedBy).
+				SetUpdatedAt(time.Now())
+
+			if req.Data.Steps != nil {
+				builder.SetSteps(req.Data.Steps)
+			}
+		},
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(taskworkflow.FieldID, req.GetId()))
+		},
+	)
+
+	return result, err
+}
+
+func (r *TaskWorkflowRepo) Delete(ctx context.Context, req *taskV1.DeleteTaskWorkflowRequest) error {
+	if req == nil {
+		return taskV1.ErrorBadRequest("invalid parameter")
+	}
+
+	if err := r.entClient.Client().TaskWorkflow.DeleteOneID(req.GetId()).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return taskV1.ErrorNotFound("task workflow not found")
+		}
+
+		r.log.Errorf("delete one data failed: %s", err.Error())
+
+		return taskV1.ErrorInternalServerError("delete failed")
+	}
+
+	return nil
+}
@@ -0,0 +1,231 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"go-wind-admin/app/admin/service/internal/data/ent/taskworkflowrun"
+
+	"github.com/tx7do/go-utils/copierutil"
+	"github.com/tx7do/go-utils/mapper"
+
+	taskV1 "go-wind-admin/api/gen/go/task/service/v1"
+)
+
+// TaskWorkflowRunRepo 任务工作流运行记录
+type TaskWorkflowRunRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+
+	mapper          *mapper.CopierMapper[taskV1.TaskWorkflowRun, ent.TaskWorkflowRun]
+	statusConverter *mapper.EnumTypeConverter[taskV1.TaskWorkflowRun_Status, taskworkflowrun.Status]
+
+	repository *entCrud.Repository[
+		ent.TaskWorkflowRunQuery, ent.TaskWorkflowRunSelect,
+		ent.TaskWorkflowRunCreate, ent.TaskWorkflowRunCreateBulk,
+		ent.TaskWorkflowRunUpdate, ent.TaskWorkflowRunUpdateOne,
+		ent.TaskWorkflowRunDelete,
+		predicate.TaskWorkflowRun,
+		taskV1.TaskWorkflowRun, ent.TaskWorkflowRun,
+	]
+}
+
+func NewTaskWorkflowRunRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *TaskWorkflowRunRepo {
+	repo := &TaskWorkflowRunRepo{
+		log:             ctx.NewLoggerHelper("task-workflow-run/repo/admin-service"),
+		entClient:       entClient,
+		mapper:          mapper.NewCopierMapper[taskV1.TaskWorkflowRun, ent.TaskWorkflowRun](),
+		statusConverter: mapper.NewEnumTypeConverter[taskV1.TaskWorkflowRun_Status, taskworkflowrun.Status](taskV1.TaskWorkflowRun_Status_name, taskV1.TaskWorkflowRun_Status_value),
+	}
+
+	repo.init()
+
+	return repo
+}
+
+func (r *TaskWorkflowRunRepo) init() {
+	r.repository = entCrud.NewRepository[
+		ent.TaskWorkflowRunQuery, ent.TaskWorkflowRunSelect,
+		ent.TaskWorkflowRunCreate, ent.TaskWorkflowRunCreateBulk,
+		ent.TaskWorkflowRunUpdate, ent.TaskWorkflowRunUpdateOne,
+		ent.TaskWorkflowRunDelete,
+		predicate.TaskWorkflowRun,
+		taskV1.TaskWorkflowRun, ent.TaskWorkflowRun,
+	](r.mapper)
+
+	r.mapper.AppendConverters(copierutil.NewTimeStringConverterPair())
+	r.mapper.AppendConverters(copierutil.NewTimeTimestamppbConverterPair())
+
+	r.mapper.AppendConverters(r.statusConverter.NewConverterPair())
+}
+
+func (r *TaskWorkflowRunRepo) List(ctx context.Context, req *paginationV1.PagingRequest) (*taskV1.ListTaskWorkflowRunResponse, error) {
+	if req == nil {
+		return nil, taskV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().TaskWorkflowRun.Query()
+
+	// 列表不返回步骤定义快照，避免响应过大
+	builder.Select(
+		taskworkflowrun.FieldID,
+		taskworkflowrun.FieldTenantID,
+		taskworkflowrun.FieldWorkflowID,
+		taskworkflowrun.FieldWorkflowName,
+		taskworkflowrun.FieldStatus,
+		taskworkflowrun.FieldStepRuns,
+		taskworkflowrun.FieldErrorMessage,
+		taskworkflowrun.FieldStartedAt,
+		taskworkflowrun.FieldFinishedAt,
+		taskworkflowrun.FieldCreatedBy,
+		taskworkflowrun.FieldCreatedAt,
+		taskworkflowrun.FieldUpdatedAt,
+	)
+
+	if req.GetOrderBy() == "" && len(req.GetSorting()) == 0 {
+		req.OrderBy = trans.Ptr("id desc")
+	}
+
+	ret, err := r.repository.ListWithPaging(ctx, builder, builder.Clone(), req)
+	if err != nil {
+		return nil, err
+	}
+	if ret == nil {
+		return &taskV1.ListTaskWorkflowRunResponse{Total: 0, Items: nil}, nil
+	}
+
+	return &taskV1.ListTaskWorkflowRunResponse{
+		Total: ret.Total,
+		Items: ret.Items,
+	}, nil
+}
+
+func (r *TaskWorkflowRunRepo) Get(ctx context.Context, req *taskV1.GetTaskWorkflowRunRequest) (*taskV1.TaskWorkflowRun, error) {
+	if req == nil {
+		return nil, taskV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().TaskWorkflowRun.Query()
+
+	return r.repository.Get(ctx, builder, req.GetViewMask(), taskworkflowrun.IDEQ(req.GetId()))
+}
+
+// Create 写入一条工作流运行记录
+func (r *TaskWorkflowRunRepo) Create(ctx context.Context, run *taskV1.TaskWorkflowRun) (*taskV1.TaskWorkflowRun, error) {
+	if run == nil {
+		return nil, taskV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().TaskWorkflowRun.Create().
+		SetNillableTenantID(run.TenantId).
+		SetNillableWorkflowID(run.WorkflowId).
+		SetNillableWorkflowName(run.WorkflowName).
+		SetNillableStatus(r.statusConverter.ToEntity(run.Status)).
+		SetNillableInput(run.Input).
+		SetSteps(run.Steps).
+		SetStepRuns(run.StepRuns).
+		SetNillableErrorMessage(run.ErrorMessage).
+		SetNillableCreatedBy(run.CreatedBy).
+		SetCreatedAt(time.Now())
+
+	if run.StartedAt != nil {
+		builder.SetStartedAt(run.StartedAt.AsTime())
+	}
+	if run.FinishedAt != nil {
+		builder.SetFinishedAt(run.FinishedAt.AsTime())
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("insert task workflow run failed: %s", err.Error())
+		return nil, taskV1.ErrorInternalServerError("insert task workflow run failed")
+	}
+
+	return r.mapper.ToDTO(entity), nil
+}
+
+// Mutate 在事务中锁定运行记录并由fn修改状态，fn返回错误时不做任何修改。
+// 并发完成的步骤依次推进，保证汇聚（fan-in）步骤只被派发一次。
+func (r *TaskWorkflowRunRepo) Mutate(ctx context.Context, id uint32, fn func(run *taskV1.TaskWorkflowRun) error) (dto *taskV1.TaskWorkflowRun, err error) {
+	var tx *ent.Tx
+	tx, err = r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return nil, taskV1.ErrorInternalServerError("start transaction failed")
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				r.log.Errorf("transaction rollback failed: %s", rollbackErr.Error())
+			}
+			return
+		}
+		if commitErr := tx.Commit(); commitErr != nil {
+			r.log.Errorf("transaction commit failed: %s", commitErr.Error())
+			dto, err = nil, taskV1.ErrorInternalServerError("transaction commit failed")
+		}
+	}()
+
+	var entity *ent.TaskWorkflowRun
+	entity, err = tx.TaskWorkflowRun.Query().
+		Where(taskworkflowrun.IDEQ(id)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, taskV1.ErrorNotFound("task workflow run not found")
+		}
+		r.log.Errorf("query task workflow run failed: %s", err.Error())
+		return nil, taskV1.ErrorInternalServerError("query task workflow run failed")
+	}
+
+	dto = r.mapper.ToDTO(entity)
+	if err = fn(dto); err != nil {
+		return nil, err
+	}
+
+	builder := tx.TaskWorkflowRun.UpdateOneID(id).
+		SetNillableStatus(r.statusConverter.ToEntity(dto.Status)).
+		SetStepRuns(dto.StepRuns).
+		SetUpdatedAt(time.Now())
+	if dto.ErrorMessage != nil {
+		builder.SetErrorMessage(dto.GetErrorMessage())
+	} else {
+		builder.ClearErrorMessage()
+	}
+	if dto.FinishedAt != nil {
+		builder.SetFinishedAt(dto.FinishedAt.AsTime())
+	} else {
+		builder.ClearFinishedAt()
+	}
+
+	if err = builder.Exec(ctx); err != nil {
+		r.log.Errorf("update task workflow run failed: %s", err.Error())
+		return nil, taskV1.ErrorInternalServerError("update task workflow run failed")
+	}
+
+	return dto, nil
+}
+
+// ListActiveIDs 查询运行中的工作流运行ID（跨租户，供重启后恢复使用）
+func (r *TaskWorkflowRunRepo) ListActiveIDs(ctx context.Context) ([]uint32, error) {
+	ids, err := r.entClient.Client().TaskWorkflowRun.Query().
+		Where(taskworkflowrun.StatusEQ(taskworkflowrun.StatusRunning)).
+		Order(ent.Asc(taskworkflowrun.FieldID)).
+		IDs(ctx)
+	if err != nil {
+		r.log.Errorf("query active task workflow runs failed: %s", err.Error())
+		return nil, taskV1.ErrorInternalServerError("query active task workflow runs failed")
+	}
+
+	return ids, nil
+}
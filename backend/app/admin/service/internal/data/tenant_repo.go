@@ -20,6 +20,7 @@ import (
 	"go-wind-admin/app/admin/service/internal/data/ent/tenant"
 
 	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
+	storageV1 "go-wind-admin/api/gen/go/storage/service/v1"
 )
 
 type TenantRepo struct {
@@ -31,6 +32,10 @@ type TenantRepo struct {
 	typeConverter        *mapper.EnumTypeConverter[identityV1.Tenant_Type, tenant.Type]
 	auditStatusConverter *mapper.EnumTypeConverter[identityV1.Tenant_AuditStatus, tenant.AuditStatus]
 
+	highRiskLoginActionConverter *mapper.EnumTypeConverter[identityV1.Tenant_HighRiskLoginAction, tenant.HighRiskLoginAction]
+	storageProviderConverter     *mapper.EnumTypeConverter[storageV1.OSSProvider, tenant.StorageProvider]
+	fileDedupPolicyConverter     *mapper.EnumTypeConverter[identityV1.Tenant_FileDedupPolicy, tenant.FileDedupPolicy]
+
 	repository *entCrud.Repository[
 		ent.TenantQuery, ent.TenantSelect,
 		ent.TenantCreate, ent.TenantCreateBulk,
@@ -49,6 +54,13 @@ func NewTenantRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Cli
 		statusConverter:      mapper.NewEnumTypeConverter[identityV1.Tenant_Status, tenant.Status](identityV1.Tenant_Status_name, identityV1.Tenant_Status_value),
 		typeConverter:        mapper.NewEnumTypeConverter[identityV1.Tenant_Type, tenant.Type](identityV1.Tenant_Type_name, identityV1.Tenant_Type_value),
 		auditStatusConverter: mapper.NewEnumTypeConverter[identityV1.Tenant_AuditStatus, tenant.AuditStatus](identityV1.Tenant_AuditStatus_name, identityV1.Tenant_AuditStatus_value),
+		highRiskLoginActionConverter: mapper.NewEnumTypeConverter[identityV1.Tenant_HighRiskLoginAction, tenant.HighRiskLoginAction](
+			identityV1.Tenant_HighRiskLoginAction_name, identityV1.Tenant_HighRiskLoginAction_value,
+		),
+		storageProviderConverter: mapper.NewEnumTypeConverter[storageV1.OSSProvider, tenant.StorageProvider](storageV1.OSSProvider_name, storageV1.OSSProvider_value),
+		fileDedupPolicyConverter: mapper.NewEnumTypeConverter[identityV1.Tenant_FileDedupPolicy, tenant.FileDedupPolicy](
+			identityV1.Tenant_FileDedupPolicy_name, identityV1.Tenant_FileDedupPolicy_value,
+		),
 	}
 
 	repo.init()
@@ -72,6 +84,9 @@ func (r *TenantRepo) init() {
 	r.mapper.AppendConverters(r.statusConverter.NewConverterPair())
 	r.mapper.AppendConverters(r.typeConverter.NewConverterPair())
 	r.mapper.AppendConverters(r.auditStatusConverter.NewConverterPair())
+	r.mapper.AppendConverters(r.highRiskLoginActionConverter.NewConverterPair())
+	r.mapper.AppendConverters(r.storageProviderConverter.NewConverterPair())
+	r.mapper.AppendConverters(r.fileDedupPolicyConverter.NewConverterPair())
 }
 
 func (r *TenantRepo) Count(ctx context.Context, req *paginationV1.PagingRequest) (int, error) {
@@ -232,6 +247,10 @@ func (r *TenantRepo) CreateWithTx(ctx context.Context, tx *ent.Tx, data *identit
 		SetNillableStatus(r.statusConverter.ToEntity(data.Status)).
 		SetNillableType(r.typeConverter.ToEntity(data.Type)).
 		SetNillableAuditStatus(r.auditStatusConverter.ToEntity(data.AuditStatus)).
+		SetNillableHighRiskLoginAction(r.highRiskLoginActionConverter.ToEntity(data.HighRiskLoginAction)).
+		SetNillableStorageProvider(r.storageProviderConverter.ToEntity(data.StorageProvider)).
+		SetNillableFileDedupPolicy(r.fileDedupPolicyConverter.ToEntity(data.FileDedupPolicy)).
+		SetNillableRecycleBinRetentionDays(data.RecycleBinRetentionDays).
 		SetNillableSubscriptionPlan(data.SubscriptionPlan).
 		SetNillableExpiredAt(timeutil.TimestamppbToTime(data.ExpiredAt)).
 		SetNillableSubscriptionAt(timeutil.TimestamppbToTime(data.SubscriptionAt)).
@@ -288,6 +307,10 @@ func (r *TenantRepo) Update(ctx context.Context, req *identityV1.UpdateTenantReq
 				SetNillableStatus(r.statusConverter.ToEntity(req.Data.Status)).
 				SetNillableType(r.typeConverter.ToEntity(req.Data.Type)).
 				SetNillableAuditStatus(r.auditStatusConverter.ToEntity(req.Data.AuditStatus)).
+				SetNillableHighRiskLoginAction(r.highRiskLoginActionConverter.ToEntity(req.Data.HighRiskLoginAction)).
+				SetNillableStorageProvider(r.storageProviderConverter.ToEntity(req.Data.StorageProvider)).
+				SetNillableFileDedupPolicy(r.fileDedupPolicyConverter.ToEntity(req.Data.FileDedupPolicy)).
+				SetNillableRecycleBinRetentionDays(req.Data.RecycleBinRetentionDays).
 				SetNillableSubscriptionPlan(req.Data.SubscriptionPlan).
 				SetNillableExpiredAt(timeutil.TimestamppbToTime(req.Data.ExpiredAt)).
 				SetNillableSubscriptionAt(timeutil.TimestamppbToTime(req.Data.SubscriptionAt)).
@@ -0,0 +1,74 @@
+package data
+
+import (
+	"context"
+	"io"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"go-wind-admin/pkg/oss"
+	"go-wind-admin/pkg/tus"
+)
+
+// NewTusUploader 创建 tus 断点续传上传器，上传状态保存在 Redis 中，
+// 数据以分片上传的方式写入上传创建时所选的对象存储，过期未完成的上传由后台循环清理
+func NewTusUploader(ctx *bootstrap.Context, rdb *redis.Client, storages *ObjectStorageRouter) (*tus.Uploader, func(), error) {
+	var client redis.UniversalClient
+	if rdb != nil {
+		client = rdb
+	}
+
+	u := tus.NewUploader(client, nil, ctx.GetLogger(),
+		tus.WithBackendSelector(func(upload *tus.Upload) (tus.Backend, error) {
+			storage, err := storages.ProviderByName(upload.Storage)
+			if err != nil {
+				return nil, err
+			}
+			return &storageTusBackend{storage: storage}, nil
+		}),
+	)
+	u.Start()
+
+	return u, u.Stop, nil
+}
+
+// storageTusBackend 把对象存储适配为 tus 存储后端
+type storageTusBackend struct {
+	storage oss.ObjectStorage
+}
+
+func (b *storageTusBackend) NewMultipartUpload(ctx context.Context, bucket, object, contentType string) (string, error) {
+	return b.storage.NewMultipartUpload(ctx, bucket, object, contentType)
+}
+
+func (b *storageTusBackend) PutObjectPart(ctx context.Context, bucket, object, uploadID string, partNumber int, reader io.Reader, size int64) (string, error) {
+	return b.storage.PutObjectPart(ctx, bucket, object, uploadID, partNumber, reader, size)
+}
+
+func (b *storageTusBackend) CompleteMultipartUpload(ctx context.Context, bucket, object, uploadID string, parts []tus.Part) error {
+	completeParts := make([]oss.CompletePart, 0, len(parts))
+	for _, p := range parts {
+		completeParts = append(completeParts, oss.CompletePart{PartNumber: p.Number, ETag: p.ETag})
+	}
+
+	_, err := b.storage.CompleteMultipartUpload(ctx, bucket, object, uploadID, completeParts)
+	return err
+}
+
+func (b *storageTusBackend) AbortMultipartUpload(ctx context.Context, bucket, object, uploadID string) error {
+	return b.storage.AbortMultipartUpload(ctx, bucket, object, uploadID)
+}
+
+func (b *storageTusBackend) PutObject(ctx context.Context, bucket, object, contentType string, reader io.Reader, size int64) error {
+	_, err := b.storage.UploadStream(ctx, bucket, object, contentType, reader, size)
+	return err
+}
+
+func (b *storageTusBackend) GetObject(ctx context.Context, bucket, object string) (io.ReadCloser, error) {
+	return b.storage.GetObject(ctx, bucket, object)
+}
+
+func (b *storageTusBackend) RemoveObject(ctx context.Context, bucket, object string) error {
+	return b.storage.DeleteFile(ctx, bucket, object)
+}
@@ -23,6 +23,8 @@ import (
 	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
 	"go-wind-admin/app/admin/service/internal/data/ent/usercredential"
 
+	appCrypto "go-wind-admin/pkg/crypto"
+
 	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
 )
 
@@ -404,6 +406,47 @@ func (r *UserCredentialRepo) VerifyCredential(ctx context.Context, req *authenti
 	}, nil
 }
 
+// HasTOTP 用户是否绑定了已启用的 TOTP 凭证
+func (r *UserCredentialRepo) HasTOTP(ctx context.Context, userID uint32) (bool, error) {
+	exist, err := r.entClient.Client().UserCredential.Query().
+		Where(
+			usercredential.UserIDEQ(userID),
+			usercredential.CredentialTypeEQ(usercredential.CredentialTypeTOTP),
+			usercredential.StatusEQ(usercredential.StatusEnabled),
+		).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("query user [%d] totp credential failed: %s", userID, err.Error())
+		return false, authenticationV1.ErrorServiceUnavailable("db error")
+	}
+	return exist, nil
+}
+
+// VerifyTOTP 使用用户已启用的 TOTP 凭证校验多因素认证码
+func (r *UserCredentialRepo) VerifyTOTP(ctx context.Context, userID uint32, code string) (bool, error) {
+	entities, err := r.entClient.Client().UserCredential.Query().
+		Select(usercredential.FieldCredential).
+		Where(
+			usercredential.UserIDEQ(userID),
+			usercredential.CredentialTypeEQ(usercredential.CredentialTypeTOTP),
+			usercredential.StatusEQ(usercredential.StatusEnabled),
+		).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query user [%d] totp credential failed: %s", userID, err.Error())
+		return false, authenticationV1.ErrorServiceUnavailable("db error")
+	}
+
+	now := time.Now()
+	for _, entity := range entities {
+		if entity.Credential != nil && appCrypto.ValidateTOTP(*entity.Credential, code, now) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 func (r *UserCredentialRepo) verifyCredential(credentialType *usercredential.CredentialType, plainCredential, targetCredential string) bool {
 	if credentialType == nil || plainCredential == "" {
 		return false
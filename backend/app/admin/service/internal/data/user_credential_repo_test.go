@@ -0,0 +1,68 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go-wind-admin/app/admin/service/internal/data/datatest"
+	"go-wind-admin/app/admin/service/internal/data/ent/usercredential"
+
+	appCrypto "go-wind-admin/pkg/crypto"
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+)
+
+func TestUserCredentialRepo_VerifyTOTP(t *testing.T) {
+	const secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+	ctx := appViewer.NewSystemViewerContext(context.Background())
+	cli := datatest.NewEntClient(t)
+	repo := NewUserCredentialRepo(datatest.NewBootstrapContext(), cli, nil)
+
+	cli.Client().UserCredential.Create().
+		SetTenantID(1).
+		SetUserID(1).
+		SetIdentityType(usercredential.IdentityTypeUserId).
+		SetIdentifier("totp:1").
+		SetCredentialType(usercredential.CredentialTypeTOTP).
+		SetCredential(secret).
+		SetStatus(usercredential.StatusEnabled).
+		SaveX(ctx)
+	// 已停用的 TOTP 凭证不能用于认证
+	cli.Client().UserCredential.Create().
+		SetTenantID(1).
+		SetUserID(2).
+		SetIdentityType(usercredential.IdentityTypeUserId).
+		SetIdentifier("totp:2").
+		SetCredentialType(usercredential.CredentialTypeTOTP).
+		SetCredential(secret).
+		SetStatus(usercredential.StatusDisabled).
+		SaveX(ctx)
+
+	enrolled, err := repo.HasTOTP(ctx, 1)
+	require.NoError(t, err)
+	assert.True(t, enrolled)
+
+	enrolled, err = repo.HasTOTP(ctx, 2)
+	require.NoError(t, err)
+	assert.False(t, enrolled)
+
+	code, err := appCrypto.GenerateTOTP(secret, time.Now())
+	require.NoError(t, err)
+
+	ok, err := repo.VerifyTOTP(ctx, 1, code)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	wrong := code[:5] + string(rune('0'+(code[5]-'0'+1)%10))
+	ok, err = repo.VerifyTOTP(ctx, 1, wrong)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	ok, err = repo.VerifyTOTP(ctx, 2, code)
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
@@ -8,14 +8,31 @@ import (
 	bootstrapAsynq "github.com/tx7do/kratos-bootstrap/transport/asynq"
 	asynqServer "github.com/tx7do/kratos-transport/transport/asynq"
 
+	"go-wind-admin/app/admin/service/internal/data"
 	"go-wind-admin/app/admin/service/internal/service"
 
 	appViewer "go-wind-admin/pkg/entgo/viewer"
+	"go-wind-admin/pkg/eventbus"
 	"go-wind-admin/pkg/task"
 )
 
 // NewAsynqServer creates a new asynq server.
-func NewAsynqServer(ctx *bootstrap.Context, taskService *service.TaskService) (*asynqServer.Server, error) {
+func NewAsynqServer(
+	ctx *bootstrap.Context,
+	taskService *service.TaskService,
+	auditLogArchiveService *service.AuditLogArchiveService,
+	auditLogExportService *service.AuditLogExportService,
+	databaseBackupService *service.DatabaseBackupService,
+	storageQuotaService *service.StorageQuotaService,
+	storageReconcileService *service.StorageReconcileService,
+	fileScanService *service.FileScanService,
+	fileService *service.FileService,
+	luaTaskService *service.LuaTaskService,
+	taskWorkflowService *service.TaskWorkflowService,
+	taskRunRepo *data.TaskRunRepo,
+	taskProgressBroker *data.TaskProgressBroker,
+	bus eventbus.EventBus,
+) (*asynqServer.Server, error) {
 	cfg := ctx.GetConfig()
 
 	if cfg == nil || cfg.Server == nil || cfg.Server.Asynq == nil {
@@ -25,14 +42,68 @@ func NewAsynqServer(ctx *bootstrap.Context, taskService *service.TaskService) (*
 	srv := bootstrapAsynq.NewAsynqServer(
 		cfg.Server.Asynq,
 		asynqServer.WithEnableKeepAlive(false),
+		// 记录每次任务执行的历史，并发布任务生命周期事件；
+		// 进度与工作流中间件需在其后，以便读取执行记录和步骤设置的结果
+		asynqServer.WithMiddleware(
+			task.RunMiddleware(taskRunRepo, bus, ctx.GetLogger()),
+			task.ProgressMiddleware(taskProgressBroker, ctx.GetLogger()),
+			task.WorkflowMiddleware(taskWorkflowService, ctx.GetLogger()),
+		),
 	)
 
 	taskService.RegisterTaskScheduler(srv)
+	auditLogArchiveService.RegisterTaskScheduler(srv)
+	auditLogExportService.RegisterTaskScheduler(srv)
+	storageQuotaService.RegisterTaskScheduler(srv)
+	fileScanService.RegisterTaskScheduler(srv)
+	taskWorkflowService.RegisterTaskScheduler(srv)
 
 	var err error
 
 	// 注册任务
-	if err = asynqServer.RegisterSubscriber(srv, task.BackupTaskType, taskService.AsyncBackup); err != nil {
+	if err = asynqServer.RegisterSubscriberWithCtx(srv, task.BackupTaskType, databaseBackupService.AsyncBackup); err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	if err = asynqServer.RegisterSubscriberWithCtx(srv, task.RestoreTaskType, databaseBackupService.AsyncRestore); err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	if err = asynqServer.RegisterSubscriberWithCtx(srv, task.TaskRunCleanupTaskType, taskService.AsyncCleanupTaskRuns); err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	if err = asynqServer.RegisterSubscriberWithCtx(srv, task.WorkflowStartTaskType, taskWorkflowService.AsyncStartWorkflow); err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	if err = asynqServer.RegisterSubscriberWithCtx(srv, task.AuditArchiveTaskType, auditLogArchiveService.AsyncArchiveAuditLogs); err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	if err = asynqServer.RegisterSubscriberWithCtx(srv, task.AuditExportTaskType, auditLogExportService.AsyncExportAuditLogs); err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	if err = asynqServer.RegisterSubscriberWithCtx(srv, task.StorageUsageReconcileTaskType, storageQuotaService.AsyncReconcileStorageUsage); err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	if err = asynqServer.RegisterSubscriberWithCtx(srv, task.StorageOrphanReconcileTaskType, storageReconcileService.AsyncReconcileOrphans); err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	if err = asynqServer.RegisterSubscriberWithCtx(srv, task.FileScanTaskType, fileScanService.AsyncScanFile); err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	if err = asynqServer.RegisterSubscriberWithCtx(srv, task.FileRecycleBinPurgeTaskType, fileService.AsyncPurgeRecycleBin); err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	// 注册 Lua 脚本中声明的任务，需在内置任务之后注册以便跳过重名
+	if err = luaTaskService.RegisterTaskHandlers(srv); err != nil {
 		log.Error(err)
 		return nil, err
 	}
@@ -43,5 +114,10 @@ func NewAsynqServer(ctx *bootstrap.Context, taskService *service.TaskService) (*
 		return nil, err
 	}
 
+	// 继续推进重启前运行中的工作流
+	if err = taskWorkflowService.RecoverRuns(appViewer.NewSystemViewerContext(ctx.Context())); err != nil {
+		log.Errorf("recover task workflow runs failed: %s", err.Error())
+	}
+
 	return srv, nil
 }
@@ -0,0 +1,106 @@
+package server
+
+import (
+	"context"
+	stdhttp "net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/tx7do/go-utils/trans"
+
+	"go-wind-admin/app/admin/service/internal/service"
+
+	storageV1 "go-wind-admin/api/gen/go/storage/service/v1"
+
+	"go-wind-admin/pkg/middleware/logging"
+)
+
+const OperationFileShareLinkServiceAccess = "/admin.service.v1.FileShareLinkService/Access"
+
+// headerKeySharePassword 分享链接访问密码的请求头。访问密码和登录令牌只从请求头读取，
+// 避免出现在访问日志、代理日志、Referer 和浏览器历史记录中
+const headerKeySharePassword = "X-Share-Password"
+
+// cookieKeyShareSession 分享链接下载会话的 Cookie，作用域限定在该分享链接的访问路径
+const cookieKeyShareSession = "share_download_session"
+
+// registerFileShareLinkHandler 注册分享链接的公开访问路由，
+// 请求不经过登录鉴权，由分享令牌、访问密码和可选的登录令牌授权
+func registerFileShareLinkHandler(srv *http.Server, svc *service.FileShareLinkService) {
+	r := srv.Route("/")
+
+	r.GET("admin/v1/shares/{token}", _FileShareLinkService_Access_HTTP_Handler(svc))
+	r.HEAD("admin/v1/shares/{token}", _FileShareLinkService_Access_HTTP_Handler(svc))
+}
+
+func _FileShareLinkService_Access_HTTP_Handler(svc *service.FileShareLinkService) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		http.SetOperation(ctx, OperationFileShareLinkServiceAccess)
+
+		req := ctx.Request()
+		query := req.URL.Query()
+
+		in := &service.FileShareAccessRequest{
+			Token:       ctx.Vars().Get("token"),
+			Password:    req.Header.Get(headerKeySharePassword),
+			AccessToken: strings.TrimSpace(strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")),
+			Head:        req.Method == stdhttp.MethodHead,
+			Range:       req.Header.Get("Range"),
+			ClientIP:    logging.ClientRealIP(req),
+			RequestID:   logging.RequestID(req),
+		}
+		if cookie, err := req.Cookie(cookieKeyShareSession); err == nil {
+			in.Session = cookie.Value
+		}
+		in.Redirect, _ = strconv.ParseBool(query.Get("redirect"))
+
+		// 下载会话按请求的字节范围计数，忽略 If-Range，保证输出的正是记录的范围
+		req.Header.Del("If-Range")
+
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return svc.Access(ctx, req.(*service.FileShareAccessRequest))
+		})
+
+		out, err := h(ctx, in)
+		if err != nil {
+			return err
+		}
+
+		access := out.(*service.FileShareAccess)
+		if access.Session != "" {
+			stdhttp.SetCookie(ctx.Response(), &stdhttp.Cookie{
+				Name:     cookieKeyShareSession,
+				Value:    access.Session,
+				Path:     service.FileShareLinkPath + in.Token,
+				MaxAge:   int(access.SessionTTL.Seconds()),
+				HttpOnly: true,
+				Secure:   req.TLS != nil,
+				SameSite: stdhttp.SameSiteLaxMode,
+			})
+		}
+		if access.RedirectURL != "" {
+			stdhttp.Redirect(ctx.Response(), req, access.RedirectURL, stdhttp.StatusFound)
+			return nil
+		}
+
+		download := access.Download
+		defer download.Content.Close()
+
+		if download.ETag != "" {
+			ctx.Response().Header().Set("ETag", strconv.Quote(strings.Trim(download.ETag, `"`)))
+		}
+
+		disposition := &storageV1.DownloadFileRequest{Disposition: trans.Ptr(shareDisposition(query.Get("disposition")))}
+		serveDownload(ctx, disposition, download.FileName, download.ContentType, download.LastModified, download.Content)
+		return nil
+	}
+}
+
+// shareDisposition 分享链接只接受 inline 和 attachment 两种展示方式，其余取值按默认的 attachment 处理
+func shareDisposition(disposition string) string {
+	if disposition == "inline" {
+		return disposition
+	}
+	return "attachment"
+}
@@ -4,8 +4,11 @@ import (
 	"bytes"
 	"context"
 	"io"
+	"mime"
+	stdhttp "net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/transport/http"
@@ -26,12 +29,16 @@ func registerFileTransferServiceHandler(srv *http.Server, svc *service.FileTrans
 
 	r.POST("admin/v1/file/upload", _FileTransferService_PostUploadFile_HTTP_Handler(svc))
 	r.PUT("admin/v1/file/upload", _FileTransferService_PutUploadFile_HTTP_Handler(svc))
+	r.POST("admin/v1/file/upload:complete", _FileTransferService_CompletePresignedUpload_HTTP_Handler(svc))
 
 	r.GET("admin/v1/file/download", _FileTransferService_DownloadFile_HTTP_Handler(svc))
+
+	registerResumableUploadHandler(srv, svc)
 }
 
 const OperationFileTransferServicePostUploadFile = "/admin.service.v1.FileTransferService/PostUploadFile"
 const OperationFileTransferServicePutUploadFile = "/admin.service.v1.FileTransferService/PutUploadFile"
+const OperationFileTransferServiceCompletePresignedUpload = "/admin.service.v1.FileTransferService/CompletePresignedUpload"
 
 const OperationFileTransferServiceDownloadFile = "/admin.service.v1.FileTransferService/DownloadFile"
 
@@ -199,6 +206,30 @@ func _FileTransferService_PutUploadFile_HTTP_Handler(svc *service.FileTransferSe
 	}
 }
 
+func _FileTransferService_CompletePresignedUpload_HTTP_Handler(svc *service.FileTransferService) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in storageV1.CompletePresignedUploadRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+
+		http.SetOperation(ctx, OperationFileTransferServiceCompletePresignedUpload)
+
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return svc.CompletePresignedUpload(ctx, req.(*storageV1.CompletePresignedUploadRequest))
+		})
+
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+
+		reply := out.(*storageV1.File)
+
+		return ctx.Result(200, reply)
+	}
+}
+
 func _FileTransferService_DownloadFile_HTTP_Handler(svc *service.FileTransferService) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		http.SetOperation(ctx, OperationFileTransferServiceDownloadFile)
@@ -210,109 +241,100 @@ func _FileTransferService_DownloadFile_HTTP_Handler(svc *service.FileTransferSer
 			return err
 		}
 
+		// 外部地址和预签名地址仍返回响应体，其余直接从对象存储流式输出
+		if _, ok := in.Selector.(*storageV1.DownloadFileRequest_DownloadUrl); ok || in.GetPreferPresignedUrl() {
+			return downloadFileResult(ctx, svc, &in)
+		}
+
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
 			aReq := req.(*storageV1.DownloadFileRequest)
-			var resp *storageV1.DownloadFileResponse
-			resp, err = svc.DownloadFile(ctx, aReq)
-			return resp, err
+			return svc.OpenDownload(ctx, aReq)
 		})
 
-		// 逻辑处理，取数据
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
 
-		reply := out.(*storageV1.DownloadFileResponse)
-		rw := ctx.Response()
-		if rw == nil {
-			return ctx.Result(500, "response writer not available")
-		}
+		download := out.(*service.FileDownload)
+		defer download.Content.Close()
 
-		data := reply.GetFile()
-		if len(data) == 0 {
-			// 若没有文件字节，交由框架默认处理（保持原行为）
-			return ctx.Result(200, reply)
+		if download.ETag != "" {
+			ctx.Response().Header().Set("ETag", strconv.Quote(strings.Trim(download.ETag, `"`)))
 		}
 
-		// 基本头部
-		mime := reply.GetMime()
-		if mime == "" {
-			mime = "application/octet-stream"
-		}
-		rw.Header().Set("Content-Type", mime)
+		serveDownload(ctx, &in, download.FileName, download.ContentType, download.LastModified, download.Content)
+		return nil
+	}
+}
 
-		filename := reply.GetSourceFileName()
-		if filename == "" {
-			filename = "file"
-		}
+// downloadFileResult 返回下载地址或由服务端代为获取的文件内容
+func downloadFileResult(ctx http.Context, svc *service.FileTransferService, in *storageV1.DownloadFileRequest) error {
+	h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+		aReq := req.(*storageV1.DownloadFileRequest)
+		return svc.DownloadFile(ctx, aReq)
+	})
+
+	// 逻辑处理，取数据
+	out, err := h(ctx, in)
+	if err != nil {
+		return err
+	}
 
-		var disposition string
-		if in.GetDisposition() != "" {
-			disposition = in.GetDisposition()
-		} else {
-			disposition = "attachment; filename=\"" + filename + "\""
-		}
-		rw.Header().Set("Content-Disposition", disposition)
-		rw.Header().Set("Accept-Ranges", "bytes")
-
-		// 使用 bytes.Reader 以便支持高效的部分读取/流式写入
-		reader := bytes.NewReader(data)
-		total := int64(len(data))
-
-		// 检查请求是否带 Range 头（仅支持单区间，格式 bytes=start-end 或 bytes=start-）
-		rangeHeader := ctx.Request().Header.Get("Range")
-		if strings.HasPrefix(rangeHeader, "bytes=") {
-			r := strings.TrimPrefix(rangeHeader, "bytes=")
-			parts := strings.SplitN(r, "-", 2)
-			if len(parts) != 2 {
-				return ctx.Result(400, "invalid Range header")
-			}
+	reply := out.(*storageV1.DownloadFileResponse)
 
-			start, err1 := strconv.ParseInt(parts[0], 10, 64)
-			if err1 != nil || start < 0 {
-				return ctx.Result(400, "invalid Range start")
-			}
+	data := reply.GetFile()
+	if len(data) == 0 {
+		// 若没有文件字节，交由框架默认处理（保持原行为）
+		return ctx.Result(200, reply)
+	}
 
-			var end int64
-			if parts[1] == "" {
-				end = total - 1
-			} else {
-				end, err1 = strconv.ParseInt(parts[1], 10, 64)
-				if err1 != nil || end < start {
-					return ctx.Result(400, "invalid Range end")
-				}
-			}
+	contentType := reply.GetMime()
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
 
-			if start >= total {
-				return ctx.Result(416, "requested range not satisfiable")
-			}
-			if end >= total {
-				end = total - 1
-			}
+	var modTime time.Time
+	if reply.UpdatedAt != nil {
+		modTime = reply.GetUpdatedAt().AsTime()
+	}
 
-			length := end - start + 1
-			// 设置部分响应头
-			rw.Header().Set("Content-Range", "bytes "+strconv.FormatInt(start, 10)+"-"+strconv.FormatInt(end, 10)+"/"+strconv.FormatInt(total, 10))
-			rw.Header().Set("Content-Length", strconv.FormatInt(length, 10))
+	serveDownload(ctx, in, reply.GetSourceFileName(), contentType, modTime, bytes.NewReader(data))
+	return nil
+}
 
-			// 返回 206 并写入指定区间
-			rw.WriteHeader(206)
-			if _, err = reader.Seek(start, io.SeekStart); err != nil {
-				return ctx.Result(500, err.Error())
-			}
-			if _, err = io.CopyN(rw, reader, length); err != nil {
-				return ctx.Result(500, err.Error())
-			}
-			return nil
-		}
+// serveDownload 输出文件内容，由 http.ServeContent 处理 Range/If-Range（含 multipart/byteranges）、
+// If-None-Match/If-Modified-Since 等条件请求，并设置 Last-Modified
+func serveDownload(
+	ctx http.Context,
+	in *storageV1.DownloadFileRequest,
+	filename, contentType string,
+	modTime time.Time,
+	content io.ReadSeeker,
+) {
+	if filename == "" {
+		filename = "file"
+	}
 
-		// 无 Range，返回完整内容（200）
-		rw.Header().Set("Content-Length", strconv.FormatInt(total, 10))
-		rw.WriteHeader(200)
-		if _, err = io.Copy(rw, reader); err != nil {
-			return ctx.Result(500, err.Error())
-		}
-		return nil
+	rw := ctx.Response()
+	rw.Header().Set("Content-Type", contentType)
+	rw.Header().Set("Content-Disposition", contentDisposition(in.GetDisposition(), filename))
+
+	stdhttp.ServeContent(rw, ctx.Request(), filename, modTime, content)
+}
+
+// contentDisposition 生成 Content-Disposition 头，非 ASCII 文件名按 RFC 2231 编码。
+// disposition 为 inline 或 attachment（默认），包含参数时视为完整的头部值原样返回。
+func contentDisposition(disposition, filename string) string {
+	if strings.Contains(disposition, ";") {
+		return disposition
+	}
+	if disposition != "inline" {
+		disposition = "attachment"
+	}
+
+	if v := mime.FormatMediaType(disposition, map[string]string{"filename": filename}); v != "" {
+		return v
 	}
+	return disposition
 }
@@ -0,0 +1,215 @@
+package server
+
+import (
+	"context"
+	stdhttp "net/http"
+	"path"
+	"strconv"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
+
+	"go-wind-admin/app/admin/service/internal/service"
+
+	"go-wind-admin/pkg/tus"
+)
+
+const OperationFileTransferServiceCreateResumableUpload = "/admin.service.v1.FileTransferService/CreateResumableUpload"
+const OperationFileTransferServiceGetResumableUpload = "/admin.service.v1.FileTransferService/GetResumableUpload"
+const OperationFileTransferServiceWriteResumableUpload = "/admin.service.v1.FileTransferService/WriteResumableUpload"
+const OperationFileTransferServiceTerminateResumableUpload = "/admin.service.v1.FileTransferService/TerminateResumableUpload"
+
+// registerResumableUploadHandler 注册 tus 1.0 断点续传接口
+func registerResumableUploadHandler(srv *http.Server, svc *service.FileTransferService) {
+	r := srv.Route("/")
+
+	r.OPTIONS("admin/v1/file/uploads", _FileTransferService_ResumableUploadOptions_HTTP_Handler(svc))
+	r.POST("admin/v1/file/uploads", _FileTransferService_CreateResumableUpload_HTTP_Handler(svc))
+
+	r.OPTIONS("admin/v1/file/uploads/{id}", _FileTransferService_ResumableUploadOptions_HTTP_Handler(svc))
+	r.HEAD("admin/v1/file/uploads/{id}", _FileTransferService_GetResumableUpload_HTTP_Handler(svc))
+	r.PATCH("admin/v1/file/uploads/{id}", _FileTransferService_WriteResumableUpload_HTTP_Handler(svc))
+	r.DELETE("admin/v1/file/uploads/{id}", _FileTransferService_TerminateResumableUpload_HTTP_Handler(svc))
+}
+
+// setTusHeaders 设置所有 tus 响应都需要携带的头部
+func setTusHeaders(ctx http.Context) {
+	h := ctx.Response().Header()
+	h.Set(tus.HeaderTusResumable, tus.Version)
+	h.Set(tus.HeaderExposeHeaders, tus.ExposedHeaders)
+}
+
+// checkTusResumable 校验客户端使用的协议版本
+func checkTusResumable(ctx http.Context) error {
+	if ctx.Request().Header.Get(tus.HeaderTusResumable) != tus.Version {
+		ctx.Response().Header().Set(tus.HeaderTusVersion, tus.Version)
+		return tus.ErrUnsupportedVersion
+	}
+	return nil
+}
+
+// setUploadHeaders 设置上传进度相关的响应头
+func setUploadHeaders(ctx http.Context, upload *tus.Upload) {
+	h := ctx.Response().Header()
+	h.Set(tus.HeaderUploadOffset, strconv.FormatInt(upload.Offset, 10))
+	if !upload.Finished {
+		h.Set(tus.HeaderUploadExpires, upload.ExpiresAt.UTC().Format(stdhttp.TimeFormat))
+	}
+}
+
+func _FileTransferService_ResumableUploadOptions_HTTP_Handler(svc *service.FileTransferService) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		setTusHeaders(ctx)
+
+		h := ctx.Response().Header()
+		h.Set(tus.HeaderTusVersion, tus.Version)
+		h.Set(tus.HeaderTusExtension, tus.Extensions)
+		h.Set(tus.HeaderTusChecksumAlgorithm, tus.ChecksumAlgorithms)
+		if maxSize := svc.MaxResumableUploadSize(); maxSize > 0 {
+			h.Set(tus.HeaderTusMaxSize, strconv.FormatInt(maxSize, 10))
+		}
+
+		ctx.Response().WriteHeader(stdhttp.StatusNoContent)
+		return nil
+	}
+}
+
+func _FileTransferService_CreateResumableUpload_HTTP_Handler(svc *service.FileTransferService) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		http.SetOperation(ctx, OperationFileTransferServiceCreateResumableUpload)
+		setTusHeaders(ctx)
+
+		if err := checkTusResumable(ctx); err != nil {
+			return err
+		}
+
+		req := ctx.Request()
+		if req.Header.Get(tus.HeaderUploadConcat) != "" || req.Header.Get(tus.HeaderUploadDeferLength) != "" {
+			return tus.ErrNotImplemented
+		}
+
+		length, err := strconv.ParseInt(req.Header.Get(tus.HeaderUploadLength), 10, 64)
+		if err != nil || length < 0 {
+			return tus.ErrInvalidLength
+		}
+
+		metadata, err := tus.ParseMetadata(req.Header.Get(tus.HeaderUploadMetadata))
+		if err != nil {
+			return err
+		}
+
+		h := ctx.Middleware(func(ctx context.Context, _ interface{}) (interface{}, error) {
+			return svc.CreateResumableUpload(ctx, length, metadata)
+		})
+
+		out, err := h(ctx, metadata)
+		if err != nil {
+			return err
+		}
+
+		upload := out.(*tus.Upload)
+
+		ctx.Response().Header().Set(tus.HeaderLocation, path.Join(req.URL.Path, upload.ID))
+		setUploadHeaders(ctx, upload)
+		ctx.Response().WriteHeader(stdhttp.StatusCreated)
+		return nil
+	}
+}
+
+func _FileTransferService_GetResumableUpload_HTTP_Handler(svc *service.FileTransferService) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		http.SetOperation(ctx, OperationFileTransferServiceGetResumableUpload)
+		setTusHeaders(ctx)
+		ctx.Response().Header().Set(tus.HeaderCacheControl, "no-store")
+
+		if err := checkTusResumable(ctx); err != nil {
+			return err
+		}
+
+		uploadID := ctx.Vars().Get("id")
+
+		h := ctx.Middleware(func(ctx context.Context, _ interface{}) (interface{}, error) {
+			return svc.GetResumableUpload(ctx, uploadID)
+		})
+
+		out, err := h(ctx, uploadID)
+		if err != nil {
+			return err
+		}
+
+		upload := out.(*tus.Upload)
+
+		rh := ctx.Response().Header()
+		rh.Set(tus.HeaderUploadLength, strconv.FormatInt(upload.Length, 10))
+		if len(upload.Metadata) > 0 {
+			rh.Set(tus.HeaderUploadMetadata, tus.EncodeMetadata(upload.Metadata))
+		}
+		setUploadHeaders(ctx, upload)
+		ctx.Response().WriteHeader(stdhttp.StatusOK)
+		return nil
+	}
+}
+
+func _FileTransferService_WriteResumableUpload_HTTP_Handler(svc *service.FileTransferService) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		http.SetOperation(ctx, OperationFileTransferServiceWriteResumableUpload)
+		setTusHeaders(ctx)
+
+		if err := checkTusResumable(ctx); err != nil {
+			return err
+		}
+
+		req := ctx.Request()
+		if req.Header.Get("Content-Type") != tus.OffsetOctetStream {
+			return tus.ErrInvalidContentType
+		}
+
+		offset, err := strconv.ParseInt(req.Header.Get(tus.HeaderUploadOffset), 10, 64)
+		if err != nil || offset < 0 {
+			return tus.ErrInvalidOffset
+		}
+
+		checksum, err := tus.ParseChecksum(req.Header.Get(tus.HeaderUploadChecksum))
+		if err != nil {
+			return err
+		}
+
+		uploadID := ctx.Vars().Get("id")
+
+		h := ctx.Middleware(func(ctx context.Context, _ interface{}) (interface{}, error) {
+			return svc.WriteResumableUpload(ctx, uploadID, offset, req.ContentLength, req.Body, checksum)
+		})
+
+		out, err := h(ctx, uploadID)
+		if err != nil {
+			return err
+		}
+
+		setUploadHeaders(ctx, out.(*tus.Upload))
+		ctx.Response().WriteHeader(stdhttp.StatusNoContent)
+		return nil
+	}
+}
+
+func _FileTransferService_TerminateResumableUpload_HTTP_Handler(svc *service.FileTransferService) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		http.SetOperation(ctx, OperationFileTransferServiceTerminateResumableUpload)
+		setTusHeaders(ctx)
+
+		if err := checkTusResumable(ctx); err != nil {
+			return err
+		}
+
+		uploadID := ctx.Vars().Get("id")
+
+		h := ctx.Middleware(func(ctx context.Context, _ interface{}) (interface{}, error) {
+			return nil, svc.TerminateResumableUpload(ctx, uploadID)
+		})
+
+		if _, err := h(ctx, uploadID); err != nil {
+			return err
+		}
+
+		ctx.Response().WriteHeader(stdhttp.StatusNoContent)
+		return nil
+	}
+}
@@ -0,0 +1,181 @@
+package server
+
+import (
+	"context"
+	"io"
+	"mime"
+	stdhttp "net/http"
+	"strconv"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
+
+	storageV1 "go-wind-admin/api/gen/go/storage/service/v1"
+
+	"go-wind-admin/pkg/oss"
+)
+
+const OperationLocalStorageServiceGetObject = "/admin.service.v1.LocalStorageService/GetObject"
+const OperationLocalStorageServicePutObject = "/admin.service.v1.LocalStorageService/PutObject"
+
+// registerLocalStorageHandler 注册本地存储的预签名访问路由，
+// 请求不经过登录鉴权，由 URL 中的 HMAC 签名与过期时间授权
+func registerLocalStorageHandler(srv *http.Server, storage *oss.LocalStorage) {
+	r := srv.Route("/")
+
+	r.GET("admin/v1/storage/local/{bucket}/{object:.+}", _LocalStorageService_GetObject_HTTP_Handler(storage))
+	r.HEAD("admin/v1/storage/local/{bucket}/{object:.+}", _LocalStorageService_GetObject_HTTP_Handler(storage))
+	r.PUT("admin/v1/storage/local/{bucket}/{object:.+}", _LocalStorageService_PutObject_HTTP_Handler(storage))
+	r.POST("admin/v1/storage/local/{bucket}/{object:.+}", _LocalStorageService_PostObject_HTTP_Handler(storage))
+}
+
+func _LocalStorageService_GetObject_HTTP_Handler(storage *oss.LocalStorage) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		http.SetOperation(ctx, OperationLocalStorageServiceGetObject)
+
+		req := ctx.Request()
+		bucketName := ctx.Vars().Get("bucket")
+		objectName := ctx.Vars().Get("object")
+
+		if err := storage.VerifyPresignedUrl(req.Method, bucketName, objectName, req.URL.Query()); err != nil {
+			return err
+		}
+
+		h := ctx.Middleware(func(ctx context.Context, _ interface{}) (interface{}, error) {
+			object, info, err := storage.OpenObject(ctx, bucketName, objectName)
+			if err != nil {
+				return nil, err
+			}
+			return &localObject{ReadSeekCloser: object, info: info}, nil
+		})
+
+		out, err := h(ctx, nil)
+		if err != nil {
+			return err
+		}
+
+		object := out.(*localObject)
+		defer object.Close()
+
+		contentType := object.info.ContentType
+		if contentType == "" {
+			contentType = oss.DefaultContentType
+		}
+
+		rw := ctx.Response()
+		rw.Header().Set("Content-Type", contentType)
+		if object.info.ETag != "" {
+			rw.Header().Set("ETag", strconv.Quote(object.info.ETag))
+		}
+
+		stdhttp.ServeContent(rw, req, "", object.info.LastModified, object)
+		return nil
+	}
+}
+
+func _LocalStorageService_PutObject_HTTP_Handler(storage *oss.LocalStorage) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		http.SetOperation(ctx, OperationLocalStorageServicePutObject)
+
+		req := ctx.Request()
+		bucketName := ctx.Vars().Get("bucket")
+		objectName := ctx.Vars().Get("object")
+
+		if err := storage.VerifyPresignedUrl(req.Method, bucketName, objectName, req.URL.Query()); err != nil {
+			return err
+		}
+
+		h := ctx.Middleware(func(ctx context.Context, _ interface{}) (interface{}, error) {
+			// 上传大文件可能超过服务端的请求超时，写入不随请求上下文取消
+			return storage.UploadStream(context.WithoutCancel(ctx), bucketName, objectName,
+				req.Header.Get("Content-Type"), req.Body, req.ContentLength)
+		})
+
+		out, err := h(ctx, nil)
+		if err != nil {
+			return err
+		}
+
+		info := out.(oss.ObjectInfo)
+
+		ctx.Response().Header().Set("ETag", strconv.Quote(info.ETag))
+		ctx.Response().WriteHeader(stdhttp.StatusOK)
+		return nil
+	}
+}
+
+// _LocalStorageService_PostObject_HTTP_Handler 处理预签名 POST 表单上传，
+// 表单字段需位于文件字段 file 之前，与 S3 的 POST Policy 上传保持一致
+func _LocalStorageService_PostObject_HTTP_Handler(storage *oss.LocalStorage) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		http.SetOperation(ctx, OperationLocalStorageServicePutObject)
+
+		req := ctx.Request()
+		bucketName := ctx.Vars().Get("bucket")
+		objectName := ctx.Vars().Get("object")
+
+		if err := storage.VerifyPresignedUrl(req.Method, bucketName, objectName, req.URL.Query()); err != nil {
+			return err
+		}
+
+		reader, err := req.MultipartReader()
+		if err != nil {
+			return storageV1.ErrorBadRequest("invalid multipart form")
+		}
+
+		var contentType string
+		for {
+			part, err := reader.NextPart()
+			if err == io.EOF {
+				return storageV1.ErrorBadRequest("missing file field")
+			}
+			if err != nil {
+				return storageV1.ErrorBadRequest("invalid multipart form")
+			}
+
+			switch part.FormName() {
+			case "key":
+				key, _ := io.ReadAll(io.LimitReader(part, 1024))
+				if string(key) != objectName {
+					return storageV1.ErrorForbidden("object key mismatch")
+				}
+				continue
+
+			case "Content-Type":
+				value, _ := io.ReadAll(io.LimitReader(part, 256))
+				contentType = string(value)
+				continue
+
+			case "file":
+			default:
+				continue
+			}
+
+			if contentType == "" {
+				if mediaType, _, err := mime.ParseMediaType(part.Header.Get("Content-Type")); err == nil {
+					contentType = mediaType
+				}
+			}
+
+			h := ctx.Middleware(func(ctx context.Context, _ interface{}) (interface{}, error) {
+				return storage.UploadStream(context.WithoutCancel(ctx), bucketName, objectName, contentType, part, -1)
+			})
+
+			out, err := h(ctx, nil)
+			if err != nil {
+				return err
+			}
+
+			info := out.(oss.ObjectInfo)
+
+			ctx.Response().Header().Set("ETag", strconv.Quote(info.ETag))
+			ctx.Response().WriteHeader(stdhttp.StatusNoContent)
+			return nil
+		}
+	}
+}
+
+// localObject 打开的本地对象及其元信息
+type localObject struct {
+	io.ReadSeekCloser
+	info oss.ObjectInfo
+}
@@ -36,6 +36,7 @@ func NewRestMiddleware(
 	authorizer *authorizer.Authorizer,
 	apiAuditLogRepo *data.ApiAuditLogRepo,
 	loginLogRepo *data.LoginAuditLogRepo,
+	loginRiskEngine *applogging.LoginRiskEngine,
 ) []middleware.Middleware {
 	var ms []middleware.Middleware
 	ms = append(ms, logging.Server(ctx.GetLogger()))
@@ -49,6 +50,7 @@ func NewRestMiddleware(
 			// TODO 如果系统的负载比较小，可以同步写入数据库，否则，建议使用异步方式，即投递进队列。
 			return loginLogRepo.Create(ctx, &auditV1.CreateLoginAuditLogRequest{Data: data})
 		}),
+		applogging.WithLoginRiskEngine(loginRiskEngine),
 	))
 
 	// add white list for authentication.
@@ -59,6 +61,9 @@ func NewRestMiddleware(
 		//OperationFileTransferServiceDownloadFile,
 		//OperationFileTransferServicePostUploadFile,
 		//OperationFileTransferServicePutUploadFile,
+		OperationLocalStorageServiceGetObject,
+		OperationLocalStorageServicePutObject,
+		OperationFileShareLinkServiceAccess,
 	)
 
 	ms = append(ms, selector.Server(
@@ -114,11 +119,20 @@ func NewRestServer(
 	apiAuditLogService *service.ApiAuditLogService,
 	operationAuditLogService *service.OperationAuditLogService,
 	dataAccessAuditLogService *service.DataAccessAuditLogService,
+	auditLogArchiveService *service.AuditLogArchiveService,
+	auditForwarderService *service.AuditForwarderService,
+	auditAnalyticsService *service.AuditAnalyticsService,
+
+	luaScriptService *service.LuaScriptService,
+	taskWorkflowService *service.TaskWorkflowService,
 
 	internalMessageService *service.InternalMessageService,
 	internalMessageCategoryService *service.InternalMessageCategoryService,
 	internalMessageRecipientService *service.InternalMessageRecipientService,
 
+	storages *data.ObjectStorageRouter,
+	storageQuotaService *service.StorageQuotaService,
+	fileShareLinkService *service.FileShareLinkService,
 ) (*http.Server, error) {
 	cfg := ctx.GetConfig()
 
@@ -141,6 +155,7 @@ func NewRestServer(
 
 	adminV1.RegisterAdminPortalServiceHTTPServer(srv, portalService)
 	adminV1.RegisterTaskServiceHTTPServer(srv, taskService)
+	adminV1.RegisterTaskWorkflowServiceHTTPServer(srv, taskWorkflowService)
 	adminV1.RegisterLoginPolicyServiceHTTPServer(srv, loginPolicyService)
 
 	adminV1.RegisterDictTypeServiceHTTPServer(srv, dictTypeService)
@@ -164,14 +179,29 @@ func NewRestServer(
 	adminV1.RegisterApiAuditLogServiceHTTPServer(srv, apiAuditLogService)
 	adminV1.RegisterOperationAuditLogServiceHTTPServer(srv, operationAuditLogService)
 	adminV1.RegisterDataAccessAuditLogServiceHTTPServer(srv, dataAccessAuditLogService)
+	adminV1.RegisterAuditLogArchiveServiceHTTPServer(srv, auditLogArchiveService)
+	adminV1.RegisterAuditForwarderServiceHTTPServer(srv, auditForwarderService)
+	adminV1.RegisterAuditAnalyticsServiceHTTPServer(srv, auditAnalyticsService)
+
+	adminV1.RegisterLuaScriptServiceHTTPServer(srv, luaScriptService)
 
 	adminV1.RegisterFileServiceHTTPServer(srv, fileService)
+	adminV1.RegisterStorageQuotaServiceHTTPServer(srv, storageQuotaService)
+	adminV1.RegisterFileShareLinkServiceHTTPServer(srv, fileShareLinkService)
 
 	// 注册文件传输服务，用于处理文件上传下载等功能
 	// TODO 它不能够使用代码生成器生成的Handler，需要手动注册。代码生成器生成的Handler无法处理文件上传下载的请求。
 	// 但，代码生成器生成代码可以提供给OpenAPI使用。
 	registerFileTransferServiceHandler(srv, fileTransferService)
 
+	// 本地存储的预签名地址由服务端签名路由提供
+	if storages.Local() != nil {
+		registerLocalStorageHandler(srv, storages.Local())
+	}
+
+	// 分享链接的公开访问路由，校验通过后流式输出或重定向到预签名地址
+	registerFileShareLinkHandler(srv, fileShareLinkService)
+
 	adminV1.RegisterInternalMessageServiceHTTPServer(srv, internalMessageService)
 	adminV1.RegisterInternalMessageCategoryServiceHTTPServer(srv, internalMessageCategoryService)
 	adminV1.RegisterInternalMessageRecipientServiceHTTPServer(srv, internalMessageRecipientService)
@@ -1,6 +1,8 @@
 package server
 
 import (
+	"net/http"
+
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 	"github.com/tx7do/kratos-bootstrap/transport/sse"
 
@@ -13,6 +15,7 @@ import (
 func NewSseServer(
 	ctx *bootstrap.Context,
 	internalMessageService *service.InternalMessageService,
+	taskProgressService *service.TaskProgressService,
 ) *sseServer.Server {
 	cfg := ctx.GetConfig()
 
@@ -21,11 +24,24 @@ func NewSseServer(
 	}
 
 	srv := sse.NewSseServer(cfg.Server.Sse,
-		sseServer.WithSubscriberFunction(internalMessageService.HandleSubscribe),
-		sseServer.WithAuthorizeFunc(internalMessageService.HandleAuthorize),
+		sseServer.WithSubscriberFunction(func(streamID sseServer.StreamID, sub *sseServer.Subscriber) {
+			// 任务进度流，流ID为 task:<任务ID>
+			if service.IsTaskProgressStream(string(streamID)) {
+				taskProgressService.HandleSubscribe(streamID, sub)
+				return
+			}
+			internalMessageService.HandleSubscribe(streamID, sub)
+		}),
+		sseServer.WithAuthorizeFunc(func(r *http.Request, token string) error {
+			if service.IsTaskProgressStream(r.URL.Query().Get("stream")) {
+				return taskProgressService.HandleAuthorize(r, token)
+			}
+			return internalMessageService.HandleAuthorize(r, token)
+		}),
 	)
 
 	internalMessageService.RegisterInternalMessagePublisher(srv)
+	taskProgressService.RegisterTaskProgressPublisher(srv)
 
 	//srv.CreateStream("test")
 
@@ -25,6 +25,8 @@ type ApiAuditLogService struct {
 	apiAuditLogRepo *data.ApiAuditLogRepo
 	apiRepo         *data.ApiRepo
 
+	exportService *AuditLogExportService
+
 	apis     []*permissionV1.Api
 	apiMutex sync.RWMutex
 }
@@ -33,11 +35,13 @@ func NewApiAuditLogService(
 	ctx *bootstrap.Context,
 	apiAuditLogRepo *data.ApiAuditLogRepo,
 	apiRepo *data.ApiRepo,
+	exportService *AuditLogExportService,
 ) *ApiAuditLogService {
 	return &ApiAuditLogService{
 		log:             ctx.NewLoggerHelper("api-audit-log/service/admin-service"),
 		apiAuditLogRepo: apiAuditLogRepo,
 		apiRepo:         apiRepo,
+		exportService:   exportService,
 	}
 }
 
@@ -115,3 +119,8 @@ func (s *ApiAuditLogService) Create(ctx context.Context, req *auditV1.CreateApiA
 
 	return &emptypb.Empty{}, nil
 }
+
+// Export 导出日志（后台任务）
+func (s *ApiAuditLogService) Export(ctx context.Context, req *auditV1.ExportAuditLogRequest) (*auditV1.ExportAuditLogResponse, error) {
+	return s.exportService.Enqueue(ctx, auditV1.AuditLogType_API_AUDIT_LOG, req)
+}
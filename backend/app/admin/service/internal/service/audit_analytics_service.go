@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	auditV1 "go-wind-admin/api/gen/go/audit/service/v1"
+
+	"go-wind-admin/pkg/middleware/auth"
+)
+
+const (
+	analyticsDefaultRange         = 24 * time.Hour
+	analyticsMaxRange             = 366 * 24 * time.Hour
+	analyticsDefaultBucketSeconds = 3600
+	analyticsMinBucketSeconds     = 60
+	analyticsMaxBuckets           = 5000
+	analyticsDefaultTopLimit      = 10
+	analyticsMaxTopLimit          = 100
+	analyticsDefaultCountryLimit  = 50
+	analyticsMaxCountryLimit      = 250
+)
+
+// AuditAnalyticsService 审计日志统计分析
+type AuditAnalyticsService struct {
+	adminV1.AuditAnalyticsServiceHTTPServer
+
+	log *log.Helper
+
+	repo *data.AuditAnalyticsRepo
+}
+
+func NewAuditAnalyticsService(ctx *bootstrap.Context, repo *data.AuditAnalyticsRepo) *AuditAnalyticsService {
+	return &AuditAnalyticsService{
+		log:  ctx.NewLoggerHelper("audit-analytics/service/admin-service"),
+		repo: repo,
+	}
+}
+
+func (s *AuditAnalyticsService) GetApiRequestStats(ctx context.Context, req *auditV1.GetApiRequestStatsRequest) (*auditV1.GetApiRequestStatsResponse, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	var err error
+	if req.TenantId, req.StartTime, req.EndTime, err = normalizeAnalyticsScope(ctx, req.TenantId, req.StartTime, req.EndTime); err != nil {
+		return nil, err
+	}
+
+	bucket := req.GetBucketSeconds()
+	if bucket == 0 {
+		bucket = analyticsDefaultBucketSeconds
+	}
+	if bucket < analyticsMinBucketSeconds {
+		return nil, adminV1.ErrorBadRequest("bucket seconds must be at least %d", analyticsMinBucketSeconds)
+	}
+	span := req.EndTime.AsTime().Sub(req.StartTime.AsTime())
+	if span/(time.Duration(bucket)*time.Second) > analyticsMaxBuckets {
+		return nil, adminV1.ErrorBadRequest("too many buckets, at most %d are allowed", analyticsMaxBuckets)
+	}
+	req.BucketSeconds = trans.Ptr(bucket)
+
+	return s.repo.GetApiRequestStats(ctx, req)
+}
+
+func (s *AuditAnalyticsService) GetApiLatencyPercentiles(ctx context.Context, req *auditV1.GetApiLatencyPercentilesRequest) (*auditV1.GetApiLatencyPercentilesResponse, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	var err error
+	if req.TenantId, req.StartTime, req.EndTime, err = normalizeAnalyticsScope(ctx, req.TenantId, req.StartTime, req.EndTime); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetApiLatencyPercentiles(ctx, req)
+}
+
+func (s *AuditAnalyticsService) GetTopActors(ctx context.Context, req *auditV1.GetTopActorsRequest) (*auditV1.GetTopActorsResponse, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	var err error
+	if req.TenantId, req.StartTime, req.EndTime, err = normalizeAnalyticsScope(ctx, req.TenantId, req.StartTime, req.EndTime); err != nil {
+		return nil, err
+	}
+
+	req.Limit = trans.Ptr(clampAnalyticsLimit(req.GetLimit(), analyticsDefaultTopLimit, analyticsMaxTopLimit))
+
+	return s.repo.GetTopActors(ctx, req)
+}
+
+func (s *AuditAnalyticsService) GetFailedLoginsByCountry(ctx context.Context, req *auditV1.GetFailedLoginsByCountryRequest) (*auditV1.GetFailedLoginsByCountryResponse, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	var err error
+	if req.TenantId, req.StartTime, req.EndTime, err = normalizeAnalyticsScope(ctx, req.TenantId, req.StartTime, req.EndTime); err != nil {
+		return nil, err
+	}
+
+	req.Limit = trans.Ptr(clampAnalyticsLimit(req.GetLimit(), analyticsDefaultCountryLimit, analyticsMaxCountryLimit))
+
+	return s.repo.GetFailedLoginsByCountry(ctx, req)
+}
+
+func (s *AuditAnalyticsService) GetLoginRiskDistribution(ctx context.Context, req *auditV1.GetLoginRiskDistributionRequest) (*auditV1.GetLoginRiskDistributionResponse, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	var err error
+	if req.TenantId, req.StartTime, req.EndTime, err = normalizeAnalyticsScope(ctx, req.TenantId, req.StartTime, req.EndTime); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetLoginRiskDistribution(ctx, req)
+}
+
+// normalizeAnalyticsScope 限定租户范围并补全时间范围。
+// 租户管理员只能统计本租户；默认统计截至当前分钟的24小时，以便相同查询能命中缓存。
+func normalizeAnalyticsScope(
+	ctx context.Context,
+	tenantID *uint32,
+	start, end *timestamppb.Timestamp,
+) (*uint32, *timestamppb.Timestamp, *timestamppb.Timestamp, error) {
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if operator.GetTenantId() > 0 {
+		tenantID = trans.Ptr(operator.GetTenantId())
+	} else if tenantID != nil && *tenantID == 0 {
+		tenantID = nil
+	}
+
+	endTime := time.Now().Truncate(time.Minute)
+	if end != nil {
+		endTime = end.AsTime()
+	}
+	startTime := endTime.Add(-analyticsDefaultRange)
+	if start != nil {
+		startTime = start.AsTime()
+	}
+
+	if !startTime.Before(endTime) {
+		return nil, nil, nil, adminV1.ErrorBadRequest("start time must be before end time")
+	}
+	if endTime.Sub(startTime) > analyticsMaxRange {
+		return nil, nil, nil, adminV1.ErrorBadRequest("time range must not exceed 366 days")
+	}
+
+	return tenantID, timestamppb.New(startTime), timestamppb.New(endTime), nil
+}
+
+func clampAnalyticsLimit(limit, def, maxLimit uint32) uint32 {
+	if limit == 0 {
+		return def
+	}
+	if limit > maxLimit {
+		return maxLimit
+	}
+	return limit
+}
@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	auditV1 "go-wind-admin/api/gen/go/audit/service/v1"
+
+	"go-wind-admin/pkg/middleware/auth"
+)
+
+// AuditForwarderService 审计事件转发（SIEM）配置
+type AuditForwarderService struct {
+	adminV1.AuditForwarderServiceHTTPServer
+
+	log *log.Helper
+
+	repo      *data.AuditForwarderRepo
+	forwarder *data.AuditEventForwarder
+}
+
+func NewAuditForwarderService(
+	ctx *bootstrap.Context,
+	repo *data.AuditForwarderRepo,
+	forwarder *data.AuditEventForwarder,
+) *AuditForwarderService {
+	return &AuditForwarderService{
+		log:       ctx.NewLoggerHelper("audit-forwarder/service/admin-service"),
+		repo:      repo,
+		forwarder: forwarder,
+	}
+}
+
+func (s *AuditForwarderService) List(ctx context.Context, req *paginationV1.PagingRequest) (*auditV1.ListAuditForwarderResponse, error) {
+	resp, err := s.repo.List(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range resp.Items {
+		item.Secret = nil
+	}
+
+	return resp, nil
+}
+
+func (s *AuditForwarderService) Get(ctx context.Context, req *auditV1.GetAuditForwarderRequest) (*auditV1.AuditForwarder, error) {
+	resp, err := s.repo.Get(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Secret = nil
+
+	return resp, nil
+}
+
+func (s *AuditForwarderService) Create(ctx context.Context, req *auditV1.CreateAuditForwarderRequest) (*emptypb.Empty, error) {
+	if req == nil || req.Data == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+	if req.Data.GetName() == "" {
+		return nil, adminV1.ErrorBadRequest("name is required")
+	}
+	if err := validateAuditForwarder(req.Data); err != nil {
+		return nil, err
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 租户管理员只能为本租户配置转发器
+	if operator.GetTenantId() > 0 {
+		req.Data.TenantId = trans.Ptr(operator.GetTenantId())
+	}
+
+	req.Data.CreatedBy = trans.Ptr(operator.UserId)
+
+	if err = s.repo.Create(ctx, req); err != nil {
+		return nil, err
+	}
+
+	s.reload(ctx)
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AuditForwarderService) Update(ctx context.Context, req *auditV1.UpdateAuditForwarderRequest) (*emptypb.Empty, error) {
+	if req == nil || req.Data == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 校验合并后的格式与传输方式
+	current, err := s.repo.Get(ctx, &auditV1.GetAuditForwarderRequest{
+		QueryBy: &auditV1.GetAuditForwarderRequest_Id{Id: req.GetId()},
+	})
+	if err != nil && !req.GetAllowMissing() {
+		return nil, err
+	}
+	merged := &auditV1.AuditForwarder{
+		Format:    req.Data.Format,
+		Transport: req.Data.Transport,
+		Endpoint:  req.Data.Endpoint,
+	}
+	if current != nil {
+		if merged.Format == nil {
+			merged.Format = current.Format
+		}
+		if merged.Transport == nil {
+			merged.Transport = current.Transport
+		}
+		if merged.Endpoint == nil {
+			merged.Endpoint = current.Endpoint
+		}
+	}
+	if err = validateAuditForwarder(merged); err != nil {
+		return nil, err
+	}
+
+	req.Data.Id = trans.Ptr(req.GetId())
+	if operator.GetTenantId() > 0 {
+		req.Data.TenantId = trans.Ptr(operator.GetTenantId())
+	}
+
+	req.Data.UpdatedBy = trans.Ptr(operator.UserId)
+	if req.UpdateMask != nil {
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "updated_by")
+	}
+
+	if err = s.repo.Update(ctx, req); err != nil {
+		return nil, err
+	}
+
+	s.reload(ctx)
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AuditForwarderService) Delete(ctx context.Context, req *auditV1.DeleteAuditForwarderRequest) (*emptypb.Empty, error) {
+	if err := s.repo.Delete(ctx, req); err != nil {
+		return nil, err
+	}
+
+	s.reload(ctx)
+
+	return &emptypb.Empty{}, nil
+}
+
+// Test 按当前配置同步投递一条测试事件
+func (s *AuditForwarderService) Test(ctx context.Context, req *auditV1.TestAuditForwarderRequest) (*auditV1.TestAuditForwarderResponse, error) {
+	if req == nil || req.GetId() == 0 {
+		return nil, adminV1.ErrorBadRequest("id is required")
+	}
+
+	cfg, err := s.repo.Get(ctx, &auditV1.GetAuditForwarderRequest{
+		QueryBy: &auditV1.GetAuditForwarderRequest_Id{Id: req.GetId()},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	err = s.forwarder.SendTest(ctx, cfg)
+	resp := &auditV1.TestAuditForwarderResponse{
+		Success:   err == nil,
+		LatencyMs: uint32(time.Since(start).Milliseconds()),
+	}
+	if err != nil {
+		resp.ErrorMessage = err.Error()
+	}
+
+	return resp, nil
+}
+
+// reload 配置变更后立即刷新本实例的转发路由，其他实例由定时刷新同步
+func (s *AuditForwarderService) reload(ctx context.Context) {
+	if err := s.forwarder.Reload(ctx); err != nil {
+		s.log.Warnf("reload audit forwarders failed: %s", err.Error())
+	}
+}
+
+// validateAuditForwarder 校验输出格式与传输方式的组合
+func validateAuditForwarder(cfg *auditV1.AuditForwarder) error {
+	if cfg.GetEndpoint() == "" {
+		return adminV1.ErrorBadRequest("endpoint is required")
+	}
+
+	switch cfg.GetFormat() {
+	case auditV1.AuditForwarder_RFC5424, auditV1.AuditForwarder_CEF:
+		switch cfg.GetTransport() {
+		case auditV1.AuditForwarder_UDP, auditV1.AuditForwarder_TCP, auditV1.AuditForwarder_TLS:
+		default:
+			return adminV1.ErrorBadRequest("syslog formats require udp, tcp or tls transport")
+		}
+	case auditV1.AuditForwarder_JSON:
+		if cfg.GetTransport() != auditV1.AuditForwarder_HTTP {
+			return adminV1.ErrorBadRequest("json format requires http transport")
+		}
+	default:
+		return adminV1.ErrorBadRequest("format is required")
+	}
+
+	return nil
+}
@@ -0,0 +1,560 @@
+package service
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	auditV1 "go-wind-admin/api/gen/go/audit/service/v1"
+
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+	"go-wind-admin/pkg/middleware/auth"
+	"go-wind-admin/pkg/oss"
+	"go-wind-admin/pkg/task"
+)
+
+const restoreAuditLogBatchSize = 500
+
+// auditLogArchiveManifest 归档清单，与归档数据对象一同上传
+type auditLogArchiveManifest struct {
+	ArchiveID   uint32    `json:"archive_id"`
+	LogType     string    `json:"log_type"`
+	Table       string    `json:"table"`
+	TenantID    uint32    `json:"tenant_id"`
+	Cutoff      time.Time `json:"cutoff"`
+	RowCount    uint64    `json:"row_count"`
+	MinLogID    uint32    `json:"min_log_id"`
+	MaxLogID    uint32    `json:"max_log_id"`
+	Object      string    `json:"object"`
+	Sha256      string    `json:"sha256"`
+	SizeBytes   uint64    `json:"size_bytes"`
+	Format      string    `json:"format"`
+	Compression string    `json:"compression"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// AuditLogArchiveService 审计日志保留、归档与恢复
+type AuditLogArchiveService struct {
+	adminV1.AuditLogArchiveServiceHTTPServer
+
+	log *log.Helper
+
+	taskScheduler TaskScheduler
+
+	policyRepo  *data.AuditLogRetentionPolicyRepo
+	archiveRepo *data.AuditLogArchiveRepo
+	archiver    *data.AuditLogArchiver
+	storage     oss.ObjectStorage
+}
+
+func NewAuditLogArchiveService(
+	ctx *bootstrap.Context,
+	policyRepo *data.AuditLogRetentionPolicyRepo,
+	archiveRepo *data.AuditLogArchiveRepo,
+	archiver *data.AuditLogArchiver,
+	storage oss.ObjectStorage,
+) *AuditLogArchiveService {
+	return &AuditLogArchiveService{
+		log:         ctx.NewLoggerHelper("audit-log-archive/service/admin-service"),
+		policyRepo:  policyRepo,
+		archiveRepo: archiveRepo,
+		archiver:    archiver,
+		storage:     storage,
+	}
+}
+
+func (s *AuditLogArchiveService) RegisterTaskScheduler(taskScheduler TaskScheduler) {
+	s.taskScheduler = taskScheduler
+}
+
+func (s *AuditLogArchiveService) ListRetentionPolicy(ctx context.Context, req *paginationV1.PagingRequest) (*auditV1.ListAuditLogRetentionPolicyResponse, error) {
+	return s.policyRepo.List(ctx, req)
+}
+
+func (s *AuditLogArchiveService) GetRetentionPolicy(ctx context.Context, req *auditV1.GetAuditLogRetentionPolicyRequest) (*auditV1.AuditLogRetentionPolicy, error) {
+	return s.policyRepo.Get(ctx, req)
+}
+
+func (s *AuditLogArchiveService) CreateRetentionPolicy(ctx context.Context, req *auditV1.CreateAuditLogRetentionPolicyRequest) (*emptypb.Empty, error) {
+	if req == nil || req.Data == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+	if req.Data.GetLogType() == auditV1.AuditLogType_AUDIT_LOG_TYPE_UNSPECIFIED {
+		return nil, adminV1.ErrorBadRequest("log type is required")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 租户管理员只能为本租户配置策略
+	if operator.GetTenantId() > 0 {
+		req.Data.TenantId = trans.Ptr(operator.GetTenantId())
+	}
+
+	req.Data.CreatedBy = trans.Ptr(operator.UserId)
+
+	if err = s.policyRepo.Create(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AuditLogArchiveService) UpdateRetentionPolicy(ctx context.Context, req *auditV1.UpdateAuditLogRetentionPolicyRequest) (*emptypb.Empty, error) {
+	if req == nil || req.Data == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Data.Id = trans.Ptr(req.GetId())
+
+	req.Data.UpdatedBy = trans.Ptr(operator.UserId)
+	if req.UpdateMask != nil {
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "updated_by")
+	}
+
+	if err = s.policyRepo.Update(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AuditLogArchiveService) DeleteRetentionPolicy(ctx context.Context, req *auditV1.DeleteAuditLogRetentionPolicyRequest) (*emptypb.Empty, error) {
+	if err := s.policyRepo.Delete(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AuditLogArchiveService) ListArchive(ctx context.Context, req *paginationV1.PagingRequest) (*auditV1.ListAuditLogArchiveResponse, error) {
+	return s.archiveRepo.List(ctx, req)
+}
+
+func (s *AuditLogArchiveService) GetArchive(ctx context.Context, req *auditV1.GetAuditLogArchiveRequest) (*auditV1.AuditLogArchive, error) {
+	return s.archiveRepo.Get(ctx, req)
+}
+
+func (s *AuditLogArchiveService) ListRestoredAuditLog(ctx context.Context, req *paginationV1.PagingRequest) (*auditV1.ListRestoredAuditLogResponse, error) {
+	return s.archiveRepo.ListRestored(ctx, req)
+}
+
+// RunArchive 立即投递一次归档任务
+func (s *AuditLogArchiveService) RunArchive(ctx context.Context, req *auditV1.RunAuditLogArchiveRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+	if s.taskScheduler == nil {
+		return nil, adminV1.ErrorServiceUnavailable("task scheduler is not available")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	taskData := &task.AuditArchiveTaskData{
+		TenantID: req.TenantId,
+	}
+	if req.LogType != nil && req.GetLogType() != auditV1.AuditLogType_AUDIT_LOG_TYPE_UNSPECIFIED {
+		taskData.LogType = req.GetLogType().String()
+	}
+	// 租户管理员只能归档本租户的日志
+	if operator.GetTenantId() > 0 {
+		taskData.TenantID = trans.Ptr(operator.GetTenantId())
+	}
+
+	if err = s.taskScheduler.NewTask(task.AuditArchiveTaskType, taskData); err != nil {
+		s.log.Errorf("enqueue audit archive task failed: %s", err.Error())
+		return nil, adminV1.ErrorInternalServerError("enqueue audit archive task failed")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// VerifyArchive 重新下载归档对象并校验摘要
+func (s *AuditLogArchiveService) VerifyArchive(ctx context.Context, req *auditV1.VerifyAuditLogArchiveRequest) (*auditV1.VerifyAuditLogArchiveResponse, error) {
+	archive, err := s.getUploadedArchive(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	file, actual, err := s.downloadArchive(ctx, archive)
+	if err != nil {
+		return nil, err
+	}
+	defer removeTempFile(file)
+
+	var rowCount uint64
+	if actual == archive.GetSha256() {
+		if err = readArchiveFile(file, func(*data.ArchivedAuditLogRow) error {
+			rowCount++
+			return nil
+		}); err != nil {
+			s.log.Errorf("read audit log archive [%d] failed: %s", archive.GetId(), err.Error())
+			return nil, auditV1.ErrorInternalServerError("read audit log archive failed")
+		}
+	}
+
+	return &auditV1.VerifyAuditLogArchiveResponse{
+		Valid:          actual == archive.GetSha256() && rowCount == archive.GetRowCount(),
+		ExpectedSha256: archive.GetSha256(),
+		ActualSha256:   actual,
+		RowCount:       rowCount,
+	}, nil
+}
+
+// RestoreArchive 校验后把归档数据写入只读查询表
+func (s *AuditLogArchiveService) RestoreArchive(ctx context.Context, req *auditV1.RestoreAuditLogArchiveRequest) (*auditV1.RestoreAuditLogArchiveResponse, error) {
+	archive, err := s.getUploadedArchive(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	file, actual, err := s.downloadArchive(ctx, archive)
+	if err != nil {
+		return nil, err
+	}
+	defer removeTempFile(file)
+
+	if actual != archive.GetSha256() {
+		s.log.Errorf("audit log archive [%d] checksum mismatch: expected %s, actual %s", archive.GetId(), archive.GetSha256(), actual)
+		return nil, auditV1.ErrorBadRequest("audit log archive checksum mismatch")
+	}
+
+	var restored uint64
+	batch := make([]*data.ArchivedAuditLogRow, 0, restoreAuditLogBatchSize)
+	flush := func() error {
+		if err := s.archiveRepo.BatchCreateRestored(ctx, archive, batch); err != nil {
+			return err
+		}
+		restored += uint64(len(batch))
+		batch = batch[:0]
+		return nil
+	}
+
+	if err = readArchiveFile(file, func(row *data.ArchivedAuditLogRow) error {
+		batch = append(batch, row)
+		if len(batch) >= restoreAuditLogBatchSize {
+			return flush()
+		}
+		return nil
+	}); err != nil {
+		s.log.Errorf("restore audit log archive [%d] failed: %s", archive.GetId(), err.Error())
+		return nil, auditV1.ErrorInternalServerError("restore audit log archive failed")
+	}
+	if err = flush(); err != nil {
+		return nil, err
+	}
+
+	if err = s.archiveRepo.MarkRestored(ctx, archive.GetId(), restored); err != nil {
+		return nil, err
+	}
+
+	return &auditV1.RestoreAuditLogArchiveResponse{RestoredCount: restored}, nil
+}
+
+// AsyncArchiveAuditLogs 异步执行审计日志的归档与清除
+func (s *AuditLogArchiveService) AsyncArchiveAuditLogs(ctx context.Context, taskType string, taskData *task.AuditArchiveTaskData) error {
+	s.log.Infof("AsyncArchiveAuditLogs [%s] [%+v]", taskType, taskData)
+
+	ctx = appViewer.NewSystemViewerContext(ctx)
+
+	var onlyType auditV1.AuditLogType
+	if taskData != nil && taskData.LogType != "" {
+		v, ok := auditV1.AuditLogType_value[taskData.LogType]
+		if !ok {
+			return auditV1.ErrorBadRequest("unsupported audit log type: %s", taskData.LogType)
+		}
+		onlyType = auditV1.AuditLogType(v)
+	}
+	var onlyTenant *uint32
+	if taskData != nil {
+		onlyTenant = taskData.TenantID
+	}
+
+	policies, err := s.policyRepo.ListEnabled(ctx)
+	if err != nil {
+		return err
+	}
+
+	// 租户专属策略优先，平台默认策略（租户 0）覆盖其余租户
+	defaults := make(map[auditV1.AuditLogType]*auditV1.AuditLogRetentionPolicy)
+	specific := make(map[auditV1.AuditLogType]map[uint32]struct{})
+	for _, p := range policies {
+		if onlyType != auditV1.AuditLogType_AUDIT_LOG_TYPE_UNSPECIFIED && p.GetLogType() != onlyType {
+			continue
+		}
+		if p.GetTenantId() == 0 {
+			defaults[p.GetLogType()] = p
+			continue
+		}
+		if specific[p.GetLogType()] == nil {
+			specific[p.GetLogType()] = make(map[uint32]struct{})
+		}
+		specific[p.GetLogType()][p.GetTenantId()] = struct{}{}
+
+		if onlyTenant != nil && *onlyTenant != p.GetTenantId() {
+			continue
+		}
+		s.applyPolicy(ctx, p, p.GetTenantId())
+	}
+
+	for logType, p := range defaults {
+		cutoff, ok := retentionCutoff(p, time.Now())
+		if !ok {
+			continue
+		}
+
+		tenantIDs, err := s.archiver.DistinctTenantIDs(ctx, logType, cutoff)
+		if err != nil {
+			s.log.Errorf("query aged tenants of [%s] failed: %s", logType.String(), err.Error())
+			continue
+		}
+		for _, tenantID := range tenantIDs {
+			if _, ok = specific[logType][tenantID]; ok && tenantID != 0 {
+				continue
+			}
+			if onlyTenant != nil && *onlyTenant != tenantID {
+				continue
+			}
+			s.applyPolicy(ctx, p, tenantID)
+		}
+	}
+
+	return nil
+}
+
+// retentionCutoff 计算策略的截止时间，保留天数为 0 视为永久保留
+func retentionCutoff(p *auditV1.AuditLogRetentionPolicy, now time.Time) (time.Time, bool) {
+	if p.GetRetentionDays() == 0 {
+		return time.Time{}, false
+	}
+	return now.AddDate(0, 0, -int(p.GetRetentionDays())), true
+}
+
+// applyPolicy 对单个租户执行一条保留策略
+func (s *AuditLogArchiveService) applyPolicy(ctx context.Context, p *auditV1.AuditLogRetentionPolicy, tenantID uint32) {
+	cutoff, ok := retentionCutoff(p, time.Now())
+	if !ok {
+		return
+	}
+
+	logType := p.GetLogType()
+	batchSize := int(p.GetBatchSize())
+
+	// 不归档，直接清除
+	if !p.GetArchiveEnabled() {
+		purged, err := s.archiver.PurgeAged(ctx, logType, tenantID, cutoff, 0, batchSize)
+		if err != nil {
+			s.log.Errorf("purge [%s] of tenant [%d] failed: %s", logType.String(), tenantID, err.Error())
+			return
+		}
+		s.log.Infof("purged %d [%s] logs of tenant [%d] older than %s", purged, logType.String(), tenantID, cutoff.Format(time.RFC3339))
+		return
+	}
+
+	if err := s.archiveAndPurge(ctx, logType, tenantID, cutoff, batchSize); err != nil {
+		s.log.Errorf("archive [%s] of tenant [%d] failed: %s", logType.String(), tenantID, err.Error())
+	}
+}
+
+// archiveAndPurge 导出 -> 上传归档与清单 -> 清除已归档的在线数据
+func (s *AuditLogArchiveService) archiveAndPurge(ctx context.Context, logType auditV1.AuditLogType, tenantID uint32, cutoff time.Time, batchSize int) error {
+	table, err := data.AuditLogTableName(logType)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp("", "audit-archive-*.ndjson.gz")
+	if err != nil {
+		return err
+	}
+	defer removeTempFile(file)
+
+	hasher := sha256.New()
+	gz := gzip.NewWriter(io.MultiWriter(file, hasher))
+	bw := bufio.NewWriter(gz)
+
+	stats, err := s.archiver.ExportAged(ctx, logType, tenantID, cutoff, batchSize, func(row *data.ArchivedAuditLogRow) error {
+		return data.WriteArchivedAuditLogRow(bw, row)
+	})
+	if err != nil {
+		return err
+	}
+	if err = bw.Flush(); err != nil {
+		return err
+	}
+	if err = gz.Close(); err != nil {
+		return err
+	}
+	if stats.RowCount == 0 {
+		return nil
+	}
+
+	size, err := file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	if _, err = file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	archiveID, err := s.archiveRepo.CreateExporting(ctx, tenantID, logType, oss.BucketAuditArchives, cutoff)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	objectName := data.AuditLogArchiveObjectName(logType, tenantID, archiveID, now)
+	result := &data.AuditLogArchiveResult{
+		ObjectName:         objectName,
+		ManifestObjectName: strings.TrimSuffix(objectName, ".ndjson.gz") + ".manifest.json",
+		Sha256:             hex.EncodeToString(hasher.Sum(nil)),
+		SizeBytes:          uint64(size),
+		RowCount:           stats.RowCount,
+		MinLogID:           stats.MinLogID,
+		MaxLogID:           stats.MaxLogID,
+	}
+
+	fail := func(purged uint64, cause error) error {
+		_ = s.archiveRepo.MarkFailed(ctx, archiveID, purged, cause)
+		return cause
+	}
+
+	if _, err = s.storage.UploadStream(ctx, oss.BucketAuditArchives, result.ObjectName, "application/gzip", file, size); err != nil {
+		return fail(0, err)
+	}
+
+	manifest, err := json.MarshalIndent(&auditLogArchiveManifest{
+		ArchiveID:   archiveID,
+		LogType:     logType.String(),
+		Table:       table,
+		TenantID:    tenantID,
+		Cutoff:      cutoff.UTC(),
+		RowCount:    result.RowCount,
+		MinLogID:    result.MinLogID,
+		MaxLogID:    result.MaxLogID,
+		Object:      result.ObjectName,
+		Sha256:      result.Sha256,
+		SizeBytes:   result.SizeBytes,
+		Format:      "ndjson",
+		Compression: "gzip",
+		CreatedAt:   now.UTC(),
+	}, "", "  ")
+	if err != nil {
+		return fail(0, err)
+	}
+	if _, err = s.storage.UploadStream(ctx, oss.BucketAuditArchives, result.ManifestObjectName, "application/json",
+		strings.NewReader(string(manifest)), int64(len(manifest))); err != nil {
+		return fail(0, err)
+	}
+
+	if err = s.archiveRepo.MarkArchived(ctx, archiveID, result); err != nil {
+		return err
+	}
+
+	// 只清除已写入归档的行（主键不超过归档的最大ID）
+	purged, err := s.archiver.PurgeAged(ctx, logType, tenantID, cutoff, result.MaxLogID, batchSize)
+	if err != nil {
+		return fail(purged, err)
+	}
+
+	s.log.Infof("archived %d [%s] logs of tenant [%d] to %s/%s, purged %d", result.RowCount, logType.String(), tenantID, oss.BucketAuditArchives, result.ObjectName, purged)
+
+	return s.archiveRepo.MarkPurged(ctx, archiveID, purged)
+}
+
+// getUploadedArchive 查询已上传对象的归档
+func (s *AuditLogArchiveService) getUploadedArchive(ctx context.Context, id uint32) (*auditV1.AuditLogArchive, error) {
+	archive, err := s.archiveRepo.Get(ctx, &auditV1.GetAuditLogArchiveRequest{
+		QueryBy: &auditV1.GetAuditLogArchiveRequest_Id{Id: id},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if archive.GetObjectName() == "" || archive.GetSha256() == "" {
+		return nil, auditV1.ErrorBadRequest("audit log archive has not been uploaded")
+	}
+
+	return archive, nil
+}
+
+// downloadArchive 下载归档对象到临时文件，同时计算摘要
+func (s *AuditLogArchiveService) downloadArchive(ctx context.Context, archive *auditV1.AuditLogArchive) (*os.File, string, error) {
+	obj, err := s.storage.GetObject(ctx, archive.GetBucketName(), archive.GetObjectName())
+	if err != nil {
+		s.log.Errorf("get audit log archive object [%s] failed: %s", archive.GetObjectName(), err.Error())
+		return nil, "", auditV1.ErrorInternalServerError("get audit log archive object failed")
+	}
+	defer obj.Close()
+
+	file, err := os.CreateTemp("", "audit-restore-*.ndjson.gz")
+	if err != nil {
+		return nil, "", auditV1.ErrorInternalServerError("create temp file failed")
+	}
+
+	hasher := sha256.New()
+	if _, err = io.Copy(io.MultiWriter(file, hasher), obj); err != nil {
+		removeTempFile(file)
+		s.log.Errorf("download audit log archive object [%s] failed: %s", archive.GetObjectName(), err.Error())
+		return nil, "", auditV1.ErrorInternalServerError("download audit log archive object failed")
+	}
+
+	if _, err = file.Seek(0, io.SeekStart); err != nil {
+		removeTempFile(file)
+		return nil, "", auditV1.ErrorInternalServerError("download audit log archive object failed")
+	}
+
+	return file, hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+// readArchiveFile 解压并逐行读取归档文件
+func readArchiveFile(file *os.File, fn func(row *data.ArchivedAuditLogRow) error) error {
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		return err
+	}
+	defer gz.Close()
+
+	return data.ReadArchivedAuditLogRows(gz, fn)
+}
+
+func removeTempFile(file *os.File) {
+	if file == nil {
+		return
+	}
+	_ = file.Close()
+	_ = os.Remove(file.Name())
+}
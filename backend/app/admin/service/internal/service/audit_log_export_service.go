@@ -0,0 +1,401 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	"github.com/tx7do/go-crud/viewer"
+	"github.com/tx7do/go-utils/id"
+	"github.com/tx7do/go-utils/timeutil"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	auditV1 "go-wind-admin/api/gen/go/audit/service/v1"
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
+	permissionV1 "go-wind-admin/api/gen/go/permission/service/v1"
+	storageV1 "go-wind-admin/api/gen/go/storage/service/v1"
+
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+	"go-wind-admin/pkg/export"
+	"go-wind-admin/pkg/middleware/auth"
+	"go-wind-admin/pkg/oss"
+	"go-wind-admin/pkg/task"
+)
+
+// auditLogExportLinkExpire 导出文件下载链接的有效期
+const auditLogExportLinkExpire = 24 * time.Hour
+
+// AuditLogExportService 审计日志导出，由各审计日志服务的 Export 接口委托调用
+type AuditLogExportService struct {
+	log *log.Helper
+
+	taskScheduler TaskScheduler
+
+	apiAuditLogRepo         *data.ApiAuditLogRepo
+	loginAuditLogRepo       *data.LoginAuditLogRepo
+	operationAuditLogRepo   *data.OperationAuditLogRepo
+	dataAccessAuditLogRepo  *data.DataAccessAuditLogRepo
+	permissionAuditLogRepo  *data.PermissionAuditLogRepo
+	policyEvaluationLogRepo *data.PolicyEvaluationLogRepo
+
+	internalMessageService *InternalMessageService
+	storage                oss.ObjectStorage
+}
+
+func NewAuditLogExportService(
+	ctx *bootstrap.Context,
+	apiAuditLogRepo *data.ApiAuditLogRepo,
+	loginAuditLogRepo *data.LoginAuditLogRepo,
+	operationAuditLogRepo *data.OperationAuditLogRepo,
+	dataAccessAuditLogRepo *data.DataAccessAuditLogRepo,
+	permissionAuditLogRepo *data.PermissionAuditLogRepo,
+	policyEvaluationLogRepo *data.PolicyEvaluationLogRepo,
+	internalMessageService *InternalMessageService,
+	storage oss.ObjectStorage,
+) *AuditLogExportService {
+	return &AuditLogExportService{
+		log:                     ctx.NewLoggerHelper("audit-log-export/service/admin-service"),
+		apiAuditLogRepo:         apiAuditLogRepo,
+		loginAuditLogRepo:       loginAuditLogRepo,
+		operationAuditLogRepo:   operationAuditLogRepo,
+		dataAccessAuditLogRepo:  dataAccessAuditLogRepo,
+		permissionAuditLogRepo:  permissionAuditLogRepo,
+		policyEvaluationLogRepo: policyEvaluationLogRepo,
+		internalMessageService:  internalMessageService,
+		storage:                 storage,
+	}
+}
+
+func (s *AuditLogExportService) RegisterTaskScheduler(taskScheduler TaskScheduler) {
+	s.taskScheduler = taskScheduler
+}
+
+// exportFormat 将接口枚举转换为导出格式
+func exportFormat(f auditV1.AuditLogExportFormat) (export.Format, error) {
+	switch f {
+	case auditV1.AuditLogExportFormat_AUDIT_LOG_EXPORT_FORMAT_UNSPECIFIED, auditV1.AuditLogExportFormat_EXPORT_FORMAT_CSV:
+		return export.FormatCSV, nil
+	case auditV1.AuditLogExportFormat_EXPORT_FORMAT_NDJSON:
+		return export.FormatNDJSON, nil
+	case auditV1.AuditLogExportFormat_EXPORT_FORMAT_XLSX:
+		return export.FormatXLSX, nil
+	default:
+		return "", fmt.Errorf("unsupported export format: %s", f.String())
+	}
+}
+
+// auditLogExportObjectName 导出文件的对象名：{TYPE}/{tenant}/{date}/{exportID}.{ext}
+func auditLogExportObjectName(logType auditV1.AuditLogType, tenantID uint32, exportID string, format export.Format, now time.Time) string {
+	return fmt.Sprintf("%s/%d/%s/%s.%s",
+		logType.String(), tenantID, now.UTC().Format("2006-01-02"), exportID, format.FileExtension())
+}
+
+// Enqueue 校验导出请求并投递后台导出任务
+func (s *AuditLogExportService) Enqueue(ctx context.Context, logType auditV1.AuditLogType, req *auditV1.ExportAuditLogRequest) (*auditV1.ExportAuditLogResponse, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+	if s.taskScheduler == nil {
+		return nil, adminV1.ErrorServiceUnavailable("task scheduler is not available")
+	}
+
+	format, err := exportFormat(req.GetFormat())
+	if err != nil {
+		return nil, adminV1.ErrorBadRequest("%s", err.Error())
+	}
+
+	desc, err := auditLogDescriptor(logType)
+	if err != nil {
+		return nil, adminV1.ErrorBadRequest("%s", err.Error())
+	}
+	// 提前校验字段掩码，避免任务执行时才失败
+	if _, err = export.NewRowWriter(format, io.Discard, desc, req.GetPaging().GetFieldMask().GetPaths()); err != nil {
+		return nil, adminV1.ErrorBadRequest("%s", err.Error())
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var paging string
+	if req.Paging != nil {
+		b, err := protojson.Marshal(req.Paging)
+		if err != nil {
+			return nil, adminV1.ErrorBadRequest("invalid paging")
+		}
+		paging = string(b)
+	}
+
+	exportID := id.NewGUIDv4(false)
+	objectName := auditLogExportObjectName(logType, operator.GetTenantId(), exportID, format, time.Now())
+
+	taskData := &task.AuditExportTaskData{
+		ExportID:   exportID,
+		LogType:    logType.String(),
+		Format:     string(format),
+		Paging:     paging,
+		ObjectName: objectName,
+		TenantID:   operator.GetTenantId(),
+		UserID:     operator.GetUserId(),
+		Username:   operator.GetUsername(),
+		OrgUnitID:  operator.GetOrgUnitId(),
+		DataScope:  int32(operator.GetDataScope()),
+	}
+	if err = s.taskScheduler.NewTask(task.AuditExportTaskType, taskData); err != nil {
+		s.log.Errorf("enqueue audit export task failed: %s", err.Error())
+		return nil, adminV1.ErrorInternalServerError("enqueue audit export task failed")
+	}
+
+	return &auditV1.ExportAuditLogResponse{
+		ExportId:   exportID,
+		ObjectName: objectName,
+	}, nil
+}
+
+// AsyncExportAuditLogs 执行导出任务：流式写入临时文件、上传对象存储、记录审计并通知发起人
+func (s *AuditLogExportService) AsyncExportAuditLogs(ctx context.Context, taskType string, taskData *task.AuditExportTaskData) error {
+	s.log.Infof("AsyncExportAuditLogs [%s] [%s] [%s]", taskType, taskData.LogType, taskData.ExportID)
+
+	ctx = exportOperatorContext(ctx, taskData)
+
+	logType := auditV1.AuditLogType(auditV1.AuditLogType_value[taskData.LogType])
+
+	paging := &paginationV1.PagingRequest{}
+	if taskData.Paging != "" {
+		if err := protojson.Unmarshal([]byte(taskData.Paging), paging); err != nil {
+			s.log.Errorf("invalid audit export paging: %s", err.Error())
+			return err
+		}
+	}
+
+	rows, err := s.exportToObject(ctx, logType, export.Format(taskData.Format), paging, taskData.ObjectName)
+
+	s.recordExport(ctx, taskData, rows, err)
+	s.notifyExport(ctx, taskData, rows, err)
+
+	if err != nil {
+		s.log.Errorf("export audit logs [%s] failed: %s", taskData.ExportID, err.Error())
+	}
+	return err
+}
+
+// exportOperatorContext 在后台任务中还原发起人的身份与数据权限
+func exportOperatorContext(ctx context.Context, taskData *task.AuditExportTaskData) context.Context {
+	ctx = auth.NewContext(ctx, &authenticationV1.UserTokenPayload{
+		UserId:    taskData.UserID,
+		TenantId:  trans.Ptr(taskData.TenantID),
+		Username:  trans.Ptr(taskData.Username),
+		OrgUnitId: trans.Ptr(taskData.OrgUnitID),
+		DataScope: trans.Ptr(identityV1.DataScope(taskData.DataScope)),
+	})
+
+	return viewer.WithContext(ctx, appViewer.NewUserViewer(
+		uint64(taskData.UserID),
+		uint64(taskData.TenantID),
+		uint64(taskData.OrgUnitID),
+		"",
+		identityV1.DataScope(taskData.DataScope),
+	))
+}
+
+func (s *AuditLogExportService) exportToObject(
+	ctx context.Context,
+	logType auditV1.AuditLogType,
+	format export.Format,
+	paging *paginationV1.PagingRequest,
+	objectName string,
+) (uint64, error) {
+	desc, err := auditLogDescriptor(logType)
+	if err != nil {
+		return 0, err
+	}
+
+	file, err := os.CreateTemp("", "audit-export-*."+format.FileExtension())
+	if err != nil {
+		return 0, err
+	}
+	defer removeTempFile(file)
+
+	writer, err := export.NewRowWriter(format, file, desc, paging.GetFieldMask().GetPaths())
+	if err != nil {
+		return 0, err
+	}
+
+	// 导出总行数未知，导出阶段只报告已写出的行数
+	progress := task.ProgressFromContext(ctx)
+	progress.SetStage("export", "exporting audit logs")
+
+	var rows uint64
+	if err = s.streamAuditLogs(ctx, logType, paging, func(msg proto.Message) error {
+		if rows++; rows%1000 == 0 {
+			progress.Report(0, "", fmt.Sprintf("exported %d rows", rows))
+		}
+		return writer.Write(msg)
+	}); err != nil {
+		_ = writer.Close()
+		return rows, err
+	}
+	if err = writer.Close(); err != nil {
+		return rows, err
+	}
+
+	size, err := file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return rows, err
+	}
+	if _, err = file.Seek(0, io.SeekStart); err != nil {
+		return rows, err
+	}
+
+	progress.Report(90, "upload", fmt.Sprintf("uploading %d rows", rows))
+
+	if _, err = s.storage.UploadStream(ctx, oss.BucketAuditExports, objectName, format.ContentType(), file, size); err != nil {
+		return rows, err
+	}
+
+	return rows, nil
+}
+
+// auditLogDescriptor 返回审计日志类型对应的消息描述符
+func auditLogDescriptor(logType auditV1.AuditLogType) (protoreflect.MessageDescriptor, error) {
+	switch logType {
+	case auditV1.AuditLogType_API_AUDIT_LOG:
+		return (&auditV1.ApiAuditLog{}).ProtoReflect().Descriptor(), nil
+	case auditV1.AuditLogType_LOGIN_AUDIT_LOG:
+		return (&auditV1.LoginAuditLog{}).ProtoReflect().Descriptor(), nil
+	case auditV1.AuditLogType_OPERATION_AUDIT_LOG:
+		return (&auditV1.OperationAuditLog{}).ProtoReflect().Descriptor(), nil
+	case auditV1.AuditLogType_DATA_ACCESS_AUDIT_LOG:
+		return (&auditV1.DataAccessAuditLog{}).ProtoReflect().Descriptor(), nil
+	case auditV1.AuditLogType_PERMISSION_AUDIT_LOG:
+		return (&auditV1.PermissionAuditLog{}).ProtoReflect().Descriptor(), nil
+	case auditV1.AuditLogType_POLICY_EVALUATION_LOG:
+		return (&permissionV1.PolicyEvaluationLog{}).ProtoReflect().Descriptor(), nil
+	default:
+		return nil, fmt.Errorf("unsupported audit log type: %s", logType.String())
+	}
+}
+
+// streamAuditLogs 按审计日志类型分批读取并逐行回调
+func (s *AuditLogExportService) streamAuditLogs(ctx context.Context, logType auditV1.AuditLogType, paging *paginationV1.PagingRequest, fn func(proto.Message) error) error {
+	switch logType {
+	case auditV1.AuditLogType_API_AUDIT_LOG:
+		return s.apiAuditLogRepo.ExportStream(ctx, paging, 0, eachRow[auditV1.ApiAuditLog](fn))
+	case auditV1.AuditLogType_LOGIN_AUDIT_LOG:
+		return s.loginAuditLogRepo.ExportStream(ctx, paging, 0, eachRow[auditV1.LoginAuditLog](fn))
+	case auditV1.AuditLogType_OPERATION_AUDIT_LOG:
+		return s.operationAuditLogRepo.ExportStream(ctx, paging, 0, eachRow[auditV1.OperationAuditLog](fn))
+	case auditV1.AuditLogType_DATA_ACCESS_AUDIT_LOG:
+		return s.dataAccessAuditLogRepo.ExportStream(ctx, paging, 0, eachRow[auditV1.DataAccessAuditLog](fn))
+	case auditV1.AuditLogType_PERMISSION_AUDIT_LOG:
+		return s.permissionAuditLogRepo.ExportStream(ctx, paging, 0, eachRow[auditV1.PermissionAuditLog](fn))
+	case auditV1.AuditLogType_POLICY_EVALUATION_LOG:
+		return s.policyEvaluationLogRepo.ExportStream(ctx, paging, 0, eachRow[permissionV1.PolicyEvaluationLog](fn))
+	default:
+		return fmt.Errorf("unsupported audit log type: %s", logType.String())
+	}
+}
+
+func eachRow[T any, PT interface {
+	*T
+	proto.Message
+}](fn func(proto.Message) error) func([]PT) error {
+	return func(items []PT) error {
+		for _, item := range items {
+			if err := fn(item); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// recordExport 将导出行为记录为操作审计日志
+func (s *AuditLogExportService) recordExport(ctx context.Context, taskData *task.AuditExportTaskData, rows uint64, cause error) {
+	afterData, _ := json.Marshal(map[string]any{
+		"format": taskData.Format,
+		"rows":   rows,
+		"bucket": oss.BucketAuditExports,
+		"object": taskData.ObjectName,
+		"filter": json.RawMessage(nonEmptyJSON(taskData.Paging)),
+	})
+
+	now := time.Now()
+	entry := &auditV1.OperationAuditLog{
+		TenantId:     trans.Ptr(taskData.TenantID),
+		UserId:       trans.Ptr(taskData.UserID),
+		Username:     trans.Ptr(taskData.Username),
+		ResourceType: trans.Ptr(taskData.LogType),
+		ResourceId:   trans.Ptr(taskData.ExportID),
+		Action:       trans.Ptr(auditV1.OperationAuditLog_EXPORT),
+		AfterData:    trans.Ptr(string(afterData)),
+		Success:      trans.Ptr(cause == nil),
+		CreatedAt:    timeutil.TimeToTimestamppb(&now),
+	}
+	if cause != nil {
+		entry.FailureReason = trans.Ptr(cause.Error())
+	}
+
+	if err := s.operationAuditLogRepo.Create(ctx, &auditV1.CreateOperationAuditLogRequest{Data: entry}); err != nil {
+		s.log.Errorf("record audit export [%s] failed: %s", taskData.ExportID, err.Error())
+	}
+}
+
+// notifyExport 通过站内信通知发起人导出结果
+func (s *AuditLogExportService) notifyExport(ctx context.Context, taskData *task.AuditExportTaskData, rows uint64, cause error) {
+	if s.internalMessageService == nil || taskData.UserID == 0 {
+		return
+	}
+
+	var title, content string
+	if cause != nil {
+		title = "审计日志导出失败"
+		content = fmt.Sprintf("导出任务 %s（%s）失败：%s", taskData.ExportID, taskData.LogType, cause.Error())
+	} else {
+		resp, err := oss.GetDownloadUrl(ctx, s.storage, &storageV1.GetDownloadInfoRequest{
+			Selector: &storageV1.GetDownloadInfoRequest_StorageObject{
+				StorageObject: &storageV1.StorageObject{
+					BucketName: trans.Ptr(oss.BucketAuditExports),
+					ObjectName: trans.Ptr(taskData.ObjectName),
+				},
+			},
+			PreferPresignedUrl:   trans.Ptr(true),
+			PresignExpireSeconds: trans.Ptr(int32(auditLogExportLinkExpire.Seconds())),
+		})
+		if err != nil {
+			s.log.Errorf("get audit export [%s] download url failed: %s", taskData.ExportID, err.Error())
+		}
+
+		title = "审计日志导出完成"
+		content = fmt.Sprintf("导出任务 %s（%s）已完成，共 %d 条记录。", taskData.ExportID, taskData.LogType, rows)
+		if url := resp.GetDownloadUrl(); url != "" {
+			content += fmt.Sprintf("下载链接（%d小时内有效）：%s", int(auditLogExportLinkExpire.Hours()), url)
+		}
+	}
+
+	if err := s.internalMessageService.SendSystemNotification(ctx, taskData.UserID, title, content); err != nil {
+		s.log.Errorf("notify audit export [%s] failed: %s", taskData.ExportID, err.Error())
+	}
+}
+
+func nonEmptyJSON(s string) string {
+	if s == "" {
+		return "{}"
+	}
+	return s
+}
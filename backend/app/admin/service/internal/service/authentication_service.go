@@ -20,6 +20,7 @@ import (
 
 	"go-wind-admin/pkg/constants"
 	"go-wind-admin/pkg/middleware/auth"
+	applogging "go-wind-admin/pkg/middleware/logging"
 )
 
 type AuthenticationService struct {
@@ -40,6 +41,10 @@ type AuthenticationService struct {
 	clientType    authenticationV1.ClientType
 
 	captchaClient *captcha.Captcha
+
+	loginRiskEngine *applogging.LoginRiskEngine
+
+	luaHooks *data.LuaHookRunner
 }
 
 func NewAuthenticationService(
@@ -54,6 +59,8 @@ func NewAuthenticationService(
 	authenticator *data.Authenticator,
 	clientType authenticationV1.ClientType,
 	captchaClient *captcha.Captcha,
+	loginRiskEngine *applogging.LoginRiskEngine,
+	luaHooks *data.LuaHookRunner,
 ) *AuthenticationService {
 	return &AuthenticationService{
 		log:                ctx.NewLoggerHelper("authn/service/admin-service"),
@@ -67,6 +74,8 @@ func NewAuthenticationService(
 		authenticator:      authenticator,
 		clientType:         clientType,
 		captchaClient:      captchaClient,
+		loginRiskEngine:    loginRiskEngine,
+		luaHooks:           luaHooks,
 	}
 }
 
@@ -333,10 +342,84 @@ func (s *AuthenticationService) resolveUserAuthority(ctx context.Context, user *
 	return nil
 }
 
+// checkLoginRisk 基于历史登录记录评估登录风险，高风险时按租户设置放行、要求多因素认证或拒绝登录
+func (s *AuthenticationService) checkLoginRisk(ctx context.Context, user *identityV1.User, mfaCode string) error {
+	if s.loginRiskEngine == nil || user.GetTenantId() == 0 {
+		return nil
+	}
+
+	assessment := s.loginRiskEngine.AssessLoginAttempt(ctx, user.GetTenantId(), user.GetUsername())
+	if !assessment.IsHigh() {
+		return nil
+	}
+
+	tenant, err := s.tenantRepo.Get(ctx, &identityV1.GetTenantRequest{QueryBy: &identityV1.GetTenantRequest_Id{Id: user.GetTenantId()}})
+	if err != nil {
+		s.log.Errorf("get tenant [%d] for login risk check failed [%s]", user.GetTenantId(), err.Error())
+		return nil
+	}
+
+	switch tenant.GetHighRiskLoginAction() {
+	case identityV1.Tenant_BLOCK:
+		s.log.Warnf("high risk login of user [%d] blocked, score [%d], factors %v", user.GetId(), assessment.Score, assessment.Factors)
+		return authenticationV1.ErrorLoginRiskBlocked("login blocked due to high risk")
+
+	case identityV1.Tenant_REQUIRE_MFA:
+		return s.verifyLoginMfa(ctx, user, mfaCode, assessment)
+
+	default:
+		return nil
+	}
+}
+
+// verifyLoginMfa 高风险登录的多因素认证：未携带认证码时返回 LOGIN_MFA_REQUIRED，客户端提示用户输入 TOTP 后携带 mfa_code 重新登录；
+// 用户未绑定 TOTP 时无法完成认证，按拒绝处理
+func (s *AuthenticationService) verifyLoginMfa(ctx context.Context, user *identityV1.User, mfaCode string, assessment *applogging.LoginRiskAssessment) error {
+	enrolled, err := s.userCredentialRepo.HasTOTP(ctx, user.GetId())
+	if err != nil {
+		return err
+	}
+	if !enrolled {
+		s.log.Warnf("high risk login of user [%d] blocked, mfa not enrolled, score [%d], factors %v", user.GetId(), assessment.Score, assessment.Factors)
+		return authenticationV1.ErrorLoginRiskBlocked("login blocked due to high risk, multi-factor authentication not enrolled")
+	}
+
+	if mfaCode == "" {
+		s.log.Warnf("high risk login of user [%d] requires mfa, score [%d], factors %v", user.GetId(), assessment.Score, assessment.Factors)
+		return authenticationV1.ErrorLoginMfaRequired("multi-factor authentication required")
+	}
+
+	ok, err := s.userCredentialRepo.VerifyTOTP(ctx, user.GetId(), mfaCode)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		s.log.Warnf("high risk login of user [%d] failed mfa verification", user.GetId())
+		return authenticationV1.ErrorLoginMfaRequired("incorrect multi-factor authentication code")
+	}
+
+	return nil
+}
+
 // doGrantTypePassword 处理授权类型 - 密码
 func (s *AuthenticationService) doGrantTypePassword(ctx context.Context, req *authenticationV1.LoginRequest) (*authenticationV1.LoginResponse, error) {
 	ctx = s.resetContextForLogin(ctx)
 
+	// 登录前钩子，脚本可拒绝登录（如限制登录时段、来源）
+	if err := s.luaHooks.Before(ctx, &data.LuaHookEvent{
+		Hook: data.LuaHookBeforeLogin,
+		Fields: map[string]any{
+			"payload": map[string]any{
+				"username":    req.GetUsername(),
+				"client_id":   req.GetClientId(),
+				"device_id":   req.GetDeviceId(),
+				"client_type": req.GetClientType().String(),
+			},
+		},
+	}); err != nil {
+		return nil, err
+	}
+
 	var err error
 	if _, err = s.userCredentialRepo.VerifyCredential(ctx, &authenticationV1.VerifyCredentialRequest{
 		IdentityType: authenticationV1.UserCredential_USERNAME,
@@ -371,12 +454,33 @@ func (s *AuthenticationService) doGrantTypePassword(ctx context.Context, req *au
 		return nil, err
 	}
 
+	// 登录风险检查
+	if err = s.checkLoginRisk(ctx, user, req.GetMfaCode()); err != nil {
+		return nil, err
+	}
+
 	// 生成令牌
 	accessToken, refreshToken, err := s.authenticator.CreateUserToken(ctx, req.GetClientType(), tokenPayload)
 	if err != nil {
 		return nil, err
 	}
 
+	s.luaHooks.After(ctx, &data.LuaHookEvent{
+		Hook: data.LuaHookAfterLogin,
+		User: tokenPayload,
+		Fields: map[string]any{
+			"payload": map[string]any{
+				"user_id":     tokenPayload.GetUserId(),
+				"username":    tokenPayload.GetUsername(),
+				"tenant_id":   tokenPayload.GetTenantId(),
+				"client_id":   tokenPayload.GetClientId(),
+				"device_id":   tokenPayload.GetDeviceId(),
+				"client_type": req.GetClientType().String(),
+				"roles":       tokenPayload.GetRoles(),
+			},
+		},
+	})
+
 	return &authenticationV1.LoginResponse{
 		TokenType:        authenticationV1.TokenType_bearer,
 		AccessToken:      accessToken,
@@ -21,12 +21,15 @@ type DataAccessAuditLogService struct {
 	log *log.Helper
 
 	repo *data.DataAccessAuditLogRepo
+
+	exportService *AuditLogExportService
 }
 
-func NewDataAccessAuditLogService(ctx *bootstrap.Context, repo *data.DataAccessAuditLogRepo) *DataAccessAuditLogService {
+func NewDataAccessAuditLogService(ctx *bootstrap.Context, repo *data.DataAccessAuditLogRepo, exportService *AuditLogExportService) *DataAccessAuditLogService {
 	return &DataAccessAuditLogService{
-		log:  ctx.NewLoggerHelper("data-access-audit-log/service/admin-service"),
-		repo: repo,
+		log:           ctx.NewLoggerHelper("data-access-audit-log/service/admin-service"),
+		repo:          repo,
+		exportService: exportService,
 	}
 }
 
@@ -49,3 +52,8 @@ func (s *DataAccessAuditLogService) Create(ctx context.Context, req *auditV1.Cre
 
 	return &emptypb.Empty{}, nil
 }
+
+// Export 导出日志（后台任务）
+func (s *DataAccessAuditLogService) Export(ctx context.Context, req *auditV1.ExportAuditLogRequest) (*auditV1.ExportAuditLogResponse, error) {
+	return s.exportService.Enqueue(ctx, auditV1.AuditLogType_DATA_ACCESS_AUDIT_LOG, req)
+}
@@ -0,0 +1,518 @@
+package service
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/hibiken/asynq"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	storageV1 "go-wind-admin/api/gen/go/storage/service/v1"
+
+	"go-wind-admin/pkg/crypto"
+	"go-wind-admin/pkg/oss"
+	"go-wind-admin/pkg/task"
+)
+
+const (
+	databaseBackupFormatVersion = 1
+	databaseBackupFormat        = "ndjson"
+	databaseBackupCompression   = "gzip"
+	databaseBackupEncryption    = "aes-256-gcm-stream"
+	databaseBackupNoEncryption  = "none"
+
+	databaseBackupManifestSuffix = ".manifest.json"
+	databaseBackupTimeLayout     = "20060102T150405Z"
+
+	maxDatabaseBackupManifestSize = 4 << 20
+)
+
+var databaseBackupNameSanitizer = regexp.MustCompile(`[^A-Za-z0-9_-]+`)
+
+// databaseBackupManifest 数据库备份清单，在备份数据上传完成后上传
+type databaseBackupManifest struct {
+	FormatVersion int                           `json:"format_version"`
+	Name          string                        `json:"name"`
+	Dialect       string                        `json:"dialect"`
+	TenantID      *uint32                       `json:"tenant_id,omitempty"`
+	Tables        []databaseBackupManifestTable `json:"tables"`
+	RowCount      uint64                        `json:"row_count"`
+	Bucket        string                        `json:"bucket"`
+	Object        string                        `json:"object"`
+	Sha256        string                        `json:"sha256"`
+	SizeBytes     uint64                        `json:"size_bytes"`
+	Format        string                        `json:"format"`
+	Compression   string                        `json:"compression"`
+	Encryption    string                        `json:"encryption"`
+	CreatedAt     time.Time                     `json:"created_at"`
+}
+
+type databaseBackupManifestTable struct {
+	Name     string `json:"name"`
+	RowCount uint64 `json:"row_count"`
+}
+
+// databaseBackupRecord 备份数据中的一行
+type databaseBackupRecord struct {
+	Table string         `json:"table"`
+	Row   map[string]any `json:"row"`
+}
+
+// DatabaseBackupService 数据库备份与恢复任务
+type DatabaseBackupService struct {
+	log *log.Helper
+
+	dumper  *data.DatabaseDumper
+	storage oss.ObjectStorage
+}
+
+func NewDatabaseBackupService(
+	ctx *bootstrap.Context,
+	dumper *data.DatabaseDumper,
+	storage oss.ObjectStorage,
+) *DatabaseBackupService {
+	return &DatabaseBackupService{
+		log:     ctx.NewLoggerHelper("database-backup/service/admin-service"),
+		dumper:  dumper,
+		storage: storage,
+	}
+}
+
+// AsyncBackup 导出全部数据表，压缩加密后上传到对象存储，并按策略清理旧备份
+func (s *DatabaseBackupService) AsyncBackup(ctx context.Context, _ string, taskData *task.BackupTaskData) error {
+	l := task.LoggerFromContext(ctx, s.log)
+
+	encryptor := crypto.DefaultEncryptor()
+	if encryptor == nil {
+		l.Warnf("%s is not configured, the backup will not be encrypted", crypto.EncryptionKeyEnv)
+	}
+
+	now := time.Now().UTC()
+	prefix := databaseBackupPrefix(taskData.Name, taskData.TenantID)
+	base := prefix + now.Format(databaseBackupTimeLayout)
+
+	manifest := &databaseBackupManifest{
+		FormatVersion: databaseBackupFormatVersion,
+		Name:          taskData.Name,
+		Dialect:       s.dumper.Dialect(),
+		TenantID:      taskData.TenantID,
+		Bucket:        oss.BucketBackups,
+		Object:        base + "." + databaseBackupFormat + ".gz",
+		Format:        databaseBackupFormat,
+		Compression:   databaseBackupCompression,
+		Encryption:    databaseBackupNoEncryption,
+		CreatedAt:     now,
+	}
+	if encryptor != nil {
+		manifest.Object += ".enc"
+		manifest.Encryption = databaseBackupEncryption
+	}
+
+	file, err := os.CreateTemp("", "db-backup-*")
+	if err != nil {
+		return err
+	}
+	defer removeTempFile(file)
+
+	progress := task.ProgressFromContext(ctx)
+
+	hasher := sha256.New()
+	if err = s.writeBackup(ctx, io.MultiWriter(file, hasher), encryptor, manifest); err != nil {
+		l.Errorf("export database failed: %s", err.Error())
+		return err
+	}
+
+	size, err := file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	if _, err = file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	manifest.Sha256 = hex.EncodeToString(hasher.Sum(nil))
+	manifest.SizeBytes = uint64(size)
+
+	progress.Report(80, "upload", fmt.Sprintf("uploading %s/%s", manifest.Bucket, manifest.Object))
+
+	contentType := "application/gzip"
+	if encryptor != nil {
+		contentType = oss.DefaultContentType
+	}
+	if _, err = s.storage.UploadStream(ctx, manifest.Bucket, manifest.Object, contentType, file, size); err != nil {
+		return err
+	}
+
+	// 清单最后上传，存在清单即代表备份完整
+	manifestName := base + databaseBackupManifestSuffix
+	manifestData, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	if _, err = s.storage.UploadStream(ctx, manifest.Bucket, manifestName, "application/json",
+		strings.NewReader(string(manifestData)), int64(len(manifestData))); err != nil {
+		return err
+	}
+
+	l.Infof("backed up %d rows of %d tables to %s/%s", manifest.RowCount, len(manifest.Tables), manifest.Bucket, manifest.Object)
+
+	progress.Report(95, "rotate", "rotating old backups")
+
+	keep := taskData.Keep
+	if keep == 0 {
+		keep = task.DefaultBackupKeep
+	}
+	rotated := s.rotateBackups(ctx, l, prefix, keep, taskData.RetentionDays, now)
+
+	task.SetResult(ctx, map[string]any{
+		"manifest":   manifestName,
+		"object":     manifest.Object,
+		"row_count":  manifest.RowCount,
+		"size_bytes": manifest.SizeBytes,
+		"encrypted":  encryptor != nil,
+		"rotated":    rotated,
+	})
+
+	return nil
+}
+
+// writeBackup 逐表写出 NDJSON，经 gzip 压缩，配置了密钥时再做流式加密
+func (s *DatabaseBackupService) writeBackup(
+	ctx context.Context,
+	out io.Writer,
+	encryptor *crypto.Encryptor,
+	manifest *databaseBackupManifest,
+) error {
+	var encWriter io.WriteCloser
+	if encryptor != nil {
+		var err error
+		if encWriter, err = encryptor.NewStreamWriter(out); err != nil {
+			return err
+		}
+		out = encWriter
+	}
+
+	gz := gzip.NewWriter(out)
+	bw := bufio.NewWriter(gz)
+	enc := json.NewEncoder(bw)
+
+	var tables []*data.DatabaseDumpTable
+	for _, t := range s.dumper.Tables() {
+		if t.IncludedIn(manifest.TenantID) {
+			tables = append(tables, t)
+		}
+	}
+
+	// 导出阶段占总进度的80%
+	progress := task.ProgressFromContext(ctx)
+	for i, t := range tables {
+		progress.Report(float64(i)*80/float64(len(tables)), "export", fmt.Sprintf("exporting table %s", t.Name))
+
+		count, err := s.dumper.ExportTable(ctx, t, manifest.TenantID, 0, func(row map[string]any) error {
+			return enc.Encode(&databaseBackupRecord{Table: t.Name, Row: row})
+		})
+		if err != nil {
+			return err
+		}
+
+		manifest.Tables = append(manifest.Tables, databaseBackupManifestTable{Name: t.Name, RowCount: count})
+		manifest.RowCount += count
+	}
+
+	if err := bw.Flush(); err != nil {
+		return err
+	}
+	if err := gz.Close(); err != nil {
+		return err
+	}
+	if encWriter != nil {
+		return encWriter.Close()
+	}
+	return nil
+}
+
+// rotateBackups 保留最近 keep 份备份，并删除超过保留天数的备份，最新的一份总是保留
+func (s *DatabaseBackupService) rotateBackups(ctx context.Context, l *log.Helper, prefix string, keep, retentionDays uint32, now time.Time) int {
+	listed, err := oss.ListFile(ctx, s.storage, &storageV1.ListOssFileRequest{
+		BucketName: trans.Ptr(oss.BucketBackups),
+		Folder:     &prefix,
+	})
+	if err != nil {
+		l.Errorf("list backups under [%s] failed: %s", prefix, err.Error())
+		return 0
+	}
+
+	var bases []string
+	for _, key := range listed.GetFiles() {
+		if strings.HasSuffix(key, databaseBackupManifestSuffix) {
+			bases = append(bases, strings.TrimSuffix(key, databaseBackupManifestSuffix))
+		}
+	}
+	// 对象名中的时间戳保证了字典序即时间顺序
+	sort.Sort(sort.Reverse(sort.StringSlice(bases)))
+
+	var cutoff time.Time
+	if retentionDays > 0 {
+		cutoff = now.AddDate(0, 0, -int(retentionDays))
+	}
+
+	rotated := 0
+	for i, base := range bases {
+		if i == 0 {
+			continue
+		}
+
+		expired := false
+		if !cutoff.IsZero() {
+			if at, perr := time.Parse(databaseBackupTimeLayout, strings.TrimPrefix(base, prefix)); perr == nil && at.Before(cutoff) {
+				expired = true
+			}
+		}
+		if i < int(keep) && !expired {
+			continue
+		}
+
+		// 先删数据对象，最后删清单
+		for _, key := range listed.GetFiles() {
+			if strings.HasPrefix(key, base+".") && key != base+databaseBackupManifestSuffix {
+				if err = s.storage.DeleteFile(ctx, oss.BucketBackups, key); err != nil {
+					l.Errorf("delete backup object [%s] failed: %s", key, err.Error())
+				}
+			}
+		}
+		if err = s.storage.DeleteFile(ctx, oss.BucketBackups, base+databaseBackupManifestSuffix); err != nil {
+			l.Errorf("delete backup manifest [%s] failed: %s", base, err.Error())
+			continue
+		}
+
+		l.Infof("rotated backup [%s]", base)
+		rotated++
+	}
+
+	return rotated
+}
+
+// AsyncRestore 校验备份清单与数据摘要，在一个事务中把备份写回数据库
+func (s *DatabaseBackupService) AsyncRestore(ctx context.Context, _ string, taskData *task.RestoreTaskData) error {
+	l := task.LoggerFromContext(ctx, s.log)
+
+	manifest, err := s.loadBackupManifest(ctx, taskData.Manifest)
+	if err != nil {
+		return err
+	}
+
+	var encryptor *crypto.Encryptor
+	if manifest.Encryption == databaseBackupEncryption {
+		if encryptor = crypto.DefaultEncryptor(); encryptor == nil {
+			return fmt.Errorf("%w: backup is encrypted but %s is not configured", asynq.SkipRetry, crypto.EncryptionKeyEnv)
+		}
+	}
+
+	progress := task.ProgressFromContext(ctx)
+	progress.Report(0, "download", fmt.Sprintf("downloading %s/%s", manifest.Bucket, manifest.Object))
+
+	file, err := s.downloadBackup(ctx, manifest)
+	if err != nil {
+		return err
+	}
+	defer removeTempFile(file)
+
+	// 下载占总进度的10%，其余按已写回的行数计算
+	progress.Report(10, "restore", fmt.Sprintf("restoring %d rows", manifest.RowCount))
+
+	var reader io.Reader = bufio.NewReader(file)
+	if encryptor != nil {
+		if reader, err = encryptor.NewStreamReader(reader); err != nil {
+			return fmt.Errorf("%w: %s", asynq.SkipRetry, err.Error())
+		}
+	}
+	gz, err := gzip.NewReader(reader)
+	if err != nil {
+		return fmt.Errorf("%w: %s", asynq.SkipRetry, err.Error())
+	}
+	defer gz.Close()
+
+	expected := make(map[string]uint64, len(manifest.Tables))
+	tables := make([]string, 0, len(manifest.Tables))
+	for _, t := range manifest.Tables {
+		expected[t.Name] = t.RowCount
+		tables = append(tables, t.Name)
+	}
+
+	counts, err := s.dumper.Restore(ctx, data.RestoreOptions{
+		Tables:          tables,
+		TenantID:        manifest.TenantID,
+		ReplaceExisting: taskData.ReplaceExisting,
+	}, func(emit func(table string, row map[string]any) error) error {
+		read := make(map[string]uint64, len(expected))
+		var total uint64
+
+		dec := json.NewDecoder(gz)
+		dec.UseNumber()
+		for {
+			var record databaseBackupRecord
+			if derr := dec.Decode(&record); derr != nil {
+				if derr == io.EOF {
+					break
+				}
+				return derr
+			}
+			if _, ok := expected[record.Table]; !ok {
+				return fmt.Errorf("table [%s] is not listed in the manifest", record.Table)
+			}
+			if eerr := emit(record.Table, record.Row); eerr != nil {
+				return eerr
+			}
+			read[record.Table]++
+
+			if total++; total%1000 == 0 && manifest.RowCount > 0 {
+				progress.Report(10+float64(total)*90/float64(manifest.RowCount), "", "")
+			}
+		}
+
+		// 行数与清单不一致时回滚
+		for name, want := range expected {
+			if read[name] != want {
+				return fmt.Errorf("table [%s] has %d rows in backup, manifest expects %d", name, read[name], want)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		l.Errorf("restore [%s] failed: %s", taskData.Manifest, err.Error())
+		return err
+	}
+
+	var total uint64
+	for _, n := range counts {
+		total += n
+	}
+	l.Infof("restored %d rows of %d tables from %s/%s", total, len(tables), manifest.Bucket, manifest.Object)
+
+	task.SetResult(ctx, map[string]any{
+		"manifest":  taskData.Manifest,
+		"row_count": total,
+		"tables":    counts,
+	})
+
+	return nil
+}
+
+// loadBackupManifest 下载并校验备份清单，校验失败的任务不再重试
+func (s *DatabaseBackupService) loadBackupManifest(ctx context.Context, name string) (*databaseBackupManifest, error) {
+	if name == "" || !strings.HasSuffix(name, databaseBackupManifestSuffix) {
+		return nil, fmt.Errorf("%w: invalid manifest object name [%s]", asynq.SkipRetry, name)
+	}
+
+	obj, err := s.storage.GetObject(ctx, oss.BucketBackups, name)
+	if err != nil {
+		return nil, err
+	}
+	defer obj.Close()
+
+	raw, err := io.ReadAll(io.LimitReader(obj, maxDatabaseBackupManifestSize))
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest databaseBackupManifest
+	if err = json.Unmarshal(raw, &manifest); err != nil {
+		return nil, fmt.Errorf("%w: invalid manifest: %s", asynq.SkipRetry, err.Error())
+	}
+
+	if err = s.validateBackupManifest(&manifest); err != nil {
+		return nil, fmt.Errorf("%w: %s", asynq.SkipRetry, err.Error())
+	}
+
+	return &manifest, nil
+}
+
+func (s *DatabaseBackupService) validateBackupManifest(manifest *databaseBackupManifest) error {
+	switch {
+	case manifest.FormatVersion != databaseBackupFormatVersion:
+		return fmt.Errorf("unsupported backup format version %d", manifest.FormatVersion)
+	case manifest.Format != databaseBackupFormat || manifest.Compression != databaseBackupCompression:
+		return fmt.Errorf("unsupported backup format %s/%s", manifest.Format, manifest.Compression)
+	case manifest.Encryption != databaseBackupEncryption && manifest.Encryption != databaseBackupNoEncryption:
+		return fmt.Errorf("unsupported backup encryption %s", manifest.Encryption)
+	case manifest.Object == "" || manifest.Sha256 == "":
+		return fmt.Errorf("manifest has no backup object")
+	case manifest.Bucket != oss.BucketBackups:
+		return fmt.Errorf("unexpected backup bucket %s", manifest.Bucket)
+	}
+
+	seen := make(map[string]bool, len(manifest.Tables))
+	for _, t := range manifest.Tables {
+		if _, ok := s.dumper.Table(t.Name); !ok {
+			return fmt.Errorf("table [%s] does not exist in the current schema", t.Name)
+		}
+		if seen[t.Name] {
+			return fmt.Errorf("table [%s] is listed twice", t.Name)
+		}
+		seen[t.Name] = true
+	}
+
+	return nil
+}
+
+// downloadBackup 下载备份数据到临时文件并核对大小与摘要
+func (s *DatabaseBackupService) downloadBackup(ctx context.Context, manifest *databaseBackupManifest) (*os.File, error) {
+	obj, err := s.storage.GetObject(ctx, manifest.Bucket, manifest.Object)
+	if err != nil {
+		return nil, err
+	}
+	defer obj.Close()
+
+	file, err := os.CreateTemp("", "db-restore-*")
+	if err != nil {
+		return nil, err
+	}
+
+	hasher := sha256.New()
+	size, err := io.Copy(io.MultiWriter(file, hasher), obj)
+	if err != nil {
+		removeTempFile(file)
+		return nil, err
+	}
+
+	if uint64(size) != manifest.SizeBytes || hex.EncodeToString(hasher.Sum(nil)) != manifest.Sha256 {
+		removeTempFile(file)
+		return nil, fmt.Errorf("%w: backup object [%s] does not match the manifest checksum", asynq.SkipRetry, manifest.Object)
+	}
+
+	if _, err = file.Seek(0, io.SeekStart); err != nil {
+		removeTempFile(file)
+		return nil, err
+	}
+
+	return file, nil
+}
+
+// databaseBackupPrefix 备份对象前缀：{名称}/{all|tenant-ID}/
+func databaseBackupPrefix(name string, tenantID *uint32) string {
+	name = strings.Trim(databaseBackupNameSanitizer.ReplaceAllString(name, "-"), "-")
+	if name == "" {
+		name = "default"
+	}
+
+	scope := "all"
+	if tenantID != nil {
+		scope = fmt.Sprintf("tenant-%d", *tenantID)
+	}
+
+	return name + "/" + scope + "/"
+}
@@ -0,0 +1,338 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/hibiken/asynq"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
+	storageV1 "go-wind-admin/api/gen/go/storage/service/v1"
+
+	"go-wind-admin/pkg/antivirus"
+	"go-wind-admin/pkg/constants"
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+	"go-wind-admin/pkg/oss"
+	"go-wind-admin/pkg/task"
+)
+
+const (
+	// fileScanMaxRetry 扫描引擎不可用时的重试次数，重试用尽后文件标记为扫描失败
+	fileScanMaxRetry = 5
+
+	// fileQuarantinePrefix 检出恶意内容的对象在隔离存储桶中的前缀，与孤立对象区分
+	fileQuarantinePrefix = "infected/"
+)
+
+// checkFileScanStatus 只有通过扫描、未启用扫描及启用扫描前上传的文件可以下载
+func checkFileScanStatus(f *storageV1.File) error {
+	switch f.GetScanStatus() {
+	case storageV1.File_SCAN_PENDING:
+		return storageV1.ErrorFileNotScanned("file is waiting for malware scan")
+	case storageV1.File_SCAN_FAILED:
+		return storageV1.ErrorFileNotScanned("file malware scan failed")
+	case storageV1.File_SCAN_INFECTED:
+		return storageV1.ErrorFileQuarantined("file is quarantined: %s", f.GetScanSignature())
+	default:
+		return nil
+	}
+}
+
+// FileScanService 上传文件的恶意文件扫描：新文件标记为等待扫描并提交异步任务，
+// 检出恶意内容的对象移动到隔离存储桶，并通知上传者、租户管理员和平台管理员
+type FileScanService struct {
+	log *log.Helper
+
+	scanner       antivirus.Scanner
+	taskScheduler TaskScheduler
+
+	storages       *data.ObjectStorageRouter
+	storageObjects *data.StorageObjectRepo
+	fileRepo       *data.FileRepo
+	tenantRepo     *data.TenantRepo
+	roleRepo       *data.RoleRepo
+	membershipRepo *data.MembershipRepo
+
+	images                 *ImageDerivativeService
+	internalMessageService *InternalMessageService
+}
+
+func NewFileScanService(
+	ctx *bootstrap.Context,
+	scanner antivirus.Scanner,
+	storages *data.ObjectStorageRouter,
+	storageObjects *data.StorageObjectRepo,
+	fileRepo *data.FileRepo,
+	tenantRepo *data.TenantRepo,
+	roleRepo *data.RoleRepo,
+	membershipRepo *data.MembershipRepo,
+	images *ImageDerivativeService,
+	internalMessageService *InternalMessageService,
+) *FileScanService {
+	return &FileScanService{
+		log:                    ctx.NewLoggerHelper("file-scan/service/admin-service"),
+		scanner:                scanner,
+		storages:               storages,
+		storageObjects:         storageObjects,
+		fileRepo:               fileRepo,
+		tenantRepo:             tenantRepo,
+		roleRepo:               roleRepo,
+		membershipRepo:         membershipRepo,
+		images:                 images,
+		internalMessageService: internalMessageService,
+	}
+}
+
+func (s *FileScanService) RegisterTaskScheduler(taskScheduler TaskScheduler) {
+	s.taskScheduler = taskScheduler
+}
+
+// InitialStatus 新文件记录的扫描状态
+func (s *FileScanService) InitialStatus() *storageV1.File_ScanStatus {
+	if s.scanner == nil {
+		return trans.Ptr(storageV1.File_SCAN_SKIPPED)
+	}
+	return trans.Ptr(storageV1.File_SCAN_PENDING)
+}
+
+// Submit 提交等待扫描的文件，未配置异步任务或提交失败时同步扫描
+func (s *FileScanService) Submit(ctx context.Context, f *storageV1.File) {
+	if s.scanner == nil || f == nil || f.GetScanStatus() != storageV1.File_SCAN_PENDING {
+		return
+	}
+
+	if s.taskScheduler != nil {
+		err := s.taskScheduler.NewTask(task.FileScanTaskType,
+			task.FileScanTaskData{FileID: f.GetId()},
+			asynq.MaxRetry(fileScanMaxRetry),
+		)
+		if err == nil {
+			return
+		}
+		s.log.Errorf("enqueue scan of file [%d] failed, scanning synchronously: %s", f.GetId(), err.Error())
+	}
+
+	if err := s.scan(context.WithoutCancel(ctx), s.log, f, true); err != nil {
+		s.log.Errorf("scan file [%d] failed: %s", f.GetId(), err.Error())
+	}
+}
+
+// AsyncScanFile 扫描文件，扫描引擎不可用时返回错误由任务重试，重试用尽后标记为扫描失败
+func (s *FileScanService) AsyncScanFile(ctx context.Context, _ string, taskData *task.FileScanTaskData) error {
+	l := task.LoggerFromContext(ctx, s.log)
+
+	ctx = appViewer.NewSystemViewerContext(ctx)
+
+	if taskData == nil || taskData.FileID == 0 {
+		return storageV1.ErrorBadRequest("file id is required")
+	}
+
+	f, err := s.fileRepo.Get(ctx, &storageV1.GetFileRequest{
+		QueryBy: &storageV1.GetFileRequest_Id{Id: taskData.FileID},
+	})
+	if err != nil {
+		// 文件已被删除
+		l.Warnf("get file [%d] failed, skip scanning: %s", taskData.FileID, err.Error())
+		return nil
+	}
+
+	retried, _ := asynq.GetRetryCount(ctx)
+	maxRetry, ok := asynq.GetMaxRetry(ctx)
+	lastAttempt := !ok || retried >= maxRetry
+
+	if err = s.scan(ctx, l, f, lastAttempt); err != nil {
+		return err
+	}
+
+	task.SetResult(ctx, map[string]any{
+		"file_id":     f.GetId(),
+		"scan_status": f.GetScanStatus().String(),
+	})
+
+	return nil
+}
+
+// scan 扫描文件对应的对象，结果应用到指向同一对象的全部文件记录。
+// 扫描引擎不可用且不是最后一次尝试时返回错误，保持等待扫描状态。
+func (s *FileScanService) scan(ctx context.Context, l *log.Helper, f *storageV1.File, lastAttempt bool) error {
+	if s.scanner == nil {
+		return nil
+	}
+
+	storage, err := s.storages.ForFile(ctx, f, f.GetTenantId())
+	if err != nil {
+		return err
+	}
+
+	bucketName := f.GetBucketName()
+	objectName := fileObjectName(f)
+
+	result, scanErr := s.scanObject(ctx, storage, bucketName, objectName)
+	if scanErr != nil {
+		definitive := errors.Is(scanErr, antivirus.ErrSizeLimitExceeded) || errors.Is(scanErr, antivirus.ErrUnexpectedResponse)
+		if !definitive && !lastAttempt {
+			l.Warnf("scan file [%d] [%s/%s] failed, will retry: %s", f.GetId(), bucketName, objectName, scanErr.Error())
+			return scanErr
+		}
+
+		l.Errorf("scan file [%d] [%s/%s] failed: %s", f.GetId(), bucketName, objectName, scanErr.Error())
+		if _, err = s.fileRepo.UpdateScanResult(ctx, storage.Provider(), bucketName, objectName, &data.FileScanResult{
+			Status:    storageV1.File_SCAN_FAILED,
+			Signature: scanErr.Error(),
+		}); err != nil {
+			return err
+		}
+		f.ScanStatus = trans.Ptr(storageV1.File_SCAN_FAILED)
+		return nil
+	}
+
+	if !result.Infected {
+		f.ScanStatus = trans.Ptr(storageV1.File_SCAN_CLEAN)
+		_, err = s.fileRepo.UpdateScanResult(ctx, storage.Provider(), bucketName, objectName, &data.FileScanResult{
+			Status: storageV1.File_SCAN_CLEAN,
+		})
+		return err
+	}
+
+	l.Warnf("file [%d] [%s/%s] is infected by [%s]", f.GetId(), bucketName, objectName, result.Signature)
+	f.ScanStatus = trans.Ptr(storageV1.File_SCAN_INFECTED)
+
+	files, err := s.quarantine(ctx, storage, bucketName, objectName, result.Signature)
+	if err != nil {
+		return err
+	}
+
+	s.notifyInfected(ctx, files, result.Signature)
+
+	return nil
+}
+
+func (s *FileScanService) scanObject(ctx context.Context, storage oss.ObjectStorage, bucketName, objectName string) (*antivirus.Result, error) {
+	reader, err := storage.GetObject(ctx, bucketName, objectName)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	return s.scanner.Scan(ctx, reader)
+}
+
+// quarantine 把检出恶意内容的对象移动到隔离存储桶，文件记录及去重的物理对象随之指向隔离区，
+// 之后上传的相同内容去重命中该对象时同样会被扫描并拦截
+func (s *FileScanService) quarantine(
+	ctx context.Context,
+	storage oss.ObjectStorage,
+	bucketName, objectName, signature string,
+) ([]*storageV1.File, error) {
+	result := &data.FileScanResult{
+		Status:    storageV1.File_SCAN_INFECTED,
+		Signature: signature,
+	}
+
+	if bucketName != oss.BucketQuarantine {
+		info, err := storage.StatObject(ctx, bucketName, objectName)
+		if err != nil {
+			return nil, err
+		}
+
+		quarantineObject := fileQuarantinePrefix + bucketName + "/" + objectName
+		if err = copyObject(ctx, storage, info, oss.BucketQuarantine, quarantineObject); err != nil {
+			return nil, err
+		}
+
+		result.QuarantineBucket = oss.BucketQuarantine
+		result.QuarantineObject = quarantineObject
+
+		if err = s.storageObjects.Relocate(ctx, storage.Provider(), bucketName, objectName,
+			oss.BucketQuarantine, quarantineObject); err != nil {
+			return nil, err
+		}
+	}
+
+	files, err := s.fileRepo.UpdateScanResult(ctx, storage.Provider(), bucketName, objectName, result)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.QuarantineBucket != "" {
+		if err = storage.DeleteFile(ctx, bucketName, objectName); err != nil {
+			s.log.Errorf("delete infected object [%s/%s] failed: %s", bucketName, objectName, err.Error())
+		}
+		s.images.DeleteDerivatives(ctx, storage, bucketName, objectName)
+	}
+
+	return files, nil
+}
+
+// notifyInfected 通过站内信通知上传者、文件所属租户的管理员和平台管理员
+func (s *FileScanService) notifyInfected(ctx context.Context, files []*storageV1.File, signature string) {
+	if s.internalMessageService == nil || len(files) == 0 {
+		return
+	}
+
+	platformAdmins := s.platformAdminUserIDs(ctx)
+	tenantAdmins := make(map[uint32]uint32)
+
+	for _, f := range files {
+		recipients := make(map[uint32]struct{})
+		if f.GetCreatedBy() != 0 {
+			recipients[f.GetCreatedBy()] = struct{}{}
+		}
+		if tenantID := f.GetTenantId(); tenantID != 0 {
+			adminID, ok := tenantAdmins[tenantID]
+			if !ok {
+				adminID = s.tenantAdminUserID(ctx, tenantID)
+				tenantAdmins[tenantID] = adminID
+			}
+			if adminID != 0 {
+				recipients[adminID] = struct{}{}
+			}
+		}
+		for _, userID := range platformAdmins {
+			recipients[userID] = struct{}{}
+		}
+
+		title := "文件检出恶意内容"
+		content := fmt.Sprintf("文件「%s」（ID：%d，租户：%d）检出恶意内容 %s，已移至隔离区并禁止下载。",
+			f.GetFileName(), f.GetId(), f.GetTenantId(), signature)
+
+		for userID := range recipients {
+			if err := s.internalMessageService.SendSystemNotification(ctx, userID, title, content); err != nil {
+				s.log.Errorf("notify infected file [%d] to user [%d] failed: %s", f.GetId(), userID, err.Error())
+			}
+		}
+	}
+}
+
+func (s *FileScanService) platformAdminUserIDs(ctx context.Context) []uint32 {
+	roleIDs, err := s.roleRepo.ListRoleIDsByRoleCodes(ctx, []string{constants.PlatformAdminRoleCode})
+	if err != nil {
+		s.log.Errorf("list platform admin roles failed: %s", err.Error())
+		return nil
+	}
+	if len(roleIDs) == 0 {
+		return nil
+	}
+
+	userIDs, err := s.membershipRepo.ListUserIDsByRoleIDs(ctx, roleIDs, true)
+	if err != nil {
+		s.log.Errorf("list platform admins failed: %s", err.Error())
+		return nil
+	}
+	return userIDs
+}
+
+func (s *FileScanService) tenantAdminUserID(ctx context.Context, tenantID uint32) uint32 {
+	tenant, err := s.tenantRepo.Get(ctx, &identityV1.GetTenantRequest{QueryBy: &identityV1.GetTenantRequest_Id{Id: tenantID}})
+	if err != nil {
+		s.log.Errorf("get tenant [%d] failed: %s", tenantID, err.Error())
+		return 0
+	}
+	return tenant.GetAdminUserId()
+}
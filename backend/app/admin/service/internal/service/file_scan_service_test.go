@@ -0,0 +1,32 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tx7do/go-utils/trans"
+
+	storageV1 "go-wind-admin/api/gen/go/storage/service/v1"
+)
+
+func TestCheckFileScanStatus(t *testing.T) {
+	for status, allowed := range map[storageV1.File_ScanStatus]bool{
+		storageV1.File_SCAN_STATUS_UNSPECIFIED: true,
+		storageV1.File_SCAN_CLEAN:              true,
+		storageV1.File_SCAN_SKIPPED:            true,
+		storageV1.File_SCAN_PENDING:            false,
+		storageV1.File_SCAN_FAILED:             false,
+		storageV1.File_SCAN_INFECTED:           false,
+	} {
+		err := checkFileScanStatus(&storageV1.File{ScanStatus: trans.Ptr(status)})
+		assert.Equal(t, allowed, err == nil, status.String())
+	}
+
+	assert.NoError(t, checkFileScanStatus(&storageV1.File{}))
+
+	err := checkFileScanStatus(&storageV1.File{
+		ScanStatus:    trans.Ptr(storageV1.File_SCAN_INFECTED),
+		ScanSignature: trans.Ptr("Eicar-Test-Signature"),
+	})
+	assert.True(t, storageV1.IsFileQuarantined(err))
+}
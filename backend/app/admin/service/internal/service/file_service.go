@@ -2,9 +2,15 @@ package service
 
 import (
 	"context"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	"github.com/tx7do/go-utils/id"
 	"github.com/tx7do/go-utils/trans"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -12,39 +18,81 @@ import (
 	"go-wind-admin/app/admin/service/internal/data"
 
 	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
 	storageV1 "go-wind-admin/api/gen/go/storage/service/v1"
 
+	appViewer "go-wind-admin/pkg/entgo/viewer"
 	"go-wind-admin/pkg/middleware/auth"
-	"go-wind-admin/pkg/oss"
+	"go-wind-admin/pkg/task"
 )
 
+const (
+	// FileRecycleBinRetentionDaysEnv 回收站文件的默认保留天数，租户可单独设置
+	FileRecycleBinRetentionDaysEnv = "FILE_RECYCLE_BIN_RETENTION_DAYS"
+
+	defaultFileRecycleBinRetentionDays = 30
+
+	fileRecycleBinPurgeBatchSize = 200
+)
+
+// FileService 文件管理。同一目录下同名文件的每次上传保存为一个版本，
+// 删除的文件连同全部版本移入所属租户的回收站，超过保留期后由清理任务永久删除
 type FileService struct {
 	adminV1.FileServiceHTTPServer
 
 	log *log.Helper
 
-	fileRepo *data.FileRepo
-	mc       *oss.MinIOClient
+	fileRepo       *data.FileRepo
+	tenantRepo     *data.TenantRepo
+	storages       *data.ObjectStorageRouter
+	storageObjects *data.StorageObjectRepo
+	quotas         *StorageQuotaService
+	images         *ImageDerivativeService
+	scans          *FileScanService
+
+	retentionDays uint32
 }
 
 func NewFileService(
 	ctx *bootstrap.Context,
 	fileRepo *data.FileRepo,
-	mc *oss.MinIOClient,
+	tenantRepo *data.TenantRepo,
+	storages *data.ObjectStorageRouter,
+	storageObjects *data.StorageObjectRepo,
+	quotas *StorageQuotaService,
+	images *ImageDerivativeService,
+	scans *FileScanService,
 ) *FileService {
-	return &FileService{
-		log:      ctx.NewLoggerHelper("file/service/admin-service"),
-		fileRepo: fileRepo,
-		mc:       mc,
+	s := &FileService{
+		log:            ctx.NewLoggerHelper("file/service/admin-service"),
+		fileRepo:       fileRepo,
+		tenantRepo:     tenantRepo,
+		storages:       storages,
+		storageObjects: storageObjects,
+		quotas:         quotas,
+		images:         images,
+		scans:          scans,
+		retentionDays:  defaultFileRecycleBinRetentionDays,
 	}
+
+	if env := strings.TrimSpace(os.Getenv(FileRecycleBinRetentionDaysEnv)); env != "" {
+		if v, err := strconv.ParseUint(env, 10, 32); err == nil && v > 0 {
+			s.retentionDays = uint32(v)
+		} else {
+			s.log.Warnf("invalid %s [%s], using %d", FileRecycleBinRetentionDaysEnv, env, defaultFileRecycleBinRetentionDays)
+		}
+	}
+
+	return s
 }
 
 func (s *FileService) List(ctx context.Context, req *paginationV1.PagingRequest) (*storageV1.ListFileResponse, error) {
 	return s.fileRepo.List(ctx, req)
 }
 
+// Get 查询文件，回收站中的文件只能通过回收站接口访问
 func (s *FileService) Get(ctx context.Context, req *storageV1.GetFileRequest) (*storageV1.File, error) {
-	return s.fileRepo.Get(ctx, req)
+	return s.fileRepo.GetActive(ctx, req)
 }
 
 func (s *FileService) Create(ctx context.Context, req *storageV1.CreateFileRequest) (*emptypb.Empty, error) {
@@ -59,11 +107,31 @@ func (s *FileService) Create(ctx context.Context, req *storageV1.CreateFileReque
 	}
 
 	req.Data.CreatedBy = trans.Ptr(operator.UserId)
+	req.Data.TenantId = trans.Ptr(operator.GetTenantId())
+	req.Data.ScanStatus = s.scans.InitialStatus()
+
+	// 物理对象由上传流程登记，不能指向任意物理对象（包括其他租户的对象）
+	req.Data.StorageObjectId = nil
+	req.Data.ObjectName = nil
+	// 版本组由服务端生成，不能把记录挂到其他文件的版本链上
+	req.Data.FileGuid = trans.Ptr(id.NewGUIDv7(false))
+	req.Data.VersionGroup = nil
+	req.Data.Version = nil
+	req.Data.IsLatest = nil
+
+	if err = s.quotas.CheckQuota(ctx, operator.GetTenantId(), operator.GetUserId(), int64(req.Data.GetSize())); err != nil {
+		return nil, err
+	}
 
-	if err = s.fileRepo.Create(ctx, req); err != nil {
+	f, err := s.fileRepo.Create(ctx, req)
+	if err != nil {
 		return nil, err
 	}
 
+	s.quotas.RecordUsage(ctx, operator.GetTenantId(), operator.GetUserId(), int64(req.Data.GetSize()), 1)
+
+	s.scans.Submit(ctx, f)
+
 	return &emptypb.Empty{}, nil
 }
 
@@ -80,6 +148,26 @@ func (s *FileService) Update(ctx context.Context, req *storageV1.UpdateFileReque
 
 	req.Data.Id = trans.Ptr(req.GetId())
 
+	// 扫描结果只能由扫描任务更新，版本信息由上传和版本管理维护，物理对象由上传流程登记
+	req.Data.ScanStatus = nil
+	req.Data.ScanSignature = nil
+	req.Data.ScannedAt = nil
+	req.Data.Version = nil
+	req.Data.IsLatest = nil
+	req.Data.VersionGroup = nil
+	req.Data.ObjectName = nil
+	req.Data.StorageObjectId = nil
+	if req.UpdateMask != nil {
+		req.UpdateMask.Paths = slices.DeleteFunc(req.UpdateMask.Paths, func(p string) bool {
+			switch p {
+			case "scan_status", "scan_signature", "scanned_at", "version", "is_latest", "version_group", "object_name", "storage_object_id":
+				return true
+			default:
+				return false
+			}
+		})
+	}
+
 	req.Data.UpdatedBy = trans.Ptr(operator.UserId)
 	if req.UpdateMask != nil {
 		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "updated_by")
@@ -92,24 +180,331 @@ func (s *FileService) Update(ctx context.Context, req *storageV1.UpdateFileReque
 	return &emptypb.Empty{}, nil
 }
 
+// Delete 删除文件，文件的全部版本移入回收站，对象和存储用量在永久删除时才释放
 func (s *FileService) Delete(ctx context.Context, req *storageV1.DeleteFileRequest) (*emptypb.Empty, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := s.getFile(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if f.DeletedAt != nil {
+		return &emptypb.Empty{}, nil
+	}
+
+	if err = s.fileRepo.MoveToRecycleBin(ctx, f, operator.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListVersions 查询文件的版本历史
+func (s *FileService) ListVersions(ctx context.Context, req *storageV1.ListFileVersionsRequest) (*storageV1.ListFileResponse, error) {
+	f, err := s.getFile(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := s.fileRepo.ListVersions(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+
+	return &storageV1.ListFileResponse{
+		Items: versions,
+		Total: uint64(len(versions)),
+	}, nil
+}
+
+// RestoreVersion 把指定版本恢复为当前版本，较新的版本保留在版本历史中
+func (s *FileService) RestoreVersion(ctx context.Context, req *storageV1.FileVersionRequest) (*storageV1.File, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := s.getVersion(ctx, req.GetId(), req.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+	if version.DeletedAt != nil {
+		return nil, storageV1.ErrorFileNotFound("file is in the recycle bin")
+	}
+	if err = checkFileScanStatus(version); err != nil {
+		return nil, err
+	}
+
+	if !version.GetIsLatest() {
+		if err = s.fileRepo.SetLatestVersion(ctx, version, operator.GetUserId()); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.getFile(ctx, version.GetId())
+}
+
+// PurgeVersion 永久删除文件的历史版本，当前版本需通过删除文件处理
+func (s *FileService) PurgeVersion(ctx context.Context, req *storageV1.FileVersionRequest) (*emptypb.Empty, error) {
+	version, err := s.getVersion(ctx, req.GetId(), req.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+	if version.GetIsLatest() {
+		return nil, adminV1.ErrorBadRequest("the current version cannot be purged, delete the file instead")
+	}
+
+	if err = s.purgeFile(ctx, version); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListRecycleBin 查询回收站中的文件，租户用户只能看到本租户的回收站
+func (s *FileService) ListRecycleBin(ctx context.Context, req *paginationV1.PagingRequest) (*storageV1.ListFileResponse, error) {
+	return s.fileRepo.ListRecycleBin(ctx, req)
+}
+
+// RestoreFromRecycleBin 从回收站恢复文件的全部版本
+func (s *FileService) RestoreFromRecycleBin(ctx context.Context, req *storageV1.RecycledFileRequest) (*storageV1.File, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := s.getRecycledFile(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.fileRepo.RestoreFromRecycleBin(ctx, f, operator.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	return s.getFile(ctx, f.GetId())
+}
+
+// PurgeFromRecycleBin 从回收站永久删除文件的全部版本
+func (s *FileService) PurgeFromRecycleBin(ctx context.Context, req *storageV1.RecycledFileRequest) (*emptypb.Empty, error) {
+	f, err := s.getRecycledFile(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.purgeVersions(ctx, f); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// AsyncPurgeRecycleBin 永久删除回收站中超过保留期的文件
+func (s *FileService) AsyncPurgeRecycleBin(ctx context.Context, taskType string, taskData *task.FileRecycleBinPurgeTaskData) error {
+	l := task.LoggerFromContext(ctx, s.log)
+	l.Infof("AsyncPurgeRecycleBin [%s] [%+v]", taskType, taskData)
+
+	ctx = appViewer.NewSystemViewerContext(ctx)
+
+	if taskData == nil {
+		taskData = &task.FileRecycleBinPurgeTaskData{}
+	}
+
+	var tenantIDs []uint32
+	if taskData.TenantID != nil {
+		tenantIDs = []uint32{*taskData.TenantID}
+	} else {
+		var err error
+		if tenantIDs, err = s.fileRepo.ListRecycleBinTenantIDs(ctx); err != nil {
+			return err
+		}
+	}
+
+	var purged, failed int
+	for _, tenantID := range tenantIDs {
+		retentionDays := taskData.RetentionDays
+		if retentionDays == 0 {
+			retentionDays = s.recycleBinRetentionDays(ctx, tenantID)
+		}
+		before := time.Now().AddDate(0, 0, -int(retentionDays))
+
+		// 清理失败的文件留在回收站中，按ID跳过以免重复处理
+		skipped := make(map[uint32]struct{})
+		for {
+			files, err := s.fileRepo.ListRecycledBefore(ctx, tenantID, before, fileRecycleBinPurgeBatchSize+len(skipped))
+			if err != nil {
+				return err
+			}
+
+			var progressed bool
+			for _, f := range files {
+				if _, ok := skipped[f.GetId()]; ok {
+					continue
+				}
+				progressed = true
+
+				if err = s.purgeVersions(ctx, f); err != nil {
+					l.Errorf("purge recycled file [%d] of tenant [%d] failed: %s", f.GetId(), tenantID, err.Error())
+					skipped[f.GetId()] = struct{}{}
+					failed++
+					continue
+				}
+				purged++
+			}
+			if !progressed {
+				break
+			}
+		}
+	}
+
+	l.Infof("recycle bin purge finished, purged: %d, failed: %d", purged, failed)
+
+	task.SetResult(ctx, map[string]int{"purged": purged, "failed": failed})
+
+	return nil
+}
+
+// recycleBinRetentionDays 租户回收站的保留天数，租户未设置时使用平台默认值
+func (s *FileService) recycleBinRetentionDays(ctx context.Context, tenantID uint32) uint32 {
+	if tenantID == 0 {
+		return s.retentionDays
+	}
+
+	tenant, err := s.tenantRepo.Get(ctx, &identityV1.GetTenantRequest{QueryBy: &identityV1.GetTenantRequest_Id{Id: tenantID}})
+	if err != nil {
+		s.log.Warnf("get tenant [%d] failed, using default recycle bin retention: %s", tenantID, err.Error())
+		return s.retentionDays
+	}
+	if tenant.GetRecycleBinRetentionDays() > 0 {
+		return tenant.GetRecycleBinRetentionDays()
+	}
+
+	return s.retentionDays
+}
+
+// getFile 查询文件记录
+func (s *FileService) getFile(ctx context.Context, id uint32) (*storageV1.File, error) {
 	f, err := s.fileRepo.Get(ctx, &storageV1.GetFileRequest{
-		QueryBy: &storageV1.GetFileRequest_Id{Id: req.GetId()},
+		QueryBy: &storageV1.GetFileRequest_Id{Id: id},
 	})
+	if err != nil {
+		return nil, storageV1.ErrorFileNotFound("file not found")
+	}
+	return f, nil
+}
+
+// getVersion 查询与文件同一版本组的指定版本
+func (s *FileService) getVersion(ctx context.Context, id, version uint32) (*storageV1.File, error) {
+	f, err := s.getFile(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if f.GetVersion() == version {
+		return f, nil
+	}
+
+	versions, err := s.fileRepo.ListVersions(ctx, f)
 	if err != nil {
 		return nil, err
 	}
+	for _, v := range versions {
+		if v.GetVersion() == version {
+			return v, nil
+		}
+	}
 
-	if err = s.fileRepo.Delete(ctx, req); err != nil {
+	return nil, storageV1.ErrorFileNotFound("file version [%d] not found", version)
+}
+
+// getRecycledFile 查询回收站中的文件
+func (s *FileService) getRecycledFile(ctx context.Context, id uint32) (*storageV1.File, error) {
+	f, err := s.getFile(ctx, id)
+	if err != nil {
 		return nil, err
 	}
+	if f.DeletedAt == nil {
+		return nil, storageV1.ErrorFileNotFound("file is not in the recycle bin")
+	}
+	return f, nil
+}
 
-	if err = s.mc.DeleteFile(ctx,
+// purgeVersions 永久删除文件的全部版本。当前版本最后删除：中途失败时当前版本仍留在回收站中，
+// 清理任务按当前版本查找回收站文件，下次执行时继续删除剩余的版本
+func (s *FileService) purgeVersions(ctx context.Context, f *storageV1.File) error {
+	versions, err := s.fileRepo.ListVersions(ctx, f)
+	if err != nil {
+		return err
+	}
+	slices.SortStableFunc(versions, func(a, b *storageV1.File) int {
+		switch {
+		case a.GetIsLatest() == b.GetIsLatest():
+			return 0
+		case a.GetIsLatest():
+			return 1
+		default:
+			return -1
+		}
+	})
+
+	for _, version := range versions {
+		if err = s.purgeFile(ctx, version); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// purgeFile 永久删除一个文件版本：删除文件记录，释放存储用量，最后一个引用删除时删除物理对象
+func (s *FileService) purgeFile(ctx context.Context, f *storageV1.File) error {
+	storage, err := s.storages.ForFile(ctx, f, f.GetTenantId())
+	if err != nil {
+		return err
+	}
+
+	if err = s.fileRepo.Delete(ctx, &storageV1.DeleteFileRequest{
+		QueryBy: &storageV1.DeleteFileRequest_Id{Id: f.GetId()},
+	}); err != nil {
+		return err
+	}
+
+	s.quotas.RecordUsage(ctx, f.GetTenantId(), f.GetCreatedBy(), -int64(f.GetSize()), -1)
+
+	// 去重的文件共用物理对象，最后一个引用删除时才删除物理对象
+	if f.StorageObjectId != nil {
+		var obj *data.StoredObject
+		if obj, err = s.storageObjects.Release(ctx, f.GetStorageObjectId()); err != nil {
+			return err
+		}
+		if obj == nil {
+			return nil
+		}
+	} else {
+		// 早期数据中多个版本可能指向同一对象，仍有文件记录引用时保留物理对象
+		var inUse bool
+		if inUse, err = s.fileRepo.ObjectNameInUse(ctx, f.GetBucketName(), fileObjectName(f)); err != nil {
+			return err
+		}
+		if inUse {
+			s.log.Warnf("object [%s/%s] of purged file [%d] is still referenced, keeping it", f.GetBucketName(), fileObjectName(f), f.GetId())
+			return nil
+		}
+	}
+
+	if err = storage.DeleteFile(ctx,
 		f.GetBucketName(),
-		f.GetFileDirectory()+"/"+f.GetSaveFileName(),
+		fileObjectName(f),
 	); err != nil {
-		return nil, err
+		return err
 	}
 
-	return &emptypb.Empty{}, nil
+	s.images.DeleteDerivatives(ctx, storage, f.GetBucketName(), fileObjectName(f))
+
+	return nil
 }
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tx7do/go-utils/trans"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data"
+	"go-wind-admin/app/admin/service/internal/data/datatest"
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/file"
+
+	storageV1 "go-wind-admin/api/gen/go/storage/service/v1"
+
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+	"go-wind-admin/pkg/oss"
+	"go-wind-admin/pkg/task"
+)
+
+// newTestFileService 创建使用内存 SQLite 数据库和临时目录本地存储的文件服务
+func newTestFileService(t *testing.T) (*FileService, *entCrud.EntClient[*ent.Client], oss.ObjectStorage) {
+	t.Helper()
+
+	t.Setenv(data.LocalStorageRootEnv, t.TempDir())
+	t.Setenv(data.LocalStorageSecretEnv, "secret")
+	t.Setenv(data.StorageProviderEnv, "")
+
+	ctx := datatest.NewBootstrapContext()
+	cli := datatest.NewEntClient(t)
+
+	tenantRepo := data.NewTenantRepo(ctx, cli)
+	fileRepo := data.NewFileRepo(ctx, cli)
+
+	storages, err := data.NewObjectStorageRouter(ctx, nil, tenantRepo)
+	require.NoError(t, err)
+
+	s := NewFileService(ctx,
+		fileRepo,
+		tenantRepo,
+		storages,
+		data.NewStorageObjectRepo(ctx, cli),
+		&StorageQuotaService{
+			log:        ctx.NewLoggerHelper("storage-quota/service/test"),
+			quotaRepo:  data.NewStorageQuotaRepo(ctx, cli),
+			tenantRepo: tenantRepo,
+		},
+		NewImageDerivativeService(ctx),
+		nil,
+	)
+
+	return s, cli, storages.Local()
+}
+
+// uploadTestVersion 写入对象并登记为文件的一个版本
+func uploadTestVersion(t *testing.T, ctx context.Context, fileRepo *data.FileRepo, storage oss.ObjectStorage, objectName string) *storageV1.File {
+	t.Helper()
+
+	if _, err := storage.StatObject(ctx, "docs", objectName); err != nil {
+		_, _, _, err = oss.UploadFile(ctx, storage, "docs", objectName, "application/pdf", []byte(objectName))
+		require.NoError(t, err)
+	}
+
+	f, err := fileRepo.Create(ctx, &storageV1.CreateFileRequest{
+		Data: &storageV1.File{
+			TenantId:      trans.Ptr(uint32(0)),
+			Provider:      trans.Ptr(storageV1.OSSProvider_LOCAL),
+			BucketName:    trans.Ptr("docs"),
+			FileDirectory: trans.Ptr("reports"),
+			FileName:      trans.Ptr("a.pdf"),
+			ObjectName:    trans.Ptr(objectName),
+			Size:          trans.Ptr(uint64(len(objectName))),
+		},
+	})
+	require.NoError(t, err)
+	return f
+}
+
+func TestFileService_PurgeVersion(t *testing.T) {
+	ctx := appViewer.NewSystemViewerContext(context.Background())
+	s, _, storage := newTestFileService(t)
+	fileRepo := s.fileRepo
+
+	v1 := uploadTestVersion(t, ctx, fileRepo, storage, "reports/1.pdf")
+	v2 := uploadTestVersion(t, ctx, fileRepo, storage, "reports/2.pdf")
+
+	_, err := s.PurgeVersion(ctx, &storageV1.FileVersionRequest{Id: v2.GetId(), Version: v2.GetVersion()})
+	assert.Error(t, err, "the current version cannot be purged")
+
+	_, err = s.PurgeVersion(ctx, &storageV1.FileVersionRequest{Id: v2.GetId(), Version: v1.GetVersion()})
+	require.NoError(t, err)
+
+	_, err = storage.StatObject(ctx, "docs", "reports/1.pdf")
+	assert.Error(t, err)
+	_, err = storage.StatObject(ctx, "docs", "reports/2.pdf")
+	assert.NoError(t, err)
+
+	versions, err := s.ListVersions(ctx, &storageV1.ListFileVersionsRequest{Id: v2.GetId()})
+	require.NoError(t, err)
+	require.Len(t, versions.GetItems(), 1)
+	assert.Equal(t, v2.GetId(), versions.GetItems()[0].GetId())
+}
+
+func TestFileService_PurgeVersionSharedObject(t *testing.T) {
+	ctx := appViewer.NewSystemViewerContext(context.Background())
+	s, _, storage := newTestFileService(t)
+	fileRepo := s.fileRepo
+
+	// 早期数据中两个版本指向同一对象，删除旧版本不能删除当前版本的内容
+	v1 := uploadTestVersion(t, ctx, fileRepo, storage, "reports/a.pdf")
+	v2 := uploadTestVersion(t, ctx, fileRepo, storage, "reports/a.pdf")
+
+	_, err := s.PurgeVersion(ctx, &storageV1.FileVersionRequest{Id: v2.GetId(), Version: v1.GetVersion()})
+	require.NoError(t, err)
+
+	_, err = storage.StatObject(ctx, "docs", "reports/a.pdf")
+	assert.NoError(t, err)
+}
+
+func TestFileService_AsyncPurgeRecycleBin(t *testing.T) {
+	ctx := appViewer.NewSystemViewerContext(context.Background())
+	s, cli, storage := newTestFileService(t)
+	fileRepo := s.fileRepo
+
+	v1 := uploadTestVersion(t, ctx, fileRepo, storage, "reports/1.pdf")
+	v2 := uploadTestVersion(t, ctx, fileRepo, storage, "reports/2.pdf")
+	require.NoError(t, fileRepo.MoveToRecycleBin(ctx, v2, 1))
+
+	// 回收站中的文件不能通过普通查询访问
+	_, err := s.Get(ctx, &storageV1.GetFileRequest{QueryBy: &storageV1.GetFileRequest_Id{Id: v2.GetId()}})
+	assert.Error(t, err)
+
+	kept := uploadTestVersion(t, ctx, fileRepo, storage, "reports/3.pdf")
+	require.NoError(t, fileRepo.MoveToRecycleBin(ctx, kept, 1))
+
+	// 只有超过保留期的文件被清理
+	expired := time.Now().AddDate(0, 0, -int(defaultFileRecycleBinRetentionDays)-1)
+	cli.Client().File.Update().
+		Where(file.IDIn(v1.GetId(), v2.GetId())).
+		SetDeletedAt(expired).
+		ExecX(ctx)
+
+	require.NoError(t, s.AsyncPurgeRecycleBin(ctx, "test", &task.FileRecycleBinPurgeTaskData{}))
+
+	for _, id := range []uint32{v1.GetId(), v2.GetId()} {
+		_, err = fileRepo.Get(ctx, &storageV1.GetFileRequest{QueryBy: &storageV1.GetFileRequest_Id{Id: id}})
+		assert.Error(t, err)
+	}
+	_, err = storage.StatObject(ctx, "docs", "reports/1.pdf")
+	assert.Error(t, err)
+	_, err = storage.StatObject(ctx, "docs", "reports/2.pdf")
+	assert.Error(t, err)
+
+	recycled, err := fileRepo.Get(ctx, &storageV1.GetFileRequest{QueryBy: &storageV1.GetFileRequest_Id{Id: kept.GetId()}})
+	require.NoError(t, err)
+	assert.NotNil(t, recycled.DeletedAt)
+	_, err = storage.StatObject(ctx, "docs", "reports/3.pdf")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tx7do/go-utils/trans"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	storageV1 "go-wind-admin/api/gen/go/storage/service/v1"
+)
+
+func TestCheckShareLinkAvailable(t *testing.T) {
+	now := time.Now()
+
+	assert.NoError(t, checkShareLinkAvailable(&storageV1.FileShareLink{}, now, false))
+
+	assert.NoError(t, checkShareLinkAvailable(&storageV1.FileShareLink{
+		ExpiresAt: timestamppb.New(now.Add(time.Hour)),
+	}, now, false))
+
+	err := checkShareLinkAvailable(&storageV1.FileShareLink{
+		ExpiresAt: timestamppb.New(now.Add(-time.Second)),
+	}, now, false)
+	assert.True(t, storageV1.IsShareLinkExpired(err))
+
+	err = checkShareLinkAvailable(&storageV1.FileShareLink{
+		RevokedAt: timestamppb.New(now),
+	}, now, true)
+	assert.True(t, storageV1.IsShareLinkExpired(err))
+
+	exhausted := &storageV1.FileShareLink{
+		MaxDownloads:  trans.Ptr(uint32(2)),
+		DownloadCount: trans.Ptr(uint32(2)),
+	}
+	assert.True(t, storageV1.IsShareLinkExpired(checkShareLinkAvailable(exhausted, now, false)))
+	// 继续已计入的最后一次下载仍然允许
+	assert.NoError(t, checkShareLinkAvailable(exhausted, now, true))
+
+	// 下载次数超过上限（如上限被调低）时继续下载也被拒绝
+	assert.True(t, storageV1.IsShareLinkExpired(checkShareLinkAvailable(&storageV1.FileShareLink{
+		MaxDownloads:  trans.Ptr(uint32(1)),
+		DownloadCount: trans.Ptr(uint32(2)),
+	}, now, true)))
+
+	assert.NoError(t, checkShareLinkAvailable(&storageV1.FileShareLink{
+		MaxDownloads:  trans.Ptr(uint32(2)),
+		DownloadCount: trans.Ptr(uint32(1)),
+	}, now, false))
+}
+
+func TestParseShareRange(t *testing.T) {
+	for _, tt := range []struct {
+		header string
+		want   data.ByteRange
+		ok     bool
+	}{
+		{header: "", ok: false},
+		{header: "bytes=0-", want: data.ByteRange{Start: 0, End: 999}, ok: true},
+		{header: "bytes=1-", want: data.ByteRange{Start: 1, End: 999}, ok: true},
+		{header: "bytes=100-199", want: data.ByteRange{Start: 100, End: 199}, ok: true},
+		{header: "bytes=900-5000", want: data.ByteRange{Start: 900, End: 999}, ok: true},
+		{header: "bytes=-100", want: data.ByteRange{Start: 900, End: 999}, ok: true},
+		{header: "bytes=-5000", want: data.ByteRange{Start: 0, End: 999}, ok: true},
+		{header: "bytes=0-9,20-29", ok: false},
+		{header: "bytes=1000-", ok: false},
+		{header: "bytes=20-10", ok: false},
+		{header: "items=0-9", ok: false},
+	} {
+		got, ok := parseShareRange(tt.header, 1000)
+		assert.Equal(t, tt.ok, ok, tt.header)
+		if tt.ok {
+			assert.Equal(t, tt.want, got, tt.header)
+		}
+	}
+}
+
+func TestMatchShareAudience(t *testing.T) {
+	assert.False(t, shareAudienceRestricted(&storageV1.FileShareLink{}))
+
+	link := &storageV1.FileShareLink{
+		AllowedTenantIds:  []uint32{2},
+		AllowedUserIds:    []uint32{10},
+		AllowedOrgUnitIds: []uint32{100, 101},
+	}
+	assert.True(t, shareAudienceRestricted(link))
+
+	assert.True(t, matchShareAudience(link, 2, 99, nil))
+	assert.True(t, matchShareAudience(link, 3, 10, nil))
+	assert.True(t, matchShareAudience(link, 3, 99, []uint32{5, 101}))
+	assert.False(t, matchShareAudience(link, 3, 99, []uint32{5}))
+	assert.False(t, matchShareAudience(link, 0, 0, nil))
+}
+
+func TestShareAccessCounted(t *testing.T) {
+	assert.True(t, shareAccessCounted(&FileShareAccessRequest{}, false))
+	// 没有继续下载会话的分段请求按新的下载计数
+	assert.True(t, shareAccessCounted(&FileShareAccessRequest{Range: "bytes=100-"}, false))
+	assert.False(t, shareAccessCounted(&FileShareAccessRequest{Range: "bytes=100-"}, true))
+
+	assert.False(t, shareAccessCounted(&FileShareAccessRequest{Head: true}, false))
+
+	// 预签名地址可以下载完整文件，重定向总是计数
+	assert.True(t, shareAccessCounted(&FileShareAccessRequest{Redirect: true}, false))
+	assert.True(t, shareAccessCounted(&FileShareAccessRequest{Redirect: true, Range: "bytes=100-"}, true))
+}
@@ -4,14 +4,16 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"fmt"
 	"go-wind-admin/app/admin/service/internal/data"
 	"io"
+	"mime"
 	"net/http"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
-	"github.com/minio/minio-go/v7"
 
 	"github.com/tx7do/go-utils/id"
 	"github.com/tx7do/go-utils/trans"
@@ -22,27 +24,87 @@ import (
 
 	"go-wind-admin/pkg/middleware/auth"
 	"go-wind-admin/pkg/oss"
+	"go-wind-admin/pkg/tus"
 )
 
+// fileDownloadRouteFormat 文件下载接口路由
+const fileDownloadRouteFormat = "/admin/v1/file/download?fileId=%d"
+
 type FileTransferService struct {
 	adminV1.FileTransferServiceHTTPServer
 
 	log *log.Helper
 
-	mc                *oss.MinIOClient
+	storages          *data.ObjectStorageRouter
+	storageObjects    *data.StorageObjectRepo
 	fileServiceClient *data.FileRepo
+
+	luaHooks *data.LuaHookRunner
+
+	tusUploader *tus.Uploader
+
+	presignedUploads *data.PresignedUploadStore
+
+	quotas *StorageQuotaService
+	images *ImageDerivativeService
+	scans  *FileScanService
 }
 
 func NewFileTransferService(
 	ctx *bootstrap.Context,
-	mc *oss.MinIOClient,
+	storages *data.ObjectStorageRouter,
+	storageObjects *data.StorageObjectRepo,
 	fileServiceClient *data.FileRepo,
+	luaHooks *data.LuaHookRunner,
+	tusUploader *tus.Uploader,
+	presignedUploads *data.PresignedUploadStore,
+	quotas *StorageQuotaService,
+	images *ImageDerivativeService,
+	scans *FileScanService,
 ) *FileTransferService {
-	return &FileTransferService{
+	svc := &FileTransferService{
 		log:               ctx.NewLoggerHelper("file-transfer/service/app-service"),
-		mc:                mc,
+		storages:          storages,
+		storageObjects:    storageObjects,
 		fileServiceClient: fileServiceClient,
+		luaHooks:          luaHooks,
+		tusUploader:       tusUploader,
+		presignedUploads:  presignedUploads,
+		quotas:            quotas,
+		images:            images,
+		scans:             scans,
 	}
+
+	tusUploader.OnComplete(svc.completeResumableUpload)
+
+	return svc
+}
+
+// beforeUpload 上传前钩子，脚本可修改存储位置或拒绝上传
+func (s *FileTransferService) beforeUpload(ctx context.Context, req *storageV1.UploadFileRequest, contentType, method string, size int64) error {
+	if err := s.luaHooks.Before(ctx, &data.LuaHookEvent{
+		Hook:    data.LuaHookBeforeFileUpload,
+		Payload: req.StorageObject,
+		Fields: map[string]any{
+			"mime":             contentType,
+			"source_file_name": req.GetSourceFileName(),
+			"size":             size,
+			"method":           method,
+		},
+	}); err != nil {
+		return err
+	}
+
+	if req.GetStorageObject().GetBucketName() == "" || req.GetStorageObject().GetObjectName() == "" {
+		return storageV1.ErrorUploadFailed("invalid storage object")
+	}
+
+	// 备份、审计归档、隔离区等存储桶仅供系统内部使用
+	if !oss.IsUserBucket(req.GetStorageObject().GetBucketName()) {
+		return storageV1.ErrorForbidden("bucket [%s] does not accept uploads", req.GetStorageObject().GetBucketName())
+	}
+
+	return nil
 }
 
 func parseKey(key string) (folder, filename, ext string) {
@@ -85,42 +147,185 @@ func parseKey(key string) (folder, filename, ext string) {
 	return dir, name, ext
 }
 
-// recordFile 记录文件元数据到数据库
+// storedLocation 文件记录指向的物理对象
+type storedLocation struct {
+	objectID   *uint32
+	bucketName string
+	objectName string
+}
+
+// dedupObject 按租户的去重策略保存对象。去重范围内已有相同内容的物理对象时增加其引用并复用：
+// write 不为空时跳过写入，为空（对象已经写入，如断点续传）时删除刚写入的重复对象；
+// 否则写入对象并登记为新的物理对象。租户关闭去重时只写入对象，不做引用计数。
+func (s *FileTransferService) dedupObject(
+	ctx context.Context,
+	storage oss.ObjectStorage,
+	tenantID uint32,
+	contentHash string,
+	bucketName, objectName, contentType string,
+	size int64,
+	write func() error,
+) (storedLocation, error) {
+	loc := storedLocation{bucketName: bucketName, objectName: objectName}
+
+	scope, err := s.storages.DedupScope(ctx, tenantID)
+	if err != nil {
+		return loc, err
+	}
+	if scope == "" || contentHash == "" {
+		if write != nil {
+			err = write()
+		}
+		return loc, err
+	}
+
+	existing, err := s.storageObjects.Acquire(ctx, scope, storage.Provider(), contentHash)
+	if err != nil {
+		return loc, err
+	}
+	if existing == nil {
+		if write != nil {
+			if err = write(); err != nil {
+				return loc, err
+			}
+		}
+
+		var created bool
+		existing, created, err = s.storageObjects.Register(ctx, &data.StoredObject{
+			DedupScope:  scope,
+			Provider:    storage.Provider(),
+			ContentHash: contentHash,
+			BucketName:  bucketName,
+			ObjectName:  objectName,
+			Size:        uint64(size),
+			ContentType: contentType,
+		})
+		if err != nil {
+			if write != nil {
+				s.removeObject(ctx, storage, bucketName, objectName)
+			}
+			return loc, err
+		}
+		if created {
+			loc.objectID = trans.Ptr(existing.ID)
+			return loc, nil
+		}
+
+		// 并发上传了相同内容，使用先登记的对象
+		s.removeObject(ctx, storage, bucketName, objectName)
+	} else if write == nil {
+		s.removeObject(ctx, storage, bucketName, objectName)
+	}
+
+	s.log.Debugf("deduplicated [%s/%s] to storage object [%d]", bucketName, objectName, existing.ID)
+
+	return storedLocation{
+		objectID:   trans.Ptr(existing.ID),
+		bucketName: existing.BucketName,
+		objectName: existing.ObjectName,
+	}, nil
+}
+
+// uniqueObjectName 返回实际写入的对象名。请求的对象名已被文件记录（包括历史版本和回收站中的文件）、
+// 物理对象或存储中已有的对象占用时，在同一目录下生成新的对象名，避免覆盖其他版本的内容
+func (s *FileTransferService) uniqueObjectName(
+	ctx context.Context,
+	storage oss.ObjectStorage,
+	bucketName, objectName, sourceFileName, contentType string,
+) (string, error) {
+	inUse, err := s.fileServiceClient.ObjectNameInUse(ctx, bucketName, objectName)
+	if err != nil {
+		return "", err
+	}
+	if !inUse {
+		if inUse, err = s.storageObjects.LocationInUse(ctx, storage.Provider(), bucketName, objectName); err != nil {
+			return "", err
+		}
+	}
+	if !inUse {
+		if _, statErr := storage.StatObject(ctx, bucketName, objectName); statErr == nil {
+			inUse = true
+		}
+	}
+	if !inUse {
+		return objectName, nil
+	}
+
+	dir, _, _ := parseKey(objectName)
+	renamed := oss.EnsureObjectName(dir, sourceFileName, contentType, nil, oss.GenerateFileNameTypeUUID)
+	s.log.Debugf("object [%s/%s] is in use, writing to [%s] instead", bucketName, objectName, renamed)
+
+	return renamed, nil
+}
+
+// discardObject 文件记录登记失败时撤销 dedupObject 的结果：释放物理对象的引用，
+// 最后一个引用释放时（本次上传登记的新对象）删除物理对象；未去重时删除本次上传写入的对象
+func (s *FileTransferService) discardObject(ctx context.Context, storage oss.ObjectStorage, loc storedLocation) {
+	if loc.objectID == nil {
+		s.removeObject(ctx, storage, loc.bucketName, loc.objectName)
+		return
+	}
+
+	obj, err := s.storageObjects.Release(ctx, *loc.objectID)
+	if err != nil {
+		s.log.Warnf("release storage object [%d] failed: %v", *loc.objectID, err)
+		return
+	}
+	if obj != nil {
+		s.removeObject(ctx, storage, obj.BucketName, obj.ObjectName)
+	}
+}
+
+// removeObject 删除去重后多余或登记失败的对象，失败时只记录日志，由对账任务清理
+func (s *FileTransferService) removeObject(ctx context.Context, storage oss.ObjectStorage, bucketName, objectName string) {
+	if err := storage.DeleteFile(ctx, bucketName, objectName); err != nil {
+		s.log.Warnf("remove object [%s/%s] failed: %v", bucketName, objectName, err)
+	}
+}
+
+// recordFile 记录文件元数据到数据库，启用扫描时新文件在扫描通过前不能下载。
+// 文件目录和文件名取自请求写入的 objectKey，去重后的物理位置只用于存储桶、对象名和物理对象ID。
 func (s *FileTransferService) recordFile(
 	ctx context.Context,
+	provider storageV1.OSSProvider,
 	tenantID, userID uint32,
-	fileData []byte,
+	contentHash string,
 	sourceFileName string,
-	info minio.UploadInfo,
+	objectKey string,
+	loc storedLocation,
+	size int64,
 	downloadUrl string,
-) error {
-
-	sum := sha256.Sum256(fileData)          // sha256.Sum256 返回 [32]byte
-	sha256Hex := hex.EncodeToString(sum[:]) // 转为十六进制字符串
-
-	dir, fileName, ext := parseKey(info.Key)
+) (*storageV1.File, error) {
+	dir, fileName, ext := parseKey(objectKey)
 	//s.log.Debugf("Parsed file - Dir: %s, FileName: %s, Ext: %s", dir, fileName, ext)
 
-	if err := s.fileServiceClient.Create(ctx, &storageV1.CreateFileRequest{
+	f, err := s.fileServiceClient.Create(ctx, &storageV1.CreateFileRequest{
 		Data: &storageV1.File{
-			Provider:      trans.Ptr(storageV1.OSSProvider_MINIO),
-			BucketName:    trans.Ptr(info.Bucket),
-			SaveFileName:  trans.Ptr(fileName + "." + ext),
-			ContentHash:   trans.Ptr(sha256Hex),
-			FileDirectory: trans.Ptr(dir),
-			FileName:      trans.Ptr(sourceFileName),
-			Extension:     trans.Ptr(ext),
-			FileGuid:      trans.Ptr(id.NewGUIDv7(false)),
-			Size:          trans.Ptr(uint64(info.Size)),
-			LinkUrl:       trans.Ptr(downloadUrl),
-			CreatedBy:     trans.Ptr(userID),
-			TenantId:      trans.Ptr(tenantID),
+			Provider:        trans.Ptr(provider),
+			BucketName:      trans.Ptr(loc.bucketName),
+			ObjectName:      trans.Ptr(loc.objectName),
+			SaveFileName:    trans.Ptr(fileName + "." + ext),
+			ContentHash:     trans.Ptr(contentHash),
+			StorageObjectId: loc.objectID,
+			FileDirectory:   trans.Ptr(dir),
+			FileName:        trans.Ptr(sourceFileName),
+			Extension:       trans.Ptr(ext),
+			FileGuid:        trans.Ptr(id.NewGUIDv7(false)),
+			Size:            trans.Ptr(uint64(size)),
+			LinkUrl:         trans.Ptr(downloadUrl),
+			ScanStatus:      s.scans.InitialStatus(),
+			CreatedBy:       trans.Ptr(userID),
+			TenantId:        trans.Ptr(tenantID),
 		},
-	}); err != nil {
+	})
+	if err != nil {
 		s.log.Errorf("Failed to create file record: %v", err)
-		return err
+		return nil, err
 	}
-	return nil
+
+	s.quotas.RecordUsage(ctx, tenantID, userID, size, 1)
+
+	return f, nil
 }
 
 // directUploadFile 直接上传文件
@@ -163,28 +368,93 @@ func (s *FileTransferService) directUploadFile(ctx context.Context, req *storage
 		)
 	}
 
-	info, _, downloadUrl, err := s.mc.UploadFile(
-		ctx,
+	if err = s.beforeUpload(ctx, req, req.GetMime(), "direct", int64(len(req.GetFile()))); err != nil {
+		return nil, err
+	}
+
+	if err = s.quotas.CheckQuota(ctx, operator.GetTenantId(), operator.GetUserId(), int64(len(req.GetFile()))); err != nil {
+		return nil, err
+	}
+
+	storage, err := s.storages.ForTenant(ctx, operator.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+
+	// 文件记录按请求的对象名登记，物理对象写入不与其他文件冲突的位置
+	objectName, err := s.uniqueObjectName(ctx, storage,
 		req.GetStorageObject().GetBucketName(),
 		req.GetStorageObject().GetObjectName(),
+		req.GetSourceFileName(),
 		req.GetMime(),
-		req.GetFile(),
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	if err = s.recordFile(
+	sum := sha256.Sum256(req.GetFile())     // sha256.Sum256 返回 [32]byte
+	sha256Hex := hex.EncodeToString(sum[:]) // 转为十六进制字符串
+
+	size := int64(len(req.GetFile()))
+
+	loc, err := s.dedupObject(
 		ctx,
+		storage,
+		operator.GetTenantId(),
+		sha256Hex,
+		req.GetStorageObject().GetBucketName(),
+		objectName,
+		req.GetMime(),
+		size,
+		func() error {
+			_, _, _, err := oss.UploadFile(
+				ctx,
+				storage,
+				req.GetStorageObject().GetBucketName(),
+				objectName,
+				req.GetMime(),
+				req.GetFile(),
+			)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	downloadUrl := storage.GetObjectDownloadUrl(loc.bucketName, loc.objectName)
+
+	f, err := s.recordFile(
+		ctx,
+		storage.Provider(),
 		operator.GetTenantId(), operator.GetUserId(),
-		req.GetFile(),
+		sha256Hex,
 		req.GetSourceFileName(),
-		info, downloadUrl); err != nil {
+		req.GetStorageObject().GetObjectName(),
+		loc, size,
+		downloadUrl)
+	if err != nil {
+		s.discardObject(ctx, storage, loc)
+		return nil, err
 	}
 
+	if IsImage(req.GetMime()) {
+		s.images.GenerateThumbnails(ctx, storage, loc.bucketName, loc.objectName, req.GetFile())
+	}
+
+	s.scans.Submit(ctx, f)
+
+	// 不返回对象的直接地址，下载必须经过文件下载接口的扫描状态检查
 	return &storageV1.UploadFileResponse{
-		ObjectName: trans.Ptr(downloadUrl),
-	}, err
+		ObjectName:  trans.Ptr(req.GetStorageObject().GetObjectName()),
+		FileId:      trans.Ptr(f.GetId()),
+		DownloadUrl: trans.Ptr(fileDownloadRoute(f.GetId())),
+	}, nil
+}
+
+// fileDownloadRoute 文件的下载路由，经过该接口下载时检查扫描状态
+func fileDownloadRoute(fileID uint32) string {
+	return fmt.Sprintf(fileDownloadRouteFormat, fileID)
 }
 
 // presignedUploadFile 预签名上传文件
@@ -210,6 +480,11 @@ func (s *FileTransferService) presignedUploadFile(ctx context.Context, req *stor
 		return nil, storageV1.ErrorUploadFailed("unknown source file name")
 	}
 
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	if req.StorageObject.BucketName == nil {
 		req.StorageObject.BucketName = trans.Ptr(oss.ContentTypeToBucketName(contentType))
 	}
@@ -225,6 +500,19 @@ func (s *FileTransferService) presignedUploadFile(ctx context.Context, req *stor
 		)
 	}
 
+	if err = s.beforeUpload(ctx, req, contentType, "presign", req.GetSize()); err != nil {
+		return nil, err
+	}
+
+	if err = s.quotas.CheckQuota(ctx, operator.GetTenantId(), operator.GetUserId(), req.GetSize()); err != nil {
+		return nil, err
+	}
+
+	storage, err := s.storages.ForTenant(ctx, operator.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+
 	var method storageV1.GetUploadPresignedUrlRequest_Method
 	switch strings.ToLower(req.GetPresign().GetMethod()) {
 	case "put":
@@ -235,8 +523,9 @@ func (s *FileTransferService) presignedUploadFile(ctx context.Context, req *stor
 		method = storageV1.GetUploadPresignedUrlRequest_Post
 	}
 
-	resp, err := s.mc.GetUploadPresignedUrl(
+	resp, err := oss.GetUploadPresignedUrl(
 		ctx,
+		storage,
 		&storageV1.GetUploadPresignedUrlRequest{
 			ContentType:   trans.Ptr(contentType),
 			ExpireSeconds: req.GetPresign().ExpireSeconds,
@@ -248,13 +537,148 @@ func (s *FileTransferService) presignedUploadFile(ctx context.Context, req *stor
 		return nil, err
 	}
 
-	// TODO : 记录文件元数据到数据库（待上传完成后再记录更合适？）
+	expiry := oss.DefaultExpiryTime
+	if req.GetPresign().ExpireSeconds != nil {
+		expiry = time.Duration(req.GetPresign().GetExpireSeconds()) * time.Second
+	}
+
+	// 客户端上传完成后调用 CompletePresignedUpload 登记文件记录并提交扫描
+	if err = s.presignedUploads.Save(ctx, &data.PresignedUpload{
+		TenantID:       operator.GetTenantId(),
+		UserID:         operator.GetUserId(),
+		Storage:        storage.Provider().String(),
+		Bucket:         resp.GetBucketName(),
+		Object:         resp.GetObjectName(),
+		ContentType:    contentType,
+		SourceFileName: req.GetSourceFileName(),
+		Size:           req.GetSize(),
+	}, expiry); err != nil {
+		return nil, err
+	}
 
 	return &storageV1.UploadFileResponse{
 		PresignedUrl: trans.Ptr(resp.UploadUrl),
+		ObjectName:   trans.Ptr(resp.GetObjectName()),
+		BucketName:   resp.BucketName,
+		FormData:     resp.GetFormData(),
 	}, nil
 }
 
+// CompletePresignedUpload 预签名上传完成后按实际写入的对象登记文件记录，
+// 计算内容哈希去重、统计用量并提交扫描，每个预签名上传只能登记一次
+func (s *FileTransferService) CompletePresignedUpload(ctx context.Context, req *storageV1.CompletePresignedUploadRequest) (*storageV1.File, error) {
+	if req.GetBucketName() == "" || req.GetObjectName() == "" {
+		return nil, storageV1.ErrorBadRequest("invalid storage object")
+	}
+
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	upload, err := s.presignedUploads.Take(ctx, req.GetBucketName(), req.GetObjectName())
+	if err != nil {
+		return nil, err
+	}
+	if upload == nil {
+		return nil, storageV1.ErrorNotFound("presigned upload not found or expired")
+	}
+	if upload.TenantID != operator.GetTenantId() || upload.UserID != operator.GetUserId() {
+		s.presignedUploads.Restore(ctx, upload)
+		return nil, storageV1.ErrorNotFound("presigned upload not found or expired")
+	}
+
+	storage, err := s.storages.ProviderByName(upload.Storage)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := storage.StatObject(ctx, upload.Bucket, upload.Object)
+	if err != nil {
+		// 对象尚未写入，允许客户端上传完成后再次调用
+		s.presignedUploads.Restore(ctx, upload)
+		return nil, err
+	}
+
+	return s.completePresignedUpload(ctx, storage, upload, info)
+}
+
+func (s *FileTransferService) completePresignedUpload(
+	ctx context.Context,
+	storage oss.ObjectStorage,
+	upload *data.PresignedUpload,
+	info oss.ObjectInfo,
+) (*storageV1.File, error) {
+	// 签发时按客户端声明的大小检查了配额，这里按实际大小再次检查
+	if info.Size > upload.Size {
+		if err := s.quotas.CheckQuota(ctx, upload.TenantID, upload.UserID, info.Size); err != nil {
+			s.removeObject(ctx, storage, upload.Bucket, upload.Object)
+			return nil, err
+		}
+	}
+
+	contentHash, err := s.hashObject(ctx, storage, upload.Bucket, upload.Object)
+	if err != nil {
+		return nil, err
+	}
+
+	contentType := info.ContentType
+	if contentType == "" {
+		contentType = upload.ContentType
+	}
+
+	loc, err := s.dedupObject(
+		ctx,
+		storage,
+		upload.TenantID,
+		contentHash,
+		upload.Bucket, upload.Object, contentType,
+		info.Size,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := s.recordFile(
+		ctx,
+		storage.Provider(),
+		upload.TenantID, upload.UserID,
+		contentHash,
+		upload.SourceFileName,
+		upload.Object,
+		loc, info.Size,
+		storage.GetObjectDownloadUrl(loc.bucketName, loc.objectName),
+	)
+	if err != nil {
+		s.discardObject(ctx, storage, loc)
+		return nil, err
+	}
+
+	if IsImage(contentType) {
+		s.images.GenerateThumbnails(ctx, storage, loc.bucketName, loc.objectName, nil)
+	}
+
+	s.scans.Submit(ctx, f)
+
+	return f, nil
+}
+
+// hashObject 流式读取对象计算 SHA-256
+func (s *FileTransferService) hashObject(ctx context.Context, storage oss.ObjectStorage, bucketName, objectName string) (string, error) {
+	reader, err := storage.GetObject(ctx, bucketName, objectName)
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+
+	h := sha256.New()
+	if _, err = io.Copy(h, reader); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
 // UploadFile 上传文件
 func (s *FileTransferService) UploadFile(ctx context.Context, req *storageV1.UploadFileRequest) (*storageV1.UploadFileResponse, error) {
 	switch req.Source.(type) {
@@ -269,6 +693,182 @@ func (s *FileTransferService) UploadFile(ctx context.Context, req *storageV1.Upl
 	}
 }
 
+// CreateResumableUpload 创建断点续传上传（tus creation），元数据支持：
+// filename 源文件名（必填）、filetype MIME类型、bucket 存储桶、directory 存储目录
+func (s *FileTransferService) CreateResumableUpload(ctx context.Context, length int64, metadata map[string]string) (*tus.Upload, error) {
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceFileName := metadata["filename"]
+	if sourceFileName == "" {
+		return nil, storageV1.ErrorUploadFailed("unknown source file name")
+	}
+
+	contentType := metadata["filetype"]
+	if contentType == "" {
+		contentType = mime.TypeByExtension(path.Ext(sourceFileName))
+	}
+	if contentType == "" {
+		contentType = oss.DefaultContentType
+	}
+
+	req := &storageV1.UploadFileRequest{
+		SourceFileName: trans.Ptr(sourceFileName),
+		Mime:           trans.Ptr(contentType),
+		Size:           trans.Ptr(length),
+		StorageObject:  &storageV1.StorageObject{},
+	}
+	if bucketName := metadata["bucket"]; bucketName != "" {
+		req.StorageObject.BucketName = trans.Ptr(bucketName)
+	} else {
+		req.StorageObject.BucketName = trans.Ptr(oss.ContentTypeToBucketName(contentType))
+	}
+	if directory := metadata["directory"]; directory != "" {
+		req.StorageObject.FileDirectory = trans.Ptr(directory)
+	}
+	req.StorageObject.ObjectName = trans.Ptr(
+		oss.EnsureObjectName(
+			req.GetStorageObject().GetFileDirectory(),
+			sourceFileName,
+			contentType,
+			nil,
+			oss.GenerateFileNameTypeUUID,
+		),
+	)
+
+	if err = s.beforeUpload(ctx, req, contentType, "resumable", length); err != nil {
+		return nil, err
+	}
+
+	if err = s.quotas.CheckQuota(ctx, operator.GetTenantId(), operator.GetUserId(), length); err != nil {
+		return nil, err
+	}
+
+	storage, err := s.storages.ForTenant(ctx, operator.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+
+	// 上传前钩子可能改写对象名，写入前同样避开已被占用的对象
+	objectName, err := s.uniqueObjectName(ctx, storage,
+		req.GetStorageObject().GetBucketName(),
+		req.GetStorageObject().GetObjectName(),
+		sourceFileName,
+		contentType,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.tusUploader.Create(ctx, &tus.Upload{
+		TenantID:       operator.GetTenantId(),
+		UserID:         operator.GetUserId(),
+		Storage:        storage.Provider().String(),
+		Bucket:         req.GetStorageObject().GetBucketName(),
+		Object:         objectName,
+		ContentType:    contentType,
+		SourceFileName: sourceFileName,
+		Metadata:       metadata,
+		Length:         length,
+	})
+}
+
+// GetResumableUpload 查询断点续传上传状态（tus HEAD），只能访问自己创建的上传
+func (s *FileTransferService) GetResumableUpload(ctx context.Context, uploadID string) (*tus.Upload, error) {
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	upload, err := s.tusUploader.Get(ctx, uploadID)
+	if err != nil {
+		return nil, err
+	}
+
+	if upload.TenantID != operator.GetTenantId() || upload.UserID != operator.GetUserId() {
+		return nil, tus.ErrNotFound
+	}
+
+	return upload, nil
+}
+
+// WriteResumableUpload 追加上传数据（tus PATCH），全部数据接收后写入文件记录
+func (s *FileTransferService) WriteResumableUpload(
+	ctx context.Context,
+	uploadID string,
+	offset, size int64,
+	body io.Reader,
+	checksum *tus.Checksum,
+) (*tus.Upload, error) {
+	if _, err := s.GetResumableUpload(ctx, uploadID); err != nil {
+		return nil, err
+	}
+
+	// 大分片的写入可能超过请求超时，已接收的数据仍需完整写入存储
+	return s.tusUploader.Write(context.WithoutCancel(ctx), uploadID, offset, size, body, checksum)
+}
+
+// TerminateResumableUpload 终止断点续传上传（tus termination）
+func (s *FileTransferService) TerminateResumableUpload(ctx context.Context, uploadID string) error {
+	if _, err := s.GetResumableUpload(ctx, uploadID); err != nil {
+		return err
+	}
+
+	return s.tusUploader.Terminate(ctx, uploadID)
+}
+
+// MaxResumableUploadSize 断点续传允许的最大字节数，0 表示不限制
+func (s *FileTransferService) MaxResumableUploadSize() int64 {
+	return s.tusUploader.MaxSize()
+}
+
+// completeResumableUpload 断点续传完成后记录文件元数据
+func (s *FileTransferService) completeResumableUpload(ctx context.Context, upload *tus.Upload) error {
+	storage, err := s.storages.ProviderByName(upload.Storage)
+	if err != nil {
+		return err
+	}
+
+	// 数据已在上传过程中写入存储并计算了内容哈希，重复时删除刚写入的对象
+	loc, err := s.dedupObject(
+		ctx,
+		storage,
+		upload.TenantID,
+		upload.ContentHash,
+		upload.Bucket, upload.Object, upload.ContentType,
+		upload.Length,
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	f, err := s.recordFile(
+		ctx,
+		storage.Provider(),
+		upload.TenantID, upload.UserID,
+		upload.ContentHash,
+		upload.SourceFileName,
+		upload.Object,
+		loc, upload.Length,
+		storage.GetObjectDownloadUrl(loc.bucketName, loc.objectName),
+	)
+	if err != nil {
+		s.discardObject(ctx, storage, loc)
+		return err
+	}
+
+	if IsImage(upload.ContentType) {
+		s.images.GenerateThumbnails(ctx, storage, loc.bucketName, loc.objectName, nil)
+	}
+
+	s.scans.Submit(ctx, f)
+
+	return nil
+}
+
 // downloadFileFromURL 从指定的 URL 下载文件内容
 func (s *FileTransferService) downloadFileFromURL(ctx context.Context, downloadUrl string) (*storageV1.DownloadFileResponse, error) {
 	if downloadUrl == "" {
@@ -278,24 +878,24 @@ func (s *FileTransferService) downloadFileFromURL(ctx context.Context, downloadU
 	// 如果需要支持断点续传，可在此构造请求并设置 Range 头
 	httpReq, err := http.NewRequestWithContext(ctx, "GET", downloadUrl, nil)
 	if err != nil {
-		return nil, storageV1.ErrorDownloadFailed(err.Error())
+		return nil, storageV1.ErrorDownloadFailed("%s", err.Error())
 	}
 	// 示例：如果你要设置 Range（可选）
 	// httpReq.Header.Set("Range", "bytes=100-")
 
 	resp, err := http.DefaultClient.Do(httpReq)
 	if err != nil {
-		return nil, storageV1.ErrorDownloadFailed(err.Error())
+		return nil, storageV1.ErrorDownloadFailed("%s", err.Error())
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusPartialContent {
-		return nil, storageV1.ErrorDownloadFailed("unexpected status: " + resp.Status)
+		return nil, storageV1.ErrorDownloadFailed("unexpected status: %s", resp.Status)
 	}
 
 	fileData, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, storageV1.ErrorDownloadFailed(err.Error())
+		return nil, storageV1.ErrorDownloadFailed("%s", err.Error())
 	}
 
 	return &storageV1.DownloadFileResponse{
@@ -305,28 +905,148 @@ func (s *FileTransferService) downloadFileFromURL(ctx context.Context, downloadU
 	}, nil
 }
 
-// DownloadFile 下载文件
-func (s *FileTransferService) DownloadFile(ctx context.Context, req *storageV1.DownloadFileRequest) (*storageV1.DownloadFileResponse, error) {
+// FileDownload 流式下载的文件
+type FileDownload struct {
+	Content      io.ReadSeekCloser
+	FileName     string
+	ContentType  string
+	Size         int64
+	ETag         string
+	LastModified time.Time
+}
+
+// fileObjectName 文件记录对应的物理对象名
+func fileObjectName(f *storageV1.File) string {
+	if f.GetObjectName() != "" {
+		return f.GetObjectName()
+	}
+	return path.Join(f.GetFileDirectory(), f.GetSaveFileName())
+}
+
+// resolveDownloadFile 解析下载请求对应的文件记录和存储位置，并校验文件所属租户及扫描状态。
+// 平台用户可以下载任意文件，租户用户只能下载本租户有文件记录的对象；
+// 等待扫描、扫描失败和已隔离的文件不能下载。
+func (s *FileTransferService) resolveDownloadFile(ctx context.Context, req *storageV1.DownloadFileRequest) (*storageV1.File, oss.ObjectStorage, string, string, error) {
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, nil, "", "", err
+	}
+
+	var f *storageV1.File
+	var bucketName, objectName string
+
 	switch req.Selector.(type) {
 	case *storageV1.DownloadFileRequest_FileId:
-		resp, err := s.fileServiceClient.Get(ctx, &storageV1.GetFileRequest{
+		f, err = s.fileServiceClient.Get(ctx, &storageV1.GetFileRequest{
 			QueryBy: &storageV1.GetFileRequest_Id{Id: req.GetFileId()},
 		})
+		if err != nil || f.DeletedAt != nil {
+			return nil, nil, "", "", storageV1.ErrorFileNotFound("file not found")
+		}
+		bucketName = f.GetBucketName()
+		objectName = fileObjectName(f)
+
+	case *storageV1.DownloadFileRequest_StorageObject:
+		bucketName = req.GetStorageObject().GetBucketName()
+		objectName = req.GetStorageObject().GetObjectName()
+		if bucketName == "" || objectName == "" {
+			return nil, nil, "", "", storageV1.ErrorBadRequest("invalid storage object")
+		}
+
+		f, err = s.fileServiceClient.GetByObject(ctx, operator.GetTenantId(), bucketName, objectName)
+		if err != nil {
+			return nil, nil, "", "", err
+		}
+		// 只能下载有文件记录的对象，未扫描或已隔离的对象以及内部存储桶中的对象都不能按键名下载
+		if f == nil {
+			s.log.Warnf("user [%d] of tenant [%d] requested [%s/%s] without a file record",
+				operator.GetUserId(), operator.GetTenantId(), bucketName, objectName)
+			return nil, nil, "", "", storageV1.ErrorFileNotFound("file not found")
+		}
+
+	default:
+		return nil, nil, "", "", storageV1.ErrorBadRequest("unknown download selector")
+	}
+
+	if operator.GetTenantId() != 0 && f.GetTenantId() != operator.GetTenantId() {
+		s.log.Warnf("user [%d] of tenant [%d] is not allowed to download [%s/%s]",
+			operator.GetUserId(), operator.GetTenantId(), bucketName, objectName)
+		return nil, nil, "", "", storageV1.ErrorForbidden("file access denied")
+	}
+
+	if err = checkFileScanStatus(f); err != nil {
+		return nil, nil, "", "", err
+	}
+
+	storage, err := s.storages.ForFile(ctx, f, operator.GetTenantId())
+	if err != nil {
+		return nil, nil, "", "", err
+	}
+
+	return f, storage, bucketName, objectName, nil
+}
+
+// OpenDownload 打开文件用于流式下载，调用方负责关闭 Content
+func (s *FileTransferService) OpenDownload(ctx context.Context, req *storageV1.DownloadFileRequest) (*FileDownload, error) {
+	f, storage, bucketName, objectName, err := s.resolveDownloadFile(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var object io.ReadSeekCloser
+	var info oss.ObjectInfo
+	if req.W != nil || req.H != nil {
+		// 图片缩放结果缓存在衍生文件存储桶中
+		object, info, err = s.images.OpenResized(context.WithoutCancel(ctx), storage, bucketName, objectName,
+			req.GetW(), req.GetH(), req.GetFit())
+	} else {
+		// 下载时长不受请求超时限制，客户端断开时写入失败即结束
+		object, info, err = storage.OpenObject(context.WithoutCancel(ctx), bucketName, objectName)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	fileName := f.GetFileName()
+	if fileName == "" {
+		fileName = path.Base(objectName)
+	}
+
+	contentType := req.GetAcceptMime()
+	if contentType == "" {
+		contentType = info.ContentType
+	}
+	if contentType == "" {
+		contentType = oss.DefaultContentType
+	}
+
+	return &FileDownload{
+		Content:      object,
+		FileName:     fileName,
+		ContentType:  contentType,
+		Size:         info.Size,
+		ETag:         info.ETag,
+		LastModified: info.LastModified,
+	}, nil
+}
+
+// DownloadFile 下载文件
+func (s *FileTransferService) DownloadFile(ctx context.Context, req *storageV1.DownloadFileRequest) (*storageV1.DownloadFileResponse, error) {
+	switch req.Selector.(type) {
+	case *storageV1.DownloadFileRequest_FileId, *storageV1.DownloadFileRequest_StorageObject:
+		_, storage, bucketName, objectName, err := s.resolveDownloadFile(ctx, req)
 		if err != nil {
-			return nil, storageV1.ErrorDownloadFailed("file not found")
+			return nil, err
 		}
 
 		req.Selector = &storageV1.DownloadFileRequest_StorageObject{
 			StorageObject: &storageV1.StorageObject{
-				BucketName: resp.BucketName,
-				ObjectName: trans.Ptr(resp.GetFileDirectory() + resp.GetSaveFileName()),
+				BucketName: trans.Ptr(bucketName),
+				ObjectName: trans.Ptr(objectName),
 			},
 		}
 
-		return s.mc.DownloadFile(ctx, req)
-
-	case *storageV1.DownloadFileRequest_StorageObject:
-		return s.mc.DownloadFile(ctx, req)
+		return oss.DownloadFile(ctx, storage, req)
 
 	case *storageV1.DownloadFileRequest_DownloadUrl:
 		return s.downloadFileFromURL(ctx, req.GetDownloadUrl())
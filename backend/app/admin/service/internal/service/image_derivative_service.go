@@ -0,0 +1,265 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	storageV1 "go-wind-admin/api/gen/go/storage/service/v1"
+
+	"go-wind-admin/pkg/imaging"
+	"go-wind-admin/pkg/oss"
+)
+
+const (
+	// ImageThumbnailPresetsEnv 上传图片时生成的缩略图规格，逗号分隔的 宽x高[:缩放模式]，例如 "256x256:cover,1024x0"
+	ImageThumbnailPresetsEnv = "IMAGE_THUMBNAIL_PRESETS"
+	// ImageResizeMaxDimensionEnv 缩放允许的最大边长（像素）
+	ImageResizeMaxDimensionEnv = "IMAGE_RESIZE_MAX_DIMENSION"
+
+	defaultImageThumbnailPresets   = "256x256:cover"
+	defaultImageResizeMaxDimension = 2048
+
+	// maxImageDerivativeSourceSize 生成缩放图时读取的原图大小上限
+	maxImageDerivativeSourceSize = 32 << 20
+)
+
+// ImagePreset 缩放规格
+type ImagePreset struct {
+	Width  int
+	Height int
+	Fit    imaging.Fit
+}
+
+// key 缓存对象名中的规格部分，上传时生成的缩略图与相同参数的缩放请求共用缓存
+func (p ImagePreset) key() string {
+	return fmt.Sprintf("%dx%d-%s", p.Width, p.Height, p.Fit)
+}
+
+// ParseImagePresets 解析缩略图规格列表
+func ParseImagePresets(s string) ([]ImagePreset, error) {
+	var presets []ImagePreset
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		size, fitName, _ := strings.Cut(item, ":")
+		ws, hs, ok := strings.Cut(strings.ToLower(size), "x")
+		if !ok {
+			return nil, fmt.Errorf("invalid image preset %q", item)
+		}
+		w, err := strconv.Atoi(ws)
+		if err != nil {
+			return nil, fmt.Errorf("invalid image preset %q", item)
+		}
+		h, err := strconv.Atoi(hs)
+		if err != nil {
+			return nil, fmt.Errorf("invalid image preset %q", item)
+		}
+		if w < 0 || h < 0 || (w == 0 && h == 0) {
+			return nil, fmt.Errorf("invalid image preset %q", item)
+		}
+		fit, err := imaging.ParseFit(fitName)
+		if err != nil {
+			return nil, err
+		}
+
+		presets = append(presets, ImagePreset{Width: w, Height: h, Fit: fit})
+	}
+	return presets, nil
+}
+
+// ImageDerivativeService 图片衍生文件：上传时生成缩略图，下载时按参数缩放并缓存结果。
+// 衍生文件保存在原图所在存储后端的衍生文件存储桶中，对象名为 <bucket>/<object>/<宽x高-模式>。
+type ImageDerivativeService struct {
+	log *log.Helper
+
+	presets      []ImagePreset
+	maxDimension int
+}
+
+func NewImageDerivativeService(ctx *bootstrap.Context) *ImageDerivativeService {
+	s := &ImageDerivativeService{
+		log:          ctx.NewLoggerHelper("image-derivative/service/admin-service"),
+		maxDimension: defaultImageResizeMaxDimension,
+	}
+
+	presets := defaultImageThumbnailPresets
+	if env, ok := os.LookupEnv(ImageThumbnailPresetsEnv); ok {
+		presets = env
+	}
+	var err error
+	if s.presets, err = ParseImagePresets(presets); err != nil {
+		s.log.Errorf("parse %s failed, thumbnails are disabled: %s", ImageThumbnailPresetsEnv, err.Error())
+	}
+
+	if env := strings.TrimSpace(os.Getenv(ImageResizeMaxDimensionEnv)); env != "" {
+		if v, err := strconv.Atoi(env); err == nil && v > 0 {
+			s.maxDimension = v
+		} else {
+			s.log.Warnf("invalid %s [%s], using %d", ImageResizeMaxDimensionEnv, env, defaultImageResizeMaxDimension)
+		}
+	}
+
+	return s
+}
+
+// IsImage 是否为可以生成衍生文件的图片类型
+func IsImage(contentType string) bool {
+	switch strings.ToLower(strings.TrimSpace(strings.Split(contentType, ";")[0])) {
+	case "image/jpeg", "image/png", "image/gif", "image/webp":
+		return true
+	default:
+		return false
+	}
+}
+
+func derivativeObjectName(bucketName, objectName string, preset ImagePreset) string {
+	return bucketName + "/" + objectName + "/" + preset.key()
+}
+
+// GenerateThumbnails 为新上传的图片生成缩略图，data 为空时从存储读取原图。
+// 缩略图只是缓存，失败时只记录日志。
+func (s *ImageDerivativeService) GenerateThumbnails(ctx context.Context, storage oss.ObjectStorage, bucketName, objectName string, data []byte) {
+	if len(s.presets) == 0 {
+		return
+	}
+
+	// 去重命中已有对象时缩略图已经存在
+	if _, err := storage.StatObject(ctx, oss.BucketImageDerivatives, derivativeObjectName(bucketName, objectName, s.presets[0])); err == nil {
+		return
+	}
+
+	var err error
+	if data == nil {
+		if data, err = s.readSource(ctx, storage, bucketName, objectName); err != nil {
+			s.log.Warnf("read image [%s/%s] failed: %s", bucketName, objectName, err.Error())
+			return
+		}
+	}
+
+	img, format, err := imaging.Decode(data)
+	if err != nil {
+		s.log.Warnf("decode image [%s/%s] failed: %s", bucketName, objectName, err.Error())
+		return
+	}
+
+	for _, preset := range s.presets {
+		if _, err = s.store(ctx, storage, bucketName, objectName, img, format, preset); err != nil {
+			s.log.Warnf("generate thumbnail [%s] of [%s/%s] failed: %s", preset.key(), bucketName, objectName, err.Error())
+		}
+	}
+}
+
+// OpenResized 打开缩放后的图片，缓存不存在时由原图生成，调用方负责关闭
+func (s *ImageDerivativeService) OpenResized(
+	ctx context.Context,
+	storage oss.ObjectStorage,
+	bucketName, objectName string,
+	width, height uint32,
+	fitName string,
+) (io.ReadSeekCloser, oss.ObjectInfo, error) {
+	fit, err := imaging.ParseFit(fitName)
+	if err != nil {
+		return nil, oss.ObjectInfo{}, storageV1.ErrorBadRequest("unknown fit mode [%s]", fitName)
+	}
+	if width == 0 && height == 0 {
+		return nil, oss.ObjectInfo{}, storageV1.ErrorBadRequest("width or height is required")
+	}
+	if int(width) > s.maxDimension || int(height) > s.maxDimension {
+		return nil, oss.ObjectInfo{}, storageV1.ErrorBadRequest("image size exceeds %dpx", s.maxDimension)
+	}
+
+	preset := ImagePreset{Width: int(width), Height: int(height), Fit: fit}
+	derivative := derivativeObjectName(bucketName, objectName, preset)
+
+	if object, info, err := storage.OpenObject(ctx, oss.BucketImageDerivatives, derivative); err == nil {
+		return object, info, nil
+	}
+
+	data, err := s.readSource(ctx, storage, bucketName, objectName)
+	if err != nil {
+		return nil, oss.ObjectInfo{}, err
+	}
+
+	img, format, err := imaging.Decode(data)
+	if err != nil {
+		s.log.Warnf("decode image [%s/%s] failed: %s", bucketName, objectName, err.Error())
+		return nil, oss.ObjectInfo{}, storageV1.ErrorBadRequest("file is not a supported image")
+	}
+
+	if _, err = s.store(ctx, storage, bucketName, objectName, img, format, preset); err != nil {
+		return nil, oss.ObjectInfo{}, err
+	}
+
+	return storage.OpenObject(ctx, oss.BucketImageDerivatives, derivative)
+}
+
+// DeleteDerivatives 原图删除后清理其全部衍生文件
+func (s *ImageDerivativeService) DeleteDerivatives(ctx context.Context, storage oss.ObjectStorage, bucketName, objectName string) {
+	objects, err := storage.ListObjects(ctx, oss.BucketImageDerivatives, bucketName+"/"+objectName+"/", true)
+	if err != nil {
+		return
+	}
+	for _, object := range objects {
+		if err = storage.DeleteFile(ctx, oss.BucketImageDerivatives, object.Key); err != nil {
+			s.log.Warnf("delete image derivative [%s] failed: %s", object.Key, err.Error())
+		}
+	}
+}
+
+// readSource 读取原图，超过大小上限的图片不生成衍生文件
+func (s *ImageDerivativeService) readSource(ctx context.Context, storage oss.ObjectStorage, bucketName, objectName string) ([]byte, error) {
+	reader, err := storage.GetObject(ctx, bucketName, objectName)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(io.LimitReader(reader, maxImageDerivativeSourceSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxImageDerivativeSourceSize {
+		return nil, storageV1.ErrorBadRequest("image is too large to resize")
+	}
+	return data, nil
+}
+
+// store 按规格缩放并写入衍生文件存储桶
+func (s *ImageDerivativeService) store(
+	ctx context.Context,
+	storage oss.ObjectStorage,
+	bucketName, objectName string,
+	img image.Image,
+	format string,
+	preset ImagePreset,
+) (oss.ObjectInfo, error) {
+	resized, err := imaging.Resize(img, preset.Width, preset.Height, preset.Fit)
+	if err != nil {
+		return oss.ObjectInfo{}, storageV1.ErrorBadRequest("invalid image size")
+	}
+
+	var buf bytes.Buffer
+	contentType, _, err := imaging.Encode(&buf, resized, format)
+	if err != nil {
+		return oss.ObjectInfo{}, err
+	}
+
+	if err = storage.EnsureBucketExists(ctx, oss.BucketImageDerivatives); err != nil {
+		return oss.ObjectInfo{}, err
+	}
+
+	return storage.UploadStream(ctx, oss.BucketImageDerivatives, derivativeObjectName(bucketName, objectName, preset),
+		contentType, bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go-wind-admin/pkg/imaging"
+	"go-wind-admin/pkg/oss"
+)
+
+func TestParseImagePresets(t *testing.T) {
+	presets, err := ParseImagePresets("256x256:cover, 1024x0 ,64X64:contain")
+	require.NoError(t, err)
+	assert.Equal(t, []ImagePreset{
+		{Width: 256, Height: 256, Fit: imaging.FitCover},
+		{Width: 1024, Height: 0, Fit: imaging.FitCover},
+		{Width: 64, Height: 64, Fit: imaging.FitContain},
+	}, presets)
+
+	presets, err = ParseImagePresets("")
+	require.NoError(t, err)
+	assert.Empty(t, presets)
+
+	for _, invalid := range []string{"256", "0x0", "axb", "10x10:stretch"} {
+		_, err = ParseImagePresets(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestImageDerivativeService(t *testing.T) {
+	ctx := context.Background()
+
+	storage, err := oss.NewLocalStorage(t.TempDir(), "http://localhost/admin/v1/storage/local", []byte("secret"), log.DefaultLogger)
+	require.NoError(t, err)
+
+	var src bytes.Buffer
+	require.NoError(t, png.Encode(&src, image.NewRGBA(image.Rect(0, 0, 400, 200))))
+	_, err = storage.UploadStream(ctx, oss.BucketImages, "a/b.png", "image/png", bytes.NewReader(src.Bytes()), int64(src.Len()))
+	require.NoError(t, err)
+
+	s := &ImageDerivativeService{
+		log:          log.NewHelper(log.DefaultLogger),
+		presets:      []ImagePreset{{Width: 64, Height: 64, Fit: imaging.FitCover}},
+		maxDimension: 1024,
+	}
+
+	s.GenerateThumbnails(ctx, storage, oss.BucketImages, "a/b.png", src.Bytes())
+	_, err = storage.StatObject(ctx, oss.BucketImageDerivatives, "images/a/b.png/64x64-cover")
+	require.NoError(t, err)
+
+	object, info, err := s.OpenResized(ctx, storage, oss.BucketImages, "a/b.png", 100, 0, "")
+	require.NoError(t, err)
+	defer object.Close()
+	assert.Equal(t, "image/png", info.ContentType)
+
+	resized, err := png.Decode(object)
+	require.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 100, 50), resized.Bounds())
+
+	_, _, err = s.OpenResized(ctx, storage, oss.BucketImages, "a/b.png", 2048, 0, "")
+	assert.Error(t, err)
+	_, _, err = s.OpenResized(ctx, storage, oss.BucketImages, "a/b.png", 10, 10, "stretch")
+	assert.Error(t, err)
+
+	s.DeleteDerivatives(ctx, storage, oss.BucketImages, "a/b.png")
+	objects, err := storage.ListObjects(ctx, oss.BucketImageDerivatives, "images/a/b.png/", true)
+	require.NoError(t, err)
+	assert.Empty(t, objects)
+}
+
+func TestIsImage(t *testing.T) {
+	assert.True(t, IsImage("image/jpeg"))
+	assert.True(t, IsImage("image/png; charset=binary"))
+	assert.False(t, IsImage("image/svg+xml"))
+	assert.False(t, IsImage("application/pdf"))
+}
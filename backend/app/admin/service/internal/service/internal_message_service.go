@@ -42,6 +42,8 @@ type InternalMessageService struct {
 	internalMessagePublisher InternalMessagePublisher
 	authenticator            *data.Authenticator
 	clientType               authenticationV1.ClientType
+
+	luaHooks *data.LuaHookRunner
 }
 
 func NewInternalMessageService(
@@ -52,6 +54,7 @@ func NewInternalMessageService(
 	userRepo data.UserRepo,
 	authenticator *data.Authenticator,
 	clientType authenticationV1.ClientType,
+	luaHooks *data.LuaHookRunner,
 ) *InternalMessageService {
 	return &InternalMessageService{
 		log:                          ctx.NewLoggerHelper("internal-message/service/admin-service"),
@@ -61,6 +64,7 @@ func NewInternalMessageService(
 		userRepo:                     userRepo,
 		authenticator:                authenticator,
 		clientType:                   clientType,
+		luaHooks:                     luaHooks,
 	}
 }
 
@@ -72,28 +76,39 @@ func (s *InternalMessageService) HandleAuthorize(_ *http.Request, token string)
 	//s.log.Debugf("authorizing token: %s", token)
 	//s.log.Debugf("authorizing token HEADER: %s", req.Header.Get("Authorization"))
 
-	resp, err := s.authenticator.Authenticate(context.Background(), &authenticationV1.ValidateTokenRequest{
-		ClientType:    s.clientType,
+	_, err := authenticateStreamToken(s.log, s.authenticator, s.clientType, token)
+	return err
+}
+
+// authenticateStreamToken 校验SSE连接携带的访问令牌，返回令牌载荷
+func authenticateStreamToken(
+	l *log.Helper,
+	authenticator *data.Authenticator,
+	clientType authenticationV1.ClientType,
+	token string,
+) (*authenticationV1.UserTokenPayload, error) {
+	resp, err := authenticator.Authenticate(context.Background(), &authenticationV1.ValidateTokenRequest{
+		ClientType:    clientType,
 		Token:         token,
 		TokenCategory: authenticationV1.TokenCategory_ACCESS,
 	})
 	if err != nil {
-		s.log.Errorf("token authentication failed: %s", err)
-		return err
+		l.Errorf("token authentication failed: %s", err)
+		return nil, err
 	}
 
 	if resp.GetIsBlocked() {
-		s.log.Warnf("token is blocked: %s", token)
-		return authenticationV1.ErrorForbidden("token is blocked")
+		l.Warnf("token is blocked: %s", token)
+		return nil, authenticationV1.ErrorForbidden("token is blocked")
 	}
 	if !resp.GetIsValid() {
-		s.log.Warnf("token is invalid: %s", token)
-		return authenticationV1.ErrorUnauthorized("invalid token")
+		l.Warnf("token is invalid: %s", token)
+		return nil, authenticationV1.ErrorUnauthorized("invalid token")
 	}
 
-	s.log.Debugf("token authenticated successfully, userId: [%d]", resp.GetPayload().GetUserId())
+	l.Debugf("token authenticated successfully, userId: [%d]", resp.GetPayload().GetUserId())
 
-	return nil
+	return resp.GetPayload(), nil
 }
 
 func (s *InternalMessageService) HandleSubscribe(streamID sse.StreamID, _ *sse.Subscriber) {
@@ -256,6 +271,14 @@ func (s *InternalMessageService) SendMessage(ctx context.Context, req *internalM
 		return nil, err
 	}
 
+	// 发送前钩子，脚本可修改消息内容、收件人或拒绝发送
+	if err = s.luaHooks.Before(ctx, &data.LuaHookEvent{
+		Hook:    data.LuaHookBeforeMessageSend,
+		Payload: req,
+	}); err != nil {
+		return nil, err
+	}
+
 	now := time.Now()
 
 	var msg *internalMessageV1.InternalMessage
@@ -300,6 +323,27 @@ func (s *InternalMessageService) SendMessage(ctx context.Context, req *internalM
 	}, nil
 }
 
+// SendSystemNotification 以系统身份向指定用户发送通知（供后台任务使用，无需操作人）
+func (s *InternalMessageService) SendSystemNotification(ctx context.Context, recipientUserId uint32, title, content string) error {
+	now := time.Now()
+
+	msg, err := s.internalMessageRepo.Create(ctx, &internalMessageV1.CreateInternalMessageRequest{
+		Data: &internalMessageV1.InternalMessage{
+			Title:     trans.Ptr(title),
+			Content:   trans.Ptr(content),
+			Status:    trans.Ptr(internalMessageV1.InternalMessage_PUBLISHED),
+			Type:      trans.Ptr(internalMessageV1.InternalMessage_NOTIFICATION),
+			CreatedAt: timeutil.TimeToTimestamppb(&now),
+		},
+	})
+	if err != nil {
+		s.log.Errorf("create system notification failed: %s", err)
+		return err
+	}
+
+	return s.sendNotification(ctx, msg.GetId(), recipientUserId, 0, &now, msg.GetTitle(), msg.GetContent())
+}
+
 // sendNotification 向客户端发送通知消息
 func (s *InternalMessageService) sendNotification(ctx context.Context, messageId uint32, recipientUserId uint32, senderUserId uint32, now *time.Time, title, content string) error {
 	recipient := &internalMessageV1.InternalMessageRecipient{
@@ -322,6 +366,10 @@ func (s *InternalMessageService) sendNotification(ctx context.Context, messageId
 
 	recipientJson, _ := json.Marshal(recipient)
 
+	if s.internalMessagePublisher == nil {
+		return nil
+	}
+
 	recipientStreamIds := s.authenticator.GetAccessTokens(ctx, s.clientType, recipientUserId)
 	for _, streamId := range recipientStreamIds {
 		s.internalMessagePublisher.Publish(ctx, sse.StreamID(streamId), &sse.Event{
@@ -21,12 +21,15 @@ type LoginAuditLogService struct {
 	log *log.Helper
 
 	repo *data.LoginAuditLogRepo
+
+	exportService *AuditLogExportService
 }
 
-func NewLoginAuditLogService(ctx *bootstrap.Context, repo *data.LoginAuditLogRepo) *LoginAuditLogService {
+func NewLoginAuditLogService(ctx *bootstrap.Context, repo *data.LoginAuditLogRepo, exportService *AuditLogExportService) *LoginAuditLogService {
 	return &LoginAuditLogService{
-		log:  ctx.NewLoggerHelper("login-audit-log/service/admin-service"),
-		repo: repo,
+		log:           ctx.NewLoggerHelper("login-audit-log/service/admin-service"),
+		repo:          repo,
+		exportService: exportService,
 	}
 }
 
@@ -49,3 +52,8 @@ func (s *LoginAuditLogService) Create(ctx context.Context, req *auditV1.CreateLo
 
 	return &emptypb.Empty{}, nil
 }
+
+// Export 导出日志（后台任务）
+func (s *LoginAuditLogService) Export(ctx context.Context, req *auditV1.ExportAuditLogRequest) (*auditV1.ExportAuditLogResponse, error) {
+	return s.exportService.Enqueue(ctx, auditV1.AuditLogType_LOGIN_AUDIT_LOG, req)
+}
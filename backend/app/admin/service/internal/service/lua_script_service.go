@@ -0,0 +1,446 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pmezard/go-difflib/difflib"
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	taskV1 "go-wind-admin/api/gen/go/task/service/v1"
+
+	"go-wind-admin/pkg/lua"
+	"go-wind-admin/pkg/middleware/auth"
+)
+
+const luaScriptTestRunHook = "test_run"
+
+// LuaScriptService Lua脚本管理：保存时编译检查、版本历史与回滚，变更后热加载到所有实例
+type LuaScriptService struct {
+	adminV1.LuaScriptServiceHTTPServer
+
+	log *log.Helper
+
+	repo   *data.LuaScriptRepo
+	syncer *data.LuaScriptSyncer
+	engine *lua.Engine
+}
+
+func NewLuaScriptService(
+	ctx *bootstrap.Context,
+	repo *data.LuaScriptRepo,
+	syncer *data.LuaScriptSyncer,
+	engine *lua.Engine,
+) *LuaScriptService {
+	return &LuaScriptService{
+		log:    ctx.NewLoggerHelper("lua-script/service/admin-service"),
+		repo:   repo,
+		syncer: syncer,
+		engine: engine,
+	}
+}
+
+func (s *LuaScriptService) List(ctx context.Context, req *paginationV1.PagingRequest) (*taskV1.ListLuaScriptResponse, error) {
+	return s.repo.List(ctx, req)
+}
+
+func (s *LuaScriptService) Get(ctx context.Context, req *taskV1.GetLuaScriptRequest) (*taskV1.LuaScript, error) {
+	return s.repo.Get(ctx, req)
+}
+
+func (s *LuaScriptService) Create(ctx context.Context, req *taskV1.CreateLuaScriptRequest) (*emptypb.Empty, error) {
+	if req == nil || req.Data == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+	if err := validateLuaScript(req.Data); err != nil {
+		return nil, err
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 租户管理员只能管理本租户的脚本
+	if operator.GetTenantId() > 0 {
+		req.Data.TenantId = trans.Ptr(operator.GetTenantId())
+	}
+	if req.Data.Author == nil {
+		req.Data.Author = trans.Ptr(operator.GetUsername())
+	}
+	req.Data.CreatedBy = trans.Ptr(operator.UserId)
+
+	created, err := s.repo.Create(ctx, req.Data, req.GetChangeLog())
+	if err != nil {
+		return nil, err
+	}
+
+	s.publish(ctx, created.GetId(), false)
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *LuaScriptService) Update(ctx context.Context, req *taskV1.UpdateLuaScriptRequest) (*emptypb.Empty, error) {
+	if req == nil || req.Data == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := s.repo.GetByID(ctx, req.GetId())
+	if err != nil {
+		if !taskV1.IsNotFound(err) || !req.GetAllowMissing() {
+			return nil, err
+		}
+
+		// 不存在则创建
+		createReq := &taskV1.CreateLuaScriptRequest{Data: req.Data, ChangeLog: req.ChangeLog}
+		createReq.Data.CreatedBy = createReq.Data.UpdatedBy
+		createReq.Data.UpdatedBy = nil
+		return s.Create(ctx, createReq)
+	}
+
+	merged := mergeLuaScript(current, req.Data, req.GetUpdateMask())
+	if err = validateLuaScript(merged); err != nil {
+		return nil, err
+	}
+	merged.UpdatedBy = trans.Ptr(operator.UserId)
+
+	if _, err = s.repo.Update(ctx, merged, req.GetChangeLog()); err != nil {
+		return nil, err
+	}
+
+	s.publish(ctx, req.GetId(), false)
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *LuaScriptService) Delete(ctx context.Context, req *taskV1.DeleteLuaScriptRequest) (*emptypb.Empty, error) {
+	// 先确认脚本对当前租户可见
+	if _, err := s.repo.GetByID(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Delete(ctx, req); err != nil {
+		return nil, err
+	}
+
+	s.publish(ctx, req.GetId(), true)
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *LuaScriptService) ListVersions(ctx context.Context, req *taskV1.ListLuaScriptVersionsRequest) (*taskV1.ListLuaScriptVersionsResponse, error) {
+	if req == nil || req.GetId() == 0 {
+		return nil, adminV1.ErrorBadRequest("id is required")
+	}
+
+	// 版本表不做租户隔离判断，先确认脚本对当前租户可见
+	if _, err := s.repo.GetByID(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	return s.repo.ListVersions(ctx, req.GetId())
+}
+
+// DiffVersions 比较两个版本的源码，并列出源码之外发生变化的字段
+func (s *LuaScriptService) DiffVersions(ctx context.Context, req *taskV1.DiffLuaScriptVersionsRequest) (*taskV1.DiffLuaScriptVersionsResponse, error) {
+	if req == nil || req.GetId() == 0 {
+		return nil, adminV1.ErrorBadRequest("id is required")
+	}
+
+	current, err := s.repo.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	toVersion := req.GetToVersion()
+	if toVersion == 0 {
+		toVersion = current.GetVersion()
+	}
+	fromVersion := req.GetFromVersion()
+	if fromVersion == 0 {
+		if toVersion <= 1 {
+			return nil, adminV1.ErrorBadRequest("version %d has no previous version", toVersion)
+		}
+		fromVersion = toVersion - 1
+	}
+
+	from, err := s.repo.GetVersion(ctx, req.GetId(), fromVersion)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.repo.GetVersion(ctx, req.GetId(), toVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	diff, err := diffLuaScriptSource(current.GetName(), from, to)
+	if err != nil {
+		s.log.Errorf("diff lua script versions failed: %s", err.Error())
+		return nil, adminV1.ErrorInternalServerError("diff lua script versions failed")
+	}
+
+	return &taskV1.DiffLuaScriptVersionsResponse{
+		FromVersion:   fromVersion,
+		ToVersion:     toVersion,
+		Diff:          diff,
+		ChangedFields: changedLuaScriptFields(from, to),
+	}, nil
+}
+
+// Rollback 以指定版本的内容生成一个新版本
+func (s *LuaScriptService) Rollback(ctx context.Context, req *taskV1.RollbackLuaScriptRequest) (*emptypb.Empty, error) {
+	if req == nil || req.GetId() == 0 || req.GetVersion() == 0 {
+		return nil, adminV1.ErrorBadRequest("id and version are required")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := s.repo.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if current.GetVersion() == req.GetVersion() {
+		return nil, adminV1.ErrorBadRequest("version %d is already the current version", req.GetVersion())
+	}
+
+	target, err := s.repo.GetVersion(ctx, req.GetId(), req.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = lua.CheckSyntax(current.GetName(), target.GetSource()); err != nil {
+		return nil, adminV1.ErrorBadRequest("version %d does not compile: %s", req.GetVersion(), err.Error())
+	}
+
+	if _, err = s.repo.Update(ctx, &taskV1.LuaScript{
+		Id:        trans.Ptr(req.GetId()),
+		Hook:      target.Hook,
+		Source:    target.Source,
+		Priority:  target.Priority,
+		Critical:  target.Critical,
+		UpdatedBy: trans.Ptr(operator.UserId),
+	}, fmt.Sprintf("rollback to version %d", req.GetVersion())); err != nil {
+		return nil, err
+	}
+
+	s.publish(ctx, req.GetId(), false)
+
+	return &emptypb.Empty{}, nil
+}
+
+// TestRun 在沙箱中使用示例上下文执行脚本，不影响已加载的脚本
+func (s *LuaScriptService) TestRun(ctx context.Context, req *taskV1.TestRunLuaScriptRequest) (*taskV1.TestRunLuaScriptResponse, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	script := &lua.Script{
+		Name:   "test-run",
+		Hook:   req.GetHook(),
+		Source: req.GetSource(),
+	}
+	if req.Source == nil {
+		if req.GetId() == 0 {
+			return nil, adminV1.ErrorBadRequest("id or source is required")
+		}
+		stored, err := s.repo.GetByID(ctx, req.GetId())
+		if err != nil {
+			return nil, err
+		}
+		script = data.ToLuaScript(stored)
+		if req.Hook != nil {
+			script.Hook = req.GetHook()
+		}
+	}
+	if script.Hook == "" {
+		script.Hook = luaScriptTestRunHook
+	}
+	// 试运行不允许写入数据
+	script.DataWrite = false
+
+	if err = script.Compile(); err != nil {
+		return &taskV1.TestRunLuaScriptResponse{ErrorMessage: err.Error()}, nil
+	}
+
+	execCtx := lua.NewContext(script.Hook)
+	if req.Context != nil {
+		execCtx.Data = req.Context.AsMap()
+	}
+	execCtx.User = &lua.UserContext{
+		ID:       operator.GetUserId(),
+		Username: operator.GetUsername(),
+		Roles:    operator.GetRoles(),
+		TenantID: operator.GetTenantId(),
+	}
+
+	start := time.Now()
+	err = s.engine.Execute(ctx, script, execCtx)
+	resp := &taskV1.TestRunLuaScriptResponse{
+		Success:    err == nil,
+		Stopped:    execCtx.Stopped,
+		StopReason: execCtx.StopReason,
+		DurationMs: uint32(time.Since(start).Milliseconds()),
+	}
+	if err != nil {
+		resp.ErrorMessage = err.Error()
+	}
+
+	if resp.Context, err = luaContextToStruct(execCtx.Data); err != nil {
+		s.log.Warnf("convert test run context failed: %s", err.Error())
+	}
+
+	return resp, nil
+}
+
+// publish 在本实例热加载脚本并通知其他实例
+func (s *LuaScriptService) publish(ctx context.Context, scriptID uint32, deleted bool) {
+	if err := s.syncer.Publish(ctx, scriptID, deleted); err != nil {
+		s.log.Errorf("reload lua script [%d] failed: %s", scriptID, err.Error())
+	}
+}
+
+// validateLuaScript 校验必填字段并编译检查源码
+func validateLuaScript(script *taskV1.LuaScript) error {
+	switch {
+	case script.GetName() == "":
+		return adminV1.ErrorBadRequest("name is required")
+	case script.GetHook() == "":
+		return adminV1.ErrorBadRequest("hook is required")
+	case strings.TrimSpace(script.GetSource()) == "":
+		return adminV1.ErrorBadRequest("source is required")
+	}
+
+	if err := lua.CheckSyntax(script.GetName(), script.GetSource()); err != nil {
+		return adminV1.ErrorBadRequest("%s", err.Error())
+	}
+
+	return nil
+}
+
+// mergeLuaScript 按更新掩码把修改合并到当前脚本；未指定掩码时合并所有非空字段
+func mergeLuaScript(current, patch *taskV1.LuaScript, mask *fieldmaskpb.FieldMask) *taskV1.LuaScript {
+	paths := make(map[string]bool, len(mask.GetPaths()))
+	for _, p := range mask.GetPaths() {
+		paths[strings.ToLower(strings.ReplaceAll(p, "_", ""))] = true
+	}
+	use := func(path string, set bool) bool {
+		if len(paths) == 0 {
+			return set
+		}
+		return paths[path]
+	}
+
+	merged := &taskV1.LuaScript{
+		Id:          current.Id,
+		TenantId:    current.TenantId,
+		Name:        current.Name,
+		Hook:        current.Hook,
+		Source:      current.Source,
+		Description: current.Description,
+		Enabled:     current.Enabled,
+		Priority:    current.Priority,
+		Critical:    current.Critical,
+		DataWrite:   current.DataWrite,
+		Version:     current.Version,
+		Author:      current.Author,
+	}
+
+	if use("name", patch.Name != nil) {
+		merged.Name = patch.Name
+	}
+	if use("hook", patch.Hook != nil) {
+		merged.Hook = patch.Hook
+	}
+	if use("source", patch.Source != nil) {
+		merged.Source = patch.Source
+	}
+	if use("description", patch.Description != nil) {
+		merged.Description = patch.Description
+	}
+	if use("enabled", patch.Enabled != nil) {
+		merged.Enabled = patch.Enabled
+	}
+	if use("priority", patch.Priority != nil) {
+		merged.Priority = patch.Priority
+	}
+	if use("critical", patch.Critical != nil) {
+		merged.Critical = patch.Critical
+	}
+	if use("data_write", patch.DataWrite != nil) {
+		merged.DataWrite = patch.DataWrite
+	}
+	if use("author", patch.Author != nil) {
+		merged.Author = patch.Author
+	}
+
+	return merged
+}
+
+// diffLuaScriptSource 生成两个版本源码的统一差异
+func diffLuaScriptSource(name string, from, to *taskV1.LuaScriptVersion) (string, error) {
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(from.GetSource()),
+		B:        difflib.SplitLines(to.GetSource()),
+		FromFile: fmt.Sprintf("%s@v%d", name, from.GetVersion()),
+		ToFile:   fmt.Sprintf("%s@v%d", name, to.GetVersion()),
+		Context:  3,
+	})
+}
+
+// changedLuaScriptFields 源码之外发生变化的字段
+func changedLuaScriptFields(from, to *taskV1.LuaScriptVersion) []string {
+	var fields []string
+	if from.GetHook() != to.GetHook() {
+		fields = append(fields, "hook")
+	}
+	if from.GetPriority() != to.GetPriority() {
+		fields = append(fields, "priority")
+	}
+	if from.GetCritical() != to.GetCritical() {
+		fields = append(fields, "critical")
+	}
+	return fields
+}
+
+// luaContextToStruct 转换脚本上下文数据，脚本写入的值经 JSON 归一化为 Struct 支持的类型
+func luaContextToStruct(values map[string]interface{}) (*structpb.Struct, error) {
+	raw, err := json.Marshal(values)
+	if err != nil {
+		return nil, err
+	}
+
+	var normalized map[string]interface{}
+	if err = json.Unmarshal(raw, &normalized); err != nil {
+		return nil, err
+	}
+
+	return structpb.NewStruct(normalized)
+}
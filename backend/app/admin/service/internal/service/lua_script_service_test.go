@@ -0,0 +1,88 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tx7do/go-utils/trans"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	taskV1 "go-wind-admin/api/gen/go/task/service/v1"
+)
+
+func TestMergeLuaScript(t *testing.T) {
+	current := &taskV1.LuaScript{
+		Id:       trans.Ptr(uint32(1)),
+		TenantId: trans.Ptr(uint32(2)),
+		Name:     trans.Ptr("audit"),
+		Hook:     trans.Ptr("user.after_create"),
+		Source:   trans.Ptr("function execute(ctx) end"),
+		Enabled:  trans.Ptr(true),
+		Priority: trans.Ptr(int32(10)),
+		Version:  trans.Ptr(uint32(3)),
+	}
+
+	// 无掩码时合并所有非空字段
+	merged := mergeLuaScript(current, &taskV1.LuaScript{Enabled: trans.Ptr(false)}, nil)
+	assert.False(t, merged.GetEnabled())
+	assert.Equal(t, "audit", merged.GetName())
+	assert.Equal(t, "function execute(ctx) end", merged.GetSource())
+	assert.Equal(t, uint32(2), merged.GetTenantId())
+
+	// 有掩码时只合并掩码中的字段，驼峰与下划线命名均可
+	merged = mergeLuaScript(current, &taskV1.LuaScript{
+		Name:     trans.Ptr("renamed"),
+		Priority: trans.Ptr(int32(1)),
+	}, &fieldmaskpb.FieldMask{Paths: []string{"priority"}})
+	assert.Equal(t, "audit", merged.GetName())
+	assert.Equal(t, int32(1), merged.GetPriority())
+
+	merged = mergeLuaScript(current, &taskV1.LuaScript{}, &fieldmaskpb.FieldMask{Paths: []string{"description"}})
+	assert.Nil(t, merged.Description)
+	assert.Equal(t, uint32(3), merged.GetVersion())
+}
+
+func TestValidateLuaScript(t *testing.T) {
+	script := &taskV1.LuaScript{
+		Name:   trans.Ptr("audit"),
+		Hook:   trans.Ptr("user.after_create"),
+		Source: trans.Ptr("function execute(ctx) return true end"),
+	}
+	assert.NoError(t, validateLuaScript(script))
+
+	script.Source = trans.Ptr("function execute(ctx) return true")
+	assert.Error(t, validateLuaScript(script))
+
+	script.Source = trans.Ptr("  ")
+	assert.Error(t, validateLuaScript(script))
+
+	script.Source = trans.Ptr("return 1")
+	script.Hook = nil
+	assert.Error(t, validateLuaScript(script))
+}
+
+func TestDiffLuaScriptVersions(t *testing.T) {
+	from := &taskV1.LuaScriptVersion{
+		Version:  trans.Ptr(uint32(1)),
+		Hook:     trans.Ptr("user.after_create"),
+		Source:   trans.Ptr("local a = 1\nreturn a\n"),
+		Priority: trans.Ptr(int32(0)),
+	}
+	to := &taskV1.LuaScriptVersion{
+		Version:  trans.Ptr(uint32(2)),
+		Hook:     trans.Ptr("user.after_create"),
+		Source:   trans.Ptr("local a = 2\nreturn a\n"),
+		Priority: trans.Ptr(int32(5)),
+		Critical: trans.Ptr(true),
+	}
+
+	diff, err := diffLuaScriptSource("audit", from, to)
+	assert.NoError(t, err)
+	assert.Contains(t, diff, "--- audit@v1")
+	assert.Contains(t, diff, "+++ audit@v2")
+	assert.Contains(t, diff, "-local a = 1")
+	assert.Contains(t, diff, "+local a = 2")
+	assert.NotContains(t, diff, "-return a")
+
+	assert.Equal(t, []string{"priority", "critical"}, changedLuaScriptFields(from, to))
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/hibiken/asynq"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	asynqServer "github.com/tx7do/kratos-transport/transport/asynq"
+
+	"go-wind-admin/pkg/lua"
+	"go-wind-admin/pkg/lua/api"
+	"go-wind-admin/pkg/task"
+)
+
+const defaultLuaTaskQueue = "default"
+
+// LuaTaskService 把 Lua 脚本中通过 task.register_handler 注册的处理器挂到 asynq 上
+type LuaTaskService struct {
+	log *log.Helper
+
+	engine *lua.Engine
+
+	// 队列名 -> 权重，用于把处理器的优先级映射到队列
+	queues map[string]int32
+}
+
+func NewLuaTaskService(ctx *bootstrap.Context, engine *lua.Engine) *LuaTaskService {
+	svc := &LuaTaskService{
+		log:    ctx.NewLoggerHelper("lua-task/service/admin-service"),
+		engine: engine,
+	}
+
+	if cfg := ctx.GetConfig(); cfg != nil && cfg.Server != nil && cfg.Server.Asynq != nil {
+		svc.queues = cfg.Server.Asynq.GetQueues()
+	}
+
+	return svc
+}
+
+// RegisterTaskHandlers 注册全部 Lua 任务处理器，与已有任务类型重名的处理器会被跳过
+func (s *LuaTaskService) RegisterTaskHandlers(srv *asynqServer.Server) error {
+	for _, h := range s.engine.TaskHandlers() {
+		if srv.TaskTypeExists(h.Name) {
+			s.log.Warnf("lua task handler [%s] conflicts with a built-in task type, skipped", h.Name)
+			continue
+		}
+
+		if err := asynqServer.RegisterSubscriberWithCtx(srv, h.Name, s.AsyncExecute); err != nil {
+			return err
+		}
+
+		s.log.Infof("registered lua task handler [%s] (queue: %s)", h.Name, s.queueFor(h.Priority))
+	}
+
+	return nil
+}
+
+// IsLuaTask 任务类型是否由 Lua 处理器实现
+func (s *LuaTaskService) IsLuaTask(typeName string) bool {
+	_, ok := api.GetHandler(typeName)
+	return ok
+}
+
+// TaskOptions 由处理器声明的超时、重试次数与优先级生成的默认任务选项
+func (s *LuaTaskService) TaskOptions(typeName string) []asynq.Option {
+	h, ok := api.GetHandler(typeName)
+	if !ok {
+		return nil
+	}
+
+	var opts []asynq.Option
+	if h.TimeoutSecs > 0 {
+		opts = append(opts, asynq.Timeout(time.Duration(h.TimeoutSecs)*time.Second))
+	}
+	if h.MaxRetries >= 0 {
+		opts = append(opts, asynq.MaxRetry(h.MaxRetries))
+	}
+	opts = append(opts, asynq.Queue(s.queueFor(h.Priority)))
+
+	return opts
+}
+
+// ValidatePayload 按处理器声明的必填、可选字段校验任务负载
+func (s *LuaTaskService) ValidatePayload(typeName string, payload any) error {
+	h, ok := api.GetHandler(typeName)
+	if !ok {
+		return nil
+	}
+
+	var fields map[string]any
+	switch p := payload.(type) {
+	case nil:
+	case map[string]any:
+		fields = p
+	default:
+		return fmt.Errorf("%w: payload must be an object", lua.ErrInvalidTaskPayload)
+	}
+
+	_, err := lua.ValidateTaskPayload(h, fields)
+	return err
+}
+
+// queueFor 选择权重与优先级最接近的队列，相同时取权重较高者
+func (s *LuaTaskService) queueFor(priority int) string {
+	best := ""
+	var bestWeight int32
+	bestDiff := math.MaxInt
+
+	for name, weight := range s.queues {
+		diff := int(weight) - priority
+		if diff < 0 {
+			diff = -diff
+		}
+		if diff < bestDiff || (diff == bestDiff && (weight > bestWeight || (weight == bestWeight && name < best))) {
+			best, bestWeight, bestDiff = name, weight, diff
+		}
+	}
+
+	if best == "" {
+		return defaultLuaTaskQueue
+	}
+	return best
+}
+
+// AsyncExecute 执行 Lua 任务处理器
+func (s *LuaTaskService) AsyncExecute(ctx context.Context, taskType string, payload *map[string]any) error {
+	l := task.LoggerFromContext(ctx, s.log)
+
+	var fields map[string]any
+	if payload != nil {
+		fields = *payload
+	}
+
+	result, err := s.engine.ExecuteTask(ctx, taskType, fields)
+	if err != nil {
+		l.Errorf("lua task [%s] failed: %s", taskType, err.Error())
+		// 负载不合法或超出沙箱的内存、指令、模块限制时，重试也不会成功
+		if errors.Is(err, lua.ErrInvalidTaskPayload) ||
+			errors.Is(err, lua.ErrTaskHandlerNotFound) ||
+			errors.Is(err, lua.ErrMemoryLimit) ||
+			errors.Is(err, lua.ErrInstructionLimit) ||
+			errors.Is(err, lua.ErrModuleNotAllowed) {
+			return fmt.Errorf("%w: %s", asynq.SkipRetry, err.Error())
+		}
+		return err
+	}
+
+	task.SetResult(ctx, result)
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLuaTaskService_queueFor(t *testing.T) {
+	s := &LuaTaskService{}
+	assert.Equal(t, "default", s.queueFor(5))
+
+	s.queues = map[string]int32{"critical": 10, "default": 5, "low": 1}
+	assert.Equal(t, "default", s.queueFor(5))
+	assert.Equal(t, "critical", s.queueFor(10))
+	assert.Equal(t, "critical", s.queueFor(99))
+	assert.Equal(t, "low", s.queueFor(1))
+	assert.Equal(t, "low", s.queueFor(0))
+	// 与两个队列距离相同时取权重较高者
+	assert.Equal(t, "default", s.queueFor(3))
+}
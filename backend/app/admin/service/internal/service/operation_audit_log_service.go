@@ -21,12 +21,15 @@ type OperationAuditLogService struct {
 	log *log.Helper
 
 	repo *data.OperationAuditLogRepo
+
+	exportService *AuditLogExportService
 }
 
-func NewOperationAuditLogService(ctx *bootstrap.Context, repo *data.OperationAuditLogRepo) *OperationAuditLogService {
+func NewOperationAuditLogService(ctx *bootstrap.Context, repo *data.OperationAuditLogRepo, exportService *AuditLogExportService) *OperationAuditLogService {
 	return &OperationAuditLogService{
-		log:  ctx.NewLoggerHelper("operation-audit-log/service/admin-service"),
-		repo: repo,
+		log:           ctx.NewLoggerHelper("operation-audit-log/service/admin-service"),
+		repo:          repo,
+		exportService: exportService,
 	}
 }
 
@@ -49,3 +52,8 @@ func (s *OperationAuditLogService) Create(ctx context.Context, req *auditV1.Crea
 
 	return &emptypb.Empty{}, nil
 }
+
+// Export 导出日志（后台任务）
+func (s *OperationAuditLogService) Export(ctx context.Context, req *auditV1.ExportAuditLogRequest) (*auditV1.ExportAuditLogResponse, error) {
+	return s.exportService.Enqueue(ctx, auditV1.AuditLogType_OPERATION_AUDIT_LOG, req)
+}
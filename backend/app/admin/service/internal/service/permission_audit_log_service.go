@@ -20,15 +20,19 @@ type PermissionAuditLogService struct {
 	log *log.Helper
 
 	policyEvaluationLogRepo *data.PermissionAuditLogRepo
+
+	exportService *AuditLogExportService
 }
 
 func NewPermissionAuditLogService(
 	ctx *bootstrap.Context,
 	policyEvaluationLogRepo *data.PermissionAuditLogRepo,
+	exportService *AuditLogExportService,
 ) *PermissionAuditLogService {
 	return &PermissionAuditLogService{
 		log:                     ctx.NewLoggerHelper("permission-audit-log/service/admin-service"),
 		policyEvaluationLogRepo: policyEvaluationLogRepo,
+		exportService:           exportService,
 	}
 }
 
@@ -61,3 +65,8 @@ func (s *PermissionAuditLogService) Create(ctx context.Context, req *auditV1.Cre
 
 	return &emptypb.Empty{}, nil
 }
+
+// Export 导出日志（后台任务）
+func (s *PermissionAuditLogService) Export(ctx context.Context, req *auditV1.ExportAuditLogRequest) (*auditV1.ExportAuditLogResponse, error) {
+	return s.exportService.Enqueue(ctx, auditV1.AuditLogType_PERMISSION_AUDIT_LOG, req)
+}
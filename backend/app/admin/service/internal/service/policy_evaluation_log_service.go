@@ -11,6 +11,7 @@ import (
 	"go-wind-admin/app/admin/service/internal/data"
 
 	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	auditV1 "go-wind-admin/api/gen/go/audit/service/v1"
 	permissionV1 "go-wind-admin/api/gen/go/permission/service/v1"
 )
 
@@ -20,15 +21,19 @@ type PolicyEvaluationLogService struct {
 	log *log.Helper
 
 	policyEvaluationLogRepo *data.PolicyEvaluationLogRepo
+
+	exportService *AuditLogExportService
 }
 
 func NewPolicyEvaluationLogService(
 	ctx *bootstrap.Context,
 	policyEvaluationLogRepo *data.PolicyEvaluationLogRepo,
+	exportService *AuditLogExportService,
 ) *PolicyEvaluationLogService {
 	return &PolicyEvaluationLogService{
 		log:                     ctx.NewLoggerHelper("policy-evaluation-log/service/admin-service"),
 		policyEvaluationLogRepo: policyEvaluationLogRepo,
+		exportService:           exportService,
 	}
 }
 
@@ -61,3 +66,8 @@ func (s *PolicyEvaluationLogService) Create(ctx context.Context, req *permission
 
 	return &emptypb.Empty{}, nil
 }
+
+// Export 导出日志（后台任务）
+func (s *PolicyEvaluationLogService) Export(ctx context.Context, req *auditV1.ExportAuditLogRequest) (*auditV1.ExportAuditLogResponse, error) {
+	return s.exportService.Enqueue(ctx, auditV1.AuditLogType_POLICY_EVALUATION_LOG, req)
+}
@@ -23,6 +23,9 @@ var ProviderSet = wire.NewSet(
 	service.NewMenuService,
 	service.NewAdminPortalService,
 	service.NewTaskService,
+	service.NewTaskWorkflowService,
+	service.NewTaskProgressService,
+	service.NewLuaTaskService,
 	service.NewRoleService,
 	service.NewOrgUnitService,
 	service.NewPositionService,
@@ -46,5 +49,16 @@ var ProviderSet = wire.NewSet(
 	service.NewPermissionAuditLogService,
 	service.NewDataAccessAuditLogService,
 	service.NewOperationAuditLogService,
+	service.NewAuditLogArchiveService,
+	service.NewAuditLogExportService,
+	service.NewDatabaseBackupService,
+	service.NewAuditForwarderService,
+	service.NewLuaScriptService,
+	service.NewAuditAnalyticsService,
 	service.NewFileTransferService,
+	service.NewStorageQuotaService,
+	service.NewStorageReconcileService,
+	service.NewImageDerivativeService,
+	service.NewFileScanService,
+	service.NewFileShareLinkService,
 )
@@ -32,6 +32,8 @@ type RoleService struct {
 
 	roleRepo   *data.RoleRepo
 	tenantRepo *data.TenantRepo
+
+	luaHooks *data.LuaHookRunner
 }
 
 func NewRoleService(
@@ -39,12 +41,14 @@ func NewRoleService(
 	authorizer *authorizer.Authorizer,
 	roleRepo *data.RoleRepo,
 	tenantRepo *data.TenantRepo,
+	luaHooks *data.LuaHookRunner,
 ) *RoleService {
 	svc := &RoleService{
 		log:        ctx.NewLoggerHelper("role/service/admin-service"),
 		authorizer: authorizer,
 		roleRepo:   roleRepo,
 		tenantRepo: tenantRepo,
+		luaHooks:   luaHooks,
 	}
 
 	svc.init()
@@ -243,6 +247,22 @@ func (s *RoleService) Update(ctx context.Context, req *permissionV1.UpdateRoleRe
 		s.log.Errorf("reset policies error: %v", err)
 	}
 
+	// 仅在有脚本时查询更新后的角色
+	if s.luaHooks.Has(data.LuaHookAfterRoleUpdate) {
+		updated, getErr := s.roleRepo.Get(ctx, &permissionV1.GetRoleRequest{
+			QueryBy: &permissionV1.GetRoleRequest_Id{Id: req.GetId()},
+		})
+		if getErr != nil {
+			s.log.Warnf("get updated role [%d] for lua hook failed: %v", req.GetId(), getErr)
+		} else {
+			s.luaHooks.After(ctx, &data.LuaHookEvent{
+				Hook:    data.LuaHookAfterRoleUpdate,
+				Payload: updated,
+				Fields:  map[string]any{"update_mask": req.GetUpdateMask().GetPaths()},
+			})
+		}
+	}
+
 	return &emptypb.Empty{}, nil
 }
 
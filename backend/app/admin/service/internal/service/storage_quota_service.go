@@ -0,0 +1,529 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/log"
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
+	storageV1 "go-wind-admin/api/gen/go/storage/service/v1"
+
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+	"go-wind-admin/pkg/middleware/auth"
+	"go-wind-admin/pkg/task"
+)
+
+const (
+	// storageQuotaWarnPercent 用量达到该百分比时提醒即将用尽
+	storageQuotaWarnPercent = 80
+	// storageQuotaFullPercent 用量达到该百分比时提醒已用尽
+	storageQuotaFullPercent = 100
+
+	storageUsageReconcileBatchSize = 500
+)
+
+// storageUsageKey 用量统计的维度，userID 为 0 表示租户整体
+type storageUsageKey struct {
+	tenantID uint32
+	userID   uint32
+}
+
+// storageUsageTotal 对账时累计的用量
+type storageUsageTotal struct {
+	bytes int64
+	files int64
+}
+
+// StorageQuotaService 租户与用户的存储配额、用量统计与对账
+type StorageQuotaService struct {
+	adminV1.StorageQuotaServiceHTTPServer
+
+	log *log.Helper
+
+	taskScheduler TaskScheduler
+
+	quotaRepo  *data.StorageQuotaRepo
+	tenantRepo *data.TenantRepo
+	fileRepo   *data.FileRepo
+	storages   *data.ObjectStorageRouter
+
+	internalMessageService *InternalMessageService
+}
+
+func NewStorageQuotaService(
+	ctx *bootstrap.Context,
+	quotaRepo *data.StorageQuotaRepo,
+	tenantRepo *data.TenantRepo,
+	fileRepo *data.FileRepo,
+	storages *data.ObjectStorageRouter,
+	internalMessageService *InternalMessageService,
+) *StorageQuotaService {
+	return &StorageQuotaService{
+		log:                    ctx.NewLoggerHelper("storage-quota/service/admin-service"),
+		quotaRepo:              quotaRepo,
+		tenantRepo:             tenantRepo,
+		fileRepo:               fileRepo,
+		storages:               storages,
+		internalMessageService: internalMessageService,
+	}
+}
+
+func (s *StorageQuotaService) RegisterTaskScheduler(taskScheduler TaskScheduler) {
+	s.taskScheduler = taskScheduler
+}
+
+func (s *StorageQuotaService) ListQuota(ctx context.Context, req *paginationV1.PagingRequest) (*storageV1.ListStorageQuotaResponse, error) {
+	return s.quotaRepo.List(ctx, req)
+}
+
+// SetQuota 设置配额，租户管理员只能设置本租户用户的配额，租户整体的配额由平台设置
+func (s *StorageQuotaService) SetQuota(ctx context.Context, req *storageV1.SetStorageQuotaRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+	if req.GetMaxBytes() < 0 || req.GetMaxFiles() < 0 {
+		return nil, adminV1.ErrorBadRequest("quota must not be negative")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tenantID := req.GetTenantId()
+	if operator.GetTenantId() > 0 {
+		if req.GetUserId() == 0 {
+			return nil, adminV1.ErrorForbidden("tenant quota can only be set by platform")
+		}
+		tenantID = operator.GetTenantId()
+	}
+
+	quota, err := s.quotaRepo.SetLimits(ctx, tenantID, req.GetUserId(), req.MaxBytes, req.MaxFiles, operator.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	// 调整上限后重新判断是否越过提醒阈值
+	s.checkThreshold(ctx, quota)
+
+	return &emptypb.Empty{}, nil
+}
+
+// DeleteQuota 清除配额设置，恢复为订阅套餐的默认配额
+func (s *StorageQuotaService) DeleteQuota(ctx context.Context, req *storageV1.DeleteStorageQuotaRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	quota, err := s.quotaRepo.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if operator.GetTenantId() > 0 && (quota.GetTenantId() != operator.GetTenantId() || quota.GetUserId() == 0) {
+		return nil, adminV1.ErrorForbidden("tenant quota can only be changed by platform")
+	}
+
+	if err = s.quotaRepo.ClearLimits(ctx, req.GetId(), operator.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetStorageUsage 查询租户整体与用户的存储用量，租户用户只能查询本租户
+func (s *StorageQuotaService) GetStorageUsage(ctx context.Context, req *storageV1.GetStorageUsageRequest) (*storageV1.GetStorageUsageResponse, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tenantID := operator.GetTenantId()
+	userID := operator.GetUserId()
+	if tenantID == 0 && req.TenantId != nil {
+		tenantID = req.GetTenantId()
+		userID = 0
+	}
+	if req.UserId != nil {
+		userID = req.GetUserId()
+	}
+
+	resp := &storageV1.GetStorageUsageResponse{}
+	if resp.Tenant, err = s.usage(ctx, tenantID, 0); err != nil {
+		return nil, err
+	}
+	if userID != 0 {
+		if resp.User, err = s.usage(ctx, tenantID, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
+
+// ReconcileStorageUsage 立即投递一次用量对账任务
+func (s *StorageQuotaService) ReconcileStorageUsage(ctx context.Context, req *storageV1.ReconcileStorageUsageRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+	if s.taskScheduler == nil {
+		return nil, adminV1.ErrorServiceUnavailable("task scheduler is not available")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	taskData := &task.StorageUsageReconcileTaskData{
+		TenantID: req.TenantId,
+	}
+	// 租户管理员只能对账本租户
+	if operator.GetTenantId() > 0 {
+		taskData.TenantID = trans.Ptr(operator.GetTenantId())
+	}
+
+	if err = s.taskScheduler.NewTask(task.StorageUsageReconcileTaskType, taskData); err != nil {
+		s.log.Errorf("enqueue storage usage reconcile task failed: %s", err.Error())
+		return nil, adminV1.ErrorInternalServerError("enqueue storage usage reconcile task failed")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// CheckQuota 上传前检查租户整体与用户的配额是否足够再存放 size 字节的一个文件
+func (s *StorageQuotaService) CheckQuota(ctx context.Context, tenantID, userID uint32, size int64) error {
+	userIDs := []uint32{0}
+	if userID != 0 {
+		userIDs = append(userIDs, userID)
+	}
+
+	for _, uid := range userIDs {
+		usage, err := s.usage(ctx, tenantID, uid)
+		if err != nil {
+			return err
+		}
+
+		if usage.GetMaxBytes() > 0 && usage.GetUsedBytes()+max(size, 0) > usage.GetMaxBytes() {
+			return storageV1.ErrorQuotaExceeded("storage quota exceeded: %s of %s used",
+				formatStorageSize(usage.GetUsedBytes()), formatStorageSize(usage.GetMaxBytes()))
+		}
+		if usage.GetMaxFiles() > 0 && usage.GetUsedFiles()+1 > usage.GetMaxFiles() {
+			return storageV1.ErrorQuotaExceeded("file count quota exceeded: %d of %d files used",
+				usage.GetUsedFiles(), usage.GetMaxFiles())
+		}
+	}
+
+	return nil
+}
+
+// RecordUsage 文件记录新增或删除后累加租户整体与用户的已用量，并在越过提醒阈值时发送站内信。
+// 文件已经写入或删除，统计失败只记录日志，由对账任务修正。
+func (s *StorageQuotaService) RecordUsage(ctx context.Context, tenantID, userID uint32, deltaBytes, deltaFiles int64) {
+	userIDs := []uint32{0}
+	if userID != 0 {
+		userIDs = append(userIDs, userID)
+	}
+
+	for _, uid := range userIDs {
+		quota, err := s.quotaRepo.AddUsage(ctx, tenantID, uid, deltaBytes, deltaFiles)
+		if err != nil {
+			s.log.Errorf("record storage usage of tenant [%d] user [%d] failed: %s", tenantID, uid, err.Error())
+			continue
+		}
+		s.checkThreshold(ctx, quota)
+	}
+}
+
+// usage 计算租户整体（userID 为 0）或用户的用量与生效的配额
+func (s *StorageQuotaService) usage(ctx context.Context, tenantID, userID uint32) (*storageV1.StorageUsage, error) {
+	quota, err := s.quotaRepo.Get(ctx, tenantID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.toUsage(ctx, tenantID, userID, quota)
+}
+
+// toUsage 单独设置的上限优先，未设置的上限使用租户订阅套餐的默认配额
+func (s *StorageQuotaService) toUsage(ctx context.Context, tenantID, userID uint32, quota *storageV1.StorageQuota) (*storageV1.StorageUsage, error) {
+	usage := &storageV1.StorageUsage{
+		TenantId: trans.Ptr(tenantID),
+		UserId:   trans.Ptr(userID),
+		Source:   storageV1.StorageUsage_QUOTA_SOURCE_UNSPECIFIED,
+	}
+
+	if quota == nil || quota.MaxBytes == nil || quota.MaxFiles == nil {
+		plan, err := s.tenantPlan(ctx, tenantID)
+		if err != nil {
+			return nil, err
+		}
+		if plan != nil {
+			usage.Source = storageV1.StorageUsage_PLAN
+			if userID == 0 {
+				usage.MaxBytes, usage.MaxFiles = plan.MaxBytes, plan.MaxFiles
+			} else {
+				usage.MaxBytes, usage.MaxFiles = plan.UserMaxBytes, plan.UserMaxFiles
+			}
+		}
+	}
+
+	if quota != nil {
+		usage.UsedBytes = max(quota.GetUsedBytes(), 0)
+		usage.UsedFiles = max(quota.GetUsedFiles(), 0)
+		if quota.MaxBytes != nil {
+			usage.MaxBytes = quota.GetMaxBytes()
+			usage.Source = storageV1.StorageUsage_DEFINED
+		}
+		if quota.MaxFiles != nil {
+			usage.MaxFiles = quota.GetMaxFiles()
+			usage.Source = storageV1.StorageUsage_DEFINED
+		}
+	}
+
+	usage.Percent = max(usagePercent(usage.UsedBytes, usage.MaxBytes), usagePercent(usage.UsedFiles, usage.MaxFiles))
+
+	return usage, nil
+}
+
+// tenantPlan 租户订阅套餐的默认配额，平台与未配置套餐的租户返回 nil
+func (s *StorageQuotaService) tenantPlan(ctx context.Context, tenantID uint32) (*data.StorageQuotaPlan, error) {
+	if tenantID == 0 {
+		return nil, nil
+	}
+
+	tenant, err := s.tenantRepo.Get(ctx, &identityV1.GetTenantRequest{QueryBy: &identityV1.GetTenantRequest_Id{Id: tenantID}})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.quotaRepo.PlanQuota(tenant.GetSubscriptionPlan()), nil
+}
+
+func usagePercent(used, limit int64) uint32 {
+	if limit <= 0 || used <= 0 {
+		return 0
+	}
+	return uint32(float64(used) * 100 / float64(limit))
+}
+
+// checkThreshold 用量越过 80%、100% 阈值时发送提醒，用量回落后重置以便再次越过时提醒
+func (s *StorageQuotaService) checkThreshold(ctx context.Context, quota *storageV1.StorageQuota) {
+	usage, err := s.toUsage(ctx, quota.GetTenantId(), quota.GetUserId(), quota)
+	if err != nil {
+		s.log.Errorf("compute storage usage of quota [%d] failed: %s", quota.GetId(), err.Error())
+		return
+	}
+
+	var threshold uint32
+	switch {
+	case usage.GetPercent() >= storageQuotaFullPercent:
+		threshold = storageQuotaFullPercent
+	case usage.GetPercent() >= storageQuotaWarnPercent:
+		threshold = storageQuotaWarnPercent
+	}
+
+	notified := quota.GetNotifiedPercent()
+	if threshold == notified {
+		return
+	}
+
+	changed, err := s.quotaRepo.MarkNotified(ctx, quota.GetId(), notified, threshold)
+	if err != nil || !changed || threshold < notified {
+		return
+	}
+
+	s.notifyThreshold(ctx, usage, threshold)
+}
+
+// notifyThreshold 通过站内信提醒用户或租户管理员
+func (s *StorageQuotaService) notifyThreshold(ctx context.Context, usage *storageV1.StorageUsage, threshold uint32) {
+	if s.internalMessageService == nil {
+		return
+	}
+
+	recipient := usage.GetUserId()
+	subject := "您"
+	if recipient == 0 {
+		if usage.GetTenantId() == 0 {
+			return
+		}
+		tenant, err := s.tenantRepo.Get(ctx, &identityV1.GetTenantRequest{QueryBy: &identityV1.GetTenantRequest_Id{Id: usage.GetTenantId()}})
+		if err != nil {
+			s.log.Errorf("get tenant [%d] failed: %s", usage.GetTenantId(), err.Error())
+			return
+		}
+		recipient = tenant.GetAdminUserId()
+		subject = fmt.Sprintf("租户「%s」", tenant.GetName())
+	}
+	if recipient == 0 {
+		return
+	}
+
+	title := "存储空间即将用尽"
+	if threshold >= storageQuotaFullPercent {
+		title = "存储空间已用尽"
+	}
+	content := fmt.Sprintf("%s的存储用量已达到配额的 %d%%：已用空间 %s / %s，文件 %d / %s 个。",
+		subject, usage.GetPercent(),
+		formatStorageSize(usage.GetUsedBytes()), formatStorageLimit(usage.GetMaxBytes(), formatStorageSize),
+		usage.GetUsedFiles(), formatStorageLimit(usage.GetMaxFiles(), func(v int64) string { return fmt.Sprintf("%d", v) }),
+	)
+	if threshold >= storageQuotaFullPercent {
+		content += "超出配额后将无法继续上传文件，请清理文件或联系管理员提升配额。"
+	}
+
+	if err := s.internalMessageService.SendSystemNotification(ctx, recipient, title, content); err != nil {
+		s.log.Errorf("notify storage quota of tenant [%d] user [%d] failed: %s", usage.GetTenantId(), usage.GetUserId(), err.Error())
+	}
+}
+
+func formatStorageLimit(limit int64, format func(int64) string) string {
+	if limit <= 0 {
+		return "不限"
+	}
+	return format(limit)
+}
+
+// formatStorageSize 返回格式化后的文本，例如 "512B", "1.5MB"
+func formatStorageSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%dB", size)
+	}
+
+	value := float64(size)
+	units := []string{"KB", "MB", "GB", "TB", "PB"}
+	var i int
+	for value /= unit; value >= unit && i < len(units)-1; i++ {
+		value /= unit
+	}
+	return fmt.Sprintf("%.1f%s", value, units[i])
+}
+
+// AsyncReconcileStorageUsage 按文件记录与存储中实际的对象大小重新统计用量，
+// 修正增量统计的偏差（如统计失败、对象被外部删除），并覆盖已有配额记录的用量
+func (s *StorageQuotaService) AsyncReconcileStorageUsage(ctx context.Context, taskType string, taskData *task.StorageUsageReconcileTaskData) error {
+	s.log.Infof("AsyncReconcileStorageUsage [%s] [%+v]", taskType, taskData)
+
+	ctx = appViewer.NewSystemViewerContext(ctx)
+
+	var onlyTenant *uint32
+	if taskData != nil {
+		onlyTenant = taskData.TenantID
+	}
+
+	totals := make(map[storageUsageKey]*storageUsageTotal)
+	add := func(key storageUsageKey, size int64) {
+		total, ok := totals[key]
+		if !ok {
+			total = &storageUsageTotal{}
+			totals[key] = total
+		}
+		total.bytes += size
+		total.files++
+	}
+
+	listings := make(map[string]map[string]int64)
+	var missing int
+
+	var afterID uint32
+	for {
+		files, err := s.fileRepo.ListAfter(ctx, onlyTenant, afterID, storageUsageReconcileBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(files) == 0 {
+			break
+		}
+
+		for _, f := range files {
+			afterID = f.GetId()
+
+			size, ok := s.objectSize(ctx, listings, f)
+			if !ok {
+				missing++
+				s.log.Warnf("object of file [%d] [%s/%s] is missing", f.GetId(), f.GetBucketName(), fileObjectName(f))
+			}
+
+			add(storageUsageKey{tenantID: f.GetTenantId()}, size)
+			if f.GetCreatedBy() != 0 {
+				add(storageUsageKey{tenantID: f.GetTenantId(), userID: f.GetCreatedBy()}, size)
+			}
+		}
+	}
+
+	// 已有记录但没有文件的维度清零
+	quotas, err := s.quotaRepo.ListByTenant(ctx, onlyTenant)
+	if err != nil {
+		return err
+	}
+	for _, q := range quotas {
+		key := storageUsageKey{tenantID: q.GetTenantId(), userID: q.GetUserId()}
+		if _, ok := totals[key]; !ok {
+			totals[key] = &storageUsageTotal{}
+		}
+	}
+
+	for key, total := range totals {
+		quota, err := s.quotaRepo.SetUsage(ctx, key.tenantID, key.userID, total.bytes, total.files)
+		if err != nil {
+			s.log.Errorf("reconcile storage usage of tenant [%d] user [%d] failed: %s", key.tenantID, key.userID, err.Error())
+			continue
+		}
+		s.checkThreshold(ctx, quota)
+	}
+
+	s.log.Infof("reconciled storage usage of %d quotas, %d objects missing", len(totals), missing)
+
+	return nil
+}
+
+// objectSize 文件在存储中的实际大小，对象不存在时返回 false；
+// 每个存储桶只列举一次，列举失败时使用文件记录中的大小
+func (s *StorageQuotaService) objectSize(ctx context.Context, listings map[string]map[string]int64, f *storageV1.File) (int64, bool) {
+	storage, err := s.storages.ForFile(ctx, f, f.GetTenantId())
+	if err != nil {
+		return int64(f.GetSize()), true
+	}
+
+	listingKey := storage.Provider().String() + "/" + f.GetBucketName()
+	objects, ok := listings[listingKey]
+	if !ok {
+		list, err := storage.ListObjects(ctx, f.GetBucketName(), "", true)
+		if err != nil {
+			s.log.Warnf("list objects of bucket [%s] failed, using recorded file sizes: %s", listingKey, err.Error())
+		} else {
+			objects = make(map[string]int64, len(list))
+			for _, object := range list {
+				objects[object.Key] = object.Size
+			}
+		}
+		listings[listingKey] = objects
+	}
+	if objects == nil {
+		return int64(f.GetSize()), true
+	}
+
+	size, ok := objects[fileObjectName(f)]
+	return size, ok
+}
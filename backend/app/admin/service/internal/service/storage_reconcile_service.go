@@ -0,0 +1,423 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	storageV1 "go-wind-admin/api/gen/go/storage/service/v1"
+
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+	"go-wind-admin/pkg/oss"
+	"go-wind-admin/pkg/task"
+	"go-wind-admin/pkg/tus"
+)
+
+const (
+	storageOrphanReconcileBatchSize = 500
+	storageOrphanReportTimeLayout   = "20060102T150405Z"
+
+	storageOrphanResultNone        = "none"
+	storageOrphanResultQuarantined = "quarantined"
+	storageOrphanResultDeleted     = "deleted"
+	storageOrphanResultFailed      = "failed"
+)
+
+// storageOrphanReport 对账报告，每次执行都会上传到存储对账报告存储桶
+type storageOrphanReport struct {
+	StartedAt  time.Time `json:"started_at"`
+	FinishedAt time.Time `json:"finished_at"`
+	DryRun     bool      `json:"dry_run"`
+	Action     string    `json:"action"`
+	GraceHours uint32    `json:"grace_hours"`
+
+	Buckets  []*storageOrphanBucketReport `json:"buckets"`
+	Orphans  []*storageOrphanObject       `json:"orphans"`
+	Dangling []*storageDanglingFile       `json:"dangling"`
+}
+
+// storageOrphanBucketReport 单个存储桶的对账结果
+type storageOrphanBucketReport struct {
+	Provider   string `json:"provider"`
+	Bucket     string `json:"bucket"`
+	Objects    int    `json:"objects"`
+	Referenced int    `json:"referenced"`
+	Orphans    int    `json:"orphans"`
+	Recent     int    `json:"recent"`
+	Dangling   int    `json:"dangling"`
+	Error      string `json:"error,omitempty"`
+}
+
+// storageOrphanObject 没有文件记录引用的对象
+type storageOrphanObject struct {
+	Provider         string    `json:"provider"`
+	Bucket           string    `json:"bucket"`
+	Object           string    `json:"object"`
+	Size             int64     `json:"size"`
+	LastModified     time.Time `json:"last_modified"`
+	Result           string    `json:"result"`
+	QuarantineObject string    `json:"quarantine_object,omitempty"`
+	Error            string    `json:"error,omitempty"`
+}
+
+// storageDanglingFile 对象已不存在的文件记录，只报告不删除
+type storageDanglingFile struct {
+	FileID   uint32 `json:"file_id"`
+	TenantID uint32 `json:"tenant_id"`
+	Provider string `json:"provider"`
+	Bucket   string `json:"bucket"`
+	Object   string `json:"object"`
+}
+
+// storageFileRef 文件记录对对象的引用
+type storageFileRef struct {
+	fileID   uint32
+	tenantID uint32
+	seen     bool
+}
+
+// StorageReconcileService 文件记录与对象存储的对账任务
+type StorageReconcileService struct {
+	log *log.Helper
+
+	storages *data.ObjectStorageRouter
+	fileRepo *data.FileRepo
+}
+
+func NewStorageReconcileService(
+	ctx *bootstrap.Context,
+	storages *data.ObjectStorageRouter,
+	fileRepo *data.FileRepo,
+) *StorageReconcileService {
+	return &StorageReconcileService{
+		log:      ctx.NewLoggerHelper("storage-reconcile/service/admin-service"),
+		storages: storages,
+		fileRepo: fileRepo,
+	}
+}
+
+// AsyncReconcileOrphans 逐个存储桶比对对象与文件记录：
+// 超过宽限期且没有文件记录的对象按设置隔离或删除，对象已不存在的文件记录写入报告
+func (s *StorageReconcileService) AsyncReconcileOrphans(ctx context.Context, _ string, taskData *task.StorageOrphanReconcileTaskData) error {
+	l := task.LoggerFromContext(ctx, s.log)
+
+	ctx = appViewer.NewSystemViewerContext(ctx)
+
+	if taskData == nil {
+		taskData = &task.StorageOrphanReconcileTaskData{}
+	}
+
+	action := strings.ToLower(strings.TrimSpace(taskData.Action))
+	switch action {
+	case "":
+		action = task.StorageOrphanActionQuarantine
+	case task.StorageOrphanActionQuarantine, task.StorageOrphanActionDelete:
+	default:
+		return storageV1.ErrorBadRequest("unknown orphan action [%s]", taskData.Action)
+	}
+
+	graceHours := taskData.GraceHours
+	if graceHours == 0 {
+		graceHours = task.DefaultStorageOrphanGraceHours
+	}
+
+	report := &storageOrphanReport{
+		StartedAt:  time.Now().UTC(),
+		DryRun:     taskData.DryRun,
+		Action:     action,
+		GraceHours: graceHours,
+		Orphans:    []*storageOrphanObject{},
+		Dangling:   []*storageDanglingFile{},
+	}
+	cutoff := report.StartedAt.Add(-time.Duration(graceHours) * time.Hour)
+
+	progress := task.ProgressFromContext(ctx)
+	progress.Report(0, "scan", "loading file records")
+
+	refs, err := s.loadFileRefs(ctx)
+	if err != nil {
+		l.Errorf("load file records failed: %s", err.Error())
+		return err
+	}
+
+	type target struct {
+		provider storageV1.OSSProvider
+		bucket   string
+	}
+	var targets []target
+	for _, provider := range s.storages.Providers() {
+		for _, bucket := range s.reconcileBuckets(taskData.Buckets, refs[provider]) {
+			targets = append(targets, target{provider: provider, bucket: bucket})
+		}
+	}
+
+	for i, t := range targets {
+		progress.Report(float64(i)*90/float64(len(targets)), "reconcile",
+			fmt.Sprintf("reconciling %s/%s", t.provider.String(), t.bucket))
+
+		storage, err := s.storages.Provider(t.provider)
+		if err != nil {
+			return err
+		}
+		report.Buckets = append(report.Buckets,
+			s.reconcileBucket(ctx, l, storage, t.bucket, refs[t.provider][t.bucket], cutoff, taskData.DryRun, action, report))
+	}
+
+	report.FinishedAt = time.Now().UTC()
+
+	progress.Report(95, "report", "uploading report")
+
+	reportObject, err := s.uploadReport(ctx, report)
+	if err != nil {
+		l.Errorf("upload orphan reconcile report failed: %s", err.Error())
+		return err
+	}
+
+	var handled, failed int
+	for _, o := range report.Orphans {
+		switch o.Result {
+		case storageOrphanResultQuarantined, storageOrphanResultDeleted:
+			handled++
+		case storageOrphanResultFailed:
+			failed++
+		}
+	}
+
+	l.Infof("reconciled %d buckets: %d orphan objects (%d %s, %d failed), %d dangling file records, report %s/%s",
+		len(report.Buckets), len(report.Orphans), handled, action, failed, len(report.Dangling), oss.BucketStorageReports, reportObject)
+	if len(report.Dangling) > 0 {
+		l.Warnf("%d file records point to missing objects, see report %s/%s", len(report.Dangling), oss.BucketStorageReports, reportObject)
+	}
+
+	task.SetResult(ctx, map[string]any{
+		"report":   reportObject,
+		"dry_run":  report.DryRun,
+		"action":   action,
+		"buckets":  len(report.Buckets),
+		"orphans":  len(report.Orphans),
+		"handled":  handled,
+		"failed":   failed,
+		"dangling": len(report.Dangling),
+	})
+
+	return nil
+}
+
+// loadFileRefs 按存储提供商、存储桶汇总全部文件记录引用的对象
+func (s *StorageReconcileService) loadFileRefs(ctx context.Context) (map[storageV1.OSSProvider]map[string]map[string]*storageFileRef, error) {
+	refs := make(map[storageV1.OSSProvider]map[string]map[string]*storageFileRef)
+
+	var afterID uint32
+	for {
+		files, err := s.fileRepo.ListAfter(ctx, nil, afterID, storageOrphanReconcileBatchSize)
+		if err != nil {
+			return nil, err
+		}
+		if len(files) == 0 {
+			return refs, nil
+		}
+
+		for _, f := range files {
+			afterID = f.GetId()
+
+			storage, err := s.storages.ForFile(ctx, f, f.GetTenantId())
+			if err != nil {
+				s.log.Warnf("resolve storage of file [%d] failed: %s", f.GetId(), err.Error())
+				continue
+			}
+
+			buckets, ok := refs[storage.Provider()]
+			if !ok {
+				buckets = make(map[string]map[string]*storageFileRef)
+				refs[storage.Provider()] = buckets
+			}
+			objects, ok := buckets[f.GetBucketName()]
+			if !ok {
+				objects = make(map[string]*storageFileRef)
+				buckets[f.GetBucketName()] = objects
+			}
+			objects[fileObjectName(f)] = &storageFileRef{fileID: f.GetId(), tenantID: f.GetTenantId()}
+		}
+	}
+}
+
+// reconcileBuckets 需要对账的存储桶，未指定时为文件上传使用的存储桶及文件记录中出现的存储桶。
+// 检出恶意内容的文件记录指向隔离存储桶，隔离存储桶只在明确指定时对账。
+func (s *StorageReconcileService) reconcileBuckets(buckets []string, refs map[string]map[string]*storageFileRef) []string {
+	if len(buckets) > 0 {
+		return buckets
+	}
+
+	result := slices.Clone(oss.UserBuckets)
+	for bucket := range refs {
+		if bucket != "" && bucket != oss.BucketQuarantine && !slices.Contains(result, bucket) {
+			result = append(result, bucket)
+		}
+	}
+	slices.Sort(result)
+	return result
+}
+
+// reconcileBucket 对账单个存储桶，列举失败时不判断孤立对象与失效记录
+func (s *StorageReconcileService) reconcileBucket(
+	ctx context.Context,
+	l *log.Helper,
+	storage oss.ObjectStorage,
+	bucket string,
+	refs map[string]*storageFileRef,
+	cutoff time.Time,
+	dryRun bool,
+	action string,
+	report *storageOrphanReport,
+) *storageOrphanBucketReport {
+	provider := storage.Provider().String()
+	result := &storageOrphanBucketReport{Provider: provider, Bucket: bucket}
+
+	objects, err := storage.ListObjects(ctx, bucket, "", true)
+	if err != nil {
+		l.Warnf("list objects of bucket [%s/%s] failed: %s", provider, bucket, err.Error())
+		result.Error = err.Error()
+		return result
+	}
+
+	for _, object := range objects {
+		// 断点续传的临时对象由上传过期清理负责
+		if strings.HasPrefix(object.Key, tus.TailObjectPrefix) {
+			continue
+		}
+
+		result.Objects++
+
+		if ref, ok := refs[object.Key]; ok {
+			ref.seen = true
+			result.Referenced++
+			continue
+		}
+
+		// 刚写入的对象可能还没有登记文件记录（预签名上传、并发上传）
+		if object.LastModified.After(cutoff) {
+			result.Recent++
+			continue
+		}
+
+		result.Orphans++
+		orphan := &storageOrphanObject{
+			Provider:     provider,
+			Bucket:       bucket,
+			Object:       object.Key,
+			Size:         object.Size,
+			LastModified: object.LastModified,
+			Result:       storageOrphanResultNone,
+		}
+		if !dryRun {
+			s.handleOrphan(ctx, l, storage, object, action, orphan)
+		}
+		report.Orphans = append(report.Orphans, orphan)
+	}
+
+	for objectName, ref := range refs {
+		if ref.seen {
+			continue
+		}
+		result.Dangling++
+		report.Dangling = append(report.Dangling, &storageDanglingFile{
+			FileID:   ref.fileID,
+			TenantID: ref.tenantID,
+			Provider: provider,
+			Bucket:   bucket,
+			Object:   objectName,
+		})
+	}
+
+	return result
+}
+
+// handleOrphan 隔离或删除孤立对象，隔离时复制到隔离存储桶的 <bucket>/<object> 后删除原对象
+func (s *StorageReconcileService) handleOrphan(
+	ctx context.Context,
+	l *log.Helper,
+	storage oss.ObjectStorage,
+	object oss.ObjectInfo,
+	action string,
+	orphan *storageOrphanObject,
+) {
+	fail := func(err error) {
+		l.Warnf("%s orphan object [%s/%s] failed: %s", action, orphan.Bucket, orphan.Object, err.Error())
+		orphan.Result = storageOrphanResultFailed
+		orphan.Error = err.Error()
+	}
+
+	if action == task.StorageOrphanActionQuarantine {
+		quarantineObject := orphan.Bucket + "/" + orphan.Object
+		if err := copyObject(ctx, storage, object, oss.BucketQuarantine, quarantineObject); err != nil {
+			fail(err)
+			return
+		}
+		orphan.QuarantineObject = quarantineObject
+	}
+
+	if err := storage.DeleteFile(ctx, orphan.Bucket, orphan.Object); err != nil {
+		fail(err)
+		return
+	}
+
+	if action == task.StorageOrphanActionQuarantine {
+		orphan.Result = storageOrphanResultQuarantined
+	} else {
+		orphan.Result = storageOrphanResultDeleted
+	}
+}
+
+// copyObject 在同一存储后端内复制对象
+func copyObject(ctx context.Context, storage oss.ObjectStorage, object oss.ObjectInfo, bucketName, objectName string) error {
+	if err := storage.EnsureBucketExists(ctx, bucketName); err != nil {
+		return err
+	}
+
+	reader, err := storage.GetObject(ctx, object.Bucket, object.Key)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	contentType := object.ContentType
+	if contentType == "" {
+		contentType = oss.DefaultContentType
+	}
+
+	_, err = storage.UploadStream(ctx, bucketName, objectName, contentType, reader, object.Size)
+	return err
+}
+
+// uploadReport 上传对账报告到平台默认存储，返回报告的对象名
+func (s *StorageReconcileService) uploadReport(ctx context.Context, report *storageOrphanReport) (string, error) {
+	body, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	objectName := "orphans/" + report.StartedAt.Format(storageOrphanReportTimeLayout)
+	if report.DryRun {
+		objectName += ".dry-run"
+	}
+	objectName += ".json"
+
+	storage := s.storages.Default()
+	if err = storage.EnsureBucketExists(ctx, oss.BucketStorageReports); err != nil {
+		return "", err
+	}
+	if _, err = storage.UploadStream(ctx, oss.BucketStorageReports, objectName, "application/json",
+		strings.NewReader(string(body)), int64(len(body))); err != nil {
+		return "", err
+	}
+
+	return objectName, nil
+}
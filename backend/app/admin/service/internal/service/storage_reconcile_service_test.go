@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go-wind-admin/pkg/oss"
+	"go-wind-admin/pkg/task"
+)
+
+func newReconcileTestStorage(t *testing.T, objects ...string) *oss.LocalStorage {
+	storage, err := oss.NewLocalStorage(t.TempDir(), "http://localhost/admin/v1/storage/local", []byte("secret"), log.DefaultLogger)
+	require.NoError(t, err)
+
+	for _, object := range objects {
+		_, err = storage.UploadStream(context.Background(), oss.BucketFiles, object, "text/plain", strings.NewReader(object), int64(len(object)))
+		require.NoError(t, err)
+	}
+	return storage
+}
+
+func TestStorageReconcileService_reconcileBucket(t *testing.T) {
+	ctx := context.Background()
+	s := &StorageReconcileService{log: log.NewHelper(log.DefaultLogger)}
+
+	storage := newReconcileTestStorage(t, "a/kept.txt", "a/orphan.txt", ".tus/upload.part")
+	refs := map[string]*storageFileRef{
+		"a/kept.txt":    {fileID: 1, tenantID: 2},
+		"a/missing.txt": {fileID: 3, tenantID: 2},
+	}
+
+	report := &storageOrphanReport{}
+	result := s.reconcileBucket(ctx, s.log, storage, oss.BucketFiles, refs, time.Now().Add(time.Hour), false, task.StorageOrphanActionQuarantine, report)
+
+	assert.Equal(t, 2, result.Objects)
+	assert.Equal(t, 1, result.Referenced)
+	assert.Equal(t, 1, result.Orphans)
+	assert.Equal(t, 1, result.Dangling)
+
+	require.Len(t, report.Orphans, 1)
+	assert.Equal(t, "a/orphan.txt", report.Orphans[0].Object)
+	assert.Equal(t, storageOrphanResultQuarantined, report.Orphans[0].Result)
+	assert.Equal(t, oss.BucketFiles+"/a/orphan.txt", report.Orphans[0].QuarantineObject)
+
+	require.Len(t, report.Dangling, 1)
+	assert.Equal(t, uint32(3), report.Dangling[0].FileID)
+
+	_, err := storage.StatObject(ctx, oss.BucketFiles, "a/orphan.txt")
+	assert.Error(t, err)
+	_, err = storage.StatObject(ctx, oss.BucketQuarantine, oss.BucketFiles+"/a/orphan.txt")
+	assert.NoError(t, err)
+	_, err = storage.StatObject(ctx, oss.BucketFiles, ".tus/upload.part")
+	assert.NoError(t, err)
+}
+
+func TestStorageReconcileService_reconcileBucketDryRunAndGrace(t *testing.T) {
+	ctx := context.Background()
+	s := &StorageReconcileService{log: log.NewHelper(log.DefaultLogger)}
+
+	storage := newReconcileTestStorage(t, "orphan.txt")
+
+	// 宽限期内的对象不处理
+	report := &storageOrphanReport{}
+	result := s.reconcileBucket(ctx, s.log, storage, oss.BucketFiles, nil, time.Now().Add(-time.Hour), false, task.StorageOrphanActionDelete, report)
+	assert.Equal(t, 1, result.Recent)
+	assert.Empty(t, report.Orphans)
+
+	// 试运行只报告
+	report = &storageOrphanReport{}
+	result = s.reconcileBucket(ctx, s.log, storage, oss.BucketFiles, nil, time.Now().Add(time.Hour), true, task.StorageOrphanActionDelete, report)
+	assert.Equal(t, 1, result.Orphans)
+	require.Len(t, report.Orphans, 1)
+	assert.Equal(t, storageOrphanResultNone, report.Orphans[0].Result)
+
+	_, err := storage.StatObject(ctx, oss.BucketFiles, "orphan.txt")
+	assert.NoError(t, err)
+
+	report = &storageOrphanReport{}
+	s.reconcileBucket(ctx, s.log, storage, oss.BucketFiles, nil, time.Now().Add(time.Hour), false, task.StorageOrphanActionDelete, report)
+	require.Len(t, report.Orphans, 1)
+	assert.Equal(t, storageOrphanResultDeleted, report.Orphans[0].Result)
+
+	_, err = storage.StatObject(ctx, oss.BucketFiles, "orphan.txt")
+	assert.Error(t, err)
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/go-utils/id"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"github.com/tx7do/kratos-transport/transport/sse"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+
+	"go-wind-admin/pkg/task"
+)
+
+const (
+	// taskProgressStreamPrefix 任务进度流ID前缀，流ID为 task:<asynq任务ID>
+	taskProgressStreamPrefix = "task:"
+
+	// taskProgressStreamKey SSE请求中指定流ID的查询参数
+	taskProgressStreamKey = "stream"
+
+	// taskProgressEvent 任务进度事件名称
+	taskProgressEvent = "task_progress"
+)
+
+type TaskProgressPublisher interface {
+	Publish(ctx context.Context, streamId sse.StreamID, event *sse.Event)
+}
+
+// TaskProgressService 通过SSE向订阅者推送任务执行进度
+type TaskProgressService struct {
+	log *log.Helper
+
+	broker   *data.TaskProgressBroker
+	taskRepo *data.TaskRepo
+
+	authenticator *data.Authenticator
+	clientType    authenticationV1.ClientType
+
+	publisher TaskProgressPublisher
+}
+
+func NewTaskProgressService(
+	ctx *bootstrap.Context,
+	broker *data.TaskProgressBroker,
+	taskRepo *data.TaskRepo,
+	authenticator *data.Authenticator,
+	clientType authenticationV1.ClientType,
+) *TaskProgressService {
+	svc := &TaskProgressService{
+		log:           ctx.NewLoggerHelper("task-progress/service/admin-service"),
+		broker:        broker,
+		taskRepo:      taskRepo,
+		authenticator: authenticator,
+		clientType:    clientType,
+	}
+
+	broker.OnProgress(svc.publish)
+
+	return svc
+}
+
+func (s *TaskProgressService) RegisterTaskProgressPublisher(publisher TaskProgressPublisher) {
+	s.publisher = publisher
+}
+
+// TaskProgressStreamID 任务进度的SSE流ID
+func TaskProgressStreamID(taskID string) string {
+	return taskProgressStreamPrefix + taskID
+}
+
+// IsTaskProgressStream 是否为任务进度流
+func IsTaskProgressStream(streamID string) bool {
+	return strings.HasPrefix(streamID, taskProgressStreamPrefix)
+}
+
+// HandleAuthorize 校验访问令牌，平台用户可订阅所有任务，租户用户只能订阅本租户定义的任务类型
+func (s *TaskProgressService) HandleAuthorize(r *http.Request, token string) error {
+	payload, err := authenticateStreamToken(s.log, s.authenticator, s.clientType, token)
+	if err != nil {
+		return err
+	}
+
+	if payload.GetTenantId() == 0 {
+		return nil
+	}
+
+	taskID := strings.TrimPrefix(r.URL.Query().Get(taskProgressStreamKey), taskProgressStreamPrefix)
+
+	p, err := s.broker.LatestProgress(r.Context(), taskID)
+	if err != nil {
+		return err
+	}
+	if p == nil {
+		return fmt.Errorf("%w: task [%s] has not started", sse.ErrForbidden, taskID)
+	}
+
+	exist, err := s.taskRepo.TypeNameExistsInTenant(r.Context(), payload.GetTenantId(), p.TaskType)
+	if err != nil {
+		return err
+	}
+	if !exist {
+		s.log.Warnf("user [%d] is not allowed to subscribe task [%s]", payload.GetUserId(), taskID)
+		return fmt.Errorf("%w: task progress access denied", sse.ErrForbidden)
+	}
+
+	return nil
+}
+
+// HandleSubscribe 订阅者连接后推送最新的进度快照，使迟到的客户端能够追上进度
+func (s *TaskProgressService) HandleSubscribe(streamID sse.StreamID, _ *sse.Subscriber) {
+	taskID := strings.TrimPrefix(string(streamID), taskProgressStreamPrefix)
+
+	p, err := s.broker.LatestProgress(context.Background(), taskID)
+	if err != nil {
+		s.log.Errorf("load task [%s] progress failed: %s", taskID, err.Error())
+		return
+	}
+	if p == nil {
+		return
+	}
+
+	s.publish(p)
+}
+
+func (s *TaskProgressService) publish(p *task.Progress) {
+	if s.publisher == nil || p == nil || p.TaskID == "" {
+		return
+	}
+
+	data, err := json.Marshal(p)
+	if err != nil {
+		s.log.Errorf("marshal task progress failed: %s", err.Error())
+		return
+	}
+
+	s.publisher.Publish(context.Background(), sse.StreamID(TaskProgressStreamID(p.TaskID)), &sse.Event{
+		ID:    []byte(id.NewGUIDv4(false)),
+		Data:  data,
+		Event: []byte(taskProgressEvent),
+	})
+}
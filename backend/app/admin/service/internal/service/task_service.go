@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/hibiken/asynq"
@@ -11,50 +13,69 @@ import (
 	"github.com/tx7do/go-utils/trans"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"go-wind-admin/app/admin/service/internal/data"
 
 	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
 	taskV1 "go-wind-admin/api/gen/go/task/service/v1"
 
+	appViewer "go-wind-admin/pkg/entgo/viewer"
 	"go-wind-admin/pkg/middleware/auth"
 	"go-wind-admin/pkg/task"
 )
 
-// TaskScheduler 任务调度接口
+// TaskScheduler 任务调度接口，周期任务由 task.PeriodicScheduler 在集群主节点上入队
 type TaskScheduler interface {
 	TaskTypeExists(taskType string) bool
 	GetRegisteredTaskTypes() []string
 
 	NewTask(typeName string, msg any, opts ...asynq.Option) error
 	NewWaitResultTask(typeName string, msg any, opts ...asynq.Option) error
-	NewPeriodicTask(cronSpec, typeName string, msg any, opts ...asynq.Option) (string, error)
-
-	RemovePeriodicTask(id string) error
-	RemoveAllPeriodicTask()
 }
 
+const (
+	// builtinTaskRunCleanupEntryID 内置的执行记录清理周期任务
+	builtinTaskRunCleanupEntryID = "builtin:" + task.TaskRunCleanupTaskType
+
+	// defaultNextRunTimesCount 预览下次执行时间的默认数量
+	defaultNextRunTimesCount = 5
+)
+
 // TaskService 任务服务
 type TaskService struct {
 	adminV1.TaskServiceHTTPServer
 
 	log *log.Helper
 
-	taskScheduler TaskScheduler
+	taskScheduler     TaskScheduler
+	periodicScheduler *task.PeriodicScheduler
+
+	userRepo    data.UserRepo
+	taskRepo    *data.TaskRepo
+	taskRunRepo *data.TaskRunRepo
 
-	userRepo data.UserRepo
-	taskRepo *data.TaskRepo
+	luaTaskService      *LuaTaskService
+	taskWorkflowService *TaskWorkflowService
 }
 
 func NewTaskService(
 	ctx *bootstrap.Context,
 	taskRepo *data.TaskRepo,
+	taskRunRepo *data.TaskRunRepo,
 	userRepo data.UserRepo,
+	luaTaskService *LuaTaskService,
+	taskWorkflowService *TaskWorkflowService,
+	periodicScheduler *task.PeriodicScheduler,
 ) *TaskService {
 	svc := &TaskService{
-		log:      ctx.NewLoggerHelper("task/service/admin-service"),
-		taskRepo: taskRepo,
-		userRepo: userRepo,
+		log:                 ctx.NewLoggerHelper("task/service/admin-service"),
+		taskRepo:            taskRepo,
+		taskRunRepo:         taskRunRepo,
+		userRepo:            userRepo,
+		luaTaskService:      luaTaskService,
+		taskWorkflowService: taskWorkflowService,
+		periodicScheduler:   periodicScheduler,
 	}
 
 	return svc
@@ -62,6 +83,9 @@ func NewTaskService(
 
 func (s *TaskService) RegisterTaskScheduler(taskScheduler TaskScheduler) {
 	s.taskScheduler = taskScheduler
+
+	// 周期任务由集群主节点通过该调度器入队
+	s.periodicScheduler.SetEnqueuer(taskScheduler)
 }
 
 func (s *TaskService) List(ctx context.Context, req *paginationV1.PagingRequest) (*taskV1.ListTaskResponse, error) {
@@ -90,6 +114,10 @@ func (s *TaskService) Create(ctx context.Context, req *taskV1.CreateTaskRequest)
 		return nil, err
 	}
 
+	if err = s.validateSchedule(req.Data, true); err != nil {
+		return nil, err
+	}
+
 	req.Data.CreatedBy = trans.Ptr(operator.UserId)
 
 	var t *taskV1.Task
@@ -115,6 +143,10 @@ func (s *TaskService) Update(ctx context.Context, req *taskV1.UpdateTaskRequest)
 		return nil, err
 	}
 
+	if err = s.validateSchedule(req.Data, false); err != nil {
+		return nil, err
+	}
+
 	req.Data.Id = trans.Ptr(req.GetId())
 
 	req.Data.UpdatedBy = trans.Ptr(operator.UserId)
@@ -128,6 +160,11 @@ func (s *TaskService) Update(ctx context.Context, req *taskV1.UpdateTaskRequest)
 		return nil, err
 	}
 
+	// 禁用后移除已注册的周期任务
+	if !t.GetEnable() && t.GetType() == taskV1.Task_PERIODIC {
+		_ = s.periodicScheduler.Remove(periodicEntryID(t))
+	}
+
 	if err = s.startTask(t); err != nil {
 		s.log.Error(err)
 	}
@@ -153,8 +190,20 @@ func (s *TaskService) Delete(ctx context.Context, req *taskV1.DeleteTaskRequest)
 	return &emptypb.Empty{}, nil
 }
 
-// ControlTask 控制调度任务
+// ControlTask 控制调度任务，指定工作流运行ID时暂停、恢复或取消该工作流运行
 func (s *TaskService) ControlTask(ctx context.Context, req *taskV1.ControlTaskRequest) (*emptypb.Empty, error) {
+	if req.WorkflowRunId != nil {
+		if err := s.taskWorkflowService.ControlRun(ctx, req.GetWorkflowRunId(), req.GetControlType()); err != nil {
+			return nil, err
+		}
+		return &emptypb.Empty{}, nil
+	}
+
+	switch req.GetControlType() {
+	case taskV1.ControlTaskRequest_Pause, taskV1.ControlTaskRequest_Resume, taskV1.ControlTaskRequest_Cancel:
+		return nil, adminV1.ErrorBadRequest("%s is only supported for workflow runs", req.GetControlType().String())
+	}
+
 	t, err := s.taskRepo.Get(ctx, &taskV1.GetTaskRequest{QueryBy: &taskV1.GetTaskRequest_TypeName{TypeName: req.GetTypeName()}})
 	if err != nil {
 		s.log.Errorf("获取任务失败[%s]", err.Error())
@@ -228,7 +277,12 @@ func (s *TaskService) startAllTask(ctx context.Context) (int32, error) {
 
 	// 重新启动任务
 	var count int32
+	var hasRunCleanup bool
 	for _, t := range resp.GetItems() {
+		if t.GetTypeName() == task.TaskRunCleanupTaskType {
+			hasRunCleanup = true
+		}
+
 		if s.startTask(t) != nil {
 			continue
 		} else {
@@ -236,6 +290,18 @@ func (s *TaskService) startAllTask(ctx context.Context) (int32, error) {
 		}
 	}
 
+	// 未配置执行记录清理任务时，使用内置的每日清理
+	if !hasRunCleanup {
+		if err = s.periodicScheduler.Add(task.PeriodicEntry{
+			ID:       builtinTaskRunCleanupEntryID,
+			CronSpec: "@daily",
+			TypeName: task.TaskRunCleanupTaskType,
+			Payload:  task.TaskRunCleanupTaskData{},
+		}); err != nil {
+			s.log.Errorf("[%s] 创建定时任务失败[%s]", task.TaskRunCleanupTaskType, err.Error())
+		}
+	}
+
 	s.log.Infof("总共成功开启定时任务[%d]个", count)
 
 	return count, nil
@@ -246,7 +312,7 @@ func (s *TaskService) stopAllTask() {
 	s.log.Infof("开始清除所有的定时任务...")
 
 	// 清除所有的定时任务
-	s.taskScheduler.RemoveAllPeriodicTask()
+	s.periodicScheduler.RemoveAll()
 
 	s.log.Infof("完成清除所有的定时任务")
 }
@@ -263,7 +329,7 @@ func (s *TaskService) stopTask(t *taskV1.Task) error {
 
 	switch t.GetType() {
 	case taskV1.Task_PERIODIC:
-		return s.taskScheduler.RemovePeriodicTask(t.GetTypeName())
+		return s.periodicScheduler.Remove(periodicEntryID(t))
 
 	case taskV1.Task_DELAY:
 
@@ -283,6 +349,11 @@ func (s *TaskService) convertTaskOption(t *taskV1.Task) (opts []asynq.Option, pa
 		_ = json.Unmarshal([]byte(t.GetTaskPayload()), &payload)
 	}
 
+	// Lua 任务处理器声明的选项作为默认值，任务自身的选项优先
+	if s.luaTaskService != nil {
+		opts = append(opts, s.luaTaskService.TaskOptions(t.GetTypeName())...)
+	}
+
 	if t.TaskOptions != nil {
 		if t.GetTaskOptions().GetMaxRetry() > 0 {
 			opts = append(opts, asynq.MaxRetry(int(t.GetTaskOptions().GetMaxRetry())))
@@ -326,27 +397,39 @@ func (s *TaskService) startTask(t *taskV1.Task) error {
 		return errors.New("task is not enable")
 	}
 
-	var opts []asynq.Option
-	var payload any
 	var err error
 
+	opts, payload := s.convertTaskOption(t)
+
+	if s.luaTaskService != nil && s.luaTaskService.IsLuaTask(t.GetTypeName()) {
+		if err = s.luaTaskService.ValidatePayload(t.GetTypeName(), payload); err != nil {
+			s.log.Errorf("[%s] 任务参数校验失败[%s]", t.GetTypeName(), err.Error())
+			return err
+		}
+	}
+
 	switch t.GetType() {
 	case taskV1.Task_PERIODIC:
-		opts, payload = s.convertTaskOption(t)
-		if _, err = s.taskScheduler.NewPeriodicTask(t.GetCronSpec(), t.GetTypeName(), payload, opts...); err != nil {
+		if err = s.periodicScheduler.Add(task.PeriodicEntry{
+			ID:            periodicEntryID(t),
+			CronSpec:      t.GetCronSpec(),
+			Timezone:      t.GetTimezone(),
+			MisfirePolicy: task.MisfirePolicy(t.GetMisfirePolicy().String()),
+			TypeName:      t.GetTypeName(),
+			Payload:       payload,
+			Options:       opts,
+		}); err != nil {
 			s.log.Errorf("[%s] 创建定时任务失败[%s]", t.GetTypeName(), err.Error())
 			return err
 		}
 
 	case taskV1.Task_DELAY:
-		opts, payload = s.convertTaskOption(t)
 		if err = s.taskScheduler.NewTask(t.GetTypeName(), payload, opts...); err != nil {
 			s.log.Errorf("[%s] 创建延迟任务失败[%s]", t.GetTypeName(), err.Error())
 			return err
 		}
 
 	case taskV1.Task_WAIT_RESULT:
-		opts, payload = s.convertTaskOption(t)
 		if err = s.taskScheduler.NewWaitResultTask(t.GetTypeName(), payload, opts...); err != nil {
 			s.log.Errorf("[%s] 创建等待结果任务失败[%s]", t.GetTypeName(), err.Error())
 			return err
@@ -356,8 +439,130 @@ func (s *TaskService) startTask(t *taskV1.Task) error {
 	return nil
 }
 
-// AsyncBackup 异步备份
-func (s *TaskService) AsyncBackup(taskType string, taskData *task.BackupTaskData) error {
-	s.log.Infof("AsyncBackup [%s] [%+v] [%s]", taskType, taskData, taskData.Name)
+// ListNextRunTimes 预览周期任务的下次执行时间，指定任务ID时使用该任务的 cron 表达式与时区
+func (s *TaskService) ListNextRunTimes(ctx context.Context, req *taskV1.ListNextRunTimesRequest) (*taskV1.ListNextRunTimesResponse, error) {
+	cronSpec := req.GetCronSpec()
+	timezone := req.GetTimezone()
+
+	if req.Id != nil {
+		t, err := s.taskRepo.Get(ctx, &taskV1.GetTaskRequest{QueryBy: &taskV1.GetTaskRequest_Id{Id: req.GetId()}})
+		if err != nil {
+			return nil, err
+		}
+		if cronSpec == "" {
+			cronSpec = t.GetCronSpec()
+		}
+		if req.Timezone == nil {
+			timezone = t.GetTimezone()
+		}
+	}
+
+	if cronSpec == "" {
+		return nil, adminV1.ErrorBadRequest("cron spec is required")
+	}
+
+	count := int(req.GetCount())
+	if count == 0 {
+		count = defaultNextRunTimesCount
+	}
+
+	times, err := task.NextRunTimes(cronSpec, timezone, time.Now(), count)
+	if err != nil {
+		return nil, adminV1.ErrorBadRequest("%s", err.Error())
+	}
+
+	loc := time.Local
+	if timezone != "" {
+		if loc, err = time.LoadLocation(timezone); err != nil {
+			return nil, adminV1.ErrorBadRequest("invalid timezone")
+		}
+	}
+
+	resp := &taskV1.ListNextRunTimesResponse{
+		Timezone: loc.String(),
+	}
+	for _, t := range times {
+		resp.Times = append(resp.Times, timestamppb.New(t))
+		resp.LocalTimes = append(resp.LocalTimes, t.In(loc).Format(time.RFC3339))
+	}
+
+	return resp, nil
+}
+
+// validateSchedule 校验周期任务的 cron 表达式与时区，requireCronSpec 为真时周期任务必须设置 cron 表达式
+func (s *TaskService) validateSchedule(t *taskV1.Task, requireCronSpec bool) error {
+	if t.GetTimezone() != "" {
+		if _, err := time.LoadLocation(t.GetTimezone()); err != nil {
+			return adminV1.ErrorBadRequest("invalid timezone: %s", t.GetTimezone())
+		}
+	}
+
+	if t.GetType() != taskV1.Task_PERIODIC {
+		return nil
+	}
+
+	if t.GetCronSpec() == "" {
+		if requireCronSpec {
+			return adminV1.ErrorBadRequest("cron spec is required for periodic task")
+		}
+		return nil
+	}
+
+	if _, err := task.ParseCronSpec(t.GetCronSpec(), t.GetTimezone()); err != nil {
+		return adminV1.ErrorBadRequest("%s", err.Error())
+	}
+
+	return nil
+}
+
+// periodicEntryID 周期任务在调度器中的条目ID
+func periodicEntryID(t *taskV1.Task) string {
+	return fmt.Sprintf("task:%d", t.GetId())
+}
+
+// ListTaskRuns 查询任务执行记录列表
+func (s *TaskService) ListTaskRuns(ctx context.Context, req *paginationV1.PagingRequest) (*taskV1.ListTaskRunResponse, error) {
+	return s.taskRunRepo.List(ctx, req)
+}
+
+// GetTaskRun 查询任务执行记录详情
+func (s *TaskService) GetTaskRun(ctx context.Context, req *taskV1.GetTaskRunRequest) (*taskV1.TaskRun, error) {
+	return s.taskRunRepo.Get(ctx, req)
+}
+
+// PurgeTaskRuns 清理过期的任务执行记录
+func (s *TaskService) PurgeTaskRuns(ctx context.Context, req *taskV1.PurgeTaskRunsRequest) (*taskV1.PurgeTaskRunsResponse, error) {
+	deleted, err := s.purgeTaskRuns(ctx, req.GetRetentionDays(), req.GetTypeName())
+	if err != nil {
+		return nil, err
+	}
+
+	return &taskV1.PurgeTaskRunsResponse{
+		Deleted: deleted,
+	}, nil
+}
+
+func (s *TaskService) purgeTaskRuns(ctx context.Context, retentionDays uint32, typeName string) (uint64, error) {
+	if retentionDays == 0 {
+		retentionDays = task.DefaultTaskRunRetentionDays
+	}
+
+	before := time.Now().AddDate(0, 0, -int(retentionDays))
+	return s.taskRunRepo.PurgeBefore(ctx, before, typeName)
+}
+
+// AsyncCleanupTaskRuns 异步清理过期的任务执行记录
+func (s *TaskService) AsyncCleanupTaskRuns(ctx context.Context, _ string, taskData *task.TaskRunCleanupTaskData) error {
+	l := task.LoggerFromContext(ctx, s.log)
+
+	deleted, err := s.purgeTaskRuns(appViewer.NewSystemViewerContext(ctx), taskData.RetentionDays, "")
+	if err != nil {
+		l.Errorf("清理任务执行记录失败[%s]", err.Error())
+		return err
+	}
+
+	l.Infof("清理任务执行记录[%d]条", deleted)
+	task.SetResult(ctx, map[string]any{"deleted": deleted})
+
 	return nil
 }
@@ -0,0 +1,646 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/hibiken/asynq"
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	taskV1 "go-wind-admin/api/gen/go/task/service/v1"
+
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+	"go-wind-admin/pkg/middleware/auth"
+	"go-wind-admin/pkg/task"
+)
+
+// TaskWorkflowService 任务工作流：按依赖关系编排多个任务步骤。
+//
+// 步骤作为普通的asynq任务派发，任务ID编码了运行ID、步骤名和派发批次，
+// 执行结束后由 task.WorkflowMiddleware 回报结果，再推进后继步骤。
+// 运行状态保存在数据库中，服务重启后会继续推进运行中的工作流。
+type TaskWorkflowService struct {
+	adminV1.TaskWorkflowServiceHTTPServer
+
+	log *log.Helper
+
+	taskScheduler TaskScheduler
+
+	workflowRepo *data.TaskWorkflowRepo
+	runRepo      *data.TaskWorkflowRunRepo
+}
+
+var _ task.WorkflowReporter = (*TaskWorkflowService)(nil)
+
+func NewTaskWorkflowService(
+	ctx *bootstrap.Context,
+	workflowRepo *data.TaskWorkflowRepo,
+	runRepo *data.TaskWorkflowRunRepo,
+) *TaskWorkflowService {
+	return &TaskWorkflowService{
+		log:          ctx.NewLoggerHelper("task-workflow/service/admin-service"),
+		workflowRepo: workflowRepo,
+		runRepo:      runRepo,
+	}
+}
+
+func (s *TaskWorkflowService) RegisterTaskScheduler(taskScheduler TaskScheduler) {
+	s.taskScheduler = taskScheduler
+}
+
+func (s *TaskWorkflowService) List(ctx context.Context, req *paginationV1.PagingRequest) (*taskV1.ListTaskWorkflowResponse, error) {
+	return s.workflowRepo.List(ctx, req)
+}
+
+func (s *TaskWorkflowService) Get(ctx context.Context, req *taskV1.GetTaskWorkflowRequest) (*taskV1.TaskWorkflow, error) {
+	return s.workflowRepo.Get(ctx, req)
+}
+
+func (s *TaskWorkflowService) Create(ctx context.Context, req *taskV1.CreateTaskWorkflowRequest) (*emptypb.Empty, error) {
+	if req == nil || req.Data == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+	if req.Data.GetName() == "" {
+		return nil, adminV1.ErrorBadRequest("workflow name is required")
+	}
+	if _, err := s.buildWorkflow(req.Data.GetSteps(), true); err != nil {
+		return nil, err
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Data.CreatedBy = trans.Ptr(operator.UserId)
+
+	if _, err = s.workflowRepo.Create(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *TaskWorkflowService) Update(ctx context.Context, req *taskV1.UpdateTaskWorkflowRequest) (*emptypb.Empty, error) {
+	if req == nil || req.Data == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	// 更新步骤时重新校验整个依赖图
+	if req.Data.Steps != nil || slices.Contains(req.GetUpdateMask().GetPaths(), "steps") {
+		if _, err := s.buildWorkflow(req.Data.GetSteps(), true); err != nil {
+			return nil, err
+		}
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Data.Id = trans.Ptr(req.GetId())
+
+	req.Data.UpdatedBy = trans.Ptr(operator.UserId)
+	if req.UpdateMask != nil {
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "updated_by")
+	}
+
+	if _, err = s.workflowRepo.Update(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *TaskWorkflowService) Delete(ctx context.Context, req *taskV1.DeleteTaskWorkflowRequest) (*emptypb.Empty, error) {
+	if err := s.workflowRepo.Delete(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// Start 启动工作流
+func (s *TaskWorkflowService) Start(ctx context.Context, req *taskV1.StartTaskWorkflowRequest) (*taskV1.TaskWorkflowRun, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	wf, err := s.workflowRepo.Get(ctx, &taskV1.GetTaskWorkflowRequest{QueryBy: &taskV1.GetTaskWorkflowRequest_Id{Id: req.GetId()}})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.startRun(ctx, wf, req.GetInput(), operator.UserId)
+}
+
+func (s *TaskWorkflowService) ListRuns(ctx context.Context, req *paginationV1.PagingRequest) (*taskV1.ListTaskWorkflowRunResponse, error) {
+	return s.runRepo.List(ctx, req)
+}
+
+func (s *TaskWorkflowService) GetRun(ctx context.Context, req *taskV1.GetTaskWorkflowRunRequest) (*taskV1.TaskWorkflowRun, error) {
+	return s.runRepo.Get(ctx, req)
+}
+
+// ControlRun 暂停、恢复或取消工作流运行
+func (s *TaskWorkflowService) ControlRun(ctx context.Context, id uint32, controlType taskV1.ControlTaskRequest_ControlType) error {
+	// 先确认运行记录对当前租户可见
+	if _, err := s.runRepo.Get(ctx, &taskV1.GetTaskWorkflowRunRequest{Id: id}); err != nil {
+		return err
+	}
+
+	switch controlType {
+	case taskV1.ControlTaskRequest_Pause:
+		_, err := s.runRepo.Mutate(ctx, id, func(run *taskV1.TaskWorkflowRun) error {
+			if run.GetStatus() != taskV1.TaskWorkflowRun_RUNNING {
+				return adminV1.ErrorBadRequest("workflow run is %s, only running runs can be paused", run.GetStatus().String())
+			}
+			run.Status = trans.Ptr(taskV1.TaskWorkflowRun_PAUSED)
+			return nil
+		})
+		return err
+
+	case taskV1.ControlTaskRequest_Resume:
+		return s.resumeRun(ctx, id)
+
+	case taskV1.ControlTaskRequest_Cancel, taskV1.ControlTaskRequest_Stop:
+		_, err := s.runRepo.Mutate(ctx, id, func(run *taskV1.TaskWorkflowRun) error {
+			switch run.GetStatus() {
+			case taskV1.TaskWorkflowRun_RUNNING, taskV1.TaskWorkflowRun_PAUSED:
+			default:
+				return adminV1.ErrorBadRequest("workflow run is %s and cannot be cancelled", run.GetStatus().String())
+			}
+
+			// 已派发的步骤会执行完毕，但结果会被忽略
+			now := timestamppb.Now()
+			for _, stepRun := range run.StepRuns {
+				if stepRun.GetStatus() == taskV1.TaskWorkflowStepRun_PENDING || stepRun.GetStatus() == taskV1.TaskWorkflowStepRun_RUNNING {
+					stepRun.Status = taskV1.TaskWorkflowStepRun_CANCELLED
+					stepRun.FinishedAt = now
+				}
+			}
+			run.Status = trans.Ptr(taskV1.TaskWorkflowRun_CANCELLED)
+			run.FinishedAt = now
+			return nil
+		})
+		return err
+
+	default:
+		return adminV1.ErrorBadRequest("unsupported control type %s for workflow runs", controlType.String())
+	}
+}
+
+// resumeRun 恢复暂停的运行；失败或已取消的运行从未完成的步骤重新执行
+func (s *TaskWorkflowService) resumeRun(ctx context.Context, id uint32) error {
+	var dispatches []*workflowDispatch
+
+	_, err := s.runRepo.Mutate(ctx, id, func(run *taskV1.TaskWorkflowRun) error {
+		switch run.GetStatus() {
+		case taskV1.TaskWorkflowRun_PAUSED:
+
+		case taskV1.TaskWorkflowRun_FAILED, taskV1.TaskWorkflowRun_CANCELLED:
+			for _, stepRun := range run.StepRuns {
+				switch stepRun.GetStatus() {
+				case taskV1.TaskWorkflowStepRun_FAILED, taskV1.TaskWorkflowStepRun_CANCELLED:
+					// 新批次的任务ID不同，旧批次迟到的结果会被忽略；仍在执行的步骤保持不变
+					stepRun.Status = taskV1.TaskWorkflowStepRun_PENDING
+					stepRun.Generation++
+					stepRun.TaskId = nil
+					stepRun.Payload = nil
+					stepRun.Output = nil
+					stepRun.ErrorMessage = nil
+					stepRun.StartedAt = nil
+					stepRun.FinishedAt = nil
+				}
+			}
+			run.ErrorMessage = nil
+			run.FinishedAt = nil
+
+		default:
+			return adminV1.ErrorBadRequest("workflow run is %s and cannot be resumed", run.GetStatus().String())
+		}
+
+		run.Status = trans.Ptr(taskV1.TaskWorkflowRun_RUNNING)
+
+		var err error
+		dispatches, err = s.advance(run)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	s.dispatch(ctx, dispatches)
+
+	return nil
+}
+
+// ReportStepResult 记录步骤的最终结果并推进工作流，由 task.WorkflowMiddleware 调用
+func (s *TaskWorkflowService) ReportStepResult(ctx context.Context, result *task.WorkflowStepResult) error {
+	ctx = appViewer.NewSystemViewerContext(ctx)
+
+	var dispatches []*workflowDispatch
+
+	_, err := s.runRepo.Mutate(ctx, result.RunID, func(run *taskV1.TaskWorkflowRun) error {
+		stepRun := findStepRun(run, result.Step)
+		if stepRun == nil ||
+			stepRun.GetGeneration() != result.Generation ||
+			stepRun.GetStatus() != taskV1.TaskWorkflowStepRun_RUNNING {
+			// 已取消、已恢复为新批次或重复回报的结果
+			return nil
+		}
+
+		stepRun.FinishedAt = timestamppb.Now()
+		if result.Output != "" {
+			stepRun.Output = trans.Ptr(result.Output)
+		}
+		if result.Err != nil {
+			stepRun.Status = taskV1.TaskWorkflowStepRun_FAILED
+			stepRun.ErrorMessage = trans.Ptr(result.Err.Error())
+		} else {
+			stepRun.Status = taskV1.TaskWorkflowStepRun_SUCCEEDED
+		}
+
+		// 暂停期间只记录结果，恢复后再推进
+		if run.GetStatus() != taskV1.TaskWorkflowRun_RUNNING {
+			return nil
+		}
+
+		var err error
+		dispatches, err = s.advance(run)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	s.dispatch(ctx, dispatches)
+
+	return nil
+}
+
+// RecoverRuns 服务启动时继续推进运行中的工作流：
+// 派发已就绪但尚未派发的步骤，并重新入队执行中的步骤（任务仍在队列中时会被asynq去重）
+func (s *TaskWorkflowService) RecoverRuns(ctx context.Context) error {
+	ids, err := s.runRepo.ListActiveIDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		var dispatches []*workflowDispatch
+
+		_, err = s.runRepo.Mutate(ctx, id, func(run *taskV1.TaskWorkflowRun) error {
+			if run.GetStatus() != taskV1.TaskWorkflowRun_RUNNING {
+				return nil
+			}
+
+			for _, stepRun := range run.StepRuns {
+				if stepRun.GetStatus() != taskV1.TaskWorkflowStepRun_RUNNING {
+					continue
+				}
+				step := findStep(run, stepRun.GetName())
+				if step == nil {
+					continue
+				}
+				dispatches = append(dispatches, newWorkflowDispatch(run.GetId(), step, stepRun))
+			}
+
+			more, err := s.advance(run)
+			dispatches = append(dispatches, more...)
+			return err
+		})
+		if err != nil {
+			s.log.Errorf("recover task workflow run [%d] failed: %s", id, err.Error())
+			continue
+		}
+
+		s.dispatch(ctx, dispatches)
+	}
+
+	if len(ids) > 0 {
+		s.log.Infof("recovered [%d] task workflow runs", len(ids))
+	}
+
+	return nil
+}
+
+// AsyncStartWorkflow 由 workflow_start 任务启动工作流，可配置为周期任务
+func (s *TaskWorkflowService) AsyncStartWorkflow(ctx context.Context, _ string, taskData *task.WorkflowStartTaskData) error {
+	l := task.LoggerFromContext(ctx, s.log)
+	sysCtx := appViewer.NewSystemViewerContext(ctx)
+
+	wf, err := s.workflowRepo.Get(sysCtx, &taskV1.GetTaskWorkflowRequest{QueryBy: &taskV1.GetTaskWorkflowRequest_Id{Id: taskData.WorkflowID}})
+	if err != nil {
+		l.Errorf("获取工作流[%d]失败[%s]", taskData.WorkflowID, err.Error())
+		return err
+	}
+
+	var input string
+	if len(taskData.Input) > 0 {
+		data, err := json.Marshal(taskData.Input)
+		if err != nil {
+			return err
+		}
+		input = string(data)
+	}
+
+	run, err := s.startRun(sysCtx, wf, input, 0)
+	if err != nil {
+		l.Errorf("启动工作流[%s]失败[%s]", wf.GetName(), err.Error())
+		return err
+	}
+
+	l.Infof("启动工作流[%s]，运行ID[%d]", wf.GetName(), run.GetId())
+	task.SetResult(ctx, map[string]any{"run_id": run.GetId()})
+
+	return nil
+}
+
+// startRun 创建运行记录并派发入口步骤
+func (s *TaskWorkflowService) startRun(ctx context.Context, wf *taskV1.TaskWorkflow, input string, createdBy uint32) (*taskV1.TaskWorkflowRun, error) {
+	if !wf.GetEnable() {
+		return nil, adminV1.ErrorBadRequest("workflow %s is disabled", wf.GetName())
+	}
+	if input != "" && !json.Valid([]byte(input)) {
+		return nil, adminV1.ErrorBadRequest("workflow input must be valid JSON")
+	}
+	if _, err := s.buildWorkflow(wf.GetSteps(), false); err != nil {
+		return nil, err
+	}
+
+	stepRuns := make([]*taskV1.TaskWorkflowStepRun, 0, len(wf.GetSteps()))
+	for _, step := range wf.GetSteps() {
+		stepRuns = append(stepRuns, &taskV1.TaskWorkflowStepRun{
+			Name:   step.GetName(),
+			Status: taskV1.TaskWorkflowStepRun_PENDING,
+		})
+	}
+
+	run := &taskV1.TaskWorkflowRun{
+		TenantId:     wf.TenantId,
+		WorkflowId:   wf.Id,
+		WorkflowName: wf.Name,
+		Status:       trans.Ptr(taskV1.TaskWorkflowRun_RUNNING),
+		Steps:        wf.GetSteps(),
+		StepRuns:     stepRuns,
+		StartedAt:    timestamppb.Now(),
+	}
+	if input != "" {
+		run.Input = trans.Ptr(input)
+	}
+	if createdBy != 0 {
+		run.CreatedBy = trans.Ptr(createdBy)
+	}
+
+	created, err := s.runRepo.Create(ctx, run)
+	if err != nil {
+		return nil, err
+	}
+
+	// 步骤结果由系统回报，推进时不受租户视图限制
+	var dispatches []*workflowDispatch
+	created, err = s.runRepo.Mutate(appViewer.NewSystemViewerContext(ctx), created.GetId(), func(run *taskV1.TaskWorkflowRun) error {
+		var err error
+		dispatches, err = s.advance(run)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.dispatch(ctx, dispatches)
+
+	return created, nil
+}
+
+// workflowDispatch 待派发的步骤任务
+type workflowDispatch struct {
+	runID      uint32
+	step       string
+	generation uint32
+	typeName   string
+	taskID     string
+	payload    string
+	maxRetry   uint32
+	timeout    time.Duration
+}
+
+func newWorkflowDispatch(runID uint32, step *taskV1.TaskWorkflowStep, stepRun *taskV1.TaskWorkflowStepRun) *workflowDispatch {
+	d := &workflowDispatch{
+		runID:      runID,
+		step:       step.GetName(),
+		generation: stepRun.GetGeneration(),
+		typeName:   step.GetTypeName(),
+		taskID:     stepRun.GetTaskId(),
+		payload:    stepRun.GetPayload(),
+		maxRetry:   step.GetMaxRetry(),
+	}
+	if step.Timeout != nil {
+		d.timeout = step.GetTimeout().AsDuration()
+	}
+	return d
+}
+
+// advance 推进运行状态，返回需要派发的步骤。只修改 run，不产生副作用。
+func (s *TaskWorkflowService) advance(run *taskV1.TaskWorkflowRun) ([]*workflowDispatch, error) {
+	wf, err := s.buildWorkflow(run.GetSteps(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	states := make(map[string]*task.StepState, len(run.StepRuns))
+	for _, stepRun := range run.StepRuns {
+		states[stepRun.GetName()] = &task.StepState{
+			Status: task.StepStatus(stepRun.GetStatus().String()),
+			Output: stepRun.GetOutput(),
+			Error:  stepRun.GetErrorMessage(),
+		}
+	}
+
+	now := timestamppb.Now()
+
+	var dispatches []*workflowDispatch
+	var plan *task.WorkflowPlan
+	for {
+		plan = wf.Plan(states, run.GetInput())
+
+		// 渲染参数失败的步骤按失败处理，然后重新推进
+		payloads := make(map[string]string, len(plan.Dispatch))
+		renderFailed := false
+		for _, name := range plan.Dispatch {
+			payload, err := wf.StepPayload(name, states, run.GetInput())
+			var data []byte
+			if err == nil {
+				data, err = json.Marshal(payload)
+			}
+			if err != nil {
+				states[name].Status = task.StepStatusFailed
+				states[name].Error = fmt.Sprintf("render payload: %s", err.Error())
+				renderFailed = true
+				continue
+			}
+			payloads[name] = string(data)
+		}
+
+		if renderFailed {
+			for name := range payloads {
+				states[name].Status = task.StepStatusPending
+			}
+			continue
+		}
+
+		for _, name := range plan.Dispatch {
+			stepRun := findStepRun(run, name)
+			if stepRun == nil {
+				stepRun = &taskV1.TaskWorkflowStepRun{Name: name}
+				run.StepRuns = append(run.StepRuns, stepRun)
+			}
+			stepRun.TaskId = trans.Ptr(task.WorkflowStepTaskID(run.GetId(), name, stepRun.GetGeneration()))
+			stepRun.Payload = trans.Ptr(payloads[name])
+			stepRun.StartedAt = now
+
+			dispatches = append(dispatches, newWorkflowDispatch(run.GetId(), findStep(run, name), stepRun))
+		}
+		break
+	}
+
+	for _, stepRun := range run.StepRuns {
+		state := states[stepRun.GetName()]
+		if state == nil {
+			continue
+		}
+
+		status := taskV1.TaskWorkflowStepRun_Status(taskV1.TaskWorkflowStepRun_Status_value[string(state.Status)])
+		if status != stepRun.GetStatus() {
+			stepRun.Status = status
+			if state.Status.Terminal() && stepRun.FinishedAt == nil {
+				stepRun.FinishedAt = now
+			}
+		}
+		if state.Error != "" {
+			stepRun.ErrorMessage = trans.Ptr(state.Error)
+		}
+	}
+
+	switch {
+	case plan.Failed:
+		failed := findStepRun(run, plan.FailedStep)
+		run.Status = trans.Ptr(taskV1.TaskWorkflowRun_FAILED)
+		run.ErrorMessage = trans.Ptr(fmt.Sprintf("step %s failed: %s", plan.FailedStep, failed.GetErrorMessage()))
+		if run.FinishedAt == nil {
+			run.FinishedAt = now
+		}
+
+	case plan.Done:
+		run.Status = trans.Ptr(taskV1.TaskWorkflowRun_SUCCEEDED)
+		run.FinishedAt = now
+	}
+
+	return dispatches, nil
+}
+
+// dispatch 把步骤入队，入队失败时按步骤失败处理
+func (s *TaskWorkflowService) dispatch(ctx context.Context, dispatches []*workflowDispatch) {
+	for _, d := range dispatches {
+		opts := []asynq.Option{
+			asynq.TaskID(d.taskID),
+			asynq.MaxRetry(int(d.maxRetry)),
+		}
+		if d.timeout > 0 {
+			opts = append(opts, asynq.Timeout(d.timeout))
+		}
+
+		var err error
+		if s.taskScheduler == nil {
+			err = errors.New("task scheduler is not available")
+		} else {
+			err = s.taskScheduler.NewTask(d.typeName, json.RawMessage(d.payload), opts...)
+		}
+		if err == nil || errors.Is(err, asynq.ErrTaskIDConflict) {
+			continue
+		}
+
+		s.log.Errorf("[%s] dispatch workflow step failed: %s", d.taskID, err.Error())
+
+		if reportErr := s.ReportStepResult(ctx, &task.WorkflowStepResult{
+			RunID:      d.runID,
+			Step:       d.step,
+			Generation: d.generation,
+			TaskID:     d.taskID,
+			Err:        fmt.Errorf("dispatch step: %w", err),
+		}); reportErr != nil {
+			s.log.Errorf("[%s] report workflow step failed: %s", d.taskID, reportErr.Error())
+		}
+	}
+}
+
+// buildWorkflow 校验步骤定义，checkTypes 为true时要求步骤的任务类型已注册
+func (s *TaskWorkflowService) buildWorkflow(steps []*taskV1.TaskWorkflowStep, checkTypes bool) (*task.Workflow, error) {
+	defs := make([]task.WorkflowStep, 0, len(steps))
+	for _, step := range steps {
+		def := task.WorkflowStep{
+			Name:              step.GetName(),
+			TypeName:          step.GetTypeName(),
+			Payload:           step.GetPayload(),
+			DependsOn:         step.GetDependsOn(),
+			Condition:         step.GetCondition(),
+			MaxRetry:          step.GetMaxRetry(),
+			ContinueOnFailure: step.GetContinueOnFailure(),
+		}
+		if step.Timeout != nil {
+			def.Timeout = step.GetTimeout().AsDuration()
+		}
+
+		if checkTypes && s.taskScheduler != nil && !s.taskScheduler.TaskTypeExists(def.TypeName) {
+			return nil, adminV1.ErrorBadRequest("step %s uses unknown task type %s", def.Name, def.TypeName)
+		}
+
+		defs = append(defs, def)
+	}
+
+	wf, err := task.NewWorkflow(defs)
+	if err != nil {
+		return nil, adminV1.ErrorBadRequest("invalid workflow: %s", err.Error())
+	}
+
+	return wf, nil
+}
+
+func findStep(run *taskV1.TaskWorkflowRun, name string) *taskV1.TaskWorkflowStep {
+	for _, step := range run.GetSteps() {
+		if step.GetName() == name {
+			return step
+		}
+	}
+	return nil
+}
+
+func findStepRun(run *taskV1.TaskWorkflowRun, name string) *taskV1.TaskWorkflowStepRun {
+	for _, stepRun := range run.GetStepRuns() {
+		if stepRun.GetName() == name {
+			return stepRun
+		}
+	}
+	return nil
+}
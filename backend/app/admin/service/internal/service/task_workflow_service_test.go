@@ -0,0 +1,95 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tx7do/go-utils/trans"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	taskV1 "go-wind-admin/api/gen/go/task/service/v1"
+)
+
+func newTestWorkflowRun(steps ...*taskV1.TaskWorkflowStep) *taskV1.TaskWorkflowRun {
+	run := &taskV1.TaskWorkflowRun{
+		Id:     trans.Ptr(uint32(7)),
+		Status: trans.Ptr(taskV1.TaskWorkflowRun_RUNNING),
+		Input:  trans.Ptr(`{"bucket":"reports"}`),
+		Steps:  steps,
+	}
+	for _, step := range steps {
+		run.StepRuns = append(run.StepRuns, &taskV1.TaskWorkflowStepRun{Name: step.GetName()})
+	}
+	return run
+}
+
+func TestTaskWorkflowService_advance(t *testing.T) {
+	s := &TaskWorkflowService{}
+
+	run := newTestWorkflowRun(
+		&taskV1.TaskWorkflowStep{Name: "export", TypeName: "audit_export", MaxRetry: trans.Ptr(uint32(2))},
+		&taskV1.TaskWorkflowStep{Name: "upload", TypeName: "upload", DependsOn: []string{"export"},
+			Payload: trans.Ptr(`{"bucket":"{{ input.bucket }}","file":"{{ steps.export.output.file }}"}`)},
+	)
+
+	dispatches, err := s.advance(run)
+	require.NoError(t, err)
+	require.Len(t, dispatches, 1)
+	assert.Equal(t, "workflow:7:export:0", dispatches[0].taskID)
+	assert.Equal(t, uint32(2), dispatches[0].maxRetry)
+	assert.Equal(t, taskV1.TaskWorkflowStepRun_RUNNING, findStepRun(run, "export").GetStatus())
+
+	findStepRun(run, "export").Status = taskV1.TaskWorkflowStepRun_SUCCEEDED
+	findStepRun(run, "export").Output = trans.Ptr(`{"file":"a.csv"}`)
+
+	dispatches, err = s.advance(run)
+	require.NoError(t, err)
+	require.Len(t, dispatches, 1)
+	assert.Equal(t, "upload", dispatches[0].step)
+	assert.JSONEq(t, `{"bucket":"reports","file":"a.csv"}`, dispatches[0].payload)
+	assert.Equal(t, taskV1.TaskWorkflowRun_RUNNING, run.GetStatus())
+
+	findStepRun(run, "upload").Status = taskV1.TaskWorkflowStepRun_SUCCEEDED
+
+	dispatches, err = s.advance(run)
+	require.NoError(t, err)
+	assert.Empty(t, dispatches)
+	assert.Equal(t, taskV1.TaskWorkflowRun_SUCCEEDED, run.GetStatus())
+	assert.NotNil(t, run.FinishedAt)
+}
+
+func TestTaskWorkflowService_advanceFailure(t *testing.T) {
+	s := &TaskWorkflowService{}
+
+	run := newTestWorkflowRun(
+		&taskV1.TaskWorkflowStep{Name: "a", TypeName: "x"},
+		&taskV1.TaskWorkflowStep{Name: "b", TypeName: "x", DependsOn: []string{"a"}},
+	)
+
+	_, err := s.advance(run)
+	require.NoError(t, err)
+
+	findStepRun(run, "a").Status = taskV1.TaskWorkflowStepRun_FAILED
+	findStepRun(run, "a").ErrorMessage = trans.Ptr("boom")
+
+	dispatches, err := s.advance(run)
+	require.NoError(t, err)
+	assert.Empty(t, dispatches)
+	assert.Equal(t, taskV1.TaskWorkflowRun_FAILED, run.GetStatus())
+	assert.Equal(t, "step a failed: boom", run.GetErrorMessage())
+	assert.Equal(t, taskV1.TaskWorkflowStepRun_CANCELLED, findStepRun(run, "b").GetStatus())
+}
+
+func TestTaskWorkflowService_buildWorkflow(t *testing.T) {
+	s := &TaskWorkflowService{}
+
+	_, err := s.buildWorkflow([]*taskV1.TaskWorkflowStep{
+		{Name: "a", TypeName: "x", DependsOn: []string{"b"}},
+		{Name: "b", TypeName: "x", DependsOn: []string{"a"}},
+	}, false)
+	assert.True(t, adminV1.IsBadRequest(err))
+
+	_, err = s.buildWorkflow([]*taskV1.TaskWorkflowStep{{Name: "a", TypeName: "x"}}, false)
+	assert.NoError(t, err)
+}
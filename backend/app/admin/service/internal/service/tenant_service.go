@@ -32,6 +32,7 @@ type TenantService struct {
 	roleRepo            *data.RoleRepo
 
 	authorizer *authorizer.Authorizer
+	storages   *data.ObjectStorageRouter
 }
 
 func NewTenantService(
@@ -41,6 +42,7 @@ func NewTenantService(
 	userCredentialsRepo *data.UserCredentialRepo,
 	roleRepo *data.RoleRepo,
 	authorizer *authorizer.Authorizer,
+	storages *data.ObjectStorageRouter,
 ) *TenantService {
 	return &TenantService{
 		log:                 ctx.NewLoggerHelper("tenant/service/admin-service"),
@@ -49,9 +51,21 @@ func NewTenantService(
 		userCredentialsRepo: userCredentialsRepo,
 		roleRepo:            roleRepo,
 		authorizer:          authorizer,
+		storages:            storages,
 	}
 }
 
+// checkStorageProvider 租户只能选择已配置的存储后端
+func (s *TenantService) checkStorageProvider(tenant *identityV1.Tenant) error {
+	if tenant.StorageProvider == nil {
+		return nil
+	}
+	if _, err := s.storages.Provider(tenant.GetStorageProvider()); err != nil {
+		return adminV1.ErrorBadRequest("storage provider [%s] is not configured", tenant.GetStorageProvider().String())
+	}
+	return nil
+}
+
 func (s *TenantService) extractRelationIDs(
 	tenants []*identityV1.Tenant,
 	userSet aggregator.ResourceMap[uint32, *identityV1.User],
@@ -158,6 +172,10 @@ func (s *TenantService) Create(ctx context.Context, req *identityV1.CreateTenant
 
 	req.Data.CreatedBy = trans.Ptr(operator.UserId)
 
+	if err = s.checkStorageProvider(req.Data); err != nil {
+		return nil, err
+	}
+
 	if _, err = s.tenantRepo.Create(ctx, req.Data); err != nil {
 		return nil, err
 	}
@@ -183,10 +201,16 @@ func (s *TenantService) Update(ctx context.Context, req *identityV1.UpdateTenant
 		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "updated_by")
 	}
 
+	if err = s.checkStorageProvider(req.Data); err != nil {
+		return nil, err
+	}
+
 	if err = s.tenantRepo.Update(ctx, req); err != nil {
 		return nil, err
 	}
 
+	s.storages.ForgetTenant(req.GetId())
+
 	return &emptypb.Empty{}, nil
 }
 
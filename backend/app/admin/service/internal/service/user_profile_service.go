@@ -1,7 +1,11 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
+	"strconv"
+	"strings"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/tx7do/go-utils/trans"
@@ -14,8 +18,21 @@ import (
 	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
 	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
 	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
+	storageV1 "go-wind-admin/api/gen/go/storage/service/v1"
 
+	"go-wind-admin/pkg/imaging"
 	"go-wind-admin/pkg/middleware/auth"
+	"go-wind-admin/pkg/oss"
+)
+
+const (
+	// maxAvatarImageSize 头像原图的大小上限
+	maxAvatarImageSize = 5 << 20
+	// avatarImageSize 头像裁剪后的边长（像素）
+	avatarImageSize = 512
+
+	// avatarFileDirectory 头像存储目录，每个用户使用单独的子目录，用户的头像只成为自己头像的历史版本
+	avatarFileDirectory = "avatars"
 )
 
 type UserProfileService struct {
@@ -25,6 +42,8 @@ type UserProfileService struct {
 	roleRepo           *data.RoleRepo
 	userCredentialRepo *data.UserCredentialRepo
 
+	fileTransferService *FileTransferService
+
 	log *log.Helper
 }
 
@@ -33,12 +52,14 @@ func NewUserProfileService(
 	userRepo data.UserRepo,
 	roleRepo *data.RoleRepo,
 	userCredentialRepo *data.UserCredentialRepo,
+	fileTransferService *FileTransferService,
 ) *UserProfileService {
 	return &UserProfileService{
-		log:                ctx.NewLoggerHelper("user-profile/service/admin-service"),
-		userRepo:           userRepo,
-		roleRepo:           roleRepo,
-		userCredentialRepo: userCredentialRepo,
+		log:                 ctx.NewLoggerHelper("user-profile/service/admin-service"),
+		userRepo:            userRepo,
+		roleRepo:            roleRepo,
+		userCredentialRepo:  userCredentialRepo,
+		fileTransferService: fileTransferService,
 	}
 }
 
@@ -138,6 +159,9 @@ func (s *UserProfileService) UploadAvatar(ctx context.Context, req *identityV1.U
 	var avatarURL string
 	switch req.GetSource().(type) {
 	case *identityV1.UploadAvatarRequest_ImageBase64:
+		if avatarURL, err = s.uploadAvatarImage(ctx, operator.GetUserId(), req.GetImageBase64()); err != nil {
+			return nil, err
+		}
 	case *identityV1.UploadAvatarRequest_ImageUrl:
 		avatarURL = req.GetImageUrl()
 	default:
@@ -163,6 +187,65 @@ func (s *UserProfileService) UploadAvatar(ctx context.Context, req *identityV1.U
 	}, nil
 }
 
+// uploadAvatarImage 解码Base64头像，校验图片类型后去除EXIF、居中裁剪为正方形并上传，返回访问地址
+func (s *UserProfileService) uploadAvatarImage(ctx context.Context, userID uint32, imageBase64 string) (string, error) {
+	// 兼容 Data URL：data:image/png;base64,xxxx
+	if strings.HasPrefix(imageBase64, "data:") {
+		if _, payload, ok := strings.Cut(imageBase64, ","); ok {
+			imageBase64 = payload
+		}
+	}
+	imageBase64 = strings.TrimSpace(imageBase64)
+
+	if imageBase64 == "" {
+		return "", authenticationV1.ErrorBadRequest("empty avatar image")
+	}
+	if base64.StdEncoding.DecodedLen(len(imageBase64)) > maxAvatarImageSize {
+		return "", authenticationV1.ErrorBadRequest("avatar image is too large")
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(imageBase64)
+	if err != nil {
+		if raw, err = base64.RawStdEncoding.DecodeString(strings.TrimRight(imageBase64, "=")); err != nil {
+			return "", authenticationV1.ErrorBadRequest("invalid avatar image encoding")
+		}
+	}
+
+	if mimeType, _ := oss.DetectFileType(raw); !IsImage(mimeType) {
+		return "", authenticationV1.ErrorBadRequest("avatar must be a jpeg, png, gif or webp image")
+	}
+
+	// 重新编码后不再携带EXIF等元数据
+	img, format, err := imaging.Decode(raw)
+	if err != nil {
+		s.log.Warnf("decode avatar image failed: %s", err.Error())
+		return "", authenticationV1.ErrorBadRequest("invalid avatar image")
+	}
+
+	var buf bytes.Buffer
+	contentType, ext, err := imaging.Encode(&buf, imaging.CropSquare(img, avatarImageSize), format)
+	if err != nil {
+		s.log.Errorf("encode avatar image failed: %s", err.Error())
+		return "", authenticationV1.ErrorInternalServerError("process avatar image failed")
+	}
+
+	resp, err := s.fileTransferService.UploadFile(ctx, &storageV1.UploadFileRequest{
+		SourceFileName: trans.Ptr("avatar" + ext),
+		Mime:           trans.Ptr(contentType),
+		StorageObject: &storageV1.StorageObject{
+			BucketName:    trans.Ptr(oss.BucketImages),
+			FileDirectory: trans.Ptr(avatarFileDirectory + "/" + strconv.FormatUint(uint64(userID), 10)),
+		},
+		Source: &storageV1.UploadFileRequest_File{File: buf.Bytes()},
+	})
+	if err != nil {
+		s.log.Errorf("upload avatar image failed: %s", err.Error())
+		return "", err
+	}
+
+	return resp.GetDownloadUrl(), nil
+}
+
 // BindContact 绑定手机号码/邮箱
 func (s *UserProfileService) BindContact(context.Context, *identityV1.BindContactRequest) (*emptypb.Empty, error) {
 	return nil, nil
@@ -39,6 +39,8 @@ type UserService struct {
 	tenantRepo   *data.TenantRepo
 
 	membershipRepo *data.MembershipRepo
+
+	luaHooks *data.LuaHookRunner
 }
 
 func NewUserService(
@@ -50,6 +52,7 @@ func NewUserService(
 	orgUnitRepo *data.OrgUnitRepo,
 	tenantRepo *data.TenantRepo,
 	membershipRepo *data.MembershipRepo,
+	luaHooks *data.LuaHookRunner,
 ) *UserService {
 	svc := &UserService{
 		log:                ctx.NewLoggerHelper("user/service/admin-service"),
@@ -60,6 +63,7 @@ func NewUserService(
 		orgUnitRepo:        orgUnitRepo,
 		tenantRepo:         tenantRepo,
 		membershipRepo:     membershipRepo,
+		luaHooks:           luaHooks,
 	}
 
 	svc.init()
@@ -396,6 +400,20 @@ func (s *UserService) Create(ctx context.Context, req *identityV1.CreateUserRequ
 	req.Data.RoleId = nil
 	req.Data.RoleIds = roleIds
 
+	// 创建前钩子，脚本可补全、校正用户信息或拒绝创建
+	if err = s.luaHooks.Before(ctx, &data.LuaHookEvent{
+		Hook:    data.LuaHookBeforeUserCreate,
+		Payload: req.Data,
+	}); err != nil {
+		return nil, err
+	}
+	// 钩子不能绕过租户与角色约束
+	req.Data.CreatedBy = trans.Ptr(operator.UserId)
+	if operator.GetTenantId() > 0 {
+		req.Data.TenantId = operator.TenantId
+	}
+	req.Data.RoleIds = roleIds
+
 	// 创建用户
 	var user *identityV1.User
 	if user, err = s.userRepo.Create(ctx, req); err != nil {
@@ -427,6 +445,11 @@ func (s *UserService) Create(ctx context.Context, req *identityV1.CreateUserRequ
 		}
 	}
 
+	s.luaHooks.After(ctx, &data.LuaHookEvent{
+		Hook:    data.LuaHookAfterUserCreate,
+		Payload: user,
+	})
+
 	return &emptypb.Empty{}, nil
 }
 
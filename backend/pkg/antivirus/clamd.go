@@ -0,0 +1,223 @@
+package antivirus
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+)
+
+const (
+	defaultClamdTimeout   = 2 * time.Minute
+	defaultClamdChunkSize = 64 << 10
+
+	clamdResponseLimit = 4096
+)
+
+// ClamdOption ClamdScanner 选项
+type ClamdOption func(*ClamdScanner)
+
+// WithClamdTimeout 设置单次扫描的超时时间（包括连接、发送与等待结果）
+func WithClamdTimeout(timeout time.Duration) ClamdOption {
+	return func(s *ClamdScanner) {
+		if timeout > 0 {
+			s.timeout = timeout
+		}
+	}
+}
+
+// WithClamdChunkSize 设置 INSTREAM 分块大小
+func WithClamdChunkSize(size int) ClamdOption {
+	return func(s *ClamdScanner) {
+		if size > 0 {
+			s.chunkSize = size
+		}
+	}
+}
+
+// ClamdScanner 通过 clamd 的 INSTREAM 协议扫描数据流
+type ClamdScanner struct {
+	network string
+	address string
+
+	timeout   time.Duration
+	chunkSize int
+
+	dialer net.Dialer
+}
+
+// NewClamdScanner 创建 clamd 扫描器，地址支持 tcp://host:port、unix:///path/clamd.sock 与 host:port
+func NewClamdScanner(address string, opts ...ClamdOption) (*ClamdScanner, error) {
+	network, addr, err := parseClamdAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &ClamdScanner{
+		network:   network,
+		address:   addr,
+		timeout:   defaultClamdTimeout,
+		chunkSize: defaultClamdChunkSize,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s, nil
+}
+
+func parseClamdAddress(address string) (network, addr string, err error) {
+	address = strings.TrimSpace(address)
+	switch {
+	case address == "":
+		return "", "", fmt.Errorf("antivirus: empty clamd address")
+	case strings.HasPrefix(address, "unix://"):
+		return "unix", strings.TrimPrefix(address, "unix://"), nil
+	case strings.HasPrefix(address, "tcp://"):
+		return "tcp", strings.TrimPrefix(address, "tcp://"), nil
+	case strings.HasPrefix(address, "/"):
+		return "unix", address, nil
+	default:
+		return "tcp", address, nil
+	}
+}
+
+func (s *ClamdScanner) Name() string {
+	return "clamd"
+}
+
+// Ping 发送 PING 命令
+func (s *ClamdScanner) Ping(ctx context.Context) error {
+	conn, err := s.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err = conn.Write([]byte("zPING\x00")); err != nil {
+		return err
+	}
+
+	reply, err := readClamdReply(conn)
+	if err != nil {
+		return err
+	}
+	if reply != "PONG" {
+		return fmt.Errorf("%w: %s", ErrUnexpectedResponse, reply)
+	}
+	return nil
+}
+
+// Scan 以 INSTREAM 协议发送数据：每个分块以4字节大端长度开头，长度为0的分块表示结束
+func (s *ClamdScanner) Scan(ctx context.Context, r io.Reader) (*Result, error) {
+	conn, err := s.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	// 上下文取消时中断阻塞的读写
+	stop := context.AfterFunc(ctx, func() {
+		_ = conn.SetDeadline(time.Now())
+	})
+	defer stop()
+
+	if _, err = conn.Write([]byte("zINSTREAM\x00")); err != nil {
+		return nil, err
+	}
+
+	w := bufio.NewWriterSize(conn, s.chunkSize+4)
+	buf := make([]byte, s.chunkSize)
+	var size [4]byte
+	for {
+		n, readErr := io.ReadFull(r, buf)
+		if n > 0 {
+			binary.BigEndian.PutUint32(size[:], uint32(n))
+			if _, err = w.Write(size[:]); err == nil {
+				_, err = w.Write(buf[:n])
+			}
+			if err != nil {
+				// clamd 超过 StreamMaxLength 时会先返回错误再关闭连接
+				if reply, replyErr := readClamdReply(conn); replyErr == nil {
+					return parseClamdScanReply(reply)
+				}
+				return nil, err
+			}
+		}
+		if readErr == io.EOF || readErr == io.ErrUnexpectedEOF {
+			break
+		}
+		if readErr != nil {
+			return nil, readErr
+		}
+	}
+
+	binary.BigEndian.PutUint32(size[:], 0)
+	if _, err = w.Write(size[:]); err != nil {
+		return nil, err
+	}
+	if err = w.Flush(); err != nil {
+		return nil, err
+	}
+
+	reply, err := readClamdReply(conn)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, err
+	}
+	return parseClamdScanReply(reply)
+}
+
+func (s *ClamdScanner) dial(ctx context.Context) (net.Conn, error) {
+	conn, err := s.dialer.DialContext(ctx, s.network, s.address)
+	if err != nil {
+		return nil, err
+	}
+
+	deadline := time.Now().Add(s.timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	if err = conn.SetDeadline(deadline); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+// readClamdReply 读取以 NUL 结尾的响应（z 前缀命令）
+func readClamdReply(r io.Reader) (string, error) {
+	reply, err := bufio.NewReader(io.LimitReader(r, clamdResponseLimit)).ReadBytes(0)
+	if err != nil && (err != io.EOF || len(reply) == 0) {
+		return "", err
+	}
+	return string(bytes.TrimRight(reply, "\x00\n")), nil
+}
+
+// parseClamdScanReply 解析扫描结果，例如：
+// "stream: OK"、"stream: Eicar-Test-Signature FOUND"、"INSTREAM size limit exceeded. ERROR"
+func parseClamdScanReply(reply string) (*Result, error) {
+	reply = strings.TrimSpace(reply)
+	// 带会话序号的响应形如 "1: stream: OK"，结果位于最后一个 ": " 之后
+	body := reply
+	if i := strings.LastIndex(reply, ": "); i >= 0 {
+		body = reply[i+2:]
+	}
+
+	switch {
+	case body == "OK":
+		return &Result{}, nil
+	case strings.HasSuffix(body, " FOUND"):
+		return &Result{Infected: true, Signature: strings.TrimSuffix(body, " FOUND")}, nil
+	case strings.Contains(reply, "size limit exceeded"):
+		return nil, ErrSizeLimitExceeded
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnexpectedResponse, reply)
+	}
+}
@@ -0,0 +1,180 @@
+package antivirus
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const eicar = `X5O!P%@AP[4\PZX54(P^)7CC)7}$EICAR-STANDARD-ANTIVIRUS-TEST-FILE!$H+H*`
+
+// fakeClamd 实现 clamd 的 PING 与 INSTREAM 命令，内容包含 EICAR 测试串时报告感染
+type fakeClamd struct {
+	ln        net.Listener
+	maxStream int
+	received  chan []byte
+}
+
+func newFakeClamd(t *testing.T, maxStream int) *fakeClamd {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	f := &fakeClamd{ln: ln, maxStream: maxStream, received: make(chan []byte, 16)}
+	go f.serve()
+	t.Cleanup(func() { _ = ln.Close() })
+	return f
+}
+
+func (f *fakeClamd) address() string {
+	return "tcp://" + f.ln.Addr().String()
+}
+
+func (f *fakeClamd) serve() {
+	for {
+		conn, err := f.ln.Accept()
+		if err != nil {
+			return
+		}
+		go f.handle(conn)
+	}
+}
+
+func (f *fakeClamd) handle(conn net.Conn) {
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	cmd, err := r.ReadString(0)
+	if err != nil {
+		return
+	}
+
+	switch strings.TrimSuffix(cmd, "\x00") {
+	case "zPING":
+		_, _ = conn.Write([]byte("PONG\x00"))
+
+	case "zINSTREAM":
+		var data bytes.Buffer
+		for {
+			var size uint32
+			if err = binary.Read(r, binary.BigEndian, &size); err != nil {
+				return
+			}
+			if size == 0 {
+				break
+			}
+			if f.maxStream > 0 && data.Len()+int(size) > f.maxStream {
+				_, _ = conn.Write([]byte("INSTREAM size limit exceeded. ERROR\x00"))
+				return
+			}
+			if _, err = io.CopyN(&data, r, int64(size)); err != nil {
+				return
+			}
+		}
+		f.received <- data.Bytes()
+
+		if bytes.Contains(data.Bytes(), []byte("EICAR-STANDARD-ANTIVIRUS-TEST-FILE")) {
+			_, _ = conn.Write([]byte("stream: Eicar-Test-Signature FOUND\x00"))
+		} else {
+			_, _ = conn.Write([]byte("stream: OK\x00"))
+		}
+
+	default:
+		_, _ = conn.Write([]byte("UNKNOWN COMMAND\x00"))
+	}
+}
+
+func TestClamdScanner_Scan(t *testing.T) {
+	clamd := newFakeClamd(t, 0)
+
+	scanner, err := NewClamdScanner(clamd.address(), WithClamdChunkSize(7))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, scanner.Ping(ctx))
+
+	content := strings.Repeat("clean data ", 100)
+	result, err := scanner.Scan(ctx, strings.NewReader(content))
+	require.NoError(t, err)
+	assert.False(t, result.Infected)
+	assert.Equal(t, content, string(<-clamd.received))
+
+	result, err = scanner.Scan(ctx, strings.NewReader(eicar))
+	require.NoError(t, err)
+	assert.True(t, result.Infected)
+	assert.Equal(t, "Eicar-Test-Signature", result.Signature)
+	<-clamd.received
+
+	result, err = scanner.Scan(ctx, strings.NewReader(""))
+	require.NoError(t, err)
+	assert.False(t, result.Infected)
+}
+
+func TestClamdScanner_SizeLimit(t *testing.T) {
+	clamd := newFakeClamd(t, 16)
+
+	scanner, err := NewClamdScanner(clamd.ln.Addr().String(), WithClamdChunkSize(8))
+	require.NoError(t, err)
+
+	_, err = scanner.Scan(context.Background(), strings.NewReader(strings.Repeat("x", 1<<20)))
+	assert.True(t, errors.Is(err, ErrSizeLimitExceeded), "unexpected error: %v", err)
+}
+
+func TestClamdScanner_Unavailable(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := ln.Addr().String()
+	require.NoError(t, ln.Close())
+
+	scanner, err := NewClamdScanner(addr, WithClamdTimeout(time.Second))
+	require.NoError(t, err)
+
+	_, err = scanner.Scan(context.Background(), strings.NewReader("data"))
+	assert.Error(t, err)
+	assert.Error(t, scanner.Ping(context.Background()))
+}
+
+func TestParseClamdScanReply(t *testing.T) {
+	result, err := parseClamdScanReply("stream: OK")
+	require.NoError(t, err)
+	assert.False(t, result.Infected)
+
+	result, err = parseClamdScanReply("1: stream: Win.Test.EICAR_HDB-1 FOUND")
+	require.NoError(t, err)
+	assert.True(t, result.Infected)
+	assert.Equal(t, "Win.Test.EICAR_HDB-1", result.Signature)
+
+	_, err = parseClamdScanReply("INSTREAM size limit exceeded. ERROR")
+	assert.ErrorIs(t, err, ErrSizeLimitExceeded)
+
+	_, err = parseClamdScanReply("stream: Can't allocate memory ERROR")
+	assert.ErrorIs(t, err, ErrUnexpectedResponse)
+}
+
+func TestParseClamdAddress(t *testing.T) {
+	for address, want := range map[string][2]string{
+		"tcp://127.0.0.1:3310":        {"tcp", "127.0.0.1:3310"},
+		"clamav:3310":                 {"tcp", "clamav:3310"},
+		"unix:///run/clamd/clamd.ctl": {"unix", "/run/clamd/clamd.ctl"},
+		"/var/run/clamd.sock":         {"unix", "/var/run/clamd.sock"},
+	} {
+		network, addr, err := parseClamdAddress(address)
+		require.NoError(t, err)
+		assert.Equal(t, want[0], network, address)
+		assert.Equal(t, want[1], addr, address)
+	}
+
+	_, _, err := parseClamdAddress(" ")
+	assert.Error(t, err)
+}
@@ -0,0 +1,34 @@
+package antivirus
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+var (
+	// ErrSizeLimitExceeded 文件超过扫描引擎允许的大小
+	ErrSizeLimitExceeded = errors.New("antivirus: stream size limit exceeded")
+	// ErrUnexpectedResponse 扫描引擎返回了无法识别的结果
+	ErrUnexpectedResponse = errors.New("antivirus: unexpected scanner response")
+)
+
+// Result 扫描结果
+type Result struct {
+	// Infected 是否检出恶意内容
+	Infected bool
+	// Signature 检出的特征名称
+	Signature string
+}
+
+// Scanner 恶意文件扫描引擎
+type Scanner interface {
+	// Name 扫描引擎名称
+	Name() string
+
+	// Ping 检查扫描引擎是否可用
+	Ping(ctx context.Context) error
+
+	// Scan 扫描数据流，检出恶意内容时返回 Infected 为 true 的结果而不是错误
+	Scan(ctx context.Context, r io.Reader) (*Result, error)
+}
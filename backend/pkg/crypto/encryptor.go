@@ -1,6 +1,7 @@
 package crypto
 
 import (
+	"os"
 	"sync"
 )
 
@@ -52,3 +53,21 @@ func DecryptIfNeeded(ciphertext string) (string, error) {
 	}
 	return encryptor.Decrypt(ciphertext)
 }
+
+// EncryptionKeyEnv is the environment variable holding the encryption key
+const EncryptionKeyEnv = "ENCRYPTION_KEY"
+
+// DefaultEncryptor returns the global encryptor if it was initialized with a key,
+// otherwise an encryptor built from the ENCRYPTION_KEY environment variable.
+// It returns nil when no key is configured.
+func DefaultEncryptor() *Encryptor {
+	if encryptor := GetGlobalEncryptor(); len(encryptor.key) > 0 {
+		return encryptor
+	}
+
+	encryptor, err := NewEncryptor(os.Getenv(EncryptionKeyEnv))
+	if err != nil {
+		return nil
+	}
+	return encryptor
+}
@@ -0,0 +1,239 @@
+package crypto
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Streaming format (used for large blobs such as database backups):
+//
+//	header: magic "GWSTRM1" | 8-byte random nonce prefix
+//	frames: 4-byte big-endian ciphertext length | ciphertext
+//
+// Each frame seals up to StreamChunkSize bytes of plaintext with AES-256-GCM.
+// The nonce is the random prefix followed by a 4-byte frame counter, and the
+// additional data marks the last frame so that truncated or reordered streams
+// fail to decrypt.
+
+const (
+	// StreamChunkSize is the plaintext size of each encrypted frame
+	StreamChunkSize = 64 * 1024
+
+	streamMagic       = "GWSTRM1"
+	streamPrefixSize  = 8
+	streamMaxFrameLen = StreamChunkSize + 64
+)
+
+var (
+	ErrStreamTruncated = errors.New("encrypted stream is truncated")
+	ErrStreamCorrupted = errors.New("encrypted stream is corrupted")
+)
+
+var (
+	streamAADMore = []byte{0}
+	streamAADLast = []byte{1}
+)
+
+func (e *Encryptor) newGCM() (cipher.AEAD, error) {
+	if len(e.key) == 0 {
+		return nil, ErrInvalidKey
+	}
+
+	block, err := aes.NewCipher(e.key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCM: %w", err)
+	}
+
+	return gcm, nil
+}
+
+type streamWriter struct {
+	w       io.Writer
+	gcm     cipher.AEAD
+	prefix  []byte
+	counter uint32
+	buf     []byte
+	closed  bool
+}
+
+// NewStreamWriter returns a writer that encrypts everything written to it into w.
+// Close must be called to write the final frame; it does not close w.
+func (e *Encryptor) NewStreamWriter(w io.Writer) (io.WriteCloser, error) {
+	gcm, err := e.newGCM()
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := make([]byte, streamPrefixSize)
+	if _, err = io.ReadFull(rand.Reader, prefix); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	if _, err = w.Write(append([]byte(streamMagic), prefix...)); err != nil {
+		return nil, err
+	}
+
+	return &streamWriter{
+		w:      w,
+		gcm:    gcm,
+		prefix: prefix,
+		buf:    make([]byte, 0, StreamChunkSize),
+	}, nil
+}
+
+func (s *streamWriter) Write(p []byte) (int, error) {
+	if s.closed {
+		return 0, errors.New("write to closed stream")
+	}
+
+	written := 0
+	for len(p) > 0 {
+		// a full buffer is only flushed once more data arrives,
+		// so that the last frame is always written by Close
+		if len(s.buf) == StreamChunkSize {
+			if err := s.flush(false); err != nil {
+				return written, err
+			}
+		}
+
+		n := copy(s.buf[len(s.buf):StreamChunkSize], p)
+		s.buf = s.buf[:len(s.buf)+n]
+		p = p[n:]
+		written += n
+	}
+
+	return written, nil
+}
+
+func (s *streamWriter) Close() error {
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	return s.flush(true)
+}
+
+func (s *streamWriter) flush(last bool) error {
+	aad := streamAADMore
+	if last {
+		aad = streamAADLast
+	}
+
+	sealed := s.gcm.Seal(nil, streamNonce(s.prefix, s.counter), s.buf, aad)
+	s.counter++
+	s.buf = s.buf[:0]
+
+	var header [4]byte
+	binary.BigEndian.PutUint32(header[:], uint32(len(sealed)))
+	if _, err := s.w.Write(header[:]); err != nil {
+		return err
+	}
+	_, err := s.w.Write(sealed)
+	return err
+}
+
+type streamReader struct {
+	r       *bufio.Reader
+	gcm     cipher.AEAD
+	prefix  []byte
+	counter uint32
+	plain   []byte
+	done    bool
+}
+
+// NewStreamReader returns a reader that decrypts a stream produced by NewStreamWriter.
+// Reading returns ErrStreamTruncated if the final frame is missing.
+func (e *Encryptor) NewStreamReader(r io.Reader) (io.Reader, error) {
+	gcm, err := e.newGCM()
+	if err != nil {
+		return nil, err
+	}
+
+	br := bufio.NewReader(r)
+
+	header := make([]byte, len(streamMagic)+streamPrefixSize)
+	if _, err = io.ReadFull(br, header); err != nil {
+		return nil, ErrStreamTruncated
+	}
+	if string(header[:len(streamMagic)]) != streamMagic {
+		return nil, fmt.Errorf("%w: bad header", ErrStreamCorrupted)
+	}
+
+	return &streamReader{
+		r:      br,
+		gcm:    gcm,
+		prefix: header[len(streamMagic):],
+	}, nil
+}
+
+func (s *streamReader) Read(p []byte) (int, error) {
+	for len(s.plain) == 0 {
+		if s.done {
+			return 0, io.EOF
+		}
+		if err := s.next(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, s.plain)
+	s.plain = s.plain[n:]
+	return n, nil
+}
+
+func (s *streamReader) next() error {
+	var header [4]byte
+	if _, err := io.ReadFull(s.r, header[:]); err != nil {
+		return ErrStreamTruncated
+	}
+
+	size := binary.BigEndian.Uint32(header[:])
+	if size > streamMaxFrameLen {
+		return fmt.Errorf("%w: frame too large", ErrStreamCorrupted)
+	}
+
+	sealed := make([]byte, size)
+	if _, err := io.ReadFull(s.r, sealed); err != nil {
+		return ErrStreamTruncated
+	}
+
+	nonce := streamNonce(s.prefix, s.counter)
+	s.counter++
+
+	if plain, err := s.gcm.Open(nil, nonce, sealed, streamAADMore); err == nil {
+		s.plain = plain
+		return nil
+	}
+
+	plain, err := s.gcm.Open(nil, nonce, sealed, streamAADLast)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrDecryptionFailed, err)
+	}
+
+	// nothing may follow the last frame
+	if _, err = s.r.Peek(1); err != io.EOF {
+		return fmt.Errorf("%w: trailing data", ErrStreamCorrupted)
+	}
+
+	s.plain = plain
+	s.done = true
+	return nil
+}
+
+func streamNonce(prefix []byte, counter uint32) []byte {
+	nonce := make([]byte, streamPrefixSize+4)
+	copy(nonce, prefix)
+	binary.BigEndian.PutUint32(nonce[streamPrefixSize:], counter)
+	return nonce
+}
@@ -0,0 +1,93 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"io"
+	"testing"
+)
+
+func encryptStream(t *testing.T, e *Encryptor, plaintext []byte) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	w, err := e.NewStreamWriter(&buf)
+	if err != nil {
+		t.Fatalf("NewStreamWriter() error = %v", err)
+	}
+	// write in odd-sized pieces to cross frame boundaries
+	for len(plaintext) > 0 {
+		n := min(len(plaintext), 10007)
+		if _, err = w.Write(plaintext[:n]); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+		plaintext = plaintext[n:]
+	}
+	if err = w.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	return buf.Bytes()
+}
+
+func decryptStream(e *Encryptor, ciphertext []byte) ([]byte, error) {
+	r, err := e.NewStreamReader(bytes.NewReader(ciphertext))
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(r)
+}
+
+func TestStreamRoundTrip(t *testing.T) {
+	e, _ := NewEncryptor("stream-test-key")
+
+	sizes := []int{0, 1, StreamChunkSize - 1, StreamChunkSize, StreamChunkSize + 1, 3*StreamChunkSize + 17}
+	for _, size := range sizes {
+		plaintext := make([]byte, size)
+		_, _ = rand.Read(plaintext)
+
+		got, err := decryptStream(e, encryptStream(t, e, plaintext))
+		if err != nil {
+			t.Fatalf("size %d: decrypt error = %v", size, err)
+		}
+		if !bytes.Equal(got, plaintext) {
+			t.Fatalf("size %d: round trip mismatch", size)
+		}
+	}
+}
+
+func TestStreamTamper(t *testing.T) {
+	e, _ := NewEncryptor("stream-test-key")
+	plaintext := bytes.Repeat([]byte("backup"), StreamChunkSize)
+	ciphertext := encryptStream(t, e, plaintext)
+
+	// wrong key
+	other, _ := NewEncryptor("another-key")
+	if _, err := decryptStream(other, ciphertext); !errors.Is(err, ErrDecryptionFailed) {
+		t.Errorf("wrong key: error = %v, want ErrDecryptionFailed", err)
+	}
+
+	// flipped byte
+	corrupted := append([]byte(nil), ciphertext...)
+	corrupted[len(corrupted)/2] ^= 0xff
+	if _, err := decryptStream(e, corrupted); err == nil {
+		t.Error("corrupted stream: expected error")
+	}
+
+	// dropping the last frame must not look like a complete stream
+	firstFrameEnd := len(streamMagic) + streamPrefixSize + 4 + StreamChunkSize + 16
+	if _, err := decryptStream(e, ciphertext[:firstFrameEnd]); !errors.Is(err, ErrStreamTruncated) {
+		t.Errorf("truncated stream: error = %v, want ErrStreamTruncated", err)
+	}
+
+	// trailing garbage after the last frame
+	if _, err := decryptStream(e, append(append([]byte(nil), ciphertext...), 0)); !errors.Is(err, ErrStreamCorrupted) {
+		t.Errorf("trailing data: error = %v, want ErrStreamCorrupted", err)
+	}
+}
+
+func TestStreamRequiresKey(t *testing.T) {
+	if _, err := (&Encryptor{}).NewStreamWriter(io.Discard); !errors.Is(err, ErrInvalidKey) {
+		t.Errorf("NewStreamWriter() error = %v, want ErrInvalidKey", err)
+	}
+}
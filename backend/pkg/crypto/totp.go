@@ -0,0 +1,88 @@
+package crypto
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// TOTPPeriod is the time step of a TOTP code (RFC 6238)
+	TOTPPeriod = 30 * time.Second
+	// TOTPDigits is the number of digits of a TOTP code
+	TOTPDigits = 6
+	// TOTPSkew is the number of time steps accepted before and after the current one
+	TOTPSkew = 1
+)
+
+// GenerateTOTP returns the TOTP code of the base32 encoded secret at the given time
+func GenerateTOTP(secret string, t time.Time) (string, error) {
+	key, err := decodeTOTPSecret(secret)
+	if err != nil {
+		return "", err
+	}
+
+	return hotp(key, uint64(t.Unix()/int64(TOTPPeriod/time.Second))), nil
+}
+
+// ValidateTOTP reports whether code is a valid TOTP code of the base32 encoded secret at the given time,
+// allowing TOTPSkew time steps of clock drift
+func ValidateTOTP(secret, code string, t time.Time) bool {
+	code = strings.TrimSpace(code)
+	if len(code) != TOTPDigits {
+		return false
+	}
+
+	key, err := decodeTOTPSecret(secret)
+	if err != nil {
+		return false
+	}
+
+	counter := t.Unix() / int64(TOTPPeriod/time.Second)
+	for i := -TOTPSkew; i <= TOTPSkew; i++ {
+		if counter+int64(i) < 0 {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(hotp(key, uint64(counter+int64(i)))), []byte(code)) == 1 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// decodeTOTPSecret decodes a base32 secret, tolerating lowercase letters, spaces and missing padding
+func decodeTOTPSecret(secret string) ([]byte, error) {
+	secret = strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(secret), " ", ""))
+	secret = strings.TrimRight(secret, "=")
+
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	if err != nil {
+		return nil, fmt.Errorf("invalid totp secret: %w", err)
+	}
+	if len(key) == 0 {
+		return nil, fmt.Errorf("invalid totp secret: empty")
+	}
+
+	return key, nil
+}
+
+// hotp computes the HOTP value of the counter (RFC 4226)
+func hotp(key []byte, counter uint64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", TOTPDigits, value%1000000)
+}
@@ -58,6 +58,29 @@ type UserCreatedEvent struct {
 	Email    string `json:"email"`
 }
 
+// TaskStartedEvent represents a task started event
+type TaskStartedEvent struct {
+	RunID    uint32 `json:"run_id,omitempty"`
+	TaskID   string `json:"task_id"`
+	TaskType string `json:"task_type"`
+	Queue    string `json:"queue,omitempty"`
+	Worker   string `json:"worker,omitempty"`
+	Attempt  uint32 `json:"attempt"`
+	MaxRetry uint32 `json:"max_retry"`
+}
+
+// TaskFailedEvent represents a task failed event
+type TaskFailedEvent struct {
+	RunID     uint32 `json:"run_id,omitempty"`
+	TaskID    string `json:"task_id"`
+	TaskType  string `json:"task_type"`
+	Error     string `json:"error"`
+	Attempt   uint32 `json:"attempt"`
+	MaxRetry  uint32 `json:"max_retry"`
+	WillRetry bool   `json:"will_retry"`
+	Duration  int64  `json:"duration_ms"`
+}
+
 // TaskCompletedEvent represents a task completed event
 type TaskCompletedEvent struct {
 	TaskID     string                 `json:"task_id"`
@@ -0,0 +1,352 @@
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Format is the output format of an export.
+type Format string
+
+const (
+	FormatCSV    Format = "csv"
+	FormatNDJSON Format = "ndjson"
+	FormatXLSX   Format = "xlsx"
+)
+
+// xlsxMaxRows is the row limit of a single worksheet, including the header row.
+const xlsxMaxRows = 1048576
+
+// FileExtension returns the file extension of the format, without the dot.
+func (f Format) FileExtension() string {
+	return string(f)
+}
+
+// ContentType returns the MIME type of the format.
+func (f Format) ContentType() string {
+	switch f {
+	case FormatCSV:
+		return "text/csv; charset=utf-8"
+	case FormatNDJSON:
+		return "application/x-ndjson"
+	case FormatXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	default:
+		return "application/octet-stream"
+	}
+}
+
+// RowWriter streams protobuf messages into an export file.
+type RowWriter interface {
+	// Write appends one message as a row.
+	Write(msg proto.Message) error
+	// Close flushes buffered data. It does not close the underlying writer.
+	Close() error
+}
+
+// NewRowWriter creates a RowWriter for the format.
+//
+// Columns are the top-level fields of desc in declaration order. If fields is not empty,
+// only those fields (proto or JSON names) are exported, in the given order.
+func NewRowWriter(format Format, w io.Writer, desc protoreflect.MessageDescriptor, fields []string) (RowWriter, error) {
+	columns, err := resolveColumns(desc, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	switch format {
+	case FormatCSV:
+		return newCSVWriter(w, columns)
+	case FormatNDJSON:
+		return newNDJSONWriter(w, columns), nil
+	case FormatXLSX:
+		return newXLSXWriter(w, columns)
+	default:
+		return nil, fmt.Errorf("unsupported export format: %s", format)
+	}
+}
+
+func resolveColumns(desc protoreflect.MessageDescriptor, fields []string) ([]protoreflect.FieldDescriptor, error) {
+	all := desc.Fields()
+
+	if len(fields) == 0 {
+		columns := make([]protoreflect.FieldDescriptor, 0, all.Len())
+		for i := 0; i < all.Len(); i++ {
+			columns = append(columns, all.Get(i))
+		}
+		return columns, nil
+	}
+
+	columns := make([]protoreflect.FieldDescriptor, 0, len(fields))
+	for _, name := range fields {
+		fd := all.ByName(protoreflect.Name(name))
+		if fd == nil {
+			fd = all.ByJSONName(name)
+		}
+		if fd == nil {
+			return nil, fmt.Errorf("unknown export field: %s", name)
+		}
+		columns = append(columns, fd)
+	}
+	return columns, nil
+}
+
+func headerOf(columns []protoreflect.FieldDescriptor) []string {
+	header := make([]string, 0, len(columns))
+	for _, fd := range columns {
+		header = append(header, fd.JSONName())
+	}
+	return header
+}
+
+// CellValue renders a field of msg as a flat text cell.
+// Scalars are formatted directly, enums by name, timestamps as RFC 3339,
+// and nested messages, lists and maps as compact JSON.
+func CellValue(msg protoreflect.Message, fd protoreflect.FieldDescriptor) string {
+	if !msg.Has(fd) {
+		return ""
+	}
+	v := msg.Get(fd)
+
+	if fd.IsList() || fd.IsMap() {
+		return marshalField(msg, fd)
+	}
+
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return strconv.FormatBool(v.Bool())
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return string(ev.Name())
+		}
+		return strconv.Itoa(int(v.Enum()))
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return strconv.FormatInt(v.Int(), 10)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return strconv.FormatUint(v.Uint(), 10)
+	case protoreflect.FloatKind:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 32)
+	case protoreflect.DoubleKind:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64)
+	case protoreflect.StringKind:
+		return v.String()
+	case protoreflect.BytesKind:
+		return marshalField(msg, fd)
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		switch m := v.Message().Interface().(type) {
+		case *timestamppb.Timestamp:
+			return m.AsTime().UTC().Format(time.RFC3339Nano)
+		case *durationpb.Duration:
+			return m.AsDuration().String()
+		}
+		b, err := protojson.Marshal(v.Message().Interface())
+		if err != nil {
+			return ""
+		}
+		return compactJSON(b)
+	default:
+		return v.String()
+	}
+}
+
+// marshalField encodes a single field as compact JSON, reusing protojson for well-known types.
+func marshalField(msg protoreflect.Message, fd protoreflect.FieldDescriptor) string {
+	tmp := msg.New()
+	tmp.Set(fd, msg.Get(fd))
+	b, err := protojson.Marshal(tmp.Interface())
+	if err != nil {
+		return ""
+	}
+
+	var wrapper map[string]json.RawMessage
+	if err = json.Unmarshal(b, &wrapper); err != nil {
+		return ""
+	}
+	return compactJSON(wrapper[fd.JSONName()])
+}
+
+// compactJSON removes the insignificant whitespace protojson may emit.
+func compactJSON(b []byte) string {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, b); err != nil {
+		return string(b)
+	}
+	return buf.String()
+}
+
+func rowOf(msg proto.Message, columns []protoreflect.FieldDescriptor) []string {
+	m := msg.ProtoReflect()
+	row := make([]string, 0, len(columns))
+	for _, fd := range columns {
+		row = append(row, CellValue(m, fd))
+	}
+	return row
+}
+
+type csvWriter struct {
+	w       *csv.Writer
+	columns []protoreflect.FieldDescriptor
+}
+
+func newCSVWriter(w io.Writer, columns []protoreflect.FieldDescriptor) (*csvWriter, error) {
+	// UTF-8 BOM so that spreadsheet applications detect the encoding
+	if _, err := w.Write([]byte{0xEF, 0xBB, 0xBF}); err != nil {
+		return nil, err
+	}
+
+	cw := &csvWriter{w: csv.NewWriter(w), columns: columns}
+	if err := cw.w.Write(headerOf(columns)); err != nil {
+		return nil, err
+	}
+	return cw, nil
+}
+
+func (c *csvWriter) Write(msg proto.Message) error {
+	return c.w.Write(rowOf(msg, c.columns))
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+type ndjsonWriter struct {
+	w       *bufio.Writer
+	columns []protoreflect.FieldDescriptor
+	opts    protojson.MarshalOptions
+	partial bool
+}
+
+func newNDJSONWriter(w io.Writer, columns []protoreflect.FieldDescriptor) *ndjsonWriter {
+	return &ndjsonWriter{
+		w:       bufio.NewWriter(w),
+		columns: columns,
+		partial: len(columns) > 0 && columns[0].ContainingMessage().Fields().Len() != len(columns),
+	}
+}
+
+func (n *ndjsonWriter) Write(msg proto.Message) error {
+	if n.partial {
+		m := msg.ProtoReflect()
+		tmp := m.New()
+		for _, fd := range n.columns {
+			if m.Has(fd) {
+				tmp.Set(fd, m.Get(fd))
+			}
+		}
+		msg = tmp.Interface()
+	}
+
+	b, err := n.opts.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	if _, err = n.w.Write(b); err != nil {
+		return err
+	}
+	return n.w.WriteByte('\n')
+}
+
+func (n *ndjsonWriter) Close() error {
+	return n.w.Flush()
+}
+
+type xlsxWriter struct {
+	out     io.Writer
+	file    *excelize.File
+	stream  *excelize.StreamWriter
+	columns []protoreflect.FieldDescriptor
+
+	sheet int
+	row   int
+}
+
+func newXLSXWriter(w io.Writer, columns []protoreflect.FieldDescriptor) (*xlsxWriter, error) {
+	xw := &xlsxWriter{
+		out:     w,
+		file:    excelize.NewFile(),
+		columns: columns,
+	}
+	if err := xw.nextSheet(); err != nil {
+		_ = xw.file.Close()
+		return nil, err
+	}
+	return xw, nil
+}
+
+// nextSheet starts a new worksheet once the current one is full.
+func (x *xlsxWriter) nextSheet() error {
+	if x.stream != nil {
+		if err := x.stream.Flush(); err != nil {
+			return err
+		}
+	}
+
+	x.sheet++
+	name := "Sheet" + strconv.Itoa(x.sheet)
+	if x.sheet > 1 {
+		if _, err := x.file.NewSheet(name); err != nil {
+			return err
+		}
+	}
+
+	stream, err := x.file.NewStreamWriter(name)
+	if err != nil {
+		return err
+	}
+	x.stream = stream
+	x.row = 0
+
+	return x.writeRow(headerOf(x.columns))
+}
+
+func (x *xlsxWriter) writeRow(values []string) error {
+	x.row++
+	cell, err := excelize.CoordinatesToCellName(1, x.row)
+	if err != nil {
+		return err
+	}
+
+	row := make([]any, len(values))
+	for i, v := range values {
+		row[i] = v
+	}
+	return x.stream.SetRow(cell, row)
+}
+
+func (x *xlsxWriter) Write(msg proto.Message) error {
+	if x.row >= xlsxMaxRows {
+		if err := x.nextSheet(); err != nil {
+			return err
+		}
+	}
+	return x.writeRow(rowOf(msg, x.columns))
+}
+
+func (x *xlsxWriter) Close() error {
+	defer func() { _ = x.file.Close() }()
+
+	if x.stream == nil {
+		return errors.New("xlsx writer is not initialized")
+	}
+	if err := x.stream.Flush(); err != nil {
+		return err
+	}
+	return x.file.Write(x.out)
+}
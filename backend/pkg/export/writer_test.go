@@ -0,0 +1,113 @@
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/xuri/excelize/v2"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	auditV1 "go-wind-admin/api/gen/go/audit/service/v1"
+)
+
+func testOperationLogs() []*auditV1.OperationAuditLog {
+	at := time.Date(2024, 5, 1, 8, 30, 0, 0, time.UTC)
+	return []*auditV1.OperationAuditLog{
+		{
+			Id:           trans.Ptr(uint32(1)),
+			Username:     trans.Ptr("alice"),
+			Action:       trans.Ptr(auditV1.OperationAuditLog_EXPORT),
+			Success:      trans.Ptr(true),
+			ResourceType: trans.Ptr("user, \"quoted\""),
+			GeoLocation:  &auditV1.GeoLocation{CountryCode: trans.Ptr("CN")},
+			CreatedAt:    timestamppb.New(at),
+		},
+		{
+			Id:       trans.Ptr(uint32(2)),
+			Username: trans.Ptr("bob"),
+		},
+	}
+}
+
+func TestCSVWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewRowWriter(FormatCSV, &buf, (&auditV1.OperationAuditLog{}).ProtoReflect().Descriptor(),
+		[]string{"id", "username", "action", "success", "resource_type", "geoLocation", "created_at"})
+	assert.NoError(t, err)
+
+	for _, l := range testOperationLogs() {
+		assert.NoError(t, w.Write(l))
+	}
+	assert.NoError(t, w.Close())
+
+	assert.True(t, bytes.HasPrefix(buf.Bytes(), []byte{0xEF, 0xBB, 0xBF}))
+
+	records, err := csv.NewReader(bytes.NewReader(buf.Bytes()[3:])).ReadAll()
+	assert.NoError(t, err)
+	assert.Len(t, records, 3)
+	assert.Equal(t, []string{"id", "username", "action", "success", "resourceType", "geoLocation", "createdAt"}, records[0])
+	assert.Equal(t, []string{"1", "alice", "EXPORT", "true", "user, \"quoted\"", `{"countryCode":"CN"}`, "2024-05-01T08:30:00Z"}, records[1])
+	assert.Equal(t, []string{"2", "bob", "", "", "", "", ""}, records[2])
+}
+
+func TestNDJSONWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewRowWriter(FormatNDJSON, &buf, (&auditV1.OperationAuditLog{}).ProtoReflect().Descriptor(),
+		[]string{"id", "username"})
+	assert.NoError(t, err)
+
+	for _, l := range testOperationLogs() {
+		assert.NoError(t, w.Write(l))
+	}
+	assert.NoError(t, w.Close())
+
+	var lines []map[string]any
+	scanner := bufio.NewScanner(&buf)
+	for scanner.Scan() {
+		var m map[string]any
+		assert.NoError(t, json.Unmarshal(scanner.Bytes(), &m))
+		lines = append(lines, m)
+	}
+	assert.Equal(t, []map[string]any{
+		{"id": float64(1), "username": "alice"},
+		{"id": float64(2), "username": "bob"},
+	}, lines)
+}
+
+func TestXLSXWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewRowWriter(FormatXLSX, &buf, (&auditV1.OperationAuditLog{}).ProtoReflect().Descriptor(),
+		[]string{"id", "username", "action"})
+	assert.NoError(t, err)
+
+	for _, l := range testOperationLogs() {
+		assert.NoError(t, w.Write(l))
+	}
+	assert.NoError(t, w.Close())
+
+	f, err := excelize.OpenReader(&buf)
+	assert.NoError(t, err)
+	defer f.Close()
+
+	rows, err := f.GetRows("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"id", "username", "action"},
+		{"1", "alice", "EXPORT"},
+		{"2", "bob"},
+	}, rows)
+}
+
+func TestUnknownField(t *testing.T) {
+	_, err := NewRowWriter(FormatCSV, &bytes.Buffer{}, (&auditV1.OperationAuditLog{}).ProtoReflect().Descriptor(), []string{"nope"})
+	assert.Error(t, err)
+
+	_, err = NewRowWriter(Format("pdf"), &bytes.Buffer{}, (&auditV1.OperationAuditLog{}).ProtoReflect().Descriptor(), nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,169 @@
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"strings"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+const (
+	// MaxPixels 允许解码的最大像素数，防止解压炸弹耗尽内存
+	MaxPixels = 40_000_000
+
+	// DefaultJpegQuality 默认的 JPEG 编码质量
+	DefaultJpegQuality = 85
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("imaging: unsupported image format")
+	ErrImageTooLarge     = errors.New("imaging: image dimensions too large")
+	ErrInvalidSize       = errors.New("imaging: invalid target size")
+)
+
+// Fit 缩放模式
+type Fit string
+
+const (
+	// FitCover 等比缩放至覆盖目标尺寸，居中裁剪多余部分
+	FitCover Fit = "cover"
+	// FitContain 等比缩放至完全落在目标尺寸内
+	FitContain Fit = "contain"
+	// FitFill 拉伸到目标尺寸
+	FitFill Fit = "fill"
+)
+
+// ParseFit 解析缩放模式，为空时使用 cover
+func ParseFit(s string) (Fit, error) {
+	switch Fit(strings.ToLower(strings.TrimSpace(s))) {
+	case "", FitCover:
+		return FitCover, nil
+	case FitContain:
+		return FitContain, nil
+	case FitFill:
+		return FitFill, nil
+	default:
+		return "", fmt.Errorf("imaging: unknown fit mode %q", s)
+	}
+}
+
+// Decode 解码图片（JPEG、PNG、GIF、WebP），按 EXIF 方向校正 JPEG，返回图片与格式名。
+// 解码后的图片不携带任何元数据，重新编码即可去除 EXIF。
+func Decode(data []byte) (image.Image, string, error) {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		if errors.Is(err, image.ErrFormat) {
+			return nil, "", ErrUnsupportedFormat
+		}
+		return nil, "", err
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > MaxPixels {
+		return nil, "", ErrImageTooLarge
+	}
+
+	img, format, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", err
+	}
+
+	if format == "jpeg" {
+		img = ApplyOrientation(img, Orientation(data))
+	}
+
+	return img, format, nil
+}
+
+// Encode 编码图片，JPEG 源图输出 JPEG，其余输出 PNG（保留透明通道，GIF 只保留首帧），
+// 返回内容类型与扩展名
+func Encode(w io.Writer, img image.Image, format string) (contentType, ext string, err error) {
+	if OutputFormat(format) == "jpeg" {
+		return "image/jpeg", ".jpg", jpeg.Encode(w, img, &jpeg.Options{Quality: DefaultJpegQuality})
+	}
+	return "image/png", ".png", png.Encode(w, img)
+}
+
+// OutputFormat 编码后的格式名，与 Encode 的选择一致
+func OutputFormat(format string) string {
+	if format == "jpeg" {
+		return "jpeg"
+	}
+	return "png"
+}
+
+// Resize 按缩放模式调整图片尺寸，width 或 height 为 0 时按另一边等比缩放，不放大原图
+func Resize(img image.Image, width, height int, fit Fit) (image.Image, error) {
+	if width < 0 || height < 0 || (width == 0 && height == 0) {
+		return nil, ErrInvalidSize
+	}
+
+	bounds := img.Bounds()
+	srcW, srcH := bounds.Dx(), bounds.Dy()
+
+	// 只给出一边时等比缩放
+	if width == 0 {
+		width = max(1, srcW*height/srcH)
+		fit = FitFill
+	} else if height == 0 {
+		height = max(1, srcH*width/srcW)
+		fit = FitFill
+	}
+
+	switch fit {
+	case FitContain:
+		scale := min(float64(width)/float64(srcW), float64(height)/float64(srcH), 1)
+		return scaleTo(img, bounds, max(1, int(float64(srcW)*scale)), max(1, int(float64(srcH)*scale))), nil
+
+	case FitFill:
+		if width >= srcW && height >= srcH {
+			return img, nil
+		}
+		return scaleTo(img, bounds, min(width, srcW), min(height, srcH)), nil
+
+	default:
+		// 不放大：目标尺寸大于原图时按原图可容纳的最大比例裁剪
+		if width > srcW || height > srcH {
+			scale := min(float64(srcW)/float64(width), float64(srcH)/float64(height))
+			width, height = max(1, int(float64(width)*scale)), max(1, int(float64(height)*scale))
+		}
+		crop := coverCrop(bounds, width, height)
+		return scaleTo(img, crop, width, height), nil
+	}
+}
+
+// CropSquare 居中裁剪为正方形，并缩放到不超过 size 的边长（size 为 0 时不缩放）
+func CropSquare(img image.Image, size int) image.Image {
+	bounds := img.Bounds()
+	side := min(bounds.Dx(), bounds.Dy())
+	if size <= 0 || size > side {
+		size = side
+	}
+	return scaleTo(img, coverCrop(bounds, side, side), size, size)
+}
+
+// coverCrop 源图中与目标宽高比一致的最大居中区域
+func coverCrop(bounds image.Rectangle, width, height int) image.Rectangle {
+	srcW, srcH := bounds.Dx(), bounds.Dy()
+
+	cropW, cropH := srcW, srcW*height/width
+	if cropH > srcH {
+		cropW, cropH = srcH*width/height, srcH
+	}
+
+	x := bounds.Min.X + (srcW-cropW)/2
+	y := bounds.Min.Y + (srcH-cropH)/2
+	return image.Rect(x, y, x+cropW, y+cropH)
+}
+
+func scaleTo(img image.Image, src image.Rectangle, width, height int) image.Image {
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, src, draw.Src, nil)
+	return dst
+}
@@ -0,0 +1,170 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestImage(w, h int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, color.RGBA{R: uint8(x * 255 / w), G: uint8(y * 255 / h), A: 255})
+		}
+	}
+	return img
+}
+
+// withExifOrientation 在 JPEG 的 SOI 之后插入只包含方向标签的 APP1 段
+func withExifOrientation(t *testing.T, jpg []byte, orientation uint16) []byte {
+	t.Helper()
+
+	var tiff bytes.Buffer
+	tiff.WriteString("MM")
+	_ = binary.Write(&tiff, binary.BigEndian, uint16(42))
+	_ = binary.Write(&tiff, binary.BigEndian, uint32(8))
+	_ = binary.Write(&tiff, binary.BigEndian, uint16(1))
+	_ = binary.Write(&tiff, binary.BigEndian, uint16(exifOrientationTag))
+	_ = binary.Write(&tiff, binary.BigEndian, uint16(3)) // SHORT
+	_ = binary.Write(&tiff, binary.BigEndian, uint32(1))
+	_ = binary.Write(&tiff, binary.BigEndian, orientation)
+	_ = binary.Write(&tiff, binary.BigEndian, uint16(0))
+	_ = binary.Write(&tiff, binary.BigEndian, uint32(0))
+
+	payload := append([]byte("Exif\x00\x00"), tiff.Bytes()...)
+
+	var out bytes.Buffer
+	out.Write(jpg[:2])
+	out.Write([]byte{0xFF, 0xE1})
+	_ = binary.Write(&out, binary.BigEndian, uint16(len(payload)+2))
+	out.Write(payload)
+	out.Write(jpg[2:])
+	return out.Bytes()
+}
+
+func encodeJpeg(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, img, nil))
+	return buf.Bytes()
+}
+
+func TestOrientation(t *testing.T) {
+	jpg := encodeJpeg(t, newTestImage(8, 4))
+
+	assert.Equal(t, 1, Orientation(jpg))
+	assert.Equal(t, 6, Orientation(withExifOrientation(t, jpg, 6)))
+	assert.Equal(t, 1, Orientation(withExifOrientation(t, jpg, 42)))
+	assert.Equal(t, 1, Orientation([]byte("not a jpeg")))
+}
+
+func TestDecodeAppliesOrientationAndStripsExif(t *testing.T) {
+	jpg := withExifOrientation(t, encodeJpeg(t, newTestImage(8, 4)), 6)
+
+	img, format, err := Decode(jpg)
+	require.NoError(t, err)
+	assert.Equal(t, "jpeg", format)
+	assert.Equal(t, 4, img.Bounds().Dx())
+	assert.Equal(t, 8, img.Bounds().Dy())
+
+	var out bytes.Buffer
+	contentType, ext, err := Encode(&out, img, format)
+	require.NoError(t, err)
+	assert.Equal(t, "image/jpeg", contentType)
+	assert.Equal(t, ".jpg", ext)
+	assert.False(t, bytes.Contains(out.Bytes(), []byte("Exif\x00\x00")))
+	assert.Equal(t, 1, Orientation(out.Bytes()))
+}
+
+func TestDecodeRejectsUnsupported(t *testing.T) {
+	_, _, err := Decode([]byte("plain text"))
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+}
+
+func TestApplyOrientation(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 2, 1))
+	red := color.RGBA{R: 255, A: 255}
+	blue := color.RGBA{B: 255, A: 255}
+	src.Set(0, 0, red)
+	src.Set(1, 0, blue)
+
+	rotated := ApplyOrientation(src, 6)
+	assert.Equal(t, image.Rect(0, 0, 1, 2), rotated.Bounds())
+	assert.Equal(t, red, rotated.At(0, 0))
+	assert.Equal(t, blue, rotated.At(0, 1))
+
+	flipped := ApplyOrientation(src, 2)
+	assert.Equal(t, blue, flipped.At(0, 0))
+
+	assert.Same(t, src, ApplyOrientation(src, 1))
+}
+
+func TestResize(t *testing.T) {
+	img := newTestImage(400, 200)
+
+	cases := []struct {
+		name          string
+		width, height int
+		fit           Fit
+		wantW, wantH  int
+	}{
+		{"cover", 100, 100, FitCover, 100, 100},
+		{"contain", 100, 100, FitContain, 100, 50},
+		{"fill", 100, 100, FitFill, 100, 100},
+		{"width only", 100, 0, FitCover, 100, 50},
+		{"height only", 0, 50, FitContain, 100, 50},
+		{"cover no upscale", 800, 800, FitCover, 200, 200},
+		{"contain no upscale", 800, 800, FitContain, 400, 200},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			out, err := Resize(img, c.width, c.height, c.fit)
+			require.NoError(t, err)
+			assert.Equal(t, c.wantW, out.Bounds().Dx())
+			assert.Equal(t, c.wantH, out.Bounds().Dy())
+		})
+	}
+
+	_, err := Resize(img, 0, 0, FitCover)
+	assert.ErrorIs(t, err, ErrInvalidSize)
+}
+
+func TestCropSquare(t *testing.T) {
+	out := CropSquare(newTestImage(300, 200), 64)
+	assert.Equal(t, image.Rect(0, 0, 64, 64), out.Bounds())
+
+	out = CropSquare(newTestImage(30, 20), 64)
+	assert.Equal(t, image.Rect(0, 0, 20, 20), out.Bounds())
+}
+
+func TestParseFit(t *testing.T) {
+	fit, err := ParseFit("")
+	require.NoError(t, err)
+	assert.Equal(t, FitCover, fit)
+
+	fit, err = ParseFit("Contain")
+	require.NoError(t, err)
+	assert.Equal(t, FitContain, fit)
+
+	_, err = ParseFit("stretch")
+	assert.Error(t, err)
+}
+
+func TestEncodePng(t *testing.T) {
+	var out bytes.Buffer
+	contentType, ext, err := Encode(&out, newTestImage(4, 4), "gif")
+	require.NoError(t, err)
+	assert.Equal(t, "image/png", contentType)
+	assert.Equal(t, ".png", ext)
+
+	_, err = png.Decode(&out)
+	assert.NoError(t, err)
+}
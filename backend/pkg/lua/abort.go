@@ -0,0 +1,37 @@
+package lua
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrHookAborted is matched by every *HookAbortError
+	ErrHookAborted = errors.New("lua hook aborted")
+
+	errScriptReturnedFalse = errors.New("script returned false")
+)
+
+// HookAbortError reports that a script vetoed a hook by returning false or calling ctx.stop()
+type HookAbortError struct {
+	Hook   string
+	Script string
+	Reason string
+}
+
+func (e *HookAbortError) Error() string {
+	return fmt.Sprintf("hook %s aborted by %s: %s", e.Hook, e.Script, e.Reason)
+}
+
+func (e *HookAbortError) Unwrap() error {
+	return ErrHookAborted
+}
+
+// AsHookAbort returns the abort details if err is a hook veto
+func AsHookAbort(err error) (*HookAbortError, bool) {
+	var abort *HookAbortError
+	if errors.As(err, &abort) {
+		return abort, true
+	}
+	return nil, false
+}
@@ -0,0 +1,126 @@
+package api
+
+import (
+	"context"
+	"sort"
+
+	"github.com/go-kratos/kratos/v2/log"
+	lua "github.com/yuin/gopher-lua"
+
+	"go-wind-admin/pkg/lua/internal/convert"
+)
+
+// DataFunc runs a data query on behalf of a Lua script.
+// ctx is the context of the calling script, so the caller's viewer, tenant
+// isolation and data scope apply to the query.
+type DataFunc func(ctx context.Context, args map[string]any) (any, error)
+
+// DataFunction is a function of the kratos_data module
+type DataFunction struct {
+	Name        string
+	Description string
+	Write       bool // Requires the script to be granted data write capability
+	Fn          DataFunc
+}
+
+// DataProvider supplies the functions of the kratos_data module
+type DataProvider interface {
+	DataFunctions() []DataFunction
+}
+
+type dataWriteKey struct{}
+
+// WithDataWrite grants the write functions of the kratos_data module to scripts running with ctx
+func WithDataWrite(ctx context.Context) context.Context {
+	return context.WithValue(ctx, dataWriteKey{}, true)
+}
+
+// ScriptContext returns the context Go functions should use on behalf of the script running on L.
+// The sandbox context of the VM counts every Done() call as an instruction and may only be polled
+// by the VM goroutine, so it hands out its parent instead.
+func ScriptContext(L *lua.LState) context.Context {
+	ctx := L.Context()
+	if sc, ok := ctx.(interface{ ScriptContext() context.Context }); ok {
+		return sc.ScriptContext()
+	}
+	if ctx == nil {
+		return context.Background()
+	}
+	return ctx
+}
+
+// DataWriteAllowed reports whether ctx was granted data write capability
+func DataWriteAllowed(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+	allowed, _ := ctx.Value(dataWriteKey{}).(bool)
+	return allowed
+}
+
+// RegisterData registers the data query API for Lua as a requireable module.
+//
+// Every function takes an optional table of arguments and returns the result
+// as a Lua table, or nil and an error message:
+//
+//	local data = require "kratos_data"
+//	local user, err = data.get_user({ id = 1 })
+//
+// Write functions raise an error unless the script was granted write capability.
+func RegisterData(L *lua.LState, provider DataProvider, logger *log.Helper) {
+	functions := provider.DataFunctions()
+	sort.Slice(functions, func(i, j int) bool { return functions[i].Name < functions[j].Name })
+
+	loader := func(L *lua.LState) int {
+		dataModule := L.NewTable()
+
+		for _, f := range functions {
+			fn := f
+			dataModule.RawSetString(fn.Name, L.NewFunction(func(L *lua.LState) int {
+				ctx := ScriptContext(L)
+
+				if fn.Write && !DataWriteAllowed(ctx) {
+					L.RaiseError("data.%s requires data write capability", fn.Name)
+					return 0
+				}
+
+				args := make(map[string]any)
+				if tbl, ok := L.Get(1).(*lua.LTable); ok {
+					if m, ok := convert.ToGoValue(tbl).(map[string]any); ok {
+						args = m
+					}
+				}
+
+				result, err := fn.Fn(ctx, args)
+				if err != nil {
+					logger.Warnf("data.%s error: %v", fn.Name, err)
+					L.Push(lua.LNil)
+					L.Push(lua.LString(err.Error()))
+					return 2
+				}
+
+				L.Push(convert.ToLuaValue(L, result))
+				return 1
+			}))
+		}
+
+		// data.functions() lists the available functions
+		dataModule.RawSetString("functions", L.NewFunction(func(L *lua.LState) int {
+			list := L.NewTable()
+			for _, fn := range functions {
+				item := L.NewTable()
+				item.RawSetString("name", lua.LString(fn.Name))
+				item.RawSetString("description", lua.LString(fn.Description))
+				item.RawSetString("write", lua.LBool(fn.Write))
+				list.Append(item)
+			}
+			L.Push(list)
+			return 1
+		}))
+
+		L.Push(dataModule)
+		return 1
+	}
+
+	L.PreloadModule("kratos_data", loader)
+}
@@ -0,0 +1,94 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	lua "github.com/yuin/gopher-lua"
+)
+
+type tenantKey struct{}
+
+type testDataProvider struct {
+	updated map[string]any
+}
+
+func (p *testDataProvider) DataFunctions() []DataFunction {
+	return []DataFunction{
+		{
+			Name: "get_user",
+			Fn: func(ctx context.Context, args map[string]any) (any, error) {
+				if args["id"] == nil {
+					return nil, errors.New("id is required")
+				}
+				return map[string]any{
+					"id":        args["id"],
+					"username":  "alice",
+					"tenant_id": ctx.Value(tenantKey{}),
+				}, nil
+			},
+		},
+		{
+			Name:  "update_user",
+			Write: true,
+			Fn: func(_ context.Context, args map[string]any) (any, error) {
+				p.updated = args
+				return true, nil
+			},
+		},
+	}
+}
+
+func newDataState(t *testing.T, provider DataProvider, ctx context.Context) *lua.LState {
+	L := lua.NewState()
+	t.Cleanup(L.Close)
+	L.SetContext(ctx)
+	RegisterData(L, provider, log.NewHelper(log.DefaultLogger))
+	return L
+}
+
+func TestRegisterData_Query(t *testing.T) {
+	ctx := context.WithValue(context.Background(), tenantKey{}, 7)
+	L := newDataState(t, &testDataProvider{}, ctx)
+
+	err := L.DoString(`
+		local data = require "kratos_data"
+
+		local user, err = data.get_user({ id = 1 })
+		assert(err == nil, err)
+		assert(user.username == "alice")
+		assert(user.tenant_id == 7, "query must run with the caller's context")
+
+		local missing, msg = data.get_user()
+		assert(missing == nil)
+		assert(msg == "id is required")
+
+		local names = {}
+		for _, fn in ipairs(data.functions()) do
+			names[#names + 1] = fn.name .. ":" .. tostring(fn.write)
+		end
+		assert(table.concat(names, ",") == "get_user:false,update_user:true")
+	`)
+	assert.NoError(t, err)
+}
+
+func TestRegisterData_WriteRequiresGrant(t *testing.T) {
+	provider := &testDataProvider{}
+	script := `
+		local data = require "kratos_data"
+		data.update_user({ id = 1, nickname = "bob" })
+	`
+
+	L := newDataState(t, provider, context.Background())
+	err := L.DoString(script)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "requires data write capability")
+	assert.Nil(t, provider.updated)
+
+	L = newDataState(t, provider, WithDataWrite(context.Background()))
+	assert.NoError(t, L.DoString(script))
+	assert.Equal(t, "bob", provider.updated["nickname"])
+}
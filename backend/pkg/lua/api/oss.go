@@ -6,14 +6,13 @@ import (
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
-	"github.com/minio/minio-go/v7"
 	lua "github.com/yuin/gopher-lua"
 
 	"go-wind-admin/pkg/oss"
 )
 
 // RegisterOSS registers the OSS (Object Storage Service) API for Lua as a requireable module
-func RegisterOSS(L *lua.LState, ossClient *oss.MinIOClient, logger *log.Helper) {
+func RegisterOSS(L *lua.LState, ossClient oss.ObjectStorage, logger *log.Helper) {
 	// Create loader function that returns the module
 	loader := func(L *lua.LState) int {
 		// Create oss module
@@ -68,9 +67,9 @@ func RegisterOSS(L *lua.LState, ossClient *oss.MinIOClient, logger *log.Helper)
 			// Generate object name
 			objectName, _ := oss.JoinObjectName(contentType, filePath, fileName)
 
-			// Get presigned URL using the underlying MinIO client
+			// Get presigned URL from the storage backend
 			ctx := context.Background()
-			presignedURL, err := ossClient.GetClient().PresignedPutObject(ctx, finalBucketName, objectName, time.Hour)
+			presignedURL, err := ossClient.PresignedPutObject(ctx, finalBucketName, objectName, time.Hour)
 			if err != nil {
 				L.RaiseError("failed to get presigned URL: %v", err)
 				return 0
@@ -81,7 +80,7 @@ func RegisterOSS(L *lua.LState, ossClient *oss.MinIOClient, logger *log.Helper)
 
 			// Create result table
 			result := L.NewTable()
-			result.RawSetString("upload_url", lua.LString(presignedURL.String()))
+			result.RawSetString("upload_url", lua.LString(presignedURL))
 			result.RawSetString("download_url", lua.LString(downloadURL))
 			result.RawSetString("object_name", lua.LString(objectName))
 			result.RawSetString("bucket_name", lua.LString(finalBucketName))
@@ -121,18 +120,12 @@ func RegisterOSS(L *lua.LState, ossClient *oss.MinIOClient, logger *log.Helper)
 			files := L.NewTable()
 			idx := 1
 
-			// Use MinIO's ListObjects with proper options
-			objectCh := ossClient.GetClient().ListObjects(ctx, bucketName, minio.ListObjectsOptions{
-				Prefix:    folder,
-				Recursive: recursive,
-			})
-
-			for object := range objectCh {
-				if object.Err != nil {
-					logger.Errorf("Error listing objects: %v", object.Err)
-					continue
-				}
+			objects, err := ossClient.ListObjects(ctx, bucketName, folder, recursive)
+			if err != nil {
+				logger.Errorf("Error listing objects: %v", err)
+			}
 
+			for _, object := range objects {
 				// Create file info table
 				fileInfo := L.NewTable()
 				fileInfo.RawSetString("key", lua.LString(object.Key))
@@ -155,7 +148,7 @@ func RegisterOSS(L *lua.LState, ossClient *oss.MinIOClient, logger *log.Helper)
 			objectName := L.CheckString(2)
 
 			ctx := context.Background()
-			err := ossClient.GetClient().RemoveObject(ctx, bucketName, objectName, minio.RemoveObjectOptions{})
+			err := ossClient.DeleteFile(ctx, bucketName, objectName)
 			if err != nil {
 				L.Push(lua.LBool(false))
 				L.Push(lua.LString(err.Error()))
@@ -175,7 +168,7 @@ func RegisterOSS(L *lua.LState, ossClient *oss.MinIOClient, logger *log.Helper)
 			content := L.CheckString(3)
 
 			ctx := context.Background()
-			_, _, downloadURL, err := ossClient.UploadFile(ctx, bucketName, objectName, "", []byte(content))
+			_, _, downloadURL, err := oss.UploadFile(ctx, ossClient, bucketName, objectName, "", []byte(content))
 			if err != nil {
 				L.Push(lua.LBool(false))
 				L.Push(lua.LString(err.Error()))
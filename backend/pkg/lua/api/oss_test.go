@@ -4,7 +4,11 @@ import (
 	"testing"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	lua "github.com/yuin/gopher-lua"
+
+	"go-wind-admin/pkg/oss"
 )
 
 // TestRegisterOSS_Module tests that the OSS module can be loaded
@@ -128,3 +132,34 @@ func TestOSSAPI_UploadFlow(t *testing.T) {
 	t.Log("Example OSS upload workflow:")
 	t.Log(workflowScript)
 }
+
+// TestRegisterOSS_LocalStorage runs the module against the local filesystem backend
+func TestRegisterOSS_LocalStorage(t *testing.T) {
+	storage, err := oss.NewLocalStorage(t.TempDir(), "/admin/v1/storage/local", []byte("secret"), log.DefaultLogger)
+	require.NoError(t, err)
+
+	L := lua.NewState()
+	defer L.Close()
+
+	RegisterOSS(L, storage, log.NewHelper(log.DefaultLogger))
+
+	err = L.DoString(`
+		local oss = require "kratos_oss"
+
+		local result = oss.upload_url({content_type = "image/png", file_name = "logo.png", file_path = "brand"})
+		assert(result.bucket_name == "images")
+		assert(string.find(result.upload_url, "X-Signature=", 1, true) ~= nil)
+
+		local ok, url = oss.upload_file("files", "docs/a.txt", "hello")
+		assert(ok, url)
+
+		local files = oss.list_files({bucket_name = "files", folder = "docs/", recursive = true})
+		assert(#files == 1 and files[1].key == "docs/a.txt" and files[1].size == 5)
+
+		assert(oss.delete_file("files", "docs/a.txt"))
+		files = oss.list_files({bucket_name = "files", recursive = true})
+		return #files
+	`)
+	require.NoError(t, err)
+	assert.Equal(t, lua.LNumber(0), L.Get(-1))
+}
@@ -1,12 +1,15 @@
 package api
 
 import (
+	"sync"
+
 	"github.com/go-kratos/kratos/v2/log"
 	lua "github.com/yuin/gopher-lua"
 )
 
 // TaskHandlerRegistry stores Lua-based task handlers
 type TaskHandlerRegistry struct {
+	mu       sync.RWMutex
 	handlers map[string]*LuaTaskHandler
 	logger   *log.Helper
 	engine   VMManager
@@ -36,8 +39,10 @@ var globalTaskRegistry = &TaskHandlerRegistry{
 
 // RegisterTask registers the task API for Lua scripts
 func RegisterTask(L *lua.LState, engine VMManager, logger *log.Helper) {
+	globalTaskRegistry.mu.Lock()
 	globalTaskRegistry.logger = logger
 	globalTaskRegistry.engine = engine
+	globalTaskRegistry.mu.Unlock()
 
 	logger.Info("🔧 Registering task API for Lua scripts")
 
@@ -133,19 +138,22 @@ func registerTaskHandler(L *lua.LState) int {
 	}
 
 	// Register globally
+	globalTaskRegistry.mu.Lock()
 	globalTaskRegistry.handlers[name] = handler
+	engine, logger := globalTaskRegistry.engine, globalTaskRegistry.logger
+	globalTaskRegistry.mu.Unlock()
 
 	// Mark the VM as dedicated so it won't be returned to the pool
 	// This ensures the handler function remains available for execution
-	if globalTaskRegistry.engine != nil {
-		globalTaskRegistry.engine.MarkVMDedicated(L)
-		if globalTaskRegistry.logger != nil {
-			globalTaskRegistry.logger.Debugf("VM marked as dedicated for task handler: %s", name)
+	if engine != nil {
+		engine.MarkVMDedicated(L)
+		if logger != nil {
+			logger.Debugf("VM marked as dedicated for task handler: %s", name)
 		}
 	}
 
-	if globalTaskRegistry.logger != nil {
-		globalTaskRegistry.logger.Infof("📝 Registered Lua task handler: %s (timeout: %ds, retries: %d, priority: %d)",
+	if logger != nil {
+		logger.Infof("📝 Registered Lua task handler: %s (timeout: %ds, retries: %d, priority: %d)",
 			name, timeoutSecs, maxRetries, priority)
 	}
 
@@ -154,17 +162,27 @@ func registerTaskHandler(L *lua.LState) int {
 
 // GetRegisteredHandlers returns all registered Lua task handlers
 func GetRegisteredHandlers() map[string]*LuaTaskHandler {
+	globalTaskRegistry.mu.RLock()
+	defer globalTaskRegistry.mu.RUnlock()
+
 	if globalTaskRegistry.logger != nil {
 		globalTaskRegistry.logger.Infof("📋 GetRegisteredHandlers called: %d handlers available", len(globalTaskRegistry.handlers))
 		for name := range globalTaskRegistry.handlers {
 			globalTaskRegistry.logger.Infof("  - %s", name)
 		}
 	}
-	return globalTaskRegistry.handlers
+	handlers := make(map[string]*LuaTaskHandler, len(globalTaskRegistry.handlers))
+	for name, h := range globalTaskRegistry.handlers {
+		handlers[name] = h
+	}
+	return handlers
 }
 
 // GetHandler returns a specific Lua task handler
 func GetHandler(name string) (*LuaTaskHandler, bool) {
+	globalTaskRegistry.mu.RLock()
+	defer globalTaskRegistry.mu.RUnlock()
+
 	handler, exists := globalTaskRegistry.handlers[name]
 	return handler, exists
 }
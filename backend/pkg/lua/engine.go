@@ -2,8 +2,11 @@ package lua
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
 	"reflect"
+	"strings"
 	"sync"
 	"time"
 
@@ -31,38 +34,64 @@ type Engine struct {
 	pool            *vmPool
 	logger          *log.Helper
 	registry        *hook.Registry
-	rdb             *redis.Client              // Redis client for cache operations
-	eventbusManager *eventbus.Manager          // EventBus manager
-	ossClient       *oss.MinIOClient           // OSS/MinIO client
-	callbacks       map[string][]*CallbackInfo // Hook callbacks (hook name -> multiple callbacks)
-	dedicatedVMs    map[*lua.LState]bool       // VMs that should not be pooled
+	rdb             *redis.Client               // Redis client for cache operations
+	eventbusManager *eventbus.Manager           // EventBus manager
+	ossClient       oss.ObjectStorage           // Object storage backend
+	dataProvider    api.DataProvider            // Application data queries
+	callbacks       map[string][]*CallbackInfo  // Hook callbacks (hook name -> multiple callbacks)
+	dedicatedVMs    map[*lua.LState]bool        // VMs that should not be pooled
+	vmLocks         map[*lua.LState]*sync.Mutex // Serializes calls into dedicated VMs
+	slots           chan struct{}               // Bounds concurrent executions to MaxVMs
+	metrics         *metricsRegistry            // Per-script execution metrics
 	mu              sync.RWMutex
 }
 
 // Config defines Lua engine configuration
 type Config struct {
-	MaxVMs         int           // Maximum concurrent VMs (default: 10)
-	VMTimeout      time.Duration // Execution timeout per script (default: 5s)
-	MaxMemory      int64         // Memory limit per VM in bytes (default: 50MB)
-	EnableDebug    bool          // Enable debug logging
-	ScriptDir      string        // Directory for file-based scripts
-	AllowedModules []string      // Allowed Lua modules
-	PoolSize       int           // VM pool size (default: 5)
+	MaxVMs          int           // Maximum concurrent executions (default: 10, 0 = unlimited)
+	VMTimeout       time.Duration // Execution timeout per script (default: 5s)
+	MaxMemory       int64         // Approximate limit of memory reachable from the VM per execution in bytes, see sandbox.go (default: 50MB, 0 = unlimited)
+	MaxInstructions int64         // Instruction budget per execution (default: 50M, 0 = unlimited)
+	MaxCallDepth    int           // Maximum depth of nested Lua calls (default: 120)
+	MaxStackSlots   int           // Maximum number of value stack slots, bounding locals, arguments and results (default: 64K)
+	EnableDebug     bool          // Enable debug logging
+	ScriptDir       string        // Directory for file-based scripts
+	AllowedModules  []string      // Modules scripts may require (empty = all registered modules)
+	PoolSize        int           // VM pool size (default: 5)
 }
 
 // DefaultConfig returns default configuration
 func DefaultConfig() *Config {
 	return &Config{
-		MaxVMs:         10,
-		VMTimeout:      5 * time.Second,
-		MaxMemory:      50 * 1024 * 1024, // 50MB
-		EnableDebug:    false,
-		ScriptDir:      "scripts",
-		AllowedModules: []string{},
-		PoolSize:       5,
+		MaxVMs:          10,
+		VMTimeout:       5 * time.Second,
+		MaxMemory:       50 * 1024 * 1024, // 50MB
+		MaxInstructions: 50_000_000,
+		MaxCallDepth:    120,
+		MaxStackSlots:   64 * 1024,
+		EnableDebug:     false,
+		ScriptDir:       "scripts",
+		AllowedModules:  []string{},
+		PoolSize:        5,
 	}
 }
 
+// callDepth returns MaxCallDepth, or its default if unset
+func (c *Config) callDepth() int {
+	if c.MaxCallDepth <= 0 {
+		return 120
+	}
+	return c.MaxCallDepth
+}
+
+// stackSlots returns MaxStackSlots, or its default if unset
+func (c *Config) stackSlots() int {
+	if c.MaxStackSlots <= 0 {
+		return 64 * 1024
+	}
+	return c.MaxStackSlots
+}
+
 // NewEngine creates a new Lua engine
 func NewEngine(config *Config, logger log.Logger) *Engine {
 	if config == nil {
@@ -77,6 +106,11 @@ func NewEngine(config *Config, logger log.Logger) *Engine {
 		registry:     hook.NewRegistry(),
 		callbacks:    make(map[string][]*CallbackInfo),
 		dedicatedVMs: make(map[*lua.LState]bool),
+		vmLocks:      make(map[*lua.LState]*sync.Mutex),
+		metrics:      newMetricsRegistry(),
+	}
+	if config.MaxVMs > 0 {
+		engine.slots = make(chan struct{}, config.MaxVMs)
 	}
 
 	// Initialize VM pool
@@ -84,7 +118,8 @@ func NewEngine(config *Config, logger log.Logger) *Engine {
 		return engine.createVM()
 	})
 
-	l.Infof("Lua engine initialized (pool: %d, timeout: %s)", config.PoolSize, config.VMTimeout)
+	l.Infof("Lua engine initialized (pool: %d, timeout: %s, max vms: %d, max memory: %d, max instructions: %d)",
+		config.PoolSize, config.VMTimeout, config.MaxVMs, config.MaxMemory, config.MaxInstructions)
 
 	// Automatically load scripts from ScriptDir if configured
 	if config.ScriptDir != "" {
@@ -98,9 +133,14 @@ func NewEngine(config *Config, logger log.Logger) *Engine {
 
 // createVM creates a new Lua VM with sandbox and API bindings
 func (e *Engine) createVM() *lua.LState {
+	callDepth, stackSlots := e.config.callDepth(), e.config.stackSlots()
+
+	// both stacks are hard limits: the call stack has a fixed size and the value stack
+	// grows on demand up to stackSlots, overflowing either raises an error in the script
 	L := lua.NewState(lua.Options{
-		CallStackSize:       120,
-		RegistrySize:        120 * 20,
+		CallStackSize:       callDepth,
+		RegistrySize:        min(callDepth*20, stackSlots),
+		RegistryMaxSize:     stackSlots,
 		SkipOpenLibs:        true, // We'll selectively open safe libs
 		IncludeGoStackTrace: e.config.EnableDebug,
 	})
@@ -122,6 +162,9 @@ func (e *Engine) openSafeLibs(L *lua.LState) {
 	lua.OpenString(L)
 	lua.OpenMath(L)
 
+	// some builtins can allocate arbitrarily large strings in a single instruction
+	e.guardAllocations(L)
+
 	// Remove dangerous functions from base
 	L.SetGlobal("dofile", lua.LNil)
 	L.SetGlobal("loadfile", lua.LNil)
@@ -139,6 +182,11 @@ func (e *Engine) openSafeLibs(L *lua.LState) {
 	L.SetGlobal("require", L.NewFunction(func(L *lua.LState) int {
 		name := L.CheckString(1)
 
+		if !e.ModuleAllowed(name) {
+			raiseViolation(L, ErrModuleNotAllowed, name)
+			return 0
+		}
+
 		// Check if module is already loaded in package.loaded
 		pkg := L.GetGlobal("package")
 		if pkg == lua.LNil {
@@ -208,6 +256,11 @@ func (e *Engine) registerAPIs(L *lua.LState) {
 		api.RegisterOSS(L, e.ossClient, e.logger)
 	}
 
+	// Register data API if a provider is available
+	if e.dataProvider != nil {
+		api.RegisterData(L, e.dataProvider, e.logger)
+	}
+
 	// Register Crypto API (always available - uses global encryptor)
 	api.RegisterCrypto(L, e.logger)
 
@@ -216,6 +269,9 @@ func (e *Engine) registerAPIs(L *lua.LState) {
 
 	// Register task API for task handler registration
 	api.RegisterTask(L, e, e.logger)
+	if !e.ModuleAllowed("task") {
+		L.SetGlobal("task", lua.LNil)
+	}
 
 	// Register utility API (sleep, time, etc.)
 	api.RegisterUtilAPI(L, e.logger)
@@ -237,7 +293,7 @@ func (e *Engine) registerAPIs(L *lua.LState) {
 	}
 
 	// Load logger and set as global 'log' for convenience
-	if logLoader, ok := preloadTable.RawGetString("logger").(*lua.LFunction); ok {
+	if logLoader, ok := preloadTable.RawGetString("logger").(*lua.LFunction); ok && e.ModuleAllowed("logger") {
 		L.Push(logLoader)
 		if err := L.PCall(0, 1, nil); err == nil {
 			L.SetGlobal("log", L.Get(-1))
@@ -246,7 +302,7 @@ func (e *Engine) registerAPIs(L *lua.LState) {
 	}
 
 	// Load hook and set as global 'hook' for convenience
-	if hookLoader, ok := preloadTable.RawGetString("hook").(*lua.LFunction); ok {
+	if hookLoader, ok := preloadTable.RawGetString("hook").(*lua.LFunction); ok && e.ModuleAllowed("hook") {
 		L.Push(hookLoader)
 		if err := L.PCall(0, 1, nil); err == nil {
 			L.SetGlobal("hook", L.Get(-1))
@@ -264,27 +320,21 @@ func (e *Engine) registerAPIs(L *lua.LState) {
 func (e *Engine) Execute(ctx context.Context, script *Script, execCtx *Context) error {
 	// Get VM from pool
 	L := e.pool.Get()
-	defer e.pool.Put(L)
 
 	// Set execution context
 	if err := e.setContext(L, execCtx); err != nil {
+		e.pool.Put(L)
 		return fmt.Errorf("failed to set context: %w", err)
 	}
 
-	// Create timeout context
-	timeoutCtx, cancel := context.WithTimeout(ctx, e.config.VMTimeout)
-	defer cancel()
-
-	// Execute script with timeout
-	errChan := make(chan error, 1)
-	go func() {
-		// Set context in VM
-		L.SetContext(timeoutCtx)
+	if script.DataWrite {
+		ctx = api.WithDataWrite(ctx)
+	}
 
+	return e.runSandboxed(ctx, script.Name, L, e.config.VMTimeout, func(L *lua.LState) error {
 		// Load and execute script
 		if err := L.DoString(script.Source); err != nil {
-			errChan <- fmt.Errorf("script execution error: %w", err)
-			return
+			return fmt.Errorf("script execution error: %w", err)
 		}
 
 		// Call execute function if exists
@@ -295,8 +345,7 @@ func (e *Engine) Execute(ctx context.Context, script *Script, execCtx *Context)
 
 			// Call function (1 argument, 1 return value)
 			if err := L.PCall(1, 1, nil); err != nil {
-				errChan <- fmt.Errorf("execute function error: %w", err)
-				return
+				return fmt.Errorf("execute function error: %w", err)
 			}
 
 			// Get result
@@ -305,46 +354,51 @@ func (e *Engine) Execute(ctx context.Context, script *Script, execCtx *Context)
 
 			// Check if script returned false (abort)
 			if ret.Type() == lua.LTBool && !lua.LVAsBool(ret) {
-				errChan <- fmt.Errorf("script returned false")
-				return
+				return errScriptReturnedFalse
 			}
 		}
 
-		errChan <- nil
-	}()
-
-	// Wait for completion or timeout
-	select {
-	case err := <-errChan:
-		return err
-	case <-timeoutCtx.Done():
-		// Force stop the VM
-		L.Close()
-		// Create new VM for pool
-		newL := e.createVM()
-		e.pool.Replace(L, newL)
-		return fmt.Errorf("script execution timeout after %s", e.config.VMTimeout)
-	}
+		return nil
+	}, func(err error) {
+		// A VM that hit a limit may hold garbage in its globals; replace it
+		if IsSandboxViolation(err) {
+			e.pool.Replace(L, e.createVM())
+			return
+		}
+		e.pool.Put(L)
+	})
 }
 
-// ExecuteHook executes all scripts registered for a hook
+// ExecuteHook executes all callbacks and scripts registered for a hook.
+//
+// A script or callback that returns false or calls ctx.stop(reason) vetoes the
+// hook: the remaining scripts are skipped and a *HookAbortError is returned.
+// Runtime failures (errors, timeouts, sandbox violations) abort the hook only
+// for Critical scripts; failures of other scripts are logged and skipped.
 func (e *Engine) ExecuteHook(ctx context.Context, hookName string, execCtx *Context) error {
 	// Check for callbacks
 	e.mu.RLock()
 	callbacks := e.callbacks[hookName]
 	e.mu.RUnlock()
 
-	// Execute all callbacks if registered
+	// Execute all callbacks if registered.
+	// Callbacks carry no Critical flag, so any failure aborts the hook.
 	for i, callback := range callbacks {
 		start := time.Now()
-		err := e.executeCallback(ctx, callback, execCtx)
+		err := e.executeCallback(ctx, callback, i+1, execCtx)
 		duration := time.Since(start)
 
+		if errors.Is(err, errScriptReturnedFalse) {
+			return &HookAbortError{Hook: hookName, Script: fmt.Sprintf("callback %d", i+1), Reason: err.Error()}
+		}
 		if err != nil {
 			e.logger.Errorf("Callback %d failed (hook: %s, duration: %s): %v",
 				i+1, hookName, duration, err)
 			return fmt.Errorf("callback %d failed: %w", i+1, err)
 		}
+		if execCtx.Stopped {
+			return &HookAbortError{Hook: hookName, Script: fmt.Sprintf("callback %d", i+1), Reason: execCtx.StopReason}
+		}
 
 		e.logger.Debugf("Callback %d completed (hook: %s, duration: %s)",
 			i+1, hookName, duration)
@@ -359,10 +413,19 @@ func (e *Engine) ExecuteHook(ctx context.Context, hookName string, execCtx *Cont
 
 	e.logger.Debugf("Executing %d scripts for hook: %s", len(hookScripts), hookName)
 
+	var tenantID uint32
+	if execCtx != nil && execCtx.User != nil {
+		tenantID = execCtx.User.TenantID
+	}
+
 	for _, hookScript := range hookScripts {
 		if !hookScript.Enabled {
 			continue
 		}
+		// tenant scripts only run for their own tenant; platform scripts run for everyone
+		if hookScript.TenantID != 0 && hookScript.TenantID != tenantID {
+			continue
+		}
 
 		// ConvertCode hook.Script to lua.Script
 		script := &Script{
@@ -376,18 +439,32 @@ func (e *Engine) ExecuteHook(ctx context.Context, hookName string, execCtx *Cont
 			Version:     hookScript.Version,
 			Author:      hookScript.Author,
 			Critical:    hookScript.Critical,
+			TenantID:    hookScript.TenantID,
+			DataWrite:   hookScript.DataWrite,
 		}
 
 		start := time.Now()
 		err := e.Execute(ctx, script, execCtx)
 		duration := time.Since(start)
 
-		if err != nil {
-			e.logger.Errorf("Script '%s' failed (hook: %s, duration: %s): %v",
+		switch {
+		case errors.Is(err, errScriptReturnedFalse):
+			// Returning false is an explicit veto
+			return &HookAbortError{Hook: hookName, Script: script.Name, Reason: err.Error()}
+
+		case err != nil && script.Critical:
+			e.logger.Errorf("Critical script '%s' failed (hook: %s, duration: %s): %v",
 				script.Name, hookName, duration, err)
-			// Always return error if script fails (including returning false)
-			// This allows hooks to abort processing
 			return fmt.Errorf("script '%s' failed: %w", script.Name, err)
+
+		case err != nil:
+			// Non-critical failures are logged and the remaining scripts still run
+			e.logger.Warnf("Script '%s' failed, skipped (hook: %s, duration: %s): %v",
+				script.Name, hookName, duration, err)
+			continue
+
+		case execCtx.Stopped:
+			return &HookAbortError{Hook: hookName, Script: script.Name, Reason: execCtx.StopReason}
 		}
 
 		e.logger.Debugf("Script '%s' completed (hook: %s, duration: %s)",
@@ -398,27 +475,21 @@ func (e *Engine) ExecuteHook(ctx context.Context, hookName string, execCtx *Cont
 }
 
 // executeCallback executes a registered callback function
-func (e *Engine) executeCallback(ctx context.Context, callback *CallbackInfo, execCtx *Context) error {
-	L := callback.L
-
-	// Create timeout context
-	timeoutCtx, cancel := context.WithTimeout(ctx, e.config.VMTimeout)
-	defer cancel()
+func (e *Engine) executeCallback(ctx context.Context, callback *CallbackInfo, index int, execCtx *Context) error {
+	// callbacks live in dedicated VMs which may be shared with other callbacks and task handlers
+	mu := e.vmLock(callback.L)
+	mu.Lock()
 
-	// Execute callback with timeout
-	errChan := make(chan error, 1)
-	go func() {
-		// Set context in VM
-		L.SetContext(timeoutCtx)
+	name := fmt.Sprintf("%s#callback%d", callback.HookName, index)
 
+	return e.runSandboxed(ctx, name, callback.L, e.config.VMTimeout, func(L *lua.LState) error {
 		// Push function and context argument
 		L.Push(callback.Function)
 		L.Push(e.contextToLuaTable(L, execCtx))
 
 		// Call function (1 argument, 1 return value)
 		if err := L.PCall(1, 1, nil); err != nil {
-			errChan <- fmt.Errorf("callback execution error: %w", err)
-			return
+			return fmt.Errorf("callback execution error: %w", err)
 		}
 
 		// Get result
@@ -427,20 +498,13 @@ func (e *Engine) executeCallback(ctx context.Context, callback *CallbackInfo, ex
 
 		// Check if callback returned false (abort)
 		if ret.Type() == lua.LTBool && !lua.LVAsBool(ret) {
-			errChan <- fmt.Errorf("callback returned false")
-			return
+			return fmt.Errorf("callback: %w", errScriptReturnedFalse)
 		}
 
-		errChan <- nil
-	}()
-
-	// Wait for completion or timeout
-	select {
-	case err := <-errChan:
-		return err
-	case <-timeoutCtx.Done():
-		return fmt.Errorf("callback execution timeout after %s", e.config.VMTimeout)
-	}
+		return nil
+	}, func(error) {
+		mu.Unlock()
+	})
 }
 
 // RegisterHook registers a hook point
@@ -467,6 +531,8 @@ func (e *Engine) AddScript(hookName string, script interface{}) error {
 			Version:     s.Version,
 			Author:      s.Author,
 			Critical:    s.Critical,
+			TenantID:    s.TenantID,
+			DataWrite:   s.DataWrite,
 		}
 	default:
 		// Try to extract fields using reflection for api.Script or similar types
@@ -548,11 +614,56 @@ func (e *Engine) RemoveScript(hookName, scriptName string) error {
 	return e.registry.RemoveScript(hookName, scriptName)
 }
 
+// ReloadScript replaces a managed script (identified by its non-zero ID) in the hook registry.
+// The previous revision is removed from whatever hook it was attached to; disabled scripts
+// are only removed. The source is compiled first so that a broken revision never replaces
+// a working one.
+func (e *Engine) ReloadScript(script *Script) error {
+	if script.ID == 0 {
+		return fmt.Errorf("script id is required")
+	}
+	if err := script.Validate(); err != nil {
+		return err
+	}
+	if err := script.Compile(); err != nil {
+		return err
+	}
+
+	e.registry.RemoveScriptByID(script.ID)
+
+	if !script.Enabled {
+		e.logger.Infof("Unloaded disabled script '%s' (id: %d)", script.Name, script.ID)
+		return nil
+	}
+
+	if err := e.AddScript(script.Hook, script); err != nil {
+		return err
+	}
+
+	e.logger.Infof("Reloaded script '%s' v%d (id: %d, hook: %s)", script.Name, script.Version, script.ID, script.Hook)
+	return nil
+}
+
+// UnloadScript removes a managed script from every hook
+func (e *Engine) UnloadScript(id uint32) bool {
+	return e.registry.RemoveScriptByID(id)
+}
+
 // ListHooks returns all registered hooks
 func (e *Engine) ListHooks() []string {
 	return e.registry.ListHooks()
 }
 
+// HasScripts reports whether any script or callback is attached to a hook,
+// letting callers skip building an execution context for idle hooks
+func (e *Engine) HasScripts(hookName string) bool {
+	e.mu.RLock()
+	callbacks := len(e.callbacks[hookName])
+	e.mu.RUnlock()
+
+	return callbacks > 0 || len(e.registry.GetScripts(hookName)) > 0
+}
+
 // RegisterCallback registers a Lua callback function for a hook
 func (e *Engine) RegisterCallback(hookName string, L *lua.LState, fn *lua.LFunction) {
 	e.mu.Lock()
@@ -580,30 +691,185 @@ func (e *Engine) MarkVMDedicated(L *lua.LState) {
 	e.logger.Debugf("VM marked as dedicated")
 }
 
-// SetRedis sets the Redis client for cache operations
-func (e *Engine) SetRedis(rdb *redis.Client) {
+// moduleAliases maps the short module names to the registered kratos_* modules
+var moduleAliases = map[string]string{
+	"logger": "kratos_logger",
+	"hook":   "kratos_hook",
+	"util":   "kratos_util",
+}
+
+// ModuleAllowed reports whether scripts may require the module.
+// An empty AllowedModules list allows every registered module; aliases match their target module.
+func (e *Engine) ModuleAllowed(name string) bool {
+	if len(e.config.AllowedModules) == 0 {
+		return true
+	}
+
+	target := moduleAliases[name]
+	for _, allowed := range e.config.AllowedModules {
+		if allowed == name || (target != "" && allowed == target) {
+			return true
+		}
+	}
+	return false
+}
+
+// guardAllocations charges the builtins that size their result from their arguments, and table.insert,
+// to the memory budget, since a single call can allocate far more than the periodic estimate allows
+func (e *Engine) guardAllocations(L *lua.LState) {
+	wrap := func(tbl *lua.LTable, name string, guard func(L *lua.LState)) {
+		orig, ok := tbl.RawGetString(name).(*lua.LFunction)
+		if !ok || !orig.IsG {
+			return
+		}
+		tbl.RawSetString(name, L.NewFunction(func(L *lua.LState) int {
+			guard(L)
+			return orig.GFunction(L)
+		}))
+	}
+
+	if strTable, ok := L.GetGlobal("string").(*lua.LTable); ok {
+		wrap(strTable, "rep", func(L *lua.LState) {
+			str := L.CheckString(1)
+			n := L.CheckInt64(2)
+			sep := L.OptString(3, "")
+			if n > 0 {
+				checkAllocation(L, float64(len(str))*float64(n)+float64(len(sep))*float64(n-1), "string.rep")
+			}
+		})
+
+		wrap(strTable, "format", func(L *lua.LState) {
+			checkAllocation(L, estimateFormatSize(L), "string.format")
+		})
+
+		// the size of a substitution is only known once it is done, so its result is checked instead
+		if orig, ok := strTable.RawGetString("gsub").(*lua.LFunction); ok && orig.IsG {
+			strTable.RawSetString("gsub", L.NewFunction(func(L *lua.LState) int {
+				n := orig.GFunction(L)
+				if n > 0 {
+					checkAllocation(L, float64(len(L.ToString(-n))), "string.gsub")
+				}
+				return n
+			}))
+		}
+	}
+
+	if tblTable, ok := L.GetGlobal("table").(*lua.LTable); ok {
+		wrap(tblTable, "insert", func(L *lua.LState) {
+			checkAllocation(L, 40, "table.insert")
+		})
+
+		wrap(tblTable, "concat", func(L *lua.LState) {
+			tbl := L.CheckTable(1)
+			sep := L.OptString(2, "")
+			i := max(L.OptInt(3, 1), 1)
+			j := min(L.OptInt(4, tbl.Len()), tbl.Len())
+
+			var size float64
+			for k := i; k <= j; k++ {
+				size += float64(len(lua.LVAsString(tbl.RawGetInt(k))))
+				if k != j {
+					size += float64(len(sep))
+				}
+			}
+			checkAllocation(L, size, "table.concat")
+		})
+	}
+}
+
+// estimateFormatSize returns an upper bound of the string.format result: the format, the arguments
+// and the widths and precisions of the verbs
+func estimateFormatSize(L *lua.LState) float64 {
+	format := L.CheckString(1)
+
+	size := float64(len(format))
+	for i := 2; i <= L.GetTop(); i++ {
+		size += float64(len(lua.LVAsString(L.Get(i)))) + 32
+	}
+
+	i := 0
+	number := func() float64 {
+		n := 0
+		for i < len(format) && format[i] >= '0' && format[i] <= '9' {
+			n = min(n*10+int(format[i]-'0'), math.MaxInt32)
+			i++
+		}
+		return float64(n)
+	}
+
+	for ; i < len(format); i++ {
+		if format[i] != '%' {
+			continue
+		}
+		i++
+		for i < len(format) && strings.IndexByte("-+ #0", format[i]) >= 0 {
+			i++
+		}
+		size += number() // width
+		if i < len(format) && format[i] == '.' {
+			i++
+			size += number() // precision
+		}
+	}
+
+	return size
+}
+
+// vmLock returns the mutex guarding a dedicated VM, since an LState is not safe for concurrent use
+func (e *Engine) vmLock(L *lua.LState) *sync.Mutex {
 	e.mu.Lock()
 	defer e.mu.Unlock()
+
+	mu, ok := e.vmLocks[L]
+	if !ok {
+		mu = &sync.Mutex{}
+		e.vmLocks[L] = mu
+	}
+	return mu
+}
+
+// SetRedis sets the Redis client for cache operations.
+// Idle pooled VMs are recreated so that they expose the cache API; call the
+// setters before loading scripts.
+func (e *Engine) SetRedis(rdb *redis.Client) {
+	e.mu.Lock()
 	e.rdb = rdb
+	e.mu.Unlock()
+
+	e.pool.Refresh()
 	e.logger.Info("Redis client configured for Lua cache API")
 }
 
 // SetEventBus sets the EventBus manager for event operations
 func (e *Engine) SetEventBus(manager *eventbus.Manager) {
 	e.mu.Lock()
-	defer e.mu.Unlock()
 	e.eventbusManager = manager
+	e.mu.Unlock()
+
+	e.pool.Refresh()
 	e.logger.Info("EventBus manager configured for Lua eventbus API")
 }
 
-// SetOSS sets the OSS client for object storage operations
-func (e *Engine) SetOSS(client *oss.MinIOClient) {
+// SetOSS sets the object storage backend for object storage operations
+func (e *Engine) SetOSS(client oss.ObjectStorage) {
 	e.mu.Lock()
-	defer e.mu.Unlock()
 	e.ossClient = client
+	e.mu.Unlock()
+
+	e.pool.Refresh()
 	e.logger.Info("OSS client configured for Lua OSS API")
 }
 
+// SetDataProvider sets the provider behind the kratos_data module
+func (e *Engine) SetDataProvider(provider api.DataProvider) {
+	e.mu.Lock()
+	e.dataProvider = provider
+	e.mu.Unlock()
+
+	e.pool.Refresh()
+	e.logger.Info("Data provider configured for Lua data API")
+}
+
 // setContext sets the execution context in the VM
 func (e *Engine) setContext(L *lua.LState, ctx *Context) error {
 	// Store context as upvalue for API functions
@@ -615,6 +881,40 @@ func (e *Engine) setContext(L *lua.LState, ctx *Context) error {
 func (e *Engine) contextToLuaTable(L *lua.LState, ctx *Context) *lua.LTable {
 	table := L.NewTable()
 
+	// Expose read-only snapshots of the hook, user and request info
+	table.RawSetString("hook", lua.LString(ctx.HookName))
+	if ctx.User != nil {
+		roles := make([]any, 0, len(ctx.User.Roles))
+		for _, role := range ctx.User.Roles {
+			roles = append(roles, role)
+		}
+		table.RawSetString("user", convert.ToLuaValue(L, map[string]any{
+			"id":        ctx.User.ID,
+			"username":  ctx.User.Username,
+			"email":     ctx.User.Email,
+			"roles":     roles,
+			"tenant_id": ctx.User.TenantID,
+		}))
+		table.RawSetString("tenant_id", lua.LNumber(ctx.User.TenantID))
+	}
+	if ctx.Request != nil {
+		headers := make(map[string]any, len(ctx.Request.Headers))
+		for k, v := range ctx.Request.Headers {
+			headers[k] = v
+		}
+		query := make(map[string]any, len(ctx.Request.Query))
+		for k, v := range ctx.Request.Query {
+			query[k] = v
+		}
+		table.RawSetString("request", convert.ToLuaValue(L, map[string]any{
+			"method":      ctx.Request.Method,
+			"path":        ctx.Request.Path,
+			"remote_addr": ctx.Request.RemoteAddr,
+			"headers":     headers,
+			"query":       query,
+		}))
+	}
+
 	// Add context methods
 	table.RawSetString("get", L.NewFunction(func(L *lua.LState) int {
 		key := L.CheckString(1)
@@ -663,6 +963,7 @@ func (e *Engine) Close() error {
 		}
 	}
 	e.dedicatedVMs = make(map[*lua.LState]bool)
+	e.vmLocks = make(map[*lua.LState]*sync.Mutex)
 	e.mu.Unlock()
 
 	// Close pooled VMs
@@ -738,6 +1039,37 @@ func (p *vmPool) Replace(old, new *lua.LState) {
 	p.Put(new)
 }
 
+// Refresh replaces idle VMs with new ones built by the factory.
+// VMs currently checked out are returned to the pool unchanged.
+func (p *vmPool) Refresh() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return
+	}
+
+	for drained := false; !drained; {
+		select {
+		case vm := <-p.vms:
+			vm.Close()
+		default:
+			drained = true
+		}
+	}
+
+	for len(p.vms) < p.size {
+		vm := p.factory()
+		select {
+		case p.vms <- vm:
+		default:
+			// filled concurrently by Put
+			vm.Close()
+			return
+		}
+	}
+}
+
 func (p *vmPool) Close() {
 	p.mu.Lock()
 	defer p.mu.Unlock()
@@ -25,6 +25,8 @@ type Script struct {
 	Version     int
 	Author      string
 	Critical    bool
+	TenantID    uint32 // Owning tenant (0 = platform script, runs for every tenant)
+	DataWrite   bool   // Grants write functions of the kratos_data module
 }
 
 // Registry manages hooks and their scripts
@@ -73,9 +75,9 @@ func (r *Registry) AddScript(hookName string, script *Script) error {
 		r.hooks[hookName] = hook
 	}
 
-	// Check for duplicate script names
+	// Check for duplicate script names within the same tenant
 	for _, s := range hook.Scripts {
-		if s.Name == script.Name {
+		if s.Name == script.Name && s.TenantID == script.TenantID {
 			return fmt.Errorf("script already exists: %s", script.Name)
 		}
 	}
@@ -110,6 +112,32 @@ func (r *Registry) RemoveScript(hookName, scriptName string) error {
 	return fmt.Errorf("script not found: %s", scriptName)
 }
 
+// RemoveScriptByID removes the script with the given ID from every hook.
+// It reports whether a script was removed.
+func (r *Registry) RemoveScriptByID(id uint32) bool {
+	if id == 0 {
+		return false
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	removed := false
+	for _, hook := range r.hooks {
+		scripts := hook.Scripts[:0]
+		for _, script := range hook.Scripts {
+			if script.ID == id {
+				removed = true
+				continue
+			}
+			scripts = append(scripts, script)
+		}
+		hook.Scripts = scripts
+	}
+
+	return removed
+}
+
 // GetScripts returns all scripts for a hook
 func (r *Registry) GetScripts(hookName string) []*Script {
 	r.mu.RLock()
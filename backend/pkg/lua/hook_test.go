@@ -538,3 +538,98 @@ func TestHookAPI_MixedRegistrationMethods(t *testing.T) {
 
 	t.Log("✓ Mixed registration methods test passed")
 }
+
+func TestExecuteHook_CriticalAndVeto(t *testing.T) {
+	newEngine := func(scripts ...*Script) *Engine {
+		cfg := DefaultConfig()
+		cfg.ScriptDir = ""
+		engine := NewEngine(cfg, log.DefaultLogger)
+		for _, s := range scripts {
+			s.Hook, s.Enabled = "guarded", true
+			if err := engine.AddScript("guarded", s); err != nil {
+				t.Fatalf("AddScript: %v", err)
+			}
+		}
+		return engine
+	}
+	failing := `function execute(ctx) error("boom") end`
+	marker := &Script{Name: "marker", Priority: 10, Source: `function execute(ctx) ctx.set("reached", true) end`}
+
+	t.Run("non-critical failure is skipped", func(t *testing.T) {
+		engine := newEngine(&Script{Name: "flaky", Priority: 1, Source: failing}, marker.Clone())
+		defer engine.Close()
+
+		execCtx := NewContext("guarded")
+		if err := engine.ExecuteHook(context.Background(), "guarded", execCtx); err != nil {
+			t.Fatalf("non-critical failure aborted the hook: %v", err)
+		}
+		if !execCtx.GetBool("reached") {
+			t.Error("scripts after a non-critical failure did not run")
+		}
+	})
+
+	t.Run("critical failure aborts", func(t *testing.T) {
+		engine := newEngine(&Script{Name: "strict", Priority: 1, Critical: true, Source: failing}, marker.Clone())
+		defer engine.Close()
+
+		execCtx := NewContext("guarded")
+		err := engine.ExecuteHook(context.Background(), "guarded", execCtx)
+		if err == nil {
+			t.Fatal("expected critical failure to abort the hook")
+		}
+		if _, ok := AsHookAbort(err); ok {
+			t.Errorf("failure reported as veto: %v", err)
+		}
+		if execCtx.GetBool("reached") {
+			t.Error("scripts after a critical failure ran")
+		}
+	})
+
+	t.Run("stop vetoes", func(t *testing.T) {
+		engine := newEngine(&Script{Name: "guard", Priority: 1, Source: `function execute(ctx) ctx.stop("not allowed") end`}, marker.Clone())
+		defer engine.Close()
+
+		execCtx := NewContext("guarded")
+		err := engine.ExecuteHook(context.Background(), "guarded", execCtx)
+		abort, ok := AsHookAbort(err)
+		if !ok {
+			t.Fatalf("expected a hook abort, got %v", err)
+		}
+		if abort.Script != "guard" || abort.Reason != "not allowed" {
+			t.Errorf("unexpected abort details: %+v", abort)
+		}
+		if execCtx.GetBool("reached") {
+			t.Error("scripts after a veto ran")
+		}
+	})
+}
+
+func TestExecuteHook_UserAndRequest(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.ScriptDir = ""
+	engine := NewEngine(cfg, log.DefaultLogger)
+	defer engine.Close()
+
+	err := engine.AddScript("inspect", &Script{
+		Name:    "inspect",
+		Hook:    "inspect",
+		Enabled: true,
+		Source: `function execute(ctx)
+			ctx.set("seen", ctx.hook .. ":" .. ctx.user.username .. ":" .. ctx.tenant_id .. ":" .. ctx.request.remote_addr .. ":" .. ctx.user.roles[1])
+		end`,
+	})
+	if err != nil {
+		t.Fatalf("AddScript: %v", err)
+	}
+
+	execCtx := NewContext("inspect").
+		WithUser(&UserContext{ID: 1, Username: "alice", Roles: []string{"admin"}, TenantID: 7}).
+		WithRequest(&HTTPContext{Method: "POST", Path: "/login", RemoteAddr: "10.0.0.1"})
+	if err = engine.ExecuteHook(context.Background(), "inspect", execCtx); err != nil {
+		t.Fatalf("ExecuteHook: %v", err)
+	}
+
+	if got, want := execCtx.GetString("seen"), "inspect:alice:7:10.0.0.1:admin"; got != want {
+		t.Errorf("seen = %q, want %q", got, want)
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+
+	lua "github.com/yuin/gopher-lua"
 )
 
 // LoadScriptsFromDir loads all .lua files from a directory
@@ -51,6 +53,19 @@ func (e *Engine) LoadScriptsFromDir(ctx context.Context, dir string) error {
 	return nil
 }
 
+// LoadScriptsFromDirs loads all .lua files from each directory in order
+func (e *Engine) LoadScriptsFromDirs(ctx context.Context, dirs ...string) error {
+	for _, dir := range dirs {
+		if dir == "" {
+			continue
+		}
+		if err := e.LoadScriptsFromDir(ctx, dir); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // LoadScriptFile loads and executes a single Lua script file
 func (e *Engine) LoadScriptFile(ctx context.Context, filePath string) error {
 	e.logger.Debugf("Loading script file: %s", filePath)
@@ -64,27 +79,16 @@ func (e *Engine) LoadScriptFile(ctx context.Context, filePath string) error {
 	// Get a VM from pool
 	L := e.pool.Get()
 
-	// Defer: check if VM is dedicated before returning to pool
-	defer func() {
-		e.mu.RLock()
-		isDedicated := e.dedicatedVMs[L]
-		e.mu.RUnlock()
-
-		if !isDedicated {
-			// Return to pool only if not marked as dedicated
-			e.pool.Put(L)
-		} else {
-			e.logger.Debugf("VM marked as dedicated, not returning to pool")
-		}
-	}()
-
-	// Set context
-	L.SetContext(ctx)
-
 	// Execute the script
 	// This allows the script to call hook.register() and hook.add_script()
-	if err := L.DoString(string(content)); err != nil {
-		return fmt.Errorf("failed to execute script: %w", err)
+	err = e.runSandboxed(ctx, filePath, L, e.config.VMTimeout, func(L *lua.LState) error {
+		if err := L.DoString(string(content)); err != nil {
+			return fmt.Errorf("failed to execute script: %w", err)
+		}
+		return nil
+	}, e.returnLoadedVM(L))
+	if err != nil {
+		return err
 	}
 
 	e.logger.Debugf("Successfully loaded script: %s", filePath)
@@ -98,8 +102,25 @@ func (e *Engine) LoadScriptString(ctx context.Context, scriptName, source string
 	// Get a VM from pool
 	L := e.pool.Get()
 
-	// Defer: check if VM is dedicated before returning to pool
-	defer func() {
+	// Execute the script
+	err := e.runSandboxed(ctx, scriptName, L, e.config.VMTimeout, func(L *lua.LState) error {
+		if err := L.DoString(source); err != nil {
+			return fmt.Errorf("failed to execute script %s: %w", scriptName, err)
+		}
+		return nil
+	}, e.returnLoadedVM(L))
+	if err != nil {
+		return err
+	}
+
+	e.logger.Debugf("Successfully loaded script: %s", scriptName)
+	return nil
+}
+
+// returnLoadedVM returns the VM used to load a script to the pool,
+// unless the script registered callbacks or task handlers in it
+func (e *Engine) returnLoadedVM(L *lua.LState) func(error) {
+	return func(error) {
 		e.mu.RLock()
 		isDedicated := e.dedicatedVMs[L]
 		e.mu.RUnlock()
@@ -110,16 +131,5 @@ func (e *Engine) LoadScriptString(ctx context.Context, scriptName, source string
 		} else {
 			e.logger.Debugf("VM marked as dedicated, not returning to pool")
 		}
-	}()
-
-	// Set context
-	L.SetContext(ctx)
-
-	// Execute the script
-	if err := L.DoString(source); err != nil {
-		return fmt.Errorf("failed to execute script %s: %w", scriptName, err)
 	}
-
-	e.logger.Debugf("Successfully loaded script: %s", scriptName)
-	return nil
 }
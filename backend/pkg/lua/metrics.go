@@ -0,0 +1,98 @@
+package lua
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ScriptMetrics aggregates executions of one script, callback or task handler
+type ScriptMetrics struct {
+	Executions            uint64        `json:"executions"`
+	Failures              uint64        `json:"failures"`
+	Timeouts              uint64        `json:"timeouts"`
+	MemoryViolations      uint64        `json:"memory_violations"`
+	InstructionViolations uint64        `json:"instruction_violations"`
+	ModuleViolations      uint64        `json:"module_violations"`
+	ConcurrencyRejections uint64        `json:"concurrency_rejections"`
+	TotalDuration         time.Duration `json:"total_duration"`
+	MaxDuration           time.Duration `json:"max_duration"`
+	MaxInstructions       int64         `json:"max_instructions"`
+	PeakMemory            int64         `json:"peak_memory"` // Approximate, in bytes
+	LastError             string        `json:"last_error,omitempty"`
+	LastRunAt             time.Time     `json:"last_run_at"`
+}
+
+type metricsRegistry struct {
+	mu      sync.Mutex
+	scripts map[string]*ScriptMetrics
+}
+
+func newMetricsRegistry() *metricsRegistry {
+	return &metricsRegistry{scripts: make(map[string]*ScriptMetrics)}
+}
+
+func (r *metricsRegistry) get(script string) *ScriptMetrics {
+	m, ok := r.scripts[script]
+	if !ok {
+		m = &ScriptMetrics{}
+		r.scripts[script] = m
+	}
+	return m
+}
+
+func (r *metricsRegistry) record(script string, duration time.Duration, steps, memory int64, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	m := r.get(script)
+	m.Executions++
+	m.TotalDuration += duration
+	m.MaxDuration = max(m.MaxDuration, duration)
+	m.MaxInstructions = max(m.MaxInstructions, steps)
+	m.PeakMemory = max(m.PeakMemory, memory)
+	m.LastRunAt = time.Now()
+
+	if err == nil {
+		return
+	}
+
+	m.Failures++
+	m.LastError = err.Error()
+
+	switch {
+	case errors.Is(err, ErrTimeout):
+		m.Timeouts++
+	case errors.Is(err, ErrMemoryLimit):
+		m.MemoryViolations++
+	case errors.Is(err, ErrInstructionLimit):
+		m.InstructionViolations++
+	case errors.Is(err, ErrModuleNotAllowed):
+		m.ModuleViolations++
+	}
+}
+
+func (r *metricsRegistry) recordRejection(script string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	m := r.get(script)
+	m.ConcurrencyRejections++
+	m.LastError = ErrConcurrencyLimit.Error()
+}
+
+func (r *metricsRegistry) snapshot() map[string]ScriptMetrics {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	out := make(map[string]ScriptMetrics, len(r.scripts))
+	for name, m := range r.scripts {
+		out[name] = *m
+	}
+	return out
+}
+
+// Metrics returns a snapshot of the per-script execution metrics
+func (e *Engine) Metrics() map[string]ScriptMetrics {
+	return e.metrics.snapshot()
+}
@@ -0,0 +1,452 @@
+package lua
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	lua "github.com/yuin/gopher-lua"
+
+	"go-wind-admin/pkg/lua/api"
+)
+
+// Sandbox limits.
+//
+// gopher-lua has no allocation or instruction hooks, but its main loop polls
+// ctx.Done() once per instruction. Scripts therefore run under a sandboxContext
+// that counts those polls as steps and, every so often, estimates the memory
+// reachable from the VM (globals, registry and the locals of every active frame).
+//
+// Hard limits:
+//   - the call stack has MaxCallDepth frames and the value stack at most
+//     MaxStackSlots slots. Overflowing either aborts the script with
+//     ErrMemoryLimit, which also bounds recursion, varargs and unpack.
+//   - the builtins that size their result from their arguments (string.rep,
+//     string.format, table.concat) and table.insert are charged against the
+//     memory budget before they allocate, string.gsub on its result. Charges
+//     accumulate until the next estimate, and a charge that does not fit
+//     triggers a fresh estimate first, so garbage from earlier calls does not
+//     count against the script.
+//
+// Approximate limit: memory allocated by the VM itself, such as strings built
+// with the `..` operator, table constructors and table assignments, is only
+// seen by the periodic estimate. A script can exceed MaxMemory by what it
+// allocates between two estimates, at least memoryCheckInterval steps and
+// more for larger heaps. Repeatedly doubling a string with `..` is the worst
+// case, as the overshoot grows exponentially with the number of steps.
+//
+// Only the VM goroutine may poll the sandbox context. Go functions called from
+// scripts use ScriptContext, which hands out the parent context, so that code
+// watching ctx.Done() on other goroutines neither counts as steps nor walks VM
+// state concurrently with the script.
+
+var (
+	ErrMemoryLimit      = errors.New("lua memory limit exceeded")
+	ErrInstructionLimit = errors.New("lua instruction limit exceeded")
+	ErrConcurrencyLimit = errors.New("lua concurrency limit reached")
+	ErrModuleNotAllowed = errors.New("lua module not allowed")
+	ErrTimeout          = errors.New("lua execution timeout")
+)
+
+// SandboxError reports a sandbox limit violation of a script
+type SandboxError struct {
+	Script string // Script, callback or task handler name
+	Kind   error  // One of the Err*Limit / ErrModuleNotAllowed / ErrTimeout errors
+	Detail string
+}
+
+func (e *SandboxError) Error() string {
+	if e.Detail == "" {
+		return fmt.Sprintf("%s: %s", e.Script, e.Kind.Error())
+	}
+	return fmt.Sprintf("%s: %s (%s)", e.Script, e.Kind.Error(), e.Detail)
+}
+
+func (e *SandboxError) Unwrap() error {
+	return e.Kind
+}
+
+// IsSandboxViolation reports whether err was caused by a sandbox limit
+func IsSandboxViolation(err error) bool {
+	var se *SandboxError
+	return errors.As(err, &se)
+}
+
+const (
+	// minimum number of steps between two memory estimates
+	memoryCheckInterval = 10000
+)
+
+var closedChan = func() chan struct{} {
+	ch := make(chan struct{})
+	close(ch)
+	return ch
+}()
+
+// sandboxContext enforces the instruction and memory budget of one execution
+type sandboxContext struct {
+	context.Context
+
+	L         *lua.LState
+	maxSteps  int64
+	maxMemory int64
+
+	steps      atomic.Int64
+	nextCheck  atomic.Int64
+	peakMemory atomic.Int64
+	lastMemory atomic.Int64
+	charged    atomic.Int64 // bytes charged by builtins since the last estimate
+	violation  atomic.Pointer[SandboxError]
+}
+
+func newSandboxContext(parent context.Context, L *lua.LState, config *Config) *sandboxContext {
+	c := &sandboxContext{
+		Context:   parent,
+		L:         L,
+		maxSteps:  config.MaxInstructions,
+		maxMemory: config.MaxMemory,
+	}
+	c.nextCheck.Store(memoryCheckInterval)
+	return c
+}
+
+// Done is polled by the VM before every instruction. It must only be called from the VM goroutine.
+func (c *sandboxContext) Done() <-chan struct{} {
+	if c.Violation() != nil {
+		return closedChan
+	}
+
+	steps := c.steps.Add(1)
+	if c.maxSteps > 0 && steps > c.maxSteps {
+		c.violate(ErrInstructionLimit, fmt.Sprintf("limit %d", c.maxSteps))
+		return closedChan
+	}
+
+	if c.maxMemory > 0 && steps >= c.nextCheck.Load() {
+		used := c.estimate()
+		if used > c.maxMemory {
+			c.violate(ErrMemoryLimit, fmt.Sprintf("~%d bytes, limit %d", used, c.maxMemory))
+			return closedChan
+		}
+		// larger heaps are walked less often so that the checks stay amortized
+		c.nextCheck.Store(steps + max(memoryCheckInterval, used/16))
+	}
+
+	return c.Context.Done()
+}
+
+// AfterFunc lets contexts derived from the sandbox, such as those of LState.NewThread,
+// register for cancellation without a watcher goroutine polling Done.
+func (c *sandboxContext) AfterFunc(f func()) func() bool {
+	return context.AfterFunc(c.Context, f)
+}
+
+func (c *sandboxContext) Err() error {
+	if v := c.Violation(); v != nil {
+		return v
+	}
+	return c.Context.Err()
+}
+
+func (c *sandboxContext) violate(kind error, detail string) {
+	c.violation.CompareAndSwap(nil, &SandboxError{Kind: kind, Detail: detail})
+}
+
+// Violation returns the first limit violation, if any
+func (c *sandboxContext) Violation() *SandboxError {
+	return c.violation.Load()
+}
+
+// estimate walks the VM state and records the reachable memory, which includes everything charged so far
+func (c *sandboxContext) estimate() int64 {
+	used := estimateMemory(c.L, c.maxMemory)
+	c.recordMemory(used)
+	c.charged.Store(0)
+	return used
+}
+
+func (c *sandboxContext) recordMemory(used int64) {
+	c.lastMemory.Store(used)
+	for {
+		peak := c.peakMemory.Load()
+		if used <= peak || c.peakMemory.CompareAndSwap(peak, used) {
+			return
+		}
+	}
+}
+
+// remainingMemory returns the memory budget left after the last estimate and the charges since, or -1 if unlimited
+func (c *sandboxContext) remainingMemory() int64 {
+	if c.maxMemory <= 0 {
+		return -1
+	}
+	return max(0, c.maxMemory-c.lastMemory.Load()-c.charged.Load())
+}
+
+// charge reserves size bytes of the budget, re-estimating once before giving up. It must only be called from the VM goroutine.
+func (c *sandboxContext) charge(size float64) bool {
+	if c.maxMemory <= 0 {
+		return true
+	}
+	if size > float64(c.remainingMemory()) {
+		used := c.estimate()
+		if size > float64(max(0, c.maxMemory-used)) {
+			return false
+		}
+	}
+	c.charged.Add(int64(size))
+	return true
+}
+
+// sandboxFromState returns the sandbox a VM is currently running under
+func sandboxFromState(L *lua.LState) *sandboxContext {
+	sb, _ := L.Context().(*sandboxContext)
+	return sb
+}
+
+// ScriptContext returns the parent context of the sandbox, for Go code acting on behalf of the script
+func (c *sandboxContext) ScriptContext() context.Context {
+	return c.Context
+}
+
+// ScriptContext returns the context Go code should use on behalf of the script running on L
+func ScriptContext(L *lua.LState) context.Context {
+	return api.ScriptContext(L)
+}
+
+// checkAllocation charges an allocation of size bytes to the budget and raises a memory violation if it does not fit
+func checkAllocation(L *lua.LState, size float64, what string) {
+	sb := sandboxFromState(L)
+	if sb == nil {
+		return
+	}
+	if !sb.charge(size) {
+		raiseViolation(L, ErrMemoryLimit, fmt.Sprintf("%s of %.0f bytes", what, size))
+	}
+}
+
+// raiseViolation records a violation on the running sandbox and aborts the script
+func raiseViolation(L *lua.LState, kind error, detail string) {
+	if sb := sandboxFromState(L); sb != nil {
+		sb.violate(kind, detail)
+	}
+	L.RaiseError("%s: %s", kind.Error(), detail)
+}
+
+// stackViolation reports a call or value stack overflow raised by the VM as a memory violation
+func stackViolation(err error, config *Config) *SandboxError {
+	var apiErr *lua.ApiError
+	if !errors.As(err, &apiErr) || apiErr.Object == nil {
+		return nil
+	}
+
+	msg := apiErr.Object.String()
+	switch {
+	case strings.HasSuffix(msg, "stack overflow"):
+		return &SandboxError{Kind: ErrMemoryLimit, Detail: fmt.Sprintf("call depth limit %d", config.callDepth())}
+	case strings.HasSuffix(msg, "registry overflow"):
+		return &SandboxError{Kind: ErrMemoryLimit, Detail: fmt.Sprintf("stack limit %d slots", config.stackSlots())}
+	default:
+		return nil
+	}
+}
+
+// estimateMemory approximates the memory reachable from the VM, stopping early once limit is exceeded
+func estimateMemory(L *lua.LState, limit int64) int64 {
+	m := &memoryWalker{visited: make(map[any]struct{}), limit: limit}
+
+	m.walk(L.G.Global)
+	m.walk(L.G.Registry)
+
+	// locals and temporaries of every active frame
+	for level := 0; level < 256 && !m.exceeded(); level++ {
+		dbg, ok := L.GetStack(level)
+		if !ok {
+			break
+		}
+		for n := 1; ; n++ {
+			name, v := L.GetLocal(dbg, n)
+			if name == "" {
+				break
+			}
+			m.walk(v)
+		}
+	}
+
+	return m.total
+}
+
+type memoryWalker struct {
+	visited map[any]struct{}
+	total   int64
+	limit   int64
+}
+
+func (m *memoryWalker) exceeded() bool {
+	return m.limit > 0 && m.total > m.limit
+}
+
+func (m *memoryWalker) walk(v lua.LValue) {
+	if v == nil || m.exceeded() {
+		return
+	}
+
+	switch val := v.(type) {
+	case lua.LString:
+		m.total += 16 + int64(len(val))
+
+	case *lua.LTable:
+		if m.seen(val) {
+			return
+		}
+		m.total += 64
+		if mt, ok := val.Metatable.(*lua.LTable); ok {
+			m.walk(mt)
+		}
+		val.ForEach(func(k, v lua.LValue) {
+			m.total += 40
+			m.walk(k)
+			m.walk(v)
+		})
+
+	case *lua.LFunction:
+		if m.seen(val) {
+			return
+		}
+		m.total += 64
+		for _, uv := range val.Upvalues {
+			m.total += 24
+			m.walk(uv.Value())
+		}
+
+	case *lua.LUserData:
+		if m.seen(val) {
+			return
+		}
+		m.total += 48
+		m.walk(val.Metatable)
+
+	case *lua.LState:
+		m.total += 256
+
+	default:
+		m.total += 8
+	}
+}
+
+func (m *memoryWalker) seen(p any) bool {
+	if _, ok := m.visited[p]; ok {
+		return true
+	}
+	m.visited[p] = struct{}{}
+	return false
+}
+
+// acquireSlot waits for one of the MaxVMs execution slots.
+// It gives up after VMTimeout so that a saturated engine fails fast instead of piling up callers.
+func (e *Engine) acquireSlot(ctx context.Context, script string) error {
+	if e.slots == nil {
+		return nil
+	}
+
+	select {
+	case e.slots <- struct{}{}:
+		return nil
+	default:
+	}
+
+	timer := time.NewTimer(e.config.VMTimeout)
+	defer timer.Stop()
+
+	select {
+	case e.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+
+	err := &SandboxError{Script: script, Kind: ErrConcurrencyLimit, Detail: fmt.Sprintf("limit %d", cap(e.slots))}
+	e.metrics.recordRejection(script)
+	return err
+}
+
+func (e *Engine) releaseSlot() {
+	if e.slots != nil {
+		<-e.slots
+	}
+}
+
+// runSandboxed runs fn on L under the sandbox limits and records the script metrics.
+// after is called once the VM is idle again, which may be after runSandboxed returned on timeout.
+func (e *Engine) runSandboxed(
+	ctx context.Context,
+	script string,
+	L *lua.LState,
+	timeout time.Duration,
+	fn func(L *lua.LState) error,
+	after func(err error),
+) error {
+	if err := e.acquireSlot(ctx, script); err != nil {
+		if after != nil {
+			after(err)
+		}
+		return err
+	}
+
+	if timeout <= 0 {
+		timeout = e.config.VMTimeout
+	}
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+
+	done := make(chan error, 1)
+	go func() {
+		defer e.releaseSlot()
+		defer cancel()
+
+		start := time.Now()
+		sb := newSandboxContext(timeoutCtx, L, e.config)
+		L.SetContext(sb)
+
+		err := fn(L)
+		L.RemoveContext()
+
+		if v := sb.Violation(); v == nil && err != nil {
+			if v = stackViolation(err, e.config); v != nil {
+				sb.violate(v.Kind, v.Detail)
+			}
+		}
+
+		switch v := sb.Violation(); {
+		case v != nil:
+			err = &SandboxError{Script: script, Kind: v.Kind, Detail: v.Detail}
+		case err != nil && errors.Is(timeoutCtx.Err(), context.DeadlineExceeded):
+			err = &SandboxError{Script: script, Kind: ErrTimeout, Detail: timeout.String()}
+		}
+
+		e.metrics.record(script, time.Since(start), sb.steps.Load(), sb.peakMemory.Load(), err)
+
+		if after != nil {
+			after(err)
+		}
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-timeoutCtx.Done():
+		// fn may have finished just before the deferred cancel fired
+		select {
+		case err := <-done:
+			return err
+		default:
+		}
+		if errors.Is(timeoutCtx.Err(), context.DeadlineExceeded) {
+			return &SandboxError{Script: script, Kind: ErrTimeout, Detail: timeout.String()}
+		}
+		return timeoutCtx.Err()
+	}
+}
@@ -0,0 +1,225 @@
+package lua
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func newSandboxTestEngine(t *testing.T, configure func(*Config)) *Engine {
+	t.Helper()
+
+	config := DefaultConfig()
+	config.ScriptDir = ""
+	config.PoolSize = 1
+	if configure != nil {
+		configure(config)
+	}
+
+	engine := NewEngine(config, log.DefaultLogger)
+	t.Cleanup(func() { _ = engine.Close() })
+	return engine
+}
+
+func runSandboxScript(engine *Engine, name, source string) error {
+	return engine.Execute(context.Background(), &Script{Name: name, Source: source, Enabled: true}, NewContext("sandbox"))
+}
+
+func TestSandbox_InstructionLimit(t *testing.T) {
+	engine := newSandboxTestEngine(t, func(c *Config) {
+		c.MaxInstructions = 100000
+	})
+
+	err := runSandboxScript(engine, "spin", `local i = 0 while true do i = i + 1 end`)
+	if !errors.Is(err, ErrInstructionLimit) {
+		t.Fatalf("expected ErrInstructionLimit, got %v", err)
+	}
+
+	var se *SandboxError
+	if !errors.As(err, &se) || se.Script != "spin" {
+		t.Errorf("expected SandboxError for script spin, got %#v", err)
+	}
+
+	// small scripts still run and the replaced VM is usable
+	if err = runSandboxScript(engine, "small", `local x = 1 + 1`); err != nil {
+		t.Errorf("small script failed: %v", err)
+	}
+
+	m := engine.Metrics()["spin"]
+	if m.Executions != 1 || m.Failures != 1 || m.InstructionViolations != 1 {
+		t.Errorf("unexpected metrics: %+v", m)
+	}
+}
+
+func TestSandbox_MemoryLimit(t *testing.T) {
+	engine := newSandboxTestEngine(t, func(c *Config) {
+		c.MaxMemory = 2 * 1024 * 1024
+		c.MaxInstructions = 0
+		c.VMTimeout = 10 * time.Second
+	})
+
+	err := runSandboxScript(engine, "hog", `
+local t = {}
+for i = 1, 10000000 do
+    t[i] = "item-" .. i
+end
+`)
+	if !errors.Is(err, ErrMemoryLimit) {
+		t.Fatalf("expected ErrMemoryLimit, got %v", err)
+	}
+
+	err = runSandboxScript(engine, "rep", `local s = string.rep("x", 100000000)`)
+	if !errors.Is(err, ErrMemoryLimit) {
+		t.Fatalf("expected ErrMemoryLimit from string.rep, got %v", err)
+	}
+
+	if err = runSandboxScript(engine, "rep_small", `local s = ("ab"):rep(3) assert(s == "ababab")`); err != nil {
+		t.Errorf("small string.rep failed: %v", err)
+	}
+
+	m := engine.Metrics()["hog"]
+	if m.MemoryViolations != 1 || m.PeakMemory <= 2*1024*1024 {
+		t.Errorf("unexpected metrics: %+v", m)
+	}
+}
+
+func TestSandbox_AllocatingBuiltins(t *testing.T) {
+	engine := newSandboxTestEngine(t, func(c *Config) {
+		c.MaxMemory = 2 * 1024 * 1024
+		c.MaxInstructions = 0
+		c.VMTimeout = 10 * time.Second
+	})
+
+	for name, source := range map[string]string{
+		"format": `local s = string.format("%099999999d", 1)`,
+		"concat": `local t = {} local part = ("x"):rep(1024) for i = 1, 4096 do t[i] = part end local s = table.concat(t)`,
+		"gsub":   `local s = ("x"):rep(64):gsub("x", ("y"):rep(65536))`,
+	} {
+		if err := runSandboxScript(engine, name, source); !errors.Is(err, ErrMemoryLimit) {
+			t.Errorf("%s: expected ErrMemoryLimit, got %v", name, err)
+		}
+	}
+
+	err := runSandboxScript(engine, "small", `
+assert(string.format("%5.2f|%s", 1.5, "a") == " 1.50|a")
+assert(table.concat({"a", "b", 3}, ",") == "a,b,3")
+assert(("abc"):gsub("b", "x") == "axc")
+`)
+	if err != nil {
+		t.Errorf("small allocations failed: %v", err)
+	}
+}
+
+func TestSandbox_AllocationCharges(t *testing.T) {
+	engine := newSandboxTestEngine(t, func(c *Config) {
+		c.MaxMemory = 2 * 1024 * 1024
+		c.MaxInstructions = 0
+		c.VMTimeout = 10 * time.Second
+	})
+
+	// every call fits the budget on its own, together they do not
+	err := runSandboxScript(engine, "kept", `local t = {} for i = 1, 8 do t[i] = string.rep("x", 512 * 1024) end`)
+	if !errors.Is(err, ErrMemoryLimit) {
+		t.Fatalf("expected ErrMemoryLimit, got %v", err)
+	}
+
+	// garbage of earlier calls is released by the re-estimate
+	if err = runSandboxScript(engine, "discarded", `for i = 1, 64 do local s = string.rep("x", 512 * 1024) end`); err != nil {
+		t.Errorf("discarded allocations failed: %v", err)
+	}
+}
+
+func TestSandbox_StackLimits(t *testing.T) {
+	engine := newSandboxTestEngine(t, func(c *Config) {
+		c.MaxMemory = 0
+		c.MaxInstructions = 0
+		c.MaxStackSlots = 4096
+	})
+
+	err := runSandboxScript(engine, "recursion", `local function f(n) return f(n + 1) + 1 end f(1)`)
+	if !errors.Is(err, ErrMemoryLimit) {
+		t.Fatalf("expected ErrMemoryLimit from recursion, got %v", err)
+	}
+
+	err = runSandboxScript(engine, "unpack", `local t = {} for i = 1, 100000 do t[i] = i end local u = {unpack(t)}`)
+	if !errors.Is(err, ErrMemoryLimit) {
+		t.Fatalf("expected ErrMemoryLimit from unpack, got %v", err)
+	}
+
+	if err = runSandboxScript(engine, "shallow", `local function f(n) if n == 0 then return 0 end return f(n - 1) + 1 end assert(f(50) == 50)`); err != nil {
+		t.Errorf("shallow recursion failed: %v", err)
+	}
+
+	if m := engine.Metrics()["recursion"]; m.MemoryViolations != 1 {
+		t.Errorf("unexpected metrics: %+v", m)
+	}
+}
+
+func TestSandbox_ModuleAllowList(t *testing.T) {
+	engine := newSandboxTestEngine(t, func(c *Config) {
+		c.AllowedModules = []string{"kratos_logger"}
+	})
+
+	if err := runSandboxScript(engine, "allowed", `local l = require("logger") l.info("ok")`); err != nil {
+		t.Fatalf("allowed module failed: %v", err)
+	}
+
+	err := runSandboxScript(engine, "denied", `local c = require("kratos_crypto")`)
+	if !errors.Is(err, ErrModuleNotAllowed) {
+		t.Fatalf("expected ErrModuleNotAllowed, got %v", err)
+	}
+
+	if err = runSandboxScript(engine, "globals", `assert(task == nil) assert(hook == nil) assert(log ~= nil)`); err != nil {
+		t.Errorf("globals of disallowed modules should be removed: %v", err)
+	}
+
+	if engine.Metrics()["denied"].ModuleViolations != 1 {
+		t.Errorf("unexpected metrics: %+v", engine.Metrics()["denied"])
+	}
+}
+
+func TestSandbox_ConcurrencyLimit(t *testing.T) {
+	engine := newSandboxTestEngine(t, func(c *Config) {
+		c.MaxVMs = 1
+		c.MaxInstructions = 0
+		c.VMTimeout = 200 * time.Millisecond
+	})
+
+	// occupy the only execution slot
+	engine.slots <- struct{}{}
+
+	err := runSandboxScript(engine, "queued", `local x = 1`)
+	if !errors.Is(err, ErrConcurrencyLimit) {
+		t.Fatalf("expected ErrConcurrencyLimit, got %v", err)
+	}
+	if engine.Metrics()["queued"].ConcurrencyRejections != 1 {
+		t.Errorf("unexpected metrics: %+v", engine.Metrics()["queued"])
+	}
+
+	<-engine.slots
+
+	// a runaway script times out and gives its slot back
+	err = runSandboxScript(engine, "busy", `while true do end`)
+	if !errors.Is(err, ErrTimeout) {
+		t.Fatalf("expected ErrTimeout, got %v", err)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		err = runSandboxScript(engine, "queued", `local x = 1`)
+	}()
+	wg.Wait()
+	if err != nil {
+		t.Errorf("script after timeout failed: %v", err)
+	}
+
+	if m := engine.Metrics()["busy"]; m.Timeouts != 1 {
+		t.Errorf("unexpected metrics: %+v", m)
+	}
+}
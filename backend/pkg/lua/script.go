@@ -3,7 +3,11 @@ package lua
 import (
 	"crypto/sha256"
 	"fmt"
+	"strings"
 	"time"
+
+	lua "github.com/yuin/gopher-lua"
+	"github.com/yuin/gopher-lua/parse"
 )
 
 // Script represents a Lua script
@@ -18,6 +22,8 @@ type Script struct {
 	Version     int       `json:"version"`     // Version number
 	Author      string    `json:"author"`      // Creator
 	Critical    bool      `json:"critical"`    // If true, failure stops hook execution
+	TenantID    uint32    `json:"tenant_id"`   // Owning tenant (0 = platform script)
+	DataWrite   bool      `json:"data_write"`  // Grants write functions of the kratos_data module
 	CreateTime  time.Time `json:"create_time"`
 	UpdateTime  time.Time `json:"update_time"`
 }
@@ -56,7 +62,26 @@ func (s *Script) Clone() *Script {
 		Version:     s.Version,
 		Author:      s.Author,
 		Critical:    s.Critical,
+		TenantID:    s.TenantID,
+		DataWrite:   s.DataWrite,
 		CreateTime:  s.CreateTime,
 		UpdateTime:  s.UpdateTime,
 	}
 }
+
+// Compile checks that the script source parses and compiles without running it
+func (s *Script) Compile() error {
+	return CheckSyntax(s.Name, s.Source)
+}
+
+// CheckSyntax parses and compiles Lua source, returning the first syntax error
+func CheckSyntax(name, source string) error {
+	chunk, err := parse.Parse(strings.NewReader(source), name)
+	if err != nil {
+		return fmt.Errorf("syntax error: %w", err)
+	}
+	if _, err = lua.Compile(chunk, name); err != nil {
+		return fmt.Errorf("compile error: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+package lua
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	"go-wind-admin/pkg/lua/api"
+)
+
+func TestCheckSyntax(t *testing.T) {
+	if err := CheckSyntax("ok", `function execute(ctx) return true end`); err != nil {
+		t.Fatalf("valid script rejected: %v", err)
+	}
+	if err := CheckSyntax("broken", `function execute(ctx) return true`); err == nil {
+		t.Fatal("expected a syntax error for a missing end")
+	}
+}
+
+func TestEngine_ReloadScript(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.ScriptDir = ""
+	engine := NewEngine(cfg, log.DefaultLogger)
+	defer engine.Close()
+
+	script := &Script{
+		ID:      1,
+		Name:    "tag",
+		Hook:    "before_save",
+		Source:  `function execute(ctx) ctx.set("tag", "v1") end`,
+		Enabled: true,
+		Version: 1,
+	}
+	if err := engine.ReloadScript(script); err != nil {
+		t.Fatalf("ReloadScript: %v", err)
+	}
+
+	run := func(hookName string) interface{} {
+		execCtx := NewContext(hookName)
+		if err := engine.ExecuteHook(context.Background(), hookName, execCtx); err != nil {
+			t.Fatalf("ExecuteHook(%s): %v", hookName, err)
+		}
+		return execCtx.Get("tag")
+	}
+
+	if got := run("before_save"); got != "v1" {
+		t.Fatalf("tag = %v, want v1", got)
+	}
+
+	// a new revision moved to another hook replaces the old one
+	next := script.Clone()
+	next.Hook = "after_save"
+	next.Source = `function execute(ctx) ctx.set("tag", "v2") end`
+	next.Version = 2
+	if err := engine.ReloadScript(next); err != nil {
+		t.Fatalf("ReloadScript: %v", err)
+	}
+	if got := run("before_save"); got != nil {
+		t.Fatalf("old revision still attached: tag = %v", got)
+	}
+	if got := run("after_save"); got != "v2" {
+		t.Fatalf("tag = %v, want v2", got)
+	}
+
+	// a broken revision keeps the current one
+	broken := next.Clone()
+	broken.Source = `function execute(ctx`
+	if err := engine.ReloadScript(broken); err == nil {
+		t.Fatal("expected compile error")
+	}
+	if got := run("after_save"); got != "v2" {
+		t.Fatalf("tag = %v, want v2 after failed reload", got)
+	}
+
+	// disabling unloads the script
+	disabled := next.Clone()
+	disabled.Enabled = false
+	if err := engine.ReloadScript(disabled); err != nil {
+		t.Fatalf("ReloadScript: %v", err)
+	}
+	if got := run("after_save"); got != nil {
+		t.Fatalf("disabled script still ran: tag = %v", got)
+	}
+}
+
+func TestEngine_ExecuteHookTenantScope(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.ScriptDir = ""
+	engine := NewEngine(cfg, log.DefaultLogger)
+	defer engine.Close()
+
+	for _, s := range []*Script{
+		{ID: 1, Name: "platform", Hook: "h", Enabled: true, Source: `function execute(ctx) ctx.set("platform", true) end`},
+		{ID: 2, Name: "tenant", Hook: "h", Enabled: true, TenantID: 7, Source: `function execute(ctx) ctx.set("tenant", true) end`},
+	} {
+		if err := engine.ReloadScript(s); err != nil {
+			t.Fatalf("ReloadScript(%s): %v", s.Name, err)
+		}
+	}
+
+	for _, tc := range []struct {
+		tenantID uint32
+		tenant   bool
+	}{
+		{tenantID: 0, tenant: false},
+		{tenantID: 7, tenant: true},
+		{tenantID: 8, tenant: false},
+	} {
+		execCtx := NewContext("h")
+		execCtx.User = &UserContext{TenantID: tc.tenantID}
+		if err := engine.ExecuteHook(context.Background(), "h", execCtx); err != nil {
+			t.Fatalf("ExecuteHook: %v", err)
+		}
+		if execCtx.Get("platform") != true {
+			t.Errorf("tenant %d: platform script did not run", tc.tenantID)
+		}
+		if ran := execCtx.Get("tenant") == true; ran != tc.tenant {
+			t.Errorf("tenant %d: tenant script ran = %v, want %v", tc.tenantID, ran, tc.tenant)
+		}
+	}
+}
+
+type testDataProvider struct{}
+
+func (testDataProvider) DataFunctions() []api.DataFunction {
+	return []api.DataFunction{{
+		Name:  "touch",
+		Write: true,
+		Fn: func(context.Context, map[string]any) (any, error) {
+			return true, nil
+		},
+	}}
+}
+
+func TestEngine_DataWriteGrant(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.ScriptDir = ""
+	engine := NewEngine(cfg, log.DefaultLogger)
+	defer engine.Close()
+	engine.SetDataProvider(testDataProvider{})
+
+	source := `function execute(ctx)
+		local data = require "kratos_data"
+		ctx.set("touched", data.touch())
+	end`
+
+	readOnly := &Script{Name: "read-only", Source: source, Enabled: true}
+	execCtx := NewContext("test")
+	if err := engine.Execute(context.Background(), readOnly, execCtx); err == nil {
+		t.Fatal("write function ran without data write capability")
+	}
+
+	writer := &Script{Name: "writer", Source: source, Enabled: true, DataWrite: true}
+	execCtx = NewContext("test")
+	if err := engine.Execute(context.Background(), writer, execCtx); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if !execCtx.GetBool("touched") {
+		t.Error("write function did not run for a granted script")
+	}
+}
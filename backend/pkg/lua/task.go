@@ -0,0 +1,163 @@
+package lua
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	lua "github.com/yuin/gopher-lua"
+
+	"go-wind-admin/pkg/lua/api"
+	"go-wind-admin/pkg/lua/internal/convert"
+)
+
+var (
+	ErrTaskHandlerNotFound = errors.New("lua task handler not found")
+	ErrInvalidTaskPayload  = errors.New("invalid lua task payload")
+)
+
+// TaskHandlers returns the Lua task handlers registered via task.register_handler, sorted by name
+func (e *Engine) TaskHandlers() []*api.LuaTaskHandler {
+	registered := api.GetRegisteredHandlers()
+
+	handlers := make([]*api.LuaTaskHandler, 0, len(registered))
+	for _, h := range registered {
+		handlers = append(handlers, h)
+	}
+	sort.Slice(handlers, func(i, j int) bool {
+		return handlers[i].Name < handlers[j].Name
+	})
+
+	return handlers
+}
+
+// ValidateTaskPayload checks a payload against the handler's required and optional
+// fields and returns a copy with optional defaults filled in.
+// Handlers that declare no fields accept any payload.
+func ValidateTaskPayload(h *api.LuaTaskHandler, payload map[string]interface{}) (map[string]interface{}, error) {
+	data := make(map[string]interface{}, len(payload)+len(h.Optional))
+	for k, v := range payload {
+		data[k] = v
+	}
+
+	var problems []string
+
+	declared := make(map[string]bool, len(h.Required)+len(h.Optional))
+	for _, name := range h.Required {
+		declared[name] = true
+		if v, ok := data[name]; !ok || v == nil {
+			problems = append(problems, fmt.Sprintf("missing required field %q", name))
+		}
+	}
+
+	for name, def := range h.Optional {
+		declared[name] = true
+		v, ok := data[name]
+		if !ok || v == nil {
+			data[name] = def
+			continue
+		}
+		if kind := payloadKind(def); kind != "" && kind != payloadKind(v) {
+			problems = append(problems, fmt.Sprintf("field %q must be a %s", name, kind))
+		}
+	}
+
+	if len(declared) > 0 {
+		for name := range payload {
+			if !declared[name] {
+				problems = append(problems, fmt.Sprintf("unknown field %q", name))
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return nil, fmt.Errorf("%w: %s", ErrInvalidTaskPayload, strings.Join(problems, "; "))
+	}
+
+	return data, nil
+}
+
+// payloadKind classifies a payload value the way optional defaults are declared in Lua
+func payloadKind(v interface{}) string {
+	switch v.(type) {
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case float32, float64, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, json.Number:
+		return "number"
+	case map[string]interface{}, []interface{}:
+		return "table"
+	default:
+		return ""
+	}
+}
+
+// ExecuteTask runs a registered Lua task handler with the given payload.
+//
+// The handler receives the usual context table (get/set/stop). If it returns a
+// table, that table is the task result; otherwise the context data is returned.
+// Returning false or calling ctx.stop() fails the task.
+func (e *Engine) ExecuteTask(ctx context.Context, name string, payload map[string]interface{}) (map[string]interface{}, error) {
+	h, ok := api.GetHandler(name)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrTaskHandlerNotFound, name)
+	}
+
+	data, err := ValidateTaskPayload(h, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := time.Duration(h.TimeoutSecs) * time.Second
+	if timeout <= 0 {
+		timeout = e.config.VMTimeout
+	}
+
+	execCtx := NewContext("task:" + name)
+	execCtx.Data = data
+
+	// the handler's VM is shared by every run of the handler;
+	// the lock is released only once the VM is idle again, even after a timeout
+	mu := e.vmLock(h.L)
+	mu.Lock()
+
+	var result map[string]interface{}
+	err = e.runSandboxed(ctx, execCtx.HookName, h.L, timeout, func(L *lua.LState) error {
+		L.Push(h.Function)
+		L.Push(e.contextToLuaTable(L, execCtx))
+		if err := L.PCall(1, 1, nil); err != nil {
+			return fmt.Errorf("task handler error: %w", err)
+		}
+
+		ret := L.Get(-1)
+		L.Pop(1)
+
+		switch {
+		case execCtx.Stopped:
+			return fmt.Errorf("task handler stopped: %s", execCtx.StopReason)
+		case ret.Type() == lua.LTBool && !lua.LVAsBool(ret):
+			return fmt.Errorf("task handler returned false")
+		case ret.Type() == lua.LTTable:
+			var ok bool
+			if result, ok = convert.ToGoValue(ret).(map[string]interface{}); !ok {
+				result = map[string]interface{}{"result": convert.ToGoValue(ret)}
+			}
+		default:
+			result = execCtx.Data
+		}
+		return nil
+	}, func(error) {
+		mu.Unlock()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
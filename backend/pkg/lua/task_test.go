@@ -0,0 +1,91 @@
+package lua
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestEngine_ExecuteTask(t *testing.T) {
+	config := DefaultConfig()
+	config.ScriptDir = ""
+	engine := NewEngine(config, log.DefaultLogger)
+	defer engine.Close()
+
+	err := engine.LoadScriptString(context.Background(), "task_test", `
+task.register_handler("test.greet", "Greets someone", function(ctx)
+    local greeting = ctx.get("greeting")
+    return { message = greeting .. ", " .. ctx.get("name"), times = ctx.get("times") }
+end, {
+    required = {"name"},
+    optional = {greeting = "hello", times = 1},
+    timeout_secs = 3,
+})
+
+task.register_handler("test.fail", "Always fails", function(ctx)
+    ctx.stop("nothing to do")
+    return true
+end)
+
+task.register_handler("test.slow", "Never finishes", function(ctx)
+    while true do end
+end, { timeout_secs = 1 })
+`)
+	if err != nil {
+		t.Fatalf("Failed to load script: %v", err)
+	}
+
+	var names []string
+	for _, h := range engine.TaskHandlers() {
+		if strings.HasPrefix(h.Name, "test.") {
+			names = append(names, h.Name)
+		}
+	}
+	if strings.Join(names, ",") != "test.fail,test.greet,test.slow" {
+		t.Fatalf("unexpected handlers: %v", names)
+	}
+
+	result, err := engine.ExecuteTask(context.Background(), "test.greet", map[string]interface{}{"name": "lua"})
+	if err != nil {
+		t.Fatalf("ExecuteTask() error = %v", err)
+	}
+	if result["message"] != "hello, lua" || result["times"] != float64(1) {
+		t.Errorf("unexpected result: %v", result)
+	}
+
+	// payload validation
+	_, err = engine.ExecuteTask(context.Background(), "test.greet", map[string]interface{}{"times": "two", "extra": 1})
+	if !errors.Is(err, ErrInvalidTaskPayload) {
+		t.Fatalf("expected ErrInvalidTaskPayload, got %v", err)
+	}
+	for _, want := range []string{`missing required field "name"`, `field "times" must be a number`, `unknown field "extra"`} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not mention %q", err.Error(), want)
+		}
+	}
+
+	if _, err = engine.ExecuteTask(context.Background(), "test.fail", nil); err == nil || !strings.Contains(err.Error(), "nothing to do") {
+		t.Errorf("expected stop reason, got %v", err)
+	}
+
+	if _, err = engine.ExecuteTask(context.Background(), "test.missing", nil); !errors.Is(err, ErrTaskHandlerNotFound) {
+		t.Errorf("expected ErrTaskHandlerNotFound, got %v", err)
+	}
+
+	start := time.Now()
+	if _, err = engine.ExecuteTask(context.Background(), "test.slow", nil); err == nil || !strings.Contains(err.Error(), "timeout") {
+		t.Errorf("expected timeout, got %v", err)
+	}
+	if time.Since(start) > 3*time.Second {
+		t.Errorf("timeout took too long: %s", time.Since(start))
+	}
+
+	// the handler VM is usable again after a timeout
+	if _, err = engine.ExecuteTask(context.Background(), "test.greet", map[string]interface{}{"name": "again"}); err != nil {
+		t.Errorf("ExecuteTask() after timeout error = %v", err)
+	}
+}
@@ -94,13 +94,20 @@ func (l *LoginAuditLogMiddleware) Handle(ctx context.Context, htr *http.Transpor
 		loginAuditLog.Status = trans.Ptr(auditV1.LoginAuditLog_FAILED)
 	}
 
+	// 基于历史登录记录的有状态风险评估
+	var extraScore int
+	var extraFactors []string
+	if l.op.loginRiskEngine != nil && htr.Operation() == l.op.loginOperation {
+		extraScore, extraFactors = l.op.loginRiskEngine.Evaluate(ctx, loginAuditLog)
+	}
+
 	// 计算风险分数和风险等级
-	riskScore := l.computeRiskScore(loginAuditLog)
+	riskScore := l.computeRiskScore(loginAuditLog, extraScore)
 	loginAuditLog.RiskScore = trans.Ptr(riskScore)
 	loginAuditLog.RiskLevel = trans.Ptr(l.levelFromScore(riskScore))
 
 	// 计算风险因素
-	loginAuditLog.RiskFactors = l.computeRiskFactors(loginAuditLog)
+	loginAuditLog.RiskFactors = mergeRiskFactors(l.computeRiskFactors(loginAuditLog), extraFactors...)
 
 	// 计算哈希和签名
 	loginAuditLog.LogHash = trans.Ptr(l.hashLog(loginAuditLog))
@@ -189,12 +196,21 @@ func (l *LoginAuditLogMiddleware) signature(loginAuditLog *auditV1.LoginAuditLog
 	return signBytes
 }
 
-// computeRiskScore 计算登录审计日志的风险分数（0-100）
-func (l *LoginAuditLogMiddleware) computeRiskScore(loginAuditLog *auditV1.LoginAuditLog) uint32 {
+// computeRiskScore 计算登录审计日志的风险分数（0-100），extra 为有状态规则附加的分数
+func (l *LoginAuditLogMiddleware) computeRiskScore(loginAuditLog *auditV1.LoginAuditLog, extra int) uint32 {
 	if loginAuditLog == nil {
 		return 0
 	}
+	return clampRiskScore(statelessRiskScore(loginAuditLog) + extra)
+}
+
+// levelFromScore 根据 risk_score 映射到 risk_level，阈值可按需调整。
+func (l *LoginAuditLogMiddleware) levelFromScore(score uint32) auditV1.LoginAuditLog_RiskLevel {
+	return levelFromRiskScore(score)
+}
 
+// statelessRiskScore 基于单条日志自身字段的无状态风险分数（未截断）
+func statelessRiskScore(loginAuditLog *auditV1.LoginAuditLog) int {
 	score := 0
 
 	// 失败登录权重较高
@@ -226,19 +242,22 @@ func (l *LoginAuditLogMiddleware) computeRiskScore(loginAuditLog *auditV1.LoginA
 		}
 	}
 
-	// 截断到 0-100
+	return score
+}
+
+// clampRiskScore 截断到 0-100
+func clampRiskScore(score int) uint32 {
 	if score < 0 {
 		score = 0
 	}
 	if score > 100 {
 		score = 100
 	}
-
 	return uint32(score)
 }
 
-// levelFromScore 根据 risk_score 映射到 risk_level，阈值可按需调整。
-func (l *LoginAuditLogMiddleware) levelFromScore(score uint32) auditV1.LoginAuditLog_RiskLevel {
+// levelFromRiskScore 根据 risk_score 映射到 risk_level
+func levelFromRiskScore(score uint32) auditV1.LoginAuditLog_RiskLevel {
 	// 常见阈值：0-30 -> LOW；31-70 -> MEDIUM；71-100 -> HIGH
 	switch {
 	case score <= 30:
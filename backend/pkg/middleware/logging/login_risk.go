@@ -0,0 +1,340 @@
+package logging
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/tx7do/go-utils/timeutil"
+	"github.com/tx7do/go-utils/trans"
+
+	auditV1 "go-wind-admin/api/gen/go/audit/service/v1"
+
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+)
+
+// 有状态风险因素常量定义
+const (
+	RiskFactorFirstLogin       = "FIRST_LOGIN"
+	RiskFactorNewDevice        = "NEW_DEVICE"
+	RiskFactorNewCountry       = "NEW_COUNTRY"
+	RiskFactorNewISP           = "NEW_ISP"
+	RiskFactorImpossibleTravel = "IMPOSSIBLE_TRAVEL"
+	RiskFactorUnusualHour      = "UNUSUAL_HOUR"
+	RiskFactorIpFailureBurst   = "IP_FAILURE_BURST"
+	RiskFactorCredentialStuff  = "CREDENTIAL_STUFFING"
+)
+
+// LoginRiskHistory 登录风险评估所需的历史数据来源
+type LoginRiskHistory interface {
+	// ListRecentSuccessLogins 查询用户最近的成功登录记录，按时间倒序，最多 limit 条
+	ListRecentSuccessLogins(ctx context.Context, tenantID uint32, username string, limit int) ([]*auditV1.LoginAuditLog, error)
+
+	// CountFailedLoginsByIP 统计某 IP 自 since 起的失败登录次数，以及涉及的不同用户名数量
+	CountFailedLoginsByIP(ctx context.Context, ip string, since time.Time) (failures int, usernames int, err error)
+}
+
+// LoginRiskAssessment 登录风险评估结果
+type LoginRiskAssessment struct {
+	Score   uint32                          // 风险分数（0-100）
+	Level   auditV1.LoginAuditLog_RiskLevel // 风险等级
+	Factors []string                        // 触发的风险因素
+}
+
+// IsHigh 是否为高风险
+func (a *LoginRiskAssessment) IsHigh() bool {
+	return a != nil && a.Level == auditV1.LoginAuditLog_HIGH
+}
+
+// LoginRiskEngine 基于历史登录记录的有状态风险引擎
+type LoginRiskEngine struct {
+	history LoginRiskHistory
+	log     *log.Helper
+
+	historySize int // 参与比对的历史成功登录条数
+
+	failureWindow        time.Duration // IP 失败爆发统计窗口
+	failureBurst         int           // 窗口内失败次数阈值
+	failureUsernameBurst int           // 窗口内不同用户名数量阈值
+
+	maxTravelSpeedKmh  float64 // 合理的最大移动速度（km/h）
+	minTravelDistance  float64 // 低于该距离（km）不判定为不可能旅行，用于规避 GeoIP 误差
+	minHistoryForHours int     // 判定异常时段所需的最少历史记录数
+
+	now func() time.Time
+}
+
+type LoginRiskEngineOption func(*LoginRiskEngine)
+
+// WithLoginRiskHistorySize 设置参与比对的历史成功登录条数
+func WithLoginRiskHistorySize(size int) LoginRiskEngineOption {
+	return func(e *LoginRiskEngine) {
+		if size > 0 {
+			e.historySize = size
+		}
+	}
+}
+
+// WithLoginRiskFailureBurst 设置 IP 失败爆发的统计窗口与阈值
+func WithLoginRiskFailureBurst(window time.Duration, failures, usernames int) LoginRiskEngineOption {
+	return func(e *LoginRiskEngine) {
+		if window > 0 {
+			e.failureWindow = window
+		}
+		if failures > 0 {
+			e.failureBurst = failures
+		}
+		if usernames > 0 {
+			e.failureUsernameBurst = usernames
+		}
+	}
+}
+
+// WithLoginRiskMaxTravelSpeed 设置不可能旅行判定的最大移动速度（km/h）
+func WithLoginRiskMaxTravelSpeed(kmh float64) LoginRiskEngineOption {
+	return func(e *LoginRiskEngine) {
+		if kmh > 0 {
+			e.maxTravelSpeedKmh = kmh
+		}
+	}
+}
+
+func NewLoginRiskEngine(history LoginRiskHistory, logger log.Logger, opts ...LoginRiskEngineOption) *LoginRiskEngine {
+	e := &LoginRiskEngine{
+		history: history,
+		log:     log.NewHelper(log.With(logger, "module", "login-risk/middleware/logging")),
+
+		historySize: 50,
+
+		failureWindow:        15 * time.Minute,
+		failureBurst:         10,
+		failureUsernameBurst: 5,
+
+		maxTravelSpeedKmh:  900,
+		minTravelDistance:  300,
+		minHistoryForHours: 5,
+
+		now: time.Now,
+	}
+	for _, o := range opts {
+		o(e)
+	}
+	return e
+}
+
+// Evaluate 评估一条登录审计日志，返回有状态规则附加的分数与风险因素
+func (e *LoginRiskEngine) Evaluate(ctx context.Context, la *auditV1.LoginAuditLog) (int, []string) {
+	if e == nil || e.history == nil || la == nil {
+		return 0, nil
+	}
+
+	ctx = appViewer.NewSystemViewerContext(ctx)
+
+	score := 0
+	var factors []string
+	add := func(s int, factor string) {
+		score += s
+		factors = append(factors, factor)
+	}
+
+	// 同一 IP 的失败爆发（跨用户名的撞库/暴力破解）
+	if ip := strings.TrimSpace(la.GetIpAddress()); ip != "" {
+		failures, usernames, err := e.history.CountFailedLoginsByIP(ctx, ip, e.now().Add(-e.failureWindow))
+		if err != nil {
+			e.log.Errorf("count failed logins by ip [%s] failed: %s", ip, err.Error())
+		} else {
+			if usernames >= e.failureUsernameBurst {
+				add(35, RiskFactorCredentialStuff)
+			}
+			if failures >= e.failureBurst {
+				add(20, RiskFactorIpFailureBurst)
+			}
+		}
+	}
+
+	username := la.GetUsername()
+	if username == "" {
+		return score, factors
+	}
+
+	history, err := e.history.ListRecentSuccessLogins(ctx, la.GetTenantId(), username, e.historySize)
+	if err != nil {
+		e.log.Errorf("list recent logins of [%s] failed: %s", username, err.Error())
+		return score, factors
+	}
+	if len(history) == 0 {
+		factors = append(factors, RiskFactorFirstLogin)
+		return score, factors
+	}
+
+	// 从未出现过的设备/客户端
+	if clientID := la.GetDeviceInfo().GetClientId(); clientID != "" && !containsHistory(history, func(h *auditV1.LoginAuditLog) string {
+		return h.GetDeviceInfo().GetClientId()
+	}, clientID) {
+		add(15, RiskFactorNewDevice)
+	}
+
+	// 新的国家/运营商（GeoLite 城市库只提供运营商名称，不含 ASN）
+	if country := la.GetGeoLocation().GetCountryCode(); country != "" && !containsHistory(history, func(h *auditV1.LoginAuditLog) string {
+		return h.GetGeoLocation().GetCountryCode()
+	}, country) {
+		add(20, RiskFactorNewCountry)
+	}
+	if isp := la.GetGeoLocation().GetIsp(); isp != "" && !containsHistory(history, func(h *auditV1.LoginAuditLog) string {
+		return h.GetGeoLocation().GetIsp()
+	}, isp) {
+		add(10, RiskFactorNewISP)
+	}
+
+	// 不可能旅行：与最近一次成功登录的位置比对
+	if e.isImpossibleTravel(la, history[0]) {
+		add(40, RiskFactorImpossibleTravel)
+	}
+
+	// 异常时段
+	if e.isUnusualHour(la, history) {
+		add(10, RiskFactorUnusualHour)
+	}
+
+	return score, factors
+}
+
+// AssessLoginAttempt 在签发令牌前评估当前请求的登录风险（假定凭证校验已通过）
+func (e *LoginRiskEngine) AssessLoginAttempt(ctx context.Context, tenantID uint32, username string) *LoginRiskAssessment {
+	la := &auditV1.LoginAuditLog{
+		ActionType: trans.Ptr(auditV1.LoginAuditLog_LOGIN),
+		Status:     trans.Ptr(auditV1.LoginAuditLog_SUCCESS),
+		Username:   trans.Ptr(username),
+		TenantId:   trans.Ptr(tenantID),
+		CreatedAt:  timeutil.TimeToTimestamppb(trans.Ptr(e.now())),
+	}
+
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		if htr, ok := tr.(*http.Transport); ok {
+			clientIp := getClientRealIP(htr.Request())
+			la.IpAddress = trans.Ptr(clientIp)
+			la.GeoLocation = fillGeoLocation(clientIp)
+			la.DeviceInfo = fillDeviceInfo(htr, nil)
+		}
+	}
+
+	extraScore, factors := e.Evaluate(ctx, la)
+	score := clampRiskScore(statelessRiskScore(la) + extraScore)
+
+	return &LoginRiskAssessment{
+		Score:   score,
+		Level:   levelFromRiskScore(score),
+		Factors: factors,
+	}
+}
+
+// isImpossibleTravel 判断两次登录之间的移动速度是否超出合理范围
+func (e *LoginRiskEngine) isImpossibleTravel(current, previous *auditV1.LoginAuditLog) bool {
+	curGeo, prevGeo := current.GetGeoLocation(), previous.GetGeoLocation()
+	if curGeo == nil || prevGeo == nil ||
+		curGeo.Latitude == nil || curGeo.Longitude == nil ||
+		prevGeo.Latitude == nil || prevGeo.Longitude == nil {
+		return false
+	}
+
+	distance := haversineKm(
+		float64(prevGeo.GetLatitude())/1e6, float64(prevGeo.GetLongitude())/1e6,
+		float64(curGeo.GetLatitude())/1e6, float64(curGeo.GetLongitude())/1e6,
+	)
+	if distance < e.minTravelDistance {
+		return false
+	}
+
+	curAt := e.now()
+	if current.GetCreatedAt() != nil {
+		curAt = current.GetCreatedAt().AsTime()
+	}
+	if previous.GetCreatedAt() == nil {
+		return false
+	}
+
+	// 上一次登录晚于本次（时钟偏差或记录乱序）时无法判断移动速度
+	elapsed := curAt.Sub(previous.GetCreatedAt().AsTime())
+	if elapsed < 0 {
+		return false
+	}
+
+	hours := elapsed.Hours()
+	if hours == 0 {
+		// 同一时刻出现在相距超过 minTravelDistance 的两地
+		return true
+	}
+
+	return distance/hours > e.maxTravelSpeedKmh
+}
+
+// isUnusualHour 判断本次登录的小时是否落在用户历史登录时段之外（前后各容忍 1 小时）
+func (e *LoginRiskEngine) isUnusualHour(current *auditV1.LoginAuditLog, history []*auditV1.LoginAuditLog) bool {
+	if len(history) < e.minHistoryForHours {
+		return false
+	}
+
+	var hours [24]int
+	for _, h := range history {
+		if h.GetCreatedAt() == nil {
+			continue
+		}
+		hours[h.GetCreatedAt().AsTime().UTC().Hour()]++
+	}
+
+	curAt := e.now()
+	if current.GetCreatedAt() != nil {
+		curAt = current.GetCreatedAt().AsTime()
+	}
+	hour := curAt.UTC().Hour()
+
+	return hours[hour] == 0 && hours[(hour+23)%24] == 0 && hours[(hour+1)%24] == 0
+}
+
+// containsHistory 判断历史记录中是否出现过指定值
+func containsHistory(history []*auditV1.LoginAuditLog, get func(*auditV1.LoginAuditLog) string, value string) bool {
+	for _, h := range history {
+		if get(h) == value {
+			return true
+		}
+	}
+	return false
+}
+
+// haversineKm 计算两个经纬度之间的球面距离（千米）
+func haversineKm(lat1, lng1, lat2, lng2 float64) float64 {
+	const earthRadiusKm = 6371.0
+
+	toRad := func(d float64) float64 { return d * math.Pi / 180 }
+
+	dLat := toRad(lat2 - lat1)
+	dLng := toRad(lng2 - lng1)
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(lat1))*math.Cos(toRad(lat2))*math.Sin(dLng/2)*math.Sin(dLng/2)
+
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// mergeRiskFactors 合并风险因素（去重、排序）
+func mergeRiskFactors(base []string, extra ...string) []string {
+	set := make(map[string]struct{}, len(base)+len(extra))
+	for _, f := range base {
+		set[f] = struct{}{}
+	}
+	for _, f := range extra {
+		set[f] = struct{}{}
+	}
+
+	out := make([]string, 0, len(set))
+	for k := range set {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,145 @@
+package logging
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/tx7do/go-utils/timeutil"
+	"github.com/tx7do/go-utils/trans"
+
+	auditV1 "go-wind-admin/api/gen/go/audit/service/v1"
+)
+
+type fakeLoginRiskHistory struct {
+	logins    []*auditV1.LoginAuditLog
+	failures  int
+	usernames int
+}
+
+func (f *fakeLoginRiskHistory) ListRecentSuccessLogins(_ context.Context, _ uint32, _ string, _ int) ([]*auditV1.LoginAuditLog, error) {
+	return f.logins, nil
+}
+
+func (f *fakeLoginRiskHistory) CountFailedLoginsByIP(_ context.Context, _ string, _ time.Time) (int, int, error) {
+	return f.failures, f.usernames, nil
+}
+
+func newRiskTestLog(at time.Time, clientID, country, isp string, lat, lng int64) *auditV1.LoginAuditLog {
+	return &auditV1.LoginAuditLog{
+		Username:  trans.Ptr("alice"),
+		IpAddress: trans.Ptr("8.8.8.8"),
+		CreatedAt: timeutil.TimeToTimestamppb(&at),
+		DeviceInfo: &auditV1.DeviceInfo{
+			ClientId: trans.Ptr(clientID),
+		},
+		GeoLocation: &auditV1.GeoLocation{
+			CountryCode: trans.Ptr(country),
+			Isp:         trans.Ptr(isp),
+			Latitude:    trans.Ptr(lat),
+			Longitude:   trans.Ptr(lng),
+		},
+	}
+}
+
+func TestLoginRiskEngine_FamiliarLogin(t *testing.T) {
+	now := time.Date(2026, 1, 10, 9, 0, 0, 0, time.UTC)
+
+	var history []*auditV1.LoginAuditLog
+	for i := 1; i <= 5; i++ {
+		history = append(history, newRiskTestLog(now.Add(-time.Duration(i)*24*time.Hour), "web-1", "CN", "ISP-A", 39904200, 116407400))
+	}
+
+	engine := NewLoginRiskEngine(&fakeLoginRiskHistory{logins: history}, log.DefaultLogger)
+	engine.now = func() time.Time { return now }
+
+	score, factors := engine.Evaluate(context.Background(), newRiskTestLog(now, "web-1", "CN", "ISP-A", 39904200, 116407400))
+	assert.Equal(t, 0, score)
+	assert.Empty(t, factors)
+}
+
+func TestLoginRiskEngine_SuspiciousLogin(t *testing.T) {
+	now := time.Date(2026, 1, 10, 3, 0, 0, 0, time.UTC)
+
+	var history []*auditV1.LoginAuditLog
+	for i := 0; i < 5; i++ {
+		// 北京，白天登录；最近一次在 1 小时前
+		at := time.Date(2026, 1, 10-i, 9, 0, 0, 0, time.UTC)
+		if i == 0 {
+			at = now.Add(-time.Hour)
+		}
+		history = append(history, newRiskTestLog(at, "web-1", "CN", "ISP-A", 39904200, 116407400))
+	}
+
+	engine := NewLoginRiskEngine(&fakeLoginRiskHistory{logins: history, failures: 12, usernames: 6}, log.DefaultLogger)
+	engine.now = func() time.Time { return now }
+
+	// 纽约，新设备
+	score, factors := engine.Evaluate(context.Background(), newRiskTestLog(now, "mobile-9", "US", "ISP-B", 40712800, -74006000))
+	assert.Equal(t, 35+20+15+20+10+40, score)
+	assert.ElementsMatch(t, []string{
+		RiskFactorCredentialStuff,
+		RiskFactorIpFailureBurst,
+		RiskFactorNewDevice,
+		RiskFactorNewCountry,
+		RiskFactorNewISP,
+		RiskFactorImpossibleTravel,
+	}, factors)
+}
+
+func TestLoginRiskEngine_FirstLoginAndUnusualHour(t *testing.T) {
+	now := time.Date(2026, 1, 10, 3, 0, 0, 0, time.UTC)
+
+	engine := NewLoginRiskEngine(&fakeLoginRiskHistory{}, log.DefaultLogger)
+	engine.now = func() time.Time { return now }
+
+	score, factors := engine.Evaluate(context.Background(), newRiskTestLog(now, "web-1", "CN", "ISP-A", 0, 0))
+	assert.Equal(t, 0, score)
+	assert.Equal(t, []string{RiskFactorFirstLogin}, factors)
+
+	var history []*auditV1.LoginAuditLog
+	for i := 1; i <= 5; i++ {
+		history = append(history, newRiskTestLog(time.Date(2026, 1, 10-i, 14, 0, 0, 0, time.UTC), "web-1", "CN", "ISP-A", 39904200, 116407400))
+	}
+	engine.history = &fakeLoginRiskHistory{logins: history}
+
+	score, factors = engine.Evaluate(context.Background(), newRiskTestLog(now, "web-1", "CN", "ISP-A", 39904200, 116407400))
+	assert.Equal(t, 10, score)
+	assert.Equal(t, []string{RiskFactorUnusualHour}, factors)
+}
+
+func TestLoginRiskEngine_ImpossibleTravel(t *testing.T) {
+	now := time.Date(2026, 1, 10, 3, 0, 0, 0, time.UTC)
+	engine := NewLoginRiskEngine(&fakeLoginRiskHistory{}, log.DefaultLogger)
+
+	beijing := newRiskTestLog(now, "web-1", "CN", "ISP-A", 39904200, 116407400)
+	newYork := newRiskTestLog(now, "web-1", "US", "ISP-B", 40712800, -74006000)
+
+	// 同一位置、同一时刻不是不可能旅行
+	assert.False(t, engine.isImpossibleTravel(beijing, newRiskTestLog(now, "web-1", "CN", "ISP-A", 39904200, 116407400)))
+	// 距离未超过阈值
+	assert.False(t, engine.isImpossibleTravel(beijing, newRiskTestLog(now, "web-1", "CN", "ISP-A", 39950000, 116400000)))
+
+	// 同一时刻出现在两地
+	assert.True(t, engine.isImpossibleTravel(newYork, beijing))
+	// 1 小时内跨越约 11000km
+	assert.True(t, engine.isImpossibleTravel(newYork, newRiskTestLog(now.Add(-time.Hour), "web-1", "CN", "ISP-A", 39904200, 116407400)))
+	// 时间足够长
+	assert.False(t, engine.isImpossibleTravel(newYork, newRiskTestLog(now.Add(-24*time.Hour), "web-1", "CN", "ISP-A", 39904200, 116407400)))
+	// 记录乱序，无法判断
+	assert.False(t, engine.isImpossibleTravel(newYork, newRiskTestLog(now.Add(time.Hour), "web-1", "CN", "ISP-A", 39904200, 116407400)))
+}
+
+func TestHaversineKm(t *testing.T) {
+	// 北京 -> 上海，约 1067km
+	d := haversineKm(39.9042, 116.4074, 31.2304, 121.4737)
+	assert.InDelta(t, 1067, d, 10)
+	assert.InDelta(t, 0, haversineKm(10, 20, 10, 20), 0.001)
+}
+
+func TestMergeRiskFactors(t *testing.T) {
+	assert.Equal(t, []string{"A", "B", "C"}, mergeRiskFactors([]string{"C", "A"}, "B", "A"))
+	assert.Equal(t, []string{}, mergeRiskFactors(nil))
+}
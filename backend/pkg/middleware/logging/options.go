@@ -19,6 +19,8 @@ type options struct {
 
 	ecPrivateKey *ecdsa.PrivateKey // 私钥（加密存储）
 	ecPublicKey  *ecdsa.PublicKey  // 公钥（可公开）
+
+	loginRiskEngine *LoginRiskEngine // 登录风险引擎（有状态）
 }
 
 type Option func(*options)
@@ -58,3 +60,9 @@ func WithECPublicKey(key *ecdsa.PublicKey) Option {
 		opts.ecPublicKey = key
 	}
 }
+
+func WithLoginRiskEngine(engine *LoginRiskEngine) Option {
+	return func(opts *options) {
+		opts.loginRiskEngine = engine
+	}
+}
@@ -24,7 +24,9 @@ import (
 	"github.com/tx7do/go-utils/trans"
 
 	"github.com/mileusna/useragent"
+	"github.com/oschwald/geoip2-golang"
 	"github.com/tx7do/go-utils/geoip/geolite"
+	"github.com/tx7do/go-utils/geoip/geolite/assets"
 	"github.com/tx7do/go-utils/jwtutil"
 
 	auditV1 "go-wind-admin/api/gen/go/audit/service/v1"
@@ -35,6 +37,9 @@ import (
 
 var ipClient, _ = geolite.NewClient()
 
+// geoCityReader 直接读取 GeoLite2 城市库，用于获取经纬度（geolite.Client 不暴露该信息）
+var geoCityReader, _ = geoip2.FromBytes(assets.GeoLite2CityData)
+
 // extractAuthToken 从JWT Token中提取用户信息
 func extractAuthToken(htr *http.Transport) *authenticationV1.UserTokenPayload {
 	authToken := htr.RequestHeader().Get(HeaderKeyAuthorization)
@@ -59,6 +64,11 @@ func extractAuthToken(htr *http.Transport) *authenticationV1.UserTokenPayload {
 	return ut
 }
 
+// ClientRealIP 获取客户端真实IP，规则与审计日志一致
+func ClientRealIP(request *http.Request) string {
+	return getClientRealIP(request)
+}
+
 // getClientRealIP 获取客户端真实IP
 func getClientRealIP(request *http.Request) string {
 	if request == nil {
@@ -119,6 +129,11 @@ func getIPFromRemoteAddr(hostAddress string) string {
 	return ""
 }
 
+// RequestID 获取请求ID，规则与审计日志一致
+func RequestID(request *http.Request) string {
+	return getRequestId(request)
+}
+
 // getRequestId 获取请求ID
 func getRequestId(request *http.Request) string {
 	if request == nil {
@@ -364,9 +379,36 @@ func fillGeoLocation(clientIp string) (info *auditV1.GeoLocation) {
 	info.City = trans.Ptr(result.City)
 	info.Isp = trans.Ptr(result.ISP)
 
+	if lat, lng, ok := clientIpToCoordinates(clientIp); ok {
+		info.Latitude = trans.Ptr(lat)
+		info.Longitude = trans.Ptr(lng)
+	}
+
 	return
 }
 
+// clientIpToCoordinates 获取客户端IP的经纬度（微度），内网IP或无法解析时返回 false
+func clientIpToCoordinates(clientIp string) (lat, lng int64, ok bool) {
+	if geoCityReader == nil {
+		return 0, 0, false
+	}
+
+	ip := net.ParseIP(strings.TrimSpace(clientIp))
+	if ip == nil || geolite.IsPrivateIP(ip) {
+		return 0, 0, false
+	}
+
+	record, err := geoCityReader.City(ip)
+	if err != nil || record == nil {
+		return 0, 0, false
+	}
+	if record.Location.Latitude == 0 && record.Location.Longitude == 0 {
+		return 0, 0, false
+	}
+
+	return int64(record.Location.Latitude * 1e6), int64(record.Location.Longitude * 1e6), true
+}
+
 // isPrivateIP 检查 IP 是否属于常见内网或链路本地地址
 func isPrivateIP(ipStr string) bool {
 	ip := net.ParseIP(strings.TrimSpace(ipStr))
@@ -0,0 +1,706 @@
+package oss
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	storageV1 "go-wind-admin/api/gen/go/storage/service/v1"
+)
+
+const (
+	localMetaDir      = ".meta"      // 对象元信息目录
+	localMultipartDir = ".multipart" // 分片上传目录
+	localTempDir      = ".tmp"       // 写入中的临时文件目录
+
+	localMultipartInfo = "upload.json"
+
+	LocalQueryExpires   = "X-Expires"   // 签名地址的过期时间（Unix 秒）
+	LocalQuerySignature = "X-Signature" // 签名地址的 HMAC 签名
+)
+
+var _ ObjectStorage = (*LocalStorage)(nil)
+
+// LocalStorage 本地文件系统存储。对象保存在 <root>/<bucket>/<object>，
+// 预签名地址指向服务端的签名路由，由 VerifyPresignedUrl 校验后读写对象。
+type LocalStorage struct {
+	root    string
+	baseUrl string
+	secret  []byte
+	log     *log.Helper
+}
+
+// localMeta 对象元信息
+type localMeta struct {
+	ContentType string `json:"content_type"`
+	ETag        string `json:"etag"`
+}
+
+// localMultipart 分片上传信息
+type localMultipart struct {
+	Bucket      string `json:"bucket"`
+	Object      string `json:"object"`
+	ContentType string `json:"content_type"`
+}
+
+// NewLocalStorage 创建本地文件系统存储，baseUrl 为签名路由的访问前缀，secret 用于签名地址
+func NewLocalStorage(root, baseUrl string, secret []byte, logger log.Logger) (*LocalStorage, error) {
+	if root == "" {
+		return nil, errors.New("local storage root is required")
+	}
+	if len(secret) == 0 {
+		return nil, errors.New("local storage secret is required")
+	}
+
+	absRoot, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LocalStorage{
+		root:    absRoot,
+		baseUrl: strings.TrimRight(baseUrl, "/"),
+		secret:  secret,
+		log:     log.NewHelper(log.With(logger, "module", "local-storage/oss")),
+	}, nil
+}
+
+// Provider 存储提供商
+func (l *LocalStorage) Provider() storageV1.OSSProvider {
+	return storageV1.OSSProvider_LOCAL
+}
+
+// Root 存储根目录
+func (l *LocalStorage) Root() string {
+	return l.root
+}
+
+// validBucketName 存储桶名只能是单级目录名，且不能以 "." 开头，避免与内部目录冲突
+func validBucketName(bucketName string) bool {
+	return bucketName != "" &&
+		!strings.HasPrefix(bucketName, ".") &&
+		!strings.ContainsAny(bucketName, `/\`)
+}
+
+// validObjectName 对象名由 "/" 分隔的非空路径段组成，不允许 "." 和 ".."
+func validObjectName(objectName string) bool {
+	if objectName == "" || strings.Contains(objectName, `\`) {
+		return false
+	}
+	for _, segment := range strings.Split(objectName, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return false
+		}
+	}
+	return true
+}
+
+// objectPath 对象在文件系统中的路径
+func (l *LocalStorage) objectPath(bucketName, objectName string) (string, error) {
+	if !validBucketName(bucketName) {
+		return "", storageV1.ErrorBadRequest("invalid bucket name")
+	}
+	if !validObjectName(objectName) {
+		return "", storageV1.ErrorBadRequest("invalid object name")
+	}
+	return filepath.Join(l.root, bucketName, filepath.FromSlash(objectName)), nil
+}
+
+// metaPath 对象元信息的路径，按对象名哈希存放，避免与对象目录结构冲突
+func (l *LocalStorage) metaPath(bucketName, objectName string) string {
+	sum := sha256.Sum256([]byte(objectName))
+	name := hex.EncodeToString(sum[:])
+	return filepath.Join(l.root, localMetaDir, bucketName, name[:2], name+".json")
+}
+
+// multipartPath 分片上传的目录，上传ID必须是十六进制字符串
+func (l *LocalStorage) multipartPath(uploadID string) (string, error) {
+	if _, err := hex.DecodeString(uploadID); err != nil || uploadID == "" {
+		return "", storageV1.ErrorBadRequest("invalid upload id")
+	}
+	return filepath.Join(l.root, localMultipartDir, uploadID), nil
+}
+
+// EnsureBucketExists 确保存储桶目录存在
+func (l *LocalStorage) EnsureBucketExists(_ context.Context, bucketName string) error {
+	if !validBucketName(bucketName) {
+		return storageV1.ErrorBadRequest("invalid bucket name")
+	}
+	if err := os.MkdirAll(filepath.Join(l.root, bucketName), 0o755); err != nil {
+		l.log.Errorf("Failed to create bucket: %v", err)
+		return storageV1.ErrorInternalServerError("failed to create bucket: %s", bucketName)
+	}
+	return nil
+}
+
+// writeTemp 把数据写入临时文件，同时计算 MD5
+func (l *LocalStorage) writeTemp(reader io.Reader, hashes ...hash.Hash) (string, int64, error) {
+	dir := filepath.Join(l.root, localTempDir)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", 0, err
+	}
+
+	file, err := os.CreateTemp(dir, "object-*")
+	if err != nil {
+		return "", 0, err
+	}
+
+	writers := []io.Writer{file}
+	for _, h := range hashes {
+		writers = append(writers, h)
+	}
+
+	n, err := io.Copy(io.MultiWriter(writers...), reader)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(file.Name())
+		return "", 0, err
+	}
+
+	return file.Name(), n, nil
+}
+
+// commit 把临时文件移动到对象路径并写入元信息
+func (l *LocalStorage) commit(tempName, bucketName, objectName string, meta localMeta) error {
+	target, err := l.objectPath(bucketName, objectName)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return err
+	}
+	if err = os.Rename(tempName, target); err != nil {
+		return err
+	}
+
+	return l.writeMeta(bucketName, objectName, meta)
+}
+
+func (l *LocalStorage) writeMeta(bucketName, objectName string, meta localMeta) error {
+	metaPath := l.metaPath(bucketName, objectName)
+	if err := os.MkdirAll(filepath.Dir(metaPath), 0o755); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(meta)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(metaPath, data, 0o644)
+}
+
+// readMeta 读取对象元信息，缺失时按扩展名推断内容类型、按修改时间和大小生成ETag
+func (l *LocalStorage) readMeta(bucketName, objectName string, fi fs.FileInfo) localMeta {
+	var meta localMeta
+	if data, err := os.ReadFile(l.metaPath(bucketName, objectName)); err == nil {
+		_ = json.Unmarshal(data, &meta)
+	}
+
+	if meta.ContentType == "" {
+		meta.ContentType = mime.TypeByExtension(path.Ext(objectName))
+	}
+	if meta.ContentType == "" {
+		meta.ContentType = DefaultContentType
+	}
+	if meta.ETag == "" {
+		meta.ETag = fmt.Sprintf("%x-%x", fi.ModTime().UnixNano(), fi.Size())
+	}
+
+	return meta
+}
+
+func (l *LocalStorage) objectInfo(bucketName, objectName string, fi fs.FileInfo) ObjectInfo {
+	meta := l.readMeta(bucketName, objectName, fi)
+	return ObjectInfo{
+		Bucket:       bucketName,
+		Key:          objectName,
+		Size:         fi.Size(),
+		ContentType:  meta.ContentType,
+		ETag:         meta.ETag,
+		LastModified: fi.ModTime(),
+	}
+}
+
+// UploadStream 以流的方式写入对象（size 未知时传 -1）
+func (l *LocalStorage) UploadStream(
+	_ context.Context,
+	bucketName string, objectName string,
+	contentType string,
+	reader io.Reader, size int64,
+) (ObjectInfo, error) {
+	if _, err := l.objectPath(bucketName, objectName); err != nil {
+		return ObjectInfo{}, err
+	}
+	if contentType == "" {
+		contentType = DefaultContentType
+	}
+
+	if size >= 0 {
+		reader = io.LimitReader(reader, size)
+	}
+
+	h := md5.New()
+	tempName, n, err := l.writeTemp(reader, h)
+	if err != nil {
+		l.log.Errorf("failed to upload stream: %v", err)
+		return ObjectInfo{}, storageV1.ErrorUploadFailed("failed to upload stream")
+	}
+	if size >= 0 && n != size {
+		_ = os.Remove(tempName)
+		return ObjectInfo{}, storageV1.ErrorUploadFailed("unexpected end of stream")
+	}
+
+	meta := localMeta{ContentType: contentType, ETag: hex.EncodeToString(h.Sum(nil))}
+	if err = l.commit(tempName, bucketName, objectName, meta); err != nil {
+		_ = os.Remove(tempName)
+		l.log.Errorf("failed to save object: %v", err)
+		return ObjectInfo{}, storageV1.ErrorUploadFailed("failed to upload stream")
+	}
+
+	return l.StatObject(context.Background(), bucketName, objectName)
+}
+
+// GetObject 获取对象的读取流，调用方负责关闭
+func (l *LocalStorage) GetObject(ctx context.Context, bucketName, objectName string) (io.ReadCloser, error) {
+	object, _, err := l.OpenObject(ctx, bucketName, objectName)
+	if err != nil {
+		return nil, err
+	}
+	return object, nil
+}
+
+// OpenObject 打开对象用于随机读取，调用方负责关闭
+func (l *LocalStorage) OpenObject(_ context.Context, bucketName, objectName string) (io.ReadSeekCloser, ObjectInfo, error) {
+	objectPath, err := l.objectPath(bucketName, objectName)
+	if err != nil {
+		return nil, ObjectInfo{}, err
+	}
+
+	file, err := os.Open(objectPath)
+	if err != nil {
+		return nil, ObjectInfo{}, l.statError(err)
+	}
+
+	fi, err := file.Stat()
+	if err != nil || fi.IsDir() {
+		_ = file.Close()
+		if err == nil {
+			err = fs.ErrNotExist
+		}
+		return nil, ObjectInfo{}, l.statError(err)
+	}
+
+	return file, l.objectInfo(bucketName, objectName, fi), nil
+}
+
+// StatObject 获取对象元信息
+func (l *LocalStorage) StatObject(_ context.Context, bucketName, objectName string) (ObjectInfo, error) {
+	objectPath, err := l.objectPath(bucketName, objectName)
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+
+	fi, err := os.Stat(objectPath)
+	if err == nil && fi.IsDir() {
+		err = fs.ErrNotExist
+	}
+	if err != nil {
+		return ObjectInfo{}, l.statError(err)
+	}
+
+	return l.objectInfo(bucketName, objectName, fi), nil
+}
+
+func (l *LocalStorage) statError(err error) error {
+	if errors.Is(err, fs.ErrNotExist) {
+		return storageV1.ErrorFileNotFound("object not found")
+	}
+	l.log.Errorf("Failed to stat object: %v", err)
+	return storageV1.ErrorDownloadFailed("failed to stat object")
+}
+
+// DeleteFile 删除对象，并清理空的上级目录
+func (l *LocalStorage) DeleteFile(_ context.Context, bucketName, objectName string) error {
+	if bucketName == "" {
+		return storageV1.ErrorBadRequest("bucket name is required")
+	}
+	if objectName == "" {
+		return storageV1.ErrorBadRequest("object name is required")
+	}
+
+	objectPath, err := l.objectPath(bucketName, objectName)
+	if err != nil {
+		return err
+	}
+
+	if err = os.Remove(objectPath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		l.log.Errorf("Failed to delete file: %v", err)
+		return storageV1.ErrorDeleteFailed("failed to delete file")
+	}
+	_ = os.Remove(l.metaPath(bucketName, objectName))
+
+	bucketPath := filepath.Join(l.root, bucketName)
+	for dir := filepath.Dir(objectPath); dir != bucketPath && strings.HasPrefix(dir, bucketPath); dir = filepath.Dir(dir) {
+		if os.Remove(dir) != nil {
+			break
+		}
+	}
+
+	return nil
+}
+
+// ListObjects 按前缀列举对象，非递归时子目录以 "/" 结尾的公共前缀返回
+func (l *LocalStorage) ListObjects(_ context.Context, bucketName, prefix string, recursive bool) ([]ObjectInfo, error) {
+	if !validBucketName(bucketName) {
+		return nil, storageV1.ErrorBadRequest("invalid bucket name")
+	}
+
+	bucketPath := filepath.Join(l.root, bucketName)
+
+	// 只遍历前缀所在的目录
+	start := bucketPath
+	if i := strings.LastIndex(prefix, "/"); i > 0 {
+		if !validObjectName(prefix[:i]) {
+			return nil, storageV1.ErrorBadRequest("invalid prefix")
+		}
+		start = filepath.Join(bucketPath, filepath.FromSlash(prefix[:i]))
+	}
+
+	var objects []ObjectInfo
+	prefixes := make(map[string]struct{})
+
+	err := filepath.WalkDir(start, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(bucketPath, p)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+
+		if !recursive {
+			if i := strings.Index(key[len(prefix):], "/"); i >= 0 {
+				commonPrefix := key[:len(prefix)+i+1]
+				if _, ok := prefixes[commonPrefix]; !ok {
+					prefixes[commonPrefix] = struct{}{}
+					objects = append(objects, ObjectInfo{Bucket: bucketName, Key: commonPrefix})
+				}
+				return nil
+			}
+		}
+
+		fi, err := d.Info()
+		if err != nil {
+			return err
+		}
+		objects = append(objects, l.objectInfo(bucketName, key, fi))
+		return nil
+	})
+	if err != nil {
+		l.log.Errorf("Failed to list objects: %v", err)
+		return nil, storageV1.ErrorInternalServerError("failed to list objects")
+	}
+
+	sort.Slice(objects, func(i, j int) bool { return objects[i].Key < objects[j].Key })
+
+	return objects, nil
+}
+
+// objectUrl 对象在签名路由下的地址
+func (l *LocalStorage) objectUrl(bucketName, objectName string) string {
+	segments := strings.Split(objectName, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return JoinObjectUrl(l.baseUrl, url.PathEscape(bucketName), strings.Join(segments, "/"))
+}
+
+// GetObjectDownloadUrl 获取对象的下载地址，本地对象不公开读取，需要签名后才能访问
+func (l *LocalStorage) GetObjectDownloadUrl(bucketName, objectName string) string {
+	return l.objectUrl(bucketName, objectName)
+}
+
+// sign 计算签名地址的 HMAC 签名
+func (l *LocalStorage) sign(method, bucketName, objectName string, expires int64) string {
+	mac := hmac.New(sha256.New, l.secret)
+	_, _ = fmt.Fprintf(mac, "%s\n%s\n%s\n%d", method, bucketName, objectName, expires)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// presign 生成签名地址
+func (l *LocalStorage) presign(method, bucketName, objectName string, expiry time.Duration) (string, error) {
+	if _, err := l.objectPath(bucketName, objectName); err != nil {
+		return "", err
+	}
+
+	expires := time.Now().Add(expiry).Unix()
+
+	query := url.Values{}
+	query.Set(LocalQueryExpires, strconv.FormatInt(expires, 10))
+	query.Set(LocalQuerySignature, l.sign(method, bucketName, objectName, expires))
+
+	return l.objectUrl(bucketName, objectName) + "?" + query.Encode(), nil
+}
+
+// PresignedGetObject 生成预签名下载地址，HEAD 请求同样适用
+func (l *LocalStorage) PresignedGetObject(_ context.Context, bucketName, objectName string, expiry time.Duration) (string, error) {
+	return l.presign(http.MethodGet, bucketName, objectName, expiry)
+}
+
+// PresignedPutObject 生成预签名 PUT 上传地址，请求体即对象内容
+func (l *LocalStorage) PresignedPutObject(_ context.Context, bucketName, objectName string, expiry time.Duration) (string, error) {
+	return l.presign(http.MethodPut, bucketName, objectName, expiry)
+}
+
+// PresignedPostObject 生成预签名 POST 表单上传地址，对象内容放在表单的 file 字段中
+func (l *LocalStorage) PresignedPostObject(_ context.Context, bucketName, objectName, contentType string, expiry time.Duration) (string, map[string]string, error) {
+	uploadUrl, err := l.presign(http.MethodPost, bucketName, objectName, expiry)
+	if err != nil {
+		return "", nil, err
+	}
+
+	formData := map[string]string{"key": objectName}
+	if contentType != "" {
+		formData["Content-Type"] = contentType
+	}
+
+	return uploadUrl, formData, nil
+}
+
+// VerifyPresignedUrl 校验签名路由收到的请求，HEAD 请求使用 GET 的签名
+func (l *LocalStorage) VerifyPresignedUrl(method, bucketName, objectName string, query url.Values) error {
+	if method == http.MethodHead {
+		method = http.MethodGet
+	}
+
+	signature := query.Get(LocalQuerySignature)
+	expires, err := strconv.ParseInt(query.Get(LocalQueryExpires), 10, 64)
+	if err != nil || signature == "" {
+		return storageV1.ErrorForbidden("missing signature")
+	}
+
+	expected := l.sign(method, bucketName, objectName, expires)
+	if !hmac.Equal([]byte(signature), []byte(expected)) {
+		return storageV1.ErrorForbidden("invalid signature")
+	}
+	if time.Now().Unix() > expires {
+		return storageV1.ErrorForbidden("presigned url expired")
+	}
+
+	return nil
+}
+
+// NewMultipartUpload 初始化分片上传，返回分片上传ID
+func (l *LocalStorage) NewMultipartUpload(_ context.Context, bucketName, objectName, contentType string) (string, error) {
+	if _, err := l.objectPath(bucketName, objectName); err != nil {
+		return "", err
+	}
+	if contentType == "" {
+		contentType = DefaultContentType
+	}
+
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", storageV1.ErrorUploadFailed("failed to create multipart upload")
+	}
+	uploadID := hex.EncodeToString(buf)
+
+	dir, _ := l.multipartPath(uploadID)
+	data, _ := json.Marshal(&localMultipart{Bucket: bucketName, Object: objectName, ContentType: contentType})
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		l.log.Errorf("failed to create multipart upload: %v", err)
+		return "", storageV1.ErrorUploadFailed("failed to create multipart upload")
+	}
+	if err := os.WriteFile(filepath.Join(dir, localMultipartInfo), data, 0o644); err != nil {
+		l.log.Errorf("failed to create multipart upload: %v", err)
+		return "", storageV1.ErrorUploadFailed("failed to create multipart upload")
+	}
+
+	return uploadID, nil
+}
+
+// loadMultipart 读取分片上传信息并校验目标对象
+func (l *LocalStorage) loadMultipart(bucketName, objectName, uploadID string) (string, *localMultipart, error) {
+	dir, err := l.multipartPath(uploadID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, localMultipartInfo))
+	if err != nil {
+		return "", nil, storageV1.ErrorFileNotFound("multipart upload not found")
+	}
+
+	var upload localMultipart
+	if err = json.Unmarshal(data, &upload); err != nil || upload.Bucket != bucketName || upload.Object != objectName {
+		return "", nil, storageV1.ErrorFileNotFound("multipart upload not found")
+	}
+
+	return dir, &upload, nil
+}
+
+func partFileName(partNumber int) string {
+	return fmt.Sprintf("%05d.part", partNumber)
+}
+
+// PutObjectPart 上传一个分片，返回分片的ETag
+func (l *LocalStorage) PutObjectPart(
+	_ context.Context,
+	bucketName, objectName, uploadID string,
+	partNumber int,
+	reader io.Reader, size int64,
+) (string, error) {
+	if partNumber < 1 {
+		return "", storageV1.ErrorBadRequest("invalid part number")
+	}
+
+	dir, _, err := l.loadMultipart(bucketName, objectName, uploadID)
+	if err != nil {
+		return "", err
+	}
+
+	if size >= 0 {
+		reader = io.LimitReader(reader, size)
+	}
+
+	h := md5.New()
+	tempName, n, err := l.writeTemp(reader, h)
+	if err == nil && size >= 0 && n != size {
+		_ = os.Remove(tempName)
+		err = io.ErrUnexpectedEOF
+	}
+	if err == nil {
+		if err = os.Rename(tempName, filepath.Join(dir, partFileName(partNumber))); err != nil {
+			_ = os.Remove(tempName)
+		}
+	}
+	if err != nil {
+		l.log.Errorf("failed to upload part %d: %v", partNumber, err)
+		return "", storageV1.ErrorUploadFailed("failed to upload part")
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// CompleteMultipartUpload 按分片号顺序合并分片，分片的ETag必须与上传时一致
+func (l *LocalStorage) CompleteMultipartUpload(
+	_ context.Context,
+	bucketName, objectName, uploadID string,
+	parts []CompletePart,
+) (ObjectInfo, error) {
+	dir, upload, err := l.loadMultipart(bucketName, objectName, uploadID)
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+	if len(parts) == 0 {
+		return ObjectInfo{}, storageV1.ErrorBadRequest("no parts to complete")
+	}
+
+	sorted := make([]CompletePart, len(parts))
+	copy(sorted, parts)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].PartNumber < sorted[j].PartNumber })
+
+	readers := make([]io.Reader, 0, len(sorted))
+	for i, p := range sorted {
+		if i > 0 && p.PartNumber == sorted[i-1].PartNumber {
+			return ObjectInfo{}, storageV1.ErrorBadRequest("duplicate part number")
+		}
+
+		file, openErr := os.Open(filepath.Join(dir, partFileName(p.PartNumber)))
+		if openErr != nil {
+			return ObjectInfo{}, storageV1.ErrorBadRequest("part %d not found", p.PartNumber)
+		}
+		defer file.Close()
+
+		readers = append(readers, &etagVerifier{reader: file, hash: md5.New(), etag: strings.Trim(p.ETag, `"`)})
+	}
+
+	h := md5.New()
+	tempName, _, err := l.writeTemp(io.MultiReader(readers...), h)
+	if err != nil {
+		if errors.Is(err, errPartETagMismatch) {
+			return ObjectInfo{}, storageV1.ErrorBadRequest("part etag mismatch")
+		}
+		l.log.Errorf("failed to complete multipart upload: %v", err)
+		return ObjectInfo{}, storageV1.ErrorUploadFailed("failed to complete multipart upload")
+	}
+
+	meta := localMeta{ContentType: upload.ContentType, ETag: hex.EncodeToString(h.Sum(nil))}
+	if err = l.commit(tempName, bucketName, objectName, meta); err != nil {
+		_ = os.Remove(tempName)
+		l.log.Errorf("failed to complete multipart upload: %v", err)
+		return ObjectInfo{}, storageV1.ErrorUploadFailed("failed to complete multipart upload")
+	}
+
+	_ = os.RemoveAll(dir)
+
+	return l.StatObject(context.Background(), bucketName, objectName)
+}
+
+// AbortMultipartUpload 中止分片上传并删除已上传的分片
+func (l *LocalStorage) AbortMultipartUpload(_ context.Context, bucketName, objectName, uploadID string) error {
+	dir, _, err := l.loadMultipart(bucketName, objectName, uploadID)
+	if err != nil {
+		return err
+	}
+
+	if err = os.RemoveAll(dir); err != nil {
+		l.log.Errorf("failed to abort multipart upload: %v", err)
+		return storageV1.ErrorDeleteFailed("failed to abort multipart upload")
+	}
+
+	return nil
+}
+
+var errPartETagMismatch = errors.New("part etag mismatch")
+
+// etagVerifier 读取分片的同时计算 MD5，读完时与上传时返回的ETag比较
+type etagVerifier struct {
+	reader io.Reader
+	hash   hash.Hash
+	etag   string
+}
+
+func (v *etagVerifier) Read(p []byte) (int, error) {
+	n, err := v.reader.Read(p)
+	v.hash.Write(p[:n])
+	if err == io.EOF && hex.EncodeToString(v.hash.Sum(nil)) != v.etag {
+		return n, errPartETagMismatch
+	}
+	return n, err
+}
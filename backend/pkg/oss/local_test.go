@@ -0,0 +1,198 @@
+package oss
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tx7do/go-utils/trans"
+
+	storageV1 "go-wind-admin/api/gen/go/storage/service/v1"
+)
+
+func createTestLocalStorage(t *testing.T) *LocalStorage {
+	storage, err := NewLocalStorage(t.TempDir(), "http://localhost:7788/admin/v1/storage/local", []byte("secret"), log.DefaultLogger)
+	require.NoError(t, err)
+	return storage
+}
+
+func TestLocalStoragePutGetDelete(t *testing.T) {
+	storage := createTestLocalStorage(t)
+	ctx := context.Background()
+
+	info, err := storage.UploadStream(ctx, "files", "docs/readme.txt", "text/plain", strings.NewReader("hello"), 5)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), info.Size)
+	assert.Equal(t, "text/plain", info.ContentType)
+	assert.Equal(t, "5d41402abc4b2a76b9719d911017c592", info.ETag)
+
+	object, stat, err := storage.OpenObject(ctx, "files", "docs/readme.txt")
+	require.NoError(t, err)
+	_, err = object.Seek(1, io.SeekStart)
+	require.NoError(t, err)
+	data, err := io.ReadAll(object)
+	require.NoError(t, err)
+	_ = object.Close()
+	assert.Equal(t, "ello", string(data))
+	assert.Equal(t, info.ETag, stat.ETag)
+
+	_, err = storage.UploadStream(ctx, "files", "docs/short.txt", "", strings.NewReader("abc"), 5)
+	assert.Error(t, err)
+
+	require.NoError(t, storage.DeleteFile(ctx, "files", "docs/readme.txt"))
+	_, err = storage.StatObject(ctx, "files", "docs/readme.txt")
+	assert.True(t, storageV1.IsFileNotFound(err))
+
+	// 删除不存在的对象不报错
+	assert.NoError(t, storage.DeleteFile(ctx, "files", "docs/readme.txt"))
+}
+
+func TestLocalStorageRejectsTraversal(t *testing.T) {
+	storage := createTestLocalStorage(t)
+	ctx := context.Background()
+
+	for _, objectName := range []string{"../escape.txt", "a/../../b", "/abs", "a//b", `a\b`} {
+		_, err := storage.UploadStream(ctx, "files", objectName, "", strings.NewReader("x"), 1)
+		assert.True(t, storageV1.IsBadRequest(err), objectName)
+	}
+
+	for _, bucketName := range []string{"", ".meta", "a/b", ".."} {
+		_, err := storage.UploadStream(ctx, bucketName, "x.txt", "", strings.NewReader("x"), 1)
+		assert.True(t, storageV1.IsBadRequest(err), bucketName)
+	}
+}
+
+func TestLocalStorageListObjects(t *testing.T) {
+	storage := createTestLocalStorage(t)
+	ctx := context.Background()
+
+	for _, name := range []string{"a.txt", "dir/b.txt", "dir/sub/c.txt", "dirx/d.txt"} {
+		_, err := storage.UploadStream(ctx, "files", name, "", strings.NewReader(name), -1)
+		require.NoError(t, err)
+	}
+
+	keys := func(objects []ObjectInfo) []string {
+		var out []string
+		for _, o := range objects {
+			out = append(out, o.Key)
+		}
+		return out
+	}
+
+	objects, err := storage.ListObjects(ctx, "files", "", false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a.txt", "dir/", "dirx/"}, keys(objects))
+
+	objects, err = storage.ListObjects(ctx, "files", "dir/", true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"dir/b.txt", "dir/sub/c.txt"}, keys(objects))
+
+	objects, err = storage.ListObjects(ctx, "files", "dir", false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"dir/", "dirx/"}, keys(objects))
+
+	resp, err := ListFile(ctx, storage, &storageV1.ListOssFileRequest{BucketName: trans.Ptr("empty")})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Files)
+}
+
+func TestLocalStorageMultipart(t *testing.T) {
+	storage := createTestLocalStorage(t)
+	ctx := context.Background()
+
+	uploadID, err := storage.NewMultipartUpload(ctx, "files", "big.bin", "application/zip")
+	require.NoError(t, err)
+
+	etag2, err := storage.PutObjectPart(ctx, "files", "big.bin", uploadID, 2, strings.NewReader("world"), 5)
+	require.NoError(t, err)
+	etag1, err := storage.PutObjectPart(ctx, "files", "big.bin", uploadID, 1, strings.NewReader("hello "), 6)
+	require.NoError(t, err)
+
+	_, err = storage.CompleteMultipartUpload(ctx, "files", "big.bin", uploadID, []CompletePart{
+		{PartNumber: 1, ETag: etag2}, {PartNumber: 2, ETag: etag2},
+	})
+	assert.True(t, storageV1.IsBadRequest(err))
+
+	info, err := storage.CompleteMultipartUpload(ctx, "files", "big.bin", uploadID, []CompletePart{
+		{PartNumber: 2, ETag: etag2}, {PartNumber: 1, ETag: etag1},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(11), info.Size)
+	assert.Equal(t, "application/zip", info.ContentType)
+
+	reader, err := storage.GetObject(ctx, "files", "big.bin")
+	require.NoError(t, err)
+	data, _ := io.ReadAll(reader)
+	_ = reader.Close()
+	assert.Equal(t, "hello world", string(data))
+
+	// 已完成的上传不能再中止
+	assert.True(t, storageV1.IsFileNotFound(storage.AbortMultipartUpload(ctx, "files", "big.bin", uploadID)))
+	assert.True(t, storageV1.IsBadRequest(storage.AbortMultipartUpload(ctx, "files", "big.bin", "../x")))
+}
+
+func TestLocalStoragePresignedUrl(t *testing.T) {
+	storage := createTestLocalStorage(t)
+	ctx := context.Background()
+
+	rawURL, err := storage.PresignedGetObject(ctx, "images", "avatars/我的 头像.png", time.Minute)
+	require.NoError(t, err)
+
+	u, err := url.Parse(rawURL)
+	require.NoError(t, err)
+	assert.Equal(t, "/admin/v1/storage/local/images/avatars/我的 头像.png", u.Path)
+
+	assert.NoError(t, storage.VerifyPresignedUrl(http.MethodGet, "images", "avatars/我的 头像.png", u.Query()))
+	assert.NoError(t, storage.VerifyPresignedUrl(http.MethodHead, "images", "avatars/我的 头像.png", u.Query()))
+	assert.True(t, storageV1.IsForbidden(storage.VerifyPresignedUrl(http.MethodPut, "images", "avatars/我的 头像.png", u.Query())))
+	assert.True(t, storageV1.IsForbidden(storage.VerifyPresignedUrl(http.MethodGet, "images", "avatars/other.png", u.Query())))
+	assert.True(t, storageV1.IsForbidden(storage.VerifyPresignedUrl(http.MethodGet, "images", "avatars/我的 头像.png", url.Values{})))
+
+	expiredURL, err := storage.PresignedPutObject(ctx, "images", "a.png", -time.Second)
+	require.NoError(t, err)
+	u, _ = url.Parse(expiredURL)
+	err = storage.VerifyPresignedUrl(http.MethodPut, "images", "a.png", u.Query())
+	assert.Equal(t, "presigned url expired", errors.FromError(err).Message)
+
+	resp, err := GetUploadPresignedUrl(ctx, storage, &storageV1.GetUploadPresignedUrlRequest{
+		Method:      storageV1.GetUploadPresignedUrlRequest_Post,
+		ContentType: trans.Ptr("image/png"),
+		FileName:    trans.Ptr("logo.png"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "images", resp.GetBucketName())
+	assert.Equal(t, "image/png", resp.FormData["Content-Type"])
+	assert.True(t, strings.HasPrefix(resp.UploadUrl, resp.DownloadUrl+"?"))
+}
+
+func TestLocalStorageDownloadRange(t *testing.T) {
+	storage := createTestLocalStorage(t)
+	ctx := context.Background()
+
+	_, _, _, err := UploadFile(ctx, storage, "files", "range.txt", "text/plain", []byte("0123456789"))
+	require.NoError(t, err)
+
+	resp, err := DownloadFile(ctx, storage, &storageV1.DownloadFileRequest{
+		Selector: &storageV1.DownloadFileRequest_StorageObject{
+			StorageObject: &storageV1.StorageObject{
+				BucketName: trans.Ptr("files"),
+				ObjectName: trans.Ptr("range.txt"),
+			},
+		},
+		RangeStart: trans.Ptr(int64(2)),
+		RangeEnd:   trans.Ptr(int64(4)),
+	})
+	require.NoError(t, err)
+	assert.True(t, bytes.Equal([]byte("234"), resp.GetFile()))
+	assert.Equal(t, "text/plain", resp.GetMime())
+	assert.Equal(t, int64(10), resp.GetSize())
+}
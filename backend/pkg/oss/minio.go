@@ -1,16 +1,14 @@
 package oss
 
 import (
-	"bytes"
 	"context"
+	"io"
 	"net/url"
 	"strings"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/minio/minio-go/v7"
-	"github.com/tx7do/go-utils/timeutil"
-	"github.com/tx7do/go-utils/trans"
 
 	conf "github.com/tx7do/kratos-bootstrap/api/gen/go/conf/v1"
 	ossMinio "github.com/tx7do/kratos-bootstrap/oss/minio"
@@ -19,11 +17,13 @@ import (
 )
 
 const (
-	defaultExpiryTime = time.Minute * 60 // 默认的预签名时间，默认为：1小时
+	DefaultExpiryTime = time.Minute * 60 // 默认的预签名时间，默认为：1小时
 
 	DefaultContentType = "application/octet-stream"
 )
 
+var _ ObjectStorage = (*MinIOClient)(nil)
+
 // MinIOClient MinIO 客户端封装
 type MinIOClient struct {
 	mc         *minio.Client
@@ -42,6 +42,11 @@ func NewMinIoClient(cfg *conf.Bootstrap, logger log.Logger) *MinIOClient {
 	}
 }
 
+// Provider 存储提供商
+func (c *MinIOClient) Provider() storageV1.OSSProvider {
+	return storageV1.OSSProvider_MINIO
+}
+
 // GetClient returns the underlying MinIO client
 func (c *MinIOClient) GetClient() *minio.Client {
 	return c.mc
@@ -83,101 +88,23 @@ func (c *MinIOClient) EnsureBucketExists(ctx context.Context, bucketName string)
 	return nil
 }
 
-// GetUploadPresignedUrl 获取上传地址
-func (c *MinIOClient) GetUploadPresignedUrl(ctx context.Context, req *storageV1.GetUploadPresignedUrlRequest) (*storageV1.GetUploadPresignedUrlResponse, error) {
-	var bucketName string
-	if req.BucketName != nil {
-		bucketName = req.GetBucketName()
-	} else {
-		bucketName = ContentTypeToBucketName(req.GetContentType())
-	}
-	if bucketName == "" {
-		bucketName = BucketFiles
-	}
-
-	objectName, _ := JoinObjectName(req.GetContentType(), req.FileDirectory, req.FileName)
-
-	expiry := defaultExpiryTime
-	if req.ExpireSeconds != nil {
-		expiry = time.Second * time.Duration(req.GetExpireSeconds())
-	}
-
-	if err := c.EnsureBucketExists(ctx, bucketName); err != nil {
-		return nil, err
-	}
-
-	var uploadUrl string
-	var downloadUrl string
-	var formData map[string]string
-
-	var err error
-	var presignedURL *url.URL
-
-	switch req.GetMethod() {
-	case storageV1.GetUploadPresignedUrlRequest_Put:
-		presignedURL, err = c.mc.PresignedPutObject(ctx, bucketName, objectName, expiry)
-		if err != nil {
-			c.log.Errorf("Failed to generate presigned PUT policy: %v", err)
-			return nil, storageV1.ErrorUploadFailed("failed to generate presigned PUT policy")
-		}
-
-		uploadUrl = presignedURL.String()
-		uploadUrl = ReplaceEndpointHost(downloadUrl, c.conf.Minio.UploadHost, c.conf.Minio.Endpoint)
-
-		downloadUrl = JoinObjectUrl(presignedURL.Host, bucketName, objectName)
-		downloadUrl = ReplaceEndpointHost(downloadUrl, c.conf.Minio.DownloadHost, c.conf.Minio.Endpoint)
-		if !strings.HasPrefix(downloadUrl, presignedURL.Scheme) {
-			downloadUrl = presignedURL.Scheme + "://" + downloadUrl
-		}
-
-	case storageV1.GetUploadPresignedUrlRequest_Post:
-		policy := minio.NewPostPolicy()
-		_ = policy.SetBucket(bucketName)
-		_ = policy.SetKey(objectName)
-		_ = policy.SetExpires(time.Now().UTC().Add(expiry))
-		_ = policy.SetContentType(req.GetContentType())
-
-		presignedURL, formData, err = c.mc.PresignedPostPolicy(ctx, policy)
-		if err != nil {
-			c.log.Errorf("Failed to generate presigned POST policy: %v", err)
-			return nil, storageV1.ErrorUploadFailed("failed to generate presigned POST policy")
-		}
-
-		uploadUrl = presignedURL.String()
-		uploadUrl = ReplaceEndpointHost(downloadUrl, c.conf.Minio.UploadHost, c.conf.Minio.Endpoint)
-
-		downloadUrl = JoinObjectUrl(presignedURL.Host, bucketName, objectName)
-		uploadUrl = ReplaceEndpointHost(downloadUrl, c.conf.Minio.DownloadHost, c.conf.Minio.Endpoint)
-		if !strings.HasPrefix(downloadUrl, presignedURL.Scheme) {
-			downloadUrl = presignedURL.Scheme + "://" + downloadUrl
-		}
-	}
-
-	return &storageV1.GetUploadPresignedUrlResponse{
-		UploadUrl:   uploadUrl,
-		DownloadUrl: downloadUrl,
-		ObjectName:  objectName,
-		BucketName:  trans.Ptr(bucketName),
-		FormData:    formData,
-	}, nil
-}
-
-// ListFile 获取文件夹下面的文件列表
-func (c *MinIOClient) ListFile(ctx context.Context, req *storageV1.ListOssFileRequest) (*storageV1.ListOssFileResponse, error) {
-	resp := &storageV1.ListOssFileResponse{
-		Files: make([]string, 0),
-	}
+// ListObjects 按前缀列举对象
+func (c *MinIOClient) ListObjects(ctx context.Context, bucketName, prefix string, recursive bool) ([]ObjectInfo, error) {
+	var objects []ObjectInfo
 	for object := range c.mc.ListObjects(ctx,
-		req.GetBucketName(),
+		bucketName,
 		minio.ListObjectsOptions{
-			Prefix:    req.GetFolder(),
-			Recursive: req.GetRecursive(),
+			Prefix:    prefix,
+			Recursive: recursive,
 		},
 	) {
-		//fmt.Printf("%+v\n", object)
-		resp.Files = append(resp.Files, object.Key)
+		if object.Err != nil {
+			c.log.Errorf("Failed to list objects: %v", object.Err)
+			return nil, storageV1.ErrorInternalServerError("failed to list objects")
+		}
+		objects = append(objects, toObjectInfo(bucketName, object))
 	}
-	return resp, nil
+	return objects, nil
 }
 
 // DeleteFile 删除一个文件
@@ -198,269 +125,277 @@ func (c *MinIOClient) DeleteFile(ctx context.Context, bucketName, objectName str
 	return nil
 }
 
-// UploadFile 上传文件
-func (c *MinIOClient) UploadFile(
+// GetObjectDownloadUrl 获取对象的下载地址
+func (c *MinIOClient) GetObjectDownloadUrl(bucketName, objectName string) string {
+	return JoinObjectUrl(c.conf.Minio.DownloadHost, bucketName, objectName)
+}
+
+// UploadStream 以流的方式上传对象，适用于大文件（size 未知时传 -1）
+func (c *MinIOClient) UploadStream(
 	ctx context.Context,
 	bucketName string, objectName string,
-	mimeType string,
-	fileContent []byte,
-) (minio.UploadInfo, string, string, error) {
-	if len(fileContent) == 0 {
-		c.log.Errorf("empty fileContent data")
-		return minio.UploadInfo{}, "", "", storageV1.ErrorUploadFailed("empty fileContent data")
-	}
-
+	contentType string,
+	reader io.Reader, size int64,
+) (ObjectInfo, error) {
 	if bucketName == "" {
-		bucketName = BucketFiles
+		return ObjectInfo{}, storageV1.ErrorBadRequest("bucket name is required")
+	}
+	if objectName == "" {
+		return ObjectInfo{}, storageV1.ErrorBadRequest("object name is required")
+	}
+	if contentType == "" {
+		contentType = DefaultContentType
 	}
 
-	var ext string
-	if mimeType == "" {
-		mimeType, ext = DetectFileType(fileContent)
+	if err := c.EnsureBucketExists(ctx, bucketName); err != nil {
+		return ObjectInfo{}, err
 	}
-	if ext == "" {
-		ext = ContentTypeToFileExtension(mimeType)
-		//ext = ".bin"
+
+	info, err := c.mc.PutObject(ctx, bucketName, objectName, reader, size, minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+	if err != nil {
+		c.log.Errorf("failed to upload stream: %v", err)
+		return ObjectInfo{}, storageV1.ErrorUploadFailed("failed to upload stream")
 	}
 
+	return ObjectInfo{
+		Bucket:       info.Bucket,
+		Key:          info.Key,
+		Size:         info.Size,
+		ContentType:  contentType,
+		ETag:         info.ETag,
+		LastModified: info.LastModified,
+	}, nil
+}
+
+// NewMultipartUpload 初始化分片上传，返回分片上传ID
+func (c *MinIOClient) NewMultipartUpload(ctx context.Context, bucketName, objectName, contentType string) (string, error) {
+	if bucketName == "" {
+		return "", storageV1.ErrorBadRequest("bucket name is required")
+	}
 	if objectName == "" {
-		bucketName = ContentTypeToBucketName(mimeType)
-		objectName = GenerateObjectName("", fileContent, ext, GenerateFileNameTypeUUID)
-	} else {
-		ext = ExtractFileExtension(objectName)
+		return "", storageV1.ErrorBadRequest("object name is required")
 	}
-	if mimeType == "" {
-		mimeType = DefaultContentType
+	if contentType == "" {
+		contentType = DefaultContentType
 	}
 
 	if err := c.EnsureBucketExists(ctx, bucketName); err != nil {
-		return minio.UploadInfo{}, "", "", err
+		return "", err
 	}
 
-	reader := bytes.NewReader(fileContent)
-	if reader == nil {
-		c.log.Errorf("invalid fileContent data")
-		return minio.UploadInfo{}, "", "", storageV1.ErrorUploadFailed("invalid fileContent data")
-	}
-
-	info, err := c.mc.PutObject(
-		ctx,
-		bucketName, objectName,
-		reader, reader.Size(),
-		minio.PutObjectOptions{
-			ContentType: mimeType,
-		},
-	)
+	core := minio.Core{Client: c.mc}
+	uploadID, err := core.NewMultipartUpload(ctx, bucketName, objectName, minio.PutObjectOptions{
+		ContentType: contentType,
+	})
 	if err != nil {
-		c.log.Errorf("failed to upload fileContent: %v", err)
-		return info, "", "", storageV1.ErrorUploadFailed("failed to upload fileContent")
+		c.log.Errorf("failed to create multipart upload: %v", err)
+		return "", storageV1.ErrorUploadFailed("failed to create multipart upload")
 	}
 
-	downloadUrl := JoinObjectUrl(c.conf.Minio.DownloadHost, bucketName, objectName)
-	storagePath := JoinObjectUrl("", bucketName, objectName)
-
-	return info, storagePath, downloadUrl, nil
+	return uploadID, nil
 }
 
-// getDownloadUrlWithStorageObjectDirect 直接获取文件内容
-func (c *MinIOClient) getDownloadUrlWithStorageObjectDirect(ctx context.Context, req *storageV1.GetDownloadInfoRequest) (*storageV1.GetDownloadInfoResponse, error) {
-	opts := minio.GetObjectOptions{}
-
-	SetDownloadRange(&opts, req.RangeStart, req.RangeEnd)
-
-	object, err := c.mc.GetObject(
-		ctx,
-		req.GetStorageObject().GetBucketName(),
-		req.GetStorageObject().GetObjectName(),
-		opts,
-	)
+// PutObjectPart 上传一个分片，返回分片的ETag
+func (c *MinIOClient) PutObjectPart(
+	ctx context.Context,
+	bucketName, objectName, uploadID string,
+	partNumber int,
+	reader io.Reader, size int64,
+) (string, error) {
+	core := minio.Core{Client: c.mc}
+	part, err := core.PutObjectPart(ctx, bucketName, objectName, uploadID, partNumber, reader, size, minio.PutObjectPartOptions{})
 	if err != nil {
-		c.log.Errorf("failed to get object: %v", err)
-		return nil, storageV1.ErrorDownloadFailed("failed to get object")
+		c.log.Errorf("failed to upload part %d: %v", partNumber, err)
+		return "", storageV1.ErrorUploadFailed("failed to upload part")
 	}
 
-	buf := new(bytes.Buffer)
-	if _, err = buf.ReadFrom(object); err != nil {
-		c.log.Errorf("failed to read object: %v", err)
-		return nil, storageV1.ErrorDownloadFailed("failed to read object")
+	return part.ETag, nil
+}
+
+// CompleteMultipartUpload 合并已上传的分片
+func (c *MinIOClient) CompleteMultipartUpload(
+	ctx context.Context,
+	bucketName, objectName, uploadID string,
+	parts []CompletePart,
+) (ObjectInfo, error) {
+	completeParts := make([]minio.CompletePart, 0, len(parts))
+	for _, p := range parts {
+		completeParts = append(completeParts, minio.CompletePart{PartNumber: p.PartNumber, ETag: p.ETag})
 	}
 
-	st, err := object.Stat()
+	core := minio.Core{Client: c.mc}
+	info, err := core.CompleteMultipartUpload(ctx, bucketName, objectName, uploadID, completeParts, minio.PutObjectOptions{})
 	if err != nil {
-		c.log.Errorf("failed to stat object: %v", err)
-		return nil, storageV1.ErrorDownloadFailed("failed to stat object")
+		c.log.Errorf("failed to complete multipart upload: %v", err)
+		return ObjectInfo{}, storageV1.ErrorUploadFailed("failed to complete multipart upload")
 	}
 
-	resp := &storageV1.GetDownloadInfoResponse{
-		Content: &storageV1.GetDownloadInfoResponse_File{
-			File: buf.Bytes(),
-		},
+	return ObjectInfo{
+		Bucket:       info.Bucket,
+		Key:          info.Key,
+		Size:         info.Size,
+		ETag:         info.ETag,
+		LastModified: info.LastModified,
+	}, nil
+}
+
+// AbortMultipartUpload 中止分片上传并释放已上传的分片
+func (c *MinIOClient) AbortMultipartUpload(ctx context.Context, bucketName, objectName, uploadID string) error {
+	core := minio.Core{Client: c.mc}
+	if err := core.AbortMultipartUpload(ctx, bucketName, objectName, uploadID); err != nil {
+		c.log.Errorf("failed to abort multipart upload: %v", err)
+		return storageV1.ErrorDeleteFailed("failed to abort multipart upload")
 	}
 
-	if req.GetAcceptMime() != "" {
-		resp.Mime = req.GetAcceptMime()
-	} else {
-		resp.Mime = st.ContentType
+	return nil
+}
+
+// GetObject 获取对象的读取流，调用方负责关闭
+func (c *MinIOClient) GetObject(ctx context.Context, bucketName, objectName string) (io.ReadCloser, error) {
+	if bucketName == "" {
+		return nil, storageV1.ErrorBadRequest("bucket name is required")
 	}
-	if resp.GetMime() == "" {
-		resp.Mime = DefaultContentType
+	if objectName == "" {
+		return nil, storageV1.ErrorBadRequest("object name is required")
 	}
 
-	resp.Checksum = st.ChecksumSHA256
-	resp.SourceFileName = st.Key
-	resp.Size = st.Size
-	resp.UpdatedAt = timeutil.TimeToTimestamppb(&st.LastModified)
+	object, err := c.mc.GetObject(ctx, bucketName, objectName, minio.GetObjectOptions{})
+	if err != nil {
+		c.log.Errorf("Failed to get object: %v", err)
+		return nil, storageV1.ErrorDownloadFailed("failed to get object")
+	}
 
-	return resp, nil
+	return object, nil
 }
 
-// getDownloadUrlWithStorageObjectPresigned 获取预签名下载地址
-func (c *MinIOClient) getDownloadUrlWithStorageObjectPresigned(ctx context.Context, req *storageV1.GetDownloadInfoRequest) (*storageV1.GetDownloadInfoResponse, error) {
-	expires := defaultExpiryTime
-	if req.PresignExpireSeconds != nil {
-		expires = time.Second * time.Duration(req.GetPresignExpireSeconds())
-	}
-	presignedURL, err := c.mc.PresignedGetObject(
-		ctx,
-		req.GetStorageObject().GetBucketName(),
-		req.GetStorageObject().GetObjectName(),
-		expires,
-		nil,
-	)
+// OpenObject 打开对象用于流式读取，返回的对象支持 Seek，可按需发起范围请求，调用方负责关闭
+func (c *MinIOClient) OpenObject(ctx context.Context, bucketName, objectName string) (io.ReadSeekCloser, ObjectInfo, error) {
+	if bucketName == "" {
+		return nil, ObjectInfo{}, storageV1.ErrorBadRequest("bucket name is required")
+	}
+	if objectName == "" {
+		return nil, ObjectInfo{}, storageV1.ErrorBadRequest("object name is required")
+	}
+
+	object, err := c.mc.GetObject(ctx, bucketName, objectName, minio.GetObjectOptions{})
 	if err != nil {
-		c.log.Errorf("Failed to generate presigned URL: %v", err)
-		return nil, storageV1.ErrorDownloadFailed("failed to generate presigned URL")
+		c.log.Errorf("Failed to get object: %v", err)
+		return nil, ObjectInfo{}, storageV1.ErrorDownloadFailed("failed to get object")
 	}
 
-	downloadUrl := presignedURL.String()
-	downloadUrl = ReplaceEndpointHost(downloadUrl, c.conf.Minio.DownloadHost, c.conf.Minio.Endpoint)
-	if !strings.HasPrefix(downloadUrl, presignedURL.Scheme) {
-		downloadUrl = presignedURL.Scheme + "://" + downloadUrl
+	info, err := object.Stat()
+	if err != nil {
+		_ = object.Close()
+		return nil, ObjectInfo{}, c.statError(err)
 	}
 
-	return &storageV1.GetDownloadInfoResponse{
-		Content: &storageV1.GetDownloadInfoResponse_DownloadUrl{
-			DownloadUrl: downloadUrl,
-		},
-	}, nil
+	return object, toObjectInfo(bucketName, info), nil
 }
 
-// GetDownloadUrl 获取下载地址
-func (c *MinIOClient) GetDownloadUrl(ctx context.Context, req *storageV1.GetDownloadInfoRequest) (*storageV1.GetDownloadInfoResponse, error) {
-	switch req.Selector.(type) {
-	case *storageV1.GetDownloadInfoRequest_StorageObject:
-		if req.GetPreferPresignedUrl() {
-			return c.getDownloadUrlWithStorageObjectPresigned(ctx, req)
-		} else {
-			return c.getDownloadUrlWithStorageObjectDirect(ctx, req)
-		}
+// StatObject 获取对象元信息
+func (c *MinIOClient) StatObject(ctx context.Context, bucketName, objectName string) (ObjectInfo, error) {
+	info, err := c.mc.StatObject(ctx, bucketName, objectName, minio.StatObjectOptions{})
+	if err != nil {
+		return ObjectInfo{}, c.statError(err)
+	}
 
-	case *storageV1.GetDownloadInfoRequest_FileId:
-		return nil, storageV1.ErrorNotImplemented("not implemented yet")
+	return toObjectInfo(bucketName, info), nil
+}
 
-	default:
-		return nil, storageV1.ErrorBadRequest("invalid selector")
+// statError 转换获取对象元信息时的错误
+func (c *MinIOClient) statError(err error) error {
+	if minio.ToErrorResponse(err).Code == minio.NoSuchKey {
+		return storageV1.ErrorFileNotFound("object not found")
 	}
+	c.log.Errorf("Failed to stat object: %v", err)
+	return storageV1.ErrorDownloadFailed("failed to stat object")
 }
 
-// downloadFileWithStorageObjectDirect 直接获取文件内容
-func (c *MinIOClient) downloadFileWithStorageObjectDirect(ctx context.Context, req *storageV1.DownloadFileRequest) (*storageV1.DownloadFileResponse, error) {
-	opts := minio.GetObjectOptions{}
-
-	SetDownloadRange(&opts, req.RangeStart, req.RangeEnd)
-
-	object, err := c.mc.GetObject(
-		ctx,
-		req.GetStorageObject().GetBucketName(),
-		req.GetStorageObject().GetObjectName(),
-		opts,
-	)
-	if err != nil {
-		c.log.Errorf("Failed to get object: %v", err)
-		return nil, storageV1.ErrorDownloadFailed("failed to get object")
+// publicUrl 把 MinIO 内部地址替换为对外的访问地址
+func (c *MinIOClient) publicUrl(u *url.URL, host string) string {
+	rawURL := ReplaceEndpointHost(u.String(), host, c.conf.Minio.Endpoint)
+	if !strings.HasPrefix(rawURL, u.Scheme) {
+		rawURL = u.Scheme + "://" + rawURL
 	}
+	return rawURL
+}
 
-	buf := new(bytes.Buffer)
-	_, err = buf.ReadFrom(object)
+// PresignedGetObject 生成预签名下载地址
+func (c *MinIOClient) PresignedGetObject(ctx context.Context, bucketName, objectName string, expiry time.Duration) (string, error) {
+	presignedURL, err := c.mc.PresignedGetObject(ctx, bucketName, objectName, expiry, nil)
 	if err != nil {
-		c.log.Errorf("Failed to read object: %v", err)
-		return nil, storageV1.ErrorDownloadFailed("failed to read object")
+		c.log.Errorf("Failed to generate presigned URL: %v", err)
+		return "", storageV1.ErrorDownloadFailed("failed to generate presigned URL")
 	}
 
-	resp := &storageV1.DownloadFileResponse{
-		Content: &storageV1.DownloadFileResponse_File{
-			File: buf.Bytes(),
-		},
-	}
+	return c.publicUrl(presignedURL, c.conf.Minio.DownloadHost), nil
+}
 
-	st, err := object.Stat()
+// PresignedPutObject 生成预签名 PUT 上传地址
+func (c *MinIOClient) PresignedPutObject(ctx context.Context, bucketName, objectName string, expiry time.Duration) (string, error) {
+	presignedURL, err := c.mc.PresignedPutObject(ctx, bucketName, objectName, expiry)
 	if err != nil {
-		c.log.Errorf("Failed to stat object: %v", err)
-		return nil, storageV1.ErrorDownloadFailed("failed to stat object")
+		c.log.Errorf("Failed to generate presigned PUT policy: %v", err)
+		return "", storageV1.ErrorUploadFailed("failed to generate presigned PUT policy")
 	}
 
-	if req.GetAcceptMime() != "" {
-		resp.Mime = req.GetAcceptMime()
-	} else {
-		resp.Mime = st.ContentType
+	return c.publicUrl(presignedURL, c.conf.Minio.UploadHost), nil
+}
+
+// PresignedPostObject 生成预签名 POST 表单上传地址及表单字段
+func (c *MinIOClient) PresignedPostObject(ctx context.Context, bucketName, objectName, contentType string, expiry time.Duration) (string, map[string]string, error) {
+	policy := minio.NewPostPolicy()
+	_ = policy.SetBucket(bucketName)
+	_ = policy.SetKey(objectName)
+	_ = policy.SetExpires(time.Now().UTC().Add(expiry))
+	if contentType != "" {
+		_ = policy.SetContentType(contentType)
 	}
-	if resp.GetMime() == "" {
-		resp.Mime = DefaultContentType
+
+	presignedURL, formData, err := c.mc.PresignedPostPolicy(ctx, policy)
+	if err != nil {
+		c.log.Errorf("Failed to generate presigned POST policy: %v", err)
+		return "", nil, storageV1.ErrorUploadFailed("failed to generate presigned POST policy")
 	}
 
-	resp.Checksum = st.ChecksumSHA256
-	resp.SourceFileName = st.Key
-	resp.Size = st.Size
-	resp.UpdatedAt = timeutil.TimeToTimestamppb(&st.LastModified)
+	return c.publicUrl(presignedURL, c.conf.Minio.UploadHost), formData, nil
+}
 
-	return resp, nil
+// UploadFile 上传文件
+func (c *MinIOClient) UploadFile(ctx context.Context, bucketName, objectName, mimeType string, fileContent []byte) (ObjectInfo, string, string, error) {
+	return UploadFile(ctx, c, bucketName, objectName, mimeType, fileContent)
 }
 
-// downloadFileWithStorageObjectPresigned 获取预签名下载地址
-func (c *MinIOClient) downloadFileWithStorageObjectPresigned(ctx context.Context, req *storageV1.DownloadFileRequest) (*storageV1.DownloadFileResponse, error) {
-	expires := defaultExpiryTime
-	if req.PresignExpireSeconds != nil {
-		expires = time.Second * time.Duration(req.GetPresignExpireSeconds())
-	}
-	presignedURL, err := c.mc.PresignedGetObject(
-		ctx,
-		req.GetStorageObject().GetBucketName(),
-		req.GetStorageObject().GetObjectName(),
-		expires,
-		nil,
-	)
-	if err != nil {
-		c.log.Errorf("Failed to generate presigned URL: %v", err)
-		return nil, storageV1.ErrorDownloadFailed("failed to generate presigned URL")
-	}
+// GetUploadPresignedUrl 获取上传地址
+func (c *MinIOClient) GetUploadPresignedUrl(ctx context.Context, req *storageV1.GetUploadPresignedUrlRequest) (*storageV1.GetUploadPresignedUrlResponse, error) {
+	return GetUploadPresignedUrl(ctx, c, req)
+}
 
-	downloadUrl := presignedURL.String()
-	downloadUrl = ReplaceEndpointHost(downloadUrl, c.conf.Minio.DownloadHost, c.conf.Minio.Endpoint)
-	if !strings.HasPrefix(downloadUrl, presignedURL.Scheme) {
-		downloadUrl = presignedURL.Scheme + "://" + downloadUrl
-	}
+// ListFile 获取文件夹下面的文件列表
+func (c *MinIOClient) ListFile(ctx context.Context, req *storageV1.ListOssFileRequest) (*storageV1.ListOssFileResponse, error) {
+	return ListFile(ctx, c, req)
+}
 
-	return &storageV1.DownloadFileResponse{
-		Content: &storageV1.DownloadFileResponse_DownloadUrl{
-			DownloadUrl: downloadUrl,
-		},
-	}, nil
+// GetDownloadUrl 获取下载地址
+func (c *MinIOClient) GetDownloadUrl(ctx context.Context, req *storageV1.GetDownloadInfoRequest) (*storageV1.GetDownloadInfoResponse, error) {
+	return GetDownloadUrl(ctx, c, req)
 }
 
 // DownloadFile 下载文件
 func (c *MinIOClient) DownloadFile(ctx context.Context, req *storageV1.DownloadFileRequest) (*storageV1.DownloadFileResponse, error) {
-	switch req.Selector.(type) {
-	case *storageV1.DownloadFileRequest_StorageObject:
-		if req.GetPreferPresignedUrl() {
-			return c.downloadFileWithStorageObjectPresigned(ctx, req)
-		} else {
-			return c.downloadFileWithStorageObjectDirect(ctx, req)
-		}
-
-	case *storageV1.DownloadFileRequest_FileId:
-		return nil, storageV1.ErrorNotImplemented("not implemented yet")
+	return DownloadFile(ctx, c, req)
+}
 
-	default:
-		return nil, storageV1.ErrorBadRequest("invalid selector")
+// toObjectInfo 转换 MinIO 的对象信息
+func toObjectInfo(bucketName string, info minio.ObjectInfo) ObjectInfo {
+	return ObjectInfo{
+		Bucket:       bucketName,
+		Key:          info.Key,
+		Size:         info.Size,
+		ContentType:  info.ContentType,
+		ETag:         strings.Trim(info.ETag, "\""),
+		LastModified: info.LastModified,
 	}
 }
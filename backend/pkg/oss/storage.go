@@ -0,0 +1,323 @@
+package oss
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"time"
+
+	"github.com/tx7do/go-utils/timeutil"
+	"github.com/tx7do/go-utils/trans"
+
+	storageV1 "go-wind-admin/api/gen/go/storage/service/v1"
+)
+
+// ObjectInfo 对象元信息
+type ObjectInfo struct {
+	Bucket       string
+	Key          string
+	Size         int64
+	ContentType  string
+	ETag         string
+	LastModified time.Time
+}
+
+// CompletePart 分片上传中已上传的分片
+type CompletePart struct {
+	PartNumber int
+	ETag       string
+}
+
+// ObjectStorage 对象存储后端，屏蔽不同存储提供商之间的差异
+type ObjectStorage interface {
+	// Provider 存储提供商
+	Provider() storageV1.OSSProvider
+
+	// EnsureBucketExists 确保存储桶存在，不存在时创建
+	EnsureBucketExists(ctx context.Context, bucketName string) error
+
+	// UploadStream 以流的方式写入对象（size 未知时传 -1）
+	UploadStream(ctx context.Context, bucketName, objectName, contentType string, reader io.Reader, size int64) (ObjectInfo, error)
+	// GetObject 获取对象的读取流，调用方负责关闭
+	GetObject(ctx context.Context, bucketName, objectName string) (io.ReadCloser, error)
+	// OpenObject 打开对象用于随机读取，调用方负责关闭
+	OpenObject(ctx context.Context, bucketName, objectName string) (io.ReadSeekCloser, ObjectInfo, error)
+	// StatObject 获取对象元信息
+	StatObject(ctx context.Context, bucketName, objectName string) (ObjectInfo, error)
+	// DeleteFile 删除对象，对象不存在时不报错
+	DeleteFile(ctx context.Context, bucketName, objectName string) error
+	// ListObjects 按前缀列举对象，非递归时子目录以 "/" 结尾的公共前缀返回
+	ListObjects(ctx context.Context, bucketName, prefix string, recursive bool) ([]ObjectInfo, error)
+
+	// GetObjectDownloadUrl 获取对象的直接下载地址
+	GetObjectDownloadUrl(bucketName, objectName string) string
+	// PresignedGetObject 生成预签名下载地址
+	PresignedGetObject(ctx context.Context, bucketName, objectName string, expiry time.Duration) (string, error)
+	// PresignedPutObject 生成预签名 PUT 上传地址
+	PresignedPutObject(ctx context.Context, bucketName, objectName string, expiry time.Duration) (string, error)
+	// PresignedPostObject 生成预签名 POST 表单上传地址及表单字段
+	PresignedPostObject(ctx context.Context, bucketName, objectName, contentType string, expiry time.Duration) (string, map[string]string, error)
+
+	// NewMultipartUpload 初始化分片上传，返回分片上传ID
+	NewMultipartUpload(ctx context.Context, bucketName, objectName, contentType string) (string, error)
+	// PutObjectPart 上传一个分片，返回分片的ETag
+	PutObjectPart(ctx context.Context, bucketName, objectName, uploadID string, partNumber int, reader io.Reader, size int64) (string, error)
+	// CompleteMultipartUpload 合并已上传的分片
+	CompleteMultipartUpload(ctx context.Context, bucketName, objectName, uploadID string, parts []CompletePart) (ObjectInfo, error)
+	// AbortMultipartUpload 中止分片上传并释放已上传的分片
+	AbortMultipartUpload(ctx context.Context, bucketName, objectName, uploadID string) error
+}
+
+// UploadFile 上传文件内容，未指定对象名时按内容类型生成，返回对象信息、存储路径与下载地址
+func UploadFile(
+	ctx context.Context,
+	storage ObjectStorage,
+	bucketName string, objectName string,
+	mimeType string,
+	fileContent []byte,
+) (ObjectInfo, string, string, error) {
+	if len(fileContent) == 0 {
+		return ObjectInfo{}, "", "", storageV1.ErrorUploadFailed("empty fileContent data")
+	}
+
+	if bucketName == "" {
+		bucketName = BucketFiles
+	}
+
+	var ext string
+	if mimeType == "" {
+		mimeType, ext = DetectFileType(fileContent)
+	}
+	if ext == "" {
+		ext = ContentTypeToFileExtension(mimeType)
+	}
+
+	if objectName == "" {
+		bucketName = ContentTypeToBucketName(mimeType)
+		objectName = GenerateObjectName("", fileContent, ext, GenerateFileNameTypeUUID)
+	}
+	if mimeType == "" {
+		mimeType = DefaultContentType
+	}
+
+	info, err := storage.UploadStream(ctx, bucketName, objectName, mimeType, bytes.NewReader(fileContent), int64(len(fileContent)))
+	if err != nil {
+		return info, "", "", err
+	}
+
+	downloadUrl := storage.GetObjectDownloadUrl(bucketName, objectName)
+	storagePath := JoinObjectUrl("", bucketName, objectName)
+
+	return info, storagePath, downloadUrl, nil
+}
+
+// GetUploadPresignedUrl 获取上传地址
+func GetUploadPresignedUrl(ctx context.Context, storage ObjectStorage, req *storageV1.GetUploadPresignedUrlRequest) (*storageV1.GetUploadPresignedUrlResponse, error) {
+	var bucketName string
+	if req.BucketName != nil {
+		bucketName = req.GetBucketName()
+	} else {
+		bucketName = ContentTypeToBucketName(req.GetContentType())
+	}
+	if bucketName == "" {
+		bucketName = BucketFiles
+	}
+
+	objectName, _ := JoinObjectName(req.GetContentType(), req.FileDirectory, req.FileName)
+
+	expiry := DefaultExpiryTime
+	if req.ExpireSeconds != nil {
+		expiry = time.Second * time.Duration(req.GetExpireSeconds())
+	}
+
+	if err := storage.EnsureBucketExists(ctx, bucketName); err != nil {
+		return nil, err
+	}
+
+	var uploadUrl string
+	var formData map[string]string
+	var err error
+
+	switch req.GetMethod() {
+	case storageV1.GetUploadPresignedUrlRequest_Put:
+		uploadUrl, err = storage.PresignedPutObject(ctx, bucketName, objectName, expiry)
+
+	case storageV1.GetUploadPresignedUrlRequest_Post:
+		uploadUrl, formData, err = storage.PresignedPostObject(ctx, bucketName, objectName, req.GetContentType(), expiry)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &storageV1.GetUploadPresignedUrlResponse{
+		UploadUrl:   uploadUrl,
+		DownloadUrl: storage.GetObjectDownloadUrl(bucketName, objectName),
+		ObjectName:  objectName,
+		BucketName:  trans.Ptr(bucketName),
+		FormData:    formData,
+	}, nil
+}
+
+// ListFile 获取文件夹下面的文件列表
+func ListFile(ctx context.Context, storage ObjectStorage, req *storageV1.ListOssFileRequest) (*storageV1.ListOssFileResponse, error) {
+	objects, err := storage.ListObjects(ctx, req.GetBucketName(), req.GetFolder(), req.GetRecursive())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &storageV1.ListOssFileResponse{
+		Files: make([]string, 0, len(objects)),
+	}
+	for _, object := range objects {
+		resp.Files = append(resp.Files, object.Key)
+	}
+	return resp, nil
+}
+
+// readObjectRange 读取对象内容，可指定闭区间范围
+func readObjectRange(ctx context.Context, storage ObjectStorage, bucketName, objectName string, start, end *int64) ([]byte, ObjectInfo, error) {
+	object, info, err := storage.OpenObject(ctx, bucketName, objectName)
+	if err != nil {
+		return nil, ObjectInfo{}, err
+	}
+	defer object.Close()
+
+	var reader io.Reader = object
+	if start != nil {
+		if _, err = object.Seek(*start, io.SeekStart); err != nil {
+			return nil, ObjectInfo{}, storageV1.ErrorDownloadFailed("failed to seek object")
+		}
+	}
+	if end != nil {
+		var offset int64
+		if start != nil {
+			offset = *start
+		}
+		reader = io.LimitReader(object, *end-offset+1)
+	}
+
+	buf := new(bytes.Buffer)
+	if _, err = buf.ReadFrom(reader); err != nil {
+		return nil, ObjectInfo{}, storageV1.ErrorDownloadFailed("failed to read object")
+	}
+
+	return buf.Bytes(), info, nil
+}
+
+// GetDownloadUrl 获取下载地址
+func GetDownloadUrl(ctx context.Context, storage ObjectStorage, req *storageV1.GetDownloadInfoRequest) (*storageV1.GetDownloadInfoResponse, error) {
+	switch req.Selector.(type) {
+	case *storageV1.GetDownloadInfoRequest_StorageObject:
+		bucketName := req.GetStorageObject().GetBucketName()
+		objectName := req.GetStorageObject().GetObjectName()
+
+		if req.GetPreferPresignedUrl() {
+			expiry := DefaultExpiryTime
+			if req.PresignExpireSeconds != nil {
+				expiry = time.Second * time.Duration(req.GetPresignExpireSeconds())
+			}
+
+			downloadUrl, err := storage.PresignedGetObject(ctx, bucketName, objectName, expiry)
+			if err != nil {
+				return nil, err
+			}
+
+			return &storageV1.GetDownloadInfoResponse{
+				Content: &storageV1.GetDownloadInfoResponse_DownloadUrl{
+					DownloadUrl: downloadUrl,
+				},
+			}, nil
+		}
+
+		content, info, err := readObjectRange(ctx, storage, bucketName, objectName, req.RangeStart, req.RangeEnd)
+		if err != nil {
+			return nil, err
+		}
+
+		resp := &storageV1.GetDownloadInfoResponse{
+			Content: &storageV1.GetDownloadInfoResponse_File{
+				File: content,
+			},
+		}
+
+		if req.GetAcceptMime() != "" {
+			resp.Mime = req.GetAcceptMime()
+		} else {
+			resp.Mime = info.ContentType
+		}
+		if resp.GetMime() == "" {
+			resp.Mime = DefaultContentType
+		}
+
+		resp.SourceFileName = info.Key
+		resp.Size = info.Size
+		resp.UpdatedAt = timeutil.TimeToTimestamppb(&info.LastModified)
+
+		return resp, nil
+
+	case *storageV1.GetDownloadInfoRequest_FileId:
+		return nil, storageV1.ErrorNotImplemented("not implemented yet")
+
+	default:
+		return nil, storageV1.ErrorBadRequest("invalid selector")
+	}
+}
+
+// DownloadFile 下载文件
+func DownloadFile(ctx context.Context, storage ObjectStorage, req *storageV1.DownloadFileRequest) (*storageV1.DownloadFileResponse, error) {
+	switch req.Selector.(type) {
+	case *storageV1.DownloadFileRequest_StorageObject:
+		bucketName := req.GetStorageObject().GetBucketName()
+		objectName := req.GetStorageObject().GetObjectName()
+
+		if req.GetPreferPresignedUrl() {
+			expiry := DefaultExpiryTime
+			if req.PresignExpireSeconds != nil {
+				expiry = time.Second * time.Duration(req.GetPresignExpireSeconds())
+			}
+
+			downloadUrl, err := storage.PresignedGetObject(ctx, bucketName, objectName, expiry)
+			if err != nil {
+				return nil, err
+			}
+
+			return &storageV1.DownloadFileResponse{
+				Content: &storageV1.DownloadFileResponse_DownloadUrl{
+					DownloadUrl: downloadUrl,
+				},
+			}, nil
+		}
+
+		content, info, err := readObjectRange(ctx, storage, bucketName, objectName, req.RangeStart, req.RangeEnd)
+		if err != nil {
+			return nil, err
+		}
+
+		resp := &storageV1.DownloadFileResponse{
+			Content: &storageV1.DownloadFileResponse_File{
+				File: content,
+			},
+		}
+
+		if req.GetAcceptMime() != "" {
+			resp.Mime = req.GetAcceptMime()
+		} else {
+			resp.Mime = info.ContentType
+		}
+		if resp.GetMime() == "" {
+			resp.Mime = DefaultContentType
+		}
+
+		resp.SourceFileName = info.Key
+		resp.Size = info.Size
+		resp.UpdatedAt = timeutil.TimeToTimestamppb(&info.LastModified)
+
+		return resp, nil
+
+	case *storageV1.DownloadFileRequest_FileId:
+		return nil, storageV1.ErrorNotImplemented("not implemented yet")
+
+	default:
+		return nil, storageV1.ErrorBadRequest("invalid selector")
+	}
+}
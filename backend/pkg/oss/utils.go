@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"mime"
 	"net/http"
+	"slices"
 	"strings"
 	"time"
 
@@ -30,8 +31,29 @@ const (
 	BucketAudios = "audios"
 	BucketDocs   = "docs"
 	BucketFiles  = "files"
+
+	BucketAuditArchives    = "audit-archives"    // 审计日志归档
+	BucketAuditExports     = "audit-exports"     // 审计日志导出
+	BucketBackups          = "db-backups"        // 数据库备份
+	BucketQuarantine       = "quarantine"        // 对账隔离的孤立对象
+	BucketStorageReports   = "storage-reports"   // 存储对账报告
+	BucketImageDerivatives = "image-derivatives" // 图片缩略图与缩放缓存
 )
 
+// UserBuckets 用户上传文件可以写入的存储桶，其余存储桶仅供系统内部使用
+var UserBuckets = []string{
+	BucketImages,
+	BucketVideos,
+	BucketAudios,
+	BucketDocs,
+	BucketFiles,
+}
+
+// IsUserBucket 判断存储桶是否允许用户上传文件
+func IsUserBucket(bucketName string) bool {
+	return slices.Contains(UserBuckets, bucketName)
+}
+
 var staticHMACSecret = []byte("0123456789abcdef0123456789abcdef") // 32 bytes secret for HMAC
 
 // ContentTypeToBucketName 根据文件类型获取存储桶名称
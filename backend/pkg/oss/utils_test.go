@@ -46,6 +46,20 @@ func TestContentTypeToBucketName(t *testing.T) {
 	}
 }
 
+func TestIsUserBucket(t *testing.T) {
+	for _, bucket := range []string{BucketImages, BucketVideos, BucketAudios, BucketDocs, BucketFiles} {
+		if !IsUserBucket(bucket) {
+			t.Errorf("IsUserBucket(%q) = false, want true", bucket)
+		}
+	}
+
+	for _, bucket := range []string{"", BucketBackups, BucketAuditArchives, BucketAuditExports, BucketQuarantine, BucketStorageReports, BucketImageDerivatives, "other"} {
+		if IsUserBucket(bucket) {
+			t.Errorf("IsUserBucket(%q) = true, want false", bucket)
+		}
+	}
+}
+
 func TestFileExtensionToBucketName(t *testing.T) {
 	tests := []struct {
 		name string
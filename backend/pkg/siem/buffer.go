@@ -0,0 +1,176 @@
+package siem
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const bufferFileSuffix = ".msg"
+
+// ErrPayloadTooLarge is returned when a single payload exceeds the buffer capacity.
+var ErrPayloadTooLarge = errors.New("payload exceeds buffer capacity")
+
+// DiskBuffer is a FIFO of payloads stored one file per entry in a directory, so
+// undelivered events survive restarts. When full, the oldest entries are dropped.
+type DiskBuffer struct {
+	dir      string
+	maxBytes int64
+
+	mu      sync.Mutex
+	entries []bufferEntry
+	size    int64
+	seq     uint64
+	dropped uint64
+}
+
+type bufferEntry struct {
+	name string
+	size int64
+}
+
+// NewDiskBuffer opens (or creates) a buffer in dir, picking up entries left by a previous run.
+func NewDiskBuffer(dir string, maxBytes int64) (*DiskBuffer, error) {
+	if maxBytes <= 0 {
+		return nil, fmt.Errorf("buffer capacity must be positive")
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+
+	items, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &DiskBuffer{dir: dir, maxBytes: maxBytes}
+	for _, item := range items {
+		name := item.Name()
+		if item.IsDir() || !strings.HasSuffix(name, bufferFileSuffix) {
+			continue
+		}
+		seq, err := strconv.ParseUint(strings.TrimSuffix(name, bufferFileSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		info, err := item.Info()
+		if err != nil {
+			continue
+		}
+
+		// ReadDir sorts by name and names are zero padded, so entries stay in order
+		b.entries = append(b.entries, bufferEntry{name: name, size: info.Size()})
+		b.size += info.Size()
+		if seq > b.seq {
+			b.seq = seq
+		}
+	}
+
+	return b, nil
+}
+
+// Push appends a payload, evicting the oldest entries if the buffer is full.
+func (b *DiskBuffer) Push(payload []byte) error {
+	size := int64(len(payload))
+	if size > b.maxBytes {
+		return ErrPayloadTooLarge
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for b.size+size > b.maxBytes && len(b.entries) > 0 {
+		b.removeLocked(b.entries[0].name)
+		b.dropped++
+	}
+
+	b.seq++
+	name := fmt.Sprintf("%020d%s", b.seq, bufferFileSuffix)
+
+	// write then rename, so a crash never leaves a truncated entry behind
+	tmp := filepath.Join(b.dir, name+".tmp")
+	if err := os.WriteFile(tmp, payload, 0o600); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, filepath.Join(b.dir, name)); err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+
+	b.entries = append(b.entries, bufferEntry{name: name, size: size})
+	b.size += size
+	return nil
+}
+
+// Peek returns the oldest entry without removing it. ok is false when the buffer is empty.
+func (b *DiskBuffer) Peek() (key string, payload []byte, ok bool, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for len(b.entries) > 0 {
+		entry := b.entries[0]
+		payload, err = os.ReadFile(filepath.Join(b.dir, entry.name))
+		if err == nil {
+			return entry.name, payload, true, nil
+		}
+		if !errors.Is(err, os.ErrNotExist) {
+			return "", nil, false, err
+		}
+		// removed behind our back, skip it
+		b.entries = b.entries[1:]
+		b.size -= entry.size
+	}
+	return "", nil, false, nil
+}
+
+// Remove deletes an entry returned by Peek.
+func (b *DiskBuffer) Remove(key string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.removeLocked(key)
+}
+
+func (b *DiskBuffer) removeLocked(key string) error {
+	for i, entry := range b.entries {
+		if entry.name != key {
+			continue
+		}
+		b.entries = append(b.entries[:i], b.entries[i+1:]...)
+		b.size -= entry.size
+
+		if err := os.Remove(filepath.Join(b.dir, key)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		return nil
+	}
+	return nil
+}
+
+// Len returns the number of buffered entries.
+func (b *DiskBuffer) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return len(b.entries)
+}
+
+// Size returns the number of buffered bytes.
+func (b *DiskBuffer) Size() int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.size
+}
+
+// Dropped returns how many entries were evicted because the buffer was full.
+func (b *DiskBuffer) Dropped() uint64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.dropped
+}
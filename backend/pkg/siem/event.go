@@ -0,0 +1,124 @@
+package siem
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Severity is a CEF style severity from 0 (lowest) to 10 (highest).
+type Severity int
+
+const (
+	SeverityLow      Severity = 3
+	SeverityMedium   Severity = 5
+	SeverityHigh     Severity = 8
+	SeverityCritical Severity = 10
+)
+
+// Event is a normalized audit event handed to the forwarders.
+type Event struct {
+	// Type is the source log type, e.g. LOGIN_AUDIT_LOG.
+	Type string
+	// TenantID is the tenant the event belongs to, used for routing.
+	TenantID uint32
+	// Time is when the event happened.
+	Time time.Time
+	// SignatureID identifies the kind of event (CEF Signature ID, syslog MSGID).
+	SignatureID string
+	// Name is a short human-readable description.
+	Name string
+	// Severity of the event.
+	Severity Severity
+	// Fields are the flattened source fields keyed by their JSON path, e.g. "geoLocation.countryCode".
+	Fields map[string]string
+}
+
+// Field is a single key/value pair of a formatted event.
+type Field struct {
+	Key   string
+	Value string
+}
+
+// Mapping maps output keys to source field paths.
+type Mapping map[string]string
+
+// NewEvent flattens a protobuf audit message into an Event.
+func NewEvent(logType string, tenantID uint32, at time.Time, msg proto.Message) (*Event, error) {
+	b, err := protojson.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+
+	var doc map[string]any
+	if err = dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]string)
+	flatten("", doc, fields)
+
+	return &Event{
+		Type:     logType,
+		TenantID: tenantID,
+		Time:     at,
+		Fields:   fields,
+	}, nil
+}
+
+func flatten(prefix string, v any, out map[string]string) {
+	switch t := v.(type) {
+	case map[string]any:
+		for k, child := range t {
+			key := k
+			if prefix != "" {
+				key = prefix + "." + k
+			}
+			flatten(key, child, out)
+		}
+	case []any:
+		b, _ := json.Marshal(t)
+		out[prefix] = string(b)
+	case string:
+		out[prefix] = t
+	case json.Number:
+		out[prefix] = t.String()
+	case bool:
+		out[prefix] = strconv.FormatBool(t)
+	case nil:
+	default:
+		out[prefix] = fmt.Sprint(t)
+	}
+}
+
+// Project returns the fields selected by the mapping, sorted by output key.
+// An empty mapping selects all fields under their source path.
+// Source fields missing from the event are skipped.
+func (e *Event) Project(mapping Mapping) []Field {
+	var fields []Field
+	if len(mapping) == 0 {
+		fields = make([]Field, 0, len(e.Fields))
+		for k, v := range e.Fields {
+			fields = append(fields, Field{Key: k, Value: v})
+		}
+	} else {
+		fields = make([]Field, 0, len(mapping))
+		for out, src := range mapping {
+			if v, ok := e.Fields[src]; ok {
+				fields = append(fields, Field{Key: out, Value: v})
+			}
+		}
+	}
+
+	sort.Slice(fields, func(i, j int) bool { return fields[i].Key < fields[j].Key })
+	return fields
+}
@@ -0,0 +1,270 @@
+package siem
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Formatter renders an event into the payload sent by a Sender.
+type Formatter interface {
+	Format(ev *Event) ([]byte, error)
+}
+
+const (
+	// DefaultSDID is the RFC 5424 structured data ID. 32473 is the private enterprise number reserved for documentation.
+	DefaultSDID = "audit@32473"
+	// DefaultAppName is the syslog APP-NAME and CEF product used when none is configured.
+	DefaultAppName = "go-wind-admin"
+
+	// FacilityLocal0 is the syslog facility local0.
+	FacilityLocal0 = 16
+)
+
+const rfc5424Time = "2006-01-02T15:04:05.000000Z07:00"
+
+// RFC5424Formatter formats events as RFC 5424 syslog messages carrying the fields as structured data.
+type RFC5424Formatter struct {
+	Hostname string
+	AppName  string
+	Facility int
+	SDID     string
+	Mapping  Mapping
+}
+
+func (f *RFC5424Formatter) Format(ev *Event) ([]byte, error) {
+	var buf bytes.Buffer
+	f.writeHeader(&buf, ev)
+
+	buf.WriteByte(' ')
+	fields := ev.Project(f.Mapping)
+	if len(fields) == 0 {
+		buf.WriteByte('-')
+	} else {
+		buf.WriteByte('[')
+		buf.WriteString(sdName(orDefault(f.SDID, DefaultSDID), 32, true))
+		for _, fld := range fields {
+			buf.WriteByte(' ')
+			buf.WriteString(sdName(fld.Key, 32, false))
+			buf.WriteString(`="`)
+			buf.WriteString(escapeSDValue(fld.Value))
+			buf.WriteByte('"')
+		}
+		buf.WriteByte(']')
+	}
+
+	if ev.Name != "" {
+		// MSG is UTF-8, so it starts with a BOM
+		buf.WriteString(" \xEF\xBB\xBF")
+		buf.WriteString(ev.Name)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// writeHeader writes "<PRI>1 TIMESTAMP HOSTNAME APP-NAME PROCID MSGID".
+func (f *RFC5424Formatter) writeHeader(buf *bytes.Buffer, ev *Event) {
+	buf.WriteByte('<')
+	buf.WriteString(strconv.Itoa(f.Facility*8 + syslogSeverity(ev.Severity)))
+	buf.WriteString(">1 ")
+
+	at := ev.Time
+	if at.IsZero() {
+		at = time.Now()
+	}
+	buf.WriteString(at.UTC().Format(rfc5424Time))
+
+	buf.WriteByte(' ')
+	buf.WriteString(headerField(f.Hostname, 255))
+	buf.WriteByte(' ')
+	buf.WriteString(headerField(orDefault(f.AppName, DefaultAppName), 48))
+	buf.WriteString(" - ")
+	buf.WriteString(headerField(ev.SignatureID, 32))
+}
+
+// syslogSeverity maps a CEF severity to a syslog severity.
+func syslogSeverity(s Severity) int {
+	switch {
+	case s >= 9:
+		return 2 // critical
+	case s >= 7:
+		return 3 // error
+	case s >= 4:
+		return 4 // warning
+	case s >= 1:
+		return 5 // notice
+	default:
+		return 6 // informational
+	}
+}
+
+// headerField keeps printable US-ASCII only and returns the NILVALUE for empty fields.
+func headerField(s string, maxLen int) string {
+	var b strings.Builder
+	for i := 0; i < len(s) && b.Len() < maxLen; i++ {
+		if c := s[i]; c >= 33 && c <= 126 {
+			b.WriteByte(c)
+		}
+	}
+	if b.Len() == 0 {
+		return "-"
+	}
+	return b.String()
+}
+
+// sdName sanitizes an SD-ID or PARAM-NAME. '@' is only allowed in SD-IDs.
+func sdName(s string, maxLen int, allowAt bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s) && b.Len() < maxLen; i++ {
+		c := s[i]
+		switch {
+		case c < 33 || c > 126, c == '=', c == ']', c == '"':
+			b.WriteByte('_')
+		case c == '@' && !allowAt:
+			b.WriteByte('_')
+		default:
+			b.WriteByte(c)
+		}
+	}
+	if b.Len() == 0 {
+		return "_"
+	}
+	return b.String()
+}
+
+var sdValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`)
+
+func escapeSDValue(s string) string {
+	return sdValueEscaper.Replace(s)
+}
+
+// CEFFormatter formats events as ArcSight CEF. When Syslog is set the CEF line is
+// sent as the MSG of an RFC 5424 message without structured data.
+type CEFFormatter struct {
+	Vendor  string
+	Product string
+	Version string
+	Mapping Mapping
+	Syslog  *RFC5424Formatter
+}
+
+var (
+	cefHeaderEscaper = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\r\n", " ", "\n", " ", "\r", " ")
+	cefValueEscaper  = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\r\n", `\n`, "\n", `\n`, "\r", `\r`)
+)
+
+func (f *CEFFormatter) Format(ev *Event) ([]byte, error) {
+	var buf bytes.Buffer
+
+	if f.Syslog != nil {
+		f.Syslog.writeHeader(&buf, ev)
+		buf.WriteString(" - ")
+	}
+
+	buf.WriteString("CEF:0|")
+	buf.WriteString(cefHeaderEscaper.Replace(orDefault(f.Vendor, DefaultAppName)))
+	buf.WriteByte('|')
+	buf.WriteString(cefHeaderEscaper.Replace(orDefault(f.Product, DefaultAppName)))
+	buf.WriteByte('|')
+	buf.WriteString(cefHeaderEscaper.Replace(orDefault(f.Version, "1.0")))
+	buf.WriteByte('|')
+	buf.WriteString(cefHeaderEscaper.Replace(orDefault(ev.SignatureID, ev.Type)))
+	buf.WriteByte('|')
+	buf.WriteString(cefHeaderEscaper.Replace(orDefault(ev.Name, ev.Type)))
+	buf.WriteByte('|')
+	buf.WriteString(strconv.Itoa(clampSeverity(ev.Severity)))
+	buf.WriteByte('|')
+
+	at := ev.Time
+	if at.IsZero() {
+		at = time.Now()
+	}
+	buf.WriteString("rt=")
+	buf.WriteString(strconv.FormatInt(at.UnixMilli(), 10))
+
+	for _, fld := range ev.Project(f.Mapping) {
+		key := cefKey(fld.Key)
+		if key == "rt" {
+			continue
+		}
+		buf.WriteByte(' ')
+		buf.WriteString(key)
+		buf.WriteByte('=')
+		buf.WriteString(cefValueEscaper.Replace(fld.Value))
+	}
+
+	return buf.Bytes(), nil
+}
+
+// cefKey keeps the characters allowed in CEF extension keys.
+func cefKey(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') || c == '_' {
+			b.WriteByte(c)
+		}
+	}
+	if b.Len() == 0 {
+		return "cs1"
+	}
+	return b.String()
+}
+
+func clampSeverity(s Severity) int {
+	switch {
+	case s < 0:
+		return 0
+	case s > 10:
+		return 10
+	default:
+		return int(s)
+	}
+}
+
+// JSONFormatter formats events as a JSON document, used by the webhook sender.
+type JSONFormatter struct {
+	Mapping Mapping
+}
+
+type jsonEvent struct {
+	EventType   string            `json:"event_type"`
+	TenantID    uint32            `json:"tenant_id"`
+	Timestamp   string            `json:"timestamp"`
+	SignatureID string            `json:"signature_id,omitempty"`
+	Name        string            `json:"name,omitempty"`
+	Severity    int               `json:"severity"`
+	Data        map[string]string `json:"data"`
+}
+
+func (f *JSONFormatter) Format(ev *Event) ([]byte, error) {
+	at := ev.Time
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	fields := ev.Project(f.Mapping)
+	data := make(map[string]string, len(fields))
+	for _, fld := range fields {
+		data[fld.Key] = fld.Value
+	}
+
+	return json.Marshal(&jsonEvent{
+		EventType:   ev.Type,
+		TenantID:    ev.TenantID,
+		Timestamp:   at.UTC().Format(time.RFC3339Nano),
+		SignatureID: ev.SignatureID,
+		Name:        ev.Name,
+		Severity:    clampSeverity(ev.Severity),
+		Data:        data,
+	})
+}
+
+func orDefault(s, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
@@ -0,0 +1,244 @@
+package siem
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ErrForwarderClosed is returned by Forward after Close.
+var ErrForwarderClosed = errors.New("forwarder is closed")
+
+// ErrQueueFull is returned when the in-memory queue is full and there is no disk buffer.
+var ErrQueueFull = errors.New("forwarder queue is full")
+
+const (
+	defaultQueueSize  = 1024
+	defaultMinBackoff = 500 * time.Millisecond
+	defaultMaxBackoff = time.Minute
+)
+
+// Option configures a Forwarder.
+type Option func(*Forwarder)
+
+// WithBuffer sets the disk buffer used for payloads that could not be delivered.
+func WithBuffer(buffer *DiskBuffer) Option {
+	return func(f *Forwarder) { f.buffer = buffer }
+}
+
+// WithQueueSize sets the size of the in-memory queue.
+func WithQueueSize(size int) Option {
+	return func(f *Forwarder) {
+		if size > 0 {
+			f.queueSize = size
+		}
+	}
+}
+
+// WithBackoff sets the retry backoff bounds.
+func WithBackoff(minBackoff, maxBackoff time.Duration) Option {
+	return func(f *Forwarder) {
+		if minBackoff > 0 {
+			f.minBackoff = minBackoff
+		}
+		if maxBackoff >= f.minBackoff {
+			f.maxBackoff = maxBackoff
+		}
+	}
+}
+
+// WithLogger sets the logger.
+func WithLogger(logger log.Logger) Option {
+	return func(f *Forwarder) { f.log = log.NewHelper(log.With(logger, "module", "siem/forwarder")) }
+}
+
+// Forwarder formats events and delivers them asynchronously. Failed deliveries
+// are written to the disk buffer and retried with exponential backoff; buffered
+// entries are always delivered before new ones.
+type Forwarder struct {
+	name      string
+	formatter Formatter
+	sender    Sender
+	buffer    *DiskBuffer
+	log       *log.Helper
+
+	queueSize  int
+	minBackoff time.Duration
+	maxBackoff time.Duration
+
+	queue   chan []byte
+	closing chan struct{}
+	done    chan struct{}
+
+	closeOnce sync.Once
+	mu        sync.RWMutex
+	closed    bool
+}
+
+// NewForwarder creates a forwarder and starts its delivery loop.
+func NewForwarder(name string, formatter Formatter, sender Sender, opts ...Option) *Forwarder {
+	f := &Forwarder{
+		name:       name,
+		formatter:  formatter,
+		sender:     sender,
+		log:        log.NewHelper(log.With(log.DefaultLogger, "module", "siem/forwarder")),
+		queueSize:  defaultQueueSize,
+		minBackoff: defaultMinBackoff,
+		maxBackoff: defaultMaxBackoff,
+		closing:    make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(f)
+	}
+	f.queue = make(chan []byte, f.queueSize)
+
+	go f.run()
+
+	return f
+}
+
+// Name returns the forwarder name.
+func (f *Forwarder) Name() string {
+	return f.name
+}
+
+// Forward formats the event and queues it for delivery. It never blocks on the network.
+func (f *Forwarder) Forward(ev *Event) error {
+	payload, err := f.formatter.Format(ev)
+	if err != nil {
+		return err
+	}
+
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	if f.closed {
+		return ErrForwarderClosed
+	}
+
+	select {
+	case f.queue <- payload:
+		return nil
+	default:
+	}
+
+	if f.buffer == nil {
+		return ErrQueueFull
+	}
+	return f.buffer.Push(payload)
+}
+
+// Close stops the delivery loop, moving queued payloads to the disk buffer, and closes the sender.
+func (f *Forwarder) Close() error {
+	f.closeOnce.Do(func() {
+		f.mu.Lock()
+		f.closed = true
+		f.mu.Unlock()
+
+		close(f.closing)
+		<-f.done
+	})
+	return f.sender.Close()
+}
+
+func (f *Forwarder) run() {
+	defer close(f.done)
+
+	backoff := f.minBackoff
+	fail := func(err error) bool {
+		f.log.Warnf("forwarder [%s] delivery failed, retry in %s: %s", f.name, backoff, err.Error())
+		timer := time.NewTimer(backoff)
+		defer timer.Stop()
+
+		backoff *= 2
+		if backoff > f.maxBackoff {
+			backoff = f.maxBackoff
+		}
+
+		select {
+		case <-f.closing:
+			return false
+		case <-timer.C:
+			return true
+		}
+	}
+
+	for {
+		// deliver buffered entries first to keep them in order
+		if f.buffer != nil {
+			key, payload, ok, err := f.buffer.Peek()
+			if err != nil {
+				if !fail(err) {
+					f.spill()
+					return
+				}
+				continue
+			}
+			if ok {
+				// while the backlog drains, newer payloads queue up behind it on disk
+				f.spill()
+
+				if err = f.send(payload); err != nil {
+					if !fail(err) {
+						f.spill()
+						return
+					}
+					continue
+				}
+				_ = f.buffer.Remove(key)
+				backoff = f.minBackoff
+				continue
+			}
+		}
+
+		select {
+		case <-f.closing:
+			f.spill()
+			return
+
+		case payload := <-f.queue:
+			if err := f.send(payload); err != nil {
+				if f.buffer != nil {
+					if perr := f.buffer.Push(payload); perr != nil {
+						f.log.Errorf("forwarder [%s] buffer payload failed: %s", f.name, perr.Error())
+					}
+				} else {
+					f.log.Errorf("forwarder [%s] drop payload: %s", f.name, err.Error())
+				}
+				if !fail(err) {
+					f.spill()
+					return
+				}
+				continue
+			}
+			backoff = f.minBackoff
+		}
+	}
+}
+
+func (f *Forwarder) send(payload []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultSendTimeout)
+	defer cancel()
+	return f.sender.Send(ctx, payload)
+}
+
+// spill moves the queued payloads to the disk buffer.
+func (f *Forwarder) spill() {
+	for {
+		select {
+		case payload := <-f.queue:
+			if f.buffer == nil {
+				continue
+			}
+			if err := f.buffer.Push(payload); err != nil {
+				f.log.Errorf("forwarder [%s] buffer payload failed: %s", f.name, err.Error())
+			}
+		default:
+			return
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package siem
+
+import (
+	"sync"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Route selects the events a forwarder receives.
+type Route struct {
+	// TenantID restricts the route to one tenant; 0 receives events of all tenants.
+	TenantID uint32
+	// LogTypes restricts the route to some log types; empty receives all types.
+	LogTypes []string
+}
+
+// Match reports whether the event is routed.
+func (r Route) Match(ev *Event) bool {
+	if r.TenantID != 0 && r.TenantID != ev.TenantID {
+		return false
+	}
+	if len(r.LogTypes) == 0 {
+		return true
+	}
+	for _, t := range r.LogTypes {
+		if t == ev.Type {
+			return true
+		}
+	}
+	return false
+}
+
+// RouteSpec describes a routed forwarder for Router.Sync.
+type RouteSpec struct {
+	// Key identifies the forwarder across syncs.
+	Key string
+	// Fingerprint changes whenever the forwarder configuration changes.
+	Fingerprint string
+	Route       Route
+	// Build creates the forwarder; it is only called for new or changed specs.
+	Build func() (*Forwarder, error)
+}
+
+type routerEntry struct {
+	fingerprint string
+	route       Route
+	forwarder   *Forwarder
+}
+
+// Router dispatches events to the forwarders whose route matches.
+type Router struct {
+	syncMu  sync.Mutex
+	mu      sync.RWMutex
+	entries map[string]*routerEntry
+	log     *log.Helper
+}
+
+// NewRouter creates an empty router.
+func NewRouter(logger log.Logger) *Router {
+	return &Router{
+		entries: make(map[string]*routerEntry),
+		log:     log.NewHelper(log.With(logger, "module", "siem/router")),
+	}
+}
+
+// Sync replaces the routed forwarders with specs. Unchanged forwarders keep running,
+// changed ones are rebuilt and removed ones are closed.
+func (r *Router) Sync(specs []RouteSpec) {
+	r.syncMu.Lock()
+	defer r.syncMu.Unlock()
+
+	next := make(map[string]*routerEntry, len(specs))
+	var stale []*Forwarder
+
+	r.mu.RLock()
+	current := r.entries
+	r.mu.RUnlock()
+
+	for _, spec := range specs {
+		if old, ok := current[spec.Key]; ok && old.fingerprint == spec.Fingerprint {
+			next[spec.Key] = &routerEntry{fingerprint: spec.Fingerprint, route: spec.Route, forwarder: old.forwarder}
+			continue
+		}
+
+		fwd, err := spec.Build()
+		if err != nil {
+			r.log.Errorf("build forwarder [%s] failed: %s", spec.Key, err.Error())
+			continue
+		}
+		next[spec.Key] = &routerEntry{fingerprint: spec.Fingerprint, route: spec.Route, forwarder: fwd}
+	}
+
+	for key, old := range current {
+		if e, ok := next[key]; !ok || e.forwarder != old.forwarder {
+			stale = append(stale, old.forwarder)
+		}
+	}
+
+	r.mu.Lock()
+	r.entries = next
+	r.mu.Unlock()
+
+	// close after swapping, so no event is dispatched to a closed forwarder
+	for _, fwd := range stale {
+		if err := fwd.Close(); err != nil {
+			r.log.Warnf("close forwarder [%s] failed: %s", fwd.Name(), err.Error())
+		}
+	}
+}
+
+// Dispatch forwards the event to every matching forwarder and returns how many accepted it.
+func (r *Router) Dispatch(ev *Event) int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var n int
+	for key, e := range r.entries {
+		if !e.route.Match(ev) {
+			continue
+		}
+		if err := e.forwarder.Forward(ev); err != nil {
+			r.log.Warnf("forward event to [%s] failed: %s", key, err.Error())
+			continue
+		}
+		n++
+	}
+	return n
+}
+
+// Len returns the number of routed forwarders.
+func (r *Router) Len() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return len(r.entries)
+}
+
+// Close closes all forwarders.
+func (r *Router) Close() {
+	r.Sync(nil)
+}
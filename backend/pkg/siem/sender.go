@@ -0,0 +1,202 @@
+package siem
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Sender delivers formatted payloads to a SIEM endpoint.
+type Sender interface {
+	Send(ctx context.Context, payload []byte) error
+	Close() error
+}
+
+// Network is the transport of a syslog sender.
+type Network string
+
+const (
+	NetworkUDP Network = "udp"
+	NetworkTCP Network = "tcp"
+	NetworkTLS Network = "tls"
+)
+
+const defaultSendTimeout = 10 * time.Second
+
+// SyslogSender sends syslog messages over UDP (one datagram per message) or over
+// TCP/TLS using octet-counting framing (RFC 6587 / RFC 5425).
+type SyslogSender struct {
+	network   Network
+	addr      string
+	tlsConfig *tls.Config
+	timeout   time.Duration
+
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+// NewSyslogSender creates a syslog sender. tlsConfig is only used by NetworkTLS.
+func NewSyslogSender(network Network, addr string, tlsConfig *tls.Config, timeout time.Duration) (*SyslogSender, error) {
+	switch network {
+	case NetworkUDP, NetworkTCP, NetworkTLS:
+	default:
+		return nil, fmt.Errorf("unsupported syslog network: %s", network)
+	}
+	if addr == "" {
+		return nil, fmt.Errorf("syslog address is required")
+	}
+	if timeout <= 0 {
+		timeout = defaultSendTimeout
+	}
+
+	return &SyslogSender{
+		network:   network,
+		addr:      addr,
+		tlsConfig: tlsConfig,
+		timeout:   timeout,
+	}, nil
+}
+
+func (s *SyslogSender) dial(ctx context.Context) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: s.timeout}
+
+	switch s.network {
+	case NetworkTLS:
+		td := &tls.Dialer{NetDialer: dialer, Config: s.tlsConfig}
+		return td.DialContext(ctx, "tcp", s.addr)
+	default:
+		return dialer.DialContext(ctx, string(s.network), s.addr)
+	}
+}
+
+func (s *SyslogSender) Send(ctx context.Context, payload []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn == nil {
+		conn, err := s.dial(ctx)
+		if err != nil {
+			return err
+		}
+		s.conn = conn
+	}
+
+	frame := payload
+	if s.network != NetworkUDP {
+		frame = make([]byte, 0, len(payload)+8)
+		frame = strconv.AppendInt(frame, int64(len(payload)), 10)
+		frame = append(frame, ' ')
+		frame = append(frame, payload...)
+	}
+
+	deadline := time.Now().Add(s.timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	_ = s.conn.SetWriteDeadline(deadline)
+
+	if _, err := s.conn.Write(frame); err != nil {
+		// drop the connection, the next Send redials
+		_ = s.conn.Close()
+		s.conn = nil
+		return err
+	}
+	return nil
+}
+
+func (s *SyslogSender) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	return err
+}
+
+const (
+	// HeaderSignature carries the hex HMAC-SHA256 of "<timestamp>.<body>", prefixed with "sha256=".
+	HeaderSignature = "X-Signature"
+	// HeaderSignatureTimestamp carries the unix timestamp used in the signature.
+	HeaderSignatureTimestamp = "X-Signature-Timestamp"
+)
+
+// Sign computes the webhook signature of body.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte{'.'})
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// WebhookSender posts JSON payloads to an HTTP endpoint, signing them with HMAC-SHA256 when a secret is set.
+type WebhookSender struct {
+	url     string
+	secret  string
+	headers map[string]string
+	client  *http.Client
+}
+
+// NewWebhookSender creates a webhook sender. A nil client uses a client with the default timeout.
+func NewWebhookSender(url, secret string, headers map[string]string, client *http.Client) (*WebhookSender, error) {
+	if url == "" {
+		return nil, fmt.Errorf("webhook url is required")
+	}
+	if client == nil {
+		client = &http.Client{Timeout: defaultSendTimeout}
+	}
+
+	return &WebhookSender{
+		url:     url,
+		secret:  secret,
+		headers: headers,
+		client:  client,
+	}, nil
+}
+
+func (w *WebhookSender) Send(ctx context.Context, payload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range w.headers {
+		req.Header.Set(k, v)
+	}
+	if w.secret != "" {
+		ts := time.Now().Unix()
+		req.Header.Set(HeaderSignatureTimestamp, strconv.FormatInt(ts, 10))
+		req.Header.Set(HeaderSignature, Sign(w.secret, ts, payload))
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (w *WebhookSender) Close() error {
+	w.client.CloseIdleConnections()
+	return nil
+}
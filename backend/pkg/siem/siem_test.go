@@ -0,0 +1,387 @@
+package siem
+
+import (
+	"bufio"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tx7do/go-utils/trans"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	auditV1 "go-wind-admin/api/gen/go/audit/service/v1"
+)
+
+var testTime = time.Date(2024, 5, 1, 8, 30, 0, 0, time.UTC)
+
+func testEvent(t *testing.T) *Event {
+	ev, err := NewEvent("LOGIN_AUDIT_LOG", 7, testTime, &auditV1.LoginAuditLog{
+		Id:          trans.Ptr(uint32(42)),
+		TenantId:    trans.Ptr(uint32(7)),
+		Username:    trans.Ptr(`al"ice]`),
+		IpAddress:   trans.Ptr("10.0.0.1"),
+		GeoLocation: &auditV1.GeoLocation{CountryCode: trans.Ptr("CN")},
+		CreatedAt:   timestamppb.New(testTime),
+	})
+	require.NoError(t, err)
+
+	ev.SignatureID = "login"
+	ev.Name = "login failed"
+	ev.Severity = SeverityMedium
+	return ev
+}
+
+// readFrame reads one octet-counted syslog frame.
+func readFrame(r *bufio.Reader) (string, error) {
+	n, err := r.ReadString(' ')
+	if err != nil {
+		return "", err
+	}
+	size, err := strconv.Atoi(strings.TrimSpace(n))
+	if err != nil {
+		return "", err
+	}
+	buf := make([]byte, size)
+	if _, err = io.ReadFull(r, buf); err != nil {
+		return "", err
+	}
+	return string(buf), nil
+}
+
+func acceptFrames(t *testing.T, ln net.Listener, out chan<- string) {
+	t.Helper()
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func(conn net.Conn) {
+				defer conn.Close()
+				r := bufio.NewReader(conn)
+				for {
+					msg, err := readFrame(r)
+					if err != nil {
+						return
+					}
+					out <- msg
+				}
+			}(conn)
+		}
+	}()
+}
+
+func receive(t *testing.T, ch <-chan string) string {
+	t.Helper()
+	select {
+	case msg := <-ch:
+		return msg
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for message")
+		return ""
+	}
+}
+
+func TestEventProject(t *testing.T) {
+	ev := testEvent(t)
+
+	assert.Equal(t, "CN", ev.Fields["geoLocation.countryCode"])
+	assert.Equal(t, "42", ev.Fields["id"])
+
+	fields := ev.Project(Mapping{"src": "ipAddress", "suser": "username", "missing": "nope"})
+	assert.Equal(t, []Field{{Key: "src", Value: "10.0.0.1"}, {Key: "suser", Value: `al"ice]`}}, fields)
+}
+
+func TestRFC5424OverUDP(t *testing.T) {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer pc.Close()
+
+	sender, err := NewSyslogSender(NetworkUDP, pc.LocalAddr().String(), nil, time.Second)
+	require.NoError(t, err)
+
+	fwd := NewForwarder("udp", &RFC5424Formatter{
+		Hostname: "admin-1",
+		Facility: FacilityLocal0,
+		Mapping:  Mapping{"user": "username", "country": "geoLocation.countryCode"},
+	}, sender)
+	defer fwd.Close()
+
+	require.NoError(t, fwd.Forward(testEvent(t)))
+
+	_ = pc.SetReadDeadline(time.Now().Add(5 * time.Second))
+	buf := make([]byte, 4096)
+	n, _, err := pc.ReadFrom(buf)
+	require.NoError(t, err)
+
+	// local0 (16) * 8 + warning (4)
+	assert.Equal(t,
+		`<132>1 2024-05-01T08:30:00.000000Z admin-1 go-wind-admin - login [audit@32473 country="CN" user="al\"ice\]"] `+"\xEF\xBB\xBF"+`login failed`,
+		string(buf[:n]))
+}
+
+func TestCEFOverTCP(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+
+	received := make(chan string, 4)
+	acceptFrames(t, ln, received)
+
+	sender, err := NewSyslogSender(NetworkTCP, ln.Addr().String(), nil, time.Second)
+	require.NoError(t, err)
+
+	fwd := NewForwarder("tcp", &CEFFormatter{
+		Vendor:  "Wind|Corp",
+		Product: "Admin",
+		Version: "2.0",
+		Mapping: Mapping{"suser": "username", "src": "ipAddress"},
+		Syslog:  &RFC5424Formatter{Hostname: "admin-1", Facility: FacilityLocal0},
+	}, sender)
+	defer fwd.Close()
+
+	ev := testEvent(t)
+	ev.Fields["username"] = "a=b\\c\nd"
+	require.NoError(t, fwd.Forward(ev))
+
+	assert.Equal(t,
+		`<132>1 2024-05-01T08:30:00.000000Z admin-1 go-wind-admin - login - `+
+			`CEF:0|Wind\|Corp|Admin|2.0|login|login failed|5|rt=1714552200000 src=10.0.0.1 suser=a\=b\\c\nd`,
+		receive(t, received))
+}
+
+func TestSyslogOverTLS(t *testing.T) {
+	cert := selfSignedCert(t)
+
+	ln, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{Certificates: []tls.Certificate{cert}})
+	require.NoError(t, err)
+	defer ln.Close()
+
+	received := make(chan string, 4)
+	acceptFrames(t, ln, received)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(cert.Leaf)
+
+	sender, err := NewSyslogSender(NetworkTLS, ln.Addr().String(), &tls.Config{RootCAs: pool, ServerName: "localhost"}, time.Second)
+	require.NoError(t, err)
+
+	fwd := NewForwarder("tls", &RFC5424Formatter{Mapping: Mapping{"ip": "ipAddress"}}, sender)
+	defer fwd.Close()
+
+	require.NoError(t, fwd.Forward(testEvent(t)))
+	msg := receive(t, received)
+	assert.True(t, strings.HasPrefix(msg, "<4>1 2024-05-01T08:30:00.000000Z - go-wind-admin - login "), msg)
+	assert.Contains(t, msg, `[audit@32473 ip="10.0.0.1"]`)
+}
+
+func TestWebhookHMAC(t *testing.T) {
+	const secret = "s3cr3t"
+	received := make(chan map[string]any, 1)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		ts, _ := strconv.ParseInt(r.Header.Get(HeaderSignatureTimestamp), 10, 64)
+		if r.Header.Get(HeaderSignature) != Sign(secret, ts, body) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var doc map[string]any
+		_ = json.Unmarshal(body, &doc)
+		doc["tenantHeader"] = r.Header.Get("X-Tenant")
+		received <- doc
+	}))
+	defer srv.Close()
+
+	sender, err := NewWebhookSender(srv.URL, secret, map[string]string{"X-Tenant": "7"}, nil)
+	require.NoError(t, err)
+
+	fwd := NewForwarder("webhook", &JSONFormatter{Mapping: Mapping{"user": "username"}}, sender)
+	defer fwd.Close()
+
+	require.NoError(t, fwd.Forward(testEvent(t)))
+
+	select {
+	case doc := <-received:
+		assert.Equal(t, "LOGIN_AUDIT_LOG", doc["event_type"])
+		assert.Equal(t, float64(7), doc["tenant_id"])
+		assert.Equal(t, "2024-05-01T08:30:00Z", doc["timestamp"])
+		assert.Equal(t, map[string]any{"user": `al"ice]`}, doc["data"])
+		assert.Equal(t, "7", doc["tenantHeader"])
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for webhook")
+	}
+
+	bad, err := NewWebhookSender(srv.URL, "wrong", nil, nil)
+	require.NoError(t, err)
+	payload, _ := (&JSONFormatter{}).Format(testEvent(t))
+	assert.Error(t, bad.Send(t.Context(), payload))
+}
+
+func TestRetryFromDiskBuffer(t *testing.T) {
+	// reserve a port, then leave it closed so the first delivery fails
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := ln.Addr().String()
+	require.NoError(t, ln.Close())
+
+	buffer, err := NewDiskBuffer(t.TempDir(), 1<<20)
+	require.NoError(t, err)
+
+	sender, err := NewSyslogSender(NetworkTCP, addr, nil, time.Second)
+	require.NoError(t, err)
+
+	fwd := NewForwarder("retry", &RFC5424Formatter{}, sender,
+		WithBuffer(buffer),
+		WithBackoff(20*time.Millisecond, 50*time.Millisecond),
+	)
+	defer fwd.Close()
+
+	ev := testEvent(t)
+	for i := 0; i < 3; i++ {
+		ev.SignatureID = "msg" + strconv.Itoa(i)
+		require.NoError(t, fwd.Forward(ev))
+	}
+
+	assert.Eventually(t, func() bool { return buffer.Len() == 3 }, 5*time.Second, 10*time.Millisecond)
+
+	ln, err = net.Listen("tcp", addr)
+	require.NoError(t, err)
+	defer ln.Close()
+
+	received := make(chan string, 4)
+	acceptFrames(t, ln, received)
+
+	for i := 0; i < 3; i++ {
+		assert.Contains(t, receive(t, received), " msg"+strconv.Itoa(i)+" ")
+	}
+	assert.Eventually(t, func() bool { return buffer.Len() == 0 }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestDiskBuffer(t *testing.T) {
+	dir := t.TempDir()
+
+	b, err := NewDiskBuffer(dir, 10)
+	require.NoError(t, err)
+
+	require.NoError(t, b.Push([]byte("aaaa")))
+	require.NoError(t, b.Push([]byte("bbbb")))
+	// evicts "aaaa"
+	require.NoError(t, b.Push([]byte("cccc")))
+	assert.Equal(t, 2, b.Len())
+	assert.Equal(t, uint64(1), b.Dropped())
+	assert.ErrorIs(t, b.Push([]byte("too large payload")), ErrPayloadTooLarge)
+
+	// entries survive a reopen
+	b, err = NewDiskBuffer(dir, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(8), b.Size())
+
+	key, payload, ok, err := b.Peek()
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "bbbb", string(payload))
+	require.NoError(t, b.Remove(key))
+
+	require.NoError(t, b.Push([]byte("dddd")))
+	_, payload, _, _ = b.Peek()
+	assert.Equal(t, "cccc", string(payload))
+}
+
+type recordSender struct {
+	ch chan []byte
+}
+
+func (s *recordSender) Send(_ context.Context, payload []byte) error {
+	s.ch <- payload
+	return nil
+}
+
+func (s *recordSender) Close() error { return nil }
+
+func TestRouter(t *testing.T) {
+	router := NewRouter(log.DefaultLogger)
+	defer router.Close()
+
+	platform := &recordSender{ch: make(chan []byte, 8)}
+	tenant := &recordSender{ch: make(chan []byte, 8)}
+	builds := 0
+
+	specs := []RouteSpec{
+		{
+			Key: "platform", Fingerprint: "v1",
+			Route: Route{LogTypes: []string{"LOGIN_AUDIT_LOG"}},
+			Build: func() (*Forwarder, error) {
+				builds++
+				return NewForwarder("platform", &JSONFormatter{}, platform), nil
+			},
+		},
+		{
+			Key: "tenant-8", Fingerprint: "v1",
+			Route: Route{TenantID: 8},
+			Build: func() (*Forwarder, error) {
+				builds++
+				return NewForwarder("tenant-8", &JSONFormatter{}, tenant), nil
+			},
+		},
+	}
+	router.Sync(specs)
+	router.Sync(specs)
+	assert.Equal(t, 2, builds)
+	assert.Equal(t, 2, router.Len())
+
+	ev := testEvent(t) // tenant 7, login
+	assert.Equal(t, 1, router.Dispatch(ev))
+
+	ev.TenantID = 8
+	ev.Type = "API_AUDIT_LOG"
+	assert.Equal(t, 1, router.Dispatch(ev))
+
+	ev.Type = "LOGIN_AUDIT_LOG"
+	assert.Equal(t, 2, router.Dispatch(ev))
+
+	router.Sync(specs[:1])
+	assert.Equal(t, 1, router.Len())
+}
+
+func selfSignedCert(t *testing.T) tls.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	leaf, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}
+}
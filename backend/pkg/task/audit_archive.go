@@ -0,0 +1,11 @@
+package task
+
+const (
+	AuditArchiveTaskType = "audit_archive"
+)
+
+// AuditArchiveTaskData 审计日志归档任务参数，字段为空时处理全部日志类型/租户
+type AuditArchiveTaskData struct {
+	LogType  string  `json:"log_type,omitempty"`
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+}
@@ -0,0 +1,21 @@
+package task
+
+const (
+	AuditExportTaskType = "audit_export"
+)
+
+// AuditExportTaskData 审计日志导出任务参数
+type AuditExportTaskData struct {
+	ExportID   string `json:"export_id"`
+	LogType    string `json:"log_type"`
+	Format     string `json:"format"`
+	Paging     string `json:"paging,omitempty"` // protojson 编码的 PagingRequest
+	ObjectName string `json:"object_name"`
+
+	// 发起人信息，用于在后台任务中还原数据权限并发送通知
+	TenantID  uint32 `json:"tenant_id"`
+	UserID    uint32 `json:"user_id"`
+	Username  string `json:"username,omitempty"`
+	OrgUnitID uint32 `json:"org_unit_id,omitempty"`
+	DataScope int32  `json:"data_scope,omitempty"`
+}
@@ -3,11 +3,31 @@ package task
 import "fmt"
 
 const (
-	BackupTaskType = "backup"
+	BackupTaskType  = "backup"
+	RestoreTaskType = "restore"
+
+	// DefaultBackupKeep 默认保留的备份份数
+	DefaultBackupKeep = 7
 )
 
+// BackupTaskData 数据库备份任务参数
 type BackupTaskData struct {
 	Name string `json:"name"`
+
+	// TenantID 只备份指定租户的数据，为空时备份全部数据
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// Keep 保留最近的备份份数，为0时使用默认值
+	Keep uint32 `json:"keep,omitempty"`
+	// RetentionDays 超过该天数的备份会被删除，为0时不按时间清理
+	RetentionDays uint32 `json:"retention_days,omitempty"`
+}
+
+// RestoreTaskData 数据库恢复任务参数
+type RestoreTaskData struct {
+	// Manifest 备份清单的对象名
+	Manifest string `json:"manifest"`
+	// ReplaceExisting 为true时先清空备份中包含的表，否则要求这些表为空
+	ReplaceExisting bool `json:"replace_existing,omitempty"`
 }
 
 // CreateBackupTaskID creates a unique task ID for a backup task based on the lottery details.
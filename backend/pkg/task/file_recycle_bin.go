@@ -0,0 +1,11 @@
+package task
+
+const FileRecycleBinPurgeTaskType = "file_recycle_bin_purge"
+
+// FileRecycleBinPurgeTaskData 回收站清理任务参数，超过保留期的文件连同全部版本永久删除
+type FileRecycleBinPurgeTaskData struct {
+	// TenantID 只清理该租户的回收站，为空时清理全部租户
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// RetentionDays 覆盖租户设置的保留天数，为0时使用租户设置或平台默认值
+	RetentionDays uint32 `json:"retention_days,omitempty"`
+}
@@ -0,0 +1,9 @@
+package task
+
+const FileScanTaskType = "file_scan"
+
+// FileScanTaskData 恶意文件扫描任务参数
+type FileScanTaskData struct {
+	// FileID 待扫描的文件记录ID，扫描结果应用到指向同一对象的全部文件记录
+	FileID uint32 `json:"file_id"`
+}
@@ -0,0 +1,520 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/redis/go-redis/v9"
+	"github.com/robfig/cron/v3"
+)
+
+// MisfirePolicy 错过触发时间（停机、选主切换等）后的补偿策略
+type MisfirePolicy string
+
+const (
+	// MisfireFireOnce 错过的多次触发只补执行一次
+	MisfireFireOnce MisfirePolicy = "FIRE_ONCE"
+	// MisfireSkip 丢弃错过的触发，等待下一次触发时间
+	MisfireSkip MisfirePolicy = "SKIP"
+	// MisfireCatchUp 逐次补执行错过的触发，最多 maxCatchUp 次
+	MisfireCatchUp MisfirePolicy = "CATCH_UP"
+)
+
+const (
+	defaultPeriodicKeyPrefix        = "task:scheduler"
+	defaultPeriodicLockTTL          = 15 * time.Second
+	defaultPeriodicTickInterval     = time.Second
+	defaultPeriodicMisfireThreshold = time.Minute
+	defaultPeriodicMaxCatchUp       = 100
+
+	// MaxNextRunTimes 预览下次执行时间的最大数量
+	MaxNextRunTimes = 100
+)
+
+var cronParser = cron.NewParser(
+	cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor,
+)
+
+// 仅当锁仍由本实例持有时才续期
+var renewLeaderScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// 仅当锁仍由本实例持有时才释放
+var releaseLeaderScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// Enqueuer 任务入队接口
+type Enqueuer interface {
+	NewTask(typeName string, msg any, opts ...asynq.Option) error
+}
+
+// PeriodicEntry 周期任务条目
+type PeriodicEntry struct {
+	ID            string
+	CronSpec      string
+	Timezone      string
+	MisfirePolicy MisfirePolicy
+	TypeName      string
+	Payload       any
+	Options       []asynq.Option
+}
+
+type periodicEntry struct {
+	PeriodicEntry
+	schedule cron.Schedule
+}
+
+// PeriodicOption 周期任务调度器选项
+type PeriodicOption func(*PeriodicScheduler)
+
+// WithPeriodicKeyPrefix 设置 Redis 键前缀
+func WithPeriodicKeyPrefix(prefix string) PeriodicOption {
+	return func(s *PeriodicScheduler) { s.keyPrefix = prefix }
+}
+
+// WithPeriodicLockTTL 设置主节点锁的过期时间
+func WithPeriodicLockTTL(ttl time.Duration) PeriodicOption {
+	return func(s *PeriodicScheduler) { s.lockTTL = ttl }
+}
+
+// WithPeriodicTickInterval 设置调度检查间隔
+func WithPeriodicTickInterval(interval time.Duration) PeriodicOption {
+	return func(s *PeriodicScheduler) { s.tickInterval = interval }
+}
+
+// WithPeriodicMisfireThreshold 设置判定为错过触发的延迟阈值
+func WithPeriodicMisfireThreshold(threshold time.Duration) PeriodicOption {
+	return func(s *PeriodicScheduler) { s.misfireThreshold = threshold }
+}
+
+// WithPeriodicInstanceID 设置实例标识，默认为 主机名-进程号-随机串
+func WithPeriodicInstanceID(id string) PeriodicOption {
+	return func(s *PeriodicScheduler) { s.instanceID = id }
+}
+
+// PeriodicScheduler 集群安全的周期任务调度器。
+//
+// 每个实例都注册相同的周期任务，但只有通过 Redis 锁选出的主节点负责入队；
+// 上次触发时间保存在 Redis 中，主节点切换或全部停机后按条目的补偿策略处理错过的触发。
+// 每次触发使用确定的任务ID入队，即便切换瞬间出现两个主节点也不会重复执行。
+// rdb 为空时以单机模式运行，本实例始终为主节点，上次触发时间仅保存在内存中。
+type PeriodicScheduler struct {
+	log *log.Helper
+	rdb redis.UniversalClient
+
+	keyPrefix        string
+	instanceID       string
+	lockTTL          time.Duration
+	tickInterval     time.Duration
+	misfireThreshold time.Duration
+	maxCatchUp       int
+
+	mu        sync.RWMutex
+	entries   map[string]*periodicEntry
+	enqueuer  Enqueuer
+	lastFires map[string]time.Time
+
+	leader atomic.Bool
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+	once sync.Once
+}
+
+// NewPeriodicScheduler 创建周期任务调度器
+func NewPeriodicScheduler(rdb redis.UniversalClient, logger log.Logger, opts ...PeriodicOption) *PeriodicScheduler {
+	s := &PeriodicScheduler{
+		log:              log.NewHelper(log.With(logger, "module", "task/periodic")),
+		rdb:              rdb,
+		keyPrefix:        defaultPeriodicKeyPrefix,
+		lockTTL:          defaultPeriodicLockTTL,
+		tickInterval:     defaultPeriodicTickInterval,
+		misfireThreshold: defaultPeriodicMisfireThreshold,
+		maxCatchUp:       defaultPeriodicMaxCatchUp,
+		entries:          make(map[string]*periodicEntry),
+		lastFires:        make(map[string]time.Time),
+		stop:             make(chan struct{}),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	if s.instanceID == "" {
+		hostname, _ := os.Hostname()
+		s.instanceID = fmt.Sprintf("%s-%d-%s", hostname, os.Getpid(), uuid.NewString()[:8])
+	}
+
+	return s
+}
+
+// SetEnqueuer 设置任务入队器，未设置前调度器不会触发任何任务
+func (s *PeriodicScheduler) SetEnqueuer(enqueuer Enqueuer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.enqueuer = enqueuer
+}
+
+// Start 启动选主与调度循环
+func (s *PeriodicScheduler) Start() {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		ticker := time.NewTicker(s.tickInterval)
+		defer ticker.Stop()
+
+		for {
+			s.runOnce(context.Background(), time.Now())
+
+			select {
+			case <-s.stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop 停止调度循环并释放主节点锁
+func (s *PeriodicScheduler) Stop() {
+	s.once.Do(func() {
+		close(s.stop)
+		s.wg.Wait()
+
+		if s.rdb != nil && s.leader.Load() {
+			ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+			defer cancel()
+			if err := releaseLeaderScript.Run(ctx, s.rdb, []string{s.leaderKey()}, s.instanceID).Err(); err != nil {
+				s.log.Warnf("release scheduler leadership failed: %s", err.Error())
+			}
+		}
+		s.leader.Store(false)
+	})
+}
+
+// IsLeader 本实例当前是否为主节点
+func (s *PeriodicScheduler) IsLeader() bool {
+	return s.leader.Load()
+}
+
+// Add 添加或替换周期任务条目，cron 表达式变化时重新开始计算触发时间
+func (s *PeriodicScheduler) Add(entry PeriodicEntry) error {
+	if entry.ID == "" {
+		return errors.New("periodic entry id is empty")
+	}
+	if entry.TypeName == "" {
+		return errors.New("periodic entry type name is empty")
+	}
+	if entry.MisfirePolicy == "" {
+		entry.MisfirePolicy = MisfireFireOnce
+	}
+
+	schedule, err := ParseCronSpec(entry.CronSpec, entry.Timezone)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	old, exists := s.entries[entry.ID]
+	s.entries[entry.ID] = &periodicEntry{PeriodicEntry: entry, schedule: schedule}
+	specChanged := exists && (old.CronSpec != entry.CronSpec || old.Timezone != entry.Timezone)
+	if specChanged {
+		delete(s.lastFires, entry.ID)
+	}
+	s.mu.Unlock()
+
+	if specChanged && s.rdb != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		defer cancel()
+		if err = s.rdb.HDel(ctx, s.lastFireKey(), entry.ID).Err(); err != nil {
+			s.log.Warnf("[%s] reset last fire time failed: %s", entry.ID, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// Remove 移除周期任务条目
+func (s *PeriodicScheduler) Remove(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.entries[id]; !ok {
+		return fmt.Errorf("periodic entry [%s] not found", id)
+	}
+	delete(s.entries, id)
+
+	return nil
+}
+
+// RemoveAll 移除所有周期任务条目
+func (s *PeriodicScheduler) RemoveAll() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries = make(map[string]*periodicEntry)
+}
+
+// Entries 返回已注册的条目，按ID排序
+func (s *PeriodicScheduler) Entries() []PeriodicEntry {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	entries := make([]PeriodicEntry, 0, len(s.entries))
+	for _, e := range s.entries {
+		entries = append(entries, e.PeriodicEntry)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].ID < entries[j].ID })
+
+	return entries
+}
+
+// runOnce 执行一次选主并在成为主节点时触发到期的条目
+func (s *PeriodicScheduler) runOnce(ctx context.Context, now time.Time) {
+	if !s.campaign(ctx) {
+		return
+	}
+
+	s.mu.RLock()
+	enqueuer := s.enqueuer
+	entries := make([]*periodicEntry, 0, len(s.entries))
+	for _, e := range s.entries {
+		entries = append(entries, e)
+	}
+	s.mu.RUnlock()
+
+	if enqueuer == nil || len(entries) == 0 {
+		return
+	}
+
+	for _, e := range entries {
+		s.fire(ctx, enqueuer, e, now)
+	}
+}
+
+// campaign 竞选或续期主节点，返回本实例是否为主节点
+func (s *PeriodicScheduler) campaign(ctx context.Context) bool {
+	if s.rdb == nil {
+		s.leader.Store(true)
+		return true
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.lockTTL/3)
+	defer cancel()
+
+	key := s.leaderKey()
+
+	if s.leader.Load() {
+		renewed, err := renewLeaderScript.Run(ctx, s.rdb, []string{key}, s.instanceID, s.lockTTL.Milliseconds()).Int64()
+		if err == nil && renewed == 1 {
+			return true
+		}
+		if err != nil {
+			s.log.Warnf("renew scheduler leadership failed: %s", err.Error())
+		}
+		s.leader.Store(false)
+		s.log.Infof("scheduler leadership lost [%s]", s.instanceID)
+	}
+
+	acquired, err := s.rdb.SetNX(ctx, key, s.instanceID, s.lockTTL).Result()
+	if err != nil {
+		s.log.Warnf("acquire scheduler leadership failed: %s", err.Error())
+		return false
+	}
+	if acquired {
+		s.leader.Store(true)
+		s.log.Infof("scheduler leadership acquired [%s]", s.instanceID)
+	}
+
+	return acquired
+}
+
+// fire 计算并入队条目到期的触发
+func (s *PeriodicScheduler) fire(ctx context.Context, enqueuer Enqueuer, e *periodicEntry, now time.Time) {
+	last, ok, err := s.loadLastFire(ctx, e.ID)
+	if err != nil {
+		s.log.Warnf("[%s] load last fire time failed: %s", e.ID, err.Error())
+		return
+	}
+	if !ok {
+		// 首次调度从当前时间开始计算，不追溯注册之前的触发
+		_ = s.saveLastFire(ctx, e.ID, now)
+		return
+	}
+
+	due, latest := dueRuns(e.schedule, last, now, e.MisfirePolicy, s.misfireThreshold, s.maxCatchUp)
+	if latest.IsZero() {
+		return
+	}
+
+	if missed := countRuns(e.schedule, last, now, s.maxCatchUp+1) - len(due); missed > 0 {
+		s.log.Infof("[%s] %d misfired run(s) handled by policy %s", e.ID, missed, e.MisfirePolicy)
+	}
+
+	for _, at := range due {
+		opts := append([]asynq.Option{asynq.TaskID(PeriodicTaskID(e.ID, at))}, e.Options...)
+		if err = enqueuer.NewTask(e.TypeName, e.Payload, opts...); err != nil && !errors.Is(err, asynq.ErrTaskIDConflict) {
+			// 入队失败时保留上次触发时间，下一轮重试
+			s.log.Errorf("[%s] enqueue periodic task failed: %s", e.ID, err.Error())
+			return
+		}
+		if err = s.saveLastFire(ctx, e.ID, at); err != nil {
+			s.log.Warnf("[%s] save last fire time failed: %s", e.ID, err.Error())
+			return
+		}
+	}
+
+	if len(due) == 0 {
+		_ = s.saveLastFire(ctx, e.ID, latest)
+	}
+}
+
+func (s *PeriodicScheduler) loadLastFire(ctx context.Context, id string) (time.Time, bool, error) {
+	if s.rdb == nil {
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+		t, ok := s.lastFires[id]
+		return t, ok, nil
+	}
+
+	val, err := s.rdb.HGet(ctx, s.lastFireKey(), id).Result()
+	if errors.Is(err, redis.Nil) {
+		return time.Time{}, false, nil
+	}
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	ms, err := strconv.ParseInt(val, 10, 64)
+	if err != nil {
+		return time.Time{}, false, nil
+	}
+
+	return time.UnixMilli(ms), true, nil
+}
+
+func (s *PeriodicScheduler) saveLastFire(ctx context.Context, id string, t time.Time) error {
+	if s.rdb == nil {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if t.After(s.lastFires[id]) {
+			s.lastFires[id] = t
+		}
+		return nil
+	}
+
+	return s.rdb.HSet(ctx, s.lastFireKey(), id, t.UnixMilli()).Err()
+}
+
+func (s *PeriodicScheduler) leaderKey() string {
+	return s.keyPrefix + ":leader"
+}
+
+func (s *PeriodicScheduler) lastFireKey() string {
+	return s.keyPrefix + ":last_fire"
+}
+
+// PeriodicTaskID 周期任务某次触发的任务ID
+func PeriodicTaskID(entryID string, at time.Time) string {
+	return fmt.Sprintf("periodic:%s:%d", entryID, at.Unix())
+}
+
+// dueRuns 按补偿策略计算 (last, now] 区间内需要入队的触发时间，latest 为区间内最后一次触发时间
+func dueRuns(schedule cron.Schedule, last, now time.Time, policy MisfirePolicy, threshold time.Duration, maxCatchUp int) (due []time.Time, latest time.Time) {
+	var runs []time.Time
+	for t := schedule.Next(last); !t.IsZero() && !t.After(now); t = schedule.Next(t) {
+		runs = append(runs, t)
+		latest = t
+		if len(runs) > maxCatchUp {
+			// 只保留最近的 maxCatchUp 次
+			runs = runs[1:]
+		}
+	}
+	if len(runs) == 0 {
+		return nil, latest
+	}
+
+	switch policy {
+	case MisfireCatchUp:
+		return runs, latest
+
+	case MisfireSkip:
+		if now.Sub(latest) <= threshold {
+			return []time.Time{latest}, latest
+		}
+		return nil, latest
+
+	default:
+		return []time.Time{latest}, latest
+	}
+}
+
+// countRuns 统计 (last, now] 区间内的触发次数，最多统计到 limit
+func countRuns(schedule cron.Schedule, last, now time.Time, limit int) int {
+	count := 0
+	for t := schedule.Next(last); !t.IsZero() && !t.After(now) && count < limit; t = schedule.Next(t) {
+		count++
+	}
+	return count
+}
+
+// ParseCronSpec 解析 cron 表达式，timezone 为 IANA 时区名称，为空时使用服务器本地时区
+func ParseCronSpec(spec, timezone string) (cron.Schedule, error) {
+	if spec == "" {
+		return nil, errors.New("cron spec is empty")
+	}
+
+	if timezone != "" {
+		if _, err := time.LoadLocation(timezone); err != nil {
+			return nil, fmt.Errorf("invalid timezone %q: %w", timezone, err)
+		}
+		spec = "CRON_TZ=" + timezone + " " + spec
+	}
+
+	schedule, err := cronParser.Parse(spec)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron spec: %w", err)
+	}
+
+	return schedule, nil
+}
+
+// NextRunTimes 计算 from 之后的 n 次触发时间
+func NextRunTimes(spec, timezone string, from time.Time, n int) ([]time.Time, error) {
+	schedule, err := ParseCronSpec(spec, timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	if n <= 0 {
+		n = 1
+	}
+	if n > MaxNextRunTimes {
+		n = MaxNextRunTimes
+	}
+
+	times := make([]time.Time, 0, n)
+	for t := schedule.Next(from); !t.IsZero() && len(times) < n; t = schedule.Next(t) {
+		times = append(times, t)
+	}
+
+	return times, nil
+}
@@ -0,0 +1,193 @@
+package task
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/hibiken/asynq"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeEnqueuer 模拟 asynq 按任务ID去重的入队行为
+type fakeEnqueuer struct {
+	mu      sync.Mutex
+	taskIDs []string
+	seen    map[string]bool
+}
+
+func (e *fakeEnqueuer) NewTask(_ string, _ any, opts ...asynq.Option) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.seen == nil {
+		e.seen = make(map[string]bool)
+	}
+
+	var id string
+	for _, opt := range opts {
+		if opt.Type() == asynq.TaskIDOpt {
+			id = opt.Value().(string)
+		}
+	}
+	if e.seen[id] {
+		return asynq.ErrTaskIDConflict
+	}
+	e.seen[id] = true
+	e.taskIDs = append(e.taskIDs, id)
+
+	return nil
+}
+
+func newTestPeriodicScheduler(t *testing.T, rdb redis.UniversalClient, id string) (*PeriodicScheduler, *fakeEnqueuer) {
+	t.Helper()
+
+	s := NewPeriodicScheduler(rdb, log.DefaultLogger, WithPeriodicInstanceID(id))
+	enqueuer := &fakeEnqueuer{}
+	s.SetEnqueuer(enqueuer)
+
+	return s, enqueuer
+}
+
+func TestPeriodicScheduler_LeaderOnly(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer rdb.Close()
+
+	a, enqA := newTestPeriodicScheduler(t, rdb, "a")
+	b, enqB := newTestPeriodicScheduler(t, rdb, "b")
+
+	entry := PeriodicEntry{ID: "task:1", CronSpec: "* * * * *", TypeName: "report"}
+	require.NoError(t, a.Add(entry))
+	require.NoError(t, b.Add(entry))
+
+	ctx := context.Background()
+	start := time.Date(2026, 1, 1, 10, 0, 30, 0, time.UTC)
+
+	a.runOnce(ctx, start)
+	b.runOnce(ctx, start)
+	assert.True(t, a.IsLeader())
+	assert.False(t, b.IsLeader())
+
+	for i := 1; i <= 3; i++ {
+		now := start.Add(time.Duration(i) * time.Minute)
+		a.runOnce(ctx, now)
+		b.runOnce(ctx, now)
+	}
+	assert.Len(t, enqA.taskIDs, 3)
+	assert.Empty(t, enqB.taskIDs)
+
+	// 主节点停止后由其他实例接管，并从共享的上次触发时间继续
+	a.Stop()
+	b.runOnce(ctx, start.Add(4*time.Minute))
+	assert.True(t, b.IsLeader())
+	assert.Equal(t, []string{PeriodicTaskID("task:1", time.Date(2026, 1, 1, 10, 4, 0, 0, time.UTC))}, enqB.taskIDs)
+}
+
+func TestPeriodicScheduler_LeaderFailover(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer rdb.Close()
+
+	a, _ := newTestPeriodicScheduler(t, rdb, "a")
+	b, _ := newTestPeriodicScheduler(t, rdb, "b")
+
+	ctx := context.Background()
+	assert.True(t, a.campaign(ctx))
+	assert.False(t, b.campaign(ctx))
+
+	// 主节点失联，锁过期
+	mr.FastForward(defaultPeriodicLockTTL + time.Second)
+	assert.True(t, b.campaign(ctx))
+	assert.False(t, a.campaign(ctx))
+	assert.False(t, a.IsLeader())
+}
+
+func TestPeriodicScheduler_MisfirePolicies(t *testing.T) {
+	last := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	now := last.Add(5*time.Minute + 10*time.Second)
+
+	schedule, err := ParseCronSpec("* * * * *", "")
+	require.NoError(t, err)
+
+	due, latest := dueRuns(schedule, last, now, MisfireFireOnce, time.Minute, 100)
+	assert.Equal(t, []time.Time{last.Add(5 * time.Minute)}, due)
+	assert.Equal(t, last.Add(5*time.Minute), latest)
+
+	due, _ = dueRuns(schedule, last, now, MisfireCatchUp, time.Minute, 100)
+	assert.Len(t, due, 5)
+
+	due, _ = dueRuns(schedule, last, now, MisfireCatchUp, time.Minute, 3)
+	assert.Equal(t, []time.Time{last.Add(3 * time.Minute), last.Add(4 * time.Minute), last.Add(5 * time.Minute)}, due)
+
+	// 最近一次触发仍在阈值内，按时执行
+	due, _ = dueRuns(schedule, last, now, MisfireSkip, time.Minute, 100)
+	assert.Equal(t, []time.Time{last.Add(5 * time.Minute)}, due)
+
+	// 超过阈值则全部跳过
+	due, latest = dueRuns(schedule, last, now.Add(45*time.Second), MisfireSkip, 30*time.Second, 100)
+	assert.Empty(t, due)
+	assert.Equal(t, last.Add(5*time.Minute), latest)
+
+	due, latest = dueRuns(schedule, last, last.Add(30*time.Second), MisfireFireOnce, time.Minute, 100)
+	assert.Empty(t, due)
+	assert.True(t, latest.IsZero())
+}
+
+func TestPeriodicScheduler_CatchUpAfterDowntime(t *testing.T) {
+	s, enqueuer := newTestPeriodicScheduler(t, nil, "a")
+	require.NoError(t, s.Add(PeriodicEntry{ID: "task:1", CronSpec: "0 * * * *", TypeName: "report", MisfirePolicy: MisfireCatchUp}))
+
+	ctx := context.Background()
+	start := time.Date(2026, 1, 1, 10, 30, 0, 0, time.UTC)
+	s.runOnce(ctx, start)
+	assert.Empty(t, enqueuer.taskIDs)
+
+	s.runOnce(ctx, start.Add(3*time.Hour))
+	assert.Len(t, enqueuer.taskIDs, 3)
+
+	// 重复检查不会再次入队
+	s.runOnce(ctx, start.Add(3*time.Hour))
+	assert.Len(t, enqueuer.taskIDs, 3)
+}
+
+func TestPeriodicScheduler_SpecChangeResetsLastFire(t *testing.T) {
+	s, enqueuer := newTestPeriodicScheduler(t, nil, "a")
+	require.NoError(t, s.Add(PeriodicEntry{ID: "task:1", CronSpec: "0 * * * *", TypeName: "report", MisfirePolicy: MisfireCatchUp}))
+
+	ctx := context.Background()
+	start := time.Date(2026, 1, 1, 10, 30, 0, 0, time.UTC)
+	s.runOnce(ctx, start)
+
+	require.NoError(t, s.Add(PeriodicEntry{ID: "task:1", CronSpec: "*/5 * * * *", TypeName: "report", MisfirePolicy: MisfireCatchUp}))
+	s.runOnce(ctx, start.Add(3*time.Hour))
+	assert.Empty(t, enqueuer.taskIDs)
+
+	require.NoError(t, s.Remove("task:1"))
+	assert.Error(t, s.Remove("task:1"))
+}
+
+func TestNextRunTimes(t *testing.T) {
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	times, err := NextRunTimes("0 9 * * *", "Asia/Shanghai", from, 2)
+	require.NoError(t, err)
+	require.Len(t, times, 2)
+	assert.Equal(t, time.Date(2026, 3, 1, 1, 0, 0, 0, time.UTC), times[0].UTC())
+	assert.Equal(t, time.Date(2026, 3, 2, 1, 0, 0, 0, time.UTC), times[1].UTC())
+
+	times, err = NextRunTimes("@daily", "", from, 1000)
+	require.NoError(t, err)
+	assert.Len(t, times, MaxNextRunTimes)
+
+	_, err = NextRunTimes("0 9 * * *", "Mars/Olympus", from, 1)
+	assert.Error(t, err)
+
+	_, err = NextRunTimes("61 * * * *", "", from, 1)
+	assert.Error(t, err)
+}
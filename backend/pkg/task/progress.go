@@ -0,0 +1,274 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/hibiken/asynq"
+)
+
+const (
+	// progressFlushInterval 两次上报之间的最小间隔，期间的更新会合并
+	progressFlushInterval = 500 * time.Millisecond
+
+	// maxProgressLogLines 进度快照中保留的最近日志行数
+	maxProgressLogLines = 50
+)
+
+// Progress 任务执行进度，既作为实时推送的事件，也作为持久化的最新快照。
+// Logs 为最近的日志行，LogSeq 为截至目前的日志总行数，
+// 客户端可据此计算每行的序号（LogSeq-len(Logs)+i）并丢弃已收到的行。
+type Progress struct {
+	RunID     uint32    `json:"run_id,omitempty"`
+	TaskID    string    `json:"task_id"`
+	TaskType  string    `json:"task_type"`
+	Attempt   uint32    `json:"attempt,omitempty"`
+	Status    RunStatus `json:"status"`
+	Percent   float64   `json:"percent"`
+	Stage     string    `json:"stage,omitempty"`
+	Message   string    `json:"message,omitempty"`
+	Logs      []string  `json:"logs,omitempty"`
+	LogSeq    uint64    `json:"log_seq"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ProgressSink 持久化并分发任务进度
+type ProgressSink interface {
+	ReportProgress(ctx context.Context, progress *Progress) error
+}
+
+type progressReporterKey struct{}
+
+// ProgressReporter 任务处理器上报执行进度的接口，所有方法对 nil 安全。
+// 更新在 progressFlushInterval 内合并后再上报，避免频繁写库。
+type ProgressReporter struct {
+	sink ProgressSink
+	log  *log.Helper
+
+	mu        sync.Mutex
+	progress  Progress
+	dirty     bool
+	lastFlush time.Time
+	timer     *time.Timer
+	closed    bool
+	version   uint64
+
+	// 保证快照按生成顺序上报，过期的快照直接丢弃
+	sendMu      sync.Mutex
+	sentVersion uint64
+}
+
+func newProgressReporter(sink ProgressSink, logger *log.Helper, run *Run) *ProgressReporter {
+	return &ProgressReporter{
+		sink: sink,
+		log:  logger,
+		progress: Progress{
+			RunID:    run.ID,
+			TaskID:   run.TaskID,
+			TaskType: run.TaskType,
+			Attempt:  run.Attempt,
+			Status:   RunStatusRunning,
+		},
+	}
+}
+
+// ProgressFromContext 返回当前任务执行的进度上报器，不在任务执行上下文中时返回nil（调用其方法为空操作）
+func ProgressFromContext(ctx context.Context) *ProgressReporter {
+	r, _ := ctx.Value(progressReporterKey{}).(*ProgressReporter)
+	return r
+}
+
+// Report 更新进度百分比（0-100）、阶段和说明，stage 或 message 为空时保留原值
+func (r *ProgressReporter) Report(percent float64, stage, message string) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	r.progress.Percent = clampPercent(percent)
+	if stage != "" {
+		r.progress.Stage = stage
+	}
+	if message != "" {
+		r.progress.Message = message
+	}
+	r.mu.Unlock()
+
+	r.touch()
+}
+
+// SetStage 进入新的阶段
+func (r *ProgressReporter) SetStage(stage, message string) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	r.progress.Stage = stage
+	r.progress.Message = message
+	r.mu.Unlock()
+
+	r.touch()
+}
+
+// Log 追加一行日志到进度流，通过 LoggerFromContext 记录的日志会自动追加
+func (r *ProgressReporter) Log(format string, args ...any) {
+	if r == nil {
+		return
+	}
+	r.appendLog(fmt.Sprintf(format, args...))
+}
+
+func (r *ProgressReporter) appendLog(line string) {
+	if len(line) > maxRunLogLineBytes {
+		line = line[:maxRunLogLineBytes]
+	}
+
+	r.mu.Lock()
+	r.progress.Logs = append(r.progress.Logs, line)
+	if len(r.progress.Logs) > maxProgressLogLines {
+		r.progress.Logs = r.progress.Logs[len(r.progress.Logs)-maxProgressLogLines:]
+	}
+	r.progress.LogSeq++
+	r.mu.Unlock()
+
+	r.touch()
+}
+
+// touch 标记有更新，距上次上报超过间隔时立即上报，否则安排延迟上报
+func (r *ProgressReporter) touch() {
+	r.mu.Lock()
+	if r.closed {
+		r.mu.Unlock()
+		return
+	}
+	r.dirty = true
+
+	wait := progressFlushInterval - time.Since(r.lastFlush)
+	if wait > 0 {
+		if r.timer == nil {
+			r.timer = time.AfterFunc(wait, r.flush)
+		}
+		r.mu.Unlock()
+		return
+	}
+	r.mu.Unlock()
+
+	r.flush()
+}
+
+func (r *ProgressReporter) flush() {
+	r.mu.Lock()
+	if r.timer != nil {
+		r.timer.Stop()
+		r.timer = nil
+	}
+	if !r.dirty {
+		r.mu.Unlock()
+		return
+	}
+	r.dirty = false
+	r.lastFlush = time.Now()
+	snapshot, version := r.snapshotLocked()
+	r.mu.Unlock()
+
+	r.send(snapshot, version)
+}
+
+// finish 上报最终状态，之后的更新将被忽略
+func (r *ProgressReporter) finish(runErr error) {
+	r.mu.Lock()
+	if r.timer != nil {
+		r.timer.Stop()
+		r.timer = nil
+	}
+	r.closed = true
+	r.dirty = false
+	if runErr != nil {
+		r.progress.Status = RunStatusFailed
+		r.progress.Message = runErr.Error()
+	} else {
+		r.progress.Status = RunStatusSucceeded
+		r.progress.Percent = 100
+	}
+	snapshot, version := r.snapshotLocked()
+	r.mu.Unlock()
+
+	r.send(snapshot, version)
+}
+
+func (r *ProgressReporter) snapshotLocked() (*Progress, uint64) {
+	r.version++
+
+	p := r.progress
+	p.Logs = append([]string(nil), r.progress.Logs...)
+	p.UpdatedAt = time.Now()
+	return &p, r.version
+}
+
+func (r *ProgressReporter) send(p *Progress, version uint64) {
+	if r.sink == nil {
+		return
+	}
+
+	r.sendMu.Lock()
+	defer r.sendMu.Unlock()
+
+	if version <= r.sentVersion {
+		return
+	}
+	r.sentVersion = version
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := r.sink.ReportProgress(ctx, p); err != nil && r.log != nil {
+		r.log.Warnf("[%s] report task progress failed: %s", p.TaskType, err.Error())
+	}
+}
+
+func clampPercent(p float64) float64 {
+	switch {
+	case p < 0:
+		return 0
+	case p > 100:
+		return 100
+	default:
+		return p
+	}
+}
+
+// ProgressMiddleware 返回为任务处理器提供进度上报器的asynq中间件，需放在 RunMiddleware 之后。
+// 处理器通过 ProgressFromContext 上报进度，通过 LoggerFromContext 记录的日志也会实时推送；
+// 任务结束时上报最终状态。
+func ProgressMiddleware(sink ProgressSink, logger log.Logger) asynq.MiddlewareFunc {
+	l := log.NewHelper(log.With(logger, "module", "task/progress"))
+
+	return func(next asynq.Handler) asynq.Handler {
+		return asynq.HandlerFunc(func(ctx context.Context, t *asynq.Task) (err error) {
+			state, ok := ctx.Value(runStateKey{}).(*runState)
+			if !ok || state.run == nil {
+				return next.ProcessTask(ctx, t)
+			}
+
+			reporter := newProgressReporter(sink, l, state.run)
+			state.setLogHook(reporter.appendLog)
+
+			// 立即上报开始状态
+			reporter.touch()
+
+			defer func() {
+				if p := recover(); p != nil {
+					reporter.finish(fmt.Errorf("panic: %v", p))
+					panic(p)
+				}
+				reporter.finish(err)
+			}()
+
+			return next.ProcessTask(context.WithValue(ctx, progressReporterKey{}, reporter), t)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeProgressSink struct {
+	mu      sync.Mutex
+	reports []Progress
+}
+
+func (s *fakeProgressSink) ReportProgress(_ context.Context, p *Progress) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reports = append(s.reports, *p)
+	return nil
+}
+
+func (s *fakeProgressSink) last() Progress {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.reports[len(s.reports)-1]
+}
+
+func TestProgressMiddleware(t *testing.T) {
+	sink := &fakeProgressSink{}
+
+	handler := RunMiddleware(&fakeRunStore{}, nil, log.DefaultLogger)(
+		ProgressMiddleware(sink, log.DefaultLogger)(asynq.HandlerFunc(func(ctx context.Context, _ *asynq.Task) error {
+			p := ProgressFromContext(ctx)
+			p.Report(10, "dump", "dumping tables")
+			LoggerFromContext(ctx, nil).Info("table users done")
+			p.Report(150, "", "")
+			return nil
+		})),
+	)
+
+	require.NoError(t, handler.ProcessTask(context.Background(), asynq.NewTask(BackupTaskType, nil)))
+
+	first := sink.reports[0]
+	assert.Equal(t, uint32(1), first.RunID)
+	assert.Equal(t, RunStatusRunning, first.Status)
+	assert.Equal(t, BackupTaskType, first.TaskType)
+
+	// 短时间内的更新被合并，最终状态总会上报
+	last := sink.last()
+	assert.Equal(t, RunStatusSucceeded, last.Status)
+	assert.Equal(t, float64(100), last.Percent)
+	assert.Equal(t, "dump", last.Stage)
+	assert.Equal(t, "dumping tables", last.Message)
+	assert.Equal(t, uint64(1), last.LogSeq)
+	require.Len(t, last.Logs, 1)
+	assert.Contains(t, last.Logs[0], "table users done")
+}
+
+func TestProgressMiddlewareFailure(t *testing.T) {
+	sink := &fakeProgressSink{}
+
+	handler := RunMiddleware(&fakeRunStore{}, nil, log.DefaultLogger)(
+		ProgressMiddleware(sink, log.DefaultLogger)(asynq.HandlerFunc(func(ctx context.Context, _ *asynq.Task) error {
+			ProgressFromContext(ctx).Report(40, "upload", "")
+			return errors.New("bucket missing")
+		})),
+	)
+
+	assert.Error(t, handler.ProcessTask(context.Background(), asynq.NewTask(BackupTaskType, nil)))
+
+	last := sink.last()
+	assert.Equal(t, RunStatusFailed, last.Status)
+	assert.Equal(t, float64(40), last.Percent)
+	assert.Equal(t, "bucket missing", last.Message)
+}
+
+func TestProgressReporterThrottle(t *testing.T) {
+	sink := &fakeProgressSink{}
+	r := newProgressReporter(sink, nil, &Run{ID: 1, TaskID: "t1", TaskType: "x"})
+
+	r.Report(1, "a", "")
+	for i := 2; i <= 20; i++ {
+		r.Report(float64(i), "", "")
+	}
+	assert.Len(t, sink.reports, 1)
+
+	// 合并的更新在间隔结束后上报
+	assert.Eventually(t, func() bool {
+		sink.mu.Lock()
+		defer sink.mu.Unlock()
+		return len(sink.reports) == 2
+	}, 2*time.Second, 20*time.Millisecond)
+	assert.Equal(t, float64(20), sink.last().Percent)
+
+	for i := 0; i < maxProgressLogLines+5; i++ {
+		r.Log("line %d", i)
+	}
+	r.finish(nil)
+	assert.Len(t, sink.last().Logs, maxProgressLogLines)
+	assert.Equal(t, uint64(maxProgressLogLines+5), sink.last().LogSeq)
+
+	// 结束后的更新被忽略
+	count := len(sink.reports)
+	r.Report(5, "", "")
+	assert.Len(t, sink.reports, count)
+}
+
+func TestProgressFromContextWithoutRun(t *testing.T) {
+	p := ProgressFromContext(context.Background())
+	assert.Nil(t, p)
+
+	// nil 上报器的方法为空操作
+	p.Report(50, "stage", "message")
+	p.SetStage("stage", "")
+	p.Log("line")
+}
@@ -0,0 +1,338 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/hibiken/asynq"
+
+	"go-wind-admin/pkg/eventbus"
+)
+
+const (
+	maxRunLogLines     = 1000
+	maxRunLogLineBytes = 2048
+	maxRunPayloadBytes = 64 * 1024
+	maxRunResultBytes  = 64 * 1024
+)
+
+// RunStatus 任务执行状态
+type RunStatus string
+
+const (
+	RunStatusRunning   RunStatus = "RUNNING"
+	RunStatusSucceeded RunStatus = "SUCCEEDED"
+	RunStatusFailed    RunStatus = "FAILED"
+)
+
+// Run 一次任务执行的记录
+type Run struct {
+	ID            uint32
+	TaskID        string
+	TaskType      string
+	Queue         string
+	Payload       string
+	Worker        string
+	Attempt       uint32
+	MaxRetry      uint32
+	Status        RunStatus
+	StartedAt     time.Time
+	FinishedAt    time.Time
+	DurationMs    uint64
+	Result        string
+	Error         string
+	Logs          []string
+	LogsTruncated bool
+}
+
+// RunStore 任务执行记录的持久化接口
+type RunStore interface {
+	// CreateRun 在任务开始时写入执行记录，返回记录ID
+	CreateRun(ctx context.Context, run *Run) (uint32, error)
+	// FinishRun 在任务结束时更新执行记录
+	FinishRun(ctx context.Context, run *Run) error
+}
+
+type runStateKey struct{}
+
+// runState 单次执行过程中收集的日志和结果
+type runState struct {
+	run *Run
+
+	mu        sync.Mutex
+	logs      []string
+	truncated bool
+	result    any
+	hasResult bool
+	onLog     func(line string)
+}
+
+func (s *runState) append(line string) {
+	s.mu.Lock()
+	onLog := s.onLog
+
+	if len(s.logs) >= maxRunLogLines {
+		s.truncated = true
+	} else {
+		if len(line) > maxRunLogLineBytes {
+			line = line[:maxRunLogLineBytes]
+			s.truncated = true
+		}
+		s.logs = append(s.logs, line)
+	}
+	s.mu.Unlock()
+
+	if onLog != nil {
+		onLog(line)
+	}
+}
+
+// setLogHook 设置每条日志的回调，用于实时推送
+func (s *runState) setLogHook(fn func(line string)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.onLog = fn
+}
+
+func (s *runState) snapshot() ([]string, bool, any, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	logs := make([]string, len(s.logs))
+	copy(logs, s.logs)
+	return logs, s.truncated, s.result, s.hasResult
+}
+
+// runLogger 将日志同时记录到执行记录和下游日志器
+type runLogger struct {
+	state *runState
+	next  log.Logger
+}
+
+func (l *runLogger) Log(level log.Level, keyvals ...any) error {
+	var sb strings.Builder
+	sb.WriteString(time.Now().Format(time.RFC3339))
+	sb.WriteByte(' ')
+	sb.WriteString(level.String())
+	for i := 0; i < len(keyvals); i += 2 {
+		key := fmt.Sprint(keyvals[i])
+		if key == log.DefaultMessageKey {
+			key = ""
+		}
+		var val any
+		if i+1 < len(keyvals) {
+			val = keyvals[i+1]
+		}
+		sb.WriteByte(' ')
+		if key != "" {
+			sb.WriteString(key)
+			sb.WriteByte('=')
+		}
+		sb.WriteString(fmt.Sprint(val))
+	}
+	l.state.append(sb.String())
+
+	if l.next == nil {
+		return nil
+	}
+	return l.next.Log(level, keyvals...)
+}
+
+// LoggerFromContext 返回一个会把日志写入当前执行记录的日志助手，
+// 不在任务执行上下文中时直接返回fallback。
+func LoggerFromContext(ctx context.Context, fallback *log.Helper) *log.Helper {
+	state, ok := ctx.Value(runStateKey{}).(*runState)
+	if !ok {
+		return fallback
+	}
+
+	var next log.Logger
+	if fallback != nil {
+		next = fallback.Logger()
+	}
+	return log.NewHelper(&runLogger{state: state, next: next})
+}
+
+// SetResult 设置当前执行的结果，结果会以JSON形式保存到执行记录并写入asynq任务结果。
+func SetResult(ctx context.Context, v any) {
+	state, ok := ctx.Value(runStateKey{}).(*runState)
+	if !ok {
+		return
+	}
+
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	state.result = v
+	state.hasResult = true
+}
+
+// RunMiddleware 返回记录任务执行历史的asynq中间件。
+// 每次执行都会写入一条执行记录，并发布 task.started / task.completed / task.failed 事件。
+func RunMiddleware(store RunStore, bus eventbus.EventBus, logger log.Logger) asynq.MiddlewareFunc {
+	l := log.NewHelper(log.With(logger, "module", "task/run"))
+	worker := currentWorker()
+
+	return func(next asynq.Handler) asynq.Handler {
+		return asynq.HandlerFunc(func(ctx context.Context, t *asynq.Task) (err error) {
+			run := newRun(ctx, t, worker)
+
+			if store != nil {
+				if run.ID, err = store.CreateRun(ctx, run); err != nil {
+					l.Errorf("[%s] create task run failed: %s", run.TaskType, err.Error())
+				}
+			}
+
+			publishRunEvent(ctx, bus, eventbus.EventTaskStarted, &eventbus.TaskStartedEvent{
+				RunID:    run.ID,
+				TaskID:   run.TaskID,
+				TaskType: run.TaskType,
+				Queue:    run.Queue,
+				Worker:   run.Worker,
+				Attempt:  run.Attempt,
+				MaxRetry: run.MaxRetry,
+			})
+
+			state := &runState{run: run}
+
+			defer func() {
+				if p := recover(); p != nil {
+					finishRun(ctx, l, store, bus, t, run, state, fmt.Errorf("panic: %v", p))
+					panic(p)
+				}
+				finishRun(ctx, l, store, bus, t, run, state, err)
+			}()
+
+			return next.ProcessTask(context.WithValue(ctx, runStateKey{}, state), t)
+		})
+	}
+}
+
+func newRun(ctx context.Context, t *asynq.Task, worker string) *Run {
+	run := &Run{
+		TaskType:  t.Type(),
+		Worker:    worker,
+		Attempt:   1,
+		Status:    RunStatusRunning,
+		StartedAt: time.Now(),
+	}
+
+	run.TaskID, _ = asynq.GetTaskID(ctx)
+	run.Queue, _ = asynq.GetQueueName(ctx)
+	if retried, ok := asynq.GetRetryCount(ctx); ok {
+		run.Attempt = uint32(retried) + 1
+	}
+	if maxRetry, ok := asynq.GetMaxRetry(ctx); ok {
+		run.MaxRetry = uint32(maxRetry)
+	}
+
+	payload := t.Payload()
+	if len(payload) > maxRunPayloadBytes {
+		payload = payload[:maxRunPayloadBytes]
+	}
+	run.Payload = string(payload)
+
+	return run
+}
+
+func finishRun(
+	ctx context.Context,
+	l *log.Helper,
+	store RunStore,
+	bus eventbus.EventBus,
+	t *asynq.Task,
+	run *Run,
+	state *runState,
+	runErr error,
+) {
+	// 任务超时或取消后仍需写入结果
+	ctx = context.WithoutCancel(ctx)
+
+	run.FinishedAt = time.Now()
+	run.DurationMs = uint64(run.FinishedAt.Sub(run.StartedAt).Milliseconds())
+
+	var result any
+	var hasResult bool
+	run.Logs, run.LogsTruncated, result, hasResult = state.snapshot()
+
+	var resultData []byte
+	if hasResult {
+		var err error
+		if resultData, err = json.Marshal(result); err != nil {
+			l.Errorf("[%s] marshal task result failed: %s", run.TaskType, err.Error())
+			resultData = nil
+		}
+	}
+	if len(resultData) > 0 {
+		if len(resultData) <= maxRunResultBytes {
+			run.Result = string(resultData)
+		}
+		if w := t.ResultWriter(); w != nil {
+			if _, err := w.Write(resultData); err != nil {
+				l.Errorf("[%s] write task result failed: %s", run.TaskType, err.Error())
+			}
+		}
+	}
+
+	if runErr != nil {
+		run.Status = RunStatusFailed
+		run.Error = runErr.Error()
+	} else {
+		run.Status = RunStatusSucceeded
+	}
+
+	if store != nil && run.ID != 0 {
+		if err := store.FinishRun(ctx, run); err != nil {
+			l.Errorf("[%s] finish task run failed: %s", run.TaskType, err.Error())
+		}
+	}
+
+	if runErr != nil {
+		publishRunEvent(ctx, bus, eventbus.EventTaskFailed, &eventbus.TaskFailedEvent{
+			RunID:     run.ID,
+			TaskID:    run.TaskID,
+			TaskType:  run.TaskType,
+			Error:     run.Error,
+			Attempt:   run.Attempt,
+			MaxRetry:  run.MaxRetry,
+			WillRetry: run.Attempt <= run.MaxRetry && !errors.Is(runErr, asynq.SkipRetry),
+			Duration:  int64(run.DurationMs),
+		})
+		return
+	}
+
+	event := &eventbus.TaskCompletedEvent{
+		TaskID:   run.TaskID,
+		TaskType: run.TaskType,
+		Success:  true,
+		Duration: int64(run.DurationMs),
+	}
+	if len(resultData) > 0 {
+		var m map[string]interface{}
+		if json.Unmarshal(resultData, &m) == nil {
+			event.Result = m
+		}
+	}
+	publishRunEvent(ctx, bus, eventbus.EventTaskCompleted, event)
+}
+
+func publishRunEvent(ctx context.Context, bus eventbus.EventBus, eventType string, data any) {
+	if bus == nil {
+		return
+	}
+	_ = bus.Publish(ctx, eventbus.NewEvent(eventType, data).WithSource("task"))
+}
+
+func currentWorker() string {
+	host, err := os.Hostname()
+	if err != nil || host == "" {
+		host = "unknown"
+	}
+	return fmt.Sprintf("%s:%d", host, os.Getpid())
+}
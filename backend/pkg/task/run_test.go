@@ -0,0 +1,121 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+
+	"go-wind-admin/pkg/eventbus"
+)
+
+type fakeRunStore struct {
+	mu       sync.Mutex
+	created  []Run
+	finished []Run
+}
+
+func (s *fakeRunStore) CreateRun(_ context.Context, run *Run) (uint32, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.created = append(s.created, *run)
+	return uint32(len(s.created)), nil
+}
+
+func (s *fakeRunStore) FinishRun(_ context.Context, run *Run) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.finished = append(s.finished, *run)
+	return nil
+}
+
+func collectEvents(t *testing.T, bus eventbus.EventBus) *[]string {
+	var types []string
+	for _, typ := range []string{eventbus.EventTaskStarted, eventbus.EventTaskCompleted, eventbus.EventTaskFailed} {
+		err := bus.Subscribe(typ, eventbus.EventHandlerFunc(func(_ context.Context, e *eventbus.Event) error {
+			types = append(types, e.Type)
+			return nil
+		}))
+		assert.NoError(t, err)
+	}
+	return &types
+}
+
+func TestRunMiddlewareSuccess(t *testing.T) {
+	store := &fakeRunStore{}
+	bus := eventbus.NewEventBus(log.DefaultLogger)
+	events := collectEvents(t, bus)
+
+	handler := RunMiddleware(store, bus, log.DefaultLogger)(asynq.HandlerFunc(func(ctx context.Context, _ *asynq.Task) error {
+		l := LoggerFromContext(ctx, log.NewHelper(log.DefaultLogger))
+		l.Infof("backup %s", "db")
+		SetResult(ctx, map[string]any{"files": 2})
+		return nil
+	}))
+
+	err := handler.ProcessTask(context.Background(), asynq.NewTask(BackupTaskType, []byte(`{"name":"db"}`)))
+	assert.NoError(t, err)
+
+	assert.Len(t, store.created, 1)
+	assert.Equal(t, RunStatusRunning, store.created[0].Status)
+	assert.Equal(t, `{"name":"db"}`, store.created[0].Payload)
+
+	assert.Len(t, store.finished, 1)
+	run := store.finished[0]
+	assert.Equal(t, uint32(1), run.ID)
+	assert.Equal(t, RunStatusSucceeded, run.Status)
+	assert.Equal(t, BackupTaskType, run.TaskType)
+	assert.Equal(t, uint32(1), run.Attempt)
+	assert.Equal(t, `{"files":2}`, run.Result)
+	assert.Len(t, run.Logs, 1)
+	assert.Contains(t, run.Logs[0], "INFO backup db")
+	assert.NotEmpty(t, run.Worker)
+
+	assert.Equal(t, []string{eventbus.EventTaskStarted, eventbus.EventTaskCompleted}, *events)
+}
+
+func TestRunMiddlewareFailure(t *testing.T) {
+	store := &fakeRunStore{}
+	bus := eventbus.NewEventBus(log.DefaultLogger)
+	events := collectEvents(t, bus)
+
+	handler := RunMiddleware(store, bus, log.DefaultLogger)(asynq.HandlerFunc(func(ctx context.Context, _ *asynq.Task) error {
+		return errors.New("disk full")
+	}))
+
+	err := handler.ProcessTask(context.Background(), asynq.NewTask(BackupTaskType, nil))
+	assert.EqualError(t, err, "disk full")
+
+	assert.Len(t, store.finished, 1)
+	assert.Equal(t, RunStatusFailed, store.finished[0].Status)
+	assert.Equal(t, "disk full", store.finished[0].Error)
+	assert.Equal(t, []string{eventbus.EventTaskStarted, eventbus.EventTaskFailed}, *events)
+}
+
+func TestRunLogTruncation(t *testing.T) {
+	state := &runState{}
+	l := log.NewHelper(&runLogger{state: state})
+
+	l.Info(strings.Repeat("x", maxRunLogLineBytes*2))
+	for i := 0; i < maxRunLogLines+10; i++ {
+		l.Info("line")
+	}
+
+	logs, truncated, _, _ := state.snapshot()
+	assert.True(t, truncated)
+	assert.Len(t, logs, maxRunLogLines)
+	assert.Len(t, logs[0], maxRunLogLineBytes)
+}
+
+func TestLoggerFromContextWithoutRun(t *testing.T) {
+	fallback := log.NewHelper(log.DefaultLogger)
+	assert.Same(t, fallback, LoggerFromContext(context.Background(), fallback))
+
+	// 不在执行上下文中时忽略结果
+	SetResult(context.Background(), "ignored")
+}
@@ -0,0 +1,25 @@
+package task
+
+const (
+	StorageOrphanReconcileTaskType = "storage_orphan_reconcile"
+
+	// StorageOrphanActionQuarantine 把孤立对象移动到隔离存储桶
+	StorageOrphanActionQuarantine = "quarantine"
+	// StorageOrphanActionDelete 直接删除孤立对象
+	StorageOrphanActionDelete = "delete"
+
+	// DefaultStorageOrphanGraceHours 默认宽限期，刚写入尚未登记文件记录的对象不会被处理
+	DefaultStorageOrphanGraceHours = 24
+)
+
+// StorageOrphanReconcileTaskData 文件记录与对象存储对账任务参数
+type StorageOrphanReconcileTaskData struct {
+	// DryRun 为true时只生成报告，不处理孤立对象
+	DryRun bool `json:"dry_run,omitempty"`
+	// Action 孤立对象的处理方式：quarantine（默认）、delete
+	Action string `json:"action,omitempty"`
+	// GraceHours 最后修改时间在该小时数以内的对象不处理，为0时使用默认值
+	GraceHours uint32 `json:"grace_hours,omitempty"`
+	// Buckets 对账的存储桶，为空时对账文件上传使用的存储桶及文件记录中出现的存储桶
+	Buckets []string `json:"buckets,omitempty"`
+}
@@ -0,0 +1,10 @@
+package task
+
+const (
+	StorageUsageReconcileTaskType = "storage_usage_reconcile"
+)
+
+// StorageUsageReconcileTaskData 存储用量对账任务参数，TenantID为空时对账全部租户
+type StorageUsageReconcileTaskData struct {
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+}
@@ -0,0 +1,13 @@
+package task
+
+const (
+	TaskRunCleanupTaskType = "task_run_cleanup"
+
+	// DefaultTaskRunRetentionDays 任务执行记录默认保留天数
+	DefaultTaskRunRetentionDays = 30
+)
+
+// TaskRunCleanupTaskData 任务执行记录清理任务参数，RetentionDays为0时使用默认保留天数
+type TaskRunCleanupTaskData struct {
+	RetentionDays uint32 `json:"retention_days,omitempty"`
+}
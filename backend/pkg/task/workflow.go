@@ -0,0 +1,518 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/hibiken/asynq"
+)
+
+const (
+	// WorkflowStartTaskType 启动工作流的任务类型，可配置为周期任务定时启动工作流
+	WorkflowStartTaskType = "workflow_start"
+
+	workflowTaskIDPrefix = "workflow"
+
+	maxWorkflowSteps = 100
+)
+
+// WorkflowStartTaskData 启动工作流任务参数
+type WorkflowStartTaskData struct {
+	WorkflowID uint32         `json:"workflow_id"`
+	Input      map[string]any `json:"input,omitempty"`
+}
+
+// StepStatus 工作流步骤状态
+type StepStatus string
+
+const (
+	StepStatusPending   StepStatus = "PENDING"
+	StepStatusRunning   StepStatus = "RUNNING"
+	StepStatusSucceeded StepStatus = "SUCCEEDED"
+	StepStatusFailed    StepStatus = "FAILED"
+	StepStatusSkipped   StepStatus = "SKIPPED"
+	StepStatusCancelled StepStatus = "CANCELLED"
+)
+
+// Terminal 步骤是否已结束
+func (s StepStatus) Terminal() bool {
+	switch s {
+	case StepStatusSucceeded, StepStatusFailed, StepStatusSkipped, StepStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// WorkflowStep 工作流步骤定义
+type WorkflowStep struct {
+	// Name 步骤名称，工作流内唯一，只能包含字母、数字和下划线
+	Name string
+	// TypeName 步骤执行的任务类型
+	TypeName string
+	// Payload 任务参数（JSON），字符串中可使用 {{ input.x }}、{{ steps.a.output.y }} 引用
+	Payload string
+	// DependsOn 依赖的步骤，全部结束后才会执行
+	DependsOn []string
+	// Condition 执行条件，为假时跳过该步骤
+	Condition string
+	// MaxRetry 步骤失败后的最大重试次数
+	MaxRetry uint32
+	// Timeout 单次执行超时时间
+	Timeout time.Duration
+	// ContinueOnFailure 为true时步骤失败不会使工作流失败，后继步骤照常执行
+	ContinueOnFailure bool
+}
+
+// StepState 步骤的执行状态
+type StepState struct {
+	Status StepStatus
+	// Output 步骤结果（JSON）
+	Output string
+	Error  string
+}
+
+// WorkflowPlan 一次推进的结果
+type WorkflowPlan struct {
+	// Dispatch 需要派发执行的步骤，状态已置为 RUNNING
+	Dispatch []string
+	// Done 所有步骤都已结束
+	Done bool
+	// Failed 工作流失败（存在未允许失败的失败步骤）
+	Failed bool
+	// FailedStep 导致工作流失败的步骤
+	FailedStep string
+}
+
+var workflowStepNameRegexp = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]{0,63}$`)
+
+// Workflow 校验过的工作流有向无环图
+type Workflow struct {
+	steps      []WorkflowStep
+	index      map[string]int
+	order      []int
+	conditions map[string]workflowCondition
+}
+
+// NewWorkflow 校验步骤定义并构建工作流：步骤名唯一、依赖存在、无环、条件表达式合法
+func NewWorkflow(steps []WorkflowStep) (*Workflow, error) {
+	if len(steps) == 0 {
+		return nil, errors.New("workflow has no steps")
+	}
+	if len(steps) > maxWorkflowSteps {
+		return nil, fmt.Errorf("workflow has more than %d steps", maxWorkflowSteps)
+	}
+
+	w := &Workflow{
+		steps:      steps,
+		index:      make(map[string]int, len(steps)),
+		conditions: make(map[string]workflowCondition),
+	}
+
+	for i, step := range steps {
+		if !workflowStepNameRegexp.MatchString(step.Name) {
+			return nil, fmt.Errorf("invalid step name %q", step.Name)
+		}
+		if _, exists := w.index[step.Name]; exists {
+			return nil, fmt.Errorf("duplicate step name %q", step.Name)
+		}
+		if step.TypeName == "" {
+			return nil, fmt.Errorf("step %q has no task type", step.Name)
+		}
+		if step.Payload != "" && !json.Valid([]byte(step.Payload)) {
+			return nil, fmt.Errorf("step %q has invalid payload", step.Name)
+		}
+		if strings.TrimSpace(step.Condition) != "" {
+			cond, err := parseWorkflowCondition(step.Condition)
+			if err != nil {
+				return nil, fmt.Errorf("step %q has invalid condition: %w", step.Name, err)
+			}
+			w.conditions[step.Name] = cond
+		}
+		w.index[step.Name] = i
+	}
+
+	for _, step := range steps {
+		for _, dep := range step.DependsOn {
+			if _, ok := w.index[dep]; !ok {
+				return nil, fmt.Errorf("step %q depends on unknown step %q", step.Name, dep)
+			}
+			if dep == step.Name {
+				return nil, fmt.Errorf("step %q depends on itself", step.Name)
+			}
+		}
+	}
+
+	order, err := w.topologicalOrder()
+	if err != nil {
+		return nil, err
+	}
+	w.order = order
+
+	return w, nil
+}
+
+// topologicalOrder Kahn 算法求拓扑序，同层按定义顺序
+func (w *Workflow) topologicalOrder() ([]int, error) {
+	inDegree := make([]int, len(w.steps))
+	successors := make([][]int, len(w.steps))
+	for i, step := range w.steps {
+		for _, dep := range step.DependsOn {
+			j := w.index[dep]
+			successors[j] = append(successors[j], i)
+			inDegree[i]++
+		}
+	}
+
+	var queue []int
+	for i := range w.steps {
+		if inDegree[i] == 0 {
+			queue = append(queue, i)
+		}
+	}
+
+	order := make([]int, 0, len(w.steps))
+	for len(queue) > 0 {
+		i := queue[0]
+		queue = queue[1:]
+		order = append(order, i)
+		for _, j := range successors[i] {
+			inDegree[j]--
+			if inDegree[j] == 0 {
+				queue = append(queue, j)
+			}
+		}
+	}
+
+	if len(order) != len(w.steps) {
+		var cyclic []string
+		for i, d := range inDegree {
+			if d > 0 {
+				cyclic = append(cyclic, w.steps[i].Name)
+			}
+		}
+		return nil, fmt.Errorf("workflow has a dependency cycle among steps %s", strings.Join(cyclic, ", "))
+	}
+
+	return order, nil
+}
+
+// Steps 按拓扑序返回步骤
+func (w *Workflow) Steps() []WorkflowStep {
+	steps := make([]WorkflowStep, 0, len(w.order))
+	for _, i := range w.order {
+		steps = append(steps, w.steps[i])
+	}
+	return steps
+}
+
+// Step 按名称查找步骤
+func (w *Workflow) Step(name string) (WorkflowStep, bool) {
+	i, ok := w.index[name]
+	if !ok {
+		return WorkflowStep{}, false
+	}
+	return w.steps[i], true
+}
+
+// Plan 根据当前状态推进工作流：
+//   - 依赖全部结束的待执行步骤，条件为真时派发，为假时跳过；跳过的步骤视为已完成
+//   - 存在失败且未允许失败的步骤时，不再派发，剩余的待执行步骤被取消
+//
+// states 会被原地修改，缺失的步骤按待执行处理。
+func (w *Workflow) Plan(states map[string]*StepState, input any) *WorkflowPlan {
+	for _, step := range w.steps {
+		if states[step.Name] == nil {
+			states[step.Name] = &StepState{Status: StepStatusPending}
+		}
+	}
+
+	plan := &WorkflowPlan{}
+
+	for {
+		if failed := w.failedStep(states); failed != "" {
+			plan.Failed = true
+			plan.FailedStep = failed
+			break
+		}
+
+		progressed := false
+		for _, i := range w.order {
+			step := w.steps[i]
+			state := states[step.Name]
+			if state.Status != StepStatusPending || !w.dependenciesDone(step, states) {
+				continue
+			}
+
+			run, err := w.evalCondition(step, states, input)
+			switch {
+			case err != nil:
+				state.Status = StepStatusFailed
+				state.Error = fmt.Sprintf("evaluate condition: %s", err.Error())
+			case run:
+				state.Status = StepStatusRunning
+				plan.Dispatch = append(plan.Dispatch, step.Name)
+			default:
+				state.Status = StepStatusSkipped
+			}
+			progressed = true
+
+			// 条件求值失败可能使工作流失败，需要重新检查
+			if state.Status == StepStatusFailed {
+				break
+			}
+		}
+
+		if !progressed {
+			break
+		}
+	}
+
+	if plan.Failed {
+		// 失败后不再派发新步骤，已派发的步骤（包括本次）由调用方处理
+		for _, name := range plan.Dispatch {
+			states[name].Status = StepStatusCancelled
+		}
+		plan.Dispatch = nil
+
+		for _, state := range states {
+			if state.Status == StepStatusPending {
+				state.Status = StepStatusCancelled
+			}
+		}
+	}
+
+	plan.Done = true
+	for _, state := range states {
+		if !state.Status.Terminal() {
+			plan.Done = false
+			break
+		}
+	}
+
+	return plan
+}
+
+func (w *Workflow) failedStep(states map[string]*StepState) string {
+	for _, i := range w.order {
+		step := w.steps[i]
+		if states[step.Name].Status == StepStatusFailed && !step.ContinueOnFailure {
+			return step.Name
+		}
+	}
+	return ""
+}
+
+func (w *Workflow) dependenciesDone(step WorkflowStep, states map[string]*StepState) bool {
+	for _, dep := range step.DependsOn {
+		if !states[dep].Status.Terminal() {
+			return false
+		}
+	}
+	return true
+}
+
+func (w *Workflow) evalCondition(step WorkflowStep, states map[string]*StepState, input any) (bool, error) {
+	cond, ok := w.conditions[step.Name]
+	if !ok {
+		return true, nil
+	}
+
+	v, err := cond.eval(WorkflowScope(states, input))
+	if err != nil {
+		return false, err
+	}
+	return workflowTruthy(v), nil
+}
+
+// StepPayload 用工作流输入和前置步骤结果渲染步骤的任务参数
+func (w *Workflow) StepPayload(name string, states map[string]*StepState, input any) (any, error) {
+	step, ok := w.Step(name)
+	if !ok {
+		return nil, fmt.Errorf("unknown step %q", name)
+	}
+	if step.Payload == "" {
+		return map[string]any{}, nil
+	}
+
+	var payload any
+	if err := json.Unmarshal([]byte(step.Payload), &payload); err != nil {
+		return nil, fmt.Errorf("step %q has invalid payload: %w", name, err)
+	}
+
+	return renderWorkflowValue(payload, WorkflowScope(states, input)), nil
+}
+
+// WorkflowScope 构建表达式的求值作用域
+func WorkflowScope(states map[string]*StepState, input any) map[string]any {
+	steps := make(map[string]any, len(states))
+	for name, state := range states {
+		item := map[string]any{
+			"status": string(state.Status),
+		}
+		if state.Output != "" {
+			var output any
+			if json.Unmarshal([]byte(state.Output), &output) == nil {
+				item["output"] = output
+			}
+		}
+		if state.Error != "" {
+			item["error"] = state.Error
+		}
+		steps[name] = item
+	}
+
+	return map[string]any{
+		"input": normalizeWorkflowInput(input),
+		"steps": steps,
+	}
+}
+
+// normalizeWorkflowInput 将输入转换为 JSON 通用结构，便于按路径取值
+func normalizeWorkflowInput(input any) any {
+	switch v := input.(type) {
+	case nil:
+		return nil
+	case string:
+		var out any
+		if json.Unmarshal([]byte(v), &out) == nil {
+			return out
+		}
+		return v
+	}
+
+	data, err := json.Marshal(input)
+	if err != nil {
+		return nil
+	}
+	var out any
+	_ = json.Unmarshal(data, &out)
+	return out
+}
+
+// WorkflowStepTaskID 生成步骤的asynq任务ID。generation 在工作流恢复时递增，
+// 同一次派发的任务ID相同，重复入队会被asynq拒绝。
+func WorkflowStepTaskID(runID uint32, step string, generation uint32) string {
+	return fmt.Sprintf("%s:%d:%s:%d", workflowTaskIDPrefix, runID, step, generation)
+}
+
+// ParseWorkflowStepTaskID 解析步骤的asynq任务ID
+func ParseWorkflowStepTaskID(taskID string) (runID uint32, step string, generation uint32, ok bool) {
+	parts := strings.Split(taskID, ":")
+	if len(parts) != 4 || parts[0] != workflowTaskIDPrefix {
+		return 0, "", 0, false
+	}
+
+	id, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return 0, "", 0, false
+	}
+	gen, err := strconv.ParseUint(parts[3], 10, 32)
+	if err != nil {
+		return 0, "", 0, false
+	}
+	if !workflowStepNameRegexp.MatchString(parts[2]) {
+		return 0, "", 0, false
+	}
+
+	return uint32(id), parts[2], uint32(gen), true
+}
+
+// WorkflowStepResult 步骤的最终执行结果（成功，或重试耗尽后的失败）
+type WorkflowStepResult struct {
+	RunID      uint32
+	Step       string
+	Generation uint32
+	TaskID     string
+	// Output 步骤结果（JSON）
+	Output string
+	Err    error
+}
+
+// WorkflowReporter 接收步骤执行结果并推进工作流
+type WorkflowReporter interface {
+	ReportStepResult(ctx context.Context, result *WorkflowStepResult) error
+}
+
+// WorkflowMiddleware 返回把工作流步骤的执行结果回报给 reporter 的asynq中间件。
+// 需放在 RunMiddleware 之后，以便读取处理器通过 SetResult 设置的结果；
+// 失败且还会重试时不回报。
+func WorkflowMiddleware(reporter WorkflowReporter, logger log.Logger) asynq.MiddlewareFunc {
+	l := log.NewHelper(log.With(logger, "module", "task/workflow"))
+
+	return func(next asynq.Handler) asynq.Handler {
+		return asynq.HandlerFunc(func(ctx context.Context, t *asynq.Task) (err error) {
+			taskID, _ := asynq.GetTaskID(ctx)
+			runID, step, generation, ok := ParseWorkflowStepTaskID(taskID)
+			if !ok || reporter == nil {
+				return next.ProcessTask(ctx, t)
+			}
+
+			state, hasState := ctx.Value(runStateKey{}).(*runState)
+			if !hasState {
+				state = &runState{}
+				ctx = context.WithValue(ctx, runStateKey{}, state)
+			}
+
+			defer func() {
+				if p := recover(); p != nil {
+					reportWorkflowStep(ctx, l, reporter, taskID, runID, step, generation, state, fmt.Errorf("panic: %v", p))
+					panic(p)
+				}
+				reportWorkflowStep(ctx, l, reporter, taskID, runID, step, generation, state, err)
+			}()
+
+			return next.ProcessTask(ctx, t)
+		})
+	}
+}
+
+func reportWorkflowStep(
+	ctx context.Context,
+	l *log.Helper,
+	reporter WorkflowReporter,
+	taskID string,
+	runID uint32,
+	step string,
+	generation uint32,
+	state *runState,
+	runErr error,
+) {
+	if runErr != nil && workflowStepWillRetry(ctx, runErr) {
+		return
+	}
+
+	result := &WorkflowStepResult{
+		RunID:      runID,
+		Step:       step,
+		Generation: generation,
+		TaskID:     taskID,
+		Err:        runErr,
+	}
+
+	if _, _, v, hasResult := state.snapshot(); hasResult {
+		if data, err := json.Marshal(v); err == nil && len(data) <= maxRunResultBytes {
+			result.Output = string(data)
+		}
+	}
+
+	// 任务超时或取消后仍需回报结果
+	if err := reporter.ReportStepResult(context.WithoutCancel(ctx), result); err != nil {
+		l.Errorf("[%s] report workflow step result failed: %s", taskID, err.Error())
+	}
+}
+
+func workflowStepWillRetry(ctx context.Context, err error) bool {
+	if errors.Is(err, asynq.SkipRetry) {
+		return false
+	}
+	retried, _ := asynq.GetRetryCount(ctx)
+	maxRetry, _ := asynq.GetMaxRetry(ctx)
+	return retried < maxRetry
+}
@@ -0,0 +1,450 @@
+package task
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// 工作流表达式
+//
+// 步骤的 condition 与 payload 模板中可以引用工作流输入和前置步骤的结果：
+//   - input.<path>                 工作流启动时的输入
+//   - steps.<name>.status          步骤状态，如 "SUCCEEDED"、"FAILED"、"SKIPPED"
+//   - steps.<name>.output.<path>   步骤通过 SetResult 设置的结果
+//   - steps.<name>.error           步骤的错误信息
+//
+// 条件表达式支持 ==、!=、>、>=、<、<=、&&、||、! 与括号，字面量支持数字、
+// 单/双引号字符串、true、false 与 null，单独的引用按真值判断：
+//
+//	steps.check.output.count > 0 && input.mode != 'dry_run'
+
+// workflowTemplateRegexp 匹配 payload 中的 {{ 引用 }}
+var workflowTemplateRegexp = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_.]*)\s*\}\}`)
+
+// lookupWorkflowPath 按 a.b.0.c 形式的路径在作用域中取值，不存在时返回 nil
+func lookupWorkflowPath(scope map[string]any, path string) any {
+	var current any = scope
+	for _, key := range strings.Split(path, ".") {
+		switch v := current.(type) {
+		case map[string]any:
+			current = v[key]
+		case []any:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(v) {
+				return nil
+			}
+			current = v[i]
+		default:
+			return nil
+		}
+	}
+	return current
+}
+
+// renderWorkflowValue 替换 JSON 值中字符串里的引用。
+// 整个字符串只有一个引用时保留被引用值的原始类型，否则按文本拼接。
+func renderWorkflowValue(value any, scope map[string]any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for k, item := range v {
+			out[k] = renderWorkflowValue(item, scope)
+		}
+		return out
+
+	case []any:
+		out := make([]any, len(v))
+		for i, item := range v {
+			out[i] = renderWorkflowValue(item, scope)
+		}
+		return out
+
+	case string:
+		if m := workflowTemplateRegexp.FindStringSubmatch(v); m != nil && m[0] == v {
+			return lookupWorkflowPath(scope, m[1])
+		}
+		return workflowTemplateRegexp.ReplaceAllStringFunc(v, func(s string) string {
+			ref := workflowTemplateRegexp.FindStringSubmatch(s)[1]
+			return workflowValueString(lookupWorkflowPath(scope, ref))
+		})
+
+	default:
+		return v
+	}
+}
+
+func workflowValueString(v any) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	case map[string]any, []any:
+		data, _ := json.Marshal(val)
+		return string(data)
+	default:
+		return fmt.Sprint(val)
+	}
+}
+
+// workflowCondition 编译后的条件表达式
+type workflowCondition interface {
+	eval(scope map[string]any) (any, error)
+}
+
+type conditionLiteral struct{ value any }
+
+func (c conditionLiteral) eval(map[string]any) (any, error) { return c.value, nil }
+
+type conditionRef struct{ path string }
+
+func (c conditionRef) eval(scope map[string]any) (any, error) {
+	return lookupWorkflowPath(scope, c.path), nil
+}
+
+type conditionNot struct{ operand workflowCondition }
+
+func (c conditionNot) eval(scope map[string]any) (any, error) {
+	v, err := c.operand.eval(scope)
+	if err != nil {
+		return nil, err
+	}
+	return !workflowTruthy(v), nil
+}
+
+type conditionBinary struct {
+	op          string
+	left, right workflowCondition
+}
+
+func (c conditionBinary) eval(scope map[string]any) (any, error) {
+	left, err := c.left.eval(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	// 逻辑运算短路求值
+	switch c.op {
+	case "&&":
+		if !workflowTruthy(left) {
+			return false, nil
+		}
+		right, err := c.right.eval(scope)
+		if err != nil {
+			return nil, err
+		}
+		return workflowTruthy(right), nil
+
+	case "||":
+		if workflowTruthy(left) {
+			return true, nil
+		}
+		right, err := c.right.eval(scope)
+		if err != nil {
+			return nil, err
+		}
+		return workflowTruthy(right), nil
+	}
+
+	right, err := c.right.eval(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	switch c.op {
+	case "==":
+		return workflowEqual(left, right), nil
+	case "!=":
+		return !workflowEqual(left, right), nil
+	}
+
+	cmp, err := workflowCompare(left, right)
+	if err != nil {
+		return nil, err
+	}
+	switch c.op {
+	case ">":
+		return cmp > 0, nil
+	case ">=":
+		return cmp >= 0, nil
+	case "<":
+		return cmp < 0, nil
+	case "<=":
+		return cmp <= 0, nil
+	}
+
+	return nil, fmt.Errorf("unknown operator %q", c.op)
+}
+
+// workflowTruthy nil、false、0、空字符串和空集合为假
+func workflowTruthy(v any) bool {
+	switch val := v.(type) {
+	case nil:
+		return false
+	case bool:
+		return val
+	case float64:
+		return val != 0
+	case string:
+		return val != ""
+	case map[string]any:
+		return len(val) > 0
+	case []any:
+		return len(val) > 0
+	default:
+		return true
+	}
+}
+
+func workflowEqual(a, b any) bool {
+	if af, ok := a.(float64); ok {
+		if bf, ok := b.(float64); ok {
+			return af == bf
+		}
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func workflowCompare(a, b any) (int, error) {
+	switch av := a.(type) {
+	case float64:
+		if bv, ok := b.(float64); ok {
+			switch {
+			case av < bv:
+				return -1, nil
+			case av > bv:
+				return 1, nil
+			}
+			return 0, nil
+		}
+	case string:
+		if bv, ok := b.(string); ok {
+			return strings.Compare(av, bv), nil
+		}
+	}
+	return 0, fmt.Errorf("cannot compare %v with %v", a, b)
+}
+
+// parseWorkflowCondition 编译条件表达式
+func parseWorkflowCondition(expr string) (workflowCondition, error) {
+	tokens, err := tokenizeWorkflowCondition(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &conditionParser{tokens: tokens}
+	cond, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos != len(p.tokens) {
+		return nil, fmt.Errorf("unexpected token %q", p.tokens[p.pos].text)
+	}
+
+	return cond, nil
+}
+
+type conditionTokenKind int
+
+const (
+	tokenOperator conditionTokenKind = iota
+	tokenIdent
+	tokenString
+	tokenNumber
+)
+
+type conditionToken struct {
+	kind conditionTokenKind
+	text string
+}
+
+func tokenizeWorkflowCondition(expr string) ([]conditionToken, error) {
+	var tokens []conditionToken
+
+	for i := 0; i < len(expr); {
+		c := expr[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+
+		case c == '(' || c == ')':
+			tokens = append(tokens, conditionToken{kind: tokenOperator, text: string(c)})
+			i++
+
+		case strings.HasPrefix(expr[i:], "&&"), strings.HasPrefix(expr[i:], "||"),
+			strings.HasPrefix(expr[i:], "=="), strings.HasPrefix(expr[i:], "!="),
+			strings.HasPrefix(expr[i:], ">="), strings.HasPrefix(expr[i:], "<="):
+			tokens = append(tokens, conditionToken{kind: tokenOperator, text: expr[i : i+2]})
+			i += 2
+
+		case c == '>' || c == '<' || c == '!':
+			tokens = append(tokens, conditionToken{kind: tokenOperator, text: string(c)})
+			i++
+
+		case c == '\'' || c == '"':
+			end := strings.IndexByte(expr[i+1:], c)
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated string at %d", i)
+			}
+			tokens = append(tokens, conditionToken{kind: tokenString, text: expr[i+1 : i+1+end]})
+			i += end + 2
+
+		case c == '-' || (c >= '0' && c <= '9'):
+			j := i + 1
+			for j < len(expr) && (expr[j] == '.' || (expr[j] >= '0' && expr[j] <= '9')) {
+				j++
+			}
+			tokens = append(tokens, conditionToken{kind: tokenNumber, text: expr[i:j]})
+			i = j
+
+		case c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z'):
+			j := i + 1
+			for j < len(expr) && (expr[j] == '_' || expr[j] == '.' ||
+				(expr[j] >= 'a' && expr[j] <= 'z') || (expr[j] >= 'A' && expr[j] <= 'Z') || (expr[j] >= '0' && expr[j] <= '9')) {
+				j++
+			}
+			tokens = append(tokens, conditionToken{kind: tokenIdent, text: expr[i:j]})
+			i = j
+
+		default:
+			return nil, fmt.Errorf("unexpected character %q at %d", c, i)
+		}
+	}
+
+	return tokens, nil
+}
+
+type conditionParser struct {
+	tokens []conditionToken
+	pos    int
+}
+
+func (p *conditionParser) peekOperator(ops ...string) (string, bool) {
+	if p.pos >= len(p.tokens) || p.tokens[p.pos].kind != tokenOperator {
+		return "", false
+	}
+	for _, op := range ops {
+		if p.tokens[p.pos].text == op {
+			return op, true
+		}
+	}
+	return "", false
+}
+
+func (p *conditionParser) parseOr() (workflowCondition, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		if _, ok := p.peekOperator("||"); !ok {
+			return left, nil
+		}
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = conditionBinary{op: "||", left: left, right: right}
+	}
+}
+
+func (p *conditionParser) parseAnd() (workflowCondition, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		if _, ok := p.peekOperator("&&"); !ok {
+			return left, nil
+		}
+		p.pos++
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = conditionBinary{op: "&&", left: left, right: right}
+	}
+}
+
+func (p *conditionParser) parseUnary() (workflowCondition, error) {
+	if _, ok := p.peekOperator("!"); ok {
+		p.pos++
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return conditionNot{operand: operand}, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *conditionParser) parseComparison() (workflowCondition, error) {
+	left, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+
+	op, ok := p.peekOperator("==", "!=", ">=", "<=", ">", "<")
+	if !ok {
+		return left, nil
+	}
+	p.pos++
+
+	right, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	return conditionBinary{op: op, left: left, right: right}, nil
+}
+
+func (p *conditionParser) parsePrimary() (workflowCondition, error) {
+	if p.pos >= len(p.tokens) {
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+
+	tok := p.tokens[p.pos]
+	p.pos++
+
+	switch tok.kind {
+	case tokenString:
+		return conditionLiteral{value: tok.text}, nil
+
+	case tokenNumber:
+		f, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", tok.text)
+		}
+		return conditionLiteral{value: f}, nil
+
+	case tokenIdent:
+		switch tok.text {
+		case "true":
+			return conditionLiteral{value: true}, nil
+		case "false":
+			return conditionLiteral{value: false}, nil
+		case "null", "nil":
+			return conditionLiteral{value: nil}, nil
+		}
+		return conditionRef{path: tok.text}, nil
+
+	case tokenOperator:
+		if tok.text == "(" {
+			inner, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := p.peekOperator(")"); !ok {
+				return nil, fmt.Errorf("missing closing parenthesis")
+			}
+			p.pos++
+			return inner, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unexpected token %q", tok.text)
+}
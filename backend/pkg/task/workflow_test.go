@@ -0,0 +1,191 @@
+package task
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWorkflowValidation(t *testing.T) {
+	_, err := NewWorkflow(nil)
+	assert.Error(t, err)
+
+	_, err = NewWorkflow([]WorkflowStep{{Name: "a", TypeName: "x"}, {Name: "a", TypeName: "x"}})
+	assert.ErrorContains(t, err, "duplicate")
+
+	_, err = NewWorkflow([]WorkflowStep{{Name: "a", TypeName: "x", DependsOn: []string{"b"}}})
+	assert.ErrorContains(t, err, "unknown step")
+
+	_, err = NewWorkflow([]WorkflowStep{{Name: "bad-name", TypeName: "x"}})
+	assert.ErrorContains(t, err, "invalid step name")
+
+	_, err = NewWorkflow([]WorkflowStep{{Name: "a", TypeName: "x", Condition: "steps.b.status =="}})
+	assert.ErrorContains(t, err, "invalid condition")
+
+	_, err = NewWorkflow([]WorkflowStep{
+		{Name: "a", TypeName: "x", DependsOn: []string{"c"}},
+		{Name: "b", TypeName: "x", DependsOn: []string{"a"}},
+		{Name: "c", TypeName: "x", DependsOn: []string{"b"}},
+	})
+	assert.ErrorContains(t, err, "cycle")
+
+	w, err := NewWorkflow([]WorkflowStep{
+		{Name: "report", TypeName: "x", DependsOn: []string{"left", "right"}},
+		{Name: "left", TypeName: "x", DependsOn: []string{"fetch"}},
+		{Name: "right", TypeName: "x", DependsOn: []string{"fetch"}},
+		{Name: "fetch", TypeName: "x"},
+	})
+	require.NoError(t, err)
+
+	var names []string
+	for _, step := range w.Steps() {
+		names = append(names, step.Name)
+	}
+	assert.Equal(t, []string{"fetch", "left", "right", "report"}, names)
+}
+
+func TestWorkflowPlanFanOutFanIn(t *testing.T) {
+	w, err := NewWorkflow([]WorkflowStep{
+		{Name: "fetch", TypeName: "x"},
+		{Name: "left", TypeName: "x", DependsOn: []string{"fetch"}},
+		{Name: "right", TypeName: "x", DependsOn: []string{"fetch"}},
+		{Name: "report", TypeName: "x", DependsOn: []string{"left", "right"}},
+	})
+	require.NoError(t, err)
+
+	states := map[string]*StepState{}
+
+	plan := w.Plan(states, nil)
+	assert.Equal(t, []string{"fetch"}, plan.Dispatch)
+	assert.False(t, plan.Done)
+
+	states["fetch"].Status = StepStatusSucceeded
+	plan = w.Plan(states, nil)
+	assert.Equal(t, []string{"left", "right"}, plan.Dispatch)
+
+	states["left"].Status = StepStatusSucceeded
+	plan = w.Plan(states, nil)
+	assert.Empty(t, plan.Dispatch, "fan-in waits for all dependencies")
+
+	states["right"].Status = StepStatusSucceeded
+	plan = w.Plan(states, nil)
+	assert.Equal(t, []string{"report"}, plan.Dispatch)
+
+	states["report"].Status = StepStatusSucceeded
+	plan = w.Plan(states, nil)
+	assert.True(t, plan.Done)
+	assert.False(t, plan.Failed)
+}
+
+func TestWorkflowPlanConditionalBranches(t *testing.T) {
+	w, err := NewWorkflow([]WorkflowStep{
+		{Name: "check", TypeName: "x"},
+		{Name: "full", TypeName: "x", DependsOn: []string{"check"}, Condition: "steps.check.output.changed > 100"},
+		{Name: "incremental", TypeName: "x", DependsOn: []string{"check"}, Condition: "!(steps.check.output.changed > 100) && input.mode != 'off'"},
+		{Name: "notify", TypeName: "x", DependsOn: []string{"full", "incremental"}},
+	})
+	require.NoError(t, err)
+
+	states := map[string]*StepState{}
+	w.Plan(states, map[string]any{"mode": "auto"})
+
+	states["check"].Status = StepStatusSucceeded
+	states["check"].Output = `{"changed":5}`
+	plan := w.Plan(states, map[string]any{"mode": "auto"})
+	assert.Equal(t, []string{"incremental"}, plan.Dispatch)
+	assert.Equal(t, StepStatusSkipped, states["full"].Status)
+
+	states["incremental"].Status = StepStatusSucceeded
+	plan = w.Plan(states, map[string]any{"mode": "auto"})
+	assert.Equal(t, []string{"notify"}, plan.Dispatch, "skipped dependencies count as done")
+}
+
+func TestWorkflowPlanFailure(t *testing.T) {
+	w, err := NewWorkflow([]WorkflowStep{
+		{Name: "a", TypeName: "x"},
+		{Name: "b", TypeName: "x"},
+		{Name: "optional", TypeName: "x", ContinueOnFailure: true},
+		{Name: "c", TypeName: "x", DependsOn: []string{"a", "optional"}},
+		{Name: "d", TypeName: "x", DependsOn: []string{"b"}},
+	})
+	require.NoError(t, err)
+
+	states := map[string]*StepState{}
+	plan := w.Plan(states, nil)
+	assert.Equal(t, []string{"a", "b", "optional"}, plan.Dispatch)
+
+	states["optional"].Status = StepStatusFailed
+	states["a"].Status = StepStatusSucceeded
+	plan = w.Plan(states, nil)
+	assert.False(t, plan.Failed)
+	assert.Equal(t, []string{"c"}, plan.Dispatch)
+
+	states["b"].Status = StepStatusFailed
+	plan = w.Plan(states, nil)
+	assert.True(t, plan.Failed)
+	assert.Equal(t, "b", plan.FailedStep)
+	assert.Empty(t, plan.Dispatch)
+	assert.Equal(t, StepStatusCancelled, states["d"].Status)
+	assert.False(t, plan.Done, "step c is still running")
+
+	states["c"].Status = StepStatusSucceeded
+	plan = w.Plan(states, nil)
+	assert.True(t, plan.Done)
+}
+
+func TestWorkflowStepPayload(t *testing.T) {
+	w, err := NewWorkflow([]WorkflowStep{
+		{Name: "export", TypeName: "x"},
+		{Name: "upload", TypeName: "x", DependsOn: []string{"export"},
+			Payload: `{"file":"{{ steps.export.output.file }}","size":"{{steps.export.output.size}}","label":"{{input.name}}-{{ steps.export.output.size }}","tags":["{{ input.tags.0 }}"]}`},
+	})
+	require.NoError(t, err)
+
+	states := map[string]*StepState{
+		"export": {Status: StepStatusSucceeded, Output: `{"file":"a.csv","size":12}`},
+	}
+
+	payload, err := w.StepPayload("upload", states, `{"name":"daily","tags":["x"]}`)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"file":  "a.csv",
+		"size":  float64(12),
+		"label": "daily-12",
+		"tags":  []any{"x"},
+	}, payload)
+
+	payload, err = w.StepPayload("export", states, nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{}, payload)
+}
+
+func TestWorkflowConditionErrorFailsStep(t *testing.T) {
+	w, err := NewWorkflow([]WorkflowStep{
+		{Name: "a", TypeName: "x", Condition: "input.count > 'x'"},
+	})
+	require.NoError(t, err)
+
+	states := map[string]*StepState{}
+	plan := w.Plan(states, map[string]any{"count": 1})
+	assert.True(t, plan.Failed)
+	assert.True(t, plan.Done)
+	assert.Equal(t, StepStatusFailed, states["a"].Status)
+	assert.Contains(t, states["a"].Error, "cannot compare")
+}
+
+func TestWorkflowStepTaskID(t *testing.T) {
+	id := WorkflowStepTaskID(42, "upload_file", 3)
+	assert.Equal(t, "workflow:42:upload_file:3", id)
+
+	runID, step, generation, ok := ParseWorkflowStepTaskID(id)
+	assert.True(t, ok)
+	assert.Equal(t, uint32(42), runID)
+	assert.Equal(t, "upload_file", step)
+	assert.Equal(t, uint32(3), generation)
+
+	_, _, _, ok = ParseWorkflowStepTaskID("backup:1")
+	assert.False(t, ok)
+	_, _, _, ok = ParseWorkflowStepTaskID("workflow:x:a:1")
+	assert.False(t, ok)
+}
@@ -0,0 +1,216 @@
+package tus
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"hash"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+const (
+	// Version 支持的 tus 协议版本
+	Version = "1.0.0"
+
+	// Extensions 支持的协议扩展
+	Extensions = "creation,expiration,checksum,termination"
+
+	// ChecksumAlgorithms 支持的校验算法
+	ChecksumAlgorithms = "sha1,sha256,md5"
+
+	// OffsetOctetStream PATCH 请求体的内容类型
+	OffsetOctetStream = "application/offset+octet-stream"
+
+	// TailObjectPrefix 暂存尾部数据的临时对象名前缀，由过期清理负责删除
+	TailObjectPrefix = ".tus/"
+)
+
+const (
+	HeaderTusResumable         = "Tus-Resumable"
+	HeaderTusVersion           = "Tus-Version"
+	HeaderTusExtension         = "Tus-Extension"
+	HeaderTusMaxSize           = "Tus-Max-Size"
+	HeaderTusChecksumAlgorithm = "Tus-Checksum-Algorithm"
+	HeaderUploadOffset         = "Upload-Offset"
+	HeaderUploadLength         = "Upload-Length"
+	HeaderUploadMetadata       = "Upload-Metadata"
+	HeaderUploadExpires        = "Upload-Expires"
+	HeaderUploadChecksum       = "Upload-Checksum"
+	HeaderUploadDeferLength    = "Upload-Defer-Length"
+	HeaderUploadConcat         = "Upload-Concat"
+	HeaderLocation             = "Location"
+	HeaderCacheControl         = "Cache-Control"
+	HeaderExposeHeaders        = "Access-Control-Expose-Headers"
+)
+
+// ExposedHeaders 浏览器客户端需要读取的响应头
+var ExposedHeaders = strings.Join([]string{
+	HeaderTusResumable, HeaderTusVersion, HeaderTusExtension, HeaderTusMaxSize, HeaderTusChecksumAlgorithm,
+	HeaderUploadOffset, HeaderUploadLength, HeaderUploadMetadata, HeaderUploadExpires, HeaderLocation,
+}, ", ")
+
+var (
+	ErrUnavailable         = errors.New(503, "TUS_UNAVAILABLE", "resumable upload requires redis")
+	ErrUnsupportedVersion  = errors.New(412, "TUS_UNSUPPORTED_VERSION", "unsupported tus version")
+	ErrNotFound            = errors.New(404, "TUS_UPLOAD_NOT_FOUND", "upload not found")
+	ErrExpired             = errors.New(410, "TUS_UPLOAD_EXPIRED", "upload expired")
+	ErrLocked              = errors.New(423, "TUS_UPLOAD_LOCKED", "upload is locked by another request")
+	ErrOffsetMismatch      = errors.New(409, "TUS_OFFSET_MISMATCH", "upload offset mismatch")
+	ErrInvalidLength       = errors.New(400, "TUS_INVALID_LENGTH", "invalid upload length")
+	ErrInvalidOffset       = errors.New(400, "TUS_INVALID_OFFSET", "invalid upload offset")
+	ErrInvalidMetadata     = errors.New(400, "TUS_INVALID_METADATA", "invalid upload metadata")
+	ErrInvalidContentType  = errors.New(415, "TUS_INVALID_CONTENT_TYPE", "content type must be "+OffsetOctetStream)
+	ErrTooLarge            = errors.New(413, "TUS_TOO_LARGE", "upload exceeds the allowed size")
+	ErrUnsupportedChecksum = errors.New(400, "TUS_UNSUPPORTED_CHECKSUM", "unsupported checksum algorithm")
+	ErrChecksumMismatch    = errors.New(460, "TUS_CHECKSUM_MISMATCH", "checksum mismatch")
+	ErrNotImplemented      = errors.New(501, "TUS_NOT_IMPLEMENTED", "extension not implemented")
+)
+
+// Part 已上传的分片
+type Part struct {
+	Number int    `json:"number"`
+	ETag   string `json:"etag"`
+	Size   int64  `json:"size"`
+}
+
+// Upload 上传会话状态，保存在 Redis 中，任意实例都可以继续上传。
+//
+// 对象存储的分片（最后一片除外）有最小尺寸限制，而 PATCH 请求的数据量由客户端决定，
+// 因此不足一个分片的尾部数据先暂存为临时对象，下次 PATCH 时与新数据拼接后再上传。
+type Upload struct {
+	ID       string `json:"id"`
+	TenantID uint32 `json:"tenant_id"`
+	UserID   uint32 `json:"user_id"`
+
+	// Storage 存储后端标识，由 BackendSelector 据此选择后端
+	Storage        string            `json:"storage,omitempty"`
+	Bucket         string            `json:"bucket"`
+	Object         string            `json:"object"`
+	ContentType    string            `json:"content_type,omitempty"`
+	SourceFileName string            `json:"source_file_name,omitempty"`
+	Metadata       map[string]string `json:"metadata,omitempty"`
+
+	Length   int64 `json:"length"`
+	Offset   int64 `json:"offset"`
+	PartSize int64 `json:"part_size"`
+
+	MultipartID string `json:"multipart_id,omitempty"`
+	Parts       []Part `json:"parts,omitempty"`
+	// TailSize 暂存的尾部数据长度，这部分数据已计入 Offset
+	TailSize int64 `json:"tail_size,omitempty"`
+
+	// HashState 已接收数据的 SHA-256 中间状态，完成后得到 ContentHash
+	HashState   []byte `json:"hash_state,omitempty"`
+	ContentHash string `json:"content_hash,omitempty"`
+
+	// Committed 对象已合并完成，Finished 完成回调已执行成功
+	Committed bool `json:"committed,omitempty"`
+	Finished  bool `json:"finished,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// TailObject 暂存尾部数据的临时对象名
+func (u *Upload) TailObject() string {
+	return TailObjectPrefix + u.ID + ".part"
+}
+
+// IsComplete 数据是否已全部接收
+func (u *Upload) IsComplete() bool {
+	return u.Offset >= u.Length
+}
+
+// ParseMetadata 解析 Upload-Metadata 头，格式为逗号分隔的 "key base64(value)"，value 可省略
+func ParseMetadata(header string) (map[string]string, error) {
+	md := make(map[string]string)
+	if strings.TrimSpace(header) == "" {
+		return md, nil
+	}
+
+	for _, pair := range strings.Split(header, ",") {
+		fields := strings.Fields(pair)
+		switch len(fields) {
+		case 1:
+			md[fields[0]] = ""
+		case 2:
+			v, err := base64.StdEncoding.DecodeString(fields[1])
+			if err != nil {
+				return nil, ErrInvalidMetadata
+			}
+			md[fields[0]] = string(v)
+		default:
+			return nil, ErrInvalidMetadata
+		}
+	}
+
+	return md, nil
+}
+
+// EncodeMetadata 把元数据编码为 Upload-Metadata 头
+func EncodeMetadata(md map[string]string) string {
+	keys := make([]string, 0, len(md))
+	for k := range md {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		if md[k] == "" {
+			pairs = append(pairs, k)
+		} else {
+			pairs = append(pairs, k+" "+base64.StdEncoding.EncodeToString([]byte(md[k])))
+		}
+	}
+
+	return strings.Join(pairs, ",")
+}
+
+// Checksum 解析后的 Upload-Checksum 头
+type Checksum struct {
+	Algorithm string
+	Sum       []byte
+}
+
+// ParseChecksum 解析 Upload-Checksum 头，格式为 "<算法> <base64摘要>"
+func ParseChecksum(header string) (*Checksum, error) {
+	if header == "" {
+		return nil, nil
+	}
+
+	fields := strings.Fields(header)
+	if len(fields) != 2 {
+		return nil, ErrUnsupportedChecksum
+	}
+
+	algorithm := strings.ToLower(fields[0])
+	if newChecksumHash(algorithm) == nil {
+		return nil, ErrUnsupportedChecksum
+	}
+
+	sum, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		return nil, ErrUnsupportedChecksum
+	}
+
+	return &Checksum{Algorithm: algorithm, Sum: sum}, nil
+}
+
+func newChecksumHash(algorithm string) hash.Hash {
+	switch algorithm {
+	case "sha1":
+		return sha1.New()
+	case "sha256":
+		return sha256.New()
+	case "md5":
+		return md5.New()
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,732 @@
+package tus
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// MinPartSize 对象存储分片的最小尺寸（最后一片除外）
+	MinPartSize int64 = 5 << 20
+	// DefaultPartSize 默认分片大小
+	DefaultPartSize int64 = 8 << 20
+	// MaxParts 单个对象的最大分片数
+	MaxParts = 10000
+
+	defaultKeyPrefix     = "tus"
+	defaultExpiration    = 24 * time.Hour
+	defaultLockTTL       = 30 * time.Second
+	defaultSweepInterval = time.Minute
+
+	// 过期后状态再保留一段时间，保证清理任务能读取到分片上传信息
+	expiredStateRetention = time.Hour
+	// 完成后状态保留一段时间，供客户端通过 HEAD 确认上传结果
+	finishedStateRetention = time.Hour
+)
+
+// 仅当锁仍由本请求持有时才续期
+var renewLockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// 仅当锁仍由本请求持有时才释放
+var releaseLockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// Backend 支持分片上传的对象存储
+type Backend interface {
+	NewMultipartUpload(ctx context.Context, bucket, object, contentType string) (uploadID string, err error)
+	PutObjectPart(ctx context.Context, bucket, object, uploadID string, partNumber int, reader io.Reader, size int64) (etag string, err error)
+	CompleteMultipartUpload(ctx context.Context, bucket, object, uploadID string, parts []Part) error
+	AbortMultipartUpload(ctx context.Context, bucket, object, uploadID string) error
+
+	PutObject(ctx context.Context, bucket, object, contentType string, reader io.Reader, size int64) error
+	GetObject(ctx context.Context, bucket, object string) (io.ReadCloser, error)
+	RemoveObject(ctx context.Context, bucket, object string) error
+}
+
+// BackendSelector 按上传选择存储后端，使不同的上传可以写入不同的存储
+type BackendSelector func(upload *Upload) (Backend, error)
+
+// CompleteFunc 上传完成回调，返回错误时上传保持已合并未完成的状态，客户端可重新发送空的 PATCH 请求重试
+type CompleteFunc func(ctx context.Context, upload *Upload) error
+
+// Option 上传器选项
+type Option func(*Uploader)
+
+// WithKeyPrefix 设置 Redis 键前缀
+func WithKeyPrefix(prefix string) Option {
+	return func(u *Uploader) { u.keyPrefix = prefix }
+}
+
+// WithExpiration 设置未完成上传的过期时间，每次 PATCH 后重新计算
+func WithExpiration(expiration time.Duration) Option {
+	return func(u *Uploader) { u.expiration = expiration }
+}
+
+// WithMaxSize 设置单个上传的最大字节数，0 表示不限制
+func WithMaxSize(size int64) Option {
+	return func(u *Uploader) { u.maxSize = size }
+}
+
+// WithPartSize 设置分片大小，生产环境不应小于 MinPartSize
+func WithPartSize(size int64) Option {
+	return func(u *Uploader) { u.partSize = size }
+}
+
+// WithLockTTL 设置上传锁的过期时间，持有期间自动续期
+func WithLockTTL(ttl time.Duration) Option {
+	return func(u *Uploader) { u.lockTTL = ttl }
+}
+
+// WithBackendSelector 设置存储后端选择器，未设置时所有上传使用创建上传器时传入的后端
+func WithBackendSelector(selector BackendSelector) Option {
+	return func(u *Uploader) { u.selector = selector }
+}
+
+// WithSweepInterval 设置过期上传的清理间隔
+func WithSweepInterval(interval time.Duration) Option {
+	return func(u *Uploader) { u.sweepInterval = interval }
+}
+
+// Uploader 基于对象存储分片上传实现的 tus 断点续传。
+//
+// 上传状态保存在 Redis 中，并用 Redis 锁保证同一上传同时只有一个请求写入，因此任意实例都可以继续上传；
+// 超过过期时间未完成的上传由清理循环中止分片上传并删除临时数据，多个实例通过 ZREM 争抢，只会清理一次。
+type Uploader struct {
+	log      *log.Helper
+	rdb      redis.UniversalClient
+	backend  Backend
+	selector BackendSelector
+
+	keyPrefix     string
+	expiration    time.Duration
+	maxSize       int64
+	partSize      int64
+	lockTTL       time.Duration
+	sweepInterval time.Duration
+
+	mu         sync.RWMutex
+	onComplete CompleteFunc
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+	once sync.Once
+}
+
+// NewUploader 创建上传器，rdb 为空时所有操作返回 ErrUnavailable
+func NewUploader(rdb redis.UniversalClient, backend Backend, logger log.Logger, opts ...Option) *Uploader {
+	u := &Uploader{
+		log:           log.NewHelper(log.With(logger, "module", "tus/uploader")),
+		rdb:           rdb,
+		backend:       backend,
+		keyPrefix:     defaultKeyPrefix,
+		expiration:    defaultExpiration,
+		partSize:      DefaultPartSize,
+		lockTTL:       defaultLockTTL,
+		sweepInterval: defaultSweepInterval,
+		stop:          make(chan struct{}),
+	}
+
+	for _, opt := range opts {
+		opt(u)
+	}
+
+	return u
+}
+
+// OnComplete 设置上传完成回调
+func (u *Uploader) OnComplete(fn CompleteFunc) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.onComplete = fn
+}
+
+// MaxSize 单个上传的最大字节数，0 表示不限制
+func (u *Uploader) MaxSize() int64 {
+	return u.maxSize
+}
+
+// Start 启动过期上传清理循环
+func (u *Uploader) Start() {
+	if u.rdb == nil {
+		return
+	}
+
+	u.wg.Add(1)
+	go func() {
+		defer u.wg.Done()
+
+		ticker := time.NewTicker(u.sweepInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-u.stop:
+				return
+			case <-ticker.C:
+				if n, err := u.Sweep(context.Background()); err != nil {
+					u.log.Errorf("sweep expired uploads failed: %s", err.Error())
+				} else if n > 0 {
+					u.log.Infof("swept %d expired uploads", n)
+				}
+			}
+		}
+	}()
+}
+
+// Stop 停止清理循环
+func (u *Uploader) Stop() {
+	u.once.Do(func() {
+		close(u.stop)
+	})
+	u.wg.Wait()
+}
+
+// Create 创建上传，调用方负责填充归属、存储位置、长度和元数据
+func (u *Uploader) Create(ctx context.Context, upload *Upload) (*Upload, error) {
+	if u.rdb == nil {
+		return nil, ErrUnavailable
+	}
+	if upload.Length < 0 {
+		return nil, ErrInvalidLength
+	}
+	if u.maxSize > 0 && upload.Length > u.maxSize {
+		return nil, ErrTooLarge
+	}
+
+	now := time.Now()
+	upload.ID = uuid.NewString()
+	upload.Offset = 0
+	upload.PartSize = u.partSizeFor(upload.Length)
+	upload.CreatedAt = now
+	upload.ExpiresAt = now.Add(u.expiration)
+
+	backend, err := u.backendFor(upload)
+	if err != nil {
+		return nil, err
+	}
+
+	if upload.Length == 0 {
+		// 空文件无需分片上传
+		if err := backend.PutObject(ctx, upload.Bucket, upload.Object, upload.ContentType, bytes.NewReader(nil), 0); err != nil {
+			return nil, err
+		}
+		finishErr := u.finish(ctx, upload)
+		if err := u.save(ctx, upload); err != nil {
+			return nil, err
+		}
+		return upload, finishErr
+	}
+
+	multipartID, err := backend.NewMultipartUpload(ctx, upload.Bucket, upload.Object, upload.ContentType)
+	if err != nil {
+		return nil, err
+	}
+	upload.MultipartID = multipartID
+
+	if err = u.save(ctx, upload); err != nil {
+		_ = backend.AbortMultipartUpload(ctx, upload.Bucket, upload.Object, multipartID)
+		return nil, err
+	}
+
+	return upload, nil
+}
+
+// Get 查询上传状态
+func (u *Uploader) Get(ctx context.Context, id string) (*Upload, error) {
+	if u.rdb == nil {
+		return nil, ErrUnavailable
+	}
+
+	upload, err := u.load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !upload.Finished && time.Now().After(upload.ExpiresAt) {
+		return nil, ErrExpired
+	}
+
+	return upload, nil
+}
+
+// Write 从 offset 处追加数据，size 为请求体长度，未知时传 -1。
+// checksum 不为空时先暂存并校验整个请求体，校验失败则丢弃；未指定校验时按流式写入，连接中断前已接收的数据会被保存。
+// 数据全部接收后合并分片并调用完成回调。
+func (u *Uploader) Write(ctx context.Context, id string, offset, size int64, body io.Reader, checksum *Checksum) (*Upload, error) {
+	if u.rdb == nil {
+		return nil, ErrUnavailable
+	}
+
+	release, err := u.lock(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	upload, err := u.load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !upload.Finished && time.Now().After(upload.ExpiresAt) {
+		return nil, ErrExpired
+	}
+	if offset != upload.Offset {
+		return upload, ErrOffsetMismatch
+	}
+
+	remaining := upload.Length - upload.Offset
+	if size > remaining {
+		return upload, ErrTooLarge
+	}
+	if checksum != nil && remaining > 0 {
+		spooled, cleanup, err := spool(body, checksum, remaining)
+		if err != nil {
+			return upload, err
+		}
+		defer cleanup()
+		body = spooled
+	}
+
+	var writeErr error
+	if remaining > 0 {
+		writeErr = u.writeParts(ctx, upload, io.LimitReader(body, remaining))
+	}
+	if writeErr == nil && upload.IsComplete() {
+		writeErr = u.finish(ctx, upload)
+	}
+
+	if !upload.Finished {
+		upload.ExpiresAt = time.Now().Add(u.expiration)
+	}
+	if err = u.save(ctx, upload); err != nil {
+		return upload, err
+	}
+
+	return upload, writeErr
+}
+
+// Terminate 终止上传并释放已上传的数据
+func (u *Uploader) Terminate(ctx context.Context, id string) error {
+	if u.rdb == nil {
+		return ErrUnavailable
+	}
+
+	release, err := u.lock(ctx, id)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	upload, err := u.load(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	u.discard(ctx, upload)
+
+	return u.delete(ctx, id)
+}
+
+// Sweep 清理已过期的上传，返回清理数量
+func (u *Uploader) Sweep(ctx context.Context) (int, error) {
+	if u.rdb == nil {
+		return 0, ErrUnavailable
+	}
+
+	ids, err := u.rdb.ZRangeByScore(ctx, u.expiryKey(), &redis.ZRangeBy{
+		Min: "-inf",
+		Max: formatScore(time.Now()),
+	}).Result()
+	if err != nil {
+		return 0, err
+	}
+
+	var count int
+	for _, id := range ids {
+		// 由成功移除索引的实例负责清理
+		n, err := u.rdb.ZRem(ctx, u.expiryKey(), id).Result()
+		if err != nil {
+			return count, err
+		}
+		if n == 0 {
+			continue
+		}
+
+		// 仍在写入的上传保存状态时会重新加入索引
+		release, err := u.lock(ctx, id)
+		if err != nil {
+			continue
+		}
+
+		upload, err := u.load(ctx, id)
+		switch {
+		case err != nil || upload.Finished:
+		case time.Now().After(upload.ExpiresAt):
+			u.discard(ctx, upload)
+			if err = u.delete(ctx, id); err == nil {
+				count++
+			}
+		default:
+			// 查询索引后上传被续期，恢复索引
+			u.rdb.ZAdd(ctx, u.expiryKey(), redis.Z{
+				Score:  float64(upload.ExpiresAt.UnixMilli()),
+				Member: id,
+			})
+		}
+
+		release()
+	}
+
+	return count, nil
+}
+
+// partSizeFor 计算分片大小，保证分片数不超过 MaxParts
+func (u *Uploader) partSizeFor(length int64) int64 {
+	size := u.partSize
+	if minSize := (length + MaxParts - 1) / MaxParts; minSize > size {
+		size = minSize
+	}
+	return size
+}
+
+// writeParts 把暂存的尾部数据与请求体拼接后按分片上传，不足一个分片的剩余数据暂存为尾部对象
+func (u *Uploader) writeParts(ctx context.Context, upload *Upload, body io.Reader) (err error) {
+	backend, err := u.backendFor(upload)
+	if err != nil {
+		return err
+	}
+
+	h, err := restoreHash(upload.HashState)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if state, marshalErr := h.(encoding.BinaryMarshaler).MarshalBinary(); marshalErr == nil {
+			upload.HashState = state
+		} else if err == nil {
+			err = marshalErr
+		}
+	}()
+
+	src := body
+	// 缓冲区开头已计入偏移量和摘要的字节数
+	carried := upload.TailSize
+	if carried > 0 {
+		tail, err := backend.GetObject(ctx, upload.Bucket, upload.TailObject())
+		if err != nil {
+			return err
+		}
+		defer tail.Close()
+		src = io.MultiReader(io.LimitReader(tail, carried), body)
+	}
+
+	buf := make([]byte, upload.PartSize)
+	for {
+		n, readErr := io.ReadFull(src, buf)
+		if readErr != nil && int64(n) < carried {
+			return fmt.Errorf("read upload tail: %w", readErr)
+		}
+
+		skip := min(carried, int64(n))
+		carried -= skip
+		received := int64(n) - skip
+		last := upload.Offset+received == upload.Length
+
+		if int64(n) == upload.PartSize || (last && n > 0) {
+			number := len(upload.Parts) + 1
+			etag, err := backend.PutObjectPart(ctx, upload.Bucket, upload.Object, upload.MultipartID, number, bytes.NewReader(buf[:n]), int64(n))
+			if err != nil {
+				return err
+			}
+
+			h.Write(buf[skip:n])
+			upload.Parts = append(upload.Parts, Part{Number: number, ETag: etag, Size: int64(n)})
+			upload.Offset += received
+			upload.TailSize = 0
+
+			if last || readErr != nil {
+				return ignoreEOF(readErr)
+			}
+			continue
+		}
+
+		// 请求体已结束或中断，保存不足一个分片的剩余数据
+		if received > 0 {
+			if err = backend.PutObject(ctx, upload.Bucket, upload.TailObject(), OffsetOctetStream, bytes.NewReader(buf[:n]), int64(n)); err != nil {
+				return err
+			}
+
+			h.Write(buf[skip:n])
+			upload.Offset += received
+			upload.TailSize = int64(n)
+		}
+
+		return ignoreEOF(readErr)
+	}
+}
+
+// finish 合并分片并执行完成回调，两步均可重试
+func (u *Uploader) finish(ctx context.Context, upload *Upload) error {
+	if !upload.Committed {
+		if upload.Length > 0 {
+			backend, err := u.backendFor(upload)
+			if err != nil {
+				return err
+			}
+			if err = backend.CompleteMultipartUpload(ctx, upload.Bucket, upload.Object, upload.MultipartID, upload.Parts); err != nil {
+				return err
+			}
+			if err = backend.RemoveObject(ctx, upload.Bucket, upload.TailObject()); err != nil {
+				u.log.Warnf("remove upload [%s] tail object failed: %s", upload.ID, err.Error())
+			}
+		}
+
+		h, err := restoreHash(upload.HashState)
+		if err != nil {
+			return err
+		}
+		upload.Committed = true
+		upload.ContentHash = hex.EncodeToString(h.Sum(nil))
+	}
+
+	if !upload.Finished {
+		u.mu.RLock()
+		fn := u.onComplete
+		u.mu.RUnlock()
+
+		if fn != nil {
+			if err := fn(ctx, upload); err != nil {
+				return err
+			}
+		}
+		upload.Finished = true
+	}
+
+	return nil
+}
+
+// discard 释放上传占用的存储，已完成的上传保留对象
+func (u *Uploader) discard(ctx context.Context, upload *Upload) {
+	if upload.Finished {
+		return
+	}
+
+	backend, err := u.backendFor(upload)
+	if err != nil {
+		u.log.Warnf("discard upload [%s] failed: %s", upload.ID, err.Error())
+		return
+	}
+
+	if upload.Committed {
+		// 对象已合并但未登记，属于孤立对象
+		if err = backend.RemoveObject(ctx, upload.Bucket, upload.Object); err != nil {
+			u.log.Warnf("remove upload [%s] object failed: %s", upload.ID, err.Error())
+		}
+		return
+	}
+
+	if upload.MultipartID != "" {
+		if err = backend.AbortMultipartUpload(ctx, upload.Bucket, upload.Object, upload.MultipartID); err != nil {
+			u.log.Warnf("abort upload [%s] failed: %s", upload.ID, err.Error())
+		}
+	}
+	if upload.TailSize > 0 || len(upload.Parts) > 0 {
+		if err = backend.RemoveObject(ctx, upload.Bucket, upload.TailObject()); err != nil {
+			u.log.Warnf("remove upload [%s] tail object failed: %s", upload.ID, err.Error())
+		}
+	}
+}
+
+// backendFor 上传使用的存储后端
+func (u *Uploader) backendFor(upload *Upload) (Backend, error) {
+	if u.selector != nil {
+		return u.selector(upload)
+	}
+	return u.backend, nil
+}
+
+func (u *Uploader) stateKey(id string) string {
+	return u.keyPrefix + ":upload:" + id
+}
+
+func (u *Uploader) lockKey(id string) string {
+	return u.keyPrefix + ":upload:" + id + ":lock"
+}
+
+func (u *Uploader) expiryKey() string {
+	return u.keyPrefix + ":expiry"
+}
+
+func (u *Uploader) load(ctx context.Context, id string) (*Upload, error) {
+	data, err := u.rdb.Get(ctx, u.stateKey(id)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var upload Upload
+	if err = json.Unmarshal(data, &upload); err != nil {
+		return nil, err
+	}
+
+	return &upload, nil
+}
+
+func (u *Uploader) save(ctx context.Context, upload *Upload) error {
+	data, err := json.Marshal(upload)
+	if err != nil {
+		return err
+	}
+
+	ttl := finishedStateRetention
+	if !upload.Finished {
+		ttl = time.Until(upload.ExpiresAt) + expiredStateRetention
+	}
+
+	_, err = u.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, u.stateKey(upload.ID), data, ttl)
+		if upload.Finished {
+			pipe.ZRem(ctx, u.expiryKey(), upload.ID)
+		} else {
+			pipe.ZAdd(ctx, u.expiryKey(), redis.Z{
+				Score:  float64(upload.ExpiresAt.UnixMilli()),
+				Member: upload.ID,
+			})
+		}
+		return nil
+	})
+	return err
+}
+
+func (u *Uploader) delete(ctx context.Context, id string) error {
+	_, err := u.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, u.stateKey(id))
+		pipe.ZRem(ctx, u.expiryKey(), id)
+		return nil
+	})
+	return err
+}
+
+// lock 获取上传锁，持有期间定期续期，返回释放函数
+func (u *Uploader) lock(ctx context.Context, id string) (func(), error) {
+	key := u.lockKey(id)
+	token := uuid.NewString()
+
+	ok, err := u.rdb.SetNX(ctx, key, token, u.lockTTL).Result()
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrLocked
+	}
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(u.lockTTL / 3)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := renewLockScript.Run(context.Background(), u.rdb, []string{key}, token, u.lockTTL.Milliseconds()).Err(); err != nil {
+					u.log.Warnf("renew upload [%s] lock failed: %s", id, err.Error())
+				}
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+		wg.Wait()
+		if err := releaseLockScript.Run(context.Background(), u.rdb, []string{key}, token).Err(); err != nil {
+			u.log.Warnf("release upload [%s] lock failed: %s", id, err.Error())
+		}
+	}, nil
+}
+
+// spool 把请求体暂存到临时文件并校验摘要，返回可重新读取的请求体和清理函数
+func spool(body io.Reader, checksum *Checksum, limit int64) (io.Reader, func(), error) {
+	f, err := os.CreateTemp("", "tus-*.part")
+	if err != nil {
+		return nil, nil, err
+	}
+	cleanup := func() {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+	}
+
+	h := newChecksumHash(checksum.Algorithm)
+	n, err := io.Copy(io.MultiWriter(f, h), io.LimitReader(body, limit+1))
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	if n > limit {
+		cleanup()
+		return nil, nil, ErrTooLarge
+	}
+	if !bytes.Equal(h.Sum(nil), checksum.Sum) {
+		cleanup()
+		return nil, nil, ErrChecksumMismatch
+	}
+
+	if _, err = f.Seek(0, io.SeekStart); err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+
+	return f, cleanup, nil
+}
+
+func restoreHash(state []byte) (hash.Hash, error) {
+	h := sha256.New()
+	if len(state) > 0 {
+		if err := h.(encoding.BinaryUnmarshaler).UnmarshalBinary(state); err != nil {
+			return nil, err
+		}
+	}
+	return h, nil
+}
+
+func ignoreEOF(err error) error {
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil
+	}
+	return err
+}
+
+func formatScore(t time.Time) string {
+	return strconv.FormatInt(t.UnixMilli(), 10)
+}
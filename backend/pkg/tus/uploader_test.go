@@ -0,0 +1,343 @@
+package tus
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeBackend struct {
+	mu         sync.Mutex
+	seq        int
+	multiparts map[string]map[int][]byte
+	objects    map[string][]byte
+	aborted    []string
+}
+
+func newFakeBackend() *fakeBackend {
+	return &fakeBackend{
+		multiparts: make(map[string]map[int][]byte),
+		objects:    make(map[string][]byte),
+	}
+}
+
+func (b *fakeBackend) NewMultipartUpload(_ context.Context, _, _, _ string) (string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.seq++
+	id := fmt.Sprintf("mp-%d", b.seq)
+	b.multiparts[id] = make(map[int][]byte)
+	return id, nil
+}
+
+func (b *fakeBackend) PutObjectPart(_ context.Context, _, _, uploadID string, partNumber int, reader io.Reader, _ int64) (string, error) {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return "", err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	parts, ok := b.multiparts[uploadID]
+	if !ok {
+		return "", errors.New("no such upload")
+	}
+	parts[partNumber] = data
+	return fmt.Sprintf("etag-%d", partNumber), nil
+}
+
+func (b *fakeBackend) CompleteMultipartUpload(_ context.Context, bucket, object, uploadID string, parts []Part) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	stored, ok := b.multiparts[uploadID]
+	if !ok {
+		return errors.New("no such upload")
+	}
+
+	sort.Slice(parts, func(i, j int) bool { return parts[i].Number < parts[j].Number })
+	var buf bytes.Buffer
+	for _, p := range parts {
+		buf.Write(stored[p.Number])
+	}
+	b.objects[bucket+"/"+object] = buf.Bytes()
+	delete(b.multiparts, uploadID)
+	return nil
+}
+
+func (b *fakeBackend) AbortMultipartUpload(_ context.Context, _, _, uploadID string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.multiparts, uploadID)
+	b.aborted = append(b.aborted, uploadID)
+	return nil
+}
+
+func (b *fakeBackend) PutObject(_ context.Context, bucket, object, _ string, reader io.Reader, _ int64) error {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.objects[bucket+"/"+object] = data
+	return nil
+}
+
+func (b *fakeBackend) GetObject(_ context.Context, bucket, object string) (io.ReadCloser, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	data, ok := b.objects[bucket+"/"+object]
+	if !ok {
+		return nil, errors.New("no such object")
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (b *fakeBackend) RemoveObject(_ context.Context, bucket, object string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.objects, bucket+"/"+object)
+	return nil
+}
+
+func (b *fakeBackend) object(key string) ([]byte, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	data, ok := b.objects[key]
+	return data, ok
+}
+
+func newTestRedis(t *testing.T) *redis.Client {
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+	return rdb
+}
+
+func TestUploaderChunkedAcrossInstances(t *testing.T) {
+	rdb := newTestRedis(t)
+	backend := newFakeBackend()
+
+	var completed []*Upload
+	onComplete := func(_ context.Context, u *Upload) error {
+		completed = append(completed, u)
+		return nil
+	}
+
+	// 两个实例共享 Redis 状态和对象存储
+	a := NewUploader(rdb, backend, log.DefaultLogger, WithPartSize(4))
+	b := NewUploader(rdb, backend, log.DefaultLogger, WithPartSize(4))
+	a.OnComplete(onComplete)
+	b.OnComplete(onComplete)
+
+	content := []byte("hello resumable world")
+	up, err := a.Create(context.Background(), &Upload{
+		Bucket: "files", Object: "big.bin", Length: int64(len(content)),
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, up.MultipartID)
+
+	offset := int64(0)
+	for i, size := range []int{3, 1, 6, 2, 9} {
+		uploader := a
+		if i%2 == 1 {
+			uploader = b
+		}
+
+		up, err = uploader.Write(context.Background(), up.ID, offset, -1, bytes.NewReader(content[offset:offset+int64(size)]), nil)
+		require.NoError(t, err)
+		offset += int64(size)
+		assert.Equal(t, offset, up.Offset)
+	}
+
+	require.True(t, up.Finished)
+	require.Len(t, completed, 1)
+
+	sum := sha256.Sum256(content)
+	assert.Equal(t, hex.EncodeToString(sum[:]), completed[0].ContentHash)
+
+	data, ok := backend.object("files/big.bin")
+	require.True(t, ok)
+	assert.Equal(t, content, data)
+
+	_, ok = backend.object("files/" + up.TailObject())
+	assert.False(t, ok)
+
+	got, err := b.Get(context.Background(), up.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(content)), got.Offset)
+}
+
+func TestUploaderOffsetAndChecksum(t *testing.T) {
+	rdb := newTestRedis(t)
+	u := NewUploader(rdb, newFakeBackend(), log.DefaultLogger, WithPartSize(4))
+
+	up, err := u.Create(context.Background(), &Upload{Bucket: "files", Object: "a.txt", Length: 10})
+	require.NoError(t, err)
+
+	_, err = u.Write(context.Background(), up.ID, 3, -1, strings.NewReader("abc"), nil)
+	assert.True(t, errors.Is(err, ErrOffsetMismatch))
+
+	_, err = u.Write(context.Background(), up.ID, 0, 11, strings.NewReader("abcdefghijk"), nil)
+	assert.True(t, errors.Is(err, ErrTooLarge))
+
+	sum := sha1.Sum([]byte("abcde"))
+	checksum, err := ParseChecksum("sha1 " + base64.StdEncoding.EncodeToString(sum[:]))
+	require.NoError(t, err)
+
+	// 校验失败的数据被丢弃
+	_, err = u.Write(context.Background(), up.ID, 0, -1, strings.NewReader("abcdX"), checksum)
+	assert.True(t, errors.Is(err, ErrChecksumMismatch))
+	got, err := u.Get(context.Background(), up.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), got.Offset)
+
+	up, err = u.Write(context.Background(), up.ID, 0, -1, strings.NewReader("abcde"), checksum)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), up.Offset)
+
+	_, err = ParseChecksum("crc32 AAAA")
+	assert.True(t, errors.Is(err, ErrUnsupportedChecksum))
+}
+
+func TestUploaderLockedAndTerminate(t *testing.T) {
+	rdb := newTestRedis(t)
+	backend := newFakeBackend()
+	u := NewUploader(rdb, backend, log.DefaultLogger, WithPartSize(4))
+
+	up, err := u.Create(context.Background(), &Upload{Bucket: "files", Object: "a.txt", Length: 10})
+	require.NoError(t, err)
+
+	release, err := u.lock(context.Background(), up.ID)
+	require.NoError(t, err)
+	_, err = u.Write(context.Background(), up.ID, 0, -1, strings.NewReader("ab"), nil)
+	assert.True(t, errors.Is(err, ErrLocked))
+	release()
+
+	_, err = u.Write(context.Background(), up.ID, 0, -1, strings.NewReader("ab"), nil)
+	require.NoError(t, err)
+
+	require.NoError(t, u.Terminate(context.Background(), up.ID))
+	assert.Contains(t, backend.aborted, up.MultipartID)
+
+	_, err = u.Get(context.Background(), up.ID)
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestUploaderSweepExpired(t *testing.T) {
+	rdb := newTestRedis(t)
+	backend := newFakeBackend()
+	u := NewUploader(rdb, backend, log.DefaultLogger, WithPartSize(4), WithExpiration(20*time.Millisecond))
+
+	expired, err := u.Create(context.Background(), &Upload{Bucket: "files", Object: "a.txt", Length: 10})
+	require.NoError(t, err)
+	_, err = u.Write(context.Background(), expired.ID, 0, -1, strings.NewReader("ab"), nil)
+	require.NoError(t, err)
+
+	time.Sleep(50 * time.Millisecond)
+
+	_, err = u.Get(context.Background(), expired.ID)
+	assert.True(t, errors.Is(err, ErrExpired))
+
+	n, err := u.Sweep(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Contains(t, backend.aborted, expired.MultipartID)
+
+	_, ok := backend.object("files/" + expired.TailObject())
+	assert.False(t, ok)
+
+	_, err = u.Get(context.Background(), expired.ID)
+	assert.True(t, errors.Is(err, ErrNotFound))
+
+	// 已清理的上传不会重复处理
+	n, err = u.Sweep(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, n)
+}
+
+func TestUploaderCompleteRetry(t *testing.T) {
+	rdb := newTestRedis(t)
+	u := NewUploader(rdb, newFakeBackend(), log.DefaultLogger, WithPartSize(4))
+
+	calls := 0
+	u.OnComplete(func(context.Context, *Upload) error {
+		calls++
+		if calls == 1 {
+			return errors.New("database unavailable")
+		}
+		return nil
+	})
+
+	up, err := u.Create(context.Background(), &Upload{Bucket: "files", Object: "a.txt", Length: 5})
+	require.NoError(t, err)
+
+	up, err = u.Write(context.Background(), up.ID, 0, -1, strings.NewReader("hello"), nil)
+	assert.Error(t, err)
+	assert.True(t, up.Committed)
+	assert.False(t, up.Finished)
+
+	// 空的 PATCH 请求重试完成回调
+	up, err = u.Write(context.Background(), up.ID, 5, -1, strings.NewReader(""), nil)
+	require.NoError(t, err)
+	assert.True(t, up.Finished)
+	assert.Equal(t, 2, calls)
+}
+
+func TestParseMetadata(t *testing.T) {
+	md, err := ParseMetadata("filename " + base64.StdEncoding.EncodeToString([]byte("报告.pdf")) + ",is_private")
+	require.NoError(t, err)
+	assert.Equal(t, "报告.pdf", md["filename"])
+	_, ok := md["is_private"]
+	assert.True(t, ok)
+
+	_, err = ParseMetadata("filename !!!")
+	assert.Error(t, err)
+}
+
+func TestUploaderBackendSelector(t *testing.T) {
+	rdb := newTestRedis(t)
+	backends := map[string]*fakeBackend{"minio": newFakeBackend(), "local": newFakeBackend()}
+
+	u := NewUploader(rdb, nil, log.DefaultLogger, WithPartSize(4), WithBackendSelector(func(upload *Upload) (Backend, error) {
+		backend, ok := backends[upload.Storage]
+		if !ok {
+			return nil, errors.New("unknown storage")
+		}
+		return backend, nil
+	}))
+
+	up, err := u.Create(context.Background(), &Upload{Storage: "local", Bucket: "files", Object: "a.txt", Length: 5})
+	require.NoError(t, err)
+
+	_, err = u.Write(context.Background(), up.ID, 0, -1, strings.NewReader("hello"), nil)
+	require.NoError(t, err)
+
+	data, ok := backends["local"].object("files/a.txt")
+	require.True(t, ok)
+	assert.Equal(t, "hello", string(data))
+
+	_, ok = backends["minio"].object("files/a.txt")
+	assert.False(t, ok)
+
+	_, err = u.Create(context.Background(), &Upload{Storage: "s3", Bucket: "files", Object: "b.txt", Length: 5})
+	assert.Error(t, err)
+}
@@ -10,6 +10,7 @@ TRUNCATE TABLE sys_org_units AUTO_INCREMENT = 1;
 TRUNCATE TABLE sys_positions AUTO_INCREMENT = 1;
 TRUNCATE TABLE sys_tasks AUTO_INCREMENT = 1;
 TRUNCATE TABLE sys_login_policies AUTO_INCREMENT = 1;
+TRUNCATE TABLE sys_audit_log_retention_policies AUTO_INCREMENT = 1;
 TRUNCATE TABLE sys_dict_types AUTO_INCREMENT = 1;
 TRUNCATE TABLE sys_dict_entries AUTO_INCREMENT = 1;
 TRUNCATE TABLE internal_message_categories AUTO_INCREMENT = 1;
@@ -98,7 +99,11 @@ ALTER TABLE sys_membership_roles AUTO_INCREMENT = (SELECT COALESCE(MAX(id) + 1,
 
 -- 调度任务（JSON字段直接保留字符串，MySQL5.7+支持）
 INSERT INTO sys_tasks(type, type_name, task_payload, cron_spec, enable, created_at)
-VALUES ('PERIODIC', 'backup', '{ "name": "test"}', '0 * * * *', true, NOW());
+VALUES ('PERIODIC', 'backup', '{ "name": "test"}', '0 * * * *', true, NOW()),
+       ('PERIODIC', 'audit_archive', '{}', '0 3 * * *', true, NOW()),
+       ('PERIODIC', 'storage_usage_reconcile', '{}', '30 3 * * *', true, NOW()),
+       ('PERIODIC', 'storage_orphan_reconcile', '{"dry_run": true}', '0 4 * * 0', true, NOW()),
+       ('PERIODIC', 'file_recycle_bin_purge', '{}', '30 4 * * *', true, NOW());
 ALTER TABLE sys_tasks AUTO_INCREMENT = (SELECT COALESCE(MAX(id) + 1, 1) FROM sys_tasks);
 
 -- 登录策略
@@ -108,6 +113,17 @@ VALUES
     (2, 1, 'WHITELIST', 'MAC', '00:1B:44:11:3A:B7 ', '无理由', NOW());
 ALTER TABLE sys_login_policies AUTO_INCREMENT = (SELECT COALESCE(MAX(id) + 1, 1) FROM sys_login_policies);
 
+-- 审计日志保留策略（平台默认，租户0）
+INSERT INTO sys_audit_log_retention_policies(id, tenant_id, log_type, retention_days, archive_enabled, enabled, batch_size, created_at)
+VALUES
+    (1, 0, 'API_AUDIT_LOG', 180, true, true, 1000, NOW()),
+    (2, 0, 'LOGIN_AUDIT_LOG', 365, true, true, 1000, NOW()),
+    (3, 0, 'OPERATION_AUDIT_LOG', 365, true, true, 1000, NOW()),
+    (4, 0, 'DATA_ACCESS_AUDIT_LOG', 180, true, true, 1000, NOW()),
+    (5, 0, 'PERMISSION_AUDIT_LOG', 730, true, true, 1000, NOW()),
+    (6, 0, 'POLICY_EVALUATION_LOG', 90, false, true, 1000, NOW());
+ALTER TABLE sys_audit_log_retention_policies AUTO_INCREMENT = (SELECT COALESCE(MAX(id) + 1, 1) FROM sys_audit_log_retention_policies);
+
 -- 字典类型（移除PG的序列重置指令，MySQL TRUNCATE已重置）
 INSERT INTO sys_dict_types(id, type_code, type_name, sort_order, description, is_enabled, created_at)
 VALUES
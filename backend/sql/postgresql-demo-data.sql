@@ -7,6 +7,7 @@ TRUNCATE TABLE public.sys_org_units,
                public.sys_positions,
                public.sys_tasks,
                public.sys_login_policies,
+               public.sys_audit_log_retention_policies,
                public.sys_dict_types,
                public.sys_dict_entries,
                public.internal_message_categories
@@ -102,7 +103,11 @@ SELECT setval('sys_membership_roles_id_seq', (SELECT MAX(id) FROM sys_membership
 -- 调度任务
 INSERT INTO public.sys_tasks(type, type_name, task_payload, cron_spec, enable, created_at)
 VALUES
-    ('PERIODIC', 'backup', '{ "name": "test"}', '0 * * * *', true, now())
+    ('PERIODIC', 'backup', '{ "name": "test"}', '0 * * * *', true, now()),
+    ('PERIODIC', 'audit_archive', '{}', '0 3 * * *', true, now()),
+    ('PERIODIC', 'storage_usage_reconcile', '{}', '30 3 * * *', true, now()),
+    ('PERIODIC', 'storage_orphan_reconcile', '{"dry_run": true}', '0 4 * * 0', true, now()),
+    ('PERIODIC', 'file_recycle_bin_purge', '{}', '30 4 * * *', true, now())
 ;
 SELECT setval('sys_tasks_id_seq', (SELECT MAX(id) FROM sys_tasks));
 
@@ -114,6 +119,18 @@ VALUES
 ;
 SELECT setval('sys_login_policies_id_seq', (SELECT MAX(id) FROM sys_login_policies));
 
+-- 审计日志保留策略（平台默认，租户0）
+INSERT INTO public.sys_audit_log_retention_policies(id, tenant_id, log_type, retention_days, archive_enabled, enabled, batch_size, created_at)
+VALUES
+    (1, 0, 'API_AUDIT_LOG', 180, true, true, 1000, now()),
+    (2, 0, 'LOGIN_AUDIT_LOG', 365, true, true, 1000, now()),
+    (3, 0, 'OPERATION_AUDIT_LOG', 365, true, true, 1000, now()),
+    (4, 0, 'DATA_ACCESS_AUDIT_LOG', 180, true, true, 1000, now()),
+    (5, 0, 'PERMISSION_AUDIT_LOG', 730, true, true, 1000, now()),
+    (6, 0, 'POLICY_EVALUATION_LOG', 90, false, true, 1000, now())
+;
+SELECT setval('sys_audit_log_retention_policies_id_seq', (SELECT MAX(id) FROM sys_audit_log_retention_policies));
+
 -- 插入字典类型
 INSERT INTO public.sys_dict_types (
     id, type_code, type_name, sort_order, is_enabled, created_at, updated_at